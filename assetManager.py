@@ -158,6 +158,7 @@ class AssetManagerPlugin:
             # Initialize plugin through service layer
             if plugin_service and plugin_service.initialize():
                 self._is_initialized = True
+                self._register_runtime_commands()
                 print(f"SUCCESS: Asset Manager v{self._plugin_version} initialized successfully!")
                 print("INFO: Enterprise Modular Service Architecture (EMSA) active")
                 print("INFO: Maya Module System enabled for automatic discovery")
@@ -178,6 +179,18 @@ class AssetManagerPlugin:
             # Restore original sys.path for security
             sys.path[:] = original_path
 
+    def _register_runtime_commands(self) -> None:
+        """
+        List the manager commands and marking menu in Maya's Hotkey Editor.
+        Hotkeys bound there keep working while the Asset Manager window is closed.
+        """
+        try:
+            import maya_plugin
+
+            maya_plugin.register_runtime_commands()
+        except Exception as e:
+            print(f"WARNING: Asset Manager runtime commands not registered: {e}")
+
     def _initialize_legacy(self) -> bool:
         """
         Legacy fallback initialization for backward compatibility.
@@ -246,6 +259,11 @@ class AssetManagerPlugin:
                     if plugin_service:
                         plugin_service.shutdown()
 
+            # Remove the manager commands from Maya's Hotkey Editor
+            maya_plugin = sys.modules.get("maya_plugin")
+            if maya_plugin is not None:
+                maya_plugin.unregister_runtime_commands()
+
             # Clean up legacy UI if exists
             if cmds.window("assetManagerUI", exists=True):
                 cmds.deleteUI("assetManagerUI")
@@ -0,0 +1,8 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Manager Command Line Package
+Makes ``mayapy -m assetmanager`` resolve from the installed plugin folder
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
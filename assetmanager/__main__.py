@@ -0,0 +1,20 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Manager Command Line Entry Point
+Runs src/cli.py for ``mayapy -m assetmanager <command>``
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import sys
+from pathlib import Path
+
+# The plugin folder holds src/ next to this package
+PLUGIN_DIR = str(Path(__file__).resolve().parent.parent)
+if PLUGIN_DIR not in sys.path:
+    sys.path.insert(0, PLUGIN_DIR)
+
+from src.cli import main  # noqa: E402
+
+sys.exit(main())
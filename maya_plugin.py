@@ -140,3 +140,74 @@ def show_asset_manager():
         # Restore original path for security
         if original_path is not None:
             sys.path[:] = original_path
+
+
+def run_manager_command(command_id):
+    """
+    Run a manager command from a Maya runtime command, hotkey, or marking menu item.
+    Opens the window first, so commands work while Asset Manager is closed.
+    """
+    window = show_asset_manager()
+    if window is None:
+        return
+    try:
+        window.run_manager_command(command_id)
+    except Exception as e:
+        print(f"[ERROR] Asset Manager command '{command_id}' failed: {e}")
+
+
+def show_marking_menu():
+    """Show the Asset Manager marking menu (press half of its hotkey)"""
+    original_path = _ensure_path_for_imports()
+    try:
+        import maya.cmds as cmds  # type: ignore
+        from src.services.hotkey_service_impl import get_hotkey_service
+
+        get_hotkey_service().show_marking_menu(cmds)
+    except Exception as e:
+        print(f"[ERROR] Failed to show the Asset Manager marking menu: {e}")
+    finally:
+        sys.path[:] = original_path
+
+
+def hide_marking_menu():
+    """Hide the Asset Manager marking menu (release half of its hotkey)"""
+    original_path = _ensure_path_for_imports()
+    try:
+        import maya.cmds as cmds  # type: ignore
+        from src.services.hotkey_service_impl import get_hotkey_service
+
+        get_hotkey_service().hide_marking_menu(cmds)
+    except Exception as e:
+        print(f"[WARNING] Failed to hide the Asset Manager marking menu: {e}")
+    finally:
+        sys.path[:] = original_path
+
+
+def register_runtime_commands():
+    """Register the manager commands with Maya's Hotkey Editor when the plugin loads"""
+    original_path = _ensure_path_for_imports()
+    try:
+        import maya.cmds as cmds  # type: ignore
+        from src.services.hotkey_service_impl import get_hotkey_service
+
+        return get_hotkey_service().register_runtime_commands(cmds)
+    except Exception as e:
+        print(f"[WARNING] Asset Manager runtime commands not registered: {e}")
+        return []
+    finally:
+        sys.path[:] = original_path
+
+
+def unregister_runtime_commands():
+    """Remove the manager commands from Maya's Hotkey Editor when the plugin unloads"""
+    original_path = _ensure_path_for_imports()
+    try:
+        import maya.cmds as cmds  # type: ignore
+        from src.services.hotkey_service_impl import get_hotkey_service
+
+        get_hotkey_service().unregister_runtime_commands(cmds)
+    except Exception as e:
+        print(f"[WARNING] Asset Manager runtime commands not removed: {e}")
+    finally:
+        sys.path[:] = original_path
@@ -445,6 +445,7 @@ print("Usage: dev_hot_reload.r() or dev_hot_reload.reload_asset_manager()")
             # Step 5: Copy complete EMSA architecture
             self._log("\n Copying EMSA architecture...")
             self.copy_source_directory("src", "src")
+            self.copy_source_directory("assetmanager", "assetmanager")
 
             # Step 6: Installation complete
             self._log("\n" + "=" * 60)
@@ -0,0 +1,348 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Manager Command Line
+Headless library maintenance under mayapy - publish, re-export, thumbnails, validate, serve
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Run from the installed plugin folder (or with it on PYTHONPATH)::
+
+    mayapy -m assetmanager publish --file car.ma --library //srv/assets --tags vehicle
+    mayapy -m assetmanager reexport --library //srv/assets --asset "car*"
+    mayapy -m assetmanager thumbnails --library //srv/assets --missing-only
+    mayapy -m assetmanager validate --library //srv/assets --report nightly.json
+    python -m assetmanager audit --library //srv/assets --report audit.json
+    python -m assetmanager serve --library //srv/assets --host 0.0.0.0 --port 8765
+    python -m assetmanager export --library //srv/assets --package assets.amlib
+    python -m assetmanager import --package assets.amlib --library //new/assets
+    python -m assetmanager migrate --library //new/assets --rewrite //srv/assets //new/assets
+    python -m assetmanager translations --locale fr --language "Français"
+
+Exit codes: 0 when every file succeeded, 1 when any file failed, 2 for usage errors.
+``serve`` runs a read-only HTTP API and ``audit`` verifies publish checksums;
+``export``, ``import`` and ``migrate`` move libraries; ``translations`` updates a UI
+translation file with the strings added since. None of them need Maya.
+"""
+
+import argparse
+import json
+import sys
+from pathlib import Path
+from typing import Any, List, Optional
+
+EXIT_OK = 0
+EXIT_FAILED = 1
+EXIT_USAGE = 2
+
+
+def build_parser() -> argparse.ArgumentParser:
+    """Build the command line parser with one sub-command per operation"""
+    parser = argparse.ArgumentParser(
+        prog="assetmanager", description="Asset Manager library maintenance (run under mayapy)"
+    )
+    commands = parser.add_subparsers(dest="command", required=True)
+
+    publish = commands.add_parser("publish", help="Publish a scene file into a library")
+    publish.add_argument("--file", required=True, type=Path, help="Maya scene to publish")
+    _add_library_argument(publish)
+    publish.add_argument("--name", default="", help="Asset name (default: file name)")
+    publish.add_argument(
+        "--tags", default="", help="Comma separated tags added to the asset (vehicle,hero)"
+    )
+    publish.add_argument("--notes", default="", help="Version notes")
+    publish.add_argument(
+        "--format", dest="file_format", choices=[".ma", ".mb"], help="Published file format"
+    )
+    publish.add_argument("--skip-validation", action="store_true", help="Skip publish checks")
+    publish.add_argument("--strict", action="store_true", help="Fail on validation warnings")
+    publish.add_argument("--no-thumbnail", action="store_true", help="Skip thumbnail render")
+
+    reexport = commands.add_parser(
+        "reexport", help="Open and save library scenes again as new versions"
+    )
+    _add_library_argument(reexport)
+    _add_asset_argument(reexport)
+
+    thumbnails = commands.add_parser("thumbnails", help="Regenerate library thumbnails")
+    _add_library_argument(thumbnails)
+    _add_asset_argument(thumbnails)
+    thumbnails.add_argument(
+        "--missing-only", action="store_true", help="Only assets without a thumbnail"
+    )
+    thumbnails.add_argument(
+        "--turntable", choices=[".gif", ".mp4"], default="", help="Also render a turntable"
+    )
+
+    validate = commands.add_parser("validate", help="Run the publish checks on assets")
+    validate.add_argument("--file", type=Path, help="Single scene to validate")
+    _add_library_argument(validate)
+    _add_asset_argument(validate)
+    validate.add_argument("--report", type=Path, help="Write findings to a JSON file")
+
+    audit = commands.add_parser("audit", help="Verify published files against their checksums")
+    _add_library_argument(audit)
+    audit.add_argument("--report", type=Path, help="Write findings to a JSON file")
+
+    serve = commands.add_parser("serve", help="Serve the library over a read-only HTTP API")
+    _add_library_argument(serve)
+    serve.add_argument("--host", default="127.0.0.1", help="Interface to listen on")
+    serve.add_argument("--port", type=int, default=8765, help="Port to listen on")
+    serve.add_argument(
+        "--allow-origin",
+        default="*",
+        help="Access-Control-Allow-Origin for browser dashboards (empty to leave it out)",
+    )
+
+    export = commands.add_parser(
+        "export", help="Package a library's files, thumbnails, and metadata into one file"
+    )
+    _add_library_argument(export)
+    export.add_argument("--package", required=True, type=Path, help="Package to write (.amlib)")
+
+    import_ = commands.add_parser("import", help="Unpack a library package into a new root")
+    import_.add_argument("--package", required=True, type=Path, help="Package to unpack")
+    import_.add_argument(
+        "--library", required=True, type=Path, help="Folder the library is unpacked into"
+    )
+    import_.add_argument(
+        "--overwrite", action="store_true", help="Replace files already in the folder"
+    )
+
+    migrate = commands.add_parser(
+        "migrate", help="Rewrite a moved library's stored paths and upgrade its metadata"
+    )
+    _add_library_argument(migrate)
+    migrate.add_argument(
+        "--rewrite",
+        nargs=2,
+        action="append",
+        default=[],
+        metavar=("OLD", "NEW"),
+        help="Old library root and its new location, repeatable",
+    )
+    migrate.add_argument(
+        "--backend", choices=["database"], default="database", help="Metadata backend"
+    )
+
+    translations = commands.add_parser(
+        "translations", help="Add new UI strings to a translation file, keeping translations"
+    )
+    translations.add_argument(
+        "--locale", default="template", help="Translation file to update (default: template)"
+    )
+    translations.add_argument("--language", default="", help="Language name shown in the menu")
+
+    return parser
+
+
+def _add_library_argument(parser: argparse.ArgumentParser) -> None:
+    parser.add_argument("--library", required=True, type=Path, help="Library (project) root")
+
+
+def _add_asset_argument(parser: argparse.ArgumentParser) -> None:
+    parser.add_argument(
+        "--asset",
+        action="append",
+        default=[],
+        help="Asset name pattern, repeatable (default: every asset)",
+    )
+
+
+def run_command(args: argparse.Namespace, cmds: Any, batch_service: Any = None) -> int:
+    """
+    Run a parsed command against a Maya session
+
+    Args:
+        args: Parsed command line
+        cmds: maya.cmds of the standalone session
+        batch_service: BatchService to use (the shared one when omitted)
+
+    Returns:
+        Process exit code
+    """
+    from .services.batch_service_impl import THUMBNAIL_EXTENSIONS, get_batch_service
+
+    service = batch_service or get_batch_service()
+    if not args.library.is_dir():
+        print(f"[ERROR] Library not found: {args.library}")
+        return EXIT_USAGE
+
+    if args.command == "publish":
+        if not args.file.is_file():
+            print(f"[ERROR] Scene not found: {args.file}")
+            return EXIT_USAGE
+        results = [
+            service.publish(
+                cmds,
+                args.file,
+                args.library,
+                name=args.name,
+                tags=[tag.strip() for tag in args.tags.split(",") if tag.strip()],
+                notes=args.notes,
+                file_format=args.file_format or "",
+                validate=not args.skip_validation,
+                strict=args.strict,
+                thumbnail=not args.no_thumbnail,
+            )
+        ]
+    elif args.command == "reexport":
+        assets = service.find_assets(args.library, args.asset)
+        results = [service.reexport(cmds, path, args.library) for path in assets]
+    elif args.command == "thumbnails":
+        assets = service.find_assets(args.library, args.asset, THUMBNAIL_EXTENSIONS)
+        results = service.render_thumbnails(
+            assets, args.missing_only, args.turntable, library_root=args.library
+        )
+    else:
+        assets = [args.file] if args.file else service.find_assets(args.library, args.asset)
+        results = [service.validate(cmds, path, args.library) for path in assets]
+        if args.report:
+            _write_report(args.report, results)
+
+    for result in results:
+        print(result.description)
+    failed = sum(1 for result in results if not result.success)
+    print(f"[INFO] {args.command}: {len(results) - failed} succeeded, {failed} failed")
+    return EXIT_FAILED if failed else EXIT_OK
+
+
+def _write_report(report_path: Path, results: List[Any]) -> None:
+    """Write validation findings as JSON for nightly job logs"""
+    data = [
+        {
+            "file": str(result.file_path),
+            "can_publish": result.success,
+            "summary": result.message,
+            "issues": [
+                {
+                    "check": issue.check_id,
+                    "severity": issue.severity,
+                    "message": issue.message,
+                    "nodes": list(issue.nodes),
+                }
+                for issue in (result.report.issues if result.report else [])
+            ],
+        }
+        for result in results
+    ]
+    report_path.parent.mkdir(parents=True, exist_ok=True)
+    with open(report_path, "w", encoding="utf-8") as f:
+        json.dump(data, f, indent=2)
+    print(f"[OK] Validation report written: {report_path}")
+
+
+def run_server(args: argparse.Namespace) -> int:
+    """Serve a library over HTTP until interrupted (no Maya session needed)"""
+    from .services.library_server_impl import serve
+
+    if not args.library.is_dir():
+        print(f"[ERROR] Library not found: {args.library}")
+        return EXIT_USAGE
+    try:
+        serve(args.library, args.host, args.port, args.allow_origin)
+    except OSError as e:
+        print(f"[ERROR] Could not listen on {args.host}:{args.port}: {e}")
+        return EXIT_FAILED
+    return EXIT_OK
+
+
+def run_audit(args: argparse.Namespace) -> int:
+    """Audit a library's publish checksums (no Maya session needed)"""
+    from .services.integrity_service_impl import get_integrity_service
+    from .services.metadata_database_impl import get_metadata_database
+
+    if not args.library.is_dir():
+        print(f"[ERROR] Library not found: {args.library}")
+        return EXIT_USAGE
+    report = get_integrity_service().audit(get_metadata_database(args.library))
+    for issue in report.problems:
+        print(f"[{issue.label.upper()}] {issue.path} - {issue.advice}")
+    if args.report:
+        args.report.parent.mkdir(parents=True, exist_ok=True)
+        with open(args.report, "w", encoding="utf-8") as f:
+            json.dump(report.to_dict(), f, indent=2)
+        print(f"[OK] Audit report written: {args.report}")
+    return EXIT_OK if report.is_clean else EXIT_FAILED
+
+
+def run_translations(args: argparse.Namespace) -> int:
+    """Bring a UI translation file in line with the tr() strings of the source"""
+    from .services.localization_service_impl import get_localization_service
+
+    try:
+        added, removed = get_localization_service().update_catalog(
+            args.locale, language=args.language
+        )
+    except Exception as e:
+        print(f"[ERROR] Failed to update translations for {args.locale}: {e}")
+        return EXIT_FAILED
+    print(f"[OK] {args.locale}.json: {added} string(s) added, {removed} removed")
+    return EXIT_OK
+
+
+def run_transfer(args: argparse.Namespace) -> int:
+    """Export, import, or migrate a library (no Maya session needed)"""
+    from .services.library_migration_service_impl import get_library_migration_service
+
+    service = get_library_migration_service()
+    if args.command != "import" and not args.library.is_dir():
+        print(f"[ERROR] Library not found: {args.library}")
+        return EXIT_USAGE
+    try:
+        if args.command == "export":
+            report = service.export_package(args.library, args.package)
+        elif args.command == "import":
+            if not args.package.is_file():
+                print(f"[ERROR] Package not found: {args.package}")
+                return EXIT_USAGE
+            report = service.import_package(args.package, args.library, args.overwrite)
+        else:
+            report = service.migrate(args.library, args.rewrite, args.backend)
+    except (ValueError, FileExistsError) as e:
+        print(f"[ERROR] {args.command} failed: {e}")
+        return EXIT_FAILED
+
+    for path in report.needs_relink:
+        print(f"[WARNING] {path} - binary scene still uses old paths; relink it in Maya")
+    for warning in report.warnings:
+        print(f"[WARNING] {warning}")
+    return EXIT_OK
+
+
+def main(argv: Optional[List[str]] = None) -> int:
+    """Parse arguments, start Maya standalone, and run the command"""
+    try:
+        args = build_parser().parse_args(argv)
+    except SystemExit as e:
+        return EXIT_USAGE if e.code else EXIT_OK
+
+    if args.command == "serve":
+        return run_server(args)
+    if args.command == "audit":
+        return run_audit(args)
+    if args.command in ("export", "import", "migrate"):
+        return run_transfer(args)
+    if args.command == "translations":
+        return run_translations(args)
+
+    try:
+        import maya.standalone  # type: ignore
+    except ImportError:
+        print("[ERROR] Maya not found - run this command with mayapy")
+        return EXIT_USAGE
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+
+        return run_command(args, cmds)
+    except Exception as e:
+        print(f"[ERROR] {args.command} failed: {e}")
+        return EXIT_FAILED
+    finally:
+        maya.standalone.uninitialize()
+
+
+if __name__ == "__main__":
+    sys.exit(main())
@@ -135,6 +135,36 @@ class EMSAContainer:
 
                 traceback.print_exc()
 
+            # Register Version Service (file-based, no dependencies)
+            try:
+                from .interfaces.version_service import IVersionService
+                from ..services.version_service_impl import get_version_service
+
+                self.register_instance(IVersionService, get_version_service())
+                print("[OK] Version service registered")
+            except Exception as version_error:
+                print(f"[WARNING] Version service registration failed: {version_error}")
+
+            # Register Lock Service (file-based, no dependencies)
+            try:
+                from .interfaces.lock_service import ILockService
+                from ..services.lock_service_impl import get_lock_service
+
+                self.register_instance(ILockService, get_lock_service())
+                print("[OK] Lock service registered")
+            except Exception as lock_error:
+                print(f"[WARNING] Lock service registration failed: {lock_error}")
+
+            # Register Source Control (Perforce through the p4 client, used when enabled)
+            try:
+                from .interfaces.source_control import ISourceControl
+                from ..services.perforce_service_impl import get_perforce_service
+
+                self.register_instance(ISourceControl, get_perforce_service())
+                print("[OK] Source control service registered")
+            except Exception as source_control_error:
+                print(f"[WARNING] Source control registration failed: {source_control_error}")
+
         except Exception as e:
             print(f"[WARNING] Could not configure essential services: {e}")
 
@@ -354,6 +384,26 @@ def configure_services() -> ServiceContainer:
 
             traceback.print_exc()
 
+        # Register Version Service
+        try:
+            from .interfaces.version_service import IVersionService
+            from ..services.version_service_impl import get_version_service
+
+            container.register_instance(IVersionService, get_version_service())
+            print("[OK] Registered version service")
+        except Exception as version_error:
+            print(f"[WARNING] Failed to register version service: {version_error}")
+
+        # Register Lock Service
+        try:
+            from .interfaces.lock_service import ILockService
+            from ..services.lock_service_impl import get_lock_service
+
+            container.register_instance(ILockService, get_lock_service())
+            print("[OK] Registered lock service")
+        except Exception as lock_error:
+            print(f"[WARNING] Failed to register lock service: {lock_error}")
+
         print(f"[TARGET] Successfully configured {len(services)} services")
         return container
 
@@ -395,6 +445,24 @@ def _configure_fallback_services(container: ServiceContainer) -> ServiceContaine
         except Exception as lib_error:
             print(f"[WARNING] Failed to configure fallback library service: {lib_error}")
 
+        # Register Version Service (file-based, safe in fallback mode)
+        try:
+            from .interfaces.version_service import IVersionService
+            from ..services.version_service_impl import get_version_service
+
+            container.register_instance(IVersionService, get_version_service())
+        except Exception as version_error:
+            print(f"[WARNING] Failed to configure fallback version service: {version_error}")
+
+        # Register Lock Service (file-based, safe in fallback mode)
+        try:
+            from .interfaces.lock_service import ILockService
+            from ..services.lock_service_impl import get_lock_service
+
+            container.register_instance(ILockService, get_lock_service())
+        except Exception as lock_error:
+            print(f"[WARNING] Failed to configure fallback lock service: {lock_error}")
+
         print("[OK] Fallback services configured")
         return container
 
@@ -13,6 +13,10 @@ from .maya_integration import IMayaIntegration
 from .event_publisher import IEventPublisher, EventType
 from .library_service import ILibraryService
 from .usd_import_service import IUsdImportService, UsdImportOptions, ImportResult
+from .version_service import IVersionService
+from .lock_service import ILockService
+from .source_control import ISourceControl
+from .render_farm import IRenderFarm
 
 __all__ = [
     "IAssetRepository",
@@ -25,4 +29,8 @@ __all__ = [
     "IUsdImportService",
     "UsdImportOptions",
     "ImportResult",
+    "IVersionService",
+    "ILockService",
+    "ISourceControl",
+    "IRenderFarm",
 ]
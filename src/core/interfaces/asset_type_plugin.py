@@ -0,0 +1,102 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Type Plugin Interface
+Contract for studio asset types the library publishes and imports through plugin code
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A studio asset type is a ``.py`` file in an asset types folder containing one or
+more subclasses::
+
+    from src.core.interfaces.asset_type_plugin import IAssetTypePlugin
+
+    class CrowdAgentType(IAssetTypePlugin):
+        type_id = "crowd_agent"
+        name = "Crowd Agent"
+        extension = ".agent"
+        folder = "crowd"
+        search_keywords = ("crowd", "agent")
+
+        def export(self, cmds, file_path, selection):
+            write_agent(file_path, selection)
+            return []
+
+        def import_asset(self, cmds, file_path):
+            return [load_agent(file_path)]
+
+Files with the type's extension are listed in the library, offered as a format when
+publishing, and answer to ``type:`` searches for the type id and keywords.
+"""
+
+from abc import ABC, abstractmethod
+from pathlib import Path
+from typing import Any, Iterable, List, Tuple
+
+from ..models.validation_result import SEVERITY_ERROR, ValidationIssue
+
+
+class IAssetTypePlugin(ABC):
+    """
+    Asset Type Plugin Interface - Single Responsibility for one studio file format
+    Only export and import are required; thumbnails and checks fall back to the defaults
+    """
+
+    type_id: str = ""
+    name: str = ""
+    extension: str = ""  # File suffix claimed by the type, e.g. ".agent"
+    description: str = ""
+    folder: str = "scenes"  # Library folder new assets go into (assets/<folder>)
+    search_keywords: Tuple[str, ...] = ()  # Extra type: keywords besides the type id
+
+    @property
+    def format_label(self) -> str:
+        """Get display text for the publish format combo (Crowd Agent (.agent))"""
+        return f"{self.name or self.type_id} ({self.extension})"
+
+    @abstractmethod
+    def export(self, cmds: Any, file_path: Path, selection: List[str]) -> List[Path]:
+        """
+        Write the content being published as an asset file
+
+        Args:
+            cmds: maya.cmds module (passed in so plugins stay testable)
+            file_path: Asset file to write (in the publish staging folder)
+            selection: Selected nodes, empty to publish the whole scene
+
+        Returns:
+            Other files written next to the asset file, versioned along with it
+        """
+
+    @abstractmethod
+    def import_asset(self, cmds: Any, file_path: Path) -> List[str]:
+        """
+        Load an asset file of this type into the scene (import is a Python keyword)
+
+        Returns:
+            Top-level nodes created
+        """
+
+    def render_thumbnail(self, file_path: Path, thumbnail_path: Path) -> bool:
+        """
+        Draw an asset's thumbnail; return False to use a viewport snapshot instead
+
+        Args:
+            file_path: Published asset file
+            thumbnail_path: PNG to write
+        """
+        return False
+
+    def validate(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        """Check the nodes about to be published as this type (runs with the publish checks)"""
+        return []
+
+    def issue(self, message: str, nodes: Iterable[str] = ()) -> ValidationIssue:
+        """Build a blocking validation issue carrying this type's id and name"""
+        return ValidationIssue(
+            check_id=self.type_id,
+            check_name=self.name or self.type_id,
+            severity=SEVERITY_ERROR,
+            message=message,
+            nodes=tuple(nodes),
+        )
@@ -0,0 +1,73 @@
+# -*- coding: utf-8 -*-
+"""
+Lock Service Interface
+Defines asset check-out / check-in operations for shared libraries
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from abc import ABC, abstractmethod
+from pathlib import Path
+from typing import Optional
+
+from ..models.asset_lock import AssetLock
+
+
+class ILockService(ABC):
+    """
+    Lock Service Interface - Single Responsibility for asset locking
+    Locks live next to the assets so every artist on the shared path sees them
+    """
+
+    @abstractmethod
+    def check_out(self, asset_path: Path, user: Optional[str] = None) -> Optional[AssetLock]:
+        """
+        Lock an asset for the given artist
+
+        Args:
+            asset_path: Library asset file
+            user: Artist checking out (defaults to the current user)
+
+        Returns:
+            Lock held by the artist, None if someone else holds it
+        """
+
+    @abstractmethod
+    def check_in(self, asset_path: Path, user: Optional[str] = None, force: bool = False) -> bool:
+        """
+        Release an asset lock
+
+        Args:
+            asset_path: Library asset file
+            user: Artist checking in (defaults to the current user)
+            force: Break a lock held by another artist
+
+        Returns:
+            True if the asset is no longer locked
+        """
+
+    @abstractmethod
+    def get_lock(self, asset_path: Path) -> Optional[AssetLock]:
+        """
+        Get the current lock of an asset
+
+        Args:
+            asset_path: Library asset file
+
+        Returns:
+            Current lock, None if the asset is not checked out
+        """
+
+    @abstractmethod
+    def can_publish(self, asset_path: Path, user: Optional[str] = None) -> bool:
+        """
+        Check if an artist may write over an asset
+
+        Args:
+            asset_path: Library asset file
+            user: Publishing artist (defaults to the current user)
+
+        Returns:
+            False if another artist has the asset checked out
+        """
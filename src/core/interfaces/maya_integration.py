@@ -43,18 +43,44 @@ class IMayaIntegration(ABC):
         """
 
     @abstractmethod
-    def reference_asset(self, asset: Asset, namespace: Optional[str] = None) -> bool:
+    def reference_asset(
+        self, asset: Asset, namespace: Optional[str] = None, group_name: Optional[str] = None
+    ) -> bool:
         """
         Reference asset in Maya scene
 
         Args:
             asset: Asset to reference
-            namespace: Optional namespace for reference
+            namespace: Namespace for the reference; None derives one from the asset name,
+                "" references into the root namespace
+            group_name: Optional transform to group the referenced nodes under
 
         Returns:
             True if reference was successful
         """
 
+    @abstractmethod
+    def get_scene_references(self) -> List[Dict[str, Any]]:
+        """
+        Get top-level file references in the current scene
+
+        Returns:
+            List of dicts with reference_node, file_path, namespace, and loaded
+        """
+
+    @abstractmethod
+    def replace_reference(self, reference_node: str, file_path: Path) -> bool:
+        """
+        Point an existing reference at another file, keeping its reference edits
+
+        Args:
+            reference_node: Reference node to repath (e.g. "heroRN")
+            file_path: New asset or version file
+
+        Returns:
+            True if the reference was replaced
+        """
+
     @abstractmethod
     def export_selection(
         self, export_path: Path, options: Optional[Dict[str, Any]] = None
@@ -0,0 +1,40 @@
+# -*- coding: utf-8 -*-
+"""
+Render Farm Interface
+Defines job submission to a render farm queue manager
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from abc import ABC, abstractmethod
+from typing import List
+
+
+class IRenderFarm(ABC):
+    """
+    Render Farm Interface - Single Responsibility for farm job submission
+    A job is one command line run on a farm node; results come back through the library
+    """
+
+    @abstractmethod
+    def is_available(self) -> bool:
+        """Check if the farm's submission client is installed"""
+
+    @abstractmethod
+    def submit(self, title: str, command: List[str], pool: str = "", priority: int = 50) -> str:
+        """
+        Submit a command line as a farm job
+
+        Args:
+            title: Job name shown in the farm monitor
+            command: Program and arguments to run on the farm node
+            pool: Farm pool (Deadline) or service key (Tractor), "" for the default
+            priority: Job priority, 0-100
+
+        Returns:
+            The farm's job id
+
+        Raises:
+            RenderFarmError: If the farm refused the job
+        """
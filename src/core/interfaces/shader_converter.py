@@ -0,0 +1,98 @@
+# -*- coding: utf-8 -*-
+"""
+Shader Converter Interface
+Contract for translating one renderer's materials to another's, including studio plugins
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A studio converter is a ``.py`` file in a shader converters folder containing one or
+more subclasses. Most only need the attribute table::
+
+    from src.core.interfaces.shader_converter import IShaderConverter
+
+    class LambertToVRay(IShaderConverter):
+        converter_id = "lambert_to_vray"
+        name = "Lambert to VRayMtl"
+        source_types = ("lambert",)
+        target_renderer = "vray"
+        target_type = "VRayMtl"
+        attribute_map = {"color": "color", "incandescence": "illumColor"}
+"""
+
+from abc import ABC
+from typing import Any, Dict, List, Tuple
+
+from ..models.shader_conversion import UnconvertedNode
+
+
+class IShaderConverter(ABC):
+    """
+    Shader Converter Interface - Single Responsibility for one material translation
+    Mapped attributes keep their input connections, so texture networks carry over
+    """
+
+    converter_id: str = ""
+    name: str = ""
+    source_types: Tuple[str, ...] = ()  # Node types this converter reads
+    target_renderer: str = ""
+    target_type: str = ""  # Node type created for the target renderer
+    attribute_map: Dict[str, str] = {}  # Source attribute -> target attribute
+    target_values: Dict[str, Any] = {}  # Set on the new shader before mapping
+
+    def convert(self, cmds: Any, shader: str) -> Tuple[str, List[UnconvertedNode]]:
+        """
+        Create the target shader with the source's values and input connections
+
+        Args:
+            cmds: maya.cmds module (passed in so converters stay testable)
+            shader: Source shader node; the service swaps and deletes it afterwards
+
+        Returns:
+            (new shader node, inputs that could not be carried over)
+        """
+        node_type = cmds.nodeType(shader)
+        short_name = shader.split("|")[-1].split(":")[-1]
+        target = cmds.shadingNode(
+            self.target_type, asShader=True, name=f"{short_name}_{self.target_renderer}"
+        )
+        for attribute, value in self.target_values.items():
+            self.set_value(cmds, f"{target}.{attribute}", value)
+
+        for source_attribute, target_attribute in self.attribute_map.items():
+            if not cmds.attributeQuery(source_attribute, node=shader, exists=True):
+                continue
+            plug = f"{shader}.{source_attribute}"
+            inputs = cmds.listConnections(plug, source=True, destination=False, plugs=True)
+            if inputs:
+                cmds.connectAttr(inputs[0], f"{target}.{target_attribute}", force=True)
+            else:
+                value = self.convert_value(cmds, shader, source_attribute, cmds.getAttr(plug))
+                self.set_value(cmds, f"{target}.{target_attribute}", value)
+
+        # Inputs on attributes the table does not cover (or on their children) are lost
+        unconverted = []
+        connections = cmds.listConnections(
+            shader, source=True, destination=False, connections=True, plugs=True
+        )
+        for destination in (connections or [])[::2]:
+            attribute = destination.split(".", 1)[1]
+            if attribute not in self.attribute_map:
+                unconverted.append(
+                    UnconvertedNode(shader, node_type, f"input on {attribute} not converted")
+                )
+        return target, unconverted
+
+    def convert_value(self, cmds: Any, shader: str, attribute: str, value: Any) -> Any:
+        """Translate an unconnected value (override for inverted or rescaled attributes)"""
+        return value
+
+    @staticmethod
+    def set_value(cmds: Any, plug: str, value: Any) -> None:
+        """Set a scalar or color value as returned by getAttr"""
+        if isinstance(value, (list, tuple)):
+            # Compound attributes come back from getAttr as [(r, g, b)]
+            values = value[0] if value and isinstance(value[0], (list, tuple)) else value
+            cmds.setAttr(plug, *values, type="double3")
+        else:
+            cmds.setAttr(plug, value)
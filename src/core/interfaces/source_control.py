@@ -0,0 +1,80 @@
+# -*- coding: utf-8 -*-
+"""
+Source Control Interface
+Defines depot operations for libraries stored in a version control system
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from abc import ABC, abstractmethod
+from pathlib import Path
+from typing import Dict, List, Optional
+
+from ..models.depot_revision import DepotRevision
+
+
+class ISourceControl(ABC):
+    """
+    Source Control Interface - Single Responsibility for depot file operations
+    Publishing opens files in a changelist before writing and submits them afterwards
+    """
+
+    @abstractmethod
+    def is_available(self) -> bool:
+        """Check if the client is installed and connected to a workspace"""
+
+    @abstractmethod
+    def get_revisions(self, paths: List[Path]) -> Dict[str, DepotRevision]:
+        """
+        Get the depot state of library files
+
+        Args:
+            paths: Local files
+
+        Returns:
+            Revisions keyed by str(local path); files not in the depot are left out
+        """
+
+    @abstractmethod
+    def open_for_publish(self, paths: List[Path], description: str) -> Optional[int]:
+        """
+        Create a changelist and open existing depot files in it so they can be written
+
+        Args:
+            paths: Files the publish will write (directories as "dir/...")
+            description: Changelist description
+
+        Returns:
+            Pending changelist number, None on failure
+        """
+
+    @abstractmethod
+    def submit_publish(self, change: int, paths: List[Path]) -> Optional[int]:
+        """
+        Add new files to a publish changelist and submit it
+
+        Args:
+            change: Changelist from open_for_publish
+            paths: Files the publish wrote (directories as "dir/...")
+
+        Returns:
+            Submitted changelist number, None if nothing was submitted
+        """
+
+    @abstractmethod
+    def revert_publish(self, change: int) -> None:
+        """Revert and delete a publish changelist after a failed publish"""
+
+    @abstractmethod
+    def sync(self, paths: List[Path], change: Optional[int] = None) -> bool:
+        """
+        Sync library files to head or to a pinned changelist
+
+        Args:
+            paths: Local files (directories as "dir/...")
+            change: Changelist to sync to, None for head
+
+        Returns:
+            True if the files are at the requested revision
+        """
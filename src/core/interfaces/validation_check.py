@@ -0,0 +1,66 @@
+# -*- coding: utf-8 -*-
+"""
+Validation Check Interface
+Contract for pre-publish scene checks, including studio-provided plugins
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A studio check is a ``.py`` file in a validation checks folder containing one or
+more subclasses::
+
+    from src.core.interfaces.validation_check import IValidationCheck
+
+    class NoLightsCheck(IValidationCheck):
+        check_id = "no_lights"
+        name = "No Lights"
+        description = "Assets must not contain lights"
+
+        def run(self, cmds, nodes):
+            lights = cmds.ls(nodes, dag=True, long=True, lights=True) or []
+            return [self.issue("Asset contains lights", lights)] if lights else []
+"""
+
+from abc import ABC, abstractmethod
+from typing import Any, Iterable, List
+
+from ..models.validation_result import SEVERITY_ERROR, ValidationIssue
+
+
+class IValidationCheck(ABC):
+    """
+    Validation Check Interface - Single Responsibility for one scene rule
+    Severity and enabled state are defaults; the validation service may override them
+    """
+
+    check_id: str = ""
+    name: str = ""
+    description: str = ""
+    default_severity: str = SEVERITY_ERROR
+    enabled_by_default: bool = True
+
+    def __init__(self):
+        self.severity = self.default_severity
+
+    @abstractmethod
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        """
+        Check the nodes about to be published
+
+        Args:
+            cmds: maya.cmds module (passed in so checks stay testable)
+            nodes: Full DAG paths of the transforms being published
+
+        Returns:
+            Issues found, empty list if the check passed
+        """
+
+    def issue(self, message: str, nodes: Iterable[str] = ()) -> ValidationIssue:
+        """Build an issue carrying this check's id, name, and current severity"""
+        return ValidationIssue(
+            check_id=self.check_id,
+            check_name=self.name or self.check_id,
+            severity=self.severity,
+            message=message,
+            nodes=tuple(nodes),
+        )
@@ -0,0 +1,100 @@
+# -*- coding: utf-8 -*-
+"""
+Version Service Interface
+Defines asset versioning operations following Interface Segregation Principle
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from abc import ABC, abstractmethod
+from pathlib import Path
+from typing import List, Optional
+
+from ..models.asset_version import AssetVersion
+
+
+class IVersionService(ABC):
+    """
+    Version Service Interface - Single Responsibility for version history
+    Every publish becomes an immutable version; the asset file in the library
+    always holds the content of the latest version
+    """
+
+    @abstractmethod
+    def publish_version(
+        self,
+        asset_path: Path,
+        notes: str = "",
+        author: Optional[str] = None,
+        companion_files: Optional[List[Path]] = None,
+    ) -> Optional[AssetVersion]:
+        """
+        Snapshot the current asset file as a new immutable version
+
+        Args:
+            asset_path: Library asset file that was just written
+            notes: Free-form publish notes
+            author: Publishing artist (defaults to the current user)
+            companion_files: Files next to the asset that belong to it (e.g. USD
+                payloads); snapshotted with their path relative to the asset
+
+        Returns:
+            Created version, None if the snapshot failed
+        """
+
+    @abstractmethod
+    def get_versions(self, asset_path: Path) -> List[AssetVersion]:
+        """
+        Get version history for an asset, oldest first
+
+        Args:
+            asset_path: Library asset file
+
+        Returns:
+            List of recorded versions
+        """
+
+    @abstractmethod
+    def get_version(self, asset_path: Path, number: int) -> Optional[AssetVersion]:
+        """
+        Get a specific version of an asset
+
+        Args:
+            asset_path: Library asset file
+            number: Version number (1 for v001)
+
+        Returns:
+            Version if recorded, None otherwise
+        """
+
+    @abstractmethod
+    def get_latest_version(self, asset_path: Path) -> Optional[AssetVersion]:
+        """
+        Get the most recent version of an asset
+
+        Args:
+            asset_path: Library asset file
+
+        Returns:
+            Latest version, None if the asset has no history
+        """
+
+    @abstractmethod
+    def rollback_to_version(
+        self, asset_path: Path, number: int, author: Optional[str] = None
+    ) -> Optional[AssetVersion]:
+        """
+        Restore an older version as the current asset
+
+        The old content is republished as a new version so history is never
+        rewritten.
+
+        Args:
+            asset_path: Library asset file
+            number: Version number to restore
+            author: Artist performing the rollback
+
+        Returns:
+            Newly created version, None if rollback failed
+        """
@@ -6,8 +6,159 @@ Domain models following Single Responsibility Principle
 Author: Mike Stumbo
 """
 
+from .activity_event import ActivityEvent
+from .alembic_cache import AlembicCacheInfo
+from .assembly_layout import AssemblyChild, AssemblyLayout
 from .asset import Asset
+from .asset_bundle import AssetBundle, BundleAsset
+from .asset_comment import AssetComment, CommentNotification, CommentThread
+from .asset_diff import AssetDiff, SceneSnapshot
+from .asset_part import AssetPart, PartImportResult
+from .asset_lock import AssetLock
+from .asset_provenance import AssetProvenance
+from .asset_rating import AssetRating
+from .asset_status import AssetStatus
+from .asset_template import AssetTemplate
+from .asset_version import AssetVersion
+from .batch_operation import BatchOperationReport
+from .bulk_edit import BulkChange, BulkEdit
+from .color_transform import ColorTransform
+from .compare_preview import ComparePreview
+from .contact_sheet import ContactSheetEntry, ContactSheetOptions
+from .dependency_graph import DependencyEdge, DependencyGraph
+from .depot_revision import DepotRevision
+from .duplicate_group import DuplicateGroup
+from .farm_job import FarmJob
+from .fbx_preset import FbxExportPreset
+from .geometry_stats import GeometryStats
+from .gltf_export import GltfExportSettings, PbrMaterial
+from .import_conflict import ImportConflictReport, NameConflict
+from .import_namespace_options import ImportNamespaceOptions
+from .import_placement_options import ImportPlacementOptions
+from .integrity_report import IntegrityIssue, IntegrityReport
+from .library_entry import LibraryEntry
+from .library_migration import LibraryMigrationReport
+from .library_permissions import LibraryPermissions
+from .lod_variant import LodVariant
+from .maintenance_schedule import (
+    CronSchedule,
+    MaintenanceJob,
+    MaintenanceResult,
+    MaintenanceSchedule,
+)
+from .merge_import import MergeImportOptions, MergeImportReport
+from .mesh_topology import MeshTopology
 from .metadata import FileMetadata
+from .metadata_field import MetadataField
+from .naming_template import NamingTemplate
+from .path_mapping import PathMapping
+from .playblast_settings import PlayblastSettings
+from .proxy_representation import ProxyRepresentation
+from .reference_edit import ReferenceEdit
+from .retention_policy import PrunedVersion, RetentionPolicy, RetentionReport
+from .scene_usage import SceneUsage
 from .search_criteria import SearchCriteria, SortBy, SortOrder
+from .shader_conversion import ShaderConversionReport, UnconvertedNode
+from .shot_camera import ImagePlaneInfo, ShotCamera
+from .show_context import ShowContext
+from .storage_usage import AssetUsage, QuotaWarning, StorageQuota, StorageReport, UsageSnapshot
+from .tag_hierarchy import TagNode
+from .tag_rule import TagRule
+from .thumbnail_settings import ThumbnailSettings
+from .trash_entry import TrashEntry
+from .usage_stats import CategoryUse, PublisherActivity, UsageStats
+from .user_identity import UserIdentity
+from .vendor_license import VendorLibrary, VendorLicense
+from .watch_folder import IngestResult, WatchFolder
 
-__all__ = ["Asset", "FileMetadata", "SearchCriteria", "SortBy", "SortOrder"]
+__all__ = [
+    "ActivityEvent",
+    "AlembicCacheInfo",
+    "AssemblyChild",
+    "AssemblyLayout",
+    "Asset",
+    "AssetBundle",
+    "AssetComment",
+    "AssetDiff",
+    "AssetPart",
+    "AssetLock",
+    "AssetProvenance",
+    "AssetRating",
+    "AssetStatus",
+    "AssetTemplate",
+    "AssetUsage",
+    "AssetVersion",
+    "BatchOperationReport",
+    "BulkChange",
+    "BulkEdit",
+    "BundleAsset",
+    "CategoryUse",
+    "ColorTransform",
+    "CommentNotification",
+    "CommentThread",
+    "ComparePreview",
+    "ContactSheetEntry",
+    "ContactSheetOptions",
+    "CronSchedule",
+    "DependencyEdge",
+    "DependencyGraph",
+    "DepotRevision",
+    "DuplicateGroup",
+    "FarmJob",
+    "FbxExportPreset",
+    "FileMetadata",
+    "GeometryStats",
+    "GltfExportSettings",
+    "ImagePlaneInfo",
+    "ImportConflictReport",
+    "ImportNamespaceOptions",
+    "ImportPlacementOptions",
+    "IngestResult",
+    "IntegrityIssue",
+    "IntegrityReport",
+    "LibraryEntry",
+    "LibraryMigrationReport",
+    "LibraryPermissions",
+    "LodVariant",
+    "MaintenanceJob",
+    "MaintenanceResult",
+    "MaintenanceSchedule",
+    "MergeImportOptions",
+    "MergeImportReport",
+    "MeshTopology",
+    "MetadataField",
+    "NameConflict",
+    "NamingTemplate",
+    "PartImportResult",
+    "PathMapping",
+    "PbrMaterial",
+    "PlayblastSettings",
+    "ProxyRepresentation",
+    "PrunedVersion",
+    "PublisherActivity",
+    "QuotaWarning",
+    "ReferenceEdit",
+    "RetentionPolicy",
+    "RetentionReport",
+    "SceneSnapshot",
+    "SceneUsage",
+    "SearchCriteria",
+    "ShaderConversionReport",
+    "ShotCamera",
+    "ShowContext",
+    "SortBy",
+    "SortOrder",
+    "StorageQuota",
+    "StorageReport",
+    "TagNode",
+    "TagRule",
+    "ThumbnailSettings",
+    "TrashEntry",
+    "UnconvertedNode",
+    "UsageSnapshot",
+    "UsageStats",
+    "UserIdentity",
+    "VendorLibrary",
+    "VendorLicense",
+    "WatchFolder",
+]
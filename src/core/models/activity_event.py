@@ -0,0 +1,81 @@
+# -*- coding: utf-8 -*-
+"""
+Activity Event Domain Model
+One entry of the library activity log: who did what to which asset, and where
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import PurePosixPath
+from typing import Any, Dict
+
+ACTIVITY_PUBLISH = "publish"
+ACTIVITY_IMPORT = "import"
+ACTIVITY_DELETE = "delete"
+ACTIVITY_RENAME = "rename"
+ACTIVITY_STATUS = "status"
+
+ACTIVITY_ACTIONS = (
+    ACTIVITY_PUBLISH,
+    ACTIVITY_IMPORT,
+    ACTIVITY_DELETE,
+    ACTIVITY_RENAME,
+    ACTIVITY_STATUS,
+)
+
+ACTIVITY_LABELS = {
+    ACTIVITY_PUBLISH: "Published",
+    ACTIVITY_IMPORT: "Imported",
+    ACTIVITY_DELETE: "Deleted",
+    ACTIVITY_RENAME: "Renamed",
+    ACTIVITY_STATUS: "Status changed",
+}
+
+
+@dataclass(frozen=True)
+class ActivityEvent:
+    """
+    Activity Event Value Object - Single Responsibility for one logged action
+    Events are only ever appended; the log outlives the assets it mentions
+    """
+
+    action: str
+    asset_key: str
+    user: str
+    machine: str
+    timestamp: datetime
+    # Action specifics: version, mode (import/reference/trash...), status, note
+    details: Dict[str, Any] = field(default_factory=dict, compare=False)
+
+    @property
+    def label(self) -> str:
+        """Get display action (Published, Imported...)"""
+        return ACTIVITY_LABELS.get(self.action, self.action.capitalize())
+
+    @property
+    def asset_name(self) -> str:
+        """Get the asset name from its library key"""
+        return PurePosixPath(self.asset_key).stem
+
+    @property
+    def summary(self) -> str:
+        """Get what happened (Published v003, Imported as reference, Status: Approved)"""
+        details = self.details
+        if self.action == ACTIVITY_PUBLISH and details.get("version"):
+            return f"{self.label} v{int(details['version']):03d}"
+        if self.action == ACTIVITY_STATUS and details.get("status"):
+            note = f" - {details['note']}" if details.get("note") else ""
+            return f"Status: {details['status']}{note}"
+        if self.action == ACTIVITY_RENAME and details.get("from"):
+            return f"{self.label} from {PurePosixPath(details['from']).name}"
+        if details.get("mode"):
+            return f"{self.label} ({details['mode']})"
+        return self.label
+
+    @property
+    def description(self) -> str:
+        """Get one log line (2024-05-02 14:03 jdoe@ws-12: Published v003)"""
+        return f"{self.timestamp:%Y-%m-%d %H:%M} {self.user}@{self.machine}: {self.summary}"
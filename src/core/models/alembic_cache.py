@@ -0,0 +1,69 @@
+# -*- coding: utf-8 -*-
+"""
+Alembic Cache Domain Model
+Export settings an Alembic (.abc) asset was published with
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, Tuple
+
+
+@dataclass(frozen=True)
+class AlembicCacheInfo:
+    """
+    Alembic Cache Value Object - Single Responsibility for cache export settings
+    Written next to the cache on publish so the library can show what it holds
+    """
+
+    start_frame: float
+    end_frame: float
+    step: float = 1.0  # Frames between samples (0.5 = two samples per frame)
+    world_space: bool = True
+    roots: Tuple[str, ...] = ()  # Exported DAG roots
+
+    @property
+    def frame_count(self) -> int:
+        """Get number of sampled frames"""
+        if self.step <= 0 or self.end_frame < self.start_frame:
+            return 0
+        return int(round((self.end_frame - self.start_frame) / self.step)) + 1
+
+    @property
+    def label(self) -> str:
+        """Get frame range text (1001-1100)"""
+        return f"{_format_frame(self.start_frame)}-{_format_frame(self.end_frame)}"
+
+    @property
+    def description(self) -> str:
+        """Get human readable settings (Frames 1001-1100, step 1, world space)"""
+        space = "world space" if self.world_space else "local space"
+        return f"Frames {self.label}, step {_format_frame(self.step)}, {space}"
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert settings to dictionary for the metadata sidecar"""
+        return {
+            "start_frame": self.start_frame,
+            "end_frame": self.end_frame,
+            "step": self.step,
+            "world_space": self.world_space,
+            "roots": list(self.roots),
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AlembicCacheInfo":
+        """Create settings from the metadata sidecar"""
+        return cls(
+            start_frame=float(data["start_frame"]),
+            end_frame=float(data["end_frame"]),
+            step=float(data.get("step", 1.0)),
+            world_space=bool(data.get("world_space", True)),
+            roots=tuple(data.get("roots", [])),
+        )
+
+
+def _format_frame(value: float) -> str:
+    """Format a frame number without a trailing .0 (1001.0 -> 1001, 0.5 -> 0.5)"""
+    return f"{value:g}"
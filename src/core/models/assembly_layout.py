@@ -0,0 +1,124 @@
+# -*- coding: utf-8 -*-
+"""
+Assembly Layout Domain Model
+A scene assembly: other library assets placed with their transforms and version pins
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path, PurePosixPath
+from typing import Any, Dict, Optional, Tuple
+
+from .asset_version import format_version_label
+
+ASSEMBLY_MODE_REFERENCE = "reference"
+ASSEMBLY_MODE_IMPORT = "import"
+
+# World matrix (16 values, row-major as returned by xform)
+Matrix = Tuple[float, ...]
+
+
+@dataclass(frozen=True)
+class AssemblyChild:
+    """
+    Assembly Child Value Object - Single Responsibility for one placed asset of an assembly
+    Paths are relative to the library root, so an assembly moves with its library
+    """
+
+    asset_path: str  # Library-relative asset file (posix)
+    version: int = 0  # Pinned version, 0 loads the current file
+    mode: str = ASSEMBLY_MODE_REFERENCE
+    # Root name without namespace -> world matrix, one per top-level transform
+    placements: Dict[str, Matrix] = field(default_factory=dict, compare=False)
+
+    @property
+    def name(self) -> str:
+        """Get the asset name from its library path"""
+        return PurePosixPath(self.asset_path).stem
+
+    @property
+    def is_pinned(self) -> bool:
+        """Check if the child loads a version snapshot rather than the current file"""
+        return self.version > 0
+
+    @property
+    def label(self) -> str:
+        """Get display text (chair v003, or chair (current))"""
+        if self.is_pinned:
+            return f"{self.name} {format_version_label(self.version)}"
+        return f"{self.name} (current)"
+
+    def resolve(self, library_root: Path) -> Path:
+        """Get the child's asset file in a library"""
+        return Path(library_root) / self.asset_path
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert child to dictionary for descriptor serialization"""
+        return {
+            "asset": self.asset_path,
+            "version": self.version,
+            "mode": self.mode,
+            "placements": {name: list(matrix) for name, matrix in self.placements.items()},
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssemblyChild":
+        """Create child from descriptor dictionary"""
+        return cls(
+            asset_path=str(data["asset"]),
+            version=int(data.get("version") or 0),
+            mode=data.get("mode", ASSEMBLY_MODE_REFERENCE),
+            placements={
+                name: tuple(float(value) for value in matrix)
+                for name, matrix in (data.get("placements") or {}).items()
+            },
+        )
+
+
+@dataclass(frozen=True)
+class AssemblyLayout:
+    """
+    Assembly Layout Value Object - Single Responsibility for an assembly's contents
+    The same asset may appear many times, once per placement
+    """
+
+    name: str
+    children: Tuple[AssemblyChild, ...] = ()
+    notes: str = ""
+    author: str = "unknown"
+    created_date: Optional[datetime] = None
+
+    @property
+    def asset_paths(self) -> Tuple[str, ...]:
+        """Get the distinct assets the layout places, sorted"""
+        return tuple(sorted({child.asset_path for child in self.children}))
+
+    @property
+    def summary(self) -> str:
+        """Get display text (40 placements of 12 assets)"""
+        return f"{len(self.children)} placement(s) of {len(self.asset_paths)} asset(s)"
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert layout to dictionary for descriptor serialization"""
+        return {
+            "name": self.name,
+            "notes": self.notes,
+            "children": [child.to_dict() for child in self.children],
+            "author": self.author,
+            "created_date": self.created_date.isoformat() if self.created_date else None,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssemblyLayout":
+        """Create layout from descriptor dictionary"""
+        created = data.get("created_date")
+        return cls(
+            name=data["name"],
+            children=tuple(AssemblyChild.from_dict(child) for child in data.get("children", [])),
+            notes=data.get("notes", ""),
+            author=data.get("author", "unknown"),
+            created_date=datetime.fromisoformat(created) if created else None,
+        )
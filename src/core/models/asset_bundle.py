@@ -0,0 +1,123 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Bundle Domain Models
+Manifest of a zip delivering selected assets to a vendor and back into a library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from pathlib import PurePosixPath
+from typing import Any, Dict, Tuple
+
+from .vendor_license import VendorLicense
+
+
+@dataclass(frozen=True)
+class BundleAsset:
+    """
+    Bundle Asset Value Object - Single Responsibility for one asset packed in a bundle
+    """
+
+    path: str  # Library-relative asset file (assets/scenes/crate.ma)
+    dependency: bool = False  # Packed because a selected asset uses it
+    versions: int = 0  # Version snapshots packed with it
+    metadata: Dict[str, Any] = field(default_factory=dict, compare=False)
+
+    @property
+    def name(self) -> str:
+        """Get the asset name (crate)"""
+        return PurePosixPath(self.path).stem
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the bundle manifest"""
+        return {
+            "path": self.path,
+            "dependency": self.dependency,
+            "versions": self.versions,
+            "metadata": dict(self.metadata),
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "BundleAsset":
+        """Create from a bundle manifest entry"""
+        return cls(
+            path=str(data["path"]),
+            dependency=bool(data.get("dependency", False)),
+            versions=int(data.get("versions", 0)),
+            metadata=dict(data.get("metadata") or {}),
+        )
+
+
+@dataclass(frozen=True)
+class AssetBundle:
+    """
+    Asset Bundle Value Object - Single Responsibility for describing a delivery
+    Paths are library-relative, so a bundle unpacks into any library
+    """
+
+    library: str  # Name of the library the bundle was exported from
+    source_root: str = ""  # That library's root, rewritten to the importing library
+    exported: str = ""  # ISO timestamp
+    author: str = ""
+    latest_only: bool = False  # Version history left out
+    assets: Tuple[BundleAsset, ...] = ()
+    # Files from outside the library: original absolute path -> bundle-relative path
+    external_files: Dict[str, str] = field(default_factory=dict, compare=False)
+    files: int = 0  # Files packed, the manifest left out
+    licenses: Tuple[VendorLicense, ...] = ()  # Terms of the vendor library files packed
+
+    @property
+    def restricted_licenses(self) -> Tuple[VendorLicense, ...]:
+        """Get the terms of packed vendor files that must not leave the studio"""
+        return tuple(terms for terms in self.licenses if terms.is_restrictive)
+
+    @property
+    def dependency_count(self) -> int:
+        """Get how many assets were packed only because others use them"""
+        return sum(1 for asset in self.assets if asset.dependency)
+
+    def summary(self) -> str:
+        """Get one-line summary (3 asset(s), 1 as a dependency, 42 file(s), latest only)"""
+        parts = [f"{len(self.assets)} asset(s)"]
+        if self.dependency_count:
+            parts.append(f"{self.dependency_count} as a dependency")
+        parts.append(f"{self.files} file(s)")
+        if self.external_files:
+            parts.append(f"{len(self.external_files)} from outside the library")
+        if self.restricted_licenses:
+            parts.append(f"{len(self.restricted_licenses)} restricted vendor license(s)")
+        parts.append("latest only" if self.latest_only else "all versions")
+        return ", ".join(parts)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the bundle manifest"""
+        return {
+            "library": self.library,
+            "source_root": self.source_root,
+            "exported": self.exported,
+            "author": self.author,
+            "latest_only": self.latest_only,
+            "assets": [asset.to_dict() for asset in self.assets],
+            "external_files": dict(self.external_files),
+            "files": self.files,
+            "licenses": [terms.to_dict() for terms in self.licenses],
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssetBundle":
+        """Create from a bundle manifest"""
+        return cls(
+            library=str(data.get("library", "")),
+            source_root=str(data.get("source_root", "")),
+            exported=str(data.get("exported", "")),
+            author=str(data.get("author", "")),
+            latest_only=bool(data.get("latest_only", False)),
+            assets=tuple(BundleAsset.from_dict(entry) for entry in data.get("assets") or []),
+            external_files={
+                str(key): str(value) for key, value in (data.get("external_files") or {}).items()
+            },
+            files=int(data.get("files", 0)),
+            licenses=tuple(VendorLicense.from_dict(entry) for entry in data.get("licenses") or []),
+        )
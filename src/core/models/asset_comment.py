@@ -0,0 +1,121 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Comment Domain Model
+Review notes left on assets, their reply threads, and the @mentions in them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import PurePosixPath
+from typing import List, Optional, Tuple
+
+# @jdoe, @j.doe, @j-doe; addresses (jdoe@studio.com) and trailing dots are not mentions
+_MENTION_PATTERN = re.compile(r"(?<![\w.@])@([\w.-]*\w)")
+
+
+def parse_mentions(body: str) -> List[str]:
+    """Get the artists a note mentions, once each, in the order they appear"""
+    mentions: List[str] = []
+    for name in _MENTION_PATTERN.findall(body):
+        if name.lower() not in (mention.lower() for mention in mentions):
+            mentions.append(name)
+    return mentions
+
+
+@dataclass(frozen=True)
+class AssetComment:
+    """
+    Asset Comment Value Object - Single Responsibility for one review note
+    Replies point at the note that starts their thread and share its version
+    """
+
+    comment_id: int
+    asset_key: str
+    user: str
+    body: str
+    created: datetime
+    version: int = 0  # Version the note is about, 0 for the asset as a whole
+    parent_id: Optional[int] = None
+    resolved: bool = False
+
+    def __post_init__(self):
+        if not self.body.strip():
+            raise ValueError("A note needs some text")
+        if self.version < 0:
+            raise ValueError(f"Invalid version: {self.version}")
+
+    @property
+    def is_reply(self) -> bool:
+        """Check if the note answers another one"""
+        return self.parent_id is not None
+
+    @property
+    def mentions(self) -> List[str]:
+        """Get the artists the note mentions"""
+        return parse_mentions(self.body)
+
+    @property
+    def asset_name(self) -> str:
+        """Get the asset name from its library key"""
+        return PurePosixPath(self.asset_key).stem
+
+    @property
+    def version_label(self) -> str:
+        """Get the version the note is about (v003), "" for the whole asset"""
+        return f"v{self.version:03d}" if self.version else ""
+
+    @property
+    def header(self) -> str:
+        """Get the byline (jdoe, 2024-05-02 14:03, on v003)"""
+        header = f"{self.user}, {self.created.strftime('%Y-%m-%d %H:%M')}"
+        return f"{header}, on {self.version_label}" if self.version else header
+
+
+@dataclass(frozen=True)
+class CommentThread:
+    """
+    Comment Thread Value Object - Single Responsibility for a note and its replies
+    Resolving the thread is recorded on its first note
+    """
+
+    root: AssetComment
+    replies: Tuple[AssetComment, ...] = field(default_factory=tuple)
+
+    @property
+    def resolved(self) -> bool:
+        """Check if the thread was marked resolved"""
+        return self.root.resolved
+
+    @property
+    def comments(self) -> List[AssetComment]:
+        """Get the first note followed by its replies"""
+        return [self.root, *self.replies]
+
+    @property
+    def last_activity(self) -> datetime:
+        """Get when the thread was last written to"""
+        return self.comments[-1].created
+
+
+@dataclass(frozen=True)
+class CommentNotification:
+    """
+    Comment Notification Value Object - Single Responsibility for one @mention
+    """
+
+    notification_id: int
+    comment: AssetComment
+    read: bool = False
+
+    @property
+    def description(self) -> str:
+        """Get one line (jdoe mentioned you on crate v003: Bevel is too soft)"""
+        on = f"{self.comment.asset_name} {self.comment.version_label}".strip()
+        body = " ".join(self.comment.body.split())
+        if len(body) > 60:
+            body = body[:57] + "..."
+        return f"{self.comment.user} mentioned you on {on}: {body}"
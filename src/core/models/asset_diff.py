@@ -0,0 +1,102 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Diff Domain Model
+What a published asset file contains, and what changed between two of its versions
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import Dict, List, Tuple
+
+Vector = Tuple[float, float, float]
+
+# Mesh face or vertex count that could not be read (geometry driven by history)
+UNKNOWN_COUNT = -1
+
+
+@dataclass(frozen=True)
+class SceneSnapshot:
+    """
+    Scene Snapshot Value Object - Single Responsibility for one file's comparable content
+    DAG paths are written without namespaces so versions line up node for node
+    """
+
+    file_path: Path
+    file_size: int = 0
+    node_types: Dict[str, int] = field(default_factory=dict)  # node type -> count
+    meshes: Dict[str, Tuple[int, int]] = field(default_factory=dict)  # transform -> faces, verts
+    transforms: Dict[str, Tuple[Vector, Vector, Vector]] = field(default_factory=dict)  # t, r, s
+    shaders: Dict[str, Tuple[str, ...]] = field(default_factory=dict)  # mesh transform -> shaders
+
+    @property
+    def node_count(self) -> int:
+        """Get the number of nodes in the file"""
+        return sum(self.node_types.values())
+
+    @property
+    def face_count(self) -> int:
+        """Get the faces of every mesh whose geometry could be read"""
+        return sum(faces for faces, _ in self.meshes.values() if faces != UNKNOWN_COUNT)
+
+    @property
+    def vertex_count(self) -> int:
+        """Get the vertices of every mesh whose geometry could be read"""
+        return sum(vertices for _, vertices in self.meshes.values() if vertices != UNKNOWN_COUNT)
+
+
+@dataclass(frozen=True)
+class AssetDiff:
+    """
+    Asset Diff Value Object - Single Responsibility for the changes between two versions
+    Lists are sorted by node path so reports read the same every time
+    """
+
+    before: SceneSnapshot
+    after: SceneSnapshot
+    node_type_changes: List[Tuple[str, int, int]] = field(default_factory=list)
+    added_meshes: List[str] = field(default_factory=list)
+    removed_meshes: List[str] = field(default_factory=list)
+    # (mesh transform, (faces, vertices) before, after)
+    geometry_changes: List[Tuple[str, Tuple[int, int], Tuple[int, int]]] = field(
+        default_factory=list
+    )
+    # (transform, "translate" / "rotate" / "scale", before, after)
+    transform_changes: List[Tuple[str, str, Vector, Vector]] = field(default_factory=list)
+    # (mesh transform, shaders before, shaders after)
+    shader_changes: List[Tuple[str, Tuple[str, ...], Tuple[str, ...]]] = field(
+        default_factory=list
+    )
+
+    @property
+    def has_changes(self) -> bool:
+        """Check if anything besides the file itself differs"""
+        return bool(
+            self.node_type_changes
+            or self.added_meshes
+            or self.removed_meshes
+            or self.geometry_changes
+            or self.transform_changes
+            or self.shader_changes
+        )
+
+    @property
+    def summary(self) -> str:
+        """Get a one-line summary (2 meshes added, 1 removed, 3 transforms moved...)"""
+        parts = []
+        if self.added_meshes:
+            parts.append(f"{len(self.added_meshes)} mesh(es) added")
+        if self.removed_meshes:
+            parts.append(f"{len(self.removed_meshes)} mesh(es) removed")
+        if self.geometry_changes:
+            parts.append(f"{len(self.geometry_changes)} mesh(es) remodeled")
+        moved = {path for path, _, _, _ in self.transform_changes}
+        if moved:
+            parts.append(f"{len(moved)} transform(s) changed")
+        if self.shader_changes:
+            parts.append(f"{len(self.shader_changes)} shader assignment(s) changed")
+        if not parts and self.node_type_changes:
+            parts.append(f"{len(self.node_type_changes)} node type count(s) changed")
+        return ", ".join(parts) if parts else "No content changes"
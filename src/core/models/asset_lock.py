@@ -0,0 +1,58 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Lock Domain Model
+Check-out record that keeps other artists from publishing over an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+
+@dataclass(frozen=True)
+class AssetLock:
+    """
+    Asset Lock Value Object - Single Responsibility for check-out data
+    One lock per asset file; it is removed again on check-in
+    """
+
+    asset_path: Path
+    user: str
+    host: str = ""
+    locked_date: Optional[datetime] = None
+
+    def is_owned_by(self, user: str) -> bool:
+        """Check if the given artist holds this lock"""
+        return self.user.lower() == (user or "").lower()
+
+    @property
+    def description(self) -> str:
+        """Get human readable lock owner text (mike@ws-04 since 2025-01-31 14:02)"""
+        owner = f"{self.user}@{self.host}" if self.host else self.user
+        if self.locked_date:
+            return f"{owner} since {self.locked_date.strftime('%Y-%m-%d %H:%M')}"
+        return owner
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert lock to dictionary for lock file serialization"""
+        return {
+            "asset_path": str(self.asset_path),
+            "user": self.user,
+            "host": self.host,
+            "locked_date": self.locked_date.isoformat() if self.locked_date else None,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssetLock":
+        """Create lock from lock file dictionary"""
+        locked = data.get("locked_date")
+        return cls(
+            asset_path=Path(data["asset_path"]),
+            user=data.get("user", "unknown"),
+            host=data.get("host", ""),
+            locked_date=datetime.fromisoformat(locked) if locked else None,
+        )
@@ -0,0 +1,70 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Part Domain Model
+Groups and meshes inside an asset file, for importing only some of them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from typing import Tuple
+
+
+@dataclass(frozen=True)
+class AssetPart:
+    """
+    Asset Part Value Object - Single Responsibility for one transform of an asset file
+    Paths are DAG paths without namespaces, as the asset file writes them
+    """
+
+    path: str  # |building_grp|floor_01
+    is_mesh: bool = False
+    mesh_count: int = 0  # Meshes at and below the part
+    face_count: int = 0  # Faces of those meshes that could be read
+    child_count: int = 0
+
+    @property
+    def name(self) -> str:
+        """Get the part's short name (floor_01)"""
+        return self.path.rsplit("|", 1)[-1]
+
+    @property
+    def parent_path(self) -> str:
+        """Get the path of the group holding the part, "" for top-level parts"""
+        return self.path.rpartition("|")[0]
+
+    @property
+    def depth(self) -> int:
+        """Get the part's level, 0 for top-level groups and meshes"""
+        return self.path.count("|") - 1
+
+    @property
+    def summary(self) -> str:
+        """Get a short description of the part's content (12 meshes, 48,200 faces)"""
+        parts = []
+        if self.mesh_count and not (self.is_mesh and self.mesh_count == 1):
+            parts.append(f"{self.mesh_count} mesh{'es' if self.mesh_count != 1 else ''}")
+        if self.face_count:
+            parts.append(f"{self.face_count:,} faces")
+        elif self.mesh_count:
+            parts.append("faces unknown")
+        return ", ".join(parts)
+
+
+@dataclass(frozen=True)
+class PartImportResult:
+    """
+    Part Import Result Value Object - Single Responsibility for one selective import
+    """
+
+    namespace: str
+    roots: Tuple[str, ...] = field(default_factory=tuple)  # Top-level nodes left in the scene
+    imported_parts: Tuple[str, ...] = field(default_factory=tuple)  # Part paths asked for
+    removed_count: int = 0  # Unwanted branches deleted after the import
+
+    @property
+    def summary(self) -> str:
+        """Get a report line for the status bar"""
+        return f"{len(self.imported_parts)} part(s), {self.removed_count} other branch(es) skipped"
+
@@ -0,0 +1,91 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Provenance Domain Model
+Where an imported asset came from, as recorded in the scene at import
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Dict, Optional
+
+
+@dataclass(frozen=True)
+class AssetProvenance:
+    """
+    Asset Provenance Value Object - Single Responsibility for import origin data
+    Stored as plain strings so any Maya attribute or JSON file can hold it
+    """
+
+    asset_id: str
+    asset_file: Path
+    library_root: Optional[Path] = None
+    relative_path: str = ""  # Asset file relative to the library, posix separators
+    version: int = 0  # 0 when the asset had no version history
+    level: str = ""  # LOD level imported, empty for the base representation
+    imported_by: str = ""
+    import_date: Optional[datetime] = None
+
+    @property
+    def description(self) -> str:
+        """Get human readable origin text (crate v003, imported by mike 2025-01-31 14:02)"""
+        text = self.asset_file.stem
+        if self.version:
+            text += f" v{self.version:03d}"
+        if self.level:
+            text += f" {self.level}"
+        if self.imported_by:
+            text += f", imported by {self.imported_by}"
+        if self.import_date:
+            text += f" {self.import_date.strftime('%Y-%m-%d %H:%M')}"
+        return text
+
+    def resolve_file(self, library_root: Optional[Path] = None) -> Path:
+        """Get the asset file, found again under another library root if it moved"""
+        if self.asset_file.exists() or library_root is None or not self.relative_path:
+            return self.asset_file
+        moved = Path(library_root) / self.relative_path
+        return moved if moved.exists() else self.asset_file
+
+    def to_dict(self) -> Dict[str, str]:
+        """Convert provenance to string values for scene attributes"""
+        return {
+            "asset_id": self.asset_id,
+            "asset_file": self.asset_file.as_posix(),
+            "library_root": self.library_root.as_posix() if self.library_root else "",
+            "relative_path": self.relative_path,
+            "version": str(self.version) if self.version else "",
+            "level": self.level,
+            "imported_by": self.imported_by,
+            "import_date": self.import_date.isoformat() if self.import_date else "",
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, str]) -> "AssetProvenance":
+        """
+        Create provenance from scene attribute values
+
+        Raises:
+            ValueError: If the asset file is missing
+        """
+        if not data.get("asset_file"):
+            raise ValueError("Provenance has no asset file")
+        version = data.get("version") or ""
+        imported = data.get("import_date") or ""
+        try:
+            import_date = datetime.fromisoformat(imported) if imported else None
+        except ValueError:
+            import_date = None
+        return cls(
+            asset_id=data.get("asset_id") or "",
+            asset_file=Path(data["asset_file"]),
+            library_root=Path(data["library_root"]) if data.get("library_root") else None,
+            relative_path=data.get("relative_path") or "",
+            version=int(version) if version.isdigit() else 0,
+            level=data.get("level") or "",
+            imported_by=data.get("imported_by") or "",
+            import_date=import_date,
+        )
@@ -0,0 +1,43 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Rating Domain Model
+Star rating of an asset averaged over every artist who rated it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+
+MAX_RATING = 5
+
+
+@dataclass(frozen=True)
+class AssetRating:
+    """
+    Asset Rating Value Object - Single Responsibility for one asset's stars
+    Each artist has one rating per asset; the average is over all of them
+    """
+
+    average: float = 0.0
+    count: int = 0  # Artists who rated the asset
+    user_rating: int = 0  # Current artist's stars, 0 when not rated
+
+    @property
+    def is_rated(self) -> bool:
+        """Check if anyone rated the asset"""
+        return self.count > 0
+
+    @property
+    def stars(self) -> str:
+        """Get the average as stars, rounded to the nearest one (★★★★☆)"""
+        filled = int(self.average + 0.5)
+        return "★" * filled + "☆" * (MAX_RATING - filled)
+
+    @property
+    def label(self) -> str:
+        """Get display text (★★★★☆ 4.2 from 5 artists)"""
+        if not self.is_rated:
+            return "Not rated"
+        artists = "artist" if self.count == 1 else "artists"
+        return f"{self.stars} {self.average:.1f} from {self.count} {artists}"
@@ -0,0 +1,108 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Status Domain Model
+Lifecycle state of a library asset and who last changed it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+from dataclasses import dataclass
+from typing import Any, Dict, Optional
+
+STATUS_WIP = "wip"
+STATUS_PENDING_REVIEW = "pending_review"
+STATUS_APPROVED = "approved"
+STATUS_DEPRECATED = "deprecated"
+STATUSES = (STATUS_WIP, STATUS_PENDING_REVIEW, STATUS_APPROVED, STATUS_DEPRECATED)
+
+STATUS_LABELS = {
+    STATUS_WIP: "WIP",
+    STATUS_PENDING_REVIEW: "Pending Review",
+    STATUS_APPROVED: "Approved",
+    STATUS_DEPRECATED: "Deprecated",
+}
+
+# Badge colors in the library grid (WIP, the default, has no badge)
+STATUS_COLORS = {
+    STATUS_WIP: "#7f8c8d",
+    STATUS_PENDING_REVIEW: "#f39c12",
+    STATUS_APPROVED: "#27ae60",
+    STATUS_DEPRECATED: "#c0392b",
+}
+
+# Only reviewers may move an asset into or out of these states
+REVIEW_STATUSES = (STATUS_APPROVED, STATUS_DEPRECATED)
+
+
+def parse_status(text: str) -> Optional[str]:
+    """Get the state a typed name means (pending, review, "Pending Review"), None if none"""
+    word = re.sub(r"[\s-]+", "_", str(text).strip().lower())
+    if not word:
+        return None
+    for state in STATUSES:
+        if state.startswith(word) or word in state.split("_"):
+            return state
+    return None
+
+
+@dataclass(frozen=True)
+class AssetStatus:
+    """
+    Asset Status Value Object - Single Responsibility for one lifecycle state
+    Assets that were never reviewed are WIP
+    """
+
+    state: str = STATUS_WIP
+    changed_by: str = ""
+    changed_date: str = ""  # ISO timestamp
+    note: str = ""
+
+    @property
+    def label(self) -> str:
+        """Get display text (Pending Review)"""
+        return STATUS_LABELS.get(self.state, self.state)
+
+    @property
+    def color(self) -> str:
+        """Get the badge color"""
+        return STATUS_COLORS.get(self.state, STATUS_COLORS[STATUS_WIP])
+
+    @property
+    def is_deprecated(self) -> bool:
+        """Check if artists should stop using the asset"""
+        return self.state == STATUS_DEPRECATED
+
+    @property
+    def description(self) -> str:
+        """Get display text (Approved by kim on 2026-03-01 10:22: final lighting pass)"""
+        text = self.label
+        if self.changed_by:
+            text += f" by {self.changed_by}"
+        if self.changed_date:
+            text += f" on {self.changed_date.replace('T', ' ')}"
+        if self.note:
+            text += f": {self.note}"
+        return text
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout stored with asset metadata"""
+        return {
+            "state": self.state,
+            "changed_by": self.changed_by,
+            "changed_date": self.changed_date,
+            "note": self.note,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Optional[Dict[str, Any]]) -> "AssetStatus":
+        """Create from stored metadata; missing or unknown states are WIP"""
+        data = data or {}
+        state = data.get("state", STATUS_WIP)
+        return cls(
+            state=state if state in STATUSES else STATUS_WIP,
+            changed_by=str(data.get("changed_by", "")),
+            changed_date=str(data.get("changed_date", "")),
+            note=str(data.get("note", "")),
+        )
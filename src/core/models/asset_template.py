@@ -0,0 +1,78 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Template Domain Model
+Studio-approved starting scene for new assets, with its render settings and naming
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, Tuple
+
+# Placeholder in the template scene's node names, replaced by the new asset's name
+NODE_NAME_TOKEN = "ASSET"
+
+# Token of the asset name pattern (PRP_{assetName} -> PRP_crate)
+ASSET_NAME_TOKEN = "{assetName}"
+
+TEMPLATE_FORMATS = (".ma", ".mb")
+
+
+@dataclass(frozen=True)
+class AssetTemplate:
+    """
+    Asset Template Value Object - Single Responsibility for one starting scene
+    The scene itself is stored next to the template's settings in the library
+    """
+
+    name: str
+    category: str = "General"
+    description: str = ""
+    name_pattern: str = ASSET_NAME_TOKEN
+    tags: Tuple[str, ...] = ()
+    export_format: str = ".ma"  # Format the publish dialog starts with
+    render_settings: Tuple[Tuple[str, Any], ...] = ()  # (node.attribute, value) pairs
+    created_by: str = ""
+    created_date: str = ""
+
+    def __post_init__(self):
+        if not self.name.strip():
+            raise ValueError("An asset template needs a name")
+        if ASSET_NAME_TOKEN not in self.name_pattern:
+            raise ValueError(f"The asset name pattern needs the {ASSET_NAME_TOKEN} token")
+        if self.export_format not in TEMPLATE_FORMATS:
+            raise ValueError(f"Templates publish as Maya scenes, not {self.export_format}")
+
+    def get_asset_name(self, name: str) -> str:
+        """Get the name an asset created from the template publishes as"""
+        return self.name_pattern.replace(ASSET_NAME_TOKEN, name.strip())
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the template's settings file"""
+        return {
+            "name": self.name,
+            "category": self.category,
+            "description": self.description,
+            "name_pattern": self.name_pattern,
+            "tags": list(self.tags),
+            "export_format": self.export_format,
+            "render_settings": dict(self.render_settings),
+            "created_by": self.created_by,
+            "created_date": self.created_date,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssetTemplate":
+        """Create from a template's settings file"""
+        return cls(
+            name=str(data["name"]),
+            category=str(data.get("category") or "General"),
+            description=str(data.get("description", "")),
+            name_pattern=str(data.get("name_pattern") or ASSET_NAME_TOKEN),
+            tags=tuple(str(tag) for tag in data.get("tags") or []),
+            export_format=str(data.get("export_format") or ".ma"),
+            render_settings=tuple((data.get("render_settings") or {}).items()),
+            created_by=str(data.get("created_by", "")),
+            created_date=str(data.get("created_date", "")),
+        )
@@ -0,0 +1,75 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Version Domain Model
+Immutable record of a single published version of an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+
+@dataclass(frozen=True)
+class AssetVersion:
+    """
+    Asset Version Value Object - Single Responsibility for version data
+    Each publish creates one of these; they are never modified afterwards
+    """
+
+    asset_name: str
+    number: int
+    file_path: Path
+    author: str = "unknown"
+    created_date: Optional[datetime] = None
+    notes: str = ""
+    extra: Dict[str, Any] = field(default_factory=dict)
+
+    @property
+    def label(self) -> str:
+        """Get zero-padded version label (v001, v002...)"""
+        return format_version_label(self.number)
+
+    @property
+    def exists(self) -> bool:
+        """Check if the version file is still present on disk"""
+        return self.file_path.exists()
+
+    @property
+    def is_pruned(self) -> bool:
+        """Check if a retention policy removed the version's files"""
+        return bool(self.extra.get("pruned"))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert version to dictionary for manifest serialization"""
+        return {
+            "asset_name": self.asset_name,
+            "number": self.number,
+            "file_path": str(self.file_path),
+            "author": self.author,
+            "created_date": self.created_date.isoformat() if self.created_date else None,
+            "notes": self.notes,
+            "extra": dict(self.extra),
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "AssetVersion":
+        """Create version from manifest dictionary"""
+        created = data.get("created_date")
+        return cls(
+            asset_name=data["asset_name"],
+            number=int(data["number"]),
+            file_path=Path(data["file_path"]),
+            author=data.get("author", "unknown"),
+            created_date=datetime.fromisoformat(created) if created else None,
+            notes=data.get("notes", ""),
+            extra=dict(data.get("extra", {})),
+        )
+
+
+def format_version_label(number: int) -> str:
+    """Format a version number as a zero-padded label (3 -> v003)"""
+    return f"v{number:03d}"
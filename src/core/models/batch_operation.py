@@ -0,0 +1,66 @@
+# -*- coding: utf-8 -*-
+"""
+Batch Operation Domain Models
+Actions run on many selected assets at once, and the report of one run
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from typing import List, Tuple
+
+BATCH_ADD_TAGS = "add_tags"
+BATCH_REMOVE_TAGS = "remove_tags"
+BATCH_SET_STATUS = "set_status"
+BATCH_MOVE_TO_COLLECTION = "move_to_collection"
+BATCH_DELETE = "delete"
+
+BATCH_ACTION_LABELS = {
+    BATCH_ADD_TAGS: "Add tags",
+    BATCH_REMOVE_TAGS: "Remove tags",
+    BATCH_SET_STATUS: "Change status",
+    BATCH_MOVE_TO_COLLECTION: "Move to collection",
+    BATCH_DELETE: "Delete",
+}
+
+
+@dataclass
+class BatchOperationReport:
+    """
+    Batch Operation Report - Single Responsibility for one action on a selection
+    Assets are listed by display name; each lands in exactly one of the lists
+    """
+
+    action: str
+    total: int
+    changed: List[str] = field(default_factory=list)
+    unchanged: List[str] = field(default_factory=list)  # Nothing to do (already tagged)
+    failed: List[Tuple[str, str]] = field(default_factory=list)  # (asset, reason)
+    cancelled: bool = False
+
+    @property
+    def label(self) -> str:
+        """Get display text (Add tags)"""
+        return BATCH_ACTION_LABELS.get(self.action, self.action)
+
+    @property
+    def processed(self) -> int:
+        """Get how many assets the action got to before finishing or being cancelled"""
+        return len(self.changed) + len(self.unchanged) + len(self.failed)
+
+    @property
+    def is_clean(self) -> bool:
+        """Check if every asset was processed without failing"""
+        return not self.failed and not self.cancelled
+
+    def summary(self) -> str:
+        """Get one-line summary for the status bar"""
+        parts = [f"{len(self.changed)} of {self.total} asset(s) changed"]
+        if self.unchanged:
+            parts.append(f"{len(self.unchanged)} unchanged")
+        if self.failed:
+            parts.append(f"{len(self.failed)} failed")
+        if self.cancelled:
+            parts.append(f"cancelled after {self.processed}")
+        return f"{self.label}: " + ", ".join(parts)
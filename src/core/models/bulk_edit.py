@@ -0,0 +1,130 @@
+# -*- coding: utf-8 -*-
+"""
+Bulk Edit Domain Model
+Library-wide metadata edits with the old value of every field they changed
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from typing import Any, Dict, Optional, Tuple
+
+OPERATION_RENAME_TAG = "rename_tag"
+OPERATION_SET_AUTHOR = "set_author"
+OPERATION_REPLACE_PATH = "replace_path"
+OPERATIONS = (OPERATION_RENAME_TAG, OPERATION_SET_AUTHOR, OPERATION_REPLACE_PATH)
+
+OPERATION_LABELS = {
+    OPERATION_RENAME_TAG: "Rename Tag",
+    OPERATION_SET_AUTHOR: "Change Author",
+    OPERATION_REPLACE_PATH: "Replace Path Prefix",
+}
+
+
+@dataclass(frozen=True)
+class BulkChange:
+    """
+    Bulk Change Value Object - Single Responsibility for one field of one asset
+    Values are whole metadata values, so undo writes back exactly what was there
+    """
+
+    asset_key: str  # Library-relative asset path
+    field: str  # Metadata key: tags, author, or the key holding the paths
+    old_value: Any = None  # None when the asset had no value
+    new_value: Any = None
+
+    @property
+    def asset_name(self) -> str:
+        """Get the asset's file name without extension"""
+        name = self.asset_key.rsplit("/", 1)[-1]
+        return name.rsplit(".", 1)[0] if "." in name else name
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert change to JSON-compatible dictionary"""
+        return {
+            "asset_key": self.asset_key,
+            "field": self.field,
+            "old_value": self.old_value,
+            "new_value": self.new_value,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "BulkChange":
+        """Create change from dictionary"""
+        return cls(
+            asset_key=str(data.get("asset_key", "")),
+            field=str(data.get("field", "")),
+            old_value=data.get("old_value"),
+            new_value=data.get("new_value"),
+        )
+
+
+@dataclass(frozen=True)
+class BulkEdit:
+    """
+    Bulk Edit Value Object - Single Responsibility for one find-and-replace run
+    A planned edit has no date until it is applied and logged
+    """
+
+    edit_id: str
+    operation: str  # One of OPERATIONS
+    find: str  # Old tag, author, or path prefix ("" for the selected assets)
+    replace: str
+    changes: Tuple[BulkChange, ...] = ()
+    user: str = ""
+    date: Optional[datetime] = None
+    undone_date: Optional[datetime] = None
+
+    @property
+    def is_undone(self) -> bool:
+        """Check if the edit was undone"""
+        return self.undone_date is not None
+
+    @property
+    def asset_keys(self) -> Tuple[str, ...]:
+        """Get the assets the edit changes, in order"""
+        return tuple(dict.fromkeys(change.asset_key for change in self.changes))
+
+    @property
+    def description(self) -> str:
+        """Get display text (Rename Tag: props -> set/props, 12 assets)"""
+        label = OPERATION_LABELS.get(self.operation, self.operation)
+        find = self.find or "selected assets"
+        return f"{label}: {find} -> {self.replace}, {len(self.asset_keys)} asset(s)"
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert edit to JSON-compatible dictionary"""
+        return {
+            "edit_id": self.edit_id,
+            "operation": self.operation,
+            "find": self.find,
+            "replace": self.replace,
+            "changes": [change.to_dict() for change in self.changes],
+            "user": self.user,
+            "date": self.date.isoformat() if self.date else "",
+            "undone_date": self.undone_date.isoformat() if self.undone_date else "",
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "BulkEdit":
+        """Create edit from dictionary"""
+        return cls(
+            edit_id=str(data.get("edit_id", "")),
+            operation=str(data.get("operation", "")),
+            find=str(data.get("find", "")),
+            replace=str(data.get("replace", "")),
+            changes=tuple(BulkChange.from_dict(change) for change in data.get("changes") or []),
+            user=str(data.get("user", "")),
+            date=_parse_date(data.get("date")),
+            undone_date=_parse_date(data.get("undone_date")),
+        )
+
+
+def _parse_date(value: Any) -> Optional[datetime]:
+    """Parse an ISO date, None when empty or malformed"""
+    try:
+        return datetime.fromisoformat(str(value)) if value else None
+    except ValueError:
+        return None
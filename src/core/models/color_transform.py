@@ -0,0 +1,67 @@
+# -*- coding: utf-8 -*-
+"""
+Color Transform Domain Model
+OCIO config and view transform a thumbnail or preview is rendered through
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import asdict, dataclass, fields
+from pathlib import Path
+from typing import Any, Dict
+
+
+@dataclass(frozen=True)
+class ColorTransform:
+    """
+    Color Transform Value Object - Single Responsibility for one color managed output
+    An empty transform means the render was not color managed
+    """
+
+    config_path: str = ""  # OCIO config file, "" for Maya's built-in config
+    config_hash: str = ""  # SHA-256 of the config file when it was read
+    rendering_space: str = ""  # ACEScg, scene-linear Rec.709-sRGB...
+    display: str = ""  # sRGB, Rec.1886...
+    view: str = ""  # ACES 1.0 SDR-video, Un-tone-mapped...
+
+    @property
+    def is_managed(self) -> bool:
+        """Check if output goes through a view transform"""
+        return bool(self.view or self.config_path)
+
+    @property
+    def config_name(self) -> str:
+        """Get the config's folder or file name (aces_1.2), "Maya default" for none"""
+        if not self.config_path:
+            return "Maya default"
+        path = Path(self.config_path)
+        return path.parent.name if path.name == "config.ocio" and path.parent.name else path.stem
+
+    @property
+    def label(self) -> str:
+        """Get display text (ACES 1.0 SDR-video / sRGB - aces_1.2)"""
+        if not self.is_managed:
+            return "Not color managed"
+        view = " / ".join(part for part in (self.view, self.display) if part) or "Default view"
+        return f"{view} - {self.config_name}"
+
+    def matches(self, other: "ColorTransform") -> bool:
+        """Check if two renders went through the same config contents and view"""
+        return (
+            self.config_path == other.config_path
+            and self.config_hash == other.config_hash
+            and self.rendering_space == other.rendering_space
+            and self.display == other.display
+            and self.view == other.view
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert transform to dictionary for library metadata"""
+        return asdict(self)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "ColorTransform":
+        """Create transform from library metadata, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        return cls(**{key: str(value) for key, value in data.items() if key in known})
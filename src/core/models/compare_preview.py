@@ -0,0 +1,77 @@
+# -*- coding: utf-8 -*-
+"""
+Compare Preview Domain Model
+Two assets (or two versions) rendered as orbit frame sequences for an A/B review
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+from typing import List, Tuple
+
+SIDE_LEFT = "left"
+SIDE_RIGHT = "right"
+SIDES = (SIDE_LEFT, SIDE_RIGHT)
+
+# Camera tilts in degrees, lowest view last; negative looks down on the asset
+DEFAULT_TILTS: Tuple[float, ...] = (-45.0, -20.0, 0.0)
+
+
+@dataclass(frozen=True)
+class ComparePreview:
+    """
+    Compare Preview Value Object - Single Responsibility for locating A/B orbit frames
+    Both sides share the frame count and tilts, so one angle shows both from the same view
+    """
+
+    left_file: Path
+    right_file: Path
+    output_dir: Path
+    left_label: str = ""
+    right_label: str = ""
+    frames: int = 36  # Orbit steps around the asset
+    tilts: Tuple[float, ...] = DEFAULT_TILTS
+    size: int = 384  # Square render resolution of each side
+
+    def __post_init__(self):
+        if self.frames < 4:
+            raise ValueError("A compare preview needs at least 4 orbit frames")
+        if not self.tilts:
+            raise ValueError("A compare preview needs at least one camera tilt")
+        if self.size <= 0:
+            raise ValueError("Compare preview size must be positive")
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate v3 | crate v5)"""
+        left = self.left_label or Path(self.left_file).stem
+        right = self.right_label or Path(self.right_file).stem
+        return f"{left} | {right}"
+
+    @property
+    def frame_count(self) -> int:
+        """Get the number of images rendered per side"""
+        return self.frames * len(self.tilts)
+
+    def frame_path(self, side: str, tilt_index: int, frame: int) -> Path:
+        """Get the image of one side at a tilt and orbit step"""
+        if side not in SIDES:
+            raise ValueError(f"Unknown compare side '{side}'")
+        return Path(self.output_dir) / side / f"tilt{tilt_index}.{frame % self.frames:04d}.png"
+
+    def frame_for_angle(self, angle: float) -> int:
+        """Get the orbit step nearest an angle in degrees (any value, wraps around)"""
+        step = 360.0 / self.frames
+        return int(round((angle % 360.0) / step)) % self.frames
+
+    def missing_frames(self) -> List[Path]:
+        """Get the images not rendered yet, for both sides"""
+        return [
+            self.frame_path(side, tilt_index, frame)
+            for side in SIDES
+            for tilt_index in range(len(self.tilts))
+            for frame in range(self.frames)
+            if not self.frame_path(side, tilt_index, frame).is_file()
+        ]
@@ -0,0 +1,93 @@
+# -*- coding: utf-8 -*-
+"""
+Contact Sheet Domain Model
+Assets laid out as a thumbnail wall with their names, versions, and statuses
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+from typing import List, Optional, Tuple
+
+from .asset_status import STATUS_COLORS, STATUS_LABELS, STATUS_WIP
+from .asset_version import format_version_label
+
+FORMAT_PDF = "pdf"
+FORMAT_PNG = "png"
+FORMATS = (FORMAT_PDF, FORMAT_PNG)
+
+FORMAT_LABELS = {
+    FORMAT_PDF: "PDF (pages)",
+    FORMAT_PNG: "PNG (one large image)",
+}
+
+
+@dataclass(frozen=True)
+class ContactSheetEntry:
+    """
+    Contact Sheet Entry Value Object - Single Responsibility for one cell of the wall
+    Entries without a thumbnail get an empty frame with the asset's name
+    """
+
+    name: str
+    asset_key: str  # Library-relative asset path
+    version: int = 0  # 0 when the asset has no version history
+    status: str = STATUS_WIP
+    thumbnail: Optional[Path] = None
+
+    @property
+    def version_label(self) -> str:
+        """Get the version text (v003, "-" unversioned)"""
+        return format_version_label(self.version) if self.version else "-"
+
+    @property
+    def status_label(self) -> str:
+        """Get the status text (Approved)"""
+        return STATUS_LABELS.get(self.status, self.status)
+
+    @property
+    def status_color(self) -> str:
+        """Get the status badge color"""
+        return STATUS_COLORS.get(self.status, STATUS_COLORS[STATUS_WIP])
+
+    @property
+    def caption(self) -> str:
+        """Get the line under the name (v003 - Approved)"""
+        return f"{self.version_label} - {self.status_label}"
+
+
+@dataclass(frozen=True)
+class ContactSheetOptions:
+    """
+    Contact Sheet Options Value Object - Single Responsibility for sheet layout choices
+    PDF sheets fill landscape A4 pages; PNG sheets are one image of every row
+    """
+
+    title: str = ""
+    file_format: str = FORMAT_PDF
+    columns: int = 5
+    cell_size: int = 256  # Thumbnail width in pixels on PNG sheets
+    html_gallery: bool = False
+
+    def get_pages(self, count: int, rows_per_page: int = 0) -> List[List[Tuple[int, int, int]]]:
+        """
+        Lay out entries in rows of the columns
+
+        Args:
+            count: Number of entries
+            rows_per_page: Rows on each page, 0 puts every row on one page
+
+        Returns:
+            Pages of (entry index, column, row on the page)
+        """
+        columns = max(1, self.columns)
+        per_page = columns * rows_per_page if rows_per_page > 0 else max(count, 1)
+        pages: List[List[Tuple[int, int, int]]] = []
+        for index in range(count):
+            if index % per_page == 0:
+                pages.append([])
+            position = index % per_page
+            pages[-1].append((index, position % columns, position // columns))
+        return pages
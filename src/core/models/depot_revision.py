@@ -0,0 +1,46 @@
+# -*- coding: utf-8 -*-
+"""
+Depot Revision Domain Model
+Source control state of one library file (workspace revision vs. depot head)
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+
+
+@dataclass(frozen=True)
+class DepotRevision:
+    """
+    Depot Revision Value Object - Single Responsibility for depot file state
+    Read fresh from the server on library refresh; never edited locally
+    """
+
+    local_path: Path
+    depot_path: str
+    have_revision: int = 0  # 0 = not synced to this workspace
+    head_revision: int = 0
+    head_change: int = 0
+    action: str = ""  # Pending action in this workspace (edit, add...), "" if not opened
+
+    @property
+    def is_latest(self) -> bool:
+        """Check if the workspace has the head revision"""
+        return self.have_revision == self.head_revision
+
+    @property
+    def label(self) -> str:
+        """Get revision text as shown by p4 (#3/5)"""
+        return f"#{self.have_revision}/{self.head_revision}"
+
+    @property
+    def description(self) -> str:
+        """Get human readable state (//depot/props/crate.ma#3 of 5, change 1234)"""
+        text = f"{self.depot_path}#{self.have_revision} of {self.head_revision}"
+        if self.head_change:
+            text = f"{text}, change {self.head_change}"
+        if self.action:
+            text = f"{text} (opened for {self.action})"
+        return text
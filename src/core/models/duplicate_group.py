@@ -0,0 +1,65 @@
+# -*- coding: utf-8 -*-
+"""
+Duplicate Group Domain Model
+Library assets that hold the same or nearly the same content
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field, replace
+from pathlib import Path
+from typing import Dict, Tuple
+
+
+@dataclass(frozen=True)
+class DuplicateGroup:
+    """
+    Duplicate Group Value Object - Single Responsibility for one set of duplicate assets
+    The canonical asset is the one the duplicates get merged into or redirected to
+    """
+
+    canonical: Path
+    duplicates: Tuple[Path, ...]
+    # Duplicate -> similarity to the canonical asset (1.0 = identical content)
+    similarity: Dict[Path, float] = field(default_factory=dict, compare=False)
+
+    @property
+    def files(self) -> Tuple[Path, ...]:
+        """Get every asset of the group, canonical first"""
+        return (self.canonical,) + self.duplicates
+
+    @property
+    def is_identical(self) -> bool:
+        """Check if every duplicate matches the canonical asset exactly"""
+        return all(self.get_similarity(path) >= 1.0 for path in self.duplicates)
+
+    @property
+    def label(self) -> str:
+        """Get group heading (crate.ma - 2 duplicates)"""
+        count = len(self.duplicates)
+        return f"{self.canonical.name} - {count} duplicate{'s' if count != 1 else ''}"
+
+    def get_similarity(self, path: Path) -> float:
+        """Get how close a duplicate is to the canonical asset (0.0-1.0)"""
+        return self.similarity.get(Path(path), 1.0)
+
+    def describe_similarity(self, path: Path) -> str:
+        """Get similarity text (Identical, 94% similar)"""
+        similarity = self.get_similarity(path)
+        if similarity >= 1.0:
+            return "Identical"
+        return f"{int(similarity * 100)}% similar"
+
+    def with_canonical(self, path: Path) -> "DuplicateGroup":
+        """Get the group with another member as canonical asset"""
+        path = Path(path)
+        if path == self.canonical:
+            return self
+        if path not in self.duplicates:
+            raise ValueError(f"{path.name} is not part of this duplicate group")
+        # Similarity was measured against the old canonical - keep it as the estimate
+        similarity = dict(self.similarity)
+        similarity[self.canonical] = similarity.pop(path, 1.0)
+        duplicates = tuple(p for p in self.files if p != path)
+        return replace(self, canonical=path, duplicates=duplicates, similarity=similarity)
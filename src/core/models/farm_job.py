@@ -0,0 +1,128 @@
+# -*- coding: utf-8 -*-
+"""
+Farm Job Domain Model
+Heavy publish step of an asset sent to a render farm, and what the farm reported back
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, replace
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+FARM_STEP_TURNTABLE = "turntable"
+FARM_STEP_USD = "usd"
+FARM_STEPS = (FARM_STEP_TURNTABLE, FARM_STEP_USD)
+
+FARM_STEP_LABELS = {
+    FARM_STEP_TURNTABLE: "Turntable render",
+    FARM_STEP_USD: "USD conversion",
+}
+
+FARM_STATE_SUBMITTED = "submitted"  # Waiting for or running on the farm
+FARM_STATE_COMPLETED = "completed"
+FARM_STATE_FAILED = "failed"
+FARM_STATES = (FARM_STATE_SUBMITTED, FARM_STATE_COMPLETED, FARM_STATE_FAILED)
+
+
+@dataclass(frozen=True)
+class FarmJob:
+    """
+    Farm Job Value Object - Single Responsibility for one farm submission
+    The asset shows as processing until the farm task writes its result
+    """
+
+    key: str  # Record name in the library, unique per submission
+    asset_path: Path
+    step: str
+    farm: str  # Backend name (deadline, tractor)
+    job_id: str = ""  # The farm's own job id
+    outputs: Tuple[Path, ...] = ()
+    state: str = FARM_STATE_SUBMITTED
+    submitted_by: str = ""
+    submitted_date: str = ""  # ISO timestamp
+    finished_date: str = ""
+    error: str = ""
+
+    def __post_init__(self):
+        if not self.key:
+            raise ValueError("Farm job needs a key")
+        if self.step not in FARM_STEPS:
+            raise ValueError(f"Unknown farm step: {self.step}")
+        if self.state not in FARM_STATES:
+            raise ValueError(f"Unknown farm job state: {self.state}")
+
+    @property
+    def is_processing(self) -> bool:
+        """Check if the farm has not reported back yet"""
+        return self.state == FARM_STATE_SUBMITTED
+
+    @property
+    def label(self) -> str:
+        """Get display text of the step (Turntable render)"""
+        return FARM_STEP_LABELS[self.step]
+
+    @property
+    def description(self) -> str:
+        """Get display text (Turntable render on deadline, job 64f1c2 - failed: no license)"""
+        text = f"{self.label} on {self.farm}"
+        if self.job_id:
+            text += f", job {self.job_id}"
+        if self.state != FARM_STATE_SUBMITTED:
+            text += f" - {self.state}"
+        if self.error:
+            text += f": {self.error}"
+        return text
+
+    def with_result(self, state: str, finished_date: str, error: str = "") -> "FarmJob":
+        """Get a copy with the farm's result"""
+        return replace(self, state=state, finished_date=finished_date, error=error)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of the job record"""
+        return {
+            "key": self.key,
+            "asset_path": str(self.asset_path),
+            "step": self.step,
+            "farm": self.farm,
+            "job_id": self.job_id,
+            "outputs": [str(path) for path in self.outputs],
+            "state": self.state,
+            "submitted_by": self.submitted_by,
+            "submitted_date": self.submitted_date,
+            "finished_date": self.finished_date,
+            "error": self.error,
+        }
+
+    @classmethod
+    def from_dict(
+        cls, data: Dict[str, Any], result: Optional[Dict[str, Any]] = None
+    ) -> "FarmJob":
+        """
+        Create from a job record
+
+        Args:
+            data: Record written at submission
+            result: Record the farm task wrote when it finished, if any
+        """
+        job = cls(
+            key=str(data["key"]),
+            asset_path=Path(data["asset_path"]),
+            step=str(data["step"]),
+            farm=str(data.get("farm", "")),
+            job_id=str(data.get("job_id", "")),
+            outputs=tuple(Path(path) for path in data.get("outputs", [])),
+            state=str(data.get("state", FARM_STATE_SUBMITTED)),
+            submitted_by=str(data.get("submitted_by", "")),
+            submitted_date=str(data.get("submitted_date", "")),
+            finished_date=str(data.get("finished_date", "")),
+            error=str(data.get("error", "")),
+        )
+        if result:
+            job = job.with_result(
+                str(result.get("state", FARM_STATE_FAILED)),
+                str(result.get("finished_date", "")),
+                str(result.get("error", "")),
+            )
+        return job
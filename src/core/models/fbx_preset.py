@@ -0,0 +1,56 @@
+# -*- coding: utf-8 -*-
+"""
+FBX Preset Domain Model
+Named FBX export settings for handing assets to a game engine
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import asdict, dataclass, fields
+from typing import Any, Dict
+
+UP_AXES = ("y", "z")
+
+# FBXExportConvertUnitString values
+UNITS = ("mm", "cm", "dm", "m", "km", "in", "ft", "yd")
+
+
+@dataclass(frozen=True)
+class FbxExportPreset:
+    """
+    FBX Export Preset Value Object - Single Responsibility for one engine's FBX settings
+    Stored in the library so every artist publishes identical handoff files
+    """
+
+    name: str
+    up_axis: str = "y"
+    units: str = "cm"
+    smoothing_groups: bool = True
+    triangulate: bool = False
+    embed_media: bool = False
+    bake_animation: bool = False
+
+    @property
+    def description(self) -> str:
+        """Get settings summary (Z up, cm, triangulated, baked animation)"""
+        parts = [f"{self.up_axis.upper()} up", self.units]
+        if self.smoothing_groups:
+            parts.append("smoothing groups")
+        if self.triangulate:
+            parts.append("triangulated")
+        if self.embed_media:
+            parts.append("embedded media")
+        if self.bake_animation:
+            parts.append("baked animation")
+        return ", ".join(parts)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert preset to dictionary for the library presets file"""
+        return asdict(self)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "FbxExportPreset":
+        """Create preset from the library presets file, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        return cls(**{key: value for key, value in data.items() if key in known})
@@ -0,0 +1,97 @@
+# -*- coding: utf-8 -*-
+"""
+Geometry Stats Domain Model
+Mesh statistics of an asset, captured when it is published
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, Tuple
+
+# Search filter and sort names -> GeometryStats attribute
+STAT_FILTERS = {
+    "tris": "triangles",
+    "verts": "vertices",
+    "uvsets": "uv_sets",
+    "texres": "max_texture_resolution",
+    "bbox": "largest_dimension",
+}
+
+
+@dataclass(frozen=True)
+class GeometryStats:
+    """
+    Geometry Stats Value Object - Single Responsibility for published mesh statistics
+    Stored as a plain dict with the asset metadata under "stats"
+    """
+
+    triangles: int = 0
+    vertices: int = 0
+    mesh_count: int = 0
+    uv_sets: int = 0  # Most UV sets on any one mesh
+    bounding_box: Tuple[float, float, float] = (0.0, 0.0, 0.0)  # World width, height, depth
+    texture_resolutions: Tuple[Tuple[str, int], ...] = ()  # ("4096x4096", count), largest first
+    has_skin_cluster: bool = False
+
+    @property
+    def largest_dimension(self) -> float:
+        """Get the longest bounding box side"""
+        return max(self.bounding_box) if self.bounding_box else 0.0
+
+    @property
+    def max_texture_resolution(self) -> int:
+        """Get the longest side of the largest texture, 0 without textures"""
+        sides = [
+            int(side)
+            for label, _count in self.texture_resolutions
+            for side in label.split("x")
+            if side.isdigit()
+        ]
+        return max(sides, default=0)
+
+    @property
+    def texture_summary(self) -> str:
+        """Get display text (2 x 4096x4096, 5 x 2048x2048)"""
+        if not self.texture_resolutions:
+            return "none"
+        return ", ".join(f"{count} x {label}" for label, count in self.texture_resolutions)
+
+    @property
+    def bounding_box_label(self) -> str:
+        """Get display text (120.5 x 80 x 40.25)"""
+        return " x ".join(f"{side:g}" for side in self.bounding_box)
+
+    def get_filter_value(self, filter_name: str) -> float:
+        """Get the value searched and sorted by a STAT_FILTERS name"""
+        return getattr(self, STAT_FILTERS[filter_name])
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in asset metadata"""
+        return {
+            "triangles": self.triangles,
+            "vertices": self.vertices,
+            "mesh_count": self.mesh_count,
+            "uv_sets": self.uv_sets,
+            "bounding_box": list(self.bounding_box),
+            "texture_resolutions": dict(self.texture_resolutions),
+            "has_skin_cluster": self.has_skin_cluster,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "GeometryStats":
+        """Create from stored metadata; missing values count as zero"""
+        box = [float(side) for side in data.get("bounding_box", [])][:3]
+        resolutions = data.get("texture_resolutions", {}) or {}
+        return cls(
+            triangles=int(data.get("triangles", 0)),
+            vertices=int(data.get("vertices", 0)),
+            mesh_count=int(data.get("mesh_count", 0)),
+            uv_sets=int(data.get("uv_sets", 0)),
+            bounding_box=tuple(box + [0.0] * (3 - len(box))),
+            texture_resolutions=tuple(
+                (str(label), int(count)) for label, count in resolutions.items()
+            ),
+            has_skin_cluster=bool(data.get("has_skin_cluster", False)),
+        )
@@ -0,0 +1,115 @@
+# -*- coding: utf-8 -*-
+"""
+glTF Export Domain Models
+Library settings for the web and AR review .glb and the PBR materials baked into it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, fields
+from typing import Any, Dict, Optional, Tuple
+
+GLB_EXTENSION = ".glb"
+GLTF_EXTENSION = ".gltf"
+GLTF_EXTENSIONS = (GLB_EXTENSION, GLTF_EXTENSION)
+
+# glTF alpha modes
+ALPHA_OPAQUE = "OPAQUE"
+ALPHA_BLEND = "BLEND"
+
+# Draco compression levels (FBX2glTF --draco-compression-level)
+DRACO_LEVELS = tuple(range(11))
+
+
+@dataclass(frozen=True)
+class GltfExportSettings:
+    """
+    glTF Export Settings Value Object - Single Responsibility for a library's glTF export
+    Stored in the library so every review file is written the same way
+    """
+
+    asset_types: Tuple[str, ...] = ()  # Asset types that also write a glTF on publish
+    binary: bool = True  # One .glb instead of a .gltf with embedded buffers
+    draco: bool = False
+    draco_level: int = 7
+    bake_materials: bool = True  # Rebuild materials from the Standard Surface shaders
+    texture_size: int = 2048  # Longest side of baked textures
+
+    @property
+    def extension(self) -> str:
+        """Get the extension of the written file"""
+        return GLB_EXTENSION if self.binary else GLTF_EXTENSION
+
+    @property
+    def description(self) -> str:
+        """Get settings summary (GLB, Draco level 7, baked materials at 2048)"""
+        parts = [self.extension.lstrip(".").upper()]
+        if self.draco:
+            parts.append(f"Draco level {self.draco_level}")
+        if self.bake_materials:
+            parts.append(f"baked materials at {self.texture_size}")
+        return ", ".join(parts)
+
+    def publishes(self, asset_type: str) -> bool:
+        """Check if publishes of an asset type (category) write a glTF"""
+        return bool(asset_type) and asset_type in self.asset_types
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the library's glTF settings file"""
+        return {
+            "asset_types": list(self.asset_types),
+            "binary": self.binary,
+            "draco": self.draco,
+            "draco_level": self.draco_level,
+            "bake_materials": self.bake_materials,
+            "texture_size": self.texture_size,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "GltfExportSettings":
+        """Create from the library's glTF settings file, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        values = {key: value for key, value in data.items() if key in known}
+        if "asset_types" in values:
+            values["asset_types"] = tuple(values["asset_types"] or ())
+        if "draco_level" in values:
+            values["draco_level"] = min(max(int(values["draco_level"]), 0), DRACO_LEVELS[-1])
+        if "texture_size" in values:
+            values["texture_size"] = max(int(values["texture_size"]), 1)
+        return cls(**values)
+
+
+@dataclass(frozen=True)
+class PbrMaterial:
+    """
+    PBR Material Value Object - Single Responsibility for one glTF metallic-roughness material
+    Metallic and roughness are the shader's values, replaced by their maps when packed
+    """
+
+    name: str  # Shader name without namespace
+    base_color: Tuple[float, float, float, float] = (1.0, 1.0, 1.0, 1.0)
+    metallic: float = 0.0
+    roughness: float = 0.5
+    emissive: Tuple[float, float, float] = (0.0, 0.0, 0.0)
+    alpha_mode: str = ALPHA_OPAQUE
+    base_color_texture: Optional[str] = None
+    metallic_texture: Optional[str] = None
+    roughness_texture: Optional[str] = None
+    normal_texture: Optional[str] = None
+    emissive_texture: Optional[str] = None
+
+    @property
+    def textures(self) -> Tuple[str, ...]:
+        """Get every texture file the material uses"""
+        return tuple(
+            path
+            for path in (
+                self.base_color_texture,
+                self.metallic_texture,
+                self.roughness_texture,
+                self.normal_texture,
+                self.emissive_texture,
+            )
+            if path
+        )
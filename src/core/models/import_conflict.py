@@ -0,0 +1,61 @@
+# -*- coding: utf-8 -*-
+"""
+Import Conflict Domain Models
+Nodes of an asset whose names are already taken in the scene, and how to import them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Tuple
+
+CONFLICT_NAMESPACE = "namespace"  # Import into a namespace of its own
+CONFLICT_SUFFIX = "suffix"  # Give the clashing nodes a free name (body -> body_1)
+CONFLICT_SKIP = "skip"  # Leave this asset out, import the rest of the selection
+CONFLICT_ABORT = "abort"  # Import nothing more
+CONFLICT_RESOLUTIONS = (CONFLICT_NAMESPACE, CONFLICT_SUFFIX, CONFLICT_SKIP, CONFLICT_ABORT)
+
+
+@dataclass(frozen=True)
+class NameConflict:
+    """
+    Name Conflict Value Object - Single Responsibility for one clashing node
+    """
+
+    name: str  # Node name as the asset file has it
+    node_type: str
+    scene_node: str  # Scene node already holding the name
+
+    @property
+    def label(self) -> str:
+        """Get display text (body (transform) - taken by |body)"""
+        return f"{self.name} ({self.node_type}) - taken by {self.scene_node}"
+
+
+@dataclass(frozen=True)
+class ImportConflictReport:
+    """
+    Import Conflict Report Value Object - Single Responsibility for an import pre-check
+    """
+
+    asset_file: Path
+    conflicts: Tuple[NameConflict, ...] = ()
+
+    @property
+    def has_conflicts(self) -> bool:
+        """Check if Maya would rename any of the asset's nodes"""
+        return bool(self.conflicts)
+
+    @property
+    def names(self) -> Tuple[str, ...]:
+        """Get the clashing node names"""
+        return tuple(conflict.name for conflict in self.conflicts)
+
+    def summary(self) -> str:
+        """Get a one-line summary (3 node name(s) of crate.ma are taken in the scene)"""
+        return (
+            f"{len(self.conflicts)} node name(s) of {self.asset_file.name} "
+            "are taken in the scene"
+        )
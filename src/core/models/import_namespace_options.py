@@ -0,0 +1,72 @@
+# -*- coding: utf-8 -*-
+"""
+Import Namespace Options Domain Model
+What happens to the namespaces an imported asset brings into the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict
+
+NAMESPACE_KEEP = "keep"  # Leave them as the file has them
+NAMESPACE_STRIP = "strip"  # Move everything into the root namespace
+NAMESPACE_MERGE = "merge"  # Collapse them into one namespace, existing or new
+NAMESPACE_PREFIX = "prefix"  # Strip them and prefix node names instead
+NAMESPACE_MODES = (NAMESPACE_KEEP, NAMESPACE_STRIP, NAMESPACE_MERGE, NAMESPACE_PREFIX)
+
+
+@dataclass(frozen=True)
+class ImportNamespaceOptions:
+    """
+    Import Namespace Options Value Object - Single Responsibility for namespace handling
+    Only namespaces the import created are changed; references keep theirs
+    """
+
+    mode: str = NAMESPACE_KEEP
+    namespace: str = ""  # Merge target, empty for the asset name
+    prefix: str = ""  # Node name prefix, empty for the asset name and an underscore
+    remove_empty: bool = False  # Remove every empty namespace of the scene afterwards
+
+    def __post_init__(self):
+        if self.mode not in NAMESPACE_MODES:
+            raise ValueError(
+                f"Unknown namespace mode '{self.mode}' (use one of: {', '.join(NAMESPACE_MODES)})"
+            )
+
+    @property
+    def changes_namespaces(self) -> bool:
+        """Check if imports are touched at all"""
+        return self.mode != NAMESPACE_KEEP or self.remove_empty
+
+    @property
+    def label(self) -> str:
+        """Get display text (Merge into crate, remove empty namespaces)"""
+        text = {
+            NAMESPACE_KEEP: "Keep namespaces",
+            NAMESPACE_STRIP: "Strip namespaces",
+            NAMESPACE_MERGE: f"Merge into {self.namespace or 'asset name'}",
+            NAMESPACE_PREFIX: f"Prefix names with {self.prefix or 'asset name'}",
+        }[self.mode]
+        return f"{text}, remove empty namespaces" if self.remove_empty else text
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the artist's import settings"""
+        return {
+            "mode": self.mode,
+            "namespace": self.namespace,
+            "prefix": self.prefix,
+            "remove_empty": self.remove_empty,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "ImportNamespaceOptions":
+        """Create options from stored settings, keeping namespaces for unknown modes"""
+        mode = str(data.get("mode", NAMESPACE_KEEP))
+        return cls(
+            mode=mode if mode in NAMESPACE_MODES else NAMESPACE_KEEP,
+            namespace=str(data.get("namespace", "")),
+            prefix=str(data.get("prefix", "")),
+            remove_empty=bool(data.get("remove_empty", False)),
+        )
@@ -0,0 +1,143 @@
+# -*- coding: utf-8 -*-
+"""
+Integrity Report Domain Model
+Findings of a library audit: files that no longer match their publish checksum
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+ISSUE_MISSING = "missing"  # Published file is gone
+ISSUE_CORRUPTED = "corrupted"  # File bytes differ from the publish checksum
+ISSUE_ORPHANED = "orphaned"  # Asset folder (history, proxies...) of no database asset
+ISSUE_UNVERIFIED = "unverified"  # Published before checksums were stored
+
+ISSUE_KINDS = (ISSUE_MISSING, ISSUE_CORRUPTED, ISSUE_ORPHANED, ISSUE_UNVERIFIED)
+
+ISSUE_LABELS = {
+    ISSUE_MISSING: "Missing",
+    ISSUE_CORRUPTED: "Corrupted",
+    ISSUE_ORPHANED: "Orphaned folder",
+    ISSUE_UNVERIFIED: "No checksum",
+}
+
+REPAIR_RESTORE = "restore"  # Copy an intact file with the same checksum over it
+REPAIR_FORGET = "forget"  # Drop the checksum record of a file that is gone for good
+REPAIR_DELETE = "delete"  # Delete an orphaned folder
+REPAIR_RECORD = "record"  # Store the current checksum as the trusted one
+
+REPAIR_LABELS = {
+    REPAIR_RESTORE: "Restore",
+    REPAIR_FORGET: "Forget",
+    REPAIR_DELETE: "Delete Folder",
+    REPAIR_RECORD: "Record Checksum",
+}
+
+
+@dataclass(frozen=True)
+class IntegrityIssue:
+    """
+    Integrity Issue Value Object - Single Responsibility for one audit finding
+    Carries the repair the audit suggests, and the intact copy a restore uses
+    """
+
+    kind: str
+    path: Path
+    asset_key: str = ""
+    repair: str = ""
+    # Intact file with the recorded checksum, for REPAIR_RESTORE
+    source: Optional[Path] = None
+
+    @property
+    def label(self) -> str:
+        """Get display kind (Missing, Corrupted...)"""
+        return ISSUE_LABELS.get(self.kind, self.kind)
+
+    @property
+    def repair_label(self) -> str:
+        """Get display name of the suggested repair"""
+        return REPAIR_LABELS.get(self.repair, "")
+
+    @property
+    def is_problem(self) -> bool:
+        """Check if the finding is damage rather than a file never fingerprinted"""
+        return self.kind != ISSUE_UNVERIFIED
+
+    @property
+    def advice(self) -> str:
+        """Get what the artist should do about the finding"""
+        if self.repair == REPAIR_RESTORE and self.source is not None:
+            return f"Restore from {self.source.parent.name}/{self.source.name}"
+        if self.kind == ISSUE_MISSING:
+            return "No intact copy left - republish the asset, or forget the record"
+        if self.kind == ISSUE_CORRUPTED:
+            return (
+                "No intact copy left - republish the asset, or record the checksum "
+                "if the edit was deliberate"
+            )
+        if self.kind == ISSUE_ORPHANED:
+            return "Belongs to no library asset - delete it, or add the asset back"
+        return "Record its checksum to verify it from now on"
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert issue to dictionary for report files"""
+        return {
+            "kind": self.kind,
+            "path": str(self.path),
+            "asset": self.asset_key,
+            "repair": self.repair,
+            "source": str(self.source) if self.source else None,
+            "advice": self.advice,
+        }
+
+
+@dataclass(frozen=True)
+class IntegrityReport:
+    """
+    Integrity Report Value Object - Single Responsibility for one library audit
+    """
+
+    library_root: Path
+    checked: int
+    issues: Tuple[IntegrityIssue, ...] = ()
+    audited: datetime = field(default_factory=datetime.now)
+
+    def get_issues(self, kind: str) -> Tuple[IntegrityIssue, ...]:
+        """Get the findings of one kind"""
+        return tuple(issue for issue in self.issues if issue.kind == kind)
+
+    @property
+    def problems(self) -> Tuple[IntegrityIssue, ...]:
+        """Get missing, corrupted, and orphaned findings"""
+        return tuple(issue for issue in self.issues if issue.is_problem)
+
+    @property
+    def is_clean(self) -> bool:
+        """Check if every recorded file verified and nothing is orphaned"""
+        return not self.problems
+
+    @property
+    def summary(self) -> str:
+        """Get one-line audit result (120 verified, 2 missing, 1 corrupted)"""
+        parts = [f"{self.checked} file(s) checked"]
+        for kind in ISSUE_KINDS:
+            count = len(self.get_issues(kind))
+            if count:
+                parts.append(f"{count} {ISSUE_LABELS[kind].lower()}")
+        return ", ".join(parts)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert report to dictionary for report files"""
+        return {
+            "library": str(self.library_root),
+            "audited": self.audited.isoformat(),
+            "checked": self.checked,
+            "clean": self.is_clean,
+            "summary": self.summary,
+            "issues": [issue.to_dict() for issue in self.issues],
+        }
@@ -0,0 +1,77 @@
+# -*- coding: utf-8 -*-
+"""
+Library Entry Domain Model
+One registered asset library shown in the library picker
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import os
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+SCOPE_PERSONAL = "personal"
+SCOPE_PROJECT = "project"
+SCOPE_STUDIO = "studio"
+SCOPES = (SCOPE_PERSONAL, SCOPE_PROJECT, SCOPE_STUDIO)
+
+SCOPE_LABELS = {
+    SCOPE_PERSONAL: "Personal",
+    SCOPE_PROJECT: "Project",
+    SCOPE_STUDIO: "Studio",
+}
+
+
+@dataclass(frozen=True)
+class LibraryEntry:
+    """
+    Library Entry Value Object - Single Responsibility for one library location
+    Database and settings live in the library itself, so the root path is enough
+    """
+
+    name: str
+    root_path: Path
+    scope: str = SCOPE_PERSONAL
+    mounted_by: Optional[Path] = None  # Maya project whose config mounted it
+
+    @property
+    def label(self) -> str:
+        """Get display text (Studio: Props)"""
+        return f"{SCOPE_LABELS.get(self.scope, self.scope)}: {self.name}"
+
+    @property
+    def is_mounted(self) -> bool:
+        """Check if the library comes from a Maya project config (not saved)"""
+        return self.mounted_by is not None
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of libraries.json"""
+        return {"name": self.name, "path": str(self.root_path), "scope": self.scope}
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any], base_dir: Optional[Path] = None) -> "LibraryEntry":
+        """
+        Create from a libraries.json or project config entry
+
+        Args:
+            data: Entry with name, path, and scope
+            base_dir: Folder relative paths are resolved against
+
+        Raises:
+            ValueError: If the path is missing or the scope does not exist
+        """
+        path = str(data.get("path") or "").strip()
+        if not path:
+            raise ValueError("Library entry has no path")
+        # $ASSET_ROOT/props and ~/library work in configs shared across platforms
+        root_path = Path(os.path.expandvars(path)).expanduser()
+        if base_dir is not None and not root_path.is_absolute():
+            root_path = Path(base_dir) / root_path
+
+        scope = data.get("scope", SCOPE_PERSONAL)
+        if scope not in SCOPES:
+            raise ValueError(f"Unknown library scope '{scope}' (use one of: {', '.join(SCOPES)})")
+        name = str(data.get("name") or "").strip() or root_path.name
+        return cls(name=name, root_path=root_path, scope=scope)
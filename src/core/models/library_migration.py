@@ -0,0 +1,51 @@
+# -*- coding: utf-8 -*-
+"""
+Library Migration Domain Models
+Metadata storage backends, and the report of packaging or migrating a library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import List
+
+BACKEND_JSON = "json"  # <asset>.meta sidecars next to each asset
+BACKEND_DATABASE = "database"  # <library>/.assetmanager/library.db
+
+BACKEND_LABELS = {
+    BACKEND_JSON: "JSON sidecars",
+    BACKEND_DATABASE: "Library database",
+}
+
+
+@dataclass
+class LibraryMigrationReport:
+    """
+    Library Migration Report - Single Responsibility for one export, import or migration
+    Counts what was moved and lists the files that still point at the old location
+    """
+
+    library_root: Path
+    files: int = 0  # Library files packaged or unpacked
+    metadata_rows: int = 0  # Database rows written
+    sidecars_migrated: int = 0  # .meta sidecars upgraded into the database
+    paths_rewritten: int = 0  # Stored paths moved to the new root
+    needs_relink: List[Path] = field(default_factory=list)  # Binary scenes left unchanged
+    warnings: List[str] = field(default_factory=list)
+
+    @property
+    def is_clean(self) -> bool:
+        """Check if nothing needs attention after the migration"""
+        return not self.needs_relink and not self.warnings
+
+    def summary(self) -> str:
+        """Get one-line summary for the status bar"""
+        parts = [f"{self.files} file(s)", f"{self.metadata_rows} metadata row(s)"]
+        if self.sidecars_migrated:
+            parts.append(f"{self.sidecars_migrated} sidecar(s) upgraded")
+        parts.append(f"{self.paths_rewritten} path(s) rewritten")
+        if self.needs_relink:
+            parts.append(f"{len(self.needs_relink)} binary scene(s) need relinking")
+        return ", ".join(parts)
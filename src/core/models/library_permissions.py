@@ -0,0 +1,130 @@
+# -*- coding: utf-8 -*-
+"""
+Library Permissions Domain Model
+Roles of the artists of one shared library and what each role may do
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, Tuple
+
+ROLE_JUNIOR = "junior"
+ROLE_ARTIST = "artist"
+ROLE_REVIEWER = "reviewer"
+ROLE_LEAD = "lead"
+ROLE_ADMIN = "admin"
+ROLES = (ROLE_JUNIOR, ROLE_ARTIST, ROLE_REVIEWER, ROLE_LEAD, ROLE_ADMIN)
+
+ACTION_IMPORT = "import"  # Import, reference, and apply library assets
+ACTION_PUBLISH = "publish"  # Publish new assets and versions, roll back
+ACTION_EDIT = "edit"  # Change tags and custom fields
+ACTION_APPROVE = "approve"  # Review assets: approve, deprecate, send back to WIP
+ACTION_DELETE = "delete"  # Remove or delete assets
+ACTION_MANAGE = "manage"  # Change library settings and permissions
+ACTIONS = (
+    ACTION_IMPORT,
+    ACTION_PUBLISH,
+    ACTION_EDIT,
+    ACTION_APPROVE,
+    ACTION_DELETE,
+    ACTION_MANAGE,
+)
+
+# What each action is called in messages ("Your role cannot publish assets")
+ACTION_LABELS = {
+    ACTION_IMPORT: "import assets",
+    ACTION_PUBLISH: "publish assets",
+    ACTION_EDIT: "edit asset metadata",
+    ACTION_APPROVE: "review assets",
+    ACTION_DELETE: "delete assets",
+    ACTION_MANAGE: "manage library settings",
+}
+
+DEFAULT_ROLE_ACTIONS: Dict[str, Tuple[str, ...]] = {
+    ROLE_JUNIOR: (ACTION_IMPORT,),
+    ROLE_ARTIST: (ACTION_IMPORT, ACTION_PUBLISH, ACTION_EDIT),
+    ROLE_REVIEWER: (ACTION_IMPORT, ACTION_PUBLISH, ACTION_EDIT, ACTION_APPROVE),
+    ROLE_LEAD: (ACTION_IMPORT, ACTION_PUBLISH, ACTION_EDIT, ACTION_APPROVE, ACTION_DELETE),
+    ROLE_ADMIN: ACTIONS,
+}
+
+
+@dataclass(frozen=True)
+class LibraryPermissions:
+    """
+    Library Permissions Value Object - Single Responsibility for role lookups
+    Artists not listed get the default role; admins can always manage
+    """
+
+    users: Tuple[Tuple[str, str], ...] = ()  # (user name, role) sorted by user
+    default_role: str = ROLE_ARTIST
+    role_actions: Tuple[Tuple[str, Tuple[str, ...]], ...] = ()  # Overrides of a role
+
+    def get_role(self, user: str) -> str:
+        """Get an artist's role (user names are not case sensitive)"""
+        user = user.lower()
+        for name, role in self.users:
+            if name.lower() == user:
+                return role
+        return self.default_role
+
+    def get_actions(self, role: str) -> Tuple[str, ...]:
+        """Get the actions a role may perform"""
+        overrides = dict(self.role_actions)
+        actions = overrides.get(role, DEFAULT_ROLE_ACTIONS.get(role, ()))
+        if role == ROLE_ADMIN and ACTION_MANAGE not in actions:
+            actions = actions + (ACTION_MANAGE,)  # Never lock every admin out
+        return actions
+
+    def allows(self, user: str, action: str) -> bool:
+        """Check if an artist may perform an action"""
+        return action in self.get_actions(self.get_role(user))
+
+    def with_user(self, user: str, role: str) -> "LibraryPermissions":
+        """Get a copy with an artist's role set; the default role removes the entry"""
+        users = {name: value for name, value in self.users if name.lower() != user.lower()}
+        if role != self.default_role:
+            users[user] = role
+        return LibraryPermissions(
+            users=tuple(sorted(users.items())),
+            default_role=self.default_role,
+            role_actions=self.role_actions,
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of permissions.json"""
+        data: Dict[str, Any] = {"default_role": self.default_role, "users": dict(self.users)}
+        if self.role_actions:
+            data["roles"] = {role: list(actions) for role, actions in self.role_actions}
+        return data
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "LibraryPermissions":
+        """
+        Create from the JSON layout of permissions.json
+
+        Raises:
+            ValueError: If a role or action does not exist
+        """
+        default_role = data.get("default_role", ROLE_ARTIST)
+        users = {str(user): role for user, role in (data.get("users") or {}).items()}
+        for role in [default_role, *users.values()]:
+            if role not in ROLES:
+                raise ValueError(f"Unknown role '{role}' (use one of: {', '.join(ROLES)})")
+
+        role_actions = []
+        for role, actions in sorted((data.get("roles") or {}).items()):
+            if role not in ROLES:
+                raise ValueError(f"Unknown role '{role}' (use one of: {', '.join(ROLES)})")
+            unknown = [action for action in actions if action not in ACTIONS]
+            if unknown:
+                raise ValueError(f"Unknown action '{unknown[0]}' for role '{role}'")
+            role_actions.append((role, tuple(actions)))
+
+        return cls(
+            users=tuple(sorted(users.items())),
+            default_role=default_role,
+            role_actions=tuple(role_actions),
+        )
@@ -0,0 +1,45 @@
+# -*- coding: utf-8 -*-
+"""
+LOD Variant Domain Model
+One level-of-detail representation of a library asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Optional
+
+# Representations an asset can hold, from full detail to stand-ins
+LOD_LEVELS = ("LOD0", "LOD1", "LOD2", "LOD3", "proxy", "render")
+
+
+@dataclass(frozen=True)
+class LodVariant:
+    """
+    LOD Variant Value Object - Single Responsibility for one asset representation
+    The base variant is the asset file itself; the others live next to it
+    """
+
+    level: str
+    file_path: Path
+    is_base: bool = False
+    author: str = ""
+    published: Optional[datetime] = None
+
+    @property
+    def label(self) -> str:
+        """Get display text (LOD0 (base), proxy)"""
+        return f"{self.level} (base)" if self.is_base else self.level
+
+    @property
+    def exists(self) -> bool:
+        """Check if the variant file is present on disk"""
+        return self.file_path.is_file()
+
+    @property
+    def sort_key(self) -> int:
+        """Get the position of the level in LOD_LEVELS (unknown levels last)"""
+        return LOD_LEVELS.index(self.level) if self.level in LOD_LEVELS else len(LOD_LEVELS)
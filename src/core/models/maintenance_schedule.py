@@ -0,0 +1,294 @@
+# -*- coding: utf-8 -*-
+"""
+Maintenance Schedule Domain Models
+Cron-style schedule of library maintenance jobs and the results they report
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime, timedelta
+from typing import Any, Dict, FrozenSet, Optional, Tuple
+
+TASK_THUMBNAILS = "thumbnails"  # Render missing thumbnails and ones with outdated color
+TASK_INTEGRITY = "integrity"  # Checksum audit of every published file
+TASK_TRASH = "trash"  # Purge trash entries past the retention period
+TASK_RETENTION = "retention"  # Prune old versions the library's retention policy lets go
+TASK_STATS = "stats"  # Recount assets, versions, and disk use
+TASK_LOCKS = "locks"  # Release check-outs older than the stale lock age
+MAINTENANCE_TASKS = (
+    TASK_THUMBNAILS,
+    TASK_INTEGRITY,
+    TASK_TRASH,
+    TASK_RETENTION,
+    TASK_STATS,
+    TASK_LOCKS,
+)
+MAINTENANCE_TASK_LABELS = {
+    TASK_THUMBNAILS: "Thumbnail regeneration",
+    TASK_INTEGRITY: "Integrity audit",
+    TASK_TRASH: "Trash purge",
+    TASK_RETENTION: "Version pruning",
+    TASK_STATS: "Stats refresh",
+    TASK_LOCKS: "Stale lock release",
+}
+
+# Nightly by default, staggered so jobs do not share the library at once; locks hourly
+DEFAULT_TASK_SCHEDULES = {
+    TASK_THUMBNAILS: "0 1 * * *",
+    TASK_INTEGRITY: "0 3 * * 0",
+    TASK_TRASH: "30 3 * * *",
+    TASK_RETENTION: "0 2 * * 0",
+    TASK_STATS: "0 4 * * *",
+    TASK_LOCKS: "0 * * * *",
+}
+
+# (name, lowest, highest) of the five cron fields
+_CRON_FIELDS = (
+    ("minute", 0, 59),
+    ("hour", 0, 23),
+    ("day of month", 1, 31),
+    ("month", 1, 12),
+    ("day of week", 0, 7),
+)
+
+
+def _parse_cron_field(text: str, name: str, lowest: int, highest: int) -> FrozenSet[int]:
+    """Get the values one cron field allows (*/15, 1-5, 0,30)"""
+    values = set()
+    for part in text.split(","):
+        span, _, step_text = part.partition("/")
+        try:
+            step = int(step_text) if step_text else 1
+            if span == "*":
+                start, end = lowest, highest
+            elif "-" in span:
+                start, end = (int(value) for value in span.split("-", 1))
+            else:
+                start = int(span)
+                end = highest if step_text else start
+        except ValueError:
+            raise ValueError(f"Invalid {name} '{part}' in cron schedule") from None
+        if step < 1 or start < lowest or end > highest or start > end:
+            raise ValueError(f"{name.capitalize()} '{part}' is outside {lowest}-{highest}")
+        values.update(range(start, end + 1, step))
+    return frozenset(values)
+
+
+@dataclass(frozen=True)
+class CronSchedule:
+    """
+    Cron Schedule Value Object - Single Responsibility for when a job runs
+    Five fields as in crontab: minute, hour, day of month, month, day of week (0 = Sunday)
+    """
+
+    expression: str
+
+    def __post_init__(self):
+        self._parse()  # Raises ValueError for invalid expressions
+
+    @property
+    def fields(self) -> Tuple[FrozenSet[int], ...]:
+        """Get the values each of the five fields allows"""
+        return self._parse()
+
+    def _parse(self) -> Tuple[FrozenSet[int], ...]:
+        """Parse the five fields"""
+        parts = self.expression.split()
+        if len(parts) != len(_CRON_FIELDS):
+            raise ValueError(
+                f"Cron schedule '{self.expression}' needs five fields (minute hour day month "
+                "weekday)"
+            )
+        parsed = [
+            _parse_cron_field(part, *spec) for part, spec in zip(parts, _CRON_FIELDS)
+        ]
+        parsed[4] = frozenset(value % 7 for value in parsed[4])  # 7 is Sunday as well
+        return tuple(parsed)
+
+    def matches(self, moment: datetime) -> bool:
+        """Check if the schedule fires in the minute of a moment"""
+        minutes, hours, days, months, weekdays = self.fields
+        if moment.minute not in minutes or moment.hour not in hours:
+            return False
+        return moment.month in months and self._matches_day(moment, days, weekdays)
+
+    def next_run(self, after: datetime) -> datetime:
+        """Get the first minute after a moment the schedule fires in"""
+        minutes, hours, days, months, weekdays = self.fields
+        moment = after.replace(second=0, microsecond=0) + timedelta(minutes=1)
+        limit = moment + timedelta(days=366 * 4)  # 29 February on a Sunday, at worst
+        while moment < limit:
+            if moment.month not in months or not self._matches_day(moment, days, weekdays):
+                moment = moment.replace(hour=0, minute=0) + timedelta(days=1)
+            elif moment.hour not in hours:
+                moment = moment.replace(minute=0) + timedelta(hours=1)
+            elif moment.minute not in minutes:
+                moment += timedelta(minutes=1)
+            else:
+                return moment
+        raise ValueError(f"Cron schedule '{self.expression}' never fires")
+
+    def _matches_day(
+        self, moment: datetime, days: FrozenSet[int], weekdays: FrozenSet[int]
+    ) -> bool:
+        """Check the day fields, either of which matches when both are restricted (as cron)"""
+        day_matches = moment.day in days
+        weekday_matches = (moment.weekday() + 1) % 7 in weekdays
+        if len(days) == 31 or len(weekdays) == 7:
+            return day_matches and weekday_matches
+        return day_matches or weekday_matches
+
+
+@dataclass(frozen=True)
+class MaintenanceJob:
+    """
+    Maintenance Job Value Object - Single Responsibility for one scheduled task
+    """
+
+    task: str
+    schedule: str = ""  # Cron expression, empty for the task's default
+    enabled: bool = False
+
+    def __post_init__(self):
+        if self.task not in MAINTENANCE_TASKS:
+            raise ValueError(f"Unknown maintenance task '{self.task}'")
+        if not self.schedule:
+            object.__setattr__(self, "schedule", DEFAULT_TASK_SCHEDULES[self.task])
+        CronSchedule(self.schedule)
+
+    @property
+    def label(self) -> str:
+        """Get display text (Integrity audit)"""
+        return MAINTENANCE_TASK_LABELS[self.task]
+
+    @property
+    def cron(self) -> CronSchedule:
+        """Get the parsed schedule"""
+        return CronSchedule(self.schedule)
+
+    def is_due(self, last_run: Optional[datetime], now: datetime) -> bool:
+        """Check if the job should run, a job that never ran being due at once"""
+        if not self.enabled:
+            return False
+        return last_run is None or self.cron.next_run(last_run) <= now
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the library's maintenance settings"""
+        return {"task": self.task, "schedule": self.schedule, "enabled": self.enabled}
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "MaintenanceJob":
+        """Create from stored settings"""
+        return cls(
+            task=str(data["task"]),
+            schedule=str(data.get("schedule", "")),
+            enabled=bool(data.get("enabled", False)),
+        )
+
+
+@dataclass(frozen=True)
+class MaintenanceSchedule:
+    """
+    Maintenance Schedule Value Object - Single Responsibility for a library's job list
+    Every task has a job; new libraries start with all of them switched off
+    """
+
+    jobs: Tuple[MaintenanceJob, ...] = ()
+    run_on_idle: bool = False  # Also run due jobs from artists' Maya sessions
+    stale_lock_hours: int = 72
+
+    def __post_init__(self):
+        if self.stale_lock_hours < 1:
+            raise ValueError("Stale locks must be at least one hour old")
+        configured = {job.task: job for job in self.jobs}
+        object.__setattr__(
+            self,
+            "jobs",
+            tuple(configured.get(task, MaintenanceJob(task)) for task in MAINTENANCE_TASKS),
+        )
+
+    def get_job(self, task: str) -> MaintenanceJob:
+        """Get the job of a task"""
+        return next(job for job in self.jobs if job.task == task)
+
+    @property
+    def enabled_jobs(self) -> Tuple[MaintenanceJob, ...]:
+        """Get the jobs switched on"""
+        return tuple(job for job in self.jobs if job.enabled)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the library's maintenance settings"""
+        return {
+            "jobs": [job.to_dict() for job in self.jobs],
+            "run_on_idle": self.run_on_idle,
+            "stale_lock_hours": self.stale_lock_hours,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "MaintenanceSchedule":
+        """Create from the library's maintenance settings, skipping unknown tasks"""
+        jobs = [
+            MaintenanceJob.from_dict(entry)
+            for entry in data.get("jobs") or []
+            if entry.get("task") in MAINTENANCE_TASKS
+        ]
+        return cls(
+            jobs=tuple(jobs),
+            run_on_idle=bool(data.get("run_on_idle", False)),
+            stale_lock_hours=max(int(data.get("stale_lock_hours", 72)), 1),
+        )
+
+
+@dataclass(frozen=True)
+class MaintenanceResult:
+    """
+    Maintenance Result Value Object - Single Responsibility for one job run
+    """
+
+    task: str
+    started: datetime
+    finished: datetime
+    success: bool
+    summary: str
+    details: Tuple[str, ...] = field(default=(), compare=False)
+    host: str = ""  # Machine the job ran on
+    trigger: str = "schedule"  # "schedule", "idle", or "manual"
+
+    @property
+    def label(self) -> str:
+        """Get display text (Integrity audit)"""
+        return MAINTENANCE_TASK_LABELS.get(self.task, self.task)
+
+    @property
+    def duration(self) -> float:
+        """Get how long the job ran, in seconds"""
+        return (self.finished - self.started).total_seconds()
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the maintenance report"""
+        return {
+            "task": self.task,
+            "started": self.started.isoformat(),
+            "finished": self.finished.isoformat(),
+            "success": self.success,
+            "summary": self.summary,
+            "details": list(self.details),
+            "host": self.host,
+            "trigger": self.trigger,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "MaintenanceResult":
+        """Create from a maintenance report entry"""
+        return cls(
+            task=str(data["task"]),
+            started=datetime.fromisoformat(data["started"]),
+            finished=datetime.fromisoformat(data["finished"]),
+            success=bool(data.get("success", False)),
+            summary=str(data.get("summary", "")),
+            details=tuple(str(line) for line in data.get("details") or []),
+            host=str(data.get("host", "")),
+            trigger=str(data.get("trigger", "schedule")),
+        )
@@ -0,0 +1,66 @@
+# -*- coding: utf-8 -*-
+"""
+Merge Import Domain Models
+What an update in place transfers onto an imported asset, and what it did
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Tuple
+
+
+@dataclass(frozen=True)
+class MergeImportOptions:
+    """
+    Merge Import Options Value Object - Single Responsibility for update in place choices
+    Nodes stay the scene's own, so constraints, skinning, and keys on them survive
+    """
+
+    shapes: bool = True  # Copy the new mesh data into the existing shapes
+    uvs: bool = True  # Copy UVs when shapes are not copied (or their topology changed)
+    shading: bool = True  # Assign the new version's shading groups
+    add_new: bool = True  # Bring in nodes the new version added
+
+    def __post_init__(self):
+        if not (self.shapes or self.uvs or self.shading or self.add_new):
+            raise ValueError("Update in place needs something to transfer")
+
+
+@dataclass(frozen=True)
+class MergeImportReport:
+    """
+    Merge Import Report Value Object - Single Responsibility for update in place results
+    Node names are relative to the asset's root, as they are in the asset file
+    """
+
+    asset_file: Path
+    updated: Tuple[str, ...] = ()  # Meshes whose shape was replaced
+    uvs_only: Tuple[str, ...] = ()  # Meshes that only got the new UVs
+    reassigned: Tuple[str, ...] = ()  # Meshes whose shading assignments changed
+    added: Tuple[str, ...] = ()  # New nodes grafted into the existing hierarchy
+    missing: Tuple[str, ...] = ()  # Scene nodes the new version no longer has, kept
+    skipped: Tuple[str, ...] = ()  # "body: topology changed under a deformer"
+    roots: Tuple[str, ...] = ()  # Top-level nodes of the asset afterwards (full paths)
+
+    @property
+    def changed(self) -> bool:
+        """Check if anything was transferred"""
+        return bool(self.updated or self.uvs_only or self.reassigned or self.added)
+
+    def summary(self) -> str:
+        """Get display text (4 shape(s), 1 UV set(s), 2 assignment(s), 1 added, 1 skipped)"""
+        parts = []
+        for count, label in (
+            (len(self.updated), "shape(s)"),
+            (len(self.uvs_only), "UV set(s)"),
+            (len(self.reassigned), "assignment(s)"),
+            (len(self.added), "added"),
+            (len(self.missing), "missing"),
+            (len(self.skipped), "skipped"),
+        ):
+            if count:
+                parts.append(f"{count} {label}")
+        return ", ".join(parts) if parts else "nothing changed"
@@ -0,0 +1,67 @@
+# -*- coding: utf-8 -*-
+"""
+Mesh Topology Domain Model
+Fingerprint of the mesh a set of blendshape targets was sculpted on
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, List
+
+
+@dataclass(frozen=True)
+class MeshTopology:
+    """
+    Mesh Topology Value Object - Single Responsibility for comparing mesh point layouts
+    Meshes with the same fingerprint number and connect their vertices the same way
+    """
+
+    vertices: int = 0
+    edges: int = 0
+    faces: int = 0
+    connectivity_hash: str = ""  # SHA-256 of every face's vertex indices, in order
+
+    @property
+    def label(self) -> str:
+        """Get display text (5,024 verts, 10,040 edges, 5,018 faces)"""
+        return f"{self.vertices:,} verts, {self.edges:,} edges, {self.faces:,} faces"
+
+    def matches(self, other: "MeshTopology") -> bool:
+        """Check if vertex indices of both meshes mean the same points"""
+        return not self.differences(other)
+
+    def differences(self, other: "MeshTopology") -> List[str]:
+        """Get why another mesh does not match (vertex count 5024 -> 4980), [] if it does"""
+        differences = [
+            f"{name} count {mine:,} -> {theirs:,}"
+            for name, mine, theirs in (
+                ("vertex", self.vertices, other.vertices),
+                ("edge", self.edges, other.edges),
+                ("face", self.faces, other.faces),
+            )
+            if mine != theirs
+        ]
+        if not differences and self.connectivity_hash != other.connectivity_hash:
+            differences.append("same counts but vertices are connected differently")
+        return differences
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the asset and its library metadata"""
+        return {
+            "vertices": self.vertices,
+            "edges": self.edges,
+            "faces": self.faces,
+            "connectivity_hash": self.connectivity_hash,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "MeshTopology":
+        """Create topology from stored metadata"""
+        return cls(
+            vertices=int(data.get("vertices", 0)),
+            edges=int(data.get("edges", 0)),
+            faces=int(data.get("faces", 0)),
+            connectivity_hash=str(data.get("connectivity_hash", "")),
+        )
@@ -0,0 +1,111 @@
+# -*- coding: utf-8 -*-
+"""
+Metadata Field Domain Model
+One custom metadata field defined by a library schema
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+from dataclasses import dataclass
+from typing import Any, Dict, Optional, Tuple
+
+FIELD_TYPE_TEXT = "text"
+FIELD_TYPE_ENUM = "enum"
+FIELD_TYPE_NUMBER = "number"
+FIELD_TYPE_BOOLEAN = "boolean"
+FIELD_TYPES = (FIELD_TYPE_TEXT, FIELD_TYPE_ENUM, FIELD_TYPE_NUMBER, FIELD_TYPE_BOOLEAN)
+
+TRUE_WORDS = ("true", "yes", "y", "on", "1")
+FALSE_WORDS = ("false", "no", "n", "off", "0")
+
+
+@dataclass(frozen=True)
+class MetadataField:
+    """
+    Metadata Field Value Object - Single Responsibility for one schema field
+    Values are stored by field name; search filters use the key (approved_by:kim)
+    """
+
+    name: str
+    field_type: str = FIELD_TYPE_TEXT
+    options: Tuple[str, ...] = ()  # Choices of enum fields
+    description: str = ""
+
+    @property
+    def key(self) -> str:
+        """Get the search filter name ("Polycount budget" -> polycount_budget)"""
+        return re.sub(r"\W+", "_", self.name.strip().lower()).strip("_")
+
+    def coerce(self, value: Any) -> Optional[Any]:
+        """
+        Convert an entered value to the field type
+
+        Returns:
+            Typed value, None for an empty value
+
+        Raises:
+            ValueError: If the value does not fit the field
+        """
+        if value is None or (isinstance(value, str) and not value.strip()):
+            return None
+
+        if self.field_type == FIELD_TYPE_BOOLEAN:
+            if isinstance(value, bool):
+                return value
+            word = str(value).strip().lower()
+            if word in TRUE_WORDS or word in FALSE_WORDS:
+                return word in TRUE_WORDS
+            raise ValueError(f"{self.name} must be yes or no, not '{value}'")
+
+        if self.field_type == FIELD_TYPE_NUMBER:
+            try:
+                number = float(value)
+            except (TypeError, ValueError):
+                raise ValueError(f"{self.name} must be a number, not '{value}'") from None
+            return int(number) if number.is_integer() else number
+
+        text = str(value).strip()
+        if self.field_type == FIELD_TYPE_ENUM:
+            for option in self.options:
+                if option.lower() == text.lower():
+                    return option
+            raise ValueError(f"{self.name} must be one of {', '.join(self.options)}")
+        return text
+
+    def format_value(self, value: Any) -> str:
+        """Get display text of a stored value"""
+        if value is None:
+            return ""
+        if self.field_type == FIELD_TYPE_BOOLEAN:
+            return "Yes" if value else "No"
+        return str(value)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert field to dictionary for the library schema file"""
+        data: Dict[str, Any] = {"name": self.name, "type": self.field_type}
+        if self.options:
+            data["options"] = list(self.options)
+        if self.description:
+            data["description"] = self.description
+        return data
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "MetadataField":
+        """
+        Create field from the library schema file
+
+        Raises:
+            ValueError: For a missing name, an unknown type, or an enum without options
+        """
+        name = str(data.get("name", "")).strip()
+        field_type = str(data.get("type", FIELD_TYPE_TEXT)).lower()
+        options = tuple(str(option) for option in data.get("options", []) or [])
+        if not name:
+            raise ValueError("Field without a name")
+        if field_type not in FIELD_TYPES:
+            raise ValueError(f"Field '{name}' has unknown type '{field_type}'")
+        if field_type == FIELD_TYPE_ENUM and not options:
+            raise ValueError(f"Enum field '{name}' has no options")
+        return cls(name, field_type, options, str(data.get("description", "")))
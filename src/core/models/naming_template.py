@@ -0,0 +1,126 @@
+# -*- coding: utf-8 -*-
+"""
+Naming Template Domain Model
+Folder and file naming pattern that published assets must follow
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+from dataclasses import dataclass
+from typing import Dict, List, Optional
+
+from .asset_version import format_version_label
+
+# Token -> regular expression a rendered value matches
+TOKEN_PATTERNS = {
+    "assetName": r"[^/]+?",
+    "type": r"[^/]+?",
+    "category": r"[^/]+?",  # Same value as type
+    "version": r"v\d{3,}",
+    "artist": r"[^/]+?",
+    "date": r"\d{4}-\d{2}-\d{2}",
+}
+
+_TOKEN_RE = re.compile(r"\{(\w+)\}")
+_UNSAFE_CHARACTERS = re.compile(r"[^\w.-]+")
+
+
+def sanitize_token_value(value: str) -> str:
+    """Make a token value safe as one path segment (Old Crate -> Old_Crate)"""
+    return _UNSAFE_CHARACTERS.sub("_", str(value).strip()).strip("_") or "unnamed"
+
+
+@dataclass(frozen=True)
+class NamingTemplate:
+    """
+    Naming Template Value Object - Single Responsibility for one path pattern
+    Paths are relative to the library's assets folder; the publish format sets the extension
+    """
+
+    pattern: str
+
+    def __post_init__(self):
+        unknown = [token for token in self.tokens if token not in TOKEN_PATTERNS]
+        if unknown:
+            raise ValueError(
+                f"Unknown token {{{unknown[0]}}} (use one of: "
+                + ", ".join(f"{{{token}}}" for token in TOKEN_PATTERNS)
+                + ")"
+            )
+        if "assetName" not in self.tokens:
+            raise ValueError("A naming template needs the {assetName} token")
+        if self.pattern.startswith("/") or ".." in self.pattern.split("/"):
+            raise ValueError("A naming template must stay inside the assets folder")
+
+    @property
+    def tokens(self) -> List[str]:
+        """Get the tokens used, in order of first use"""
+        tokens: List[str] = []
+        for token in _TOKEN_RE.findall(self.pattern):
+            if token not in tokens:
+                tokens.append(token)
+        return tokens
+
+    @property
+    def has_version(self) -> bool:
+        """Check if every publish gets its own versioned path"""
+        return "version" in self.tokens
+
+    @property
+    def _stem_pattern(self) -> str:
+        """Get the pattern without the file extension written in it"""
+        pattern = self.pattern.strip().replace("\\", "/")
+        folder, _, name = pattern.rpartition("/")
+        name = re.sub(r"\.\w+$", "", name)
+        return f"{folder}/{name}" if folder else name
+
+    def render(self, values: Dict[str, str], extension: str, version: int = 1) -> str:
+        """
+        Get the relative path of a publish
+
+        Args:
+            values: Token values (assetName, type, artist, date)
+            extension: Publish format (.ma, .usd...)
+            version: Version number for the {version} token
+        """
+        values = {key: sanitize_token_value(value) for key, value in values.items()}
+        values.setdefault("category", values.get("type", ""))
+        values.setdefault("type", values["category"])
+        values["version"] = format_version_label(version)
+
+        def replace(match: "re.Match[str]") -> str:
+            return values.get(match.group(1)) or "unknown"
+
+        return _TOKEN_RE.sub(replace, self._stem_pattern) + extension
+
+    def match(
+        self, relative_path: str, values: Optional[Dict[str, str]] = None
+    ) -> Optional[Dict[str, str]]:
+        """
+        Get the token values of a path that follows the template
+
+        Args:
+            relative_path: Path relative to the assets folder (any extension)
+            values: Token values the path must have (e.g. the asset name)
+
+        Returns:
+            Token -> value, None when the path does not follow the template
+        """
+        expected = {key: sanitize_token_value(value) for key, value in (values or {}).items()}
+        regex = ""
+        seen: List[str] = []
+        for index, part in enumerate(_TOKEN_RE.split(self._stem_pattern)):
+            if index % 2 == 0:
+                regex += re.escape(part)
+            elif part in seen:
+                regex += f"(?P={part})"
+            elif part in expected:
+                seen.append(part)
+                regex += f"(?P<{part}>{re.escape(expected[part])})"
+            else:
+                seen.append(part)
+                regex += f"(?P<{part}>{TOKEN_PATTERNS[part]})"
+        found = re.fullmatch(regex + r"\.[^/.]+", relative_path.replace("\\", "/"))
+        return found.groupdict() if found else None
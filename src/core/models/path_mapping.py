@@ -0,0 +1,99 @@
+# -*- coding: utf-8 -*-
+"""
+Path Mapping Domain Model
+Where a shared library is mounted on each operating system, and the token stored
+paths use instead of the mount
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+import sys
+from dataclasses import dataclass, field
+from typing import Any, Dict, Optional
+
+PLATFORM_WINDOWS = "windows"
+PLATFORM_LINUX = "linux"
+PLATFORM_MACOS = "macos"
+PLATFORMS = (PLATFORM_WINDOWS, PLATFORM_LINUX, PLATFORM_MACOS)
+
+PLATFORM_LABELS = {
+    PLATFORM_WINDOWS: "Windows",
+    PLATFORM_LINUX: "Linux",
+    PLATFORM_MACOS: "macOS",
+}
+
+DEFAULT_ROOT_TOKEN = "ASSET_ROOT"
+
+_TOKEN_PATTERN = re.compile(r"^[A-Za-z_][A-Za-z0-9_]*$")
+
+
+def get_platform(system: Optional[str] = None) -> str:
+    """Get the PLATFORMS name of an operating system (sys.platform by default)"""
+    system = system or sys.platform
+    if system.startswith("win"):
+        return PLATFORM_WINDOWS
+    if system == "darwin":
+        return PLATFORM_MACOS
+    return PLATFORM_LINUX
+
+
+def normalize_root(path: str) -> str:
+    """Get a mount root with forward slashes and no trailing slash (P:/assets)"""
+    root = str(path).strip().replace("\\", "/")
+    return root if root == "/" else root.rstrip("/")
+
+
+@dataclass(frozen=True)
+class PathMapping:
+    """
+    Path Mapping Value Object - Single Responsibility for a library's mount points
+    Platforms without a root use the folder the library was opened from
+    """
+
+    token: str = DEFAULT_ROOT_TOKEN  # Environment variable stored paths start with
+    roots: Dict[str, str] = field(default_factory=dict)  # Platform -> mount root
+
+    def __post_init__(self):
+        if not _TOKEN_PATTERN.match(self.token):
+            raise ValueError(f"Invalid path token: {self.token!r} (use letters, digits, _)")
+        for platform in self.roots:
+            if platform not in PLATFORMS:
+                raise ValueError(
+                    f"Unknown platform '{platform}' (use one of: {', '.join(PLATFORMS)})"
+                )
+
+    @property
+    def variable(self) -> str:
+        """Get the token as written in stored paths ($ASSET_ROOT)"""
+        return f"${self.token}"
+
+    def get_root(self, platform: str) -> str:
+        """Get the mount root on a platform, "" when the table has none"""
+        return normalize_root(self.roots.get(platform, ""))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of the library setting"""
+        return {
+            "token": self.token,
+            "roots": {platform: self.get_root(platform) for platform in self.roots},
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "PathMapping":
+        """
+        Create from the library setting
+
+        Raises:
+            ValueError: If the token or a platform is invalid
+        """
+        roots = data.get("roots") or {}
+        return cls(
+            token=str(data.get("token") or DEFAULT_ROOT_TOKEN).strip(),
+            roots={
+                str(platform): normalize_root(root)
+                for platform, root in roots.items()
+                if str(root or "").strip()
+            },
+        )
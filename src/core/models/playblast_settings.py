@@ -0,0 +1,50 @@
+# -*- coding: utf-8 -*-
+"""
+Playblast Settings Domain Model
+Frame range, camera, and resolution of an asset's animated preview
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import asdict, dataclass, fields
+from typing import Any, Dict
+
+
+@dataclass(frozen=True)
+class PlayblastSettings:
+    """
+    Playblast Settings Value Object - Single Responsibility for preview capture settings
+    Chosen at publish time and kept in the library metadata next to the preview
+    """
+
+    start_frame: float
+    end_frame: float
+    camera: str = "persp"
+    width: int = 640
+    height: int = 360
+
+    @property
+    def frame_count(self) -> int:
+        """Get number of captured frames"""
+        if self.end_frame < self.start_frame:
+            return 0
+        return int(round(self.end_frame - self.start_frame)) + 1
+
+    @property
+    def description(self) -> str:
+        """Get settings summary (Frames 1-48 through persp, 640x360)"""
+        return (
+            f"Frames {self.start_frame:g}-{self.end_frame:g} through {self.camera}, "
+            f"{self.width}x{self.height}"
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert settings to dictionary for library metadata"""
+        return asdict(self)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "PlayblastSettings":
+        """Create settings from library metadata, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        return cls(**{key: value for key, value in data.items() if key in known})
@@ -0,0 +1,55 @@
+# -*- coding: utf-8 -*-
+"""
+Proxy Representation Domain Model
+Lightweight viewport stand-in of a library asset, swapped for full geometry to render
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Optional
+
+PROXY_GPU_CACHE = "gpu"
+PROXY_BOUNDING_BOX = "bbox"
+PROXY_STANDIN = "standin"  # Arnold aiStandIn loading an .ass or USD export at render time
+
+# Proxy kinds in order of preference, with display text; standins are for rendering
+VIEWPORT_PROXY_KINDS = (PROXY_GPU_CACHE, PROXY_BOUNDING_BOX)
+PROXY_KINDS = VIEWPORT_PROXY_KINDS + (PROXY_STANDIN,)
+PROXY_LABELS = {
+    PROXY_GPU_CACHE: "GPU cache",
+    PROXY_BOUNDING_BOX: "Bounding box",
+    PROXY_STANDIN: "Arnold standin",
+}
+
+# Files an Arnold standin can load, with display text
+STANDIN_FORMATS = (".ass", ".usd")
+STANDIN_FORMAT_LABELS = {".ass": "Arnold scene (.ass)", ".usd": "USD (.usd)"}
+
+
+@dataclass(frozen=True)
+class ProxyRepresentation:
+    """
+    Proxy Representation Value Object - Single Responsibility for one published proxy
+    GPU caches draw the real shapes cheaply; bounding boxes only show the volume;
+    standins draw a box and hand Arnold the full geometry only when it renders
+    """
+
+    kind: str
+    file_path: Path
+    author: str = ""
+    published: Optional[datetime] = None
+
+    @property
+    def label(self) -> str:
+        """Get display text (GPU cache, Arnold standin (.ass))"""
+        label = PROXY_LABELS.get(self.kind, self.kind)
+        return f"{label} ({self.file_path.suffix})" if self.kind == PROXY_STANDIN else label
+
+    @property
+    def exists(self) -> bool:
+        """Check if the proxy file is present on disk"""
+        return self.file_path.is_file()
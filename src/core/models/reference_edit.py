@@ -0,0 +1,65 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Edit Domain Model
+Reference edits that stopped applying when a reference was updated to a new version
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Tuple
+
+EDIT_KEEP = "keep"
+EDIT_DISCARD = "discard"
+EDIT_RETARGET = "retarget"
+EDIT_ACTIONS = (EDIT_KEEP, EDIT_DISCARD, EDIT_RETARGET)
+
+EDIT_ACTION_LABELS = {
+    EDIT_KEEP: "Keep",
+    EDIT_DISCARD: "Discard",
+    EDIT_RETARGET: "Retarget",
+}
+
+
+@dataclass(frozen=True)
+class ReferenceEdit:
+    """
+    Reference Edit Value Object - Single Responsibility for failed edits of one target
+    Maya removes and retargets edits by target and command, so they are grouped so too
+    """
+
+    reference_node: str
+    command: str  # MEL command of the edits: setAttr, connectAttr, parent, ...
+    target: str  # Node or plug the edits are stored under, as Maya names it
+    edits: Tuple[str, ...]  # MEL edit strings
+    node_exists: bool = False  # The node is still there, only the attribute is gone
+
+    @property
+    def node(self) -> str:
+        """Get the target node (|crate:root|crate:lid)"""
+        return self.target.split(".", 1)[0]
+
+    @property
+    def attribute(self) -> str:
+        """Get the target attribute, "" for node edits"""
+        return self.target.split(".", 1)[1] if "." in self.target else ""
+
+    @property
+    def short_name(self) -> str:
+        """Get the target without DAG path and namespace (lid.rotateX)"""
+        name = self.node.rsplit("|", 1)[-1].rsplit(":", 1)[-1]
+        return f"{name}.{self.attribute}" if self.attribute else name
+
+    @property
+    def problem(self) -> str:
+        """Get why the edits failed"""
+        if self.node_exists and self.attribute:
+            return f"Attribute {self.attribute} was removed"
+        return f"Node {self.node.rsplit('|', 1)[-1]} was renamed or removed"
+
+    @property
+    def label(self) -> str:
+        """Get display text (setAttr lid.rotateX, 2 edits)"""
+        count = f", {len(self.edits)} edits" if len(self.edits) > 1 else ""
+        return f"{self.command} {self.short_name}{count}"
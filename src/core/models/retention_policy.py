@@ -0,0 +1,120 @@
+# -*- coding: utf-8 -*-
+"""
+Version Retention Domain Models
+Which published versions a library keeps, and the versions a policy run prunes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+from .asset_version import format_version_label
+from .storage_usage import format_size
+
+
+@dataclass(frozen=True)
+class RetentionPolicy:
+    """
+    Retention Policy Value Object - Single Responsibility for a library's version limits
+    A version outside the last keep_last is pruned once older than max_wip_age_days,
+    unless it was approved; the latest version is always kept
+    """
+
+    enabled: bool = False
+    keep_last: int = 10
+    keep_approved: bool = True
+    max_wip_age_days: int = 90  # 0 prunes versions outside the last keep_last at any age
+    dry_run: bool = True  # Only report what would be pruned
+
+    def __post_init__(self):
+        if self.keep_last < 1:
+            raise ValueError("At least the latest version must be kept")
+        if self.max_wip_age_days < 0:
+            raise ValueError("The version age limit cannot be negative")
+
+    @property
+    def description(self) -> str:
+        """Get display text (Keep the last 10 versions, prune others after 90 days)"""
+        kept = f"the last {self.keep_last}" + (" and approved" if self.keep_approved else "")
+        text = f"Keep {kept} versions, "
+        if self.max_wip_age_days:
+            text += f"prune others after {self.max_wip_age_days} days"
+        else:
+            text += "prune the others"
+        return text + (" (dry run)" if self.dry_run else "")
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the library's retention settings"""
+        return {
+            "enabled": self.enabled,
+            "keep_last": self.keep_last,
+            "keep_approved": self.keep_approved,
+            "max_wip_age_days": self.max_wip_age_days,
+            "dry_run": self.dry_run,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "RetentionPolicy":
+        """Create from the library's retention settings"""
+        return cls(
+            enabled=bool(data.get("enabled", False)),
+            keep_last=max(int(data.get("keep_last", 10)), 1),
+            keep_approved=bool(data.get("keep_approved", True)),
+            max_wip_age_days=max(int(data.get("max_wip_age_days", 90)), 0),
+            dry_run=bool(data.get("dry_run", True)),
+        )
+
+
+@dataclass(frozen=True)
+class PrunedVersion:
+    """
+    Pruned Version Value Object - Single Responsibility for one version a policy removes
+    """
+
+    asset_path: Path
+    number: int
+    size_bytes: int
+    created_date: Optional[datetime] = None
+    author: str = ""
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate v003)"""
+        return f"{self.asset_path.stem} {format_version_label(self.number)}"
+
+
+@dataclass(frozen=True)
+class RetentionReport:
+    """
+    Retention Report Value Object - Single Responsibility for one policy run
+    A dry run lists the versions it would prune without removing them
+    """
+
+    policy: RetentionPolicy
+    versions: Tuple[PrunedVersion, ...] = ()
+    assets_checked: int = 0
+    versions_kept: int = 0
+    dry_run: bool = True
+    failed: Tuple[str, ...] = ()  # Versions that could not be removed, with the reason
+
+    @property
+    def freed_bytes(self) -> int:
+        """Get the bytes the pruned versions held"""
+        return sum(version.size_bytes for version in self.versions)
+
+    @property
+    def summary(self) -> str:
+        """Get display text (Would prune 12 version(s) of 4 asset(s), 3.2 GB)"""
+        assets = len({version.asset_path for version in self.versions})
+        verb = "Would prune" if self.dry_run else "Pruned"
+        text = (
+            f"{verb} {len(self.versions)} version(s) of {assets} asset(s), "
+            f"{format_size(self.freed_bytes)}"
+        )
+        if self.failed:
+            text += f", {len(self.failed)} failed"
+        return text
@@ -0,0 +1,42 @@
+# -*- coding: utf-8 -*-
+"""
+Scene Usage Domain Model
+A project scene referencing a library asset, and which version of it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from pathlib import Path
+
+from .asset_version import format_version_label
+
+
+@dataclass(frozen=True)
+class SceneUsage:
+    """
+    Scene Usage Value Object - Single Responsibility for one reference of an asset
+    A scene referencing the asset twice (two namespaces) has two usages
+    """
+
+    scene: Path
+    reference_path: str  # Path as stored in the scene
+    version: int = 0  # Pinned version snapshot, 0 for the live asset file
+    namespace: str = ""
+
+    @property
+    def is_pinned(self) -> bool:
+        """Check if the scene loads a version snapshot rather than the current file"""
+        return self.version > 0
+
+    @property
+    def version_label(self) -> str:
+        """Get version text (v003, or current)"""
+        return format_version_label(self.version) if self.is_pinned else "current"
+
+    @property
+    def label(self) -> str:
+        """Get display text (shot010.ma: crate v003)"""
+        namespace = f"{self.namespace} " if self.namespace else ""
+        return f"{self.scene.name}: {namespace}{self.version_label}"
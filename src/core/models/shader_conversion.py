@@ -0,0 +1,70 @@
+# -*- coding: utf-8 -*-
+"""
+Shader Conversion Domain Models
+Renderers, and the report of converting imported materials to the scene's renderer
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from typing import List, Tuple
+
+RENDERER_ARNOLD = "arnold"
+RENDERER_VRAY = "vray"
+RENDERER_REDSHIFT = "redshift"
+RENDERER_RENDERMAN = "renderman"
+RENDERER_MAYA = "maya"  # Maya's own nodes, understood by every renderer
+
+RENDERER_LABELS = {
+    RENDERER_ARNOLD: "Arnold",
+    RENDERER_VRAY: "V-Ray",
+    RENDERER_REDSHIFT: "Redshift",
+    RENDERER_RENDERMAN: "RenderMan",
+    RENDERER_MAYA: "Maya",
+}
+
+
+@dataclass(frozen=True)
+class UnconvertedNode:
+    """
+    Unconverted Node Value Object - Single Responsibility for one node left as it was
+    The node may still render, but not the way it did in its own renderer
+    """
+
+    node: str
+    node_type: str
+    reason: str
+
+    @property
+    def label(self) -> str:
+        """Get display text (noise1 (aiNoise): Arnold node, no V-Ray converter)"""
+        return f"{self.node} ({self.node_type}): {self.reason}"
+
+
+@dataclass
+class ShaderConversionReport:
+    """
+    Shader Conversion Report - Single Responsibility for one import's conversion results
+    """
+
+    renderer: str
+    converted: List[Tuple[str, str]] = field(default_factory=list)  # (shader, converted type)
+    unconverted: List[UnconvertedNode] = field(default_factory=list)
+
+    @property
+    def renderer_label(self) -> str:
+        """Get the target renderer's display name"""
+        return RENDERER_LABELS.get(self.renderer, self.renderer)
+
+    @property
+    def is_clean(self) -> bool:
+        """Check if nothing was left unconverted"""
+        return not self.unconverted
+
+    def summary(self) -> str:
+        """Get one-line summary for the status bar"""
+        return (
+            f"{len(self.converted)} material(s) converted to {self.renderer_label}, "
+            f"{len(self.unconverted)} node(s) not converted"
+        )
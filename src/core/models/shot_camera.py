@@ -0,0 +1,106 @@
+# -*- coding: utf-8 -*-
+"""
+Shot Camera Domain Model
+Lens, film back, animation, and image planes of a camera published to the library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import Any, Dict, Optional, Tuple
+
+MM_PER_INCH = 25.4  # Maya stores film apertures in inches
+
+
+@dataclass(frozen=True)
+class ImagePlaneInfo:
+    """Image plane attached to a shot camera"""
+
+    name: str  # Image plane node without namespace
+    image: str = ""  # Image or sequence path as stored on the node
+
+
+@dataclass(frozen=True)
+class ShotCamera:
+    """
+    Shot Camera Value Object - Single Responsibility for published camera settings
+    Kept in the library metadata so artists see the lens before loading the camera
+    """
+
+    name: str  # Camera transform without namespace or DAG path
+    focal_length: float = 35.0  # mm
+    horizontal_aperture: float = 1.417  # inches
+    vertical_aperture: float = 0.945  # inches
+    film_fit: str = "fill"
+    near_clip: float = 0.1
+    far_clip: float = 10000.0
+    start_frame: Optional[float] = None  # First key, None for a still camera
+    end_frame: Optional[float] = None
+    image_planes: Tuple[ImagePlaneInfo, ...] = ()
+
+    @property
+    def is_animated(self) -> bool:
+        """Check whether the camera or its lens has keys"""
+        return self.start_frame is not None and self.end_frame is not None
+
+    @property
+    def film_back_label(self) -> str:
+        """Get film back size in millimetres (36.0 x 24.0 mm)"""
+        width = self.horizontal_aperture * MM_PER_INCH
+        height = self.vertical_aperture * MM_PER_INCH
+        return f"{width:.1f} x {height:.1f} mm"
+
+    @property
+    def frame_range_label(self) -> str:
+        """Get animated frame range (1001-1120), "still" when the camera has no keys"""
+        if not self.is_animated:
+            return "still"
+        return f"{self.start_frame:g}-{self.end_frame:g}"
+
+    @property
+    def description(self) -> str:
+        """Get settings summary (35mm lens, 36.0 x 24.0 mm film back, frames 1001-1120)"""
+        frames = f"frames {self.frame_range_label}" if self.is_animated else "still"
+        planes = f", {len(self.image_planes)} image plane(s)" if self.image_planes else ""
+        return (
+            f"{self.focal_length:g}mm lens, {self.film_back_label} film back, {frames}{planes}"
+        )
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert settings to dictionary for library metadata"""
+        return {
+            "name": self.name,
+            "focal_length": self.focal_length,
+            "horizontal_aperture": self.horizontal_aperture,
+            "vertical_aperture": self.vertical_aperture,
+            "film_fit": self.film_fit,
+            "near_clip": self.near_clip,
+            "far_clip": self.far_clip,
+            "start_frame": self.start_frame,
+            "end_frame": self.end_frame,
+            "image_planes": [
+                {"name": plane.name, "image": plane.image} for plane in self.image_planes
+            ],
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "ShotCamera":
+        """Create settings from library metadata"""
+        start_frame = data.get("start_frame")
+        end_frame = data.get("end_frame")
+        return cls(
+            name=str(data["name"]),
+            focal_length=float(data.get("focal_length", 35.0)),
+            horizontal_aperture=float(data.get("horizontal_aperture", 1.417)),
+            vertical_aperture=float(data.get("vertical_aperture", 0.945)),
+            film_fit=str(data.get("film_fit", "fill")),
+            near_clip=float(data.get("near_clip", 0.1)),
+            far_clip=float(data.get("far_clip", 10000.0)),
+            start_frame=float(start_frame) if start_frame is not None else None,
+            end_frame=float(end_frame) if end_frame is not None else None,
+            image_planes=tuple(
+                ImagePlaneInfo(str(plane["name"]), str(plane.get("image", "")))
+                for plane in data.get("image_planes", [])
+            ),
+        )
@@ -0,0 +1,51 @@
+# -*- coding: utf-8 -*-
+"""
+Show Context Domain Model
+The show an artist is working on, and the asset tags that put assets in it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass
+from typing import List
+
+SHOW_TAG_PREFIX = "show:"
+SHOW_TAG_ALL = "show:all"  # Shared assets, listed on every show
+
+
+def get_show_tags(tags: List[str]) -> List[str]:
+    """Get the show tags among an asset's tags (lowercase)"""
+    return [
+        tag.strip().lower() for tag in tags if tag.strip().lower().startswith(SHOW_TAG_PREFIX)
+    ]
+
+
+@dataclass(frozen=True)
+class ShowContext:
+    """
+    Show Context Value Object - Single Responsibility for the current show
+    Show names compare without case, as tags are typed by hand
+    """
+
+    show: str
+    source: str  # Where it was read (ASSETMANAGER_SHOW, Maya workspace)
+
+    def __post_init__(self):
+        if not self.show.strip() or ":" in self.show:
+            raise ValueError(f"Invalid show name: {self.show!r}")
+
+    @property
+    def tag(self) -> str:
+        """Get the tag of assets for this show (show:gotham)"""
+        return f"{SHOW_TAG_PREFIX}{self.show.strip().lower()}"
+
+    @property
+    def description(self) -> str:
+        """Get display text (gotham, from ASSETMANAGER_SHOW)"""
+        return f"{self.show}, from {self.source}"
+
+    def includes(self, tags: List[str]) -> bool:
+        """Check if an asset with these tags belongs on the show"""
+        show_tags = get_show_tags(tags)
+        return self.tag in show_tags or SHOW_TAG_ALL in show_tags
@@ -0,0 +1,224 @@
+# -*- coding: utf-8 -*-
+"""
+Storage Usage Domain Models
+Disk use of a library by category and asset, its growth, and the quotas it is held to
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import date, datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+GIGABYTE = 1024**3
+
+SCOPE_LIBRARY = "library"
+SCOPE_CATEGORY = "category"
+SCOPE_ASSET = "asset"
+
+
+def format_size(size: float) -> str:
+    """Get a short size label (12.4 MB)"""
+    if size < 1024:
+        return f"{size:.0f} B"
+    for unit in ("KB", "MB", "GB"):
+        size /= 1024
+        if size < 1024:
+            return f"{size:.1f} {unit}"
+    return f"{size / 1024:.1f} TB"
+
+
+@dataclass(frozen=True)
+class QuotaWarning:
+    """
+    Quota Warning Value Object - Single Responsibility for one limit reached or passed
+    """
+
+    scope: str  # SCOPE_LIBRARY, SCOPE_CATEGORY, or SCOPE_ASSET
+    name: str  # Library, category, or asset name
+    used_bytes: int
+    limit_bytes: int
+
+    @property
+    def percent(self) -> float:
+        """Get the share of the limit in use"""
+        return 100.0 * self.used_bytes / self.limit_bytes
+
+    @property
+    def is_exceeded(self) -> bool:
+        """Check if the limit is passed, not only approached"""
+        return self.used_bytes > self.limit_bytes
+
+    @property
+    def description(self) -> str:
+        """Get display text (Category 'props' uses 82.0 GB of 50.0 GB (164%))"""
+        label = {SCOPE_LIBRARY: "Library", SCOPE_CATEGORY: "Category", SCOPE_ASSET: "Asset"}
+        return (
+            f"{label.get(self.scope, self.scope)} '{self.name}' uses "
+            f"{format_size(self.used_bytes)} of {format_size(self.limit_bytes)} "
+            f"({self.percent:.0f}%)"
+        )
+
+
+@dataclass(frozen=True)
+class StorageQuota:
+    """
+    Storage Quota Value Object - Single Responsibility for a library's size limits
+    Limits are in gigabytes, 0 for none; warnings start at warn_percent of a limit
+    """
+
+    library_gb: float = 0.0
+    asset_gb: float = 0.0  # One asset with its versions, caches, and thumbnails
+    category_gb: Dict[str, float] = field(default_factory=dict)
+    warn_percent: int = 90
+
+    def __post_init__(self):
+        if not 1 <= self.warn_percent <= 100:
+            raise ValueError("Quota warnings start between 1% and 100% of a limit")
+        if self.library_gb < 0 or self.asset_gb < 0 or any(
+            limit < 0 for limit in self.category_gb.values()
+        ):
+            raise ValueError("Quota limits cannot be negative")
+
+    @property
+    def is_set(self) -> bool:
+        """Check if any limit is configured"""
+        return bool(self.library_gb or self.asset_gb or any(self.category_gb.values()))
+
+    def check(self, scope: str, name: str, used_bytes: int) -> Optional[QuotaWarning]:
+        """Get the warning for a library, category, or asset size, None below the threshold"""
+        if scope == SCOPE_LIBRARY:
+            limit_gb = self.library_gb
+        elif scope == SCOPE_ASSET:
+            limit_gb = self.asset_gb
+        else:
+            limit_gb = self.category_gb.get(name, 0.0)
+        if not limit_gb:
+            return None
+        limit_bytes = int(limit_gb * GIGABYTE)
+        if used_bytes * 100 < limit_bytes * self.warn_percent:
+            return None
+        return QuotaWarning(scope, name, used_bytes, limit_bytes)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the library's quota settings"""
+        return {
+            "library_gb": self.library_gb,
+            "asset_gb": self.asset_gb,
+            "category_gb": {name: limit for name, limit in sorted(self.category_gb.items())},
+            "warn_percent": self.warn_percent,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "StorageQuota":
+        """Create from the library's quota settings"""
+        return cls(
+            library_gb=max(float(data.get("library_gb", 0.0)), 0.0),
+            asset_gb=max(float(data.get("asset_gb", 0.0)), 0.0),
+            category_gb={
+                str(name): max(float(limit), 0.0)
+                for name, limit in (data.get("category_gb") or {}).items()
+            },
+            warn_percent=min(max(int(data.get("warn_percent", 90)), 1), 100),
+        )
+
+
+@dataclass(frozen=True)
+class AssetUsage:
+    """
+    Asset Usage Value Object - Single Responsibility for the disk use of one asset
+    """
+
+    asset_path: Path
+    category: str
+    size_bytes: int  # Asset file with its versions, caches, dependencies, and thumbnails
+    file_count: int = 0
+
+    @property
+    def name(self) -> str:
+        """Get the asset name"""
+        return self.asset_path.stem
+
+
+@dataclass(frozen=True)
+class UsageSnapshot:
+    """
+    Usage Snapshot Value Object - Single Responsibility for a library's size on one day
+    """
+
+    day: date
+    total_bytes: int
+    asset_count: int
+    category_bytes: Dict[str, int] = field(default_factory=dict, compare=False)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the storage history"""
+        return {
+            "day": self.day.isoformat(),
+            "total_bytes": self.total_bytes,
+            "asset_count": self.asset_count,
+            "category_bytes": dict(self.category_bytes),
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "UsageSnapshot":
+        """Create from a storage history entry"""
+        return cls(
+            day=date.fromisoformat(data["day"]),
+            total_bytes=int(data.get("total_bytes", 0)),
+            asset_count=int(data.get("asset_count", 0)),
+            category_bytes={
+                str(name): int(size) for name, size in (data.get("category_bytes") or {}).items()
+            },
+        )
+
+
+@dataclass(frozen=True)
+class StorageReport:
+    """
+    Storage Report Value Object - Single Responsibility for one measurement of a library
+    The total includes files outside categories (trash, database, settings)
+    """
+
+    library_name: str
+    measured: datetime
+    total_bytes: int
+    category_bytes: Dict[str, int] = field(default_factory=dict)
+    assets: Tuple[AssetUsage, ...] = ()
+    history: Tuple[UsageSnapshot, ...] = ()  # Oldest first, today's included
+    warnings: Tuple[QuotaWarning, ...] = ()
+
+    def get_largest(self, count: int = 20) -> List[AssetUsage]:
+        """Get the biggest assets, largest first"""
+        return sorted(self.assets, key=lambda usage: usage.size_bytes, reverse=True)[:count]
+
+    def get_category_assets(self, category: str) -> List[AssetUsage]:
+        """Get the assets of one category"""
+        return [usage for usage in self.assets if usage.category == category]
+
+    def get_growth(self, days: int) -> Optional[int]:
+        """Get how many bytes the library grew over the last days, None without history"""
+        if not self.history:
+            return None
+        latest = self.history[-1]
+        earlier = [
+            snapshot for snapshot in self.history if (latest.day - snapshot.day).days >= days
+        ]
+        if not earlier:
+            return None
+        return latest.total_bytes - earlier[-1].total_bytes
+
+    @property
+    def exceeded(self) -> List[QuotaWarning]:
+        """Get the warnings for limits already passed"""
+        return [warning for warning in self.warnings if warning.is_exceeded]
+
+    @property
+    def summary(self) -> str:
+        """Get display text (1,204 asset(s), 312.4 GB, 2 quota warning(s))"""
+        text = f"{len(self.assets):,} asset(s), {format_size(self.total_bytes)}"
+        if self.warnings:
+            text += f", {len(self.warnings)} quota warning(s)"
+        return text
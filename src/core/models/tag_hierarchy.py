@@ -0,0 +1,105 @@
+# -*- coding: utf-8 -*-
+"""
+Tag Hierarchy Domain Model
+Slash separated tags (environment/exterior/forest) and the tree they form
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import re
+from dataclasses import dataclass, field
+from typing import Dict, Iterable, List, Tuple
+
+TAG_SEPARATOR = "/"
+
+_SEGMENT_SEPARATORS = re.compile(r"\s*[/\\]+\s*")
+_WHITESPACE = re.compile(r"\s+")
+
+
+def normalize_tag(text: str) -> str:
+    """Clean up a typed tag (' Environment / exterior ' -> 'Environment/exterior')"""
+    segments = _SEGMENT_SEPARATORS.split(str(text).strip())
+    return TAG_SEPARATOR.join(
+        _WHITESPACE.sub(" ", segment) for segment in segments if segment.strip()
+    )
+
+
+def parse_tags(text: str) -> List[str]:
+    """Get the tags of a comma separated list, without blanks or duplicates"""
+    tags: List[str] = []
+    for part in str(text).split(","):
+        tag = normalize_tag(part)
+        if tag and tag.lower() not in (known.lower() for known in tags):
+            tags.append(tag)
+    return tags
+
+
+def tag_ancestors(tag: str) -> List[str]:
+    """Get the parent tags of a tag, outermost first (a/b/c -> a, a/b)"""
+    segments = tag.split(TAG_SEPARATOR)
+    return [TAG_SEPARATOR.join(segments[:depth]) for depth in range(1, len(segments))]
+
+
+def is_tag_within(tag: str, parent: str) -> bool:
+    """Check if a tag is the parent tag or below it (case-insensitive)"""
+    tag, parent = tag.lower(), parent.lower()
+    return tag == parent or tag.startswith(parent + TAG_SEPARATOR)
+
+
+def reparent_tag(tag: str, old_parent: str, new_parent: str) -> str:
+    """Move a tag at or below old_parent under new_parent (a/b with a -> x gives x/b)"""
+    return new_parent + tag[len(old_parent) :]
+
+
+@dataclass(frozen=True)
+class TagNode:
+    """
+    Tag Node Value Object - Single Responsibility for one level of the tag tree
+    Parent tags nobody uses directly still get a node so the tree stays connected
+    """
+
+    name: str  # Last segment (forest)
+    path: str  # Full tag (environment/exterior/forest)
+    uses: int = 0  # Assets carrying exactly this tag
+    children: Tuple["TagNode", ...] = field(default_factory=tuple)
+
+    @property
+    def total_uses(self) -> int:
+        """Get the uses of this tag and every tag below it"""
+        return self.uses + sum(child.total_uses for child in self.children)
+
+
+def build_tag_tree(tag_uses: Dict[str, int], extra_tags: Iterable[str] = ()) -> List[TagNode]:
+    """
+    Arrange tags into a tree sorted by name
+
+    Args:
+        tag_uses: Tag -> number of assets using it
+        extra_tags: Known tags no asset uses yet (the tag registry)
+    """
+    uses: Dict[str, int] = {}
+    for tag, count in tag_uses.items():
+        uses[tag] = uses.get(tag, 0) + count
+    for tag in extra_tags:
+        uses.setdefault(tag, 0)
+
+    for tag in list(uses):
+        for parent in tag_ancestors(tag):
+            uses.setdefault(parent, 0)
+    children: Dict[str, List[str]] = {}
+    for tag in uses:
+        parent = tag.rpartition(TAG_SEPARATOR)[0]
+        children.setdefault(parent, []).append(tag)
+
+    def build(path: str) -> TagNode:
+        return TagNode(
+            name=path.rpartition(TAG_SEPARATOR)[2],
+            path=path,
+            uses=uses[path],
+            children=tuple(
+                build(child) for child in sorted(children.get(path, []), key=str.lower)
+            ),
+        )
+
+    return [build(tag) for tag in sorted(children.get("", []), key=str.lower)]
@@ -0,0 +1,156 @@
+# -*- coding: utf-8 -*-
+"""
+Tag Rule Domain Model
+Condition on a publish that adds tags to the asset without the artist typing them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import fnmatch
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Dict, Iterable, Optional, Tuple
+
+from .tag_hierarchy import normalize_tag
+
+
+@dataclass(frozen=True)
+class TagRule:
+    """
+    Tag Rule Value Object - Single Responsibility for matching one publish
+    Every condition that is set must hold; node types match when any of them is present
+    """
+
+    tags: Tuple[str, ...]
+    name_pattern: str = ""  # Glob on the asset name (chr_*), any case
+    node_types: Tuple[str, ...] = ()  # Node type globs (skinCluster, pgYeti*)
+    min_triangles: Optional[int] = None
+    max_triangles: Optional[int] = None
+    source_directory: str = ""  # Glob on the published scene's folder (*/characters/*)
+
+    def __post_init__(self):
+        tags = tuple(dict.fromkeys(tag for tag in map(normalize_tag, self.tags) if tag))
+        object.__setattr__(self, "tags", tags)
+        node_types = tuple(node_type.strip() for node_type in self.node_types if node_type.strip())
+        object.__setattr__(self, "node_types", node_types)
+        if not tags:
+            raise ValueError("A tag rule needs at least one tag to add")
+        if not self.has_conditions:
+            raise ValueError(f"The rule adding {', '.join(tags)} has no condition")
+        if (
+            self.min_triangles is not None
+            and self.max_triangles is not None
+            and self.min_triangles > self.max_triangles
+        ):
+            raise ValueError(f"The rule adding {', '.join(tags)} has min above max triangles")
+
+    @property
+    def has_conditions(self) -> bool:
+        """Check if the rule tests anything - a rule without conditions would tag everything"""
+        return bool(
+            self.name_pattern.strip()
+            or self.node_types
+            or self.min_triangles is not None
+            or self.max_triangles is not None
+            or self.source_directory.strip()
+        )
+
+    @property
+    def uses_triangles(self) -> bool:
+        """Check if the rule needs the published triangle count"""
+        return self.min_triangles is not None or self.max_triangles is not None
+
+    @property
+    def description(self) -> str:
+        """Get display text (chr_* with skinCluster -> rigged)"""
+        conditions = []
+        if self.name_pattern.strip():
+            conditions.append(f"named {self.name_pattern.strip()}")
+        if self.node_types:
+            conditions.append(f"with {' or '.join(self.node_types)}")
+        if self.min_triangles is not None:
+            conditions.append(f"at least {self.min_triangles:,} tris")
+        if self.max_triangles is not None:
+            conditions.append(f"at most {self.max_triangles:,} tris")
+        if self.source_directory.strip():
+            conditions.append(f"from {self.source_directory.strip()}")
+        return f"{', '.join(conditions)} -> {', '.join(self.tags)}"
+
+    def matches(
+        self,
+        asset_name: str,
+        node_types: Iterable[str] = (),
+        triangles: Optional[int] = None,
+        source_file: Optional[Path] = None,
+    ) -> bool:
+        """
+        Check if a publish meets every condition of the rule
+
+        Args:
+            asset_name: Name the asset is published as
+            node_types: Node types in the published nodes and their history
+            triangles: Published triangle count, None when not measured
+            source_file: Scene the publish comes from, None when unsaved
+        """
+        pattern = self.name_pattern.strip()
+        if pattern and not fnmatch.fnmatch(asset_name.lower(), pattern.lower()):
+            return False
+        if self.node_types:
+            present = {node_type.lower() for node_type in node_types}
+            if not any(fnmatch.filter(present, wanted.lower()) for wanted in self.node_types):
+                return False
+        if self.uses_triangles:
+            if triangles is None:
+                return False
+            if self.min_triangles is not None and triangles < self.min_triangles:
+                return False
+            if self.max_triangles is not None and triangles > self.max_triangles:
+                return False
+        directory = self.source_directory.strip().replace("\\", "/")
+        if directory:
+            if source_file is None:
+                return False
+            folder = Path(source_file).parent.as_posix().lower()
+            if not fnmatch.fnmatch(folder, directory.lower().rstrip("/")):
+                return False
+        return True
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the dict stored in the library's tag rules, leaving out unset conditions"""
+        data: Dict[str, Any] = {"tags": list(self.tags)}
+        if self.name_pattern.strip():
+            data["name"] = self.name_pattern.strip()
+        if self.node_types:
+            data["node_types"] = list(self.node_types)
+        if self.min_triangles is not None:
+            data["min_triangles"] = self.min_triangles
+        if self.max_triangles is not None:
+            data["max_triangles"] = self.max_triangles
+        if self.source_directory.strip():
+            data["source_directory"] = self.source_directory.strip()
+        return data
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "TagRule":
+        """
+        Create a rule from stored settings
+
+        Raises:
+            ValueError: If the rule adds no tags, tests nothing, or has invalid counts
+        """
+
+        def count(key: str) -> Optional[int]:
+            value = data.get(key)
+            return None if value in (None, "") else int(value)
+
+        tags = data.get("tags") or []
+        node_types = data.get("node_types") or []
+        return cls(
+            tags=tuple([tags] if isinstance(tags, str) else tags),
+            name_pattern=str(data.get("name", "")),
+            node_types=tuple([node_types] if isinstance(node_types, str) else node_types),
+            min_triangles=count("min_triangles"),
+            max_triangles=count("max_triangles"),
+            source_directory=str(data.get("source_directory", "")),
+        )
@@ -0,0 +1,71 @@
+# -*- coding: utf-8 -*-
+"""
+Trash Entry Domain Model
+Deleted asset kept in the library trash until it is restored or purged
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+
+@dataclass(frozen=True)
+class TrashEntry:
+    """
+    Trash Entry Value Object - Single Responsibility for soft-delete records
+    Paths are relative to the library root so a remounted library can still restore
+    """
+
+    entry_id: str
+    asset_path: str  # Asset file relative to the library, posix separators
+    files: Tuple[str, ...] = ()  # Everything moved: the asset, thumbnails, versions, LODs
+    deleted_by: str = ""
+    deleted_date: Optional[datetime] = None
+    size: int = 0  # Bytes held in the trash
+    metadata: Dict[str, Any] = field(default_factory=dict)  # Library database row
+
+    @property
+    def name(self) -> str:
+        """Get the asset name shown in the trash"""
+        return Path(self.asset_path).stem
+
+    def get_expiry(self, retention_days: int) -> Optional[datetime]:
+        """Get when automatic purging removes this entry, None if it is kept until purged"""
+        if retention_days <= 0 or self.deleted_date is None:
+            return None
+        return self.deleted_date + timedelta(days=retention_days)
+
+    def is_expired(self, retention_days: int, now: Optional[datetime] = None) -> bool:
+        """Check if the entry is older than the library's retention period"""
+        expiry = self.get_expiry(retention_days)
+        return expiry is not None and (now or datetime.now()) >= expiry
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert entry to dictionary for the trash record file"""
+        return {
+            "entry_id": self.entry_id,
+            "asset_path": self.asset_path,
+            "files": list(self.files),
+            "deleted_by": self.deleted_by,
+            "deleted_date": self.deleted_date.isoformat() if self.deleted_date else None,
+            "size": self.size,
+            "metadata": self.metadata,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "TrashEntry":
+        """Create entry from a trash record dictionary"""
+        deleted = data.get("deleted_date")
+        return cls(
+            entry_id=data["entry_id"],
+            asset_path=data["asset_path"],
+            files=tuple(data.get("files", [])),
+            deleted_by=data.get("deleted_by", ""),
+            deleted_date=datetime.fromisoformat(deleted) if deleted else None,
+            size=int(data.get("size", 0)),
+            metadata=dict(data.get("metadata") or {}),
+        )
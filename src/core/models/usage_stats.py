@@ -0,0 +1,179 @@
+# -*- coding: utf-8 -*-
+"""
+Usage Statistics Domain Models
+How often a library's assets are imported and its artists publish, counted locally
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field, replace
+from datetime import date, timedelta
+from typing import Any, Dict, List, Optional, Tuple
+
+# Days the publish frequency looks back by default
+PUBLISH_WINDOW_DAYS = 90
+
+
+@dataclass(frozen=True)
+class CategoryUse:
+    """
+    Category Use Value Object - Single Responsibility for how much of a category is used
+    """
+
+    name: str
+    asset_count: int
+    used_count: int  # Assets imported at least once
+    import_count: int
+
+    @property
+    def is_neglected(self) -> bool:
+        """Check if none of the category's assets were imported"""
+        return self.asset_count > 0 and self.used_count == 0
+
+    @property
+    def used_percent(self) -> float:
+        """Get the share of the category's assets that were imported"""
+        return 100.0 * self.used_count / self.asset_count if self.asset_count else 0.0
+
+
+@dataclass(frozen=True)
+class PublisherActivity:
+    """
+    Publisher Activity Value Object - Single Responsibility for one artist's publishing
+    """
+
+    user: str
+    publish_count: int  # Publishes in the window
+    window_days: int
+    last_publish: Optional[date] = None
+
+    @property
+    def per_week(self) -> float:
+        """Get the average publishes per week over the window"""
+        return 7.0 * self.publish_count / self.window_days if self.window_days else 0.0
+
+
+@dataclass(frozen=True)
+class UsageStats:
+    """
+    Usage Stats Value Object - Single Responsibility for a library's usage counters
+    Nothing is counted until a library admin opts the library in
+    """
+
+    enabled: bool = False
+    since: Optional[date] = None  # Day counting started
+    imports: Dict[str, int] = field(default_factory=dict)  # Asset key -> imports
+    last_imports: Dict[str, date] = field(default_factory=dict)  # Asset key -> last day
+    publishes: Dict[str, Dict[date, int]] = field(default_factory=dict)  # User -> day -> count
+
+    @property
+    def import_count(self) -> int:
+        """Get the imports counted across the library"""
+        return sum(self.imports.values())
+
+    @property
+    def publish_count(self) -> int:
+        """Get the publishes counted across the library"""
+        return sum(sum(days.values()) for days in self.publishes.values())
+
+    def with_import(self, asset_key: str, day: date) -> "UsageStats":
+        """Get the stats with one more import of an asset"""
+        imports = dict(self.imports)
+        imports[asset_key] = imports.get(asset_key, 0) + 1
+        last = max(day, self.last_imports.get(asset_key, day))
+        return replace(self, imports=imports, last_imports={**self.last_imports, asset_key: last})
+
+    def with_publish(self, user: str, day: date) -> "UsageStats":
+        """Get the stats with one more publish by an artist"""
+        days = dict(self.publishes.get(user, {}))
+        days[day] = days.get(day, 0) + 1
+        return replace(self, publishes={**self.publishes, user: days})
+
+    def get_most_imported(self, count: int = 50) -> List[Tuple[str, int]]:
+        """Get (asset key, imports) of the most imported assets, most first"""
+        ranked = sorted(self.imports.items(), key=lambda item: (-item[1], item[0]))
+        return ranked[:count]
+
+    def get_tag_use(self, tags_by_asset: Dict[str, List[str]]) -> List[Tuple[str, int]]:
+        """
+        Get (tag, imports) of the tags on imported assets, most used first
+
+        Args:
+            tags_by_asset: Asset key -> tags, from the library metadata
+        """
+        counts: Dict[str, int] = {}
+        for asset_key, imports in self.imports.items():
+            for tag in set(tags_by_asset.get(asset_key) or []):
+                counts[tag] = counts.get(tag, 0) + imports
+        return sorted(counts.items(), key=lambda item: (-item[1], item[0]))
+
+    def get_publishers(
+        self, window_days: int = PUBLISH_WINDOW_DAYS, today: Optional[date] = None
+    ) -> List[PublisherActivity]:
+        """Get every artist's publishes over the last days, most active first"""
+        first_day = (today or date.today()) - timedelta(days=window_days - 1)
+        activities = [
+            PublisherActivity(
+                user=user,
+                publish_count=sum(count for day, count in days.items() if day >= first_day),
+                window_days=window_days,
+                last_publish=max(days) if days else None,
+            )
+            for user, days in self.publishes.items()
+        ]
+        return sorted(activities, key=lambda activity: (-activity.publish_count, activity.user))
+
+    def get_category_use(self, asset_categories: Dict[str, str]) -> List[CategoryUse]:
+        """
+        Get how much each category is used, the most neglected first
+
+        Args:
+            asset_categories: Asset key -> category of every asset in the library
+        """
+        assets: Dict[str, List[str]] = {}
+        for asset_key, category in asset_categories.items():
+            assets.setdefault(category, []).append(asset_key)
+        uses = [
+            CategoryUse(
+                name=category,
+                asset_count=len(keys),
+                used_count=sum(1 for key in keys if self.imports.get(key)),
+                import_count=sum(self.imports.get(key, 0) for key in keys),
+            )
+            for category, keys in assets.items()
+        ]
+        return sorted(uses, key=lambda use: (use.used_percent, use.import_count, use.name))
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the library's usage statistics file"""
+        return {
+            "enabled": self.enabled,
+            "since": self.since.isoformat() if self.since else None,
+            "imports": dict(sorted(self.imports.items())),
+            "last_imports": {
+                key: day.isoformat() for key, day in sorted(self.last_imports.items())
+            },
+            "publishes": {
+                user: {day.isoformat(): count for day, count in sorted(days.items())}
+                for user, days in sorted(self.publishes.items())
+            },
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "UsageStats":
+        """Create from the library's usage statistics file"""
+        since = data.get("since")
+        return cls(
+            enabled=bool(data.get("enabled", False)),
+            since=date.fromisoformat(since) if since else None,
+            imports={str(key): int(count) for key, count in (data.get("imports") or {}).items()},
+            last_imports={
+                str(key): date.fromisoformat(day)
+                for key, day in (data.get("last_imports") or {}).items()
+            },
+            publishes={
+                str(user): {date.fromisoformat(day): int(count) for day, count in days.items()}
+                for user, days in (data.get("publishes") or {}).items()
+            },
+        )
@@ -0,0 +1,60 @@
+# -*- coding: utf-8 -*-
+"""
+User Identity Domain Model
+The studio identity an artist signed in with, used for publish records, locks, and roles
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, fields
+from datetime import datetime
+from typing import Any, Dict, Optional, Tuple
+
+
+@dataclass(frozen=True)
+class UserIdentity:
+    """
+    User Identity Value Object - Single Responsibility for who is using the library
+    Workstation logins are unverified; directory and OAuth sign-ins are verified
+    """
+
+    user_name: str  # Studio login, the name in permissions.json and publish records
+    display_name: str = ""
+    email: str = ""
+    groups: Tuple[str, ...] = ()  # Directory or identity provider groups
+    backend: str = "os"  # Sign-in backend that verified the artist
+    verified: bool = False
+    signed_in: str = ""  # ISO time of the sign-in
+    expires: str = ""  # ISO time the session ends, "" never
+    os_user: str = ""  # Workstation login the artist signed in on
+
+    @property
+    def label(self) -> str:
+        """Get the name shown in the status bar: Kim Lee (klee)"""
+        if self.display_name and self.display_name != self.user_name:
+            return f"{self.display_name} ({self.user_name})"
+        return self.user_name
+
+    def is_expired(self, now: Optional[datetime] = None) -> bool:
+        """Check if the session has ended"""
+        if not self.expires:
+            return False
+        try:
+            return (now or datetime.now()) >= datetime.fromisoformat(self.expires)
+        except ValueError:
+            return True  # An unreadable end time never keeps a session open
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to a dictionary for the session file"""
+        data = {f.name: getattr(self, f.name) for f in fields(self)}
+        data["groups"] = list(self.groups)
+        return data
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "UserIdentity":
+        """Create from the session file, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        values = {key: value for key, value in data.items() if key in known}
+        values["groups"] = tuple(values.get("groups") or ())
+        return cls(**values)
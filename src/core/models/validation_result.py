@@ -0,0 +1,71 @@
+# -*- coding: utf-8 -*-
+"""
+Validation Result Domain Models
+Issues found by pre-publish checks and the report shown before publishing
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field
+from typing import List, Tuple
+
+SEVERITY_ERROR = "error"  # blocks the publish
+SEVERITY_WARNING = "warning"  # artist may publish anyway
+
+
+@dataclass(frozen=True)
+class ValidationIssue:
+    """
+    Validation Issue Value Object - Single Responsibility for one finding
+    Nodes are full DAG paths so the UI can select them in the scene
+    """
+
+    check_id: str
+    check_name: str
+    severity: str
+    message: str
+    nodes: Tuple[str, ...] = ()
+
+    @property
+    def is_error(self) -> bool:
+        """Check if this issue blocks publishing"""
+        return self.severity == SEVERITY_ERROR
+
+
+@dataclass
+class ValidationReport:
+    """
+    Validation Report - Single Responsibility for aggregating check results
+    """
+
+    issues: List[ValidationIssue] = field(default_factory=list)
+    checks_run: List[str] = field(default_factory=list)
+    failed_checks: List[str] = field(default_factory=list)  # checks that raised
+
+    @property
+    def errors(self) -> List[ValidationIssue]:
+        """Get blocking issues"""
+        return [issue for issue in self.issues if issue.is_error]
+
+    @property
+    def warnings(self) -> List[ValidationIssue]:
+        """Get non-blocking issues"""
+        return [issue for issue in self.issues if not issue.is_error]
+
+    @property
+    def can_publish(self) -> bool:
+        """Check if the publish may go ahead (warnings only)"""
+        return not self.errors
+
+    @property
+    def is_clean(self) -> bool:
+        """Check if every check passed without findings"""
+        return not self.issues and not self.failed_checks
+
+    def summary(self) -> str:
+        """Get one-line summary for the status bar"""
+        return (
+            f"{len(self.checks_run)} checks: {len(self.errors)} error(s), "
+            f"{len(self.warnings)} warning(s)"
+        )
@@ -0,0 +1,110 @@
+# -*- coding: utf-8 -*-
+"""
+Vendor License Domain Models
+License terms of purchased asset packs kept as read-only vendor libraries
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, field, fields, replace
+from typing import Any, Dict
+
+
+@dataclass(frozen=True)
+class VendorLicense:
+    """
+    Vendor License Value Object - Single Responsibility for the terms of vendor assets
+    """
+
+    vendor: str = ""  # Who sold the assets (KitBash3D)
+    license_type: str = ""  # As the vendor names it (Royalty Free, Editorial Only)
+    source_url: str = ""  # Where the pack and its license text come from
+    attribution: str = ""  # Credit the license requires, "" when none is needed
+    redistributable: bool = False  # May be delivered to clients inside bundles
+
+    @property
+    def is_restrictive(self) -> bool:
+        """Check if the assets must not leave the studio"""
+        return not self.redistributable
+
+    @property
+    def label(self) -> str:
+        """Get display text (KitBash3D - Royalty Free)"""
+        parts = [self.vendor or "Unknown vendor", self.license_type or "license not recorded"]
+        return " - ".join(parts)
+
+    def with_overrides(self, data: Dict[str, Any]) -> "VendorLicense":
+        """Get a copy with the terms a single asset or folder changes"""
+        known = {terms_field.name for terms_field in fields(self)}
+        changes = {key: value for key, value in data.items() if key in known}
+        if "redistributable" in changes:
+            changes["redistributable"] = bool(changes["redistributable"])
+        return replace(self, **changes)
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of vendor.json"""
+        return {
+            "vendor": self.vendor,
+            "license_type": self.license_type,
+            "source_url": self.source_url,
+            "attribution": self.attribution,
+            "redistributable": self.redistributable,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "VendorLicense":
+        """Create from vendor.json or a bundle manifest entry"""
+        return cls(
+            vendor=str(data.get("vendor") or ""),
+            license_type=str(data.get("license_type") or ""),
+            source_url=str(data.get("source_url") or ""),
+            attribution=str(data.get("attribution") or ""),
+            redistributable=bool(data.get("redistributable", False)),
+        )
+
+
+@dataclass(frozen=True)
+class VendorLibrary:
+    """
+    Vendor Library Value Object - Single Responsibility for a pack's license terms
+    Assets and folders can change some of the pack's terms, the longest path winning
+    """
+
+    license: VendorLicense = VendorLicense()
+    # Library-relative file or folder -> the terms it changes
+    asset_overrides: Dict[str, Dict[str, Any]] = field(default_factory=dict)
+
+    def get_license(self, relative_path: str) -> VendorLicense:
+        """Get the terms of a library-relative file"""
+        matches = [
+            path
+            for path in self.asset_overrides
+            if relative_path == path or relative_path.startswith(path + "/")
+        ]
+        if not matches:
+            return self.license
+        return self.license.with_overrides(self.asset_overrides[max(matches, key=len)])
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert to the JSON layout of vendor.json"""
+        assets = {path: dict(terms) for path, terms in sorted(self.asset_overrides.items())}
+        return {**self.license.to_dict(), "assets": assets}
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "VendorLibrary":
+        """
+        Create from vendor.json
+
+        Raises:
+            ValueError: If the per-asset terms are not a mapping of path to terms
+        """
+        assets = data.get("assets") or {}
+        if not isinstance(assets, dict):
+            raise ValueError("'assets' must map library-relative paths to license terms")
+        overrides = {}
+        for path, terms in assets.items():
+            if not isinstance(terms, dict):
+                raise ValueError(f"License terms of '{path}' must be an object")
+            overrides[str(path).strip("/")] = dict(terms)
+        return cls(license=VendorLicense.from_dict(data), asset_overrides=overrides)
@@ -0,0 +1,96 @@
+# -*- coding: utf-8 -*-
+"""
+Watch Folder Domain Model
+Drop folders whose new exports are ingested into the library automatically
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import dataclass, fields
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+
+from .asset_version import format_version_label
+
+MODEL_EXTENSIONS = (".obj", ".fbx")
+IMAGE_EXTENSIONS = (".png", ".jpg", ".jpeg", ".tif", ".tiff", ".tga", ".exr")
+DEFAULT_EXTENSIONS = MODEL_EXTENSIONS + IMAGE_EXTENSIONS
+
+
+@dataclass(frozen=True)
+class WatchFolder:
+    """
+    Watch Folder Value Object - Single Responsibility for one drop folder's ingest rules
+    The name pattern reads the asset name (and type) out of the dropped file's name
+    """
+
+    path: str
+    category: str = "General"  # Asset type of ingested assets, unless the name has {type}
+    name_pattern: str = ""  # e.g. "{type}_{assetName}_v{version}", "" strips version suffixes
+    extensions: Tuple[str, ...] = DEFAULT_EXTENSIONS
+    flag_review: bool = True  # Ingested assets wait in Pending Review
+    enabled: bool = True
+
+    @property
+    def label(self) -> str:
+        """Get a short name for lists and reports (the folder's name)"""
+        return Path(self.path).name or self.path
+
+    def accepts(self, file_path: Path) -> bool:
+        """Check whether a file dropped in the folder is ingested"""
+        name = Path(file_path).name
+        if name.startswith(".") or name.startswith("~"):
+            return False  # Hidden and temporary files exporters write first
+        return Path(file_path).suffix.lower() in self.extensions
+
+    def to_dict(self) -> Dict[str, Any]:
+        """Convert the folder to a dictionary for the artist's settings file"""
+        return {
+            "path": self.path,
+            "category": self.category,
+            "name_pattern": self.name_pattern,
+            "extensions": list(self.extensions),
+            "flag_review": self.flag_review,
+            "enabled": self.enabled,
+        }
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> "WatchFolder":
+        """Create a folder from the artist's settings file, ignoring unknown keys"""
+        known = {f.name for f in fields(cls)}
+        values = {key: value for key, value in data.items() if key in known}
+        if "extensions" in values:
+            values["extensions"] = tuple(
+                f".{extension.lower().lstrip('.')}" for extension in values["extensions"]
+            )
+        return cls(**values)
+
+
+@dataclass(frozen=True)
+class IngestResult:
+    """
+    Ingest Result Value Object - Single Responsibility for one ingested file
+    """
+
+    source: Path
+    asset_file: Optional[Path] = None
+    version: int = 0  # Version published for the file, 0 if versioning failed
+    is_new_asset: bool = True
+    flagged: bool = False  # Set to Pending Review
+    error: str = ""
+
+    @property
+    def succeeded(self) -> bool:
+        """Check if the file reached the library"""
+        return self.asset_file is not None and not self.error
+
+    @property
+    def summary(self) -> str:
+        """Get a report line for the status bar"""
+        if not self.succeeded:
+            return f"{self.source.name}: {self.error}"
+        kind = "new asset" if self.is_new_asset else "new version"
+        version = f" {format_version_label(self.version)}" if self.version else ""
+        review = ", pending review" if self.flagged else ""
+        return f"{self.source.name} -> {self.asset_file.stem}{version} ({kind}{review})"
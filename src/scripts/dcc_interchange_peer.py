@@ -0,0 +1,310 @@
+"""
+Asset Manager Interchange Peer
+Pairs a Houdini or Blender session with Maya's Send to / Receive from DCC commands.
+
+Run it from the other application's Python shell (or a startup script)::
+
+    import dcc_interchange_peer
+    dcc_interchange_peer.start()                 # Houdini or Blender, found automatically
+    dcc_interchange_peer.send_selection("rock")  # Push a layer to Maya
+
+Layers Maya sends are imported as they arrive; Maya's Receive command asks this
+session for a layer of its selection. Only the standard library is needed here;
+the protocol must stay in step with src/services/interchange_service_impl.py.
+
+Author: Mike Stumbo
+Version: 1.5.0
+"""
+
+import json
+import os
+import queue
+import socket
+import tempfile
+import threading
+import time
+from typing import Any, Callable, Dict, Optional, Tuple
+
+PROTOCOL_MAGIC = "am_interchange"
+PROTOCOL_VERSION = 1
+PEER_PORT = 7820
+MAYA_PORT = 7821
+
+# exporter(folder, name) -> written layer path; importer(layer_path, name) -> node names
+Exporter = Callable[[str, str], str]
+Importer = Callable[[str, str], Any]
+
+
+def encode_message(
+    message_type: str, data: Optional[Dict[str, Any]] = None, token: str = ""
+) -> bytes:
+    """Encode an interchange message as one JSON line"""
+    message = {"magic": PROTOCOL_MAGIC, "version": PROTOCOL_VERSION, "type": message_type}
+    message["data"] = data or {}
+    if token:
+        message["token"] = token
+    return (json.dumps(message) + "\n").encode("utf-8")
+
+
+def read_message(connection: socket.socket) -> Dict[str, Any]:
+    """Read one message line; ValueError for anything else"""
+    payload = b""
+    while not payload.endswith(b"\n"):
+        chunk = connection.recv(4096)
+        if not chunk:
+            break
+        payload += chunk
+    message = json.loads(payload.decode("utf-8") or "{}")
+    if message.get("magic") != PROTOCOL_MAGIC or message.get("version") != PROTOCOL_VERSION:
+        raise ValueError("Not an Asset Manager interchange message")
+    return message
+
+
+class PeerSession:
+    """
+    Interchange peer - answers Maya on a worker thread, touches the scene on the main one
+    """
+
+    def __init__(
+        self,
+        dcc: str,
+        exporter: Exporter,
+        importer: Importer,
+        port: int = PEER_PORT,
+        maya_address: Tuple[str, int] = ("127.0.0.1", MAYA_PORT),
+        token: str = "",
+        run_in_main: Optional[Callable[[Callable[[], Any]], Any]] = None,
+    ):
+        self.dcc = dcc
+        self._exporter = exporter
+        self._importer = importer
+        self._port = port
+        self._maya_address = maya_address
+        self._token = token
+        self._run_in_main = run_in_main or (lambda job: job())
+        self._socket: Optional[socket.socket] = None
+        self._stopping = threading.Event()
+
+    @property
+    def port(self) -> int:
+        """Get the bound port"""
+        return self._socket.getsockname()[1] if self._socket else self._port
+
+    def start(self) -> int:
+        """Listen for Maya; returns the port"""
+        self._socket = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+        self._socket.bind(("127.0.0.1", self._port))
+        self._socket.listen(4)
+        self._socket.settimeout(0.25)
+        self._stopping.clear()
+        threading.Thread(target=self._serve, name="InterchangePeer", daemon=True).start()
+        print(f"[INFO] Asset Manager interchange listening on port {self.port}")
+        return self.port
+
+    def stop(self) -> None:
+        """Stop listening"""
+        self._stopping.set()
+        if self._socket is not None:
+            time.sleep(0.3)
+            self._socket.close()
+            self._socket = None
+
+    def send_selection(self, name: str = "selection", folder: Optional[str] = None) -> str:
+        """Export the selection and tell Maya to import it; returns the layer path"""
+        folder = folder or os.path.join(tempfile.gettempdir(), "assetmanager_interchange")
+        os.makedirs(folder, exist_ok=True)
+        layer_path = self._exporter(folder, name)
+        data = {"file": layer_path, "name": name, "dcc": self.dcc}
+        with socket.create_connection(self._maya_address, timeout=10.0) as connection:
+            connection.sendall(encode_message("layer", data, self._token))
+            reply = read_message(connection)
+        if reply.get("type") == "error":
+            raise RuntimeError(reply["data"].get("message"))
+        return layer_path
+
+    def _serve(self) -> None:
+        """Accept Maya's connections until stopped"""
+        while not self._stopping.is_set():
+            try:
+                connection, _address = self._socket.accept()
+            except socket.timeout:
+                continue
+            except OSError:
+                break
+            with connection:
+                try:
+                    connection.settimeout(60.0)
+                    reply = self._answer(read_message(connection))
+                except Exception as e:
+                    reply = ("error", {"message": str(e)})
+                try:
+                    connection.sendall(encode_message(reply[0], reply[1], self._token))
+                except OSError:
+                    pass
+
+    def _answer(self, message: Dict[str, Any]) -> Tuple[str, Dict[str, Any]]:
+        """Answer one of Maya's messages"""
+        if self._token and message.get("token") != self._token:
+            return "error", {"message": "The interchange token does not match"}
+        data = message.get("data") or {}
+        if message.get("type") == "ping":
+            return "pong", {"dcc": self.dcc}
+        if message.get("type") == "layer":
+            layer_path, name = data["file"], data.get("name") or "maya_layer"
+            nodes = self._run_in_main(lambda: self._importer(layer_path, name))
+            print(f"[OK] Imported {name} from Maya")
+            return "ack", {"file": layer_path, "nodes": [str(node) for node in nodes or []]}
+        if message.get("type") == "request":
+            folder = data.get("exchange_dir") or tempfile.gettempdir()
+            name = f"{self.dcc}_selection"
+            layer_path = self._run_in_main(lambda: self._exporter(folder, name))
+            return "layer", {"file": layer_path, "name": name, "dcc": self.dcc}
+        return "error", {"message": f"Unknown interchange message: {message.get('type')}"}
+
+
+# Houdini --------------------------------------------------------------------------------
+
+
+def _houdini_export(folder: str, name: str) -> str:
+    """Write the selected SOP node's geometry with a USD Export SOP"""
+    import hou  # type: ignore
+
+    selected = [node for node in hou.selectedNodes() if isinstance(node, hou.SopNode)]
+    if not selected:
+        raise RuntimeError("Select a SOP node in Houdini")
+    layer_path = os.path.join(folder, f"{name}_houdini_{time.strftime('%Y%m%d_%H%M%S')}.usda")
+    exporter = selected[0].parent().createNode("usdexport", "assetmanager_interchange")
+    try:
+        exporter.setInput(0, selected[0])
+        exporter.parm("lopoutput").set(layer_path)
+        exporter.parm("execute").pressButton()
+    finally:
+        exporter.destroy()
+    return layer_path
+
+
+def _houdini_import(layer_path: str, name: str) -> Any:
+    """Load a layer through a USD Import SOP in a new geometry object"""
+    import hou  # type: ignore
+
+    geo = hou.node("/obj").createNode("geo", f"maya_{name}")
+    importer = geo.createNode("usdimport")
+    importer.parm("filepath1").set(layer_path)
+    importer.setDisplayFlag(True)
+    geo.layoutChildren()
+    return [geo.path()]
+
+
+def _houdini_main_thread(job: Callable[[], Any]) -> Any:
+    """Run scene work on Houdini's main thread"""
+    import hdefereval  # type: ignore
+
+    return hdefereval.executeInMainThreadWithResult(job)
+
+
+# Blender --------------------------------------------------------------------------------
+
+
+def _blender_export(folder: str, name: str) -> str:
+    """Write the selected objects with Blender's USD exporter"""
+    import bpy  # type: ignore
+
+    if not bpy.context.selected_objects:
+        raise RuntimeError("Select the objects to send in Blender")
+    layer_path = os.path.join(folder, f"{name}_blender_{time.strftime('%Y%m%d_%H%M%S')}.usda")
+    bpy.ops.wm.usd_export(filepath=layer_path, selected_objects_only=True, export_materials=True)
+    return layer_path
+
+
+def _blender_import(layer_path: str, name: str) -> Any:
+    """Load a layer with Blender's USD importer"""
+    import bpy  # type: ignore
+
+    bpy.ops.wm.usd_import(filepath=layer_path)
+    return [obj.name for obj in bpy.context.selected_objects]
+
+
+_blender_jobs: "queue.Queue" = queue.Queue()
+
+
+def _blender_main_thread(job: Callable[[], Any]) -> Any:
+    """Run scene work from a Blender timer (bpy operators only work on the main thread)"""
+    done = threading.Event()
+    outcome: Dict[str, Any] = {}
+
+    def run() -> None:
+        try:
+            outcome["result"] = job()
+        except Exception as e:
+            outcome["error"] = e
+        done.set()
+
+    _blender_jobs.put(run)
+    if not done.wait(60.0):
+        raise RuntimeError("Blender was too busy to answer")
+    if "error" in outcome:
+        raise outcome["error"]
+    return outcome.get("result")
+
+
+def _blender_poll() -> float:
+    """Blender timer running queued scene work"""
+    while not _blender_jobs.empty():
+        _blender_jobs.get()()
+    return 0.2
+
+
+# Entry points ---------------------------------------------------------------------------
+
+_session: Optional[PeerSession] = None
+
+
+def start(dcc: Optional[str] = None, port: int = PEER_PORT, token: str = "") -> PeerSession:
+    """Start the peer in Houdini or Blender (detected when dcc is omitted)"""
+    global _session
+    if _session is not None:
+        return _session
+    if dcc is None:
+        try:
+            import hou  # type: ignore  # noqa: F401
+
+            dcc = "houdini"
+        except ImportError:
+            dcc = "blender"
+    if dcc == "houdini":
+        session = PeerSession(
+            "houdini",
+            _houdini_export,
+            _houdini_import,
+            port,
+            token=token,
+            run_in_main=_houdini_main_thread,
+        )
+    else:
+        import bpy  # type: ignore
+
+        bpy.app.timers.register(_blender_poll, persistent=True)
+        session = PeerSession(
+            "blender",
+            _blender_export,
+            _blender_import,
+            port,
+            token=token,
+            run_in_main=_blender_main_thread,
+        )
+    session.start()
+    _session = session
+    return session
+
+
+def send_selection(name: str = "selection") -> str:
+    """Send the selection to Maya; returns the layer path"""
+    return start().send_selection(name)
+
+
+def stop() -> None:
+    """Stop the peer"""
+    global _session
+    if _session is not None:
+        _session.stop()
+        _session = None
@@ -0,0 +1,189 @@
+# -*- coding: utf-8 -*-
+"""
+Activity Service Implementation
+Library activity log: publishes, imports, deletes, renames, and status changes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Events are appended to the library database with the artist, the machine, and a
+timestamp, so leads can see who changed what on a shared library. Logging never
+blocks the action it records; a failed write is only reported.
+"""
+
+import logging
+import socket
+from datetime import date, datetime, timedelta
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.activity_event import (
+    ACTIVITY_DELETE,
+    ACTIVITY_IMPORT,
+    ACTIVITY_PUBLISH,
+    ActivityEvent,
+)
+from .hook_service_impl import HOOK_ASSET_DELETED, HOOK_POST_IMPORT, HOOK_POST_PUBLISH
+from .metadata_database_impl import get_metadata_database
+from .version_service_impl import get_current_user
+
+# Post-action hook points and the activity they log
+HOOK_ACTIVITIES = {
+    HOOK_POST_PUBLISH: ACTIVITY_PUBLISH,
+    HOOK_POST_IMPORT: ACTIVITY_IMPORT,
+    HOOK_ASSET_DELETED: ACTIVITY_DELETE,
+}
+
+# Hook context keys worth keeping; scene selections and file lists are left out
+HOOK_DETAIL_KEYS = ("version", "mode", "lod_level", "namespace", "notes", "trash_entry")
+
+# Events shown in the asset detail panel
+RECENT_ACTIVITY_LIMIT = 5
+# Rows the library-wide view loads at most
+ACTIVITY_VIEW_LIMIT = 1000
+
+
+class ActivityService:
+    """
+    Activity Service - Single Responsibility for the library activity log
+    """
+
+    def __init__(self, database_factory=None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+
+    # Recording --------------------------------------------------------------------------
+
+    def record(
+        self,
+        library_root: Optional[Path],
+        action: str,
+        asset_file: Optional[Path],
+        user: Optional[str] = None,
+        **details: Any,
+    ) -> Optional[ActivityEvent]:
+        """
+        Append an event to a library's activity log
+
+        Args:
+            library_root: Library the asset belongs to (nothing is logged without one)
+            action: One of ACTIVITY_ACTIONS
+            asset_file: Asset the action was on
+            user: Artist, defaults to the current one
+            details: Action specifics (version, mode, status, note...)
+
+        Returns:
+            Logged event, None if it could not be written
+        """
+        if library_root is None:
+            return None
+        details = {key: value for key, value in details.items() if value not in (None, "")}
+        try:
+            database = self._database_factory(Path(library_root))
+            event = ActivityEvent(
+                action=action,
+                asset_key=database.get_asset_key(Path(asset_file)) if asset_file else "",
+                user=user or get_current_user(),
+                machine=socket.gethostname(),
+                timestamp=datetime.now().replace(microsecond=0),
+                details=details,
+            )
+            database.log_activity(
+                asset_file,
+                event.action,
+                event.user,
+                event.machine,
+                event.timestamp.isoformat(),
+                details,
+            )
+            return event
+        except Exception as e:
+            self.logger.warning(f"Could not log {action} of {asset_file}: {e}")
+            print(f"[WARNING] Activity log not updated: {e}")
+            return None
+
+    def record_hook(
+        self, hook: str, library_root: Optional[Path], context: Dict[str, Any]
+    ) -> Optional[ActivityEvent]:
+        """Log the action behind a post-action hook point (other hooks log nothing)"""
+        action = HOOK_ACTIVITIES.get(hook)
+        if action is None:
+            return None
+        details = {key: context[key] for key in HOOK_DETAIL_KEYS if key in context}
+        return self.record(
+            library_root, action, context.get("asset_file"), context.get("user"), **details
+        )
+
+    # Queries ----------------------------------------------------------------------------
+
+    def get_asset_events(
+        self, library_root: Path, asset_file: Path, limit: int = RECENT_ACTIVITY_LIMIT
+    ) -> List[ActivityEvent]:
+        """Get the latest events of one asset, newest first"""
+        database = self._database_factory(Path(library_root))
+        return self._to_events(database.get_activity(Path(asset_file), limit=limit))
+
+    def get_events(
+        self,
+        library_root: Path,
+        user: str = "",
+        since: Optional[date] = None,
+        until: Optional[date] = None,
+        action: str = "",
+        limit: int = ACTIVITY_VIEW_LIMIT,
+    ) -> List[ActivityEvent]:
+        """
+        Get library-wide events, newest first
+
+        Args:
+            library_root: Library whose log is read
+            user: Only events by this artist ("" for everyone)
+            since: First day included
+            until: Last day included
+            action: Only events of this action ("" for all)
+            limit: Most events returned
+        """
+        database = self._database_factory(Path(library_root))
+        rows = database.get_activity(
+            user=user,
+            since=since.isoformat() if since else "",
+            until=(until + timedelta(days=1)).isoformat() if until else "",
+            action=action,
+            limit=limit,
+        )
+        return self._to_events(rows)
+
+    def get_users(self, library_root: Path) -> List[str]:
+        """Get every artist in a library's activity log"""
+        return self._database_factory(Path(library_root)).get_activity_users()
+
+    def _to_events(self, rows: List[Dict[str, Any]]) -> List[ActivityEvent]:
+        """Build events from database rows"""
+        return [
+            ActivityEvent(
+                action=row["action"],
+                asset_key=row["asset"],
+                user=row["user"],
+                machine=row["machine"],
+                timestamp=datetime.fromisoformat(row["timestamp"]),
+                details=row["details"],
+            )
+            for row in rows
+        ]
+
+
+# Singleton instance factory
+_activity_service_instance = None
+
+
+def get_activity_service() -> ActivityService:
+    """
+    Get singleton instance of ActivityService.
+
+    Returns:
+        ActivityService: Singleton service instance
+    """
+    global _activity_service_instance
+    if _activity_service_instance is None:
+        _activity_service_instance = ActivityService()
+    return _activity_service_instance
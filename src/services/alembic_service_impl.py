@@ -0,0 +1,182 @@
+# -*- coding: utf-8 -*-
+"""
+Alembic Service Implementation
+Publish selected geometry as Alembic caches and load them as geometry or GPU caches
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The export settings are stored in a sidecar next to the cache and versioned with it::
+
+    assets/models/crowd_run.abc
+    assets/models/crowd_run.abc.json   <- {"start_frame": 1001, "end_frame": 1100, ...}
+
+Caches load either as full geometry (AbcImport, editable meshes) or as a gpuCache
+node, which draws large caches fast but cannot be edited.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, List, Optional, Tuple
+
+from ..core.models.alembic_cache import AlembicCacheInfo
+
+ALEMBIC_EXTENSION = ".abc"
+SIDECAR_SUFFIX = ".json"
+
+IMPORT_MODE_GEOMETRY = "geometry"
+IMPORT_MODE_GPU_CACHE = "gpu_cache"
+
+
+class AlembicService:
+    """
+    Alembic Service - Single Responsibility for Alembic cache publish and load
+    Scene access goes through the cmds argument so jobs can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Metadata ---------------------------------------------------------------------------
+
+    def get_sidecar_path(self, file_path: Path) -> Path:
+        """Get the settings sidecar of a cache (crowd_run.abc -> crowd_run.abc.json)"""
+        file_path = Path(file_path)
+        return file_path.with_name(file_path.name + SIDECAR_SUFFIX)
+
+    def read_cache_info(self, file_path: Path) -> Optional[AlembicCacheInfo]:
+        """Get the settings a cache was published with, None for plain .abc files"""
+        sidecar = self.get_sidecar_path(file_path)
+        if not sidecar.is_file():
+            return None
+        try:
+            with open(sidecar, "r", encoding="utf-8") as f:
+                return AlembicCacheInfo.from_dict(json.load(f))
+        except Exception as e:
+            self.logger.warning(f"Unreadable Alembic settings {sidecar.name}: {e}")
+            return None
+
+    # Export -----------------------------------------------------------------------------
+
+    def get_playback_range(self, cmds: Any) -> Tuple[float, float]:
+        """Get the scene playback range, the default cache frame range"""
+        return (
+            float(cmds.playbackOptions(query=True, minTime=True)),
+            float(cmds.playbackOptions(query=True, maxTime=True)),
+        )
+
+    def get_export_roots(self, cmds: Any, selection: List[str]) -> List[str]:
+        """Get selected transforms as cache roots, dropping ones nested under another root"""
+        roots = cmds.ls(selection, long=True, transforms=True) or []
+        return [
+            root
+            for root in roots
+            if not any(root.startswith(other + "|") for other in roots if other != root)
+        ]
+
+    def build_job(self, file_path: Path, info: AlembicCacheInfo) -> str:
+        """Build the AbcExport job string for a cache"""
+        parts = [
+            f"-frameRange {info.start_frame:g} {info.end_frame:g}",
+            f"-step {info.step:g}",
+            "-uvWrite",
+            "-writeUVSets",
+            "-writeVisibility",
+            "-dataFormat ogawa",
+        ]
+        if info.world_space:
+            parts.append("-worldSpace")
+        parts += [f"-root {root}" for root in info.roots]
+        parts.append(f'-file "{Path(file_path).as_posix()}"')
+        return " ".join(parts)
+
+    def export_cache(self, cmds: Any, file_path: Path, info: AlembicCacheInfo) -> Path:
+        """
+        Write the cache and its settings sidecar
+
+        Args:
+            cmds: maya.cmds module
+            file_path: Target .abc file
+            info: Frame range, sampling, space, and roots to export
+
+        Returns:
+            Sidecar path, to version together with the cache
+
+        Raises:
+            RuntimeError: When nothing is selected or the export fails
+        """
+        if not info.roots:
+            raise RuntimeError("Select the geometry to cache")
+        if info.frame_count == 0:
+            raise RuntimeError(f"Invalid frame range {info.label} with step {info.step:g}")
+
+        self._load_plugin(cmds, "AbcExport")
+        file_path = Path(file_path)
+        cmds.AbcExport(jobArg=self.build_job(file_path, info))
+        if not file_path.is_file():
+            raise RuntimeError(f"AbcExport did not write {file_path.name}")
+
+        sidecar = self.get_sidecar_path(file_path)
+        with open(sidecar, "w", encoding="utf-8") as f:
+            json.dump(info.to_dict(), f, indent=2)
+        print(f"[OK] Exported Alembic cache {file_path.name} ({info.description})")
+        return sidecar
+
+    # Import -----------------------------------------------------------------------------
+
+    def import_cache(
+        self, cmds: Any, file_path: Path, mode: str = IMPORT_MODE_GEOMETRY
+    ) -> List[str]:
+        """
+        Load a cache into the scene
+
+        Args:
+            cmds: maya.cmds module
+            file_path: .abc file
+            mode: IMPORT_MODE_GEOMETRY or IMPORT_MODE_GPU_CACHE
+
+        Returns:
+            New top-level transforms
+        """
+        file_path = Path(file_path)
+        if mode == IMPORT_MODE_GPU_CACHE:
+            self._load_plugin(cmds, "gpuCache")
+            transform = cmds.createNode("transform", name=f"{file_path.stem}_gpu")
+            shape = cmds.createNode(
+                "gpuCache", name=f"{file_path.stem}_gpuShape", parent=transform
+            )
+            cmds.setAttr(f"{shape}.cacheFileName", str(file_path), type="string")
+            print(f"[OK] Loaded {file_path.name} as GPU cache")
+            return [transform]
+
+        self._load_plugin(cmds, "AbcImport")
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        cmds.AbcImport(str(file_path), mode="import")
+        roots = [node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before]
+        print(f"[OK] Imported {file_path.name} as geometry ({len(roots)} root(s))")
+        return roots
+
+    def _load_plugin(self, cmds: Any, plugin: str) -> None:
+        """Load a Maya plugin the cache job needs"""
+        if cmds.pluginInfo(plugin, query=True, loaded=True):
+            return
+        if not cmds.loadPlugin(plugin, quiet=True):
+            raise RuntimeError(f"{plugin} plugin not available")
+
+
+# Singleton instance factory
+_alembic_service_instance = None
+
+
+def get_alembic_service() -> AlembicService:
+    """
+    Get singleton instance of AlembicService.
+
+    Returns:
+        AlembicService: Singleton service instance
+    """
+    global _alembic_service_instance
+    if _alembic_service_instance is None:
+        _alembic_service_instance = AlembicService()
+    return _alembic_service_instance
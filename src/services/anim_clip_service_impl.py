@@ -0,0 +1,392 @@
+# -*- coding: utf-8 -*-
+"""
+Animation Clip Service Implementation
+Export animation curves from a rig as a clip asset and apply it to other rigs
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A clip is a JSON file next to the other library assets::
+
+    assets/animation/walk_cycle.animclip
+    assets/animation/.thumbnails/walk_cycle_screenshot.png
+
+Node names are stored without namespace, so a clip captured from ``heroA:`` can be
+applied to ``heroB:`` (or any rig sharing the control names) by namespace remapping.
+Times are stored relative to the clip start and rescaled when the scene FPS differs.
+"""
+
+import json
+import logging
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from .version_service_impl import get_current_user
+
+ANIM_CLIP_EXTENSION = ".animclip"
+CLIP_FORMAT_VERSION = 1
+
+# Maya time units -> frames per second
+TIME_UNIT_FPS = {
+    "game": 15.0,
+    "film": 24.0,
+    "pal": 25.0,
+    "ntsc": 30.0,
+    "show": 48.0,
+    "palf": 50.0,
+    "ntscf": 60.0,
+}
+
+# Namespaces Maya creates itself
+IGNORED_NAMESPACES = {"UI", "shared"}
+
+
+@dataclass
+class ClipApplyResult:
+    """Outcome of applying a clip to a rig"""
+
+    applied_curves: int = 0
+    missing: List[str] = field(default_factory=list)  # node.attribute not found on target
+
+    @property
+    def success(self) -> bool:
+        return self.applied_curves > 0
+
+
+def strip_namespace(node: str) -> str:
+    """Remove namespaces (and DAG path) from a node name: |heroA:root|heroA:ctrl -> ctrl"""
+    short_name = node.rsplit("|", 1)[-1]
+    return short_name.rsplit(":", 1)[-1]
+
+
+def remap_node(node: str, namespace: str) -> str:
+    """Put a namespace-free node name into the target namespace ("" for root)"""
+    namespace = namespace.strip(":")
+    return f"{namespace}:{node}" if namespace else node
+
+
+def get_namespace(node: str) -> str:
+    """Get the namespace of a node name, "" for the root namespace"""
+    short_name = node.rsplit("|", 1)[-1]
+    return short_name.rsplit(":", 1)[0] if ":" in short_name else ""
+
+
+def time_unit_to_fps(unit: str) -> float:
+    """Convert a Maya time unit (film, ntsc, 120fps...) to frames per second"""
+    if unit in TIME_UNIT_FPS:
+        return TIME_UNIT_FPS[unit]
+    if unit.endswith("fps"):
+        try:
+            return float(unit[:-3])
+        except ValueError:
+            pass
+    return 24.0
+
+
+class AnimClipService:
+    """
+    Animation Clip Service - Single Responsibility for clip capture and application
+    All Maya calls go through the cmds argument so clips can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Capture ----------------------------------------------------------------------------
+
+    def get_rig_identifier(self, cmds: Any, node: str) -> str:
+        """Get an id for the rig a node belongs to: rigId/assetName attribute, else root name"""
+        long_names = cmds.ls(node, long=True) or [node]
+        root = "|" + long_names[0].strip("|").split("|")[0]
+        for attribute in ("rigId", "assetName"):
+            if cmds.attributeQuery(attribute, node=root, exists=True):
+                value = cmds.getAttr(f"{root}.{attribute}")
+                if value:
+                    return str(value)
+        return strip_namespace(root)
+
+    def get_animated_nodes(self, cmds: Any, nodes: List[str]) -> List[str]:
+        """Expand a selection (controls or rig root) to all nodes with anim curves"""
+        candidates = list(nodes)
+        for node in nodes:
+            candidates.extend(
+                cmds.listRelatives(node, allDescendents=True, type="transform", fullPath=True)
+                or []
+            )
+
+        animated = []
+        for node in dict.fromkeys(candidates):
+            if cmds.listConnections(node, type="animCurve", source=True, destination=False):
+                animated.append(node)
+        return animated
+
+    def capture_clip(
+        self,
+        cmds: Any,
+        nodes: List[str],
+        frame_range: Tuple[float, float],
+        name: str,
+        notes: str = "",
+    ) -> Dict[str, Any]:
+        """
+        Read the keys of the given nodes into clip data
+
+        Args:
+            cmds: maya.cmds module
+            nodes: Selected controls or rig root
+            frame_range: (start, end) frames to capture
+            name: Clip name
+            notes: Free text description
+
+        Returns:
+            Clip dictionary ready for save_clip
+        """
+        start, end = frame_range
+        animated = self.get_animated_nodes(cmds, nodes)
+        curves = []
+
+        for node in animated:
+            connections = cmds.listConnections(
+                node,
+                type="animCurve",
+                source=True,
+                destination=False,
+                connections=True,
+                plugs=False,
+            ) or []
+            # Pairs of (node.attribute, animCurve)
+            for plug, curve in zip(connections[::2], connections[1::2]):
+                curve_data = self._read_curve(cmds, curve, start, end)
+                if curve_data["keys"]:
+                    curve_data["node"] = strip_namespace(plug.split(".", 1)[0])
+                    curve_data["attribute"] = plug.split(".", 1)[1]
+                    curves.append(curve_data)
+
+        first_node = nodes[0] if nodes else ""
+        return {
+            "type": "anim_clip",
+            "format_version": CLIP_FORMAT_VERSION,
+            "name": name,
+            "notes": notes,
+            "rig": self.get_rig_identifier(cmds, first_node) if first_node else "",
+            "source_namespace": get_namespace(first_node),
+            "frame_range": [start, end],
+            "fps": time_unit_to_fps(cmds.currentUnit(query=True, time=True)),
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+            "curves": curves,
+        }
+
+    def _read_curve(self, cmds: Any, curve: str, start: float, end: float) -> Dict[str, Any]:
+        """Read keys and tangents of one anim curve within the frame range"""
+        time_range = (start, end)
+        times = cmds.keyframe(curve, query=True, time=time_range, timeChange=True) or []
+        if not times:
+            return {"keys": []}
+
+        values = cmds.keyframe(curve, query=True, time=time_range, valueChange=True)
+
+        def tangent(**flag):
+            return cmds.keyTangent(curve, query=True, time=time_range, **flag)
+
+        in_types, out_types = tangent(inTangentType=True), tangent(outTangentType=True)
+        in_angles, out_angles = tangent(inAngle=True), tangent(outAngle=True)
+        in_weights, out_weights = tangent(inWeight=True), tangent(outWeight=True)
+
+        keys = [
+            [
+                times[i] - start,
+                values[i],
+                in_types[i],
+                out_types[i],
+                in_angles[i],
+                out_angles[i],
+                in_weights[i],
+                out_weights[i],
+            ]
+            for i in range(len(times))
+        ]
+        weighted = cmds.keyTangent(curve, query=True, weightedTangents=True) or [False]
+        pre_infinity = cmds.setInfinity(curve, query=True, preInfinite=True) or ["constant"]
+        post_infinity = cmds.setInfinity(curve, query=True, postInfinite=True) or ["constant"]
+        return {
+            "keys": keys,
+            "weighted": bool(weighted[0]),
+            "pre_infinity": pre_infinity[0],
+            "post_infinity": post_infinity[0],
+        }
+
+    # Storage ----------------------------------------------------------------------------
+
+    def save_clip(self, clip_path: Path, clip: Dict[str, Any]) -> Path:
+        """Write clip data to a .animclip file"""
+        clip_path = Path(clip_path)
+        if clip_path.suffix != ANIM_CLIP_EXTENSION:
+            clip_path = clip_path.with_suffix(ANIM_CLIP_EXTENSION)
+        clip_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(clip_path, "w", encoding="utf-8") as f:
+            json.dump(clip, f, indent=2)
+        print(f"[OK] Saved animation clip {clip_path.name} ({len(clip['curves'])} curves)")
+        return clip_path
+
+    def load_clip(self, clip_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .animclip file, None if it is not a valid clip"""
+        try:
+            with open(clip_path, "r", encoding="utf-8") as f:
+                clip = json.load(f)
+            if clip.get("type") != "anim_clip":
+                return None
+            return clip
+        except Exception as e:
+            self.logger.error(f"Failed to read animation clip {clip_path}: {e}")
+            return None
+
+    # Apply ------------------------------------------------------------------------------
+
+    def get_scene_namespaces(self, cmds: Any) -> List[str]:
+        """Get namespaces that could hold a rig, including "" for the root namespace"""
+        namespaces = cmds.namespaceInfo(":", listOnlyNamespaces=True, recurse=True) or []
+        return [""] + sorted(ns.strip(":") for ns in namespaces if ns not in IGNORED_NAMESPACES)
+
+    def find_missing_nodes(self, cmds: Any, clip: Dict[str, Any], namespace: str) -> List[str]:
+        """Get clip channels the target namespace does not have"""
+        missing = []
+        for curve in clip.get("curves", []):
+            plug = f"{remap_node(curve['node'], namespace)}.{curve['attribute']}"
+            if not cmds.objExists(plug):
+                missing.append(plug)
+        return missing
+
+    def apply_clip(
+        self,
+        cmds: Any,
+        clip: Dict[str, Any],
+        namespace: str,
+        start_frame: Optional[float] = None,
+        replace: bool = True,
+    ) -> ClipApplyResult:
+        """
+        Key a clip onto the rig in the given namespace
+
+        Args:
+            cmds: maya.cmds module
+            clip: Clip data from load_clip
+            namespace: Target rig namespace ("" for root)
+            start_frame: Frame the clip starts at (defaults to its original start)
+            replace: Remove existing keys in the clip's range first
+
+        Returns:
+            Applied curve count and channels missing on the target rig
+        """
+        result = ClipApplyResult()
+        clip_start, clip_end = clip.get("frame_range", [0, 0])
+        start_frame = clip_start if start_frame is None else start_frame
+
+        scene_fps = time_unit_to_fps(cmds.currentUnit(query=True, time=True))
+        time_scale = scene_fps / float(clip.get("fps") or scene_fps)
+        end_frame = start_frame + (clip_end - clip_start) * time_scale
+
+        for curve in clip.get("curves", []):
+            target = remap_node(curve["node"], namespace)
+            attribute = curve["attribute"]
+            if not cmds.objExists(f"{target}.{attribute}"):
+                result.missing.append(f"{target}.{attribute}")
+                continue
+
+            if replace:
+                cmds.cutKey(target, attribute=attribute, time=(start_frame, end_frame), clear=True)
+
+            for key in curve["keys"]:
+                time = start_frame + key[0] * time_scale
+                cmds.setKeyframe(target, attribute=attribute, time=time, value=key[1])
+
+            if curve.get("weighted"):
+                cmds.keyTangent(target, attribute=attribute, edit=True, weightedTangents=True)
+            for key in curve["keys"]:
+                time = start_frame + key[0] * time_scale
+                self._apply_tangents(cmds, target, attribute, time, key)
+
+            for flag in ("pre_infinity", "post_infinity"):
+                infinity = curve.get(flag)
+                if infinity and infinity != "constant":
+                    option = "preInfinite" if flag == "pre_infinity" else "postInfinite"
+                    cmds.setInfinity(target, attribute=attribute, **{option: infinity})
+            result.applied_curves += 1
+
+        print(
+            f"[OK] Applied clip {clip.get('name', '')} to namespace '{namespace or ':'}': "
+            f"{result.applied_curves} curves, {len(result.missing)} missing"
+        )
+        return result
+
+    def _apply_tangents(
+        self, cmds: Any, node: str, attribute: str, time: float, key: list
+    ) -> None:
+        """Restore tangent types; explicit angles only for fixed tangents"""
+        _, _, in_type, out_type, in_angle, out_angle, in_weight, out_weight = key
+        frame = (time, time)
+        # Types other than fixed are recomputed by Maya from the (identical) key layout
+        if in_type == "fixed" or out_type == "fixed":
+            angles = {}
+            if in_type == "fixed":
+                angles.update(inAngle=in_angle, inWeight=in_weight)
+            if out_type == "fixed":
+                angles.update(outAngle=out_angle, outWeight=out_weight)
+            cmds.keyTangent(node, attribute=attribute, edit=True, time=frame, **angles)
+        types = {"outTangentType": out_type}
+        if in_type != "step":  # "step" is only valid as an out tangent
+            types["inTangentType"] = in_type
+        cmds.keyTangent(node, attribute=attribute, edit=True, time=frame, **types)
+
+    # Thumbnails -------------------------------------------------------------------------
+
+    def capture_thumbnail(
+        self, cmds: Any, clip_path: Path, frame: float, size: int = 256
+    ) -> Optional[Path]:
+        """Playblast one frame of the clip into the library thumbnail folder"""
+        return playblast_thumbnail(cmds, clip_path, frame, size)
+
+
+def playblast_thumbnail(
+    cmds: Any, asset_path: Path, frame: float, size: int = 256
+) -> Optional[Path]:
+    """Playblast one frame as the asset's custom screenshot (used by clips and poses)"""
+    asset_path = Path(asset_path)
+    thumbnail = asset_path.parent / ".thumbnails" / f"{asset_path.stem}_screenshot.png"
+    thumbnail.parent.mkdir(parents=True, exist_ok=True)
+    try:
+        cmds.playblast(
+            frame=[frame],
+            format="image",
+            compression="png",
+            completeFilename=str(thumbnail),
+            widthHeight=(size, size),
+            percent=100,
+            viewer=False,
+            showOrnaments=False,
+            forceOverwrite=True,
+            offScreen=True,
+        )
+        return thumbnail if thumbnail.exists() else None
+    except Exception as e:
+        print(f"[WARNING] Could not capture thumbnail: {e}")
+        return None
+
+
+# Singleton instance factory
+_anim_clip_service_instance = None
+
+
+def get_anim_clip_service() -> AnimClipService:
+    """
+    Get singleton instance of AnimClipService.
+
+    Returns:
+        AnimClipService: Singleton service instance
+    """
+    global _anim_clip_service_instance
+    if _anim_clip_service_instance is None:
+        _anim_clip_service_instance = AnimClipService()
+    return _anim_clip_service_instance
@@ -0,0 +1,403 @@
+# -*- coding: utf-8 -*-
+"""
+Assembly Service Implementation
+Save layouts of library assets as assembly assets and rebuild them in a scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+An assembly asset is a small JSON descriptor like a light rig. It lists the library
+assets placed in a scene (a furnished room made of 40 props), each with the world
+matrix of its top-level transforms and the version it was saved with::
+
+    assets/assemblies/living_room.assembly
+        children[0]  assets/props/chair.ma  v003  reference  {chair_grp: [16 floats]}
+        children[1]  assets/props/chair.ma  v003  reference  {chair_grp: [...]}
+        children[2]  assets/props/lamp.ma   v001  import     {lamp_grp: [...]}
+
+Pinned children load their version snapshot, so the room looks the same until the
+assembly is updated to bump its children to their latest versions. Imported
+assemblies sit under one group tagged with the descriptor.
+"""
+
+import json
+import logging
+from dataclasses import replace
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.assembly_layout import (
+    ASSEMBLY_MODE_IMPORT,
+    ASSEMBLY_MODE_REFERENCE,
+    AssemblyChild,
+    AssemblyLayout,
+    Matrix,
+)
+from .maya_integration_impl import REFERENCE_FILE_TYPES, REFERENCE_PLUGINS, sanitize_namespace
+from .path_mapping_service_impl import get_path_mapping_service
+from .scene_asset_service_impl import SceneAsset, get_scene_asset_service
+from .version_service_impl import get_current_user, get_version_service
+
+ASSEMBLY_EXTENSION = ".assembly"
+ASSEMBLY_FORMAT_VERSION = 1
+
+# Attribute on the group of an imported assembly, holding its descriptor path
+ASSEMBLY_ATTRIBUTE = "assetManagerAssembly"
+
+
+def get_root_name(node: str) -> str:
+    """Get a transform's name without its DAG path or namespace"""
+    return node.rsplit("|", 1)[-1].rsplit(":", 1)[-1]
+
+
+class AssemblyService:
+    """
+    Assembly Service - Single Responsibility for assembly save, import, and updates
+    All Maya calls go through the cmds argument so assemblies can be tested without Maya
+    """
+
+    def __init__(self, version_service=None, scene_asset_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+        self._scene_asset_service = scene_asset_service or get_scene_asset_service()
+
+    # Save -------------------------------------------------------------------------------
+
+    def collect_children(
+        self,
+        cmds: Any,
+        scene_assets: List[SceneAsset],
+        library_root: Path,
+        pin_latest: bool = False,
+    ) -> List[AssemblyChild]:
+        """
+        Get the placement and version of scene assets from a library
+
+        Args:
+            cmds: maya.cmds module
+            scene_assets: Assets found by the scene asset scan
+            library_root: Library the children must belong to
+            pin_latest: Pin the latest published versions instead of the loaded ones
+
+        Returns:
+            One child per scene asset, skipping assets outside the library
+        """
+        root = Path(library_root).resolve()
+        children = []
+        for scene_asset in scene_assets:
+            try:
+                asset_path = scene_asset.asset_file.resolve().relative_to(root).as_posix()
+            except (OSError, ValueError):
+                print(f"[WARNING] {scene_asset.label} is not in the library, left out")
+                continue
+            if scene_asset.asset_file.suffix.lower() not in REFERENCE_FILE_TYPES:
+                print(f"[WARNING] {scene_asset.asset_file.suffix} assets cannot be assembled")
+                continue
+            nodes = self._scene_asset_service.get_nodes(cmds, scene_asset)
+            if not nodes:
+                continue
+
+            mode = ASSEMBLY_MODE_REFERENCE if scene_asset.is_referenced else ASSEMBLY_MODE_IMPORT
+            version = scene_asset.loaded_version or scene_asset.latest_version
+            if pin_latest:
+                version = scene_asset.latest_version
+            children.append(
+                AssemblyChild(
+                    asset_path=asset_path,
+                    version=version,
+                    mode=mode,
+                    placements={
+                        get_root_name(node): tuple(
+                            cmds.xform(node, query=True, matrix=True, worldSpace=True)
+                        )
+                        for node in nodes
+                    },
+                )
+            )
+        return sorted(children, key=lambda child: child.asset_path)
+
+    def get_selected(
+        self, cmds: Any, scene_assets: List[SceneAsset], selection: List[str]
+    ) -> List[SceneAsset]:
+        """Get the scene assets with a root inside, or under, the selected nodes"""
+
+        def overlaps(node: str, selected: str) -> bool:
+            # Same node, or one is a DAG descendant of the other
+            return (node + "|").startswith(selected + "|") or (selected + "|").startswith(
+                node + "|"
+            )
+
+        return [
+            scene_asset
+            for scene_asset in scene_assets
+            if any(
+                overlaps(node, selected)
+                for node in self._scene_asset_service.get_nodes(cmds, scene_asset)
+                for selected in selection
+            )
+        ]
+
+    def save_assembly(
+        self,
+        cmds: Any,
+        scene_assets: List[SceneAsset],
+        descriptor_path: Path,
+        library_root: Path,
+        notes: str = "",
+        pin_latest: bool = False,
+    ) -> Optional[AssemblyLayout]:
+        """
+        Write the placements of scene assets as an assembly asset
+
+        Args:
+            cmds: maya.cmds module
+            scene_assets: Assets to assemble
+            descriptor_path: .assembly file to write
+            library_root: Library the children belong to
+            notes: Free text description
+            pin_latest: Pin the latest published versions instead of the loaded ones
+
+        Returns:
+            Written layout, None if no library asset was given
+        """
+        descriptor_path = Path(descriptor_path).with_suffix(ASSEMBLY_EXTENSION)
+        children = self.collect_children(cmds, scene_assets, library_root, pin_latest)
+        if not children:
+            print("[ERROR] No library assets to save as an assembly")
+            return None
+
+        layout = AssemblyLayout(
+            name=descriptor_path.stem,
+            children=tuple(children),
+            notes=notes,
+            author=get_current_user(),
+            created_date=datetime.now(),
+        )
+        self.write_assembly(descriptor_path, layout)
+        print(f"[OK] Saved assembly {descriptor_path.name} ({layout.summary})")
+        return layout
+
+    def load_assembly(self, descriptor_path: Path) -> Optional[AssemblyLayout]:
+        """Read a .assembly descriptor, None if it is not a valid assembly"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "assembly":
+                return None
+            return AssemblyLayout.from_dict(descriptor)
+        except Exception as e:
+            self.logger.error(f"Failed to read assembly {descriptor_path}: {e}")
+            return None
+
+    def write_assembly(self, descriptor_path: Path, layout: AssemblyLayout) -> None:
+        """Write a layout as indented JSON"""
+        descriptor: Dict[str, Any] = {
+            "type": "assembly",
+            "format_version": ASSEMBLY_FORMAT_VERSION,
+        }
+        descriptor.update(layout.to_dict())
+        descriptor_path = Path(descriptor_path)
+        descriptor_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+
+    # Updates ----------------------------------------------------------------------------
+
+    def get_outdated(
+        self, layout: AssemblyLayout, library_root: Path
+    ) -> List[Tuple[AssemblyChild, int]]:
+        """
+        Get the pinned children whose asset has a newer version
+
+        Returns:
+            (child, latest version number) pairs
+        """
+        outdated = []
+        latest_numbers: Dict[str, int] = {}
+        for child in layout.children:
+            if not child.is_pinned:
+                continue
+            if child.asset_path not in latest_numbers:
+                latest = self._version_service.get_latest_version(child.resolve(library_root))
+                latest_numbers[child.asset_path] = latest.number if latest else 0
+            if latest_numbers[child.asset_path] > child.version:
+                outdated.append((child, latest_numbers[child.asset_path]))
+        return outdated
+
+    def bump_to_latest(
+        self, descriptor_path: Path, library_root: Path
+    ) -> Optional[AssemblyLayout]:
+        """
+        Pin every outdated child of an assembly to its latest version and save it
+
+        Returns:
+            Updated layout, None if the descriptor could not be read
+        """
+        layout = self.load_assembly(descriptor_path)
+        if layout is None:
+            return None
+        outdated = self.get_outdated(layout, library_root)
+        if not outdated:
+            return layout
+
+        latest = {child.asset_path: number for child, number in outdated}
+        updated = replace(
+            layout,
+            children=tuple(
+                replace(child, version=latest[child.asset_path])
+                if child.is_pinned and child.asset_path in latest
+                else child
+                for child in layout.children
+            ),
+            author=get_current_user(),
+            created_date=datetime.now(),
+        )
+        self.write_assembly(descriptor_path, updated)
+        print(f"[OK] Bumped {len(outdated)} child(ren) of {layout.name} to latest")
+        return updated
+
+    # Import -----------------------------------------------------------------------------
+
+    def find_assemblies(self, cmds: Any) -> List[str]:
+        """Get the groups of assemblies imported into the scene"""
+        tagged = cmds.ls(f"*.{ASSEMBLY_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        return list(tagged or [])
+
+    def import_assembly(
+        self,
+        cmds: Any,
+        descriptor_path: Path,
+        library_root: Path,
+        use_latest: bool = False,
+    ) -> Optional[str]:
+        """
+        Rebuild an assembly's layout under a tagged group, as one undoable step
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .assembly file
+            library_root: Library the children are loaded from
+            use_latest: Load the children's current files instead of their pinned versions
+
+        Returns:
+            Group of the imported assembly, None if no child could be loaded
+        """
+        descriptor_path = Path(descriptor_path)
+        layout = self.load_assembly(descriptor_path)
+        if layout is None:
+            return None
+
+        cmds.undoInfo(openChunk=True, chunkName=f"Import {layout.name}")
+        try:
+            group = cmds.ls(cmds.group(empty=True, name=f"{layout.name}_assembly"), long=True)[0]
+            loaded = 0
+            for child in layout.children:
+                roots = self._load_child(cmds, child, library_root, use_latest)
+                if not roots:
+                    continue
+                loaded += 1
+                placed = [
+                    cmds.ls((cmds.parent(root, group) or [root])[0], long=True)[0]
+                    for root in roots
+                ]
+                self._place(cmds, placed, child.placements)
+
+            if not loaded:
+                cmds.delete(group)
+                print(f"[ERROR] No child of {layout.name} could be loaded")
+                return None
+            cmds.addAttr(group, longName=ASSEMBLY_ATTRIBUTE, dataType="string")
+            cmds.setAttr(
+                f"{group}.{ASSEMBLY_ATTRIBUTE}", descriptor_path.as_posix(), type="string"
+            )
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        skipped = len(layout.children) - loaded
+        note = f", {skipped} missing" if skipped else ""
+        print(f"[OK] Imported assembly {layout.name} as {group} ({loaded} placed{note})")
+        return group
+
+    # Internals --------------------------------------------------------------------------
+
+    def _resolve_file(
+        self, child: AssemblyChild, library_root: Path, use_latest: bool
+    ) -> Tuple[Path, Optional[int]]:
+        """Get the file a child loads and its version (None for the current file)"""
+        asset_file = child.resolve(library_root)
+        if child.is_pinned and not use_latest:
+            version = self._version_service.get_version(asset_file, child.version)
+            if version is not None and version.exists:
+                return version.file_path, version.number
+            print(f"[WARNING] {child.label} snapshot missing, loading the current file")
+        return asset_file, None
+
+    def _load_child(
+        self, cmds: Any, child: AssemblyChild, library_root: Path, use_latest: bool
+    ) -> List[str]:
+        """Reference or import one child, returning its new top-level transforms"""
+        asset_file = child.resolve(library_root)
+        file_path, version = self._resolve_file(child, library_root, use_latest)
+        extension = file_path.suffix.lower()
+        if not file_path.is_file() or extension not in REFERENCE_FILE_TYPES:
+            print(f"[WARNING] Cannot load {child.asset_path}, skipped")
+            return []
+
+        plugin = REFERENCE_PLUGINS.get(extension)
+        if plugin:
+            try:
+                cmds.loadPlugin(plugin, quiet=True)
+            except Exception as e:
+                print(f"[WARNING] {plugin} not available: {e}")
+
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        options: Dict[str, Any] = {"type": REFERENCE_FILE_TYPES[extension], "ignoreVersion": True}
+        if child.mode == ASSEMBLY_MODE_REFERENCE:
+            # Maya numbers clashing namespaces (chair1, chair2...)
+            cmds.file(
+                get_path_mapping_service().to_portable(file_path, library_root),
+                reference=True,
+                namespace=sanitize_namespace(child.name),
+                **options,
+            )
+        else:
+            cmds.file(str(file_path), i=True, **options)
+        roots = [node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before]
+
+        if roots and child.mode == ASSEMBLY_MODE_IMPORT:
+            try:
+                self._scene_asset_service.tag_imported(
+                    cmds, roots, asset_file, library_root, version=version
+                )
+            except Exception as e:
+                print(f"[WARNING] Could not record provenance of {child.name}: {e}")
+        return roots
+
+    def _place(self, cmds: Any, roots: List[str], placements: Dict[str, Matrix]) -> None:
+        """Move loaded roots to their saved world matrices, matched by name"""
+        if len(roots) == 1 and len(placements) == 1:
+            # Imports may be renamed on clashes (chair_grp1); a lone root is unambiguous
+            cmds.xform(roots[0], matrix=list(next(iter(placements.values()))), worldSpace=True)
+            return
+        for root in roots:
+            matrix = placements.get(get_root_name(root))
+            if matrix is not None:
+                cmds.xform(root, matrix=list(matrix), worldSpace=True)
+
+
+# Singleton instance factory
+_assembly_service_instance = None
+
+
+def get_assembly_service() -> AssemblyService:
+    """
+    Get singleton instance of AssemblyService.
+
+    Returns:
+        AssemblyService: Singleton service instance
+    """
+    global _assembly_service_instance
+    if _assembly_service_instance is None:
+        _assembly_service_instance = AssemblyService()
+    return _assembly_service_instance
@@ -0,0 +1,120 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Batch Service Implementation
+Run one action over many selected assets with progress, cancelling, and a report
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The browser asks once, then hands the selection and a per-asset operation to run().
+An operation returns True when it changed the asset and False when there was nothing
+to do; an exception fails that asset only and the batch carries on with the next.
+"""
+
+import logging
+from typing import Any, Callable, Iterable, List, Optional
+
+from ..core.models.batch_operation import BatchOperationReport
+from ..core.models.tag_hierarchy import normalize_tag
+
+# (assets done, total) -> False to stop before the next asset
+Progress = Callable[[int, int], bool]
+
+
+def _asset_name(asset: Any) -> str:
+    """Get the name an asset is reported by"""
+    return str(getattr(asset, "display_name", "") or getattr(asset, "name", "") or asset)
+
+
+class AssetBatchService:
+    """
+    Asset Batch Service - Single Responsibility for multi-selection operations
+    Works on asset objects; saving them stays with the caller's operation
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def run(
+        self,
+        action: str,
+        assets: List[Any],
+        operation: Callable[[Any], bool],
+        progress: Optional[Progress] = None,
+    ) -> BatchOperationReport:
+        """
+        Apply an operation to every asset in turn
+
+        Args:
+            action: Batch action constant the report is labelled with
+            operation: Returns True when it changed the asset
+            progress: Called before each asset and once at the end
+
+        Returns:
+            What happened to each asset
+        """
+        report = BatchOperationReport(action, len(assets))
+        for index, asset in enumerate(assets):
+            if progress is not None and progress(index, len(assets)) is False:
+                report.cancelled = True
+                break
+            name = _asset_name(asset)
+            try:
+                if operation(asset):
+                    report.changed.append(name)
+                else:
+                    report.unchanged.append(name)
+            except Exception as e:
+                self.logger.warning(f"{report.label} failed for {name}: {e}")
+                report.failed.append((name, str(e)))
+        if progress is not None and not report.cancelled:
+            progress(len(assets), len(assets))
+        print(f"[{'OK' if report.is_clean else 'WARNING'}] {report.summary()}")
+        return report
+
+    # Tags -------------------------------------------------------------------------------
+
+    def get_tags(self, assets: Iterable[Any]) -> List[str]:
+        """Get every tag used by the assets, sorted without regard to case"""
+        tags = {}
+        for asset in assets:
+            for tag in getattr(asset, "tags", None) or []:
+                tags.setdefault(tag.lower(), tag)
+        return sorted(tags.values(), key=str.lower)
+
+    def add_tags(self, asset: Any, tags: List[str]) -> bool:
+        """Add tags an asset does not have yet; returns True when any was added"""
+        current = list(getattr(asset, "tags", None) or [])
+        known = {tag.lower() for tag in current}
+        new_tags = [tag for tag in map(normalize_tag, tags) if tag and tag.lower() not in known]
+        if not new_tags:
+            return False
+        asset.tags = current + new_tags
+        return True
+
+    def remove_tags(self, asset: Any, tags: List[str]) -> bool:
+        """Remove tags from an asset; returns True when it had any of them"""
+        current = list(getattr(asset, "tags", None) or [])
+        removed = {tag.lower() for tag in tags}
+        kept = [tag for tag in current if tag.lower() not in removed]
+        if len(kept) == len(current):
+            return False
+        asset.tags = kept
+        return True
+
+
+# Singleton instance factory
+_asset_batch_service_instance = None
+
+
+def get_asset_batch_service() -> AssetBatchService:
+    """
+    Get singleton instance of AssetBatchService.
+
+    Returns:
+        AssetBatchService: Singleton service instance
+    """
+    global _asset_batch_service_instance
+    if _asset_batch_service_instance is None:
+        _asset_batch_service_instance = AssetBatchService()
+    return _asset_batch_service_instance
@@ -0,0 +1,341 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Bundle Service Implementation
+Package selected assets for a vendor, and ingest the bundles they send back
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A bundle is a plain zip, so vendors without the Asset Manager can open it. Each asset
+travels with everything the trash would take for it - thumbnails, collected
+dependencies, LODs, and its version history unless only the latest version is sent -
+plus the library assets it references or places, and the textures and caches it uses::
+
+    crate_delivery.zip
+        bundle.json                                       <- manifest and metadata
+        assets/scenes/crate.ma
+        assets/scenes/.thumbnails/crate_screenshot.png
+        assets/scenes/.versions/crate/v002/crate.ma
+        assets/props/bolt.ma                              <- referenced by crate
+        assets/scenes/.dependencies/crate/textures/wood.png <- from outside the library
+
+Files from outside the library are packed under the asset's dependency folder. The
+manifest lists the license terms of files from vendor libraries, and the export warns
+when any of them may not be redistributed.
+Importing unpacks the bundle at the same library-relative paths, stores its metadata,
+and points paths at the importing library - Maya ASCII scenes and descriptors are
+rewritten, binary scenes are reported for relinking in Maya.
+"""
+
+import json
+import logging
+import zipfile
+from datetime import datetime
+from pathlib import Path, PurePosixPath
+from typing import Any, Callable, Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.asset_bundle import AssetBundle, BundleAsset
+from ..core.models.dependency_graph import (
+    EDGE_ASSEMBLY,
+    EDGE_CACHE,
+    EDGE_REFERENCE,
+    EDGE_TEXTURE,
+    DependencyEdge,
+)
+from ..core.models.library_migration import LibraryMigrationReport
+from .dependency_graph_service_impl import DependencyGraphService
+from .dependency_service_impl import DEPENDENCIES_DIR_NAME, expand_file_pattern
+from .duplicate_service_impl import get_duplicate_service
+from .library_migration_service_impl import get_library_migration_service
+from .metadata_database_impl import get_metadata_database
+from .trash_service_impl import get_trash_service
+from .vendor_library_service_impl import get_vendor_library_service
+from .version_service_impl import get_current_user, get_version_service
+
+BUNDLE_EXTENSION = ".zip"
+BUNDLE_MANIFEST_NAME = "bundle.json"
+BUNDLE_FORMAT_VERSION = 1
+
+# Uses that pull another library asset into the bundle
+ASSET_EDGE_KINDS = {EDGE_REFERENCE, EDGE_ASSEMBLY}
+
+# Folder under the asset's dependency folder receiving files from outside the library
+EXTERNAL_FOLDERS = {EDGE_TEXTURE: "textures", EDGE_CACHE: "caches", EDGE_REFERENCE: "scenes"}
+
+Progress = Callable[[int, int], None]
+
+
+class AssetBundleService:
+    """
+    Asset Bundle Service - Single Responsibility for vendor delivery bundles
+    Pure file and database operations; scenes are read as files, never opened in Maya
+    """
+
+    def __init__(
+        self,
+        graph_service: Optional[DependencyGraphService] = None,
+        database_factory: Optional[Callable[[Path], Any]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._graph_service = graph_service or DependencyGraphService()
+        self._database_factory = database_factory or get_metadata_database
+
+    # Export -----------------------------------------------------------------------------
+
+    def plan_bundle(
+        self,
+        library_root: Path,
+        asset_files: Iterable[Path],
+        latest_only: bool = False,
+        include_dependencies: bool = True,
+    ) -> Tuple[AssetBundle, Dict[str, Path]]:
+        """
+        Work out what a bundle of assets holds, without writing it
+
+        Args:
+            library_root: Library the assets belong to
+            asset_files: Selected asset files
+            latest_only: Leave out version history
+            include_dependencies: Also pack the library assets the selection uses
+
+        Returns:
+            (manifest, bundle path -> file on disk)
+
+        Raises:
+            ValueError: If a selected asset is not in the library
+        """
+        library_root = Path(library_root).resolve()
+        database = self._database_factory(library_root)
+        queue = [(Path(asset_file).resolve(), False) for asset_file in asset_files]
+        for asset_file, _dependency in queue:
+            if self._get_relative(library_root, asset_file) is None:
+                raise ValueError(f"{asset_file.name} is not in the library {library_root.name}")
+
+        members: Dict[str, Path] = {}
+        external: Dict[str, str] = {}
+        assets: List[BundleAsset] = []
+        library_assets: Optional[List[Path]] = None
+        visited = set()
+        while queue:
+            asset_file, dependency = queue.pop(0)
+            if asset_file in visited or not asset_file.is_file():
+                continue
+            visited.add(asset_file)
+            relative = self._get_relative(library_root, asset_file)
+            history = get_version_service().get_history_directory(asset_file)
+            for path in get_trash_service().get_asset_paths(asset_file):
+                if latest_only and path == history:
+                    continue
+                files = [path] if path.is_file() else sorted(path.rglob("*"))
+                for file_path in (f for f in files if f.is_file()):
+                    members.setdefault(self._get_relative(library_root, file_path), file_path)
+
+            versions = 0 if latest_only else len(get_version_service().get_versions(asset_file))
+            metadata = database.get_asset_metadata(asset_file) or {}
+            assets.append(BundleAsset(relative, dependency, versions, metadata))
+
+            if asset_file.suffix.lower() == ".mb" and library_assets is None:
+                library_assets = get_duplicate_service().find_assets(library_root)
+            for edge in self._scan(asset_file, library_root, library_assets):
+                inside = self._get_relative(library_root, edge.target) is not None
+                if edge.kind in ASSET_EDGE_KINDS and inside:
+                    if include_dependencies:
+                        queue.append((edge.target, True))
+                elif inside:
+                    for source in expand_file_pattern(str(edge.target)):
+                        members.setdefault(self._get_relative(library_root, source), source)
+                else:
+                    self._add_external(relative, edge, members, external)
+
+        bundle = AssetBundle(
+            library=library_root.name,
+            source_root=library_root.as_posix(),
+            exported=datetime.now().isoformat(),
+            author=get_current_user(),
+            latest_only=latest_only,
+            assets=tuple(assets),
+            external_files=external,
+            files=len(members),
+            licenses=tuple(get_vendor_library_service().find_licenses(members.values())),
+        )
+        return bundle, members
+
+    def export_bundle(
+        self,
+        library_root: Path,
+        asset_files: Iterable[Path],
+        bundle_file: Path,
+        latest_only: bool = False,
+        include_dependencies: bool = True,
+        progress: Optional[Progress] = None,
+    ) -> AssetBundle:
+        """
+        Write a bundle of assets for delivery
+
+        Args:
+            library_root: Library the assets belong to
+            asset_files: Selected asset files
+            bundle_file: Zip file to write
+            latest_only: Leave out version history
+            include_dependencies: Also pack the library assets the selection uses
+            progress: Called with (files packed, total)
+
+        Returns:
+            The bundle's manifest
+        """
+        bundle, members = self.plan_bundle(
+            library_root, asset_files, latest_only, include_dependencies
+        )
+        for terms in bundle.restricted_licenses:
+            print(f"[WARNING] Bundle carries assets that may not be redistributed: {terms.label}")
+        bundle_file = Path(bundle_file)
+        manifest = {"format": BUNDLE_FORMAT_VERSION, **bundle.to_dict()}
+
+        bundle_file.parent.mkdir(parents=True, exist_ok=True)
+        with zipfile.ZipFile(bundle_file, "w", zipfile.ZIP_DEFLATED, allowZip64=True) as zf:
+            zf.writestr(BUNDLE_MANIFEST_NAME, json.dumps(manifest, indent=2))
+            for index, (name, path) in enumerate(sorted(members.items()), 1):
+                zf.write(path, name)
+                if progress is not None:
+                    progress(index, len(members))
+
+        print(f"[OK] Exported bundle {bundle_file.name}: {bundle.summary()}")
+        return bundle
+
+    # Import -----------------------------------------------------------------------------
+
+    def read_bundle(self, bundle_file: Path) -> AssetBundle:
+        """Get a bundle's manifest, raising ValueError for files that are not bundles"""
+        try:
+            with zipfile.ZipFile(bundle_file) as zf:
+                manifest = json.loads(zf.read(BUNDLE_MANIFEST_NAME).decode("utf-8"))
+        except (KeyError, zipfile.BadZipFile, json.JSONDecodeError) as e:
+            raise ValueError(f"{Path(bundle_file).name} is not an asset bundle: {e}")
+        if int(manifest.get("format", 0)) > BUNDLE_FORMAT_VERSION:
+            raise ValueError(
+                f"{Path(bundle_file).name} was written by a newer Asset Manager "
+                f"(bundle format {manifest['format']})"
+            )
+        return AssetBundle.from_dict(manifest)
+
+    def import_bundle(
+        self,
+        bundle_file: Path,
+        library_root: Path,
+        overwrite: bool = False,
+        progress: Optional[Progress] = None,
+    ) -> LibraryMigrationReport:
+        """
+        Unpack a bundle into a library and point its paths at the library
+
+        Args:
+            bundle_file: Bundle written by export_bundle (or sent back by a vendor)
+            library_root: Library receiving the assets
+            overwrite: Replace assets already in the library instead of refusing
+            progress: Called with (files unpacked, total)
+
+        Returns:
+            What was unpacked and rewritten
+
+        Raises:
+            ValueError: If the file is not a bundle or holds unsafe paths
+            FileExistsError: If bundle files exist in the library and overwrite is off
+        """
+        library_root = Path(library_root)
+        bundle = self.read_bundle(bundle_file)
+        report = LibraryMigrationReport(library_root)
+        migration = get_library_migration_service()
+
+        with zipfile.ZipFile(bundle_file) as zf:
+            unpacked = migration.unpack_archive(
+                zf, library_root, BUNDLE_MANIFEST_NAME, "bundle", overwrite, progress
+            )
+        report.files = len(unpacked)
+
+        # Files from outside the source library first - they lie outside its root too
+        new_root = library_root.resolve().as_posix()
+        path_map = [
+            (original, f"{new_root}/{bundled}")
+            for original, bundled in bundle.external_files.items()
+        ]
+        if bundle.source_root:
+            path_map.append((bundle.source_root, new_root))
+        if path_map:
+            report.paths_rewritten += migration.rewrite_files(unpacked, path_map, report)
+
+        database = self._database_factory(library_root)
+        for asset in bundle.assets:
+            if not asset.metadata:
+                continue
+            metadata, count = migration.rewrite_data(dict(asset.metadata), path_map)
+            database.save_asset_metadata(library_root / asset.path, metadata)
+            report.metadata_rows += 1
+            report.paths_rewritten += count
+
+        print(f"[OK] Imported bundle {Path(bundle_file).name}: {report.summary()}")
+        return report
+
+    # Internals --------------------------------------------------------------------------
+
+    def _scan(
+        self, asset_file: Path, library_root: Path, library_assets: Optional[List[Path]]
+    ) -> List[DependencyEdge]:
+        """Get the uses an asset makes of other files, none if it cannot be read"""
+        try:
+            return self._graph_service.scan_file(asset_file, library_root, library_assets)
+        except (OSError, UnicodeDecodeError, ValueError) as e:
+            print(f"[WARNING] Could not read the dependencies of {asset_file.name}: {e}")
+            return []
+
+    def _add_external(
+        self,
+        asset_relative: str,
+        edge: DependencyEdge,
+        members: Dict[str, Path],
+        external: Dict[str, str],
+    ) -> None:
+        """Pack a file from outside the library under the asset's dependency folder"""
+        original = edge.target.as_posix()
+        sources = expand_file_pattern(str(edge.target))
+        if original in external or not sources:
+            if not sources:
+                print(f"[WARNING] Missing dependency not bundled: {original}")
+            return
+        asset_path = PurePosixPath(asset_relative)
+        folder = (
+            asset_path.parent
+            / DEPENDENCIES_DIR_NAME
+            / asset_path.stem
+            / EXTERNAL_FOLDERS.get(edge.kind, "files")
+        )
+        # Same-named files from different folders go to numbered subfolders
+        base, index = folder, 1
+        while any(members.get((folder / s.name).as_posix(), s) != s for s in sources):
+            folder, index = base / str(index), index + 1
+        for source in sources:
+            members[(folder / source.name).as_posix()] = source
+        external[original] = (folder / edge.target.name).as_posix()
+
+    def _get_relative(self, library_root: Path, path: Path) -> Optional[str]:
+        """Get a library-relative posix path, None outside the library"""
+        try:
+            return Path(path).resolve().relative_to(library_root).as_posix()
+        except ValueError:
+            return None
+
+
+# Singleton instance factory
+_asset_bundle_service_instance = None
+
+
+def get_asset_bundle_service() -> AssetBundleService:
+    """
+    Get singleton instance of AssetBundleService.
+
+    Returns:
+        AssetBundleService: Singleton service instance
+    """
+    global _asset_bundle_service_instance
+    if _asset_bundle_service_instance is None:
+        _asset_bundle_service_instance = AssetBundleService()
+    return _asset_bundle_service_instance
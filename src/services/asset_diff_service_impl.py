@@ -0,0 +1,317 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Diff Service Implementation
+Compare two versions of an asset: nodes, meshes, transforms, shaders, and polycount
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Maya ASCII files are read as text, so versions compare without Maya and without
+touching the open scene. Other formats (.mb, .fbx, .usd...) are imported into a
+temporary namespace through the cmds argument, measured, and deleted again.
+Mesh geometry created by construction history has no stored face list in a .ma
+file; such meshes count as unknown instead of zero.
+"""
+
+import logging
+import re
+import shlex
+import uuid
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Set, Tuple
+
+from ..core.models.asset_diff import UNKNOWN_COUNT, AssetDiff, SceneSnapshot, Vector
+
+TRANSFORM_TYPES = {"transform", "joint"}
+TRANSFORM_ATTRIBUTES = {".t": "translate", ".r": "rotate", ".s": "scale"}
+DEFAULT_TRANSFORM: Tuple[Vector, Vector, Vector] = ((0.0, 0.0, 0.0),) * 2 + ((1.0, 1.0, 1.0),)
+
+# Moves below this are float noise from re-saving the file
+TRANSFORM_TOLERANCE = 1e-4
+
+# Only the start of a statement is kept - mesh data can run for megabytes
+_STATEMENT_HEAD_LENGTH = 400
+
+_SET_ATTR_RE = re.compile(r'^setAttr\s+(?P<flags>(?:-\w+\s+(?:\d+\s+)?)*)"(?P<attr>[^"]+)"')
+_SIZE_FLAG_RE = re.compile(r"-s\s+(\d+)")
+_DOUBLE3_RE = re.compile(r'-type\s+"double3"\s+(\S+)\s+(\S+)\s+(\S+)')
+
+
+class AssetDiffService:
+    """
+    Asset Diff Service - Single Responsibility for comparing asset versions
+    Snapshots hold plain data, so reports can be built and tested without Qt or Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Compare ----------------------------------------------------------------------------
+
+    def compare_files(self, before_file: Path, after_file: Path, cmds: Any = None) -> AssetDiff:
+        """Compare two asset files (cmds is needed for anything but Maya ASCII)"""
+        return self.compare_snapshots(
+            self.take_snapshot(before_file, cmds), self.take_snapshot(after_file, cmds)
+        )
+
+    def compare_snapshots(self, before: SceneSnapshot, after: SceneSnapshot) -> AssetDiff:
+        """Work out what changed from one snapshot to the next"""
+        node_type_changes = [
+            (node_type, before.node_types.get(node_type, 0), after.node_types.get(node_type, 0))
+            for node_type in sorted(set(before.node_types) | set(after.node_types))
+            if before.node_types.get(node_type, 0) != after.node_types.get(node_type, 0)
+        ]
+        kept_meshes = sorted(set(before.meshes) & set(after.meshes))
+        geometry_changes = [
+            (mesh, before.meshes[mesh], after.meshes[mesh])
+            for mesh in kept_meshes
+            if before.meshes[mesh] != after.meshes[mesh]
+        ]
+
+        transform_changes = []
+        for path in sorted(set(before.transforms) & set(after.transforms)):
+            for index, attribute in enumerate(TRANSFORM_ATTRIBUTES.values()):
+                old, new = before.transforms[path][index], after.transforms[path][index]
+                if any(abs(a - b) > TRANSFORM_TOLERANCE for a, b in zip(old, new)):
+                    transform_changes.append((path, attribute, old, new))
+
+        shader_changes = [
+            (mesh, before.shaders.get(mesh, ()), after.shaders.get(mesh, ()))
+            for mesh in kept_meshes
+            if before.shaders.get(mesh, ()) != after.shaders.get(mesh, ())
+        ]
+
+        return AssetDiff(
+            before=before,
+            after=after,
+            node_type_changes=node_type_changes,
+            added_meshes=sorted(set(after.meshes) - set(before.meshes)),
+            removed_meshes=sorted(set(before.meshes) - set(after.meshes)),
+            geometry_changes=geometry_changes,
+            transform_changes=transform_changes,
+            shader_changes=shader_changes,
+        )
+
+    # Snapshots --------------------------------------------------------------------------
+
+    def take_snapshot(self, file_path: Path, cmds: Any = None) -> SceneSnapshot:
+        """
+        Read the comparable content of an asset file
+
+        Raises:
+            ValueError: If the file needs Maya to be read and cmds is None
+        """
+        file_path = Path(file_path)
+        if file_path.suffix.lower() == ".ma":
+            return self._read_maya_ascii(file_path)
+        if cmds is None:
+            raise ValueError(f"Comparing {file_path.suffix} files needs Maya")
+        return self._read_with_maya(cmds, file_path)
+
+    def _read_maya_ascii(self, file_path: Path) -> SceneSnapshot:
+        """Parse createNode, setAttr, and connectAttr statements of a .ma file"""
+        types: Dict[str, str] = {}  # node path -> type
+        short_names: Dict[str, str] = {}  # short name -> node path (latest)
+        intermediate: Set[str] = set()
+        geometry: Dict[str, List[int]] = {}  # mesh path -> [faces, vertices]
+        transforms: Dict[str, List[Vector]] = {}
+        members: Dict[str, Set[str]] = {}  # mesh path -> shading engines
+        surface_shaders: Dict[str, str] = {}  # shading engine -> shader
+
+        def resolve(reference: str) -> str:
+            reference = reference.lstrip(":")
+            if reference.startswith("|"):
+                return reference
+            return short_names.get(reference, reference)
+
+        current: Optional[str] = None
+        for statement in self._read_statements(file_path):
+            command = statement.split(None, 1)[0]
+            if command == "createNode":
+                current = self._create_node(statement, types, short_names, resolve)
+                if current is not None and types[current] in TRANSFORM_TYPES:
+                    transforms[current] = list(DEFAULT_TRANSFORM)
+                elif current is not None and types[current] == "mesh":
+                    geometry[current] = [UNKNOWN_COUNT, UNKNOWN_COUNT]
+            elif command == "setAttr" and current is not None:
+                self._set_attribute(statement, current, transforms, geometry, intermediate)
+            elif command == "connectAttr":
+                try:
+                    source, destination = [t for t in shlex.split(statement) if t[0] != "-"][1:3]
+                except (ValueError, IndexError):
+                    continue
+                source_node, _, source_attr = source.partition(".")
+                target_node, _, target_attr = destination.partition(".")
+                if target_attr.startswith(("dsm", "dagSetMembers")) and source_attr.startswith(
+                    ("iog", "instObjGroups")
+                ):
+                    members.setdefault(resolve(source_node), set()).add(resolve(target_node))
+                elif target_attr in ("ss", "surfaceShader"):
+                    surface_shaders[resolve(target_node)] = resolve(source_node)
+            elif command not in ("setAttr",):
+                current = None  # select, requires, file... end the node being edited
+
+        node_types: Dict[str, int] = {}
+        for node_type in types.values():
+            node_types[node_type] = node_types.get(node_type, 0) + 1
+
+        meshes: Dict[str, Tuple[int, int]] = {}
+        shaders: Dict[str, Tuple[str, ...]] = {}
+        for mesh, (faces, vertices) in geometry.items():
+            if mesh in intermediate:
+                continue
+            parent = mesh.rpartition("|")[0] or mesh
+            meshes[parent] = (faces, vertices)
+            engines = members.get(mesh, set())
+            if engines:
+                shaders[parent] = tuple(sorted(surface_shaders.get(e, e) for e in engines))
+
+        return SceneSnapshot(
+            file_path=file_path,
+            file_size=file_path.stat().st_size,
+            node_types=node_types,
+            meshes=meshes,
+            transforms={path: tuple(values) for path, values in transforms.items()},
+            shaders=shaders,
+        )
+
+    def _read_statements(self, file_path: Path):
+        """Yield the start of every MEL statement (statements end with ';')"""
+        head: List[str] = []
+        length = 0
+        with open(file_path, "r", encoding="utf-8", errors="replace") as f:
+            for line in f:
+                stripped = line.strip()
+                if not head and (not stripped or stripped.startswith("//")):
+                    continue
+                if length < _STATEMENT_HEAD_LENGTH:
+                    head.append(stripped)
+                    length += len(stripped)
+                if stripped.endswith(";"):
+                    yield " ".join(head).rstrip(";").strip()
+                    head, length = [], 0
+
+    def _create_node(
+        self, statement: str, types: Dict[str, str], short_names: Dict[str, str], resolve
+    ) -> Optional[str]:
+        """Register a createNode statement's node, returning its path"""
+        try:
+            tokens = shlex.split(statement)
+        except ValueError:
+            return None
+        if len(tokens) < 2:
+            return None
+        node_type, name, parent = tokens[1], "", ""
+        for flag, value in zip(tokens[2:], tokens[3:]):
+            if flag in ("-n", "-name"):
+                name = value
+            elif flag in ("-p", "-parent"):
+                parent = value
+        if not name:
+            return None
+        name = name.split(":")[-1]
+        if parent:
+            path = f"{resolve(parent.split(':')[-1])}|{name}"
+        elif node_type in TRANSFORM_TYPES:
+            path = f"|{name}"
+        else:
+            path = name
+        types[path] = node_type
+        short_names[name] = path
+        return path
+
+    def _set_attribute(
+        self,
+        statement: str,
+        node: str,
+        transforms: Dict[str, List[Vector]],
+        geometry: Dict[str, List[int]],
+        intermediate: Set[str],
+    ) -> None:
+        """Record the transform, geometry size, or intermediate flag a setAttr sets"""
+        found = _SET_ATTR_RE.match(statement)
+        if found is None:
+            return
+        attribute = found.group("attr")
+        if node in transforms and attribute in TRANSFORM_ATTRIBUTES:
+            values = _DOUBLE3_RE.search(statement)
+            if values:
+                index = list(TRANSFORM_ATTRIBUTES).index(attribute)
+                transforms[node][index] = tuple(float(value) for value in values.groups())
+        elif node in geometry:
+            size = _SIZE_FLAG_RE.search(found.group("flags"))
+            if attribute.startswith(".fc[") and size:
+                geometry[node][0] = max(geometry[node][0], int(size.group(1)))
+            elif attribute.startswith(".vt[") and size:
+                geometry[node][1] = max(geometry[node][1], int(size.group(1)))
+            elif attribute == ".io" and statement.rstrip().endswith("yes"):
+                intermediate.add(node)
+
+    def _read_with_maya(self, cmds: Any, file_path: Path) -> SceneSnapshot:
+        """Import a file into a temporary namespace, measure it, and delete it again"""
+        namespace = f"assetDiff_{uuid.uuid4().hex[:8]}"
+        prefix = f"{namespace}:"
+        cmds.undoInfo(stateWithoutFlush=False)
+        try:
+            cmds.file(str(file_path), i=True, namespace=namespace, returnNewNodes=True)
+            nodes = cmds.ls(f"{prefix}*", long=True) or []
+            node_types: Dict[str, int] = {}
+            for node in nodes:
+                node_type = cmds.nodeType(node)
+                node_types[node_type] = node_types.get(node_type, 0) + 1
+
+            def strip(path: str) -> str:
+                return path.replace(prefix, "")
+
+            meshes: Dict[str, Tuple[int, int]] = {}
+            shaders: Dict[str, Tuple[str, ...]] = {}
+            for shape in cmds.ls(nodes, type="mesh", long=True, noIntermediate=True) or []:
+                parents = cmds.listRelatives(shape, parent=True, fullPath=True) or [shape]
+                parent = strip(parents[0])
+                faces = cmds.polyEvaluate(shape, face=True)
+                vertices = cmds.polyEvaluate(shape, vertex=True)
+                meshes[parent] = (int(faces), int(vertices))
+                engines = set(cmds.listConnections(shape, type="shadingEngine") or [])
+                assigned = set()
+                for engine in engines:
+                    shader = cmds.listConnections(f"{engine}.surfaceShader") or [engine]
+                    assigned.add(strip(shader[0]))
+                if assigned:
+                    shaders[parent] = tuple(sorted(assigned))
+
+            transforms = {}
+            for node in cmds.ls(nodes, type=list(TRANSFORM_TYPES), long=True) or []:
+                transforms[strip(node)] = tuple(
+                    tuple(float(v) for v in cmds.getAttr(f"{node}.{attribute}")[0])
+                    for attribute in TRANSFORM_ATTRIBUTES.values()
+                )
+        finally:
+            if cmds.namespace(exists=namespace):
+                cmds.namespace(removeNamespace=namespace, deleteNamespaceContent=True)
+            cmds.undoInfo(stateWithoutFlush=True)
+
+        return SceneSnapshot(
+            file_path=file_path,
+            file_size=file_path.stat().st_size,
+            node_types=node_types,
+            meshes=meshes,
+            transforms=transforms,
+            shaders=shaders,
+        )
+
+
+# Singleton instance factory
+_asset_diff_service_instance = None
+
+
+def get_asset_diff_service() -> AssetDiffService:
+    """
+    Get singleton instance of AssetDiffService.
+
+    Returns:
+        AssetDiffService: Singleton service instance
+    """
+    global _asset_diff_service_instance
+    if _asset_diff_service_instance is None:
+        _asset_diff_service_instance = AssetDiffService()
+    return _asset_diff_service_instance
@@ -0,0 +1,328 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Rename Service Implementation
+Rename or move library assets without breaking the scenes that reference them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The asset moves with everything stored for it - the files the trash would take -
+renamed after the new asset name::
+
+    assets/scenes/crate.ma                          -> assets/props/barrel.ma
+    assets/scenes/.thumbnails/crate_screenshot.png  -> assets/props/.thumbnails/barrel_...
+    assets/scenes/.versions/crate/v002/crate.ma     -> assets/props/.versions/barrel/v002/...
+    assets/scenes/.lods/crate/crate_lod1.ma         -> assets/props/.lods/barrel/barrel_lod1.ma
+
+The library database follows the asset and keeps a redirect from the old path.
+Scenes still referencing old paths are repathed from the redirects, right after the
+rename or later from Edit > Repair Renamed References.
+"""
+
+import json
+import logging
+import re
+import shutil
+from pathlib import Path
+from typing import Any, Dict, Iterable, List, Optional, Tuple
+
+from .dependency_service_impl import DEPENDENCIES_DIR_NAME
+from .duplicate_service_impl import get_duplicate_service
+from .lock_service_impl import get_lock_service
+from .lod_service_impl import MANIFEST_FILE_NAME as LOD_MANIFEST_FILE_NAME
+from .lod_service_impl import get_lod_service
+from .trash_service_impl import get_trash_service
+from .version_service_impl import (
+    MANIFEST_FILE_NAME as VERSION_MANIFEST_FILE_NAME,
+    get_current_user,
+    get_version_service,
+)
+
+_VERSION_FOLDER = re.compile(r"v\d{3,}")
+_MA_STRING = re.compile(r'"((?:[^"\\]|\\.)*)"')
+
+
+def _rename_part(part: str, old_stem: str, new_stem: str) -> str:
+    """Rename one path component named after an asset (crate.ma.meta, crate_lod1.ma)"""
+    if part == old_stem or part.startswith((f"{old_stem}_", f"{old_stem}.")):
+        return new_stem + part[len(old_stem):]
+    return part
+
+
+class AssetRenameService:
+    """
+    Asset Rename Service - Single Responsibility for renaming and moving assets
+    Pure file and database operations; the open Maya scene is repathed by the caller
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Rename -----------------------------------------------------------------------------
+
+    def check_rename(self, library_root: Path, asset_file: Path, new_file: Path) -> str:
+        """Get why an asset cannot be renamed to a path, "" when it can"""
+        library_root, asset_file, new_file = Path(library_root), Path(asset_file), Path(new_file)
+        if not asset_file.is_file():
+            return f"{asset_file.name} does not exist"
+        if not new_file.stem:
+            return "Enter a name for the asset"
+        if new_file.suffix.lower() != asset_file.suffix.lower():
+            return f"The asset must keep its {asset_file.suffix} extension"
+        try:
+            new_file.resolve().relative_to(library_root.resolve())
+        except ValueError:
+            return "Assets can only be moved within their library"
+        if new_file.resolve() == asset_file.resolve():
+            return "The asset already has this name"
+        lock = get_lock_service().get_lock(asset_file)
+        if lock is not None and not lock.is_owned_by(get_current_user()):
+            return f"{asset_file.name} is checked out by {lock.user}"
+        taken = [target for _source, target in self.plan_moves(asset_file, new_file)]
+        taken = [target for target in taken if target.exists()]
+        if taken:
+            return f"{taken[0].name} already exists in {taken[0].parent}"
+        return ""
+
+    def plan_moves(self, asset_file: Path, new_file: Path) -> List[Tuple[Path, Path]]:
+        """Get (current, new) of the asset file and every file or folder stored for it"""
+        asset_file, new_file = Path(asset_file), Path(new_file)
+        moves = []
+        for path in get_trash_service().get_asset_paths(asset_file):
+            if path == asset_file:
+                moves.append((path, new_file))
+                continue
+            relative = path.relative_to(asset_file.parent)
+            parts = [_rename_part(p, asset_file.stem, new_file.stem) for p in relative.parts]
+            moves.append((path, new_file.parent.joinpath(*parts)))
+        return moves
+
+    def rename(
+        self, library_root: Path, asset_file: Path, new_file: Path, database: Any = None
+    ) -> Dict[Path, Path]:
+        """
+        Rename or move an asset with its thumbnails, history, LODs, and metadata
+
+        Args:
+            library_root: Library the asset belongs to
+            asset_file: Asset file to rename
+            new_file: New asset file path inside the same library
+            database: Library MetadataDatabase to update (files only when omitted)
+
+        Returns:
+            Old file -> new file of everything scenes may reference (asset, version
+            snapshots, LOD variants)
+
+        Raises:
+            ValueError: If the asset cannot be renamed to new_file
+        """
+        asset_file, new_file = Path(asset_file), Path(new_file)
+        problem = self.check_rename(library_root, asset_file, new_file)
+        if problem:
+            raise ValueError(problem)
+
+        old_lods = get_lod_service().get_variants(asset_file)
+        locked = get_lock_service().get_lock(asset_file) is not None
+        moves = self.plan_moves(asset_file, new_file)
+        for source, target in moves:
+            target.parent.mkdir(parents=True, exist_ok=True)
+            shutil.move(str(source), str(target))
+        sources = [source for source, _target in moves]
+        if locked:
+            # The artist's check-out follows the asset
+            get_lock_service().check_in(asset_file)
+            get_lock_service().check_out(new_file)
+            sources.append(get_lock_service().get_lock_file(asset_file))
+        self._remove_empty_folders(asset_file.parent, sources)
+
+        redirects = {asset_file: new_file}
+        if asset_file.stem != new_file.stem:
+            redirects.update(self._rename_history(asset_file, new_file))
+            redirects.update(self._rename_lods(asset_file, new_file, old_lods))
+            self._repath_dependencies(asset_file.stem, new_file.stem, redirects.values())
+
+        if database is not None:
+            self._update_database(database, asset_file, new_file, moves, redirects)
+
+        print(f"[OK] Renamed {asset_file.name} to {new_file}")
+        return redirects
+
+    # Redirects --------------------------------------------------------------------------
+
+    def get_redirects(self, database: Any) -> Dict[Path, Path]:
+        """Get old file -> new file for every renamed asset and its version snapshots"""
+        library_root = Path(database.library_root)
+        version_service = get_version_service()
+        redirects: Dict[Path, Path] = {}
+        for old_key, new_key in database.get_redirects().items():
+            old_file, new_file = library_root / old_key, library_root / new_key
+            redirects[old_file] = new_file
+            old_history = version_service.get_history_directory(old_file)
+            for version in version_service.get_versions(new_file):
+                old_snapshot = old_history / version.file_path.parent.name / old_file.name
+                redirects[old_snapshot] = version.file_path
+        return redirects
+
+    def repair_references(
+        self, search_roots: Iterable[Path], redirects: Dict[Path, Path]
+    ) -> Tuple[List[Path], List[Path]]:
+        """
+        Repath project scenes still referencing renamed files
+
+        Returns:
+            (repathed scenes, Maya binary scenes naming an old file - repath in Maya)
+        """
+        if not redirects:
+            return [], []
+        return get_duplicate_service().redirect_paths(search_roots, redirects)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _rename_history(self, asset_file: Path, new_file: Path) -> Dict[Path, Path]:
+        """Rename the version snapshots in the moved history folder and its manifest"""
+        history_dir = get_version_service().get_history_directory(new_file)
+        old_history = get_version_service().get_history_directory(asset_file)
+        redirects = {}
+        for version_dir in sorted(history_dir.glob("v*")):
+            snapshot = version_dir / asset_file.name
+            if _VERSION_FOLDER.fullmatch(version_dir.name) and snapshot.is_file():
+                snapshot.rename(version_dir / new_file.name)
+                redirects[old_history / version_dir.name / asset_file.name] = (
+                    version_dir / new_file.name
+                )
+
+        def update(manifest: Dict[str, Any]) -> None:
+            manifest["asset_name"] = new_file.stem
+            for entry in manifest.get("versions", []):
+                entry["asset_name"] = new_file.stem
+                file_path = Path(entry.get("file_path", ""))
+                if file_path.name == asset_file.name:
+                    entry["file_path"] = (file_path.parent / new_file.name).as_posix()
+
+        self._update_manifest(history_dir / VERSION_MANIFEST_FILE_NAME, update)
+        return redirects
+
+    def _rename_lods(
+        self, asset_file: Path, new_file: Path, variants: List[Any]
+    ) -> Dict[Path, Path]:
+        """Rename the LOD variants in the moved LOD folder and its manifest"""
+        lod_dir = get_lod_service().get_lod_directory(new_file)
+        redirects = {}
+        for variant in variants:
+            if variant.is_base:
+                continue
+            moved = lod_dir / variant.file_path.name
+            renamed = lod_dir / _rename_part(moved.name, asset_file.stem, new_file.stem)
+            if moved.is_file():
+                moved.rename(renamed)
+                redirects[variant.file_path] = renamed
+
+        def update(manifest: Dict[str, Any]) -> None:
+            for entry in manifest.get("variants", {}).values():
+                entry["file"] = _rename_part(entry.get("file", ""), asset_file.stem, new_file.stem)
+
+        self._update_manifest(lod_dir / LOD_MANIFEST_FILE_NAME, update)
+        return redirects
+
+    def _repath_dependencies(self, old_stem: str, new_stem: str, files: Iterable[Path]) -> None:
+        """Point asset-relative dependency paths in moved Maya ASCII files at the new folder"""
+        old_prefix = f"{DEPENDENCIES_DIR_NAME}/{old_stem}/"
+        new_prefix = f"{DEPENDENCIES_DIR_NAME}/{new_stem}/"
+
+        def replace(match):
+            value = match.group(1).replace("\\\\", "/")
+            if not value.startswith(old_prefix):
+                return match.group(0)
+            return f'"{new_prefix}{value[len(old_prefix):]}"'
+
+        for path in files:
+            if path.suffix.lower() != ".ma" or not path.is_file():
+                continue
+            try:
+                text = path.read_text(encoding="utf-8")
+                repathed = _MA_STRING.sub(replace, text)
+                if repathed != text:
+                    path.write_text(repathed, encoding="utf-8")
+            except (OSError, UnicodeDecodeError) as e:
+                print(f"[WARNING] Could not repath dependencies of {path.name}: {e}")
+
+    def _update_database(
+        self,
+        database: Any,
+        asset_file: Path,
+        new_file: Path,
+        moves: List[Tuple[Path, Path]],
+        redirects: Dict[Path, Path],
+    ) -> None:
+        """Move the asset's metadata and checksums, and remember where it went"""
+        database.rename_asset(asset_file, new_file)
+        database.add_redirect(asset_file, new_file, get_current_user())
+
+        # Checksums are keyed by file; files inside moved folders move with them
+        asset_key = database.get_asset_key(new_file)
+        moved_files = dict(redirects)
+        for source, target in moves:
+            moved_files.setdefault(source, target)
+        renamed: Dict[Path, str] = {}
+        stale = []
+        for file_key, record in database.get_checksums().items():
+            if record["asset"] != asset_key:
+                continue
+            new_path = self._get_moved_path(database.library_root / file_key, moved_files)
+            if new_path is not None:
+                stale.append(file_key)
+                renamed[new_path] = record["sha256"]
+        database.remove_checksums(stale)
+        database.record_checksums(new_file, renamed)
+
+    def _get_moved_path(self, path: Path, moved: Dict[Path, Path]) -> Optional[Path]:
+        """Get where a file went when it or one of its folders was moved"""
+        if path in moved:
+            return moved[path]
+        for source, target in moved.items():
+            try:
+                return target / path.relative_to(source)
+            except ValueError:
+                continue
+        return None
+
+    def _update_manifest(self, manifest_file: Path, update) -> None:
+        """Rewrite a moved JSON manifest in place"""
+        if not manifest_file.is_file():
+            return
+        try:
+            with open(manifest_file, "r", encoding="utf-8") as f:
+                manifest = json.load(f)
+            update(manifest)
+            with open(manifest_file, "w", encoding="utf-8") as f:
+                json.dump(manifest, f, indent=2)
+        except Exception as e:
+            self.logger.error(f"Failed to update manifest {manifest_file}: {e}")
+
+    def _remove_empty_folders(self, asset_dir: Path, sources: List[Path]) -> None:
+        """Remove bookkeeping folders (.thumbnails, .versions...) the move left empty"""
+        for source in sources:
+            folder = source.parent
+            while folder != asset_dir and asset_dir in folder.parents:
+                try:
+                    folder.rmdir()
+                except OSError:
+                    break
+                folder = folder.parent
+
+
+# Singleton instance factory
+_asset_rename_service_instance = None
+
+
+def get_asset_rename_service() -> AssetRenameService:
+    """
+    Get singleton instance of AssetRenameService.
+
+    Returns:
+        AssetRenameService: Singleton service instance
+    """
+    global _asset_rename_service_instance
+    if _asset_rename_service_instance is None:
+        _asset_rename_service_instance = AssetRenameService()
+    return _asset_rename_service_instance
@@ -18,6 +18,62 @@ from ..core.interfaces.metadata_extractor import IMetadataExtractor
 from ..core.models.asset import Asset
 from ..core.models.search_criteria import SearchCriteria, SortBy, SortOrder
 from ..config.constants import SEARCH_CONFIG
+from .asset_type_service_impl import get_asset_type_service
+
+
+def generate_asset_id(file_path: Path) -> str:
+    """Generate unique asset ID from file path (the same ID wherever it is computed)"""
+    path_str = str(Path(file_path).resolve())
+    return hashlib.md5(path_str.encode()).hexdigest()
+
+
+def get_asset_type(file_path: Path) -> str:
+    """Get the asset type of a file from its extension (unknown for non-assets)"""
+    asset_type = get_builtin_asset_type(file_path)
+    if asset_type == "unknown":
+        # Studio asset types from plugin folders
+        plugin = get_asset_type_service().get_plugin_for_extension(file_path.suffix)
+        if plugin is not None:
+            return plugin.type_id
+    return asset_type
+
+
+def get_builtin_asset_type(file_path: Path) -> str:
+    """Get the asset type of a file the library knows without plugins"""
+    ext = file_path.suffix.lower()
+
+    if ext in {".ma", ".mb", ".mel"}:
+        return "maya_scene"
+    elif ext in {".obj", ".fbx", ".abc", ".usd", ".usda", ".usdc", ".usdz"}:
+        return "3d_model"
+    elif ext in {".png", ".jpg", ".jpeg", ".tiff", ".tga", ".exr", ".hdr"}:
+        return "image"
+    elif ext in {".mov", ".mp4", ".avi"}:
+        return "video"
+    elif ext in {".mtl", ".mat", ".material", ".mtlx"}:
+        return "material"
+    elif ext in {".zip", ".rar"}:
+        return "archive"
+    elif ext == ".animclip":
+        return "anim_clip"
+    elif ext == ".pose":
+        return "pose"
+    elif ext == ".blendshape":
+        return "blendshape"
+    elif ext == ".skinweights":
+        return "skin_weights"
+    elif ext == ".lightrig":
+        return "light_rig"
+    elif ext == ".groom":
+        return "groom"
+    elif ext == ".texset":
+        return "texture_set"
+    elif ext == ".assembly":
+        return "assembly"
+    elif ext == ".volume":
+        return "volume"
+    else:
+        return "unknown"
 
 
 class AssetRepositoryImpl(IAssetRepository):
@@ -39,7 +95,10 @@ class AssetRepositoryImpl(IAssetRepository):
             ".obj",
             ".fbx",
             ".abc",
-            ".usd",  # 3D formats
+            ".usd",
+            ".usda",
+            ".usdc",
+            ".usdz",  # 3D formats
             ".png",
             ".jpg",
             ".jpeg",
@@ -54,6 +113,17 @@ class AssetRepositoryImpl(IAssetRepository):
             ".mat",  # Material files
             ".zip",
             ".rar",  # Compressed assets
+            ".animclip",  # Animation clips
+            ".pose",  # Rig poses
+            ".blendshape",  # Blendshape target sets
+            ".skinweights",  # Skin weights and influence lists
+            ".material",  # Material presets
+            ".mtlx",  # MaterialX documents from Houdini, Mari...
+            ".lightrig",  # Light rigs and HDRI environments
+            ".groom",  # XGen and Yeti grooms
+            ".texset",  # Texture sets
+            ".assembly",  # Layouts of other assets
+            ".volume",  # OpenVDB volume sequences
             # Note: .txt, .md, .json removed to prevent project files from appearing
         }
 
@@ -67,13 +137,15 @@ class AssetRepositoryImpl(IAssetRepository):
 
     def _generate_asset_id(self, file_path: Path) -> str:
         """Generate unique asset ID from file path"""
-        path_str = str(file_path.resolve())
-        return hashlib.md5(path_str.encode()).hexdigest()
+        return generate_asset_id(file_path)
 
     def _is_supported_file(self, file_path: Path) -> bool:
         """Check if file extension is supported and not a project management file"""
-        # Check if extension is supported
-        if file_path.suffix.lower() not in self._supported_extensions:
+        # Check if extension is supported (by the library or a studio asset type)
+        extension = file_path.suffix.lower()
+        if extension not in self._supported_extensions and (
+            get_asset_type_service().get_plugin_for_extension(extension) is None
+        ):
             return False
 
         # Exclude project management files
@@ -92,6 +164,14 @@ class AssetRepositoryImpl(IAssetRepository):
 
         return True
 
+    def _is_in_hidden_directory(self, file_path: Path, root: Path) -> bool:
+        """Check if file lives below a hidden folder (e.g. .versions) inside the root"""
+        try:
+            relative_parts = file_path.relative_to(root).parts[:-1]
+        except ValueError:
+            return False
+        return any(part.startswith(".") for part in relative_parts)
+
     def _create_asset_from_path(self, file_path: Path) -> Optional[Asset]:
         """
         Create Asset object from file path
@@ -132,22 +212,7 @@ class AssetRepositoryImpl(IAssetRepository):
 
     def _determine_asset_type(self, file_path: Path) -> str:
         """Determine asset type from file extension"""
-        ext = file_path.suffix.lower()
-
-        if ext in {".ma", ".mb", ".mel"}:
-            return "maya_scene"
-        elif ext in {".obj", ".fbx", ".abc", ".usd"}:
-            return "3d_model"
-        elif ext in {".png", ".jpg", ".jpeg", ".tiff", ".tga", ".exr", ".hdr"}:
-            return "image"
-        elif ext in {".mov", ".mp4", ".avi"}:
-            return "video"
-        elif ext in {".mtl", ".mat"}:
-            return "material"
-        elif ext in {".zip", ".rar"}:
-            return "archive"
-        else:
-            return "unknown"
+        return get_asset_type(file_path)
 
     def find_all(self, directory: Path) -> List[Asset]:
         """
@@ -164,6 +229,7 @@ class AssetRepositoryImpl(IAssetRepository):
             for file_path in directory.rglob("*"):
                 if (
                     file_path.is_file()
+                    and not self._is_in_hidden_directory(file_path, directory)
                     and self._is_supported_file(file_path)
                     and not self._is_asset_removed(file_path)  # Filter out removed assets
                 ):
@@ -0,0 +1,123 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Status Service Implementation
+Approval workflow moving library assets through WIP, review, approval, and deprecation
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Status is stored with the asset's metadata under "status"::
+
+    WIP -> Pending Review        any artist who can edit metadata (submit for review)
+    Pending Review -> Approved   reviewers (roles with the approve permission)
+    any -> Deprecated            reviewers; importing a deprecated asset asks first
+    Approved / Deprecated -> *   reviewers
+
+Filter the library with status:approved, status:review, status:deprecated, status:wip.
+"""
+
+import logging
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.asset_status import REVIEW_STATUSES, STATUSES, AssetStatus
+from ..core.models.library_permissions import ACTION_APPROVE, ACTION_EDIT
+from .permission_service_impl import get_permission_service
+from .version_service_impl import get_current_user
+
+STATUS_METADATA_KEY = "status"
+
+
+class AssetStatusService:
+    """
+    Asset Status Service - Single Responsibility for asset lifecycle changes
+    Role checks happen here so every caller enforces the same rules
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def get_status(self, metadata: Optional[Dict[str, Any]]) -> AssetStatus:
+        """Get the status stored in asset metadata (WIP when never set)"""
+        stored = (metadata or {}).get(STATUS_METADATA_KEY)
+        return AssetStatus.from_dict(stored if isinstance(stored, dict) else None)
+
+    def get_required_action(self, current: str, new_state: str) -> str:
+        """Get the permission a status change needs"""
+        if new_state in REVIEW_STATUSES or current in REVIEW_STATUSES:
+            return ACTION_APPROVE
+        return ACTION_EDIT
+
+    def can_change(self, library_root: Optional[Path], current: str, new_state: str) -> bool:
+        """Check if the current artist may make a status change"""
+        action = self.get_required_action(current, new_state)
+        return get_permission_service().is_allowed(library_root, action)
+
+    def change_status(
+        self,
+        library_root: Optional[Path],
+        metadata: Optional[Dict[str, Any]],
+        new_state: str,
+        note: str = "",
+        user: Optional[str] = None,
+    ) -> AssetStatus:
+        """
+        Move an asset to another state
+
+        Args:
+            library_root: Library whose permissions apply
+            metadata: Asset metadata holding the current status
+            new_state: One of STATUSES
+            note: Review comment shown with the status
+            user: Reviewer, defaults to the current artist
+
+        Returns:
+            New status to store under STATUS_METADATA_KEY
+
+        Raises:
+            ValueError: If the state does not exist
+            PermissionDenied: If the artist's role does not allow the change
+        """
+        if new_state not in STATUSES:
+            raise ValueError(f"Unknown asset status: {new_state}")
+        current = self.get_status(metadata)
+        user = user or get_current_user()
+        get_permission_service().check(
+            library_root, self.get_required_action(current.state, new_state), user
+        )
+        status = AssetStatus(
+            state=new_state,
+            changed_by=user,
+            changed_date=datetime.now().isoformat(timespec="seconds"),
+            note=note.strip(),
+        )
+        print(f"[OK] Status {current.label} -> {status.label} by {user}")
+        return status
+
+    def filter_assets(self, assets: List[Any], state: str) -> List[Any]:
+        """Get the assets in one state ("" keeps every asset)"""
+        if not state:
+            return list(assets)
+        return [
+            asset
+            for asset in assets
+            if self.get_status(getattr(asset, "metadata", None)).state == state
+        ]
+
+
+# Singleton instance factory
+_asset_status_service_instance = None
+
+
+def get_asset_status_service() -> AssetStatusService:
+    """
+    Get singleton instance of AssetStatusService.
+
+    Returns:
+        AssetStatusService: Singleton service instance
+    """
+    global _asset_status_service_instance
+    if _asset_status_service_instance is None:
+        _asset_status_service_instance = AssetStatusService()
+    return _asset_status_service_instance
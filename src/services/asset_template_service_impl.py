@@ -0,0 +1,274 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Template Service Implementation
+Publishable starting scenes new assets are created from, with their render settings
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A template is a Maya ASCII scene holding the studio's group structure, plus a settings
+file with the render settings to restore and the values the publish dialog starts with::
+
+    MyProject/.assetmanager/templates/Hero_Prop.json
+    MyProject/.assetmanager/templates/Hero_Prop.ma
+
+Nodes named with the ASSET placeholder (ASSET_grp|ASSET_geo) are renamed for the new
+asset when a scene is created from the template. The new scene remembers its template
+and asset name in fileInfo, so the publish dialog is filled in even after a reopen.
+"""
+
+import json
+import logging
+import shutil
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.asset_template import NODE_NAME_TOKEN, AssetTemplate
+from ..core.models.naming_template import sanitize_token_value
+from .version_service_impl import get_current_user
+
+SETTINGS_DIR_NAME = ".assetmanager"
+TEMPLATES_DIR_NAME = "templates"
+TEMPLATE_SCENE_EXTENSION = ".ma"
+
+# fileInfo keys of a scene created from a template
+TEMPLATE_FILE_INFO = "assetManagerTemplate"
+ASSET_NAME_FILE_INFO = "assetManagerTemplateAsset"
+
+# Render settings a template restores; the group structure travels in the scene itself
+RENDER_SETTING_ATTRIBUTES = (
+    "defaultRenderGlobals.currentRenderer",
+    "defaultRenderGlobals.imageFilePrefix",
+    "defaultRenderGlobals.animation",
+    "defaultRenderGlobals.startFrame",
+    "defaultRenderGlobals.endFrame",
+    "defaultResolution.width",
+    "defaultResolution.height",
+    "defaultResolution.deviceAspectRatio",
+)
+
+
+class AssetTemplateService:
+    """
+    Asset Template Service - Single Responsibility for template storage and new scenes
+    All Maya calls go through the cmds argument so templates can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Storage ----------------------------------------------------------------------------
+
+    def get_templates_dir(self, library_root: Path) -> Path:
+        """Get the folder holding a library's asset templates"""
+        return Path(library_root) / SETTINGS_DIR_NAME / TEMPLATES_DIR_NAME
+
+    def get_scene_file(self, library_root: Path, template_name: str) -> Path:
+        """Get the starting scene of a template"""
+        file_name = sanitize_token_value(template_name) + TEMPLATE_SCENE_EXTENSION
+        return self.get_templates_dir(library_root) / file_name
+
+    def _get_settings_file(self, library_root: Path, template_name: str) -> Path:
+        """Get the settings file of a template"""
+        return self.get_scene_file(library_root, template_name).with_suffix(".json")
+
+    def list_templates(self, library_root: Optional[Path]) -> List[AssetTemplate]:
+        """Get a library's templates whose scene is present, sorted by name"""
+        if library_root is None:
+            return []
+        templates = []
+        for settings_file in sorted(self.get_templates_dir(library_root).glob("*.json")):
+            try:
+                with open(settings_file, "r", encoding="utf-8") as f:
+                    template = AssetTemplate.from_dict(json.load(f))
+            except Exception as e:
+                print(f"[WARNING] Skipping unreadable asset template {settings_file}: {e}")
+                continue
+            if self.get_scene_file(library_root, template.name).is_file():
+                templates.append(template)
+        return sorted(templates, key=lambda template: template.name.lower())
+
+    def get_template(self, library_root: Optional[Path], name: str) -> Optional[AssetTemplate]:
+        """Get a template by name (None if the library has no such template)"""
+        for template in self.list_templates(library_root):
+            if template.name == name:
+                return template
+        return None
+
+    def save_template(
+        self, library_root: Path, template: AssetTemplate, scene_file: Path
+    ) -> bool:
+        """
+        Store a template, copying its starting scene into the library
+
+        Args:
+            scene_file: Maya ASCII scene; may already be the template's own scene file
+        """
+        target = self.get_scene_file(library_root, template.name)
+        try:
+            target.parent.mkdir(parents=True, exist_ok=True)
+            if Path(scene_file).resolve() != target.resolve():
+                shutil.copy2(scene_file, target)
+            with open(
+                self._get_settings_file(library_root, template.name), "w", encoding="utf-8"
+            ) as f:
+                json.dump(template.to_dict(), f, indent=2)
+            print(f"[OK] Saved asset template {template.name}")
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save asset template {template.name}: {e}")
+            return False
+
+    def delete_template(self, library_root: Path, name: str) -> bool:
+        """Remove a template and its starting scene"""
+        removed = False
+        for path in (
+            self._get_settings_file(library_root, name),
+            self.get_scene_file(library_root, name),
+        ):
+            if path.is_file():
+                path.unlink()
+                removed = True
+        return removed
+
+    # Maya scenes ------------------------------------------------------------------------
+
+    def capture_render_settings(self, cmds: Any) -> Tuple[Tuple[str, Any], ...]:
+        """Get the scene's values of the render settings templates restore"""
+        settings = []
+        for attribute in RENDER_SETTING_ATTRIBUTES:
+            try:
+                value = cmds.getAttr(attribute)
+            except Exception:
+                continue  # Renderer not loaded or attribute missing
+            if value is not None:
+                settings.append((attribute, value))
+        return tuple(settings)
+
+    def save_scene_as_template(
+        self, cmds: Any, library_root: Path, template: AssetTemplate
+    ) -> Optional[AssetTemplate]:
+        """
+        Save the open scene and its render settings as a template
+
+        Returns:
+            The stored template, or None if it could not be saved
+        """
+        scene_file = self.get_scene_file(library_root, template.name)
+        saved = AssetTemplate(
+            name=template.name,
+            category=template.category,
+            description=template.description,
+            name_pattern=template.name_pattern,
+            tags=template.tags,
+            export_format=template.export_format,
+            render_settings=self.capture_render_settings(cmds),
+            created_by=get_current_user(),
+            created_date=datetime.now().isoformat(timespec="seconds"),
+        )
+        try:
+            scene_file.parent.mkdir(parents=True, exist_ok=True)
+            cmds.file(str(scene_file), exportAll=True, type="mayaAscii", force=True)
+        except Exception as e:
+            self.logger.error(f"Failed to export template scene {scene_file}: {e}")
+            return None
+        return saved if self.save_template(library_root, saved, scene_file) else None
+
+    def create_scene(
+        self, cmds: Any, library_root: Path, template: AssetTemplate, name: str
+    ) -> List[str]:
+        """
+        Open a new scene seeded from a template for a new asset
+
+        Args:
+            name: Asset name the artist typed, before the template's name pattern
+
+        Returns:
+            Nodes renamed from the ASSET placeholder
+
+        Raises:
+            FileNotFoundError: If the template's scene is missing
+        """
+        scene_file = self.get_scene_file(library_root, template.name)
+        if not scene_file.is_file():
+            raise FileNotFoundError(f"Template scene not found: {scene_file}")
+        node_name = sanitize_token_value(template.get_asset_name(name))
+
+        cmds.file(new=True, force=True)
+        new_nodes = cmds.file(
+            str(scene_file), i=True, type="mayaAscii", ignoreVersion=True, returnNewNodes=True
+        )
+
+        # Deepest first, so renaming a group does not change the paths still to rename
+        renamed = []
+        placeholders = [
+            node
+            for node in cmds.ls(new_nodes or [], long=True) or []
+            if NODE_NAME_TOKEN in node.rsplit("|", 1)[-1]
+        ]
+        for node in sorted(placeholders, key=lambda node: node.count("|"), reverse=True):
+            short_name = node.rsplit("|", 1)[-1]
+            try:
+                renamed.append(cmds.rename(node, short_name.replace(NODE_NAME_TOKEN, node_name)))
+            except Exception as e:
+                print(f"[WARNING] Could not rename template node {node}: {e}")
+
+        for attribute, value in template.render_settings:
+            try:
+                if isinstance(value, str):
+                    cmds.setAttr(attribute, value, type="string")
+                else:
+                    cmds.setAttr(attribute, value)
+            except Exception as e:
+                print(f"[WARNING] Could not restore render setting {attribute}: {e}")
+
+        cmds.fileInfo(TEMPLATE_FILE_INFO, template.name)
+        cmds.fileInfo(ASSET_NAME_FILE_INFO, name.strip())
+        print(f"[OK] New scene for {node_name} from template {template.name}")
+        return renamed
+
+    def get_scene_template(
+        self, cmds: Any, library_root: Optional[Path]
+    ) -> Optional[Tuple[AssetTemplate, str]]:
+        """
+        Get the template the open scene was created from
+
+        Returns:
+            (template, asset name typed at creation), or None for other scenes
+        """
+        template_name = (cmds.fileInfo(TEMPLATE_FILE_INFO, query=True) or [""])[0]
+        if not template_name:
+            return None
+        template = self.get_template(library_root, template_name)
+        if template is None:
+            return None
+        name = (cmds.fileInfo(ASSET_NAME_FILE_INFO, query=True) or [""])[0]
+        return template, name
+
+    def get_publish_defaults(self, template: AssetTemplate, name: str) -> Dict[str, Any]:
+        """Get the values the publish dialog starts with for an asset from a template"""
+        return {
+            "name": template.get_asset_name(name) if name.strip() else "",
+            "category": template.category,
+            "description": template.description,
+            "tags": list(template.tags),
+            "format": template.export_format,
+        }
+
+
+# Singleton instance factory
+_asset_template_service_instance = None
+
+
+def get_asset_template_service() -> AssetTemplateService:
+    """
+    Get singleton instance of AssetTemplateService.
+
+    Returns:
+        AssetTemplateService: Singleton service instance
+    """
+    global _asset_template_service_instance
+    if _asset_template_service_instance is None:
+        _asset_template_service_instance = AssetTemplateService()
+    return _asset_template_service_instance
@@ -0,0 +1,231 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Type Service Implementation
+Discovers studio asset types from plugin folders and runs their export, import,
+and thumbnail code
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Asset type folders, in load order (a later type with the same extension replaces an
+earlier one)::
+
+    ~/.assetmanager/asset_types/              <- per-artist types
+    $ASSET_MANAGER_ASSET_TYPES_PATH           <- studio folders (os.pathsep separated)
+    <library>/.assetmanager/asset_types/      <- types shipped with a shared library
+
+Extensions of the built-in asset types cannot be claimed by a plugin. Types are
+re-read when another library is loaded; lookups without a library use the last one.
+"""
+
+import importlib.util
+import inspect
+import logging
+import os
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.interfaces.asset_type_plugin import IAssetTypePlugin
+
+ASSET_TYPES_PATH_ENV = "ASSET_MANAGER_ASSET_TYPES_PATH"
+ASSET_TYPES_DIR_NAME = "asset_types"
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+THUMBNAILS_DIR_NAME = ".thumbnails"
+
+
+class AssetTypeService:
+    """
+    Asset Type Service - Single Responsibility for studio asset type plugins
+    A broken plugin is skipped or reported; it never stops the library from loading
+    """
+
+    def __init__(self, user_types_dir: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._user_types_dir = user_types_dir or USER_CONFIG_DIR / ASSET_TYPES_DIR_NAME
+        self._plugins: Dict[str, IAssetTypePlugin] = {}  # Extension -> plugin
+        self._loaded_library: Optional[str] = None
+        self._is_loaded = False
+
+    # Discovery --------------------------------------------------------------------------
+
+    def get_type_directories(self, library_root: Optional[Path] = None) -> List[Path]:
+        """Get asset type folders in load order"""
+        directories = [self._user_types_dir]
+        for entry in os.environ.get(ASSET_TYPES_PATH_ENV, "").split(os.pathsep):
+            if entry.strip():
+                directories.append(Path(entry.strip()))
+        if library_root:
+            directories.append(Path(library_root) / ".assetmanager" / ASSET_TYPES_DIR_NAME)
+        return directories
+
+    def load(self, library_root: Optional[Path] = None) -> int:
+        """
+        Read the asset type plugins again
+
+        Args:
+            library_root: Current library, whose own asset types folder is included
+
+        Returns:
+            Number of asset types loaded
+        """
+        from .asset_repository_impl import get_builtin_asset_type
+
+        plugins: Dict[str, IAssetTypePlugin] = {}
+        for directory in self.get_type_directories(library_root):
+            for path, module in self._load_folder_modules(directory):
+                for plugin in self._create_plugins(path, module):
+                    extension = plugin.extension.lower()
+                    if get_builtin_asset_type(Path(f"asset{extension}")) != "unknown":
+                        print(
+                            f"[WARNING] Asset type {plugin.type_id} cannot claim the built-in "
+                            f"{extension} files"
+                        )
+                        continue
+                    plugins[extension] = plugin
+        self._plugins = plugins
+        self._loaded_library = str(library_root) if library_root else None
+        self._is_loaded = True
+
+        if plugins:
+            print(
+                f"[INFO] Loaded {len(plugins)} studio asset type(s): "
+                + ", ".join(plugin.format_label for plugin in plugins.values())
+            )
+        return len(plugins)
+
+    def get_plugins(self, library_root: Optional[Path] = None) -> List[IAssetTypePlugin]:
+        """Get the loaded asset types by name"""
+        self._ensure_loaded(library_root)
+        return sorted(self._plugins.values(), key=lambda plugin: plugin.name.lower())
+
+    def get_plugin_for_extension(
+        self, extension: str, library_root: Optional[Path] = None
+    ) -> Optional[IAssetTypePlugin]:
+        """Get the asset type claiming a file suffix (".agent"), None for other files"""
+        self._ensure_loaded(library_root)
+        if extension and not extension.startswith("."):
+            extension = f".{extension}"
+        return self._plugins.get(extension.lower())
+
+    def get_extensions(self, library_root: Optional[Path] = None) -> List[str]:
+        """Get the file suffixes of the loaded asset types"""
+        self._ensure_loaded(library_root)
+        return sorted(self._plugins)
+
+    # Running ----------------------------------------------------------------------------
+
+    def export(
+        self, plugin: IAssetTypePlugin, cmds: Any, file_path: Path, selection: List[str]
+    ) -> List[Path]:
+        """
+        Write an asset file through its type's plugin
+
+        Returns:
+            Other files the plugin wrote, to be versioned with the asset
+
+        Raises:
+            RuntimeError: If the plugin fails
+        """
+        try:
+            written = plugin.export(cmds, Path(file_path), list(selection)) or []
+        except Exception as e:
+            self.logger.error(f"Asset type {plugin.type_id} export failed: {e}")
+            raise RuntimeError(f"{plugin.name or plugin.type_id} export failed: {e}") from e
+        return [Path(path) for path in written if Path(path) != Path(file_path)]
+
+    def import_asset(self, plugin: IAssetTypePlugin, cmds: Any, file_path: Path) -> List[str]:
+        """
+        Load an asset file through its type's plugin
+
+        Raises:
+            RuntimeError: If the plugin fails
+        """
+        try:
+            return list(plugin.import_asset(cmds, Path(file_path)) or [])
+        except Exception as e:
+            self.logger.error(f"Asset type {plugin.type_id} import failed: {e}")
+            raise RuntimeError(f"{plugin.name or plugin.type_id} import failed: {e}") from e
+
+    def get_thumbnail_path(self, file_path: Path) -> Path:
+        """Get where an asset's drawn thumbnail is stored (its custom screenshot)"""
+        file_path = Path(file_path)
+        return file_path.parent / THUMBNAILS_DIR_NAME / f"{file_path.stem}_screenshot.png"
+
+    def render_thumbnail(self, plugin: IAssetTypePlugin, file_path: Path) -> Optional[Path]:
+        """Let an asset's type draw its thumbnail; None when the default one is needed"""
+        thumbnail_path = self.get_thumbnail_path(file_path)
+        try:
+            thumbnail_path.parent.mkdir(parents=True, exist_ok=True)
+            if plugin.render_thumbnail(Path(file_path), thumbnail_path):
+                if thumbnail_path.is_file():
+                    return thumbnail_path
+        except Exception as e:
+            print(f"[WARNING] Asset type {plugin.type_id} could not draw a thumbnail: {e}")
+        return None
+
+    # Internals --------------------------------------------------------------------------
+
+    def _ensure_loaded(self, library_root: Optional[Path]) -> None:
+        """Load plugins on first use and when another library is given"""
+        if not self._is_loaded:
+            self.load(library_root)
+        elif library_root and str(library_root) != self._loaded_library:
+            self.load(library_root)
+
+    def _load_folder_modules(self, directory: Path) -> List[Tuple[Path, Any]]:
+        """Import every plugin file of an asset types folder"""
+        modules: List[Tuple[Path, Any]] = []
+        if not directory.is_dir():
+            return modules
+
+        for path in sorted(directory.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            module_name = f"asset_manager_asset_types_{abs(hash(str(path)))}_{path.stem}"
+            try:
+                spec = importlib.util.spec_from_file_location(module_name, path)
+                if spec is None or spec.loader is None:
+                    continue
+                module = importlib.util.module_from_spec(spec)
+                spec.loader.exec_module(module)
+                modules.append((path, module))
+            except Exception as e:
+                print(f"[WARNING] Could not load asset type plugin {path}: {e}")
+        return modules
+
+    def _create_plugins(self, path: Path, module: Any) -> List[IAssetTypePlugin]:
+        """Create the concrete asset types defined in a plugin file"""
+        plugins = []
+        for _, member in inspect.getmembers(module, inspect.isclass):
+            if member.__module__ != module.__name__ or inspect.isabstract(member):
+                continue
+            # Duck typing - plugins may import the interface under another package path
+            extension = str(getattr(member, "extension", "") or "")
+            if not getattr(member, "type_id", "") or not extension.startswith("."):
+                continue
+            if not callable(getattr(member, "export", None)) or not callable(
+                getattr(member, "import_asset", None)
+            ):
+                continue
+            try:
+                plugins.append(member())
+            except Exception as e:
+                print(f"[WARNING] Could not create asset type {member.type_id} of {path}: {e}")
+        return plugins
+
+
+# Singleton instance factory
+_asset_type_service_instance = None
+
+
+def get_asset_type_service() -> AssetTypeService:
+    """
+    Get singleton instance of AssetTypeService.
+
+    Returns:
+        AssetTypeService: Singleton service instance
+    """
+    global _asset_type_service_instance
+    if _asset_type_service_instance is None:
+        _asset_type_service_instance = AssetTypeService()
+    return _asset_type_service_instance
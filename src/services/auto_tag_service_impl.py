@@ -0,0 +1,209 @@
+# -*- coding: utf-8 -*-
+"""
+Auto Tag Service Implementation
+Library rules adding tags at publish time from the asset name, scene content, and folder
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Rules are stored with the library. A publish gets the tags of every rule it matches on
+top of the tags the artist typed, so the library stays consistently tagged. Libraries
+without a rules file use the built-in rules (deformers -> rigged, Yeti and XGen ->
+groom); an empty rule list turns auto-tagging off::
+
+    MyProject/.assetmanager/tag_rules.json
+    {"rules": [
+      {"tags": ["rigged"], "node_types": ["skinCluster", "blendShape"]},
+      {"tags": ["hero"], "name": "chr_*", "min_triangles": 100000},
+      {"tags": ["environment/forest"], "source_directory": "*/environments/forest*"}
+    ]}
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, List, Optional, Set
+
+from ..core.models.tag_rule import TagRule
+from .validation_service_impl import DEFAULT_CAMERAS
+
+SETTINGS_DIR_NAME = ".assetmanager"
+RULES_FILE_NAME = "tag_rules.json"
+
+# Node types of Maya's deformers - anything they drive was built to move
+DEFORMER_TYPES = (
+    "skinCluster",
+    "blendShape",
+    "cluster",
+    "ffd",
+    "wire",
+    "nonLinear",
+    "deltaMush",
+    "tension",
+    "wrap",
+    "proximityWrap",
+)
+
+DEFAULT_RULES = (
+    TagRule(tags=("rigged",), node_types=DEFORMER_TYPES),
+    TagRule(tags=("groom",), node_types=("pgYeti*", "xgmDescription", "xgmSplineDescription")),
+)
+
+
+class AutoTagService:
+    """
+    Auto Tag Service - Single Responsibility for publish-time tag rules
+    Scene access goes through the cmds argument so rules can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_rules_file(self, library_root: Path) -> Path:
+        """Get the tag rules file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / RULES_FILE_NAME
+
+    def load_rules(self, library_root: Optional[Path]) -> List[TagRule]:
+        """
+        Get the rules publishes into a library are tagged by
+
+        Returns:
+            The library's rules, the built-in rules when it has no rules file; invalid
+            rules are left out with a warning
+        """
+        rules_file = self.get_rules_file(library_root) if library_root else None
+        if rules_file is None or not rules_file.is_file():
+            return list(DEFAULT_RULES)
+        try:
+            with open(rules_file, "r", encoding="utf-8") as f:
+                entries = json.load(f).get("rules") or []
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable tag rules {rules_file}: {e}")
+            return list(DEFAULT_RULES)
+
+        rules = []
+        for entry in entries:
+            try:
+                rules.append(TagRule.from_dict(entry))
+            except (AttributeError, TypeError, ValueError) as e:
+                print(f"[WARNING] Skipping tag rule {entry} in {rules_file.name}: {e}")
+        return rules
+
+    def save_rules(self, library_root: Path, rules: List[TagRule]) -> bool:
+        """Write a library's tag rules (an empty list turns auto-tagging off)"""
+        rules_file = self.get_rules_file(library_root)
+        try:
+            rules_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(rules_file, "w", encoding="utf-8") as f:
+                json.dump({"rules": [rule.to_dict() for rule in rules]}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save tag rules: {e}")
+            return False
+
+    def reset_rules(self, library_root: Path) -> bool:
+        """Go back to the built-in rules by removing the library's rules file"""
+        try:
+            self.get_rules_file(library_root).unlink(missing_ok=True)
+            return True
+        except OSError as e:
+            self.logger.error(f"Failed to reset tag rules: {e}")
+            return False
+
+    # Publish ----------------------------------------------------------------------------
+
+    def get_publish_tags(
+        self,
+        cmds: Any,
+        library_root: Optional[Path],
+        asset_name: str,
+        selection: Optional[List[str]] = None,
+        triangles: Optional[int] = None,
+    ) -> List[str]:
+        """
+        Get the tags the library's rules add to a publish
+
+        Args:
+            cmds: maya.cmds module
+            library_root: Library published into
+            asset_name: Name the asset is published as
+            selection: Published nodes; None or empty is the whole scene
+            triangles: Triangle count already measured, counted here when a rule needs it
+
+        Returns:
+            Tags of every matching rule, in rule order
+        """
+        rules = self.load_rules(library_root)
+        if not rules:
+            return []
+        node_types: Set[str] = set()
+        if any(rule.node_types for rule in rules):
+            node_types = self.collect_node_types(cmds, selection)
+        if triangles is None and any(rule.uses_triangles for rule in rules):
+            from .geometry_stats_service_impl import get_geometry_stats_service
+
+            triangles = get_geometry_stats_service().compute(cmds, selection).triangles
+        source_file = self.get_source_file(cmds)
+        return self.evaluate(rules, asset_name, node_types, triangles, source_file)
+
+    def evaluate(
+        self,
+        rules: List[TagRule],
+        asset_name: str,
+        node_types: Set[str],
+        triangles: Optional[int],
+        source_file: Optional[Path],
+    ) -> List[str]:
+        """Get the tags of every rule a publish matches, without duplicates"""
+        tags: List[str] = []
+        for rule in rules:
+            if rule.matches(asset_name, node_types, triangles, source_file):
+                tags += [tag for tag in rule.tags if tag not in tags]
+        return tags
+
+    def collect_node_types(self, cmds: Any, selection: Optional[List[str]] = None) -> Set[str]:
+        """Get the node types under the published nodes and in their history"""
+        roots = list(selection or [])
+        if not roots:
+            roots = cmds.ls(assemblies=True, long=True) or []
+            roots = [root for root in roots if root not in DEFAULT_CAMERAS]
+        if not roots:
+            return set()
+        try:
+            nodes = cmds.ls(roots, dag=True, long=True) or []
+            if not nodes:
+                return set()
+            history = cmds.listHistory(nodes) or []
+            listing = cmds.ls(list(dict.fromkeys(nodes + history)), showType=True) or []
+        except Exception as e:
+            self.logger.warning(f"Could not read the published node types: {e}")
+            return set()
+        # showType lists each node followed by its type
+        return set(listing[1::2])
+
+    def get_source_file(self, cmds: Any) -> Optional[Path]:
+        """Get the scene file being published from, None while it is unsaved"""
+        try:
+            scene = cmds.file(query=True, sceneName=True)
+        except Exception:
+            return None
+        return Path(scene) if scene else None
+
+
+# Singleton instance factory
+_auto_tag_service_instance = None
+
+
+def get_auto_tag_service() -> AutoTagService:
+    """
+    Get singleton instance of AutoTagService.
+
+    Returns:
+        AutoTagService: Singleton service instance
+    """
+    global _auto_tag_service_instance
+    if _auto_tag_service_instance is None:
+        _auto_tag_service_instance = AutoTagService()
+    return _auto_tag_service_instance
@@ -0,0 +1,775 @@
+# -*- coding: utf-8 -*-
+"""
+Batch Service Implementation
+Library maintenance without the Maya UI: publish, re-export, thumbnails, and validation
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Used by the mayapy command line (src/cli.py). Every operation works on the scene of
+the running standalone session through the cmds argument and reuses the services the
+UI publishes through, so batch and interactive publishes give identical libraries::
+
+    <library>/assets/scenes/car.ma                 <- published asset
+    <library>/assets/scenes/.versions/car/v004/    <- version snapshot
+    <library>/assets/scenes/.thumbnails/           <- rendered thumbnails
+    <library>/.assetmanager/library.db             <- tags and version index
+
+A scene holding many props can also be split into one asset per selection set or
+top-level group and published in a single run (publish_scene_items), exporting
+several assets at once in mayapy worker processes (PublishWorkerPool).
+"""
+
+import fnmatch
+import logging
+import shutil
+import tempfile
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.activity_event import ACTIVITY_PUBLISH
+from ..core.models.asset_version import AssetVersion
+from ..core.models.library_permissions import ACTION_PUBLISH
+from ..core.models.validation_result import ValidationReport
+from .activity_service_impl import get_activity_service
+from .auto_tag_service_impl import get_auto_tag_service
+from .hook_service_impl import HOOK_POST_PUBLISH, HOOK_PRE_PUBLISH, HookCancelled, get_hook_service
+from .integrity_service_impl import IntegrityService
+from .kitsu_service_impl import get_kitsu_service
+from .lock_service_impl import get_lock_service
+from .metadata_database_impl import get_metadata_database
+from .permission_service_impl import PermissionDenied, get_permission_service
+from .publish_transaction_service_impl import get_publish_transaction_service
+from .publish_worker_pool_impl import (
+    SNAPSHOT_NAME,
+    ExportJob,
+    PublishWorkerPool,
+    get_publish_worker_pool,
+)
+from .shotgrid_service_impl import get_shotgrid_service
+from .thumbnail_queue_impl import ThumbnailJob, ThumbnailQueue, get_thumbnail_queue
+from .thumbnail_settings_service_impl import get_thumbnail_settings_service
+from .validation_service_impl import DEFAULT_CAMERAS, get_validation_service
+from .version_service_impl import VersionServiceImpl, get_version_service
+
+PUBLISH_SUBFOLDER = "scenes"
+MAYA_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+# What a multi-asset scene publish splits the scene by
+SOURCE_SETS = "sets"
+SOURCE_GROUPS = "groups"
+SCENE_SOURCES = (SOURCE_SETS, SOURCE_GROUPS)
+DEFAULT_SETS = {"defaultLightSet", "defaultObjectSet"}
+
+# Formats thumbnail_batch.py can open
+THUMBNAIL_EXTENSIONS = {
+    ".ma",
+    ".mb",
+    ".abc",
+    ".fbx",
+    ".obj",
+    ".usd",
+    ".usda",
+    ".usdc",
+    ".usdz",
+    ".material",
+}
+
+
+@dataclass
+class BatchResult:
+    """Outcome of one batch operation on one file"""
+
+    file_path: Path
+    success: bool
+    message: str = ""
+    report: Optional[ValidationReport] = None
+    details: Dict[str, Any] = field(default_factory=dict)
+
+    @property
+    def description(self) -> str:
+        """Get one log line for this result"""
+        status = "OK" if self.success else "FAILED"
+        return f"[{status}] {self.file_path.name}: {self.message}"
+
+
+@dataclass
+class ScenePublishItem:
+    """One asset published out of a scene holding several"""
+
+    source: str  # Selection set or top-level group the asset comes from
+    name: str
+    nodes: List[str]
+    tags: List[str] = field(default_factory=list)
+    enabled: bool = True
+
+
+def _node_name(node: str) -> str:
+    """Get a node's short name without DAG path or namespace"""
+    return node.split("|")[-1].split(":")[-1]
+
+
+class BatchService:
+    """
+    Batch Service - Single Responsibility for headless library operations
+    Scene access goes through the cmds argument so operations can be tested without Maya
+    """
+
+    def __init__(
+        self,
+        version_service: Optional[VersionServiceImpl] = None,
+        thumbnail_queue: Optional[ThumbnailQueue] = None,
+        worker_pool: Optional[PublishWorkerPool] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+        self._thumbnail_queue = thumbnail_queue or get_thumbnail_queue()
+        self._worker_pool = worker_pool or get_publish_worker_pool()
+
+    # Library ----------------------------------------------------------------------------
+
+    def get_publish_directory(self, library_root: Path) -> Path:
+        """Get the folder batch publishes go into (same as the UI for Maya scenes)"""
+        return Path(library_root) / "assets" / PUBLISH_SUBFOLDER
+
+    def find_assets(
+        self,
+        library_root: Path,
+        patterns: Optional[List[str]] = None,
+        extensions: Optional[set] = None,
+    ) -> List[Path]:
+        """
+        Get library asset files, skipping version history and thumbnail folders
+
+        Args:
+            library_root: Library (project) root
+            patterns: Glob patterns matched against the asset name (car*, props_*)
+            extensions: Allowed suffixes, defaults to Maya scenes
+
+        Returns:
+            Matching asset files sorted by path
+        """
+        library_root = Path(library_root)
+        extensions = extensions or set(MAYA_FILE_TYPES)
+        assets_dir = library_root / "assets"
+        search_root = assets_dir if assets_dir.is_dir() else library_root
+
+        found = []
+        for path in sorted(search_root.rglob("*")):
+            relative = path.relative_to(search_root)
+            if any(part.startswith(".") for part in relative.parts):
+                continue
+            if not path.is_file() or path.suffix.lower() not in extensions:
+                continue
+            if patterns and not any(fnmatch.fnmatch(path.stem, p) for p in patterns):
+                continue
+            found.append(path)
+        return found
+
+    # Publish ----------------------------------------------------------------------------
+
+    def publish(
+        self,
+        cmds: Any,
+        source_file: Path,
+        library_root: Path,
+        name: str = "",
+        tags: Optional[List[str]] = None,
+        notes: str = "",
+        file_format: str = "",
+        validate: bool = True,
+        strict: bool = False,
+        thumbnail: bool = True,
+    ) -> BatchResult:
+        """
+        Publish a scene file into a library as a new version
+
+        Args:
+            cmds: maya.cmds module
+            source_file: Maya scene to publish
+            library_root: Library (project) root
+            name: Asset name, defaults to the source file name
+            tags: Tags added to the asset
+            notes: Version notes
+            file_format: ".ma" or ".mb", defaults to the source format
+            validate: Run the publish checks first; errors always block
+            strict: Treat validation warnings as errors
+            thumbnail: Render a thumbnail after publishing
+
+        Returns:
+            Result with the published asset file
+        """
+        source_file = Path(source_file)
+        library_root = Path(library_root)
+        suffix = (file_format or source_file.suffix).lower()
+        if not suffix.startswith("."):
+            suffix = f".{suffix}"
+        if suffix not in MAYA_FILE_TYPES:
+            return BatchResult(source_file, False, f"cannot publish as '{suffix}'")
+
+        asset_file = self._get_asset_file(library_root, name or source_file.stem, suffix)
+        refusal = self._check_can_publish(library_root, asset_file)
+        if refusal:
+            return BatchResult(asset_file, False, refusal)
+
+        cmds.file(str(source_file), open=True, force=True, ignoreVersion=True)
+        return self._publish_scene(
+            cmds,
+            asset_file,
+            library_root,
+            [],
+            tags or [],
+            notes or f"Published from {source_file.name}",
+            validate,
+            strict,
+            thumbnail,
+        )
+
+    def find_scene_items(self, cmds: Any, source: str = SOURCE_SETS) -> List[ScenePublishItem]:
+        """
+        Get the assets a scene splits into - one per selection set or top-level group
+
+        Args:
+            cmds: maya.cmds module
+            source: SOURCE_SETS or SOURCE_GROUPS
+
+        Returns:
+            Items named after their set or group, empty sets and default cameras skipped
+        """
+        if source not in SCENE_SOURCES:
+            raise ValueError(f"Unknown scene source '{source}' (use one of: {SCENE_SOURCES})")
+
+        items = []
+        if source == SOURCE_SETS:
+            # exactType leaves out shading groups and other derived set types
+            for object_set in cmds.ls(exactType="objectSet") or []:
+                if object_set in DEFAULT_SETS:
+                    continue
+                members = cmds.sets(object_set, query=True) or []
+                if members:
+                    items.append(ScenePublishItem(object_set, _node_name(object_set), members))
+        else:
+            for group in cmds.ls(assemblies=True, long=True) or []:
+                if group not in DEFAULT_CAMERAS:
+                    items.append(ScenePublishItem(group, _node_name(group), [group]))
+        return items
+
+    def publish_scene_items(
+        self,
+        cmds: Any,
+        items: List[ScenePublishItem],
+        library_root: Path,
+        tags: Optional[List[str]] = None,
+        notes: str = "",
+        file_format: str = ".ma",
+        validate: bool = True,
+        strict: bool = False,
+        thumbnail: bool = True,
+        progress: Optional[Callable[[int, ScenePublishItem], bool]] = None,
+        workers: int = 0,
+    ) -> List[BatchResult]:
+        """
+        Publish several assets out of the open scene in one run
+
+        A failing item is reported and the run goes on with the next one. With workers,
+        checks and versioning still run here but the exports run in mayapy processes.
+
+        Args:
+            cmds: maya.cmds module
+            items: Assets to publish; disabled items are skipped
+            library_root: Library (project) root
+            tags: Tags added to every asset, on top of each item's own tags
+            notes: Version notes
+            file_format: ".ma" or ".mb"
+            validate: Run the publish checks on each item's nodes
+            strict: Treat validation warnings as errors
+            thumbnail: Render thumbnails of the published assets at the end
+            progress: Called with (index, item) before each item, or with workers as each
+                export finishes (index counting finished exports); return False to stop
+            workers: Worker processes exporting at once, 0 exports in this session
+
+        Returns:
+            One result per enabled item that was started
+        """
+        library_root = Path(library_root)
+        suffix = file_format if file_format.startswith(".") else f".{file_format}"
+        previous_selection = cmds.ls(selection=True) or []
+        enabled = [item for item in items if item.enabled]
+
+        if workers > 0 and self._worker_pool.is_available():
+            results = self._publish_items_in_workers(
+                cmds,
+                enabled,
+                library_root,
+                suffix,
+                list(tags or []),
+                notes,
+                validate,
+                strict,
+                progress,
+                workers,
+            )
+        else:
+            results = []
+            for index, item in enumerate(enabled):
+                if progress is not None and not progress(index, item):
+                    break
+                asset_file = self._get_asset_file(library_root, item.name, suffix)
+                refusal = self._check_can_publish_item(library_root, asset_file)
+                if refusal:
+                    results.append(BatchResult(asset_file, False, refusal))
+                    continue
+                try:
+                    result = self._publish_scene(
+                        cmds,
+                        asset_file,
+                        library_root,
+                        list(item.nodes),
+                        list(dict.fromkeys(list(tags or []) + item.tags)),
+                        notes or f"Published from {item.source}",
+                        validate,
+                        strict,
+                        thumbnail=False,
+                    )
+                except Exception as e:
+                    result = BatchResult(asset_file, False, f"export failed: {e}")
+                result.details["source"] = item.source
+                results.append(result)
+
+        try:
+            cmds.select(previous_selection, replace=True)
+        except Exception as e:
+            self.logger.warning(f"Could not restore the selection: {e}")
+
+        if thumbnail:
+            self.render_thumbnails(
+                [r.file_path for r in results if r.success], library_root=library_root
+            )
+        return results
+
+    def reexport(self, cmds: Any, asset_file: Path, library_root: Path) -> BatchResult:
+        """
+        Open and save an asset again with the running Maya, as a new version
+
+        Refreshes files saved by older Maya releases or pointing at moved references.
+        """
+        asset_file = Path(asset_file)
+        suffix = asset_file.suffix.lower()
+        if suffix not in MAYA_FILE_TYPES:
+            return BatchResult(asset_file, False, "only Maya scenes can be re-exported")
+        try:
+            get_permission_service().check(library_root, ACTION_PUBLISH)
+        except PermissionDenied as e:
+            return BatchResult(asset_file, False, str(e))
+        if not get_lock_service().can_publish(asset_file):
+            return BatchResult(asset_file, False, "checked out by another artist")
+
+        if not self._version_service.get_versions(asset_file):
+            self._version_service.publish_version(asset_file, notes="Baseline (pre-versioning)")
+
+        cmds.file(str(asset_file), open=True, force=True, ignoreVersion=True)
+        cmds.file(rename=str(asset_file))
+        cmds.file(save=True, force=True, type=MAYA_FILE_TYPES[suffix])
+
+        version = self._version_service.publish_version(asset_file, notes="Batch re-export")
+        if version is None:
+            return BatchResult(asset_file, False, "could not create a version")
+        self._update_library_database(Path(library_root), asset_file, [], version)
+        get_activity_service().record(
+            library_root, ACTIVITY_PUBLISH, asset_file, version=version.number, mode="re-export"
+        )
+        return BatchResult(asset_file, True, f"re-exported as {version.label}")
+
+    # Thumbnails -------------------------------------------------------------------------
+
+    def render_thumbnails(
+        self,
+        asset_files: List[Path],
+        missing_only: bool = False,
+        turntable_format: str = "",
+        library_root: Optional[Path] = None,
+    ) -> List[BatchResult]:
+        """
+        Render thumbnails with the background queue and wait for every job
+
+        With a library, each asset renders with its thumbnail settings (camera,
+        lighting, background, and resolution of its asset type).
+        """
+        queue = self._thumbnail_queue
+        queue.clear_finished()
+
+        paths = [Path(path) for path in asset_files]
+        if missing_only:
+            paths = [p for p in paths if not ThumbnailJob(p).still_path.exists()]
+
+        settings_for = None
+        if library_root is not None:
+            settings_service = get_thumbnail_settings_service()
+            try:
+                database = get_metadata_database(Path(library_root))
+            except Exception as e:
+                print(f"[WARNING] Thumbnails use asset type settings only: {e}")
+                database = None
+
+            def settings_for(path: Path):
+                return settings_service.get_asset_settings(library_root, path, database)
+
+        jobs = queue.enqueue_many(paths, settings_for, turntable_format=turntable_format)
+        queue.wait()
+
+        return [
+            BatchResult(
+                job.asset_path,
+                job.status == "done",
+                "thumbnail rendered" if job.status == "done" else job.error,
+            )
+            for job in jobs
+        ]
+
+    # Validation -------------------------------------------------------------------------
+
+    def validate(self, cmds: Any, asset_file: Path, library_root: Path) -> BatchResult:
+        """Open an asset and run the publish checks on it"""
+        asset_file = Path(asset_file)
+        cmds.file(str(asset_file), open=True, force=True, ignoreVersion=True)
+        report = get_validation_service().validate(cmds, library_root=library_root)
+        return BatchResult(asset_file, report.can_publish, report.summary(), report)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_asset_file(self, library_root: Path, name: str, suffix: str) -> Path:
+        """Get the published file of an asset name, keeping file-safe characters"""
+        safe_name = "".join(c for c in name if c.isalnum() or c in (" ", "-", "_")).rstrip()
+        return self.get_publish_directory(library_root) / f"{safe_name}{suffix}"
+
+    def _check_can_publish_item(self, library_root: Path, asset_file: Path) -> str:
+        """Get why a scene item cannot be published, empty when it can"""
+        if asset_file.suffix not in MAYA_FILE_TYPES:
+            return f"cannot publish as '{asset_file.suffix}'"
+        return self._check_can_publish(library_root, asset_file)
+
+    def _publish_items_in_workers(
+        self,
+        cmds: Any,
+        items: List[ScenePublishItem],
+        library_root: Path,
+        suffix: str,
+        tags: List[str],
+        notes: str,
+        validate: bool,
+        strict: bool,
+        progress: Optional[Callable[[int, ScenePublishItem], bool]],
+        workers: int,
+    ) -> List[BatchResult]:
+        """Check items here, export them from a scene snapshot in workers, version them here"""
+        results: Dict[int, BatchResult] = {}
+        started: Dict[int, Tuple[Path, Dict[str, Any], Optional[ValidationReport]]] = {}
+        jobs: Dict[int, ExportJob] = {}
+        rule_tags: Dict[int, List[str]] = {}
+        staging = Path(tempfile.mkdtemp(prefix="assetManager_publish_"))
+        try:
+            for index, item in enumerate(items):
+                asset_file = self._get_asset_file(library_root, item.name, suffix)
+                refusal = self._check_can_publish_item(library_root, asset_file)
+                if refusal:
+                    results[index] = BatchResult(asset_file, False, refusal)
+                    continue
+                try:
+                    failure, hook_context, report = self._begin_publish(
+                        cmds,
+                        asset_file,
+                        library_root,
+                        list(item.nodes),
+                        notes or f"Published from {item.source}",
+                        validate,
+                        strict,
+                    )
+                except Exception as e:
+                    failure = BatchResult(asset_file, False, f"publish failed: {e}")
+                if failure is not None:
+                    failure.details["source"] = item.source
+                    results[index] = failure
+                    continue
+                started[index] = (asset_file, hook_context, report)
+                rule_tags[index] = self._add_rule_tags(
+                    cmds, library_root, asset_file, list(item.nodes), tags + item.tags
+                )
+                output = staging / f"{index:03d}_{asset_file.name}"
+                jobs[index] = ExportJob(
+                    item.name, list(item.nodes), output, MAYA_FILE_TYPES[suffix]
+                )
+
+            if jobs:
+                self._export_in_workers(cmds, staging, jobs, items, progress, workers)
+
+            for index, job in jobs.items():
+                item = items[index]
+                asset_file, hook_context, report = started[index]
+                if job.status == "cancelled":
+                    continue
+                if job.status != "done":
+                    result = BatchResult(asset_file, False, f"export failed: {job.error}", report)
+                else:
+                    try:
+                        result = self._commit_publish(
+                            asset_file,
+                            library_root,
+                            rule_tags[index],
+                            hook_context,
+                            report,
+                            lambda staged_file: shutil.copyfile(job.output, staged_file),
+                        )
+                    except Exception as e:
+                        result = BatchResult(asset_file, False, f"publish failed: {e}", report)
+                result.details["source"] = item.source
+                results[index] = result
+        finally:
+            shutil.rmtree(staging, ignore_errors=True)
+        return [results[index] for index in sorted(results)]
+
+    def _export_in_workers(
+        self,
+        cmds: Any,
+        staging: Path,
+        jobs: Dict[int, ExportJob],
+        items: List[ScenePublishItem],
+        progress: Optional[Callable[[int, ScenePublishItem], bool]],
+        workers: int,
+    ) -> None:
+        """Save a snapshot of the open scene and run the export jobs on it"""
+        # Workers open what is in the artist's session now, saved or not
+        snapshot = staging / SNAPSHOT_NAME
+        try:
+            cmds.file(
+                str(snapshot),
+                force=True,
+                exportAll=True,
+                preserveReferences=True,
+                type=MAYA_FILE_TYPES[snapshot.suffix],
+            )
+        except Exception as e:
+            for job in jobs.values():
+                job.status, job.error = "failed", f"could not save a scene snapshot: {e}"
+            return
+
+        items_by_job = {id(job): items[index] for index, job in jobs.items()}
+
+        def on_finished(finished: int, job: ExportJob) -> bool:
+            return progress is None or progress(finished, items_by_job[id(job)])
+
+        self._worker_pool.run(snapshot, list(jobs.values()), on_finished, workers)
+
+    def _check_can_publish(self, library_root: Path, asset_file: Path) -> str:
+        """Get why an asset cannot be published, empty when it can"""
+        try:
+            get_permission_service().check(library_root, ACTION_PUBLISH)
+        except PermissionDenied as e:
+            return str(e)
+
+        lock_service = get_lock_service()
+        if not lock_service.can_publish(asset_file):
+            lock = lock_service.get_lock(asset_file)
+            owner = lock.description if lock else "another artist"
+            return f"checked out by {owner}"
+        return ""
+
+    def _publish_scene(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        library_root: Path,
+        nodes: List[str],
+        tags: List[str],
+        notes: str,
+        validate: bool,
+        strict: bool,
+        thumbnail: bool,
+    ) -> BatchResult:
+        """Export the open scene (or only nodes) as the next version of an asset"""
+        suffix = asset_file.suffix
+        refusal, hook_context, report = self._begin_publish(
+            cmds, asset_file, library_root, nodes, notes, validate, strict
+        )
+        if refusal is not None:
+            return refusal
+
+        tags = self._add_rule_tags(cmds, library_root, asset_file, nodes, tags)
+
+        def export(staged_file: Path) -> None:
+            if nodes:
+                cmds.select(nodes, replace=True, noExpand=True)
+                cmds.file(
+                    str(staged_file),
+                    force=True,
+                    exportSelected=True,
+                    preserveReferences=True,
+                    type=MAYA_FILE_TYPES[suffix],
+                )
+            else:
+                cmds.file(
+                    str(staged_file), force=True, exportAll=True, type=MAYA_FILE_TYPES[suffix]
+                )
+
+        return self._commit_publish(
+            asset_file, library_root, tags, hook_context, report, export, thumbnail
+        )
+
+    def _commit_publish(
+        self,
+        asset_file: Path,
+        library_root: Path,
+        tags: List[str],
+        hook_context: Dict[str, Any],
+        report: Optional[ValidationReport],
+        write: Callable[[Path], None],
+        thumbnail: bool = False,
+    ) -> BatchResult:
+        """Write an asset into a staging folder, move it into place, and version it"""
+        transaction_service = get_publish_transaction_service()
+        transaction = transaction_service.begin(asset_file, notes=hook_context["notes"])
+        try:
+            write(transaction.staged_file)
+            transaction_service.commit(transaction)
+        except Exception:
+            transaction_service.rollback(transaction)
+            raise
+        try:
+            return self._finish_publish(
+                asset_file,
+                library_root,
+                tags,
+                hook_context["notes"],
+                hook_context,
+                report,
+                thumbnail,
+            )
+        finally:
+            transaction_service.finish(transaction)
+
+    def _begin_publish(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        library_root: Path,
+        nodes: List[str],
+        notes: str,
+        validate: bool,
+        strict: bool,
+    ) -> Tuple[Optional[BatchResult], Dict[str, Any], Optional[ValidationReport]]:
+        """Validate and run pre-publish hooks; gets (refusal or None, hook context, report)"""
+        report = None
+        hook_context = {
+            "asset_file": asset_file,
+            "asset_name": asset_file.stem,
+            "asset_type": "",
+            "file_format": asset_file.suffix,
+            "selection": list(nodes),
+            "notes": notes,
+        }
+        if validate:
+            report = get_validation_service().validate(
+                cmds, selection=nodes or None, library_root=library_root
+            )
+            if not report.can_publish or (strict and report.warnings):
+                message = f"validation failed ({report.summary()})"
+                return BatchResult(asset_file, False, message, report), hook_context, report
+
+        try:
+            get_hook_service().run(HOOK_PRE_PUBLISH, library_root, **hook_context)
+        except HookCancelled as e:
+            message = f"cancelled by pipeline hook: {e}"
+            return BatchResult(asset_file, False, message, report), hook_context, report
+
+        asset_file.parent.mkdir(parents=True, exist_ok=True)
+        if asset_file.exists() and not self._version_service.get_versions(asset_file):
+            self._version_service.publish_version(asset_file, notes="Baseline (pre-versioning)")
+        return None, hook_context, report
+
+    def _finish_publish(
+        self,
+        asset_file: Path,
+        library_root: Path,
+        tags: List[str],
+        notes: str,
+        hook_context: Dict[str, Any],
+        report: Optional[ValidationReport],
+        thumbnail: bool,
+    ) -> BatchResult:
+        """Version an exported asset file, update the library, and run post-publish hooks"""
+        version = self._version_service.publish_version(asset_file, notes=notes)
+        if version is None:
+            return BatchResult(asset_file, False, "could not create a version", report)
+
+        self._update_library_database(library_root, asset_file, tags, version)
+        if thumbnail:
+            self.render_thumbnails([asset_file], library_root=library_root)
+
+        still = ThumbnailJob(asset_file).still_path
+        shotgrid_service = get_shotgrid_service()
+        if shotgrid_service.is_enabled():
+            shotgrid_service.publish(asset_file, version.number, notes, still)
+        kitsu_service = get_kitsu_service()
+        if kitsu_service.is_enabled():
+            kitsu_service.publish(asset_file, version.number, notes, still)
+
+        get_hook_service().run(
+            HOOK_POST_PUBLISH,
+            library_root,
+            **hook_context,
+            version=version.number,
+            files=[asset_file],
+        )
+        get_activity_service().record_hook(
+            HOOK_POST_PUBLISH, library_root, dict(hook_context, version=version.number)
+        )
+
+        return BatchResult(
+            asset_file, True, f"published {version.label}", report, {"version": version.number}
+        )
+
+    def _add_rule_tags(
+        self, cmds: Any, library_root: Path, asset_file: Path, nodes: List[str], tags: List[str]
+    ) -> List[str]:
+        """Get a publish's tags plus those the library's auto-tag rules add"""
+        try:
+            rule_tags = get_auto_tag_service().get_publish_tags(
+                cmds, library_root, asset_file.stem, nodes
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not apply tag rules to {asset_file.name}: {e}")
+            rule_tags = []
+        return list(dict.fromkeys(tags + rule_tags))
+
+    def _update_library_database(
+        self, library_root: Path, asset_file: Path, tags: List[str], version: AssetVersion
+    ) -> None:
+        """Add tags, index versions, and store publish checksums, keeping UI metadata"""
+        try:
+            database = get_metadata_database(library_root)
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata["tags"] = list(dict.fromkeys(metadata.get("tags", []) + tags))
+            metadata["modified_date"] = datetime.now().isoformat()
+            database.save_asset_metadata(asset_file, metadata)
+            database.record_versions(asset_file, self._version_service.get_versions(asset_file))
+            IntegrityService(self._version_service).record_publish(database, asset_file, version)
+        except Exception as e:
+            print(f"[WARNING] Could not update library database for {asset_file.name}: {e}")
+
+
+# Singleton instance factory
+_batch_service_instance = None
+
+
+def get_batch_service() -> BatchService:
+    """
+    Get singleton instance of BatchService.
+
+    Returns:
+        BatchService: Singleton service instance
+    """
+    global _batch_service_instance
+    if _batch_service_instance is None:
+        _batch_service_instance = BatchService()
+    return _batch_service_instance
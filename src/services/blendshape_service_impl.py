@@ -0,0 +1,353 @@
+# -*- coding: utf-8 -*-
+"""
+Blendshape Service Implementation
+Publish blendshape target sets and apply them to meshes with the same topology
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A blendshape set is a JSON file in the library's blendshapes folder. Targets are
+stored as sparse point deltas against the base mesh, together with the base mesh's
+topology fingerprint, so a set only goes onto meshes whose vertex indices mean the
+same points::
+
+    assets/blendshapes/hero_face_correctives.blendshape
+    {"type": "blendshape", "base_mesh": "head_geo",
+     "topology": {"vertices": 5024, "edges": 10040, "faces": 5018, ...},
+     "targets": {"jawOpen": {"indices": [12, 13], "deltas": [[0, -0.4, 0.1], ...]}}}
+
+Targets are read from a blendShape node on the base mesh, or from sculpted copies
+of it selected before the base. Applying writes the deltas straight into a new
+blendShape node in front of the mesh's deformer chain, so no target meshes are made.
+"""
+
+import hashlib
+import json
+import logging
+import re
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.mesh_topology import MeshTopology
+from .anim_clip_service_impl import playblast_thumbnail, strip_namespace
+from .version_service_impl import get_current_user
+
+BLENDSHAPE_EXTENSION = ".blendshape"
+BLENDSHAPE_FORMAT_VERSION = 1
+
+# Library metadata key holding the base mesh's MeshTopology.to_dict()
+TOPOLOGY_METADATA_KEY = "topology"
+
+# Points that moved less than this are left out of a target
+DELTA_TOLERANCE = 1e-5
+
+# Input target item holding a target at full weight (5000 + 1000 x weight)
+FULL_WEIGHT_ITEM = 6000
+
+# Vertex components of a componentList: vtx[12] or vtx[12:40]
+_COMPONENT_PATTERN = re.compile(r"\[(\d+)(?::(\d+))?\]")
+
+Targets = Dict[str, Dict[str, list]]  # Target name -> {"indices": [...], "deltas": [...]}
+
+
+@dataclass
+class BlendshapeApplyResult:
+    """Outcome of applying a blendshape set to a mesh"""
+
+    node: str = ""  # blendShape node created
+    targets: List[str] = field(default_factory=list)
+    skipped_points: int = 0  # Deltas of vertices the mesh does not have
+
+    @property
+    def success(self) -> bool:
+        return bool(self.node and self.targets)
+
+
+def parse_components(components: List[str]) -> List[int]:
+    """Get the vertex indices of componentList entries (vtx[0:2], vtx[7] -> 0, 1, 2, 7)"""
+    indices = []
+    for component in components or []:
+        match = _COMPONENT_PATTERN.search(component)
+        if match:
+            first = int(match.group(1))
+            last = int(match.group(2)) if match.group(2) else first
+            indices.extend(range(first, last + 1))
+    return indices
+
+
+class BlendshapeService:
+    """
+    Blendshape Service - Single Responsibility for blendshape target set capture and apply
+    All Maya calls go through the cmds argument so sets can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Topology ---------------------------------------------------------------------------
+
+    def get_topology(self, cmds: Any, mesh: str) -> MeshTopology:
+        """Get the counts and connectivity fingerprint of a mesh"""
+        hasher = hashlib.sha256()
+        for face in cmds.polyInfo(mesh, faceToVertex=True) or []:
+            # "FACE     12:     20     21     33     32 \n" -> "20 21 33 32"
+            hasher.update(" ".join(face.split(":", 1)[-1].split()).encode("utf-8"))
+            hasher.update(b"\n")
+        return MeshTopology(
+            vertices=int(cmds.polyEvaluate(mesh, vertex=True) or 0),
+            edges=int(cmds.polyEvaluate(mesh, edge=True) or 0),
+            faces=int(cmds.polyEvaluate(mesh, face=True) or 0),
+            connectivity_hash=hasher.hexdigest(),
+        )
+
+    def check_topology(self, cmds: Any, blendshape: Dict[str, Any], mesh: str) -> List[str]:
+        """Get why a mesh does not match a set's base mesh, [] if it does"""
+        stored = MeshTopology.from_dict(blendshape.get("topology", {}))
+        return stored.differences(self.get_topology(cmds, mesh))
+
+    def find_matching_meshes(self, cmds: Any, topology: MeshTopology) -> List[str]:
+        """Get scene mesh transforms (full paths) with the given topology"""
+        matches = []
+        for shape in cmds.ls(type="mesh", noIntermediate=True, long=True) or []:
+            if int(cmds.polyEvaluate(shape, vertex=True) or 0) != topology.vertices:
+                continue  # Cheap check before hashing every face
+            if topology.matches(self.get_topology(cmds, shape)):
+                parents = cmds.listRelatives(shape, parent=True, fullPath=True) or [shape]
+                matches.extend(parent for parent in parents if parent not in matches)
+        return matches
+
+    # Capture ----------------------------------------------------------------------------
+
+    def find_blendshape_nodes(self, cmds: Any, mesh: str) -> List[str]:
+        """Get the blendShape nodes deforming a mesh, nearest first"""
+        return cmds.ls(cmds.listHistory(mesh) or [], type="blendShape") or []
+
+    def read_node_targets(self, cmds: Any, node: str) -> Targets:
+        """
+        Get the targets of a blendShape node as sparse deltas
+
+        Targets whose sculpt mesh is still connected are diffed against the mesh;
+        targets without geometry (deleted sculpts) are read from the node.
+        """
+        base_shapes = cmds.blendShape(node, query=True, geometry=True) or []
+        rest = self._get_rest_shape(cmds, base_shapes[0]) if base_shapes else ""
+        rest_points = self._get_points(cmds, rest) if rest else []
+
+        targets: Targets = {}
+        for index in cmds.getAttr(f"{node}.weight", multiIndices=True) or []:
+            name = cmds.aliasAttr(f"{node}.weight[{index}]", query=True) or f"target{index}"
+            item = self._get_target_item(node, index)
+            sculpts = cmds.listConnections(f"{item}.inputGeomTarget", source=True, shapes=True)
+            if sculpts and rest_points:
+                sculpt_points = self._get_points(cmds, sculpts[0])
+                indices, deltas = self._diff_points(rest_points, sculpt_points)
+            else:
+                indices = parse_components(cmds.getAttr(f"{item}.inputComponentsTarget") or [])
+                points = cmds.getAttr(f"{item}.inputPointsTarget") or []
+                deltas = [[round(float(value), 6) for value in point[:3]] for point in points]
+            if indices and len(indices) == len(deltas):
+                targets[name] = {"indices": indices, "deltas": deltas}
+        return targets
+
+    def read_mesh_targets(
+        self, cmds: Any, base_mesh: str, target_meshes: List[str]
+    ) -> Tuple[Targets, List[str]]:
+        """
+        Get sculpted copies of the base mesh as sparse deltas
+
+        Returns:
+            Targets named after their meshes, and the meshes skipped because their
+            topology differs from the base
+        """
+        base_topology = self.get_topology(cmds, base_mesh)
+        rest_points = self._get_points(cmds, self._get_rest_shape(cmds, base_mesh))
+        targets: Targets = {}
+        mismatched = []
+        for target_mesh in target_meshes:
+            if not base_topology.matches(self.get_topology(cmds, target_mesh)):
+                mismatched.append(target_mesh)
+                continue
+            indices, deltas = self._diff_points(rest_points, self._get_points(cmds, target_mesh))
+            targets[strip_namespace(target_mesh)] = {"indices": indices, "deltas": deltas}
+        return targets, mismatched
+
+    def create_blendshape_set(
+        self,
+        cmds: Any,
+        base_mesh: str,
+        targets: Targets,
+        name: str,
+        notes: str = "",
+        source_node: str = "",
+    ) -> Dict[str, Any]:
+        """Build the blendshape set dictionary ready for save_blendshape_set"""
+        return {
+            "type": "blendshape",
+            "format_version": BLENDSHAPE_FORMAT_VERSION,
+            "name": name,
+            "notes": notes,
+            "base_mesh": strip_namespace(base_mesh),
+            "source_node": strip_namespace(source_node) if source_node else "",
+            "topology": self.get_topology(cmds, base_mesh).to_dict(),
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+            "targets": targets,
+        }
+
+    # Storage ----------------------------------------------------------------------------
+
+    def save_blendshape_set(self, set_path: Path, blendshape: Dict[str, Any]) -> Path:
+        """Write blendshape set data to a .blendshape file"""
+        set_path = Path(set_path)
+        if set_path.suffix != BLENDSHAPE_EXTENSION:
+            set_path = set_path.with_suffix(BLENDSHAPE_EXTENSION)
+        set_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(set_path, "w", encoding="utf-8") as f:
+            json.dump(blendshape, f, indent=2)
+        print(f"[OK] Saved blendshape set {set_path.name} ({len(blendshape['targets'])} targets)")
+        return set_path
+
+    def load_blendshape_set(self, set_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .blendshape file, None if it is not a valid blendshape set"""
+        try:
+            with open(set_path, "r", encoding="utf-8") as f:
+                blendshape = json.load(f)
+            if blendshape.get("type") != "blendshape":
+                return None
+            return blendshape
+        except Exception as e:
+            self.logger.error(f"Failed to read blendshape set {set_path}: {e}")
+            return None
+
+    def record_topology(self, database: Any, asset_file: Path, topology: MeshTopology) -> None:
+        """Keep the base mesh fingerprint with the asset's library metadata"""
+        metadata = database.get_asset_metadata(Path(asset_file)) or {}
+        metadata[TOPOLOGY_METADATA_KEY] = topology.to_dict()
+        database.save_asset_metadata(Path(asset_file), metadata)
+
+    # Apply ------------------------------------------------------------------------------
+
+    def apply_blendshape_set(
+        self,
+        cmds: Any,
+        blendshape: Dict[str, Any],
+        mesh: str,
+        targets: Optional[List[str]] = None,
+    ) -> BlendshapeApplyResult:
+        """
+        Add a blendShape node with the set's targets to a mesh
+
+        Args:
+            cmds: maya.cmds module
+            blendshape: Set data from load_blendshape_set
+            mesh: Mesh to deform; check_topology first, deltas of vertices it does
+                not have are skipped
+            targets: Limit to these target names, None for all
+
+        Returns:
+            Node created, targets added, and points skipped
+        """
+        result = BlendshapeApplyResult()
+        vertex_count = int(cmds.polyEvaluate(mesh, vertex=True) or 0)
+        chosen = [
+            (name, target)
+            for name, target in blendshape.get("targets", {}).items()
+            if targets is None or name in targets
+        ]
+        if not chosen:
+            return result
+
+        node_name = f"{strip_namespace(mesh)}_{blendshape.get('name', 'shapes')}_blendShape"
+        result.node = cmds.blendShape(mesh, name=node_name, frontOfChain=True)[0]
+        for index, (name, target) in enumerate(chosen):
+            points = [
+                (vertex, delta)
+                for vertex, delta in zip(target["indices"], target["deltas"])
+                if vertex < vertex_count
+            ]
+            result.skipped_points += len(target["indices"]) - len(points)
+            item = self._get_target_item(result.node, index)
+            components = [f"vtx[{vertex}]" for vertex, _delta in points]
+            cmds.setAttr(f"{result.node}.weight[{index}]", 0.0)
+            cmds.setAttr(
+                f"{item}.inputPointsTarget",
+                len(points),
+                *[tuple(delta[:3]) + (1.0,) for _vertex, delta in points],
+                type="pointArray",
+            )
+            cmds.setAttr(
+                f"{item}.inputComponentsTarget", len(components), *components, type="componentList"
+            )
+            try:
+                cmds.aliasAttr(name, f"{result.node}.weight[{index}]")
+            except Exception as e:
+                # Target names are attribute names; Maya rejects a few (spaces, clashes)
+                self.logger.warning(f"Could not name target {index} '{name}': {e}")
+            result.targets.append(name)
+
+        print(
+            f"[OK] Applied blendshape set {blendshape.get('name', '')} to {mesh}: "
+            f"{len(result.targets)} targets, {result.skipped_points} points skipped"
+        )
+        return result
+
+    # Thumbnails -------------------------------------------------------------------------
+
+    def capture_thumbnail(self, cmds: Any, set_path: Path, size: int = 256) -> Optional[Path]:
+        """Playblast the current frame into the library thumbnail folder"""
+        return playblast_thumbnail(cmds, set_path, cmds.currentTime(query=True), size)
+
+    # Points -----------------------------------------------------------------------------
+
+    def _get_target_item(self, node: str, index: int) -> str:
+        """Get the input target item plug of a target at full weight"""
+        return (
+            f"{node}.inputTarget[0].inputTargetGroup[{index}]"
+            f".inputTargetItem[{FULL_WEIGHT_ITEM}]"
+        )
+
+    def _get_rest_shape(self, cmds: Any, mesh: str) -> str:
+        """Get the undeformed shape of a mesh (its Orig shape when it has deformers)"""
+        shapes = cmds.listRelatives(mesh, shapes=True, fullPath=True) or []
+        for shape in shapes:
+            if cmds.getAttr(f"{shape}.intermediateObject") and not cmds.listConnections(
+                f"{shape}.inMesh", source=True
+            ):
+                return shape
+        return mesh
+
+    def _get_points(self, cmds: Any, mesh: str) -> List[float]:
+        """Get the object space vertex positions of a mesh, flattened x, y, z"""
+        return cmds.xform(f"{mesh}.vtx[*]", query=True, objectSpace=True, translation=True) or []
+
+    def _diff_points(
+        self, rest_points: List[float], points: List[float]
+    ) -> Tuple[List[int], List[List[float]]]:
+        """Get the vertices that moved and how far"""
+        indices: List[int] = []
+        deltas: List[List[float]] = []
+        for index in range(min(len(rest_points), len(points)) // 3):
+            delta = [points[index * 3 + axis] - rest_points[index * 3 + axis] for axis in range(3)]
+            if any(abs(value) > DELTA_TOLERANCE for value in delta):
+                indices.append(index)
+                deltas.append([round(value, 6) for value in delta])
+        return indices, deltas
+
+
+# Singleton instance factory
+_blendshape_service_instance = None
+
+
+def get_blendshape_service() -> BlendshapeService:
+    """
+    Get singleton instance of BlendshapeService.
+
+    Returns:
+        BlendshapeService: Singleton service instance
+    """
+    global _blendshape_service_instance
+    if _blendshape_service_instance is None:
+        _blendshape_service_instance = BlendshapeService()
+    return _blendshape_service_instance
@@ -0,0 +1,301 @@
+# -*- coding: utf-8 -*-
+"""
+Bulk Edit Service Implementation
+Library-wide find-and-replace for asset metadata, logged so each run can be undone
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Admins rename a tag across all assets, change the author of a batch, or rewrite a
+path prefix in the paths asset metadata records (ingest sources, texture maps,
+dependencies). A run is planned first, so the affected assets can be previewed,
+then applied as one transaction and logged with the old value of every field::
+
+    MyProject/.assetmanager/bulk_edits.json   <- applied edits, newest first
+
+    replace_path  //old-server/textures -> //nas/textures
+        assets/scenes/crate.ma  ingest: {"source": "//old-server/textures/crate.obj"}
+
+Undo writes the old values back, skipping fields someone changed since.
+"""
+
+import json
+import logging
+import uuid
+from dataclasses import replace
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, Iterable, List, Tuple
+
+from ..core.models.bulk_edit import (
+    OPERATION_RENAME_TAG,
+    OPERATION_REPLACE_PATH,
+    OPERATION_SET_AUTHOR,
+    BulkChange,
+    BulkEdit,
+)
+from ..core.models.tag_hierarchy import normalize_tag
+from .metadata_database_impl import SIDECAR_SUFFIX, get_metadata_database
+from .tag_service_impl import get_tag_service
+from .version_service_impl import get_current_user
+
+SETTINGS_DIR_NAME = ".assetmanager"
+LOG_FILE_NAME = "bulk_edits.json"
+MAX_LOG_ENTRIES = 200
+
+# Metadata that never holds stored paths
+_COLUMN_KEYS = {"tags", "author", "category", "is_favorite", "modified_date"}
+_COLUMN_KEYS |= {"access_count", "last_accessed"}
+
+
+def replace_path_prefix(value: Any, old_prefix: str, new_prefix: str) -> Any:
+    """
+    Rewrite a path prefix in a metadata value and the strings nested in it
+
+    Only whole folders match (//srv/lib is not a prefix of //srv/library) and
+    backslashes compare as slashes; the rest of each path is kept as written.
+    """
+    if isinstance(value, str):
+        old = old_prefix.replace("\\", "/").rstrip("/")
+        path = value.replace("\\", "/")
+        if old and (path == old or path.startswith(f"{old}/")):
+            return new_prefix.rstrip("/\\") + value[len(old) :]
+        return value
+    if isinstance(value, dict):
+        return {
+            key: replace_path_prefix(item, old_prefix, new_prefix) for key, item in value.items()
+        }
+    if isinstance(value, list):
+        return [replace_path_prefix(item, old_prefix, new_prefix) for item in value]
+    return value
+
+
+class BulkEditService:
+    """
+    Bulk Edit Service - Single Responsibility for library-wide metadata edits
+    Planning never writes; applying writes exactly the changes a plan lists
+    """
+
+    def __init__(self, database_factory=None, tag_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+        self._tag_service = tag_service or get_tag_service()
+
+    # Planning ---------------------------------------------------------------------------
+
+    def plan_rename_tag(self, library_root: Path, old_tag: str, new_tag: str) -> BulkEdit:
+        """
+        List the assets whose tags a rename changes, sub-tags included
+
+        Raises:
+            ValueError: If either tag is empty or they are the same
+        """
+        old_tag, new_tag = normalize_tag(old_tag), normalize_tag(new_tag)
+        if not old_tag or not new_tag:
+            raise ValueError("Enter the tag to rename and its new name")
+        if old_tag == new_tag:
+            raise ValueError("The new tag name is the same as the old one")
+        changes = []
+        for key, metadata in self._get_all_metadata(library_root).items():
+            tags = list(metadata.get("tags") or [])
+            new_tags = self._tag_service.remap_tags(tags, old_tag, new_tag)
+            if new_tags != tags:
+                changes.append(BulkChange(key, "tags", tags, new_tags))
+        return self._new_edit(OPERATION_RENAME_TAG, old_tag, new_tag, changes)
+
+    def plan_set_author(
+        self,
+        library_root: Path,
+        new_author: str,
+        old_author: str = "",
+        asset_keys: Iterable[str] = (),
+    ) -> BulkEdit:
+        """
+        List the assets whose author changes
+
+        Args:
+            library_root: Library (project) root
+            new_author: Author the assets get
+            old_author: Change the assets of this author (not case sensitive)
+            asset_keys: Change these assets, when no old author is given
+
+        Raises:
+            ValueError: If no author is given or nothing picks the assets
+        """
+        new_author, old_author = new_author.strip(), old_author.strip()
+        if not new_author:
+            raise ValueError("Enter the new author")
+        selected = set(asset_keys)
+        if not old_author and not selected:
+            raise ValueError("Enter the author to replace or select the assets to change")
+        changes = []
+        for key, metadata in self._get_all_metadata(library_root).items():
+            author = metadata.get("author")
+            if old_author:
+                if str(author or "").lower() != old_author.lower():
+                    continue
+            elif key not in selected:
+                continue
+            if author != new_author:
+                changes.append(BulkChange(key, "author", author, new_author))
+        return self._new_edit(OPERATION_SET_AUTHOR, old_author, new_author, changes)
+
+    def plan_replace_path(self, library_root: Path, old_prefix: str, new_prefix: str) -> BulkEdit:
+        """
+        List the metadata values holding paths under a prefix
+
+        Raises:
+            ValueError: If the old prefix is empty
+        """
+        old_prefix, new_prefix = old_prefix.strip(), new_prefix.strip()
+        if not old_prefix.strip("/\\"):
+            raise ValueError("Enter the path prefix to replace")
+        changes = []
+        for key, metadata in self._get_all_metadata(library_root).items():
+            for field in sorted(set(metadata) - _COLUMN_KEYS):
+                value = metadata[field]
+                new_value = replace_path_prefix(value, old_prefix, new_prefix)
+                if new_value != value:
+                    changes.append(BulkChange(key, field, value, new_value))
+        return self._new_edit(OPERATION_REPLACE_PATH, old_prefix, new_prefix, changes)
+
+    # Applying ---------------------------------------------------------------------------
+
+    def apply(self, library_root: Path, edit: BulkEdit) -> BulkEdit:
+        """
+        Write a planned edit and log it; a failure puts back what was written
+
+        Returns:
+            The logged edit, with its user and date
+        """
+        database = self._database_factory(library_root)
+        written: List[BulkChange] = []
+        try:
+            for change in edit.changes:
+                self._write(database, change.asset_key, change.field, change.new_value)
+                written.append(change)
+        except Exception:
+            for change in reversed(written):
+                try:
+                    self._write(database, change.asset_key, change.field, change.old_value)
+                except Exception as e:
+                    self.logger.error(f"Could not restore {change.asset_key}: {e}")
+            raise
+
+        applied = replace(edit, user=get_current_user(), date=datetime.now())
+        self._save_log(library_root, [applied] + self.get_log(library_root))
+        print(f"[OK] {applied.description}")
+        return applied
+
+    def undo(self, library_root: Path, edit_id: str) -> Tuple[BulkEdit, List[str]]:
+        """
+        Write back the old values of a logged edit
+
+        Returns:
+            (the edit marked undone, "asset: field" of values changed since, left as is)
+
+        Raises:
+            KeyError: If the log has no such edit
+            ValueError: If the edit was already undone
+        """
+        log = self.get_log(library_root)
+        index = next((i for i, entry in enumerate(log) if entry.edit_id == edit_id), None)
+        if index is None:
+            raise KeyError(f"No bulk edit {edit_id} in the log")
+        edit = log[index]
+        if edit.is_undone:
+            raise ValueError("This bulk edit was already undone")
+
+        database = self._database_factory(library_root)
+        conflicts = []
+        for change in edit.changes:
+            metadata = database.get_asset_metadata(Path(library_root) / change.asset_key) or {}
+            if metadata.get(change.field) != change.new_value:
+                conflicts.append(f"{change.asset_key}: {change.field}")
+                continue
+            self._write(database, change.asset_key, change.field, change.old_value)
+
+        log[index] = replace(edit, undone_date=datetime.now())
+        self._save_log(library_root, log)
+        print(f"[OK] Undid {edit.description} ({len(conflicts)} changed since)")
+        return log[index], conflicts
+
+    # Transaction log --------------------------------------------------------------------
+
+    def get_log_file(self, library_root: Path) -> Path:
+        """Get the bulk edit log of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / LOG_FILE_NAME
+
+    def get_log(self, library_root: Path) -> List[BulkEdit]:
+        """Get the applied edits of a library, newest first"""
+        log_file = self.get_log_file(library_root)
+        if not log_file.is_file():
+            return []
+        try:
+            with open(log_file, "r", encoding="utf-8") as f:
+                return [BulkEdit.from_dict(entry) for entry in json.load(f)]
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable bulk edit log {log_file}: {e}")
+            return []
+
+    def _save_log(self, library_root: Path, log: List[BulkEdit]) -> None:
+        """Write the log, dropping the oldest entries beyond MAX_LOG_ENTRIES"""
+        log_file = self.get_log_file(library_root)
+        log_file.parent.mkdir(parents=True, exist_ok=True)
+        with open(log_file, "w", encoding="utf-8") as f:
+            json.dump([entry.to_dict() for entry in log[:MAX_LOG_ENTRIES]], f, indent=2)
+
+    # Storage ----------------------------------------------------------------------------
+
+    def _get_all_metadata(self, library_root: Path) -> Dict[str, Dict[str, Any]]:
+        """Get every asset's metadata keyed by asset key"""
+        return self._database_factory(library_root).get_all_metadata()
+
+    def _new_edit(self, operation: str, find: str, new: str, changes: List) -> BulkEdit:
+        """Create a planned edit"""
+        return BulkEdit(uuid.uuid4().hex[:12], operation, find, new, tuple(changes))
+
+    def _write(self, database: Any, asset_key: str, field: str, value: Any) -> None:
+        """Set one metadata value (None removes it) in the database and sidecar"""
+        asset_file = Path(database.library_root) / asset_key
+        metadata = database.get_asset_metadata(asset_file) or {}
+        if value is None:
+            metadata.pop(field, None)
+        else:
+            metadata[field] = value
+        database.save_asset_metadata(asset_file, metadata)
+
+        # Older plugin versions still read the .meta sidecars; keep their fields in step
+        sidecar = Path(database.library_root) / f"{asset_key}{SIDECAR_SUFFIX}"
+        if not sidecar.is_file():
+            return
+        try:
+            with open(sidecar, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if field not in data:
+                return
+            if value is None:
+                data.pop(field)
+            else:
+                data[field] = value
+            with open(sidecar, "w", encoding="utf-8") as f:
+                json.dump(data, f, indent=2)
+        except Exception as e:
+            self.logger.warning(f"Could not update {field} in {sidecar}: {e}")
+
+
+# Singleton instance factory
+_bulk_edit_service_instance = None
+
+
+def get_bulk_edit_service() -> BulkEditService:
+    """
+    Get singleton instance of BulkEditService.
+
+    Returns:
+        BulkEditService: Singleton service instance
+    """
+    global _bulk_edit_service_instance
+    if _bulk_edit_service_instance is None:
+        _bulk_edit_service_instance = BulkEditService()
+    return _bulk_edit_service_instance
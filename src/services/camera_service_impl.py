@@ -0,0 +1,199 @@
+# -*- coding: utf-8 -*-
+"""
+Camera Service Implementation
+Publish shot cameras with their lens, animation, and image planes, and look through them
+on import
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Cameras are published as Maya scenes in the "Cameras" category, exported from the
+camera transform so its keys and the image planes under its shape go with it. The lens
+and film back are kept in the asset's library metadata::
+
+    "camera": {"name": "sh010_cam", "focal_length": 40.0, "start_frame": 1001, ...}
+
+Image plane images are collected with the asset's other dependencies. Imported shot
+cameras are not moved by the import placement options, and can be made the active
+viewport's camera so animation starts from the approved layout framing.
+"""
+
+import logging
+from typing import Any, Dict, List, Optional
+
+from ..core.models.shot_camera import ImagePlaneInfo, ShotCamera
+from .anim_clip_service_impl import strip_namespace
+from .validation_service_impl import DEFAULT_CAMERAS
+
+CAMERA_CATEGORY = "Cameras"
+CAMERA_METADATA_KEY = "camera"
+
+
+class CameraService:
+    """
+    Camera Service - Single Responsibility for shot camera metadata and viewport setup
+    Scene access goes through the cmds argument so cameras can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def find_cameras(self, cmds: Any, selection: Optional[List[str]] = None) -> List[str]:
+        """
+        Get the camera transforms being published, leaving out Maya's default cameras
+
+        Args:
+            cmds: maya.cmds module
+            selection: Selected nodes (transforms or camera shapes), the whole scene when empty
+        """
+        if selection:
+            nodes = cmds.ls(selection, long=True) or []
+        else:
+            nodes = cmds.ls(type="camera", long=True) or []
+        cameras = []
+        for node in nodes:
+            camera = self._get_camera_transform(cmds, node)
+            if camera and camera not in DEFAULT_CAMERAS and camera not in cameras:
+                cameras.append(camera)
+        return cameras
+
+    def read_camera(self, cmds: Any, camera: str) -> ShotCamera:
+        """
+        Read the lens, film back, key range, and image planes of a camera
+
+        Args:
+            cmds: maya.cmds module
+            camera: Full path of the camera transform
+        """
+        shape = self._get_camera_shape(cmds, camera)
+        if shape is None:
+            raise ValueError(f"{camera} is not a camera")
+
+        keys = cmds.keyframe([camera, shape], query=True, timeChange=True) or []
+        planes = cmds.listConnections(
+            f"{shape}.imagePlane", source=True, destination=False, shapes=True
+        )
+        image_planes = []
+        for plane in planes or []:
+            image = cmds.getAttr(f"{plane}.imageName") or ""
+            image_planes.append(ImagePlaneInfo(strip_namespace(plane), str(image)))
+
+        return ShotCamera(
+            name=strip_namespace(camera),
+            focal_length=float(cmds.getAttr(f"{shape}.focalLength")),
+            horizontal_aperture=float(cmds.getAttr(f"{shape}.horizontalFilmAperture")),
+            vertical_aperture=float(cmds.getAttr(f"{shape}.verticalFilmAperture")),
+            film_fit=str(cmds.getAttr(f"{shape}.filmFit", asString=True)),
+            near_clip=float(cmds.getAttr(f"{shape}.nearClipPlane")),
+            far_clip=float(cmds.getAttr(f"{shape}.farClipPlane")),
+            start_frame=float(min(keys)) if keys else None,
+            end_frame=float(max(keys)) if keys else None,
+            image_planes=tuple(image_planes),
+        )
+
+    def build_camera_metadata(self, cmds: Any, camera: str) -> Dict[str, Any]:
+        """Get the library metadata stored for a published camera"""
+        return self.read_camera(cmds, camera).to_dict()
+
+    def get_camera(self, metadata: Optional[Dict[str, Any]]) -> Optional[ShotCamera]:
+        """Get the shot camera stored in an asset's metadata, None for other assets"""
+        camera_metadata = (metadata or {}).get(CAMERA_METADATA_KEY)
+        if not camera_metadata:
+            return None
+        try:
+            return ShotCamera.from_dict(camera_metadata)
+        except (KeyError, TypeError, ValueError) as e:
+            print(f"[WARNING] Ignoring invalid camera metadata: {e}")
+            return None
+
+    # Import -----------------------------------------------------------------------------
+
+    def setup_camera(
+        self,
+        cmds: Any,
+        roots: List[str],
+        camera: ShotCamera,
+        look_through: bool = True,
+    ) -> Optional[str]:
+        """
+        Find an imported or referenced shot camera and make it the active viewport's camera
+
+        Args:
+            cmds: maya.cmds module
+            roots: Top-level transforms the import added
+            camera: Camera stored when the asset was published
+            look_through: Whether the active viewport switches to the camera
+
+        Returns:
+            Full path of the imported camera, None when the import holds no camera
+        """
+        imported = self._find_imported_camera(cmds, roots, camera.name)
+        if imported is None:
+            print(f"[WARNING] {camera.name}: the imported nodes hold no camera")
+            return None
+        if look_through:
+            panel = self.get_active_model_panel(cmds)
+            if panel is None:
+                print(f"[WARNING] No viewport to look through {camera.name}")
+            else:
+                cmds.modelPanel(panel, edit=True, camera=imported)
+                print(f"[OK] Looking through {camera.name} in {panel}")
+        return imported
+
+    def get_active_model_panel(self, cmds: Any) -> Optional[str]:
+        """Get the viewport with focus, or the first visible one"""
+        panel = cmds.getPanel(withFocus=True)
+        if panel and cmds.getPanel(typeOf=panel) == "modelPanel":
+            return panel
+        for panel in cmds.getPanel(visiblePanels=True) or []:
+            if cmds.getPanel(typeOf=panel) == "modelPanel":
+                return panel
+        return None
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_camera_shape(self, cmds: Any, camera: str) -> Optional[str]:
+        """Get the camera shape of a transform"""
+        shapes = cmds.listRelatives(camera, shapes=True, type="camera", fullPath=True) or []
+        return shapes[0] if shapes else None
+
+    def _get_camera_transform(self, cmds: Any, node: str) -> Optional[str]:
+        """Get the camera transform of a selected transform or camera shape"""
+        if cmds.nodeType(node) == "camera":
+            parents = cmds.listRelatives(node, parent=True, fullPath=True) or []
+            return parents[0] if parents else None
+        return node if self._get_camera_shape(cmds, node) else None
+
+    def _find_imported_camera(self, cmds: Any, roots: List[str], name: str) -> Optional[str]:
+        """Get the imported camera, also when a reference group wraps it"""
+        cameras = []
+        for root in roots:
+            descendants = cmds.listRelatives(
+                root, allDescendents=True, type="transform", fullPath=True
+            )
+            for node in [root] + list(reversed(descendants or [])):
+                if self._get_camera_shape(cmds, node):
+                    cameras.append(node)
+        for camera in cameras:
+            if strip_namespace(camera) == name:
+                return camera
+        return cameras[0] if len(cameras) == 1 else None
+
+
+# Singleton instance factory
+_camera_service_instance = None
+
+
+def get_camera_service() -> CameraService:
+    """
+    Get singleton instance of CameraService.
+
+    Returns:
+        CameraService: Singleton service instance
+    """
+    global _camera_service_instance
+    if _camera_service_instance is None:
+        _camera_service_instance = CameraService()
+    return _camera_service_instance
@@ -0,0 +1,145 @@
+# -*- coding: utf-8 -*-
+"""
+Collection Service Implementation
+Resolve manual and smart (saved query) collections to library assets
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Collections use the dict layout stored by the metadata database::
+
+    {"description": "...", "created": "2024-05-01", "assets": ["props/crate.ma"]}
+    {"description": "...", "created": "2024-05-01", "assets": [], "query": "tag:env updated:7d"}
+
+Manual collections list member asset keys (library-relative paths). Smart collections
+keep no members; they are re-evaluated against the search index whenever they are
+shown, so they follow the library as assets are published, tagged, or removed.
+"""
+
+from datetime import date
+from typing import Any, Callable, Dict, List
+
+from .search_engine_impl import SearchIndex, parse_query
+
+
+def is_smart_collection(data: Dict[str, Any]) -> bool:
+    """Check whether a collection is defined by a saved query"""
+    return bool(str(data.get("query", "")).strip())
+
+
+def make_collection(description: str = "", query: str = "") -> Dict[str, Any]:
+    """Create collection data for a new manual (no query) or smart collection"""
+    data: Dict[str, Any] = {
+        "description": description,
+        "created": date.today().isoformat(),
+        "assets": [],
+        "category": "smart" if query.strip() else "user",
+    }
+    if query.strip():
+        data["query"] = query.strip()
+    return data
+
+
+class CollectionService:
+    """
+    Collection Service - Single Responsibility for collection membership
+    Works on collection dicts; persistence stays with the metadata database
+    """
+
+    def validate_query(self, query: str) -> bool:
+        """Check that a smart collection query parses to something searchable"""
+        return parse_query(query) is not None
+
+    def resolve_members(
+        self,
+        data: Dict[str, Any],
+        assets: List[Any],
+        search_index: SearchIndex,
+        key_of: Callable[[Any], str],
+    ) -> List[Any]:
+        """
+        Get the library assets that belong to a collection
+
+        Args:
+            data: Collection dict
+            assets: All assets in the loaded library
+            search_index: Index built from the same assets
+            key_of: Returns the library key of an asset
+
+        Returns:
+            Member assets, in collection order for manual collections
+        """
+        if is_smart_collection(data):
+            return search_index.search(data["query"])
+
+        by_key: Dict[str, Any] = {}
+        for asset in assets:
+            by_key.setdefault(key_of(asset), asset)
+            # Collections created before keys were used list plain file names
+            by_key.setdefault(asset.file_path.name, asset)
+
+        members = []
+        for key in data.get("assets", []):
+            asset = by_key.get(key)
+            if asset is not None and asset not in members:
+                members.append(asset)
+        return members
+
+    def add_members(self, data: Dict[str, Any], keys: List[str]) -> int:
+        """Add asset keys to a manual collection; returns how many were new"""
+        if is_smart_collection(data):
+            return 0
+        members = data.setdefault("assets", [])
+        added = 0
+        for key in keys:
+            if key not in members:
+                members.append(key)
+                added += 1
+        return added
+
+    def remove_members(self, data: Dict[str, Any], keys: List[str]) -> int:
+        """Remove asset keys from a manual collection; returns how many were removed"""
+        members = data.get("assets", [])
+        removed = set(keys)
+        before = len(members)
+        data["assets"] = [key for key in members if key not in removed]
+        return before - len(data["assets"])
+
+    def move_members(
+        self, collections: Dict[str, Dict[str, Any]], keys: List[str], target: str
+    ) -> List[str]:
+        """
+        Move asset keys into a manual collection and out of every other manual one
+
+        Returns:
+            Names of the collections that changed, the target last
+        """
+        if target not in collections or is_smart_collection(collections[target]):
+            raise ValueError(f"'{target}' is not a manual collection")
+        changed = [
+            name
+            for name, data in collections.items()
+            if name != target
+            and not is_smart_collection(data)
+            and self.remove_members(data, keys)
+        ]
+        if self.add_members(collections[target], keys):
+            changed.append(target)
+        return changed
+
+
+# Singleton instance factory
+_collection_service_instance = None
+
+
+def get_collection_service() -> CollectionService:
+    """
+    Get singleton instance of CollectionService.
+
+    Returns:
+        CollectionService: Singleton service instance
+    """
+    global _collection_service_instance
+    if _collection_service_instance is None:
+        _collection_service_instance = CollectionService()
+    return _collection_service_instance
@@ -0,0 +1,245 @@
+# -*- coding: utf-8 -*-
+"""
+Color Management Service Implementation
+OCIO view transform of thumbnail and preview renders, and the thumbnails it outdates
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The project's OCIO config and view are stored with the library, so batch thumbnails
+look like the artists' viewports. Without library settings the config named by the
+OCIO environment variable is used. The transform each thumbnail was rendered with is
+kept in its asset's library metadata, so a changed config shows which thumbnails to
+regenerate::
+
+    MyProject/.assetmanager/color_management.json
+    {"config_path": "//studio/ocio/aces_1.2/config.ocio", "rendering_space": "ACEScg",
+     "display": "sRGB", "view": "ACES 1.0 SDR-video"}
+"""
+
+import hashlib
+import json
+import logging
+import os
+from dataclasses import replace
+from pathlib import Path
+from typing import Any, Dict, Iterable, List, Optional
+
+from ..core.models.color_transform import ColorTransform
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "color_management.json"
+OCIO_ENV_VAR = "OCIO"
+# Maya names configs shipped with it through this token
+MAYA_CONFIG_TOKEN = "<MAYA_RESOURCES>"
+
+# Library metadata key holding the transform an asset's thumbnail was rendered with
+THUMBNAIL_COLOR_METADATA_KEY = "thumbnail_color"
+
+# Maya output targets the view transform is applied to (ogsRender and playblast)
+OUTPUT_TARGETS = ("renderer", "playblast")
+
+
+class ColorManagementService:
+    """
+    Color Management Service - Single Responsibility for color managed thumbnail output
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Project transform ------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the color management settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def load_project_transform(self, library_root: Optional[Path]) -> ColorTransform:
+        """
+        Get the transform thumbnails of a library are rendered with
+
+        Returns:
+            Library settings with the $OCIO config when they name no config; an
+            unmanaged transform without either. The config hash is read now.
+        """
+        transform = ColorTransform()
+        settings_file = self.get_settings_file(library_root) if library_root else None
+        if settings_file is not None and settings_file.is_file():
+            try:
+                with open(settings_file, "r", encoding="utf-8") as f:
+                    transform = ColorTransform.from_dict(json.load(f))
+            except Exception as e:
+                print(f"[WARNING] Ignoring unreadable color settings {settings_file}: {e}")
+        if not transform.config_path and os.environ.get(OCIO_ENV_VAR):
+            transform = replace(transform, config_path=os.environ[OCIO_ENV_VAR])
+        return self._with_config_hash(transform)
+
+    def save_project_transform(self, library_root: Path, transform: ColorTransform) -> bool:
+        """Write a library's color management settings (the hash is never stored)"""
+        settings_file = self.get_settings_file(library_root)
+        data = transform.to_dict()
+        data.pop("config_hash", None)
+        try:
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump(data, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save color management settings: {e}")
+            return False
+
+    def hash_config(self, config_path: str) -> str:
+        """Get the SHA-256 of an OCIO config file, "" when it cannot be read"""
+        path = Path(os.path.expandvars(config_path)) if config_path else None
+        if path is None or not path.is_file():
+            return ""
+        try:
+            return hashlib.sha256(path.read_bytes()).hexdigest()
+        except OSError as e:
+            self.logger.warning(f"Could not read OCIO config {path}: {e}")
+            return ""
+
+    # Maya -------------------------------------------------------------------------------
+
+    def read_scene_transform(self, cmds: Any) -> ColorTransform:
+        """Get the config and view transform of the open Maya scene"""
+        if not cmds.colorManagementPrefs(query=True, cmEnabled=True):
+            return ColorTransform()
+        config_path = ""
+        if cmds.colorManagementPrefs(query=True, cmConfigFileEnabled=True):
+            config_path = cmds.colorManagementPrefs(query=True, configFilePath=True) or ""
+        if config_path.startswith(MAYA_CONFIG_TOKEN):
+            config_path = ""  # Maya's own config, every mayapy finds it
+        try:
+            display = cmds.colorManagementPrefs(query=True, displayName=True) or ""
+            view = cmds.colorManagementPrefs(query=True, viewName=True) or ""
+        except TypeError:
+            # Maya 2020 and older name the view transform only
+            display = ""
+            view = cmds.colorManagementPrefs(query=True, viewTransformName=True) or ""
+        transform = ColorTransform(
+            config_path=config_path,
+            rendering_space=cmds.colorManagementPrefs(query=True, renderingSpaceName=True) or "",
+            display=display,
+            view=view,
+        )
+        return self._with_config_hash(transform)
+
+    def enable_view_transform(self, cmds: Any) -> Dict[str, Any]:
+        """
+        Apply the scene's view transform to playblasts and viewport renders
+
+        Returns:
+            Previous output settings for restore_view_transform, empty when the scene
+            is not color managed
+        """
+        previous: Dict[str, Any] = {}
+        try:
+            if not cmds.colorManagementPrefs(query=True, cmEnabled=True):
+                return previous
+            for target in OUTPUT_TARGETS:
+                previous[target] = (
+                    cmds.colorManagementPrefs(
+                        query=True, outputTarget=target, outputTransformEnabled=True
+                    ),
+                    cmds.colorManagementPrefs(
+                        query=True, outputTarget=target, outputUseViewTransform=True
+                    ),
+                )
+                cmds.colorManagementPrefs(
+                    edit=True,
+                    outputTarget=target,
+                    outputTransformEnabled=True,
+                    outputUseViewTransform=True,
+                )
+        except Exception as e:
+            print(f"[WARNING] Could not apply the view transform to the capture: {e}")
+        return previous
+
+    def restore_view_transform(self, cmds: Any, previous: Dict[str, Any]) -> None:
+        """Put back the output settings enable_view_transform changed"""
+        for target, (enabled, use_view) in previous.items():
+            try:
+                cmds.colorManagementPrefs(
+                    edit=True,
+                    outputTarget=target,
+                    outputTransformEnabled=enabled,
+                    outputUseViewTransform=use_view,
+                )
+            except Exception as e:
+                print(f"[WARNING] Could not restore {target} color output: {e}")
+
+    def build_batch_arguments(self, transform: ColorTransform) -> List[str]:
+        """Build the thumbnail_batch.py arguments of a transform ([] when unmanaged)"""
+        arguments: List[str] = []
+        for flag, value in (
+            ("--ocio-config", transform.config_path),
+            ("--ocio-rendering-space", transform.rendering_space),
+            ("--ocio-display", transform.display),
+            ("--ocio-view", transform.view),
+        ):
+            if value:
+                arguments += [flag, value]
+        return arguments
+
+    # Thumbnail metadata -----------------------------------------------------------------
+
+    def get_thumbnail_transform(
+        self, database: Any, asset_file: Path
+    ) -> Optional[ColorTransform]:
+        """Get the transform an asset's thumbnail was rendered with, None if unknown"""
+        metadata = database.get_asset_metadata(Path(asset_file)) or {}
+        data = metadata.get(THUMBNAIL_COLOR_METADATA_KEY)
+        return ColorTransform.from_dict(data) if isinstance(data, dict) else None
+
+    def record_thumbnail_transform(
+        self, database: Any, asset_file: Path, transform: ColorTransform
+    ) -> None:
+        """Store the transform an asset's thumbnail was just rendered with"""
+        metadata = database.get_asset_metadata(Path(asset_file)) or {}
+        metadata[THUMBNAIL_COLOR_METADATA_KEY] = transform.to_dict()
+        database.save_asset_metadata(Path(asset_file), metadata)
+
+    def find_stale_thumbnails(
+        self, database: Any, transform: ColorTransform, asset_files: Iterable[Path]
+    ) -> List[Path]:
+        """
+        Get assets whose thumbnail was not rendered with the project transform
+
+        Thumbnails with no recorded transform predate color management and count as
+        stale once the project is color managed.
+        """
+        metadata = database.get_all_metadata()
+        stale = []
+        for asset_file in asset_files:
+            data = (metadata.get(database.get_asset_key(Path(asset_file))) or {}).get(
+                THUMBNAIL_COLOR_METADATA_KEY
+            )
+            recorded = ColorTransform.from_dict(data) if isinstance(data, dict) else None
+            if recorded is None:
+                if transform.is_managed:
+                    stale.append(Path(asset_file))
+            elif not recorded.matches(transform):
+                stale.append(Path(asset_file))
+        return stale
+
+    def _with_config_hash(self, transform: ColorTransform) -> ColorTransform:
+        """Get a transform carrying the current hash of its config file"""
+        return replace(transform, config_hash=self.hash_config(transform.config_path))
+
+
+# Singleton instance factory
+_color_management_service_instance = None
+
+
+def get_color_management_service() -> ColorManagementService:
+    """
+    Get singleton instance of ColorManagementService.
+
+    Returns:
+        ColorManagementService: Singleton service instance
+    """
+    global _color_management_service_instance
+    if _color_management_service_instance is None:
+        _color_management_service_instance = ColorManagementService()
+    return _color_management_service_instance
@@ -0,0 +1,182 @@
+# -*- coding: utf-8 -*-
+"""
+Comment Service Implementation
+Threaded review notes on assets, with @mentions that notify the artists named
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Notes are stored in the library database, so everyone on a shared library sees
+the same threads. A note can be about one version of the asset; its replies stay
+on that version. Writing @jdoe in a note puts it in jdoe's notifications until
+they open it.
+"""
+
+import logging
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.asset_comment import AssetComment, CommentNotification, CommentThread
+from .metadata_database_impl import get_metadata_database
+from .version_service_impl import get_current_user
+
+
+class CommentService:
+    """
+    Comment Service - Single Responsibility for asset review notes and mentions
+    """
+
+    def __init__(self, database_factory=None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+
+    # Notes ------------------------------------------------------------------------------
+
+    def add_comment(
+        self,
+        library_root: Path,
+        asset_file: Path,
+        body: str,
+        version: int = 0,
+        parent_id: Optional[int] = None,
+        user: Optional[str] = None,
+    ) -> AssetComment:
+        """
+        Leave a note on an asset and notify the artists it mentions
+
+        Args:
+            library_root: Library the asset belongs to
+            asset_file: Asset the note is about
+            body: Note text
+            version: Version the note is about, 0 for the whole asset
+            parent_id: Note being answered; replies join the thread it belongs to
+            user: Author, defaults to the current artist
+
+        Returns:
+            The stored note
+
+        Raises:
+            ValueError: If the note is empty or the note answered is not on the asset
+        """
+        database = self._database_factory(Path(library_root))
+        asset_file = Path(asset_file)
+        asset_key = database.get_asset_key(asset_file)
+        user = user or get_current_user()
+        body = body.strip()
+        if not body:
+            raise ValueError("A note needs some text")
+
+        if parent_id is not None:
+            parent = database.get_comment(parent_id)
+            if parent is None or parent["asset_path"] != asset_key:
+                raise ValueError(f"Note {parent_id} is not on {asset_file.name}")
+            parent_id = parent["parent_id"] or parent["id"]
+            version = parent["version"]
+
+        created = datetime.now().replace(microsecond=0)
+        comment_id = database.add_comment(
+            asset_file, user, body, created.isoformat(), version, parent_id
+        )
+        comment = AssetComment(
+            comment_id=comment_id,
+            asset_key=asset_key,
+            user=user,
+            body=body,
+            created=created,
+            version=version,
+            parent_id=parent_id,
+        )
+
+        mentioned = [name for name in comment.mentions if name.lower() != user.lower()]
+        if mentioned:
+            database.add_notifications(comment_id, mentioned, created.isoformat())
+            print(f"[INFO] Notified {', '.join(mentioned)} of a note on {comment.asset_name}")
+        return comment
+
+    def get_threads(self, library_root: Path, asset_file: Path) -> List[CommentThread]:
+        """Get an asset's threads, latest activity first, each with replies oldest first"""
+        database = self._database_factory(Path(library_root))
+        comments = [self._to_comment(row) for row in database.get_comments(Path(asset_file))]
+
+        replies: Dict[int, List[AssetComment]] = {}
+        for comment in comments:
+            if comment.is_reply:
+                replies.setdefault(comment.parent_id, []).append(comment)
+        threads = [
+            CommentThread(comment, tuple(replies.get(comment.comment_id, [])))
+            for comment in comments
+            if not comment.is_reply
+        ]
+        return sorted(
+            threads,
+            key=lambda thread: (thread.last_activity, thread.comments[-1].comment_id),
+            reverse=True,
+        )
+
+    def set_resolved(
+        self, library_root: Path, thread: CommentThread, resolved: bool = True
+    ) -> None:
+        """Mark a thread resolved, or open it again"""
+        database = self._database_factory(Path(library_root))
+        database.set_comments_resolved([thread.root.comment_id], resolved)
+
+    # Notifications ----------------------------------------------------------------------
+
+    def get_notifications(
+        self, library_root: Path, user: Optional[str] = None, unread_only: bool = True
+    ) -> List[CommentNotification]:
+        """Get the notes mentioning an artist (the current one by default), newest first"""
+        database = self._database_factory(Path(library_root))
+        rows = database.get_notifications(user or get_current_user(), unread_only)
+        return [
+            CommentNotification(
+                notification_id=row["notification_id"],
+                comment=self._to_comment(row),
+                read=bool(row["read"]),
+            )
+            for row in rows
+        ]
+
+    def mark_read(
+        self,
+        library_root: Path,
+        notifications: Optional[List[CommentNotification]] = None,
+        user: Optional[str] = None,
+    ) -> None:
+        """Mark notifications read (every one of the artist's when none are given)"""
+        database = self._database_factory(Path(library_root))
+        ids = None
+        if notifications is not None:
+            ids = [notification.notification_id for notification in notifications]
+        database.mark_notifications_read(user or get_current_user(), ids)
+
+    def _to_comment(self, row: Dict[str, Any]) -> AssetComment:
+        """Build a note from a database row"""
+        return AssetComment(
+            comment_id=row["id"],
+            asset_key=row["asset_path"],
+            user=row["user"],
+            body=row["body"],
+            created=datetime.fromisoformat(row["created_date"]),
+            version=row["version"] or 0,
+            parent_id=row["parent_id"],
+            resolved=bool(row["resolved"]),
+        )
+
+
+# Singleton instance factory
+_comment_service_instance = None
+
+
+def get_comment_service() -> CommentService:
+    """
+    Get singleton instance of CommentService.
+
+    Returns:
+        CommentService: Singleton service instance
+    """
+    global _comment_service_instance
+    if _comment_service_instance is None:
+        _comment_service_instance = CommentService()
+    return _comment_service_instance
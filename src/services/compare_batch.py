@@ -0,0 +1,150 @@
+# -*- coding: utf-8 -*-
+"""
+Compare Batch Script
+Runs inside mayapy to render two assets as orbit frame sequences for an A/B review
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Launched by ComparePreviewService, so neither asset is ever imported into the
+artist's scene. Each side is opened in the batch scene on its own and lit by the
+thumbnail rig. Both share one camera distance, fitted to the larger of the two, so
+a revision that grew or shrank shows that way side by side. Opening assets and
+rendering frames reuse thumbnail_batch.py from the same folder::
+
+    output/left/tilt0.0000.png ... tilt2.0035.png
+    output/right/tilt0.0000.png ... tilt2.0035.png
+
+Usage::
+
+    mayapy compare_batch.py --left crate_v003.ma --right crate.ma --output frames
+        [--frames 36 --tilts=-45,-20,0 --size 384]
+        [--ocio-config config.ocio --ocio-rendering-space ACEScg
+         --ocio-display sRGB --ocio-view "ACES 1.0 SDR-video"]
+"""
+
+import argparse
+import math
+import sys
+from pathlib import Path
+from typing import Any, List, Tuple
+
+from thumbnail_batch import (  # type: ignore
+    _open_asset,
+    _parse_floats,
+    _render_frame,
+    _setup_color_management,
+    _setup_lighting,
+)
+
+BACKGROUND = (0.36, 0.36, 0.36)
+FOCAL_LENGTH = 35.0
+FILM_APERTURE_MM = 36.0  # Maya's default horizontal film aperture (1.417 in)
+FRAME_MARGIN = 1.1
+
+# (center, radius) of an asset's bounding sphere
+Bounds = Tuple[Tuple[float, float, float], float]
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line"""
+    parser = argparse.ArgumentParser(description="Render Asset Manager compare previews")
+    parser.add_argument("--left", required=True, help="Asset file shown on the left")
+    parser.add_argument("--right", required=True, help="Asset file shown on the right")
+    parser.add_argument("--output", required=True, help="Folder receiving the frames")
+    parser.add_argument("--frames", type=int, default=36, help="Orbit steps per tilt")
+    parser.add_argument(
+        "--tilts", type=_parse_floats, default=(-45.0, -20.0, 0.0), help="Camera tilts"
+    )
+    parser.add_argument("--size", type=int, default=384, help="Square render resolution")
+    parser.add_argument("--ocio-config", default="", help="OCIO config file")
+    parser.add_argument("--ocio-rendering-space", default="", help="Rendering color space")
+    parser.add_argument("--ocio-display", default="", help="OCIO display")
+    parser.add_argument("--ocio-view", default="", help="OCIO view transform")
+    return parser.parse_args(argv)
+
+
+def orbit_distance(radius: float, focal_length: float = FOCAL_LENGTH) -> float:
+    """Get how far the camera sits so a bounding sphere fills the frame from any angle"""
+    half_angle = math.atan(FILM_APERTURE_MM / 2.0 / focal_length)
+    return radius / math.sin(half_angle) * FRAME_MARGIN
+
+
+def _measure(cmds: Any) -> Bounds:
+    """Get the bounding sphere of the open asset's geometry"""
+    meshes = cmds.ls(type="mesh", noIntermediate=True) or []
+    if not meshes:
+        raise RuntimeError("No geometry to render")
+    bbox = cmds.exactWorldBoundingBox(meshes)
+    center = ((bbox[0] + bbox[3]) / 2.0, (bbox[1] + bbox[4]) / 2.0, (bbox[2] + bbox[5]) / 2.0)
+    radius = math.sqrt(sum((bbox[i + 3] - bbox[i]) ** 2 for i in range(3))) / 2.0
+    return center, max(radius, 1e-3)
+
+
+def _create_orbit_camera(cmds: Any, center, distance: float, size: int) -> Tuple[str, str, str]:
+    """Create a camera on an orbit (rotateY) and tilt (rotateX) rig around the center"""
+    orbit = cmds.group(empty=True, name="compareOrbit")
+    cmds.xform(orbit, worldSpace=True, translation=center)
+    tilt = cmds.group(empty=True, name="compareTilt", parent=orbit)
+
+    camera, shape = cmds.camera(name="compareCam", focalLength=FOCAL_LENGTH)
+    camera = cmds.parent(camera, tilt)[0]
+    cmds.xform(camera, objectSpace=True, translation=(0, 0, distance), rotation=(0, 0, 0))
+    cmds.setAttr(f"{shape}.nearClipPlane", max(distance / 1000.0, 0.001))
+    cmds.setAttr(f"{shape}.farClipPlane", distance * 4.0)
+
+    cmds.setAttr("defaultResolution.width", size)
+    cmds.setAttr("defaultResolution.height", size)
+    cmds.setAttr("defaultResolution.deviceAspectRatio", 1.0)
+    cmds.setAttr("defaultRenderGlobals.imageFormat", 32)  # PNG
+    return camera, orbit, tilt
+
+
+def _render_orbit(cmds: Any, args: argparse.Namespace, side: str, bounds: Bounds, distance):
+    """Render one side at every tilt and orbit step"""
+    camera, orbit, tilt = _create_orbit_camera(cmds, bounds[0], distance, args.size)
+    output = Path(args.output) / side
+    for tilt_index, angle in enumerate(args.tilts):
+        cmds.setAttr(f"{tilt}.rotateX", angle)
+        for frame in range(args.frames):
+            cmds.setAttr(f"{orbit}.rotateY", 360.0 * frame / args.frames)
+            image = output / f"tilt{tilt_index}.{frame:04d}.png"
+            _render_frame(cmds, camera, image, args.size)
+
+
+def main(argv: List[str]) -> int:
+    """Render both sides - returns process exit code"""
+    args = _parse_args(argv)
+    sides = [("left", Path(args.left)), ("right", Path(args.right))]
+
+    import maya.standalone  # type: ignore
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+
+        bounds = {}
+        for side, asset_file in sides:
+            _open_asset(cmds, asset_file)
+            bounds[side] = _measure(cmds)
+        distance = orbit_distance(max(radius for _center, radius in bounds.values()))
+
+        for side, asset_file in sides:
+            _open_asset(cmds, asset_file)
+            neutral = asset_file.suffix.lower() != ".lightrig"
+            _setup_lighting(cmds, neutral, BACKGROUND)
+            _setup_color_management(cmds, args)
+            _render_orbit(cmds, args, side, bounds[side], distance)
+            print(f"[OK] Rendered {side} compare frames: {asset_file.name}")
+        return 0
+
+    except Exception as e:
+        print(f"[ERROR] Compare batch failed: {e}")
+        return 1
+
+    finally:
+        maya.standalone.uninitialize()
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
@@ -0,0 +1,169 @@
+# -*- coding: utf-8 -*-
+"""
+Compare Preview Service Implementation
+Render two assets or two versions side by side, offscreen, for an A/B review
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A mayapy process (compare_batch.py) opens each asset in its own batch scene and
+renders an orbit at a few camera tilts, so reviewers tumble a revision without
+importing anything into their working scene. Frames go to a temporary folder that
+is removed once the preview closes::
+
+    assetManager_compare_xxxx/left/tilt1.0004.png    <- tilt 1, orbit step 4
+"""
+
+import logging
+import shutil
+import subprocess
+import tempfile
+import threading
+from pathlib import Path
+from typing import Callable, List, Optional
+
+from ..core.models.color_transform import ColorTransform
+from ..core.models.compare_preview import DEFAULT_TILTS, ComparePreview
+from .color_management_service_impl import get_color_management_service
+from .thumbnail_queue_impl import find_mayapy
+
+COMPARE_SCRIPT = Path(__file__).with_name("compare_batch.py")
+
+# Renders the preview; returns the error, "" on success
+CompareRunner = Callable[[ComparePreview, Optional[ColorTransform]], str]
+
+
+class ComparePreviewService:
+    """
+    Compare Preview Service - Single Responsibility for offscreen A/B renders
+    Rendering runs in a separate mayapy process; the open scene is never touched
+    """
+
+    def __init__(self, runner: Optional[CompareRunner] = None):
+        self.logger = logging.getLogger(__name__)
+        self._runner = runner or self._run_batch
+
+    def create_preview(
+        self,
+        left_file: Path,
+        right_file: Path,
+        left_label: str = "",
+        right_label: str = "",
+        frames: int = 36,
+        size: int = 384,
+    ) -> ComparePreview:
+        """Get a preview of two asset files rendering into a new temporary folder"""
+        output_dir = Path(tempfile.mkdtemp(prefix="assetManager_compare_"))
+        return ComparePreview(
+            Path(left_file),
+            Path(right_file),
+            output_dir,
+            left_label,
+            right_label,
+            frames=frames,
+            tilts=DEFAULT_TILTS,
+            size=size,
+        )
+
+    def render(self, preview: ComparePreview, color: Optional[ColorTransform] = None) -> str:
+        """
+        Render both sides of a preview
+
+        Args:
+            preview: Assets and frames to render
+            color: Project OCIO config and view to render through, Maya defaults if None
+
+        Returns:
+            The error, "" once every frame is on disk
+        """
+        for asset_file in (preview.left_file, preview.right_file):
+            if not Path(asset_file).is_file():
+                return f"{Path(asset_file).name} does not exist"
+        try:
+            error = self._runner(preview, color)
+        except Exception as e:
+            error = str(e)
+        if not error:
+            missing = preview.missing_frames()
+            if missing:
+                error = f"{len(missing)} of {2 * preview.frame_count} frames were not rendered"
+        if error:
+            print(f"[ERROR] Compare preview of {preview.label} failed: {error}")
+        else:
+            print(f"[OK] Rendered compare preview: {preview.label}")
+        return error
+
+    def render_in_background(
+        self,
+        preview: ComparePreview,
+        finished: Callable[[str], None],
+        color: Optional[ColorTransform] = None,
+    ) -> threading.Thread:
+        """Render on a worker thread; finished is called from that thread with the error"""
+        thread = threading.Thread(
+            target=lambda: finished(self.render(preview, color)), name="compare", daemon=True
+        )
+        thread.start()
+        return thread
+
+    def discard(self, preview: ComparePreview) -> None:
+        """Remove a preview's rendered frames"""
+        shutil.rmtree(preview.output_dir, ignore_errors=True)
+
+    def build_batch_command(
+        self, preview: ComparePreview, mayapy: Path, color: Optional[ColorTransform] = None
+    ) -> List[str]:
+        """Build the mayapy command line rendering a preview"""
+        command = [
+            str(mayapy),
+            str(COMPARE_SCRIPT),
+            "--left",
+            str(preview.left_file),
+            "--right",
+            str(preview.right_file),
+            "--output",
+            str(preview.output_dir),
+            "--frames",
+            str(preview.frames),
+            # = keeps a leading minus from reading as a flag
+            "--tilts=" + ",".join(f"{tilt:g}" for tilt in preview.tilts),
+            "--size",
+            str(preview.size),
+        ]
+        if color is not None:
+            command += get_color_management_service().build_batch_arguments(color)
+        return command
+
+    def _run_batch(self, preview: ComparePreview, color: Optional[ColorTransform]) -> str:
+        """Render a preview in a separate mayapy process"""
+        mayapy = find_mayapy()
+        if mayapy is None:
+            return "mayapy not found (set MAYA_LOCATION)"
+
+        result = subprocess.run(
+            self.build_batch_command(preview, mayapy, color),
+            capture_output=True,
+            text=True,
+            check=False,
+        )
+        if result.returncode != 0:
+            output = (result.stdout + result.stderr).strip().splitlines()
+            return output[-1] if output else f"mayapy exited with {result.returncode}"
+        return ""
+
+
+# Singleton instance factory
+_compare_preview_service_instance = None
+
+
+def get_compare_preview_service() -> ComparePreviewService:
+    """
+    Get singleton instance of ComparePreviewService.
+
+    Returns:
+        ComparePreviewService: Singleton service instance
+    """
+    global _compare_preview_service_instance
+    if _compare_preview_service_instance is None:
+        _compare_preview_service_instance = ComparePreviewService()
+    return _compare_preview_service_instance
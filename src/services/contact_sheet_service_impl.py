@@ -0,0 +1,351 @@
+# -*- coding: utf-8 -*-
+"""
+Contact Sheet Service Implementation
+Export a collection as a thumbnail wall, and an HTML gallery, for reviews outside Maya
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Each asset gets a cell with its thumbnail, name, version, and status. PDF sheets
+fill landscape A4 pages; PNG sheets are one large image. The optional gallery is a
+folder an art director can open in any browser::
+
+    props_review.pdf
+    props_review_gallery/index.html
+    props_review_gallery/images/001_crate.png
+"""
+
+import html
+import logging
+import math
+import re
+import shutil
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional
+
+from ..core.models.contact_sheet import (
+    FORMAT_PDF,
+    FORMATS,
+    ContactSheetEntry,
+    ContactSheetOptions,
+)
+from .asset_status_service_impl import get_asset_status_service
+
+GALLERY_SUFFIX = "_gallery"
+GALLERY_IMAGES_DIR_NAME = "images"
+PDF_RESOLUTION = 150  # Dots per inch of PDF pages
+
+# Colors of the sheet: light so it prints
+BACKGROUND_COLOR = "#ffffff"
+TEXT_COLOR = "#202020"
+FRAME_COLOR = "#c8c8c8"
+
+_UNSAFE_NAME = re.compile(r"[^A-Za-z0-9_\-]+")
+
+
+class ContactSheetService:
+    """
+    Contact Sheet Service - Single Responsibility for review sheet exports
+    Sheets are drawn with Qt, so they need no image libraries besides PySide6
+    """
+
+    def __init__(self, status_service: Any = None):
+        self.logger = logging.getLogger(__name__)
+        self._status_service = status_service or get_asset_status_service()
+
+    # Entries ----------------------------------------------------------------------------
+
+    def build_entries(
+        self,
+        assets: List[Any],
+        key_of: Callable[[Any], str],
+        versions: Optional[Dict[str, int]] = None,
+        thumbnail_of: Optional[Callable[[Any], Optional[Path]]] = None,
+    ) -> List[ContactSheetEntry]:
+        """
+        Get the cells of a sheet, in asset order
+
+        Args:
+            assets: Library assets shown, such as a collection's members
+            key_of: Returns the library key of an asset
+            versions: Asset key -> latest version number
+            thumbnail_of: Returns an asset's thumbnail image, None when it has none
+        """
+        versions = versions or {}
+        entries = []
+        for asset in assets:
+            key = key_of(asset)
+            thumbnail = thumbnail_of(asset) if thumbnail_of else None
+            if thumbnail and not Path(thumbnail).is_file():
+                thumbnail = None
+            status = self._status_service.get_status(getattr(asset, "metadata", None))
+            entries.append(
+                ContactSheetEntry(
+                    name=getattr(asset, "display_name", "") or Path(key).stem,
+                    asset_key=key,
+                    version=int(versions.get(key) or 0),
+                    status=status.state,
+                    thumbnail=Path(thumbnail) if thumbnail else None,
+                )
+            )
+        return entries
+
+    # Export -----------------------------------------------------------------------------
+
+    def get_gallery_dir(self, output_path: Path) -> Path:
+        """Get the gallery folder written next to a sheet"""
+        output_path = Path(output_path)
+        return output_path.parent / f"{output_path.stem}{GALLERY_SUFFIX}"
+
+    def export(
+        self, entries: List[ContactSheetEntry], output_path: Path, options: ContactSheetOptions
+    ) -> List[Path]:
+        """
+        Write a contact sheet, and its gallery when the options ask for one
+
+        Returns:
+            The files written: the sheet, then the gallery's index.html
+
+        Raises:
+            ValueError: When there are no entries or the format is unknown
+        """
+        if not entries:
+            raise ValueError("The contact sheet has no assets")
+        if options.file_format not in FORMATS:
+            raise ValueError(f"Unknown contact sheet format '{options.file_format}'")
+        output_path = Path(output_path).with_suffix(f".{options.file_format}")
+        output_path.parent.mkdir(parents=True, exist_ok=True)
+        if options.file_format == FORMAT_PDF:
+            self._render_pdf(entries, output_path, options)
+        else:
+            self._render_png(entries, output_path, options)
+        written = [output_path]
+        if options.html_gallery:
+            written.append(
+                self.write_gallery(entries, self.get_gallery_dir(output_path), options.title)
+            )
+        print(f"[OK] Exported contact sheet of {len(entries)} asset(s): {output_path}")
+        return written
+
+    def write_gallery(
+        self, entries: List[ContactSheetEntry], gallery_dir: Path, title: str = ""
+    ) -> Path:
+        """
+        Write an HTML gallery with copies of the thumbnails
+
+        Returns:
+            The gallery's index.html
+        """
+        gallery_dir = Path(gallery_dir)
+        images_dir = gallery_dir / GALLERY_IMAGES_DIR_NAME
+        images_dir.mkdir(parents=True, exist_ok=True)
+        title = title or "Contact Sheet"
+
+        cells = []
+        for number, entry in enumerate(entries, 1):
+            image = '<div class="missing">No thumbnail</div>'
+            if entry.thumbnail is not None:
+                name = _UNSAFE_NAME.sub("_", entry.name).strip("_") or "asset"
+                copy = images_dir / f"{number:03d}_{name}{entry.thumbnail.suffix.lower()}"
+                try:
+                    shutil.copy2(entry.thumbnail, copy)
+                    image = (
+                        f'<img src="{GALLERY_IMAGES_DIR_NAME}/{html.escape(copy.name)}" '
+                        f'alt="{html.escape(entry.name)}">'
+                    )
+                except OSError as e:
+                    print(f"[WARNING] Could not copy the thumbnail of {entry.name}: {e}")
+            cells.append(
+                f'  <figure title="{html.escape(entry.asset_key)}">{image}\n'
+                f"    <figcaption><b>{html.escape(entry.name)}</b><br>"
+                f"{html.escape(entry.version_label)} "
+                f'<span class="status" style="background:{entry.status_color}">'
+                f"{html.escape(entry.status_label)}</span></figcaption>\n"
+                "  </figure>"
+            )
+
+        index = gallery_dir / "index.html"
+        index.write_text(
+            "<!DOCTYPE html>\n"
+            f'<html><head><meta charset="utf-8"><title>{html.escape(title)}</title>\n'
+            "<style>\n"
+            f"body {{ font-family: sans-serif; background: {BACKGROUND_COLOR}; "
+            f"color: {TEXT_COLOR}; }}\n"
+            ".wall { display: grid; grid-template-columns: repeat(auto-fill, minmax(220px, 1fr));"
+            " gap: 16px; }\n"
+            f"figure {{ margin: 0; border: 1px solid {FRAME_COLOR}; padding: 8px; }}\n"
+            "img, .missing { width: 100%; aspect-ratio: 1; object-fit: contain; }\n"
+            ".missing { display: flex; align-items: center; justify-content: center; "
+            "color: #888; }\n"
+            ".status { color: white; padding: 1px 6px; border-radius: 3px; }\n"
+            "</style></head><body>\n"
+            f"<h1>{html.escape(title)}</h1>\n"
+            f"<p>{len(entries)} asset(s)</p>\n"
+            '<div class="wall">\n' + "\n".join(cells) + "\n</div>\n</body></html>\n",
+            encoding="utf-8",
+        )
+        return index
+
+    # Rendering --------------------------------------------------------------------------
+
+    def _render_pdf(
+        self, entries: List[ContactSheetEntry], output_path: Path, options: ContactSheetOptions
+    ) -> None:
+        """Draw the sheet on landscape A4 pages"""
+        from PySide6.QtCore import QMarginsF
+        from PySide6.QtGui import QPageLayout, QPageSize, QPainter, QPdfWriter
+
+        writer = QPdfWriter(str(output_path))
+        writer.setPageSize(QPageSize(QPageSize.PageSizeId.A4))
+        writer.setPageOrientation(QPageLayout.Orientation.Landscape)
+        writer.setPageMargins(QMarginsF(10, 10, 10, 10), QPageLayout.Unit.Millimeter)
+        writer.setResolution(PDF_RESOLUTION)
+        writer.setTitle(options.title or output_path.stem)
+
+        width, height = writer.width(), writer.height()
+        cell_width = width // max(1, options.columns)
+        header_height = cell_width // 4
+        rows_per_page = max(1, (height - header_height) // self._get_cell_height(cell_width))
+        pages = options.get_pages(len(entries), rows_per_page)
+
+        painter = QPainter(writer)
+        try:
+            for page_number, page in enumerate(pages, 1):
+                if page_number > 1:
+                    writer.newPage()
+                title = options.title or output_path.stem
+                if len(pages) > 1:
+                    title += f"  ({page_number}/{len(pages)})"
+                self._draw_header(painter, title, width, header_height)
+                for index, column, row in page:
+                    y = header_height + row * self._get_cell_height(cell_width)
+                    self._draw_cell(painter, entries[index], column * cell_width, y, cell_width)
+        finally:
+            painter.end()
+
+    def _render_png(
+        self, entries: List[ContactSheetEntry], output_path: Path, options: ContactSheetOptions
+    ) -> None:
+        """Draw every row of the sheet on one image"""
+        from PySide6.QtGui import QColor, QImage, QPainter
+
+        columns = max(1, min(options.columns, len(entries)))
+        cell_width = max(64, options.cell_size)
+        header_height = cell_width // 4
+        rows = math.ceil(len(entries) / columns)
+        image = QImage(
+            columns * cell_width,
+            header_height + rows * self._get_cell_height(cell_width),
+            QImage.Format.Format_RGB32,
+        )
+        image.fill(QColor(BACKGROUND_COLOR))
+
+        painter = QPainter(image)
+        try:
+            self._draw_header(
+                painter, options.title or output_path.stem, image.width(), header_height
+            )
+            for index, column, row in options.get_pages(len(entries))[0]:
+                y = header_height + row * self._get_cell_height(cell_width)
+                self._draw_cell(painter, entries[index], column * cell_width, y, cell_width)
+        finally:
+            painter.end()
+        if not image.save(str(output_path), "PNG"):
+            raise RuntimeError(f"Could not write {output_path}")
+
+    def _get_cell_height(self, cell_width: int) -> int:
+        """Get the height of a cell: its square thumbnail and two caption lines"""
+        return cell_width + cell_width // 3
+
+    def _draw_header(self, painter: Any, title: str, width: int, height: int) -> None:
+        """Draw the sheet title"""
+        from PySide6.QtCore import QRect, Qt
+        from PySide6.QtGui import QColor, QFont
+
+        font = QFont()
+        font.setPixelSize(max(12, height // 2))
+        font.setBold(True)
+        painter.setFont(font)
+        painter.setPen(QColor(TEXT_COLOR))
+        painter.drawText(
+            QRect(0, 0, width, height),
+            int(Qt.AlignmentFlag.AlignLeft | Qt.AlignmentFlag.AlignVCenter),
+            title,
+        )
+
+    def _draw_cell(
+        self, painter: Any, entry: ContactSheetEntry, x: int, y: int, width: int
+    ) -> None:
+        """Draw one asset: thumbnail, name, and version with a status badge"""
+        from PySide6.QtCore import QRect, Qt
+        from PySide6.QtGui import QColor, QFont, QImage
+
+        padding = max(4, width // 32)
+        size = width - 2 * padding
+        frame = QRect(x + padding, y + padding, size, size)
+        painter.setPen(QColor(FRAME_COLOR))
+        painter.drawRect(frame)
+
+        image = QImage(str(entry.thumbnail)) if entry.thumbnail is not None else QImage()
+        font = QFont()
+        font.setPixelSize(max(10, width // 14))
+        if not image.isNull():
+            image = image.scaled(
+                size,
+                size,
+                Qt.AspectRatioMode.KeepAspectRatio,
+                Qt.TransformationMode.SmoothTransformation,
+            )
+            painter.drawImage(
+                frame.x() + (size - image.width()) // 2,
+                frame.y() + (size - image.height()) // 2,
+                image,
+            )
+        else:
+            painter.setFont(font)
+            painter.drawText(frame, int(Qt.AlignmentFlag.AlignCenter), "No thumbnail")
+
+        line_height = width // 6
+        name_rect = QRect(x + padding, frame.bottom() + padding, size, line_height)
+        font.setBold(True)
+        painter.setFont(font)
+        painter.setPen(QColor(TEXT_COLOR))
+        name = painter.fontMetrics().elidedText(entry.name, Qt.TextElideMode.ElideRight, size)
+        painter.drawText(name_rect, int(Qt.AlignmentFlag.AlignLeft), name)
+
+        font.setBold(False)
+        painter.setFont(font)
+        caption_y = name_rect.bottom()
+        version_width = painter.fontMetrics().horizontalAdvance(f"{entry.version_label}  ")
+        painter.drawText(
+            QRect(x + padding, caption_y, version_width, line_height),
+            int(Qt.AlignmentFlag.AlignLeft),
+            entry.version_label,
+        )
+        badge_width = painter.fontMetrics().horizontalAdvance(f" {entry.status_label} ")
+        badge = QRect(
+            x + padding + version_width,
+            caption_y,
+            min(badge_width, size - version_width),
+            painter.fontMetrics().height(),
+        )
+        painter.fillRect(badge, QColor(entry.status_color))
+        painter.setPen(QColor("#ffffff"))
+        painter.drawText(badge, int(Qt.AlignmentFlag.AlignCenter), entry.status_label)
+
+
+# Singleton instance factory
+_contact_sheet_service_instance = None
+
+
+def get_contact_sheet_service() -> ContactSheetService:
+    """
+    Get singleton instance of ContactSheetService.
+
+    Returns:
+        ContactSheetService: Singleton service instance
+    """
+    global _contact_sheet_service_instance
+    if _contact_sheet_service_instance is None:
+        _contact_sheet_service_instance = ContactSheetService()
+    return _contact_sheet_service_instance
@@ -0,0 +1,191 @@
+# -*- coding: utf-8 -*-
+"""
+Dependency Graph Service Implementation
+Scans a library and its project for which files use which, without opening Maya
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Every scene and descriptor is read as a file, so the graph covers assets nobody has
+open. What each file type contributes::
+
+    town.assembly    -> placed child assets (assembly)
+    street.ma        -> file -r references (reference), file node textures and
+                        Alembic / GPU caches (texture, cache)
+    shot010.mb       -> library assets whose paths appear in the binary (reference)
+    paint.material   -> textures used by its hidden shading network (texture)
+
+References to version snapshots and LOD files count as uses of their asset.
+"""
+
+import json
+import logging
+import re
+from pathlib import Path
+from typing import Dict, Iterable, List, Optional, Set
+
+from ..core.models.dependency_graph import (
+    EDGE_ASSEMBLY,
+    EDGE_CACHE,
+    EDGE_REFERENCE,
+    EDGE_TEXTURE,
+    NODE_ASSET,
+    NODE_FILE,
+    NODE_SCENE,
+    DependencyEdge,
+    DependencyGraph,
+)
+from .assembly_service_impl import ASSEMBLY_EXTENSION, get_assembly_service
+from .duplicate_service_impl import ASSET_EXTENSIONS, SCENE_EXTENSIONS
+from .material_service_impl import MATERIAL_EXTENSION
+from .maya_ascii_references import find_references, resolve_reference_path
+from .reference_update_service_impl import get_reference_update_service
+
+GRAPH_EXTENSIONS = ASSET_EXTENSIONS | {ASSEMBLY_EXTENSION, MATERIAL_EXTENSION}
+CACHE_EXTENSIONS = {".abc", ".gpu"}
+
+# File node, aiImage, PxrTexture, AlembicNode, and gpuCache path attributes
+_MA_FILE_ATTRIBUTE = re.compile(
+    r'setAttr\s+"\.(?:ftn|fileTextureName|fn|filename|abc_File|cfn|cacheFileName)"'
+    r'\s+-type\s+"string"\s+"((?:[^"\\]|\\.)*)"'
+)
+
+
+class DependencyGraphService:
+    """
+    Dependency Graph Service - Single Responsibility for project-wide usage scans
+    Files are parsed, never opened in Maya, so a scan never touches the artist's scene
+    """
+
+    def __init__(self, assembly_service=None, reference_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._assembly_service = assembly_service or get_assembly_service()
+        self._reference_service = reference_service or get_reference_update_service()
+
+    def build(self, library_root: Path, search_roots: Iterable[Path] = ()) -> DependencyGraph:
+        """
+        Scan a library and the project folders using it
+
+        Args:
+            library_root: Library whose assets are graph nodes
+            search_roots: Further folders (e.g. the Maya project) whose scenes use assets
+
+        Returns:
+            Graph of every use found; nodes are resolved absolute paths
+        """
+        library_root = Path(library_root).resolve()
+        files: Dict[Path, str] = {}
+        for path in self._iter_files(library_root, GRAPH_EXTENSIONS):
+            files[path.resolve()] = NODE_ASSET
+        for root in search_roots:
+            for path in self._iter_files(Path(root), SCENE_EXTENSIONS):
+                files.setdefault(path.resolve(), NODE_SCENE)
+
+        assets = [path for path, kind in files.items() if kind == NODE_ASSET]
+        edges: List[DependencyEdge] = []
+        for path in sorted(files):
+            try:
+                edges.extend(self.scan_file(path, library_root, assets))
+            except (OSError, UnicodeDecodeError) as e:
+                self.logger.warning(f"Skipping unreadable file {path.name}: {e}")
+
+        nodes = dict(files)
+        for edge in edges:
+            nodes.setdefault(edge.target, NODE_FILE)
+        graph = DependencyGraph(tuple(dict.fromkeys(edges)), nodes)
+        print(f"[INFO] Dependency graph: {len(nodes)} file(s), {len(graph.edges)} use(s)")
+        return graph
+
+    def scan_file(
+        self, path: Path, library_root: Path, assets: Optional[List[Path]] = None
+    ) -> List[DependencyEdge]:
+        """
+        Get the uses one file makes of others
+
+        Args:
+            path: Scene or descriptor to read
+            library_root: Library assembly children are relative to
+            assets: Library assets looked for in Maya binary scenes
+
+        Returns:
+            Edges from the file to what it uses
+        """
+        path = Path(path)
+        suffix = path.suffix.lower()
+        if suffix == ".ma":
+            return self._scan_maya_ascii(path, path.read_text(encoding="utf-8"))
+        if suffix == ".mb":
+            content = path.read_bytes()
+            return [
+                DependencyEdge(path, asset, EDGE_REFERENCE)
+                for asset in assets or []
+                if asset != path and asset.as_posix().encode("utf-8") in content
+            ]
+        if suffix == ASSEMBLY_EXTENSION:
+            layout = self._assembly_service.load_assembly(path)
+            children = layout.children if layout else ()
+            targets = [self._resolve_asset(child.resolve(library_root)) for child in children]
+            return [DependencyEdge(path, target, EDGE_ASSEMBLY) for target in targets]
+        if suffix == MATERIAL_EXTENSION:
+            with open(path, "r", encoding="utf-8") as f:
+                network = path.parent / json.load(f).get("network", "")
+            if not network.is_file():
+                return []
+            # The network is part of the material - its textures are the material's
+            text = network.read_text(encoding="utf-8")
+            edges = self._scan_maya_ascii(network, text, references=False)
+            return [DependencyEdge(path, edge.target, edge.kind) for edge in edges]
+        return []
+
+    def _scan_maya_ascii(
+        self, path: Path, text: str, references: bool = True
+    ) -> List[DependencyEdge]:
+        """Get a Maya ASCII file's references and the texture and cache files it uses"""
+        edges = []
+        if references:
+            for reference in find_references(text):
+                target = resolve_reference_path(path, reference.path)
+                if target is not None:
+                    edges.append(DependencyEdge(path, self._resolve_asset(target), EDGE_REFERENCE))
+
+        for raw_path in _MA_FILE_ATTRIBUTE.findall(text):
+            target = resolve_reference_path(path, raw_path)
+            if target is not None:
+                kind = EDGE_CACHE if target.suffix.lower() in CACHE_EXTENSIONS else EDGE_TEXTURE
+                edges.append(DependencyEdge(path, target, kind))
+        return edges
+
+    def _resolve_asset(self, path: Path) -> Path:
+        """Get the asset a version snapshot or LOD file belongs to"""
+        asset_file, _version = self._reference_service.resolve(Path(path))
+        return Path(asset_file).resolve()
+
+    def _iter_files(self, search_root: Path, extensions: Set[str]) -> List[Path]:
+        """Get files with the extensions below a folder, skipping hidden folders"""
+        if not search_root.is_dir():
+            return []
+        found = []
+        for path in sorted(search_root.rglob("*")):
+            relative = path.relative_to(search_root)
+            if any(part.startswith(".") for part in relative.parts[:-1]):
+                continue
+            if path.is_file() and path.suffix.lower() in extensions:
+                found.append(path)
+        return found
+
+
+# Singleton instance factory
+_dependency_graph_service_instance = None
+
+
+def get_dependency_graph_service() -> DependencyGraphService:
+    """
+    Get singleton instance of DependencyGraphService.
+
+    Returns:
+        DependencyGraphService: Singleton service instance
+    """
+    global _dependency_graph_service_instance
+    if _dependency_graph_service_instance is None:
+        _dependency_graph_service_instance = DependencyGraphService()
+    return _dependency_graph_service_instance
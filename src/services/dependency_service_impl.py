@@ -0,0 +1,345 @@
+# -*- coding: utf-8 -*-
+"""
+Dependency Service Implementation
+Collects external files an exported asset depends on so it stays portable
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Layout for an asset at ``assets/scenes/hero.ma``::
+
+    assets/scenes/hero.ma                                   <- exported scene
+    assets/scenes/.dependencies/hero/textures/wood.png      <- collected files
+    assets/scenes/.dependencies/hero/caches/sim.abc
+
+Paths inside the exported scene are written relative to the asset folder and
+resolved again when the asset is imported through the Asset Manager. File
+references are flattened into the export, so textures and caches used by
+referenced nodes are collected like any other.
+"""
+
+import logging
+import os
+import re
+import shutil
+import zipfile
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Dict, List, Optional, Tuple
+
+from .path_mapping_service_impl import get_path_mapping_service
+
+DEPENDENCIES_DIR_NAME = ".dependencies"
+
+# Node type -> (file path attribute, dependency category)
+DEPENDENCY_ATTRIBUTES: Dict[str, Tuple[str, str]] = {
+    "file": ("fileTextureName", "textures"),
+    "aiImage": ("filename", "textures"),
+    "PxrTexture": ("filename", "textures"),
+    "AlembicNode": ("abc_File", "caches"),
+    "gpuCache": ("cacheFileName", "caches"),
+    "audio": ("filename", "audio"),
+    "imagePlane": ("imageName", "images"),
+}
+
+# Tile / frame tokens expanded to every matching file on disk
+_FILE_TOKEN_PATTERN = re.compile(r"<udim>|<uvtile>|<u>|<v>|<f>|#+", re.IGNORECASE)
+
+
+@dataclass
+class AssetDependency:
+    """Single external file referenced by a Maya node"""
+
+    node: str
+    attribute: str
+    path: str
+    category: str
+
+
+@dataclass
+class CollectionResult:
+    """Outcome of collecting dependencies for one asset"""
+
+    copied_files: List[Path]
+    missing: List[AssetDependency]
+    repathed: Dict[str, str]
+
+
+def expand_file_pattern(path: str) -> List[Path]:
+    """
+    Expand UDIM / frame tokens in a file path to the files present on disk
+
+    Args:
+        path: File path that may contain tokens like <UDIM> or ####, or $ASSET_ROOT
+
+    Returns:
+        Existing files the path refers to (empty if none found)
+    """
+    file_path = Path(os.path.expandvars(path))
+    if not _FILE_TOKEN_PATTERN.search(file_path.name):
+        return [file_path] if file_path.is_file() else []
+
+    if not file_path.parent.is_dir():
+        return []
+    glob_pattern = _FILE_TOKEN_PATTERN.sub("*", file_path.name)
+    return sorted(p for p in file_path.parent.glob(glob_pattern) if p.is_file())
+
+
+class DependencyService:
+    """
+    Dependency Service - Single Responsibility for external file collection
+    Scanning and repathing need Maya; copying and packaging are pure file operations
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def get_dependency_directory(self, asset_file: Path) -> Path:
+        """Get the folder collected dependencies of an asset are copied into"""
+        asset_file = Path(asset_file)
+        return asset_file.parent / DEPENDENCIES_DIR_NAME / asset_file.stem
+
+    def plan_copies(
+        self, dependencies: List[AssetDependency], asset_file: Path
+    ) -> Dict[Path, Path]:
+        """
+        Decide where each dependency file goes inside the asset folder
+
+        Files with the same name from different folders get a numeric suffix
+        so they never overwrite each other.
+
+        Args:
+            dependencies: Dependencies found in the scene
+            asset_file: Asset file being exported
+
+        Returns:
+            Mapping of source file -> target file
+        """
+        dependency_dir = self.get_dependency_directory(asset_file)
+        plan: Dict[Path, Path] = {}
+        taken = set()
+
+        for dependency in dependencies:
+            for source in expand_file_pattern(dependency.path):
+                source = source.resolve()
+                if source in plan:
+                    continue
+
+                target = dependency_dir / dependency.category / source.name
+                counter = 1
+                while target in taken:
+                    target = target.with_name(f"{source.stem}_{counter}{source.suffix}")
+                    counter += 1
+
+                plan[source] = target
+                taken.add(target)
+
+        return plan
+
+    def copy_dependencies(self, plan: Dict[Path, Path]) -> List[Path]:
+        """Copy planned dependency files into the asset folder"""
+        copied = []
+        for source, target in plan.items():
+            try:
+                target.parent.mkdir(parents=True, exist_ok=True)
+                if source != target.resolve():
+                    shutil.copy2(source, target)
+                copied.append(target)
+            except Exception as e:
+                self.logger.error(f"Failed to copy dependency {source}: {e}")
+                print(f"[ERROR] Failed to copy dependency {source.name}: {e}")
+        return copied
+
+    def get_relative_path(
+        self, dependency_path: str, plan: Dict[Path, Path], asset_file: Path
+    ) -> Optional[str]:
+        """
+        Get the asset-relative path a dependency should point at after collection
+
+        Token paths (UDIM, frame numbers) keep their tokens so Maya still
+        expands them.
+
+        Returns:
+            Relative path string, None if the dependency was not collected
+        """
+        file_path = Path(dependency_path)
+        for source in expand_file_pattern(dependency_path):
+            target = plan.get(source.resolve())
+            if target is None:
+                continue
+            if _FILE_TOKEN_PATTERN.search(file_path.name):
+                target = target.with_name(file_path.name)
+            return target.relative_to(Path(asset_file).parent).as_posix()
+        return None
+
+    def create_package(
+        self, asset_file: Path, files: List[Path], package_path: Optional[Path] = None
+    ) -> Optional[Path]:
+        """
+        Zip an asset together with its collected dependencies
+
+        Args:
+            asset_file: Exported asset file
+            files: Dependency files inside the asset folder
+            package_path: Zip file to write (defaults to <asset>.zip next to the asset)
+
+        Returns:
+            Written package path, None if packaging failed
+        """
+        asset_file = Path(asset_file)
+        package_path = Path(package_path) if package_path else asset_file.with_suffix(".zip")
+
+        try:
+            package_path.parent.mkdir(parents=True, exist_ok=True)
+            with zipfile.ZipFile(package_path, "w", zipfile.ZIP_DEFLATED) as package:
+                package.write(asset_file, asset_file.name)
+                for file_path in files:
+                    package.write(file_path, file_path.relative_to(asset_file.parent).as_posix())
+
+            print(f"[OK] Packaged {asset_file.name} with {len(files)} dependencies")
+            return package_path
+
+        except Exception as e:
+            self.logger.error(f"Failed to package {asset_file}: {e}")
+            print(f"[ERROR] Failed to create package: {e}")
+            return None
+
+    # Maya-side operations -------------------------------------------------------------
+
+    def scan_dependencies(self, nodes: Optional[List[str]] = None) -> List[AssetDependency]:
+        """
+        Find external files used by the scene (or by the given nodes' history)
+
+        Args:
+            nodes: Limit the scan to these nodes and their shading networks
+
+        Returns:
+            List of dependencies with their current file paths
+        """
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return []
+
+        dependencies: List[AssetDependency] = []
+        candidates = self._get_candidate_nodes(cmds, nodes)
+
+        for node_type, (attribute, category) in DEPENDENCY_ATTRIBUTES.items():
+            for node in cmds.ls(candidates, type=node_type) if candidates else []:
+                path = cmds.getAttr(f"{node}.{attribute}")
+                if path:
+                    dependencies.append(AssetDependency(node, attribute, path, category))
+
+        return dependencies
+
+    def collect(self, asset_file: Path, dependencies: List[AssetDependency]) -> CollectionResult:
+        """
+        Copy dependencies into the asset folder and repath the live scene
+
+        Call restore_paths() with the returned mapping after exporting so the
+        artist's working scene keeps its original paths.
+
+        Args:
+            asset_file: Asset file about to be exported
+            dependencies: Dependencies from scan_dependencies()
+
+        Returns:
+            Copied files, dependencies not found on disk, and node -> original path
+        """
+        plan = self.plan_copies(dependencies, asset_file)
+        copied = self.copy_dependencies(plan)
+        missing: List[AssetDependency] = []
+        repathed: Dict[str, str] = {}
+
+        for dependency in dependencies:
+            new_path = self.get_relative_path(dependency.path, plan, asset_file)
+            if new_path is None:
+                missing.append(dependency)
+                print(f"[WARNING] Dependency not found on disk: {dependency.path}")
+                continue
+            if self._set_dependency_path(dependency, new_path):
+                repathed[self._dependency_key(dependency)] = dependency.path
+
+        return CollectionResult(copied, missing, repathed)
+
+    def restore_paths(self, dependencies: List[AssetDependency], repathed: Dict[str, str]):
+        """Point repathed nodes back at their original files"""
+        for dependency in dependencies:
+            original = repathed.get(self._dependency_key(dependency))
+            if original:
+                self._set_dependency_path(dependency, original)
+
+    def resolve_relative_paths(self, asset_file: Path, nodes: List[str]) -> int:
+        """
+        Make asset-relative dependency paths absolute after importing an asset
+
+        Args:
+            asset_file: Imported asset file
+            nodes: Nodes created by the import
+
+        Returns:
+            Number of paths resolved
+        """
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return 0
+
+        asset_dir = Path(asset_file).parent
+        resolved = 0
+        for node_type, (attribute, _category) in DEPENDENCY_ATTRIBUTES.items():
+            for node in cmds.ls(nodes, type=node_type) if nodes else []:
+                path = cmds.getAttr(f"{node}.{attribute}") or ""
+                if not path or Path(path).is_absolute():
+                    continue
+                candidate = asset_dir / path
+                if expand_file_pattern(str(candidate)):
+                    # Under the library's root token, so the scene opens on any platform
+                    portable = get_path_mapping_service().to_portable(candidate)
+                    cmds.setAttr(f"{node}.{attribute}", portable, type="string")
+                    resolved += 1
+        return resolved
+
+    def _get_candidate_nodes(self, cmds, nodes: Optional[List[str]]) -> List[str]:
+        """Get nodes to scan - the whole scene or the selection's upstream network"""
+        if nodes is None:
+            return cmds.ls() or []
+        if not nodes:
+            return []
+
+        shapes = cmds.listRelatives(nodes, allDescendents=True, fullPath=True) or []
+        shading_groups = cmds.listConnections(shapes + nodes, type="shadingEngine") or []
+        history = cmds.listHistory(nodes + list(set(shading_groups))) or []
+        return list(set(history + nodes + shapes))
+
+    def _set_dependency_path(self, dependency: AssetDependency, path: str) -> bool:
+        """Point a node at a new file path"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            cmds.setAttr(f"{dependency.node}.{dependency.attribute}", path, type="string")
+            return True
+        except Exception as e:
+            self.logger.warning(f"Could not repath {dependency.node}: {e}")
+            return False
+
+    @staticmethod
+    def _dependency_key(dependency: AssetDependency) -> str:
+        return f"{dependency.node}.{dependency.attribute}"
+
+
+# Singleton instance factory
+_dependency_service_instance = None
+
+
+def get_dependency_service() -> DependencyService:
+    """
+    Get singleton instance of DependencyService.
+
+    Returns:
+        DependencyService: Singleton service instance
+    """
+    global _dependency_service_instance
+    if _dependency_service_instance is None:
+        _dependency_service_instance = DependencyService()
+    return _dependency_service_instance
@@ -0,0 +1,403 @@
+# -*- coding: utf-8 -*-
+"""
+Duplicate Service Implementation
+Find library assets with the same content and repath scenes onto one canonical asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Every asset is hashed byte for byte. Maya ASCII and OBJ files additionally get a
+geometry fingerprint built from their vertex, edge, face, and UV data, so copies
+saved under another name, with other node names, or with a few edited vertices are
+found as well::
+
+    crate.ma          <- canonical
+    crate_old.ma      Identical      (same bytes)
+    wooden_box.ma     96% similar    (same mesh, renamed nodes, one vertex moved)
+
+Scenes referencing a duplicate are repathed to the canonical asset: Maya ASCII
+scenes are rewritten on disk, the open scene through its reference nodes.
+"""
+
+import hashlib
+import logging
+import re
+from collections import Counter
+from pathlib import Path
+from typing import Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.duplicate_group import DuplicateGroup
+from .maya_ascii_references import find_references, resolve_reference_path
+from .path_mapping_service_impl import get_path_mapping_service
+
+# Asset formats compared (images, MEL, and JSON assets are not geometry)
+ASSET_EXTENSIONS = {".ma", ".mb", ".obj", ".fbx", ".abc", ".usd", ".usda", ".usdc", ".usdz"}
+SCENE_EXTENSIONS = {".ma", ".mb"}
+
+# Assets at least this close to each other are reported as duplicates
+DEFAULT_SIMILARITY_THRESHOLD = 0.9
+
+# Mesh / curve / surface data statements of Maya ASCII files
+_MA_GEOMETRY_STATEMENT = re.compile(
+    r'setAttr\b[^;"]*"\.(vt|ed|fc|pt|cc|cached|uvst\[\d+\]\.uvsp)[\[" ][^;]*;'
+)
+_MA_TYPE_FLAG = re.compile(r'-type\s+"[^"]*"')
+_OBJ_GEOMETRY_RECORDS = ("v", "vt", "vn", "f", "l")
+
+_HASH_CHUNK_SIZE = 1024 * 1024
+
+
+class DuplicateService:
+    """
+    Duplicate Service - Single Responsibility for duplicate detection and redirects
+    Works on files only; the open Maya scene is repathed by the caller
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Fingerprints -----------------------------------------------------------------------
+
+    def hash_file(self, file_path: Path) -> str:
+        """Get the SHA-256 of a file's bytes"""
+        digest = hashlib.sha256()
+        with open(file_path, "rb") as f:
+            for chunk in iter(lambda: f.read(_HASH_CHUNK_SIZE), b""):
+                digest.update(chunk)
+        return digest.hexdigest()
+
+    def get_geometry_signature(self, file_path: Path) -> Optional[Counter]:
+        """
+        Get the geometry fingerprint of a Maya ASCII or OBJ file
+
+        The fingerprint counts data records (vertex triples, face lists, ...) and
+        ignores node names, comments, and header info, so it survives re-saving.
+
+        Returns:
+            Record counts, None for formats without a readable geometry fingerprint
+        """
+        file_path = Path(file_path)
+        extension = file_path.suffix.lower()
+        if extension not in (".ma", ".obj"):
+            return None
+        try:
+            text = file_path.read_text(encoding="utf-8", errors="replace")
+        except OSError as e:
+            self.logger.warning(f"Could not read {file_path.name}: {e}")
+            return None
+
+        records: Counter = Counter()
+        if extension == ".ma":
+            for match in _MA_GEOMETRY_STATEMENT.finditer(text):
+                # Attribute indices ([0:7]) only tell where Maya split long arrays
+                kind = match.group(1).split("[")[0]
+                data = _MA_TYPE_FLAG.sub(" ", text[match.end(1) : match.end()])
+                values = re.sub(r'^[^"]*"', "", data).rstrip(";").split()
+                for index in range(0, len(values), 3):
+                    records[(kind,) + tuple(values[index : index + 3])] += 1
+        else:
+            for line in text.splitlines():
+                values = line.split()
+                if values and values[0] in _OBJ_GEOMETRY_RECORDS:
+                    records[tuple(values)] += 1
+        return records or None
+
+    def get_similarity(self, first: Counter, second: Counter) -> float:
+        """Get the weighted overlap of two geometry fingerprints (1.0 = same geometry)"""
+        shared = sum((first & second).values())
+        total = sum((first | second).values())
+        return shared / total if total else 0.0
+
+    # Detection --------------------------------------------------------------------------
+
+    def find_assets(self, library_root: Path) -> List[Path]:
+        """Get library asset files, skipping hidden history and thumbnail folders"""
+        library_root = Path(library_root)
+        assets_dir = library_root / "assets"
+        search_root = assets_dir if assets_dir.is_dir() else library_root
+        return [
+            path
+            for path in self._iter_files(search_root, ASSET_EXTENSIONS)
+            if not path.name.startswith(".")
+        ]
+
+    def find_duplicates(
+        self,
+        library_root: Path,
+        threshold: float = DEFAULT_SIMILARITY_THRESHOLD,
+    ) -> List[DuplicateGroup]:
+        """
+        Group library assets holding the same or nearly the same content
+
+        Args:
+            library_root: Library (project) root
+            threshold: Minimum geometry similarity (0.0-1.0) of near duplicates
+
+        Returns:
+            Duplicate groups; the oldest file of each group is its canonical asset
+        """
+        assets = self.find_assets(library_root)
+        hashes: Dict[Path, str] = {}
+        signatures: Dict[Path, Counter] = {}
+        for path in assets:
+            try:
+                hashes[path] = self.hash_file(path)
+            except OSError as e:
+                self.logger.warning(f"Skipping unreadable asset {path.name}: {e}")
+                continue
+            signature = self.get_geometry_signature(path)
+            if signature is not None:
+                signatures[path] = signature
+
+        # Union-find over identical bytes and similar geometry
+        parent = {path: path for path in hashes}
+
+        def find(path: Path) -> Path:
+            while parent[path] != path:
+                parent[path] = parent[parent[path]]
+                path = parent[path]
+            return path
+
+        by_hash: Dict[str, Path] = {}
+        for path, digest in hashes.items():
+            if digest in by_hash:
+                parent[find(path)] = find(by_hash[digest])
+            else:
+                by_hash[digest] = path
+
+        fingerprinted = sorted(signatures)
+        for index, first in enumerate(fingerprinted):
+            for second in fingerprinted[index + 1 :]:
+                if find(first) == find(second):
+                    continue
+                if self.get_similarity(signatures[first], signatures[second]) >= threshold:
+                    parent[find(second)] = find(first)
+
+        members: Dict[Path, List[Path]] = {}
+        for path in hashes:
+            members.setdefault(find(path), []).append(path)
+
+        groups = []
+        for paths in members.values():
+            if len(paths) < 2:
+                continue
+            paths.sort(key=lambda p: (p.stat().st_mtime, str(p)))
+            canonical, duplicates = paths[0], tuple(paths[1:])
+            similarity = {}
+            for path in duplicates:
+                if hashes[path] == hashes[canonical]:
+                    similarity[path] = 1.0
+                elif path in signatures and canonical in signatures:
+                    similarity[path] = self.get_similarity(signatures[path], signatures[canonical])
+                else:
+                    similarity[path] = threshold  # Joined through another similar member
+            groups.append(DuplicateGroup(canonical, duplicates, similarity))
+
+        groups.sort(key=lambda group: str(group.canonical))
+        print(f"[INFO] Found {len(groups)} duplicate group(s) in {len(hashes)} asset(s)")
+        return groups
+
+    # Redirects --------------------------------------------------------------------------
+
+    def find_referencing_scenes(
+        self, search_roots: Iterable[Path], asset_file: Path
+    ) -> Tuple[List[Path], List[Path]]:
+        """
+        Find scenes that reference an asset
+
+        Args:
+            search_roots: Folders searched for .ma / .mb scenes
+            asset_file: Referenced asset
+
+        Returns:
+            (Maya ASCII scenes that can be repathed, Maya binary scenes naming the
+            asset, which need Maya to repath)
+        """
+        asset_file = Path(asset_file)
+        asset_bytes = self._get_stored_spellings(asset_file)
+        ascii_scenes, binary_scenes = [], []
+        for scene in self._iter_scenes(search_roots):
+            if scene.resolve() == asset_file.resolve():
+                continue
+            try:
+                if scene.suffix.lower() == ".ma":
+                    text = scene.read_text(encoding="utf-8")
+                    if self._get_reference_spans(scene, text, asset_file):
+                        ascii_scenes.append(scene)
+                else:
+                    content = scene.read_bytes()
+                    if any(spelling in content for spelling in asset_bytes):
+                        binary_scenes.append(scene)
+            except (OSError, UnicodeDecodeError) as e:
+                self.logger.warning(f"Skipping unreadable scene {scene.name}: {e}")
+        return ascii_scenes, binary_scenes
+
+    def redirect_scene(self, scene_file: Path, duplicate: Path, canonical: Path) -> int:
+        """
+        Repath a Maya ASCII scene's references of a duplicate to the canonical asset
+
+        The reference nodes, namespaces, and edits are kept, so the scene loads the
+        canonical asset exactly where it loaded the duplicate.
+
+        Returns:
+            Number of reference statements repathed
+        """
+        scene_file = Path(scene_file)
+        text = scene_file.read_text(encoding="utf-8")
+        spans = self._get_reference_spans(scene_file, text, Path(duplicate))
+        if not spans:
+            return 0
+
+        canonical_path = get_path_mapping_service().to_portable(Path(canonical))
+        # Replace from the end so earlier offsets stay valid
+        for start, end, copy_number in reversed(spans):
+            text = text[:start] + canonical_path + copy_number + text[end:]
+        scene_file.write_text(text, encoding="utf-8")
+        print(f"[OK] Repathed {len(spans)} reference(s) in {scene_file.name}")
+        return len(spans)
+
+    def redirect(
+        self, search_roots: Iterable[Path], duplicate: Path, canonical: Path
+    ) -> Tuple[List[Path], List[Path]]:
+        """
+        Repath every scene referencing a duplicate to the canonical asset
+
+        Returns:
+            (repathed scenes, scenes left unchanged - binary or not writable)
+        """
+        ascii_scenes, skipped = self.find_referencing_scenes(search_roots, duplicate)
+        repathed = []
+        for scene in ascii_scenes:
+            try:
+                if self.redirect_scene(scene, duplicate, canonical):
+                    repathed.append(scene)
+            except OSError as e:
+                print(f"[ERROR] Could not repath {scene.name}: {e}")
+                skipped.append(scene)
+        return repathed, skipped
+
+    def redirect_paths(
+        self, search_roots: Iterable[Path], redirects: Dict[Path, Path]
+    ) -> Tuple[List[Path], List[Path]]:
+        """
+        Repath every scene referencing any of several files in one pass over the scenes
+
+        Args:
+            search_roots: Folders searched for .ma / .mb scenes
+            redirects: Old file -> file references should load instead
+
+        Returns:
+            (repathed scenes, Maya binary or unwritable scenes naming an old file)
+        """
+        targets = {Path(old).resolve(): Path(new) for old, new in redirects.items()}
+        old_bytes = [spelling for old in redirects for spelling in self._get_stored_spellings(old)]
+        repathed, skipped = [], []
+        for scene in self._iter_scenes(search_roots):
+            try:
+                if scene.suffix.lower() != ".ma":
+                    content = scene.read_bytes()
+                    if any(old in content for old in old_bytes):
+                        skipped.append(scene)
+                    continue
+                text = scene.read_text(encoding="utf-8")
+                spans = self._get_redirect_spans(scene, text, targets)
+                if not spans:
+                    continue
+                for start, end, copy_number, target in reversed(spans):
+                    new_path = get_path_mapping_service().to_portable(target)
+                    text = text[:start] + new_path + copy_number + text[end:]
+                scene.write_text(text, encoding="utf-8")
+                print(f"[OK] Repathed {len(spans)} reference(s) in {scene.name}")
+                repathed.append(scene)
+            except (OSError, UnicodeDecodeError) as e:
+                print(f"[ERROR] Could not repath {scene.name}: {e}")
+                skipped.append(scene)
+        return repathed, skipped
+
+    def merge_metadata(self, library_root: Path, duplicate: Path, canonical: Path) -> None:
+        """Move a duplicate's tags and collection memberships onto the canonical asset"""
+        from .metadata_database_impl import get_metadata_database
+
+        database = get_metadata_database(library_root)
+        duplicate_data = database.get_asset_metadata(duplicate)
+        if duplicate_data and duplicate_data.get("tags"):
+            canonical_data = database.get_asset_metadata(canonical) or {}
+            tags = list(canonical_data.get("tags") or [])
+            tags += [tag for tag in duplicate_data["tags"] if tag not in tags]
+            database.save_asset_metadata(canonical, dict(canonical_data, tags=tags))
+
+        duplicate_key = database.get_asset_key(duplicate)
+        canonical_key = database.get_asset_key(canonical)
+        for name, data in database.get_collections().items():
+            members = data.get("assets", [])
+            if duplicate_key not in members:
+                continue
+            merged = []
+            for key in members:
+                key = canonical_key if key == duplicate_key else key
+                if key not in merged:
+                    merged.append(key)
+            database.save_collection(name, dict(data, assets=merged))
+        database.remove_asset(duplicate)
+        print(f"[OK] Merged metadata of {Path(duplicate).name} into {Path(canonical).name}")
+
+    def _get_reference_spans(
+        self, scene_file: Path, text: str, asset_file: Path
+    ) -> List[Tuple[int, int, str]]:
+        """Get (start, end, copy number) of reference path strings pointing at an asset"""
+        spans = self._get_redirect_spans(scene_file, text, {asset_file.resolve(): asset_file})
+        return [(start, end, copy_number) for start, end, copy_number, _target in spans]
+
+    def _get_redirect_spans(
+        self, scene_file: Path, text: str, targets: Dict[Path, Path]
+    ) -> List[Tuple[int, int, str, Path]]:
+        """Get (start, end, copy number, new file) of reference paths naming old files"""
+        spans = []
+        for reference in find_references(text):
+            target = targets.get(resolve_reference_path(scene_file, reference.path))
+            if target is not None:
+                spans.append((reference.start, reference.end, reference.copy_number, target))
+        return spans
+
+    def _get_stored_spellings(self, path: Path) -> List[bytes]:
+        """Get the ways a scene may store a path: absolute and, inside the library, portable"""
+        spellings = [Path(path).as_posix(), get_path_mapping_service().to_portable(Path(path))]
+        return [spelling.encode("utf-8") for spelling in dict.fromkeys(spellings)]
+
+    def _iter_scenes(self, search_roots: Iterable[Path]) -> List[Path]:
+        """Get the .ma / .mb scenes below the search roots, each once"""
+        scenes: Dict[Path, Path] = {}
+        for root in search_roots:
+            for scene in self._iter_files(Path(root), SCENE_EXTENSIONS):
+                scenes.setdefault(scene.resolve(), scene)
+        return sorted(scenes.values())
+
+    def _iter_files(self, search_root: Path, extensions: set) -> List[Path]:
+        """Get files with the extensions below a folder, skipping hidden folders"""
+        if not search_root.is_dir():
+            return []
+        found = []
+        for path in sorted(search_root.rglob("*")):
+            relative = path.relative_to(search_root)
+            if any(part.startswith(".") for part in relative.parts[:-1]):
+                continue
+            if path.is_file() and path.suffix.lower() in extensions:
+                found.append(path)
+        return found
+
+
+# Singleton instance factory
+_duplicate_service_instance = None
+
+
+def get_duplicate_service() -> DuplicateService:
+    """
+    Get singleton instance of DuplicateService.
+
+    Returns:
+        DuplicateService: Singleton service instance
+    """
+    global _duplicate_service_instance
+    if _duplicate_service_instance is None:
+        _duplicate_service_instance = DuplicateService()
+    return _duplicate_service_instance
@@ -0,0 +1,73 @@
+# -*- coding: utf-8 -*-
+"""
+Export Batch Script
+Runs inside mayapy to export one asset's nodes out of a scene snapshot
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Launched by PublishWorkerPool, one process per asset, so a batch publish exports
+several assets at once and a crash only fails the asset being exported. The job
+file lists what to export::
+
+    {"scene": "snapshot.mb", "output": "000_Chair.ma", "file_type": "mayaAscii",
+     "nodes": ["|chair_grp"]}
+
+Usage::
+
+    mayapy export_batch.py --job 000_Chair.json
+"""
+
+import argparse
+import json
+import sys
+from pathlib import Path
+from typing import Any, Dict, List
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line"""
+    parser = argparse.ArgumentParser(description="Export one Asset Manager publish")
+    parser.add_argument("--job", required=True, help="JSON job file written by the pool")
+    return parser.parse_args(argv)
+
+
+def _export(cmds: Any, job: Dict[str, Any]) -> None:
+    """Open the snapshot and export the job's nodes"""
+    cmds.file(job["scene"], open=True, force=True, ignoreVersion=True)
+    cmds.select(job["nodes"], replace=True, noExpand=True)
+    cmds.file(
+        job["output"],
+        force=True,
+        exportSelected=True,
+        preserveReferences=True,
+        type=job["file_type"],
+    )
+
+
+def main(argv: List[str]) -> int:
+    """Export one asset - returns process exit code"""
+    args = _parse_args(argv)
+    with open(args.job, "r", encoding="utf-8") as f:
+        job = json.load(f)
+
+    import maya.standalone  # type: ignore
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+
+        _export(cmds, job)
+        print(f"[OK] Exported {Path(job['output']).name}")
+        return 0
+
+    except Exception as e:
+        print(f"[ERROR] Export batch failed: {e}")
+        return 1
+
+    finally:
+        maya.standalone.uninitialize()
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
@@ -0,0 +1,106 @@
+# -*- coding: utf-8 -*-
+"""
+Farm Batch Script
+Runs inside mayapy on a farm node to carry out one heavy publish step of an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Submitted by RenderFarmService to Deadline or Tractor. Whatever happens, the step's
+result is written back to the library's job record so the asset stops showing as
+processing; the exit code tells the farm monitor too. Turntables run
+thumbnail_batch.py with the arguments after ``--``; USD conversions open the asset
+and export it with a geometry payload::
+
+    mayapy farm_batch.py --jobs-dir <library>/.assetmanager/farm --key 3f9c0a1b2d4e
+        --step turntable --input hero.ma -- --input hero.ma --still ... --turntable ...
+    mayapy farm_batch.py --jobs-dir ... --key ... --step usd --input hero.ma
+        --output hero.usd
+"""
+
+import argparse
+import subprocess
+import sys
+from pathlib import Path
+from typing import List
+
+_PLUGIN_DIR = str(Path(__file__).resolve().parents[2])
+THUMBNAIL_SCRIPT = Path(__file__).with_name("thumbnail_batch.py")
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line; arguments after -- go to the turntable render"""
+    if "--" in argv:
+        split = argv.index("--")
+        argv, step_arguments = argv[:split], argv[split + 1 :]
+    else:
+        step_arguments = []
+    parser = argparse.ArgumentParser(description="Run an Asset Manager farm step")
+    parser.add_argument("--jobs-dir", required=True, help="Library folder of job records")
+    parser.add_argument("--key", required=True, help="Job record to write the result to")
+    parser.add_argument("--step", required=True, choices=["turntable", "usd"])
+    parser.add_argument("--input", required=True, help="Library asset file")
+    parser.add_argument("--output", default="", help="USD file written by the usd step")
+    args = parser.parse_args(argv)
+    args.step_arguments = step_arguments
+    return args
+
+
+def _render_turntable(step_arguments: List[str]) -> str:
+    """Render in a mayapy process of its own - returns an error, "" on success"""
+    result = subprocess.run(
+        [sys.executable, str(THUMBNAIL_SCRIPT)] + step_arguments,
+        capture_output=True,
+        text=True,
+        check=False,
+    )
+    print(result.stdout + result.stderr)
+    if result.returncode == 0:
+        return ""
+    lines = (result.stdout + result.stderr).strip().splitlines()
+    return lines[-1] if lines else f"Turntable render exited with {result.returncode}"
+
+
+def _convert_to_usd(asset_file: Path, output: Path) -> str:
+    """Export an asset scene as a USD asset - returns an error, "" on success"""
+    import maya.standalone  # type: ignore
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+        from src.services.usd_service_impl import get_usd_service
+
+        cmds.file(str(asset_file), open=True, force=True, ignoreVersion=True)
+        cmds.select(cmds.ls(assemblies=True), replace=True)
+        result = get_usd_service().export_selection_as_asset(output, prim_name=asset_file.stem)
+        return "" if result["success"] else str(result["error"])
+    finally:
+        maya.standalone.uninitialize()
+
+
+def main(argv: List[str]) -> int:
+    """Run one farm step and write its result back - returns process exit code"""
+    args = _parse_args(argv)
+    if _PLUGIN_DIR not in sys.path:
+        sys.path.insert(0, _PLUGIN_DIR)
+
+    from src.services.render_farm_service_impl import get_render_farm_service
+
+    try:
+        if args.step == "turntable":
+            error = _render_turntable(args.step_arguments)
+        else:
+            error = _convert_to_usd(Path(args.input), Path(args.output))
+    except Exception as e:
+        error = str(e) or type(e).__name__
+
+    get_render_farm_service().write_result(Path(args.jobs_dir), args.key, not error, error)
+    if error:
+        print(f"[ERROR] Farm {args.step} step failed for {Path(args.input).name}: {error}")
+        return 1
+    print(f"[OK] Farm {args.step} step finished for {Path(args.input).name}")
+    return 0
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
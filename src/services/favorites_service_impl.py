@@ -0,0 +1,136 @@
+# -*- coding: utf-8 -*-
+"""
+Favorites Service Implementation
+Per-artist favorites, star ratings, and recently used assets of a library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Favorites and ratings are stored in the library database against the artist's
+user name, so they follow the artist between machines and every artist keeps
+their own. Ratings are shown averaged over all artists. Recent assets come from
+the artist's imports and publishes in the activity log.
+"""
+
+import logging
+from pathlib import Path
+from typing import Dict, List, Optional
+
+from ..core.models.activity_event import ACTIVITY_IMPORT, ACTIVITY_PUBLISH
+from ..core.models.asset_rating import MAX_RATING, AssetRating
+from .activity_service_impl import get_activity_service
+from .metadata_database_impl import get_metadata_database
+from .version_service_impl import get_current_user
+
+# Actions that put an asset in the artist's Recent tab
+RECENT_ACTIONS = (ACTIVITY_IMPORT, ACTIVITY_PUBLISH)
+# Assets the Recent tab shows at most
+RECENT_ASSET_LIMIT = 20
+
+
+class FavoritesService:
+    """
+    Favorites Service - Single Responsibility for what each artist favorites and rates
+    """
+
+    def __init__(self, database_factory=None, activity_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+        self._activity_service = activity_service or get_activity_service()
+
+    # Favorites --------------------------------------------------------------------------
+
+    def set_favorite(
+        self,
+        library_root: Path,
+        asset_file: Path,
+        favorite: bool = True,
+        user: Optional[str] = None,
+    ) -> None:
+        """Add an asset to, or remove it from, an artist's favorites"""
+        database = self._database_factory(Path(library_root))
+        database.set_favorite(Path(asset_file), user or get_current_user(), favorite)
+
+    def get_favorites(self, library_root: Path, user: Optional[str] = None) -> List[Path]:
+        """Get an artist's favorite asset files, oldest first"""
+        library_root = Path(library_root)
+        database = self._database_factory(library_root)
+        return [library_root / key for key in database.get_favorites(user or get_current_user())]
+
+    # Ratings ----------------------------------------------------------------------------
+
+    def rate(
+        self, library_root: Path, asset_file: Path, stars: int, user: Optional[str] = None
+    ) -> AssetRating:
+        """
+        Store an artist's rating of an asset
+
+        Args:
+            library_root: Library the asset belongs to
+            asset_file: Asset rated
+            stars: 1 to MAX_RATING, 0 to clear the artist's rating
+            user: Artist, defaults to the current one
+
+        Returns:
+            Asset rating including the new stars
+
+        Raises:
+            ValueError: If stars is out of range
+        """
+        if not 0 <= stars <= MAX_RATING:
+            raise ValueError(f"Ratings are 1 to {MAX_RATING} stars")
+        user = user or get_current_user()
+        database = self._database_factory(Path(library_root))
+        database.set_rating(Path(asset_file), user, stars)
+        key = database.get_asset_key(Path(asset_file))
+        return self.get_ratings(library_root, user).get(key, AssetRating())
+
+    def get_ratings(
+        self, library_root: Path, user: Optional[str] = None
+    ) -> Dict[str, AssetRating]:
+        """Get the rating of every rated asset keyed by asset key - one query per library"""
+        database = self._database_factory(Path(library_root))
+        return {
+            key: AssetRating(
+                average=float(row["average"]),
+                count=int(row["count"]),
+                user_rating=int(row["user_rating"] or 0),
+            )
+            for key, row in database.get_ratings(user or get_current_user()).items()
+        }
+
+    # Recent -----------------------------------------------------------------------------
+
+    def get_recent(
+        self, library_root: Path, user: Optional[str] = None, limit: int = RECENT_ASSET_LIMIT
+    ) -> List[Path]:
+        """Get the asset files an artist imported or published last, newest first"""
+        library_root = Path(library_root)
+        user = user or get_current_user()
+        recent: List[Path] = []
+        for event in self._activity_service.get_events(library_root, user=user):
+            if event.action not in RECENT_ACTIONS or not event.asset_key:
+                continue
+            asset_file = library_root / event.asset_key
+            if asset_file not in recent:
+                recent.append(asset_file)
+            if len(recent) >= limit:
+                break
+        return recent
+
+
+# Singleton instance factory
+_favorites_service_instance = None
+
+
+def get_favorites_service() -> FavoritesService:
+    """
+    Get singleton instance of FavoritesService.
+
+    Returns:
+        FavoritesService: Singleton service instance
+    """
+    global _favorites_service_instance
+    if _favorites_service_instance is None:
+        _favorites_service_instance = FavoritesService()
+    return _favorites_service_instance
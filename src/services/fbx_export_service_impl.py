@@ -0,0 +1,222 @@
+# -*- coding: utf-8 -*-
+"""
+FBX Export Service Implementation
+Library FBX presets and the automatic .fbx handoff written next to published scenes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Presets and the asset type they apply to are stored with the library, so every
+artist publishing a "Props" asset writes the same engine-ready FBX::
+
+    MyProject/.assetmanager/fbx_presets.json
+    {
+      "presets": {"Unreal": {"up_axis": "z", "units": "cm", "triangulate": true, ...}},
+      "asset_types": {"Props": "Unreal", "Vehicles": "Unreal"}
+    }
+
+    assets/scenes/crate.ma
+    assets/scenes/crate.fbx   <- written with the Unreal preset on publish
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.fbx_preset import FbxExportPreset
+
+PRESETS_DIR_NAME = ".assetmanager"
+PRESETS_FILE_NAME = "fbx_presets.json"
+
+# Starting points for a library without a presets file
+DEFAULT_PRESETS = {
+    "Unreal": FbxExportPreset(
+        "Unreal", up_axis="z", units="cm", triangulate=True, embed_media=True
+    ),
+    "Unity": FbxExportPreset("Unity", up_axis="y", units="m", embed_media=True),
+}
+
+# Runs one MEL command (maya.mel.eval)
+MelRunner = Callable[[str], Any]
+
+
+def _mel_bool(value: bool) -> str:
+    return "true" if value else "false"
+
+
+class FbxExportService:
+    """
+    FBX Export Service - Single Responsibility for FBX presets and preset exports
+    Exports go through a MEL runner so the command sequence can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Presets ----------------------------------------------------------------------------
+
+    def get_presets_file(self, library_root: Path) -> Path:
+        """Get the presets file of a library"""
+        return Path(library_root) / PRESETS_DIR_NAME / PRESETS_FILE_NAME
+
+    def load_presets(
+        self, library_root: Optional[Path]
+    ) -> Tuple[Dict[str, FbxExportPreset], Dict[str, str]]:
+        """
+        Get a library's presets and asset type assignments
+
+        Returns:
+            (preset name -> preset, asset type -> preset name); the defaults with no
+            assignments when the library has no presets file
+        """
+        presets = dict(DEFAULT_PRESETS)
+        asset_types: Dict[str, str] = {}
+        if library_root is None:
+            return presets, asset_types
+
+        presets_file = self.get_presets_file(library_root)
+        if not presets_file.is_file():
+            return presets, asset_types
+        try:
+            with open(presets_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            presets = {
+                name: FbxExportPreset.from_dict(dict(values, name=name))
+                for name, values in data.get("presets", {}).items()
+            }
+            asset_types = {
+                asset_type: name
+                for asset_type, name in data.get("asset_types", {}).items()
+                if name in presets
+            }
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable FBX presets {presets_file}: {e}")
+        return presets, asset_types
+
+    def save_presets(
+        self,
+        library_root: Path,
+        presets: Dict[str, FbxExportPreset],
+        asset_types: Dict[str, str],
+    ) -> bool:
+        """Write a library's presets and asset type assignments"""
+        presets_file = self.get_presets_file(library_root)
+        data = {
+            "presets": {
+                name: {k: v for k, v in preset.to_dict().items() if k != "name"}
+                for name, preset in presets.items()
+            },
+            "asset_types": {
+                asset_type: name for asset_type, name in asset_types.items() if name in presets
+            },
+        }
+        try:
+            presets_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(presets_file, "w", encoding="utf-8") as f:
+                json.dump(data, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save FBX presets: {e}")
+            return False
+
+    def get_preset_for_asset_type(
+        self, library_root: Optional[Path], asset_type: str
+    ) -> Optional[FbxExportPreset]:
+        """Get the preset publishes of an asset type (category) use, None for no FBX"""
+        presets, asset_types = self.load_presets(library_root)
+        name = asset_types.get(asset_type)
+        return presets.get(name) if name else None
+
+    # Export -----------------------------------------------------------------------------
+
+    def build_commands(
+        self,
+        preset: FbxExportPreset,
+        file_path: Path,
+        frame_range: Tuple[float, float],
+        selection_only: bool,
+    ) -> List[str]:
+        """Build the MEL command sequence that exports with a preset"""
+        commands = [
+            "FBXResetExport",
+            f"FBXExportUpAxis {preset.up_axis}",
+            f"FBXExportConvertUnitString {preset.units}",
+            f"FBXExportSmoothingGroups -v {_mel_bool(preset.smoothing_groups)}",
+            f"FBXExportTriangulate -v {_mel_bool(preset.triangulate)}",
+            f"FBXExportEmbeddedTextures -v {_mel_bool(preset.embed_media)}",
+            f"FBXExportBakeComplexAnimation -v {_mel_bool(preset.bake_animation)}",
+        ]
+        if preset.bake_animation:
+            commands += [
+                f"FBXExportBakeComplexStart -v {frame_range[0]:g}",
+                f"FBXExportBakeComplexEnd -v {frame_range[1]:g}",
+            ]
+        export = f'FBXExport -f "{Path(file_path).as_posix()}"'
+        commands.append(f"{export} -s" if selection_only else export)
+        return commands
+
+    def export(
+        self,
+        cmds: Any,
+        file_path: Path,
+        preset: FbxExportPreset,
+        selection: Optional[List[str]] = None,
+        mel_runner: Optional[MelRunner] = None,
+    ) -> Path:
+        """
+        Export the selection (or whole scene) as an FBX with a preset
+
+        Args:
+            cmds: maya.cmds module
+            file_path: Target .fbx file
+            preset: Export settings
+            selection: Nodes to export; None or empty exports the scene
+            mel_runner: Runs MEL commands (maya.mel.eval when omitted)
+
+        Returns:
+            Written FBX file
+
+        Raises:
+            RuntimeError: When the FBX plugin is missing or nothing was written
+        """
+        if mel_runner is None:
+            import maya.mel as mel  # type: ignore
+
+            mel_runner = mel.eval
+
+        if not cmds.pluginInfo("fbxmaya", query=True, loaded=True):
+            if not cmds.loadPlugin("fbxmaya", quiet=True):
+                raise RuntimeError("fbxmaya plugin not available")
+
+        file_path = Path(file_path)
+        frame_range = (
+            float(cmds.playbackOptions(query=True, minTime=True)),
+            float(cmds.playbackOptions(query=True, maxTime=True)),
+        )
+        if selection:
+            cmds.select(selection, replace=True)
+        for command in self.build_commands(preset, file_path, frame_range, bool(selection)):
+            mel_runner(command)
+
+        if not file_path.is_file():
+            raise RuntimeError(f"FBX export did not write {file_path.name}")
+        print(f"[OK] Exported {file_path.name} with FBX preset '{preset.name}'")
+        return file_path
+
+
+# Singleton instance factory
+_fbx_export_service_instance = None
+
+
+def get_fbx_export_service() -> FbxExportService:
+    """
+    Get singleton instance of FbxExportService.
+
+    Returns:
+        FbxExportService: Singleton service instance
+    """
+    global _fbx_export_service_instance
+    if _fbx_export_service_instance is None:
+        _fbx_export_service_instance = FbxExportService()
+    return _fbx_export_service_instance
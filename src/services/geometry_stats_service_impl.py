@@ -0,0 +1,155 @@
+# -*- coding: utf-8 -*-
+"""
+Geometry Stats Service Implementation
+Measure the meshes being published and keep the numbers with the asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Stats are stored with the asset's metadata under "stats" and can be searched and
+sorted by in the library::
+
+    type:model tris:>100000   verts:<5k   uvsets:>1   texres:>=4096   bbox:<50   skinned:yes
+"""
+
+import logging
+from typing import Any, Dict, List, Optional
+
+from ..core.models.geometry_stats import STAT_FILTERS, GeometryStats
+from .validation_service_impl import DEFAULT_CAMERAS
+
+STATS_METADATA_KEY = "stats"  # Asset metadata key holding GeometryStats.to_dict()
+
+
+class GeometryStatsService:
+    """
+    Geometry Stats Service - Single Responsibility for publish-time mesh statistics
+    Scene access goes through the cmds argument so stats can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Measuring --------------------------------------------------------------------------
+
+    def compute(self, cmds: Any, selection: Optional[List[str]] = None) -> GeometryStats:
+        """
+        Measure the meshes being published
+
+        Args:
+            cmds: maya.cmds module
+            selection: Published selection; None or empty measures the whole scene
+
+        Returns:
+            Stats of every non-intermediate mesh under the selection
+        """
+        meshes = self._get_meshes(cmds, selection)
+        if not meshes:
+            return GeometryStats()
+
+        triangles = vertices = uv_sets = 0
+        for mesh in meshes:
+            try:
+                triangles += int(cmds.polyEvaluate(mesh, triangle=True) or 0)
+                vertices += int(cmds.polyEvaluate(mesh, vertex=True) or 0)
+                mesh_uv_sets = cmds.polyUVSet(mesh, query=True, allUVSets=True) or []
+                uv_sets = max(uv_sets, len(mesh_uv_sets))
+            except Exception as e:
+                self.logger.warning(f"Skipping stats of {mesh}: {e}")
+
+        history = self._get_history(cmds, meshes)
+        return GeometryStats(
+            triangles=triangles,
+            vertices=vertices,
+            mesh_count=len(meshes),
+            uv_sets=uv_sets,
+            bounding_box=self._get_bounding_box(cmds, meshes),
+            texture_resolutions=self._get_texture_resolutions(cmds, history),
+            has_skin_cluster=bool(cmds.ls(history, type="skinCluster")),
+        )
+
+    def _get_meshes(self, cmds: Any, selection: Optional[List[str]]) -> List[str]:
+        """Get full paths of the published mesh shapes"""
+        if selection:
+            roots = selection
+        else:
+            roots = cmds.ls(assemblies=True, long=True) or []
+            roots = [root for root in roots if root not in DEFAULT_CAMERAS]
+        if not roots:
+            return []
+        return cmds.ls(roots, dag=True, type="mesh", noIntermediate=True, long=True) or []
+
+    def _get_history(self, cmds: Any, meshes: List[str]) -> List[str]:
+        """Get deformers and shading networks feeding the meshes"""
+        try:
+            shading_groups = cmds.listConnections(meshes, type="shadingEngine") or []
+            return cmds.listHistory(meshes + list(set(shading_groups))) or []
+        except Exception as e:
+            self.logger.warning(f"Could not read mesh history: {e}")
+            return []
+
+    def _get_bounding_box(self, cmds: Any, meshes: List[str]) -> tuple:
+        """Get the world-space width, height, and depth of the meshes"""
+        try:
+            x_min, y_min, z_min, x_max, y_max, z_max = cmds.exactWorldBoundingBox(meshes)
+        except Exception as e:
+            self.logger.warning(f"Could not measure bounding box: {e}")
+            return (0.0, 0.0, 0.0)
+        return tuple(round(size, 3) for size in (x_max - x_min, y_max - y_min, z_max - z_min))
+
+    def _get_texture_resolutions(self, cmds: Any, history: List[str]) -> tuple:
+        """Count the file textures of each resolution, largest first"""
+        counts: Dict[tuple, int] = {}
+        for node in set(cmds.ls(history, type="file") or []):
+            try:
+                width = int(cmds.getAttr(f"{node}.outSizeX"))
+                height = int(cmds.getAttr(f"{node}.outSizeY"))
+            except Exception:
+                continue  # Missing image - the validation checks report it
+            if width and height:
+                counts[(width, height)] = counts.get((width, height), 0) + 1
+        ordered = sorted(counts, key=lambda size: (max(size), min(size)), reverse=True)
+        return tuple((f"{width}x{height}", counts[(width, height)]) for width, height in ordered)
+
+    # Stored stats -----------------------------------------------------------------------
+
+    def get_stats(self, metadata: Optional[Dict[str, Any]]) -> Optional[GeometryStats]:
+        """Get the stats stored in asset metadata, None for assets published without them"""
+        stored = (metadata or {}).get(STATS_METADATA_KEY)
+        if not isinstance(stored, dict):
+            return None
+        try:
+            return GeometryStats.from_dict(stored)
+        except (TypeError, ValueError) as e:
+            self.logger.warning(f"Ignoring unreadable geometry stats: {e}")
+            return None
+
+    def sort_assets(self, assets: List[Any], filter_name: str) -> List[Any]:
+        """Order assets by a stat (STAT_FILTERS name), largest first, unmeasured last"""
+        if filter_name not in STAT_FILTERS:
+            return list(assets)
+
+        def sort_key(asset: Any) -> tuple:
+            stats = self.get_stats(getattr(asset, "metadata", None))
+            if stats is None:
+                return (1, 0.0)
+            return (0, -stats.get_filter_value(filter_name))
+
+        return sorted(assets, key=sort_key)
+
+
+# Singleton instance factory
+_geometry_stats_service_instance = None
+
+
+def get_geometry_stats_service() -> GeometryStatsService:
+    """
+    Get singleton instance of GeometryStatsService.
+
+    Returns:
+        GeometryStatsService: Singleton service instance
+    """
+    global _geometry_stats_service_instance
+    if _geometry_stats_service_instance is None:
+        _geometry_stats_service_instance = GeometryStatsService()
+    return _geometry_stats_service_instance
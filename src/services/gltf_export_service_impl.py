@@ -0,0 +1,598 @@
+# -*- coding: utf-8 -*-
+"""
+glTF Export Service Implementation
+Write assets as glTF 2.0 for the web viewer and the AR review app
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Assets are exported as a Y up, meter, triangulated FBX and converted with FBX2glTF
+(ASSET_MANAGER_FBX2GLTF, then PATH), optionally Draco compressed. Materials are then
+rebuilt from the Standard Surface shaders as metallic-roughness PBR, with metalness
+and roughness maps packed into one texture, so the review file looks like the asset::
+
+    MyProject/.assetmanager/gltf_export.json   <- asset types, GLB, Draco, texture size
+
+    assets/scenes/crate.ma
+    assets/scenes/crate.glb   <- written on publish or from the library on demand
+"""
+
+import base64
+import json
+import logging
+import os
+import shutil
+import struct
+import subprocess
+import tempfile
+import uuid
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.fbx_preset import FbxExportPreset
+from ..core.models.gltf_export import (
+    ALPHA_BLEND,
+    ALPHA_OPAQUE,
+    GltfExportSettings,
+    PbrMaterial,
+)
+from .fbx_export_service_impl import FbxExportService, MelRunner, get_fbx_export_service
+from .shader_converters import STANDARD_SURFACE_TYPES
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "gltf_export.json"
+CONVERTER_ENV_VAR = "ASSET_MANAGER_FBX2GLTF"
+CONVERTER_NAME = "FBX2glTF"
+
+# FBX written for the converter: glTF is Y up, in meters, and made of triangles
+GLTF_FBX_PRESET = FbxExportPreset(
+    "glTF", up_axis="y", units="m", triangulate=True, embed_media=True
+)
+
+# GLB container (glTF 2.0 binary) header and chunk types
+GLB_MAGIC = 0x46546C67
+GLB_VERSION = 2
+CHUNK_JSON = 0x4E4F534A
+CHUNK_BIN = 0x004E4942
+
+# Linear filtering with mipmaps and repeat wrapping, as the viewers expect
+DEFAULT_SAMPLER = {"magFilter": 9729, "minFilter": 9987, "wrapS": 10497, "wrapT": 10497}
+IMAGE_MIME_TYPES = {".png": "image/png", ".jpg": "image/jpeg", ".jpeg": "image/jpeg"}
+
+# Runs one converter command line (subprocess.run by default)
+CommandRunner = Callable[[List[str]], Any]
+
+
+def find_converter() -> Optional[str]:
+    """Get the FBX2glTF executable (ASSET_MANAGER_FBX2GLTF, then PATH), None if missing"""
+    configured = os.environ.get(CONVERTER_ENV_VAR)
+    if configured and Path(configured).is_file():
+        return configured
+    return shutil.which(CONVERTER_NAME)
+
+
+def _run_command(command: List[str]) -> None:
+    subprocess.run(command, check=True, capture_output=True)
+
+
+def _strip_namespace(name: str) -> str:
+    return name.rsplit(":", 1)[-1]
+
+
+def read_glb(data: bytes) -> Tuple[Dict[str, Any], Optional[bytearray]]:
+    """
+    Split a GLB container into its glTF document and binary buffer
+
+    Raises:
+        ValueError: When the data is not a glTF 2.0 binary
+    """
+    if len(data) < 20:
+        raise ValueError("Not a GLB file")
+    magic, version, length = struct.unpack_from("<III", data, 0)
+    if magic != GLB_MAGIC or version != GLB_VERSION:
+        raise ValueError("Not a glTF 2.0 binary")
+
+    document: Optional[Dict[str, Any]] = None
+    binary: Optional[bytearray] = None
+    offset = 12
+    while offset + 8 <= min(length, len(data)):
+        chunk_length, chunk_type = struct.unpack_from("<II", data, offset)
+        chunk = data[offset + 8 : offset + 8 + chunk_length]
+        if chunk_type == CHUNK_JSON:
+            document = json.loads(chunk.decode("utf-8"))
+        elif chunk_type == CHUNK_BIN and binary is None:
+            binary = bytearray(chunk)
+        offset += 8 + chunk_length
+    if document is None:
+        raise ValueError("GLB file has no JSON chunk")
+    return document, binary
+
+
+def write_glb(document: Dict[str, Any], binary: Optional[bytes]) -> bytes:
+    """Join a glTF document and its binary buffer into a GLB container"""
+    json_chunk = json.dumps(document, separators=(",", ":")).encode("utf-8")
+    json_chunk += b" " * (-len(json_chunk) % 4)
+    chunks = [struct.pack("<II", len(json_chunk), CHUNK_JSON) + json_chunk]
+    if binary:
+        bin_chunk = bytes(binary) + b"\x00" * (-len(binary) % 4)
+        chunks.append(struct.pack("<II", len(bin_chunk), CHUNK_BIN) + bin_chunk)
+    body = b"".join(chunks)
+    return struct.pack("<III", GLB_MAGIC, GLB_VERSION, 12 + len(body)) + body
+
+
+class GltfExportService:
+    """
+    glTF Export Service - Single Responsibility for glTF review files
+    Scene access goes through cmds and the converter through a runner, so exports can be
+    tested without Maya or FBX2glTF
+    """
+
+    def __init__(self, fbx_service: Optional[FbxExportService] = None):
+        self.logger = logging.getLogger(__name__)
+        self._fbx_service = fbx_service or get_fbx_export_service()
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the glTF settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def load_settings(self, library_root: Optional[Path]) -> GltfExportSettings:
+        """Get a library's glTF settings, the defaults (no publish types) when none are saved"""
+        if library_root is None:
+            return GltfExportSettings()
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return GltfExportSettings()
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                return GltfExportSettings.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable glTF settings {settings_file}: {e}")
+            return GltfExportSettings()
+
+    def save_settings(self, library_root: Path, settings: GltfExportSettings) -> bool:
+        """Write a library's glTF settings"""
+        settings_file = self.get_settings_file(library_root)
+        try:
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump(settings.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save glTF settings: {e}")
+            return False
+
+    def get_output_path(self, asset_file: Path, settings: GltfExportSettings) -> Path:
+        """Get the review file written next to an asset"""
+        return Path(asset_file).with_suffix(settings.extension)
+
+    # Export -----------------------------------------------------------------------------
+
+    def build_converter_command(
+        self, converter: str, fbx_file: Path, output_file: Path, settings: GltfExportSettings
+    ) -> List[str]:
+        """Build the FBX2glTF command line; the converter adds the extension itself"""
+        command = [converter, "-i", str(fbx_file), "-o", str(Path(output_file).with_suffix(""))]
+        command.append("--binary" if settings.binary else "--embed")
+        command.append("--pbr-metallic-roughness")
+        if settings.draco:
+            command += ["--draco", "--draco-compression-level", str(settings.draco_level)]
+        return command
+
+    def export(
+        self,
+        cmds: Any,
+        output_file: Path,
+        settings: GltfExportSettings,
+        selection: Optional[List[str]] = None,
+        runner: Optional[CommandRunner] = None,
+        mel_runner: Optional[MelRunner] = None,
+    ) -> Path:
+        """
+        Export the selection (or whole scene) as a glTF review file
+
+        Args:
+            cmds: maya.cmds module
+            output_file: Target .glb or .gltf file
+            settings: Library glTF settings
+            selection: Nodes to export; None or empty exports the scene
+            runner: Runs the converter (subprocess.run when omitted)
+            mel_runner: Runs the FBX export's MEL commands (maya.mel.eval when omitted)
+
+        Returns:
+            Written glTF file
+
+        Raises:
+            RuntimeError: When FBX2glTF is missing or the FBX or glTF was not written
+        """
+        converter = find_converter()
+        if converter is None:
+            raise RuntimeError(
+                f"{CONVERTER_NAME} not found - install it on PATH or set {CONVERTER_ENV_VAR}"
+            )
+
+        output_file = Path(output_file)
+        with tempfile.TemporaryDirectory(prefix="gltf_export_") as temp_dir:
+            fbx_file = Path(temp_dir) / f"{output_file.stem}.fbx"
+            self._fbx_service.export(cmds, fbx_file, GLTF_FBX_PRESET, selection, mel_runner)
+
+            converted = fbx_file.with_suffix(settings.extension)
+            command = self.build_converter_command(converter, fbx_file, converted, settings)
+            (runner or _run_command)(command)
+            if not converted.is_file():
+                raise RuntimeError(f"{CONVERTER_NAME} did not write {converted.name}")
+
+            if settings.bake_materials:
+                materials = self.read_materials(cmds, selection or cmds.ls(assemblies=True))
+                self.bake_file(converted, materials, settings.texture_size)
+
+            output_file.parent.mkdir(parents=True, exist_ok=True)
+            shutil.copy2(converted, output_file)
+
+        print(f"[OK] Exported {output_file.name} ({settings.description})")
+        return output_file
+
+    def export_asset(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        settings: GltfExportSettings,
+        runner: Optional[CommandRunner] = None,
+        mel_runner: Optional[MelRunner] = None,
+    ) -> Path:
+        """Import an asset into a temporary namespace, export it as glTF, and delete it"""
+        asset_file = Path(asset_file)
+        namespace = f"gltfExport_{uuid.uuid4().hex[:8]}"
+        cmds.undoInfo(stateWithoutFlush=False)
+        try:
+            nodes = cmds.file(str(asset_file), i=True, namespace=namespace, returnNewNodes=True)
+            roots = cmds.ls(nodes or [], assemblies=True) or []
+            if not roots:
+                raise RuntimeError(f"{asset_file.name} has no geometry to export")
+            output_file = self.get_output_path(asset_file, settings)
+            return self.export(cmds, output_file, settings, roots, runner, mel_runner)
+        finally:
+            if cmds.namespace(exists=namespace):
+                cmds.namespace(removeNamespace=namespace, deleteNamespaceContent=True)
+            cmds.undoInfo(stateWithoutFlush=True)
+
+    # Materials --------------------------------------------------------------------------
+
+    def read_materials(self, cmds: Any, nodes: List[str]) -> List[PbrMaterial]:
+        """Get the PBR materials of the shaders assigned to meshes under the nodes"""
+        shapes = cmds.ls(nodes, dag=True, type="mesh", long=True, noIntermediate=True) or []
+        shaders: List[str] = []
+        for shape in shapes:
+            for engine in cmds.listConnections(shape, type="shadingEngine") or []:
+                connected = cmds.listConnections(
+                    f"{engine}.surfaceShader", source=True, destination=False
+                )
+                if connected and connected[0] not in shaders:
+                    shaders.append(connected[0])
+        return [self.read_material(cmds, shader) for shader in shaders]
+
+    def read_material(self, cmds: Any, shader: str) -> PbrMaterial:
+        """
+        Get the PBR material of one shader
+
+        Standard Surface shaders map their base, metalness, specular roughness, emission,
+        opacity, and normal maps; other shaders keep their color and transparency.
+        """
+        if cmds.nodeType(shader) in STANDARD_SURFACE_TYPES:
+            base = float(cmds.getAttr(f"{shader}.base"))
+            color = cmds.getAttr(f"{shader}.baseColor")[0]
+            alpha = min(float(value) for value in cmds.getAttr(f"{shader}.opacity")[0])
+            base_color_texture = self._get_texture(cmds, f"{shader}.baseColor")
+            if base_color_texture:
+                color = (1.0, 1.0, 1.0)
+            emission = float(cmds.getAttr(f"{shader}.emission"))
+            emission_color = cmds.getAttr(f"{shader}.emissionColor")[0]
+            emissive_texture = self._get_texture(cmds, f"{shader}.emissionColor")
+            if emissive_texture:
+                emission_color = (1.0, 1.0, 1.0)
+            metallic_texture = self._get_texture(cmds, f"{shader}.metalness")
+            roughness_texture = self._get_texture(cmds, f"{shader}.specularRoughness")
+            return PbrMaterial(
+                name=_strip_namespace(shader),
+                base_color=(*(base * float(value) for value in color), alpha),
+                metallic=float(cmds.getAttr(f"{shader}.metalness")),
+                roughness=float(cmds.getAttr(f"{shader}.specularRoughness")),
+                emissive=tuple(min(emission * float(value), 1.0) for value in emission_color),
+                alpha_mode=ALPHA_BLEND if alpha < 1.0 else ALPHA_OPAQUE,
+                base_color_texture=base_color_texture,
+                metallic_texture=metallic_texture,
+                roughness_texture=roughness_texture,
+                normal_texture=self._get_normal_texture(cmds, f"{shader}.normalCamera"),
+                emissive_texture=emissive_texture if emission > 0 else None,
+            )
+
+        color, alpha, base_color_texture = (1.0, 1.0, 1.0), 1.0, None
+        if cmds.attributeQuery("color", node=shader, exists=True):
+            base_color_texture = self._get_texture(cmds, f"{shader}.color")
+            if not base_color_texture:
+                color = cmds.getAttr(f"{shader}.color")[0]
+        if cmds.attributeQuery("transparency", node=shader, exists=True):
+            alpha = 1.0 - max(float(value) for value in cmds.getAttr(f"{shader}.transparency")[0])
+        normal_texture = None
+        if cmds.attributeQuery("normalCamera", node=shader, exists=True):
+            normal_texture = self._get_normal_texture(cmds, f"{shader}.normalCamera")
+        return PbrMaterial(
+            name=_strip_namespace(shader),
+            base_color=(*(float(value) for value in color), alpha),
+            roughness=1.0,
+            alpha_mode=ALPHA_BLEND if alpha < 1.0 else ALPHA_OPAQUE,
+            base_color_texture=base_color_texture,
+            normal_texture=normal_texture,
+        )
+
+    def _get_texture(self, cmds: Any, plug: str) -> Optional[str]:
+        """Get the file texture feeding a shader input, None when it is not a file node"""
+        sources = cmds.listConnections(plug, source=True, destination=False) or []
+        if not sources or cmds.nodeType(sources[0]) != "file":
+            return None
+        return cmds.getAttr(f"{sources[0]}.fileTextureName") or None
+
+    def _get_normal_texture(self, cmds: Any, plug: str) -> Optional[str]:
+        """Get the tangent space normal map behind aiNormalMap or bump2d (normals mode)"""
+        sources = cmds.listConnections(plug, source=True, destination=False) or []
+        if not sources:
+            return None
+        node_type = cmds.nodeType(sources[0])
+        if node_type == "aiNormalMap":
+            return self._get_texture(cmds, f"{sources[0]}.input")
+        if node_type == "bump2d" and cmds.getAttr(f"{sources[0]}.bumpInterp") == 1:
+            return self._get_texture(cmds, f"{sources[0]}.bumpValue")
+        return None
+
+    # Baking -----------------------------------------------------------------------------
+
+    def bake_file(self, gltf_file: Path, materials: List[PbrMaterial], texture_size: int) -> int:
+        """
+        Replace the materials of a .glb or embedded .gltf with the PBR materials
+
+        Returns:
+            Number of materials replaced
+        """
+        gltf_file = Path(gltf_file)
+        data = gltf_file.read_bytes()
+        if gltf_file.suffix.lower() == ".glb":
+            document, binary = read_glb(data)
+            binary = binary if binary is not None else bytearray()
+            count = self.apply_materials(document, binary, materials, texture_size)
+            gltf_file.write_bytes(write_glb(document, binary))
+        else:
+            document = json.loads(data.decode("utf-8"))
+            count = self.apply_materials(document, None, materials, texture_size)
+            gltf_file.write_text(json.dumps(document, indent=2), encoding="utf-8")
+        return count
+
+    def apply_materials(
+        self,
+        document: Dict[str, Any],
+        binary: Optional[bytearray],
+        materials: List[PbrMaterial],
+        texture_size: int,
+    ) -> int:
+        """
+        Replace a glTF document's materials of the same name with the PBR materials
+
+        Args:
+            document: glTF JSON, edited in place
+            binary: GLB buffer textures are appended to; None embeds them as data URIs
+            materials: Materials read from the shaders
+            texture_size: Longest side of the baked textures
+
+        Returns:
+            Number of materials replaced
+        """
+        by_name = {material.name: material for material in materials}
+        textures: Dict[Tuple[str, ...], Optional[int]] = {}
+        count = 0
+        for index, existing in enumerate(document.get("materials") or []):
+            material = by_name.get(_strip_namespace(existing.get("name", "")))
+            if material is None:
+                continue
+            baked = self._build_material(document, binary, material, texture_size, textures)
+            if "doubleSided" in existing:
+                baked["doubleSided"] = existing["doubleSided"]
+            document["materials"][index] = baked
+            count += 1
+
+        if binary:
+            buffers = document.setdefault("buffers", [{}])
+            buffers[0]["byteLength"] = len(binary)
+        return count
+
+    def _build_material(
+        self,
+        document: Dict[str, Any],
+        binary: Optional[bytearray],
+        material: PbrMaterial,
+        texture_size: int,
+        textures: Dict[Tuple[str, ...], Optional[int]],
+    ) -> Dict[str, Any]:
+        """Build the glTF material of a PBR material, adding its textures to the document"""
+
+        def texture(key: Tuple[str, ...], bake: Callable[[], Optional[Tuple[bytes, str]]]):
+            if key not in textures:
+                image = bake()
+                textures[key] = (
+                    self._add_texture(document, binary, *image) if image is not None else None
+                )
+            return textures[key]
+
+        pbr: Dict[str, Any] = {
+            "baseColorFactor": [round(value, 6) for value in material.base_color],
+            "metallicFactor": round(material.metallic, 6),
+            "roughnessFactor": round(material.roughness, 6),
+        }
+        baked: Dict[str, Any] = {"name": material.name, "pbrMetallicRoughness": pbr}
+
+        if material.base_color_texture:
+            path = material.base_color_texture
+            index = texture((path,), lambda: self._bake_image(path, texture_size))
+            if index is not None:
+                pbr["baseColorTexture"] = {"index": index}
+        if material.metallic_texture or material.roughness_texture:
+            metal, rough = material.metallic_texture, material.roughness_texture
+            index = texture(
+                ("metallicRoughness", metal or "", rough or ""),
+                lambda: self._pack_metallic_roughness(metal, rough, texture_size),
+            )
+            if index is not None:
+                # The maps carry the values; without the packed map the shader's stand in
+                pbr["metallicRoughnessTexture"] = {"index": index}
+                pbr["metallicFactor"] = 1.0 if metal else pbr["metallicFactor"]
+                pbr["roughnessFactor"] = 1.0 if rough else pbr["roughnessFactor"]
+        if material.normal_texture:
+            path = material.normal_texture
+            index = texture((path,), lambda: self._bake_image(path, texture_size))
+            if index is not None:
+                baked["normalTexture"] = {"index": index}
+        if material.emissive_texture:
+            path = material.emissive_texture
+            index = texture((path,), lambda: self._bake_image(path, texture_size))
+            if index is not None:
+                baked["emissiveTexture"] = {"index": index}
+        if any(material.emissive):
+            baked["emissiveFactor"] = [round(value, 6) for value in material.emissive]
+        baked["alphaMode"] = material.alpha_mode
+        return baked
+
+    def _add_texture(
+        self, document: Dict[str, Any], binary: Optional[bytearray], data: bytes, mime_type: str
+    ) -> int:
+        """Add an image to the document (buffer or data URI) and get its texture index"""
+        images = document.setdefault("images", [])
+        if binary is not None:
+            binary.extend(b"\x00" * (-len(binary) % 4))
+            views = document.setdefault("bufferViews", [])
+            views.append({"buffer": 0, "byteOffset": len(binary), "byteLength": len(data)})
+            binary.extend(data)
+            images.append({"bufferView": len(views) - 1, "mimeType": mime_type})
+        else:
+            encoded = base64.b64encode(data).decode("ascii")
+            images.append({"uri": f"data:{mime_type};base64,{encoded}"})
+
+        samplers = document.setdefault("samplers", [])
+        if not samplers:
+            samplers.append(dict(DEFAULT_SAMPLER))
+        textures = document.setdefault("textures", [])
+        textures.append({"sampler": 0, "source": len(images) - 1})
+        return len(textures) - 1
+
+    def _bake_image(self, path: str, texture_size: int) -> Optional[Tuple[bytes, str]]:
+        """
+        Get a texture as PNG resized to the texture size, (bytes, MIME type)
+
+        Without Qt, PNG and JPEG files are embedded unchanged and other formats left out.
+        """
+        texture_file = Path(path)
+        if not texture_file.is_file():
+            print(f"[WARNING] Texture not found, left out of the glTF: {texture_file}")
+            return None
+        try:
+            from PySide6.QtGui import QImage
+        except ImportError:
+            mime_type = IMAGE_MIME_TYPES.get(texture_file.suffix.lower())
+            if mime_type is None:
+                print(f"[WARNING] {texture_file.name} needs Qt to be converted for the glTF")
+                return None
+            return texture_file.read_bytes(), mime_type
+
+        image = QImage(str(texture_file))
+        if image.isNull():
+            print(f"[WARNING] Could not read {texture_file.name}, left out of the glTF")
+            return None
+        return self._encode_png(self._fit_image(image, texture_size)), "image/png"
+
+    def _pack_metallic_roughness(
+        self, metal_path: Optional[str], rough_path: Optional[str], texture_size: int
+    ) -> Optional[Tuple[bytes, str]]:
+        """
+        Pack a metalness and a roughness map into glTF's blue and green channels
+
+        A missing map is left at full strength, so its factor carries the shader's value.
+        """
+        try:
+            from PySide6.QtGui import QColor, QImage, QPainter
+        except ImportError:
+            print("[WARNING] Packing metalness and roughness maps needs Qt")
+            return None
+
+        sources = {}
+        for channel, path in (("blue", metal_path), ("green", rough_path)):
+            if not path:
+                continue
+            image = QImage(path)
+            if image.isNull():
+                print(f"[WARNING] Could not read {Path(path).name}, left out of the glTF")
+                return None
+            sources[channel] = self._fit_image(image, texture_size)
+        size = max(sources.values(), key=lambda image: image.width() * image.height()).size()
+
+        def channel_image(channel: str) -> Any:
+            # Gray maps multiplied by one primary keep their values in that channel only
+            image = QImage(size, QImage.Format.Format_RGB32)
+            image.fill(QColor(255, 255, 255))
+            painter = QPainter(image)
+            try:
+                if channel in sources:
+                    painter.drawImage(image.rect(), sources[channel])
+                painter.setCompositionMode(QPainter.CompositionMode.CompositionMode_Multiply)
+                primary = QColor(0, 0, 255) if channel == "blue" else QColor(0, 255, 0)
+                painter.fillRect(image.rect(), primary)
+            finally:
+                painter.end()
+            return image
+
+        packed = channel_image("green")
+        painter = QPainter(packed)
+        try:
+            painter.setCompositionMode(QPainter.CompositionMode.CompositionMode_Plus)
+            painter.drawImage(0, 0, channel_image("blue"))
+        finally:
+            painter.end()
+        return self._encode_png(packed.convertToFormat(QImage.Format.Format_RGB888)), "image/png"
+
+    def _fit_image(self, image: Any, texture_size: int) -> Any:
+        """Scale an image down so its longest side is at most the texture size"""
+        from PySide6.QtCore import Qt
+
+        if max(image.width(), image.height()) <= texture_size:
+            return image
+        return image.scaled(
+            texture_size,
+            texture_size,
+            Qt.AspectRatioMode.KeepAspectRatio,
+            Qt.TransformationMode.SmoothTransformation,
+        )
+
+    def _encode_png(self, image: Any) -> bytes:
+        """Get an image's PNG bytes"""
+        from PySide6.QtCore import QBuffer, QByteArray, QIODevice
+
+        data = QByteArray()
+        buffer = QBuffer(data)
+        buffer.open(QIODevice.OpenModeFlag.WriteOnly)
+        image.save(buffer, "PNG")
+        buffer.close()
+        return bytes(data.data())
+
+
+# Singleton instance factory
+_gltf_export_service_instance = None
+
+
+def get_gltf_export_service() -> GltfExportService:
+    """
+    Get singleton instance of GltfExportService.
+
+    Returns:
+        GltfExportService: Singleton service instance
+    """
+    global _gltf_export_service_instance
+    if _gltf_export_service_instance is None:
+        _gltf_export_service_instance = GltfExportService()
+    return _gltf_export_service_instance
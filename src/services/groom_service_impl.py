@@ -0,0 +1,486 @@
+# -*- coding: utf-8 -*-
+"""
+Groom Service Implementation
+Publish XGen and Yeti grooms with their collection files and maps, and bind them on import
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A groom asset is a JSON descriptor like a light rig. The groom nodes and the growth
+meshes they are bound to go into a hidden Maya ASCII file; XGen collection files are
+written next to it, and collection folders (XGen) or image search paths (Yeti) with
+their maps are copied into a folder of their own::
+
+    assets/grooms/hero_hair.groom
+    assets/grooms/.grooms/hero_hair.ma
+    assets/grooms/.grooms/hero_hair__hero_hairPalette.xgen     <- XGen collection file
+    assets/grooms/.grooms/.dependencies/hero_hair/data/hero_hairPalette/...  <- maps
+
+On import each growth mesh is bound to a scene mesh: one with the same topology drives
+it through a blendShape, any other one through a proximity wrap. The descriptor keeps
+every growth mesh's topology so a remap can be offered when names or topology differ.
+"""
+
+import json
+import logging
+import shutil
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.mesh_topology import MeshTopology
+from .anim_clip_service_impl import playblast_thumbnail, strip_namespace
+from .blendshape_service_impl import get_blendshape_service
+from .dependency_service_impl import get_dependency_service
+from .version_service_impl import get_current_user
+
+GROOM_EXTENSION = ".groom"
+GROOM_FORMAT_VERSION = 1
+GROOMS_DIR_NAME = ".grooms"
+
+SYSTEM_XGEN = "xgen"
+SYSTEM_YETI = "yeti"
+SYSTEM_LABELS = {SYSTEM_XGEN: "XGen", SYSTEM_YETI: "Yeti"}
+SYSTEM_PLUGINS = {SYSTEM_XGEN: "xgenToolkit", SYSTEM_YETI: "pgYetiMaya"}
+
+# Groom node type -> hair system (palettes are collections, the others shapes)
+GROOM_NODE_TYPES = {
+    "xgmPalette": SYSTEM_XGEN,
+    "xgmSplineDescription": SYSTEM_XGEN,  # Interactive groom
+    "pgYetiMaya": SYSTEM_YETI,
+}
+
+# Attribute holding the folder of a groom's maps and caches
+DATA_PATH_ATTRIBUTES = {"xgmPalette": "xgDataPath", "pgYetiMaya": "imageSearchPath"}
+
+# Attribute on the group of an imported groom, holding its descriptor path
+GROOM_ATTRIBUTE = "assetManagerGroom"
+
+BIND_FOLLOW = "follow"  # Same topology: the scene mesh drives the growth mesh's points
+BIND_WRAP = "wrap"  # Other topology: proximity wrap onto the scene mesh
+
+
+@dataclass
+class GroomImportResult:
+    """Outcome of importing a groom"""
+
+    group: str = ""  # Group holding the imported groom
+    bindings: List[Tuple[str, str, str]] = field(default_factory=list)  # (growth, mesh, how)
+    unbound: List[str] = field(default_factory=list)  # Growth meshes left where they were
+
+    @property
+    def success(self) -> bool:
+        """Check if the groom was imported"""
+        return bool(self.group)
+
+
+class GroomService:
+    """
+    Groom Service - Single Responsibility for hair and fur asset save, import, and binding
+    All Maya calls go through the cmds argument so grooms can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Scene queries ----------------------------------------------------------------------
+
+    def find_grooms(
+        self, cmds: Any, selection: Optional[List[str]] = None
+    ) -> List[Tuple[str, str]]:
+        """
+        Get the groom nodes of the selection (and its shapes), or of the whole scene
+
+        Returns:
+            (node, node type) pairs
+        """
+        if selection is None:
+            nodes = cmds.ls(type=list(GROOM_NODE_TYPES), long=True) or []
+        else:
+            nodes = []
+            for node in selection:
+                candidates = [node] + (cmds.listRelatives(node, shapes=True, fullPath=True) or [])
+                nodes.extend(
+                    groom
+                    for groom in cmds.ls(candidates, type=list(GROOM_NODE_TYPES), long=True) or []
+                    if groom not in nodes
+                )
+        return [(node, cmds.nodeType(node)) for node in nodes]
+
+    def get_system(self, grooms: List[Tuple[str, str]]) -> str:
+        """Get the hair system of groom nodes ("" when they mix XGen and Yeti)"""
+        systems = {GROOM_NODE_TYPES[node_type] for _node, node_type in grooms}
+        return systems.pop() if len(systems) == 1 else ""
+
+    def get_growth_meshes(self, cmds: Any, grooms: List[Tuple[str, str]]) -> List[str]:
+        """Get the mesh transforms the grooms grow from"""
+        nodes = []
+        for node, _node_type in grooms:
+            nodes.append(node)
+            nodes.extend(cmds.listRelatives(node, allDescendents=True, fullPath=True) or [])
+        history = (cmds.listHistory(nodes) or []) if nodes else []
+        meshes = []
+        for shape in cmds.ls(history, type="mesh", noIntermediate=True, long=True) or []:
+            for parent in cmds.listRelatives(shape, parent=True, fullPath=True) or []:
+                if parent not in meshes:
+                    meshes.append(parent)
+        return meshes
+
+    def find_scene_meshes(self, cmds: Any) -> List[str]:
+        """Get every mesh transform of the scene a groom can be bound to"""
+        meshes = []
+        for shape in cmds.ls(type="mesh", noIntermediate=True, long=True) or []:
+            for parent in cmds.listRelatives(shape, parent=True, fullPath=True) or []:
+                if parent not in meshes:
+                    meshes.append(parent)
+        return meshes
+
+    # Save -------------------------------------------------------------------------------
+
+    def save_groom(
+        self, cmds: Any, grooms: List[Tuple[str, str]], descriptor_path: Path, notes: str = ""
+    ) -> Optional[Path]:
+        """
+        Export grooms, their growth meshes, and their collection files and maps
+
+        Args:
+            cmds: maya.cmds module
+            grooms: (node, node type) pairs from find_grooms
+            descriptor_path: .groom file to write
+            notes: Free text description
+
+        Returns:
+            Written descriptor path, None if the grooms cannot be saved together
+        """
+        descriptor_path = Path(descriptor_path).with_suffix(GROOM_EXTENSION)
+        if not grooms:
+            print("[ERROR] No XGen or Yeti grooms to save")
+            return None
+        system = self.get_system(grooms)
+        if not system:
+            print("[ERROR] A groom asset holds XGen or Yeti grooms, not both")
+            return None
+
+        growth_meshes = self.get_growth_meshes(cmds, grooms)
+        network_path = descriptor_path.parent / GROOMS_DIR_NAME / f"{descriptor_path.stem}.ma"
+        network_path.parent.mkdir(parents=True, exist_ok=True)
+        data_dirs = self._copy_data_dirs(cmds, grooms, network_path, descriptor_path)
+
+        # Copy shader textures next to the network and point the live nodes at them for export
+        export_nodes = [self._get_export_node(cmds, node) for node, _type in grooms]
+        dependency_service = get_dependency_service()
+        dependencies = dependency_service.scan_dependencies(export_nodes)
+        collected = dependency_service.collect(network_path, dependencies)
+        try:
+            cmds.select(export_nodes + growth_meshes, replace=True)
+            cmds.file(
+                str(network_path),
+                exportSelected=True,
+                type="mayaAscii",
+                force=True,
+                constructionHistory=True,
+                channels=True,
+                constraints=False,
+                expressions=True,
+                shader=True,
+                preserveReferences=False,
+            )
+        finally:
+            dependency_service.restore_paths(dependencies, collected.repathed)
+            cmds.select(clear=True)
+
+        # XGen writes one collection file per palette next to the exported scene
+        collection_files = sorted(network_path.parent.glob(f"{network_path.stem}__*.xgen"))
+        blendshape_service = get_blendshape_service()
+        descriptor = {
+            "type": "groom",
+            "format_version": GROOM_FORMAT_VERSION,
+            "name": descriptor_path.stem,
+            "system": system,
+            "notes": notes,
+            "grooms": [strip_namespace(node) for node, _type in grooms],
+            "growth_meshes": [
+                {
+                    "name": strip_namespace(mesh),
+                    "topology": blendshape_service.get_topology(cmds, mesh).to_dict(),
+                }
+                for mesh in growth_meshes
+            ],
+            "network": network_path.relative_to(descriptor_path.parent).as_posix(),
+            "collection_files": [
+                path.relative_to(descriptor_path.parent).as_posix() for path in collection_files
+            ],
+            "data_dirs": data_dirs,
+            "textures": [
+                path.relative_to(descriptor_path.parent).as_posix()
+                for path in collected.copied_files
+            ],
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+        }
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+
+        print(
+            f"[OK] Saved {SYSTEM_LABELS[system]} groom {descriptor_path.name} "
+            f"({len(grooms)} grooms, {len(growth_meshes)} growth meshes)"
+        )
+        return descriptor_path
+
+    def load_groom(self, descriptor_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .groom descriptor, None if it is not a valid groom"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "groom":
+                return None
+            return descriptor
+        except Exception as e:
+            self.logger.error(f"Failed to read groom {descriptor_path}: {e}")
+            return None
+
+    def capture_thumbnail(
+        self, cmds: Any, descriptor_path: Path, size: int = 256
+    ) -> Optional[Path]:
+        """Playblast the current frame into the library thumbnail folder"""
+        return playblast_thumbnail(cmds, descriptor_path, cmds.currentTime(query=True), size)
+
+    # Binding ----------------------------------------------------------------------------
+
+    def get_topology(self, descriptor: Dict[str, Any], growth_mesh: str) -> MeshTopology:
+        """Get the stored topology of one of a groom's growth meshes"""
+        for entry in descriptor.get("growth_meshes", []):
+            if entry.get("name") == growth_mesh:
+                return MeshTopology.from_dict(entry.get("topology", {}))
+        return MeshTopology()
+
+    def check_target(
+        self, cmds: Any, descriptor: Dict[str, Any], growth_mesh: str, mesh: str
+    ) -> List[str]:
+        """Get why a scene mesh does not match a growth mesh, [] if it does"""
+        stored = self.get_topology(descriptor, growth_mesh)
+        return stored.differences(get_blendshape_service().get_topology(cmds, mesh))
+
+    def suggest_targets(self, cmds: Any, descriptor: Dict[str, Any]) -> Dict[str, str]:
+        """
+        Pick the scene mesh each growth mesh binds to
+
+        A mesh with the same name wins, one that also matches topology first; otherwise
+        the only mesh with the same topology. Growth meshes without either map to "".
+        """
+        blendshape_service = get_blendshape_service()
+        scene_meshes = self.find_scene_meshes(cmds)
+        targets = {}
+        for entry in descriptor.get("growth_meshes", []):
+            name = entry.get("name", "")
+            topology = MeshTopology.from_dict(entry.get("topology", {}))
+            same_name = [mesh for mesh in scene_meshes if strip_namespace(mesh) == name]
+            matching = [
+                mesh
+                for mesh in same_name
+                if topology.matches(blendshape_service.get_topology(cmds, mesh))
+            ]
+            if matching or same_name:
+                targets[name] = (matching or same_name)[0]
+                continue
+            same_topology = blendshape_service.find_matching_meshes(cmds, topology)
+            targets[name] = same_topology[0] if len(same_topology) == 1 else ""
+        return targets
+
+    def needs_remap(
+        self, cmds: Any, descriptor: Dict[str, Any], targets: Dict[str, str]
+    ) -> bool:
+        """Check if any growth mesh lacks a same-named scene mesh with its topology"""
+        for entry in descriptor.get("growth_meshes", []):
+            name = entry.get("name", "")
+            mesh = targets.get(name, "")
+            if not mesh or strip_namespace(mesh) != name:
+                return True
+            if self.check_target(cmds, descriptor, name, mesh):
+                return True
+        return False
+
+    # Import -----------------------------------------------------------------------------
+
+    def import_groom(
+        self, cmds: Any, descriptor_path: Path, targets: Dict[str, str]
+    ) -> Optional[GroomImportResult]:
+        """
+        Import a groom under a tagged group and bind its growth meshes to scene meshes
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .groom file
+            targets: Growth mesh name -> scene mesh to bind to ("" leaves it unbound)
+
+        Returns:
+            Group, bindings, and unbound growth meshes; None if the groom could not load
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_groom(descriptor_path)
+        if descriptor is None:
+            return None
+        network_path = descriptor_path.parent / descriptor.get("network", "")
+        if not network_path.is_file():
+            print(f"[ERROR] Groom network missing: {network_path}")
+            return None
+
+        system = descriptor.get("system", SYSTEM_XGEN)
+        plugin = SYSTEM_PLUGINS.get(system)
+        if plugin:
+            try:
+                cmds.loadPlugin(plugin, quiet=True)
+            except Exception as e:
+                print(f"[WARNING] {plugin} not available, the groom may not load: {e}")
+
+        # Own namespace, so growth meshes do not clash with the meshes they bind to
+        name = descriptor["name"]
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        new_nodes = cmds.file(
+            str(network_path),
+            i=True,
+            type="mayaAscii",
+            namespace=f"{name}_groom",
+            returnNewNodes=True,
+        ) or []
+        get_dependency_service().resolve_relative_paths(network_path, new_nodes)
+        self._point_at_data_dirs(cmds, descriptor_path, descriptor, new_nodes)
+
+        result = GroomImportResult()
+        growth_nodes = self._find_imported_growth_meshes(cmds, descriptor, new_nodes)
+        for growth_name, growth in growth_nodes.items():
+            mesh = targets.get(growth_name, "")
+            if not mesh:
+                result.unbound.append(growth_name)
+                continue
+            differs = self.check_target(cmds, descriptor, growth_name, mesh)
+            method = BIND_WRAP if differs else BIND_FOLLOW
+            try:
+                self._bind(cmds, growth, mesh, method)
+                result.bindings.append((growth_name, mesh, method))
+            except Exception as e:
+                print(f"[WARNING] Could not bind {growth_name} to {mesh}: {e}")
+                result.unbound.append(growth_name)
+
+        roots = [node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before]
+        if not roots:
+            print(f"[ERROR] {descriptor_path.name} has no groom to import")
+            return None
+        result.group = cmds.group(roots, name=f"{name}_groom")
+        cmds.addAttr(result.group, longName=GROOM_ATTRIBUTE, dataType="string")
+        cmds.setAttr(
+            f"{result.group}.{GROOM_ATTRIBUTE}", descriptor_path.as_posix(), type="string"
+        )
+        print(
+            f"[OK] Imported groom {name}: {len(result.bindings)} bound, "
+            f"{len(result.unbound)} unbound"
+        )
+        return result
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_export_node(self, cmds: Any, node: str) -> str:
+        """Get the node to select for export (the transform of groom shapes)"""
+        if cmds.nodeType(node) == "xgmPalette":
+            return node
+        return (cmds.listRelatives(node, parent=True, fullPath=True) or [node])[0]
+
+    def _resolve_data_path(self, cmds: Any, value: str) -> Optional[Path]:
+        """Get the first folder of a data path attribute, with ${PROJECT} filled in"""
+        first = str(value or "").split(";")[0].strip()
+        if not first:
+            return None
+        if "${PROJECT}" in first:
+            project = cmds.workspace(query=True, rootDirectory=True) or ""
+            first = first.replace("${PROJECT}", project.rstrip("/") + "/")
+        path = Path(first)
+        return path if path.is_dir() else None
+
+    def _copy_data_dirs(
+        self,
+        cmds: Any,
+        grooms: List[Tuple[str, str]],
+        network_path: Path,
+        descriptor_path: Path,
+    ) -> Dict[str, str]:
+        """Copy collection folders and image search paths into the asset's own folder"""
+        data_root = get_dependency_service().get_dependency_directory(network_path) / "data"
+        data_dirs = {}
+        for node, node_type in grooms:
+            attribute = DATA_PATH_ATTRIBUTES.get(node_type)
+            if attribute is None:
+                continue
+            source = self._resolve_data_path(cmds, cmds.getAttr(f"{node}.{attribute}"))
+            if source is None:
+                continue
+            target = data_root / strip_namespace(node)
+            try:
+                shutil.copytree(source, target, dirs_exist_ok=True)
+            except Exception as e:
+                print(f"[WARNING] Could not copy groom data {source}: {e}")
+                continue
+            data_dirs[strip_namespace(node)] = target.relative_to(
+                descriptor_path.parent
+            ).as_posix()
+        return data_dirs
+
+    def _point_at_data_dirs(
+        self,
+        cmds: Any,
+        descriptor_path: Path,
+        descriptor: Dict[str, Any],
+        new_nodes: List[str],
+    ) -> None:
+        """Point imported groom nodes at their maps in the library"""
+        data_dirs = descriptor.get("data_dirs") or {}
+        for node in cmds.ls(new_nodes, type=list(DATA_PATH_ATTRIBUTES), long=True) or []:
+            relative = data_dirs.get(strip_namespace(node))
+            if not relative:
+                continue
+            attribute = DATA_PATH_ATTRIBUTES[cmds.nodeType(node)]
+            location = (descriptor_path.parent / relative).as_posix()
+            cmds.setAttr(f"{node}.{attribute}", location, type="string")
+
+    def _find_imported_growth_meshes(
+        self, cmds: Any, descriptor: Dict[str, Any], new_nodes: List[str]
+    ) -> Dict[str, str]:
+        """Get growth mesh name -> imported transform"""
+        names = [entry.get("name", "") for entry in descriptor.get("growth_meshes", [])]
+        found = {}
+        for shape in cmds.ls(new_nodes, type="mesh", noIntermediate=True, long=True) or []:
+            for parent in cmds.listRelatives(shape, parent=True, fullPath=True) or []:
+                name = strip_namespace(parent)
+                if name in names and name not in found:
+                    found[name] = parent
+        return found
+
+    def _bind(self, cmds: Any, growth: str, mesh: str, method: str) -> None:
+        """Make a scene mesh drive a growth mesh, then hide the growth mesh"""
+        name = strip_namespace(growth)
+        if method == BIND_FOLLOW:
+            cmds.blendShape(
+                mesh, growth, origin="world", weight=(0, 1.0), name=f"{name}_groomFollow"
+            )
+        else:
+            wrap = cmds.deformer(growth, type="proximityWrap", name=f"{name}_groomWrap")[0]
+            driver = (cmds.listRelatives(mesh, shapes=True, fullPath=True) or [mesh])[0]
+            cmds.proximityWrap(wrap, edit=True, addDrivers=[driver])
+        cmds.setAttr(f"{growth}.visibility", False)
+
+
+# Singleton instance factory
+_groom_service_instance = None
+
+
+def get_groom_service() -> GroomService:
+    """
+    Get singleton instance of GroomService.
+
+    Returns:
+        GroomService: Singleton service instance
+    """
+    global _groom_service_instance
+    if _groom_service_instance is None:
+        _groom_service_instance = GroomService()
+    return _groom_service_instance
@@ -0,0 +1,261 @@
+# -*- coding: utf-8 -*-
+"""
+Hook Service Implementation
+Studio Python callbacks run before and after publishes, imports, and deletes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Hook folders, in load order (callbacks of one hook run in this order)::
+
+    ~/.assetmanager/hooks/             <- per-artist hooks
+    $ASSET_MANAGER_HOOKS_PATH          <- studio folders (os.pathsep separated)
+    <library>/.assetmanager/hooks/     <- hooks shipped with a shared library
+
+A hook file defines functions named after hook points, and/or a register function
+for callbacks with other names. Every callback gets one context dict::
+
+    # studio_hooks.py
+    def post_publish(context):
+        update_shotgrid(context["asset_file"], context["version"])
+
+    def register(hooks):
+        hooks.register("pre_publish", rename_nodes)
+
+Raising HookCancelled from a pre_ hook stops the publish or import and shows the
+message to the artist. Any other error is logged and the next callback still runs,
+so a broken studio hook never blocks artists.
+"""
+
+import importlib.util
+import logging
+import os
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from .version_service_impl import get_current_user
+
+HOOK_PRE_PUBLISH = "pre_publish"
+HOOK_POST_PUBLISH = "post_publish"
+HOOK_PRE_IMPORT = "pre_import"
+HOOK_POST_IMPORT = "post_import"
+HOOK_ASSET_DELETED = "on_asset_deleted"
+HOOK_POINTS = (
+    HOOK_PRE_PUBLISH,
+    HOOK_POST_PUBLISH,
+    HOOK_PRE_IMPORT,
+    HOOK_POST_IMPORT,
+    HOOK_ASSET_DELETED,
+)
+
+HOOKS_PATH_ENV = "ASSET_MANAGER_HOOKS_PATH"
+HOOKS_DIR_NAME = "hooks"
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+REGISTERED_SOURCE = "(registered in code)"
+
+
+class HookCancelled(Exception):
+    """Raised by a pre_ hook to stop the action; the message is shown to the artist"""
+
+
+class HookService:
+    """
+    Hook Service - Single Responsibility for pipeline customization callbacks
+    Hook files are re-read when the library changes or on reload
+    """
+
+    def __init__(self, user_hooks_dir: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._user_hooks_dir = user_hooks_dir or USER_CONFIG_DIR / HOOKS_DIR_NAME
+        # Hook point -> (source, callback); code registrations survive reloads
+        self._registered: Dict[str, List[Tuple[str, Callable]]] = {}
+        self._loaded: Dict[str, List[Tuple[str, Callable]]] = {}
+        self._loaded_library: Optional[str] = None
+        self._is_loaded = False
+
+    # Registration -----------------------------------------------------------------------
+
+    def register(self, hook: str, callback: Callable[[Dict[str, Any]], Any]) -> None:
+        """
+        Add a callback to a hook point (from Python code, e.g. userSetup.py)
+
+        Raises:
+            ValueError: If the hook point does not exist
+        """
+        self._add(self._registered, hook, REGISTERED_SOURCE, callback)
+
+    def unregister(self, hook: str, callback: Callable) -> None:
+        """Remove a callback added with register"""
+        self._registered[hook] = [
+            entry for entry in self._registered.get(hook, []) if entry[1] != callback
+        ]
+
+    def get_hook_directories(self, library_root: Optional[Path] = None) -> List[Path]:
+        """Get hook folders in load order"""
+        directories = [self._user_hooks_dir]
+        for entry in os.environ.get(HOOKS_PATH_ENV, "").split(os.pathsep):
+            if entry.strip():
+                directories.append(Path(entry.strip()))
+        if library_root:
+            directories.append(Path(library_root) / ".assetmanager" / HOOKS_DIR_NAME)
+        return directories
+
+    def load(self, library_root: Optional[Path] = None) -> int:
+        """
+        Read the hook files again
+
+        Args:
+            library_root: Current library, whose own hooks folder is included
+
+        Returns:
+            Number of callbacks loaded from files
+        """
+        self._loaded = {}
+        for directory in self.get_hook_directories(library_root):
+            for path, module in self._load_folder_modules(directory):
+                self._collect_callbacks(path, module)
+        self._loaded_library = str(library_root) if library_root else None
+        self._is_loaded = True
+
+        count = sum(len(callbacks) for callbacks in self._loaded.values())
+        if count:
+            print(f"[INFO] Loaded {count} pipeline hook callbacks")
+        return count
+
+    def get_callbacks(
+        self, hook: str, library_root: Optional[Path] = None
+    ) -> List[Tuple[str, Callable]]:
+        """Get (source, callback) of a hook point in run order"""
+        self._ensure_loaded(library_root)
+        return self._loaded.get(hook, []) + self._registered.get(hook, [])
+
+    # Running ----------------------------------------------------------------------------
+
+    def run(self, hook: str, library_root: Optional[Path] = None, **context: Any) -> int:
+        """
+        Call every callback of a hook point
+
+        Args:
+            hook: One of HOOK_POINTS
+            library_root: Current library, whose own hooks are included
+            context: Details of the action (asset_file, asset_name, ...)
+
+        Returns:
+            Number of callbacks that ran without error
+
+        Raises:
+            HookCancelled: If a pre_ hook stops the action
+        """
+        if hook not in HOOK_POINTS:
+            raise ValueError(f"Unknown hook point: {hook}")
+
+        context = {
+            "hook": hook,
+            "library_root": Path(library_root) if library_root else None,
+            "user": get_current_user(),
+            **context,
+        }
+        succeeded = 0
+        for source, callback in self.get_callbacks(hook, library_root):
+            name = getattr(callback, "__name__", repr(callback))
+            try:
+                callback(dict(context))
+                succeeded += 1
+            except HookCancelled as e:
+                if hook.startswith("pre_"):
+                    print(f"[INFO] {hook} hook {name} cancelled: {e}")
+                    raise
+                self.logger.warning(f"{hook} hook {name} cannot cancel: {e}")
+            except Exception as e:
+                self.logger.error(f"{hook} hook {name} from {source} failed: {e}")
+                print(f"[WARNING] Pipeline hook {name} ({hook}) failed: {e}")
+        return succeeded
+
+    # Internals --------------------------------------------------------------------------
+
+    def _ensure_loaded(self, library_root: Optional[Path]) -> None:
+        """Load hook files on first use and when the library changes"""
+        library = str(library_root) if library_root else None
+        if not self._is_loaded or library != self._loaded_library:
+            self.load(library_root)
+
+    def _add(
+        self,
+        callbacks: Dict[str, List[Tuple[str, Callable]]],
+        hook: str,
+        source: str,
+        callback: Callable,
+    ) -> None:
+        """Add a callback to a hook table after checking it"""
+        if hook not in HOOK_POINTS:
+            raise ValueError(f"Unknown hook point: {hook}")
+        if not callable(callback):
+            raise ValueError(f"Hook callback for {hook} is not callable")
+        callbacks.setdefault(hook, []).append((source, callback))
+
+    def _load_folder_modules(self, directory: Path) -> List[Tuple[Path, Any]]:
+        """Import every hook file of a folder"""
+        modules: List[Tuple[Path, Any]] = []
+        if not directory.is_dir():
+            return modules
+
+        for path in sorted(directory.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            module_name = f"asset_manager_hooks_{abs(hash(str(path)))}_{path.stem}"
+            try:
+                spec = importlib.util.spec_from_file_location(module_name, path)
+                if spec is None or spec.loader is None:
+                    continue
+                module = importlib.util.module_from_spec(spec)
+                spec.loader.exec_module(module)
+                modules.append((path, module))
+            except Exception as e:
+                print(f"[WARNING] Could not load pipeline hooks {path}: {e}")
+        return modules
+
+    def _collect_callbacks(self, path: Path, module: Any) -> None:
+        """Add the hook functions and register() callbacks of a hook file"""
+        for hook in HOOK_POINTS:
+            callback = getattr(module, hook, None)
+            if callable(callback):
+                self._add(self._loaded, hook, str(path), callback)
+
+        register = getattr(module, "register", None)
+        if not callable(register):
+            return
+        registrar = _HookRegistrar()
+        try:
+            register(registrar)
+            for hook, callback in registrar.callbacks:
+                self._add(self._loaded, hook, str(path), callback)
+        except Exception as e:
+            print(f"[WARNING] register() of pipeline hooks {path.name} failed: {e}")
+
+
+class _HookRegistrar:
+    """What a hook file's register() receives"""
+
+    def __init__(self):
+        self.callbacks: List[Tuple[str, Callable]] = []
+
+    def register(self, hook: str, callback: Callable[[Dict[str, Any]], Any]) -> None:
+        """Add a callback to a hook point"""
+        self.callbacks.append((hook, callback))
+
+
+# Singleton instance factory
+_hook_service_instance = None
+
+
+def get_hook_service() -> HookService:
+    """
+    Get singleton instance of HookService.
+
+    Returns:
+        HookService: Singleton service instance
+    """
+    global _hook_service_instance
+    if _hook_service_instance is None:
+        _hook_service_instance = HookService()
+    return _hook_service_instance
@@ -0,0 +1,330 @@
+# -*- coding: utf-8 -*-
+"""
+Hotkey Service Implementation
+Configurable shortcuts for manager commands, Maya runtime commands, and a marking menu
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Shortcuts the artist changed in the hotkey editor are stored per artist; commands left
+alone keep their default::
+
+    ~/.assetmanager/hotkeys.json   {"shortcuts": {"import_last": "Ctrl+Alt+I"}}
+
+Every command is also registered as a Maya runtime command in the "Custom Scripts.Asset
+Manager" category, so it can be bound in Maya's own Hotkey Editor and works while the
+window is closed. AssetManagerMarkingMenu_Press / _Release bound to one key (press and
+release) show the commands as a marking menu over the viewport.
+"""
+
+import json
+import logging
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+COMMAND_OPEN_BROWSER = "open_browser"
+COMMAND_PUBLISH_SELECTED = "publish_selected"
+COMMAND_IMPORT_SELECTED = "import_selected"
+COMMAND_IMPORT_LAST = "import_last"
+COMMAND_SEARCH = "search"
+COMMAND_ADVANCED_SEARCH = "advanced_search"
+COMMAND_SCRATCH_EXPORT = "scratch_export"
+
+RUNTIME_CATEGORY = "Custom Scripts.Asset Manager"
+# maya_plugin is on the script path wherever the shelf launcher works
+RUNTIME_COMMAND_SCRIPT = "import maya_plugin; maya_plugin.run_manager_command({command_id!r})"
+MARKING_MENU_NAME = "assetManagerMarkingMenu"
+MARKING_MENU_PRESS = "AssetManagerMarkingMenu_Press"
+MARKING_MENU_RELEASE = "AssetManagerMarkingMenu_Release"
+MARKING_MENU_PARENT = "viewPanes"
+
+# Modifier spellings, in the order Qt writes them
+_MODIFIER_NAMES = {
+    "ctrl": "Ctrl",
+    "control": "Ctrl",
+    "shift": "Shift",
+    "alt": "Alt",
+    "meta": "Meta",
+}
+
+
+@dataclass(frozen=True)
+class ManagerCommand:
+    """A manager action that can get a shortcut and a Maya runtime command"""
+
+    command_id: str
+    label: str
+    annotation: str
+    default_shortcut: str = ""  # Qt key sequence inside the window
+    radial_position: str = ""  # Marking menu slot (N, E, S, W, ...), "" for the overflow list
+    in_window: bool = True  # False for commands only Maya's hotkeys can run
+
+    @property
+    def runtime_name(self) -> str:
+        """Get the Maya runtime command name, e.g. AssetManagerImportLast"""
+        return "AssetManager" + "".join(part.title() for part in self.command_id.split("_"))
+
+
+COMMANDS = [
+    ManagerCommand(
+        COMMAND_OPEN_BROWSER,
+        "Open Asset Browser",
+        "Show the Asset Manager window",
+        radial_position="N",
+        in_window=False,
+    ),
+    ManagerCommand(
+        COMMAND_PUBLISH_SELECTED,
+        "Publish Selected",
+        "Publish the Maya selection as a new asset",
+        "Ctrl+P",
+        "E",
+    ),
+    ManagerCommand(
+        COMMAND_IMPORT_SELECTED,
+        "Import Selected",
+        "Import the assets selected in the library",
+        "Ctrl+I",
+    ),
+    ManagerCommand(
+        COMMAND_IMPORT_LAST,
+        "Import Last Asset",
+        "Import the asset used most recently again",
+        "Ctrl+Shift+I",
+        "S",
+    ),
+    ManagerCommand(
+        COMMAND_SEARCH,
+        "Search Library",
+        "Jump to the library search field",
+        "Ctrl+Shift+F",
+        "W",
+    ),
+    ManagerCommand(
+        COMMAND_ADVANCED_SEARCH,
+        "Advanced Search",
+        "Search the library by type, tags, and dates",
+        "Ctrl+F",
+    ),
+    ManagerCommand(
+        COMMAND_SCRATCH_EXPORT,
+        "Quick Export to Scratch",
+        "Export the Maya selection to your scratch library, named automatically",
+        "Ctrl+Alt+E",
+        "NE",
+    ),
+]
+
+
+def normalize_shortcut(shortcut: str) -> str:
+    """Get a key sequence in one spelling ("shift+ctrl+p" -> "Ctrl+Shift+P"), "" for none"""
+    parts = [part.strip() for part in str(shortcut or "").split("+")]
+    if not parts[-1]:
+        return ""
+    modifiers = {_MODIFIER_NAMES.get(part.lower(), part) for part in parts[:-1]}
+    ordered = [name for name in dict.fromkeys(_MODIFIER_NAMES.values()) if name in modifiers]
+    key = parts[-1][0].upper() + parts[-1][1:]
+    return "+".join(ordered + [key])
+
+
+class HotkeyService:
+    """
+    Hotkey Service - Single Responsibility for manager command shortcuts
+    The window applies the shortcuts; Maya binds the runtime commands registered here
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "hotkeys.json"
+
+    # Shortcuts --------------------------------------------------------------------------
+
+    def get_commands(self) -> List[ManagerCommand]:
+        """Get the manager commands, in editor order"""
+        return list(COMMANDS)
+
+    def get_command(self, command_id: str) -> Optional[ManagerCommand]:
+        """Get a manager command by id"""
+        return next((command for command in COMMANDS if command.command_id == command_id), None)
+
+    def get_shortcuts(self) -> Dict[str, str]:
+        """Get the window shortcut of every in-window command, the artist's where changed"""
+        overrides = self._load_overrides()
+        return {
+            command.command_id: overrides.get(command.command_id, command.default_shortcut)
+            for command in COMMANDS
+            if command.in_window
+        }
+
+    def set_shortcuts(self, shortcuts: Dict[str, str]) -> bool:
+        """
+        Store the artist's shortcuts, keeping only those that differ from the default
+
+        Raises:
+            ValueError: If a command is unknown or two commands share a shortcut
+        """
+        conflicts = self.find_conflicts(shortcuts)
+        if conflicts:
+            raise ValueError("; ".join(conflicts))
+        overrides = {}
+        for command_id, shortcut in shortcuts.items():
+            command = self.get_command(command_id)
+            if command is None or not command.in_window:
+                raise ValueError(f"No window shortcut for command '{command_id}'")
+            shortcut = normalize_shortcut(shortcut)
+            if shortcut != normalize_shortcut(command.default_shortcut):
+                overrides[command_id] = shortcut
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump({"shortcuts": overrides}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save shortcuts: {e}")
+            return False
+
+    def find_conflicts(
+        self, shortcuts: Dict[str, str], reserved: Optional[Dict[str, str]] = None
+    ) -> List[str]:
+        """
+        Get shortcuts used twice, [] when there are none
+
+        Args:
+            shortcuts: Shortcut per command id
+            reserved: Fixed shortcuts of other window actions (shortcut -> action label)
+        """
+        taken = {normalize_shortcut(key): label for key, label in (reserved or {}).items()}
+        conflicts = []
+        for command_id, shortcut in shortcuts.items():
+            shortcut = normalize_shortcut(shortcut)
+            if not shortcut:
+                continue
+            command = self.get_command(command_id)
+            label = command.label if command else command_id
+            if shortcut in taken:
+                conflicts.append(f"{shortcut} is used by both {taken[shortcut]} and {label}")
+            else:
+                taken[shortcut] = label
+        return conflicts
+
+    # Maya runtime commands --------------------------------------------------------------
+
+    def register_runtime_commands(self, cmds: Any) -> List[str]:
+        """
+        Create or update the runtime commands of every manager command and the marking menu
+
+        Returns:
+            Names of the runtime commands, as listed in Maya's Hotkey Editor
+        """
+        names = []
+        for command in COMMANDS:
+            self._set_runtime_command(
+                cmds,
+                command.runtime_name,
+                command.annotation,
+                RUNTIME_COMMAND_SCRIPT.format(command_id=command.command_id),
+            )
+            names.append(command.runtime_name)
+        self._set_runtime_command(
+            cmds,
+            MARKING_MENU_PRESS,
+            "Show the Asset Manager marking menu (bind to the key press)",
+            "import maya_plugin; maya_plugin.show_marking_menu()",
+        )
+        self._set_runtime_command(
+            cmds,
+            MARKING_MENU_RELEASE,
+            "Hide the Asset Manager marking menu (bind to the key release)",
+            "import maya_plugin; maya_plugin.hide_marking_menu()",
+        )
+        names.extend([MARKING_MENU_PRESS, MARKING_MENU_RELEASE])
+        print(f"[OK] Registered {len(names)} Asset Manager runtime commands")
+        return names
+
+    def unregister_runtime_commands(self, cmds: Any) -> None:
+        """Delete the runtime commands and the marking menu when the plugin unloads"""
+        self.hide_marking_menu(cmds)
+        names = [command.runtime_name for command in COMMANDS]
+        for name in names + [MARKING_MENU_PRESS, MARKING_MENU_RELEASE]:
+            if cmds.runTimeCommand(name, exists=True):
+                cmds.runTimeCommand(name, edit=True, delete=True)
+
+    def show_marking_menu(self, cmds: Any) -> str:
+        """Build the marking menu over the viewport panes (press half of the hotkey)"""
+        self.hide_marking_menu(cmds)
+        menu = cmds.popupMenu(
+            MARKING_MENU_NAME,
+            button=1,
+            markingMenu=True,
+            allowOptionBoxes=False,
+            parent=MARKING_MENU_PARENT,
+        )
+        for command in COMMANDS:
+            options = {}
+            if command.radial_position:
+                options["radialPosition"] = command.radial_position
+            cmds.menuItem(
+                label=command.label,
+                annotation=command.annotation,
+                command=RUNTIME_COMMAND_SCRIPT.format(command_id=command.command_id),
+                sourceType="python",
+                parent=menu,
+                **options,
+            )
+        return menu
+
+    def hide_marking_menu(self, cmds: Any) -> None:
+        """Delete the marking menu (release half of the hotkey)"""
+        if cmds.popupMenu(MARKING_MENU_NAME, exists=True):
+            cmds.deleteUI(MARKING_MENU_NAME)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _set_runtime_command(self, cmds: Any, name: str, annotation: str, script: str) -> None:
+        """Create a runtime command, or point an existing one at the current script"""
+        if cmds.runTimeCommand(name, exists=True):
+            cmds.runTimeCommand(
+                name, edit=True, annotation=annotation, command=script, commandLanguage="python"
+            )
+            return
+        # Default commands are not written to the user's prefs; the plugin recreates them
+        cmds.runTimeCommand(
+            name,
+            annotation=annotation,
+            category=RUNTIME_CATEGORY,
+            command=script,
+            commandLanguage="python",
+            default=True,
+        )
+
+    def _load_overrides(self) -> Dict[str, str]:
+        """Read the artist's changed shortcuts, {} when there are none"""
+        if not self._config_file.is_file():
+            return {}
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                shortcuts = json.load(f).get("shortcuts") or {}
+            return {str(key): normalize_shortcut(value) for key, value in shortcuts.items()}
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable shortcuts {self._config_file}: {e}")
+            return {}
+
+
+# Singleton instance factory
+_hotkey_service_instance = None
+
+
+def get_hotkey_service() -> HotkeyService:
+    """
+    Get singleton instance of HotkeyService.
+
+    Returns:
+        HotkeyService: Singleton service instance
+    """
+    global _hotkey_service_instance
+    if _hotkey_service_instance is None:
+        _hotkey_service_instance = HotkeyService()
+    return _hotkey_service_instance
@@ -0,0 +1,639 @@
+# -*- coding: utf-8 -*-
+"""
+Identity Service Implementation
+Sign artists in with the studio directory or identity provider instead of the OS login
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Publish records, locks, comments, and library roles all name the artist returned by
+get_current_user. With the default "os" backend that is the workstation login, as
+before. With a sign-in backend it is the studio identity the artist proved, so
+several artists can share one workstation account::
+
+    os      Workstation login, unverified (the default)
+    ldap    LDAP / Active Directory bind with the artist's password (needs ldap3)
+    oauth   OpenID Connect device sign-in in the browser (Azure AD, Okta, Google...)
+
+Studios pick the backend for everyone with a shared settings file, which artists
+cannot change from the Asset Manager::
+
+    ASSET_MANAGER_IDENTITY_CONFIG=//server/pipeline/assetmanager/identity.json
+    {"backend": "oauth", "session_hours": 10,
+     "oauth": {"issuer": "https://login.microsoftonline.com/<tenant>/v2.0",
+               "client_id": "..."}}
+
+Other backends are added with register_backend. Passwords and tokens are never
+stored: the session file only remembers who signed in, and until when. Like the
+permissions file it is a workflow guard, not a security boundary.
+"""
+
+import getpass
+import json
+import logging
+import os
+import time
+import urllib.error
+import urllib.parse
+import urllib.request
+from dataclasses import dataclass, replace
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple, Type
+
+from ..core.models.user_identity import UserIdentity
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+IDENTITY_CONFIG_ENV = "ASSET_MANAGER_IDENTITY_CONFIG"
+
+BACKEND_OS = "os"
+BACKEND_LDAP = "ldap"
+BACKEND_OAUTH = "oauth"
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "backend": BACKEND_OS,
+    "session_hours": 12,  # 0 keeps artists signed in until they sign out
+    "sign_out_on_exit": False,  # For workstations artists take turns on
+    "ldap": {
+        "server": "",  # ldaps://dc01.studio.local
+        "bind_format": "{user}",  # {user}@studio.local (AD) or uid={user},ou=people,...
+        "base_dn": "",  # dc=studio,dc=local
+        "user_filter": "(|(sAMAccountName={user})(uid={user}))",
+    },
+    "oauth": {
+        "issuer": "",  # Discovery at <issuer>/.well-known/openid-configuration
+        "client_id": "",
+        "scopes": "openid profile email",
+        "username_claim": "preferred_username",
+        "strip_email_domain": True,  # kim@studio.com -> kim, as in permissions.json
+    },
+}
+
+DEVICE_CODE_GRANT = "urn:ietf:params:oauth:grant-type:device_code"
+REQUEST_TIMEOUT = 30  # Seconds
+
+# (status, JSON answer) of a GET (data None) or a form POST
+HttpRequest = Callable[[str, Optional[Dict[str, str]], Dict[str, str]], Tuple[int, Dict]]
+
+
+class AuthenticationError(Exception):
+    """Raised when a sign-in is refused; the message is shown to the artist"""
+
+
+def get_os_user() -> str:
+    """Get the workstation login"""
+    try:
+        return getpass.getuser()
+    except Exception:
+        return "unknown"
+
+
+def request_json(
+    url: str, data: Optional[Dict[str, str]] = None, headers: Optional[Dict[str, str]] = None
+) -> Tuple[int, Dict[str, Any]]:
+    """GET a JSON document, or POST a form; error answers are returned, not raised"""
+    body = urllib.parse.urlencode(data).encode("utf-8") if data is not None else None
+    request = urllib.request.Request(url, data=body, headers=dict(headers or {}))
+    request.add_header("Accept", "application/json")
+    try:
+        with urllib.request.urlopen(request, timeout=REQUEST_TIMEOUT) as response:
+            return response.status, json.loads(response.read().decode("utf-8") or "{}")
+    except urllib.error.HTTPError as e:
+        try:
+            return e.code, json.loads(e.read().decode("utf-8") or "{}")
+        except ValueError:
+            return e.code, {}
+    except (urllib.error.URLError, OSError) as e:
+        raise AuthenticationError(f"Cannot reach {urllib.parse.urlparse(url).netloc}: {e}")
+
+
+@dataclass
+class DeviceSignIn:
+    """A browser sign-in waiting for the artist to enter the code"""
+
+    user_code: str  # Shown to the artist
+    verification_uri: str  # Page the code is entered on
+    device_code: str
+    interval: int  # Seconds between polls
+    expires_at: float  # time.time() the code stops working
+    token_endpoint: str
+    userinfo_endpoint: str
+
+    @property
+    def is_expired(self) -> bool:
+        return time.time() >= self.expires_at
+
+
+class IdentityBackend:
+    """
+    Base of the sign-in backends
+    Password backends implement authenticate; browser backends start and poll
+    """
+
+    name = ""
+    label = ""
+    uses_password = True
+
+    def __init__(self, settings: Dict[str, Any]):
+        self.settings = settings
+
+    def authenticate(self, user_name: str, password: str) -> UserIdentity:
+        """
+        Check an artist's credentials
+
+        Raises:
+            AuthenticationError: When they are refused or the server cannot be reached
+        """
+        raise AuthenticationError(f"{self.label} does not sign in with a password")
+
+
+class LdapBackend(IdentityBackend):
+    """Bind to LDAP / Active Directory as the artist and read their directory entry"""
+
+    name = BACKEND_LDAP
+    label = "LDAP / Active Directory"
+
+    def __init__(self, settings: Dict[str, Any], connect: Optional[Callable[..., Any]] = None):
+        """
+        Args:
+            settings: The "ldap" settings
+            connect: Returns an ldap3-like Connection for (server, bind name, password)
+        """
+        super().__init__(settings)
+        self._connect = connect or self._connect_ldap3
+
+    def authenticate(self, user_name: str, password: str) -> UserIdentity:
+        user_name = user_name.strip()
+        if not user_name or not password:
+            # An empty password is an anonymous bind, which most directories accept
+            raise AuthenticationError("Enter your user name and password")
+        if not self.settings.get("server"):
+            raise AuthenticationError("No LDAP server is set in the identity settings")
+
+        bind_format = self.settings.get("bind_format", "{user}")
+        # In a DN (uid={user},ou=people,...) a comma or plus would add other components
+        bind_user = _escape_dn_value(user_name) if "=" in bind_format else user_name
+        bind_name = bind_format.format(user=bind_user)
+        connection = self._connect(self.settings["server"], bind_name, password)
+        try:
+            if not connection.bind():
+                raise AuthenticationError("The directory refused the user name or password")
+            entry: Dict[str, Any] = {}
+            if self.settings.get("base_dn"):
+                search_filter = self.settings["user_filter"].format(
+                    user=_escape_filter(user_name)
+                )
+                connection.search(
+                    self.settings["base_dn"],
+                    search_filter,
+                    attributes=["sAMAccountName", "uid", "displayName", "mail", "memberOf"],
+                )
+                for result in connection.response or []:
+                    if result.get("type", "searchResEntry") == "searchResEntry":
+                        entry = dict(result.get("attributes") or {})
+                        break
+        finally:
+            connection.unbind()
+
+        directory_name = _first(entry.get("sAMAccountName")) or _first(entry.get("uid"))
+        return UserIdentity(
+            user_name=directory_name or user_name,
+            display_name=_first(entry.get("displayName")),
+            email=_first(entry.get("mail")),
+            groups=tuple(
+                _common_name(group) for group in _as_list(entry.get("memberOf")) if group
+            ),
+            backend=self.name,
+            verified=True,
+        )
+
+    def _connect_ldap3(self, server: str, bind_name: str, password: str) -> Any:
+        """Open an ldap3 connection (not bound yet)"""
+        try:
+            import ldap3  # type: ignore
+        except ImportError:
+            raise AuthenticationError(
+                "LDAP sign-in needs the ldap3 package (mayapy -m pip install ldap3)"
+            )
+        return ldap3.Connection(
+            ldap3.Server(server, connect_timeout=REQUEST_TIMEOUT),
+            user=bind_name,
+            password=password,
+            receive_timeout=REQUEST_TIMEOUT,
+        )
+
+
+class OAuthBackend(IdentityBackend):
+    """
+    OpenID Connect device sign-in (RFC 8628)
+    The artist enters a short code on the identity provider's page in any browser
+    """
+
+    name = BACKEND_OAUTH
+    label = "OAuth / OpenID Connect"
+    uses_password = False
+
+    def __init__(self, settings: Dict[str, Any], http: Optional[HttpRequest] = None):
+        super().__init__(settings)
+        self._http = http or request_json
+
+    def start(self) -> DeviceSignIn:
+        """
+        Ask the identity provider for a sign-in code
+
+        Raises:
+            AuthenticationError: When the provider is not set up or cannot be reached
+        """
+        issuer = str(self.settings.get("issuer", "")).rstrip("/")
+        if not issuer or not self.settings.get("client_id"):
+            raise AuthenticationError("No OAuth issuer and client ID are set in the settings")
+        status, discovery = self._http(f"{issuer}/.well-known/openid-configuration", None, {})
+        endpoints = ("device_authorization_endpoint", "token_endpoint")
+        if status != 200 or not all(discovery.get(endpoint) for endpoint in endpoints):
+            raise AuthenticationError(f"{issuer} does not offer device sign-in")
+        if not discovery.get("userinfo_endpoint"):
+            # Checked before the artist is given a code they could never finish with
+            raise AuthenticationError(
+                f"{issuer} does not publish a userinfo endpoint to read your profile from"
+            )
+
+        status, answer = self._http(
+            discovery["device_authorization_endpoint"],
+            {"client_id": self.settings["client_id"], "scope": self.settings["scopes"]},
+            {},
+        )
+        if status != 200 or not answer.get("device_code"):
+            raise AuthenticationError(_describe_error(answer, "The sign-in code was refused"))
+        return DeviceSignIn(
+            user_code=answer.get("user_code", ""),
+            verification_uri=answer.get("verification_uri_complete")
+            or answer.get("verification_uri")
+            or answer.get("verification_url", ""),
+            device_code=answer["device_code"],
+            interval=int(answer.get("interval") or 5),
+            expires_at=time.time() + int(answer.get("expires_in") or 900),
+            token_endpoint=discovery["token_endpoint"],
+            userinfo_endpoint=discovery["userinfo_endpoint"],
+        )
+
+    def poll(self, sign_in: DeviceSignIn) -> Optional[UserIdentity]:
+        """
+        Check if the artist entered the code
+
+        Returns:
+            The identity once signed in, None while the provider is still waiting
+
+        Raises:
+            AuthenticationError: When the sign-in was declined or the code expired
+        """
+        if sign_in.is_expired:
+            raise AuthenticationError("The sign-in code expired, start again")
+        status, answer = self._http(
+            sign_in.token_endpoint,
+            {
+                "grant_type": DEVICE_CODE_GRANT,
+                "device_code": sign_in.device_code,
+                "client_id": self.settings["client_id"],
+            },
+            {},
+        )
+        error = answer.get("error", "")
+        if error == "authorization_pending":
+            return None
+        if error == "slow_down":
+            sign_in.interval += 5
+            return None
+        if status != 200 or not answer.get("access_token"):
+            raise AuthenticationError(_describe_error(answer, "The sign-in was declined"))
+
+        status, claims = self._http(
+            sign_in.userinfo_endpoint,
+            None,
+            {"Authorization": f"Bearer {answer['access_token']}"},
+        )
+        if status != 200:
+            raise AuthenticationError("Could not read your profile from the identity provider")
+        user_name = str(claims.get(self.settings.get("username_claim") or "sub") or "")
+        if not user_name:
+            raise AuthenticationError("The identity provider did not send a user name")
+        if self.settings.get("strip_email_domain", True):
+            user_name = user_name.split("@", 1)[0]
+        return UserIdentity(
+            user_name=user_name,
+            display_name=str(claims.get("name") or ""),
+            email=str(claims.get("email") or ""),
+            groups=tuple(str(group) for group in _as_list(claims.get("groups"))),
+            backend=self.name,
+            verified=True,
+        )
+
+
+# Backend name -> class; studios add theirs with register_backend
+_BACKENDS: Dict[str, Type[IdentityBackend]] = {
+    BACKEND_LDAP: LdapBackend,
+    BACKEND_OAUTH: OAuthBackend,
+}
+
+
+def register_backend(backend_class: Type[IdentityBackend]) -> None:
+    """Offer a studio sign-in backend, chosen with its name in the settings"""
+    if not backend_class.name or backend_class.name == BACKEND_OS:
+        raise ValueError("A sign-in backend needs a name other than 'os'")
+    _BACKENDS[backend_class.name] = backend_class
+
+
+def get_backend_labels() -> Dict[str, str]:
+    """Get the backends that can be chosen, by name"""
+    labels = {BACKEND_OS: "Workstation login"}
+    labels.update({name: backend.label or name for name, backend in _BACKENDS.items()})
+    return labels
+
+
+class IdentityService:
+    """
+    Identity Service - Single Responsibility for who the artist using the library is
+    Sign-ins are cached in a session file until they expire or the artist signs out
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        session_file: Optional[Path] = None,
+        backend_factory: Optional[Callable[[str, Dict[str, Any]], IdentityBackend]] = None,
+    ):
+        """
+        Args:
+            config_file: Artist settings, used when no studio settings file is set
+            session_file: Who is signed in on this workstation account
+            backend_factory: Creates the backend for (name, settings), for tests
+        """
+        self.logger = logging.getLogger(__name__)
+        self._user_config_file = config_file or USER_CONFIG_DIR / "identity.json"
+        self._session_file = session_file or USER_CONFIG_DIR / "identity_session.json"
+        self._backend_factory = backend_factory or self._create_backend
+        self._config: Dict[str, Any] = self._load_config()
+        self._session: Optional[UserIdentity] = self._load_session()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_studio_config_file(self) -> Optional[Path]:
+        """Get the shared settings file set by the studio, None if artists choose"""
+        configured = os.environ.get(IDENTITY_CONFIG_ENV, "").strip()
+        return Path(configured) if configured else None
+
+    def is_managed(self) -> bool:
+        """Check if the studio settings file decides the backend"""
+        return self.get_studio_config_file() is not None
+
+    def get_config(self) -> Dict[str, Any]:
+        """Get a copy of the settings"""
+        return json.loads(json.dumps(self._config))
+
+    def set_config(self, **values: Any) -> None:
+        """Update settings (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown identity settings: {', '.join(sorted(unknown))}")
+        if "backend" in values and values["backend"] not in get_backend_labels():
+            raise ValueError(f"Unknown sign-in backend: {values['backend']}")
+        for key, value in values.items():
+            if isinstance(DEFAULT_CONFIG[key], dict):
+                self._config[key] = dict(self._config[key], **(value or {}))
+            else:
+                self._config[key] = value
+
+    def save_config(self) -> bool:
+        """Write the artist's settings; studio settings are never written"""
+        if self.is_managed():
+            print("[WARNING] Identity settings are set by the studio and were not saved")
+            return False
+        try:
+            self._user_config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._user_config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save identity settings: {e}")
+            return False
+
+    def reload(self) -> None:
+        """Read the settings and the session again (after switching settings files)"""
+        self._config = self._load_config()
+        self._session = self._load_session()
+
+    def get_backend_name(self) -> str:
+        """Get the configured backend name"""
+        return str(self._config.get("backend") or BACKEND_OS)
+
+    def get_backend(self) -> Optional[IdentityBackend]:
+        """Get the sign-in backend, None for workstation logins"""
+        name = self.get_backend_name()
+        if name == BACKEND_OS:
+            return None
+        return self._backend_factory(name, dict(self._config.get(name) or {}))
+
+    # Identity ---------------------------------------------------------------------------
+
+    def get_identity(self) -> UserIdentity:
+        """Get the signed-in artist, or the workstation login when nobody is"""
+        session = self._get_valid_session()
+        if session is not None:
+            return session
+        return UserIdentity(user_name=get_os_user(), os_user=get_os_user())
+
+    def get_user_name(self) -> str:
+        """Get the name publish records, locks, and roles use"""
+        return self.get_identity().user_name
+
+    def requires_sign_in(self) -> bool:
+        """Check if the artist must sign in before changing libraries"""
+        return self.get_backend_name() != BACKEND_OS and self._get_valid_session() is None
+
+    # Sign in ----------------------------------------------------------------------------
+
+    def sign_in(self, user_name: str, password: str) -> UserIdentity:
+        """
+        Sign in with a password backend (LDAP, studio backends)
+
+        Raises:
+            AuthenticationError: When the backend refuses the credentials
+        """
+        backend = self.get_backend()
+        if backend is None:
+            raise AuthenticationError("Sign-in is not set up - choose a backend first")
+        return self._start_session(backend.authenticate(user_name, password))
+
+    def start_browser_sign_in(self) -> DeviceSignIn:
+        """
+        Start a browser sign-in (OAuth)
+
+        Raises:
+            AuthenticationError: When the backend has no browser sign-in
+        """
+        backend = self.get_backend()
+        if backend is None or backend.uses_password:
+            raise AuthenticationError("This sign-in backend uses a password")
+        return backend.start()
+
+    def finish_browser_sign_in(self, sign_in: DeviceSignIn) -> Optional[UserIdentity]:
+        """
+        Check a browser sign-in, starting the session once the artist completed it
+
+        Returns:
+            The signed-in identity, None while the artist has not entered the code
+        """
+        backend = self.get_backend()
+        if backend is None or backend.uses_password:
+            raise AuthenticationError("This sign-in backend uses a password")
+        identity = backend.poll(sign_in)
+        return self._start_session(identity) if identity is not None else None
+
+    def sign_out(self) -> Optional[UserIdentity]:
+        """End the session, returning who was signed in"""
+        previous = self._session
+        self._session = None
+        try:
+            self._session_file.unlink()
+        except FileNotFoundError:
+            pass
+        except OSError as e:
+            self.logger.error(f"Failed to remove the identity session: {e}")
+        if previous is not None:
+            print(f"[INFO] Signed out {previous.user_name}")
+        return previous
+
+    def on_exit(self) -> None:
+        """Sign out when Maya closes, if the settings say so"""
+        if self._config.get("sign_out_on_exit") and self._session is not None:
+            self.sign_out()
+
+    # Session ----------------------------------------------------------------------------
+
+    def _start_session(self, identity: UserIdentity) -> UserIdentity:
+        """Remember a verified sign-in for the session length"""
+        now = datetime.now()
+        hours = float(self._config.get("session_hours") or 0)
+        identity = replace(
+            identity,
+            backend=self.get_backend_name(),
+            signed_in=now.isoformat(timespec="seconds"),
+            expires=(now + timedelta(hours=hours)).isoformat(timespec="seconds")
+            if hours
+            else "",
+            verified=True,
+            os_user=get_os_user(),
+        )
+        self._session = identity
+        try:
+            self._session_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._session_file, "w", encoding="utf-8") as f:
+                json.dump(identity.to_dict(), f, indent=2)
+            os.chmod(self._session_file, 0o600)
+        except Exception as e:
+            self.logger.error(f"Failed to save the identity session: {e}")
+        print(f"[OK] Signed in as {identity.label} ({identity.backend})")
+        return identity
+
+    def _get_valid_session(self) -> Optional[UserIdentity]:
+        """Get the session if it is from the configured backend and has not ended"""
+        session = self._session
+        if session is None or session.backend != self.get_backend_name():
+            return None
+        if session.is_expired() or not session.verified:
+            return None
+        return session
+
+    def _load_session(self) -> Optional[UserIdentity]:
+        """Read the session file"""
+        try:
+            with open(self._session_file, "r", encoding="utf-8") as f:
+                return UserIdentity.from_dict(json.load(f))
+        except FileNotFoundError:
+            return None
+        except Exception as e:
+            self.logger.error(f"Unreadable identity session, signing out: {e}")
+            return None
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read the studio settings file, or the artist's"""
+        config = json.loads(json.dumps(DEFAULT_CONFIG))
+        config_file = self.get_studio_config_file() or self._user_config_file
+        if not config_file.exists():
+            if self.is_managed():
+                print(f"[WARNING] Studio identity settings not found: {config_file}")
+            return config
+        try:
+            with open(config_file, "r", encoding="utf-8") as f:
+                stored = json.load(f)
+            for key, value in stored.items():
+                if key not in config:
+                    continue
+                if isinstance(config[key], dict):
+                    config[key].update(value or {})
+                else:
+                    config[key] = value
+        except Exception as e:
+            self.logger.error(f"Failed to load identity settings {config_file}: {e}")
+        return config
+
+    def _create_backend(self, name: str, settings: Dict[str, Any]) -> IdentityBackend:
+        """Create a registered backend with its settings"""
+        backend_class = _BACKENDS.get(name)
+        if backend_class is None:
+            raise AuthenticationError(f"Unknown sign-in backend '{name}'")
+        return backend_class(settings)
+
+
+def _first(value: Any) -> str:
+    """Get the first value of an LDAP attribute (they can be lists)"""
+    values = _as_list(value)
+    return str(values[0]) if values else ""
+
+
+def _as_list(value: Any) -> List[Any]:
+    """Get an attribute or claim as a list"""
+    if value is None or value == "":
+        return []
+    return list(value) if isinstance(value, (list, tuple)) else [value]
+
+
+def _common_name(distinguished_name: str) -> str:
+    """Get a group's name from its DN: CN=Rigging,OU=Groups,DC=studio -> Rigging"""
+    first = str(distinguished_name).split(",", 1)[0]
+    return first.split("=", 1)[1] if first.upper().startswith("CN=") else first
+
+
+def _escape_filter(value: str) -> str:
+    """Escape an LDAP search filter value (RFC 4515)"""
+    for character in ("\\", "*", "(", ")", "\0"):
+        value = value.replace(character, f"\\{ord(character):02x}")
+    return value
+
+
+def _escape_dn_value(value: str) -> str:
+    """Escape an attribute value of an LDAP distinguished name (RFC 4514)"""
+    for character in ("\\", ",", "+", '"', "<", ">", ";", "="):
+        value = value.replace(character, f"\\{character}")
+    return f"\\{value}" if value.startswith("#") else value
+
+
+def _describe_error(answer: Dict[str, Any], fallback: str) -> str:
+    """Get the identity provider's error description"""
+    return str(answer.get("error_description") or answer.get("error") or fallback)
+
+
+# Singleton instance factory
+_identity_service_instance = None
+
+
+def get_identity_service() -> IdentityService:
+    """
+    Get singleton instance of IdentityService.
+
+    Returns:
+        IdentityService: Singleton service instance
+    """
+    global _identity_service_instance
+    if _identity_service_instance is None:
+        _identity_service_instance = IdentityService()
+    return _identity_service_instance
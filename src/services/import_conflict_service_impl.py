@@ -0,0 +1,207 @@
+# -*- coding: utf-8 -*-
+"""
+Import Conflict Service Implementation
+Find the nodes of an asset whose names are taken in the scene before it is imported
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Maya renames clashing nodes on import without a word, which breaks constraints and
+scripts looking nodes up by name. Maya ASCII files are read as text; other files are
+imported once into a scratch namespace that is deleted again. Only nodes without a
+parent can clash, since DAG children follow their parent's new name::
+
+    createNode transform -n "crate";            <- clashes with the scene's |crate
+    createNode mesh -n "crateShape" -p "crate"; <- moves along with crate
+
+The artist then imports into a namespace of its own, gives the clashing nodes a free
+name (crate -> crate_1), skips the asset, or stops the import.
+"""
+
+import logging
+import re
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.import_conflict import ImportConflictReport, NameConflict
+from .maya_integration_impl import make_unique_namespace, sanitize_namespace
+from .namespace_service_impl import ROOT_NAMESPACE, get_namespace_service
+
+# Scene formats checked before import; caches and USD stages load their own way
+CHECKED_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary", ".fbx": "FBX", ".obj": "OBJ"}
+
+PROBE_NAMESPACE = "assetManagerProbe"
+
+# Scene bookkeeping every file carries; Maya merges or renames these harmlessly
+IGNORED_NODE_TYPES = {
+    "displayLayerManager",
+    "lightLinker",
+    "nodeGraphEditorInfo",
+    "poseInterpolatorManager",
+    "renderLayerManager",
+    "script",
+    "sequenceManager",
+    "shapeEditorManager",
+}
+IGNORED_NODE_NAMES = {"defaultLayer", "defaultRenderLayer"}
+
+_CREATE_NODE = re.compile(r"^\s*createNode\s+(\w+)\s+(.*?);\s*$")
+_NAME_FLAG = re.compile(r'-n\s+"([^"]+)"')
+_PARENT_FLAG = re.compile(r'-p\s+"([^"]+)"')
+_SHARED_FLAG = re.compile(r"(^|\s)-s(\s|$)")
+
+
+def _list_namespace_names(cmds: Any) -> List[str]:
+    """Get the scene's namespaces as relative names (a, a:b)"""
+    return [ns.lstrip(ROOT_NAMESPACE) for ns in get_namespace_service().list_namespaces(cmds)]
+
+
+class ImportConflictService:
+    """
+    Import Conflict Service - Single Responsibility for import name clash checks
+    Scene access goes through the cmds argument so checks can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Asset nodes ------------------------------------------------------------------------
+
+    def read_ascii_nodes(self, file_path: Path) -> List[Tuple[str, str]]:
+        """Get the (name, type) of the parentless nodes a Maya ASCII file creates"""
+        nodes: List[Tuple[str, str]] = []
+        with open(file_path, "r", encoding="utf-8", errors="replace") as f:
+            for line in f:
+                match = _CREATE_NODE.match(line)
+                if match is None:
+                    continue
+                node_type, flags = match.groups()
+                name = _NAME_FLAG.search(flags)
+                # Shared nodes (the default cameras) merge with the scene's own
+                if name is None or _PARENT_FLAG.search(flags) or _SHARED_FLAG.search(flags):
+                    continue
+                nodes.append((name.group(1), node_type))
+        return nodes
+
+    def probe_nodes(self, cmds: Any, file_path: Path) -> List[Tuple[str, str]]:
+        """Get the (name, type) of a file's parentless nodes by importing it aside"""
+        file_type = CHECKED_FILE_TYPES[file_path.suffix.lower()]
+        probe = make_unique_namespace(PROBE_NAMESPACE, _list_namespace_names(cmds))
+        nodes: List[Tuple[str, str]] = []
+        try:
+            new_nodes = cmds.file(
+                str(file_path), i=True, type=file_type, namespace=probe, returnNewNodes=True
+            )
+            for node in new_nodes or []:
+                if cmds.listRelatives(node, parent=True):
+                    continue
+                name = node.lstrip("|").split(f"{probe}{ROOT_NAMESPACE}", 1)[-1]
+                nodes.append((name, cmds.nodeType(node)))
+        finally:
+            if cmds.namespace(exists=probe):
+                cmds.namespace(removeNamespace=probe, deleteNamespaceContent=True)
+        return nodes
+
+    # Conflicts --------------------------------------------------------------------------
+
+    def check_import(self, cmds: Any, file_path: Path) -> ImportConflictReport:
+        """
+        Find the nodes of an asset Maya would rename when importing it into the scene
+
+        Returns:
+            Report of the clashing nodes; empty for formats that are not checked or when
+            the file cannot be read
+        """
+        file_path = Path(file_path)
+        if file_path.suffix.lower() not in CHECKED_FILE_TYPES:
+            return ImportConflictReport(file_path)
+        try:
+            if file_path.suffix.lower() == ".ma":
+                nodes = self.read_ascii_nodes(file_path)
+            else:
+                nodes = self.probe_nodes(cmds, file_path)
+        except Exception as e:
+            print(f"[WARNING] Could not check {file_path.name} for name clashes: {e}")
+            return ImportConflictReport(file_path)
+
+        conflicts: List[NameConflict] = []
+        for name, node_type in nodes:
+            if node_type in IGNORED_NODE_TYPES or name in IGNORED_NODE_NAMES:
+                continue
+            scene_node = self.find_scene_node(cmds, name)
+            if scene_node is not None and name not in [c.name for c in conflicts]:
+                conflicts.append(NameConflict(name, node_type, scene_node))
+        return ImportConflictReport(file_path, tuple(conflicts))
+
+    def find_scene_node(self, cmds: Any, name: str) -> Optional[str]:
+        """Get the scene node a parentless node named so would clash with, if any"""
+        for node in cmds.ls(name, long=True) or []:
+            # Nodes deeper in the DAG may share the short name
+            if node in (name, f"|{name}"):
+                return node
+        return None
+
+    # Resolution -------------------------------------------------------------------------
+
+    def get_import_namespace(self, cmds: Any, asset_name: str) -> str:
+        """Get a namespace named after the asset that the scene does not have yet"""
+        return make_unique_namespace(sanitize_namespace(asset_name), _list_namespace_names(cmds))
+
+    def get_free_name(self, cmds: Any, name: str, namespace: str) -> str:
+        """Get name_1, name_2... free both in the scene and in the import's namespace"""
+        counter = 1
+        while True:
+            candidate = f"{name}_{counter}"
+            taken_in_namespace = cmds.objExists(f"{ROOT_NAMESPACE}{namespace}:{candidate}")
+            if self.find_scene_node(cmds, candidate) is None and not taken_in_namespace:
+                return candidate
+            counter += 1
+
+    def rename_conflicts(
+        self, cmds: Any, namespace: str, report: ImportConflictReport
+    ) -> Dict[str, str]:
+        """
+        Give the clashing nodes of an import free names, then move it into the root
+
+        Args:
+            cmds: maya.cmds module
+            namespace: Namespace the asset was imported into
+            report: check_import result from before the import
+
+        Returns:
+            Clashing name -> the name the node now has
+        """
+        renamed: Dict[str, str] = {}
+        for name in report.names:
+            node = f"{ROOT_NAMESPACE}{namespace}:{name}"
+            if not cmds.objExists(node):
+                continue
+            free_name = self.get_free_name(cmds, name, namespace)
+            try:
+                cmds.rename(node, f"{ROOT_NAMESPACE}{namespace}:{free_name}")
+                renamed[name] = free_name
+            except Exception as e:
+                self.logger.warning(f"Could not rename {node}: {e}")
+        get_namespace_service().merge_namespaces(
+            cmds, [f"{ROOT_NAMESPACE}{namespace}"], ROOT_NAMESPACE
+        )
+        if renamed:
+            print(f"[OK] Renamed {len(renamed)} clashing node(s) of {report.asset_file.name}")
+        return renamed
+
+
+# Singleton instance factory
+_import_conflict_service_instance = None
+
+
+def get_import_conflict_service() -> ImportConflictService:
+    """
+    Get singleton instance of ImportConflictService.
+
+    Returns:
+        ImportConflictService: Singleton service instance
+    """
+    global _import_conflict_service_instance
+    if _import_conflict_service_instance is None:
+        _import_conflict_service_instance = ImportConflictService()
+    return _import_conflict_service_instance
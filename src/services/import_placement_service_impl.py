@@ -0,0 +1,198 @@
+# -*- coding: utf-8 -*-
+"""
+Import Placement Service Implementation
+Move imported assets to the origin, the selection, or the camera focus and group them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The target point is read just before the import, so the selection and camera are the
+artist's and not what the import left behind. The top-level nodes the import added
+move as one, the bottom centre of their bounding box landing on the target, then
+snap to the ground plane and go under the named group, which is created on first use::
+
+    |set_dress                 <- group name, shared by every import into it
+        |crate_grp             <- first import
+        |barrel_grp            <- second import, at the camera focus
+
+The options are per artist, in ~/.assetmanager/import_placement.json.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, List, Optional, Tuple
+
+from ..core.models.import_placement_options import (
+    PLACE_CAMERA_FOCUS,
+    PLACE_ORIGIN,
+    PLACE_SELECTION,
+    ImportPlacementOptions,
+)
+from .maya_integration_impl import sanitize_namespace
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+Vector = Tuple[float, float, float]
+
+
+class ImportPlacementService:
+    """
+    Import Placement Service - Single Responsibility for where imports land
+    Scene access goes through the cmds argument so placement can be tested without Maya
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "import_placement.json"
+
+    # Configuration ----------------------------------------------------------------------
+
+    def load_options(self) -> ImportPlacementOptions:
+        """Get the artist's import placement options, placing as authored by default"""
+        if not self._config_file.is_file():
+            return ImportPlacementOptions()
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                return ImportPlacementOptions.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable placement options {self._config_file}: {e}")
+            return ImportPlacementOptions()
+
+    def save_options(self, options: ImportPlacementOptions) -> bool:
+        """Write the artist's import placement options"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(options.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save placement options: {e}")
+            return False
+
+    # Scene ------------------------------------------------------------------------------
+
+    def list_roots(self, cmds: Any) -> List[str]:
+        """Get the scene's top-level nodes as long names"""
+        return list(cmds.ls(assemblies=True, long=True) or [])
+
+    def get_target_position(
+        self, cmds: Any, options: ImportPlacementOptions
+    ) -> Optional[Vector]:
+        """
+        Get the point an import is placed on, read before importing
+
+        Args:
+            cmds: maya.cmds module
+            options: Placement to apply
+
+        Returns:
+            World space point, None to leave the import where the file has it
+        """
+        if options.mode == PLACE_ORIGIN:
+            return (0.0, 0.0, 0.0)
+        if options.mode == PLACE_SELECTION:
+            return self._get_selection_pivot(cmds)
+        if options.mode == PLACE_CAMERA_FOCUS:
+            return self._get_camera_focus(cmds)
+        return None
+
+    def _get_selection_pivot(self, cmds: Any) -> Optional[Vector]:
+        """Get the average world pivot of the selected transforms"""
+        selected = cmds.ls(selection=True, type="transform", long=True) or []
+        if not selected:
+            print("[WARNING] Nothing selected to place the import at, leaving it as authored")
+            return None
+        pivots = [
+            cmds.xform(node, query=True, worldSpace=True, rotatePivot=True) for node in selected
+        ]
+        return tuple(sum(pivot[axis] for pivot in pivots) / len(pivots) for axis in range(3))
+
+    def _get_camera_focus(self, cmds: Any) -> Optional[Vector]:
+        """Get the centre of interest of the camera in the focused (or first visible) viewport"""
+        panel = cmds.getPanel(withFocus=True)
+        if not panel or cmds.getPanel(typeOf=panel) != "modelPanel":
+            panels = [
+                visible
+                for visible in cmds.getPanel(visiblePanels=True) or []
+                if cmds.getPanel(typeOf=visible) == "modelPanel"
+            ]
+            if not panels:
+                print("[WARNING] No viewport to place the import in, leaving it as authored")
+                return None
+            panel = panels[0]
+        camera = cmds.modelPanel(panel, query=True, camera=True)
+        focus = cmds.camera(camera, query=True, worldCenterOfInterest=True)
+        return (float(focus[0]), float(focus[1]), float(focus[2]))
+
+    def place(
+        self,
+        cmds: Any,
+        options: ImportPlacementOptions,
+        roots: List[str],
+        asset_name: str,
+        target: Optional[Vector] = None,
+    ) -> List[str]:
+        """
+        Move the top-level nodes an import added and group them as the options say
+
+        Args:
+            cmds: maya.cmds module
+            options: Placement to apply
+            roots: Top-level nodes the import added
+            asset_name: Imported asset, filling {asset} in the group name
+            target: get_target_position result from just before the import
+
+        Returns:
+            The placed nodes, renamed by grouping
+        """
+        roots = [root for root in roots if cmds.objExists(root)]
+        if not roots:
+            return []
+
+        if target is not None:
+            x_min, y_min, z_min, x_max, _, z_max = cmds.exactWorldBoundingBox(*roots)
+            cmds.move(
+                target[0] - (x_min + x_max) / 2.0,
+                target[1] - y_min,
+                target[2] - (z_min + z_max) / 2.0,
+                *roots,
+                relative=True,
+                worldSpace=True,
+            )
+        if options.snap_to_ground:
+            y_min = cmds.exactWorldBoundingBox(*roots)[1]
+            cmds.move(0.0, -y_min, 0.0, *roots, relative=True, worldSpace=True)
+
+        group_name = options.group_for(sanitize_namespace(asset_name))
+        if group_name:
+            roots = self._group_under(cmds, roots, sanitize_namespace(group_name))
+        print(f"[OK] {options.label} for {asset_name}")
+        return roots
+
+    def _group_under(self, cmds: Any, roots: List[str], group_name: str) -> List[str]:
+        """Parent nodes under a top-level group, creating it when the scene has none"""
+        existing = [
+            node for node in cmds.ls(group_name, long=True) or [] if node.count("|") == 1
+        ]
+        if existing:
+            return list(cmds.parent(*roots, existing[0]) or [])
+        group = cmds.group(*roots, name=group_name, world=True)
+        return list(cmds.listRelatives(group, children=True, fullPath=True) or [])
+
+
+# Singleton instance factory
+_import_placement_service_instance = None
+
+
+def get_import_placement_service() -> ImportPlacementService:
+    """
+    Get singleton instance of ImportPlacementService.
+
+    Returns:
+        ImportPlacementService: Singleton service instance
+    """
+    global _import_placement_service_instance
+    if _import_placement_service_instance is None:
+        _import_placement_service_instance = ImportPlacementService()
+    return _import_placement_service_instance
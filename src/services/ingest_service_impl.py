@@ -0,0 +1,447 @@
+# -*- coding: utf-8 -*-
+"""
+Ingest Service Implementation
+Watch drop folders and ingest new OBJ/FBX/image exports into the library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Artists point ZBrush or Marvelous Designer exports at a watch folder; every file that
+is new or changed since the last look, and no longer being written, becomes a library
+asset - or a new version of the asset it names::
+
+    ~/.assetmanager/watch_folders.json
+    {
+      "enabled": true,
+      "folders": [{"path": "D:/exports/zbrush", "category": "Characters",
+                   "name_pattern": "{assetName}_v{version}", "flag_review": true}]
+    }
+
+    D:/exports/zbrush/ogre_v004.fbx  ->  assets/models/ogre.fbx (next version)
+
+Name patterns use {assetName}, {type} (the asset type, overriding the folder's), and
+{version}, with * matching anything. Without a pattern the file name is the asset name,
+less a trailing version (_v004). Files already in a folder when it is added are left
+alone unless asked for.
+"""
+
+import json
+import logging
+import os
+import re
+import shutil
+import time
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.asset_status import STATUS_PENDING_REVIEW
+from ..core.models.watch_folder import IMAGE_EXTENSIONS, IngestResult, WatchFolder
+from .version_service_impl import get_current_user
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+ASSETS_DIR_NAME = "assets"
+INGEST_METADATA_KEY = "ingest"
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "enabled": False,
+    "interval_seconds": 15,  # Between looks at the folders
+    "settle_seconds": 5,  # Files changed more recently are still being written
+    "folders": [],
+}
+
+# Name pattern tokens and what they match in a file name
+PATTERN_TOKENS = {
+    "{assetName}": r"(?P<assetName>.+?)",
+    "{type}": r"(?P<type>[^_.\- ]+)",
+    "{version}": r"(?P<version>\d+)",
+    "*": r".*?",
+}
+TRAILING_VERSION = re.compile(r"^(?P<assetName>.+?)[_.\- ]v(?P<version>\d+)$", re.IGNORECASE)
+
+
+def compile_name_pattern(pattern: str) -> "re.Pattern[str]":
+    """
+    Turn a watch folder name pattern into a regular expression for file names
+
+    Raises:
+        ValueError: If the pattern has no {assetName}
+    """
+    if "{assetName}" not in pattern:
+        raise ValueError(f"Name pattern {pattern!r} needs {{assetName}}")
+    parts = re.split(r"(\{assetName\}|\{type\}|\{version\}|\*)", pattern)
+    expression = "".join(PATTERN_TOKENS.get(part, re.escape(part)) for part in parts)
+    return re.compile(f"^{expression}$", re.IGNORECASE)
+
+
+def parse_ingest_name(file_name: str, pattern: str = "") -> Optional[Tuple[str, str, int]]:
+    """
+    Read the asset a dropped file belongs to out of its name
+
+    Args:
+        file_name: Dropped file's name (ogre_v004.fbx)
+        pattern: Watch folder name pattern, "" for the name less a trailing version
+
+    Returns:
+        (asset name, asset type or "", version or 0), None if the name does not match
+    """
+    stem = Path(file_name).stem
+    match = compile_name_pattern(pattern).match(stem) if pattern else TRAILING_VERSION.match(stem)
+    if pattern and match is None:
+        return None
+    values = match.groupdict() if match else {"assetName": stem}
+    name = "".join(c for c in values["assetName"] if c.isalnum() or c in (" ", "-", "_"))
+    name = name.strip(" -_")
+    if not name:
+        return None
+    return name, values.get("type") or "", int(values.get("version") or 0)
+
+
+class IngestService:
+    """
+    Ingest Service - Single Responsibility for turning dropped files into library assets
+    Files are remembered by modification time and size, so a re-export ingests again
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        version_service: Any = None,
+        lock_service: Any = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "watch_folders.json"
+        self._state_file = self._config_file.with_name("watch_folders_seen.json")
+        self._version_service = version_service
+        self._lock_service = lock_service
+        self._config: Dict[str, Any] = self._load_config()
+        self._seen: Dict[str, str] = self._load_seen()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self) -> Dict[str, Any]:
+        """Get a copy of the watch folder configuration"""
+        config = dict(self._config)
+        config["folders"] = [dict(folder) for folder in self._config["folders"]]
+        return config
+
+    def set_config(self, **values: Any) -> None:
+        """
+        Update configuration values
+
+        Raises:
+            ValueError: If a key is unknown or a folder's name pattern is invalid
+        """
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown watch folder settings: {', '.join(sorted(unknown))}")
+        if "folders" in values:
+            values["folders"] = [self._to_folder(folder).to_dict() for folder in values["folders"]]
+        self._config.update(values)
+
+    def get_folders(self) -> List[WatchFolder]:
+        """Get the configured watch folders"""
+        return [WatchFolder.from_dict(folder) for folder in self._config["folders"]]
+
+    def add_folder(self, folder: WatchFolder, ingest_existing: bool = False) -> None:
+        """
+        Add a watch folder, or replace the one with the same path
+
+        Args:
+            folder: Folder and its ingest rules
+            ingest_existing: Also ingest the files already in the folder, else only
+                files dropped from now on are
+        """
+        self._to_folder(folder)  # Validates the name pattern
+        folders = [f for f in self.get_folders() if Path(f.path) != Path(folder.path)]
+        self.set_config(folders=folders + [folder])
+        if not ingest_existing:
+            for file_path in self._list_files(folder):
+                self._seen[self._file_key(file_path)] = self._signature(file_path)
+            self._save_seen()
+
+    def remove_folder(self, path: str) -> bool:
+        """Stop watching a folder, True if it was watched"""
+        folders = self.get_folders()
+        kept = [folder for folder in folders if Path(folder.path) != Path(path)]
+        self.set_config(folders=kept)
+        return len(kept) != len(folders)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save watch folder settings: {e}")
+            return False
+
+    # Watching ---------------------------------------------------------------------------
+
+    def find_ready_files(self, now: Optional[float] = None) -> List[Tuple[WatchFolder, Path]]:
+        """
+        Get the dropped files waiting to be ingested
+
+        Args:
+            now: Current time, for files still being written
+
+        Returns:
+            (watch folder, file) of new or changed files that stopped changing
+        """
+        now = time.time() if now is None else now
+        settle = float(self._config.get("settle_seconds", 5))
+        ready: List[Tuple[WatchFolder, Path]] = []
+        for folder in self.get_folders():
+            if not folder.enabled:
+                continue
+            for file_path in self._list_files(folder):
+                try:
+                    if now - file_path.stat().st_mtime < settle:
+                        continue  # Still being exported
+                except OSError:
+                    continue
+                if self._seen.get(self._file_key(file_path)) != self._signature(file_path):
+                    ready.append((folder, file_path))
+        return ready
+
+    def scan(
+        self, library_root: Optional[Path], database: Any = None, now: Optional[float] = None
+    ) -> List[IngestResult]:
+        """
+        Ingest every ready file of the watch folders
+
+        Args:
+            library_root: Library the files are ingested into
+            database: Library metadata database for asset types and review flags
+            now: Current time, for files still being written
+
+        Returns:
+            One result per file, failed ones included; [] while watching is off
+        """
+        if not self._config.get("enabled") or library_root is None:
+            return []
+        results = []
+        for folder, file_path in self.find_ready_files(now):
+            signature = self._signature(file_path)
+            results.append(self.ingest_file(library_root, folder, file_path, database))
+            # Failed files wait for the next export instead of failing on every look
+            self._seen[self._file_key(file_path)] = signature
+        if results:
+            self._save_seen()
+        return results
+
+    # Ingesting --------------------------------------------------------------------------
+
+    def get_target_path(self, library_root: Path, asset_name: str, extension: str) -> Path:
+        """Get the library file an ingested asset is written to (as Add Asset files it)"""
+        extension = extension.lower()
+        subfolder = "textures" if extension in IMAGE_EXTENSIONS else "models"
+        return Path(library_root) / ASSETS_DIR_NAME / subfolder / f"{asset_name}{extension}"
+
+    def ingest_file(
+        self, library_root: Path, folder: WatchFolder, source: Path, database: Any = None
+    ) -> IngestResult:
+        """
+        Copy a dropped file into the library as a new asset or a new version
+
+        Args:
+            library_root: Library the file is ingested into
+            folder: Watch folder the file was dropped in
+            source: Dropped file
+            database: Library metadata database; without one no type or review is stored
+
+        Returns:
+            Result naming the asset file, or the reason the file was not ingested
+        """
+        source = Path(source)
+        parsed = parse_ingest_name(source.name, folder.name_pattern)
+        if parsed is None:
+            return IngestResult(source=source, error="Name does not match the folder's pattern")
+        asset_name, asset_type, _version = parsed
+        target = self.get_target_path(library_root, asset_name, source.suffix)
+        is_new = not target.exists()
+        if not is_new and not self._get_lock_service().can_publish(target):
+            lock = self._get_lock_service().get_lock(target)
+            owner = lock.user if lock else "another artist"
+            return IngestResult(source=source, is_new_asset=False, error=f"Checked out by {owner}")
+
+        version_service = self._get_version_service()
+        try:
+            target.parent.mkdir(parents=True, exist_ok=True)
+            if not is_new and not version_service.get_versions(target):
+                version_service.publish_version(target, notes="Baseline (pre-versioning)")
+            # Copy next to the asset first, so it is never half written
+            partial = target.with_name(f".{target.name}.ingest")
+            shutil.copy2(source, partial)
+            os.replace(partial, target)
+        except Exception as e:
+            return IngestResult(source=source, is_new_asset=is_new, error=f"Copy failed: {e}")
+
+        version = version_service.publish_version(
+            target, notes=f"Ingested from {folder.label}/{source.name}"
+        )
+        flagged = False
+        if database is not None:
+            flagged = self._store_metadata(
+                database, library_root, target, folder, source, asset_type or folder.category
+            )
+        result = IngestResult(
+            source=source,
+            asset_file=target,
+            version=version.number if version else 0,
+            is_new_asset=is_new,
+            flagged=flagged,
+        )
+        print(f"[OK] Ingested {result.summary}")
+        return result
+
+    def _store_metadata(
+        self,
+        database: Any,
+        library_root: Path,
+        asset_file: Path,
+        folder: WatchFolder,
+        source: Path,
+        asset_type: str,
+    ) -> bool:
+        """Keep the ingest's asset type and origin, and flag it for review; True if flagged"""
+        from .asset_status_service_impl import STATUS_METADATA_KEY, get_asset_status_service
+        from .thumbnail_settings_service_impl import ASSET_TYPE_METADATA_KEY
+
+        flagged = False
+        try:
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[ASSET_TYPE_METADATA_KEY] = asset_type
+            metadata[INGEST_METADATA_KEY] = {
+                "source": str(source),
+                "folder": folder.path,
+                "user": get_current_user(),
+                "date": datetime.now().isoformat(timespec="seconds"),
+            }
+            if folder.flag_review:
+                try:
+                    status = get_asset_status_service().change_status(
+                        library_root,
+                        metadata,
+                        STATUS_PENDING_REVIEW,
+                        note=f"Ingested from watch folder {folder.label}",
+                    )
+                    metadata[STATUS_METADATA_KEY] = status.to_dict()
+                    flagged = True
+                except Exception as e:
+                    print(f"[WARNING] Could not flag {asset_file.name} for review: {e}")
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Could not store ingest metadata of {asset_file.name}: {e}")
+            return False
+        return flagged
+
+    # Storage ----------------------------------------------------------------------------
+
+    def _to_folder(self, folder: Any) -> WatchFolder:
+        """Get a watch folder from a folder or its dictionary, checking its name pattern"""
+        if isinstance(folder, dict):
+            folder = WatchFolder.from_dict(folder)
+        if folder.name_pattern:
+            compile_name_pattern(folder.name_pattern)
+        return folder
+
+    def _list_files(self, folder: WatchFolder) -> List[Path]:
+        """Get the files of a watch folder it ingests (not its subfolders)"""
+        directory = Path(folder.path)
+        if not directory.is_dir():
+            return []
+        try:
+            return sorted(p for p in directory.iterdir() if p.is_file() and folder.accepts(p))
+        except OSError as e:
+            self.logger.warning(f"Could not list watch folder {directory}: {e}")
+            return []
+
+    def _file_key(self, file_path: Path) -> str:
+        """Get the key a dropped file is remembered under"""
+        return Path(file_path).as_posix()
+
+    def _signature(self, file_path: Path) -> str:
+        """Get what changes when a file is exported again (modification time and size)"""
+        try:
+            stat = Path(file_path).stat()
+        except OSError:
+            return ""
+        return f"{stat.st_mtime_ns}:{stat.st_size}"
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        config["folders"] = []
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Could not read watch folder settings: {e}")
+        return config
+
+    def _load_seen(self) -> Dict[str, str]:
+        """Read the files already ingested"""
+        if not self._state_file.exists():
+            return {}
+        try:
+            with open(self._state_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            return dict(data) if isinstance(data, dict) else {}
+        except Exception as e:
+            self.logger.warning(f"Could not read ingested files: {e}")
+            return {}
+
+    def _save_seen(self) -> None:
+        """Write the files already ingested, dropping those no longer in a watch folder"""
+        watched = {Path(folder.path).as_posix() for folder in self.get_folders()}
+        self._seen = {
+            key: signature
+            for key, signature in self._seen.items()
+            if Path(key).parent.as_posix() in watched
+        }
+        try:
+            self._state_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._state_file, "w", encoding="utf-8") as f:
+                json.dump(self._seen, f, indent=2)
+        except Exception as e:
+            self.logger.error(f"Failed to save ingested files: {e}")
+
+    def _get_version_service(self) -> Any:
+        """Get the version service new versions are published with"""
+        if self._version_service is None:
+            from .version_service_impl import get_version_service
+
+            self._version_service = get_version_service()
+        return self._version_service
+
+    def _get_lock_service(self) -> Any:
+        """Get the lock service guarding checked-out assets"""
+        if self._lock_service is None:
+            from .lock_service_impl import get_lock_service
+
+            self._lock_service = get_lock_service()
+        return self._lock_service
+
+
+# Singleton instance factory
+_ingest_service_instance = None
+
+
+def get_ingest_service() -> IngestService:
+    """
+    Get singleton instance of IngestService.
+
+    Returns:
+        IngestService: Singleton service instance
+    """
+    global _ingest_service_instance
+    if _ingest_service_instance is None:
+        _ingest_service_instance = IngestService()
+    return _ingest_service_instance
@@ -0,0 +1,198 @@
+# -*- coding: utf-8 -*-
+"""
+Instance Service Implementation
+Turn repeated imports of one asset into Maya instances that share its geometry
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Set dressing often imports the same prop dozens of times, each a full copy of its
+meshes. Copies are matched by the asset file tagged on their root and by their
+mesh layout (relative shape paths and vertex counts), so a copy that was modelled
+on after import is left alone. Each duplicate root is replaced by an instance of
+the first copy with the same name, parent, and world transform::
+
+    |set_grp|crate_grp    (master - keeps its meshes)
+    |set_grp|crate_grp1   -> instance of crate_grp, shapes shared
+    |set_grp|crate_grp2   -> instance of crate_grp, shapes shared
+
+Instances share the master's shading as well as its geometry.
+"""
+
+import logging
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from .viewport_drop_service_impl import SOURCE_ATTRIBUTE
+
+# Relative shape path and vertex count of every mesh under a root
+MeshLayout = Tuple[Tuple[str, int], ...]
+
+
+@dataclass(frozen=True)
+class InstanceGroup:
+    """Copies of one asset in the scene that can share the master's geometry"""
+
+    asset_file: Path
+    master: str
+    duplicates: Tuple[str, ...]
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate.ma: 3 copies)"""
+        count = len(self.duplicates)
+        return f"{self.asset_file.name}: {count} cop{'ies' if count != 1 else 'y'}"
+
+
+class InstanceService:
+    """
+    Instance Service - Single Responsibility for sharing geometry between asset copies
+    Scene access goes through the cmds argument so conversions can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Finding ----------------------------------------------------------------------------
+
+    def find_duplicates(self, cmds: Any) -> List[InstanceGroup]:
+        """
+        Find imported copies of the same asset that could be instances
+
+        A copy whose meshes are already instanced is a master candidate and is never
+        converted again; referenced copies are left to the reference.
+
+        Returns:
+            One group per asset with at least one convertible copy
+        """
+        copies: Dict[Tuple[str, MeshLayout], List[str]] = {}
+        tagged = cmds.ls(f"*.{SOURCE_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        for root in sorted(tagged or []):
+            if cmds.referenceQuery(root, isNodeReferenced=True):
+                continue
+            layout = self.get_mesh_layout(cmds, root)
+            if not layout:
+                continue  # Nothing to share
+            asset_path = cmds.getAttr(f"{root}.{SOURCE_ATTRIBUTE}") or ""
+            copies.setdefault((asset_path, layout), []).append(root)
+
+        groups = []
+        for (asset_path, _layout), roots in copies.items():
+            instanced = [root for root in roots if self.is_instanced(cmds, root)]
+            master = instanced[0] if instanced else roots[0]
+            duplicates = tuple(
+                root for root in roots if root != master and root not in instanced
+            )
+            if duplicates:
+                groups.append(InstanceGroup(Path(asset_path), master, duplicates))
+        return groups
+
+    def get_mesh_layout(self, cmds: Any, root: str) -> MeshLayout:
+        """Get the relative path and vertex count of every mesh under a root"""
+        shapes = cmds.listRelatives(root, allDescendents=True, type="mesh", fullPath=True) or []
+        layout = []
+        for shape in shapes:
+            if cmds.getAttr(f"{shape}.intermediateObject"):
+                continue
+            vertices = cmds.polyEvaluate(shape, vertex=True)
+            layout.append((shape[len(root) :], int(vertices) if vertices else 0))
+        return tuple(sorted(layout))
+
+    def is_instanced(self, cmds: Any, root: str) -> bool:
+        """Check if the meshes under a root are shared with another transform"""
+        shapes = cmds.listRelatives(root, allDescendents=True, type="mesh", fullPath=True) or []
+        return any(
+            len(cmds.listRelatives(shape, allParents=True, fullPath=True) or []) > 1
+            for shape in shapes
+        )
+
+    # Converting -------------------------------------------------------------------------
+
+    def convert_to_instances(self, cmds: Any, group: InstanceGroup) -> List[str]:
+        """
+        Replace every duplicate of a group with an instance of its master
+
+        Args:
+            cmds: maya.cmds module
+            group: Copies found by find_duplicates
+
+        Returns:
+            New instance roots (full paths), one per converted duplicate
+        """
+        converted = []
+        cmds.undoInfo(openChunk=True, chunkName=f"Instance {group.asset_file.stem}")
+        try:
+            for duplicate in group.duplicates:
+                root = self._replace_with_instance(cmds, group.master, duplicate)
+                if root is not None:
+                    converted.append(root)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+        print(
+            f"[OK] {group.asset_file.name}: {len(converted)} copy(s) now instance "
+            f"{group.master.rsplit('|', 1)[-1]}"
+        )
+        return converted
+
+    def convert_all(self, cmds: Any) -> int:
+        """Convert every duplicate in the scene, returns the number converted"""
+        return sum(
+            len(self.convert_to_instances(cmds, group)) for group in self.find_duplicates(cmds)
+        )
+
+    def _replace_with_instance(self, cmds: Any, master: str, duplicate: str) -> Optional[str]:
+        """Swap one duplicate root for an instance with its name, parent, and placement"""
+        if not cmds.objExists(duplicate):
+            return None
+        name = duplicate.rsplit("|", 1)[-1]
+        parents = cmds.listRelatives(duplicate, parent=True, fullPath=True) or []
+        matrix = cmds.xform(duplicate, query=True, matrix=True, worldSpace=True)
+        source = cmds.getAttr(f"{duplicate}.{SOURCE_ATTRIBUTE}")
+        # Provenance nodes list the duplicate as a member; the instance takes its place
+        member_plugs = (
+            cmds.listConnections(
+                f"{duplicate}.message", source=False, destination=True, plugs=True
+            )
+            or []
+        )
+
+        # Maya adds the instance next to the master; move it to the duplicate's parent
+        instance = cmds.ls(cmds.instance(master)[0], long=True)[0]
+        current = cmds.listRelatives(instance, parent=True, fullPath=True) or []
+        if current != parents:
+            if parents:
+                moved = cmds.parent(instance, parents[0])[0]
+            else:
+                moved = cmds.parent(instance, world=True)[0]
+            instance = cmds.ls(moved, long=True)[0]
+        cmds.xform(instance, matrix=matrix, worldSpace=True)
+
+        cmds.delete(duplicate)
+        renamed = cmds.rename(instance, name).rsplit("|", 1)[-1]
+        instance = f"{parents[0] if parents else ''}|{renamed}"
+
+        if not cmds.attributeQuery(SOURCE_ATTRIBUTE, node=instance, exists=True):
+            cmds.addAttr(instance, longName=SOURCE_ATTRIBUTE, dataType="string")
+        cmds.setAttr(f"{instance}.{SOURCE_ATTRIBUTE}", source, type="string")
+        for plug in member_plugs:
+            if not cmds.listConnections(plug, source=True, destination=False):
+                cmds.connectAttr(f"{instance}.message", plug)
+        return instance
+
+
+# Singleton instance factory
+_instance_service_instance = None
+
+
+def get_instance_service() -> InstanceService:
+    """
+    Get singleton instance of InstanceService.
+
+    Returns:
+        InstanceService: Singleton service instance
+    """
+    global _instance_service_instance
+    if _instance_service_instance is None:
+        _instance_service_instance = InstanceService()
+    return _instance_service_instance
@@ -0,0 +1,248 @@
+# -*- coding: utf-8 -*-
+"""
+Integrity Service Implementation
+Publish checksums and the library audit that verifies files against them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Every publish stores the SHA-256 of the asset file, its companions, and the
+version snapshot in the library database. The audit hashes each recorded file
+again and reports files that are missing or whose bytes changed, per-asset
+folders (``.versions/<asset>``, ``.proxies/<asset>``...) whose asset the
+database no longer knows, and published files that predate checksums.
+"""
+
+import hashlib
+import logging
+import shutil
+from pathlib import Path
+from typing import Callable, Dict, Iterable, List, Optional, Set
+
+from ..core.models.asset_version import AssetVersion
+from ..core.models.integrity_report import (
+    ISSUE_CORRUPTED,
+    ISSUE_MISSING,
+    ISSUE_ORPHANED,
+    ISSUE_UNVERIFIED,
+    REPAIR_DELETE,
+    REPAIR_FORGET,
+    REPAIR_RECORD,
+    REPAIR_RESTORE,
+    IntegrityIssue,
+    IntegrityReport,
+)
+from .dependency_service_impl import DEPENDENCIES_DIR_NAME
+from .lod_service_impl import LODS_DIR_NAME
+from .proxy_service_impl import PROXIES_DIR_NAME
+from .version_service_impl import VERSIONS_DIR_NAME, get_version_service
+
+_HASH_CHUNK_SIZE = 1024 * 1024
+
+# Hidden folders holding one ``<asset stem>`` sub-folder per library asset
+PER_ASSET_DIR_NAMES = (VERSIONS_DIR_NAME, PROXIES_DIR_NAME, LODS_DIR_NAME, DEPENDENCIES_DIR_NAME)
+
+# Called with (files done, files total) while the audit hashes files
+ProgressCallback = Callable[[int, int], None]
+
+
+class IntegrityService:
+    """
+    Integrity Service - Single Responsibility for publish checksums and audits
+    Works on files and the library database only; nothing here needs Maya
+    """
+
+    def __init__(self, version_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+
+    # Checksums --------------------------------------------------------------------------
+
+    def hash_file(self, file_path: Path) -> str:
+        """Get the SHA-256 of a file's bytes"""
+        digest = hashlib.sha256()
+        with open(file_path, "rb") as f:
+            for chunk in iter(lambda: f.read(_HASH_CHUNK_SIZE), b""):
+                digest.update(chunk)
+        return digest.hexdigest()
+
+    def get_published_files(self, asset_file: Path, version: AssetVersion) -> List[Path]:
+        """Get the files one version publish wrote: asset, companions, and snapshot"""
+        asset_file = Path(asset_file)
+        companions = version.extra.get("companions", [])
+        files = [asset_file, version.file_path]
+        files += [asset_file.parent / relative for relative in companions]
+        files += [version.file_path.parent / relative for relative in companions]
+        return [path for path in dict.fromkeys(files) if path.is_file()]
+
+    def record_publish(self, database, asset_file: Path, version: AssetVersion) -> int:
+        """
+        Store checksums of every file a version publish wrote
+
+        Args:
+            database: Library MetadataDatabase
+            asset_file: Published asset file
+            version: Version the publish created
+
+        Returns:
+            Number of files recorded
+        """
+        checksums = {}
+        for path in self.get_published_files(asset_file, version):
+            try:
+                checksums[path] = self.hash_file(path)
+            except OSError as e:
+                self.logger.warning(f"Could not fingerprint {path}: {e}")
+        database.record_checksums(asset_file, checksums)
+        return len(checksums)
+
+    # Audit ------------------------------------------------------------------------------
+
+    def audit(self, database, progress: Optional[ProgressCallback] = None) -> IntegrityReport:
+        """
+        Verify every recorded file against its checksum and look for orphaned folders
+
+        Args:
+            database: Library MetadataDatabase
+            progress: Optional callback for long audits of network libraries
+
+        Returns:
+            Report with one finding per damaged, orphaned, or unverified file
+        """
+        root = database.library_root
+        records = database.get_checksums()
+        actual: Dict[str, Optional[str]] = {}
+
+        for done, key in enumerate(records, start=1):
+            path = root / key
+            try:
+                actual[key] = self.hash_file(path) if path.is_file() else None
+            except OSError as e:
+                self.logger.warning(f"Could not read {path}: {e}")
+                actual[key] = None
+            if progress is not None:
+                progress(done, len(records))
+
+        # Files whose bytes still match are the intact copies damaged files restore from
+        intact: Dict[str, Path] = {}
+        for key, record in records.items():
+            if actual[key] == record["sha256"]:
+                intact.setdefault(record["sha256"], root / key)
+
+        issues: List[IntegrityIssue] = []
+        for key, record in records.items():
+            if actual[key] == record["sha256"]:
+                continue
+            kind = ISSUE_CORRUPTED if (root / key).is_file() else ISSUE_MISSING
+            source = intact.get(record["sha256"])
+            if source is not None:
+                repair = REPAIR_RESTORE
+            else:
+                repair = REPAIR_RECORD if kind == ISSUE_CORRUPTED else REPAIR_FORGET
+            issues.append(IntegrityIssue(kind, root / key, record["asset"], repair, source))
+
+        asset_keys = set(database.get_all_metadata())
+        asset_keys.update(database.get_latest_version_numbers())
+        asset_keys.update(record["asset"] for record in records.values())
+        issues += self._find_orphaned_folders(root, asset_keys)
+        issues += self._find_unverified_files(root, asset_keys, set(records))
+
+        report = IntegrityReport(root, len(records), tuple(issues))
+        print(f"[{'OK' if report.is_clean else 'WARNING'}] Library audit: {report.summary}")
+        return report
+
+    def _find_orphaned_folders(self, root: Path, asset_keys: Set[str]) -> List[IntegrityIssue]:
+        """Find per-asset folders of assets neither in the database nor on disk"""
+        owners: Set[tuple] = set()
+        for key in asset_keys:
+            path = Path(key)
+            owners.add((path.parent.as_posix(), path.stem))
+
+        issues = []
+        for dir_name in PER_ASSET_DIR_NAMES:
+            for folder in sorted(root.rglob(f"{dir_name}/*")):
+                if not folder.is_dir() or folder.parent.name != dir_name:
+                    continue
+                asset_dir = folder.parent.parent
+                relative_dir = asset_dir.relative_to(root).as_posix()
+                if any(part.startswith(".") for part in Path(relative_dir).parts):
+                    continue  # Trash and settings keep their own copies
+                if (relative_dir, folder.name) in owners:
+                    continue
+                # An asset file without a database row is unindexed, not orphaned
+                if any(path.is_file() for path in asset_dir.glob(f"{folder.name}.*")):
+                    continue
+                issues.append(IntegrityIssue(ISSUE_ORPHANED, folder, "", REPAIR_DELETE))
+        return issues
+
+    def _find_unverified_files(
+        self, root: Path, asset_keys: Iterable[str], recorded: Set[str]
+    ) -> List[IntegrityIssue]:
+        """Find published files of known assets that have no checksum yet"""
+        issues = []
+        for key in sorted(asset_keys):
+            asset_file = root / key
+            files = [asset_file] if asset_file.is_file() else []
+            for version in self._version_service.get_versions(asset_file):
+                files += self.get_published_files(asset_file, version)
+            for path in dict.fromkeys(files):
+                try:
+                    file_key = path.relative_to(root).as_posix()
+                except ValueError:
+                    continue  # Manifest from before the library moved
+                if file_key not in recorded:
+                    issues.append(IntegrityIssue(ISSUE_UNVERIFIED, path, key, REPAIR_RECORD))
+        return issues
+
+    # Repairs ----------------------------------------------------------------------------
+
+    def repair(self, database, issue: IntegrityIssue) -> bool:
+        """
+        Apply the repair an audit suggested for one finding
+
+        Args:
+            database: Library MetadataDatabase the audit ran against
+            issue: Finding to repair
+
+        Returns:
+            True if the finding is resolved
+        """
+        try:
+            if issue.repair == REPAIR_RESTORE and issue.source is not None:
+                issue.path.parent.mkdir(parents=True, exist_ok=True)
+                shutil.copy2(issue.source, issue.path)
+            elif issue.repair == REPAIR_RECORD:
+                database.record_checksums(
+                    database.library_root / issue.asset_key,
+                    {issue.path: self.hash_file(issue.path)},
+                )
+            elif issue.repair == REPAIR_FORGET:
+                database.remove_checksums([database.get_asset_key(issue.path)])
+            elif issue.repair == REPAIR_DELETE:
+                shutil.rmtree(issue.path)
+            else:
+                return False
+        except Exception as e:
+            self.logger.error(f"Could not repair {issue.path}: {e}")
+            print(f"[ERROR] {issue.repair_label or 'Repair'} failed for {issue.path.name}: {e}")
+            return False
+
+        print(f"[OK] {issue.repair_label}: {issue.path.name}")
+        return True
+
+
+# Singleton instance factory
+_integrity_service_instance = None
+
+
+def get_integrity_service() -> IntegrityService:
+    """
+    Get singleton instance of IntegrityService.
+
+    Returns:
+        IntegrityService: Singleton service instance
+    """
+    global _integrity_service_instance
+    if _integrity_service_instance is None:
+        _integrity_service_instance = IntegrityService()
+    return _integrity_service_instance
@@ -0,0 +1,505 @@
+# -*- coding: utf-8 -*-
+"""
+Interchange Service Implementation
+Round-trip geometry with a paired Houdini or Blender session through USD layers
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Nothing is published: the selection is written to a temporary .usda layer and the
+peer is told where it is over a small JSON-lines protocol on a local TCP socket::
+
+    Maya  --layer {file, name, dcc}-->  peer    peer imports the layer
+    Maya  --request-->  peer            peer exports its selection, answers with a layer
+    peer  --layer {file, name, dcc}-->  Maya    queued, imported on the UI thread
+
+The peer side is src/scripts/dcc_interchange_peer.py, run inside Houdini or Blender.
+Settings are per artist, in ~/.assetmanager/interchange.json.
+"""
+
+import json
+import logging
+import re
+import socket
+import tempfile
+import threading
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+PEER_DCCS = {"houdini": "Houdini", "blender": "Blender"}
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "peer_dcc": "houdini",
+    "peer_host": "127.0.0.1",
+    "peer_port": 7820,  # Where the peer script listens
+    "listen_port": 7821,  # Where Maya listens for layers the peer sends
+    "listen": False,  # Accept layers the peer sends (opened once paired in the settings)
+    "token": "",  # Shared secret both sides send; empty accepts any local session
+    "exchange_dir": "",  # Folder of the temporary layers - the system temp folder if empty
+    "import_as_stage": False,  # Bring layers in as a USD stage instead of Maya geometry
+}
+
+# Keep in step with src/scripts/dcc_interchange_peer.py
+PROTOCOL_MAGIC = "am_interchange"
+PROTOCOL_VERSION = 1
+MAX_MESSAGE_BYTES = 1024 * 1024
+
+# Answers one decoded message with the reply's type and data
+MessageHandler = Callable[[Dict[str, Any]], Tuple[str, Dict[str, Any]]]
+
+
+@dataclass(frozen=True)
+class InterchangeLayer:
+    """A USD layer passed between Maya and the peer session"""
+
+    file_path: Path
+    name: str
+    dcc: str  # Session that wrote the layer (maya, houdini, blender)
+    delivered: bool = False  # Sent layers: the peer acknowledged it
+    message: str = ""
+
+
+def encode_message(
+    message_type: str, data: Optional[Dict[str, Any]] = None, token: str = ""
+) -> bytes:
+    """Encode an interchange message as one JSON line"""
+    message: Dict[str, Any] = {
+        "magic": PROTOCOL_MAGIC,
+        "version": PROTOCOL_VERSION,
+        "type": message_type,
+        "data": data or {},
+    }
+    if token:
+        message["token"] = token
+    return (json.dumps(message, ensure_ascii=False) + "\n").encode("utf-8")
+
+
+def decode_message(payload: bytes) -> Dict[str, Any]:
+    """
+    Decode an interchange message
+
+    Raises:
+        ValueError: For broken JSON or messages of another protocol
+    """
+    message = json.loads(payload.decode("utf-8"))
+    if not isinstance(message, dict) or message.get("magic") != PROTOCOL_MAGIC:
+        raise ValueError("Not an Asset Manager interchange message")
+    if message.get("version") != PROTOCOL_VERSION:
+        raise ValueError(f"Unsupported interchange protocol version {message.get('version')}")
+    return message
+
+
+def read_message(connection: socket.socket) -> Dict[str, Any]:
+    """
+    Read one message line from a connection
+
+    Raises:
+        ValueError: When the peer hangs up early or sends something else
+    """
+    payload = b""
+    while not payload.endswith(b"\n"):
+        chunk = connection.recv(4096)
+        if not chunk:
+            break
+        payload += chunk
+        if len(payload) > MAX_MESSAGE_BYTES:
+            raise ValueError("Interchange message is too large")
+    if not payload.strip():
+        raise ValueError("The session closed the connection without answering")
+    return decode_message(payload.strip())
+
+
+def exchange_message(
+    address: Tuple[str, int],
+    message_type: str,
+    data: Optional[Dict[str, Any]] = None,
+    token: str = "",
+    timeout: float = 5.0,
+) -> Dict[str, Any]:
+    """
+    Send a message and wait for the answer
+
+    Raises:
+        OSError: When nothing listens at the address
+        ValueError: For answers of another protocol
+        RuntimeError: When the session answers with an error
+    """
+    with socket.create_connection(address, timeout=timeout) as connection:
+        connection.settimeout(timeout)
+        connection.sendall(encode_message(message_type, data, token))
+        reply = read_message(connection)
+    if reply.get("type") == "error":
+        raise RuntimeError(str(reply["data"].get("message") or "the session refused"))
+    return reply
+
+
+class InterchangeServer:
+    """Listens on a local port and answers each connection's message on a worker thread"""
+
+    def __init__(
+        self, port: int, handler: MessageHandler, token: str = "", host: str = "127.0.0.1"
+    ):
+        self._address = (host, port)
+        self._handler = handler
+        self._token = token
+        self._socket: Optional[socket.socket] = None
+        self._thread: Optional[threading.Thread] = None
+        self._stopping = threading.Event()
+
+    @property
+    def port(self) -> int:
+        """Get the bound port (the free one picked when started on port 0)"""
+        return self._socket.getsockname()[1] if self._socket else self._address[1]
+
+    @property
+    def is_running(self) -> bool:
+        """Check whether the server is accepting connections"""
+        return self._thread is not None and self._thread.is_alive()
+
+    def start(self) -> int:
+        """
+        Start listening
+
+        Returns:
+            The port listened on
+
+        Raises:
+            OSError: When the port is taken
+        """
+        if self.is_running:
+            return self.port
+        server = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+        try:
+            server.bind(self._address)
+            server.listen(4)
+            server.settimeout(0.25)
+        except OSError:
+            server.close()
+            raise
+        self._socket = server
+        self._stopping.clear()
+        self._thread = threading.Thread(
+            target=self._serve, name="AssetManagerInterchange", daemon=True
+        )
+        self._thread.start()
+        return self.port
+
+    def stop(self) -> None:
+        """Stop listening and close the port"""
+        self._stopping.set()
+        if self._thread is not None:
+            self._thread.join(2.0)
+            self._thread = None
+        if self._socket is not None:
+            self._socket.close()
+            self._socket = None
+
+    def _serve(self) -> None:
+        """Accept connections until stopped"""
+        while not self._stopping.is_set():
+            try:
+                connection, _address = self._socket.accept()
+            except socket.timeout:
+                continue
+            except OSError:
+                break
+            with connection:
+                self._answer(connection)
+
+    def _answer(self, connection: socket.socket) -> None:
+        """Read one message and write the handler's reply"""
+        try:
+            connection.settimeout(5.0)
+            message = read_message(connection)
+            if self._token and message.get("token") != self._token:
+                reply = ("error", {"message": "The interchange token does not match"})
+            else:
+                reply = self._handler(message)
+        except (OSError, ValueError) as e:
+            reply = ("error", {"message": str(e)})
+        except Exception as e:
+            logging.getLogger(__name__).error(f"Interchange handler failed: {e}")
+            reply = ("error", {"message": f"Interchange handler failed: {e}"})
+        try:
+            connection.sendall(encode_message(reply[0], reply[1], self._token))
+        except OSError:
+            pass  # The sender gave up waiting
+
+
+class InterchangeService:
+    """
+    Interchange Service - Single Responsibility for quick USD round trips with other DCCs
+    Layers live in a temp folder and never touch the library or its version history
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "interchange.json"
+        self._config: Dict[str, Any] = self._load_config()
+        self._server: Optional[InterchangeServer] = None
+        self._received: List[InterchangeLayer] = []
+        self._lock = threading.Lock()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self) -> Dict[str, Any]:
+        """Get the configuration"""
+        return dict(self._config)
+
+    def set_config(self, **values: Any) -> None:
+        """Update configuration values (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown interchange settings: {', '.join(sorted(unknown))}")
+        if "peer_dcc" in values and values["peer_dcc"] not in PEER_DCCS:
+            raise ValueError(f"Unknown interchange peer: {values['peer_dcc']}")
+        self._config.update(values)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save interchange config: {e}")
+            return False
+
+    def get_peer_name(self) -> str:
+        """Get the paired application's display name (Houdini)"""
+        return PEER_DCCS.get(self._config.get("peer_dcc"), "DCC")
+
+    # Layers -----------------------------------------------------------------------------
+
+    def get_exchange_dir(self) -> Path:
+        """Get the folder temporary layers are written to"""
+        folder = self._config.get("exchange_dir")
+        return Path(folder) if folder else Path(tempfile.gettempdir()) / "assetmanager_interchange"
+
+    def get_layer_path(self, name: str, now: Optional[datetime] = None) -> Path:
+        """Get a new layer file for a send (crate_maya_20260601_120000.usda)"""
+        stamp = (now or datetime.now()).strftime("%Y%m%d_%H%M%S")
+        safe = re.sub(r"[^A-Za-z0-9_]+", "_", name).strip("_") or "selection"
+        return self.get_exchange_dir() / f"{safe}_maya_{stamp}.usda"
+
+    def write_selection_layer(self, cmds: Any, name: str) -> Path:
+        """
+        Export the selection as a temporary USD layer
+
+        Raises:
+            RuntimeError: When nothing is selected or mayaUSD cannot write the layer
+        """
+        if not cmds.ls(selection=True):
+            raise RuntimeError("Select the geometry to send first")
+        layer_path = self.get_layer_path(name)
+        layer_path.parent.mkdir(parents=True, exist_ok=True)
+        try:
+            if not cmds.pluginInfo("mayaUsdPlugin", query=True, loaded=True):
+                cmds.loadPlugin("mayaUsdPlugin", quiet=True)
+            cmds.mayaUSDExport(
+                file=str(layer_path),
+                selection=True,
+                exportUVs=True,
+                exportColorSets=True,
+                shadingMode="useRegistry",
+                convertMaterialsTo=["UsdPreviewSurface"],
+                defaultUSDFormat="usda",
+            )
+        except Exception as e:
+            raise RuntimeError(f"USD layer export failed: {e}") from e
+        if not layer_path.is_file():
+            raise RuntimeError(f"mayaUSD did not write {layer_path.name}")
+        return layer_path
+
+    # Peer session -----------------------------------------------------------------------
+
+    def send_selection(self, cmds: Any, name: Optional[str] = None) -> InterchangeLayer:
+        """
+        Write the selection to a layer and tell the peer to load it
+
+        Args:
+            cmds: maya.cmds module
+            name: Name the layer is loaded under (the first selected node when omitted)
+
+        Raises:
+            RuntimeError: When the layer could not be written; an unreachable peer
+                only leaves the layer undelivered
+        """
+        selection = cmds.ls(selection=True) or []
+        name = name or (selection[0].split("|")[-1].split(":")[-1] if selection else "selection")
+        layer_path = self.write_selection_layer(cmds, name)
+        data = {
+            "file": str(layer_path),
+            "name": name,
+            "dcc": "maya",
+            "up_axis": cmds.upAxis(query=True, axis=True),
+            "meters_per_unit": 0.01,
+        }
+        try:
+            self._exchange("layer", data)
+        except (OSError, RuntimeError, ValueError) as e:
+            print(f"[WARNING] {self.get_peer_name()} did not take {layer_path.name}: {e}")
+            return InterchangeLayer(layer_path, name, "maya", message=str(e))
+        print(f"[OK] Sent {name} to {self.get_peer_name()} ({layer_path.name})")
+        return InterchangeLayer(layer_path, name, "maya", delivered=True, message="loaded")
+
+    def receive(self, timeout: float = 60.0) -> InterchangeLayer:
+        """
+        Ask the peer for a layer of its current selection
+
+        Raises:
+            RuntimeError: When the peer is unreachable, refuses, or its layer is missing
+        """
+        self.get_exchange_dir().mkdir(parents=True, exist_ok=True)
+        try:
+            reply = self._exchange(
+                "request", {"dcc": "maya", "exchange_dir": str(self.get_exchange_dir())}, timeout
+            )
+        except (OSError, ValueError) as e:
+            raise RuntimeError(f"{self.get_peer_name()} did not answer: {e}") from e
+        layer = self._layer_from_data(reply.get("data") or {})
+        if not layer.file_path.is_file():
+            raise RuntimeError(f"{self.get_peer_name()} layer is missing: {layer.file_path}")
+        return layer
+
+    def ping_peer(self) -> Optional[Dict[str, Any]]:
+        """Get the peer's pong ({dcc, version, scene}), None when it does not answer"""
+        try:
+            return self._exchange("ping", {"dcc": "maya"}, 2.0).get("data") or {}
+        except (OSError, RuntimeError, ValueError) as e:
+            self.logger.info(f"Interchange peer did not answer: {e}")
+            return None
+
+    # Listening --------------------------------------------------------------------------
+
+    def start_listening(self) -> bool:
+        """Accept layers the peer sends (queued for take_received); False if the port is taken"""
+        if self._server is not None and self._server.is_running:
+            return True
+        self._server = InterchangeServer(
+            int(self._config.get("listen_port") or 0),
+            self._handle_message,
+            str(self._config.get("token") or ""),
+        )
+        try:
+            port = self._server.start()
+        except OSError as e:
+            print(f"[WARNING] Interchange cannot listen on {self._config.get('listen_port')}: {e}")
+            self._server = None
+            return False
+        print(f"[INFO] Listening for {self.get_peer_name()} layers on port {port}")
+        return True
+
+    def stop_listening(self) -> None:
+        """Stop accepting layers from the peer"""
+        if self._server is not None:
+            self._server.stop()
+            self._server = None
+
+    @property
+    def listening_port(self) -> Optional[int]:
+        """Get the port layers are accepted on, None when not listening"""
+        return self._server.port if self._server is not None else None
+
+    def take_received(self) -> List[InterchangeLayer]:
+        """Get (and clear) the layers the peer sent since the last call"""
+        with self._lock:
+            received, self._received = self._received, []
+        return received
+
+    def import_layer(self, layer: InterchangeLayer, as_stage: Optional[bool] = None) -> List[str]:
+        """
+        Bring a received layer into the scene
+
+        Args:
+            layer: Layer from receive or take_received
+            as_stage: Keep it as a USD stage (the import_as_stage setting when omitted)
+
+        Returns:
+            Nodes created
+        """
+        from .usd_service_impl import get_usd_service
+
+        if as_stage is None:
+            as_stage = bool(self._config.get("import_as_stage"))
+        usd_service = get_usd_service()
+        if as_stage:
+            proxy_shape = usd_service.import_usd_as_stage(layer.file_path, f"{layer.name}_stage")
+            return [proxy_shape] if proxy_shape else []
+        namespace = re.sub(r"[^A-Za-z0-9_]+", "_", f"{layer.dcc}_{layer.name}")
+        return usd_service.import_usd_file(layer.file_path, namespace=namespace)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _exchange(
+        self, message_type: str, data: Dict[str, Any], timeout: float = 10.0
+    ) -> Dict[str, Any]:
+        """Send a message to the peer session and get its answer"""
+        address = (str(self._config.get("peer_host")), int(self._config.get("peer_port")))
+        return exchange_message(
+            address, message_type, data, str(self._config.get("token") or ""), timeout
+        )
+
+    def _handle_message(self, message: Dict[str, Any]) -> Tuple[str, Dict[str, Any]]:
+        """Answer the peer (on the server thread - nothing here touches the scene)"""
+        message_type = message.get("type")
+        data = message.get("data") or {}
+        if message_type == "ping":
+            return "pong", {"dcc": "maya", "port": self.listening_port}
+        if message_type == "layer":
+            layer = self._layer_from_data(data)
+            if not layer.file_path.is_file():
+                return "error", {"message": f"Maya cannot read {layer.file_path}"}
+            with self._lock:
+                self._received.append(layer)
+            print(f"[INFO] Received {layer.name} from {layer.dcc}")
+            return "ack", {"file": str(layer.file_path)}
+        if message_type == "request":
+            return "error", {"message": "Maya sends layers with Send Selection"}
+        return "error", {"message": f"Unknown interchange message: {message_type}"}
+
+    def _layer_from_data(self, data: Dict[str, Any]) -> InterchangeLayer:
+        """Build a layer from a layer message's data"""
+        if not data.get("file"):
+            raise RuntimeError("The layer message names no file")
+        file_path = Path(str(data["file"]))
+        dcc = str(data.get("dcc") or self._config.get("peer_dcc"))
+        return InterchangeLayer(file_path, str(data.get("name") or file_path.stem), dcc)
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Ignoring unreadable interchange config: {e}")
+        if config.get("peer_dcc") not in PEER_DCCS:
+            config["peer_dcc"] = DEFAULT_CONFIG["peer_dcc"]
+        return config
+
+
+# Singleton instance factory
+_interchange_service_instance = None
+
+
+def get_interchange_service() -> InterchangeService:
+    """
+    Get singleton instance of InterchangeService.
+
+    Returns:
+        InterchangeService: Singleton service instance
+    """
+    global _interchange_service_instance
+    if _interchange_service_instance is None:
+        _interchange_service_instance = InterchangeService()
+    return _interchange_service_instance
@@ -0,0 +1,383 @@
+# -*- coding: utf-8 -*-
+"""
+Kitsu Service Implementation
+Post library publishes to Kitsu (CGWire) tasks and pull the artist's task list
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Talks to the Kitsu (Zou) REST API directly, so no gazu install is needed in Maya.
+A publish linked to a task leaves a comment with the playblast or thumbnail as its
+preview, and an output file entry carrying the library version on the task's shot
+or asset. Connection settings live in ~/.assetmanager/kitsu.json; a bot token or an
+artist login can also come from the environment::
+
+    KITSU_HOST=https://kitsu.studio.com/api
+    KITSU_EMAIL=artist@studio.com
+    KITSU_PASSWORD=...
+    KITSU_TOKEN=...        <- bot token, used instead of a login
+"""
+
+import json
+import logging
+import mimetypes
+import os
+import urllib.error
+import urllib.request
+import uuid
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+# Config key -> environment variable that overrides it
+CONFIG_ENVIRONMENT = {
+    "host": "KITSU_HOST",
+    "email": "KITSU_EMAIL",
+    "password": "KITSU_PASSWORD",
+    "token": "KITSU_TOKEN",
+}
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "enabled": False,
+    "host": "",
+    "email": "",
+    "password": "",
+    "token": "",
+    "task": None,  # Task publishes are linked to (KitsuTask.to_dict())
+}
+
+# Asset extension -> output type name (created on first use)
+OUTPUT_TYPES = {
+    ".ma": "Maya Scene",
+    ".mb": "Maya Scene",
+    ".abc": "Alembic Cache",
+    ".fbx": "FBX",
+    ".obj": "OBJ",
+    ".usd": "USD",
+    ".usda": "USD",
+    ".usdc": "USD",
+}
+DEFAULT_OUTPUT_TYPE = "Asset File"
+
+REQUEST_TIMEOUT = 30  # Seconds
+
+
+@dataclass(frozen=True)
+class KitsuTask:
+    """Task assigned to the artist, with the shot or asset it belongs to"""
+
+    id: str
+    project_id: str
+    project_name: str
+    entity_id: str
+    entity_name: str  # Shot or asset name
+    entity_type: str  # Shot, Asset type (Character, Prop...)
+    task_type_id: str
+    task_type_name: str
+    task_status_id: str
+    task_status_name: str = ""
+    sequence_name: str = ""
+
+    @property
+    def entity_label(self) -> str:
+        """Get the shot or asset name, with its sequence for shots (SQ01 / SH010)"""
+        if self.sequence_name:
+            return f"{self.sequence_name} / {self.entity_name}"
+        return self.entity_name
+
+    @property
+    def description(self) -> str:
+        """Get the task as shown in the UI (Robots > SQ01 / SH010 > Animation)"""
+        parts = (self.project_name, self.entity_label, self.task_type_name)
+        return " > ".join(part for part in parts if part)
+
+    def to_dict(self) -> Dict[str, str]:
+        """Serialize for the config file"""
+        return dict(self.__dict__)
+
+    @classmethod
+    def from_dict(cls, data: Dict[str, Any]) -> Optional["KitsuTask"]:
+        """Build from a stored task, None when the entry is incomplete"""
+        try:
+            return cls(**{key: str(data.get(key) or "") for key in cls.__dataclass_fields__})
+        except (AttributeError, TypeError):
+            return None
+
+    @classmethod
+    def from_api(cls, data: Dict[str, Any]) -> "KitsuTask":
+        """Build from a row of /data/user/tasks"""
+        return cls(
+            id=data["id"],
+            project_id=data.get("project_id", ""),
+            project_name=data.get("project_name", ""),
+            entity_id=data.get("entity_id", ""),
+            entity_name=data.get("entity_name", ""),
+            entity_type=data.get("entity_type_name", ""),
+            task_type_id=data.get("task_type_id", ""),
+            task_type_name=data.get("task_type_name", ""),
+            task_status_id=data.get("task_status_id", ""),
+            task_status_name=data.get("task_status_name", ""),
+            sequence_name=data.get("sequence_name") or "",
+        )
+
+
+class KitsuClient:
+    """Minimal JSON client for the Kitsu REST API"""
+
+    def __init__(self, host: str, token: str):
+        self._host = host.rstrip("/")
+        self._token = token
+
+    @classmethod
+    def login(cls, host: str, email: str, password: str) -> "KitsuClient":
+        """Sign in as an artist and keep the access token"""
+        client = cls(host, "")
+        answer = client.post("auth/login", {"email": email, "password": password})
+        if not answer.get("access_token"):
+            raise RuntimeError("Kitsu login failed - check the email and password")
+        return cls(host, answer["access_token"])
+
+    def get(self, path: str) -> Any:
+        """GET a JSON resource"""
+        return self._send(urllib.request.Request(self._url(path), method="GET"))
+
+    def post(self, path: str, data: Dict[str, Any]) -> Any:
+        """POST JSON and get the JSON answer"""
+        request = urllib.request.Request(
+            self._url(path), data=json.dumps(data).encode("utf-8"), method="POST"
+        )
+        request.add_header("Content-Type", "application/json")
+        return self._send(request)
+
+    def upload(self, path: str, file_path: Path) -> Any:
+        """POST a file as multipart form data (previews)"""
+        boundary = uuid.uuid4().hex
+        mime_type = mimetypes.guess_type(file_path.name)[0] or "application/octet-stream"
+        body = (
+            f"--{boundary}\r\n"
+            f'Content-Disposition: form-data; name="file"; filename="{file_path.name}"\r\n'
+            f"Content-Type: {mime_type}\r\n\r\n"
+        ).encode("utf-8")
+        body += Path(file_path).read_bytes() + f"\r\n--{boundary}--\r\n".encode("utf-8")
+        request = urllib.request.Request(self._url(path), data=body, method="POST")
+        request.add_header("Content-Type", f"multipart/form-data; boundary={boundary}")
+        return self._send(request)
+
+    def _url(self, path: str) -> str:
+        """Get the full URL of an API path"""
+        return f"{self._host}/{path.lstrip('/')}"
+
+    def _send(self, request: urllib.request.Request) -> Any:
+        """Send a request with the access token and decode the answer"""
+        request.add_header("Accept", "application/json")
+        if self._token:
+            request.add_header("Authorization", f"Bearer {self._token}")
+        try:
+            with urllib.request.urlopen(request, timeout=REQUEST_TIMEOUT) as response:
+                payload = response.read()
+        except urllib.error.HTTPError as e:
+            raise RuntimeError(f"Kitsu answered {e.code} for {request.full_url}") from e
+        return json.loads(payload.decode("utf-8")) if payload else {}
+
+
+class KitsuService:
+    """
+    Kitsu Service - Single Responsibility for publish tracking in Kitsu
+    Never blocks a publish; failures are reported and the library publish stands
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        client_factory: Optional[Callable[[Dict[str, Any]], Any]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "kitsu.json"
+        self._client_factory = client_factory or self._create_client
+        self._client: Any = None
+        self._config: Dict[str, Any] = self._load_config()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self, apply_environment: bool = True) -> Dict[str, Any]:
+        """Get the configuration, by default with environment overrides applied"""
+        config = dict(self._config)
+        if not apply_environment:
+            return config
+        for key, variable in CONFIG_ENVIRONMENT.items():
+            if os.environ.get(variable):
+                config[key] = os.environ[variable]
+        return config
+
+    def set_config(self, **values: Any) -> None:
+        """Update configuration values (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown Kitsu settings: {', '.join(sorted(unknown))}")
+        self._config.update(values)
+        if set(values) & set(CONFIG_ENVIRONMENT):
+            self._client = None  # Sign in again with the new connection
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save Kitsu config: {e}")
+            return False
+
+    def is_enabled(self) -> bool:
+        """Check if publishes are posted to Kitsu"""
+        return bool(self._config.get("enabled"))
+
+    # Tasks ------------------------------------------------------------------------------
+
+    def get_my_tasks(self) -> List[KitsuTask]:
+        """
+        Get the open tasks assigned to the signed-in artist
+
+        Raises:
+            RuntimeError: When Kitsu cannot be reached or the login is refused
+        """
+        rows = self._get_client().get("data/user/tasks") or []
+        tasks = [KitsuTask.from_api(row) for row in rows]
+        return sorted(tasks, key=lambda task: task.description.lower())
+
+    def get_active_task(self) -> Optional[KitsuTask]:
+        """Get the task publishes are linked to"""
+        stored = self._config.get("task")
+        return KitsuTask.from_dict(stored) if isinstance(stored, dict) else None
+
+    def set_active_task(self, task: Optional[KitsuTask]) -> None:
+        """Link publishes to a task (None to stop linking), and save the choice"""
+        self._config["task"] = task.to_dict() if task else None
+        self.save_config()
+
+    # Publish ----------------------------------------------------------------------------
+
+    def publish(
+        self,
+        file_path: Path,
+        version_number: int,
+        description: str = "",
+        preview_path: Optional[Path] = None,
+        task: Optional[KitsuTask] = None,
+    ) -> Optional[Dict[str, Any]]:
+        """
+        Post a library publish to a Kitsu task
+
+        Args:
+            file_path: Published asset file
+            version_number: Library version number (v003 -> 3), the output file revision
+            description: Publish notes
+            preview_path: Playblast movie or thumbnail attached to the comment
+            task: Task to post to, defaults to the active task
+
+        Returns:
+            {"comment", "preview", "output_file"} entity dicts, None if nothing was posted
+        """
+        file_path = Path(file_path)
+        task = task or self.get_active_task()
+        if task is None:
+            print("[WARNING] Kitsu publish skipped: no task (pick one in Kitsu Tasks)")
+            return None
+        if preview_path is not None and not Path(preview_path).exists():
+            preview_path = None
+
+        label = f"{file_path.name} v{version_number:03d}"
+        text = f"Published {label}" + (f"\n\n{description}" if description else "")
+        try:
+            client = self._get_client()
+            comment = client.post(
+                f"actions/tasks/{task.id}/comment",
+                {"task_status_id": task.task_status_id, "comment": text},
+            )
+            preview = None
+            if preview_path is not None:
+                preview = client.post(
+                    f"actions/tasks/{task.id}/comments/{comment['id']}/add-preview", {}
+                )
+                client.upload(f"pictures/preview-files/{preview['id']}", Path(preview_path))
+
+            output_type = self._get_output_type(client, file_path)
+            output_file = client.post(
+                f"data/entities/{task.entity_id}/output-files/new",
+                {
+                    "name": file_path.stem,
+                    "output_type_id": output_type["id"],
+                    "task_type_id": task.task_type_id,
+                    "comment": description,
+                    "revision": version_number,
+                    "representation": file_path.suffix.lstrip(".").lower(),
+                    "path": str(file_path),
+                },
+            )
+        except Exception as e:
+            print(f"[ERROR] Kitsu publish of {label} failed: {e}")
+            return None
+
+        print(f"[OK] Posted {label} to Kitsu ({task.description})")
+        return {"comment": comment, "preview": preview, "output_file": output_file}
+
+    def _get_output_type(self, client: Any, file_path: Path) -> Dict[str, Any]:
+        """Get the output type of an asset file, creating it on first use"""
+        name = OUTPUT_TYPES.get(file_path.suffix.lower(), DEFAULT_OUTPUT_TYPE)
+        for output_type in client.get("data/output-types") or []:
+            if output_type.get("name") == name:
+                return output_type
+        short_name = "".join(word[0] for word in name.split()).lower()
+        return client.post("data/output-types", {"name": name, "short_name": short_name})
+
+    # Connection -------------------------------------------------------------------------
+
+    def _get_client(self) -> Any:
+        """Get the signed-in client, connecting on first use"""
+        if self._client is None:
+            self._client = self._client_factory(self.get_config())
+        return self._client
+
+    def _create_client(self, config: Dict[str, Any]) -> KitsuClient:
+        """Connect with the bot token, else sign in with the artist's login"""
+        if not config.get("host"):
+            raise RuntimeError("Kitsu host is not configured")
+        if config.get("token"):
+            return KitsuClient(config["host"], config["token"])
+        if not (config.get("email") and config.get("password")):
+            raise RuntimeError("Kitsu email and password (or a bot token) are not configured")
+        return KitsuClient.login(config["host"], config["email"], config["password"])
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Ignoring unreadable Kitsu config: {e}")
+        return config
+
+
+# Singleton instance factory
+_kitsu_service_instance = None
+
+
+def get_kitsu_service() -> KitsuService:
+    """
+    Get singleton instance of KitsuService.
+
+    Returns:
+        KitsuService: Singleton service instance
+    """
+    global _kitsu_service_instance
+    if _kitsu_service_instance is None:
+        _kitsu_service_instance = KitsuService()
+    return _kitsu_service_instance
@@ -0,0 +1,449 @@
+# -*- coding: utf-8 -*-
+"""
+Library Migration Service Implementation
+Packages whole libraries, and moves them between locations and metadata backends
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A library package is a zip of every library file - assets, thumbnails, version
+history, settings - plus the library database as portable JSON::
+
+    props.amlib
+        package.json                     <- format, source root, database tables
+        assets/scenes/crate.ma
+        assets/scenes/.thumbnails/crate_screenshot.png
+        assets/scenes/.versions/crate/v001/crate.ma
+        .assetmanager/naming_templates.json
+
+Importing a package unpacks it into the new root, rebuilds the database and rewrites
+the paths stored under the old root. The same migration runs on a library moved by
+hand (a copy to a new server), and upgrades JSON sidecar metadata to the database::
+
+    python -m assetmanager migrate --library //new/assets --rewrite //old/assets //new/assets
+
+Library-relative paths need no rewrite. Absolute paths are rewritten in the database,
+JSON sidecars and descriptors, and Maya ASCII scenes; Maya binary scenes still naming
+the old root are reported for relinking in Maya.
+"""
+
+import json
+import logging
+import re
+import zipfile
+from datetime import datetime
+from pathlib import Path, PurePosixPath
+from typing import Any, Callable, Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.library_migration import (
+    BACKEND_DATABASE,
+    BACKEND_JSON,
+    LibraryMigrationReport,
+)
+from .lock_service_impl import LOCKS_DIR_NAME
+from .metadata_database_impl import (
+    DATABASE_DIR_NAME,
+    DATABASE_FILE_NAME,
+    SIDECAR_SUFFIX,
+    get_metadata_database,
+)
+from .publish_transaction_service_impl import STAGING_DIR_NAME
+
+PACKAGE_EXTENSION = ".amlib"
+PACKAGE_MANIFEST_NAME = "package.json"
+PACKAGE_FORMAT_VERSION = 1
+
+# The database travels as JSON tables; its files belong to the library being left
+_DATABASE_FILES = {DATABASE_FILE_NAME + suffix for suffix in ("", "-journal", "-wal", "-shm")}
+# Check-out locks belong to the artists of the old location, unfinished publishes to nobody
+_SKIPPED_DIR_NAMES = {LOCKS_DIR_NAME, STAGING_DIR_NAME}
+
+# Metadata sidecars, settings, and the JSON asset descriptors
+_JSON_SUFFIXES = {
+    SIDECAR_SUFFIX,
+    ".json",
+    ".assembly",
+    ".material",
+    ".pose",
+    ".blendshape",
+    ".skinweights",
+    ".animclip",
+    ".lightrig",
+    ".groom",
+    ".texset",
+    ".volume",
+}
+_MA_STRING = re.compile(r'"((?:[^"\\]|\\.)*)"')
+_WINDOWS_ROOT = re.compile(r"^(?:[A-Za-z]:|//)")
+
+Progress = Callable[[int, int], None]
+
+
+def rewrite_path(value: str, path_map: List[Tuple[str, str]]) -> str:
+    """
+    Move a stored path from an old library root to its new root
+
+    Separators are compared as forward slashes; Windows roots (drive letters, UNC
+    shares) compare case-insensitively. Values outside every old root are returned
+    unchanged.
+    """
+    normalized = value.replace("\\", "/")
+    for old_root, new_root in path_map:
+        old = old_root.replace("\\", "/").rstrip("/")
+        if not old:
+            continue
+        head = normalized[: len(old)]
+        same = head.lower() == old.lower() if _WINDOWS_ROOT.match(old) else head == old
+        rest = normalized[len(old):]
+        if same and (not rest or rest.startswith("/")):
+            return new_root.replace("\\", "/").rstrip("/") + rest
+    return value
+
+
+class LibraryMigrationService:
+    """
+    Library Migration Service - Single Responsibility for moving libraries
+    Pure file and database operations; runs without Maya
+    """
+
+    def __init__(self, database_factory: Optional[Callable[[Path], Any]] = None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+
+    # Backends ---------------------------------------------------------------------------
+
+    def detect_backend(self, library_root: Path) -> str:
+        """Get the metadata backend a library uses (database once it has one)"""
+        if (Path(library_root) / DATABASE_DIR_NAME / DATABASE_FILE_NAME).is_file():
+            return BACKEND_DATABASE
+        return BACKEND_JSON
+
+    # Packages ---------------------------------------------------------------------------
+
+    def export_package(
+        self, library_root: Path, package_file: Path, progress: Optional[Progress] = None
+    ) -> LibraryMigrationReport:
+        """
+        Package a library's files and metadata into one zip file
+
+        Args:
+            library_root: Library (project) root
+            package_file: Package to write (.amlib)
+            progress: Called with (files packaged, total)
+
+        Returns:
+            What was packaged
+        """
+        library_root, package_file = Path(library_root), Path(package_file)
+        report = LibraryMigrationReport(library_root)
+        tables = self._database_factory(library_root).export_tables()
+        report.metadata_rows = sum(len(rows) for rows in tables.values())
+
+        files = [f for f in self._iter_library_files(library_root) if f != package_file]
+        manifest = {
+            "format": PACKAGE_FORMAT_VERSION,
+            "library": library_root.name,
+            "source_root": library_root.resolve().as_posix(),
+            "exported": datetime.now().isoformat(),
+            "tables": tables,
+        }
+
+        package_file.parent.mkdir(parents=True, exist_ok=True)
+        with zipfile.ZipFile(package_file, "w", zipfile.ZIP_DEFLATED, allowZip64=True) as zf:
+            zf.writestr(PACKAGE_MANIFEST_NAME, json.dumps(manifest, indent=2))
+            for index, path in enumerate(files, 1):
+                zf.write(path, path.relative_to(library_root).as_posix())
+                report.files += 1
+                if progress is not None:
+                    progress(index, len(files))
+
+        print(f"[OK] Packaged {library_root.name}: {report.summary()}")
+        return report
+
+    def read_package_manifest(self, package_file: Path) -> Dict[str, Any]:
+        """Get a package's manifest, raising ValueError for files that are not packages"""
+        try:
+            with zipfile.ZipFile(package_file) as zf:
+                manifest = json.loads(zf.read(PACKAGE_MANIFEST_NAME).decode("utf-8"))
+        except (KeyError, zipfile.BadZipFile, json.JSONDecodeError) as e:
+            raise ValueError(f"{Path(package_file).name} is not a library package: {e}")
+        if int(manifest.get("format", 0)) > PACKAGE_FORMAT_VERSION:
+            raise ValueError(
+                f"{Path(package_file).name} was written by a newer Asset Manager "
+                f"(package format {manifest['format']})"
+            )
+        return manifest
+
+    def import_package(
+        self,
+        package_file: Path,
+        library_root: Path,
+        overwrite: bool = False,
+        progress: Optional[Progress] = None,
+    ) -> LibraryMigrationReport:
+        """
+        Unpack a package into a library root and point its metadata at the new root
+
+        Args:
+            package_file: Package written by export_package
+            library_root: Folder the library is unpacked into
+            overwrite: Replace files already in the folder instead of refusing
+            progress: Called with (files unpacked, total)
+
+        Returns:
+            What was unpacked and rewritten
+
+        Raises:
+            ValueError: If the file is not a package or holds unsafe paths
+            FileExistsError: If package files exist in the folder and overwrite is off
+        """
+        library_root = Path(library_root)
+        manifest = self.read_package_manifest(package_file)
+        report = LibraryMigrationReport(library_root)
+
+        with zipfile.ZipFile(package_file) as zf:
+            unpacked = self.unpack_archive(
+                zf, library_root, PACKAGE_MANIFEST_NAME, "package", overwrite, progress
+            )
+            report.files = len(unpacked)
+
+        database = self._database_factory(library_root)
+        report.metadata_rows = database.import_tables(manifest.get("tables", {}))
+
+        source_root = manifest.get("source_root", "")
+        path_map = [(source_root, library_root.resolve().as_posix())] if source_root else []
+        migrated = self.migrate(library_root, path_map)
+        report.paths_rewritten = migrated.paths_rewritten
+        report.needs_relink = migrated.needs_relink
+        report.warnings = migrated.warnings
+
+        print(f"[OK] Imported {manifest.get('library', library_root.name)}: {report.summary()}")
+        return report
+
+    def unpack_archive(
+        self,
+        archive: zipfile.ZipFile,
+        library_root: Path,
+        manifest_name: str,
+        kind: str = "package",
+        overwrite: bool = False,
+        progress: Optional[Progress] = None,
+    ) -> List[Path]:
+        """
+        Unpack an archive's files (all but its manifest) at their library-relative paths
+
+        Args:
+            archive: Open package or asset bundle
+            manifest_name: Member describing the archive, not unpacked
+            kind: What the archive is, for error messages
+
+        Returns:
+            Files unpacked
+
+        Raises:
+            ValueError: If a member's path leaves the library
+            FileExistsError: If archive files exist in the library and overwrite is off
+        """
+        members = [m for m in archive.infolist() if not m.is_dir()]
+        members = [m for m in members if m.filename != manifest_name]
+        targets = [(m, self._get_member_target(library_root, m, kind)) for m in members]
+        if not overwrite:
+            existing = [target for _member, target in targets if target.exists()]
+            if existing:
+                raise FileExistsError(
+                    f"{len(existing)} {kind} file(s) already exist in {library_root.name}, "
+                    f"e.g. {existing[0].relative_to(library_root).as_posix()}"
+                )
+        unpacked = []
+        for index, (member, target) in enumerate(targets, 1):
+            target.parent.mkdir(parents=True, exist_ok=True)
+            with archive.open(member) as source, open(target, "wb") as destination:
+                while True:
+                    chunk = source.read(1 << 20)
+                    if not chunk:
+                        break
+                    destination.write(chunk)
+            unpacked.append(target)
+            if progress is not None:
+                progress(index, len(targets))
+        return unpacked
+
+    # Migration --------------------------------------------------------------------------
+
+    def migrate(
+        self,
+        library_root: Path,
+        path_map: Iterable[Tuple[str, str]],
+        backend: str = BACKEND_DATABASE,
+    ) -> LibraryMigrationReport:
+        """
+        Rewrite a library's stored paths and upgrade its metadata backend
+
+        Args:
+            library_root: Library (project) root, already at its new location
+            path_map: (old root, new root) pairs; the first matching pair wins
+            backend: Metadata backend to end up with (only upgrades are supported)
+
+        Returns:
+            What was upgraded and rewritten
+        """
+        if backend != BACKEND_DATABASE:
+            raise ValueError(f"Cannot migrate metadata to the '{backend}' backend")
+        library_root = Path(library_root)
+        path_map = [(str(old), str(new)) for old, new in path_map]
+        report = LibraryMigrationReport(library_root)
+
+        # Sidecars are imported only into a new database - afterwards they are stale
+        was_json = self.detect_backend(library_root) == BACKEND_JSON
+        database = self._database_factory(library_root)
+        if was_json:
+            report.sidecars_migrated = database.migrate_from_json(force=True)
+
+        if path_map:
+            tables = database.export_tables()
+            rewritten = self._rewrite_tables(tables, path_map)
+            if rewritten:
+                report.metadata_rows = database.import_tables(tables)
+            report.paths_rewritten += rewritten
+            files = self._iter_library_files(library_root)
+            report.paths_rewritten += self.rewrite_files(files, path_map, report)
+
+        if report.needs_relink:
+            print(f"[WARNING] {len(report.needs_relink)} binary scene(s) still use old paths")
+        print(f"[OK] Migrated {library_root.name}: {report.summary()}")
+        return report
+
+    def rewrite_files(
+        self,
+        files: Iterable[Path],
+        path_map: List[Tuple[str, str]],
+        report: LibraryMigrationReport,
+    ) -> int:
+        """Rewrite stored paths in some library files (just unpacked) - returns paths changed"""
+        return sum(self._rewrite_file(Path(path), path_map, report) for path in files)
+
+    def rewrite_data(self, data: Any, path_map: List[Tuple[str, str]]) -> Tuple[Any, int]:
+        """Rewrite every stored path of JSON data (e.g. asset metadata) - returns (data, count)"""
+        return self._rewrite_json(data, path_map)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _iter_library_files(self, library_root: Path) -> List[Path]:
+        """Get every file of a library except database files and check-out locks"""
+        files = []
+        for path in sorted(Path(library_root).rglob("*")):
+            relative = path.relative_to(library_root)
+            if any(part in _SKIPPED_DIR_NAMES for part in relative.parts[:-1]):
+                continue
+            if relative.parts[:1] == (DATABASE_DIR_NAME,) and path.name in _DATABASE_FILES:
+                continue
+            if path.is_file():
+                files.append(path)
+        return files
+
+    def _get_member_target(
+        self, library_root: Path, member: zipfile.ZipInfo, kind: str = "package"
+    ) -> Path:
+        """Get where an archive member unpacks, refusing paths leaving the library"""
+        relative = PurePosixPath(member.filename.replace("\\", "/"))
+        if relative.is_absolute() or ".." in relative.parts or ":" in member.filename:
+            raise ValueError(f"{kind.capitalize()} holds an unsafe path: {member.filename}")
+        return library_root.joinpath(*relative.parts)
+
+    def _rewrite_tables(
+        self, tables: Dict[str, List[Dict[str, Any]]], path_map: List[Tuple[str, str]]
+    ) -> int:
+        """Rewrite stored paths in database rows in place - returns paths changed"""
+        changed = 0
+        for rows in tables.values():
+            for row in rows:
+                for column, value in row.items():
+                    if not isinstance(value, str):
+                        continue
+                    try:
+                        data = json.loads(value) if value[:1] in "[{" else None
+                    except json.JSONDecodeError:
+                        data = None
+                    if isinstance(data, (dict, list)):
+                        data, count = self._rewrite_json(data, path_map)
+                        if count:
+                            row[column] = json.dumps(data)
+                    else:
+                        row[column] = rewrite_path(value, path_map)
+                        count = int(row[column] != value)
+                    changed += count
+        return changed
+
+    def _rewrite_json(self, data: Any, path_map: List[Tuple[str, str]]) -> Tuple[Any, int]:
+        """Rewrite every string value of a JSON document - returns (data, paths changed)"""
+        if isinstance(data, str):
+            rewritten = rewrite_path(data, path_map)
+            return rewritten, int(rewritten != data)
+        if isinstance(data, list):
+            items = [self._rewrite_json(item, path_map) for item in data]
+            return [item for item, _ in items], sum(count for _, count in items)
+        if isinstance(data, dict):
+            items = {key: self._rewrite_json(value, path_map) for key, value in data.items()}
+            return (
+                {key: item for key, (item, _) in items.items()},
+                sum(count for _, count in items.values()),
+            )
+        return data, 0
+
+    def _rewrite_file(
+        self, path: Path, path_map: List[Tuple[str, str]], report: LibraryMigrationReport
+    ) -> int:
+        """Rewrite stored paths in one library file - returns paths changed"""
+        suffix = path.suffix.lower()
+        try:
+            if suffix == ".mb":
+                content = path.read_bytes()
+                old_roots = [old.replace("\\", "/").encode("utf-8") for old, _ in path_map]
+                if any(old in content.replace(b"\\", b"/") for old in old_roots):
+                    report.needs_relink.append(path)
+                return 0
+            if suffix not in _JSON_SUFFIXES and suffix != ".ma":
+                return 0
+
+            text = path.read_text(encoding="utf-8")
+            if suffix == ".ma":
+                count = 0
+
+                def replace(match):
+                    nonlocal count
+                    value = match.group(1)
+                    rewritten = rewrite_path(value.replace("\\\\", "/"), path_map)
+                    if rewritten == value.replace("\\\\", "/"):
+                        return match.group(0)
+                    count += 1
+                    return f'"{rewritten}"'
+
+                rewritten_text = _MA_STRING.sub(replace, text)
+            else:
+                data, count = self._rewrite_json(json.loads(text), path_map)
+                rewritten_text = json.dumps(data, indent=2)
+
+            if count:
+                path.write_text(rewritten_text, encoding="utf-8")
+            return count
+        except (OSError, UnicodeDecodeError, json.JSONDecodeError) as e:
+            report.warnings.append(f"{path.name}: {e}")
+            self.logger.warning(f"Could not rewrite paths in {path}: {e}")
+            return 0
+
+
+# Singleton instance factory
+_library_migration_service_instance = None
+
+
+def get_library_migration_service() -> LibraryMigrationService:
+    """
+    Get singleton instance of LibraryMigrationService.
+
+    Returns:
+        LibraryMigrationService: Singleton service instance
+    """
+    global _library_migration_service_instance
+    if _library_migration_service_instance is None:
+        _library_migration_service_instance = LibraryMigrationService()
+    return _library_migration_service_instance
@@ -0,0 +1,264 @@
+# -*- coding: utf-8 -*-
+"""
+Library Registry Implementation
+Registered asset libraries and the libraries a Maya project mounts
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Libraries registered by the artist are stored in ~/.assetmanager/libraries.json. A Maya
+project can list the libraries it uses in <maya project>/asset_libraries.json::
+
+    {
+        "libraries": [
+            {"name": "Show Assets", "path": "assets/library", "scope": "project"},
+            {"name": "Studio Props", "path": "//server/library/props", "scope": "studio"}
+        ],
+        "default": "Show Assets"
+    }
+
+Relative paths are resolved against the Maya project, and environment variables are
+expanded, so "$ASSET_ROOT/props" works on every platform. Mounted libraries are listed
+while that project is set and are never written to libraries.json. Every library keeps
+its own database and settings in <library>/.assetmanager, so switching libraries only
+changes the root the browser loads.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Callable, List, Optional
+
+from ..core.models.library_entry import SCOPE_PERSONAL, SCOPES, LibraryEntry
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+PROJECT_CONFIG_FILE_NAME = "asset_libraries.json"
+
+
+def _same_path(first: Path, second: Path) -> bool:
+    """Check if two paths are the same folder"""
+    try:
+        return Path(first).resolve() == Path(second).resolve()
+    except OSError:
+        return Path(first) == Path(second)
+
+
+class LibraryRegistryService:
+    """
+    Library Registry Service - Single Responsibility for the list of known libraries
+    A broken project config mounts nothing instead of failing the project change
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "libraries.json"
+        self._registered: List[LibraryEntry] = self._load_registered()
+        self._mounted: List[LibraryEntry] = []
+        self._mounted_project: Optional[Path] = None
+        self._callbacks: List[Any] = []
+
+    # Registered libraries ---------------------------------------------------------------
+
+    def get_libraries(self) -> List[LibraryEntry]:
+        """Get mounted project libraries first, then registered ones (one entry per root)"""
+        libraries: List[LibraryEntry] = []
+        for entry in self._mounted + self._registered:
+            if not any(_same_path(entry.root_path, known.root_path) for known in libraries):
+                libraries.append(entry)
+        return libraries
+
+    def find_library(self, root_path: Path) -> Optional[LibraryEntry]:
+        """Get the entry of a library root, None if it is not known"""
+        for entry in self.get_libraries():
+            if _same_path(entry.root_path, root_path):
+                return entry
+        return None
+
+    def register_library(
+        self, name: str, root_path: Path, scope: str = SCOPE_PERSONAL
+    ) -> LibraryEntry:
+        """
+        Add a library to the picker, or rename/re-scope one already registered
+
+        Raises:
+            ValueError: If the name is empty, taken by another library, or the scope is unknown
+        """
+        name = name.strip()
+        if not name:
+            raise ValueError("Library name cannot be empty")
+        if scope not in SCOPES:
+            raise ValueError(f"Unknown library scope '{scope}' (use one of: {', '.join(SCOPES)})")
+        root_path = Path(root_path)
+        for entry in self._registered:
+            if entry.name.lower() == name.lower() and not _same_path(entry.root_path, root_path):
+                raise ValueError(f"A library named '{name}' is already registered")
+
+        entry = LibraryEntry(name=name, root_path=root_path, scope=scope)
+        self._registered = [
+            known for known in self._registered if not _same_path(known.root_path, root_path)
+        ]
+        self._registered.append(entry)
+        return entry
+
+    def remember_library(self, root_path: Path) -> LibraryEntry:
+        """Get the entry of a library root, registering (and saving) it when new"""
+        entry = self.find_library(root_path)
+        if entry is not None:
+            return entry
+        root_path = Path(root_path)
+        taken = {known.name.lower() for known in self._registered}
+        name, number = root_path.name or str(root_path), 2
+        while name.lower() in taken:
+            name, number = f"{root_path.name} {number}", number + 1
+        entry = self.register_library(name, root_path)
+        self.save()
+        return entry
+
+    def unregister_library(self, root_path: Path) -> bool:
+        """Remove a library from the picker (its files are left alone)"""
+        count = len(self._registered)
+        self._registered = [
+            entry for entry in self._registered if not _same_path(entry.root_path, root_path)
+        ]
+        return len(self._registered) != count
+
+    def save(self) -> bool:
+        """Write registered libraries to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump({"libraries": [e.to_dict() for e in self._registered]}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save library registry: {e}")
+            return False
+
+    def _load_registered(self) -> List[LibraryEntry]:
+        """Read registered libraries, skipping entries that cannot be read"""
+        if not self._config_file.exists():
+            return []
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+        except Exception as e:
+            print(f"[WARNING] Unreadable library registry {self._config_file}: {e}")
+            return []
+        return self._parse_entries(data.get("libraries") or [], self._config_file)
+
+    def _parse_entries(
+        self, items: List[Any], source: Path, base_dir: Optional[Path] = None
+    ) -> List[LibraryEntry]:
+        """Create entries from JSON, reporting the ones that are invalid"""
+        entries = []
+        for item in items:
+            try:
+                entries.append(LibraryEntry.from_dict(item, base_dir))
+            except (AttributeError, ValueError) as e:
+                print(f"[WARNING] Skipped library in {source}: {e}")
+        return entries
+
+    # Maya project libraries -------------------------------------------------------------
+
+    def get_project_config_file(self, maya_project: Path) -> Path:
+        """Get the file listing the libraries of a Maya project"""
+        return Path(maya_project) / PROJECT_CONFIG_FILE_NAME
+
+    def mount_project(self, maya_project: Optional[Path]) -> Optional[LibraryEntry]:
+        """
+        Replace the mounted libraries with the ones a Maya project lists
+
+        Args:
+            maya_project: Maya project root, None to unmount
+
+        Returns:
+            The project's default library (first listed when no default is named),
+            None when the project has no config
+        """
+        self._mounted = []
+        self._mounted_project = Path(maya_project) if maya_project else None
+        if maya_project is None:
+            return None
+
+        config_file = self.get_project_config_file(maya_project)
+        if not config_file.exists():
+            return None
+        try:
+            with open(config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+        except Exception as e:
+            print(f"[WARNING] Unreadable project libraries {config_file}: {e}")
+            return None
+
+        entries = self._parse_entries(data.get("libraries") or [], config_file, maya_project)
+        self._mounted = [
+            LibraryEntry(e.name, e.root_path, e.scope, mounted_by=Path(maya_project))
+            for e in entries
+        ]
+        if not self._mounted:
+            return None
+        print(f"[OK] Mounted {len(self._mounted)} libraries from {config_file}")
+
+        default_name = str(data.get("default") or "").lower()
+        for entry in self._mounted:
+            if entry.name.lower() == default_name:
+                return entry
+        return self._mounted[0]
+
+    def get_mounted_project(self) -> Optional[Path]:
+        """Get the Maya project whose libraries are mounted"""
+        return self._mounted_project
+
+    # Maya callbacks ---------------------------------------------------------------------
+
+    def install(self, on_project_changed: Callable[[], None]) -> bool:
+        """
+        Call back when the artist sets another Maya project
+
+        Returns:
+            True if the callback is registered
+        """
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+        except ImportError:
+            return False
+
+        self.uninstall()
+        try:
+            self._callbacks = [
+                om.MEventMessage.addEventCallback(
+                    "workspaceChanged", lambda *_args: on_project_changed()
+                )
+            ]
+        except Exception as e:
+            self.logger.warning(f"Failed to register Maya project callback: {e}")
+            return False
+        return True
+
+    def uninstall(self) -> None:
+        """Stop following Maya project changes"""
+        if not self._callbacks:
+            return
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+
+            om.MMessage.removeCallbacks(self._callbacks)
+        except Exception as e:
+            self.logger.warning(f"Failed to remove Maya project callback: {e}")
+        self._callbacks = []
+
+
+# Singleton instance factory
+_library_registry_instance = None
+
+
+def get_library_registry() -> LibraryRegistryService:
+    """
+    Get singleton instance of LibraryRegistryService.
+
+    Returns:
+        LibraryRegistryService: Singleton service instance
+    """
+    global _library_registry_instance
+    if _library_registry_instance is None:
+        _library_registry_instance = LibraryRegistryService()
+    return _library_registry_instance
@@ -0,0 +1,403 @@
+# -*- coding: utf-8 -*-
+"""
+Library Scan Service Implementation
+Incremental library scanning backed by a per-artist cache of the directory state
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The cache keeps every scanned folder's listing and every asset file's size,
+modification time, and asset record::
+
+    ~/.assetmanager/scan_cache.db
+
+A scan lists the library with os.scandir, skipping bookkeeping folders (.versions,
+.thumbnails, .lods...) instead of walking them, and only builds records (metadata
+extraction) for files that are new or whose size or timestamp changed. While a file
+watcher covers the library, scans re-list only the folders it reported as changed
+and take everything else from the cache.
+"""
+
+import json
+import logging
+import os
+import sqlite3
+import threading
+import time
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import Any, Callable, Dict, Iterable, List, Optional, Set, Tuple
+
+CACHE_FILE = Path.home() / ".assetmanager" / "scan_cache.db"
+
+_SCHEMA = """
+CREATE TABLE IF NOT EXISTS scanned_files (
+    library TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    path TEXT NOT NULL,
+    size INTEGER NOT NULL,
+    mtime_ns INTEGER NOT NULL,
+    record TEXT NOT NULL,
+    PRIMARY KEY (library, kind, path)
+);
+CREATE TABLE IF NOT EXISTS scanned_directories (
+    library TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    path TEXT NOT NULL,
+    subdirs TEXT NOT NULL,
+    files TEXT NOT NULL,
+    PRIMARY KEY (library, kind, path)
+);
+"""
+
+# Builds the cached record of a new or changed file; None skips the file
+RecordBuilder = Callable[[Path, os.stat_result], Optional[Dict[str, Any]]]
+
+
+@dataclass
+class ScanResult:
+    """Outcome of one library scan"""
+
+    records: Dict[str, Dict[str, Any]]  # Library-relative path -> asset record
+    added: List[str] = field(default_factory=list)
+    changed: List[str] = field(default_factory=list)
+    removed: List[str] = field(default_factory=list)
+    directories_listed: int = 0
+    incremental: bool = False  # Only watcher-reported folders were listed
+    seconds: float = 0.0
+
+    @property
+    def summary(self) -> str:
+        """Get display text (40212 assets: 3 new, 1 changed, 0 removed)"""
+        return (
+            f"{len(self.records)} assets: {len(self.added)} new, {len(self.changed)} changed, "
+            f"{len(self.removed)} removed ({self.directories_listed} folders listed "
+            f"in {self.seconds:.1f}s)"
+        )
+
+
+@dataclass
+class _LibraryState:
+    """Cached directory state of one library for one record kind"""
+
+    files: Dict[str, Tuple[int, int, Dict[str, Any]]]  # path -> (size, mtime_ns, record)
+    directories: Dict[str, Tuple[List[str], List[str]]]  # path -> (subdirs, file names)
+    complete: bool = False  # A full scan ran this session
+
+
+class LibraryScanService:
+    """
+    Library Scan Service - Single Responsibility for incremental asset discovery
+    Cache writes only touch the rows of files and folders that changed
+    """
+
+    def __init__(self, cache_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._cache_file = cache_file or CACHE_FILE
+        self._lock = threading.RLock()
+        self._connection: Optional[sqlite3.Connection] = None
+        self._states: Dict[Tuple[str, str], _LibraryState] = {}
+        self._watched: Set[str] = set()
+        self._pending: Dict[str, Set[str]] = {}  # library -> folders reported as changed
+        self._full_scan_requested: Set[str] = set()
+
+    # Watcher state ----------------------------------------------------------------------
+
+    def set_watched(self, library_root: Path, watched: bool) -> None:
+        """Tell the scanner whether a file watcher reports changes in the library"""
+        key = self._library_key(library_root)
+        if watched:
+            self._watched.add(key)
+        else:
+            self._watched.discard(key)
+
+    def is_watched(self, library_root: Path) -> bool:
+        """Check if a file watcher covers the library"""
+        return self._library_key(library_root) in self._watched
+
+    def mark_changed(self, library_root: Path, paths: Iterable[Path]) -> None:
+        """Queue folders (or the folders of files) to be listed again on the next scan"""
+        root = Path(library_root)
+        pending = self._pending.setdefault(self._library_key(root), set())
+        for path in paths:
+            path = Path(path)
+            folder = path if path.is_dir() else path.parent  # Deleted folders relist the parent
+            try:
+                pending.add(self._relative(root, folder))
+            except ValueError:
+                continue  # Outside the library
+
+    def request_full_scan(self, library_root: Path) -> None:
+        """List every folder again on the next scan (e.g. manual refresh)"""
+        self._full_scan_requested.add(self._library_key(library_root))
+
+    def get_directories(self, library_root: Path) -> List[Path]:
+        """Get the folders found by the last scans, for a file watcher to watch"""
+        root = Path(library_root)
+        library = self._library_key(root)
+        relatives: Set[str] = set()
+        for (state_library, _kind), state in self._states.items():
+            if state_library == library:
+                relatives.update(state.directories)
+        return [root / relative if relative else root for relative in sorted(relatives)]
+
+    # Scanning ---------------------------------------------------------------------------
+
+    def scan(
+        self,
+        library_root: Path,
+        kind: str,
+        is_candidate: Callable[[Path], bool],
+        build_record: RecordBuilder,
+    ) -> ScanResult:
+        """
+        Find the library's asset files, reusing cached records of unchanged files
+
+        Args:
+            library_root: Library (project) root
+            kind: Record layout name - repositories with different records need
+                different kinds so they never read each other's cache
+            is_candidate: Decides from the path which files are assets
+            build_record: Creates the cached record of a new or changed asset file
+
+        Returns:
+            Records of every asset plus what changed since the last scan
+        """
+        started = time.monotonic()
+        root = Path(library_root)
+        library = self._library_key(root)
+        state = self._get_state(library, kind)
+
+        pending = self._pending.pop(library, set())
+        incremental = (
+            state.complete
+            and library in self._watched
+            and library not in self._full_scan_requested
+        )
+        self._full_scan_requested.discard(library)
+
+        result = ScanResult(records={}, incremental=incremental)
+        files: Dict[str, Tuple[int, int, Dict[str, Any]]] = {}
+        directories: Dict[str, Tuple[List[str], List[str]]] = {}
+        listed: Set[str] = set()
+
+        stack = [""]
+        while stack:
+            relative_dir = stack.pop()
+            cached = state.directories.get(relative_dir)
+            if incremental and cached is not None and relative_dir not in pending:
+                subdirs, names = cached
+                for name in names:
+                    file_key = self._join(relative_dir, name)
+                    if file_key in state.files:
+                        files[file_key] = state.files[file_key]
+            else:
+                subdirs, names = self._list_directory(
+                    root, relative_dir, state, files, result, is_candidate, build_record
+                )
+                listed.add(relative_dir)
+            directories[relative_dir] = (subdirs, names)
+            stack.extend(self._join(relative_dir, name) for name in reversed(subdirs))
+
+        result.removed = sorted(set(state.files) - set(files))
+        result.records = {key: entry[2] for key, entry in files.items()}
+        result.directories_listed = len(listed)
+
+        self._write_changes(library, kind, state, files, directories, listed, result)
+        state.files, state.directories, state.complete = files, directories, True
+
+        result.seconds = time.monotonic() - started
+        print(f"[INFO] Library scan: {result.summary}")
+        return result
+
+    def clear_cache(self, library_root: Path) -> None:
+        """Forget everything cached for a library"""
+        library = self._library_key(library_root)
+        self._states = {key: state for key, state in self._states.items() if key[0] != library}
+        with self._lock:
+            connection = self._get_connection()
+            if connection is None:
+                return
+            with connection:
+                connection.execute("DELETE FROM scanned_files WHERE library = ?", (library,))
+                connection.execute(
+                    "DELETE FROM scanned_directories WHERE library = ?", (library,)
+                )
+
+    # Internals --------------------------------------------------------------------------
+
+    def _list_directory(
+        self,
+        root: Path,
+        relative_dir: str,
+        state: _LibraryState,
+        files: Dict[str, Tuple[int, int, Dict[str, Any]]],
+        result: ScanResult,
+        is_candidate: Callable[[Path], bool],
+        build_record: RecordBuilder,
+    ) -> Tuple[List[str], List[str]]:
+        """List one folder, diffing its asset files against the cache"""
+        subdirs: List[str] = []
+        names: List[str] = []
+        try:
+            entries = sorted(os.scandir(root / relative_dir), key=lambda entry: entry.name)
+        except OSError as e:
+            self.logger.warning(f"Could not list {root / relative_dir}: {e}")
+            return subdirs, names
+
+        for entry in entries:
+            if entry.name.startswith("."):
+                continue  # Bookkeeping folders (.versions, .thumbnails...) and hidden files
+            try:
+                if entry.is_dir():
+                    subdirs.append(entry.name)
+                    continue
+                path = Path(entry.path)
+                if not entry.is_file() or not is_candidate(path):
+                    continue
+                stat = entry.stat()
+            except OSError:
+                continue
+
+            file_key = self._join(relative_dir, entry.name)
+            cached = state.files.get(file_key)
+            if cached is not None and cached[:2] == (stat.st_size, stat.st_mtime_ns):
+                files[file_key] = cached
+                names.append(entry.name)
+                continue
+
+            try:
+                record = build_record(path, stat)
+            except Exception as e:
+                self.logger.warning(f"Could not read asset {path}: {e}")
+                record = None
+            if record is None:
+                continue
+            files[file_key] = (stat.st_size, stat.st_mtime_ns, record)
+            names.append(entry.name)
+            (result.changed if cached is not None else result.added).append(file_key)
+        return subdirs, names
+
+    def _get_state(self, library: str, kind: str) -> _LibraryState:
+        """Get the in-memory state, loading it from the cache file on first use"""
+        state = self._states.get((library, kind))
+        if state is not None:
+            return state
+
+        state = _LibraryState(files={}, directories={})
+        with self._lock:
+            connection = self._get_connection()
+            if connection is not None:
+                try:
+                    for row in connection.execute(
+                        "SELECT path, size, mtime_ns, record FROM scanned_files "
+                        "WHERE library = ? AND kind = ?",
+                        (library, kind),
+                    ):
+                        state.files[row[0]] = (row[1], row[2], json.loads(row[3]))
+                    for row in connection.execute(
+                        "SELECT path, subdirs, files FROM scanned_directories "
+                        "WHERE library = ? AND kind = ?",
+                        (library, kind),
+                    ):
+                        state.directories[row[0]] = (json.loads(row[1]), json.loads(row[2]))
+                except (sqlite3.Error, ValueError) as e:
+                    self.logger.warning(f"Ignoring unreadable scan cache: {e}")
+                    state = _LibraryState(files={}, directories={})
+        self._states[(library, kind)] = state
+        return state
+
+    def _write_changes(
+        self,
+        library: str,
+        kind: str,
+        state: _LibraryState,
+        files: Dict[str, Tuple[int, int, Dict[str, Any]]],
+        directories: Dict[str, Tuple[List[str], List[str]]],
+        listed: Set[str],
+        result: ScanResult,
+    ) -> None:
+        """Store the rows that differ from the cache"""
+        updated = result.added + result.changed
+        vanished = [path for path in state.directories if path not in directories]
+        relisted = [path for path in listed if state.directories.get(path) != directories[path]]
+        if not (updated or result.removed or vanished or relisted):
+            return
+
+        with self._lock:
+            connection = self._get_connection()
+            if connection is None:
+                return
+            try:
+                with connection:
+                    connection.executemany(
+                        "INSERT OR REPLACE INTO scanned_files VALUES (?, ?, ?, ?, ?, ?)",
+                        [
+                            (library, kind, path, files[path][0], files[path][1],
+                             json.dumps(files[path][2], default=str))
+                            for path in updated
+                        ],
+                    )
+                    connection.executemany(
+                        "DELETE FROM scanned_files WHERE library = ? AND kind = ? AND path = ?",
+                        [(library, kind, path) for path in result.removed],
+                    )
+                    connection.executemany(
+                        "INSERT OR REPLACE INTO scanned_directories VALUES (?, ?, ?, ?, ?)",
+                        [
+                            (library, kind, path, json.dumps(directories[path][0]),
+                             json.dumps(directories[path][1]))
+                            for path in relisted
+                        ],
+                    )
+                    connection.executemany(
+                        "DELETE FROM scanned_directories "
+                        "WHERE library = ? AND kind = ? AND path = ?",
+                        [(library, kind, path) for path in vanished],
+                    )
+            except sqlite3.Error as e:
+                self.logger.warning(f"Could not update scan cache: {e}")
+
+    def _get_connection(self) -> Optional[sqlite3.Connection]:
+        """Open the cache database on first use; None if it cannot be written"""
+        if self._connection is None:
+            try:
+                self._cache_file.parent.mkdir(parents=True, exist_ok=True)
+                self._connection = sqlite3.connect(
+                    str(self._cache_file), timeout=10.0, check_same_thread=False
+                )
+                self._connection.executescript(_SCHEMA)
+            except (OSError, sqlite3.Error) as e:
+                self.logger.warning(f"Scan cache unavailable, scanning without it: {e}")
+                self._connection = None
+        return self._connection
+
+    @staticmethod
+    def _library_key(library_root: Path) -> str:
+        return Path(library_root).as_posix()
+
+    @staticmethod
+    def _relative(root: Path, path: Path) -> str:
+        relative = path.relative_to(root).as_posix()
+        return "" if relative == "." else relative
+
+    @staticmethod
+    def _join(relative_dir: str, name: str) -> str:
+        return f"{relative_dir}/{name}" if relative_dir else name
+
+
+# Singleton instance factory
+_library_scan_service_instance = None
+
+
+def get_library_scan_service() -> LibraryScanService:
+    """
+    Get singleton instance of LibraryScanService.
+
+    Returns:
+        LibraryScanService: Singleton service instance
+    """
+    global _library_scan_service_instance
+    if _library_scan_service_instance is None:
+        _library_scan_service_instance = LibraryScanService()
+    return _library_scan_service_instance
@@ -0,0 +1,338 @@
+# -*- coding: utf-8 -*-
+"""
+Library Server Implementation
+Read-only HTTP API so web dashboards and review tools browse the same library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Runs without Maya (plain Python or mayapy) and only reads the library::
+
+    python -m assetmanager serve --library //srv/assets --port 8765
+
+    GET /api/library                      <- name, asset count, tags, collections
+    GET /api/assets?q=crate&type=maya_scene&tag=props&status=approved&offset=0&limit=100
+    GET /api/assets/assets/scenes/crate.ma              <- metadata and versions
+    GET /api/thumbnails/assets/scenes/crate.ma          <- screenshot image
+    GET /api/turntables/assets/scenes/crate.ma          <- turntable GIF/MP4 or playblast
+    GET /api/tags
+    GET /api/collections
+
+Assets are addressed by their path relative to the library root, the same key the
+library database uses. Only files found by the library scan are served, so requests
+cannot reach anything outside the library's assets.
+"""
+
+import json
+import logging
+import mimetypes
+import threading
+import time
+from http.server import BaseHTTPRequestHandler, ThreadingHTTPServer
+from pathlib import Path
+from typing import Any, Dict, Optional, Tuple
+from urllib.parse import parse_qs, quote, unquote, urlsplit
+
+from ..core.models.asset_status import parse_status
+from .asset_repository_impl import get_asset_type
+from .asset_status_service_impl import get_asset_status_service
+from .library_scan_service_impl import get_library_scan_service
+from .metadata_database_impl import get_metadata_database
+from .version_service_impl import get_version_service
+
+DEFAULT_HOST = "127.0.0.1"
+DEFAULT_PORT = 8765
+API_PREFIX = "api"
+SCAN_KIND = "server"  # Scan cache record layout of the server
+RESCAN_SECONDS = 30.0  # Listings are at most this old
+DEFAULT_PAGE_SIZE = 100
+MAX_PAGE_SIZE = 1000
+
+THUMBNAILS_DIR_NAME = ".thumbnails"
+SCREENSHOT_SUFFIXES = ("_screenshot.png", "_screenshot.jpg")
+TURNTABLE_SUFFIXES = ("_turntable.gif", "_turntable.mp4", "_playblast.mp4")
+
+# (status code, content type, body)
+Response = Tuple[int, str, bytes]
+
+
+class LibraryApi:
+    """
+    Library API - Single Responsibility for answering read requests about one library
+    Routing returns plain responses so endpoints can be tested without a socket
+    """
+
+    def __init__(
+        self,
+        library_root: Path,
+        metadata_database: Any = None,
+        scan_service: Any = None,
+        version_service: Any = None,
+    ):
+        """
+        Args:
+            library_root: Library (project) root to serve
+            metadata_database: Library database (the shared one when omitted)
+            scan_service: Incremental library scanner (the shared one when omitted)
+            version_service: Version history service (the shared one when omitted)
+        """
+        self.logger = logging.getLogger(__name__)
+        self._library_root = Path(library_root)
+        self._database = metadata_database or get_metadata_database(self._library_root)
+        self._scan_service = scan_service or get_library_scan_service()
+        self._version_service = version_service or get_version_service()
+        self._lock = threading.Lock()
+        self._records: Dict[str, Dict[str, Any]] = {}
+        self._scanned_at: Optional[float] = None
+
+    def handle(self, url: str) -> Response:
+        """Answer a GET request for a path with query string (/api/assets?q=crate)"""
+        parsed = urlsplit(url)
+        parts = [unquote(part) for part in parsed.path.split("/") if part]
+        query = {name: values[-1] for name, values in parse_qs(parsed.query).items()}
+        if not parts or parts[0] != API_PREFIX or len(parts) < 2:
+            return self._error(404, f"Unknown endpoint: {parsed.path}")
+
+        endpoint, key = parts[1], "/".join(parts[2:])
+        try:
+            if endpoint == "library" and not key:
+                return self._json(self.get_library_info())
+            if endpoint == "assets" and not key:
+                return self._json(self.list_assets(**self._get_list_options(query)))
+            if endpoint == "assets":
+                detail = self.get_asset(key)
+                return self._json(detail) if detail else self._error(404, f"No asset {key}")
+            if endpoint in ("thumbnails", "turntables") and key:
+                suffixes = SCREENSHOT_SUFFIXES if endpoint == "thumbnails" else TURNTABLE_SUFFIXES
+                return self._file(self.get_preview_file(key, suffixes), key)
+            if endpoint == "tags" and not key:
+                return self._json(self._database.get_all_tags())
+            if endpoint == "collections" and not key:
+                return self._json(self._database.get_collections())
+        except ValueError as e:
+            return self._error(400, str(e))
+        except Exception as e:
+            self.logger.error(f"Library API request {url} failed: {e}")
+            return self._error(500, str(e))
+        return self._error(404, f"Unknown endpoint: {parsed.path}")
+
+    # Endpoints --------------------------------------------------------------------------
+
+    def get_library_info(self) -> Dict[str, Any]:
+        """Get an overview of the library"""
+        return {
+            "name": self._library_root.name,
+            "asset_count": len(self._get_records()),
+            "tags": self._database.get_all_tags(),
+            "collections": sorted(self._database.get_collections()),
+        }
+
+    def list_assets(
+        self,
+        text: str = "",
+        asset_type: str = "",
+        tag: str = "",
+        status: str = "",
+        offset: int = 0,
+        limit: int = DEFAULT_PAGE_SIZE,
+    ) -> Dict[str, Any]:
+        """
+        Get one page of library assets sorted by path
+
+        Args:
+            text: Matches asset names and tags (case-insensitive)
+            asset_type: Asset type (maya_scene, material, light_rig...)
+            tag: Tag the assets carry
+            status: Lifecycle state (wip, pending_review, approved, deprecated)
+            offset: Assets skipped before the page
+            limit: Page size
+
+        Returns:
+            {"total": matching assets, "offset", "limit", "assets": summaries}
+        """
+        state = parse_status(status) if status else None
+        if status and state is None:
+            raise ValueError(f"Unknown status: {status}")
+        text, tag = text.strip().lower(), tag.strip().lower()
+        all_metadata = self._database.get_all_metadata()
+
+        matches = []
+        for key, record in sorted(self._get_records().items()):
+            metadata = all_metadata.get(key) or {}
+            tags = [str(item).lower() for item in metadata.get("tags") or []]
+            if asset_type and record["type"] != asset_type:
+                continue
+            if tag and tag not in tags:
+                continue
+            if text and text not in Path(key).stem.lower() and not any(text in t for t in tags):
+                continue
+            if state and get_asset_status_service().get_status(metadata).state != state:
+                continue
+            matches.append((key, record, metadata))
+
+        limit = max(1, min(limit, MAX_PAGE_SIZE))
+        offset = max(0, offset)
+        return {
+            "total": len(matches),
+            "offset": offset,
+            "limit": limit,
+            "assets": [self._summarize(*match) for match in matches[offset : offset + limit]],
+        }
+
+    def get_asset(self, key: str) -> Optional[Dict[str, Any]]:
+        """Get an asset's summary, full metadata, and version history"""
+        record = self._get_records().get(key)
+        if record is None:
+            return None
+        asset_file = self._library_root / key
+        metadata = self._database.get_asset_metadata(asset_file) or {}
+        detail = self._summarize(key, record, metadata)
+        detail["metadata"] = metadata
+        detail["versions"] = [
+            {
+                "number": version.number,
+                "label": version.label,
+                "author": version.author,
+                "created_date": version.created_date.isoformat() if version.created_date else None,
+                "notes": version.notes,
+            }
+            for version in self._version_service.get_versions(asset_file)
+        ]
+        return detail
+
+    def get_preview_file(self, key: str, suffixes: Tuple[str, ...]) -> Optional[Path]:
+        """Get the screenshot or turntable stored for a library asset"""
+        if key not in self._get_records():
+            return None
+        asset_file = self._library_root / key
+        thumbnails = asset_file.parent / THUMBNAILS_DIR_NAME
+        for suffix in suffixes:
+            preview = thumbnails / f"{asset_file.stem}{suffix}"
+            if preview.is_file():
+                return preview
+        return None
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_records(self) -> Dict[str, Dict[str, Any]]:
+        """Get the scanned assets, rescanning when the listing is too old"""
+        with self._lock:
+            now = time.monotonic()
+            if self._scanned_at is None or now - self._scanned_at > RESCAN_SECONDS:
+                result = self._scan_service.scan(
+                    self._library_root, SCAN_KIND, self._is_asset_file, self._build_record
+                )
+                self._records, self._scanned_at = result.records, now
+            return self._records
+
+    def _is_asset_file(self, file_path: Path) -> bool:
+        """Check if a file is an asset the library lists"""
+        return get_asset_type(file_path) != "unknown"
+
+    def _build_record(self, file_path: Path, stat_info: Any) -> Dict[str, Any]:
+        """Build the cached scan record of a new or changed asset file"""
+        return {
+            "type": get_asset_type(file_path),
+            "size": stat_info.st_size,
+            "modified": stat_info.st_mtime,
+        }
+
+    def _summarize(self, key: str, record: Dict[str, Any], metadata: Dict[str, Any]) -> Dict:
+        """Get the listing entry of an asset"""
+        url_key = quote(key)
+        has_thumbnail = self.get_preview_file(key, SCREENSHOT_SUFFIXES) is not None
+        has_turntable = self.get_preview_file(key, TURNTABLE_SUFFIXES) is not None
+        return {
+            "key": key,
+            "name": Path(key).stem,
+            "type": record["type"],
+            "size": record["size"],
+            "modified": time.strftime("%Y-%m-%dT%H:%M:%S", time.localtime(record["modified"])),
+            "tags": list(metadata.get("tags") or []),
+            "status": get_asset_status_service().get_status(metadata).state,
+            "url": f"/{API_PREFIX}/assets/{url_key}",
+            "thumbnail": f"/{API_PREFIX}/thumbnails/{url_key}" if has_thumbnail else None,
+            "turntable": f"/{API_PREFIX}/turntables/{url_key}" if has_turntable else None,
+        }
+
+    def _get_list_options(self, query: Dict[str, str]) -> Dict[str, Any]:
+        """Get list_assets arguments from query parameters"""
+        try:
+            offset = int(query.get("offset", 0))
+            limit = int(query.get("limit", DEFAULT_PAGE_SIZE))
+        except ValueError:
+            raise ValueError("offset and limit must be whole numbers")
+        return {
+            "text": query.get("q", ""),
+            "asset_type": query.get("type", ""),
+            "tag": query.get("tag", ""),
+            "status": query.get("status", ""),
+            "offset": offset,
+            "limit": limit,
+        }
+
+    def _json(self, data: Any) -> Response:
+        return 200, "application/json", json.dumps(data, default=str).encode("utf-8")
+
+    def _error(self, status: int, message: str) -> Response:
+        return status, "application/json", json.dumps({"error": message}).encode("utf-8")
+
+    def _file(self, path: Optional[Path], key: str) -> Response:
+        if path is None:
+            return self._error(404, f"No preview for {key}")
+        content_type = mimetypes.guess_type(path.name)[0] or "application/octet-stream"
+        return 200, content_type, path.read_bytes()
+
+
+def create_server(
+    library_root: Path,
+    host: str = DEFAULT_HOST,
+    port: int = DEFAULT_PORT,
+    allow_origin: str = "*",
+    api: Optional[LibraryApi] = None,
+) -> ThreadingHTTPServer:
+    """
+    Create the HTTP server of a library (call serve_forever to run it)
+
+    Args:
+        library_root: Library (project) root to serve
+        host: Interface to listen on (0.0.0.0 for every interface)
+        port: Port to listen on (0 picks a free one)
+        allow_origin: Access-Control-Allow-Origin sent to browsers, empty to leave it out
+        api: LibraryApi answering requests (one for the library when omitted)
+    """
+    library_api = api or LibraryApi(library_root)
+    logger = logging.getLogger(__name__)
+
+    class LibraryRequestHandler(BaseHTTPRequestHandler):
+        """Read-only request handler delegating to the library API"""
+
+        def do_GET(self) -> None:
+            status, content_type, body = library_api.handle(self.path)
+            self.send_response(status)
+            self.send_header("Content-Type", content_type)
+            self.send_header("Content-Length", str(len(body)))
+            if allow_origin:
+                self.send_header("Access-Control-Allow-Origin", allow_origin)
+            self.end_headers()
+            self.wfile.write(body)
+
+        def log_message(self, format: str, *args: Any) -> None:
+            logger.debug(f"{self.address_string()} {format % args}")
+
+    return ThreadingHTTPServer((host, port), LibraryRequestHandler)
+
+
+def serve(
+    library_root: Path, host: str = DEFAULT_HOST, port: int = DEFAULT_PORT, allow_origin: str = "*"
+) -> None:
+    """Serve a library until interrupted (Ctrl+C)"""
+    server = create_server(library_root, host, port, allow_origin)
+    print(f"[OK] Serving {library_root} at http://{host}:{server.server_port}/{API_PREFIX}/")
+    try:
+        server.serve_forever()
+    except KeyboardInterrupt:
+        print("[INFO] Library server stopped")
+    finally:
+        server.server_close()
+
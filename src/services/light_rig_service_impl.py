@@ -0,0 +1,409 @@
+# -*- coding: utf-8 -*-
+"""
+Light Rig Service Implementation
+Save light rigs and HDRI environments as assets and load them into the lighting scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A light rig asset is a small JSON descriptor like a material preset. Rigs saved from
+the scene keep their lights (Arnold skydomes, RenderMan domes, Maya lights) and
+networks in a hidden Maya ASCII file; HDRI environments only keep the image and build
+a dome light for their renderer on import::
+
+    assets/lighting/studio_softbox.lightrig
+    assets/lighting/.rigs/studio_softbox.ma
+    assets/lighting/.rigs/.dependencies/studio_softbox/textures/gobo.png
+    assets/lighting/sunset_beach.lightrig                              <- HDRI, no network
+    assets/lighting/.rigs/.dependencies/sunset_beach/textures/sunset_beach.exr
+    assets/lighting/.thumbnails/studio_softbox_screenshot.png          <- lit sphere
+
+Imported rigs are grouped under one transform tagged with the descriptor, which is
+how a later import finds the active rig to replace instead of stacking a second one.
+"""
+
+import json
+import logging
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from .dependency_service_impl import get_dependency_service
+from .version_service_impl import get_current_user
+
+LIGHT_RIG_EXTENSION = ".lightrig"
+LIGHT_RIG_FORMAT_VERSION = 1
+RIGS_DIR_NAME = ".rigs"
+
+KIND_RIG = "rig"
+KIND_HDRI = "hdri"
+
+# Attribute on the group of an imported rig, holding its descriptor path
+RIG_ATTRIBUTE = "assetManagerLightRig"
+
+HDRI_EXTENSIONS = {".hdr", ".exr", ".tx", ".tif", ".tiff"}
+
+# Light shape type -> renderer label shown in the library
+LIGHT_TYPES = {
+    "ambientLight": "maya",
+    "directionalLight": "maya",
+    "pointLight": "maya",
+    "spotLight": "maya",
+    "areaLight": "maya",
+    "volumeLight": "maya",
+    "aiSkyDomeLight": "arnold",
+    "aiAreaLight": "arnold",
+    "aiPhotometricLight": "arnold",
+    "aiMeshLight": "arnold",
+    "aiLightPortal": "arnold",
+    "PxrDomeLight": "renderman",
+    "PxrRectLight": "renderman",
+    "PxrDistantLight": "renderman",
+    "PxrSphereLight": "renderman",
+    "PxrDiskLight": "renderman",
+    "PxrCylinderLight": "renderman",
+    "PxrEnvDayLight": "renderman",
+}
+
+RENDERER_PLUGINS = {"arnold": "mtoa", "renderman": "RenderMan_for_Maya"}
+
+# Renderer -> dome light type that carries an HDRI
+DOME_LIGHT_TYPES = {"arnold": "aiSkyDomeLight", "renderman": "PxrDomeLight"}
+
+
+def get_rig_renderer(light_types: List[str]) -> str:
+    """Get the renderer a set of lights needs ("maya" when they are all native)"""
+    for light_type in light_types:
+        renderer = LIGHT_TYPES.get(light_type, "maya")
+        if renderer != "maya":
+            return renderer
+    return "maya"
+
+
+class LightRigService:
+    """
+    Light Rig Service - Single Responsibility for light rig and HDRI save and import
+    All Maya calls go through the cmds argument so rigs can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Scene queries ----------------------------------------------------------------------
+
+    def find_lights(
+        self, cmds: Any, selection: Optional[List[str]] = None
+    ) -> List[Tuple[str, str]]:
+        """
+        Get the light transforms of the selection, or of the whole scene
+
+        Returns:
+            (transform, light type) pairs
+        """
+        if selection is None:
+            shapes = cmds.ls(type=list(LIGHT_TYPES), long=True) or []
+        else:
+            shapes = []
+            for node in selection:
+                candidates = [node] + (cmds.listRelatives(node, shapes=True, fullPath=True) or [])
+                shapes.extend(
+                    shape
+                    for shape in cmds.ls(candidates, type=list(LIGHT_TYPES), long=True) or []
+                    if shape not in shapes
+                )
+
+        lights = []
+        for shape in shapes:
+            transform = (cmds.listRelatives(shape, parent=True, fullPath=True) or [shape])[0]
+            if transform not in [light for light, _type in lights]:
+                lights.append((transform, cmds.nodeType(shape)))
+        return lights
+
+    def find_active_rigs(self, cmds: Any) -> List[str]:
+        """Get the groups of light rigs imported into the scene"""
+        tagged = cmds.ls(f"*.{RIG_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        return list(tagged or [])
+
+    # Save -------------------------------------------------------------------------------
+
+    def save_light_rig(
+        self, cmds: Any, lights: List[str], descriptor_path: Path, notes: str = ""
+    ) -> Optional[Path]:
+        """
+        Export lights and their networks (HDRI and gobo textures) as a light rig asset
+
+        Args:
+            cmds: maya.cmds module
+            lights: Light transforms to save
+            descriptor_path: .lightrig file to write
+            notes: Free text description
+
+        Returns:
+            Written descriptor path, None if no light was given
+        """
+        descriptor_path = Path(descriptor_path).with_suffix(LIGHT_RIG_EXTENSION)
+        light_types = self._get_light_types(cmds, lights)
+        if not light_types:
+            print("[ERROR] No lights to save as a light rig")
+            return None
+
+        network_path = descriptor_path.parent / RIGS_DIR_NAME / f"{descriptor_path.stem}.ma"
+        network_path.parent.mkdir(parents=True, exist_ok=True)
+
+        # Copy textures next to the network and point the live nodes at them for export
+        dependency_service = get_dependency_service()
+        dependencies = dependency_service.scan_dependencies(list(lights))
+        collected = dependency_service.collect(network_path, dependencies)
+        try:
+            cmds.select(list(lights), replace=True)
+            cmds.file(
+                str(network_path),
+                exportSelected=True,
+                type="mayaAscii",
+                force=True,
+                constructionHistory=True,
+                channels=True,
+                constraints=False,
+                expressions=False,
+                shader=True,
+                preserveReferences=False,
+            )
+        finally:
+            dependency_service.restore_paths(dependencies, collected.repathed)
+            cmds.select(clear=True)
+
+        textures = [
+            path.relative_to(descriptor_path.parent).as_posix() for path in collected.copied_files
+        ]
+        skydome_textures = [
+            self._relative_texture(dependency.path, collected.copied_files, descriptor_path)
+            for dependency in dependencies
+            if self._feeds_dome(cmds, dependency.node)
+        ]
+        descriptor = self._make_descriptor(
+            descriptor_path,
+            KIND_RIG,
+            get_rig_renderer(list(light_types.values())),
+            notes,
+            lights=[
+                {"name": light.rsplit("|", 1)[-1], "type": light_type}
+                for light, light_type in light_types.items()
+            ],
+            network=network_path.relative_to(descriptor_path.parent).as_posix(),
+            hdri=next((texture for texture in skydome_textures if texture), ""),
+            textures=textures,
+        )
+        self._write_descriptor(descriptor_path, descriptor)
+
+        print(
+            f"[OK] Saved light rig {descriptor_path.name} ({len(light_types)} lights, "
+            f"{len(textures)} textures)"
+        )
+        return descriptor_path
+
+    def save_hdri(
+        self, image_path: Path, descriptor_path: Path, renderer: str = "arnold", notes: str = ""
+    ) -> Optional[Path]:
+        """
+        Copy an HDRI image into the library as an environment asset
+
+        Args:
+            image_path: .hdr / .exr / .tx image
+            descriptor_path: .lightrig file to write
+            renderer: Renderer whose dome light carries the image (arnold, renderman)
+            notes: Free text description
+
+        Returns:
+            Written descriptor path, None if the image or renderer is not usable
+        """
+        image_path = Path(image_path)
+        descriptor_path = Path(descriptor_path).with_suffix(LIGHT_RIG_EXTENSION)
+        if image_path.suffix.lower() not in HDRI_EXTENSIONS or not image_path.is_file():
+            print(f"[ERROR] Not an HDRI image: {image_path}")
+            return None
+        if renderer not in DOME_LIGHT_TYPES:
+            print(f"[ERROR] No dome light for renderer {renderer}")
+            return None
+
+        dependency_service = get_dependency_service()
+        network_path = descriptor_path.parent / RIGS_DIR_NAME / f"{descriptor_path.stem}.ma"
+        dependency_dir = dependency_service.get_dependency_directory(network_path)
+        target = dependency_dir / "textures" / image_path.name
+        copied = dependency_service.copy_dependencies({image_path.resolve(): target})
+        if not copied:
+            print(f"[ERROR] Could not copy {image_path.name} into the library")
+            return None
+
+        hdri = target.relative_to(descriptor_path.parent).as_posix()
+        descriptor = self._make_descriptor(
+            descriptor_path,
+            KIND_HDRI,
+            renderer,
+            notes,
+            lights=[{"name": descriptor_path.stem, "type": DOME_LIGHT_TYPES[renderer]}],
+            network="",
+            hdri=hdri,
+            textures=[hdri],
+        )
+        self._write_descriptor(descriptor_path, descriptor)
+
+        print(f"[OK] Saved HDRI environment {descriptor_path.name} ({renderer})")
+        return descriptor_path
+
+    def load_light_rig(self, descriptor_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .lightrig descriptor, None if it is not a valid light rig"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "light_rig":
+                return None
+            return descriptor
+        except Exception as e:
+            self.logger.error(f"Failed to read light rig {descriptor_path}: {e}")
+            return None
+
+    # Import -----------------------------------------------------------------------------
+
+    def import_light_rig(
+        self, cmds: Any, descriptor_path: Path, replace_active: bool = False
+    ) -> Optional[str]:
+        """
+        Import a light rig or HDRI environment under a tagged group
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .lightrig file
+            replace_active: Delete the rigs imported before once this one is loaded
+
+        Returns:
+            Group of the imported rig, None if the rig could not be imported
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_light_rig(descriptor_path)
+        if descriptor is None:
+            return None
+
+        renderer = descriptor.get("renderer", "maya")
+        plugin = RENDERER_PLUGINS.get(renderer)
+        if plugin:
+            try:
+                cmds.loadPlugin(plugin, quiet=True)
+            except Exception as e:
+                print(f"[WARNING] {plugin} not available, {renderer} lights may not load: {e}")
+
+        previous = self.find_active_rigs(cmds)
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        if descriptor.get("kind") == KIND_HDRI:
+            roots = [self._build_dome(cmds, descriptor_path, descriptor)]
+        else:
+            network_path = descriptor_path.parent / descriptor.get("network", "")
+            if not network_path.is_file():
+                print(f"[ERROR] Light rig network missing: {network_path}")
+                return None
+            new_nodes = cmds.file(
+                str(network_path), i=True, type="mayaAscii", returnNewNodes=True
+            ) or []
+            get_dependency_service().resolve_relative_paths(network_path, new_nodes)
+            roots = [
+                node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before
+            ]
+        if not roots:
+            print(f"[ERROR] {descriptor_path.name} has no lights to import")
+            return None
+
+        group = cmds.group(roots, name=f"{descriptor['name']}_lightRig")
+        cmds.addAttr(group, longName=RIG_ATTRIBUTE, dataType="string")
+        cmds.setAttr(f"{group}.{RIG_ATTRIBUTE}", descriptor_path.as_posix(), type="string")
+
+        if replace_active and previous:
+            cmds.delete(previous)
+            print(f"[OK] Replaced {len(previous)} light rig(s) with {descriptor['name']}")
+        else:
+            print(f"[OK] Imported light rig {descriptor['name']} as {group}")
+        return group
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_light_types(self, cmds: Any, lights: List[str]) -> Dict[str, str]:
+        """Get light type by transform, skipping nodes that are not lights"""
+        light_types = {}
+        for light in lights:
+            shapes = [light] + (cmds.listRelatives(light, shapes=True, fullPath=True) or [])
+            for shape in shapes:
+                light_type = cmds.nodeType(shape)
+                if light_type in LIGHT_TYPES:
+                    light_types[light] = light_type
+                    break
+        return light_types
+
+    def _feeds_dome(self, cmds: Any, texture_node: str) -> bool:
+        """Check if a texture node drives a dome light (the rig's environment image)"""
+        targets = cmds.listConnections(texture_node, source=False, destination=True) or []
+        return any(cmds.nodeType(target) in DOME_LIGHT_TYPES.values() for target in targets)
+
+    def _relative_texture(self, path: str, copied_files: List[Path], descriptor_path: Path) -> str:
+        """Get a collected texture relative to the descriptor, empty if it was not copied"""
+        name = Path(path).name
+        for copied in copied_files:
+            if copied.name == name:
+                return copied.relative_to(descriptor_path.parent).as_posix()
+        return ""
+
+    def _build_dome(self, cmds: Any, descriptor_path: Path, descriptor: Dict[str, Any]) -> str:
+        """Create the renderer's dome light lit by the environment image"""
+        renderer = descriptor.get("renderer", "arnold")
+        light_type = DOME_LIGHT_TYPES.get(renderer)
+        if light_type is None:
+            raise RuntimeError(f"No dome light for renderer {renderer}")
+        image = (descriptor_path.parent / descriptor["hdri"]).as_posix()
+
+        name = descriptor["name"]
+        shape = cmds.createNode(light_type, name=f"{name}DomeShape")
+        transform = cmds.listRelatives(shape, parent=True, fullPath=True)[0]
+        if renderer == "renderman":
+            cmds.setAttr(f"{shape}.lightColorMap", image, type="string")
+        else:
+            texture = cmds.shadingNode("file", asTexture=True, name=f"{name}_hdri")
+            cmds.setAttr(f"{texture}.fileTextureName", image, type="string")
+            cmds.connectAttr(f"{texture}.outColor", f"{shape}.color", force=True)
+        return transform
+
+    def _make_descriptor(
+        self, descriptor_path: Path, kind: str, renderer: str, notes: str, **fields: Any
+    ) -> Dict[str, Any]:
+        """Build a descriptor with the fields every light rig shares"""
+        descriptor = {
+            "type": "light_rig",
+            "format_version": LIGHT_RIG_FORMAT_VERSION,
+            "name": descriptor_path.stem,
+            "kind": kind,
+            "renderer": renderer,
+            "notes": notes,
+        }
+        descriptor.update(fields)
+        descriptor["author"] = get_current_user()
+        descriptor["created_date"] = datetime.now().isoformat()
+        return descriptor
+
+    def _write_descriptor(self, descriptor_path: Path, descriptor: Dict[str, Any]) -> None:
+        """Write a descriptor as indented JSON"""
+        descriptor_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+
+
+# Singleton instance factory
+_light_rig_service_instance = None
+
+
+def get_light_rig_service() -> LightRigService:
+    """
+    Get singleton instance of LightRigService.
+
+    Returns:
+        LightRigService: Singleton service instance
+    """
+    global _light_rig_service_instance
+    if _light_rig_service_instance is None:
+        _light_rig_service_instance = LightRigService()
+    return _light_rig_service_instance
@@ -0,0 +1,213 @@
+# -*- coding: utf-8 -*-
+"""
+Localization Service Implementation
+UI strings looked up in per-language translation files, with the artist's language setting
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+UI code wraps its English text in tr(), which is also the key into the translation
+files in src/ui/translations. A file maps each English string to its translation;
+missing and empty entries show the English text, so a partial translation still works::
+
+    {
+      "language": "Français",
+      "locale": "fr",
+      "strings": {
+        "&File": "&Fichier",
+        "Imported {name}": "{name} importé"
+      }
+    }
+
+template.json lists every string with empty translations; copy it to <locale>.json to
+start a new language, and run ``python -m assetmanager translations --locale fr`` after
+UI changes to add new strings to a file. The language is per artist, in
+~/.assetmanager/localization.json, and applies from the next Asset Manager start.
+"""
+
+import ast
+import json
+import logging
+from pathlib import Path
+from typing import Dict, List, Optional, Tuple
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+TRANSLATIONS_DIR = Path(__file__).resolve().parents[1] / "ui" / "translations"
+SOURCE_ROOT = Path(__file__).resolve().parents[1]
+
+SOURCE_LOCALE = "en"  # Language of the text in the code
+TEMPLATE_LOCALE = "template"  # Empty catalog new languages start from
+
+
+class LocalizationService:
+    """
+    Localization Service - Single Responsibility for translating UI strings
+    The catalog of the artist's language is loaded on the first lookup
+    """
+
+    def __init__(
+        self, config_file: Optional[Path] = None, translations_dir: Optional[Path] = None
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "localization.json"
+        self._translations_dir = Path(translations_dir or TRANSLATIONS_DIR)
+        self._strings: Optional[Dict[str, str]] = None
+
+    # Language setting -------------------------------------------------------------------
+
+    def get_language(self) -> str:
+        """Get the artist's UI language, English by default"""
+        if not self._config_file.is_file():
+            return SOURCE_LOCALE
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                return str(json.load(f).get("language") or SOURCE_LOCALE)
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable language setting {self._config_file}: {e}")
+            return SOURCE_LOCALE
+
+    def set_language(self, locale: str) -> bool:
+        """Store the artist's UI language, used from the next start"""
+        if locale not in dict(self.get_available_languages()):
+            self.logger.error(f"No translation file for language '{locale}'")
+            return False
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump({"language": locale}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save language setting: {e}")
+            return False
+
+    def get_available_languages(self) -> List[Tuple[str, str]]:
+        """Get (locale, language name) of every translation file, English first"""
+        languages = {SOURCE_LOCALE: "English"}
+        for catalog_file in sorted(self._translations_dir.glob("*.json")):
+            locale = catalog_file.stem
+            if locale == TEMPLATE_LOCALE:
+                continue
+            try:
+                with open(catalog_file, "r", encoding="utf-8") as f:
+                    languages[locale] = str(json.load(f).get("language") or locale)
+            except Exception as e:
+                print(f"[WARNING] Skipping unreadable translation file {catalog_file}: {e}")
+        return [(SOURCE_LOCALE, languages.pop(SOURCE_LOCALE))] + sorted(languages.items())
+
+    # Translation ------------------------------------------------------------------------
+
+    def load_catalog(self, locale: str) -> Dict[str, str]:
+        """Get the translations of a language, empty when it has no file"""
+        catalog_file = self._translations_dir / f"{locale}.json"
+        if not catalog_file.is_file():
+            return {}
+        try:
+            with open(catalog_file, "r", encoding="utf-8") as f:
+                strings = json.load(f).get("strings") or {}
+            return {str(key): str(value) for key, value in strings.items() if value}
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable translation file {catalog_file}: {e}")
+            return {}
+
+    def translate(self, text: str, **values) -> str:
+        """
+        Get the artist's language version of a UI string
+
+        Args:
+            text: English text, with {name} placeholders filled from values
+        """
+        if self._strings is None:
+            self._strings = self.load_catalog(self.get_language())
+        translated = self._strings.get(text, text)
+        if not values:
+            return translated
+        try:
+            return translated.format(**values)
+        except (KeyError, IndexError, ValueError):
+            return text.format(**values)  # Translation with broken placeholders
+
+    # Translation files ------------------------------------------------------------------
+
+    def extract_strings(self, source_root: Path = SOURCE_ROOT) -> List[str]:
+        """Get the literal text of every tr() call in the source, sorted"""
+        strings = set()
+        for source_file in Path(source_root).rglob("*.py"):
+            try:
+                tree = ast.parse(source_file.read_text(encoding="utf-8"))
+            except (SyntaxError, UnicodeDecodeError) as e:
+                print(f"[WARNING] Skipping {source_file} when extracting strings: {e}")
+                continue
+            for node in ast.walk(tree):
+                if (
+                    isinstance(node, ast.Call)
+                    and isinstance(node.func, ast.Name)
+                    and node.func.id == "tr"
+                    and node.args
+                    and isinstance(node.args[0], ast.Constant)
+                    and isinstance(node.args[0].value, str)
+                ):
+                    strings.add(node.args[0].value)
+        return sorted(strings)
+
+    def update_catalog(
+        self, locale: str, source_root: Path = SOURCE_ROOT, language: str = ""
+    ) -> Tuple[int, int]:
+        """
+        Bring a translation file in line with the source, keeping existing translations
+
+        English maps every string to itself and the template leaves them empty.
+
+        Returns:
+            Number of strings added and removed
+        """
+        catalog_file = self._translations_dir / f"{locale}.json"
+        existing: Dict[str, object] = {}
+        if catalog_file.is_file():
+            with open(catalog_file, "r", encoding="utf-8") as f:
+                existing = json.load(f)
+        old_strings = dict(existing.get("strings") or {})
+
+        sources = self.extract_strings(source_root)
+        strings = {}
+        for text in sources:
+            if locale == SOURCE_LOCALE:
+                strings[text] = old_strings.get(text) or text
+            elif locale == TEMPLATE_LOCALE:
+                strings[text] = ""
+            else:
+                strings[text] = old_strings.get(text, "")
+
+        catalog = {
+            "language": language or existing.get("language") or locale,
+            "locale": locale,
+            "strings": strings,
+        }
+        self._translations_dir.mkdir(parents=True, exist_ok=True)
+        with open(catalog_file, "w", encoding="utf-8") as f:
+            json.dump(catalog, f, indent=2, ensure_ascii=False)
+            f.write("\n")
+        added = len(set(strings) - set(old_strings))
+        removed = len(set(old_strings) - set(strings))
+        return added, removed
+
+
+# Singleton instance factory
+_localization_service_instance = None
+
+
+def get_localization_service() -> LocalizationService:
+    """
+    Get singleton instance of LocalizationService.
+
+    Returns:
+        LocalizationService: Singleton service instance
+    """
+    global _localization_service_instance
+    if _localization_service_instance is None:
+        _localization_service_instance = LocalizationService()
+    return _localization_service_instance
+
+
+def tr(text: str, **values) -> str:
+    """Translate a UI string into the artist's language - see LocalizationService.translate"""
+    return get_localization_service().translate(text, **values)
@@ -0,0 +1,144 @@
+# -*- coding: utf-8 -*-
+"""
+Lock Service Implementation
+File-based asset check-out locks for libraries shared by several artists
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Layout for an asset at ``assets/scenes/hero.ma``::
+
+    assets/scenes/hero.ma
+    assets/scenes/.locks/hero.ma.lock      <- JSON: user, host, timestamp
+
+Lock files are created with exclusive-create semantics so two artists checking
+out at the same moment cannot both win, even on a network share.
+"""
+
+import json
+import logging
+import os
+import socket
+from datetime import datetime
+from pathlib import Path
+from typing import Optional
+
+from ..core.interfaces.lock_service import ILockService
+from ..core.models.asset_lock import AssetLock
+from .version_service_impl import get_current_user
+
+LOCKS_DIR_NAME = ".locks"
+LOCK_FILE_SUFFIX = ".lock"
+
+
+class LockServiceImpl(ILockService):
+    """
+    Lock Service Implementation - Single Responsibility for asset locking
+    The lock file itself is the source of truth; nothing is cached
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def get_lock_file(self, asset_path: Path) -> Path:
+        """Get the lock file path for an asset (may not exist)"""
+        asset_path = Path(asset_path)
+        return asset_path.parent / LOCKS_DIR_NAME / f"{asset_path.name}{LOCK_FILE_SUFFIX}"
+
+    def check_out(self, asset_path: Path, user: Optional[str] = None) -> Optional[AssetLock]:
+        """Lock an asset for the given artist"""
+        asset_path = Path(asset_path)
+        user = user or get_current_user()
+
+        existing = self.get_lock(asset_path)
+        if existing is not None:
+            if existing.is_owned_by(user):
+                return existing
+            print(f"[WARNING] {asset_path.name} is checked out by {existing.description}")
+            return None
+
+        lock = AssetLock(
+            asset_path=asset_path,
+            user=user,
+            host=socket.gethostname(),
+            locked_date=datetime.now(),
+        )
+        lock_file = self.get_lock_file(asset_path)
+        try:
+            lock_file.parent.mkdir(parents=True, exist_ok=True)
+            # "x" fails if another artist created the lock file in the meantime
+            with open(lock_file, "x", encoding="utf-8") as f:
+                json.dump(lock.to_dict(), f, indent=2)
+        except FileExistsError:
+            winner = self.get_lock(asset_path)
+            if winner is not None and winner.is_owned_by(user):
+                return winner
+            print(f"[WARNING] {asset_path.name} was checked out by someone else first")
+            return None
+        except Exception as e:
+            self.logger.error(f"Failed to check out {asset_path}: {e}")
+            print(f"[ERROR] Failed to check out {asset_path.name}: {e}")
+            return None
+
+        print(f"[LOCK] {asset_path.name} checked out by {user}")
+        return lock
+
+    def check_in(self, asset_path: Path, user: Optional[str] = None, force: bool = False) -> bool:
+        """Release an asset lock"""
+        asset_path = Path(asset_path)
+        user = user or get_current_user()
+
+        lock = self.get_lock(asset_path)
+        if lock is None:
+            return True
+        if not lock.is_owned_by(user) and not force:
+            print(f"[WARNING] Cannot check in {asset_path.name}: locked by {lock.description}")
+            return False
+
+        try:
+            os.remove(self.get_lock_file(asset_path))
+            action = "checked in" if lock.is_owned_by(user) else f"unlocked (was {lock.user})"
+            print(f"[LOCK] {asset_path.name} {action} by {user}")
+            return True
+        except FileNotFoundError:
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to check in {asset_path}: {e}")
+            print(f"[ERROR] Failed to check in {asset_path.name}: {e}")
+            return False
+
+    def get_lock(self, asset_path: Path) -> Optional[AssetLock]:
+        """Get the current lock of an asset"""
+        lock_file = self.get_lock_file(asset_path)
+        if not lock_file.exists():
+            return None
+
+        try:
+            with open(lock_file, "r", encoding="utf-8") as f:
+                return AssetLock.from_dict(json.load(f))
+        except Exception as e:
+            # Unreadable lock (e.g. still being written) - treat asset as locked
+            self.logger.warning(f"Could not read lock file {lock_file}: {e}")
+            return AssetLock(asset_path=Path(asset_path), user="unknown")
+
+    def can_publish(self, asset_path: Path, user: Optional[str] = None) -> bool:
+        """Check if an artist may write over an asset"""
+        lock = self.get_lock(asset_path)
+        return lock is None or lock.is_owned_by(user or get_current_user())
+
+
+# Singleton instance factory
+_lock_service_instance = None
+
+
+def get_lock_service() -> LockServiceImpl:
+    """
+    Get singleton instance of LockServiceImpl.
+
+    Returns:
+        LockServiceImpl: Singleton service instance
+    """
+    global _lock_service_instance
+    if _lock_service_instance is None:
+        _lock_service_instance = LockServiceImpl()
+    return _lock_service_instance
@@ -0,0 +1,346 @@
+# -*- coding: utf-8 -*-
+"""
+LOD Service Implementation
+Publish level-of-detail variants of an asset and swap them in the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The asset file is the base representation (LOD0 unless noted otherwise); every
+other level is exported from its own selection into a hidden folder next to it::
+
+    assets/scenes/crate.ma                        <- LOD0 (base)
+    assets/scenes/.lods/crate/crate_LOD2.ma
+    assets/scenes/.lods/crate/crate_proxy.ma
+    assets/scenes/.lods/crate/lods.json          <- {"base_level": "LOD0", "variants": {...}}
+
+Imported LOD roots are tagged with the asset and level so the scene tool can swap
+them later; referenced LODs are swapped by repathing their reference node.
+"""
+
+import json
+import logging
+import uuid
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.lod_variant import LOD_LEVELS, LodVariant
+from .version_service_impl import get_current_user
+
+LODS_DIR_NAME = ".lods"
+MANIFEST_FILE_NAME = "lods.json"
+DEFAULT_BASE_LEVEL = "LOD0"
+
+# String attributes on the top-level transforms of an imported LOD
+ASSET_ATTRIBUTE = "assetManagerAsset"
+LEVEL_ATTRIBUTE = "assetManagerLod"
+GROUP_ATTRIBUTE = "assetManagerLodGroup"  # Shared by all roots of one import
+
+MAYA_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+
+@dataclass(frozen=True)
+class SceneLod:
+    """LOD of a library asset loaded in the scene"""
+
+    asset_file: Path
+    level: str
+    nodes: Tuple[str, ...] = ()  # Imported top-level transforms
+    reference_node: Optional[str] = None  # Set for referenced LODs
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate - LOD1, referenced)"""
+        kind = "referenced" if self.reference_node else "imported"
+        return f"{self.asset_file.stem} - {self.level}, {kind}"
+
+
+class LodService:
+    """
+    LOD Service - Single Responsibility for asset LOD variants
+    Scene access goes through the cmds argument so LOD swaps can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Variants ---------------------------------------------------------------------------
+
+    def get_lod_directory(self, asset_file: Path) -> Path:
+        """Get the folder an asset's LOD variants are stored in"""
+        asset_file = Path(asset_file)
+        return asset_file.parent / LODS_DIR_NAME / asset_file.stem
+
+    def get_variant_path(self, asset_file: Path, level: str) -> Path:
+        """Get the file a LOD level of an asset is published to"""
+        asset_file = Path(asset_file)
+        if level == self.get_base_level(asset_file):
+            return asset_file
+        return self.get_lod_directory(asset_file) / f"{asset_file.stem}_{level}{asset_file.suffix}"
+
+    def get_base_level(self, asset_file: Path) -> str:
+        """Get the level the asset file itself represents"""
+        return self._read_manifest(asset_file).get("base_level", DEFAULT_BASE_LEVEL)
+
+    def get_variants(self, asset_file: Path) -> List[LodVariant]:
+        """Get the published representations of an asset, base first then by level"""
+        asset_file = Path(asset_file)
+        manifest = self._read_manifest(asset_file)
+        base_level = manifest.get("base_level", DEFAULT_BASE_LEVEL)
+
+        variants = [LodVariant(base_level, asset_file, is_base=True)]
+        for level, entry in manifest.get("variants", {}).items():
+            published = entry.get("published")
+            variant = LodVariant(
+                level,
+                self.get_lod_directory(asset_file) / entry.get("file", ""),
+                author=entry.get("author", ""),
+                published=datetime.fromisoformat(published) if published else None,
+            )
+            if variant.exists:
+                variants.append(variant)
+        return variants[:1] + sorted(variants[1:], key=lambda variant: variant.sort_key)
+
+    def has_variants(self, asset_file: Path) -> bool:
+        """Check if an asset holds more than its base representation"""
+        return len(self.get_variants(asset_file)) > 1
+
+    def get_lod_files(self, asset_file: Path) -> List[Path]:
+        """Get the variant files and manifest, to version together with the asset"""
+        lod_dir = self.get_lod_directory(asset_file)
+        if not lod_dir.is_dir():
+            return []
+        return sorted(path for path in lod_dir.iterdir() if path.is_file())
+
+    def publish_variant(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        level: str,
+        selection: List[str],
+        author: Optional[str] = None,
+    ) -> Path:
+        """
+        Export a selection as one LOD level of an asset
+
+        Args:
+            cmds: maya.cmds module
+            asset_file: Library asset (.ma / .mb) the variant belongs to
+            level: One of LOD_LEVELS other than the base level
+            selection: Nodes that make up this representation
+            author: Artist recorded with the variant (current user when omitted)
+
+        Returns:
+            Written variant file
+
+        Raises:
+            ValueError: For unknown levels, the base level, or an empty selection
+        """
+        asset_file = Path(asset_file)
+        if level not in LOD_LEVELS:
+            raise ValueError(f"Unknown LOD level '{level}'")
+        if level == self.get_base_level(asset_file):
+            raise ValueError(f"{level} is the asset file itself - publish the asset instead")
+        if not selection:
+            raise ValueError(f"Select the nodes that make up {level}")
+        if asset_file.suffix.lower() not in MAYA_FILE_TYPES:
+            raise ValueError(f"{asset_file.suffix} assets cannot hold LOD variants")
+
+        variant_file = self.get_variant_path(asset_file, level)
+        variant_file.parent.mkdir(parents=True, exist_ok=True)
+        cmds.select(selection, replace=True)
+        cmds.file(
+            str(variant_file),
+            exportSelected=True,
+            type=MAYA_FILE_TYPES[asset_file.suffix.lower()],
+            force=True,
+        )
+        if not variant_file.is_file():
+            raise RuntimeError(f"Export did not write {variant_file.name}")
+
+        manifest = self._read_manifest(asset_file)
+        manifest.setdefault("base_level", DEFAULT_BASE_LEVEL)
+        manifest.setdefault("variants", {})[level] = {
+            "file": variant_file.name,
+            "author": author or get_current_user(),
+            "published": datetime.now().isoformat(),
+        }
+        self._write_manifest(asset_file, manifest)
+        print(f"[OK] Published {level} of {asset_file.stem}")
+        return variant_file
+
+    def identify(self, file_path: Path) -> Optional[Tuple[Path, str]]:
+        """
+        Get the asset and level a file represents
+
+        Returns:
+            (asset file, level), None if the file is no LOD of an asset with variants
+        """
+        file_path = Path(file_path)
+        asset_file = file_path
+        if file_path.parent.parent.name == LODS_DIR_NAME:
+            asset_dir = file_path.parent.parent.parent
+            asset_file = asset_dir / f"{file_path.parent.name}{file_path.suffix}"
+
+        variants = self.get_variants(asset_file)
+        if len(variants) < 2:
+            return None
+        for variant in variants:
+            if variant.file_path == file_path:
+                return asset_file, variant.level
+        return None
+
+    # Scene ------------------------------------------------------------------------------
+
+    def import_variant(
+        self, cmds: Any, asset_file: Path, level: str, namespace: str = ""
+    ) -> Tuple[List[str], List[str]]:
+        """
+        Import one LOD level and tag its top-level transforms for swapping
+
+        Args:
+            namespace: Namespace to import into, "" for the scene's root namespace
+
+        Returns:
+            (new top-level transforms, every node the import created)
+        """
+        variant_file = self.get_variant_path(asset_file, level)
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        new_nodes = cmds.file(
+            str(variant_file),
+            i=True,
+            type=MAYA_FILE_TYPES[variant_file.suffix.lower()],
+            returnNewNodes=True,
+            **({"namespace": namespace} if namespace else {}),
+        )
+        roots = [node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before]
+        self.tag_roots(cmds, roots, asset_file, level)
+        return roots, list(new_nodes or [])
+
+    def tag_roots(self, cmds: Any, roots: List[str], asset_file: Path, level: str) -> None:
+        """Record asset and level on imported top-level transforms"""
+        group = uuid.uuid4().hex[:12]
+        for root in roots:
+            for attribute, value in (
+                (ASSET_ATTRIBUTE, Path(asset_file).as_posix()),
+                (LEVEL_ATTRIBUTE, level),
+                (GROUP_ATTRIBUTE, group),
+            ):
+                if not cmds.attributeQuery(attribute, node=root, exists=True):
+                    cmds.addAttr(root, longName=attribute, dataType="string")
+                cmds.setAttr(f"{root}.{attribute}", value, type="string")
+
+    def find_scene_lods(self, cmds: Any) -> List[SceneLod]:
+        """Get the imported and referenced asset LODs in the scene"""
+        scene_lods: List[SceneLod] = []
+
+        groups: Dict[str, List[str]] = {}
+        tagged = cmds.ls(f"*.{LEVEL_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        for node in tagged or []:
+            if cmds.referenceQuery(node, isNodeReferenced=True):
+                continue
+            groups.setdefault(cmds.getAttr(f"{node}.{GROUP_ATTRIBUTE}") or node, []).append(node)
+        for nodes in groups.values():
+            asset_file = Path(cmds.getAttr(f"{nodes[0]}.{ASSET_ATTRIBUTE}"))
+            level = cmds.getAttr(f"{nodes[0]}.{LEVEL_ATTRIBUTE}")
+            scene_lods.append(SceneLod(asset_file, level, tuple(sorted(nodes))))
+
+        for reference_file in cmds.file(query=True, reference=True) or []:
+            file_path = Path(
+                cmds.referenceQuery(reference_file, filename=True, withoutCopyNumber=True)
+            )
+            identified = self.identify(file_path)
+            if identified is None:
+                continue
+            asset_file, level = identified
+            reference_node = cmds.referenceQuery(reference_file, referenceNode=True)
+            scene_lods.append(SceneLod(asset_file, level, reference_node=reference_node))
+
+        return sorted(scene_lods, key=lambda scene_lod: scene_lod.label)
+
+    def swap(self, cmds: Any, scene_lod: SceneLod, level: str) -> SceneLod:
+        """
+        Replace a loaded LOD with another level of the same asset
+
+        Referenced LODs keep their reference node and edits; imported LODs are
+        re-imported under the same parent, and a single new root takes the placement
+        of the old one.
+
+        Returns:
+            The loaded LOD after the swap
+        """
+        variant_file = self.get_variant_path(scene_lod.asset_file, level)
+        if not variant_file.is_file():
+            raise RuntimeError(f"{scene_lod.asset_file.stem} has no {level} variant")
+
+        cmds.undoInfo(openChunk=True, chunkName=f"Swap to {level}")
+        try:
+            if scene_lod.reference_node:
+                cmds.file(
+                    str(variant_file),
+                    loadReference=scene_lod.reference_node,
+                    type=MAYA_FILE_TYPES[variant_file.suffix.lower()],
+                )
+                swapped = SceneLod(
+                    scene_lod.asset_file, level, reference_node=scene_lod.reference_node
+                )
+            else:
+                anchor = scene_lod.nodes[0]
+                parents = cmds.listRelatives(anchor, parent=True, fullPath=True) or []
+                matrix = cmds.xform(anchor, query=True, matrix=True, worldSpace=True)
+                cmds.delete(list(scene_lod.nodes))
+
+                roots, _new_nodes = self.import_variant(cmds, scene_lod.asset_file, level)
+                placed = []
+                for root in roots:
+                    if parents:
+                        root = (cmds.parent(root, parents[0]) or [root])[0]
+                    if len(roots) == 1:
+                        cmds.xform(root, matrix=matrix, worldSpace=True)
+                    placed.append(root)
+                swapped = SceneLod(scene_lod.asset_file, level, tuple(placed))
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        print(f"[OK] Swapped {scene_lod.asset_file.stem} {scene_lod.level} -> {level}")
+        return swapped
+
+    # Manifest ---------------------------------------------------------------------------
+
+    def _read_manifest(self, asset_file: Path) -> Dict[str, Any]:
+        """Read an asset's LOD manifest, empty when it has no variants"""
+        manifest_file = self.get_lod_directory(asset_file) / MANIFEST_FILE_NAME
+        if not manifest_file.is_file():
+            return {}
+        try:
+            with open(manifest_file, "r", encoding="utf-8") as f:
+                return json.load(f)
+        except Exception as e:
+            self.logger.warning(f"Unreadable LOD manifest {manifest_file}: {e}")
+            return {}
+
+    def _write_manifest(self, asset_file: Path, manifest: Dict[str, Any]) -> None:
+        """Write an asset's LOD manifest"""
+        manifest_file = self.get_lod_directory(asset_file) / MANIFEST_FILE_NAME
+        manifest_file.parent.mkdir(parents=True, exist_ok=True)
+        with open(manifest_file, "w", encoding="utf-8") as f:
+            json.dump(manifest, f, indent=2)
+
+
+# Singleton instance factory
+_lod_service_instance = None
+
+
+def get_lod_service() -> LodService:
+    """
+    Get singleton instance of LodService.
+
+    Returns:
+        LodService: Singleton service instance
+    """
+    global _lod_service_instance
+    if _lod_service_instance is None:
+        _lod_service_instance = LodService()
+    return _lod_service_instance
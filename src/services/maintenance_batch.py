@@ -0,0 +1,93 @@
+# -*- coding: utf-8 -*-
+"""
+Maintenance Batch Script
+Runs inside mayapy to carry out the scheduled maintenance jobs of libraries
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Start it from cron or a Windows scheduled task every few minutes, or leave it
+running with --loop on a render node. Each pass runs the jobs whose schedule fired
+since they last ran and adds their results to the library's maintenance report.
+Thumbnails render in mayapy processes of their own, found next to this interpreter::
+
+    */5 * * * *  mayapy maintenance_batch.py --library /mnt/libraries/props
+
+Usage::
+
+    mayapy maintenance_batch.py --library LIBRARY [--library LIBRARY ...]
+                                [--task TASK ...] [--loop] [--interval SECONDS]
+"""
+
+import argparse
+import sys
+import time
+from pathlib import Path
+from typing import List
+
+_PLUGIN_DIR = str(Path(__file__).resolve().parents[2])
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line"""
+    parser = argparse.ArgumentParser(description="Run Asset Manager library maintenance")
+    parser.add_argument(
+        "--library", action="append", required=True, help="Library root (repeatable)"
+    )
+    parser.add_argument(
+        "--task",
+        action="append",
+        default=[],
+        help="Run this task now whatever its schedule (thumbnails, integrity, trash, "
+        "retention, stats, locks; repeatable)",
+    )
+    parser.add_argument("--loop", action="store_true", help="Keep running due jobs")
+    parser.add_argument(
+        "--interval", type=int, default=60, help="Seconds between passes with --loop"
+    )
+    return parser.parse_args(argv)
+
+
+def main(argv: List[str]) -> int:
+    """Run due (or named) maintenance jobs - returns process exit code"""
+    args = _parse_args(argv)
+    if _PLUGIN_DIR not in sys.path:
+        sys.path.insert(0, _PLUGIN_DIR)
+
+    try:
+        from src.core.models.maintenance_schedule import MAINTENANCE_TASKS
+        from src.services.maintenance_service_impl import get_maintenance_service
+
+        unknown = [task for task in args.task if task not in MAINTENANCE_TASKS]
+        if unknown:
+            print(f"[ERROR] Unknown maintenance task(s): {', '.join(unknown)}")
+            return 1
+
+        service = get_maintenance_service()
+        failed = False
+        while True:
+            for library in args.library:
+                library_root = Path(library)
+                if not library_root.is_dir():
+                    print(f"[ERROR] Library not found: {library_root}")
+                    failed = True
+                    continue
+                if args.task:
+                    results = service.run_jobs(library_root, args.task)
+                else:
+                    results = service.run_due(library_root)
+                failed = failed or any(not result.success for result in results)
+            if not args.loop:
+                return 1 if failed else 0
+            time.sleep(max(args.interval, 1))
+
+    except KeyboardInterrupt:
+        return 0
+
+    except Exception as e:
+        print(f"[ERROR] Maintenance batch failed: {e}")
+        return 1
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
@@ -0,0 +1,402 @@
+# -*- coding: utf-8 -*-
+"""
+Maintenance Service Implementation
+Run a library's scheduled maintenance jobs and keep the report of what they did
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Schedule, report, and refreshed stats sit with the library, so every machine running
+jobs for it shares them::
+
+    MyProject/.assetmanager/maintenance.json          <- jobs, cron schedules
+    MyProject/.assetmanager/maintenance_report.json   <- results, newest first
+    MyProject/.assetmanager/library_stats.json        <- last stats refresh
+    MyProject/.assetmanager/maintenance.running       <- held while jobs run
+
+Due jobs run from maintenance_batch.py (mayapy, e.g. started by cron or a Windows
+scheduled task) or, when the schedule allows, from an idle Maya session. The running
+file is created exclusively, so only one machine runs a library's jobs at a time.
+"""
+
+import json
+import logging
+import os
+import socket
+import threading
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import Callable, Dict, List, Optional, Tuple
+
+from ..core.models.asset_lock import AssetLock
+from ..core.models.maintenance_schedule import (
+    TASK_INTEGRITY,
+    TASK_LOCKS,
+    TASK_RETENTION,
+    TASK_STATS,
+    TASK_THUMBNAILS,
+    TASK_TRASH,
+    MaintenanceJob,
+    MaintenanceResult,
+    MaintenanceSchedule,
+)
+from .color_management_service_impl import get_color_management_service
+from .duplicate_service_impl import get_duplicate_service
+from .integrity_service_impl import get_integrity_service
+from .lock_service_impl import LOCK_FILE_SUFFIX, LOCKS_DIR_NAME, get_lock_service
+from .metadata_database_impl import get_metadata_database
+from .retention_service_impl import get_retention_service
+from .storage_service_impl import get_storage_service
+from .thumbnail_queue_impl import ThumbnailJob, ThumbnailQueue
+from .thumbnail_settings_service_impl import get_thumbnail_settings_service
+from .trash_service_impl import get_trash_service
+from .version_service_impl import get_version_service
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SCHEDULE_FILE_NAME = "maintenance.json"
+REPORT_FILE_NAME = "maintenance_report.json"
+STATS_FILE_NAME = "library_stats.json"
+RUNNING_FILE_NAME = "maintenance.running"
+
+# Results kept in the report, oldest dropped first
+MAX_REPORT_RESULTS = 500
+
+# Detail lines kept per result
+MAX_RESULT_DETAILS = 50
+
+# A running file older than this was left by a crashed run and is taken over
+RUNNING_TIMEOUT = timedelta(hours=6)
+
+# Renders a thumbnail job, returns success (a mayapy batch process by default)
+ThumbnailRunner = Callable[[ThumbnailJob], bool]
+
+
+class MaintenanceService:
+    """
+    Maintenance Service - Single Responsibility for scheduled library upkeep
+    Each task reuses the service the matching menu command uses
+    """
+
+    def __init__(self, thumbnail_runner: Optional[ThumbnailRunner] = None):
+        self.logger = logging.getLogger(__name__)
+        self._thumbnail_runner = thumbnail_runner
+
+    # Schedule ---------------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the maintenance schedule file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SCHEDULE_FILE_NAME
+
+    def load_schedule(self, library_root: Path) -> MaintenanceSchedule:
+        """Get a library's maintenance schedule, every job off when none is saved"""
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return MaintenanceSchedule()
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                return MaintenanceSchedule.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable maintenance schedule {settings_file}: {e}")
+            return MaintenanceSchedule()
+
+    def save_schedule(self, library_root: Path, schedule: MaintenanceSchedule) -> bool:
+        """Write a library's maintenance schedule"""
+        try:
+            settings_file = self.get_settings_file(library_root)
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump(schedule.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save maintenance schedule: {e}")
+            return False
+
+    def get_due_jobs(
+        self, library_root: Path, now: Optional[datetime] = None
+    ) -> List[MaintenanceJob]:
+        """Get the switched-on jobs whose schedule fired since they last ran"""
+        now = now or datetime.now()
+        last_runs = self.get_last_runs(library_root)
+        return [
+            job
+            for job in self.load_schedule(library_root).enabled_jobs
+            if job.is_due(last_runs.get(job.task), now)
+        ]
+
+    # Running ----------------------------------------------------------------------------
+
+    def run_due(
+        self, library_root: Path, now: Optional[datetime] = None, trigger: str = "schedule"
+    ) -> List[MaintenanceResult]:
+        """
+        Run every due job of a library, unless another machine is running them
+
+        Returns:
+            Results of the jobs run, in the order they ran
+        """
+        due = self.get_due_jobs(library_root, now)
+        if not due:
+            return []
+        return self.run_jobs(library_root, [job.task for job in due], trigger)
+
+    def run_jobs(
+        self, library_root: Path, tasks: List[str], trigger: str = "manual"
+    ) -> List[MaintenanceResult]:
+        """
+        Run maintenance tasks now and add their results to the report
+
+        Returns:
+            Results of the tasks run, empty when another machine holds the running file
+        """
+        library_root = Path(library_root)
+        if not self._acquire(library_root):
+            print(f"[INFO] Maintenance of {library_root.name} is already running elsewhere")
+            return []
+        try:
+            results = [self.run_task(library_root, task, trigger) for task in tasks]
+        finally:
+            self._release(library_root)
+        self._append_report(library_root, results)
+        return results
+
+    def run_in_background(
+        self,
+        library_root: Path,
+        finished: Callable[[List[MaintenanceResult]], None],
+        tasks: Optional[List[str]] = None,
+        trigger: str = "manual",
+    ) -> None:
+        """
+        Run tasks (the due jobs when None) on a worker thread
+
+        Args:
+            finished: Called from the worker thread with the results
+        """
+
+        def run() -> None:
+            try:
+                if tasks is None:
+                    results = self.run_due(library_root, trigger=trigger)
+                else:
+                    results = self.run_jobs(library_root, tasks, trigger)
+            except Exception as e:
+                self.logger.error(f"Maintenance of {library_root} failed: {e}")
+                results = []
+            finished(results)
+
+        threading.Thread(target=run, daemon=True).start()
+
+    def run_task(
+        self, library_root: Path, task: str, trigger: str = "manual"
+    ) -> MaintenanceResult:
+        """Run one task, turning its failure into a failed result"""
+        runners = {
+            TASK_THUMBNAILS: self.regenerate_thumbnails,
+            TASK_INTEGRITY: self.audit_integrity,
+            TASK_TRASH: self.purge_trash,
+            TASK_RETENTION: self.prune_versions,
+            TASK_STATS: self.refresh_stats,
+            TASK_LOCKS: self.release_stale_locks,
+        }
+        started = datetime.now()
+        try:
+            success, summary, details = runners[task](Path(library_root))
+        except Exception as e:
+            self.logger.error(f"Maintenance task {task} failed: {e}")
+            success, summary, details = False, f"Failed: {e}", []
+        result = MaintenanceResult(
+            task,
+            started,
+            datetime.now(),
+            success,
+            summary,
+            tuple(details[:MAX_RESULT_DETAILS]),
+            host=socket.gethostname(),
+            trigger=trigger,
+        )
+        print(f"[{'OK' if success else 'WARNING'}] {result.label}: {summary}")
+        return result
+
+    def _acquire(self, library_root: Path) -> bool:
+        """Create the running file, taking over one left by a crashed run"""
+        running_file = library_root / SETTINGS_DIR_NAME / RUNNING_FILE_NAME
+        running_file.parent.mkdir(parents=True, exist_ok=True)
+        if running_file.is_file():
+            age = datetime.now() - datetime.fromtimestamp(running_file.stat().st_mtime)
+            if age < RUNNING_TIMEOUT:
+                return False
+            running_file.unlink()
+        try:
+            with open(running_file, "x", encoding="utf-8") as f:
+                f.write(socket.gethostname())
+            return True
+        except FileExistsError:
+            return False
+
+    def _release(self, library_root: Path) -> None:
+        """Remove the running file"""
+        try:
+            os.remove(library_root / SETTINGS_DIR_NAME / RUNNING_FILE_NAME)
+        except FileNotFoundError:
+            pass
+
+    # Tasks ------------------------------------------------------------------------------
+
+    def regenerate_thumbnails(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """Render thumbnails that are missing or were made with other color management"""
+        database = get_metadata_database(library_root)
+        assets = get_duplicate_service().find_assets(library_root)
+        color_service = get_color_management_service()
+        transform = color_service.load_project_transform(library_root)
+        stale = set(color_service.find_stale_thumbnails(database, transform, assets))
+        wanted = [
+            asset
+            for asset in assets
+            if asset in stale or not ThumbnailJob(asset).still_path.is_file()
+        ]
+        if not wanted:
+            return True, f"All {len(assets)} thumbnail(s) up to date", []
+
+        settings_service = get_thumbnail_settings_service()
+        queue = ThumbnailQueue(runner=self._thumbnail_runner)
+        jobs = queue.enqueue_many(
+            wanted,
+            lambda path: settings_service.get_asset_settings(library_root, path, database),
+            color=transform,
+        )
+        queue.wait()
+        for job in jobs:
+            if job.status == "done":
+                color_service.record_thumbnail_transform(database, job.asset_path, transform)
+        failed = [job for job in jobs if job.status != "done"]
+        summary = f"Rendered {len(jobs) - len(failed)} of {len(jobs)} thumbnail(s)"
+        details = [f"{job.asset_path.name}: {job.error or 'failed'}" for job in failed]
+        return not failed, summary, details
+
+    def audit_integrity(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """Verify published files against their checksums"""
+        report = get_integrity_service().audit(get_metadata_database(library_root))
+        details = [f"{issue.label}: {issue.path}" for issue in report.problems]
+        return report.is_clean, report.summary, details
+
+    def purge_trash(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """Purge trash entries past the library's retention period"""
+        purged = get_trash_service().purge_expired(library_root)
+        return True, f"Purged {len(purged)} expired asset(s)", [entry.name for entry in purged]
+
+    def prune_versions(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """Prune old versions by the library's retention policy (listed only in a dry run)"""
+        retention_service = get_retention_service()
+        if not retention_service.load_policy(library_root).enabled:
+            return True, "Version retention is off", []
+        report = retention_service.run(library_root)
+        details = list(report.failed) + [version.label for version in report.versions]
+        return not report.failed, report.summary, details
+
+    def refresh_stats(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """
+        Recount assets, versions, and disk use into library_stats.json
+
+        Also records the day's storage snapshot; a passed storage quota fails the task so
+        it shows in the report.
+        """
+        assets = get_duplicate_service().find_assets(library_root)
+        by_type: Dict[str, int] = {}
+        versions = 0
+        for asset in assets:
+            by_type[asset.suffix.lower()] = by_type.get(asset.suffix.lower(), 0) + 1
+            versions += len(get_version_service().get_versions(asset))
+        storage = get_storage_service().measure(library_root)
+        disk_bytes = storage.total_bytes
+        stats = {
+            "refreshed": datetime.now().isoformat(),
+            "assets": len(assets),
+            "by_type": dict(sorted(by_type.items())),
+            "versions": versions,
+            "tags": len(get_metadata_database(library_root).get_all_tags()),
+            "disk_bytes": disk_bytes,
+        }
+        stats_file = library_root / SETTINGS_DIR_NAME / STATS_FILE_NAME
+        stats_file.parent.mkdir(parents=True, exist_ok=True)
+        with open(stats_file, "w", encoding="utf-8") as f:
+            json.dump(stats, f, indent=2)
+        summary = (
+            f"{len(assets)} asset(s), {versions} version(s), "
+            f"{disk_bytes / (1024 * 1024):.1f} MB on disk"
+        )
+        if storage.warnings:
+            summary += f", {len(storage.warnings)} quota warning(s)"
+        details = [warning.description for warning in storage.warnings]
+        details += [f"{suffix}: {count}" for suffix, count in stats["by_type"].items()]
+        return not storage.exceeded, summary, details
+
+    def release_stale_locks(self, library_root: Path) -> Tuple[bool, str, List[str]]:
+        """Release check-outs older than the schedule's stale lock age"""
+        max_age = timedelta(hours=self.load_schedule(library_root).stale_lock_hours)
+        lock_service = get_lock_service()
+        released: List[str] = []
+        for lock_file in sorted(library_root.rglob(f"{LOCKS_DIR_NAME}/*{LOCK_FILE_SUFFIX}")):
+            asset_file = lock_file.parent.parent / lock_file.name[: -len(LOCK_FILE_SUFFIX)]
+            lock = lock_service.get_lock(asset_file)
+            if lock is None or not self._is_stale(lock, lock_file, max_age):
+                continue
+            if lock_service.check_in(asset_file, force=True):
+                released.append(f"{asset_file.name} ({lock.description})")
+        return True, f"Released {len(released)} stale lock(s)", released
+
+    def _is_stale(self, lock: AssetLock, lock_file: Path, max_age: timedelta) -> bool:
+        """Check if a lock is older than the stale lock age"""
+        locked = lock.locked_date or datetime.fromtimestamp(lock_file.stat().st_mtime)
+        return datetime.now() - locked > max_age
+
+    # Report -----------------------------------------------------------------------------
+
+    def get_report(self, library_root: Path) -> List[MaintenanceResult]:
+        """Get the library's maintenance results, newest first"""
+        report_file = Path(library_root) / SETTINGS_DIR_NAME / REPORT_FILE_NAME
+        if not report_file.is_file():
+            return []
+        try:
+            with open(report_file, "r", encoding="utf-8") as f:
+                entries = json.load(f).get("results") or []
+            return [MaintenanceResult.from_dict(entry) for entry in entries]
+        except Exception as e:
+            self.logger.warning(f"Unreadable maintenance report {report_file}: {e}")
+            return []
+
+    def get_last_runs(self, library_root: Path) -> Dict[str, datetime]:
+        """Get when each task last ran"""
+        last_runs: Dict[str, datetime] = {}
+        for result in self.get_report(library_root):
+            last_runs.setdefault(result.task, result.started)
+        return last_runs
+
+    def _append_report(self, library_root: Path, results: List[MaintenanceResult]) -> None:
+        """Add results to the front of the report"""
+        if not results:
+            return
+        entries = [result.to_dict() for result in reversed(results)]
+        entries += [result.to_dict() for result in self.get_report(library_root)]
+        report_file = library_root / SETTINGS_DIR_NAME / REPORT_FILE_NAME
+        try:
+            with open(report_file, "w", encoding="utf-8") as f:
+                json.dump({"results": entries[:MAX_REPORT_RESULTS]}, f, indent=2)
+        except Exception as e:
+            self.logger.error(f"Failed to write maintenance report: {e}")
+
+
+# Singleton instance factory
+_maintenance_service_instance = None
+
+
+def get_maintenance_service() -> MaintenanceService:
+    """
+    Get singleton instance of MaintenanceService.
+
+    Returns:
+        MaintenanceService: Singleton service instance
+    """
+    global _maintenance_service_instance
+    if _maintenance_service_instance is None:
+        _maintenance_service_instance = MaintenanceService()
+    return _maintenance_service_instance
@@ -0,0 +1,381 @@
+# -*- coding: utf-8 -*-
+"""
+Material Service Implementation
+Save shading networks as material preset assets and assign them to meshes or faces
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A material asset is a small JSON descriptor; the network itself is a Maya ASCII file
+in a hidden folder so it does not show up as a separate scene asset::
+
+    assets/materials/red_car_paint.material
+    assets/materials/.networks/red_car_paint.ma
+    assets/materials/.networks/red_car_paint.mtlx                 <- Standard Surface only
+    assets/materials/.networks/.dependencies/red_car_paint/textures/flakes.png
+    assets/materials/.thumbnails/red_car_paint_screenshot.png     <- rendered swatch
+
+Only the surface (and displacement) shader networks are exported. A fresh shading
+group is built on import, so assigning never drags the source scene's geometry along.
+"""
+
+import json
+import logging
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .dependency_service_impl import get_dependency_service
+from .version_service_impl import get_current_user
+
+MATERIAL_EXTENSION = ".material"
+MATERIAL_FORMAT_VERSION = 1
+NETWORKS_DIR_NAME = ".networks"
+
+# Attribute tagging imported shaders with their preset, so repeat assigns reuse them
+PRESET_ATTRIBUTE = "assetManagerPreset"
+
+DEFAULT_SHADING_ENGINES = {"initialShadingGroup", "initialParticleSE"}
+
+# Shader node type prefixes -> renderer label shown in the library
+RENDERER_PREFIXES = [("ai", "arnold"), ("Pxr", "renderman")]
+
+
+def get_renderer(shader_type: str) -> str:
+    """Get the renderer a shader type belongs to ("maya" for native shaders)"""
+    for prefix, renderer in RENDERER_PREFIXES:
+        if shader_type.startswith(prefix):
+            return renderer
+    return "maya"
+
+
+class MaterialService:
+    """
+    Material Service - Single Responsibility for material preset save and assign
+    All Maya calls go through the cmds argument so presets can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Scene queries ----------------------------------------------------------------------
+
+    def find_shading_engines(self, cmds: Any, selection: List[str]) -> List[str]:
+        """Get shading groups of the selection (shaders, shading groups, meshes, or faces)"""
+        engines: List[str] = []
+        for node in selection:
+            if cmds.nodeType(node) == "shadingEngine":
+                found = [node]
+            else:
+                shapes = cmds.listRelatives(node, shapes=True, fullPath=True) or []
+                found = cmds.listConnections([node] + shapes, type="shadingEngine") or []
+            engines.extend(engine for engine in found if engine not in engines)
+        # The default groups are not presets anyone wants to save
+        return [engine for engine in engines if engine not in DEFAULT_SHADING_ENGINES]
+
+    def get_network_roots(self, cmds: Any, shading_engine: str) -> Dict[str, str]:
+        """Get the shaders plugged into a shading group, by slot"""
+        roots = {}
+        slots = ("surfaceShader", "displacementShader", "aiSurfaceShader")
+        for slot in slots:
+            if not cmds.attributeQuery(slot, node=shading_engine, exists=True):
+                continue
+            sources = cmds.listConnections(
+                f"{shading_engine}.{slot}", source=True, destination=False
+            ) or []
+            if sources:
+                roots[slot] = sources[0]
+        return roots
+
+    # Save -------------------------------------------------------------------------------
+
+    def save_material(
+        self,
+        cmds: Any,
+        shading_engine: str,
+        descriptor_path: Path,
+        notes: str = "",
+    ) -> Optional[Path]:
+        """
+        Export a shading group's networks and textures as a material asset
+
+        Args:
+            cmds: maya.cmds module
+            shading_engine: Shading group to save
+            descriptor_path: .material file to write
+            notes: Free text description
+
+        Returns:
+            Written descriptor path, None if the group has no surface shader
+        """
+        descriptor_path = Path(descriptor_path).with_suffix(MATERIAL_EXTENSION)
+        roots = self.get_network_roots(cmds, shading_engine)
+        shader = roots.get("aiSurfaceShader") or roots.get("surfaceShader")
+        if not shader:
+            print(f"[ERROR] {shading_engine} has no surface shader to save")
+            return None
+
+        network_path = descriptor_path.parent / NETWORKS_DIR_NAME / f"{descriptor_path.stem}.ma"
+        network_path.parent.mkdir(parents=True, exist_ok=True)
+
+        # Copy textures next to the network and point the live nodes at them for export.
+        # The scan also walks the group's member geometry, so keep the network's files only.
+        dependency_service = get_dependency_service()
+        network_nodes = set(cmds.listHistory(list(roots.values())) or [])
+        dependencies = [
+            dependency
+            for dependency in dependency_service.scan_dependencies(list(roots.values()))
+            if dependency.node in network_nodes
+        ]
+        collected = dependency_service.collect(network_path, dependencies)
+        try:
+            cmds.select(list(roots.values()), replace=True, noExpand=True)
+            cmds.file(
+                str(network_path),
+                exportSelected=True,
+                type="mayaAscii",
+                force=True,
+                constructionHistory=True,
+                channels=False,
+                constraints=False,
+                expressions=False,
+                shader=True,
+                preserveReferences=False,
+            )
+            # Written while the file nodes still point at the copied textures
+            materialx_path = self._write_materialx(cmds, shader, network_path)
+        finally:
+            dependency_service.restore_paths(dependencies, collected.repathed)
+            cmds.select(clear=True)
+
+        shader_type = cmds.nodeType(shader)
+        descriptor = {
+            "type": "material",
+            "format_version": MATERIAL_FORMAT_VERSION,
+            "name": descriptor_path.stem,
+            "notes": notes,
+            "renderer": get_renderer(shader_type),
+            "shader_type": shader_type,
+            "shader": shader,
+            "slots": roots,
+            "network": network_path.relative_to(descriptor_path.parent).as_posix(),
+            "materialx": (
+                materialx_path.relative_to(descriptor_path.parent).as_posix()
+                if materialx_path
+                else ""
+            ),
+            "textures": [
+                path.relative_to(descriptor_path.parent).as_posix()
+                for path in collected.copied_files
+            ],
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+        }
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+
+        print(
+            f"[OK] Saved material {descriptor_path.name} ({shader_type}, "
+            f"{len(collected.copied_files)} textures)"
+        )
+        return descriptor_path
+
+    def export_materialx(self, cmds: Any, descriptor_path: Path) -> Optional[Path]:
+        """
+        Write the MaterialX document of a material saved before documents were written
+
+        The preset is imported (or reused from the scene) to read its network.
+
+        Returns:
+            Document path, None for presets whose shader is not a Standard Surface
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_material(descriptor_path)
+        engine = self.find_imported_material(cmds, descriptor_path)
+        if engine is None:
+            engine = self.import_material(cmds, descriptor_path)
+        if descriptor is None or engine is None:
+            return None
+        roots = self.get_network_roots(cmds, engine)
+        shader = roots.get("aiSurfaceShader") or roots.get("surfaceShader")
+        if not shader:
+            return None
+
+        network_path = descriptor_path.parent / descriptor["network"]
+        materialx_path = self._write_materialx(
+            cmds, shader, network_path, descriptor.get("name", descriptor_path.stem)
+        )
+        if materialx_path is None:
+            return None
+        descriptor["materialx"] = materialx_path.relative_to(descriptor_path.parent).as_posix()
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+        return materialx_path
+
+    def _write_materialx(
+        self, cmds: Any, shader: str, network_path: Path, material_name: str = ""
+    ) -> Optional[Path]:
+        """Write a Standard Surface network as a .mtlx next to its Maya file"""
+        from .materialx_service_impl import MATERIALX_EXTENSION, get_materialx_service
+        from .shader_converters import STANDARD_SURFACE_TYPES
+
+        if cmds.nodeType(shader) not in STANDARD_SURFACE_TYPES:
+            return None  # MaterialX has no equivalent of Lambert, Blinn, PxrSurface...
+        materialx_path = network_path.with_suffix(MATERIALX_EXTENSION)
+        try:
+            result = get_materialx_service().write_document(
+                cmds, shader, materialx_path, material_name or network_path.stem
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not write MaterialX for {shader}: {e}")
+            return None
+        return materialx_path if result is not None else None
+
+    def load_material(self, descriptor_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .material descriptor, None if it is not a valid material preset"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "material":
+                return None
+            return descriptor
+        except Exception as e:
+            self.logger.error(f"Failed to read material {descriptor_path}: {e}")
+            return None
+
+    # Import and assign ------------------------------------------------------------------
+
+    def find_imported_material(self, cmds: Any, descriptor_path: Path) -> Optional[str]:
+        """Get the shading group of a preset already imported into the scene"""
+        key = Path(descriptor_path).as_posix()
+        for engine in cmds.ls(type="shadingEngine") or []:
+            for shader in self.get_network_roots(cmds, engine).values():
+                if not cmds.attributeQuery(PRESET_ATTRIBUTE, node=shader, exists=True):
+                    continue
+                if cmds.getAttr(f"{shader}.{PRESET_ATTRIBUTE}") == key:
+                    return engine
+        return None
+
+    def import_material(self, cmds: Any, descriptor_path: Path) -> Optional[str]:
+        """
+        Import a material preset and build its shading group
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .material file
+
+        Returns:
+            New shading group, None if the preset could not be imported
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_material(descriptor_path)
+        if descriptor is None:
+            return None
+
+        network_path = descriptor_path.parent / descriptor["network"]
+        if not network_path.exists():
+            print(f"[ERROR] Material network missing: {network_path}")
+            return None
+
+        if descriptor.get("renderer") == "arnold":
+            try:
+                cmds.loadPlugin("mtoa", quiet=True)
+            except Exception as e:
+                print(f"[WARNING] Arnold (mtoa) not available, shader may not load: {e}")
+
+        new_nodes = cmds.file(
+            str(network_path), i=True, type="mayaAscii", returnNewNodes=True
+        ) or []
+        get_dependency_service().resolve_relative_paths(network_path, new_nodes)
+
+        # Imported nodes that clash are prefixed with the file name (red_car_paint_paint).
+        # Only that form matches, so flakespaint is never taken for paint.
+        short_names = {node: node.rsplit("|", 1)[-1].rsplit(":", 1)[-1] for node in new_nodes}
+
+        def find_node(original: str) -> Optional[str]:
+            for name in (original, f"{network_path.stem}_{original}"):
+                for node, short_name in short_names.items():
+                    if short_name == name:
+                        return node
+            return None
+
+        shader = find_node(descriptor["shader"])
+        if shader is None:
+            print(f"[ERROR] Shader {descriptor['shader']} not found in {network_path.name}")
+            return None
+
+        engine = cmds.sets(
+            renderable=True, noSurfaceShader=True, empty=True, name=f"{shader}SG"
+        )
+        for slot, original in descriptor.get("slots", {}).items():
+            node = find_node(original)
+            if node is None:
+                continue
+            if not cmds.attributeQuery(slot, node=engine, exists=True):
+                continue  # Arnold override slot when mtoa is not loaded
+            output = "displacement" if slot == "displacementShader" else "outColor"
+            if not cmds.attributeQuery(output, node=node, exists=True):
+                output = "outValue"
+            cmds.connectAttr(f"{node}.{output}", f"{engine}.{slot}", force=True)
+            if not cmds.attributeQuery(PRESET_ATTRIBUTE, node=node, exists=True):
+                cmds.addAttr(node, longName=PRESET_ATTRIBUTE, dataType="string")
+            cmds.setAttr(
+                f"{node}.{PRESET_ATTRIBUTE}", descriptor_path.as_posix(), type="string"
+            )
+
+        print(f"[OK] Imported material {descriptor['name']} as {engine}")
+        return engine
+
+    def get_assignable_targets(self, cmds: Any, selection: List[str]) -> List[str]:
+        """Filter a selection to meshes, their transforms, and face components"""
+        targets = []
+        for item in selection:
+            if ".f[" in item:
+                targets.append(item)
+            elif cmds.nodeType(item) == "mesh":
+                targets.append(item)
+            elif cmds.listRelatives(item, shapes=True, type="mesh", noIntermediate=True):
+                targets.append(item)
+        return targets
+
+    def assign_material(
+        self, cmds: Any, descriptor_path: Path, targets: List[str]
+    ) -> Optional[str]:
+        """
+        Assign a material preset to meshes or faces, importing it on first use
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .material file
+            targets: Meshes, transforms, or face components
+
+        Returns:
+            Shading group assigned, None on failure
+        """
+        engine = self.find_imported_material(cmds, descriptor_path)
+        if engine is None:
+            engine = self.import_material(cmds, descriptor_path)
+        if engine is None:
+            return None
+
+        if targets:
+            cmds.sets(targets, edit=True, forceElement=engine)
+            print(f"[OK] Assigned {engine} to {len(targets)} object(s)/component(s)")
+        return engine
+
+
+# Singleton instance factory
+_material_service_instance = None
+
+
+def get_material_service() -> MaterialService:
+    """
+    Get singleton instance of MaterialService.
+
+    Returns:
+        MaterialService: Singleton service instance
+    """
+    global _material_service_instance
+    if _material_service_instance is None:
+        _material_service_instance = MaterialService()
+    return _material_service_instance
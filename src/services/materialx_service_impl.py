@@ -0,0 +1,518 @@
+# -*- coding: utf-8 -*-
+"""
+MaterialX Service Implementation
+Write material assets as MaterialX documents and build Maya networks from .mtlx files
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Standard Surface materials (Arnold's aiStandardSurface or Maya's standardSurface)
+are written next to their Maya network when they are saved, so Houdini, Mari, and
+other MaterialX applications read the same material::
+
+    assets/materials/.networks/red_car_paint.ma
+    assets/materials/.networks/red_car_paint.mtlx
+    assets/materials/.networks/.dependencies/red_car_paint/textures/flakes.png
+
+Documents hold one standard_surface per surfacematerial. Inputs are set to values or
+fed by image nodes (normal maps through a normalmap node); other upstream nodes are
+reported and left at their values. Texture paths are written relative to the document.
+"""
+
+import logging
+import os
+import re
+import xml.etree.ElementTree as ET
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+from xml.dom import minidom
+
+from .shader_converters import STANDARD_SURFACE_TYPES
+from .texture_set_service_impl import (
+    COLORSPACE_COLOR,
+    COLORSPACE_DATA,
+    UDIM_TOKEN,
+    UV_TILING_UDIM,
+    get_texture_set_service,
+    get_udim_pattern,
+)
+
+MATERIALX_EXTENSION = ".mtlx"
+MATERIALX_VERSION = "1.38"
+# Shader built on import when Arnold is available, Maya's own otherwise
+DEFAULT_IMPORT_SHADER = "aiStandardSurface"
+
+# MaterialX standard_surface input -> (Standard Surface attribute, MaterialX type)
+STANDARD_SURFACE_INPUTS: Dict[str, Tuple[str, str]] = {
+    "base": ("base", "float"),
+    "base_color": ("baseColor", "color3"),
+    "diffuse_roughness": ("diffuseRoughness", "float"),
+    "metalness": ("metalness", "float"),
+    "specular": ("specular", "float"),
+    "specular_color": ("specularColor", "color3"),
+    "specular_roughness": ("specularRoughness", "float"),
+    "specular_IOR": ("specularIOR", "float"),
+    "specular_anisotropy": ("specularAnisotropy", "float"),
+    "specular_rotation": ("specularRotation", "float"),
+    "transmission": ("transmission", "float"),
+    "transmission_color": ("transmissionColor", "color3"),
+    "transmission_depth": ("transmissionDepth", "float"),
+    "transmission_scatter": ("transmissionScatter", "color3"),
+    "transmission_scatter_anisotropy": ("transmissionScatterAnisotropy", "float"),
+    "transmission_dispersion": ("transmissionDispersion", "float"),
+    "transmission_extra_roughness": ("transmissionExtraRoughness", "float"),
+    "subsurface": ("subsurface", "float"),
+    "subsurface_color": ("subsurfaceColor", "color3"),
+    "subsurface_radius": ("subsurfaceRadius", "color3"),
+    "subsurface_scale": ("subsurfaceScale", "float"),
+    "subsurface_anisotropy": ("subsurfaceAnisotropy", "float"),
+    "sheen": ("sheen", "float"),
+    "sheen_color": ("sheenColor", "color3"),
+    "sheen_roughness": ("sheenRoughness", "float"),
+    "coat": ("coat", "float"),
+    "coat_color": ("coatColor", "color3"),
+    "coat_roughness": ("coatRoughness", "float"),
+    "coat_anisotropy": ("coatAnisotropy", "float"),
+    "coat_rotation": ("coatRotation", "float"),
+    "coat_IOR": ("coatIOR", "float"),
+    "coat_affect_color": ("coatAffectColor", "float"),
+    "coat_affect_roughness": ("coatAffectRoughness", "float"),
+    "thin_film_thickness": ("thinFilmThickness", "float"),
+    "thin_film_IOR": ("thinFilmIOR", "float"),
+    "emission": ("emission", "float"),
+    "emission_color": ("emissionColor", "color3"),
+    "opacity": ("opacity", "color3"),
+    "thin_walled": ("thinWalled", "boolean"),
+    "normal": ("normalCamera", "vector3"),
+}
+
+# Maya colorspace -> MaterialX colorspace of color maps (data maps carry none)
+MATERIALX_COLORSPACES = {
+    "sRGB": "srgb_texture",
+    "Utility - sRGB - Texture": "srgb_texture",
+    "scene-linear Rec.709-sRGB": "lin_rec709",
+    "Utility - Linear - sRGB": "lin_rec709",
+    "ACEScg": "acescg",
+    "ACES - ACEScg": "acescg",
+}
+# MaterialX colorspace -> Maya colorspace of imported color maps
+MAYA_COLORSPACES = {
+    "srgb_texture": "sRGB",
+    "g22_rec709": "sRGB",
+    "lin_rec709": "scene-linear Rec.709-sRGB",
+    "acescg": "ACEScg",
+    "lin_ap1": "ACEScg",
+}
+
+# Image node types read as file textures
+IMAGE_NODE_TYPES = {"image", "tiledimage"}
+
+_INVALID_NAME_CHARACTERS = re.compile(r"[^A-Za-z0-9_]")
+
+
+@dataclass
+class MaterialXResult:
+    """Outcome of writing or importing a MaterialX document"""
+
+    material: str = ""  # Document material written, or the shading group built
+    shader: str = ""  # standard_surface element, or the Maya shader built
+    textures: List[str] = field(default_factory=list)  # Texture paths or file nodes
+    unsupported: List[str] = field(default_factory=list)  # Connections left out
+
+    @property
+    def success(self) -> bool:
+        return bool(self.shader)
+
+
+def get_materialx_name(node: str) -> str:
+    """Get a valid MaterialX element name of a Maya node (|geo|ns:paint -> paint)"""
+    name = _INVALID_NAME_CHARACTERS.sub("_", node.rsplit("|", 1)[-1].rsplit(":", 1)[-1])
+    return name if name and not name[0].isdigit() else f"n_{name}"
+
+
+class MaterialXService:
+    """
+    MaterialX Service - Single Responsibility for MaterialX document exchange
+    All Maya calls go through the cmds argument so documents can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Export -----------------------------------------------------------------------------
+
+    def write_document(
+        self, cmds: Any, shader: str, mtlx_path: Path, material_name: str = ""
+    ) -> Optional[MaterialXResult]:
+        """
+        Write a Standard Surface shader and its file textures as a MaterialX document
+
+        Args:
+            cmds: maya.cmds module
+            shader: aiStandardSurface or standardSurface
+            mtlx_path: .mtlx file to write
+            material_name: surfacematerial name, the shader's name by default
+
+        Returns:
+            Material written with its textures and left out connections, None when
+            the shader is not a Standard Surface
+        """
+        mtlx_path = Path(mtlx_path)
+        shader_type = cmds.nodeType(shader)
+        if shader_type not in STANDARD_SURFACE_TYPES:
+            print(f"[ERROR] {shader} is a {shader_type}; only Standard Surface writes MaterialX")
+            return None
+
+        name = get_materialx_name(material_name or shader)
+        document = ET.Element("materialx", version=MATERIALX_VERSION, colorspace="lin_rec709")
+        surface = ET.SubElement(
+            document, "standard_surface", name=f"SR_{name}", type="surfaceshader"
+        )
+        result = MaterialXResult(material=name, shader=surface.get("name", ""))
+
+        for input_name, (attribute, input_type) in STANDARD_SURFACE_INPUTS.items():
+            if not cmds.attributeQuery(attribute, node=shader, exists=True):
+                continue
+            plug = f"{shader}.{attribute}"
+            sources = cmds.listConnections(plug, source=True, destination=False, plugs=True)
+            upstream = ""
+            if sources:
+                upstream = self._write_upstream(cmds, document, sources[0], input_type, mtlx_path)
+                if not upstream:
+                    result.unsupported.append(f"{attribute} <- {sources[0]}")
+            element = ET.SubElement(surface, "input", name=input_name, type=input_type)
+            if upstream:
+                element.set("nodename", upstream)
+            elif input_type != "vector3":
+                element.set("value", self._format_value(cmds.getAttr(plug), input_type))
+            else:
+                surface.remove(element)  # Unconnected normals use the geometry's
+
+        material = ET.SubElement(document, "surfacematerial", name=name, type="material")
+        ET.SubElement(
+            material,
+            "input",
+            name="surfaceshader",
+            type="surfaceshader",
+            nodename=surface.get("name", ""),
+        )
+
+        result.textures = [
+            element.get("value", "")
+            for element in document.iter("input")
+            if element.get("type") == "filename"
+        ]
+        mtlx_path.parent.mkdir(parents=True, exist_ok=True)
+        text = minidom.parseString(ET.tostring(document)).toprettyxml(indent="  ")
+        mtlx_path.write_text(text, encoding="utf-8")
+        print(
+            f"[OK] Wrote MaterialX {mtlx_path.name} ({len(result.textures)} textures, "
+            f"{len(result.unsupported)} connections left out)"
+        )
+        return result
+
+    def _write_upstream(
+        self, cmds: Any, document: ET.Element, source_plug: str, input_type: str, mtlx_path: Path
+    ) -> str:
+        """Write the node feeding a shader input, "" when MaterialX cannot express it"""
+        node = source_plug.split(".", 1)[0]
+        node_type = cmds.nodeType(node)
+        if node_type == "file":
+            return self._write_image(cmds, document, node, input_type, mtlx_path)
+
+        # Tangent space normal maps: aiNormalMap.input or bump2d.bumpValue (normals mode)
+        file_input = ""
+        if input_type == "vector3" and node_type == "aiNormalMap":
+            file_input = f"{node}.input"
+        elif input_type == "vector3" and node_type == "bump2d":
+            if cmds.getAttr(f"{node}.bumpInterp") == 1:
+                file_input = f"{node}.bumpValue"
+        if not file_input:
+            return ""
+        files = cmds.listConnections(file_input, source=True, destination=False) or []
+        if not files or cmds.nodeType(files[0]) != "file":
+            return ""
+        image = self._write_image(cmds, document, files[0], "vector3", mtlx_path)
+        if not image:
+            return ""
+        name = f"{image}_normalmap"
+        if self._find_element(document, name) is None:
+            normal = ET.SubElement(document, "normalmap", name=name, type="vector3")
+            ET.SubElement(normal, "input", name="in", type="vector3", nodename=image)
+        return name
+
+    def _write_image(
+        self, cmds: Any, document: ET.Element, file_node: str, input_type: str, mtlx_path: Path
+    ) -> str:
+        """Write a file node as an image node, "" without a texture file"""
+        path = cmds.getAttr(f"{file_node}.fileTextureName") or ""
+        if not path:
+            return ""
+        if cmds.getAttr(f"{file_node}.uvTilingMode") == UV_TILING_UDIM:
+            path = get_udim_pattern(Path(path))[0].as_posix()
+        try:
+            path = Path(os.path.relpath(path, mtlx_path.parent)).as_posix()
+        except ValueError:
+            path = Path(path).as_posix()  # Another drive on Windows
+
+        # One image per file node and type: a map can feed a color and a float input
+        name = get_materialx_name(file_node)
+        if input_type != "color3":
+            name = f"{name}_{input_type}"
+        if self._find_element(document, name) is None:
+            image = ET.SubElement(document, "image", name=name, type=input_type)
+            file_element = ET.SubElement(image, "input", name="file", type="filename", value=path)
+            colorspace = MATERIALX_COLORSPACES.get(cmds.getAttr(f"{file_node}.colorSpace") or "")
+            if input_type == "color3" and colorspace:
+                file_element.set("colorspace", colorspace)
+        return name
+
+    def _format_value(self, value: Any, input_type: str) -> str:
+        """Format a Maya attribute value as a MaterialX value string"""
+        if input_type == "boolean":
+            return "true" if value else "false"
+        if isinstance(value, (list, tuple)):
+            # Compound attributes come back as [(r, g, b)]
+            flat = value[0] if value and isinstance(value[0], (list, tuple)) else value
+            return ", ".join(f"{float(component):g}" for component in flat)
+        return f"{float(value):g}"
+
+    # Import -----------------------------------------------------------------------------
+
+    def list_materials(self, mtlx_path: Path) -> List[str]:
+        """Get the surfacematerial names of a document, [] if it cannot be read"""
+        document = self._read_document(mtlx_path)
+        if document is None:
+            return []
+        return [material.get("name", "") for material in document.iter("surfacematerial")]
+
+    def import_document(
+        self,
+        cmds: Any,
+        mtlx_path: Path,
+        material_name: str = "",
+        shader_type: str = DEFAULT_IMPORT_SHADER,
+    ) -> Optional[MaterialXResult]:
+        """
+        Build a Standard Surface network and shading group from a MaterialX material
+
+        Args:
+            cmds: maya.cmds module
+            mtlx_path: .mtlx document
+            material_name: surfacematerial to build, the first one by default
+            shader_type: aiStandardSurface, or standardSurface (also used without mtoa)
+
+        Returns:
+            Shading group, shader, file nodes, and inputs left out; None when the
+            document has no standard_surface material
+        """
+        mtlx_path = Path(mtlx_path)
+        document = self._read_document(mtlx_path)
+        if document is None:
+            return None
+        materials = [
+            material
+            for material in document.iter("surfacematerial")
+            if not material_name or material.get("name") == material_name
+        ]
+        surface = None
+        if materials:
+            for surface_input in materials[0].iter("input"):
+                if surface_input.get("name") == "surfaceshader":
+                    surface = self._resolve_input(document, document, surface_input)[1]
+        if surface is None or surface.tag != "standard_surface":
+            print(f"[ERROR] {mtlx_path.name} has no standard_surface material to import")
+            return None
+
+        if shader_type == "aiStandardSurface":
+            try:
+                cmds.loadPlugin("mtoa", quiet=True)
+            except Exception as e:
+                print(f"[WARNING] Arnold (mtoa) not available, using standardSurface: {e}")
+                shader_type = "standardSurface"
+
+        name = materials[0].get("name", mtlx_path.stem)
+        shader = cmds.shadingNode(shader_type, asShader=True, name=name)
+        engine = cmds.sets(renderable=True, noSurfaceShader=True, empty=True, name=f"{shader}SG")
+        cmds.connectAttr(f"{shader}.outColor", f"{engine}.surfaceShader", force=True)
+        result = MaterialXResult(material=engine, shader=shader)
+
+        texture_set_service = get_texture_set_service()
+        images: Dict[str, str] = {}  # graph/image element name -> file node
+        for element in surface.findall("input"):
+            input_name = element.get("name", "")
+            if input_name not in STANDARD_SURFACE_INPUTS:
+                result.unsupported.append(input_name)
+                continue
+            attribute, input_type = STANDARD_SURFACE_INPUTS[input_name]
+            scope, upstream = self._resolve_input(document, document, element)
+            if upstream is None:
+                if "value" in element.attrib:
+                    self._set_value(cmds, f"{shader}.{attribute}", element.get("value", ""))
+                continue
+
+            kind = upstream.tag
+            if kind == "normalmap" and attribute == "normalCamera":
+                scope, upstream = self._resolve_named_input(document, scope, upstream, "in")
+                if upstream is not None and upstream.tag in IMAGE_NODE_TYPES:
+                    file_node = self._import_image(
+                        cmds, document, scope, upstream, mtlx_path, images
+                    )
+                    texture_set_service.connect_normal_map(cmds, file_node, shader, shader_type)
+                    continue
+            elif kind in IMAGE_NODE_TYPES and attribute != "normalCamera":
+                file_node = self._import_image(cmds, document, scope, upstream, mtlx_path, images)
+                output = "outColor" if input_type == "color3" else "outAlpha"
+                cmds.connectAttr(f"{file_node}.{output}", f"{shader}.{attribute}", force=True)
+                continue
+            result.unsupported.append(f"{input_name} <- {kind}")
+
+        result.textures = list(images.values())
+        print(
+            f"[OK] Imported MaterialX {name} as {engine} ({len(result.textures)} textures, "
+            f"{len(result.unsupported)} inputs left out)"
+        )
+        return result
+
+    def _read_document(self, mtlx_path: Path) -> Optional[ET.Element]:
+        """Parse a .mtlx file, None if it is not a MaterialX document"""
+        try:
+            document = ET.parse(str(mtlx_path)).getroot()
+        except (OSError, ET.ParseError) as e:
+            self.logger.error(f"Failed to read MaterialX {mtlx_path}: {e}")
+            return None
+        return document if document.tag == "materialx" else None
+
+    def _find_element(self, scope: ET.Element, name: str) -> Optional[ET.Element]:
+        """Get the child element of a document or node graph with the given name"""
+        for child in scope:
+            if child.get("name") == name:
+                return child
+        return None
+
+    def _resolve_input(
+        self, document: ET.Element, scope: ET.Element, element: ET.Element
+    ) -> Tuple[ET.Element, Optional[ET.Element]]:
+        """
+        Get the node connected to an input and the scope (document or graph) it is in
+
+        Returns:
+            (scope, node), node None for inputs holding a value
+        """
+        graph_name = element.get("nodegraph")
+        if graph_name:
+            graph = self._find_element(document, graph_name)
+            if graph is None:
+                return scope, None
+            wanted = element.get("output")
+            output = next(
+                (
+                    child
+                    for child in graph.findall("output")
+                    if not wanted or child.get("name") == wanted
+                ),
+                None,
+            )
+            if output is None or not output.get("nodename"):
+                return graph, None
+            return graph, self._find_element(graph, output.get("nodename", ""))
+        node_name = element.get("nodename")
+        if not node_name:
+            return scope, None
+        node = self._find_element(scope, node_name)
+        if node is None and scope is not document:
+            node = self._find_element(document, node_name)
+            scope = document
+        return scope, node
+
+    def _resolve_named_input(
+        self, document: ET.Element, scope: ET.Element, node: ET.Element, input_name: str
+    ) -> Tuple[ET.Element, Optional[ET.Element]]:
+        """Get the node connected to one of a node's inputs, like _resolve_input"""
+        for element in node.findall("input"):
+            if element.get("name") == input_name:
+                return self._resolve_input(document, scope, element)
+        return scope, None
+
+    def _import_image(
+        self,
+        cmds: Any,
+        document: ET.Element,
+        scope: ET.Element,
+        image: ET.Element,
+        mtlx_path: Path,
+        images: Dict[str, str],
+    ) -> str:
+        """Create (once) the file node of an image element"""
+        key = f"{scope.get('name', '')}/{image.get('name', '')}"
+        if key in images:
+            return images[key]
+        file_element = next(
+            (child for child in image.findall("input") if child.get("name") == "file"), None
+        )
+        value = file_element.get("value", "") if file_element is not None else ""
+        # fileprefix applies to every filename below the element that sets it
+        prefix = next(
+            (
+                element.get("fileprefix", "")
+                for element in (image, scope, document)
+                if element.get("fileprefix")
+            ),
+            "",
+        )
+        path = Path(prefix + value)
+        if not path.is_absolute():
+            path = mtlx_path.parent / path
+        image_type = image.get("type", "color3")
+        colorspace = COLORSPACE_DATA
+        if image_type == "color3":
+            source_colorspace = (
+                (file_element.get("colorspace") if file_element is not None else None)
+                or image.get("colorspace")
+                or scope.get("colorspace")
+                or document.get("colorspace", "")
+            )
+            colorspace = MAYA_COLORSPACES.get(source_colorspace, COLORSPACE_COLOR)
+        file_node = get_texture_set_service().create_file_node(
+            cmds,
+            get_materialx_name(image.get("name", "image")),
+            path.as_posix(),
+            colorspace,
+            udim=UDIM_TOKEN in path.name,
+            luminance=image_type == "float",
+        )
+        images[key] = file_node
+        return file_node
+
+    def _set_value(self, cmds: Any, plug: str, value: str) -> None:
+        """Set a shader attribute from a MaterialX value string"""
+        try:
+            if value in ("true", "false"):
+                cmds.setAttr(plug, value == "true")
+                return
+            components = [float(component) for component in value.split(",")]
+            if len(components) == 1:
+                cmds.setAttr(plug, components[0])
+            else:
+                cmds.setAttr(plug, *components, type="double3")
+        except Exception as e:
+            # Arnold-only attributes on a standardSurface, or a malformed value
+            self.logger.warning(f"Could not set {plug} to {value}: {e}")
+
+
+# Singleton instance factory
+_materialx_service_instance = None
+
+
+def get_materialx_service() -> MaterialXService:
+    """
+    Get singleton instance of MaterialXService.
+
+    Returns:
+        MaterialXService: Singleton service instance
+    """
+    global _materialx_service_instance
+    if _materialx_service_instance is None:
+        _materialx_service_instance = MaterialXService()
+    return _materialx_service_instance
@@ -0,0 +1,77 @@
+# -*- coding: utf-8 -*-
+"""
+Maya ASCII References
+Read the file -r / -rdi reference statements of Maya ASCII scenes as text
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Shared by the services that read scenes without opening Maya. The last string of a
+statement is the path; repeated references carry a copy number after it::
+
+    file -rdi 1 -ns "crate1" -rfn "crateRN1" -typ "mayaAscii" "$ASSET_ROOT/crate.ma{1}";
+"""
+
+import os
+import re
+from dataclasses import dataclass
+from pathlib import Path
+from typing import List, Optional
+
+from .path_mapping_service_impl import get_path_mapping_service
+
+# file -r / -rdi statements of Maya ASCII scenes; the last string is the path
+_MA_REFERENCE_STATEMENT = re.compile(r'^file\s+(?:[^;]*\s)?-(?:r|rdi)\s[^;]*;', re.MULTILINE)
+_MA_STRING = re.compile(r'"((?:[^"\\]|\\.)*)"')
+_MA_NAMESPACE = re.compile(r'-ns\s+"([^"]*)"')
+COPY_NUMBER = re.compile(r"\{\d+\}$")
+
+
+@dataclass(frozen=True)
+class MayaAsciiReference:
+    """One reference statement of a Maya ASCII scene"""
+
+    path: str  # As stored, without the copy number
+    copy_number: str  # "{1}" of repeated references, "" for the first one
+    namespace: str  # "" when the statement has no -ns flag
+    start: int  # Where the stored path, copy number included, sits in the scene text
+    end: int
+
+
+def find_references(text: str) -> List[MayaAsciiReference]:
+    """Get the reference statements of a Maya ASCII scene's text, in file order"""
+    references = []
+    for statement in _MA_REFERENCE_STATEMENT.finditer(text):
+        strings = list(_MA_STRING.finditer(statement.group(0)))
+        if not strings:
+            continue
+        path_match = strings[-1]
+        raw_path = path_match.group(1)
+        copy_number = COPY_NUMBER.search(raw_path)
+        suffix = copy_number.group(0) if copy_number else ""
+        namespace = _MA_NAMESPACE.search(statement.group(0))
+        start = statement.start() + path_match.start(1)
+        references.append(
+            MayaAsciiReference(
+                raw_path[: len(raw_path) - len(suffix)],
+                suffix,
+                namespace.group(1) if namespace else "",
+                start,
+                start + len(raw_path),
+            )
+        )
+    return references
+
+
+def resolve_reference_path(scene_file: Path, path: str) -> Optional[Path]:
+    """Resolve a stored path the way Maya would (root token, env vars, scene-relative)"""
+    if not path:
+        return None
+    stored = get_path_mapping_service().resolve(path.replace("\\\\", "/"))
+    expanded = Path(os.path.expandvars(str(stored)))
+    if not expanded.is_absolute():
+        expanded = Path(scene_file).parent / expanded
+    try:
+        return expanded.resolve()
+    except OSError:
+        return None
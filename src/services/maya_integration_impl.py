@@ -12,6 +12,38 @@ from pathlib import Path
 
 from ..core.interfaces.maya_integration import IMayaIntegration
 from ..core.models.asset import Asset
+from .path_mapping_service_impl import get_path_mapping_service
+
+# File types Maya can reference, by extension
+REFERENCE_FILE_TYPES = {
+    ".ma": "mayaAscii",
+    ".mb": "mayaBinary",
+    ".abc": "Alembic",
+    ".fbx": "FBX",
+    ".obj": "OBJ",
+}
+
+# Plugins that must be loaded before referencing a file type
+REFERENCE_PLUGINS = {".abc": "AbcImport", ".fbx": "fbxmaya", ".obj": "objExport"}
+
+
+def sanitize_namespace(name: str) -> str:
+    """Turn an asset name into a valid Maya namespace"""
+    cleaned = "".join(c if c.isalnum() or c == "_" else "_" for c in name.strip())
+    if cleaned and cleaned[0].isdigit():
+        cleaned = f"_{cleaned}"
+    return cleaned or "asset"
+
+
+def make_unique_namespace(base_namespace: str, existing_namespaces: List[str]) -> str:
+    """Append _1, _2... until the namespace is not taken"""
+    if base_namespace not in existing_namespaces:
+        return base_namespace
+
+    counter = 1
+    while f"{base_namespace}_{counter}" in existing_namespaces:
+        counter += 1
+    return f"{base_namespace}_{counter}"
 
 
 class MayaIntegrationImpl(IMayaIntegration):
@@ -81,7 +113,9 @@ class MayaIntegrationImpl(IMayaIntegration):
             print(f"Error importing asset {asset.name}: {e}")
             return False
 
-    def reference_asset(self, asset: Asset, namespace: Optional[str] = None) -> bool:
+    def reference_asset(
+        self, asset: Asset, namespace: Optional[str] = None, group_name: Optional[str] = None
+    ) -> bool:
         """
         Reference asset in Maya scene
         Single Responsibility: handle Maya reference operations
@@ -90,28 +124,97 @@ class MayaIntegrationImpl(IMayaIntegration):
             return False
 
         try:
-            if not asset.file_path.exists() or not asset.is_maya_file:
+            extension = asset.file_path.suffix.lower()
+            if not asset.file_path.exists() or extension not in REFERENCE_FILE_TYPES:
                 return False
 
-            # Generate namespace if not provided
+            plugin = REFERENCE_PLUGINS.get(extension)
+            if plugin and not self._maya_cmds.pluginInfo(plugin, query=True, loaded=True):
+                self._maya_cmds.loadPlugin(plugin, quiet=True)
+
+            # Generate namespace if not provided; ":" is Maya's root namespace
             if namespace is None:
                 namespace = self._generate_namespace(asset.name)
 
-            # Create reference
-            reference_node = self._maya_cmds.file(
-                str(asset.file_path),
-                reference=True,
-                namespace=namespace,
-                mergeNamespacesOnClash=False,
-                ignoreVersion=True,
-            )
+            reference_options: Dict[str, Any] = {
+                "reference": True,
+                "type": REFERENCE_FILE_TYPES[extension],
+                "namespace": namespace or ":",
+                "mergeNamespacesOnClash": not namespace,
+                "ignoreVersion": True,
+            }
+            if group_name:
+                reference_options.update(groupReference=True, groupName=group_name)
 
-            return reference_node is not None
+            # Stored under the library's root token, so the scene opens on any platform
+            reference_path = get_path_mapping_service().to_portable(asset.file_path)
+            reference_file = self._maya_cmds.file(reference_path, **reference_options)
+            if reference_file:
+                print(f"[OK] Referenced {asset.name} into namespace '{namespace or ':'}'")
+            return reference_file is not None
 
         except Exception as e:
             print(f"Error referencing asset {asset.name}: {e}")
             return False
 
+    def get_scene_references(self) -> List[Dict[str, Any]]:
+        """
+        Get top-level file references in the current scene
+        Single Responsibility: query Maya reference state
+        """
+        if not self.is_maya_available() or not self._maya_cmds:
+            return []
+
+        references = []
+        for reference_file in self._maya_cmds.file(query=True, reference=True) or []:
+            try:
+                reference_node = self._maya_cmds.referenceQuery(reference_file, referenceNode=True)
+                references.append(
+                    {
+                        "reference_node": reference_node,
+                        # withoutCopyNumber strips the {1} suffix of repeated references
+                        "file_path": Path(
+                            self._maya_cmds.referenceQuery(
+                                reference_file, filename=True, withoutCopyNumber=True
+                            )
+                        ),
+                        "namespace": self._maya_cmds.referenceQuery(
+                            reference_file, namespace=True, shortName=True
+                        ),
+                        "loaded": self._maya_cmds.referenceQuery(reference_node, isLoaded=True),
+                    }
+                )
+            except Exception as e:
+                print(f"[WARNING] Skipping unreadable reference {reference_file}: {e}")
+        return references
+
+    def replace_reference(self, reference_node: str, file_path: Path) -> bool:
+        """
+        Point an existing reference at another file, keeping its reference edits
+        Single Responsibility: handle Maya reference repathing
+        """
+        if not self.is_maya_available() or not self._maya_cmds:
+            return False
+
+        file_path = Path(file_path)
+        extension = file_path.suffix.lower()
+        if not file_path.exists() or extension not in REFERENCE_FILE_TYPES:
+            print(f"[ERROR] Cannot replace reference with {file_path.name}")
+            return False
+
+        try:
+            # loadReference swaps the file in place - edits stored on the node are reapplied
+            self._maya_cmds.file(
+                get_path_mapping_service().to_portable(file_path),
+                loadReference=reference_node,
+                type=REFERENCE_FILE_TYPES[extension],
+            )
+            print(f"[OK] Replaced reference {reference_node} with {file_path.name}")
+            return True
+        except Exception as e:
+            print(f"Error replacing reference {reference_node}: {e}")
+            return False
+
     def export_selection(
         self, export_path: Path, options: Optional[Dict[str, Any]] = None
     ) -> bool:
@@ -299,17 +402,8 @@ class MayaIntegrationImpl(IMayaIntegration):
         if not self.is_maya_available() or not self._maya_cmds:
             return asset_name
 
-        base_namespace = asset_name.replace(" ", "_").replace("-", "_")
+        base_namespace = sanitize_namespace(asset_name)
 
         # Check if namespace already exists
         existing_namespaces = self._maya_cmds.namespaceInfo(listOnlyNamespaces=True) or []
-
-        if base_namespace not in existing_namespaces:
-            return base_namespace
-
-        # Generate unique namespace
-        counter = 1
-        while f"{base_namespace}_{counter}" in existing_namespaces:
-            counter += 1
-
-        return f"{base_namespace}_{counter}"
+        return make_unique_namespace(base_namespace, existing_namespaces)
@@ -0,0 +1,439 @@
+# -*- coding: utf-8 -*-
+"""
+Merge Import Service Implementation
+Update an imported asset in place by transferring a new version onto its existing nodes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The new version is imported into a scratch namespace and its transforms are paired
+with the scene's copy: by the UUID the Maya ASCII file records for the node, else by
+name under the partner of its parent, else by a short name only one scene node has.
+Maya gives imported nodes new UUIDs when the scene already has theirs, so the file's
+record is read as text::
+
+    createNode transform -n "body" -p "crate_grp";
+        rename -uid "4C1A9B0E-4F2D-11EF-8A86-0242AC120002";
+
+Mesh data is copied into the upstream shape (the Orig shape of a deformed mesh), so
+constraints, skin clusters, blendShapes, and keys on the scene's nodes stay. Shading
+groups the scene already has are reused; new ones come along with the new nodes. The
+imported copy is deleted and whatever it left is merged into the root namespace.
+"""
+
+import logging
+import re
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Set, Tuple
+
+from ..core.models.merge_import import MergeImportOptions, MergeImportReport
+from .maya_integration_impl import make_unique_namespace
+from .namespace_service_impl import ROOT_NAMESPACE, get_namespace_service
+
+MERGE_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+MERGE_NAMESPACE = "assetManagerMerge"
+
+_CREATE_NODE = re.compile(r"^\s*createNode\s+(\w+)\s+(.*?);\s*$")
+_NAME_FLAG = re.compile(r'-n\s+"([^"]+)"')
+_PARENT_FLAG = re.compile(r'-p\s+"([^"]+)"')
+_UUID = re.compile(r'^\s*rename\s+-uid\s+"([^"]+)"\s*;')
+
+
+def strip_namespaces(path: str) -> str:
+    """Get a DAG path without namespaces (|ns:a|ns:b -> |a|b)"""
+    return "|".join(part.rsplit(":", 1)[-1] for part in path.split("|"))
+
+
+def match_nodes(scene_nodes: Dict[str, str], new_nodes: Dict[str, str]) -> Dict[str, str]:
+    """
+    Pair the transforms of a new version with the scene's copy
+
+    UUIDs pair first. The rest pair parents first: by name under the partner of their
+    parent, else by a short name only one unpaired scene node has. A lone unpaired root
+    on each side pairs whatever it is called. Nodes under an unpaired parent come in
+    with it.
+
+    Args:
+        scene_nodes: Relative DAG path (crate_grp|body) -> UUID, "" when unknown
+        new_nodes: The same for the new version
+
+    Returns:
+        New version's relative path -> scene relative path
+    """
+    scene_by_uuid: Dict[str, List[str]] = {}
+    for path, uuid in scene_nodes.items():
+        if uuid:
+            scene_by_uuid.setdefault(uuid, []).append(path)
+
+    matched: Dict[str, str] = {}
+    for path, uuid in new_nodes.items():
+        candidates = scene_by_uuid.get(uuid, []) if uuid else []
+        if len(candidates) == 1:
+            matched[path] = candidates[0]
+
+    for path in sorted(new_nodes, key=lambda p: (p.count("|"), p)):
+        if path in matched:
+            continue
+        parent, _, name = path.rpartition("|")
+        if parent and parent not in matched:
+            continue
+        taken = set(matched.values())
+        candidate = f"{matched[parent]}|{name}" if parent else name
+        if candidate in scene_nodes and candidate not in taken:
+            matched[path] = candidate
+            continue
+        same_name = [p for p in scene_nodes if p not in taken and p.rpartition("|")[2] == name]
+        if len(same_name) == 1:
+            matched[path] = same_name[0]
+            continue
+        if not parent:
+            scene_roots = [p for p in scene_nodes if "|" not in p and p not in taken]
+            new_roots = [p for p in new_nodes if "|" not in p and p not in matched]
+            if len(scene_roots) == 1 and len(new_roots) == 1:
+                matched[path] = scene_roots[0]
+    return matched
+
+
+def _get_top_unmatched(nodes: List[str], matched: Set[str]) -> List[str]:
+    """Get the unmatched paths whose parent matched, or that are roots"""
+    return sorted(
+        path
+        for path in nodes
+        if path not in matched and (path.rpartition("|")[0] in matched or "|" not in path)
+    )
+
+
+class MergeImportService:
+    """
+    Merge Import Service - Single Responsibility for updating imported assets in place
+    Scene access goes through the cmds argument so merges can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Nodes ------------------------------------------------------------------------------
+
+    def read_ascii_uuids(self, file_path: Path) -> Dict[str, str]:
+        """Get relative DAG path -> UUID of the nodes a Maya ASCII file creates"""
+        uuids: Dict[str, str] = {}
+        paths: Dict[str, str] = {}  # Short name -> path of the node created last
+        current = ""
+        with open(file_path, "r", encoding="utf-8", errors="replace") as f:
+            for line in f:
+                match = _CREATE_NODE.match(line)
+                if match is not None:
+                    name = _NAME_FLAG.search(match.group(2))
+                    parent = _PARENT_FLAG.search(match.group(2))
+                    current = ""
+                    if name is None:
+                        continue
+                    current = strip_namespaces(name.group(1))
+                    if parent is not None:
+                        parent_name = parent.group(1)
+                        if not parent_name.startswith("|"):
+                            parent_name = paths.get(parent_name, parent_name)
+                        current = f"{strip_namespaces(parent_name).lstrip('|')}|{current}"
+                    paths[name.group(1)] = current
+                    continue
+                uuid = _UUID.match(line)
+                if uuid is not None and current:
+                    uuids[current] = uuid.group(1)
+        return uuids
+
+    def get_dag_nodes(self, cmds: Any, roots: List[str]) -> Dict[str, str]:
+        """Get relative DAG path (no namespaces) -> full path of roots and their transforms"""
+        nodes: Dict[str, str] = {}
+        for root in roots:
+            prefix = root.rsplit("|", 1)[0]
+            descendants = cmds.listRelatives(
+                root, allDescendents=True, type="transform", fullPath=True
+            )
+            for node in [root] + (descendants or []):
+                nodes[strip_namespaces(node[len(prefix) :]).lstrip("|")] = node
+        return nodes
+
+    # Merge ------------------------------------------------------------------------------
+
+    def merge(
+        self,
+        cmds: Any,
+        roots: List[str],
+        asset_file: Path,
+        options: Optional[MergeImportOptions] = None,
+    ) -> MergeImportReport:
+        """
+        Transfer an asset file onto the nodes of a copy imported before
+
+        Args:
+            cmds: maya.cmds module
+            roots: Top-level transforms of the imported copy (full paths)
+            asset_file: Maya scene of the new version
+            options: What to transfer, everything by default
+
+        Returns:
+            What was transferred; its roots are the copy's top-level nodes afterwards
+        """
+        asset_file = Path(asset_file)
+        options = options or MergeImportOptions()
+        file_type = MERGE_FILE_TYPES.get(asset_file.suffix.lower())
+        if file_type is None or not asset_file.is_file():
+            raise RuntimeError(f"Cannot update {asset_file.name} in place - only Maya scenes")
+        scene_nodes = self.get_dag_nodes(cmds, roots)
+        if not scene_nodes:
+            raise RuntimeError(f"The imported copy of {asset_file.stem} is not in the scene")
+        file_uuids = self.read_ascii_uuids(asset_file) if file_type == "mayaAscii" else {}
+
+        namespaces = get_namespace_service().list_namespaces(cmds)
+        namespace = make_unique_namespace(
+            MERGE_NAMESPACE, [ns.lstrip(ROOT_NAMESPACE) for ns in namespaces]
+        )
+        cmds.undoInfo(openChunk=True, chunkName=f"Update {asset_file.stem} in place")
+        try:
+            imported = cmds.file(
+                str(asset_file), i=True, type=file_type, namespace=namespace, returnNewNodes=True
+            )
+            new_roots = [
+                node
+                for node in cmds.ls(imported or [], long=True, type="transform") or []
+                if not cmds.listRelatives(node, parent=True)
+            ]
+            new_nodes = self.get_dag_nodes(cmds, new_roots)
+            matched = match_nodes(
+                {path: self._get_uuid(cmds, node) for path, node in scene_nodes.items()},
+                {path: file_uuids.get(path, "") for path in new_nodes},
+            )
+
+            updated, uvs_only, reassigned, skipped = [], [], [], []
+            for new_path, scene_path in sorted(matched.items()):
+                transferred, reason = self._transfer(
+                    cmds, new_nodes[new_path], scene_nodes[scene_path], options
+                )
+                if reason:
+                    skipped.append(f"{scene_path}: {reason}")
+                if "shape" in transferred:
+                    updated.append(scene_path)
+                if "uvs" in transferred:
+                    uvs_only.append(scene_path)
+                if "shading" in transferred:
+                    reassigned.append(scene_path)
+
+            added, added_roots = self._graft(cmds, roots, scene_nodes, new_nodes, matched, options)
+            leftovers = [
+                root for root in new_roots if root not in added_roots and cmds.objExists(root)
+            ]
+            if leftovers:
+                cmds.delete(leftovers)
+            self._reuse_scene_shading(cmds, namespace)
+            added_uuids = [self._get_uuid(cmds, root) for root in added_roots]
+            get_namespace_service().merge_namespaces(
+                cmds, [f"{ROOT_NAMESPACE}{namespace}"], ROOT_NAMESPACE
+            )
+        except Exception:
+            if cmds.namespace(exists=namespace):
+                cmds.namespace(removeNamespace=namespace, deleteNamespaceContent=True)
+            raise
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        placed = [(cmds.ls(uuid, long=True) or [""])[0] for uuid in added_uuids if uuid]
+        report = MergeImportReport(
+            asset_file=asset_file,
+            updated=tuple(updated),
+            uvs_only=tuple(uvs_only),
+            reassigned=tuple(reassigned),
+            added=tuple(added),
+            missing=tuple(_get_top_unmatched(list(scene_nodes), set(matched.values()))),
+            skipped=tuple(skipped),
+            roots=tuple(roots) + tuple(node for node in placed if node),
+        )
+        print(f"[OK] Updated {asset_file.stem} in place: {report.summary()}")
+        return report
+
+    def _transfer(
+        self, cmds: Any, source: str, target: str, options: MergeImportOptions
+    ) -> Tuple[List[str], str]:
+        """
+        Transfer the mesh of one imported transform onto its scene partner
+
+        Returns:
+            What was transferred (shape, uvs, shading), and why the mesh was skipped
+        """
+        source_shape = self._get_mesh(cmds, source)
+        target_shape = self._get_mesh(cmds, target)
+        if source_shape is None or target_shape is None:
+            return [], ""  # Groups, joints, and locators keep the scene's nodes as they are
+        input_shape = self._get_input_shape(cmds, target, target_shape)
+        if input_shape is None:
+            return [], "construction history feeds the mesh"
+        same_topology = self._get_topology(cmds, source_shape) == self._get_topology(
+            cmds, input_shape
+        )
+        if not same_topology and input_shape != target_shape:
+            # Deformer weights follow vertex ids
+            return [], "topology changed under a deformer"
+        if not same_topology and not options.shapes:
+            # UVs and face assignments only carry over between matching meshes
+            return [], "topology changed"
+
+        transferred = []
+        if options.shapes:
+            cmds.connectAttr(f"{source_shape}.outMesh", f"{input_shape}.inMesh", force=True)
+            cmds.dgeval(f"{input_shape}.outMesh")
+            cmds.disconnectAttr(f"{source_shape}.outMesh", f"{input_shape}.inMesh")
+            transferred.append("shape")
+        elif options.uvs:
+            cmds.polyTransfer(
+                input_shape,
+                alternateObject=source_shape,
+                uvSets=True,
+                vertices=False,
+                vertexColor=False,
+                constructionHistory=False,
+            )
+            transferred.append("uvs")
+        if options.shading and self._assign_shading(
+            cmds, source, source_shape, target, target_shape
+        ):
+            transferred.append("shading")
+        return transferred, ""
+
+    def _assign_shading(
+        self, cmds: Any, source: str, source_shape: str, target: str, target_shape: str
+    ) -> bool:
+        """Give a scene mesh the imported mesh's shading assignments - returns if they changed"""
+        before = set(self._get_shading_groups(cmds, target_shape))
+        after: Set[str] = set()
+        for group in self._get_shading_groups(cmds, source_shape):
+            members = []
+            for member in cmds.ls(cmds.sets(group, query=True) or [], long=True) or []:
+                node, dot, component = member.partition(".")
+                if node == source_shape or (node == source and not dot):
+                    members.append(target_shape)
+                elif node == source:
+                    members.append(f"{target}.{component}")
+            if not members:
+                continue
+            # The scene's own shading group of the same name wins over the new copy
+            name = group.rsplit(":", 1)[-1]
+            if cmds.objExists(name) and cmds.nodeType(name) == "shadingEngine":
+                group = name
+            cmds.sets(members, edit=True, forceElement=group)
+            after.add(group)
+        return bool(after) and after != before
+
+    def _graft(
+        self,
+        cmds: Any,
+        roots: List[str],
+        scene_nodes: Dict[str, str],
+        new_nodes: Dict[str, str],
+        matched: Dict[str, str],
+        options: MergeImportOptions,
+    ) -> Tuple[List[str], List[str]]:
+        """
+        Move the nodes the new version added under their parent's scene partner
+
+        Returns:
+            Relative paths of the added nodes, and the added roots (full paths)
+        """
+        added: List[str] = []
+        added_roots: List[str] = []
+        if not options.add_new:
+            return added, added_roots
+        root_parents = cmds.listRelatives(roots[0], parent=True, fullPath=True) or []
+        for path in _get_top_unmatched(list(new_nodes), set(matched)):
+            parent = path.rpartition("|")[0]
+            node = new_nodes[path]
+            if parent:
+                cmds.parent(node, scene_nodes[matched[parent]])
+            elif root_parents:
+                moved = cmds.parent(node, root_parents[0]) or [node]
+                added_roots.append((cmds.ls(moved[0], long=True) or [moved[0]])[0])
+            else:
+                added_roots.append(node)
+            added.append(path)
+        return added, added_roots
+
+    def _reuse_scene_shading(self, cmds: Any, namespace: str) -> None:
+        """
+        Reuse the scene's shading groups for the rest of the import
+
+        Members left in an imported group the scene also has move to the scene's group;
+        imported groups without members are deleted with their networks.
+        """
+        unused, kept = [], []
+        for group in cmds.ls(f"{namespace}:*", type="shadingEngine") or []:
+            members = cmds.sets(group, query=True)
+            name = group.rsplit(":", 1)[-1]
+            if members and cmds.objExists(name) and cmds.nodeType(name) == "shadingEngine":
+                cmds.sets(members, edit=True, forceElement=name)
+                members = None
+            (kept if members else unused).append(group)
+        if not unused:
+            return
+        keep: Set[str] = set()
+        for group in kept:
+            keep.update(cmds.listHistory(group) or [])
+        doomed = set()
+        for group in unused:
+            for node in [group] + (cmds.listHistory(group) or []):
+                # Only the import's own nodes, never scene textures it points at
+                if node.startswith(f"{namespace}:") and node not in keep:
+                    doomed.add(node)
+        cmds.delete(sorted(doomed))
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_uuid(self, cmds: Any, node: str) -> str:
+        """Get a node's UUID"""
+        return (cmds.ls(node, uuid=True) or [""])[0]
+
+    def _get_mesh(self, cmds: Any, transform: str) -> Optional[str]:
+        """Get the visible mesh shape of a transform"""
+        shapes = cmds.listRelatives(
+            transform, shapes=True, noIntermediate=True, fullPath=True, type="mesh"
+        )
+        return shapes[0] if shapes else None
+
+    def _get_input_shape(self, cmds: Any, transform: str, shape: str) -> Optional[str]:
+        """Get the shape mesh data goes into - the Orig shape when the mesh is deformed"""
+        if not self._has_input(cmds, shape):
+            return shape
+        shapes = cmds.listRelatives(transform, shapes=True, fullPath=True, type="mesh")
+        for candidate in shapes or []:
+            if cmds.getAttr(f"{candidate}.intermediateObject") and not self._has_input(
+                cmds, candidate
+            ):
+                return candidate
+        return None
+
+    def _has_input(self, cmds: Any, shape: str) -> bool:
+        """Check if something drives a mesh's inMesh"""
+        return bool(cmds.listConnections(f"{shape}.inMesh", source=True, destination=False))
+
+    def _get_topology(self, cmds: Any, shape: str) -> Tuple[int, int]:
+        """Get (vertex count, face count) of a mesh"""
+        return cmds.polyEvaluate(shape, vertex=True), cmds.polyEvaluate(shape, face=True)
+
+    def _get_shading_groups(self, cmds: Any, shape: str) -> List[str]:
+        """Get the shading groups a mesh is assigned to"""
+        return sorted(set(cmds.listConnections(shape, type="shadingEngine") or []))
+
+
+# Singleton instance factory
+_merge_import_service_instance = None
+
+
+def get_merge_import_service() -> MergeImportService:
+    """
+    Get singleton instance of MergeImportService.
+
+    Returns:
+        MergeImportService: Singleton service instance
+    """
+    global _merge_import_service_instance
+    if _merge_import_service_instance is None:
+        _merge_import_service_instance = MergeImportService()
+    return _merge_import_service_instance
@@ -0,0 +1,934 @@
+# -*- coding: utf-8 -*-
+"""
+Metadata Database Implementation
+Single SQLite database per library for tags, collections, versions, and stats
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Layout for a library (project) at ``MyProject/``::
+
+    MyProject/.assetmanager/library.db
+
+Asset rows are keyed by their path relative to the library root so the
+database keeps working when the library is mounted under a different drive
+letter. On first open, existing ``<asset>.meta`` JSON sidecars are migrated
+automatically; sidecars are left in place for older plugin versions.
+"""
+
+import json
+import logging
+import sqlite3
+import threading
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+DATABASE_DIR_NAME = ".assetmanager"
+DATABASE_FILE_NAME = "library.db"
+SIDECAR_SUFFIX = ".meta"
+SCHEMA_VERSION = 6
+
+# Tables copied by library packages and migrations, parents before children
+PORTABLE_TABLES = (
+    "assets",
+    "tags",
+    "collections",
+    "collection_assets",
+    "versions",
+    "depot_pins",
+    "checksums",
+    "activity",
+    "settings",
+    "redirects",
+    "favorites",
+    "ratings",
+    "comments",
+    "notifications",
+)
+
+_SCHEMA = """
+CREATE TABLE IF NOT EXISTS assets (
+    path TEXT PRIMARY KEY,
+    name TEXT NOT NULL,
+    category TEXT DEFAULT 'general',
+    is_favorite INTEGER DEFAULT 0,
+    modified_date TEXT DEFAULT '',
+    access_count INTEGER DEFAULT 0,
+    last_accessed TEXT,
+    extra TEXT DEFAULT '{}'
+);
+CREATE TABLE IF NOT EXISTS tags (
+    asset_path TEXT NOT NULL REFERENCES assets(path) ON DELETE CASCADE,
+    tag TEXT NOT NULL,
+    PRIMARY KEY (asset_path, tag)
+);
+CREATE INDEX IF NOT EXISTS idx_tags_tag ON tags(tag);
+CREATE TABLE IF NOT EXISTS collections (
+    name TEXT PRIMARY KEY,
+    data TEXT DEFAULT '{}'
+);
+CREATE TABLE IF NOT EXISTS collection_assets (
+    collection TEXT NOT NULL REFERENCES collections(name) ON DELETE CASCADE,
+    asset_name TEXT NOT NULL,
+    position INTEGER DEFAULT 0,
+    PRIMARY KEY (collection, asset_name)
+);
+CREATE TABLE IF NOT EXISTS versions (
+    asset_path TEXT NOT NULL,
+    number INTEGER NOT NULL,
+    author TEXT,
+    created_date TEXT,
+    notes TEXT,
+    PRIMARY KEY (asset_path, number)
+);
+CREATE TABLE IF NOT EXISTS depot_pins (
+    asset_path TEXT PRIMARY KEY,
+    change INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS checksums (
+    file_path TEXT PRIMARY KEY,
+    asset_path TEXT NOT NULL,
+    sha256 TEXT NOT NULL,
+    recorded_date TEXT
+);
+CREATE INDEX IF NOT EXISTS idx_checksums_asset ON checksums(asset_path);
+CREATE TABLE IF NOT EXISTS activity (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    timestamp TEXT NOT NULL,
+    user TEXT NOT NULL,
+    machine TEXT DEFAULT '',
+    action TEXT NOT NULL,
+    asset_path TEXT DEFAULT '',
+    details TEXT DEFAULT '{}'
+);
+CREATE INDEX IF NOT EXISTS idx_activity_asset ON activity(asset_path, timestamp);
+CREATE INDEX IF NOT EXISTS idx_activity_timestamp ON activity(timestamp);
+CREATE TABLE IF NOT EXISTS settings (
+    key TEXT PRIMARY KEY,
+    value TEXT
+);
+CREATE TABLE IF NOT EXISTS redirects (
+    old_path TEXT PRIMARY KEY,
+    new_path TEXT NOT NULL,
+    user TEXT DEFAULT '',
+    renamed_date TEXT
+);
+CREATE TABLE IF NOT EXISTS favorites (
+    asset_path TEXT NOT NULL,
+    user TEXT NOT NULL,
+    added_date TEXT,
+    PRIMARY KEY (asset_path, user)
+);
+CREATE TABLE IF NOT EXISTS ratings (
+    asset_path TEXT NOT NULL,
+    user TEXT NOT NULL,
+    rating INTEGER NOT NULL,
+    rated_date TEXT,
+    PRIMARY KEY (asset_path, user)
+);
+CREATE TABLE IF NOT EXISTS comments (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    asset_path TEXT NOT NULL,
+    parent_id INTEGER,
+    version INTEGER DEFAULT 0,
+    user TEXT NOT NULL,
+    body TEXT NOT NULL,
+    created_date TEXT NOT NULL,
+    resolved INTEGER DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_comments_asset ON comments(asset_path, created_date);
+CREATE TABLE IF NOT EXISTS notifications (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    user TEXT NOT NULL,
+    comment_id INTEGER NOT NULL,
+    created_date TEXT,
+    read INTEGER DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_notifications_user ON notifications(user, read);
+"""
+
+
+class MetadataDatabase:
+    """
+    Metadata Database - Single Responsibility for library-wide metadata storage
+    One connection per database guarded by a lock; safe to share between threads
+    """
+
+    def __init__(self, library_root: Path, db_path: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._library_root = Path(library_root)
+        self._db_path = db_path or self._library_root / DATABASE_DIR_NAME / DATABASE_FILE_NAME
+        self._lock = threading.RLock()
+
+        self._db_path.parent.mkdir(parents=True, exist_ok=True)
+        # Generous timeout - shared network libraries see concurrent writers
+        self._connection = sqlite3.connect(
+            str(self._db_path), timeout=10.0, check_same_thread=False
+        )
+        self._connection.row_factory = sqlite3.Row
+        self._connection.execute("PRAGMA foreign_keys = ON")
+        self._create_schema()
+
+    @property
+    def db_path(self) -> Path:
+        """Get database file location"""
+        return self._db_path
+
+    @property
+    def library_root(self) -> Path:
+        """Get the library folder asset keys are relative to"""
+        return self._library_root
+
+    def close(self) -> None:
+        """Close the database connection"""
+        with self._lock:
+            self._connection.close()
+
+    # Assets -----------------------------------------------------------------------------
+
+    def get_asset_key(self, file_path: Path) -> str:
+        """Get the library-relative key used for an asset file"""
+        file_path = Path(file_path)
+        try:
+            return file_path.relative_to(self._library_root).as_posix()
+        except ValueError:
+            return file_path.as_posix()
+
+    def save_asset_metadata(self, file_path: Path, metadata: Dict[str, Any]) -> None:
+        """
+        Insert or update metadata for one asset
+
+        Args:
+            file_path: Asset file
+            metadata: Keys tags, is_favorite, category, modified_date; anything else
+                is kept in the extra column
+        """
+        key = self.get_asset_key(file_path)
+        known = {"tags", "is_favorite", "category", "modified_date"}
+        known |= {"access_count", "last_accessed"}  # owned by record_access
+        extra = {k: v for k, v in metadata.items() if k not in known}
+
+        with self._lock, self._connection:
+            self._connection.execute(
+                """
+                INSERT INTO assets (path, name, category, is_favorite, modified_date, extra)
+                VALUES (?, ?, ?, ?, ?, ?)
+                ON CONFLICT(path) DO UPDATE SET
+                    category = excluded.category,
+                    is_favorite = excluded.is_favorite,
+                    modified_date = excluded.modified_date,
+                    extra = excluded.extra
+                """,
+                (
+                    key,
+                    Path(file_path).stem,
+                    metadata.get("category", "general"),
+                    int(bool(metadata.get("is_favorite", False))),
+                    str(metadata.get("modified_date", "")),
+                    json.dumps(extra),
+                ),
+            )
+            if "tags" in metadata:
+                self._connection.execute("DELETE FROM tags WHERE asset_path = ?", (key,))
+                self._connection.executemany(
+                    "INSERT OR IGNORE INTO tags (asset_path, tag) VALUES (?, ?)",
+                    [(key, tag) for tag in metadata.get("tags") or []],
+                )
+
+    def get_asset_metadata(self, file_path: Path) -> Optional[Dict[str, Any]]:
+        """Get metadata for one asset, None if it has no row yet"""
+        key = self.get_asset_key(file_path)
+        return self._load_metadata("WHERE a.path = ?", (key,)).get(key)
+
+    def get_all_metadata(self) -> Dict[str, Dict[str, Any]]:
+        """Get metadata for every asset keyed by asset key - one query for the library"""
+        return self._load_metadata("", ())
+
+    def remove_asset(self, file_path: Path) -> None:
+        """Delete an asset and its tags from the database"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            self._connection.execute("DELETE FROM assets WHERE path = ?", (key,))
+            self._connection.execute("DELETE FROM versions WHERE asset_path = ?", (key,))
+            self._connection.execute("DELETE FROM checksums WHERE asset_path = ?", (key,))
+
+    def rename_asset(self, file_path: Path, new_path: Path) -> None:
+        """Move an asset's row, tags, versions, pin, log, notes, and memberships to a new path"""
+        old_key, new_key = self.get_asset_key(file_path), self.get_asset_key(new_path)
+        with self._lock, self._connection:
+            # Tags reference the asset row, so the row is copied before they move
+            self._connection.execute(
+                "INSERT OR REPLACE INTO assets (path, name, category, is_favorite, "
+                "modified_date, access_count, last_accessed, extra) "
+                "SELECT ?, ?, category, is_favorite, modified_date, access_count, "
+                "last_accessed, extra FROM assets WHERE path = ?",
+                (new_key, Path(new_path).stem, old_key),
+            )
+            for table, column in (
+                ("tags", "asset_path"),
+                ("versions", "asset_path"),
+                ("depot_pins", "asset_path"),
+                ("checksums", "asset_path"),
+                ("activity", "asset_path"),
+                ("collection_assets", "asset_name"),
+                ("favorites", "asset_path"),
+                ("ratings", "asset_path"),
+                ("comments", "asset_path"),
+            ):
+                self._connection.execute(
+                    f"UPDATE OR REPLACE {table} SET {column} = ? WHERE {column} = ?",
+                    (new_key, old_key),
+                )
+            self._connection.execute("DELETE FROM assets WHERE path = ?", (old_key,))
+
+    def find_assets_by_tag(self, tag: str) -> List[str]:
+        """Get asset keys carrying a tag (case-insensitive)"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT asset_path FROM tags WHERE tag = ? COLLATE NOCASE ORDER BY asset_path",
+                (tag,),
+            ).fetchall()
+        return [row["asset_path"] for row in rows]
+
+    def get_all_tags(self) -> Dict[str, int]:
+        """Get every tag with its usage count"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT tag, COUNT(*) AS uses FROM tags GROUP BY tag ORDER BY tag"
+            ).fetchall()
+        return {row["tag"]: row["uses"] for row in rows}
+
+    def replace_tags(self, replacements: Dict[str, Optional[str]]) -> List[str]:
+        """
+        Rename or delete tags on every asset in one transaction
+
+        Args:
+            replacements: Tag -> new tag, None deletes the tag (merging two tags is
+                renaming both to the same tag)
+
+        Returns:
+            Keys of the assets whose tags changed
+        """
+        placeholders = ", ".join("?" for _ in replacements)
+        with self._lock, self._connection:
+            # Read everything first so chained renames (a -> a/b, a/b -> a/b/b) apply once
+            rows = self._connection.execute(
+                f"SELECT asset_path, tag FROM tags WHERE tag IN ({placeholders})",
+                tuple(replacements),
+            ).fetchall()
+            self._connection.execute(
+                f"DELETE FROM tags WHERE tag IN ({placeholders})", tuple(replacements)
+            )
+            self._connection.executemany(
+                "INSERT OR IGNORE INTO tags (asset_path, tag) VALUES (?, ?)",
+                [
+                    (row["asset_path"], replacements[row["tag"]])
+                    for row in rows
+                    if replacements[row["tag"]]
+                ],
+            )
+        return sorted({row["asset_path"] for row in rows})
+
+    # Stats ------------------------------------------------------------------------------
+
+    def record_access(self, file_path: Path) -> None:
+        """Bump access count and last-accessed time (e.g. on import)"""
+        key = self.get_asset_key(file_path)
+        now = datetime.now().isoformat()
+        with self._lock, self._connection:
+            self._connection.execute(
+                """
+                INSERT INTO assets (path, name, access_count, last_accessed) VALUES (?, ?, 1, ?)
+                ON CONFLICT(path) DO UPDATE SET
+                    access_count = access_count + 1,
+                    last_accessed = excluded.last_accessed
+                """,
+                (key, Path(file_path).stem, now),
+            )
+
+    def get_most_used(self, limit: int = 20) -> List[str]:
+        """Get asset keys ordered by access count"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT path FROM assets WHERE access_count > 0 "
+                "ORDER BY access_count DESC, last_accessed DESC LIMIT ?",
+                (limit,),
+            ).fetchall()
+        return [row["path"] for row in rows]
+
+    # Collections ------------------------------------------------------------------------
+
+    def save_collection(self, name: str, data: Dict[str, Any]) -> None:
+        """Insert or replace a collection; data["assets"] holds member asset keys"""
+        members = list(data.get("assets", []))
+        details = {k: v for k, v in data.items() if k != "assets"}
+        with self._lock, self._connection:
+            self._connection.execute(
+                "INSERT INTO collections (name, data) VALUES (?, ?) "
+                "ON CONFLICT(name) DO UPDATE SET data = excluded.data",
+                (name, json.dumps(details, default=str)),
+            )
+            self._connection.execute(
+                "DELETE FROM collection_assets WHERE collection = ?", (name,)
+            )
+            self._connection.executemany(
+                "INSERT OR IGNORE INTO collection_assets (collection, asset_name, position) "
+                "VALUES (?, ?, ?)",
+                [(name, asset_name, i) for i, asset_name in enumerate(members)],
+            )
+
+    def delete_collection(self, name: str) -> None:
+        """Delete a collection and its membership"""
+        with self._lock, self._connection:
+            self._connection.execute("DELETE FROM collections WHERE name = ?", (name,))
+
+    def get_collections(self) -> Dict[str, Dict[str, Any]]:
+        """Get all collections in the dict layout the Collection Manager uses"""
+        with self._lock:
+            rows = self._connection.execute("SELECT name, data FROM collections").fetchall()
+            members = self._connection.execute(
+                "SELECT collection, asset_name FROM collection_assets "
+                "ORDER BY collection, position"
+            ).fetchall()
+
+        collections = {}
+        for row in rows:
+            collections[row["name"]] = {**json.loads(row["data"] or "{}"), "assets": []}
+        for member in members:
+            collections[member["collection"]]["assets"].append(member["asset_name"])
+        return collections
+
+    # Depot pins -------------------------------------------------------------------------
+
+    def set_depot_pin(self, file_path: Path, change: Optional[int]) -> None:
+        """Pin an asset to a source control changelist; None syncs it to head again"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            if change:
+                self._connection.execute(
+                    "INSERT OR REPLACE INTO depot_pins (asset_path, change) VALUES (?, ?)",
+                    (key, int(change)),
+                )
+            else:
+                self._connection.execute("DELETE FROM depot_pins WHERE asset_path = ?", (key,))
+
+    def get_depot_pin(self, file_path: Path) -> Optional[int]:
+        """Get the changelist an asset is pinned to, None for head"""
+        with self._lock:
+            row = self._connection.execute(
+                "SELECT change FROM depot_pins WHERE asset_path = ?",
+                (self.get_asset_key(file_path),),
+            ).fetchone()
+        return row["change"] if row else None
+
+    # Versions ---------------------------------------------------------------------------
+
+    def record_versions(self, file_path: Path, versions: List[Any]) -> None:
+        """Mirror an asset's version history (AssetVersion list) for fast queries"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            self._connection.executemany(
+                "INSERT OR REPLACE INTO versions (asset_path, number, author, created_date, notes)"
+                " VALUES (?, ?, ?, ?, ?)",
+                [
+                    (
+                        key,
+                        version.number,
+                        version.author,
+                        version.created_date.isoformat() if version.created_date else None,
+                        version.notes,
+                    )
+                    for version in versions
+                ],
+            )
+
+    def get_latest_version_numbers(self) -> Dict[str, int]:
+        """Get the latest recorded version number for every versioned asset"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT asset_path, MAX(number) AS latest FROM versions GROUP BY asset_path"
+            ).fetchall()
+        return {row["asset_path"]: row["latest"] for row in rows}
+
+    def get_version_authors(self) -> Dict[str, List[str]]:
+        """Get every artist who published a version, per asset key"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT DISTINCT asset_path, author FROM versions "
+                "WHERE author IS NOT NULL AND author != '' ORDER BY asset_path, author"
+            ).fetchall()
+
+        authors: Dict[str, List[str]] = {}
+        for row in rows:
+            authors.setdefault(row["asset_path"], []).append(row["author"])
+        return authors
+
+    # Checksums --------------------------------------------------------------------------
+
+    def record_checksums(self, file_path: Path, checksums: Dict[Path, str]) -> None:
+        """
+        Store the SHA-256 of files published with an asset
+
+        Args:
+            file_path: Asset file the files belong to
+            checksums: Published file (asset, companion, version snapshot) -> SHA-256
+        """
+        key = self.get_asset_key(file_path)
+        recorded = datetime.now().isoformat()
+        with self._lock, self._connection:
+            self._connection.executemany(
+                "INSERT OR REPLACE INTO checksums (file_path, asset_path, sha256, recorded_date)"
+                " VALUES (?, ?, ?, ?)",
+                [
+                    (self.get_asset_key(path), key, sha256, recorded)
+                    for path, sha256 in checksums.items()
+                ],
+            )
+
+    def get_checksums(self) -> Dict[str, Dict[str, str]]:
+        """Get every recorded checksum keyed by file key, with its asset key"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT file_path, asset_path, sha256, recorded_date FROM checksums "
+                "ORDER BY file_path"
+            ).fetchall()
+        return {
+            row["file_path"]: {
+                "asset": row["asset_path"],
+                "sha256": row["sha256"],
+                "recorded_date": row["recorded_date"] or "",
+            }
+            for row in rows
+        }
+
+    def remove_checksums(self, file_keys: List[str]) -> None:
+        """Forget the checksums of files (library-relative file keys)"""
+        with self._lock, self._connection:
+            self._connection.executemany(
+                "DELETE FROM checksums WHERE file_path = ?", [(key,) for key in file_keys]
+            )
+
+    # Redirects --------------------------------------------------------------------------
+
+    def add_redirect(self, file_path: Path, new_path: Path, user: str = "") -> None:
+        """Remember that an asset now lives at another path"""
+        old_key, new_key = self.get_asset_key(file_path), self.get_asset_key(new_path)
+        with self._lock, self._connection:
+            # Older redirects to the old path follow it; one back to new_path is void
+            self._connection.execute(
+                "UPDATE redirects SET new_path = ? WHERE new_path = ?", (new_key, old_key)
+            )
+            self._connection.execute("DELETE FROM redirects WHERE old_path = ?", (new_key,))
+            self._connection.execute(
+                "INSERT OR REPLACE INTO redirects (old_path, new_path, user, renamed_date)"
+                " VALUES (?, ?, ?, ?)",
+                (old_key, new_key, user, datetime.now().isoformat()),
+            )
+
+    def get_redirects(self) -> Dict[str, str]:
+        """Get the current key of every renamed or moved asset, keyed by its old key"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT old_path, new_path FROM redirects ORDER BY old_path"
+            ).fetchall()
+        return {row["old_path"]: row["new_path"] for row in rows}
+
+    # Favorites and ratings --------------------------------------------------------------
+
+    def set_favorite(self, file_path: Path, user: str, favorite: bool = True) -> None:
+        """Add an asset to, or remove it from, one artist's favorites"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            if favorite:
+                self._connection.execute(
+                    "INSERT OR IGNORE INTO favorites (asset_path, user, added_date)"
+                    " VALUES (?, ?, ?)",
+                    (key, user, datetime.now().isoformat()),
+                )
+            else:
+                self._connection.execute(
+                    "DELETE FROM favorites WHERE asset_path = ? AND user = ?", (key, user)
+                )
+
+    def get_favorites(self, user: str) -> List[str]:
+        """Get the asset keys an artist favorited, oldest first"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT asset_path FROM favorites WHERE user = ? ORDER BY added_date",
+                (user,),
+            ).fetchall()
+        return [row["asset_path"] for row in rows]
+
+    def set_rating(self, file_path: Path, user: str, rating: int) -> None:
+        """Store one artist's star rating of an asset (0 clears it)"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            if rating > 0:
+                self._connection.execute(
+                    "INSERT OR REPLACE INTO ratings (asset_path, user, rating, rated_date)"
+                    " VALUES (?, ?, ?, ?)",
+                    (key, user, int(rating), datetime.now().isoformat()),
+                )
+            else:
+                self._connection.execute(
+                    "DELETE FROM ratings WHERE asset_path = ? AND user = ?", (key, user)
+                )
+
+    def get_ratings(self, user: str = "") -> Dict[str, Dict[str, Any]]:
+        """Get average, count, and the artist's own rating of every rated asset"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT asset_path, AVG(rating) AS average, COUNT(*) AS count,"
+                " MAX(CASE WHEN user = ? THEN rating ELSE 0 END) AS mine"
+                " FROM ratings GROUP BY asset_path",
+                (user,),
+            ).fetchall()
+        return {
+            row["asset_path"]: {
+                "average": row["average"],
+                "count": row["count"],
+                "user_rating": row["mine"],
+            }
+            for row in rows
+        }
+
+    # Comments ---------------------------------------------------------------------------
+
+    def add_comment(
+        self,
+        file_path: Path,
+        user: str,
+        body: str,
+        created_date: str,
+        version: int = 0,
+        parent_id: Optional[int] = None,
+    ) -> int:
+        """Store a review note on an asset and get its id"""
+        key = self.get_asset_key(file_path)
+        with self._lock, self._connection:
+            cursor = self._connection.execute(
+                "INSERT INTO comments (asset_path, parent_id, version, user, body, created_date)"
+                " VALUES (?, ?, ?, ?, ?, ?)",
+                (key, parent_id, int(version), user, body, created_date),
+            )
+        return int(cursor.lastrowid)
+
+    def get_comments(self, file_path: Path) -> List[Dict[str, Any]]:
+        """Get every note on an asset, oldest first"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT * FROM comments WHERE asset_path = ? ORDER BY created_date, id",
+                (self.get_asset_key(file_path),),
+            ).fetchall()
+        return [dict(row) for row in rows]
+
+    def get_comment(self, comment_id: int) -> Optional[Dict[str, Any]]:
+        """Get one note by id"""
+        with self._lock:
+            row = self._connection.execute(
+                "SELECT * FROM comments WHERE id = ?", (int(comment_id),)
+            ).fetchone()
+        return dict(row) if row else None
+
+    def set_comments_resolved(self, comment_ids: List[int], resolved: bool) -> None:
+        """Mark notes resolved or open again"""
+        with self._lock, self._connection:
+            self._connection.executemany(
+                "UPDATE comments SET resolved = ? WHERE id = ?",
+                [(int(resolved), int(comment_id)) for comment_id in comment_ids],
+            )
+
+    def add_notifications(self, comment_id: int, users: List[str], created_date: str) -> None:
+        """Tell artists about a note that mentions them"""
+        with self._lock, self._connection:
+            self._connection.executemany(
+                "INSERT INTO notifications (user, comment_id, created_date) VALUES (?, ?, ?)",
+                [(user, int(comment_id), created_date) for user in users],
+            )
+
+    def get_notifications(self, user: str, unread_only: bool = True) -> List[Dict[str, Any]]:
+        """
+        Get an artist's notifications with the note they point at, newest first
+
+        Artists are matched without case, as mentions are typed by hand.
+        """
+        query = (
+            "SELECT notifications.id AS notification_id, notifications.read, comments.*"
+            " FROM notifications JOIN comments ON comments.id = notifications.comment_id"
+            " WHERE notifications.user = ? COLLATE NOCASE"
+        )
+        if unread_only:
+            query += " AND notifications.read = 0"
+        query += " ORDER BY notifications.created_date DESC, notifications.id DESC"
+        with self._lock:
+            rows = self._connection.execute(query, (user,)).fetchall()
+        return [dict(row) for row in rows]
+
+    def mark_notifications_read(
+        self, user: str, notification_ids: Optional[List[int]] = None
+    ) -> None:
+        """Mark some, or all, of an artist's notifications read"""
+        with self._lock, self._connection:
+            if notification_ids is None:
+                self._connection.execute(
+                    "UPDATE notifications SET read = 1 WHERE user = ? COLLATE NOCASE", (user,)
+                )
+            else:
+                self._connection.executemany(
+                    "UPDATE notifications SET read = 1 WHERE user = ? COLLATE NOCASE AND id = ?",
+                    [(user, int(notification_id)) for notification_id in notification_ids],
+                )
+
+    # Activity ---------------------------------------------------------------------------
+
+    def log_activity(
+        self,
+        file_path: Optional[Path],
+        action: str,
+        user: str,
+        machine: str,
+        timestamp: str,
+        details: Optional[Dict[str, Any]] = None,
+    ) -> None:
+        """Append one entry to the library activity log (kept when assets are removed)"""
+        key = self.get_asset_key(file_path) if file_path else ""
+        with self._lock, self._connection:
+            self._connection.execute(
+                "INSERT INTO activity (timestamp, user, machine, action, asset_path, details)"
+                " VALUES (?, ?, ?, ?, ?, ?)",
+                (timestamp, user, machine, action, key, json.dumps(details or {}, default=str)),
+            )
+
+    def get_activity(
+        self,
+        file_path: Optional[Path] = None,
+        user: str = "",
+        since: str = "",
+        until: str = "",
+        action: str = "",
+        limit: Optional[int] = None,
+    ) -> List[Dict[str, Any]]:
+        """
+        Get activity log entries, newest first
+
+        Args:
+            file_path: Only entries of this asset
+            user: Only entries by this artist
+            since: ISO timestamp entries start at (inclusive)
+            until: ISO timestamp entries end before (exclusive)
+            action: Only entries of this action
+            limit: Most entries returned
+        """
+        clauses, params = [], []
+        for column, value in (
+            ("asset_path = ?", self.get_asset_key(file_path) if file_path else ""),
+            ("user = ?", user),
+            ("timestamp >= ?", since),
+            ("timestamp < ?", until),
+            ("action = ?", action),
+        ):
+            if value:
+                clauses.append(column)
+                params.append(value)
+        query = "SELECT * FROM activity"
+        if clauses:
+            query += " WHERE " + " AND ".join(clauses)
+        query += " ORDER BY timestamp DESC, id DESC"
+        if limit:
+            query += f" LIMIT {int(limit)}"
+
+        with self._lock:
+            rows = self._connection.execute(query, params).fetchall()
+        return [
+            {
+                "timestamp": row["timestamp"],
+                "user": row["user"],
+                "machine": row["machine"] or "",
+                "action": row["action"],
+                "asset": row["asset_path"] or "",
+                "details": json.loads(row["details"] or "{}"),
+            }
+            for row in rows
+        ]
+
+    def get_activity_users(self) -> List[str]:
+        """Get every artist with an entry in the activity log"""
+        with self._lock:
+            rows = self._connection.execute(
+                "SELECT DISTINCT user FROM activity ORDER BY user COLLATE NOCASE"
+            ).fetchall()
+        return [row["user"] for row in rows]
+
+    # Portability ------------------------------------------------------------------------
+
+    def export_tables(self) -> Dict[str, List[Dict[str, Any]]]:
+        """Get every row of the portable tables as plain dictionaries"""
+        tables = {}
+        with self._lock:
+            for table in PORTABLE_TABLES:
+                rows = self._connection.execute(f"SELECT * FROM {table}").fetchall()
+                tables[table] = [dict(row) for row in rows]
+        return tables
+
+    def import_tables(self, tables: Dict[str, List[Dict[str, Any]]]) -> int:
+        """
+        Replace the contents of the given tables in one transaction
+
+        Columns this schema does not know are dropped, so packages written by
+        older or newer plugin versions still import.
+
+        Returns:
+            Number of rows written
+        """
+        names = [table for table in PORTABLE_TABLES if table in tables]
+        written = 0
+        with self._lock, self._connection:
+            for table in reversed(names):
+                self._connection.execute(f"DELETE FROM {table}")
+            for table in names:
+                columns = {
+                    row["name"]
+                    for row in self._connection.execute(f"PRAGMA table_info({table})")
+                }
+                for row in tables[table]:
+                    known = [column for column in row if column in columns]
+                    if not known:
+                        continue
+                    self._connection.execute(
+                        f"INSERT OR REPLACE INTO {table} ({', '.join(known)}) "
+                        f"VALUES ({', '.join('?' for _ in known)})",
+                        [row[column] for column in known],
+                    )
+                    written += 1
+        return written
+
+    # Migration --------------------------------------------------------------------------
+
+    def migrate_from_json(self, force: bool = False) -> int:
+        """
+        Import existing ``.meta`` sidecars and version manifests into the database
+
+        Runs once per library unless forced; sidecars are not deleted.
+
+        Args:
+            force: Re-run even if the library was already migrated
+
+        Returns:
+            Number of sidecar files imported
+        """
+        if not force and self._get_setting("json_migrated"):
+            return 0
+
+        imported = 0
+        for sidecar in self._library_root.rglob(f"*{SIDECAR_SUFFIX}"):
+            relative_parts = sidecar.relative_to(self._library_root).parts
+            if any(part.startswith(".") for part in relative_parts[:-1]):
+                continue
+            try:
+                with open(sidecar, "r", encoding="utf-8") as f:
+                    metadata = json.load(f)
+                asset_file = sidecar.with_name(sidecar.name[: -len(SIDECAR_SUFFIX)])
+                self.save_asset_metadata(asset_file, metadata)
+                imported += 1
+            except Exception as e:
+                self.logger.warning(f"Skipping unreadable metadata sidecar {sidecar}: {e}")
+
+        self._migrate_version_manifests()
+        self._set_setting("json_migrated", datetime.now().isoformat())
+        print(f"[OK] Migrated {imported} metadata sidecars into {self._db_path.name}")
+        return imported
+
+    def _migrate_version_manifests(self) -> None:
+        """Import version manifests written by the version service"""
+        from .version_service_impl import (
+            MANIFEST_FILE_NAME,
+            VERSIONS_DIR_NAME,
+            get_version_service,
+        )
+
+        version_service = get_version_service()
+        for manifest in self._library_root.rglob(f"{VERSIONS_DIR_NAME}/*/{MANIFEST_FILE_NAME}"):
+            asset_dir = manifest.parent.parent.parent
+            stem = manifest.parent.name
+            for asset_file in asset_dir.glob(f"{stem}.*"):
+                if asset_file.is_file() and not asset_file.name.endswith(SIDECAR_SUFFIX):
+                    self.record_versions(asset_file, version_service.get_versions(asset_file))
+                    break
+
+    # Internals --------------------------------------------------------------------------
+
+    def _create_schema(self) -> None:
+        """Create tables and record schema version"""
+        with self._lock, self._connection:
+            self._connection.executescript(_SCHEMA)
+            self._connection.execute(f"PRAGMA user_version = {SCHEMA_VERSION}")
+
+    def _load_metadata(self, where: str, params: tuple) -> Dict[str, Dict[str, Any]]:
+        """Load asset rows plus tags into plain dictionaries"""
+        with self._lock:
+            rows = self._connection.execute(f"SELECT * FROM assets a {where}", params).fetchall()
+            tag_rows = self._connection.execute(
+                "SELECT asset_path, tag FROM tags "
+                + ("WHERE asset_path = ?" if where else "")
+                + " ORDER BY rowid",
+                params,
+            ).fetchall()
+
+        tags: Dict[str, List[str]] = {}
+        for row in tag_rows:
+            tags.setdefault(row["asset_path"], []).append(row["tag"])
+
+        result = {}
+        for row in rows:
+            metadata = json.loads(row["extra"] or "{}")
+            metadata.update(
+                {
+                    "tags": tags.get(row["path"], []),
+                    "is_favorite": bool(row["is_favorite"]),
+                    "category": row["category"],
+                    "modified_date": row["modified_date"],
+                    "access_count": row["access_count"],
+                    "last_accessed": row["last_accessed"],
+                }
+            )
+            result[row["path"]] = metadata
+        return result
+
+    def _get_setting(self, key: str) -> Optional[str]:
+        with self._lock:
+            row = self._connection.execute(
+                "SELECT value FROM settings WHERE key = ?", (key,)
+            ).fetchone()
+        return row["value"] if row else None
+
+    def _set_setting(self, key: str, value: str) -> None:
+        with self._lock, self._connection:
+            self._connection.execute(
+                "INSERT OR REPLACE INTO settings (key, value) VALUES (?, ?)", (key, value)
+            )
+
+
+# One database per library root
+_databases: Dict[str, MetadataDatabase] = {}
+_databases_lock = threading.Lock()
+
+
+def get_metadata_database(library_root: Path) -> MetadataDatabase:
+    """
+    Get the metadata database of a library, migrating JSON sidecars on first open.
+
+    Args:
+        library_root: Library (project) root directory
+
+    Returns:
+        MetadataDatabase: Shared database instance for that library
+    """
+    key = str(Path(library_root).resolve())
+    with _databases_lock:
+        database = _databases.get(key)
+        if database is None:
+            database = MetadataDatabase(Path(library_root))
+            database.migrate_from_json()
+            _databases[key] = database
+    return database
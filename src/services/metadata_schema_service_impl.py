@@ -0,0 +1,125 @@
+# -*- coding: utf-8 -*-
+"""
+Metadata Schema Service Implementation
+Library-defined custom metadata fields and their values per asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Fields are defined once per library; values are stored with the asset's metadata
+under "fields" and can be filtered in search by field key::
+
+    MyProject/.assetmanager/metadata_schema.json
+    {
+      "fields": [
+        {"name": "Approved by", "type": "text"},
+        {"name": "Polycount budget", "type": "number"},
+        {"name": "Franchise", "type": "enum", "options": ["Racers", "Pirates"]},
+        {"name": "Game ready", "type": "boolean"}
+      ]
+    }
+
+    approved_by:kim   polycount_budget:<5000   franchise:racers   game_ready:yes
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.metadata_field import MetadataField
+
+SCHEMA_DIR_NAME = ".assetmanager"
+SCHEMA_FILE_NAME = "metadata_schema.json"
+FIELDS_METADATA_KEY = "fields"  # Asset metadata key holding field name -> value
+
+
+class MetadataSchemaService:
+    """
+    Metadata Schema Service - Single Responsibility for custom metadata fields
+    Invalid schema entries are skipped with a warning so one typo hides one field only
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def get_schema_file(self, library_root: Path) -> Path:
+        """Get the schema file of a library"""
+        return Path(library_root) / SCHEMA_DIR_NAME / SCHEMA_FILE_NAME
+
+    def load_schema(self, library_root: Optional[Path]) -> List[MetadataField]:
+        """Get a library's custom fields in schema order, none without a schema file"""
+        if library_root is None:
+            return []
+        schema_file = self.get_schema_file(library_root)
+        if not schema_file.is_file():
+            return []
+        try:
+            with open(schema_file, "r", encoding="utf-8") as f:
+                entries = json.load(f).get("fields", [])
+        except Exception as e:
+            self.logger.warning(f"Unreadable metadata schema {schema_file}: {e}")
+            return []
+
+        fields: List[MetadataField] = []
+        for entry in entries:
+            try:
+                field = MetadataField.from_dict(entry)
+            except (AttributeError, ValueError) as e:
+                print(f"[WARNING] Skipping metadata field in {schema_file.name}: {e}")
+                continue
+            if any(existing.key == field.key for existing in fields):
+                print(f"[WARNING] Skipping duplicate metadata field '{field.name}'")
+                continue
+            fields.append(field)
+        return fields
+
+    def save_schema(self, library_root: Path, fields: List[MetadataField]) -> bool:
+        """Write a library's custom fields"""
+        schema_file = self.get_schema_file(library_root)
+        try:
+            schema_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(schema_file, "w", encoding="utf-8") as f:
+                json.dump({"fields": [field.to_dict() for field in fields]}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save metadata schema: {e}")
+            return False
+
+    def get_values(self, metadata: Optional[Dict[str, Any]]) -> Dict[str, Any]:
+        """Get the custom field values stored in asset metadata (field name -> value)"""
+        return dict((metadata or {}).get(FIELDS_METADATA_KEY, {}) or {})
+
+    def set_value(
+        self, values: Dict[str, Any], field: MetadataField, value: Any
+    ) -> Dict[str, Any]:
+        """
+        Set one field in a copy of the values; empty values remove the field
+
+        Raises:
+            ValueError: If the value does not fit the field
+        """
+        updated = dict(values)
+        typed = field.coerce(value)
+        if typed is None:
+            updated.pop(field.name, None)
+        else:
+            updated[field.name] = typed
+        return updated
+
+
+# Singleton instance factory
+_metadata_schema_service_instance = None
+
+
+def get_metadata_schema_service() -> MetadataSchemaService:
+    """
+    Get singleton instance of MetadataSchemaService.
+
+    Returns:
+        MetadataSchemaService: Singleton service instance
+    """
+    global _metadata_schema_service_instance
+    if _metadata_schema_service_instance is None:
+        _metadata_schema_service_instance = MetadataSchemaService()
+    return _metadata_schema_service_instance
@@ -0,0 +1,391 @@
+# -*- coding: utf-8 -*-
+"""
+Missing Asset Service Implementation
+Stand in for referenced assets whose files are missing and resolve them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A reference whose file cannot be found (server down, version pruned) is left
+unloaded instead of failing, and a wireframe box the size of the asset's stored
+bounding box is placed where its edits put it. The box carries what the library
+knows about the asset::
+
+    crate_placeholder (nurbsCurve box, drawn red)
+        .assetManagerPlaceholder        <- reference node it stands in for
+        .assetManagerPlaceholderAsset   <- library asset file
+        .assetManagerPlaceholderFile    <- missing file the reference loads
+        .assetManagerPlaceholderVersion <- version loaded, 0 when not known
+        .assetManagerPlaceholderInfo    <- JSON of author, description, tags, status, stats
+
+The artist then loads another version, repaths the reference, or removes it.
+"""
+
+import json
+import logging
+import shlex
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.geometry_stats import GeometryStats
+from .geometry_stats_service_impl import STATS_METADATA_KEY
+from .maya_integration_impl import REFERENCE_FILE_TYPES, sanitize_namespace
+from .metadata_database_impl import get_metadata_database
+from .reference_update_service_impl import get_reference_update_service, parse_reference_edit
+from .scene_asset_service_impl import SceneAsset, get_scene_asset_service
+from .version_service_impl import get_version_service
+
+REFERENCE_ATTRIBUTE = "assetManagerPlaceholder"
+ASSET_ATTRIBUTE = "assetManagerPlaceholderAsset"
+FILE_ATTRIBUTE = "assetManagerPlaceholderFile"
+VERSION_ATTRIBUTE = "assetManagerPlaceholderVersion"
+INFO_ATTRIBUTE = "assetManagerPlaceholderInfo"
+PLACEHOLDER_SUFFIX = "_placeholder"
+
+RESOLVE_VERSION = "version"  # Load another version of the asset
+RESOLVE_REPATH = "repath"  # Load the reference from a file picked by hand
+RESOLVE_REMOVE = "remove"  # Remove the reference and its placeholder
+RESOLUTIONS = (RESOLVE_VERSION, RESOLVE_REPATH, RESOLVE_REMOVE)
+
+PLACEHOLDER_COLOR = 13  # Maya's red drawing override index
+DEFAULT_SIZE = 1.0  # Box side when the asset has no stored bounding box
+INFO_KEYS = ("author", "description", "tags", "status", STATS_METADATA_KEY)
+
+# Reference edit attribute -> placeholder attribute its values are copied to
+PLACEMENT_ATTRIBUTES = {
+    "translate": "translate",
+    "t": "translate",
+    "rotate": "rotate",
+    "r": "rotate",
+    "scale": "scale",
+    "s": "scale",
+}
+
+# Corners of a unit box standing on the origin, drawn as one linear curve
+_BOX_POINTS = [
+    (-0.5, 0, -0.5),
+    (0.5, 0, -0.5),
+    (0.5, 0, 0.5),
+    (-0.5, 0, 0.5),
+    (-0.5, 0, -0.5),
+    (-0.5, 1, -0.5),
+    (0.5, 1, -0.5),
+    (0.5, 0, -0.5),
+    (0.5, 1, -0.5),
+    (0.5, 1, 0.5),
+    (0.5, 0, 0.5),
+    (0.5, 1, 0.5),
+    (-0.5, 1, 0.5),
+    (-0.5, 0, 0.5),
+    (-0.5, 1, 0.5),
+    (-0.5, 1, -0.5),
+]
+
+
+class MissingAssetService:
+    """
+    Missing Asset Service - Single Responsibility for placeholders of missing references
+    Scene access goes through the cmds argument so placeholders can be tested without Maya
+    """
+
+    def __init__(
+        self,
+        scene_asset_service=None,
+        version_service=None,
+        reference_update_service=None,
+        database_factory: Optional[Callable[[Path], Any]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._scene_asset_service = scene_asset_service or get_scene_asset_service()
+        self._version_service = version_service or get_version_service()
+        self._reference_update_service = (
+            reference_update_service or get_reference_update_service()
+        )
+        self._database_factory = database_factory or get_metadata_database
+        self._callbacks: List[Any] = []
+
+    # Scene callbacks --------------------------------------------------------------------
+
+    def install(self) -> bool:
+        """
+        Skip loading references whose files are missing, instead of Maya failing on them
+
+        Returns:
+            True if the callback is registered
+        """
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+        except ImportError:
+            return False
+
+        self.uninstall()
+
+        def check_reference(file_object, *_args):
+            try:
+                file_path = Path(file_object.resolvedFullName() or file_object.rawFullName())
+                if file_path.is_file():
+                    return True
+            except Exception:
+                return True  # Let Maya report anything else
+            print(f"[WARNING] Referenced file missing, leaving it unloaded: {file_path}")
+            return False
+
+        messages = om.MSceneMessage
+        try:
+            self._callbacks = [
+                messages.addCheckFileCallback(
+                    messages.kBeforeLoadReferenceCheck, check_reference
+                )
+            ]
+        except Exception as e:
+            self.logger.warning(f"Failed to register missing reference callback: {e}")
+            self._callbacks = []
+            return False
+        return True
+
+    def uninstall(self) -> None:
+        """Let Maya load missing references itself again"""
+        if not self._callbacks:
+            return
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+
+            om.MMessage.removeCallbacks(self._callbacks)
+        except Exception as e:
+            self.logger.warning(f"Failed to remove missing reference callback: {e}")
+        self._callbacks = []
+
+    # Placeholders -----------------------------------------------------------------------
+
+    def find_placeholders(self, cmds: Any) -> Dict[str, str]:
+        """Get the placeholders in the scene by the reference node they stand in for"""
+        found = cmds.ls(f"*.{REFERENCE_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        return {cmds.getAttr(f"{node}.{REFERENCE_ATTRIBUTE}"): node for node in found or []}
+
+    def sync(
+        self, cmds: Any, scene_assets: List[SceneAsset], library_root: Optional[Path] = None
+    ) -> List[str]:
+        """
+        Place a box for every missing reference and delete boxes no longer needed
+
+        Args:
+            cmds: maya.cmds module
+            scene_assets: Scene assets from a fresh scan
+            library_root: Current library, whose metadata the boxes carry
+
+        Returns:
+            Placeholders created
+        """
+        placeholders = self.find_placeholders(cmds)
+        missing = {
+            scene_asset.reference_node: scene_asset
+            for scene_asset in scene_assets
+            if scene_asset.is_referenced and scene_asset.missing
+        }
+        for reference_node, node in placeholders.items():
+            if reference_node in missing or not cmds.objExists(node):
+                continue
+            if not cmds.objExists(reference_node) or cmds.referenceQuery(
+                reference_node, isLoaded=True
+            ):
+                cmds.delete(node)  # Removed, or loaded again
+
+        created = []
+        for reference_node, scene_asset in missing.items():
+            if reference_node in placeholders:
+                continue
+            try:
+                created.append(self.create_placeholder(cmds, scene_asset, library_root))
+            except Exception as e:
+                print(f"[WARNING] Could not place a placeholder for {scene_asset.label}: {e}")
+        return created
+
+    def create_placeholder(
+        self, cmds: Any, scene_asset: SceneAsset, library_root: Optional[Path] = None
+    ) -> str:
+        """
+        Place a box the size of a missing reference's asset where its edits put it
+
+        Returns:
+            The placeholder transform
+        """
+        metadata = self._get_metadata(scene_asset.asset_file, library_root)
+        stats = GeometryStats.from_dict(metadata.get(STATS_METADATA_KEY) or {})
+        size = [side if side > 0 else DEFAULT_SIZE for side in stats.bounding_box]
+
+        name = sanitize_namespace(scene_asset.namespace or scene_asset.asset_file.stem)
+        node = cmds.curve(
+            degree=1,
+            point=[(x * size[0], y * size[1], z * size[2]) for x, y, z in _BOX_POINTS],
+            name=f"{name}{PLACEHOLDER_SUFFIX}",
+        )
+        cmds.setAttr(f"{node}.overrideEnabled", 1)
+        cmds.setAttr(f"{node}.overrideColor", PLACEHOLDER_COLOR)
+        for attribute, values in self.get_placement(cmds, scene_asset).items():
+            cmds.setAttr(f"{node}.{attribute}", *values)
+
+        info = {key: metadata[key] for key in INFO_KEYS if key in metadata}
+        for attribute, value in (
+            (REFERENCE_ATTRIBUTE, scene_asset.reference_node),
+            (ASSET_ATTRIBUTE, scene_asset.asset_file.as_posix()),
+            (FILE_ATTRIBUTE, Path(scene_asset.file_path or scene_asset.asset_file).as_posix()),
+            (VERSION_ATTRIBUTE, str(scene_asset.loaded_version)),
+            (INFO_ATTRIBUTE, json.dumps(info, sort_keys=True, default=str)),
+        ):
+            cmds.addAttr(node, longName=attribute, dataType="string")
+            cmds.setAttr(f"{node}.{attribute}", value, type="string")
+        print(f"[OK] Placed {node} for missing {scene_asset.label}")
+        return node
+
+    def read_info(self, cmds: Any, node: str) -> Dict[str, Any]:
+        """Get the asset metadata a placeholder carries"""
+        try:
+            return json.loads(cmds.getAttr(f"{node}.{INFO_ATTRIBUTE}") or "{}")
+        except (TypeError, ValueError):
+            return {}
+
+    def get_placement(self, cmds: Any, scene_asset: SceneAsset) -> Dict[str, Tuple[float, ...]]:
+        """
+        Get the translate, rotate, and scale the reference's edits give its root
+
+        Edits are kept while a reference is unloaded; the least nested node with a
+        placement edit is taken as the root.
+
+        Returns:
+            Placeholder attribute -> its three values
+        """
+        try:
+            edits = cmds.referenceQuery(
+                scene_asset.reference_node, editStrings=True, editCommand="setAttr"
+            )
+        except Exception:
+            return {}
+
+        found: Dict[str, Tuple[int, Tuple[float, ...]]] = {}
+        for edit in edits or []:
+            _command, target = parse_reference_edit(edit, scene_asset.namespace)
+            node_name, _, attribute = target.rpartition(".")
+            attribute = PLACEMENT_ATTRIBUTES.get(attribute)
+            values = self._get_numbers(edit)
+            if not node_name or attribute is None or len(values) != 3:
+                continue
+            depth = node_name.strip("|").count("|")
+            if attribute not in found or depth < found[attribute][0]:
+                found[attribute] = (depth, values)
+        return {attribute: values for attribute, (_depth, values) in found.items()}
+
+    # Resolving --------------------------------------------------------------------------
+
+    def get_version_choices(self, scene_asset: SceneAsset) -> List[Tuple[str, Path]]:
+        """
+        Get the files a missing reference could load instead
+
+        Returns:
+            (label, file) for the current asset file and every version snapshot on disk,
+            newest first, leaving out the missing file
+        """
+        missing = Path(scene_asset.file_path or scene_asset.asset_file)
+        choices = []
+        if scene_asset.asset_file.is_file() and scene_asset.asset_file != missing:
+            choices.append(("Latest (current file)", scene_asset.asset_file))
+        versions = self._version_service.get_versions(scene_asset.asset_file)
+        for version in sorted(versions, key=lambda version: version.number, reverse=True):
+            if version.exists and version.file_path != missing:
+                choices.append((version.label, version.file_path))
+        return choices
+
+    def resolve(
+        self,
+        cmds: Any,
+        scene_asset: SceneAsset,
+        resolution: str,
+        file_path: Optional[Path] = None,
+    ) -> None:
+        """
+        Resolve a missing reference
+
+        Args:
+            cmds: maya.cmds module
+            scene_asset: Missing referenced asset
+            resolution: One of RESOLUTIONS
+            file_path: File to load for RESOLVE_VERSION and RESOLVE_REPATH
+        """
+        if resolution == RESOLVE_REMOVE:
+            self.remove(cmds, scene_asset)
+        elif resolution in (RESOLVE_VERSION, RESOLVE_REPATH):
+            if file_path is None:
+                raise ValueError("Choose the file to load")
+            self.repath(cmds, scene_asset, file_path)
+        else:
+            raise ValueError(f"Unknown resolution: {resolution}")
+
+    def repath(self, cmds: Any, scene_asset: SceneAsset, file_path: Path) -> None:
+        """Load a missing reference from another file, keeping its edits"""
+        file_path = Path(file_path)
+        extension = file_path.suffix.lower()
+        if not file_path.is_file():
+            raise FileNotFoundError(f"{file_path} does not exist")
+        if extension not in REFERENCE_FILE_TYPES:
+            raise ValueError(f"Cannot reference {file_path.name}")
+        cmds.file(
+            str(file_path),
+            loadReference=scene_asset.reference_node,
+            type=REFERENCE_FILE_TYPES[extension],
+        )
+        self._reference_update_service.forget(scene_asset.reference_node)
+        self._delete_placeholder(cmds, scene_asset)
+        print(f"[OK] Loaded {scene_asset.label} from {file_path}")
+
+    def remove(self, cmds: Any, scene_asset: SceneAsset) -> None:
+        """Remove a missing reference and its placeholder"""
+        self._delete_placeholder(cmds, scene_asset)
+        self._scene_asset_service.remove(cmds, scene_asset)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _delete_placeholder(self, cmds: Any, scene_asset: SceneAsset) -> None:
+        """Delete the box standing in for a reference"""
+        node = self.find_placeholders(cmds).get(scene_asset.reference_node)
+        if node and cmds.objExists(node):
+            cmds.delete(node)
+
+    def _get_metadata(self, asset_file: Path, library_root: Optional[Path]) -> Dict[str, Any]:
+        """Get the library's metadata of an asset, empty when it cannot be read"""
+        if library_root is None:
+            return {}
+        try:
+            return self._database_factory(library_root).get_asset_metadata(asset_file) or {}
+        except Exception as e:
+            self.logger.warning(f"Could not read metadata of {asset_file.name}: {e}")
+            return {}
+
+    def _get_numbers(self, edit: str) -> Tuple[float, ...]:
+        """Get the numbers at the end of a setAttr edit"""
+        try:
+            tokens = shlex.split(edit.strip().rstrip(";"))
+        except ValueError:
+            tokens = edit.strip().rstrip(";").split()
+        values: List[float] = []
+        for token in reversed(tokens):
+            try:
+                values.insert(0, float(token))
+            except ValueError:
+                break
+        return tuple(values)
+
+
+# Singleton instance factory
+_missing_asset_service_instance = None
+
+
+def get_missing_asset_service() -> MissingAssetService:
+    """
+    Get singleton instance of MissingAssetService.
+
+    Returns:
+        MissingAssetService: Singleton service instance
+    """
+    global _missing_asset_service_instance
+    if _missing_asset_service_instance is None:
+        _missing_asset_service_instance = MissingAssetService()
+    return _missing_asset_service_instance
@@ -0,0 +1,221 @@
+# -*- coding: utf-8 -*-
+"""
+Namespace Service Implementation
+Tidy the namespaces imported assets bring in: strip, merge, or flatten them to a prefix
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Files from other vendors often arrive several namespaces deep. Only the namespaces an
+import created are changed, deepest first, so the scene's own and referenced assets'
+namespaces stay as they are::
+
+    vendorA:export:crate:body      <- as imported
+    body                           <- strip
+    crate:body                     <- merge into crate
+    crate_body                     <- prefix crate_
+
+The options are per artist, in ~/.assetmanager/import_namespaces.json.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, List, Optional
+
+from ..core.models.import_namespace_options import (
+    NAMESPACE_MERGE,
+    NAMESPACE_PREFIX,
+    NAMESPACE_STRIP,
+    ImportNamespaceOptions,
+)
+from .maya_integration_impl import sanitize_namespace
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+ROOT_NAMESPACE = ":"
+
+# Namespaces every Maya scene has; they are never moved or removed
+PROTECTED_NAMESPACES = {":UI", ":shared"}
+
+
+def namespace_depth(namespace: str) -> int:
+    """Get how deep an absolute namespace is (:a:b -> 2)"""
+    return namespace.strip(ROOT_NAMESPACE).count(ROOT_NAMESPACE) + 1
+
+
+def strip_namespace(node: str) -> str:
+    """Get a node's short name without namespaces (|a:grp|a:b:body -> body)"""
+    return node.rsplit("|", 1)[-1].rsplit(ROOT_NAMESPACE, 1)[-1]
+
+
+class NamespaceService:
+    """
+    Namespace Service - Single Responsibility for import namespace cleanup
+    Scene access goes through the cmds argument so cleanup can be tested without Maya
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "import_namespaces.json"
+
+    # Configuration ----------------------------------------------------------------------
+
+    def load_options(self) -> ImportNamespaceOptions:
+        """Get the artist's import namespace options, keeping namespaces by default"""
+        if not self._config_file.is_file():
+            return ImportNamespaceOptions()
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                return ImportNamespaceOptions.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable namespace options {self._config_file}: {e}")
+            return ImportNamespaceOptions()
+
+    def save_options(self, options: ImportNamespaceOptions) -> bool:
+        """Write the artist's import namespace options"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(options.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save namespace options: {e}")
+            return False
+
+    # Scene ------------------------------------------------------------------------------
+
+    def list_namespaces(self, cmds: Any) -> List[str]:
+        """Get every namespace of the scene as absolute names (:a, :a:b), Maya's own left out"""
+        namespaces = cmds.namespaceInfo(
+            ROOT_NAMESPACE, listOnlyNamespaces=True, recurse=True, absoluteName=True
+        )
+        return [ns for ns in namespaces or [] if ns not in PROTECTED_NAMESPACES]
+
+    def apply_import_options(
+        self,
+        cmds: Any,
+        options: ImportNamespaceOptions,
+        namespaces_before: List[str],
+        asset_name: str,
+    ) -> List[str]:
+        """
+        Change the namespaces an import created as the options say
+
+        Args:
+            cmds: maya.cmds module
+            options: Namespace handling to apply
+            namespaces_before: list_namespaces result from just before the import
+            asset_name: Imported asset, naming the merge namespace and prefix by default
+
+        Returns:
+            Namespaces removed from the scene
+        """
+        before = set(namespaces_before)
+        created = [ns for ns in self.list_namespaces(cmds) if ns not in before]
+        removed: List[str] = []
+        if options.mode == NAMESPACE_MERGE:
+            target = ROOT_NAMESPACE + sanitize_namespace(options.namespace or asset_name)
+            removed = self.merge_namespaces(cmds, created, target)
+        elif options.mode == NAMESPACE_PREFIX:
+            prefix = options.prefix or f"{sanitize_namespace(asset_name)}_"
+            removed = self.flatten_namespaces(cmds, created, prefix)
+        elif options.mode == NAMESPACE_STRIP:
+            removed = self.merge_namespaces(cmds, created, ROOT_NAMESPACE)
+        if options.remove_empty:
+            removed += self.remove_empty_namespaces(cmds)
+        if removed:
+            print(f"[OK] Cleaned up {len(removed)} namespace(s) of {asset_name}")
+        return removed
+
+    def merge_namespaces(self, cmds: Any, namespaces: List[str], target: str) -> List[str]:
+        """
+        Move the contents of namespaces into one and remove them
+
+        Args:
+            cmds: maya.cmds module
+            namespaces: Absolute namespaces to empty
+            target: Absolute namespace receiving the nodes, ":" for the root; created
+                when missing
+
+        Returns:
+            Namespaces removed
+        """
+        if target != ROOT_NAMESPACE and not cmds.namespace(exists=target):
+            cmds.namespace(add=target.lstrip(ROOT_NAMESPACE))
+        removed: List[str] = []
+        for namespace in sorted(namespaces, key=namespace_depth, reverse=True):
+            # The target and the namespaces holding it stay
+            if f"{target}{ROOT_NAMESPACE}".startswith(f"{namespace}{ROOT_NAMESPACE}"):
+                continue
+            try:
+                # force renames nodes whose names are taken in the target
+                cmds.namespace(moveNamespace=(namespace, target), force=True)
+                cmds.namespace(removeNamespace=namespace)
+                removed.append(namespace)
+            except Exception as e:
+                print(f"[WARNING] Could not merge namespace {namespace}: {e}")
+        return removed
+
+    def flatten_namespaces(self, cmds: Any, namespaces: List[str], prefix: str) -> List[str]:
+        """
+        Move the nodes of namespaces into the root and put a prefix on their names
+
+        Returns:
+            Namespaces removed
+        """
+        nodes: List[str] = []
+        for namespace in namespaces:
+            members = cmds.namespaceInfo(namespace, listOnlyDependencyNodes=True, dagPath=True)
+            nodes += members or []
+        # UUIDs keep finding the nodes while their names and DAG paths change
+        uuids = (cmds.ls(nodes, uuid=True) or []) if nodes else []
+        removed = self.merge_namespaces(cmds, namespaces, ROOT_NAMESPACE)
+        for uuid in uuids:
+            found = cmds.ls(uuid, long=True) or []
+            if not found:
+                continue
+            name = strip_namespace(found[0])
+            if name.startswith(prefix):
+                continue
+            try:
+                cmds.rename(found[0], f"{prefix}{name}")
+            except Exception as e:
+                self.logger.warning(f"Could not prefix {found[0]}: {e}")
+        return removed
+
+    def remove_empty_namespaces(self, cmds: Any) -> List[str]:
+        """
+        Remove every namespace of the scene holding no nodes, deepest first
+
+        Returns:
+            Namespaces removed
+        """
+        removed: List[str] = []
+        for namespace in sorted(self.list_namespaces(cmds), key=namespace_depth, reverse=True):
+            try:
+                if cmds.namespaceInfo(namespace, listOnlyDependencyNodes=True):
+                    continue
+                if cmds.namespaceInfo(namespace, listOnlyNamespaces=True):
+                    continue
+                cmds.namespace(removeNamespace=namespace)
+                removed.append(namespace)
+            except Exception as e:
+                self.logger.warning(f"Could not remove namespace {namespace}: {e}")
+        return removed
+
+
+# Singleton instance factory
+_namespace_service_instance = None
+
+
+def get_namespace_service() -> NamespaceService:
+    """
+    Get singleton instance of NamespaceService.
+
+    Returns:
+        NamespaceService: Singleton service instance
+    """
+    global _namespace_service_instance
+    if _namespace_service_instance is None:
+        _namespace_service_instance = NamespaceService()
+    return _namespace_service_instance
@@ -0,0 +1,235 @@
+# -*- coding: utf-8 -*-
+"""
+Naming Template Service Implementation
+Library naming templates deciding where scene publishes land and what they are called
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Templates are paths relative to the library's assets folder, with a default and
+optional per asset type overrides::
+
+    MyProject/.assetmanager/naming_templates.json
+    {
+      "template": "{category}/{assetName}/{version}/{assetName}_{version}.ma",
+      "types": {"Rigs": "rigs/{assetName}/{assetName}_rig.ma"}
+    }
+
+    assets/Props/crate/v003/crate_v003.ma
+
+Tokens are {assetName}, {type} (or {category}), {version}, {artist}, and {date}. The
+extension written in a template is replaced by the publish format. With {version} in the
+path, every publish gets a new file one version above the highest one on disk. Libraries
+without a template keep publishing into assets/scenes and assets/models.
+"""
+
+import json
+import logging
+from datetime import datetime
+from pathlib import Path
+from typing import Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.naming_template import NamingTemplate
+from .version_service_impl import get_current_user
+
+SETTINGS_DIR_NAME = ".assetmanager"
+TEMPLATES_FILE_NAME = "naming_templates.json"
+ASSETS_DIR_NAME = "assets"
+
+# Scene publishes the templates apply to; materials, poses, and clips keep their folders
+TEMPLATED_EXTENSIONS = {".ma", ".mb", ".abc", ".usd", ".usda", ".usdc", ".usdz"}
+
+
+class NamingTemplateService:
+    """
+    Naming Template Service - Single Responsibility for publish path templates
+    An unreadable or invalid template file falls back to the built-in folders
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_templates_file(self, library_root: Path) -> Path:
+        """Get the naming templates file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / TEMPLATES_FILE_NAME
+
+    def load_templates(
+        self, library_root: Optional[Path]
+    ) -> Tuple[Optional[NamingTemplate], Dict[str, NamingTemplate]]:
+        """
+        Get a library's templates
+
+        Returns:
+            (default template or None, asset type -> template override)
+        """
+        if library_root is None:
+            return None, {}
+        templates_file = self.get_templates_file(library_root)
+        if not templates_file.is_file():
+            return None, {}
+        try:
+            with open(templates_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable naming templates {templates_file}: {e}")
+            return None, {}
+
+        default = self._parse_template(data.get("template"), templates_file)
+        overrides: Dict[str, NamingTemplate] = {}
+        for asset_type, pattern in (data.get("types") or {}).items():
+            template = self._parse_template(pattern, templates_file)
+            if template is not None:
+                overrides[asset_type] = template
+        return default, overrides
+
+    def save_templates(
+        self,
+        library_root: Path,
+        default: Optional[NamingTemplate],
+        overrides: Dict[str, NamingTemplate],
+    ) -> bool:
+        """Write a library's templates (no default and no overrides removes the file)"""
+        templates_file = self.get_templates_file(library_root)
+        try:
+            if default is None and not overrides:
+                templates_file.unlink(missing_ok=True)
+                return True
+            templates_file.parent.mkdir(parents=True, exist_ok=True)
+            data = {
+                "template": default.pattern if default else "",
+                "types": {name: template.pattern for name, template in overrides.items()},
+            }
+            with open(templates_file, "w", encoding="utf-8") as f:
+                json.dump(data, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save naming templates: {e}")
+            return False
+
+    def get_template(
+        self, library_root: Optional[Path], asset_type: str
+    ) -> Optional[NamingTemplate]:
+        """Get the template of an asset type, None when the library has none"""
+        default, overrides = self.load_templates(library_root)
+        return overrides.get(asset_type, default)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def resolve_publish_path(
+        self,
+        library_root: Optional[Path],
+        asset_name: str,
+        asset_type: str,
+        extension: str,
+    ) -> Optional[Tuple[Path, Optional[int]]]:
+        """
+        Get where a scene publish lands under the library's template
+
+        Returns:
+            (asset file, version number when the path is versioned), None when the
+            library has no template for the asset type
+        """
+        template = self.get_template(library_root, asset_type)
+        if library_root is None or template is None:
+            return None
+
+        values = {
+            "assetName": asset_name,
+            "type": asset_type,
+            "category": asset_type,
+            "artist": get_current_user(),
+            "date": datetime.now().strftime("%Y-%m-%d"),
+        }
+        assets_root = Path(library_root) / ASSETS_DIR_NAME
+        version = None
+        if template.has_version:
+            version = self.get_next_version(assets_root, template, values)
+        relative = template.render(values, extension, version or 1)
+        return assets_root / relative, version
+
+    def get_next_version(
+        self, assets_root: Path, template: NamingTemplate, values: Dict[str, str]
+    ) -> int:
+        """Get one above the highest version of an asset published under the template"""
+        fixed = {key: values[key] for key in ("assetName", "type", "category") if key in values}
+        # Only the folders before the first changing token can hold earlier versions
+        search_root = assets_root
+        folders = template.pattern.strip().replace("\\", "/").split("/")[:-1]
+        for part, value in zip(folders, template.render(values, "").split("/")):
+            if any(f"{{{token}}}" in part for token in ("version", "artist", "date")):
+                break
+            search_root = search_root / value
+
+        highest = 0
+        if search_root.is_dir():
+            for path in search_root.rglob("*"):
+                if not path.is_file():
+                    continue
+                found = template.match(path.relative_to(assets_root).as_posix(), fixed)
+                if found and found.get("version"):
+                    highest = max(highest, int(found["version"][1:]))
+        return highest + 1
+
+    # Library scan -----------------------------------------------------------------------
+
+    def find_violations(
+        self, library_root: Optional[Path], asset_files: Iterable[Path]
+    ) -> Dict[str, str]:
+        """
+        Get scene assets that do not follow any of the library's templates
+
+        Returns:
+            Asset file path -> reason, empty when the library has no template
+        """
+        default, overrides = self.load_templates(library_root)
+        templates: List[NamingTemplate] = ([default] if default else []) + list(
+            overrides.values()
+        )
+        if library_root is None or not templates:
+            return {}
+
+        assets_root = Path(library_root) / ASSETS_DIR_NAME
+        expected = " or ".join(template.pattern for template in templates)
+        violations: Dict[str, str] = {}
+        for asset_file in asset_files:
+            asset_file = Path(asset_file)
+            if asset_file.suffix.lower() not in TEMPLATED_EXTENSIONS:
+                continue
+            try:
+                relative = asset_file.relative_to(assets_root).as_posix()
+            except ValueError:
+                violations[str(asset_file)] = f"Outside the assets folder (expected {expected})"
+                continue
+            if not any(template.match(relative) for template in templates):
+                violations[str(asset_file)] = f"{relative} does not follow {expected}"
+        return violations
+
+    def _parse_template(self, pattern: Optional[str], source: Path) -> Optional[NamingTemplate]:
+        """Create a template from the settings file, None for blank or invalid patterns"""
+        if not pattern or not str(pattern).strip():
+            return None
+        try:
+            return NamingTemplate(str(pattern).strip())
+        except ValueError as e:
+            print(f"[WARNING] Skipped naming template '{pattern}' in {source}: {e}")
+            return None
+
+
+# Singleton instance factory
+_naming_template_instance = None
+
+
+def get_naming_template_service() -> NamingTemplateService:
+    """
+    Get singleton instance of NamingTemplateService.
+
+    Returns:
+        NamingTemplateService: Singleton service instance
+    """
+    global _naming_template_instance
+    if _naming_template_instance is None:
+        _naming_template_instance = NamingTemplateService()
+    return _naming_template_instance
+
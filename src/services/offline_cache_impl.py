@@ -0,0 +1,275 @@
+# -*- coding: utf-8 -*-
+"""
+Offline Cache Implementation
+Local mirror of a network library for working while it is unreachable
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Frequently used assets are copied with their thumbnails, dependencies, and metadata
+into a per-library cache that mirrors the library layout, so it can be browsed and
+imported from like the library itself::
+
+    ~/.assetmanager/offline_cache/props_3f2a9c1d/assets/scenes/crate.ma
+    ~/.assetmanager/offline_cache/props_3f2a9c1d/.assetmanager/library.db
+    ~/.assetmanager/offline_cache/props_3f2a9c1d/.assetmanager/offline_cache.json
+
+The manifest records the size and modification time of every mirrored file. Assets
+published into the cache while offline differ from it, which queues them; syncing
+copies them to the library unless the library copy changed since it was cached.
+"""
+
+import hashlib
+import json
+import logging
+import os
+import re
+import shutil
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .library_registry_impl import USER_CONFIG_DIR
+from .metadata_database_impl import DATABASE_DIR_NAME, SIDECAR_SUFFIX, get_metadata_database
+from .trash_service_impl import get_trash_service
+from .version_service_impl import get_version_service
+
+CACHE_DIR_NAME = "offline_cache"
+MANIFEST_FILE_NAME = "offline_cache.json"
+
+# Most used assets mirrored when a cache is updated
+DEFAULT_MIRROR_LIMIT = 50
+
+
+@dataclass
+class OfflineSyncResult:
+    """Outcome of syncing offline publishes to the library"""
+
+    synced: List[Path] = field(default_factory=list)  # library paths
+    conflicts: List[Path] = field(default_factory=list)  # changed in the library meanwhile
+    failed: List[Path] = field(default_factory=list)
+
+
+def _get_stamp(path: Path) -> List[int]:
+    """Get the size and whole-second modification time used to spot changed files"""
+    stat = path.stat()
+    return [stat.st_size, int(stat.st_mtime)]
+
+
+class OfflineCacheService:
+    """
+    Offline Cache Service - Single Responsibility for the local library mirror
+    Pure file operations; the browser loads the cache root like any other library
+    """
+
+    def __init__(self, cache_dir: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._cache_dir = cache_dir or USER_CONFIG_DIR / CACHE_DIR_NAME
+
+    # Cache location ---------------------------------------------------------------------
+
+    def get_cache_root(self, library_root: Path) -> Path:
+        """Get the local mirror of a library (may not exist)"""
+        # Keyed by the path as given - a network library cannot be resolved while offline
+        key = os.path.normcase(str(library_root).rstrip("\\/"))
+        digest = hashlib.sha1(key.encode("utf-8")).hexdigest()[:8]
+        name = re.sub(r"[^\w.-]+", "_", Path(library_root).name) or "library"
+        return self._cache_dir / f"{name}_{digest}"
+
+    def has_cache(self, library_root: Path) -> bool:
+        """Check if a library has been mirrored for offline use"""
+        return self._get_manifest_file(library_root).is_file()
+
+    def is_reachable(self, library_root: Path) -> bool:
+        """Check if the library folder can be reached"""
+        try:
+            return Path(library_root).is_dir()
+        except OSError:
+            return False
+
+    # Mirror -----------------------------------------------------------------------------
+
+    def cache_asset(self, library_root: Path, asset_file: Path) -> bool:
+        """
+        Copy an asset with its thumbnails, dependencies, and metadata into the cache
+
+        Version history is left out; unchanged files are not copied again.
+
+        Returns:
+            True if the asset is available offline
+        """
+        library_root, asset_file = Path(library_root), Path(asset_file)
+        try:
+            relative = asset_file.relative_to(library_root)
+        except ValueError:
+            return False
+
+        cache_root = self.get_cache_root(library_root)
+        manifest = self._load_manifest(library_root)
+        history = get_version_service().get_history_directory(asset_file)
+        try:
+            for source in self._collect_files(asset_file, exclude=[history]):
+                file_key = source.relative_to(library_root).as_posix()
+                target = cache_root / file_key
+                stamp = _get_stamp(source)
+                if target.exists() and manifest["files"].get(file_key) == stamp:
+                    continue
+                target.parent.mkdir(parents=True, exist_ok=True)
+                shutil.copy2(source, target)
+                manifest["files"][file_key] = stamp
+        except OSError as e:
+            print(f"[WARNING] Could not cache {asset_file.name} for offline use: {e}")
+            return False
+        finally:
+            self._save_manifest(library_root, manifest)
+
+        self._copy_metadata(library_root, cache_root, relative)
+        return True
+
+    def mirror_most_used(
+        self, library_root: Path, limit: int = DEFAULT_MIRROR_LIMIT
+    ) -> List[Path]:
+        """
+        Cache the library's most used assets (by import count)
+
+        Returns:
+            Library paths of the cached assets
+        """
+        library_root = Path(library_root)
+        self._save_manifest(library_root, self._load_manifest(library_root))  # Mark as cached
+        cached = []
+        for key in get_metadata_database(library_root).get_most_used(limit):
+            asset_file = library_root / key
+            if asset_file.is_file() and self.cache_asset(library_root, asset_file):
+                cached.append(asset_file)
+        print(f"[OK] Cached {len(cached)} most used asset(s) of {library_root.name} offline")
+        return cached
+
+    # Offline publishes ------------------------------------------------------------------
+
+    def get_pending_publishes(self, library_root: Path) -> List[Path]:
+        """Get asset files published into the cache that are not in the library yet"""
+        cache_root = self.get_cache_root(library_root)
+        if not cache_root.is_dir():
+            return []
+        manifest = self._load_manifest(library_root)
+        pending = []
+        for path in sorted(cache_root.rglob("*")):
+            relative = path.relative_to(cache_root)
+            # Thumbnails, versions, and databases travel with their asset
+            if any(part.startswith(".") for part in relative.parts):
+                continue
+            if not path.is_file() or path.name.endswith(SIDECAR_SUFFIX):
+                continue
+            if manifest["files"].get(relative.as_posix()) != _get_stamp(path):
+                pending.append(path)
+        return pending
+
+    def sync(self, library_root: Path) -> OfflineSyncResult:
+        """
+        Copy assets published offline into the library, with metadata and thumbnails
+
+        An asset is left in the queue as a conflict when the library copy was created or
+        changed after the cache was made, so nobody's publish is overwritten.
+        """
+        library_root = Path(library_root)
+        result = OfflineSyncResult()
+        if not self.is_reachable(library_root):
+            return result
+
+        cache_root = self.get_cache_root(library_root)
+        manifest = self._load_manifest(library_root)
+        for cached_file in self.get_pending_publishes(library_root):
+            relative = cached_file.relative_to(cache_root)
+            target = library_root / relative
+            file_key = relative.as_posix()
+            if target.exists() and manifest["files"].get(file_key) != _get_stamp(target):
+                print(f"[WARNING] {file_key} changed in the library - not synced")
+                result.conflicts.append(target)
+                continue
+            try:
+                for source in self._collect_files(cached_file):
+                    file_key = source.relative_to(cache_root).as_posix()
+                    destination = library_root / file_key
+                    destination.parent.mkdir(parents=True, exist_ok=True)
+                    shutil.copy2(source, destination)
+                    manifest["files"][file_key] = _get_stamp(source)
+            except OSError as e:
+                print(f"[ERROR] Could not sync {relative.as_posix()}: {e}")
+                result.failed.append(target)
+                continue
+            self._copy_metadata(cache_root, library_root, relative)
+            result.synced.append(target)
+
+        self._save_manifest(library_root, manifest)
+        if result.synced:
+            print(f"[OK] Synced {len(result.synced)} offline publish(es) to {library_root}")
+        return result
+
+    # Internals --------------------------------------------------------------------------
+
+    def _collect_files(self, asset_file: Path, exclude: Optional[List[Path]] = None) -> List[Path]:
+        """Get the asset file and every file stored for it"""
+        files: List[Path] = []
+        for path in get_trash_service().get_asset_paths(asset_file):
+            if path in (exclude or []):
+                continue
+            if path.is_dir():
+                files.extend(sorted(child for child in path.rglob("*") if child.is_file()))
+            else:
+                files.append(path)
+        return files
+
+    def _copy_metadata(self, source_root: Path, target_root: Path, relative: Path) -> None:
+        """Copy an asset's database row (tags, custom fields, rig data) between libraries"""
+        try:
+            source = get_metadata_database(source_root)
+            metadata = source.get_asset_metadata(source_root / relative)
+            if metadata:
+                database = get_metadata_database(target_root)
+                database.save_asset_metadata(target_root / relative, metadata)
+        except Exception as e:
+            self.logger.warning(f"Failed to copy metadata of {relative}: {e}")
+
+    def _get_manifest_file(self, library_root: Path) -> Path:
+        """Get the file listing what the cache of a library holds"""
+        return self.get_cache_root(library_root) / DATABASE_DIR_NAME / MANIFEST_FILE_NAME
+
+    def _load_manifest(self, library_root: Path) -> Dict[str, Any]:
+        """Read the cache manifest, an empty one if the library is not cached"""
+        manifest_file = self._get_manifest_file(library_root)
+        manifest: Dict[str, Any] = {"library": str(library_root), "files": {}}
+        if manifest_file.is_file():
+            try:
+                with open(manifest_file, "r", encoding="utf-8") as f:
+                    manifest["files"] = dict(json.load(f).get("files") or {})
+            except Exception as e:
+                print(f"[WARNING] Unreadable offline cache manifest {manifest_file}: {e}")
+        return manifest
+
+    def _save_manifest(self, library_root: Path, manifest: Dict[str, Any]) -> None:
+        """Write the cache manifest"""
+        manifest_file = self._get_manifest_file(library_root)
+        try:
+            manifest_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(manifest_file, "w", encoding="utf-8") as f:
+                json.dump(manifest, f, indent=2)
+        except Exception as e:
+            self.logger.error(f"Failed to save offline cache manifest: {e}")
+
+
+# Singleton instance factory
+_offline_cache_instance = None
+
+
+def get_offline_cache_service() -> OfflineCacheService:
+    """
+    Get singleton instance of OfflineCacheService.
+
+    Returns:
+        OfflineCacheService: Singleton service instance
+    """
+    global _offline_cache_instance
+    if _offline_cache_instance is None:
+        _offline_cache_instance = OfflineCacheService()
+    return _offline_cache_instance
@@ -0,0 +1,233 @@
+# -*- coding: utf-8 -*-
+"""
+Part Import Service Implementation
+List the groups and meshes inside an asset and import only the ones picked
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The outline is the asset diff snapshot's transforms: Maya ASCII files are read as
+text, other formats need Maya to import them once into a scratch namespace. Picking
+a part keeps the groups above it, so it lands where it sits in the asset::
+
+    |building_grp|floor_02|window_03   picked
+    |building_grp|floor_02             kept (holds the pick)
+    |building_grp|floor_01             deleted after the import with everything below it
+
+Maya cannot read part of a file, so the whole asset is imported and the branches
+nobody asked for are deleted again, together with the shading groups only they used.
+"""
+
+import logging
+from pathlib import Path
+from typing import Any, Dict, Iterable, List, Tuple
+
+from ..core.models.asset_diff import UNKNOWN_COUNT
+from ..core.models.asset_part import AssetPart, PartImportResult
+from .merge_import_service_impl import strip_namespaces
+
+
+def build_parts(transforms: Iterable[str], meshes: Dict[str, Tuple[int, int]]) -> List[AssetPart]:
+    """
+    Build the outline of an asset from its transform paths
+
+    Args:
+        transforms: DAG paths of the asset's transforms (|building_grp|floor_01)
+        meshes: Mesh transform path -> (faces, vertices), faces UNKNOWN_COUNT if not stored
+
+    Returns:
+        Parts sorted by path, so every group comes before what it holds
+    """
+    paths = sorted(set(transforms) | set(meshes))
+    mesh_counts: Dict[str, int] = {}
+    face_counts: Dict[str, int] = {}
+    child_counts: Dict[str, int] = {}
+    for path in paths:
+        parent = path.rpartition("|")[0]
+        if parent:
+            child_counts[parent] = child_counts.get(parent, 0) + 1
+    for mesh, (faces, _vertices) in meshes.items():
+        # Count the mesh at its own transform and at every group above it
+        ancestor = mesh
+        while ancestor:
+            mesh_counts[ancestor] = mesh_counts.get(ancestor, 0) + 1
+            if faces != UNKNOWN_COUNT:
+                face_counts[ancestor] = face_counts.get(ancestor, 0) + faces
+            ancestor = ancestor.rpartition("|")[0]
+
+    return [
+        AssetPart(
+            path=path,
+            is_mesh=path in meshes,
+            mesh_count=mesh_counts.get(path, 0),
+            face_count=face_counts.get(path, 0),
+            child_count=child_counts.get(path, 0),
+        )
+        for path in paths
+    ]
+
+
+def get_removed_paths(paths: Iterable[str], picked: Iterable[str]) -> List[str]:
+    """
+    Get the branches to delete so only the picked parts (and the groups above them) stay
+
+    Returns:
+        The topmost path of each unwanted branch - deleting it removes everything below
+    """
+    picked = set(picked)
+    removed: List[str] = []
+    for path in sorted(set(paths)):
+        if any(path.startswith(f"{branch}|") for branch in removed):
+            continue  # Goes with its deleted group
+        if path in picked or any(path.startswith(f"{part}|") for part in picked):
+            continue  # A picked part or inside one
+        if any(part.startswith(f"{path}|") for part in picked):
+            continue  # A group holding a picked part
+        removed.append(path)
+    return removed
+
+
+class PartImportService:
+    """
+    Part Import Service - Single Responsibility for importing parts of an asset
+    Outlines are cached per file until the file changes
+    """
+
+    def __init__(self, diff_service: Any = None):
+        self.logger = logging.getLogger(__name__)
+        self._diff_service = diff_service
+        self._outlines: Dict[str, Tuple[float, List[AssetPart]]] = {}  # path -> (mtime, parts)
+
+    def needs_maya(self, file_path: Path) -> bool:
+        """Check whether listing an asset's parts imports it (anything but Maya ASCII)"""
+        return Path(file_path).suffix.lower() != ".ma"
+
+    def get_parts(self, file_path: Path, cmds: Any = None) -> List[AssetPart]:
+        """
+        Get the groups and meshes of an asset file
+
+        Args:
+            file_path: Asset file
+            cmds: maya.cmds module, needed for files other than Maya ASCII
+
+        Raises:
+            ValueError: If the file needs Maya to be read and cmds is None
+        """
+        file_path = Path(file_path)
+        modified = file_path.stat().st_mtime
+        cached = self._outlines.get(str(file_path))
+        if cached is not None and cached[0] == modified:
+            return list(cached[1])
+
+        snapshot = self._get_diff_service().take_snapshot(file_path, cmds)
+        meshes = {path: counts for path, counts in snapshot.meshes.items() if path}
+        parts = build_parts(snapshot.transforms, meshes)
+        self._outlines[str(file_path)] = (modified, parts)
+        return list(parts)
+
+    def import_parts(
+        self, cmds: Any, file_path: Path, part_paths: List[str], namespace: str = ""
+    ) -> PartImportResult:
+        """
+        Import an asset and keep only the picked parts
+
+        Args:
+            cmds: maya.cmds module
+            file_path: Asset file
+            part_paths: Picked part paths from get_parts
+            namespace: Namespace the parts land in, "" for the root namespace
+
+        Raises:
+            ValueError: If no part is picked
+            RuntimeError: If the import fails or none of the picked parts came in
+        """
+        if not part_paths:
+            raise ValueError("Pick at least one part to import")
+        picked = set(part_paths)
+
+        cmds.undoInfo(openChunk=True, chunkName="Import Asset Parts")
+        try:
+            options: Dict[str, Any] = {"namespace": namespace} if namespace else {}
+            try:
+                new_nodes = cmds.file(
+                    str(file_path),
+                    i=True,
+                    returnNewNodes=True,
+                    ignoreVersion=True,
+                    preserveReferences=True,
+                    **options,
+                )
+            except Exception as e:
+                raise RuntimeError(f"Import of {Path(file_path).name} failed: {e}") from e
+
+            new_nodes = new_nodes or []
+            transforms = self._get_transforms(cmds, new_nodes)
+            if not picked & set(transforms):
+                cmds.delete([node for node in transforms.values() if cmds.objExists(node)])
+                raise RuntimeError(
+                    f"{Path(file_path).name} no longer has the picked parts - list them again"
+                )
+            removed = get_removed_paths(transforms, picked)
+            doomed = [transforms[path] for path in removed if cmds.objExists(transforms[path])]
+            if doomed:
+                cmds.delete(doomed)
+            self._delete_unused_shading(cmds, new_nodes)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        kept = {path for path in transforms if path not in removed}
+        kept = {path for path in kept if not any(path.startswith(f"{r}|") for r in removed)}
+        roots = [transforms[path] for path in sorted(kept) if path.rpartition("|")[0] not in kept]
+        result = PartImportResult(
+            namespace=namespace,
+            roots=tuple(roots),
+            imported_parts=tuple(sorted(picked & kept)),
+            removed_count=len(removed),
+        )
+        print(f"[OK] Imported parts of {Path(file_path).name}: {result.summary}")
+        return result
+
+    def _get_transforms(self, cmds: Any, nodes: List[str]) -> Dict[str, str]:
+        """Map the imported transforms' namespace-free paths to their scene paths"""
+        transforms: Dict[str, str] = {}
+        for node in cmds.ls(nodes, type=["transform", "joint"], long=True) or []:
+            transforms[strip_namespaces(node)] = node
+        return transforms
+
+    def _delete_unused_shading(self, cmds: Any, nodes: List[str]) -> None:
+        """Delete imported shading groups left without members, with their own shaders"""
+        for engine in cmds.ls(nodes, type="shadingEngine") or []:
+            if not cmds.objExists(engine) or cmds.sets(engine, query=True):
+                continue
+            shaders = cmds.listConnections(f"{engine}.surfaceShader", source=True) or []
+            cmds.delete(engine)
+            for shader in shaders:
+                if not cmds.objExists(shader):
+                    continue
+                if not cmds.listConnections(shader, type="shadingEngine"):
+                    cmds.delete(shader)
+
+    def _get_diff_service(self) -> Any:
+        """Get the asset diff service whose snapshots list the parts"""
+        if self._diff_service is None:
+            from .asset_diff_service_impl import get_asset_diff_service
+
+            self._diff_service = get_asset_diff_service()
+        return self._diff_service
+
+
+# Singleton instance factory
+_part_import_service_instance = None
+
+
+def get_part_import_service() -> PartImportService:
+    """
+    Get singleton instance of PartImportService.
+
+    Returns:
+        PartImportService: Singleton service instance
+    """
+    global _part_import_service_instance
+    if _part_import_service_instance is None:
+        _part_import_service_instance = PartImportService()
+    return _part_import_service_instance
@@ -0,0 +1,297 @@
+# -*- coding: utf-8 -*-
+"""
+Path Mapping Service Implementation
+Store library paths under a root token so one library works on Windows, Linux, and macOS
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A library lists where it is mounted on each operating system in its settings::
+
+    MyProject/.assetmanager/path_mapping.json
+    {
+        "token": "ASSET_ROOT",
+        "roots": {"windows": "P:/assets", "linux": "/mnt/assets", "macos": "/Volumes/assets"}
+    }
+
+Paths written into scenes and library records start with $ASSET_ROOT instead of the
+mount, and the variable is set to this machine's root when the library is opened. An
+ASSET_ROOT already in the environment (Maya.env, the studio launcher) wins over the
+table, and should be set there so scenes opened before the Asset Manager still find
+their references. Absolute paths saved on another operating system are mapped through
+Maya's dirmap while the library is open.
+
+Libraries without a mapping file keep absolute paths (unless the studio set the token),
+as giving each of them its opened folder as $ASSET_ROOT would make their paths collide.
+"""
+
+import json
+import logging
+import os
+from pathlib import Path
+from typing import Any, Dict, List, MutableMapping, Optional, Tuple
+
+from ..core.models.path_mapping import PathMapping, get_platform, normalize_root
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "path_mapping.json"
+
+
+def _strip_root(path: str, root: str) -> Optional[str]:
+    """Get what follows a mount root in a path ("" for the root itself), None if not under it"""
+    if not root:
+        return None
+    # Drive letters and UNC shares are case-insensitive
+    windows_root = root.startswith("//") or (len(root) > 1 and root[1] == ":")
+    compare_path, compare_root = (path.lower(), root.lower()) if windows_root else (path, root)
+    if compare_path == compare_root:
+        return ""
+    prefix = compare_root if compare_root.endswith("/") else compare_root + "/"
+    if compare_path.startswith(prefix):
+        return "/" + path[len(prefix) :]
+    return None
+
+
+class PathMappingService:
+    """
+    Path Mapping Service - Single Responsibility for cross-platform library paths
+    Maya is passed in, so mapping can be tested and used by batch tools without it
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        self._active_root: Optional[Path] = None  # Library of the last install()
+        self._installed: Dict[str, str] = {}  # Token -> value install() set it to
+        self._previous: Dict[str, Optional[str]] = {}  # Token -> value before install()
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the path mapping settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def get_mapping(self, library_root: Optional[Path]) -> PathMapping:
+        """Get a library's mount table (only the default token when it has none)"""
+        if library_root is None:
+            return PathMapping()
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return PathMapping()
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                return PathMapping.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable path mapping {settings_file}: {e}")
+            return PathMapping()
+
+    def has_mapping(self, library_root: Optional[Path]) -> bool:
+        """Check if a library has its own mount table (only those store token paths)"""
+        return library_root is not None and self.get_settings_file(library_root).is_file()
+
+    def save_mapping(self, library_root: Path, mapping: PathMapping) -> bool:
+        """Store a library's mount table"""
+        settings_file = self.get_settings_file(library_root)
+        try:
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump(mapping.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save path mapping: {e}")
+            return False
+
+    def get_local_root(
+        self,
+        library_root: Optional[Path],
+        mapping: Optional[PathMapping] = None,
+        environ: Optional[MutableMapping[str, str]] = None,
+        platform: Optional[str] = None,
+    ) -> str:
+        """
+        Get where the library is mounted on this machine
+
+        Args:
+            library_root: Library as opened, used when nothing else names the root
+            mapping: Mount table, read from the library by default
+            environ: Environment variables, os.environ by default
+            platform: One of PLATFORMS, this machine's by default
+
+        Returns:
+            Normalized root, "" without a library
+        """
+        mapping = mapping or self.get_mapping(library_root)
+        root = self._get_environment_root(mapping, environ)
+        root = root or mapping.get_root(platform or get_platform())
+        if not root and library_root is not None:
+            root = Path(library_root).as_posix()
+        return normalize_root(root) if root else ""
+
+    # Mapping ----------------------------------------------------------------------------
+
+    def to_portable(
+        self,
+        path: Any,
+        library_root: Optional[Path] = None,
+        environ: Optional[MutableMapping[str, str]] = None,
+        platform: Optional[str] = None,
+    ) -> str:
+        """
+        Get the form of a path to store: $ASSET_ROOT/... when it is inside the library
+
+        Args:
+            path: Absolute path on this machine or any mount in the table
+            library_root: Library the path belongs to, the installed one by default
+
+        Returns:
+            Token path, or the path with forward slashes when it is outside the library
+        """
+        text = str(path).replace("\\", "/")
+        library_root = library_root or self._active_root
+        if library_root is None:
+            return text
+        mapping = self.get_mapping(library_root)
+        # The folder the library was opened from only counts when it is the local root,
+        # as the token would otherwise resolve somewhere else
+        roots = [
+            self._get_token_root(library_root, mapping, environ, platform),
+            *(mapping.get_root(name) for name in mapping.roots),
+        ]
+        for root in roots:
+            rest = _strip_root(text, root)
+            if rest is not None:
+                return mapping.variable + rest
+        return text
+
+    def resolve(
+        self,
+        stored: Any,
+        library_root: Optional[Path] = None,
+        environ: Optional[MutableMapping[str, str]] = None,
+        platform: Optional[str] = None,
+    ) -> Path:
+        """
+        Get the path on this machine of a stored path
+
+        Token paths ($ASSET_ROOT/..., ${ASSET_ROOT}/..., %ASSET_ROOT%/...) and paths
+        under another platform's mount are moved to the local root; other paths are
+        returned as they are.
+        """
+        text = str(stored).replace("\\", "/")
+        library_root = library_root or self._active_root
+        mapping = self.get_mapping(library_root)
+        local_root = self._get_token_root(library_root, mapping, environ, platform)
+        if not local_root:
+            return Path(text)
+
+        tokens = [mapping.variable, f"${{{mapping.token}}}", f"%{mapping.token}%"]
+        foreign_roots = [mapping.get_root(name) for name in mapping.roots]
+        for root in tokens + [root for root in foreign_roots if root != local_root]:
+            rest = _strip_root(text, root)
+            if rest is not None:
+                return Path(local_root + rest)
+        return Path(text)
+
+    # Maya -------------------------------------------------------------------------------
+
+    def install(
+        self,
+        library_root: Path,
+        cmds: Any = None,
+        environ: Optional[MutableMapping[str, str]] = None,
+        platform: Optional[str] = None,
+    ) -> List[Tuple[str, str]]:
+        """
+        Make a library's stored paths resolve on this machine
+
+        Sets the root token variable for this session and, inside Maya, maps the
+        library's mounts on other platforms to the local one with dirmap. A library
+        without a mapping file puts back the token value an earlier install() replaced.
+
+        Args:
+            library_root: Library being opened
+            cmds: maya.cmds module, None outside Maya
+
+        Returns:
+            (other mount, local root) pairs given to dirmap
+        """
+        environ = os.environ if environ is None else environ
+        mapping = self.get_mapping(library_root)
+        local_root = self.get_local_root(library_root, mapping, environ, platform)
+        self._active_root = Path(library_root)
+        if not self.has_mapping(library_root):
+            self._restore_token(mapping.token, environ)
+        elif environ.get(mapping.token) != local_root:
+            if mapping.token not in self._installed:
+                self._previous[mapping.token] = environ.get(mapping.token)
+            environ[mapping.token] = local_root
+            self._installed[mapping.token] = local_root
+
+        pairs = [
+            (root, local_root)
+            for root in (mapping.get_root(name) for name in mapping.roots)
+            if root and root != local_root
+        ]
+        if cmds is not None and pairs:
+            try:
+                cmds.dirmap(enable=True)
+                for pair in pairs:
+                    cmds.dirmap(mapDirectory=pair)
+            except Exception as e:
+                print(f"[WARNING] Could not map library mounts in Maya: {e}")
+                return []
+        if pairs:
+            print(f"[OK] Mapped {len(pairs)} other mount(s) of the library to {local_root}")
+        return pairs
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_environment_root(
+        self, mapping: PathMapping, environ: Optional[MutableMapping[str, str]]
+    ) -> str:
+        """Get the token's value set by the studio, "" when unset or set by install()"""
+        environ = os.environ if environ is None else environ
+        root = environ.get(mapping.token, "")
+        if root and root == self._installed.get(mapping.token):
+            return ""  # The root of the library opened before, not this one's
+        return root
+
+    def _get_token_root(
+        self,
+        library_root: Optional[Path],
+        mapping: PathMapping,
+        environ: Optional[MutableMapping[str, str]],
+        platform: Optional[str],
+    ) -> str:
+        """Get the root token paths stand for, "" when the library stores absolute paths"""
+        if self.has_mapping(library_root):
+            return self.get_local_root(library_root, mapping, environ, platform)
+        root = self._get_environment_root(mapping, environ)
+        return normalize_root(root) if root else ""
+
+    def _restore_token(self, token: str, environ: MutableMapping[str, str]) -> None:
+        """Put back the value a token had before install() set it"""
+        installed = self._installed.pop(token, None)
+        previous = self._previous.pop(token, None)
+        if installed is None or environ.get(token) != installed:
+            return  # Never set, or changed since by someone else
+        if previous is None:
+            del environ[token]
+        else:
+            environ[token] = previous
+
+
+# Singleton instance factory
+_path_mapping_service_instance = None
+
+
+def get_path_mapping_service() -> PathMappingService:
+    """
+    Get singleton instance of PathMappingService.
+
+    Returns:
+        PathMappingService: Singleton service instance
+    """
+    global _path_mapping_service_instance
+    if _path_mapping_service_instance is None:
+        _path_mapping_service_instance = PathMappingService()
+    return _path_mapping_service_instance
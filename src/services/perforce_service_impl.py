@@ -0,0 +1,247 @@
+# -*- coding: utf-8 -*-
+"""
+Perforce Service Implementation
+Library storage in a Perforce depot through the p4 command line client
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Connection settings come from the usual P4PORT / P4USER / P4CLIENT environment or
+P4CONFIG file, so the tool works with whatever workspace the artist already uses.
+Commands run as ``p4 -ztag -Mj`` and return one JSON record per line. A publish is::
+
+    open_for_publish()   p4 change -i, p4 edit / reopen -c N   (files become writable)
+    ... export, versions, dependencies ...
+    submit_publish()     p4 reconcile -a -e -c N, p4 revert -a -c N, p4 submit -c N
+"""
+
+import json
+import logging
+import re
+import shutil
+import subprocess
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional
+
+from ..core.interfaces.source_control import ISourceControl
+from ..core.models.depot_revision import DepotRevision
+
+P4_EXECUTABLE = "p4"
+
+# Files per fstat call, keeps the command line under OS limits
+FSTAT_BATCH_SIZE = 200
+
+# Server message severity: 2 = warning ("file(s) up-to-date"), 3+ = error
+P4_ERROR_SEVERITY = 3
+
+_CHANGE_CREATED = re.compile(r"Change (\d+) created")
+
+# Runs p4 with the given arguments and optional stdin, returns the JSON records
+P4Runner = Callable[[List[str], Optional[str]], List[Dict[str, Any]]]
+
+
+class PerforceError(Exception):
+    """Exception raised when a p4 command reports an error"""
+
+
+class PerforceServiceImpl(ISourceControl):
+    """
+    Perforce Service - Single Responsibility for depot operations on library files
+    Commands go through a runner so the service can be tested without a server
+    """
+
+    def __init__(self, runner: Optional[P4Runner] = None, executable: str = P4_EXECUTABLE):
+        self.logger = logging.getLogger(__name__)
+        self._executable = executable
+        self._runner = runner or self._run_p4
+        self._info: Optional[Dict[str, Any]] = None
+
+    # Connection -------------------------------------------------------------------------
+
+    def is_available(self) -> bool:
+        """Check if p4 is installed and the current workspace is known to the server"""
+        info = self._get_info()
+        return bool(info.get("clientRoot")) and info.get("clientName") != "*unknown*"
+
+    def is_managed(self, path: Path) -> bool:
+        """Check if a file lies under the workspace root"""
+        root = self._get_info().get("clientRoot")
+        if not root:
+            return False
+        try:
+            Path(path).resolve().relative_to(Path(root).resolve())
+            return True
+        except ValueError:
+            return False
+
+    def reset(self) -> None:
+        """Forget cached connection info, e.g. after the workspace changed"""
+        self._info = None
+
+    def _get_info(self) -> Dict[str, Any]:
+        """Get p4 info for the current environment (cached)"""
+        if self._info is None:
+            self._info = {}
+            try:
+                records = self._runner(["info"], None)
+                self._info = next((r for r in records if "clientName" in r), {})
+            except Exception as e:
+                print(f"[INFO] Perforce not available: {e}")
+        return self._info
+
+    # Queries ----------------------------------------------------------------------------
+
+    def get_revisions(self, paths: List[Path]) -> Dict[str, DepotRevision]:
+        """Get depot state of library files in batched fstat calls"""
+        managed = [str(path) for path in paths if self.is_managed(path)]
+        revisions: Dict[str, DepotRevision] = {}
+        for start in range(0, len(managed), FSTAT_BATCH_SIZE):
+            batch = managed[start : start + FSTAT_BATCH_SIZE]
+            try:
+                records = self._runner(
+                    ["fstat", "-T", "depotFile,clientFile,haveRev,headRev,headChange,action"]
+                    + batch,
+                    None,
+                )
+            except Exception as e:
+                print(f"[WARNING] Failed to read depot revisions: {e}")
+                continue
+            for record in records:
+                if "depotFile" not in record or "clientFile" not in record:
+                    continue
+                revision = DepotRevision(
+                    local_path=Path(record["clientFile"]),
+                    depot_path=record["depotFile"],
+                    have_revision=int(record.get("haveRev", 0) or 0),
+                    head_revision=int(record.get("headRev", 0) or 0),
+                    head_change=int(record.get("headChange", 0) or 0),
+                    action=record.get("action", ""),
+                )
+                revisions[str(revision.local_path)] = revision
+        return revisions
+
+    # Publish ----------------------------------------------------------------------------
+
+    def open_for_publish(self, paths: List[Path], description: str) -> Optional[int]:
+        """Create a changelist and open the publish's existing depot files in it"""
+        try:
+            change = self._create_change(description)
+            files = [str(path) for path in paths]
+            # Depot files are read-only until opened; files without a depot revision
+            # only warn here and are added by reconcile after the publish wrote them
+            self._runner(["edit", "-c", str(change)] + files, None)
+            # Files already open in another changelist move into this one
+            self._runner(["reopen", "-c", str(change)] + files, None)
+            print(f"[OK] Opened publish changelist {change}")
+            return change
+        except Exception as e:
+            print(f"[ERROR] Could not open files for publish in Perforce: {e}")
+            return None
+
+    def submit_publish(self, change: int, paths: List[Path]) -> Optional[int]:
+        """Add new files, drop unchanged ones, and submit the publish changelist"""
+        files = [str(path) for path in paths]
+        try:
+            self._runner(["reconcile", "-a", "-e", "-c", str(change)] + files, None)
+            self._runner(["revert", "-a", "-c", str(change), "//..."], None)
+
+            opened = self._runner(["opened", "-c", str(change)], None)
+            if not any("depotFile" in record for record in opened):
+                self._runner(["change", "-d", str(change)], None)
+                print(f"[INFO] Nothing changed, deleted empty changelist {change}")
+                return None
+
+            records = self._runner(["submit", "-c", str(change)], None)
+            submitted = next(
+                (int(r["submittedChange"]) for r in records if "submittedChange" in r), None
+            )
+            print(f"[OK] Submitted changelist {submitted or change}")
+            return submitted or change
+        except Exception as e:
+            print(f"[ERROR] Perforce submit of changelist {change} failed: {e}")
+            return None
+
+    def revert_publish(self, change: int) -> None:
+        """Revert a failed publish's changelist and delete it"""
+        try:
+            self._runner(["revert", "-c", str(change), "//..."], None)
+            self._runner(["change", "-d", str(change)], None)
+        except Exception as e:
+            print(f"[WARNING] Could not clean up changelist {change}: {e}")
+
+    def _create_change(self, description: str) -> int:
+        """Create a numbered pending changelist with a description"""
+        form = next((r for r in self._runner(["change", "-o"], None) if "Client" in r), {})
+        lines = description.strip().splitlines() or ["Asset Manager publish"]
+        spec = (
+            "Change: new\n"
+            f"Client: {form.get('Client', '')}\n"
+            f"User: {form.get('User', '')}\n"
+            "Status: new\n"
+            "Description:\n" + "".join(f"\t{line}\n" for line in lines)
+        )
+        for record in self._runner(["change", "-i"], spec):
+            match = _CHANGE_CREATED.search(str(record.get("data", "")))
+            if match:
+                return int(match.group(1))
+        raise PerforceError("p4 change -i did not report a changelist number")
+
+    # Sync -------------------------------------------------------------------------------
+
+    def sync(self, paths: List[Path], change: Optional[int] = None) -> bool:
+        """Sync files to head or to a pinned changelist"""
+        suffix = f"@{change}" if change else "#head"
+        try:
+            self._runner(["sync"] + [f"{path}{suffix}" for path in paths], None)
+            return True
+        except Exception as e:
+            print(f"[ERROR] Perforce sync failed: {e}")
+            return False
+
+    # Command runner ---------------------------------------------------------------------
+
+    def _run_p4(self, args: List[str], input_text: Optional[str]) -> List[Dict[str, Any]]:
+        """Run p4 with tagged JSON output and raise on server errors"""
+        if shutil.which(self._executable) is None:
+            raise PerforceError(f"'{self._executable}' command line client not found")
+
+        # Forms (change -i) are read from stdin as plain spec text
+        command = [self._executable, "-ztag", "-Mj"] + args
+        result = subprocess.run(
+            command, input=input_text, capture_output=True, text=True, timeout=300
+        )
+
+        records = []
+        for line in result.stdout.splitlines():
+            try:
+                records.append(json.loads(line))
+            except json.JSONDecodeError:
+                continue
+
+        errors = [
+            str(r.get("data", "")).strip()
+            for r in records
+            if int(r.get("severity", 0) or 0) >= P4_ERROR_SEVERITY
+        ]
+        if errors:
+            raise PerforceError("; ".join(errors))
+        if result.returncode != 0 and not records:
+            raise PerforceError(result.stderr.strip() or f"p4 {args[0]} failed")
+        return records
+
+
+# Singleton instance factory
+_perforce_service_instance = None
+
+
+def get_perforce_service() -> PerforceServiceImpl:
+    """
+    Get singleton instance of PerforceServiceImpl.
+
+    Returns:
+        PerforceServiceImpl: Singleton service instance
+    """
+    global _perforce_service_instance
+    if _perforce_service_instance is None:
+        _perforce_service_instance = PerforceServiceImpl()
+    return _perforce_service_instance
@@ -0,0 +1,201 @@
+# -*- coding: utf-8 -*-
+"""
+Permission Service Implementation
+Role-based permissions of shared libraries enforced before destructive operations
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Roles are configured per library; a library without a permissions file lets every
+artist do everything, as before::
+
+    MyProject/.assetmanager/permissions.json
+    {
+      "default_role": "junior",
+      "users": {"kim": "admin", "sam": "lead", "alex": "artist"},
+      "roles": {"artist": ["import", "publish", "edit", "delete"]}   <- optional
+    }
+
+    junior   import
+    artist   import, publish, edit
+    reviewer import, publish, edit, approve (review status changes)
+    lead     import, publish, edit, approve, delete
+    admin    everything, including manage (library settings and these permissions)
+
+An unreadable permissions file gives everyone the junior role until it is fixed, so
+a typo never opens a library up. The file is a workflow guard: protect it with
+file system permissions where artists must not be able to edit it.
+
+Vendor libraries (purchased asset packs, see vendor_library_service_impl) are read-only
+on top of the roles: everyone may import, only admins may manage.
+
+When the studio set up a sign-in backend (see identity_service_impl), roles are looked
+up for the signed-in studio identity, and artists who have not signed in may only
+import until they do.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Dict, Optional, Tuple
+
+from ..core.models.library_permissions import (
+    ACTION_IMPORT,
+    ACTION_LABELS,
+    ACTION_MANAGE,
+    ROLE_ADMIN,
+    ROLE_JUNIOR,
+    LibraryPermissions,
+)
+from .identity_service_impl import get_identity_service
+from .vendor_library_service_impl import READ_ONLY_ACTIONS, get_vendor_library_service
+from .version_service_impl import get_current_user
+
+PERMISSIONS_DIR_NAME = ".assetmanager"
+PERMISSIONS_FILE_NAME = "permissions.json"
+
+# Stands in for a broken permissions file
+_LOCKED_DOWN = LibraryPermissions(default_role=ROLE_JUNIOR)
+
+
+class PermissionDenied(Exception):
+    """Raised when the current artist's role does not allow an action"""
+
+
+class PermissionService:
+    """
+    Permission Service - Single Responsibility for library role checks
+    Permission files are re-read when they change on disk
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        # Permissions file -> (modification time, parsed permissions)
+        self._cache: Dict[str, Tuple[int, Optional[LibraryPermissions]]] = {}
+
+    def get_permissions_file(self, library_root: Path) -> Path:
+        """Get the permissions file of a library"""
+        return Path(library_root) / PERMISSIONS_DIR_NAME / PERMISSIONS_FILE_NAME
+
+    def load_permissions(self, library_root: Optional[Path]) -> Optional[LibraryPermissions]:
+        """Get a library's permissions, None when the library is unrestricted"""
+        if library_root is None:
+            return None
+        permissions_file = self.get_permissions_file(library_root)
+        try:
+            modified = permissions_file.stat().st_mtime_ns
+        except OSError:
+            return None  # No permissions file
+
+        cached = self._cache.get(str(permissions_file))
+        if cached is not None and cached[0] == modified:
+            return cached[1]
+
+        try:
+            with open(permissions_file, "r", encoding="utf-8") as f:
+                permissions = LibraryPermissions.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Unreadable permissions {permissions_file}, using junior role: {e}")
+            permissions = _LOCKED_DOWN
+        self._cache[str(permissions_file)] = (modified, permissions)
+        return permissions
+
+    def save_permissions(self, library_root: Path, permissions: LibraryPermissions) -> bool:
+        """
+        Write a library's permissions
+
+        Raises:
+            PermissionDenied: If the current artist cannot manage the library or
+                would lose the right to
+        """
+        permissions_file = self.get_permissions_file(library_root)
+        if permissions_file.exists():
+            self.check(library_root, ACTION_MANAGE)
+        if not permissions.allows(get_current_user(), ACTION_MANAGE):
+            # Whoever changes the permissions must keep the right to change them
+            raise PermissionDenied("Keep yourself as an admin of the library")
+        try:
+            permissions_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(permissions_file, "w", encoding="utf-8") as f:
+                json.dump(permissions.to_dict(), f, indent=2)
+            self._cache.pop(str(permissions_file), None)
+            print(f"[OK] Saved library permissions {permissions_file}")
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save library permissions: {e}")
+            return False
+
+    def get_role(self, library_root: Optional[Path], user: Optional[str] = None) -> str:
+        """Get an artist's role in a library (admin when the library is unrestricted)"""
+        permissions = self.load_permissions(library_root)
+        if permissions is None:
+            return ROLE_ADMIN
+        return permissions.get_role(user or get_current_user())
+
+    def is_restricted(self, library_root: Optional[Path]) -> bool:
+        """Check if a library has a permissions file"""
+        return self.load_permissions(library_root) is not None
+
+    def is_read_only(self, library_root: Optional[Path]) -> bool:
+        """Check if a library is a read-only vendor library"""
+        return get_vendor_library_service().is_read_only(library_root)
+
+    def is_allowed(
+        self, library_root: Optional[Path], action: str, user: Optional[str] = None
+    ) -> bool:
+        """Check if an artist may perform an action in a library"""
+        if action in READ_ONLY_ACTIONS and self.is_read_only(library_root):
+            return False
+        if user is None and action != ACTION_IMPORT and self.needs_sign_in():
+            return False
+        permissions = self.load_permissions(library_root)
+        if permissions is None:
+            return True
+        return permissions.allows(user or get_current_user(), action)
+
+    def needs_sign_in(self) -> bool:
+        """Check if the current artist must sign in before changing anything"""
+        return get_identity_service().requires_sign_in()
+
+    def check(self, library_root: Optional[Path], action: str, user: Optional[str] = None) -> None:
+        """
+        Make sure an artist may perform an action in a library
+
+        Raises:
+            PermissionDenied: With a message naming the role and the action
+        """
+        label = ACTION_LABELS.get(action, action)
+        if user is None and action != ACTION_IMPORT and self.needs_sign_in():
+            print(f"[WARNING] Not signed in, cannot {label}")
+            raise PermissionDenied(
+                f"Sign in with your studio account (File > Sign In) to {label}."
+            )
+        user = user or get_current_user()
+        if self.is_allowed(library_root, action, user):
+            return
+        if action in READ_ONLY_ACTIONS and self.is_read_only(library_root):
+            print(f"[WARNING] {Path(library_root).name} is a vendor library, cannot {label}")
+            raise PermissionDenied(
+                "This is a read-only vendor library: its assets can be imported, "
+                f"but you cannot {label} in it."
+            )
+        role = self.get_role(library_root, user)
+        print(f"[WARNING] {user} ({role}) is not allowed to {label}")
+        raise PermissionDenied(f"Your role in this library ({role}) cannot {label}.")
+
+
+# Singleton instance factory
+_permission_service_instance = None
+
+
+def get_permission_service() -> PermissionService:
+    """
+    Get singleton instance of PermissionService.
+
+    Returns:
+        PermissionService: Singleton service instance
+    """
+    global _permission_service_instance
+    if _permission_service_instance is None:
+        _permission_service_instance = PermissionService()
+    return _permission_service_instance
@@ -0,0 +1,215 @@
+# -*- coding: utf-8 -*-
+"""
+Playblast Service Implementation
+Capture short playblast previews of rigs and animation as MP4 movies at publish time
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The viewport is playblasted as a PNG sequence through the chosen camera and encoded
+with ffmpeg, next to the asset's still thumbnail::
+
+    assets/animation/.thumbnails/walk_cycle_screenshot.png    <- still
+    assets/animation/.thumbnails/walk_cycle_playblast.mp4     <- animated preview
+
+The capture settings are kept in the asset's library metadata so a preview can be
+re-captured with the same range and camera.
+"""
+
+import logging
+import os
+import shutil
+import subprocess
+import tempfile
+from pathlib import Path
+from typing import Any, List, Optional
+
+from ..core.models.playblast_settings import PlayblastSettings
+from .anim_clip_service_impl import time_unit_to_fps
+from .color_management_service_impl import get_color_management_service
+from .thumbnail_queue_impl import get_turntable_path
+
+PLAYBLAST_SUFFIX = "_playblast.mp4"
+PLAYBLAST_METADATA_KEY = "playblast"
+THUMBNAILS_DIR_NAME = ".thumbnails"
+
+# Environment variable pointing at ffmpeg when it is not on PATH
+FFMPEG_ENV_VAR = "ASSET_MANAGER_FFMPEG"
+
+_FRAME_PREFIX = "frame"
+_FRAME_PADDING = 4
+
+
+def get_playblast_path(asset_path: Path) -> Path:
+    """Get the playblast preview path for an asset (may not exist)"""
+    asset_path = Path(asset_path)
+    return asset_path.parent / THUMBNAILS_DIR_NAME / f"{asset_path.stem}{PLAYBLAST_SUFFIX}"
+
+
+def find_preview_movie(asset_path: Path) -> Optional[Path]:
+    """Get the movie preview of an asset: its playblast, else an MP4 turntable"""
+    for candidate in (get_playblast_path(asset_path), get_turntable_path(asset_path, ".mp4")):
+        if candidate.exists():
+            return candidate
+    return None
+
+
+def find_ffmpeg() -> Optional[str]:
+    """Get the ffmpeg executable (ASSET_MANAGER_FFMPEG, then PATH), None if missing"""
+    configured = os.environ.get(FFMPEG_ENV_VAR)
+    if configured and Path(configured).is_file():
+        return configured
+    return shutil.which("ffmpeg")
+
+
+class PlayblastService:
+    """
+    Playblast Service - Single Responsibility for animated preview capture
+    Scene access goes through the cmds argument so capture can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def list_cameras(self, cmds: Any) -> List[str]:
+        """Get the scene's camera transforms, the perspective camera first"""
+        shapes = cmds.ls(type="camera", long=True) or []
+        cameras = []
+        for shape in shapes:
+            parent = (cmds.listRelatives(shape, parent=True) or [shape])[0]
+            if parent not in cameras:
+                cameras.append(parent)
+        return sorted(cameras, key=lambda camera: (camera != "persp", camera.lower()))
+
+    def get_default_settings(self, cmds: Any) -> PlayblastSettings:
+        """Get settings for the scene's playback range through the perspective camera"""
+        return PlayblastSettings(
+            start_frame=cmds.playbackOptions(query=True, minTime=True),
+            end_frame=cmds.playbackOptions(query=True, maxTime=True),
+        )
+
+    def capture(
+        self, cmds: Any, asset_path: Path, settings: PlayblastSettings
+    ) -> Optional[Path]:
+        """
+        Playblast the viewport and encode it as the asset's MP4 preview
+
+        Args:
+            cmds: maya.cmds module
+            asset_path: Published asset the preview belongs to
+            settings: Frame range, camera, and resolution
+
+        Returns:
+            Written movie path, None if the capture or encode failed
+        """
+        if settings.frame_count == 0:
+            print(f"[ERROR] Playblast range {settings.description} holds no frames")
+            return None
+        ffmpeg = find_ffmpeg()
+        if ffmpeg is None:
+            print(f"[ERROR] ffmpeg not found - set {FFMPEG_ENV_VAR} to capture playblasts")
+            return None
+
+        output = get_playblast_path(asset_path)
+        temp_dir = Path(tempfile.mkdtemp(prefix="assetManager_playblast_"))
+        try:
+            images = self._playblast_frames(cmds, settings, temp_dir)
+            if not images:
+                print(f"[ERROR] Playblast of {Path(asset_path).name} wrote no frames")
+                return None
+            fps = time_unit_to_fps(cmds.currentUnit(query=True, time=True))
+            self._encode(ffmpeg, images, output, fps)
+        except Exception as e:
+            print(f"[ERROR] Could not capture playblast for {Path(asset_path).name}: {e}")
+            return None
+        finally:
+            shutil.rmtree(temp_dir, ignore_errors=True)
+
+        print(f"[OK] Captured playblast preview: {output.name} ({settings.description})")
+        return output
+
+    # Internals --------------------------------------------------------------------------
+
+    def _playblast_frames(
+        self, cmds: Any, settings: PlayblastSettings, temp_dir: Path
+    ) -> List[Path]:
+        """Playblast the range as a PNG sequence, looking through the requested camera"""
+        panel = self._get_model_panel(cmds)
+        previous_camera = cmds.modelPanel(panel, query=True, camera=True) if panel else None
+        current_time = cmds.currentTime(query=True)
+        if panel and cmds.objExists(settings.camera):
+            cmds.modelPanel(panel, edit=True, camera=settings.camera)
+        # Frames go through the scene's OCIO view transform, as the viewport shows them
+        color_output = get_color_management_service().enable_view_transform(cmds)
+        try:
+            options = {"editorPanelName": panel} if panel else {}
+            cmds.playblast(
+                startTime=settings.start_frame,
+                endTime=settings.end_frame,
+                format="image",
+                compression="png",
+                filename=str(temp_dir / _FRAME_PREFIX),
+                framePadding=_FRAME_PADDING,
+                widthHeight=(settings.width, settings.height),
+                percent=100,
+                viewer=False,
+                showOrnaments=False,
+                forceOverwrite=True,
+                offScreen=True,
+                **options,
+            )
+        finally:
+            get_color_management_service().restore_view_transform(cmds, color_output)
+            if previous_camera:
+                cmds.modelPanel(panel, edit=True, camera=previous_camera)
+            cmds.currentTime(current_time)
+        return sorted(temp_dir.glob(f"{_FRAME_PREFIX}.*.png"))
+
+    def _get_model_panel(self, cmds: Any) -> Optional[str]:
+        """Get the focused viewport, else the first visible one (None in batch mode)"""
+        panels = [cmds.getPanel(withFocus=True)] + list(cmds.getPanel(visiblePanels=True) or [])
+        for panel in panels:
+            if panel and cmds.getPanel(typeOf=panel) == "modelPanel":
+                return panel
+        return None
+
+    def _encode(self, ffmpeg: str, images: List[Path], output: Path, fps: float) -> None:
+        """Encode a numbered PNG sequence as an H.264 MP4 that plays everywhere"""
+        output.parent.mkdir(parents=True, exist_ok=True)
+        first_frame = images[0].name.split(".")[1]
+        pattern = str(images[0].parent / f"{_FRAME_PREFIX}.%0{_FRAME_PADDING}d.png")
+        command = [
+            ffmpeg,
+            "-y",
+            "-framerate",
+            f"{fps:g}",
+            "-start_number",
+            str(int(first_frame)),
+            "-i",
+            pattern,
+            "-vf",
+            "scale=trunc(iw/2)*2:trunc(ih/2)*2",  # H.264 needs even dimensions
+            "-c:v",
+            "libx264",
+            "-pix_fmt",
+            "yuv420p",
+            str(output),
+        ]
+        subprocess.run(command, check=True, capture_output=True)
+
+
+# Singleton instance factory
+_playblast_service_instance = None
+
+
+def get_playblast_service() -> PlayblastService:
+    """
+    Get singleton instance of PlayblastService.
+
+    Returns:
+        PlayblastService: Singleton service instance
+    """
+    global _playblast_service_instance
+    if _playblast_service_instance is None:
+        _playblast_service_instance = PlayblastService()
+    return _playblast_service_instance
@@ -0,0 +1,259 @@
+# -*- coding: utf-8 -*-
+"""
+Pose Service Implementation
+Capture controller attribute values as pose assets and apply them with mirror and blend
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A pose is a JSON file in the library's poses folder::
+
+    assets/poses/fist_closed.pose
+    assets/poses/.thumbnails/fist_closed_screenshot.png
+
+Controls are stored without namespace and remapped on apply, like animation clips.
+Mirroring swaps left/right control names; controls without a side are reflected
+across the YZ plane by negating MIRROR_NEGATE_ATTRIBUTES.
+"""
+
+import json
+import logging
+import re
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .anim_clip_service_impl import (
+    get_anim_clip_service,
+    get_namespace,
+    playblast_thumbnail,
+    remap_node,
+    strip_namespace,
+)
+from .version_service_impl import get_current_user
+
+POSE_EXTENSION = ".pose"
+POSE_FORMAT_VERSION = 1
+
+# Attribute types that hold a single number a pose can restore
+POSE_ATTRIBUTE_TYPES = {
+    "double",
+    "doubleLinear",
+    "doubleAngle",
+    "float",
+    "long",
+    "short",
+    "bool",
+    "enum",
+}
+
+# (left, right) name tokens, checked in order; matched as whole name parts
+MIRROR_SIDE_TOKENS = [("L", "R"), ("l", "r"), ("Left", "Right"), ("left", "right"), ("lf", "rt")]
+
+# Reflecting a center control across the YZ plane flips these channels
+MIRROR_NEGATE_ATTRIBUTES = {"translateX", "rotateY", "rotateZ"}
+
+
+@dataclass
+class PoseApplyResult:
+    """Outcome of applying a pose to a rig"""
+
+    applied_attributes: int = 0
+    missing: List[str] = field(default_factory=list)  # node.attribute not found on target
+
+    @property
+    def success(self) -> bool:
+        return self.applied_attributes > 0
+
+
+def mirror_control_name(name: str) -> Optional[str]:
+    """Get the opposite-side control name, None for controls without a side"""
+    for left, right in MIRROR_SIDE_TOKENS:
+        for source, target in ((left, right), (right, left)):
+            # Side token delimited by "_" or the name boundary: L_arm_ctrl, arm_L_ctrl, arm_L
+            pattern = rf"(^|_){source}(_|$)"
+            if re.search(pattern, name):
+                return re.sub(pattern, rf"\g<1>{target}\g<2>", name, count=1)
+    return None
+
+
+class PoseService:
+    """
+    Pose Service - Single Responsibility for pose capture and application
+    All Maya calls go through the cmds argument so poses can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Capture ----------------------------------------------------------------------------
+
+    def get_pose_attributes(self, cmds: Any, node: str) -> List[str]:
+        """Get keyable, unlocked, scalar attributes of a control"""
+        attributes = []
+        for attribute in cmds.listAttr(node, keyable=True, unlocked=True, scalar=True) or []:
+            try:
+                if cmds.getAttr(f"{node}.{attribute}", type=True) in POSE_ATTRIBUTE_TYPES:
+                    attributes.append(attribute)
+            except Exception:
+                continue  # Compound children or proxies that cannot be queried directly
+        return attributes
+
+    def capture_pose(
+        self, cmds: Any, controls: List[str], name: str, notes: str = ""
+    ) -> Dict[str, Any]:
+        """
+        Read the current attribute values of the selected controls
+
+        Args:
+            cmds: maya.cmds module
+            controls: Selected rig controls
+            name: Pose name
+            notes: Free text description
+
+        Returns:
+            Pose dictionary ready for save_pose
+        """
+        values: Dict[str, Dict[str, float]] = {}
+        for control in controls:
+            attributes = {}
+            for attribute in self.get_pose_attributes(cmds, control):
+                attributes[attribute] = float(cmds.getAttr(f"{control}.{attribute}"))
+            if attributes:
+                values[strip_namespace(control)] = attributes
+
+        first_control = controls[0] if controls else ""
+        rig = ""
+        if first_control:
+            rig = get_anim_clip_service().get_rig_identifier(cmds, first_control)
+        return {
+            "type": "pose",
+            "format_version": POSE_FORMAT_VERSION,
+            "name": name,
+            "notes": notes,
+            "rig": rig,
+            "source_namespace": get_namespace(first_control),
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+            "controls": values,
+        }
+
+    # Storage ----------------------------------------------------------------------------
+
+    def save_pose(self, pose_path: Path, pose: Dict[str, Any]) -> Path:
+        """Write pose data to a .pose file"""
+        pose_path = Path(pose_path)
+        if pose_path.suffix != POSE_EXTENSION:
+            pose_path = pose_path.with_suffix(POSE_EXTENSION)
+        pose_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(pose_path, "w", encoding="utf-8") as f:
+            json.dump(pose, f, indent=2)
+        print(f"[OK] Saved pose {pose_path.name} ({len(pose['controls'])} controls)")
+        return pose_path
+
+    def load_pose(self, pose_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .pose file, None if it is not a valid pose"""
+        try:
+            with open(pose_path, "r", encoding="utf-8") as f:
+                pose = json.load(f)
+            if pose.get("type") != "pose":
+                return None
+            return pose
+        except Exception as e:
+            self.logger.error(f"Failed to read pose {pose_path}: {e}")
+            return None
+
+    # Apply ------------------------------------------------------------------------------
+
+    def mirror_pose(self, pose: Dict[str, Any]) -> Dict[str, Dict[str, float]]:
+        """Get the pose's control values mirrored to the opposite side"""
+        mirrored: Dict[str, Dict[str, float]] = {}
+        for control, attributes in pose.get("controls", {}).items():
+            opposite = mirror_control_name(control)
+            if opposite is None:
+                mirrored[control] = {
+                    attribute: -value if attribute in MIRROR_NEGATE_ATTRIBUTES else value
+                    for attribute, value in attributes.items()
+                }
+            else:
+                # Sided controls on a symmetrical rig share orientation with their pair
+                mirrored[opposite] = dict(attributes)
+        return mirrored
+
+    def apply_pose(
+        self,
+        cmds: Any,
+        pose: Dict[str, Any],
+        namespace: str,
+        blend: float = 1.0,
+        mirror: bool = False,
+        controls: Optional[List[str]] = None,
+    ) -> PoseApplyResult:
+        """
+        Set pose values on the rig in the given namespace
+
+        Args:
+            cmds: maya.cmds module
+            pose: Pose data from load_pose
+            namespace: Target rig namespace ("" for root)
+            blend: 0.0 keeps the current pose, 1.0 applies the stored pose fully
+            mirror: Apply to the opposite side
+            controls: Limit to these controls (namespace-free names), None for all
+
+        Returns:
+            Applied attribute count and channels missing on the target rig
+        """
+        result = PoseApplyResult()
+        blend = max(0.0, min(1.0, blend))
+        values = self.mirror_pose(pose) if mirror else pose.get("controls", {})
+        only = {strip_namespace(control) for control in controls} if controls else None
+
+        for control, attributes in values.items():
+            if only is not None and control not in only:
+                continue
+            target = remap_node(control, namespace)
+            for attribute, value in attributes.items():
+                plug = f"{target}.{attribute}"
+                if not cmds.objExists(plug):
+                    result.missing.append(plug)
+                    continue
+                if blend < 1.0:
+                    current = float(cmds.getAttr(plug))
+                    value = current + (value - current) * blend
+                try:
+                    cmds.setAttr(plug, value)
+                    result.applied_attributes += 1
+                except Exception as e:
+                    # Connected or locked on this rig even though it was free on the source
+                    self.logger.warning(f"Could not set {plug}: {e}")
+
+        print(
+            f"[OK] Applied pose {pose.get('name', '')} to namespace '{namespace or ':'}' "
+            f"at {blend:.0%}{' mirrored' if mirror else ''}: {result.applied_attributes} "
+            f"attributes, {len(result.missing)} missing"
+        )
+        return result
+
+    # Thumbnails -------------------------------------------------------------------------
+
+    def capture_thumbnail(self, cmds: Any, pose_path: Path, size: int = 256) -> Optional[Path]:
+        """Playblast the current frame into the library thumbnail folder"""
+        return playblast_thumbnail(cmds, pose_path, cmds.currentTime(query=True), size)
+
+
+# Singleton instance factory
+_pose_service_instance = None
+
+
+def get_pose_service() -> PoseService:
+    """
+    Get singleton instance of PoseService.
+
+    Returns:
+        PoseService: Singleton service instance
+    """
+    global _pose_service_instance
+    if _pose_service_instance is None:
+        _pose_service_instance = PoseService()
+    return _pose_service_instance
@@ -0,0 +1,553 @@
+# -*- coding: utf-8 -*-
+"""
+Proxy Service Implementation
+Publish GPU cache, bounding box, or Arnold standin proxies of an asset and swap them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Proxies are exported at publish time into a hidden folder next to the asset::
+
+    assets/scenes/forest.ma                            <- full geometry
+    assets/scenes/.proxies/forest/forest_gpu.abc       <- GPU cache
+    assets/scenes/.proxies/forest/forest_bbox.ma       <- one box per mesh
+    assets/scenes/.proxies/forest/forest_standin.ass   <- Arnold standin (or .usd)
+    assets/scenes/.proxies/forest/proxies.json
+
+Imported proxies are tagged with their asset so a swap can bring in the full asset
+at the same place and later put the proxy back. The render swap hooks into the
+scene's pre/post render MEL, so batch renders of a saved scene swap as well.
+Standins are left out of it, since Arnold renders the full geometry they load.
+"""
+
+import json
+import logging
+from dataclasses import dataclass
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.proxy_representation import (
+    PROXY_GPU_CACHE,
+    PROXY_KINDS,
+    PROXY_LABELS,
+    PROXY_STANDIN,
+    STANDIN_FORMATS,
+    ProxyRepresentation,
+)
+from .dependency_service_impl import get_dependency_service
+from .version_service_impl import get_current_user
+
+PROXIES_DIR_NAME = ".proxies"
+MANIFEST_FILE_NAME = "proxies.json"
+
+# String attributes on the top-level transform of an imported proxy or its swap
+ASSET_ATTRIBUTE = "assetManagerProxyAsset"
+KIND_ATTRIBUTE = "assetManagerProxyKind"
+STATE_ATTRIBUTE = "assetManagerProxyState"
+
+STATE_PROXY = "proxy"
+STATE_FULL = "full"  # Swapped by hand - stays until restored
+STATE_RENDER = "render"  # Swapped for a render - restored once it finishes
+
+MAYA_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+# arnoldExportAss node mask for standins: shapes and shaders, no camera, lights, or options
+STANDIN_ASS_MASK = 0x08 | 0x10
+
+RENDER_GLOBALS = "defaultRenderGlobals"
+_PLUGIN_DIR = Path(__file__).resolve().parents[2].as_posix()
+
+
+def _render_hook(function: str) -> str:
+    """Get the MEL line that runs one of the render functions below"""
+    return (
+        f"python(\"import sys; p = r'{_PLUGIN_DIR}'; p in sys.path or sys.path.insert(0, p); "
+        f'from src.services.proxy_service_impl import {function}; {function}()")'
+    )
+
+
+PRE_RENDER_HOOK = _render_hook("swap_proxies_for_render")
+POST_RENDER_HOOK = _render_hook("restore_proxies_after_render")
+
+
+@dataclass(frozen=True)
+class SceneProxy:
+    """Proxy of a library asset in the scene, or the full asset swapped in for it"""
+
+    asset_file: Path
+    kind: str
+    node: str  # Top-level transform
+    state: str = STATE_PROXY
+
+    @property
+    def is_full(self) -> bool:
+        """Check if the full geometry currently stands in for the proxy"""
+        return self.state != STATE_PROXY
+
+    @property
+    def label(self) -> str:
+        """Get display text (forest - GPU cache, full geometry)"""
+        showing = "full geometry" if self.is_full else "proxy"
+        return f"{self.asset_file.stem} - {PROXY_LABELS.get(self.kind, self.kind)}, {showing}"
+
+
+class ProxyService:
+    """
+    Proxy Service - Single Responsibility for viewport proxies of assets
+    Scene access goes through the cmds argument so proxy swaps can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Proxies ----------------------------------------------------------------------------
+
+    def get_proxy_directory(self, asset_file: Path) -> Path:
+        """Get the folder an asset's proxies are stored in"""
+        asset_file = Path(asset_file)
+        return asset_file.parent / PROXIES_DIR_NAME / asset_file.stem
+
+    def get_proxy_path(
+        self, asset_file: Path, kind: str, standin_format: str = STANDIN_FORMATS[0]
+    ) -> Path:
+        """Get the file a proxy kind of an asset is published to"""
+        asset_file = Path(asset_file)
+        suffix = {PROXY_GPU_CACHE: ".abc", PROXY_STANDIN: standin_format}.get(
+            kind, asset_file.suffix
+        )
+        return self.get_proxy_directory(asset_file) / f"{asset_file.stem}_{kind}{suffix}"
+
+    def get_proxies(self, asset_file: Path) -> List[ProxyRepresentation]:
+        """Get the published proxies of an asset, GPU cache first"""
+        proxies: List[ProxyRepresentation] = []
+        for kind, entry in self._read_manifest(asset_file).get("proxies", {}).items():
+            published = entry.get("published")
+            proxy = ProxyRepresentation(
+                kind,
+                self.get_proxy_directory(asset_file) / entry.get("file", ""),
+                author=entry.get("author", ""),
+                published=datetime.fromisoformat(published) if published else None,
+            )
+            if proxy.exists and kind in PROXY_KINDS:
+                proxies.append(proxy)
+        return sorted(proxies, key=lambda proxy: PROXY_KINDS.index(proxy.kind))
+
+    def has_proxy(self, asset_file: Path) -> bool:
+        """Check if an asset has a proxy to import"""
+        return bool(self.get_proxies(asset_file))
+
+    def get_proxy_files(self, asset_file: Path) -> List[Path]:
+        """Get the proxy files and manifest, to version together with the asset"""
+        proxy_dir = self.get_proxy_directory(asset_file)
+        if not proxy_dir.is_dir():
+            return []
+        return sorted(path for path in proxy_dir.iterdir() if path.is_file())
+
+    def generate(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        kind: str,
+        selection: List[str],
+        author: Optional[str] = None,
+        standin_format: str = STANDIN_FORMATS[0],
+    ) -> Path:
+        """
+        Export a proxy of the published selection
+
+        Args:
+            cmds: maya.cmds module
+            asset_file: Library asset (.ma / .mb) the proxy stands in for
+            kind: PROXY_GPU_CACHE, PROXY_BOUNDING_BOX, or PROXY_STANDIN
+            selection: Nodes the asset was exported from
+            author: Artist recorded with the proxy (current user when omitted)
+            standin_format: File a standin loads, .ass or .usd
+
+        Returns:
+            Written proxy file
+
+        Raises:
+            ValueError: For unknown kinds or formats, non-Maya assets, or an empty selection
+        """
+        asset_file = Path(asset_file)
+        if kind not in PROXY_KINDS:
+            raise ValueError(f"Unknown proxy kind '{kind}'")
+        if kind == PROXY_STANDIN and standin_format not in STANDIN_FORMATS:
+            raise ValueError(f"Arnold standins cannot load {standin_format} files")
+        if asset_file.suffix.lower() not in MAYA_FILE_TYPES:
+            raise ValueError(f"{asset_file.suffix} assets cannot swap to proxies")
+        if not selection:
+            raise ValueError("Select the nodes to build the proxy from")
+
+        proxy_file = self.get_proxy_path(asset_file, kind, standin_format)
+        proxy_file.parent.mkdir(parents=True, exist_ok=True)
+        if kind == PROXY_GPU_CACHE:
+            self._export_gpu_cache(cmds, proxy_file, selection)
+        elif kind == PROXY_STANDIN:
+            self._export_standin(cmds, proxy_file, selection)
+        else:
+            self._export_bounding_boxes(cmds, proxy_file, selection)
+        if not proxy_file.is_file():
+            raise RuntimeError(f"Export did not write {proxy_file.name}")
+        if kind == PROXY_STANDIN:
+            # A standin in the other format would otherwise be versioned with the asset
+            for other_format in STANDIN_FORMATS:
+                stale = self.get_proxy_path(asset_file, kind, other_format)
+                if stale != proxy_file and stale.is_file():
+                    stale.unlink()
+
+        manifest = self._read_manifest(asset_file)
+        manifest.setdefault("proxies", {})[kind] = {
+            "file": proxy_file.name,
+            "author": author or get_current_user(),
+            "published": datetime.now().isoformat(),
+        }
+        self._write_manifest(asset_file, manifest)
+        print(f"[OK] Generated {PROXY_LABELS[kind]} proxy of {asset_file.stem}")
+        return proxy_file
+
+    def _export_gpu_cache(self, cmds: Any, proxy_file: Path, selection: List[str]) -> None:
+        """Write the selection at the current frame as a GPU cache"""
+        self._load_plugin(cmds, "gpuCache")
+        frame = cmds.currentTime(query=True)
+        cmds.gpuCache(
+            *selection,
+            startTime=frame,
+            endTime=frame,
+            optimize=True,
+            writeMaterials=True,
+            dataFormat="ogawa",
+            directory=proxy_file.parent.as_posix(),
+            fileName=proxy_file.stem,
+        )
+
+    def _export_standin(self, cmds: Any, proxy_file: Path, selection: List[str]) -> None:
+        """Write the selection with its shaders as an Arnold scene or USD file"""
+        cmds.select(selection, replace=True)
+        if proxy_file.suffix == ".usd":
+            # Arnold's USD procedural renders the preview surfaces
+            self._load_plugin(cmds, "mayaUsdPlugin")
+            cmds.mayaUSDExport(
+                file=proxy_file.as_posix(),
+                selection=True,
+                shadingMode="useRegistry",
+                convertMaterialsTo=["UsdPreviewSurface"],
+            )
+        else:
+            self._load_plugin(cmds, "mtoa")
+            cmds.arnoldExportAss(
+                filename=proxy_file.as_posix(),
+                selected=True,
+                mask=STANDIN_ASS_MASK,
+                lightLinks=False,
+                shadowLinks=False,
+                boundingBox=True,
+            )
+
+    def _export_bounding_boxes(self, cmds: Any, proxy_file: Path, selection: List[str]) -> None:
+        """Write one box per mesh of the selection, grouped under a single root"""
+        meshes = [
+            mesh
+            for mesh in cmds.listRelatives(
+                selection, allDescendents=True, type="mesh", fullPath=True
+            )
+            or []
+            if not cmds.getAttr(f"{mesh}.intermediateObject")
+        ]
+        if not meshes:
+            raise ValueError("The selection holds no meshes to box")
+
+        boxes = []
+        for mesh in meshes:
+            x_min, y_min, z_min, x_max, y_max, z_max = cmds.exactWorldBoundingBox(mesh)
+            name = mesh.rsplit("|", 1)[-1].rsplit(":", 1)[-1]
+            box = cmds.polyCube(
+                width=max(x_max - x_min, 0.001),
+                height=max(y_max - y_min, 0.001),
+                depth=max(z_max - z_min, 0.001),
+                name=f"{name}_bbox",
+                constructionHistory=False,
+            )[0]
+            cmds.xform(
+                box,
+                translation=((x_min + x_max) / 2, (y_min + y_max) / 2, (z_min + z_max) / 2),
+                worldSpace=True,
+            )
+            boxes.append(box)
+
+        root = cmds.group(boxes, name=proxy_file.stem, world=True)
+        try:
+            cmds.select(root, replace=True)
+            cmds.file(
+                str(proxy_file),
+                exportSelected=True,
+                type=MAYA_FILE_TYPES[proxy_file.suffix.lower()],
+                force=True,
+            )
+        finally:
+            cmds.delete(root)
+
+    # Scene ------------------------------------------------------------------------------
+
+    def import_proxy(self, cmds: Any, asset_file: Path, kind: Optional[str] = None) -> str:
+        """
+        Bring an asset into the scene as its proxy
+
+        Args:
+            kind: Proxy to load (the preferred published one when omitted)
+
+        Returns:
+            Top-level transform of the proxy
+        """
+        proxies = {proxy.kind: proxy for proxy in self.get_proxies(asset_file)}
+        if not proxies:
+            raise RuntimeError(f"{Path(asset_file).stem} has no proxy")
+        proxy = proxies.get(kind) if kind else next(iter(proxies.values()))
+        if proxy is None:
+            raise RuntimeError(f"{Path(asset_file).stem} has no {PROXY_LABELS[kind]} proxy")
+
+        if proxy.kind == PROXY_GPU_CACHE:
+            self._load_plugin(cmds, "gpuCache")
+            name = f"{Path(asset_file).stem}_proxy"
+            root = cmds.createNode("transform", name=name)
+            shape = cmds.createNode("gpuCache", name=f"{name}Shape", parent=root)
+            cmds.setAttr(f"{shape}.cacheFileName", proxy.file_path.as_posix(), type="string")
+        elif proxy.kind == PROXY_STANDIN:
+            self._load_plugin(cmds, "mtoa")
+            name = f"{Path(asset_file).stem}_standin"
+            root = cmds.createNode("transform", name=name)
+            shape = cmds.createNode("aiStandIn", name=f"{name}Shape", parent=root)
+            cmds.setAttr(f"{shape}.dso", proxy.file_path.as_posix(), type="string")
+        else:
+            before = set(cmds.ls(assemblies=True, long=True) or [])
+            cmds.file(
+                str(proxy.file_path),
+                i=True,
+                type=MAYA_FILE_TYPES[proxy.file_path.suffix.lower()],
+                returnNewNodes=True,
+            )
+            roots = [
+                node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before
+            ]
+            root = roots[0] if len(roots) == 1 else cmds.group(roots, name="proxy", world=True)
+
+        self._tag_root(cmds, root, asset_file, proxy.kind, STATE_PROXY)
+        print(f"[OK] Imported {Path(asset_file).stem} as {proxy.label} proxy")
+        return root
+
+    def find_scene_proxies(self, cmds: Any) -> List[SceneProxy]:
+        """Get the proxies in the scene, and full assets swapped in for proxies"""
+        scene_proxies = []
+        tagged = cmds.ls(f"*.{KIND_ATTRIBUTE}", objectsOnly=True, long=True, recursive=True)
+        for node in tagged or []:
+            if cmds.referenceQuery(node, isNodeReferenced=True):
+                continue
+            scene_proxies.append(
+                SceneProxy(
+                    Path(cmds.getAttr(f"{node}.{ASSET_ATTRIBUTE}")),
+                    cmds.getAttr(f"{node}.{KIND_ATTRIBUTE}"),
+                    node,
+                    cmds.getAttr(f"{node}.{STATE_ATTRIBUTE}") or STATE_PROXY,
+                )
+            )
+        return sorted(scene_proxies, key=lambda scene_proxy: scene_proxy.label)
+
+    def swap_to_full(
+        self, cmds: Any, scene_proxy: SceneProxy, state: str = STATE_FULL
+    ) -> SceneProxy:
+        """
+        Replace a proxy with the full asset, under the same parent and placement
+
+        Args:
+            state: STATE_RENDER marks swaps the post-render restore should undo
+
+        Returns:
+            The group holding the full asset
+        """
+        if scene_proxy.is_full:
+            return scene_proxy
+        asset_file = scene_proxy.asset_file
+        if not asset_file.is_file():
+            raise RuntimeError(f"{asset_file.name} is missing - cannot swap in full geometry")
+
+        cmds.undoInfo(openChunk=True, chunkName=f"Swap {asset_file.stem} to full geometry")
+        try:
+            parents, matrix = self._take_placement(cmds, scene_proxy.node)
+            before = set(cmds.ls(assemblies=True, long=True) or [])
+            new_nodes = cmds.file(
+                str(asset_file),
+                i=True,
+                type=MAYA_FILE_TYPES[asset_file.suffix.lower()],
+                returnNewNodes=True,
+            )
+            get_dependency_service().resolve_relative_paths(asset_file, list(new_nodes or []))
+            roots = [
+                node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before
+            ]
+            group = cmds.group(roots, name=f"{asset_file.stem}_full", world=True)
+            group = self._place(cmds, group, parents, matrix)
+            self._tag_root(cmds, group, asset_file, scene_proxy.kind, state)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+        print(f"[OK] Swapped {asset_file.stem} proxy -> full geometry")
+        return SceneProxy(asset_file, scene_proxy.kind, group, state)
+
+    def swap_to_proxy(self, cmds: Any, scene_proxy: SceneProxy) -> SceneProxy:
+        """
+        Put the proxy back in place of a full asset swapped in earlier
+
+        Returns:
+            The proxy after the swap
+        """
+        if not scene_proxy.is_full:
+            return scene_proxy
+        asset_file = scene_proxy.asset_file
+
+        cmds.undoInfo(openChunk=True, chunkName=f"Swap {asset_file.stem} to proxy")
+        try:
+            parents, matrix = self._take_placement(cmds, scene_proxy.node)
+            root = self.import_proxy(cmds, asset_file, scene_proxy.kind)
+            root = self._place(cmds, root, parents, matrix)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+        print(f"[OK] Swapped {asset_file.stem} full geometry -> proxy")
+        return SceneProxy(asset_file, scene_proxy.kind, root)
+
+    def swap_all(self, cmds: Any, to_full: bool, state: str = STATE_FULL) -> int:
+        """
+        Swap every proxy in the scene to full geometry, or every swapped asset back
+
+        Returns:
+            Number of assets swapped
+        """
+        swapped = 0
+        for scene_proxy in self.find_scene_proxies(cmds):
+            if state == STATE_RENDER and scene_proxy.kind == PROXY_STANDIN:
+                continue  # Arnold renders the geometry a standin loads
+            try:
+                if to_full and not scene_proxy.is_full:
+                    self.swap_to_full(cmds, scene_proxy, state)
+                elif not to_full and scene_proxy.is_full:
+                    if state == STATE_RENDER and scene_proxy.state != STATE_RENDER:
+                        continue  # Swapped by hand - leave it as the artist set it
+                    self.swap_to_proxy(cmds, scene_proxy)
+                else:
+                    continue
+                swapped += 1
+            except Exception as e:
+                print(f"[WARNING] Could not swap {scene_proxy.label}: {e}")
+        return swapped
+
+    def _take_placement(self, cmds: Any, node: str) -> Tuple[List[str], List[float]]:
+        """Remove a node, returning its parent and world matrix for its replacement"""
+        parents = cmds.listRelatives(node, parent=True, fullPath=True) or []
+        matrix = cmds.xform(node, query=True, matrix=True, worldSpace=True)
+        cmds.delete(node)
+        return parents, matrix
+
+    def _place(self, cmds: Any, node: str, parents: List[str], matrix: List[float]) -> str:
+        """Move a replacement node to where the node it replaces was"""
+        if parents:
+            node = (cmds.parent(node, parents[0]) or [node])[0]
+        cmds.xform(node, matrix=matrix, worldSpace=True)
+        return node
+
+    def _tag_root(self, cmds: Any, root: str, asset_file: Path, kind: str, state: str) -> None:
+        """Record asset, proxy kind, and what is showing on a top-level transform"""
+        for attribute, value in (
+            (ASSET_ATTRIBUTE, Path(asset_file).as_posix()),
+            (KIND_ATTRIBUTE, kind),
+            (STATE_ATTRIBUTE, state),
+        ):
+            if not cmds.attributeQuery(attribute, node=root, exists=True):
+                cmds.addAttr(root, longName=attribute, dataType="string")
+            cmds.setAttr(f"{root}.{attribute}", value, type="string")
+
+    # Render swap ------------------------------------------------------------------------
+
+    def is_render_swap_installed(self, cmds: Any) -> bool:
+        """Check if the scene swaps proxies to full geometry when it renders"""
+        return PRE_RENDER_HOOK in (cmds.getAttr(f"{RENDER_GLOBALS}.preMel") or "")
+
+    def install_render_swap(self, cmds: Any) -> None:
+        """Swap proxies to full geometry before each render and back after it"""
+        for attribute, hook in (("preMel", PRE_RENDER_HOOK), ("postMel", POST_RENDER_HOOK)):
+            script = cmds.getAttr(f"{RENDER_GLOBALS}.{attribute}") or ""
+            if hook in script:
+                continue
+            script = f"{script.rstrip().rstrip(';')}; {hook}" if script.strip() else hook
+            cmds.setAttr(f"{RENDER_GLOBALS}.{attribute}", script, type="string")
+        print("[OK] Proxies swap to full geometry at render time")
+
+    def remove_render_swap(self, cmds: Any) -> None:
+        """Stop swapping proxies at render time, keeping other render scripts"""
+        for attribute, hook in (("preMel", PRE_RENDER_HOOK), ("postMel", POST_RENDER_HOOK)):
+            script = cmds.getAttr(f"{RENDER_GLOBALS}.{attribute}") or ""
+            if hook not in script:
+                continue
+            parts = [part.strip() for part in script.split(hook)]
+            script = "; ".join(part.strip(";").strip() for part in parts if part.strip(";"))
+            cmds.setAttr(f"{RENDER_GLOBALS}.{attribute}", script, type="string")
+        print("[OK] Proxies no longer swap at render time")
+
+    def _load_plugin(self, cmds: Any, plugin: str) -> None:
+        """Load a Maya plugin the proxy needs"""
+        if cmds.pluginInfo(plugin, query=True, loaded=True):
+            return
+        if not cmds.loadPlugin(plugin, quiet=True):
+            raise RuntimeError(f"{plugin} plugin not available")
+
+    # Manifest ---------------------------------------------------------------------------
+
+    def _read_manifest(self, asset_file: Path) -> Dict[str, Any]:
+        """Read an asset's proxy manifest, empty when it has no proxies"""
+        manifest_file = self.get_proxy_directory(asset_file) / MANIFEST_FILE_NAME
+        if not manifest_file.is_file():
+            return {}
+        try:
+            with open(manifest_file, "r", encoding="utf-8") as f:
+                return json.load(f)
+        except Exception as e:
+            self.logger.warning(f"Unreadable proxy manifest {manifest_file}: {e}")
+            return {}
+
+    def _write_manifest(self, asset_file: Path, manifest: Dict[str, Any]) -> None:
+        """Write an asset's proxy manifest"""
+        manifest_file = self.get_proxy_directory(asset_file) / MANIFEST_FILE_NAME
+        manifest_file.parent.mkdir(parents=True, exist_ok=True)
+        with open(manifest_file, "w", encoding="utf-8") as f:
+            json.dump(manifest, f, indent=2)
+
+
+def swap_proxies_for_render() -> None:
+    """Pre-render MEL entry point - swap every proxy to full geometry"""
+    import maya.cmds as cmds  # type: ignore
+
+    count = get_proxy_service().swap_all(cmds, to_full=True, state=STATE_RENDER)
+    if count:
+        print(f"[OK] Swapped {count} proxy(s) to full geometry for rendering")
+
+
+def restore_proxies_after_render() -> None:
+    """Post-render MEL entry point - put back the proxies swapped for the render"""
+    import maya.cmds as cmds  # type: ignore
+
+    get_proxy_service().swap_all(cmds, to_full=False, state=STATE_RENDER)
+
+
+# Singleton instance factory
+_proxy_service_instance = None
+
+
+def get_proxy_service() -> ProxyService:
+    """
+    Get singleton instance of ProxyService.
+
+    Returns:
+        ProxyService: Singleton service instance
+    """
+    global _proxy_service_instance
+    if _proxy_service_instance is None:
+        _proxy_service_instance = ProxyService()
+    return _proxy_service_instance
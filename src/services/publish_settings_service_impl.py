@@ -0,0 +1,238 @@
+# -*- coding: utf-8 -*-
+"""
+Publish Settings Service Implementation
+Remember what the publish dialog was filled with, per asset and per artist
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Every publish stores its dialog values twice: the export options become the artist's
+defaults for the next new asset, and all values (name, tags, thumbnail camera, ...)
+are kept with the asset's library metadata. Publishing again from the same work scene
+pre-fills the dialog with the asset's values, so an iterative publish is two clicks::
+
+    ~/.assetmanager/publish_settings.json
+    {
+      "defaults": {"format": ".mb", "include_materials": true, ...},
+      "scenes": {"D:/work/crate_v012.ma": "D:/library/Props/crate/crate.mb"}
+    }
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Dict, Optional
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+PUBLISH_SETTINGS_METADATA_KEY = "publish_settings"
+
+# Thumbnail cameras are presets (top) or scene cameras (scene:|cameras|hero_cam)
+SCENE_CAMERA_PREFIX = "scene:"
+
+# Dialog values that carry over to the artist's next new asset
+OPTION_KEYS = (
+    "format",
+    "export_selected",
+    "generate_thumbnail",
+    "include_materials",
+    "collect_dependencies",
+    "create_package",
+    "send_to_unreal",
+    "proxy",
+    "standin",
+)
+
+# Dialog values only the asset itself is published with again
+ASSET_KEYS = ("name", "category", "description", "tags", "thumbnail_camera")
+
+# Work scenes remembered, oldest dropped first
+MAX_SCENES = 200
+
+
+def extract_settings(asset_data: Dict[str, Any], with_asset_values: bool = True) -> Dict[str, Any]:
+    """
+    Get the values of a publish dialog that are remembered
+
+    Args:
+        asset_data: Values the publish dialog returned
+        with_asset_values: Also keep the asset's own values (name, tags, thumbnail
+            camera), not only the export options
+
+    Returns:
+        Remembered values; options the dialog left out are stored off, so publishing
+        again does not turn them back on
+    """
+    settings: Dict[str, Any] = {key: asset_data.get(key) for key in OPTION_KEYS}
+    settings["send_to_unreal"] = bool(settings["send_to_unreal"])
+    alembic = asset_data.get("alembic")
+    if alembic:
+        # The frame range follows the scene, the sampling stays with the artist
+        settings["alembic"] = {
+            "step": alembic.get("step", 1.0),
+            "world_space": alembic.get("world_space", True),
+        }
+    if with_asset_values:
+        settings.update({key: asset_data.get(key) for key in ASSET_KEYS})
+        settings["tags"] = list(settings["tags"] or [])
+    return settings
+
+
+def merge_settings(*layers: Optional[Dict[str, Any]]) -> Dict[str, Any]:
+    """Merge dialog defaults, the values of later layers winning"""
+    merged: Dict[str, Any] = {}
+    for layer in layers:
+        merged.update(layer or {})
+    return merged
+
+
+class PublishSettingsService:
+    """
+    Publish Settings Service - Single Responsibility for remembered publish dialog values
+    Artist defaults and work scenes are per user, asset values live with the library
+    """
+
+    def __init__(self, settings_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._settings_file = settings_file or USER_CONFIG_DIR / "publish_settings.json"
+        self._data: Dict[str, Any] = self._load()
+
+    # Artist defaults --------------------------------------------------------------------
+
+    def get_user_defaults(self) -> Dict[str, Any]:
+        """Get the export options of the artist's last publish"""
+        return dict(self._data["defaults"])
+
+    def get_scene_asset(self, scene_file: Optional[Path]) -> Optional[Path]:
+        """
+        Get the asset a work scene was last published as
+
+        Returns:
+            The asset file while it still exists, else None
+        """
+        if not scene_file:
+            return None
+        asset_file = self._data["scenes"].get(self._scene_key(scene_file))
+        if asset_file and Path(asset_file).is_file():
+            return Path(asset_file)
+        return None
+
+    # Asset values -----------------------------------------------------------------------
+
+    def get_asset_settings(self, database: Any, asset_file: Path) -> Dict[str, Any]:
+        """Get the dialog values an asset was last published with, {} if none are stored"""
+        if database is None:
+            return {}
+        try:
+            metadata = database.get_asset_metadata(Path(asset_file)) or {}
+        except Exception as e:
+            self.logger.warning(f"Could not read metadata of {Path(asset_file).name}: {e}")
+            return {}
+        return dict(metadata.get(PUBLISH_SETTINGS_METADATA_KEY) or {})
+
+    def get_dialog_defaults(
+        self,
+        database: Any = None,
+        asset_file: Optional[Path] = None,
+        template_defaults: Optional[Dict[str, Any]] = None,
+    ) -> Dict[str, Any]:
+        """
+        Get the values the publish dialog opens with
+
+        Args:
+            database: Library metadata database holding the asset's values
+            asset_file: Asset being published again, None for a new asset
+            template_defaults: Values of the asset template the scene came from
+
+        Returns:
+            The artist's defaults, overridden by the template, overridden by the values
+            the asset was last published with
+        """
+        asset_settings = self.get_asset_settings(database, asset_file) if asset_file else {}
+        return merge_settings(self.get_user_defaults(), template_defaults, asset_settings)
+
+    def remember_publish(
+        self,
+        asset_data: Dict[str, Any],
+        asset_file: Path,
+        scene_file: Optional[Path] = None,
+        database: Any = None,
+    ) -> bool:
+        """
+        Remember a publish's dialog values for the asset, the artist, and the work scene
+
+        Args:
+            asset_data: Values the publish dialog returned
+            asset_file: Published asset file
+            scene_file: Work scene published from, None for an untitled scene
+            database: Library metadata database; without one only the artist's defaults
+                are kept
+
+        Returns:
+            True if the artist's settings file was written
+        """
+        asset_file = Path(asset_file)
+        if database is not None:
+            try:
+                metadata = database.get_asset_metadata(asset_file) or {}
+                metadata[PUBLISH_SETTINGS_METADATA_KEY] = extract_settings(asset_data)
+                database.save_asset_metadata(asset_file, metadata)
+            except Exception as e:
+                print(f"[WARNING] Could not store publish settings of {asset_file.name}: {e}")
+
+        self._data["defaults"] = extract_settings(asset_data, with_asset_values=False)
+        if scene_file:
+            scenes = self._data["scenes"]
+            scenes.pop(self._scene_key(scene_file), None)  # Most recent last
+            scenes[self._scene_key(scene_file)] = str(asset_file)
+            for stale in list(scenes)[:-MAX_SCENES]:
+                del scenes[stale]
+        return self._save()
+
+    # Storage ----------------------------------------------------------------------------
+
+    def _scene_key(self, scene_file: Path) -> str:
+        """Get the key a work scene is remembered under"""
+        return Path(scene_file).as_posix()
+
+    def _load(self) -> Dict[str, Any]:
+        """Read the artist's settings file"""
+        data: Dict[str, Any] = {"defaults": {}, "scenes": {}}
+        if not self._settings_file.exists():
+            return data
+        try:
+            with open(self._settings_file, "r", encoding="utf-8") as f:
+                stored = json.load(f)
+            if isinstance(stored, dict):
+                data["defaults"] = dict(stored.get("defaults") or {})
+                data["scenes"] = dict(stored.get("scenes") or {})
+        except Exception as e:
+            self.logger.warning(f"Could not read publish settings: {e}")
+        return data
+
+    def _save(self) -> bool:
+        """Write the artist's settings file"""
+        try:
+            self._settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._settings_file, "w", encoding="utf-8") as f:
+                json.dump(self._data, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save publish settings: {e}")
+            return False
+
+
+# Singleton instance factory
+_publish_settings_instance = None
+
+
+def get_publish_settings_service() -> PublishSettingsService:
+    """
+    Get singleton instance of PublishSettingsService.
+
+    Returns:
+        PublishSettingsService: Singleton service instance
+    """
+    global _publish_settings_instance
+    if _publish_settings_instance is None:
+        _publish_settings_instance = PublishSettingsService()
+    return _publish_settings_instance
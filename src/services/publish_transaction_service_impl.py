@@ -0,0 +1,346 @@
+# -*- coding: utf-8 -*-
+"""
+Publish Transaction Service Implementation
+Crash-safe publishing: outputs are staged, verified, then moved into the library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A publish writes the asset and every file that travels with it into a hidden staging
+folder next to the asset, laid out as they will be in the asset folder. Only when all
+outputs exist and are not empty are they moved into place - companions first, the
+asset file last - and the version is registered::
+
+    assets/scenes/.staging/car-1a2b3c4d/transaction.json   <- state of the publish
+    assets/scenes/.staging/car-1a2b3c4d/car.ma
+    assets/scenes/.staging/car-1a2b3c4d/car.fbx
+    assets/scenes/.staging/car-1a2b3c4d/.dependencies/car/textures/paint.png
+
+Hidden folders are never listed, so a crash mid-publish leaves the library as it was.
+When a library is opened, interrupted commits are finished and staging folders that
+were abandoned are deleted (recover).
+"""
+
+import json
+import logging
+import os
+import shutil
+import socket
+import uuid
+from dataclasses import dataclass, field
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .version_service_impl import get_current_user, get_version_service
+
+STAGING_DIR_NAME = ".staging"
+TRANSACTION_FILE_NAME = "transaction.json"
+
+STATE_STAGING = "staging"  # Outputs are being written
+STATE_COMMITTING = "committing"  # Outputs are being moved into the asset folder
+STATE_COMMITTED = "committed"  # Outputs are in place, the version may still be missing
+
+# Another session may still be writing a staging folder younger than this
+STALE_STAGING_AGE = timedelta(hours=6)
+# Moving files takes seconds; a commit older than this was interrupted
+STALE_COMMIT_AGE = timedelta(minutes=5)
+
+
+class PublishIncomplete(Exception):
+    """Raised when staged outputs are missing or empty and nothing was moved"""
+
+
+@dataclass
+class PublishTransaction:
+    """A publish being staged for one asset file"""
+
+    asset_file: Path  # Where the asset is published to
+    staging_dir: Path  # Hidden folder the outputs are written into first
+    notes: str = ""  # Version notes, used when a crash interrupts the commit
+    register_version: bool = True  # Whether a version snapshot follows the commit
+    state: str = STATE_STAGING
+    moved: List[Path] = field(default_factory=list)  # Files committed into the library
+
+    @property
+    def staged_file(self) -> Path:
+        """Get the path exports write the asset file to"""
+        return self.staging_dir / self.asset_file.name
+
+    def get_staged_path(self, target: Path) -> Path:
+        """Get where an output bound for the asset folder is written"""
+        return self.staging_dir / Path(target).relative_to(self.asset_file.parent)
+
+    def get_target_path(self, staged: Path) -> Path:
+        """Get where a staged output ends up (paths outside staging are returned as is)"""
+        try:
+            return self.asset_file.parent / Path(staged).relative_to(self.staging_dir)
+        except ValueError:
+            return Path(staged)
+
+    def get_staged_files(self) -> List[Path]:
+        """Get the staged outputs, companions first and the asset file last"""
+        bookkeeping = {TRANSACTION_FILE_NAME, f"{TRANSACTION_FILE_NAME}.tmp"}
+        files = [
+            path
+            for path in sorted(self.staging_dir.rglob("*"))
+            if path.is_file()
+            and path != self.staged_file
+            and not (path.parent == self.staging_dir and path.name in bookkeeping)
+        ]
+        if self.staged_file.is_file():
+            files.append(self.staged_file)
+        return files
+
+
+class PublishTransactionService:
+    """
+    Publish Transaction Service - Single Responsibility for staged, all-or-nothing publishes
+    Interactive and batch publishes both stage through it so a crash never shows in listings
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        self._active: Dict[Path, PublishTransaction] = {}  # Staging folder -> this session's
+
+    # Transactions -----------------------------------------------------------------------
+
+    def begin(
+        self, asset_file: Path, notes: str = "", register_version: bool = True
+    ) -> PublishTransaction:
+        """
+        Create the staging folder of a publish
+
+        Args:
+            asset_file: Asset file the publish replaces or creates
+            notes: Version notes
+            register_version: Whether the caller registers a version after the commit
+        """
+        asset_file = Path(asset_file)
+        staging_dir = (
+            asset_file.parent / STAGING_DIR_NAME / f"{asset_file.stem}-{uuid.uuid4().hex[:8]}"
+        )
+        staging_dir.mkdir(parents=True)
+        transaction = PublishTransaction(asset_file, staging_dir, notes, register_version)
+        self._active[staging_dir] = transaction
+        self._write_state(transaction)
+        return transaction
+
+    def verify(
+        self, transaction: PublishTransaction, expected: Optional[List[Path]] = None
+    ) -> List[str]:
+        """
+        Get why staged outputs cannot be committed, [] if they can
+
+        Args:
+            expected: Outputs the publish wrote besides the asset file (staged paths)
+        """
+        problems = []
+        for path in [transaction.staged_file] + list(expected or []):
+            if not Path(path).is_file():
+                problems.append(f"{Path(path).name} was not written")
+        for path in transaction.get_staged_files():
+            if path.stat().st_size == 0:
+                problems.append(f"{path.name} is empty")
+        return problems
+
+    def commit(
+        self, transaction: PublishTransaction, expected: Optional[List[Path]] = None
+    ) -> List[Path]:
+        """
+        Verify the staged outputs and move them into the asset folder
+
+        Returns:
+            Files now in the library, the asset file last
+
+        Raises:
+            PublishIncomplete: If an output is missing or empty (nothing is moved)
+        """
+        problems = self.verify(transaction, expected)
+        if problems:
+            raise PublishIncomplete("; ".join(problems))
+
+        targets = [transaction.get_target_path(path) for path in transaction.get_staged_files()]
+        transaction.state = STATE_COMMITTING
+        self._write_state(transaction, targets)
+        self._move_staged_files(transaction)
+        transaction.state = STATE_COMMITTED
+        self._write_state(transaction)
+        print(
+            f"[OK] Committed {transaction.asset_file.name} "
+            f"({len(transaction.moved)} file(s)) into the library"
+        )
+        return list(transaction.moved)
+
+    def finish(self, transaction: PublishTransaction) -> None:
+        """Remove the staging folder once the version is registered"""
+        self._active.pop(transaction.staging_dir, None)
+        self._remove_staging_dir(transaction.staging_dir)
+
+    def rollback(self, transaction: PublishTransaction) -> None:
+        """
+        Drop a publish, discarding outputs not committed yet
+
+        A commit that failed part way is left on disk instead: some of its files are in
+        the library already and the rest only exist in staging, so recover() finishes it.
+        """
+        if transaction.state == STATE_COMMITTING:
+            self._active.pop(transaction.staging_dir, None)
+            print(
+                f"[WARNING] The publish of {transaction.asset_file.name} stopped part way "
+                "through its commit; it is completed when the library is opened again"
+            )
+            return
+        if transaction.state == STATE_STAGING:
+            print(f"[WARNING] Discarded the unfinished publish of {transaction.asset_file.name}")
+        self.finish(transaction)
+
+    # Recovery ---------------------------------------------------------------------------
+
+    def find_staging_dirs(self, library_root: Path) -> List[Path]:
+        """Get the staging folders of a library's publishes"""
+        return sorted(
+            path for path in Path(library_root).rglob(f"{STAGING_DIR_NAME}/*") if path.is_dir()
+        )
+
+    def recover(self, library_root: Optional[Path], now: Optional[datetime] = None) -> List[str]:
+        """
+        Finish interrupted commits and delete abandoned staging folders
+
+        Staging folders younger than STALE_STAGING_AGE are left alone, as another artist's
+        session may still be publishing into them.
+
+        Returns:
+            What was done, one line per publish
+        """
+        if library_root is None:
+            return []
+        now = now or datetime.now()
+        actions = []
+        for staging_dir in self.find_staging_dirs(library_root):
+            if staging_dir in self._active:
+                continue  # This session is publishing into it
+            try:
+                action = self._recover_staging_dir(staging_dir, now)
+            except Exception as e:
+                self.logger.warning(f"Could not recover publish staging {staging_dir}: {e}")
+                continue
+            if action:
+                actions.append(action)
+        for action in actions:
+            print(f"[INFO] {action}")
+        return actions
+
+    # Internals --------------------------------------------------------------------------
+
+    def _recover_staging_dir(self, staging_dir: Path, now: datetime) -> str:
+        """Finish or delete one staging folder, "" when it is left alone"""
+        state = self._read_state(staging_dir)
+        updated = datetime.fromtimestamp(staging_dir.stat().st_mtime)
+        if state and state.get("updated"):
+            updated = datetime.fromisoformat(state["updated"])
+        age = now - updated
+
+        if not state or state.get("state") == STATE_STAGING:
+            if age < STALE_STAGING_AGE:
+                return ""
+            self._remove_staging_dir(staging_dir)
+            name = state["asset_file"] if state else staging_dir.name
+            return f"Removed the unfinished publish of {Path(name).name}"
+
+        if age < STALE_COMMIT_AGE:
+            return ""
+        transaction = PublishTransaction(
+            Path(state["asset_file"]),
+            staging_dir,
+            state.get("notes", ""),
+            bool(state.get("register_version", True)),
+            state["state"],
+        )
+        if transaction.state == STATE_COMMITTING:
+            self._move_staged_files(transaction)
+
+        # Versioned after the commit, so a crash in between leaves the version to register
+        version = None
+        if transaction.register_version:
+            companions = [
+                Path(path)
+                for path in state.get("files", [])
+                if Path(path) != transaction.asset_file and Path(path).is_file()
+            ]
+            version = get_version_service().publish_version(
+                transaction.asset_file,
+                notes=transaction.notes,
+                author=state.get("user"),
+                companion_files=companions,
+            )
+        self._remove_staging_dir(staging_dir)
+        label = f" as {version.label}" if version else ""
+        return f"Completed the interrupted publish of {transaction.asset_file.name}{label}"
+
+    def _move_staged_files(self, transaction: PublishTransaction) -> None:
+        """Move staged outputs into the asset folder, one atomic rename per file"""
+        for staged in transaction.get_staged_files():
+            target = transaction.get_target_path(staged)
+            target.parent.mkdir(parents=True, exist_ok=True)
+            os.replace(staged, target)
+            transaction.moved.append(target)
+
+    def _write_state(
+        self, transaction: PublishTransaction, files: Optional[List[Path]] = None
+    ) -> None:
+        """Write the transaction file recovery reads after a crash"""
+        state_file = transaction.staging_dir / TRANSACTION_FILE_NAME
+        state = self._read_state(transaction.staging_dir) or {
+            "asset_file": transaction.asset_file.as_posix(),
+            "notes": transaction.notes,
+            "register_version": transaction.register_version,
+            "user": get_current_user(),
+            "host": socket.gethostname(),
+            "started": datetime.now().isoformat(),
+        }
+        state["state"] = transaction.state
+        state["updated"] = datetime.now().isoformat()
+        if files is not None:
+            state["files"] = [path.as_posix() for path in files]
+        temporary = state_file.with_name(f"{TRANSACTION_FILE_NAME}.tmp")
+        with open(temporary, "w", encoding="utf-8") as f:
+            json.dump(state, f, indent=2)
+        os.replace(temporary, state_file)
+
+    def _read_state(self, staging_dir: Path) -> Optional[Dict[str, Any]]:
+        """Read a staging folder's transaction file, None if it has none"""
+        state_file = staging_dir / TRANSACTION_FILE_NAME
+        if not state_file.is_file():
+            return None
+        try:
+            with open(state_file, "r", encoding="utf-8") as f:
+                return json.load(f)
+        except Exception as e:
+            self.logger.warning(f"Unreadable publish transaction {state_file}: {e}")
+            return None
+
+    def _remove_staging_dir(self, staging_dir: Path) -> None:
+        """Delete a staging folder, and the .staging folder once it is empty"""
+        shutil.rmtree(staging_dir, ignore_errors=True)
+        try:
+            staging_dir.parent.rmdir()
+        except OSError:
+            pass  # Other publishes are staging next to it
+
+
+# Singleton instance factory
+_publish_transaction_service_instance = None
+
+
+def get_publish_transaction_service() -> PublishTransactionService:
+    """
+    Get singleton instance of PublishTransactionService.
+
+    Returns:
+        PublishTransactionService: Singleton service instance
+    """
+    global _publish_transaction_service_instance
+    if _publish_transaction_service_instance is None:
+        _publish_transaction_service_instance = PublishTransactionService()
+    return _publish_transaction_service_instance
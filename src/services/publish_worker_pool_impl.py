@@ -0,0 +1,169 @@
+# -*- coding: utf-8 -*-
+"""
+Publish Worker Pool Implementation
+Farms the exports of a batch publish to a pool of mayapy worker processes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The artist's scene is saved once as a snapshot; every worker opens it and exports
+one asset into a staging folder. Versioning and the library database stay in the
+calling session, so only the slow part runs in parallel::
+
+    <temp>/assetManager_publish_xxxx/scene.mb        <- snapshot of the open scene
+    <temp>/assetManager_publish_xxxx/000_Chair.json  <- job read by export_batch.py
+    <temp>/assetManager_publish_xxxx/000_Chair.ma    <- exported asset, copied on success
+"""
+
+import json
+import logging
+import os
+import subprocess
+from concurrent.futures import ThreadPoolExecutor, as_completed
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Callable, List, Optional
+
+from .thumbnail_queue_impl import find_mayapy
+
+EXPORT_SCRIPT = Path(__file__).with_name("export_batch.py")
+SNAPSHOT_NAME = "scene.mb"
+
+# Each worker is a full Maya session, so leave cores and memory for the artist's own
+DEFAULT_WORKERS = max(1, min(4, (os.cpu_count() or 2) // 2))
+
+
+@dataclass
+class ExportJob:
+    """Single asset export run by a worker"""
+
+    name: str
+    nodes: List[str]
+    output: Path  # Staging file the worker writes
+    file_type: str = "mayaAscii"
+    status: str = "queued"  # queued -> running -> done / failed, or cancelled
+    error: str = ""
+
+    @property
+    def job_file(self) -> Path:
+        """Get the JSON file describing the job to the worker"""
+        return self.output.with_suffix(".json")
+
+
+class PublishWorkerPool:
+    """
+    Publish Worker Pool - Single Responsibility for parallel batch exports
+    Each job runs in its own mayapy process, so one failing asset never stops the others
+    """
+
+    def __init__(
+        self,
+        max_workers: int = DEFAULT_WORKERS,
+        runner: Optional[Callable[[Path, ExportJob], bool]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._max_workers = max_workers
+        self._has_runner = runner is not None
+        self._runner = runner or self._run_export_job
+
+    def is_available(self) -> bool:
+        """Check if workers can be started (mayapy found, or a runner was given)"""
+        return self._has_runner or find_mayapy() is not None
+
+    def run(
+        self,
+        scene: Path,
+        jobs: List[ExportJob],
+        progress: Optional[Callable[[int, ExportJob], bool]] = None,
+        max_workers: Optional[int] = None,
+    ) -> List[ExportJob]:
+        """
+        Export every job from a scene snapshot, several at a time
+
+        Args:
+            scene: Snapshot every worker opens
+            jobs: Exports to run
+            progress: Called in the calling thread as each job finishes, with the number
+                finished so far; return False to cancel the jobs not started yet
+            max_workers: Worker processes, defaults to the pool's size
+
+        Returns:
+            The jobs, each done, failed, or cancelled
+        """
+        if not jobs:
+            return jobs
+        workers = max(1, min(max_workers or self._max_workers, len(jobs)))
+        with ThreadPoolExecutor(max_workers=workers, thread_name_prefix="publish") as executor:
+            futures = {executor.submit(self._process, scene, job): job for job in jobs}
+            finished = 0
+            stopped = False
+            for future in as_completed(futures):
+                if stopped or future.cancelled():
+                    continue
+                finished += 1
+                if progress is not None and not progress(finished, futures[future]):
+                    stopped = True
+                    for pending, job in futures.items():
+                        if pending.cancel():
+                            job.status = "cancelled"
+        return jobs
+
+    def build_export_command(self, job: ExportJob, mayapy: Path) -> List[str]:
+        """Build the mayapy command line for a job"""
+        return [str(mayapy), str(EXPORT_SCRIPT), "--job", str(job.job_file)]
+
+    def _process(self, scene: Path, job: ExportJob) -> None:
+        """Run one job, recording failures on the job instead of raising"""
+        job.status = "running"
+        try:
+            success = self._runner(scene, job)
+            job.status = "done" if success else "failed"
+        except Exception as e:
+            job.status = "failed"
+            job.error = str(e)
+
+        if job.status == "failed":
+            print(f"[ERROR] Export worker failed for {job.name}: {job.error}")
+
+    def _run_export_job(self, scene: Path, job: ExportJob) -> bool:
+        """Export a job in a separate mayapy process"""
+        mayapy = find_mayapy()
+        if mayapy is None:
+            job.error = "mayapy not found (set MAYA_LOCATION)"
+            return False
+
+        with open(job.job_file, "w", encoding="utf-8") as f:
+            json.dump(
+                {
+                    "scene": str(scene),
+                    "output": str(job.output),
+                    "file_type": job.file_type,
+                    "nodes": list(job.nodes),
+                },
+                f,
+            )
+        result = subprocess.run(
+            self.build_export_command(job, mayapy), capture_output=True, text=True, check=False
+        )
+        if result.returncode != 0 or not job.output.exists():
+            output = (result.stdout + result.stderr).strip().splitlines()
+            job.error = output[-1] if output else f"mayapy exited with {result.returncode}"
+            return False
+        return True
+
+
+# Singleton instance factory
+_publish_worker_pool_instance = None
+
+
+def get_publish_worker_pool() -> PublishWorkerPool:
+    """
+    Get singleton instance of PublishWorkerPool.
+
+    Returns:
+        PublishWorkerPool: Singleton pool instance
+    """
+    global _publish_worker_pool_instance
+    if _publish_worker_pool_instance is None:
+        _publish_worker_pool_instance = PublishWorkerPool()
+    return _publish_worker_pool_instance
@@ -0,0 +1,462 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Update Service Implementation
+Find scene references that are behind the latest published version of their asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A reference either points at the asset file itself or at a version snapshot::
+
+    assets/scenes/crate.ma                        <- live, loaded at the version current then
+    assets/scenes/.versions/crate/v002/crate.ma   <- pinned to v002
+    assets/scenes/.lods/crate/crate_proxy.ma      <- LOD, follows the asset like a live one
+
+Pinned references know their version from the path. Live references are noted at
+the latest version when first scanned, so a publish made while the scene is open
+shows up as an update. Updating reloads the reference from the asset file (or its
+LOD), keeping the reference node and its edits.
+
+Edits on nodes or attributes the new version renamed or removed fail to apply. The
+update reports the edits that failed only since the reload, so each can be kept (it
+applies again if a later version brings the node back), discarded, or retargeted::
+
+    setAttr "crate:lid.rotateX" 45   ->   crate:lid_geo.rotateX
+"""
+
+import difflib
+import logging
+import re
+import shlex
+from dataclasses import dataclass, replace
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.asset_version import format_version_label
+from ..core.models.reference_edit import (
+    EDIT_DISCARD,
+    EDIT_KEEP,
+    EDIT_RETARGET,
+    ReferenceEdit,
+)
+from .lod_service_impl import get_lod_service
+from .maya_integration_impl import REFERENCE_FILE_TYPES
+from .version_service_impl import VERSIONS_DIR_NAME, get_version_service
+
+_VERSION_FOLDER = re.compile(r"v(\d+)")
+_NUMBER = re.compile(r"-?\d+(\.\d*)?(e-?\d+)?")
+
+
+def parse_reference_edit(edit: str, namespace: str = "") -> Tuple[str, str]:
+    """
+    Get the command of a reference edit and the node or plug it changes
+
+    Args:
+        edit: MEL edit string (setAttr "crate:lid.rotateX" 45)
+        namespace: Namespace of the reference, whose nodes are the edit's targets
+
+    Returns:
+        (command, target), target "" when the edit names no node
+    """
+    try:
+        tokens = shlex.split(edit.strip().rstrip(";"))
+    except ValueError:
+        tokens = edit.strip().rstrip(";").split()
+    if not tokens:
+        return "", ""
+    command, arguments = tokens[0], tokens[1:]
+    # Flags and their values are never node names; node names hold no spaces
+    names = [
+        token
+        for token in arguments
+        if not token.startswith("-") and not _NUMBER.fullmatch(token) and " " not in token
+    ]
+    if namespace:
+        for token in names:
+            if f"{namespace}:" in token:
+                return command, token
+    for token in names:
+        if ":" in token or "|" in token or "." in token:
+            return command, token
+    return command, names[-1] if names else ""
+
+
+@dataclass(frozen=True)
+class ReferenceUpdate:
+    """Scene reference and how far it is behind its library asset"""
+
+    reference_node: str
+    namespace: str
+    file_path: Path  # File the reference loads
+    asset_file: Path  # Library asset it belongs to
+    loaded_version: int
+    latest_version: int
+    latest_author: str = ""
+    pinned: bool = False  # Points at a version snapshot
+    lost_edits: Tuple[ReferenceEdit, ...] = ()  # Edits that stopped applying on update
+
+    @property
+    def is_outdated(self) -> bool:
+        """Check if a newer version was published than the one loaded"""
+        return self.loaded_version < self.latest_version
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate:crateRN v002 -> v004)"""
+        return (
+            f"{self.namespace or self.asset_file.stem}:{self.reference_node} "
+            f"{format_version_label(self.loaded_version)} -> "
+            f"{format_version_label(self.latest_version)}"
+        )
+
+    @property
+    def update_path(self) -> Path:
+        """Get the file the reference is reloaded from to get the latest version"""
+        return self.asset_file if self.pinned else self.file_path
+
+
+class ReferenceUpdateService:
+    """
+    Reference Update Service - Single Responsibility for outdated reference detection
+    Scene access goes through the cmds argument so scans can be tested without Maya
+    """
+
+    def __init__(self, version_service=None, lod_service=None):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+        self._lod_service = lod_service or get_lod_service()
+        # (reference node, file) -> version a live reference was loaded at
+        self._loaded_versions: Dict[Tuple[str, str], int] = {}
+        self._callbacks: List[Any] = []
+
+    # Scene callbacks --------------------------------------------------------------------
+
+    def install(self, on_scene_changed: Callable[[], None]) -> bool:
+        """
+        Track scene opens and reference reloads, which load the latest versions
+
+        Args:
+            on_scene_changed: Called after a scene is opened or created
+
+        Returns:
+            True if the callbacks are registered
+        """
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+        except ImportError:
+            return False
+
+        self.uninstall()
+
+        def scene_changed(*_args):
+            self.forget()
+            on_scene_changed()
+
+        def reference_loaded(reference_node, _referenced_file, *_args):
+            name = om.MFnDependencyNode(reference_node).name()
+            self.forget(name)
+
+        messages = om.MSceneMessage
+        try:
+            self._callbacks = [
+                messages.addCallback(messages.kAfterOpen, scene_changed),
+                messages.addCallback(messages.kAfterNew, scene_changed),
+                messages.addReferenceCallback(messages.kAfterLoadReference, reference_loaded),
+            ]
+        except Exception as e:
+            self.logger.warning(f"Failed to register reference update callbacks: {e}")
+            self.uninstall()
+            return False
+        return True
+
+    def uninstall(self) -> None:
+        """Stop tracking scene opens and reference reloads"""
+        if not self._callbacks:
+            return
+        try:
+            import maya.api.OpenMaya as om  # type: ignore
+
+            om.MMessage.removeCallbacks(self._callbacks)
+        except Exception as e:
+            self.logger.warning(f"Failed to remove reference update callbacks: {e}")
+        self._callbacks = []
+
+    def forget(self, reference_node: Optional[str] = None) -> None:
+        """Drop noted live versions (of one reference node), e.g. after it was reloaded"""
+        self._loaded_versions = {
+            key: number
+            for key, number in self._loaded_versions.items()
+            if reference_node is not None and key[0] != reference_node
+        }
+
+    # References -------------------------------------------------------------------------
+
+    def resolve(self, file_path: Path) -> Tuple[Path, Optional[int]]:
+        """
+        Get the library asset a referenced file belongs to
+
+        Returns:
+            (asset file, pinned version number or None for live files)
+        """
+        file_path = Path(file_path)
+        version_match = _VERSION_FOLDER.fullmatch(file_path.parent.name)
+        if version_match and file_path.parent.parent.parent.name == VERSIONS_DIR_NAME:
+            asset_file = file_path.parent.parent.parent.parent / file_path.name
+            return asset_file, int(version_match.group(1))
+
+        identified = self._lod_service.identify(file_path)
+        if identified is not None:
+            return identified[0], None
+        return file_path, None
+
+    def scan(self, cmds: Any) -> List[ReferenceUpdate]:
+        """
+        Compare every top-level reference with its asset's version history
+
+        Args:
+            cmds: maya.cmds module
+
+        Returns:
+            References of versioned assets, outdated or not
+        """
+        updates = []
+        for reference_file in cmds.file(query=True, reference=True) or []:
+            try:
+                file_path = Path(
+                    cmds.referenceQuery(reference_file, filename=True, withoutCopyNumber=True)
+                )
+                reference_node = cmds.referenceQuery(reference_file, referenceNode=True)
+                namespace = cmds.referenceQuery(reference_file, namespace=True, shortName=True)
+            except Exception as e:
+                self.logger.warning(f"Skipping unreadable reference {reference_file}: {e}")
+                continue
+
+            asset_file, pinned_version = self.resolve(file_path)
+            latest = self._version_service.get_latest_version(asset_file)
+            if latest is None:
+                continue
+
+            if pinned_version is None:
+                key = (reference_node, file_path.as_posix())
+                loaded_version = self._loaded_versions.setdefault(key, latest.number)
+            else:
+                loaded_version = pinned_version
+
+            updates.append(
+                ReferenceUpdate(
+                    reference_node=reference_node,
+                    namespace=namespace or "",
+                    file_path=file_path,
+                    asset_file=asset_file,
+                    loaded_version=loaded_version,
+                    latest_version=latest.number,
+                    latest_author=latest.author,
+                    pinned=pinned_version is not None,
+                )
+            )
+        return updates
+
+    def get_outdated(self, cmds: Any) -> List[ReferenceUpdate]:
+        """Get the references that are behind their asset's latest version"""
+        return [update for update in self.scan(cmds) if update.is_outdated]
+
+    def update(self, cmds: Any, reference: ReferenceUpdate) -> ReferenceUpdate:
+        """
+        Reload a reference at its asset's latest version, keeping reference edits
+
+        Returns:
+            The reference after the update, with the edits that no longer apply
+        """
+        target = reference.update_path
+        extension = target.suffix.lower()
+        if not target.is_file() or extension not in REFERENCE_FILE_TYPES:
+            raise RuntimeError(f"Cannot update {reference.reference_node} from {target.name}")
+
+        # Edits failing before the update are not the new version's doing
+        failed_before = set(self._get_failed_edit_strings(cmds, reference.reference_node))
+        cmds.file(
+            str(target),
+            loadReference=reference.reference_node,
+            type=REFERENCE_FILE_TYPES[extension],
+        )
+        self._loaded_versions[(reference.reference_node, target.as_posix())] = (
+            reference.latest_version
+        )
+        lost_edits = []
+        for failed in self.get_failed_edits(cmds, reference.reference_node, reference.namespace):
+            new_edits = tuple(edit for edit in failed.edits if edit not in failed_before)
+            if new_edits:
+                lost_edits.append(replace(failed, edits=new_edits))
+        if lost_edits:
+            count = sum(len(failed.edits) for failed in lost_edits)
+            print(f"[WARNING] Updated {reference.label}, {count} reference edit(s) failed")
+        else:
+            print(f"[OK] Updated {reference.label}")
+        return replace(
+            reference,
+            file_path=target,
+            loaded_version=reference.latest_version,
+            pinned=False,
+            lost_edits=tuple(lost_edits),
+        )
+
+    def update_all(
+        self, cmds: Any, references: List[ReferenceUpdate]
+    ) -> Tuple[List[ReferenceUpdate], List[str]]:
+        """
+        Update every outdated reference
+
+        Returns:
+            (updated references, error messages of the ones that failed)
+        """
+        updated, errors = [], []
+        for reference in references:
+            if not reference.is_outdated:
+                continue
+            try:
+                updated.append(self.update(cmds, reference))
+            except Exception as e:
+                errors.append(f"{reference.label}: {e}")
+        return updated, errors
+
+    # Reference edits --------------------------------------------------------------------
+
+    def get_failed_edits(
+        self, cmds: Any, reference_node: str, namespace: str = ""
+    ) -> List[ReferenceEdit]:
+        """
+        Get the edits of a reference that do not apply, by target and command
+
+        Args:
+            cmds: maya.cmds module
+            reference_node: Reference node whose edits are read
+            namespace: Namespace of the reference, to find the edits' targets
+        """
+        grouped: Dict[Tuple[str, str], List[str]] = {}
+        for edit in self._get_failed_edit_strings(cmds, reference_node):
+            command, target = parse_reference_edit(edit, namespace)
+            if command and target:
+                grouped.setdefault((target, command), []).append(edit)
+        failed = []
+        for (target, command), edits in grouped.items():
+            node = target.split(".", 1)[0]
+            failed.append(
+                ReferenceEdit(
+                    reference_node=reference_node,
+                    command=command,
+                    target=target,
+                    edits=tuple(edits),
+                    node_exists=bool(cmds.objExists(node)),
+                )
+            )
+        return failed
+
+    def suggest_targets(self, cmds: Any, edit: ReferenceEdit, limit: int = 5) -> List[str]:
+        """
+        Get the nodes (or attributes) an edit most likely meant, closest name first
+
+        Renamed nodes are looked for in the namespace of the node the edit names;
+        removed attributes among the attributes of the node, which is still there.
+        """
+        if edit.node_exists and edit.attribute:
+            attributes = cmds.listAttr(edit.node) or []
+            matches = difflib.get_close_matches(edit.attribute, attributes, limit, 0.5)
+            return [f"{edit.node}.{attribute}" for attribute in matches]
+
+        short_name = edit.node.rsplit("|", 1)[-1]
+        namespace = short_name.rsplit(":", 1)[0] if ":" in short_name else ""
+        candidates = cmds.ls(f"{namespace}:*" if namespace else "*") or []
+        by_name = {name.rsplit(":", 1)[-1]: name for name in candidates}
+        matches = difflib.get_close_matches(short_name.rsplit(":", 1)[-1], by_name, limit, 0.5)
+        suffix = f".{edit.attribute}" if edit.attribute else ""
+        return [f"{by_name[match]}{suffix}" for match in matches]
+
+    def resolve_edits(
+        self, cmds: Any, choices: List[Tuple[ReferenceEdit, str, str]]
+    ) -> Tuple[List[ReferenceEdit], List[str]]:
+        """
+        Keep, discard, or retarget failed reference edits
+
+        Args:
+            cmds: maya.cmds module
+            choices: (edit, EDIT_* action, new target for EDIT_RETARGET)
+
+        Returns:
+            (retargeted edits that still do not apply, error messages)
+        """
+        errors: List[str] = []
+        retargeted: Dict[str, List[ReferenceEdit]] = {}  # reference node -> retargeted
+        for edit, action, new_target in choices:
+            if action == EDIT_KEEP:
+                continue
+            try:
+                if action == EDIT_DISCARD:
+                    cmds.referenceEdit(
+                        edit.target,
+                        failedEdits=True,
+                        successfulEdits=False,
+                        removeEdits=True,
+                        editCommand=edit.command,
+                    )
+                elif action == EDIT_RETARGET:
+                    new_target = new_target.strip()
+                    if not new_target:
+                        raise ValueError("choose the node the edits go to")
+                    cmds.referenceEdit(
+                        edit.reference_node,
+                        changeEditTarget=(edit.target, new_target),
+                        failedEdits=True,
+                        successfulEdits=False,
+                        editCommand=edit.command,
+                    )
+                    retargeted.setdefault(edit.reference_node, []).append(
+                        replace(edit, target=new_target)
+                    )
+                else:
+                    raise ValueError(f"unknown action '{action}'")
+            except Exception as e:
+                errors.append(f"{edit.label}: {e}")
+
+        # Retargeted edits are applied when the reference loads again
+        still_failed = []
+        for reference_node, edits in retargeted.items():
+            try:
+                cmds.file(loadReference=reference_node)
+            except Exception as e:
+                errors.append(f"{reference_node}: could not reload to apply edits: {e}")
+                continue
+            failed = self._get_failed_edit_strings(cmds, reference_node)
+            still_failed += [
+                edit
+                for edit in edits
+                if any(f.startswith(edit.command) and edit.target in f for f in failed)
+            ]
+        return still_failed, errors
+
+    def _get_failed_edit_strings(self, cmds: Any, reference_node: str) -> List[str]:
+        """Get the MEL strings of a reference's edits that do not apply"""
+        try:
+            edit_strings = cmds.referenceQuery(
+                reference_node, editStrings=True, failedEdits=True, successfulEdits=False
+            )
+        except Exception as e:
+            self.logger.warning(f"Could not read the failed edits of {reference_node}: {e}")
+            return []
+        return list(edit_strings or [])
+
+
+# Singleton instance factory
+_reference_update_service_instance = None
+
+
+def get_reference_update_service() -> ReferenceUpdateService:
+    """
+    Get singleton instance of ReferenceUpdateService.
+
+    Returns:
+        ReferenceUpdateService: Singleton service instance
+    """
+    global _reference_update_service_instance
+    if _reference_update_service_instance is None:
+        _reference_update_service_instance = ReferenceUpdateService()
+    return _reference_update_service_instance
@@ -0,0 +1,462 @@
+# -*- coding: utf-8 -*-
+"""
+Render Farm Service Implementation
+Send heavy publish steps - turntables, USD conversions - to Deadline or Tractor
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Each submission leaves a job record in the library, and the asset shows as processing
+until the farm task writes a result next to it. The library must sit on storage the
+farm nodes mount at the same path::
+
+    <library>/.assetmanager/farm/3f9c0a1b2d4e.json         <- written at submission
+    <library>/.assetmanager/farm/3f9c0a1b2d4e.result.json  <- written by farm_batch.py
+
+Deadline jobs run through its CommandLine plugin (``deadlinecommand``), Tractor jobs
+are spooled as an Alfred script (``tractor-spool``). Farm nodes with Maya installed
+somewhere else than the artist's machine get their mayapy from ASSETMANAGER_FARM_MAYAPY.
+"""
+
+import json
+import logging
+import os
+import re
+import shlex
+import shutil
+import subprocess
+import sys
+import tempfile
+import uuid
+from dataclasses import replace
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional
+
+from ..core.interfaces.render_farm import IRenderFarm
+from ..core.models.farm_job import (
+    FARM_STATE_COMPLETED,
+    FARM_STATE_FAILED,
+    FARM_STEP_TURNTABLE,
+    FARM_STEP_USD,
+    FARM_STEPS,
+    FarmJob,
+)
+from .path_mapping_service_impl import get_path_mapping_service
+from .thumbnail_queue_impl import ThumbnailJob, get_thumbnail_queue, get_turntable_path
+from .version_service_impl import get_current_user
+
+SETTINGS_DIR_NAME = ".assetmanager"
+FARM_DIR_NAME = "farm"
+RESULT_SUFFIX = ".result.json"
+FARM_TASK_SCRIPT = Path(__file__).with_name("farm_batch.py")
+FARM_MAYAPY_ENVIRONMENT = "ASSETMANAGER_FARM_MAYAPY"
+
+# Scenes the USD conversion step can open; USD assets need no conversion
+USD_SOURCE_TYPES = {".ma", ".mb"}
+
+FARM_DEADLINE = "deadline"
+FARM_TRACTOR = "tractor"
+FARM_LABELS = {FARM_DEADLINE: "Deadline", FARM_TRACTOR: "Tractor"}
+
+DEADLINE_COMMAND = "deadlinecommand"
+TRACTOR_SPOOL = "tractor-spool"
+TRACTOR_DEFAULT_SERVICE = "PixarRender"
+
+_DEADLINE_JOB_ID = re.compile(r"JobID=(\S+)")
+_TRACTOR_JOB_ID = re.compile(r"jid[:=]\s*(\d+)")
+
+# Runs a client command line, returns its output; raises RenderFarmError on failure
+FarmRunner = Callable[[List[str]], str]
+
+
+class RenderFarmError(Exception):
+    """Exception raised when a farm refuses a job"""
+
+
+def _run_client(command: List[str]) -> str:
+    """Run a farm client and return its output"""
+    try:
+        result = subprocess.run(command, capture_output=True, text=True, check=False)
+    except OSError as e:
+        raise RenderFarmError(f"{command[0]} could not be started: {e}")
+    output = (result.stdout + result.stderr).strip()
+    if result.returncode != 0:
+        lines = output.splitlines()
+        raise RenderFarmError(
+            lines[-1] if lines else f"{command[0]} exited with {result.returncode}"
+        )
+    return output
+
+
+def _join_arguments(arguments: List[str]) -> str:
+    """Quote arguments into one command line string for the farm node's platform"""
+    if sys.platform == "win32":
+        return subprocess.list2cmdline(arguments)
+    return shlex.join(arguments)
+
+
+def _tcl_word(text: str) -> str:
+    """Quote a word for an Alfred script"""
+    return "{" + text.replace("{", "\\{").replace("}", "\\}") + "}"
+
+
+class DeadlineFarm(IRenderFarm):
+    """
+    Deadline Farm - Single Responsibility for Deadline CommandLine submissions
+    Commands go through a runner so submissions can be tested without a repository
+    """
+
+    def __init__(self, runner: Optional[FarmRunner] = None, executable: str = ""):
+        self._runner = runner or _run_client
+        self._executable = executable or self._find_executable()
+
+    def _find_executable(self) -> str:
+        """Get deadlinecommand from DEADLINE_PATH (set by the client installer) or PATH"""
+        deadline_path = os.environ.get("DEADLINE_PATH")
+        if deadline_path:
+            candidate = Path(deadline_path) / DEADLINE_COMMAND
+            if candidate.with_suffix(".exe").is_file() or candidate.is_file():
+                return str(candidate)
+        return DEADLINE_COMMAND
+
+    def is_available(self) -> bool:
+        """Check if deadlinecommand can be found"""
+        return Path(self._executable).is_file() or shutil.which(self._executable) is not None
+
+    def submit(self, title: str, command: List[str], pool: str = "", priority: int = 50) -> str:
+        """Submit through job and plugin info files"""
+        job_info = {"Plugin": "CommandLine", "Name": title, "Priority": str(priority)}
+        if pool:
+            job_info["Pool"] = pool
+        plugin_info = {
+            "Executable": command[0],
+            "Arguments": _join_arguments(command[1:]),
+            "Shell": "False",
+        }
+        folder = Path(tempfile.mkdtemp(prefix="assetManager_deadline_"))
+        try:
+            job_file = folder / "job_info.job"
+            plugin_file = folder / "plugin_info.job"
+            job_file.write_text(
+                "".join(f"{key}={value}\n" for key, value in job_info.items()), encoding="utf-8"
+            )
+            plugin_file.write_text(
+                "".join(f"{key}={value}\n" for key, value in plugin_info.items()),
+                encoding="utf-8",
+            )
+            output = self._runner([self._executable, str(job_file), str(plugin_file)])
+        finally:
+            shutil.rmtree(folder, ignore_errors=True)
+
+        match = _DEADLINE_JOB_ID.search(output)
+        if match is None:
+            lines = output.strip().splitlines()
+            raise RenderFarmError(lines[-1] if lines else "Deadline did not return a job id")
+        return match.group(1)
+
+
+class TractorFarm(IRenderFarm):
+    """
+    Tractor Farm - Single Responsibility for Tractor Alfred script submissions
+    The engine comes from TRACTOR_ENGINE, as for every other tractor-spool call
+    """
+
+    def __init__(self, runner: Optional[FarmRunner] = None, executable: str = TRACTOR_SPOOL):
+        self._runner = runner or _run_client
+        self._executable = executable
+
+    def is_available(self) -> bool:
+        """Check if tractor-spool can be found"""
+        return shutil.which(self._executable) is not None
+
+    def build_script(self, title: str, command: List[str], pool: str, priority: int) -> str:
+        """Get the Alfred script of a one task job"""
+        service = _tcl_word(pool or TRACTOR_DEFAULT_SERVICE)
+        arguments = " ".join(_tcl_word(argument) for argument in command)
+        return (
+            f"Job -title {_tcl_word(title)} -priority {priority} -service {service} -subtasks {{\n"
+            f"    Task -title {_tcl_word(title)} -cmds {{\n"
+            f"        RemoteCmd {{{arguments}}} -service {service}\n"
+            "    }\n"
+            "}\n"
+        )
+
+    def submit(self, title: str, command: List[str], pool: str = "", priority: int = 50) -> str:
+        """Spool an Alfred script"""
+        folder = Path(tempfile.mkdtemp(prefix="assetManager_tractor_"))
+        try:
+            script = folder / "job.alf"
+            script.write_text(self.build_script(title, command, pool, priority), encoding="utf-8")
+            output = self._runner([self._executable, str(script)])
+        finally:
+            shutil.rmtree(folder, ignore_errors=True)
+
+        match = _TRACTOR_JOB_ID.search(output)
+        if match is None:
+            lines = output.strip().splitlines()
+            raise RenderFarmError(lines[-1] if lines else "Tractor did not return a job id")
+        return match.group(1)
+
+
+class RenderFarmService:
+    """
+    Render Farm Service - Single Responsibility for farm jobs of library assets
+    The job records in the library are the source of truth; nothing is cached
+    """
+
+    def __init__(self, farms: Optional[Dict[str, IRenderFarm]] = None):
+        self.logger = logging.getLogger(__name__)
+        self._farms: Dict[str, IRenderFarm] = farms or {
+            FARM_DEADLINE: DeadlineFarm(),
+            FARM_TRACTOR: TractorFarm(),
+        }
+
+    # Farms ------------------------------------------------------------------------------
+
+    def get_farm_names(self) -> List[str]:
+        """Get the names of the farms submissions can go to"""
+        return list(self._farms)
+
+    def get_available_farms(self) -> List[str]:
+        """Get the farms whose submission client is installed here"""
+        return [name for name, farm in self._farms.items() if farm.is_available()]
+
+    def get_task_mayapy(self, mayapy: Optional[Path]) -> str:
+        """Get the mayapy farm nodes run, the artist's own unless set for the farm"""
+        return os.environ.get(FARM_MAYAPY_ENVIRONMENT) or str(mayapy or "mayapy")
+
+    # Submission -------------------------------------------------------------------------
+
+    def get_jobs_dir(self, library_root: Path) -> Path:
+        """Get the folder of a library's job records"""
+        return Path(library_root) / SETTINGS_DIR_NAME / FARM_DIR_NAME
+
+    def get_steps(self, asset_path: Path) -> List[str]:
+        """Get the steps that apply to an asset"""
+        if Path(asset_path).suffix.lower() in USD_SOURCE_TYPES:
+            return list(FARM_STEPS)
+        return [FARM_STEP_TURNTABLE]
+
+    def get_outputs(
+        self, asset_path: Path, step: str, turntable_format: str = ".mp4"
+    ) -> List[Path]:
+        """Get the files a step writes for an asset"""
+        asset_path = Path(asset_path)
+        if step == FARM_STEP_TURNTABLE:
+            # The still is rendered again too, so it matches the turntable's first frame
+            still_path = ThumbnailJob(asset_path).still_path
+            return [get_turntable_path(asset_path, turntable_format), still_path]
+        return [asset_path.with_suffix(".usd")]
+
+    def build_task_command(
+        self,
+        library_root: Path,
+        job: FarmJob,
+        mayapy: Optional[Path] = None,
+        turntable_format: str = ".mp4",
+        frames: int = 36,
+        **thumbnail_options: Any,
+    ) -> List[str]:
+        """
+        Build the command line a farm node runs for a job
+
+        Args:
+            library_root: Library of the job record
+            job: Job being submitted
+            mayapy: mayapy of the artist's Maya
+            turntable_format: ".gif" or ".mp4" for turntable jobs
+            frames: Turntable frame count
+            **thumbnail_options: size, settings, and color of the turntable render
+        """
+        task_mayapy = self.get_task_mayapy(mayapy)
+        command = [
+            task_mayapy,
+            str(FARM_TASK_SCRIPT),
+            "--jobs-dir",
+            str(self.get_jobs_dir(library_root)),
+            "--key",
+            job.key,
+            "--step",
+            job.step,
+            "--input",
+            str(job.asset_path),
+        ]
+        if job.step == FARM_STEP_USD:
+            return command + ["--output", str(job.outputs[0])]
+
+        thumbnail_job = ThumbnailJob(job.asset_path, turntable_format, frames, **thumbnail_options)
+        batch = get_thumbnail_queue().build_batch_command(thumbnail_job, Path(task_mayapy))
+        return command + ["--"] + batch[2:]
+
+    def submit(
+        self,
+        library_root: Path,
+        asset_path: Path,
+        step: str,
+        farm_name: str,
+        mayapy: Optional[Path] = None,
+        pool: str = "",
+        priority: int = 50,
+        user: Optional[str] = None,
+        **options: Any,
+    ) -> FarmJob:
+        """
+        Send one step of an asset to a farm and record it in the library
+
+        Args:
+            library_root: Library the asset belongs to
+            asset_path: Library asset file
+            step: FARM_STEP_TURNTABLE or FARM_STEP_USD
+            farm_name: One of get_farm_names()
+            mayapy: mayapy of the artist's Maya
+            pool: Farm pool or service key, "" for the default
+            priority: Job priority
+            user: Submitting artist, defaults to the current one
+            **options: build_task_command options (turntable_format, frames, ...)
+
+        Returns:
+            The recorded job
+
+        Raises:
+            RenderFarmError: If the farm is unknown or refused the job
+        """
+        farm = self._farms.get(farm_name)
+        if farm is None:
+            raise RenderFarmError(f"Unknown render farm: {farm_name}")
+        asset_path = Path(asset_path)
+        if step not in self.get_steps(asset_path):
+            raise RenderFarmError(f"{asset_path.name} has no {step} step")
+        job = FarmJob(
+            key=uuid.uuid4().hex[:12],
+            asset_path=asset_path,
+            step=step,
+            farm=farm_name,
+            outputs=tuple(
+                self.get_outputs(asset_path, step, options.get("turntable_format", ".mp4"))
+            ),
+            submitted_by=user or get_current_user(),
+            submitted_date=datetime.now().isoformat(timespec="seconds"),
+        )
+        command = self.build_task_command(library_root, job, mayapy, **options)
+        # Written first, so a farm task finishing right away always finds its record
+        self._write_record(library_root, job)
+        title = f"{asset_path.stem} - {job.label}"
+        try:
+            job_id = farm.submit(title, command, pool, priority)
+        except Exception:
+            self.remove(library_root, job)
+            raise
+
+        job = replace(job, job_id=job_id)
+        self._write_record(library_root, job)
+        print(f"[OK] Submitted {title} to {FARM_LABELS.get(farm_name, farm_name)} ({job_id})")
+        return job
+
+    # Results ----------------------------------------------------------------------------
+
+    def write_result(
+        self,
+        jobs_dir: Path,
+        key: str,
+        success: bool,
+        error: str = "",
+        now: Optional[datetime] = None,
+    ) -> Path:
+        """Record that a farm task finished - called on the farm node"""
+        result_file = Path(jobs_dir) / f"{key}{RESULT_SUFFIX}"
+        result = {
+            "state": FARM_STATE_COMPLETED if success else FARM_STATE_FAILED,
+            "finished_date": (now or datetime.now()).isoformat(timespec="seconds"),
+            "error": error,
+        }
+        temp_file = result_file.with_name(f"{result_file.name}.tmp")
+        temp_file.write_text(json.dumps(result, indent=2), encoding="utf-8")
+        os.replace(temp_file, result_file)  # Never read half written
+        return result_file
+
+    def list_jobs(self, library_root: Optional[Path]) -> List[FarmJob]:
+        """Get a library's farm jobs with the results written back so far, oldest first"""
+        if library_root is None:
+            return []
+        jobs_dir = self.get_jobs_dir(library_root)
+        if not jobs_dir.is_dir():
+            return []
+        jobs: List[FarmJob] = []
+        for record_file in jobs_dir.glob("*.json"):
+            if record_file.name.endswith(RESULT_SUFFIX):
+                continue
+            try:
+                data = json.loads(record_file.read_text(encoding="utf-8"))
+                result_file = jobs_dir / f"{record_file.stem}{RESULT_SUFFIX}"
+                result = None
+                if result_file.is_file():
+                    result = json.loads(result_file.read_text(encoding="utf-8"))
+                jobs.append(self._map_paths(FarmJob.from_dict(data, result), library_root))
+            except Exception as e:
+                self.logger.warning(f"Skipping farm job record {record_file.name}: {e}")
+        return sorted(jobs, key=lambda job: (job.submitted_date, job.key))
+
+    def get_processing(self, library_root: Optional[Path]) -> Dict[str, List[FarmJob]]:
+        """Get the jobs still on the farm by str(asset path)"""
+        processing: Dict[str, List[FarmJob]] = {}
+        for job in self.list_jobs(library_root):
+            if job.is_processing:
+                processing.setdefault(str(job.asset_path), []).append(job)
+        return processing
+
+    def collect_finished(self, library_root: Optional[Path]) -> List[FarmJob]:
+        """Get the jobs the farm reported back on and remove their records"""
+        finished = [job for job in self.list_jobs(library_root) if not job.is_processing]
+        for job in finished:
+            self.remove(library_root, job)
+            if job.state == FARM_STATE_FAILED:
+                print(f"[ERROR] Farm job failed for {job.asset_path.name}: {job.description}")
+        return finished
+
+    def remove(self, library_root: Path, job: FarmJob) -> None:
+        """Delete a job's record and result, e.g. for jobs deleted in the farm monitor"""
+        jobs_dir = self.get_jobs_dir(library_root)
+        for path in (jobs_dir / f"{job.key}.json", jobs_dir / f"{job.key}{RESULT_SUFFIX}"):
+            try:
+                path.unlink()
+            except FileNotFoundError:
+                pass
+
+    def _write_record(self, library_root: Path, job: FarmJob) -> None:
+        """Write a job record"""
+        jobs_dir = self.get_jobs_dir(library_root)
+        jobs_dir.mkdir(parents=True, exist_ok=True)
+        record_file = jobs_dir / f"{job.key}.json"
+        # Under the library's root token, so artists on other platforms see the job too
+        path_mapping = get_path_mapping_service()
+        data = job.to_dict()
+        data["asset_path"] = path_mapping.to_portable(job.asset_path, library_root)
+        data["outputs"] = [path_mapping.to_portable(path, library_root) for path in job.outputs]
+        record_file.write_text(json.dumps(data, indent=2), encoding="utf-8")
+
+    def _map_paths(self, job: FarmJob, library_root: Path) -> FarmJob:
+        """Get a recorded job with its paths on this machine"""
+        path_mapping = get_path_mapping_service()
+        return replace(
+            job,
+            asset_path=path_mapping.resolve(job.asset_path, library_root),
+            outputs=tuple(path_mapping.resolve(path, library_root) for path in job.outputs),
+        )
+
+
+# Singleton instance factory
+_render_farm_service_instance = None
+
+
+def get_render_farm_service() -> RenderFarmService:
+    """
+    Get singleton instance of RenderFarmService.
+
+    Returns:
+        RenderFarmService: Singleton service instance
+    """
+    global _render_farm_service_instance
+    if _render_farm_service_instance is None:
+        _render_farm_service_instance = RenderFarmService()
+    return _render_farm_service_instance
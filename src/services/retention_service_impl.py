@@ -0,0 +1,230 @@
+# -*- coding: utf-8 -*-
+"""
+Retention Service Implementation
+Prune old asset versions a library's retention policy no longer keeps
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The policy sits with the library and is applied by the maintenance "retention" job::
+
+    MyProject/.assetmanager/retention.json   <- keep last N, keep approved, WIP age
+
+New policies start as a dry run: the maintenance report lists the versions that would
+go until an admin switches dry run off. A version counts as approved when it was the
+latest one at the time the asset was approved; the activity log keeps every approval,
+so versions approved before a later rework stay protected.
+"""
+
+import json
+import logging
+from dataclasses import replace
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import List, Optional, Set
+
+from ..core.models.activity_event import ACTIVITY_STATUS
+from ..core.models.asset_status import STATUS_APPROVED, STATUS_LABELS
+from ..core.models.asset_version import AssetVersion
+from ..core.models.retention_policy import PrunedVersion, RetentionPolicy, RetentionReport
+from .asset_status_service_impl import get_asset_status_service
+from .metadata_database_impl import get_metadata_database
+from .trash_service_impl import TRASH_DIR_NAME
+from .version_service_impl import MANIFEST_FILE_NAME, VERSIONS_DIR_NAME, get_version_service
+
+SETTINGS_DIR_NAME = ".assetmanager"
+POLICY_FILE_NAME = "retention.json"
+
+
+class RetentionService:
+    """
+    Retention Service - Single Responsibility for version pruning
+    Planning never deletes; pruning removes exactly the versions a plan lists
+    """
+
+    def __init__(self, version_service=None, database_factory=None):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+        self._database_factory = database_factory or get_metadata_database
+
+    # Policy -----------------------------------------------------------------------------
+
+    def get_policy_file(self, library_root: Path) -> Path:
+        """Get the retention policy file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / POLICY_FILE_NAME
+
+    def load_policy(self, library_root: Path) -> RetentionPolicy:
+        """Get a library's retention policy, switched off when none is saved"""
+        policy_file = self.get_policy_file(library_root)
+        if not policy_file.is_file():
+            return RetentionPolicy()
+        try:
+            with open(policy_file, "r", encoding="utf-8") as f:
+                return RetentionPolicy.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable retention policy {policy_file}: {e}")
+            return RetentionPolicy()
+
+    def save_policy(self, library_root: Path, policy: RetentionPolicy) -> bool:
+        """Write a library's retention policy"""
+        try:
+            policy_file = self.get_policy_file(library_root)
+            policy_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(policy_file, "w", encoding="utf-8") as f:
+                json.dump(policy.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save retention policy: {e}")
+            return False
+
+    # Planning ---------------------------------------------------------------------------
+
+    def find_versioned_assets(self, library_root: Path) -> List[Path]:
+        """Get the asset files of every version history in a library, outside the trash"""
+        library_root = Path(library_root)
+        assets = []
+        for manifest in sorted(library_root.rglob(f"{VERSIONS_DIR_NAME}/*/{MANIFEST_FILE_NAME}")):
+            if TRASH_DIR_NAME in manifest.relative_to(library_root).parts:
+                continue
+            assets.extend(self._version_service.get_versioned_files(manifest.parent))
+        return assets
+
+    def plan(
+        self,
+        library_root: Path,
+        policy: Optional[RetentionPolicy] = None,
+        now: Optional[datetime] = None,
+    ) -> RetentionReport:
+        """
+        List the versions a policy would prune, without removing anything
+
+        Args:
+            library_root: Library (project) root
+            policy: Policy to apply, defaults to the library's saved one
+            now: Time version ages are measured from
+
+        Returns:
+            Dry-run report
+        """
+        library_root = Path(library_root)
+        policy = policy or self.load_policy(library_root)
+        now = now or datetime.now()
+        database = self._database_factory(library_root) if policy.keep_approved else None
+
+        assets = self.find_versioned_assets(library_root)
+        candidates: List[PrunedVersion] = []
+        kept = 0
+        for asset_file in assets:
+            versions = [
+                version
+                for version in self._version_service.get_versions(asset_file)
+                if not version.is_pruned
+            ]
+            protected = {version.number for version in versions[-policy.keep_last :]}
+            if database is not None:
+                protected |= self.get_approved_versions(database, asset_file, versions)
+            for version in versions:
+                if version.number in protected or not self._is_old(version, policy, now):
+                    kept += 1
+                    continue
+                candidates.append(
+                    PrunedVersion(
+                        asset_file,
+                        version.number,
+                        self._get_size(version.file_path.parent),
+                        version.created_date,
+                        version.author,
+                    )
+                )
+        return RetentionReport(policy, tuple(candidates), len(assets), kept, dry_run=True)
+
+    def get_approved_versions(
+        self, database, asset_file: Path, versions: List[AssetVersion]
+    ) -> Set[int]:
+        """Get the versions that were the latest one when the asset was approved"""
+        approvals: List[datetime] = []
+        status = get_asset_status_service().get_status(database.get_asset_metadata(asset_file))
+        if status.state == STATUS_APPROVED and status.changed_date:
+            approvals.append(datetime.fromisoformat(status.changed_date))
+        approved_label = STATUS_LABELS[STATUS_APPROVED]
+        for row in database.get_activity(asset_file, action=ACTIVITY_STATUS):
+            if row["details"].get("status") == approved_label:
+                approvals.append(datetime.fromisoformat(row["timestamp"]))
+
+        approved = set()
+        for moment in approvals:
+            published = [v for v in versions if v.created_date and v.created_date <= moment]
+            if published:
+                approved.add(published[-1].number)
+        return approved
+
+    def _is_old(self, version: AssetVersion, policy: RetentionPolicy, now: datetime) -> bool:
+        """Check if a version is past the policy's age limit (undated versions are kept)"""
+        if not policy.max_wip_age_days:
+            return True
+        if version.created_date is None:
+            return False
+        return now - version.created_date > timedelta(days=policy.max_wip_age_days)
+
+    def _get_size(self, folder: Path) -> int:
+        """Get the bytes a version folder holds"""
+        if not folder.is_dir():
+            return 0
+        return sum(path.stat().st_size for path in folder.rglob("*") if path.is_file())
+
+    # Pruning ----------------------------------------------------------------------------
+
+    def prune(self, library_root: Path, report: RetentionReport) -> RetentionReport:
+        """
+        Remove the versions a dry-run report listed, forgetting their checksums
+
+        Returns:
+            Report of the versions actually removed
+        """
+        database = self._database_factory(Path(library_root))
+        pruned: List[PrunedVersion] = []
+        failed: List[str] = []
+        for candidate in report.versions:
+            version = self._version_service.get_version(candidate.asset_path, candidate.number)
+            if version is None:
+                continue
+            version_dir = version.file_path.parent
+            file_keys = [
+                database.get_asset_key(path) for path in version_dir.rglob("*") if path.is_file()
+            ]
+            try:
+                freed = self._version_service.prune_version(candidate.asset_path, version.number)
+            except Exception as e:
+                self.logger.error(f"Could not prune {candidate.label}: {e}")
+                failed.append(f"{candidate.label}: {e}")
+                continue
+            database.remove_checksums(file_keys)
+            pruned.append(replace(candidate, size_bytes=freed))
+
+        result = replace(report, versions=tuple(pruned), dry_run=False, failed=tuple(failed))
+        print(f"[{'WARNING' if failed else 'OK'}] {result.summary}")
+        return result
+
+    def run(self, library_root: Path, now: Optional[datetime] = None) -> RetentionReport:
+        """Apply the library's policy: a report only while it is a dry run"""
+        report = self.plan(library_root, now=now)
+        if report.policy.dry_run or not report.versions:
+            return report
+        return self.prune(library_root, report)
+
+
+# Singleton instance factory
+_retention_service_instance = None
+
+
+def get_retention_service() -> RetentionService:
+    """
+    Get singleton instance of RetentionService.
+
+    Returns:
+        RetentionService: Singleton service instance
+    """
+    global _retention_service_instance
+    if _retention_service_instance is None:
+        _retention_service_instance = RetentionService()
+    return _retention_service_instance
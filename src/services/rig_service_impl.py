@@ -0,0 +1,307 @@
+# -*- coding: utf-8 -*-
+"""
+Rig Service Implementation
+Validate rig structure on publish and build animator selection sets on import
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Rigs are published as Maya scenes in the "Rigs" category. The publish checks for one
+top node with the library's required groups and stores the controller sets and an
+optional picker layout in the asset's library metadata::
+
+    hero_rig
+    |-- geo
+    |-- rig
+        |-- ctrl_grp|body_ctrl ...
+
+    MyProject/.assetmanager/rig_structure.json   <- {"required_groups": ["geo", "rig"]}
+
+Controls are stored as paths below the top node without namespaces, so the sets are
+rebuilt on the imported or referenced copy whatever its namespace.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.validation_result import (
+    SEVERITY_ERROR,
+    SEVERITY_WARNING,
+    ValidationIssue,
+    ValidationReport,
+)
+from .anim_clip_service_impl import get_namespace, remap_node, strip_namespace
+from .validation_service_impl import DEFAULT_CAMERAS
+
+RIG_CATEGORY = "Rigs"
+RIG_METADATA_KEY = "rig"
+RIG_CHECK_ID = "rig_structure"
+RIG_CHECK_NAME = "Rig Structure"
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "rig_structure.json"
+DEFAULT_REQUIRED_GROUPS = ("geo", "rig")
+
+CHARACTER_SET_SUFFIX = "_character"
+CONTROLS_SET_NAME = "controls"  # Set built from every control when the rig has none
+PICKER_ATTRIBUTE = "assetManagerPicker"  # Picker layout JSON on the character set
+
+
+class RigService:
+    """
+    Rig Service - Single Responsibility for rig publish metadata and import setup
+    Scene access goes through the cmds argument so rigs can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the rig structure settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def get_required_groups(self, library_root: Optional[Path]) -> List[str]:
+        """Get the groups every rig's top node must have"""
+        if library_root is None:
+            return list(DEFAULT_REQUIRED_GROUPS)
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return list(DEFAULT_REQUIRED_GROUPS)
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                groups = json.load(f).get("required_groups", DEFAULT_REQUIRED_GROUPS)
+            return [str(group) for group in groups]
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable rig settings {settings_file}: {e}")
+            return list(DEFAULT_REQUIRED_GROUPS)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def find_top_nodes(self, cmds: Any, selection: Optional[List[str]] = None) -> List[str]:
+        """Get the top-level transforms being published (selection or whole scene)"""
+        if not selection:
+            roots = cmds.ls(assemblies=True, long=True) or []
+            return [root for root in roots if root not in DEFAULT_CAMERAS]
+        nodes = cmds.ls(selection, long=True, transforms=True) or []
+        return [node for node in nodes if not any(node.startswith(f"{n}|") for n in nodes)]
+
+    def validate_rig(
+        self, cmds: Any, selection: Optional[List[str]] = None, library_root: Optional[Path] = None
+    ) -> ValidationReport:
+        """
+        Check that the rig has one top node with the required groups and some controls
+
+        Args:
+            cmds: maya.cmds module
+            selection: Nodes being published, the whole scene when empty
+            library_root: Library whose required groups apply
+
+        Returns:
+            Report with errors for a wrong structure and a warning for a rig without controls
+        """
+        report = ValidationReport(checks_run=[RIG_CHECK_ID])
+        top_nodes = self.find_top_nodes(cmds, selection)
+        if len(top_nodes) != 1:
+            report.issues.append(
+                self._issue(
+                    SEVERITY_ERROR,
+                    f"A rig is published from one top node, found {len(top_nodes)}",
+                    top_nodes,
+                )
+            )
+            return report
+
+        top_node = top_nodes[0]
+        children = cmds.listRelatives(top_node, children=True, type="transform", fullPath=True)
+        names = [strip_namespace(child).lower() for child in children or []]
+        missing = []
+        for group in self.get_required_groups(library_root):
+            group = group.lower()
+            if not any(name == group or name.endswith(f"_{group}") for name in names):
+                missing.append(group)
+        if missing:
+            report.issues.append(
+                self._issue(
+                    SEVERITY_ERROR,
+                    f"{strip_namespace(top_node)} is missing the group(s): {', '.join(missing)}",
+                    [top_node],
+                )
+            )
+        if not self.find_controls(cmds, top_node):
+            report.issues.append(
+                self._issue(
+                    SEVERITY_WARNING,
+                    f"{strip_namespace(top_node)} has no controls (NURBS curve transforms)",
+                    [top_node],
+                )
+            )
+        return report
+
+    def find_controls(self, cmds: Any, top_node: str) -> List[str]:
+        """Get the transforms below a rig's top node that have NURBS curve shapes"""
+        controls = []
+        descendants = cmds.listRelatives(
+            top_node, allDescendents=True, type="transform", fullPath=True
+        )
+        for node in reversed(descendants or []):  # parents before children
+            shapes = cmds.listRelatives(node, shapes=True, fullPath=True) or []
+            if any(cmds.nodeType(shape) == "nurbsCurve" for shape in shapes):
+                controls.append(node)
+        return controls
+
+    def find_controller_sets(self, cmds: Any, top_node: str) -> Dict[str, List[str]]:
+        """
+        Get the selection sets of a rig that hold only its controls
+
+        Deformer and shading sets hold shapes or components and are left out.
+
+        Returns:
+            Set name -> control full paths
+        """
+        controls = set(self.find_controls(cmds, top_node))
+        controller_sets = {}
+        for object_set in cmds.ls(type="objectSet") or []:
+            if cmds.nodeType(object_set) != "objectSet":
+                continue  # shadingEngine and other derived set types
+            members = cmds.ls(cmds.sets(object_set, query=True) or [], long=True) or []
+            if members and all(member in controls for member in members):
+                controller_sets[strip_namespace(object_set)] = members
+        return controller_sets
+
+    def build_rig_metadata(
+        self,
+        top_node: str,
+        controller_sets: Dict[str, List[str]],
+        picker: Optional[Dict[str, Any]] = None,
+        character_set: bool = True,
+    ) -> Dict[str, Any]:
+        """
+        Get the library metadata stored for a published rig
+
+        Args:
+            top_node: Full path of the rig's top node
+            controller_sets: Set name -> control full paths
+            picker: Picker layout to hand to picker tools on import
+            character_set: Whether import creates a character set of every control
+        """
+        return {
+            "top_node": strip_namespace(top_node),
+            "controller_sets": {
+                name: [self._get_relative_path(top_node, member) for member in members]
+                for name, members in controller_sets.items()
+            },
+            "character_set": character_set,
+            "picker": picker,
+        }
+
+    def load_picker_layout(self, picker_file: Path) -> Optional[Dict[str, Any]]:
+        """Read a picker layout JSON file, None if it is not a JSON object"""
+        try:
+            with open(picker_file, "r", encoding="utf-8") as f:
+                layout = json.load(f)
+        except Exception as e:
+            print(f"[ERROR] Could not read picker layout {picker_file}: {e}")
+            return None
+        if not isinstance(layout, dict):
+            print(f"[ERROR] Picker layout {Path(picker_file).name} is not a JSON object")
+            return None
+        return layout
+
+    # Import -----------------------------------------------------------------------------
+
+    def setup_rig(
+        self, cmds: Any, roots: List[str], rig_metadata: Dict[str, Any], name: str
+    ) -> List[str]:
+        """
+        Build the selection sets and character set of an imported or referenced rig
+
+        Args:
+            cmds: maya.cmds module
+            roots: Top-level transforms the import added
+            rig_metadata: Metadata stored when the rig was published
+            name: Prefix of the created sets (asset name)
+
+        Returns:
+            The created sets, character set last
+        """
+        top_node = self._find_rig_root(cmds, roots, rig_metadata.get("top_node", ""))
+        if top_node is None:
+            print(f"[WARNING] {name}: the imported nodes hold no rig top node")
+            return []
+        namespace = get_namespace(top_node)
+        prefix = remap_node(name, namespace) if namespace else name
+
+        created = []
+        all_controls: List[str] = []
+        controller_sets = dict(rig_metadata.get("controller_sets") or {})
+        if not controller_sets:
+            controls = self.find_controls(cmds, top_node)
+            controller_sets = {
+                CONTROLS_SET_NAME: [self._get_relative_path(top_node, c) for c in controls]
+            }
+        for set_name, members in controller_sets.items():
+            resolved = [self._resolve(cmds, top_node, member, namespace) for member in members]
+            nodes = [node for node in resolved if node is not None]
+            if len(nodes) < len(members):
+                print(f"[WARNING] {set_name}: {len(members) - len(nodes)} control(s) not found")
+            if not nodes:
+                continue
+            created.append(cmds.sets(nodes, name=f"{prefix}_{set_name}"))
+            all_controls.extend(node for node in nodes if node not in all_controls)
+
+        if rig_metadata.get("character_set", True) and all_controls:
+            character = cmds.character(all_controls, name=f"{prefix}{CHARACTER_SET_SUFFIX}")
+            picker = rig_metadata.get("picker")
+            if picker:
+                cmds.addAttr(character, longName=PICKER_ATTRIBUTE, dataType="string")
+                cmds.setAttr(f"{character}.{PICKER_ATTRIBUTE}", json.dumps(picker), type="string")
+            created.append(character)
+
+        print(f"[OK] Set up rig {name}: {len(created)} set(s) for {len(all_controls)} control(s)")
+        return created
+
+    # Internals --------------------------------------------------------------------------
+
+    def _issue(self, severity: str, message: str, nodes: List[str]) -> ValidationIssue:
+        return ValidationIssue(RIG_CHECK_ID, RIG_CHECK_NAME, severity, message, tuple(nodes))
+
+    def _get_relative_path(self, top_node: str, node: str) -> str:
+        """Get a node's path below the top node without namespaces (ctrl_grp|hand_ctrl)"""
+        relative = node[len(top_node) :].strip("|") if node.startswith(f"{top_node}|") else node
+        return "|".join(strip_namespace(part) for part in relative.split("|") if part)
+
+    def _find_rig_root(self, cmds: Any, roots: List[str], top_node: str) -> Optional[str]:
+        """Get the imported top node of the rig, also when a reference group wraps it"""
+        for root in roots:
+            children = cmds.listRelatives(root, children=True, type="transform", fullPath=True)
+            for node in [root] + (children or []):
+                if strip_namespace(node) == top_node:
+                    return node
+        return roots[0] if len(roots) == 1 else None
+
+    def _resolve(self, cmds: Any, top_node: str, relative: str, namespace: str) -> Optional[str]:
+        """Get the scene path of a stored control below the imported top node"""
+        path = "|".join(remap_node(part, namespace) for part in relative.split("|"))
+        node = f"{top_node}|{path}"
+        return node if cmds.objExists(node) else None
+
+
+# Singleton instance factory
+_rig_service_instance = None
+
+
+def get_rig_service() -> RigService:
+    """
+    Get singleton instance of RigService.
+
+    Returns:
+        RigService: Singleton service instance
+    """
+    global _rig_service_instance
+    if _rig_service_instance is None:
+        _rig_service_instance = RigService()
+    return _rig_service_instance
@@ -0,0 +1,626 @@
+# -*- coding: utf-8 -*-
+"""
+Scene Asset Service Implementation
+Find the library assets loaded in the open scene and update, replace, or remove them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Referenced assets are found from their reference file. Every import writes a network
+node recording its provenance, with the .message of each new top-level transform
+connected to its members attribute::
+
+    crate_provenance (network)
+        .assetManagerId           <- asset ID (same as the library browser's)
+        .assetManagerFile         <- asset file at import
+        .assetManagerLibrary      <- library root at import
+        .assetManagerPath         <- asset file relative to the library
+        .assetManagerVersion      <- version that was latest at import
+        .assetManagerLevel        <- LOD level, empty for the base representation
+        .assetManagerImportedBy / .assetManagerImportDate
+        .assetManagerMembers[0]   <- |crate_grp.message
+
+Connections follow renamed and re-parented nodes and namespace edits, so the import
+stays identifiable for updates and replacements. Roots tagged before provenance nodes
+(viewport drops, LOD imports) are still listed from their string attributes.
+"""
+
+import logging
+from dataclasses import dataclass, replace
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.asset_provenance import AssetProvenance
+from ..core.models.asset_version import format_version_label
+from ..core.models.merge_import import MergeImportOptions, MergeImportReport
+from .asset_repository_impl import generate_asset_id
+from .lock_service_impl import get_lock_service
+from .lod_service_impl import (
+    ASSET_ATTRIBUTE as LOD_ASSET_ATTRIBUTE,
+    GROUP_ATTRIBUTE as LOD_GROUP_ATTRIBUTE,
+    LEVEL_ATTRIBUTE as LOD_LEVEL_ATTRIBUTE,
+    get_lod_service,
+)
+from .maya_integration_impl import REFERENCE_FILE_TYPES, sanitize_namespace
+from .merge_import_service_impl import get_merge_import_service
+from .reference_update_service_impl import ReferenceUpdate, get_reference_update_service
+from .version_service_impl import get_current_user, get_version_service
+from .viewport_drop_service_impl import SOURCE_ATTRIBUTE
+
+# AssetProvenance field -> string attribute on the provenance node
+PROVENANCE_ATTRIBUTES = {
+    "asset_id": "assetManagerId",
+    "asset_file": "assetManagerFile",
+    "library_root": "assetManagerLibrary",
+    "relative_path": "assetManagerPath",
+    "version": "assetManagerVersion",
+    "level": "assetManagerLevel",
+    "imported_by": "assetManagerImportedBy",
+    "import_date": "assetManagerImportDate",
+}
+MEMBERS_ATTRIBUTE = "assetManagerMembers"
+PROVENANCE_NODE_SUFFIX = "_provenance"
+
+MAYA_FILE_TYPES = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+
+@dataclass(frozen=True)
+class SceneAsset:
+    """Library asset loaded in the open scene"""
+
+    asset_file: Path
+    nodes: Tuple[str, ...] = ()  # Imported top-level transforms
+    reference_node: Optional[str] = None  # Set for referenced assets
+    namespace: str = ""
+    file_path: Optional[Path] = None  # File a reference loads (version snapshot, LOD)
+    level: str = ""  # LOD level, empty for the base representation
+    loaded_version: int = 0  # 0 when not known
+    latest_version: int = 0  # 0 when the asset has no version history
+    pinned: bool = False  # Reference to a version snapshot
+    locked_by: str = ""  # Lock description while the asset is checked out
+    provenance: Optional[AssetProvenance] = None  # Recorded at import
+    provenance_node: str = ""
+    missing: bool = False  # The file a reference loads is not on disk
+
+    @property
+    def is_referenced(self) -> bool:
+        """Check if the asset is loaded through a file reference"""
+        return self.reference_node is not None
+
+    @property
+    def is_outdated(self) -> bool:
+        """Check if a newer version was published than the one loaded"""
+        return 0 < self.loaded_version < self.latest_version
+
+    @property
+    def label(self) -> str:
+        """Get display text (crate:crateRN, or crate - LOD1 for imported LODs)"""
+        if self.is_referenced:
+            name = f"{self.namespace or self.asset_file.stem}:{self.reference_node}"
+        else:
+            name = self.asset_file.stem
+        return f"{name} - {self.level}" if self.level else name
+
+    @property
+    def version_label(self) -> str:
+        """Get the loaded version (v002, v002 (pinned), or - when not known)"""
+        if not self.loaded_version:
+            return "-"
+        label = format_version_label(self.loaded_version)
+        return f"{label} (pinned)" if self.pinned else label
+
+    @property
+    def update_status(self) -> str:
+        """Get how the loaded asset compares with the library"""
+        if self.missing:
+            return "File missing"
+        if not self.latest_version:
+            return "Not versioned"
+        if not self.loaded_version:
+            return f"Unknown (latest {format_version_label(self.latest_version)})"
+        if self.is_outdated:
+            return f"{format_version_label(self.latest_version)} available"
+        return "Up to date"
+
+
+class SceneAssetService:
+    """
+    Scene Asset Service - Single Responsibility for library assets in the open scene
+    Scene access goes through the cmds argument so the outliner can be tested without Maya
+    """
+
+    def __init__(
+        self,
+        version_service=None,
+        lock_service=None,
+        reference_update_service=None,
+        lod_service=None,
+        merge_import_service=None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._version_service = version_service or get_version_service()
+        self._lock_service = lock_service or get_lock_service()
+        self._reference_update_service = (
+            reference_update_service or get_reference_update_service()
+        )
+        self._lod_service = lod_service or get_lod_service()
+        self._merge_import_service = merge_import_service or get_merge_import_service()
+
+    # Provenance -------------------------------------------------------------------------
+
+    def tag_imported(
+        self,
+        cmds: Any,
+        roots: List[str],
+        asset_file: Path,
+        library_root: Optional[Path] = None,
+        level: str = "",
+        version: Optional[int] = None,
+    ) -> str:
+        """
+        Record where imported top-level transforms came from
+
+        Roots also get the asset file as a string attribute, which viewport drops use
+        to find a copy to instance. The version defaults to the latest, for imports of
+        the current file rather than a snapshot.
+
+        Returns:
+            The provenance node
+        """
+        asset_file = Path(asset_file)
+        for root in roots:
+            if not cmds.attributeQuery(SOURCE_ATTRIBUTE, node=root, exists=True):
+                cmds.addAttr(root, longName=SOURCE_ATTRIBUTE, dataType="string")
+            cmds.setAttr(f"{root}.{SOURCE_ATTRIBUTE}", asset_file.as_posix(), type="string")
+
+        relative_path = ""
+        if library_root is not None:
+            try:
+                relative_path = (
+                    asset_file.resolve().relative_to(Path(library_root).resolve()).as_posix()
+                )
+            except (OSError, ValueError):
+                pass  # Imported from outside the library
+        if version is None:
+            latest = self._version_service.get_latest_version(asset_file)
+            version = latest.number if latest else 0
+        provenance = AssetProvenance(
+            asset_id=generate_asset_id(asset_file),
+            asset_file=asset_file,
+            library_root=Path(library_root) if library_root else None,
+            relative_path=relative_path,
+            version=version,
+            level=level,
+            imported_by=get_current_user(),
+            import_date=datetime.now(),
+        )
+        return self.write_provenance(cmds, roots, provenance)
+
+    def write_provenance(self, cmds: Any, roots: List[str], provenance: AssetProvenance) -> str:
+        """Create a provenance node with the roots connected as its members"""
+        node = cmds.createNode(
+            "network",
+            name=f"{sanitize_namespace(provenance.asset_file.stem)}{PROVENANCE_NODE_SUFFIX}",
+            skipSelect=True,
+        )
+        for key, value in provenance.to_dict().items():
+            attribute = PROVENANCE_ATTRIBUTES[key]
+            cmds.addAttr(node, longName=attribute, dataType="string")
+            cmds.setAttr(f"{node}.{attribute}", value, type="string")
+        cmds.addAttr(node, longName=MEMBERS_ATTRIBUTE, attributeType="message", multi=True)
+        for index, root in enumerate(roots):
+            cmds.connectAttr(f"{root}.message", f"{node}.{MEMBERS_ATTRIBUTE}[{index}]")
+        return node
+
+    def read_provenance(self, cmds: Any, node: str) -> Optional[AssetProvenance]:
+        """Get the provenance a node records, None if it is not a provenance node"""
+        values = {
+            key: self._get_string(cmds, node, attribute)
+            for key, attribute in PROVENANCE_ATTRIBUTES.items()
+        }
+        try:
+            return AssetProvenance.from_dict(values)
+        except ValueError:
+            return None
+
+    def find_provenance_nodes(self, cmds: Any) -> List[str]:
+        """Get every provenance node in the scene"""
+        return [
+            node
+            for node in cmds.ls(type="network") or []
+            if cmds.attributeQuery(PROVENANCE_ATTRIBUTES["asset_file"], node=node, exists=True)
+        ]
+
+    def get_members(self, cmds: Any, provenance_node: str) -> List[str]:
+        """Get the top-level transforms connected to a provenance node (full paths)"""
+        members = cmds.listConnections(
+            f"{provenance_node}.{MEMBERS_ATTRIBUTE}", source=True, destination=False
+        )
+        return (cmds.ls(members, long=True) or []) if members else []
+
+    def import_tracked(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        importer: Callable[[], bool],
+        library_root: Optional[Path] = None,
+        level: str = "",
+    ) -> Optional[List[str]]:
+        """
+        Run an import and record the provenance of the top-level transforms it added
+
+        Args:
+            cmds: maya.cmds module
+            asset_file: Library asset being imported
+            importer: Imports the asset, returns success
+            library_root: Library the asset is imported from
+            level: LOD level being imported
+
+        Returns:
+            New top-level transforms, None when the import failed
+        """
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        if not importer():
+            return None
+        roots = [node for node in cmds.ls(assemblies=True, long=True) or [] if node not in before]
+        if roots:
+            try:
+                self.tag_imported(cmds, roots, asset_file, library_root, level)
+            except Exception as e:
+                print(f"[WARNING] Could not record provenance of {Path(asset_file).name}: {e}")
+        return roots
+
+    # Scene ------------------------------------------------------------------------------
+
+    def scan(self, cmds: Any, library_root: Optional[Path] = None) -> List[SceneAsset]:
+        """
+        Get every managed asset in the scene
+
+        A reference counts as managed when its asset has a version history or lives in
+        the library; imported assets count when the manager tagged them.
+
+        Args:
+            cmds: maya.cmds module
+            library_root: Current library, None to list versioned references only
+
+        Returns:
+            Imported and referenced assets sorted by label
+        """
+        scene_assets = self._scan_imported(cmds, library_root) + self._scan_referenced(
+            cmds, library_root
+        )
+        return sorted(scene_assets, key=lambda scene_asset: scene_asset.label.lower())
+
+    def _scan_imported(self, cmds: Any, library_root: Optional[Path]) -> List[SceneAsset]:
+        """List imports from their provenance nodes, then roots only tagged by attributes"""
+        scene_assets = []
+        claimed = set()
+        for node in self.find_provenance_nodes(cmds):
+            provenance = self.read_provenance(cmds, node)
+            members = self.get_members(cmds, node)
+            if provenance is None or not members:
+                continue  # Everything the import created was deleted
+            claimed.update(members)
+            if any(self._holds_reference(cmds, member) for member in members):
+                continue  # A referencing drop, listed with its reference
+            scene_assets.append(
+                self._with_library_state(
+                    SceneAsset(
+                        asset_file=provenance.resolve_file(library_root),
+                        nodes=tuple(sorted(members)),
+                        level=provenance.level,
+                        loaded_version=provenance.version,
+                        provenance=provenance,
+                        provenance_node=node,
+                    )
+                )
+            )
+
+        tagged: List[str] = []
+        for attribute in (SOURCE_ATTRIBUTE, LOD_ASSET_ATTRIBUTE):
+            found = cmds.ls(f"*.{attribute}", objectsOnly=True, long=True, recursive=True)
+            tagged.extend(node for node in found or [] if node not in tagged + list(claimed))
+
+        groups: Dict[Tuple[str, str], List[str]] = {}
+        for node in tagged:
+            if cmds.referenceQuery(node, isNodeReferenced=True) or self._holds_reference(
+                cmds, node
+            ):
+                continue  # Listed with its reference
+            asset_path = self._get_string(cmds, node, SOURCE_ATTRIBUTE) or self._get_string(
+                cmds, node, LOD_ASSET_ATTRIBUTE
+            )
+            group = self._get_string(cmds, node, LOD_GROUP_ATTRIBUTE) or node
+            groups.setdefault((asset_path, group), []).append(node)
+
+        for (asset_path, _group), nodes in groups.items():
+            scene_assets.append(
+                self._with_library_state(
+                    SceneAsset(
+                        asset_file=Path(asset_path),
+                        nodes=tuple(sorted(nodes)),
+                        level=self._get_string(cmds, nodes[0], LOD_LEVEL_ATTRIBUTE),
+                    )
+                )
+            )
+        return scene_assets
+
+    def _scan_referenced(self, cmds: Any, library_root: Optional[Path]) -> List[SceneAsset]:
+        """List references of library assets, with the versions noted for update checks"""
+        noted = {
+            update.reference_node: update
+            for update in self._reference_update_service.scan(cmds)
+        }
+
+        scene_assets = []
+        for reference_file in cmds.file(query=True, reference=True) or []:
+            try:
+                file_path = Path(
+                    cmds.referenceQuery(reference_file, filename=True, withoutCopyNumber=True)
+                )
+                reference_node = cmds.referenceQuery(reference_file, referenceNode=True)
+                namespace = cmds.referenceQuery(reference_file, namespace=True, shortName=True)
+            except Exception as e:
+                self.logger.warning(f"Skipping unreadable reference {reference_file}: {e}")
+                continue
+
+            asset_file, pinned_version = self._reference_update_service.resolve(file_path)
+            update = noted.get(reference_node)
+            if update is None and not self._is_in_library(asset_file, library_root):
+                continue
+            identified = self._lod_service.identify(file_path)
+            scene_assets.append(
+                self._with_library_state(
+                    SceneAsset(
+                        asset_file=asset_file,
+                        reference_node=reference_node,
+                        namespace=namespace or "",
+                        file_path=file_path,
+                        level=identified[1] if identified else "",
+                        loaded_version=update.loaded_version if update else 0,
+                        pinned=pinned_version is not None,
+                        missing=not file_path.is_file(),
+                    )
+                )
+            )
+        return scene_assets
+
+    # Actions ----------------------------------------------------------------------------
+
+    def get_nodes(self, cmds: Any, scene_asset: SceneAsset) -> List[str]:
+        """Get the top-level transforms of a scene asset"""
+        if not scene_asset.is_referenced:
+            return [node for node in scene_asset.nodes if cmds.objExists(node)]
+        nodes = cmds.referenceQuery(scene_asset.reference_node, nodes=True, dagPath=True) or []
+        transforms = cmds.ls(nodes, long=True, transforms=True) or []
+        # A reference's roots are the transforms whose parent it does not load
+        return [node for node in transforms if node.rsplit("|", 1)[0] not in transforms]
+
+    def update(self, cmds: Any, scene_asset: SceneAsset) -> SceneAsset:
+        """
+        Load the latest version of a scene asset in place
+
+        References are reloaded keeping their edits. Imported assets are imported again
+        under the same parent, and a single new root takes the placement of the old one.
+
+        Returns:
+            The scene asset after the update
+        """
+        if not scene_asset.is_referenced:
+            return self._reimport(cmds, scene_asset, scene_asset.asset_file, scene_asset.level)
+
+        updated = self._reference_update_service.update(
+            cmds,
+            ReferenceUpdate(
+                reference_node=scene_asset.reference_node,
+                namespace=scene_asset.namespace,
+                file_path=scene_asset.file_path or scene_asset.asset_file,
+                asset_file=scene_asset.asset_file,
+                loaded_version=scene_asset.loaded_version,
+                latest_version=scene_asset.latest_version,
+                pinned=scene_asset.pinned,
+            ),
+        )
+        return self._with_library_state(
+            replace(
+                scene_asset,
+                file_path=updated.file_path,
+                loaded_version=updated.loaded_version,
+                pinned=False,
+            )
+        )
+
+    def update_in_place(
+        self, cmds: Any, scene_asset: SceneAsset, options: Optional[MergeImportOptions] = None
+    ) -> Tuple[SceneAsset, MergeImportReport]:
+        """
+        Transfer the latest version of an imported asset onto its existing nodes
+
+        Unlike an update, the scene's nodes stay, and with them the constraints, skin
+        clusters, and keys that point at them.
+
+        Returns:
+            The scene asset after the update, and what was transferred
+        """
+        if scene_asset.is_referenced:
+            raise RuntimeError("References update by reloading - use Update to Latest")
+        source_file = scene_asset.asset_file
+        if scene_asset.level:
+            source_file = self._lod_service.get_variant_path(source_file, scene_asset.level)
+        nodes = self.get_nodes(cmds, scene_asset)
+        if not nodes:
+            raise RuntimeError(f"{scene_asset.label} is no longer in the scene")
+        library_root = scene_asset.provenance.library_root if scene_asset.provenance else None
+
+        report = self._merge_import_service.merge(cmds, nodes, source_file, options)
+        self._delete_provenance(cmds, scene_asset)
+        provenance_node = self.tag_imported(
+            cmds, list(report.roots), scene_asset.asset_file, library_root, scene_asset.level
+        )
+        provenance = self.read_provenance(cmds, provenance_node)
+        updated = self._with_library_state(
+            replace(
+                scene_asset,
+                nodes=report.roots,
+                loaded_version=provenance.version if provenance else 0,
+                provenance=provenance,
+                provenance_node=provenance_node,
+            )
+        )
+        return updated, report
+
+    def replace(self, cmds: Any, scene_asset: SceneAsset, asset_file: Path) -> SceneAsset:
+        """
+        Swap a scene asset for another library asset, keeping its placement
+
+        References keep their reference node and namespace; imported assets are
+        replaced like an update.
+
+        Returns:
+            The replacement in the scene
+        """
+        asset_file = Path(asset_file)
+        if not scene_asset.is_referenced:
+            return self._reimport(cmds, scene_asset, asset_file, "")
+
+        extension = asset_file.suffix.lower()
+        if not asset_file.is_file() or extension not in REFERENCE_FILE_TYPES:
+            raise RuntimeError(f"Cannot reference {asset_file.name}")
+        cmds.file(
+            str(asset_file),
+            loadReference=scene_asset.reference_node,
+            type=REFERENCE_FILE_TYPES[extension],
+        )
+        self._reference_update_service.forget(scene_asset.reference_node)
+        print(f"[OK] Replaced {scene_asset.label} with {asset_file.stem}")
+        latest = self._version_service.get_latest_version(asset_file)
+        return self._with_library_state(
+            replace(
+                scene_asset,
+                asset_file=asset_file,
+                file_path=asset_file,
+                level="",
+                loaded_version=latest.number if latest else 0,
+                pinned=False,
+            )
+        )
+
+    def remove(self, cmds: Any, scene_asset: SceneAsset) -> None:
+        """Remove a scene asset - unload its reference or delete its imported nodes"""
+        if scene_asset.is_referenced:
+            cmds.file(referenceNode=scene_asset.reference_node, removeReference=True)
+        else:
+            cmds.delete(self.get_nodes(cmds, scene_asset))
+            self._delete_provenance(cmds, scene_asset)
+        print(f"[OK] Removed {scene_asset.label} from the scene")
+
+    def _reimport(
+        self, cmds: Any, scene_asset: SceneAsset, asset_file: Path, level: str
+    ) -> SceneAsset:
+        """Replace imported nodes with a fresh import of an asset (or one of its LODs)"""
+        source_file = asset_file
+        if level:
+            source_file = self._lod_service.get_variant_path(asset_file, level)
+        file_type = MAYA_FILE_TYPES.get(source_file.suffix.lower())
+        if file_type is None or not source_file.is_file():
+            raise RuntimeError(f"Cannot re-import {source_file.name} - only Maya scenes update")
+        nodes = self.get_nodes(cmds, scene_asset)
+        if not nodes:
+            raise RuntimeError(f"{scene_asset.label} is no longer in the scene")
+        library_root = scene_asset.provenance.library_root if scene_asset.provenance else None
+
+        cmds.undoInfo(openChunk=True, chunkName=f"Update {asset_file.stem}")
+        try:
+            parents = cmds.listRelatives(nodes[0], parent=True, fullPath=True) or []
+            matrix = cmds.xform(nodes[0], query=True, matrix=True, worldSpace=True)
+            cmds.delete(nodes)
+            self._delete_provenance(cmds, scene_asset)
+
+            before = set(cmds.ls(assemblies=True, long=True) or [])
+            if level:
+                self._lod_service.import_variant(cmds, asset_file, level)
+            elif cmds.file(str(source_file), i=True, type=file_type) is None:
+                raise RuntimeError(f"Could not import {source_file.name}")
+            placed = []
+            for root in cmds.ls(assemblies=True, long=True) or []:
+                if root in before:
+                    continue
+                if parents:
+                    root = (cmds.parent(root, parents[0]) or [root])[0]
+                placed.append(root)
+            if len(placed) == 1:
+                cmds.xform(placed[0], matrix=matrix, worldSpace=True)
+            provenance_node = self.tag_imported(cmds, placed, asset_file, library_root, level)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        print(f"[OK] Loaded latest {asset_file.stem} in place of {scene_asset.label}")
+        provenance = self.read_provenance(cmds, provenance_node)
+        return self._with_library_state(
+            SceneAsset(
+                asset_file=asset_file,
+                nodes=tuple(placed),
+                level=level,
+                loaded_version=provenance.version if provenance else 0,
+                provenance=provenance,
+                provenance_node=provenance_node,
+            )
+        )
+
+    def _delete_provenance(self, cmds: Any, scene_asset: SceneAsset) -> None:
+        """Delete the provenance node of an import whose nodes were deleted"""
+        if scene_asset.provenance_node and cmds.objExists(scene_asset.provenance_node):
+            cmds.delete(scene_asset.provenance_node)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _with_library_state(self, scene_asset: SceneAsset) -> SceneAsset:
+        """Fill in the latest version and lock of the asset"""
+        latest = self._version_service.get_latest_version(scene_asset.asset_file)
+        lock = self._lock_service.get_lock(scene_asset.asset_file)
+        return replace(
+            scene_asset,
+            latest_version=latest.number if latest else 0,
+            locked_by=lock.description if lock else "",
+        )
+
+    def _is_in_library(self, asset_file: Path, library_root: Optional[Path]) -> bool:
+        """Check if a file lives in the library"""
+        if library_root is None:
+            return False
+        try:
+            Path(asset_file).resolve().relative_to(Path(library_root).resolve())
+            return True
+        except (OSError, ValueError):
+            return False
+
+    def _holds_reference(self, cmds: Any, node: str) -> bool:
+        """Check if a tagged root only groups referenced nodes (a referencing drop)"""
+        children = cmds.listRelatives(node, children=True, fullPath=True) or []
+        return bool(children) and all(
+            cmds.referenceQuery(child, isNodeReferenced=True) for child in children
+        )
+
+    def _get_string(self, cmds: Any, node: str, attribute: str) -> str:
+        """Get a string attribute, empty when the node does not have it"""
+        if not cmds.attributeQuery(attribute, node=node, exists=True):
+            return ""
+        return cmds.getAttr(f"{node}.{attribute}") or ""
+
+
+# Singleton instance factory
+_scene_asset_service_instance = None
+
+
+def get_scene_asset_service() -> SceneAssetService:
+    """
+    Get singleton instance of SceneAssetService.
+
+    Returns:
+        SceneAssetService: Singleton service instance
+    """
+    global _scene_asset_service_instance
+    if _scene_asset_service_instance is None:
+        _scene_asset_service_instance = SceneAssetService()
+    return _scene_asset_service_instance
@@ -0,0 +1,315 @@
+# -*- coding: utf-8 -*-
+"""
+Scratch Service Implementation
+A personal scratch library for quick exports, promoted to a curated library later
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Quick exports of the selection go to the artist's own scratch library instead of the
+file server, named after the selection and the time they were made. The scratch
+library is an ordinary library in the picker, so its assets can be browsed, imported,
+and promoted into a curated library as new assets::
+
+    ~/.assetmanager/scratch.json
+    {"root": "", "file_format": ".ma", "name_pattern": "{object}_{date}_{time}"}
+
+    ~/.assetmanager/scratch/assets/scenes/crate_20261014_153012.ma
+        -> //server/library/assets/scenes/crate.ma (version 1)
+
+Name patterns use {object} (the first selected node), {user}, {date}, and {time}.
+Promoting moves the scratch file unless keep_after_promote is set.
+"""
+
+import json
+import logging
+import os
+import re
+import shutil
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.library_entry import SCOPE_PERSONAL
+from .version_service_impl import get_current_user
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+ASSETS_DIR_NAME = "assets"
+SCRATCH_SUBFOLDER = "scenes"
+SCRATCH_LIBRARY_NAME = "Scratch"
+PROMOTED_METADATA_KEY = "promoted_from"
+
+SCRATCH_FORMATS = {".ma": "mayaAscii", ".mb": "mayaBinary"}
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "root": "",  # "" keeps the scratch library in ~/.assetmanager/scratch
+    "file_format": ".ma",
+    "name_pattern": "{object}_{date}_{time}",
+    "keep_after_promote": False,
+}
+
+# Characters kept in auto names; everything else becomes an underscore
+_UNSAFE_NAME = re.compile(r"[^A-Za-z0-9_\-]+")
+# The time stamp auto names end with, dropped from the suggested curated name
+_NAME_STAMP = re.compile(r"_\d{8}(_\d{6})?(_\d+)?$")
+
+
+def make_scratch_name(
+    selection: List[str], pattern: str = "", user: str = "", now: Optional[datetime] = None
+) -> str:
+    """Get the auto name of a quick export: crate_20261014_153012"""
+    now = now or datetime.now()
+    node = selection[0] if selection else "scene"
+    # |group1|ns:crate -> crate
+    node = node.rsplit("|", 1)[-1].rsplit(":", 1)[-1] or "scene"
+    name = (pattern or DEFAULT_CONFIG["name_pattern"]).format(
+        object=node,
+        user=user,
+        date=now.strftime("%Y%m%d"),
+        time=now.strftime("%H%M%S"),
+    )
+    return _UNSAFE_NAME.sub("_", name).strip("_") or "scratch"
+
+
+def suggest_library_name(scratch_name: str) -> str:
+    """Get the curated name of a scratch asset, without its time stamp"""
+    return _NAME_STAMP.sub("", scratch_name) or scratch_name
+
+
+class ScratchService:
+    """
+    Scratch Service - Single Responsibility for the artist's scratch library
+    Scratch assets have no versions or reviews until they are promoted
+    """
+
+    def __init__(self, config_file: Optional[Path] = None, version_service: Any = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "scratch.json"
+        self._version_service = version_service
+        self._config: Dict[str, Any] = self._load_config()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self) -> Dict[str, Any]:
+        """Get a copy of the scratch configuration"""
+        return dict(self._config)
+
+    def set_config(self, **values: Any) -> None:
+        """Update configuration values (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown scratch settings: {', '.join(sorted(unknown))}")
+        if "file_format" in values and values["file_format"] not in SCRATCH_FORMATS:
+            raise ValueError(f"Scratch exports are Maya files, not '{values['file_format']}'")
+        self._config.update(values)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save scratch settings: {e}")
+            return False
+
+    # Scratch library --------------------------------------------------------------------
+
+    def get_root(self) -> Path:
+        """Get the scratch library folder"""
+        configured = str(self._config.get("root") or "").strip()
+        if configured:
+            return Path(os.path.expandvars(configured)).expanduser()
+        return self._config_file.parent / "scratch"  # ~/.assetmanager/scratch
+
+    def is_scratch(self, path: Path) -> bool:
+        """Check if a file or library is (in) the scratch library"""
+        try:
+            Path(path).resolve().relative_to(self.get_root().resolve())
+            return True
+        except (ValueError, OSError):
+            return False
+
+    def ensure_library(self, registry: Any) -> Any:
+        """Create the scratch library and list it in the library picker"""
+        root = self.get_root()
+        (root / ASSETS_DIR_NAME / SCRATCH_SUBFOLDER).mkdir(parents=True, exist_ok=True)
+        entry = registry.find_library(root)
+        if entry is not None:
+            return entry
+        try:
+            entry = registry.register_library(SCRATCH_LIBRARY_NAME, root, SCOPE_PERSONAL)
+            registry.save()
+        except ValueError:
+            entry = registry.remember_library(root)  # "Scratch" names another library
+        return entry
+
+    def get_assets(self) -> List[Path]:
+        """Get the scratch exports, newest first"""
+        folder = self.get_root() / ASSETS_DIR_NAME / SCRATCH_SUBFOLDER
+        if not folder.is_dir():
+            return []
+        files = [p for p in folder.iterdir() if p.is_file() and p.suffix in SCRATCH_FORMATS]
+        return sorted(files, key=lambda p: p.stat().st_mtime, reverse=True)
+
+    # Quick export -----------------------------------------------------------------------
+
+    def suggest_name(self, cmds: Any) -> str:
+        """Get the auto name of a quick export of the current selection"""
+        selection = cmds.ls(selection=True) or []
+        pattern = self._config.get("name_pattern", "")
+        return make_scratch_name(selection, pattern, get_current_user())
+
+    def get_export_path(self, name: str) -> Path:
+        """Get a scratch file for a name, numbered when the name is taken"""
+        extension = self._config.get("file_format") or ".ma"
+        folder = self.get_root() / ASSETS_DIR_NAME / SCRATCH_SUBFOLDER
+        name = _UNSAFE_NAME.sub("_", name.strip()).strip("_") or "scratch"
+        path, number = folder / f"{name}{extension}", 2
+        while path.exists():
+            path, number = folder / f"{name}_{number}{extension}", number + 1
+        return path
+
+    def export_selection(self, cmds: Any, name: str = "") -> Path:
+        """
+        Export the Maya selection to the scratch library
+
+        Args:
+            cmds: maya.cmds module
+            name: Asset name, the auto name when empty
+
+        Raises:
+            ValueError: When nothing is selected
+        """
+        selection = cmds.ls(selection=True) or []
+        if not selection:
+            raise ValueError("Select the objects to export to scratch")
+        export_path = self.get_export_path(name or self.suggest_name(cmds))
+        export_path.parent.mkdir(parents=True, exist_ok=True)
+        cmds.file(
+            str(export_path),
+            force=True,
+            options="v=0",
+            type=SCRATCH_FORMATS[export_path.suffix],
+            exportSelected=True,
+        )
+        print(f"[OK] Exported {len(selection)} object(s) to scratch: {export_path.name}")
+        return export_path
+
+    # Promotion --------------------------------------------------------------------------
+
+    def get_promote_path(self, library_root: Path, name: str, extension: str) -> Path:
+        """Get the library file a promoted asset is written to (as publishes file it)"""
+        return Path(library_root) / ASSETS_DIR_NAME / SCRATCH_SUBFOLDER / f"{name}{extension}"
+
+    def promote(
+        self,
+        scratch_file: Path,
+        library_root: Path,
+        name: str,
+        notes: str = "",
+        database: Any = None,
+    ) -> Tuple[Path, Any]:
+        """
+        Copy a scratch asset into a curated library as a new asset
+
+        Args:
+            scratch_file: Scratch export to promote
+            library_root: Library it becomes an asset of
+            name: Asset name in the library
+            notes: Notes of the asset's first version
+            database: Library metadata database keeping where the asset came from
+
+        Returns:
+            The library asset file and its first version
+
+        Raises:
+            ValueError: When the file is not a scratch asset or the name is empty
+            FileExistsError: When the library already has an asset of that name
+        """
+        scratch_file = Path(scratch_file)
+        if not self.is_scratch(scratch_file) or not scratch_file.is_file():
+            raise ValueError(f"{scratch_file.name} is not in the scratch library")
+        if self.is_scratch(library_root):
+            raise ValueError("Choose a library other than the scratch library")
+        name = _UNSAFE_NAME.sub("_", name.strip()).strip("_")
+        if not name:
+            raise ValueError("Asset name cannot be empty")
+        target = self.get_promote_path(library_root, name, scratch_file.suffix)
+        if target.exists():
+            raise FileExistsError(f"The library already has an asset named '{name}'")
+
+        target.parent.mkdir(parents=True, exist_ok=True)
+        # Copy next to the asset first, so the library never lists half a file
+        partial = target.with_name(f".{target.name}.promote")
+        shutil.copy2(scratch_file, partial)
+        os.replace(partial, target)
+
+        version_notes = notes.strip() or f"Promoted from scratch {scratch_file.name}"
+        version = self._get_version_service().publish_version(target, notes=version_notes)
+        if database is not None:
+            self._store_metadata(database, target, scratch_file)
+        if not self._config.get("keep_after_promote"):
+            try:
+                scratch_file.unlink()
+            except OSError as e:
+                print(f"[WARNING] Could not remove promoted scratch file {scratch_file}: {e}")
+        print(f"[OK] Promoted {scratch_file.name} to {library_root} as {target.name}")
+        return target, version
+
+    def _store_metadata(self, database: Any, asset_file: Path, scratch_file: Path) -> None:
+        """Keep where a promoted asset came from with its library metadata"""
+        try:
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[PROMOTED_METADATA_KEY] = {
+                "source": scratch_file.name,
+                "user": get_current_user(),
+                "date": datetime.now().isoformat(timespec="seconds"),
+            }
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Could not store where {asset_file.name} came from: {e}")
+
+    # Storage ----------------------------------------------------------------------------
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Could not read scratch settings: {e}")
+        if config["file_format"] not in SCRATCH_FORMATS:
+            config["file_format"] = DEFAULT_CONFIG["file_format"]
+        return config
+
+    def _get_version_service(self) -> Any:
+        """Get the version service promoted assets get their first version from"""
+        if self._version_service is None:
+            from .version_service_impl import get_version_service
+
+            self._version_service = get_version_service()
+        return self._version_service
+
+
+# Singleton instance factory
+_scratch_service_instance = None
+
+
+def get_scratch_service() -> ScratchService:
+    """
+    Get singleton instance of ScratchService.
+
+    Returns:
+        ScratchService: Singleton service instance
+    """
+    global _scratch_service_instance
+    if _scratch_service_instance is None:
+        _scratch_service_instance = ScratchService()
+    return _scratch_service_instance
@@ -0,0 +1,603 @@
+# -*- coding: utf-8 -*-
+"""
+Search Engine Implementation
+In-memory indexed search with field filters and boolean operators
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Query syntax::
+
+    car                         name, tag, category, or author word starting with "car"
+    "sports car"                exact phrase in name or tags
+    tag:vehicle                 tag (wildcards allowed: tag:veh*)
+    tag:environment/exterior    that tag and the tags below it (environment/exterior/forest)
+    type:rig                    model / rig / texture / anim / clip / pose, type, or ext
+                                (studio asset types also match their id and keywords)
+    author:mike                 artist who published a version
+    after:2024-01  before:2024-06-30  date:2024-03   modified date filters
+    updated:7d  after:2w  date:today                 relative dates (d / w / m days back)
+    is:favorite                 favorites only
+    status:approved  status:review  status:deprecated  status:wip   review status
+    tris:>100k  verts:<5000  uvsets:>1  texres:>=4096  bbox:<50  skinned:yes
+                                geometry stats captured at publish time
+    approved_by:kim  polycount_budget:<5000  game_ready:yes   custom fields of the library
+                                schema (numbers take < <= > >= and 100..500 ranges, 100k 2m)
+    A AND B   A OR B   NOT A   -A   ( ... )
+
+Adjacent terms are combined with AND. The index is rebuilt on library refresh, so
+queries never touch the disk and are fast enough to run on every keystroke.
+"""
+
+import fnmatch
+import re
+from bisect import bisect_left
+from dataclasses import dataclass, field
+from datetime import date, datetime, timedelta
+from typing import Any, Dict, List, Optional, Set, Tuple
+
+from ..core.models.asset_status import STATUS_WIP, AssetStatus, parse_status
+from ..core.models.geometry_stats import STAT_FILTERS, GeometryStats
+from ..core.models.metadata_field import (
+    FALSE_WORDS,
+    FIELD_TYPE_BOOLEAN,
+    FIELD_TYPE_NUMBER,
+    TRUE_WORDS,
+    MetadataField,
+)
+from ..core.models.tag_hierarchy import is_tag_within
+from .asset_type_service_impl import get_asset_type_service
+
+# Extension groups used for type: filters
+TEXTURE_EXTENSIONS = {".png", ".jpg", ".jpeg", ".tif", ".tiff", ".tga", ".exr", ".hdr", ".tx"}
+MODEL_EXTENSIONS = {".ma", ".mb", ".obj", ".fbx", ".abc", ".usd", ".usda", ".usdc", ".usdz"}
+RIG_KEYWORDS = {"rig", "rigs", "rigged", "skeleton"}
+ANIM_KEYWORDS = {"anim", "anims", "animation", "animations", "cycle", "mocap"}
+
+FIELD_ALIASES = {
+    "tag": "tag",
+    "tags": "tag",
+    "type": "type",
+    "kind": "type",
+    "ext": "ext",
+    "author": "author",
+    "by": "author",
+    "name": "name",
+    "category": "category",
+    "cat": "category",
+    "after": "after",
+    "since": "after",
+    "updated": "after",
+    "before": "before",
+    "until": "before",
+    "date": "date",
+    "is": "is",
+    "tris": "tris",
+    "triangles": "tris",
+    "polys": "tris",
+    "verts": "verts",
+    "vertices": "verts",
+    "uvsets": "uvsets",
+    "texres": "texres",
+    "bbox": "bbox",
+    "skinned": "skinned",
+    "status": "status",
+    "state": "status",
+}
+
+_TOKEN_PATTERN = re.compile(r'\(|\)|-?(?:\w+:)?"[^"]*"?|[^\s()]+')
+_WORD_PATTERN = re.compile(r"[a-z0-9]+")
+_RELATIVE_DATE_PATTERN = re.compile(r"^(\d+)([dwm])$")
+_RELATIVE_DATE_DAYS = {"d": 1, "w": 7, "m": 30}
+_FIELD_NAME_PATTERN = re.compile(r"^\w+$")
+_NUMBER = r"-?\d+(?:\.\d+)?[km]?"
+_NUMBER_SUFFIXES = {"k": 1000.0, "m": 1000000.0}
+_NUMBER_FILTER_PATTERN = re.compile(rf"^(<=|>=|<|>)?({_NUMBER})(?:\.\.({_NUMBER}))?$")
+
+
+# Query tree -----------------------------------------------------------------------------
+
+
+@dataclass
+class QueryTerm:
+    """Leaf of the query tree: a field filter or free text"""
+
+    field: str  # "" for free text
+    value: str
+    phrase: bool = False
+
+
+@dataclass
+class QueryNot:
+    """Negated sub-query"""
+
+    child: Any
+
+
+@dataclass
+class QueryGroup:
+    """AND / OR over sub-queries"""
+
+    operator: str  # "AND" or "OR"
+    children: List[Any] = field(default_factory=list)
+
+
+def _tokenize(text: str) -> List[str]:
+    """Split query text into terms, operators, and parentheses"""
+    return _TOKEN_PATTERN.findall(text)
+
+
+def _make_term(token: str) -> Any:
+    """Build a (possibly negated) term node from one token"""
+    negated = token.startswith("-") and len(token) > 1
+    if negated:
+        token = token[1:]
+
+    field_name = ""
+    if ":" in token and not token.startswith('"'):
+        prefix, value = token.split(":", 1)
+        if prefix.lower() in FIELD_ALIASES and value:
+            field_name, token = FIELD_ALIASES[prefix.lower()], value
+        elif _FIELD_NAME_PATTERN.match(prefix) and value:
+            # Possibly a custom schema field; the index falls back to free text otherwise
+            field_name, token = prefix.lower(), value
+
+    phrase = token.startswith('"')
+    value = token.strip('"')
+    term = QueryTerm(field_name, value, phrase=phrase and not field_name)
+    return QueryNot(term) if negated else term
+
+
+class _QueryParser:
+    """Recursive descent parser: OR binds loosest, then AND, then NOT"""
+
+    def __init__(self, tokens: List[str]):
+        self._tokens = tokens
+        self._position = 0
+
+    def parse(self) -> Optional[Any]:
+        node = self._parse_or()
+        # Stray closing parentheses - keep parsing what follows
+        while self._position < len(self._tokens):
+            self._position += 1
+            rest = self._parse_or()
+            if rest is not None:
+                node = rest if node is None else QueryGroup("AND", [node, rest])
+        return node
+
+    def _peek(self) -> Optional[str]:
+        if self._position < len(self._tokens):
+            return self._tokens[self._position]
+        return None
+
+    def _parse_or(self) -> Optional[Any]:
+        children = [self._parse_and()]
+        while self._peek() == "OR":
+            self._position += 1
+            children.append(self._parse_and())
+        children = [child for child in children if child is not None]
+        if not children:
+            return None
+        return children[0] if len(children) == 1 else QueryGroup("OR", children)
+
+    def _parse_and(self) -> Optional[Any]:
+        children = []
+        while True:
+            token = self._peek()
+            if token is None or token in (")", "OR"):
+                break
+            if token == "AND":
+                self._position += 1
+                continue
+            node = self._parse_not()
+            if node is not None:
+                children.append(node)
+        if not children:
+            return None
+        return children[0] if len(children) == 1 else QueryGroup("AND", children)
+
+    def _parse_not(self) -> Optional[Any]:
+        token = self._peek()
+        if token is None or token in (")", "OR", "AND"):
+            return None  # An operator with nothing after it (NOT, a OR NOT) is dropped
+        if token == "NOT":
+            self._position += 1
+            child = self._parse_not()
+            return QueryNot(child) if child is not None else None
+        if token == "(":
+            self._position += 1
+            node = self._parse_or()
+            if self._peek() == ")":
+                self._position += 1
+            return node
+        self._position += 1
+        return _make_term(token)
+
+
+def parse_query(text: str) -> Optional[Any]:
+    """
+    Parse search text into a query tree
+
+    Args:
+        text: Query typed by the artist; malformed input never raises
+
+    Returns:
+        Root node (QueryTerm / QueryNot / QueryGroup), None for an empty query
+    """
+    return _QueryParser(_tokenize(text or "")).parse()
+
+
+def _to_number(text: str) -> float:
+    """Convert 5000, 2.5, 100k, or 2m to a number"""
+    multiplier = _NUMBER_SUFFIXES.get(text[-1], 1.0)
+    return float(text.rstrip("km")) * multiplier
+
+
+def _parse_number_filter(value: str) -> Optional[Any]:
+    """Parse 5000, <5000, >=100, 100..500, or >100k into a predicate on numbers"""
+    match = _NUMBER_FILTER_PATTERN.match(value.strip())
+    if match is None:
+        return None
+    operator, first, last = match.groups()
+    low = _to_number(first)
+    high = _to_number(last) if last is not None else None
+    if high is not None:
+        return lambda number: low <= number <= high
+    return {
+        "<": lambda number: number < low,
+        "<=": lambda number: number <= low,
+        ">": lambda number: number > low,
+        ">=": lambda number: number >= low,
+    }.get(operator or "", lambda number: number == low)
+
+
+def _parse_date_range(value: str, today: Optional[date] = None) -> Optional[Tuple[date, date]]:
+    """Parse YYYY, YYYY-MM, YYYY-MM-DD, today, yesterday, or 7d / 2w / 3m (ago)"""
+    today = today or date.today()
+    value = value.lower()
+    if value in ("today", "yesterday"):
+        day = today if value == "today" else today - timedelta(days=1)
+        return day, day
+    relative = _RELATIVE_DATE_PATTERN.match(value)
+    if relative:
+        # From N units ago up to today, so smart collections keep a rolling window
+        days = int(relative.group(1)) * _RELATIVE_DATE_DAYS[relative.group(2)]
+        try:
+            return today - timedelta(days=days), today
+        except OverflowError:
+            return date.min, today  # modified:999999d reaches back past year 1
+
+    parts = value.split("-")
+    try:
+        numbers = [int(part) for part in parts]
+        if len(numbers) == 1:
+            return date(numbers[0], 1, 1), date(numbers[0], 12, 31)
+        if len(numbers) == 2:
+            year, month = numbers
+            start = date(year, month, 1)
+            next_month = date(year + month // 12, month % 12 + 1, 1)
+            return start, date.fromordinal(next_month.toordinal() - 1)
+        if len(numbers) == 3:
+            day = date(*numbers)
+            return day, day
+    except ValueError:
+        pass
+    return None
+
+
+# Index ----------------------------------------------------------------------------------
+
+
+@dataclass
+class SearchDocument:
+    """Pre-computed searchable fields of one asset"""
+
+    asset: Any
+    name: str
+    tags: Set[str]
+    kinds: Set[str]
+    extension: str
+    category: str
+    authors: Set[str]
+    modified: Optional[date]
+    is_favorite: bool
+    text: str  # lower-case name and tags for phrase matching
+    fields: Dict[str, Any] = field(default_factory=dict)  # Custom field key -> value
+    stats: Optional[GeometryStats] = None  # Captured at publish, None for older assets
+    status: str = STATUS_WIP  # Review status
+
+
+def classify_asset_kinds(asset: Any) -> Set[str]:
+    """Get the type: keywords an asset answers to (model, rig, texture, anim...)"""
+    extension = str(getattr(asset, "file_extension", "") or "").lower()
+    if extension and not extension.startswith("."):
+        extension = f".{extension}"
+    category = str(getattr(asset, "category", "") or "").lower()
+    words = set(_WORD_PATTERN.findall(str(getattr(asset, "name", "")).lower()))
+    words |= {str(tag).lower() for tag in getattr(asset, "tags", []) or []}
+    words.add(category)
+
+    kinds = {str(getattr(asset, "asset_type", "unknown")).lower(), extension.lstrip(".")}
+    if category:
+        kinds.add(category)
+    if extension in TEXTURE_EXTENSIONS:
+        kinds.add("texture")
+    elif extension == ".animclip":
+        kinds.update({"anim", "clip"})
+    elif extension == ".pose":
+        kinds.add("pose")
+    elif extension == ".blendshape":
+        kinds.update({"blendshape", "shape"})
+    elif extension == ".skinweights":
+        kinds.update({"skin", "weights", "rig"})
+    elif extension == ".lightrig":
+        kinds.update({"light", "hdri"})
+    elif extension == ".groom":
+        kinds.update({"groom", "hair"})
+    elif extension == ".texset":
+        kinds.add("texture")
+    elif extension == ".assembly":
+        kinds.update({"assembly", "set"})
+    elif extension == ".volume":
+        kinds.update({"volume", "vdb", "fx"})
+    elif extension in MODEL_EXTENSIONS:
+        if words & RIG_KEYWORDS:
+            kinds.add("rig")
+        elif words & ANIM_KEYWORDS:
+            kinds.add("anim")
+        else:
+            kinds.add("model")
+    else:
+        plugin = get_asset_type_service().get_plugin_for_extension(extension)
+        if plugin is not None:
+            kinds.add(plugin.type_id.lower())
+            kinds.update(keyword.lower() for keyword in plugin.search_keywords)
+    return kinds
+
+
+class SearchIndex:
+    """
+    Search Index - Single Responsibility for fast in-memory asset queries
+    Word index supports prefix lookups so partial words match while typing
+    """
+
+    def __init__(self):
+        self._documents: List[SearchDocument] = []
+        self._words: Dict[str, Set[int]] = {}
+        self._sorted_words: List[str] = []
+        self._fields: Dict[str, MetadataField] = {}  # Custom field key -> field
+
+    def __len__(self) -> int:
+        return len(self._documents)
+
+    def build(
+        self,
+        assets: List[Any],
+        authors: Optional[Dict[str, List[str]]] = None,
+        fields: Optional[List[MetadataField]] = None,
+    ) -> None:
+        """
+        Index a library
+
+        Args:
+            assets: Library assets (tags, favorites, and metadata["stats"] already loaded)
+            authors: Optional asset file path (str) -> artists who published it
+            fields: Custom fields of the library schema; values come from metadata["fields"]
+        """
+        authors = authors or {}
+        self._documents = []
+        self._words = {}
+        self._fields = {schema_field.key: schema_field for schema_field in fields or []}
+
+        for asset in assets:
+            asset_authors = {a.lower() for a in authors.get(str(asset.file_path), [])}
+            metadata_author = (getattr(asset, "metadata", None) or {}).get("author")
+            if metadata_author:
+                asset_authors.add(str(metadata_author).lower())
+
+            stored = (getattr(asset, "metadata", None) or {}).get("fields") or {}
+            field_values = {
+                schema_field.key: stored[schema_field.name]
+                for schema_field in self._fields.values()
+                if stored.get(schema_field.name) is not None
+            }
+
+            stored_stats = (getattr(asset, "metadata", None) or {}).get("stats")
+            try:
+                stats = GeometryStats.from_dict(stored_stats) if stored_stats else None
+            except (AttributeError, TypeError, ValueError):
+                stats = None
+
+            stored_status = (getattr(asset, "metadata", None) or {}).get("status")
+            status = AssetStatus.from_dict(
+                stored_status if isinstance(stored_status, dict) else None
+            )
+
+            modified = getattr(asset, "modified_date", None)
+            tags = {str(tag).lower() for tag in getattr(asset, "tags", []) or []}
+            name = str(getattr(asset, "display_name", None) or asset.name).lower()
+            extension = str(getattr(asset, "file_extension", "") or "").lower().lstrip(".")
+            document = SearchDocument(
+                asset=asset,
+                name=name,
+                tags=tags,
+                kinds=classify_asset_kinds(asset),
+                extension=extension,
+                category=str(getattr(asset, "category", "") or "").lower(),
+                authors=asset_authors,
+                modified=modified.date() if isinstance(modified, datetime) else None,
+                is_favorite=bool(getattr(asset, "is_favorite", False)),
+                text=" ".join([name] + sorted(tags)),
+                fields=field_values,
+                stats=stats,
+                status=status.state,
+            )
+            doc_id = len(self._documents)
+            self._documents.append(document)
+
+            words = set(_WORD_PATTERN.findall(" ".join([document.text, document.category])))
+            for author in asset_authors:
+                words.update(_WORD_PATTERN.findall(author))
+            for value in field_values.values():
+                if isinstance(value, str):
+                    words.update(_WORD_PATTERN.findall(value.lower()))
+            for word in words:
+                self._words.setdefault(word, set()).add(doc_id)
+
+        self._sorted_words = sorted(self._words)
+
+    def search(self, query_text: str) -> List[Any]:
+        """
+        Run a query against the index
+
+        Args:
+            query_text: Query in the syntax described in the module docstring
+
+        Returns:
+            Matching assets in library order (all assets for an empty query)
+        """
+        root = parse_query(query_text)
+        if root is None:
+            return [document.asset for document in self._documents]
+        matches = self._evaluate(root)
+        return [document.asset for i, document in enumerate(self._documents) if i in matches]
+
+    def _all_ids(self) -> Set[int]:
+        return set(range(len(self._documents)))
+
+    def _evaluate(self, node: Any) -> Set[int]:
+        """Evaluate a query node to the set of matching document ids"""
+        if isinstance(node, QueryGroup):
+            results = [self._evaluate(child) for child in node.children]
+            if node.operator == "OR":
+                return set().union(*results)
+            return set.intersection(*results) if results else self._all_ids()
+        if isinstance(node, QueryNot):
+            return self._all_ids() - self._evaluate(node.child)
+        return self._evaluate_term(node)
+
+    def _evaluate_term(self, term: QueryTerm) -> Set[int]:
+        """Evaluate a leaf term"""
+        value = term.value.lower()
+        if not term.field:
+            return self._match_text(value, term.phrase)
+
+        if term.field in ("after", "before", "date"):
+            return self._match_date(term.field, value)
+        if term.field in self._fields:
+            return self._match_field(self._fields[term.field], value)
+        if term.field in STAT_FILTERS or term.field == "skinned":
+            return self._match_stat(term.field, value)
+        if term.field not in FIELD_ALIASES.values():
+            # Not a field of this library - search word:word as plain text
+            return self._match_text(f"{term.field} {value}", phrase=False)
+
+        matcher = {
+            "tag": lambda d: any(
+                is_tag_within(tag, value) or fnmatch.fnmatchcase(tag, value) for tag in d.tags
+            ),
+            "type": lambda d: value in d.kinds,
+            "ext": lambda d: d.extension == value.lstrip("."),
+            "author": lambda d: any(author.startswith(value) for author in d.authors),
+            "name": lambda d: value in d.name,
+            "category": lambda d: d.category == value,
+            "is": lambda d: value.startswith("fav") and d.is_favorite,
+            "status": lambda d: d.status == parse_status(value),
+        }[term.field]
+        return {i for i, document in enumerate(self._documents) if matcher(document)}
+
+    def _match_field(self, schema_field: MetadataField, value: str) -> Set[int]:
+        """Filter on a custom field: yes/no, number comparisons, or text wildcards"""
+        key = schema_field.key
+        if schema_field.field_type == FIELD_TYPE_BOOLEAN:
+            if value not in TRUE_WORDS and value not in FALSE_WORDS:
+                return set()
+            wanted = value in TRUE_WORDS
+            # Unset booleans count as "no"
+            return {
+                i
+                for i, d in enumerate(self._documents)
+                if bool(d.fields.get(key, False)) == wanted
+            }
+
+        if schema_field.field_type == FIELD_TYPE_NUMBER:
+            number_filter = _parse_number_filter(value)
+            if number_filter is None:
+                return set()
+            return {
+                i
+                for i, d in enumerate(self._documents)
+                if isinstance(d.fields.get(key), (int, float)) and number_filter(d.fields[key])
+            }
+
+        pattern = value if any(c in value for c in "*?[") else f"*{value}*"
+        return {
+            i
+            for i, d in enumerate(self._documents)
+            if key in d.fields and fnmatch.fnmatchcase(str(d.fields[key]).lower(), pattern)
+        }
+
+    def _match_stat(self, field_name: str, value: str) -> Set[int]:
+        """Filter on publish-time geometry stats; assets without stats never match"""
+        if field_name == "skinned":
+            if value not in TRUE_WORDS and value not in FALSE_WORDS:
+                return set()
+            wanted = value in TRUE_WORDS
+            return {
+                i
+                for i, d in enumerate(self._documents)
+                if d.stats is not None and d.stats.has_skin_cluster == wanted
+            }
+
+        number_filter = _parse_number_filter(value)
+        if number_filter is None:
+            return set()
+        return {
+            i
+            for i, d in enumerate(self._documents)
+            if d.stats is not None and number_filter(d.stats.get_filter_value(field_name))
+        }
+
+    def _match_text(self, value: str, phrase: bool) -> Set[int]:
+        """Free-text match: phrase substring, else every word as a prefix"""
+        if phrase:
+            return {i for i, document in enumerate(self._documents) if value in document.text}
+
+        words = _WORD_PATTERN.findall(value)
+        if not words:
+            return self._all_ids()
+
+        result = self._all_ids()
+        for word in words:
+            result &= self._prefix_lookup(word)
+        return result
+
+    def _prefix_lookup(self, prefix: str) -> Set[int]:
+        """Get documents containing any indexed word starting with prefix"""
+        ids: Set[int] = set()
+        start = bisect_left(self._sorted_words, prefix)
+        for word in self._sorted_words[start:]:
+            if not word.startswith(prefix):
+                break
+            ids |= self._words[word]
+        return ids
+
+    def _match_date(self, field_name: str, value: str) -> Set[int]:
+        """Filter on modified date (after: / before: / date: absolute or relative)"""
+        date_range = _parse_date_range(value)
+        if date_range is None:
+            return set()
+        start, end = date_range
+        if field_name == "before" and _RELATIVE_DATE_PATTERN.match(value.lower()):
+            end = start  # before:7d means older than a week
+
+        def matches(modified: Optional[date]) -> bool:
+            if modified is None:
+                return False
+            if field_name == "after":
+                return modified >= start
+            if field_name == "before":
+                return modified <= end
+            return start <= modified <= end
+
+        return {i for i, d in enumerate(self._documents) if matches(d.modified)}
@@ -0,0 +1,302 @@
+# -*- coding: utf-8 -*-
+"""
+Shader Conversion Service Implementation
+Converts imported material networks to the scene's active renderer with pluggable converters
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Converter folders, in load order (a later converter for the same source type and
+renderer is used over an earlier one)::
+
+    src/services/shader_converters/            <- built-in converters
+    ~/.assetmanager/shader_converters/         <- per-artist converters
+    $ASSET_MANAGER_SHADER_CONVERTER_PATH       <- studio folders (os.pathsep separated)
+    <library>/.assetmanager/shader_converters/ <- converters shipped with a shared library
+"""
+
+import importlib
+import importlib.util
+import inspect
+import logging
+import os
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.interfaces.shader_converter import IShaderConverter
+from ..core.models.shader_conversion import (
+    RENDERER_ARNOLD,
+    RENDERER_LABELS,
+    RENDERER_MAYA,
+    RENDERER_REDSHIFT,
+    RENDERER_RENDERMAN,
+    RENDERER_VRAY,
+    ShaderConversionReport,
+    UnconvertedNode,
+)
+
+SHADER_CONVERTER_PATH_ENV = "ASSET_MANAGER_SHADER_CONVERTER_PATH"
+CONVERTERS_DIR_NAME = "shader_converters"
+BUILTIN_CONVERTERS_DIR = Path(__file__).with_name(CONVERTERS_DIR_NAME)
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+# defaultRenderGlobals.currentRenderer -> renderer (Maya Software, Hardware map to Maya)
+SCENE_RENDERERS = {
+    "arnold": RENDERER_ARNOLD,
+    "vray": RENDERER_VRAY,
+    "redshift": RENDERER_REDSHIFT,
+    "renderman": RENDERER_RENDERMAN,
+    "renderManRIS": RENDERER_RENDERMAN,
+}
+
+# Node type prefixes of each renderer's own nodes (aiNoise, VRayMtl, PxrSurface)
+NODE_TYPE_PREFIXES = {
+    RENDERER_ARNOLD: ("ai",),
+    RENDERER_VRAY: ("VRay",),
+    RENDERER_REDSHIFT: ("Redshift",),
+    RENDERER_RENDERMAN: ("Pxr",),
+}
+
+
+def get_node_renderer(node_type: str) -> str:
+    """Get the renderer a node type belongs to (RENDERER_MAYA for Maya's own nodes)"""
+    for renderer, prefixes in NODE_TYPE_PREFIXES.items():
+        for prefix in prefixes:
+            remainder = node_type[len(prefix) :]
+            if node_type.startswith(prefix) and remainder[:1].isupper():
+                return renderer
+    return RENDERER_MAYA
+
+
+class ShaderConversionService:
+    """
+    Shader Conversion Service - Single Responsibility for import-time material translation
+    Anything that cannot be translated is left in place and listed in the report
+    """
+
+    def __init__(self, user_converters_dir: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._user_converters_dir = user_converters_dir or USER_CONFIG_DIR / CONVERTERS_DIR_NAME
+
+    # Discovery --------------------------------------------------------------------------
+
+    def get_converter_directories(self, library_root: Optional[Path] = None) -> List[Path]:
+        """Get converter folders in load order (built-in folder is loaded separately)"""
+        directories = [self._user_converters_dir]
+        for entry in os.environ.get(SHADER_CONVERTER_PATH_ENV, "").split(os.pathsep):
+            if entry.strip():
+                directories.append(Path(entry.strip()))
+        if library_root:
+            directories.append(Path(library_root) / ".assetmanager" / CONVERTERS_DIR_NAME)
+        return directories
+
+    def discover_converters(self, library_root: Optional[Path] = None) -> List[IShaderConverter]:
+        """
+        Load every converter
+
+        Args:
+            library_root: Current library, whose own converters folder is included
+
+        Returns:
+            Converter instances ordered by load order
+        """
+        classes: Dict[str, type] = {}
+        for module in self._load_builtin_modules():
+            self._collect_converter_classes(module, classes)
+        for directory in self.get_converter_directories(library_root):
+            for module in self._load_folder_modules(directory):
+                self._collect_converter_classes(module, classes)
+
+        converters = []
+        for converter_id, converter_class in classes.items():
+            try:
+                converters.append(converter_class())
+            except Exception as e:
+                print(f"[WARNING] Could not create shader converter {converter_id}: {e}")
+        return converters
+
+    def find_converter(
+        self, converters: List[IShaderConverter], node_type: str, renderer: str
+    ) -> Optional[IShaderConverter]:
+        """Get the converter for a shader type and renderer, preferring later-loaded ones"""
+        for converter in reversed(converters):
+            if converter.target_renderer == renderer and node_type in converter.source_types:
+                return converter
+        return None
+
+    # Conversion -------------------------------------------------------------------------
+
+    def get_scene_renderer(self, cmds: Any) -> str:
+        """Get the scene's active renderer (RENDERER_MAYA for Maya's own renderers)"""
+        try:
+            current = cmds.getAttr("defaultRenderGlobals.currentRenderer")
+        except Exception:
+            return RENDERER_MAYA
+        return SCENE_RENDERERS.get(current, RENDERER_MAYA)
+
+    def find_shaders(self, cmds: Any, roots: List[str]) -> Dict[str, List[str]]:
+        """Get the surface shaders of the meshes under the roots, with their shading groups"""
+        meshes = cmds.listRelatives(roots, allDescendents=True, type="mesh", fullPath=True)
+        if not meshes:
+            return {}
+        shaders: Dict[str, List[str]] = {}
+        engines = cmds.listConnections(meshes, type="shadingEngine") or []
+        for engine in dict.fromkeys(engines):
+            plug = f"{engine}.surfaceShader"
+            for shader in cmds.listConnections(plug, source=True, destination=False) or []:
+                shaders.setdefault(shader, []).append(engine)
+        return shaders
+
+    def convert_imported(
+        self,
+        cmds: Any,
+        roots: List[str],
+        library_root: Optional[Path] = None,
+        renderer: Optional[str] = None,
+    ) -> Optional[ShaderConversionReport]:
+        """
+        Convert the materials of freshly imported nodes to the scene's renderer
+
+        Args:
+            cmds: maya.cmds module
+            roots: Top-level nodes of the import
+            library_root: Current library for library-specific converters
+            renderer: Target renderer (the scene's active renderer when omitted)
+
+        Returns:
+            Conversion report, None when the scene renders with Maya or nothing has shaders
+        """
+        renderer = renderer or self.get_scene_renderer(cmds)
+        shaders = self.find_shaders(cmds, roots)
+        if renderer == RENDERER_MAYA or not shaders:
+            return None
+
+        report = ShaderConversionReport(renderer)
+        target_label = RENDERER_LABELS.get(renderer, renderer)
+        converters = self.discover_converters(library_root)
+        for shader, engines in shaders.items():
+            node_type = cmds.nodeType(shader)
+            shader_renderer = get_node_renderer(node_type)
+            if shader_renderer == renderer:
+                continue
+            converter = self.find_converter(converters, node_type, renderer)
+            if converter is None:
+                # Maya's own materials render everywhere; only foreign ones are reported
+                if shader_renderer != RENDERER_MAYA:
+                    source_label = RENDERER_LABELS.get(shader_renderer, shader_renderer)
+                    reason = f"{source_label} shader, no {target_label} converter"
+                    report.unconverted.append(UnconvertedNode(shader, node_type, reason))
+                continue
+            if cmds.referenceQuery(shader, isNodeReferenced=True):
+                reason = "referenced shader, convert it in its source asset"
+                report.unconverted.append(UnconvertedNode(shader, node_type, reason))
+                continue
+            try:
+                converted, unconverted = self._replace_shader(cmds, shader, engines, converter)
+            except Exception as e:
+                self.logger.error(f"Shader converter {converter.converter_id} failed: {e}")
+                reason = f"{converter.name or converter.converter_id} failed: {e}"
+                report.unconverted.append(UnconvertedNode(shader, node_type, reason))
+                continue
+            report.converted.append((converted, converter.target_type))
+            report.unconverted.extend(unconverted)
+
+        report.unconverted.extend(self._find_foreign_nodes(cmds, roots, report))
+        print(f"[INFO] Material conversion: {report.summary()}")
+        return report
+
+    # Internals --------------------------------------------------------------------------
+
+    def _replace_shader(
+        self, cmds: Any, shader: str, engines: List[str], converter: IShaderConverter
+    ) -> Tuple[str, List[UnconvertedNode]]:
+        """Convert a shader, swap it into its shading groups, and take over its name"""
+        new_shader, unconverted = converter.convert(cmds, shader)
+        for engine in engines:
+            cmds.connectAttr(f"{new_shader}.outColor", f"{engine}.surfaceShader", force=True)
+        # Deleting the shader alone keeps its upstream textures, now feeding the new one
+        cmds.delete(shader)
+        return cmds.rename(new_shader, shader), unconverted
+
+    def _find_foreign_nodes(
+        self, cmds: Any, roots: List[str], report: ShaderConversionReport
+    ) -> List[UnconvertedNode]:
+        """Get upstream nodes of another renderer that the target renderer ignores"""
+        reported = {entry.node for entry in report.unconverted}
+        target_label = report.renderer_label
+        foreign = []
+        for shader in self.find_shaders(cmds, roots):
+            for node in cmds.listHistory(shader) or []:
+                if node in reported:
+                    continue
+                node_type = cmds.nodeType(node)
+                node_renderer = get_node_renderer(node_type)
+                if node_renderer in (RENDERER_MAYA, report.renderer):
+                    continue
+                reported.add(node)
+                source_label = RENDERER_LABELS.get(node_renderer, node_renderer)
+                reason = f"{source_label} node, not rendered by {target_label}"
+                foreign.append(UnconvertedNode(node, node_type, reason))
+        return foreign
+
+    def _load_builtin_modules(self) -> List[Any]:
+        """Import built-in converters as package modules (they use relative imports)"""
+        modules = []
+        package = f"{__package__}.{CONVERTERS_DIR_NAME}"
+        for path in sorted(BUILTIN_CONVERTERS_DIR.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            try:
+                modules.append(importlib.import_module(f"{package}.{path.stem}"))
+            except Exception as e:
+                print(f"[WARNING] Could not load built-in shader converter {path.name}: {e}")
+        return modules
+
+    def _load_folder_modules(self, directory: Path) -> List[Any]:
+        """Import every converter file of a plugin folder"""
+        modules: List[Any] = []
+        if not directory.is_dir():
+            return modules
+
+        for path in sorted(directory.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            module_name = f"asset_manager_shader_converter_{abs(hash(str(path)))}_{path.stem}"
+            try:
+                spec = importlib.util.spec_from_file_location(module_name, path)
+                if spec is None or spec.loader is None:
+                    continue
+                module = importlib.util.module_from_spec(spec)
+                spec.loader.exec_module(module)
+                modules.append(module)
+            except Exception as e:
+                # A broken studio converter must never stop artists from importing
+                print(f"[WARNING] Could not load shader converter {path}: {e}")
+        return modules
+
+    def _collect_converter_classes(self, module: Any, classes: Dict[str, type]) -> None:
+        """Add concrete converter classes defined in a module"""
+        for _, member in inspect.getmembers(module, inspect.isclass):
+            if member.__module__ != module.__name__ or inspect.isabstract(member):
+                continue
+            # Duck typing - plugins may import the interface under another package path
+            converter_id = getattr(member, "converter_id", "")
+            if converter_id and callable(getattr(member, "convert", None)):
+                classes[converter_id] = member
+
+
+# Singleton instance factory
+_shader_conversion_service_instance = None
+
+
+def get_shader_conversion_service() -> ShaderConversionService:
+    """
+    Get singleton instance of ShaderConversionService.
+
+    Returns:
+        ShaderConversionService: Singleton service instance
+    """
+    global _shader_conversion_service_instance
+    if _shader_conversion_service_instance is None:
+        _shader_conversion_service_instance = ShaderConversionService()
+    return _shader_conversion_service_instance
@@ -0,0 +1,72 @@
+# -*- coding: utf-8 -*-
+"""
+Built-in Shader Converters
+One module per converter; discovered the same way as studio converter folders
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Dict
+
+# Arnold's shader and Maya's own Standard Surface share their attribute names
+STANDARD_SURFACE_TYPES = ("aiStandardSurface", "standardSurface")
+
+# Standard Surface attribute -> VRayMtl attribute (VRayMtl set to use roughness)
+VRAY_ATTRIBUTES = {
+    "base": "diffuseColorAmount",
+    "baseColor": "color",
+    "diffuseRoughness": "roughnessAmount",
+    "metalness": "metalness",
+    "specular": "reflectionColorAmount",
+    "specularColor": "reflectionColor",
+    "specularRoughness": "reflectionGlossiness",
+    "specularIOR": "refractionIOR",
+    "specularAnisotropy": "anisotropy",
+    "specularRotation": "anisotropyRotation",
+    "transmission": "refractionColorAmount",
+    "transmissionColor": "refractionColor",
+    "coat": "coatAmount",
+    "coatColor": "coatColor",
+    "coatRoughness": "coatGlossiness",
+    "coatIOR": "coatIOR",
+    "sheenColor": "sheenColor",
+    "sheenRoughness": "sheenGlossiness",
+    "emissionColor": "illumColor",
+    "opacity": "opacityMap",
+}
+
+# Standard Surface attribute -> RedshiftStandardMaterial attribute
+REDSHIFT_ATTRIBUTES = {
+    "base": "base_color_weight",
+    "baseColor": "base_color",
+    "diffuseRoughness": "diffuse_roughness",
+    "metalness": "metalness",
+    "specular": "refl_weight",
+    "specularColor": "refl_color",
+    "specularRoughness": "refl_roughness",
+    "specularIOR": "refl_ior",
+    "specularAnisotropy": "refl_aniso",
+    "specularRotation": "refl_aniso_rotation",
+    "transmission": "refr_weight",
+    "transmissionColor": "refr_color",
+    "subsurface": "ms_amount",
+    "subsurfaceColor": "ms_color",
+    "subsurfaceRadius": "ms_radius",
+    "coat": "coat_weight",
+    "coatColor": "coat_color",
+    "coatRoughness": "coat_roughness",
+    "coatIOR": "coat_ior",
+    "sheen": "sheen_weight",
+    "sheenColor": "sheen_color",
+    "sheenRoughness": "sheen_roughness",
+    "emission": "emission_weight",
+    "emissionColor": "emission_color",
+    "opacity": "opacity_color",
+    "thinWalled": "thin_walled",
+}
+
+
+def invert(attribute_map: Dict[str, str]) -> Dict[str, str]:
+    """Get the reverse of an attribute table, for converting back to Standard Surface"""
+    return {target: source for source, target in attribute_map.items()}
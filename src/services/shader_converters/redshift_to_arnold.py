@@ -0,0 +1,23 @@
+# -*- coding: utf-8 -*-
+"""
+Redshift to Arnold Converter
+RedshiftStandardMaterial becomes aiStandardSurface
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from . import REDSHIFT_ATTRIBUTES, invert
+from ...core.interfaces.shader_converter import IShaderConverter
+from ...core.models.shader_conversion import RENDERER_ARNOLD
+
+
+class RedshiftToArnold(IShaderConverter):
+    """Redshift to Arnold - Single Responsibility for aiStandardSurface from Redshift"""
+
+    converter_id = "redshift_to_arnold"
+    name = "RedshiftStandardMaterial to aiStandardSurface"
+    source_types = ("RedshiftStandardMaterial",)
+    target_renderer = RENDERER_ARNOLD
+    target_type = "aiStandardSurface"
+    attribute_map = invert(REDSHIFT_ATTRIBUTES)
@@ -0,0 +1,26 @@
+# -*- coding: utf-8 -*-
+"""
+Standard Surface to Redshift Converter
+Arnold and Maya Standard Surface materials become RedshiftStandardMaterial
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from . import REDSHIFT_ATTRIBUTES, STANDARD_SURFACE_TYPES
+from ...core.interfaces.shader_converter import IShaderConverter
+from ...core.models.shader_conversion import RENDERER_REDSHIFT
+
+
+class StandardSurfaceToRedshift(IShaderConverter):
+    """
+    Standard Surface to Redshift - Single Responsibility for RedshiftStandardMaterial
+    Both follow the same physical model, so values carry over unchanged
+    """
+
+    converter_id = "standard_surface_to_redshift"
+    name = "Standard Surface to RedshiftStandardMaterial"
+    source_types = STANDARD_SURFACE_TYPES
+    target_renderer = RENDERER_REDSHIFT
+    target_type = "RedshiftStandardMaterial"
+    attribute_map = REDSHIFT_ATTRIBUTES
@@ -0,0 +1,25 @@
+# -*- coding: utf-8 -*-
+"""
+Standard Surface to V-Ray Converter
+Arnold and Maya Standard Surface materials become VRayMtl
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from . import STANDARD_SURFACE_TYPES, VRAY_ATTRIBUTES
+from ...core.interfaces.shader_converter import IShaderConverter
+from ...core.models.shader_conversion import RENDERER_VRAY
+
+
+class StandardSurfaceToVRay(IShaderConverter):
+    """Standard Surface to V-Ray - Single Responsibility for VRayMtl from Standard Surface"""
+
+    converter_id = "standard_surface_to_vray"
+    name = "Standard Surface to VRayMtl"
+    source_types = STANDARD_SURFACE_TYPES
+    target_renderer = RENDERER_VRAY
+    target_type = "VRayMtl"
+    attribute_map = VRAY_ATTRIBUTES
+    # Glossiness attributes read as roughness; one IOR drives reflection and refraction
+    target_values = {"useRoughness": 1, "lockFresnelIORToRefractionIOR": 1}
@@ -0,0 +1,55 @@
+# -*- coding: utf-8 -*-
+"""
+V-Ray to Arnold Converter
+VRayMtl becomes aiStandardSurface
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List, Tuple
+
+from . import VRAY_ATTRIBUTES, invert
+from ...core.interfaces.shader_converter import IShaderConverter
+from ...core.models.shader_conversion import RENDERER_ARNOLD, UnconvertedNode
+
+GLOSSINESS_ATTRIBUTES = ("reflectionGlossiness", "coatGlossiness", "sheenGlossiness")
+
+
+class VRayToArnold(IShaderConverter):
+    """
+    V-Ray to Arnold - Single Responsibility for aiStandardSurface from VRayMtl
+    Glossiness is inverted to roughness unless the VRayMtl already used roughness
+    """
+
+    converter_id = "vray_to_arnold"
+    name = "VRayMtl to aiStandardSurface"
+    source_types = ("VRayMtl",)
+    target_renderer = RENDERER_ARNOLD
+    target_type = "aiStandardSurface"
+    attribute_map = invert(VRAY_ATTRIBUTES)
+    target_values = {"emission": 1.0}  # VRayMtl has no emission weight
+
+    def convert(self, cmds: Any, shader: str) -> Tuple[str, List[UnconvertedNode]]:
+        target, unconverted = super().convert(cmds, shader)
+        if not self._uses_roughness(cmds, shader):
+            # A connected glossiness map cannot be inverted without extra nodes
+            for attribute in GLOSSINESS_ATTRIBUTES:
+                plug = f"{shader}.{attribute}"
+                if cmds.listConnections(plug, source=True, destination=False):
+                    unconverted.append(
+                        UnconvertedNode(
+                            shader, "VRayMtl", f"{attribute} map needs inverting for roughness"
+                        )
+                    )
+        return target, unconverted
+
+    def convert_value(self, cmds: Any, shader: str, attribute: str, value: Any) -> Any:
+        if attribute in GLOSSINESS_ATTRIBUTES and not self._uses_roughness(cmds, shader):
+            return 1.0 - value
+        return value
+
+    @staticmethod
+    def _uses_roughness(cmds: Any, shader: str) -> bool:
+        """Check if the VRayMtl's glossiness attributes already hold roughness"""
+        return bool(cmds.getAttr(f"{shader}.useRoughness"))
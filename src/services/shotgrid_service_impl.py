@@ -0,0 +1,323 @@
+# -*- coding: utf-8 -*-
+"""
+ShotGrid Service Implementation
+Register library publishes as PublishedFile entities in ShotGrid (Flow Production Tracking)
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Inside a ShotGrid Toolkit environment (Maya launched from ShotGrid Desktop) the current
+engine supplies the connection and the project / shot / task context, and publishes go
+through ``sgtk.util.register_publish``. Without Toolkit, a script key and a fallback
+project id from ~/.assetmanager/shotgrid.json are used with ``shotgun_api3``.
+Credentials can also come from the environment::
+
+    SHOTGRID_SITE=https://studio.shotgrid.autodesk.com
+    SHOTGRID_SCRIPT_NAME=asset_manager
+    SHOTGRID_SCRIPT_KEY=...
+"""
+
+import json
+import logging
+import os
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Any, Callable, Dict, Optional
+
+from .version_service_impl import get_current_user
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+# Config key -> environment variable that overrides it
+CONFIG_ENVIRONMENT = {
+    "site": "SHOTGRID_SITE",
+    "script_name": "SHOTGRID_SCRIPT_NAME",
+    "script_key": "SHOTGRID_SCRIPT_KEY",
+}
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "enabled": False,
+    "site": "",
+    "script_name": "",
+    "script_key": "",
+    "project_id": 0,
+}
+
+# Asset extension -> PublishedFileType code (created on first use)
+PUBLISHED_FILE_TYPES = {
+    ".ma": "Maya Scene",
+    ".mb": "Maya Scene",
+    ".abc": "Alembic Cache",
+    ".fbx": "FBX File",
+    ".obj": "OBJ File",
+    ".usd": "USD File",
+    ".usda": "USD File",
+    ".usdc": "USD File",
+    ".animclip": "Animation Clip",
+    ".pose": "Pose",
+    ".material": "Material Preset",
+}
+DEFAULT_PUBLISHED_FILE_TYPE = "Asset File"
+
+
+@dataclass(frozen=True)
+class ShotGridContext:
+    """Project / entity / task / artist a publish is linked to (ShotGrid entity dicts)"""
+
+    project: Optional[Dict[str, Any]] = None
+    entity: Optional[Dict[str, Any]] = None
+    task: Optional[Dict[str, Any]] = None
+    user: Optional[Dict[str, Any]] = None
+
+    @property
+    def description(self) -> str:
+        """Get the context as shown in the UI (Project > Shot 010 > Animation)"""
+        parts = [
+            str(
+                entity.get("name")
+                or entity.get("code")
+                or entity.get("content")  # Task names live in "content"
+                or f"{entity['type']} {entity['id']}"
+            )
+            for entity in (self.project, self.entity, self.task)
+            if entity
+        ]
+        return " > ".join(parts) or "No context"
+
+
+class ShotGridService:
+    """
+    ShotGrid Service - Single Responsibility for publish tracking in ShotGrid
+    Never blocks a publish; failures are reported and the library publish stands
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        connection_factory: Optional[Callable[[Dict[str, Any]], Any]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "shotgrid.json"
+        self._connection_factory = connection_factory or self._create_connection
+        self._config: Dict[str, Any] = self._load_config()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self, apply_environment: bool = True) -> Dict[str, Any]:
+        """Get the configuration, by default with environment overrides applied"""
+        config = dict(self._config)
+        if not apply_environment:
+            return config
+        for key, variable in CONFIG_ENVIRONMENT.items():
+            if os.environ.get(variable):
+                config[key] = os.environ[variable]
+        return config
+
+    def set_config(self, **values: Any) -> None:
+        """Update configuration values (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown ShotGrid settings: {', '.join(sorted(unknown))}")
+        self._config.update(values)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save ShotGrid config: {e}")
+            return False
+
+    def is_enabled(self) -> bool:
+        """Check if publishes are registered in ShotGrid"""
+        return bool(self._config.get("enabled"))
+
+    # Context ----------------------------------------------------------------------------
+
+    def get_toolkit_engine(self) -> Any:
+        """Get the running ShotGrid Toolkit engine, None outside a Toolkit environment"""
+        try:
+            import sgtk  # type: ignore
+
+            return sgtk.platform.current_engine()
+        except Exception:
+            return None
+
+    def get_context(self) -> ShotGridContext:
+        """Get the publish context from Toolkit, or the configured fallback project"""
+        engine = self.get_toolkit_engine()
+        if engine is not None and engine.context is not None:
+            context = engine.context
+            return ShotGridContext(
+                project=context.project,
+                entity=context.entity,
+                task=context.task,
+                user=context.user,
+            )
+
+        project_id = int(self.get_config().get("project_id") or 0)
+        project = {"type": "Project", "id": project_id} if project_id else None
+        return ShotGridContext(project=project)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def publish(
+        self,
+        file_path: Path,
+        version_number: int,
+        description: str = "",
+        thumbnail_path: Optional[Path] = None,
+        context: Optional[ShotGridContext] = None,
+    ) -> Optional[Dict[str, Any]]:
+        """
+        Create a PublishedFile for a library publish
+
+        Args:
+            file_path: Published asset file
+            version_number: Library version number (v003 -> 3)
+            description: Publish notes
+            thumbnail_path: Image uploaded as the PublishedFile thumbnail
+            context: Link target, defaults to get_context()
+
+        Returns:
+            Created PublishedFile entity dict, None if nothing was registered
+        """
+        file_path = Path(file_path)
+        context = context or self.get_context()
+        if not context.project:
+            print("[WARNING] ShotGrid publish skipped: no project (set one in ShotGrid Settings)")
+            return None
+
+        if thumbnail_path is not None and not Path(thumbnail_path).exists():
+            thumbnail_path = None
+        type_code = PUBLISHED_FILE_TYPES.get(
+            file_path.suffix.lower(), DEFAULT_PUBLISHED_FILE_TYPE
+        )
+
+        try:
+            engine = self.get_toolkit_engine()
+            if engine is not None:
+                published = self._register_with_toolkit(
+                    engine, file_path, version_number, description, thumbnail_path, type_code
+                )
+            else:
+                published = self._register_with_api(
+                    file_path, version_number, description, thumbnail_path, type_code, context
+                )
+        except Exception as e:
+            print(f"[ERROR] ShotGrid publish of {file_path.name} failed: {e}")
+            return None
+
+        print(
+            f"[OK] ShotGrid PublishedFile {published.get('id')} for {file_path.name} "
+            f"v{version_number:03d} ({context.description})"
+        )
+        return published
+
+    def _register_with_toolkit(
+        self,
+        engine: Any,
+        file_path: Path,
+        version_number: int,
+        description: str,
+        thumbnail_path: Optional[Path],
+        type_code: str,
+    ) -> Dict[str, Any]:
+        """Register through Toolkit so its hooks, templates, and path cache stay in step"""
+        import sgtk  # type: ignore
+
+        return sgtk.util.register_publish(
+            engine.sgtk,
+            engine.context,
+            str(file_path),
+            file_path.stem,
+            version_number,
+            comment=description,
+            thumbnail_path=str(thumbnail_path) if thumbnail_path else None,
+            published_file_type=type_code,
+        )
+
+    def _register_with_api(
+        self,
+        file_path: Path,
+        version_number: int,
+        description: str,
+        thumbnail_path: Optional[Path],
+        type_code: str,
+        context: ShotGridContext,
+    ) -> Dict[str, Any]:
+        """Create the PublishedFile with a script key connection"""
+        connection = self._connection_factory(self.get_config())
+
+        file_type = connection.find_one("PublishedFileType", [["code", "is", type_code]])
+        if file_type is None:
+            file_type = connection.create("PublishedFileType", {"code": type_code})
+
+        user = context.user or connection.find_one(
+            "HumanUser", [["login", "is", get_current_user()]], ["name"]
+        )
+
+        data: Dict[str, Any] = {
+            "project": context.project,
+            "code": file_path.name,
+            "name": file_path.stem,
+            "version_number": version_number,
+            "description": description,
+            "path": {"local_path": str(file_path)},
+            "published_file_type": file_type,
+        }
+        if context.entity:
+            data["entity"] = context.entity
+        if context.task:
+            data["task"] = context.task
+        if user:
+            data["created_by"] = user
+
+        published = connection.create("PublishedFile", data)
+        if thumbnail_path is not None:
+            connection.upload_thumbnail("PublishedFile", published["id"], str(thumbnail_path))
+        return published
+
+    def _create_connection(self, config: Dict[str, Any]) -> Any:
+        """Connect to ShotGrid with a script key"""
+        import shotgun_api3  # type: ignore
+
+        if not (config.get("site") and config.get("script_name") and config.get("script_key")):
+            raise RuntimeError("ShotGrid site, script name, and script key are not configured")
+        return shotgun_api3.Shotgun(
+            config["site"], script_name=config["script_name"], api_key=config["script_key"]
+        )
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Ignoring unreadable ShotGrid config: {e}")
+        return config
+
+
+# Singleton instance factory
+_shotgrid_service_instance = None
+
+
+def get_shotgrid_service() -> ShotGridService:
+    """
+    Get singleton instance of ShotGridService.
+
+    Returns:
+        ShotGridService: Singleton service instance
+    """
+    global _shotgrid_service_instance
+    if _shotgrid_service_instance is None:
+        _shotgrid_service_instance = ShotGridService()
+    return _shotgrid_service_instance
@@ -0,0 +1,103 @@
+# -*- coding: utf-8 -*-
+"""
+Show Context Service Implementation
+Find the show the artist is working on and limit the library to assets tagged for it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The show is read from the first of these that is set: the ASSETMANAGER_SHOW
+environment variable (usually set by the studio launcher), the show variable of the
+Maya workspace, and the SHOW environment variable. Workspaces set it in their
+workspace.mel::
+
+    workspace -v "show" "gotham";
+
+Assets belong to a show through a show:<name> tag; show:all puts shared assets on
+every show. Published assets get the current show's tag while the filter is on, so
+they do not disappear from the list they were published into.
+"""
+
+import logging
+import os
+from typing import Any, List, Mapping, Optional
+
+from ..core.models.show_context import ShowContext, get_show_tags
+
+SHOW_ENV_VARIABLE = "ASSETMANAGER_SHOW"
+WORKSPACE_VARIABLE = "show"
+FALLBACK_ENV_VARIABLES = ("SHOW",)
+
+
+class ShowContextService:
+    """
+    Show Context Service - Single Responsibility for the current show and its assets
+    Environment and Maya are passed in so the lookup can be tested without either
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    def get_current_show(
+        self, cmds: Any = None, environ: Optional[Mapping[str, str]] = None
+    ) -> Optional[ShowContext]:
+        """
+        Get the show the artist is working on
+
+        Args:
+            cmds: maya.cmds module, None outside Maya
+            environ: Environment variables, os.environ by default
+
+        Returns:
+            The current show, None when nothing names one
+        """
+        environ = os.environ if environ is None else environ
+        candidates = [(environ.get(SHOW_ENV_VARIABLE, ""), SHOW_ENV_VARIABLE)]
+        if cmds is not None:
+            candidates.append((self._get_workspace_show(cmds), "Maya workspace"))
+        candidates += [(environ.get(name, ""), name) for name in FALLBACK_ENV_VARIABLES]
+
+        for show, source in candidates:
+            if not show.strip():
+                continue
+            try:
+                return ShowContext(show.strip(), source)
+            except ValueError as e:
+                print(f"[WARNING] Ignoring show from {source}: {e}")
+        return None
+
+    def filter_assets(self, assets: List[Any], show: Optional[ShowContext]) -> List[Any]:
+        """Get the assets tagged for a show (None keeps every asset)"""
+        if show is None:
+            return list(assets)
+        return [asset for asset in assets if show.includes(getattr(asset, "tags", None) or [])]
+
+    def get_publish_tags(self, tags: List[str], show: Optional[ShowContext]) -> List[str]:
+        """Get a publish's tags with the show's tag added, unless it names a show already"""
+        if show is None or get_show_tags(tags):
+            return list(tags)
+        return list(tags) + [show.tag]
+
+    def _get_workspace_show(self, cmds: Any) -> str:
+        """Get the show variable of the current Maya workspace, "" when unset"""
+        try:
+            return str(cmds.workspace(variableEntry=WORKSPACE_VARIABLE) or "")
+        except Exception:
+            return ""  # Older workspaces without variables
+
+
+# Singleton instance factory
+_show_context_service_instance = None
+
+
+def get_show_context_service() -> ShowContextService:
+    """
+    Get singleton instance of ShowContextService.
+
+    Returns:
+        ShowContextService: Singleton service instance
+    """
+    global _show_context_service_instance
+    if _show_context_service_instance is None:
+        _show_context_service_instance = ShowContextService()
+    return _show_context_service_instance
@@ -0,0 +1,510 @@
+# -*- coding: utf-8 -*-
+"""
+Skin Weights Service Implementation
+Publish skinCluster weights and transfer them onto meshes by point order, position, or UV
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A skin weights asset is a JSON file in the library's skinweights folder. Each skinned
+mesh keeps its influence list, sparse per-vertex weights, and the world positions and
+UVs of its vertices, so the weights can go onto a later revision of the model::
+
+    assets/skinweights/hero_body.skinweights
+    {"type": "skinweights", "meshes": [{"name": "body_geo", "influences": ["root", ...],
+     "topology": {"vertices": 5024, ...}, "points": [[0.0, 91.2, 3.1], ...],
+     "uvs": [[0.51, 0.27], ...], "weights": [[[0, 0.75], [3, 0.25]], ...]}]}
+
+Point order copies vertex for vertex and needs the same topology. World position
+and UV give each vertex the weights of the nearest stored vertex, in space or in the
+UV layout, so they survive added edge loops and re-meshed models.
+"""
+
+import itertools
+import json
+import logging
+import math
+from collections import defaultdict
+from dataclasses import dataclass, field
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Sequence, Tuple
+
+from ..core.models.mesh_topology import MeshTopology
+from .anim_clip_service_impl import (
+    get_namespace,
+    playblast_thumbnail,
+    remap_node,
+    strip_namespace,
+)
+from .blendshape_service_impl import get_blendshape_service
+from .version_service_impl import get_current_user
+
+SKIN_WEIGHTS_EXTENSION = ".skinweights"
+SKIN_WEIGHTS_FORMAT_VERSION = 1
+
+METHOD_AUTO = "auto"
+METHOD_POINT_ORDER = "point_order"  # Vertex for vertex, same topology only
+METHOD_WORLD_POSITION = "world_position"  # Nearest stored vertex in world space
+METHOD_UV = "uv"  # Nearest stored vertex in the UV layout
+METHOD_LABELS = {
+    METHOD_AUTO: "Automatic",
+    METHOD_POINT_ORDER: "Point order",
+    METHOD_WORLD_POSITION: "World position",
+    METHOD_UV: "UV",
+}
+
+# Weights smaller than this are left out of a vertex
+WEIGHT_TOLERANCE = 1e-4
+
+Weights = List[List[List[float]]]  # Per vertex: [[influence index, weight], ...]
+
+
+@dataclass
+class SkinWeightsApplyResult:
+    """Outcome of applying one stored mesh's weights to a mesh"""
+
+    mesh: str = ""
+    skin_cluster: str = ""
+    method: str = ""
+    created: bool = False  # New skinCluster rather than the mesh's own
+    influences: List[str] = field(default_factory=list)
+    missing_influences: List[str] = field(default_factory=list)  # Not in the scene
+    fallback_vertices: int = 0  # Weighted by world position instead of the method
+
+    @property
+    def success(self) -> bool:
+        return bool(self.skin_cluster and self.influences)
+
+
+class NearestPoints:
+    """
+    Nearest point lookup over 2D or 3D points, bucketed in a uniform grid
+    Rings of cells around the query are searched until no closer point can exist
+    """
+
+    def __init__(self, points: Sequence[Sequence[float]]):
+        self._points = [tuple(float(value) for value in point) for point in points]
+        if not self._points:
+            raise ValueError("No points to look up")
+        dimensions = len(self._points[0])
+        self._low = [min(point[axis] for point in self._points) for axis in range(dimensions)]
+        high = [max(point[axis] for point in self._points) for axis in range(dimensions)]
+        extent = max(high[axis] - self._low[axis] for axis in range(dimensions))
+        cells_per_axis = max(1, round(len(self._points) ** (1.0 / dimensions)))
+        self._cell = (extent / cells_per_axis) or 1.0
+        self._span = cells_per_axis
+        self._cells: Dict[Tuple[int, ...], List[int]] = defaultdict(list)
+        for index, point in enumerate(self._points):
+            self._cells[self._get_key(point)].append(index)
+
+    def nearest(self, point: Sequence[float]) -> int:
+        """Get the index of the stored point closest to a point"""
+        center = self._get_key(point)
+        reach = self._span + max(abs(value) for value in center) + 1
+        best, best_distance = -1, math.inf
+        for ring in range(reach + 1):
+            for key in self._get_ring(center, ring):
+                for index in self._cells.get(key, ()):
+                    distance = sum((a - b) ** 2 for a, b in zip(self._points[index], point))
+                    if distance < best_distance:
+                        best, best_distance = index, distance
+            # Points in farther rings are at least this far away
+            if best >= 0 and best_distance <= (ring * self._cell) ** 2:
+                break
+        return best
+
+    def _get_key(self, point: Sequence[float]) -> Tuple[int, ...]:
+        """Get the grid cell of a point"""
+        return tuple(
+            int(math.floor((value - low) / self._cell)) for value, low in zip(point, self._low)
+        )
+
+    def _get_ring(self, center: Tuple[int, ...], ring: int):
+        """Get the cells exactly `ring` cells away from a cell"""
+        if ring == 0:
+            yield center
+            return
+        for offset in itertools.product(range(-ring, ring + 1), repeat=len(center)):
+            if max(abs(value) for value in offset) == ring:
+                yield tuple(a + b for a, b in zip(center, offset))
+
+
+def transfer_weights(
+    mesh_weights: Dict[str, Any],
+    method: str,
+    points: Sequence[Sequence[float]],
+    uvs: Optional[Sequence[Optional[Sequence[float]]]] = None,
+) -> Tuple[Weights, int]:
+    """
+    Map stored weights onto the vertices of another mesh
+
+    Args:
+        mesh_weights: One entry of a skin weights asset's "meshes"
+        method: METHOD_POINT_ORDER, METHOD_WORLD_POSITION, or METHOD_UV
+        points: World positions of the target vertices
+        uvs: UV of each target vertex (None for vertices without one), for METHOD_UV
+
+    Returns:
+        Weights per target vertex, and how many vertices fell back to world position
+        (beyond the stored vertex count, or without a UV)
+    """
+    stored = mesh_weights.get("weights", [])
+    stored_points = mesh_weights.get("points", [])
+    position_lookup = None
+    fallback = 0
+
+    def by_position(vertex: int) -> List[List[float]]:
+        nonlocal position_lookup
+        if position_lookup is None:
+            position_lookup = NearestPoints(stored_points)
+        return stored[position_lookup.nearest(points[vertex])]
+
+    if method == METHOD_POINT_ORDER:
+        weights = []
+        for vertex in range(len(points)):
+            if vertex < len(stored):
+                weights.append(stored[vertex])
+            else:
+                weights.append(by_position(vertex))
+                fallback += 1
+        return weights, fallback
+
+    if method == METHOD_UV:
+        stored_uvs = mesh_weights.get("uvs", [])
+        uv_vertices = [vertex for vertex, uv in enumerate(stored_uvs) if uv]
+        if not uv_vertices:
+            raise ValueError(f"{mesh_weights.get('name', 'The mesh')} was published without UVs")
+        uv_lookup = NearestPoints([stored_uvs[vertex] for vertex in uv_vertices])
+        weights = []
+        for vertex in range(len(points)):
+            uv = uvs[vertex] if uvs and vertex < len(uvs) else None
+            if uv:
+                weights.append(stored[uv_vertices[uv_lookup.nearest(uv)]])
+            else:
+                weights.append(by_position(vertex))
+                fallback += 1
+        return weights, fallback
+
+    if method == METHOD_WORLD_POSITION:
+        return [by_position(vertex) for vertex in range(len(points))], 0
+    raise ValueError(f"Unknown transfer method: {method}")
+
+
+def normalize_weights(
+    vertex_weights: List[List[float]], indices: Dict[int, int]
+) -> List[Tuple[int, float]]:
+    """
+    Get a vertex's weights on the influences found in the scene, summing to one
+
+    Args:
+        vertex_weights: [[stored influence index, weight], ...]
+        indices: Stored influence index -> skinCluster influence index
+    """
+    kept = [
+        (indices[int(influence)], float(weight))
+        for influence, weight in vertex_weights
+        if int(influence) in indices
+    ]
+    total = sum(weight for _index, weight in kept)
+    if total <= 0.0:
+        return []
+    return [(index, round(weight / total, 6)) for index, weight in kept]
+
+
+class SkinWeightsService:
+    """
+    Skin Weights Service - Single Responsibility for skin weight capture and transfer
+    All Maya calls go through the cmds argument so weights can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Meshes -----------------------------------------------------------------------------
+
+    def find_meshes(self, cmds: Any, nodes: Optional[List[str]] = None) -> List[str]:
+        """Get the mesh transforms (full paths) of nodes, or of the whole scene"""
+        if nodes is None:
+            shapes = cmds.ls(type="mesh", noIntermediate=True, long=True) or []
+        else:
+            shapes = cmds.ls(nodes, dag=True, type="mesh", noIntermediate=True, long=True) or []
+        meshes = []
+        for shape in shapes:
+            for parent in cmds.listRelatives(shape, parent=True, fullPath=True) or []:
+                if parent not in meshes:
+                    meshes.append(parent)
+        return meshes
+
+    def find_skin_cluster(self, cmds: Any, mesh: str) -> str:
+        """Get the skinCluster deforming a mesh, "" if it has none"""
+        skin_clusters = cmds.ls(cmds.listHistory(mesh) or [], type="skinCluster") or []
+        return skin_clusters[0] if skin_clusters else ""
+
+    # Capture ----------------------------------------------------------------------------
+
+    def read_mesh_weights(self, cmds: Any, mesh: str) -> Optional[Dict[str, Any]]:
+        """Get a skinned mesh's influences, weights, and vertex positions and UVs"""
+        skin_cluster = self.find_skin_cluster(cmds, mesh)
+        if not skin_cluster:
+            return None
+        vertex_count = int(cmds.polyEvaluate(mesh, vertex=True) or 0)
+        weights: Weights = []
+        for vertex in range(vertex_count):
+            values = cmds.skinPercent(
+                skin_cluster, f"{mesh}.vtx[{vertex}]", query=True, value=True
+            )
+            weights.append(
+                [
+                    [index, round(float(value), 6)]
+                    for index, value in enumerate(values or [])
+                    if value > WEIGHT_TOLERANCE
+                ]
+            )
+        influences = cmds.skinCluster(skin_cluster, query=True, influence=True) or []
+        return {
+            "name": strip_namespace(mesh),
+            "skin_cluster": strip_namespace(skin_cluster),
+            "topology": get_blendshape_service().get_topology(cmds, mesh).to_dict(),
+            "influences": [strip_namespace(influence) for influence in influences],
+            "max_influences": int(cmds.getAttr(f"{skin_cluster}.maxInfluences") or 0),
+            "skinning_method": int(cmds.getAttr(f"{skin_cluster}.skinningMethod") or 0),
+            "points": self._get_points(cmds, mesh),
+            "uvs": self._get_vertex_uvs(cmds, mesh, vertex_count),
+            "weights": weights,
+        }
+
+    def create_skin_weights(
+        self, cmds: Any, meshes: List[str], name: str, notes: str = ""
+    ) -> Dict[str, Any]:
+        """Build the skin weights dictionary ready for save_skin_weights"""
+        entries = [self.read_mesh_weights(cmds, mesh) for mesh in meshes]
+        return {
+            "type": "skinweights",
+            "format_version": SKIN_WEIGHTS_FORMAT_VERSION,
+            "name": name,
+            "notes": notes,
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+            "meshes": [entry for entry in entries if entry],
+        }
+
+    # Storage ----------------------------------------------------------------------------
+
+    def save_skin_weights(self, weights_path: Path, skin_weights: Dict[str, Any]) -> Path:
+        """Write skin weights data to a .skinweights file"""
+        weights_path = Path(weights_path)
+        if weights_path.suffix != SKIN_WEIGHTS_EXTENSION:
+            weights_path = weights_path.with_suffix(SKIN_WEIGHTS_EXTENSION)
+        weights_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(weights_path, "w", encoding="utf-8") as f:
+            json.dump(skin_weights, f)
+        print(
+            f"[OK] Saved skin weights {weights_path.name} "
+            f"({len(skin_weights['meshes'])} meshes)"
+        )
+        return weights_path
+
+    def load_skin_weights(self, weights_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .skinweights file, None if it is not a valid skin weights asset"""
+        try:
+            with open(weights_path, "r", encoding="utf-8") as f:
+                skin_weights = json.load(f)
+            if skin_weights.get("type") != "skinweights":
+                return None
+            return skin_weights
+        except Exception as e:
+            self.logger.error(f"Failed to read skin weights {weights_path}: {e}")
+            return None
+
+    def get_mesh_weights(self, skin_weights: Dict[str, Any], name: str) -> Dict[str, Any]:
+        """Get the stored entry of one mesh, {} if the asset does not have it"""
+        for entry in skin_weights.get("meshes", []):
+            if entry.get("name") == name:
+                return entry
+        return {}
+
+    # Transfer ---------------------------------------------------------------------------
+
+    def suggest_targets(
+        self, cmds: Any, skin_weights: Dict[str, Any], meshes: List[str]
+    ) -> Dict[str, str]:
+        """
+        Pick the mesh each stored mesh's weights go onto
+
+        A mesh with the same name wins. A single stored mesh goes onto the only mesh
+        offered, whatever its name. Stored meshes without either map to "".
+        """
+        names = [entry.get("name", "") for entry in skin_weights.get("meshes", [])]
+        targets = {}
+        for name in names:
+            same_name = [mesh for mesh in meshes if strip_namespace(mesh) == name]
+            targets[name] = same_name[0] if same_name else ""
+        if len(names) == 1 and not targets[names[0]] and len(meshes) == 1:
+            targets[names[0]] = meshes[0]
+        return targets
+
+    def check_topology(self, cmds: Any, mesh_weights: Dict[str, Any], mesh: str) -> List[str]:
+        """Get why a mesh does not match a stored mesh's topology, [] if it does"""
+        stored = MeshTopology.from_dict(mesh_weights.get("topology", {}))
+        return stored.differences(get_blendshape_service().get_topology(cmds, mesh))
+
+    def choose_method(self, cmds: Any, mesh_weights: Dict[str, Any], mesh: str) -> str:
+        """Get the automatic method: point order, else UV when both have UVs, else position"""
+        if not self.check_topology(cmds, mesh_weights, mesh):
+            return METHOD_POINT_ORDER
+        if any(mesh_weights.get("uvs", [])) and int(cmds.polyEvaluate(mesh, uvcoord=True) or 0):
+            return METHOD_UV
+        return METHOD_WORLD_POSITION
+
+    def apply_skin_weights(
+        self, cmds: Any, mesh_weights: Dict[str, Any], mesh: str, method: str = METHOD_AUTO
+    ) -> SkinWeightsApplyResult:
+        """
+        Skin a mesh with stored weights, reusing its skinCluster when it has one
+
+        Args:
+            cmds: maya.cmds module
+            mesh_weights: One entry of the asset's "meshes" (get_mesh_weights)
+            mesh: Mesh to skin
+            method: METHOD_* constant; METHOD_AUTO picks with choose_method
+
+        Returns:
+            skinCluster used, influences found and missing, and fallback vertices
+        """
+        if method == METHOD_AUTO:
+            method = self.choose_method(cmds, mesh_weights, mesh)
+        result = SkinWeightsApplyResult(mesh=mesh, method=method)
+
+        vertex_count = int(cmds.polyEvaluate(mesh, vertex=True) or 0)
+        points = self._get_points(cmds, mesh)
+        uvs = self._get_vertex_uvs(cmds, mesh, vertex_count) if method == METHOD_UV else None
+        weights, result.fallback_vertices = transfer_weights(mesh_weights, method, points, uvs)
+
+        # Influences are found by name: in the mesh's namespace, the root, then any
+        namespace = get_namespace(mesh)
+        found: Dict[int, str] = {}
+        for index, name in enumerate(mesh_weights.get("influences", [])):
+            for candidate in dict.fromkeys([remap_node(name, namespace), name, f"*:{name}"]):
+                matches = cmds.ls(candidate, type="transform", long=True) or []
+                if matches:
+                    found[index] = matches[0]
+                    break
+            else:
+                result.missing_influences.append(name)
+        if not found:
+            return result
+
+        result.skin_cluster = self.find_skin_cluster(cmds, mesh)
+        if not result.skin_cluster:
+            result.skin_cluster = cmds.skinCluster(
+                *dict.fromkeys(found.values()),
+                mesh,
+                toSelectedBones=True,
+                maximumInfluences=int(mesh_weights.get("max_influences") or 4),
+                skinMethod=int(mesh_weights.get("skinning_method") or 0),
+                normalizeWeights=1,
+                name=f"{strip_namespace(mesh)}_skinCluster",
+            )[0]
+            result.created = True
+        else:
+            current = cmds.skinCluster(result.skin_cluster, query=True, influence=True) or []
+            current_names = {strip_namespace(influence) for influence in current}
+            for joint in dict.fromkeys(found.values()):
+                if strip_namespace(joint) not in current_names:
+                    cmds.skinCluster(
+                        result.skin_cluster, edit=True, addInfluence=joint, weight=0.0
+                    )
+
+        logical = self._get_influence_indices(cmds, result.skin_cluster)
+        indices = {
+            index: logical[strip_namespace(joint)]
+            for index, joint in found.items()
+            if strip_namespace(joint) in logical
+        }
+        self._write_weights(cmds, result.skin_cluster, weights, indices)
+        result.influences = [strip_namespace(joint) for joint in dict.fromkeys(found.values())]
+
+        print(
+            f"[OK] Applied skin weights to {mesh} by {METHOD_LABELS[method].lower()}: "
+            f"{len(result.influences)} influences, {len(result.missing_influences)} missing"
+        )
+        return result
+
+    # Thumbnails -------------------------------------------------------------------------
+
+    def capture_thumbnail(
+        self, cmds: Any, weights_path: Path, size: int = 256
+    ) -> Optional[Path]:
+        """Playblast the current frame into the library thumbnail folder"""
+        return playblast_thumbnail(cmds, weights_path, cmds.currentTime(query=True), size)
+
+    # Points -----------------------------------------------------------------------------
+
+    def _get_points(self, cmds: Any, mesh: str) -> List[List[float]]:
+        """Get the world space vertex positions of a mesh"""
+        values = cmds.xform(f"{mesh}.vtx[*]", query=True, worldSpace=True, translation=True)
+        values = values or []
+        return [
+            [round(float(value), 6) for value in values[index : index + 3]]
+            for index in range(0, len(values) - 2, 3)
+        ]
+
+    def _get_vertex_uvs(
+        self, cmds: Any, mesh: str, vertex_count: int
+    ) -> List[Optional[List[float]]]:
+        """Get the first UV of every vertex in the current UV set, None without one"""
+        if not int(cmds.polyEvaluate(mesh, uvcoord=True) or 0):
+            return []
+        uvs: List[Optional[List[float]]] = []
+        for vertex in range(vertex_count):
+            maps = cmds.polyListComponentConversion(
+                f"{mesh}.vtx[{vertex}]", fromVertex=True, toUV=True
+            )
+            values = cmds.polyEditUV(maps[0], query=True) if maps else None
+            uvs.append([round(float(v), 6) for v in values[:2]] if values else None)
+        return uvs
+
+    def _get_influence_indices(self, cmds: Any, skin_cluster: str) -> Dict[str, int]:
+        """Get the weightList index of each influence, by namespace-free name"""
+        indices = {}
+        for index in cmds.getAttr(f"{skin_cluster}.matrix", multiIndices=True) or []:
+            sources = cmds.listConnections(f"{skin_cluster}.matrix[{index}]", source=True)
+            if sources:
+                indices[strip_namespace(sources[0])] = int(index)
+        return indices
+
+    def _write_weights(
+        self, cmds: Any, skin_cluster: str, weights: Weights, indices: Dict[int, int]
+    ) -> None:
+        """Replace every vertex's weights, leaving vertices without any on their old ones"""
+        cmds.setAttr(f"{skin_cluster}.normalizeWeights", 0)
+        try:
+            for vertex, vertex_weights in enumerate(weights):
+                normalized = normalize_weights(vertex_weights, indices)
+                if not normalized:
+                    continue  # Only on influences missing from the scene
+                plug = f"{skin_cluster}.weightList[{vertex}].weights"
+                for index in cmds.getAttr(plug, multiIndices=True) or []:
+                    cmds.setAttr(f"{plug}[{index}]", 0.0)
+                for index, weight in normalized:
+                    cmds.setAttr(f"{plug}[{index}]", weight)
+        finally:
+            cmds.setAttr(f"{skin_cluster}.normalizeWeights", 1)
+
+
+# Singleton instance factory
+_skin_weights_service_instance = None
+
+
+def get_skin_weights_service() -> SkinWeightsService:
+    """
+    Get singleton instance of SkinWeightsService.
+
+    Returns:
+        SkinWeightsService: Singleton service instance
+    """
+    global _skin_weights_service_instance
+    if _skin_weights_service_instance is None:
+        _skin_weights_service_instance = SkinWeightsService()
+    return _skin_weights_service_instance
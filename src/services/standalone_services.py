@@ -20,6 +20,9 @@ from abc import ABC, abstractmethod
 from enum import Enum
 from dataclasses import dataclass, field
 
+SUPPORTED_EXTENSIONS = {".ma", ".mb", ".obj", ".fbx", ".abc", ".usd", ".usda", ".usdc"}
+SCAN_KIND = "standalone"  # Scan cache record layout of this repository
+
 # Maya-compatible import strategy
 
 
@@ -245,41 +248,74 @@ class StandaloneAssetRepository(IAssetRepository):
         """
         assets = []
         try:
+            directory = Path(directory)
             if not directory.exists():
                 self.logger.warning(f"Directory not found: {directory}")
                 return assets
 
-            # Scan for asset files
-            supported_extensions = {".ma", ".mb", ".obj", ".fbx", ".abc", ".usd", ".usda", ".usdc"}
-
-            for file_path in directory.rglob("*"):
-                if file_path.suffix.lower() in supported_extensions:
-                    try:
-                        stat_info = file_path.stat()
-
-                        # Extract comprehensive metadata - Fix for asset info panel
-                        metadata = self._extract_asset_metadata(file_path, stat_info)
-
-                        asset = Asset(
-                            id=str(file_path),
-                            name=file_path.stem,
-                            file_path=file_path,
-                            file_extension=file_path.suffix,
-                            file_size=stat_info.st_size,
-                            asset_type=self._get_asset_type(file_path),
-                            created_date=datetime.fromtimestamp(stat_info.st_ctime),
-                            modified_date=datetime.fromtimestamp(stat_info.st_mtime),
-                            metadata=metadata,  # FIXED: Now populating metadata
-                        )
-                        assets.append(asset)
-                    except Exception as e:
-                        self.logger.warning(f"Failed to create asset from {file_path}: {e}")
+            # Only new or changed files are read; the rest come from the scan cache
+            result = self._get_scanner().scan(
+                directory, SCAN_KIND, self._is_supported_file, self._build_scan_record
+            )
+            for relative_path, record in sorted(result.records.items()):
+                file_path = directory / relative_path
+                try:
+                    assets.append(self._create_asset_from_record(file_path, record))
+                except Exception as e:
+                    self.logger.warning(f"Failed to create asset from {file_path}: {e}")
 
         except Exception as e:
             self.logger.error(f"Error scanning directory {directory}: {e}")
 
         return assets
 
+    def _get_scanner(self):
+        """Get the incremental library scanner (shared with the library widget)"""
+        try:
+            from .library_scan_service_impl import get_library_scan_service
+        except ImportError:
+            from services.library_scan_service_impl import get_library_scan_service
+        return get_library_scan_service()
+
+    def _get_asset_type_service(self):
+        """Get the studio asset type plugins (their files are listed too)"""
+        try:
+            from .asset_type_service_impl import get_asset_type_service
+        except ImportError:
+            from services.asset_type_service_impl import get_asset_type_service
+        return get_asset_type_service()
+
+    def _is_supported_file(self, file_path: Path) -> bool:
+        """Check if a file is an asset the library lists"""
+        extension = file_path.suffix.lower()
+        if extension in SUPPORTED_EXTENSIONS:
+            return True
+        return self._get_asset_type_service().get_plugin_for_extension(extension) is not None
+
+    def _build_scan_record(self, file_path: Path, stat_info) -> Dict[str, Any]:
+        """Read a new or changed asset file into its cached scan record"""
+        return {
+            "size": stat_info.st_size,
+            "created": stat_info.st_ctime,
+            "modified": stat_info.st_mtime,
+            # Extract comprehensive metadata - Fix for asset info panel
+            "metadata": self._extract_asset_metadata(file_path, stat_info),
+        }
+
+    def _create_asset_from_record(self, file_path: Path, record: Dict[str, Any]) -> Asset:
+        """Create a fresh asset from a scan record (callers may change its metadata)"""
+        return Asset(
+            id=str(file_path),
+            name=file_path.stem,
+            file_path=file_path,
+            file_extension=file_path.suffix,
+            file_size=record["size"],
+            asset_type=self._get_asset_type(file_path),
+            created_date=datetime.fromtimestamp(record["created"]),
+            modified_date=datetime.fromtimestamp(record["modified"]),
+            metadata=dict(record["metadata"]),
+        )
+
     def find_by_criteria(self, criteria: SearchCriteria) -> List[Asset]:
         """
         Find assets by search criteria - Clean Code: Pure Function
@@ -495,7 +531,10 @@ class StandaloneAssetRepository(IAssetRepository):
             ".usda": "usd_ascii",
             ".usdc": "usd_binary",
         }
-        return type_mapping.get(extension, "unknown")
+        if extension in type_mapping:
+            return type_mapping[extension]
+        plugin = self._get_asset_type_service().get_plugin_for_extension(extension)
+        return plugin.type_id if plugin is not None else "unknown"
 
     def _extract_asset_metadata(self, file_path: Path, stat_info) -> Dict[str, Any]:
         """
@@ -0,0 +1,292 @@
+# -*- coding: utf-8 -*-
+"""
+Storage Service Implementation
+Measure a library's disk use, keep its growth history, and warn about quotas
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Quotas and the daily history sit with the library, so every artist and the maintenance
+batch see the same limits::
+
+    MyProject/.assetmanager/storage_quota.json     <- limits in GB, warning threshold
+    MyProject/.assetmanager/storage_history.json   <- one size snapshot per day
+
+Categories are the top-level folders under assets/. An asset's size counts everything
+stored for it (versions, caches, dependencies, LODs, thumbnails), the files trashing it
+would move.
+"""
+
+import json
+import logging
+import threading
+from datetime import datetime, timedelta
+from pathlib import Path
+from typing import Callable, Dict, List, Optional, Set, Tuple
+
+from ..core.models.storage_usage import (
+    SCOPE_ASSET,
+    SCOPE_CATEGORY,
+    SCOPE_LIBRARY,
+    AssetUsage,
+    QuotaWarning,
+    StorageQuota,
+    StorageReport,
+    UsageSnapshot,
+)
+from .asset_repository_impl import get_asset_type
+from .trash_service_impl import get_trash_service
+
+SETTINGS_DIR_NAME = ".assetmanager"
+QUOTA_FILE_NAME = "storage_quota.json"
+HISTORY_FILE_NAME = "storage_history.json"
+
+# Snapshots older than this are dropped from the history
+MAX_HISTORY_DAYS = 730
+
+# Category of asset files directly in assets/
+UNCATEGORIZED = "(uncategorized)"
+
+# Scenes claim their FBX handoff and LODs before those are counted as assets of their own
+_SCENE_EXTENSIONS = (".ma", ".mb")
+
+
+class StorageService:
+    """
+    Storage Service - Single Responsibility for library disk use and quotas
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Quota ------------------------------------------------------------------------------
+
+    def get_quota_file(self, library_root: Path) -> Path:
+        """Get the quota settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / QUOTA_FILE_NAME
+
+    def load_quota(self, library_root: Path) -> StorageQuota:
+        """Get a library's quotas, no limits when none are saved"""
+        quota_file = self.get_quota_file(library_root)
+        if not quota_file.is_file():
+            return StorageQuota()
+        try:
+            with open(quota_file, "r", encoding="utf-8") as f:
+                return StorageQuota.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable storage quota {quota_file}: {e}")
+            return StorageQuota()
+
+    def save_quota(self, library_root: Path, quota: StorageQuota) -> bool:
+        """Write a library's quotas"""
+        try:
+            quota_file = self.get_quota_file(library_root)
+            quota_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(quota_file, "w", encoding="utf-8") as f:
+                json.dump(quota.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save storage quota: {e}")
+            return False
+
+    # Measuring --------------------------------------------------------------------------
+
+    def measure(
+        self, library_root: Path, record: bool = True, now: Optional[datetime] = None
+    ) -> StorageReport:
+        """
+        Measure a library's disk use by category and asset
+
+        Args:
+            library_root: Library (project) root
+            record: Store today's snapshot in the growth history
+            now: Measurement time, defaults to now
+
+        Returns:
+            Report with the history and the quota warnings
+        """
+        library_root = Path(library_root)
+        now = now or datetime.now()
+        category_root = self._get_category_root(library_root)
+
+        total_bytes = 0
+        category_bytes: Dict[str, int] = {}
+        for path in library_root.rglob("*"):
+            if not path.is_file():
+                continue
+            size = path.stat().st_size
+            total_bytes += size
+            category = self.get_category(category_root, path)
+            if category:
+                category_bytes[category] = category_bytes.get(category, 0) + size
+
+        assets = self._measure_assets(category_root)
+        snapshot = UsageSnapshot(now.date(), total_bytes, len(assets), category_bytes)
+        if record:
+            history = self._record_snapshot(library_root, snapshot)
+        else:
+            history = [entry for entry in self.get_history(library_root) if entry.day < now.date()]
+            history.append(snapshot)
+
+        quota = self.load_quota(library_root)
+        checks = [(SCOPE_LIBRARY, library_root.name, total_bytes)]
+        checks += [(SCOPE_CATEGORY, name, size) for name, size in sorted(category_bytes.items())]
+        checks += [(SCOPE_ASSET, usage.name, usage.size_bytes) for usage in assets]
+        warnings = [quota.check(*check) for check in checks]
+
+        return StorageReport(
+            library_name=library_root.name,
+            measured=now,
+            total_bytes=total_bytes,
+            category_bytes=dict(sorted(category_bytes.items())),
+            assets=tuple(assets),
+            history=tuple(history),
+            warnings=tuple(sorted(filter(None, warnings), key=lambda w: -w.percent)),
+        )
+
+    def measure_in_background(
+        self, library_root: Path, finished: Callable[[Optional[StorageReport]], None]
+    ) -> None:
+        """Measure on a worker thread, passing the report (None on failure) to a callback"""
+
+        def run():
+            try:
+                report = self.measure(library_root)
+            except Exception as e:
+                self.logger.error(f"Measuring {library_root} failed: {e}")
+                report = None
+            finished(report)
+
+        threading.Thread(target=run, daemon=True).start()
+
+    def check_publish(self, library_root: Path, asset_file: Path) -> List[QuotaWarning]:
+        """
+        Check a just-published asset and its category against the library's quotas
+
+        The library limit is left to full measurements; walking a whole library on
+        every publish would be too slow.
+        """
+        quota = self.load_quota(library_root)
+        if not quota.is_set:
+            return []
+        asset_file = Path(asset_file)
+        category_root = self._get_category_root(Path(library_root))
+        category = self.get_category(category_root, asset_file)
+        size, _count = self.get_asset_size(asset_file)
+        warnings = [quota.check(SCOPE_ASSET, asset_file.stem, size)]
+        if category and category != UNCATEGORIZED:
+            folder_bytes, _count = self._get_size(category_root / category)
+            warnings.append(quota.check(SCOPE_CATEGORY, category, folder_bytes))
+        return [warning for warning in warnings if warning is not None]
+
+    def get_asset_size(self, asset_file: Path) -> Tuple[int, int]:
+        """Get the bytes and file count of an asset and everything stored for it"""
+        size = count = 0
+        for path in get_trash_service().get_asset_paths(asset_file):
+            path_size, path_count = self._get_size(path)
+            size += path_size
+            count += path_count
+        return size, count
+
+    def get_category(self, category_root: Path, path: Path) -> Optional[str]:
+        """Get the category a file is stored in, None outside categories (trash, settings)"""
+        try:
+            parts = Path(path).relative_to(category_root).parts
+        except ValueError:
+            return None
+        if not parts or parts[0].startswith("."):
+            return None
+        return parts[0] if len(parts) > 1 else UNCATEGORIZED
+
+    def _get_category_root(self, library_root: Path) -> Path:
+        """Get the folder whose subfolders are the categories"""
+        assets_dir = library_root / "assets"
+        return assets_dir if assets_dir.is_dir() else library_root
+
+    def _measure_assets(self, category_root: Path) -> List[AssetUsage]:
+        """Measure every asset file outside hidden folders, each file counted once"""
+        asset_files = [
+            path
+            for path in category_root.rglob("*")
+            if path.is_file()
+            and not any(part.startswith(".") for part in path.relative_to(category_root).parts)
+            and get_asset_type(path) != "unknown"
+        ]
+        asset_files.sort(key=lambda path: (path.suffix.lower() not in _SCENE_EXTENSIONS, path))
+
+        claimed: Set[Path] = set()
+        assets: List[AssetUsage] = []
+        for asset_file in asset_files:
+            if asset_file in claimed:
+                continue
+            size = count = 0
+            for path in get_trash_service().get_asset_paths(asset_file):
+                files = [path] if path.is_file() else [p for p in path.rglob("*") if p.is_file()]
+                for file_path in files:
+                    if file_path not in claimed:
+                        claimed.add(file_path)
+                        size += file_path.stat().st_size
+                        count += 1
+            category = self.get_category(category_root, asset_file) or UNCATEGORIZED
+            assets.append(AssetUsage(asset_file, category, size, count))
+        return assets
+
+    def _get_size(self, path: Path) -> Tuple[int, int]:
+        """Get the bytes and file count of a file or folder"""
+        if path.is_file():
+            return path.stat().st_size, 1
+        files = [p for p in path.rglob("*") if p.is_file()]
+        return sum(p.stat().st_size for p in files), len(files)
+
+    # History ----------------------------------------------------------------------------
+
+    def get_history(self, library_root: Path) -> List[UsageSnapshot]:
+        """Get a library's daily size snapshots, oldest first"""
+        history_file = Path(library_root) / SETTINGS_DIR_NAME / HISTORY_FILE_NAME
+        if not history_file.is_file():
+            return []
+        try:
+            with open(history_file, "r", encoding="utf-8") as f:
+                entries = json.load(f).get("snapshots") or []
+            return sorted(
+                (UsageSnapshot.from_dict(entry) for entry in entries),
+                key=lambda snapshot: snapshot.day,
+            )
+        except Exception as e:
+            self.logger.warning(f"Unreadable storage history {history_file}: {e}")
+            return []
+
+    def _record_snapshot(self, library_root: Path, snapshot: UsageSnapshot) -> List[UsageSnapshot]:
+        """Store a snapshot in place of the day's earlier one, dropping the oldest"""
+        oldest = snapshot.day - timedelta(days=MAX_HISTORY_DAYS)
+        history = [
+            entry
+            for entry in self.get_history(library_root)
+            if oldest <= entry.day < snapshot.day
+        ]
+        history.append(snapshot)
+        history_file = library_root / SETTINGS_DIR_NAME / HISTORY_FILE_NAME
+        try:
+            history_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(history_file, "w", encoding="utf-8") as f:
+                json.dump({"snapshots": [entry.to_dict() for entry in history]}, f, indent=2)
+        except Exception as e:
+            self.logger.error(f"Failed to write storage history: {e}")
+        return history
+
+
+# Singleton instance factory
+_storage_service_instance = None
+
+
+def get_storage_service() -> StorageService:
+    """
+    Get singleton instance of StorageService.
+
+    Returns:
+        StorageService: Singleton service instance
+    """
+    global _storage_service_instance
+    if _storage_service_instance is None:
+        _storage_service_instance = StorageService()
+    return _storage_service_instance
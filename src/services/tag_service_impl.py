@@ -0,0 +1,158 @@
+# -*- coding: utf-8 -*-
+"""
+Tag Service Implementation
+Library-wide tag hierarchy: rename, merge, and delete tags on every asset at once
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Tags nest with slashes (environment/exterior/forest). Renaming, merging, or deleting
+a tag applies to the tags below it too, so renaming environment to env turns
+environment/exterior/forest into env/exterior/forest. Changes go to the library
+database and to the .meta sidecars older plugin versions still read.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Any, Dict, Iterable, List, Optional
+
+from ..core.models.tag_hierarchy import (
+    TagNode,
+    build_tag_tree,
+    is_tag_within,
+    normalize_tag,
+    reparent_tag,
+    tag_ancestors,
+)
+from .metadata_database_impl import SIDECAR_SUFFIX
+
+
+class TagService:
+    """
+    Tag Service - Single Responsibility for library-wide tag maintenance
+    Works on any MetadataDatabase, so the same calls serve the UI and scripts
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Queries ----------------------------------------------------------------------------
+
+    def get_known_tags(self, database: Any, extra_tags: Iterable[str] = ()) -> List[str]:
+        """Get every tag in use or registered, plus their parent tags, for autocomplete"""
+        tags = set(database.get_all_tags()) if database is not None else set()
+        tags.update(tag for tag in (normalize_tag(t) for t in extra_tags) if tag)
+        for tag in list(tags):
+            tags.update(tag_ancestors(tag))
+        return sorted(tags, key=str.lower)
+
+    def get_tag_tree(self, database: Any, extra_tags: Iterable[str] = ()) -> List[TagNode]:
+        """Get the library's tags as a tree with usage counts"""
+        tag_uses = database.get_all_tags() if database is not None else {}
+        return build_tag_tree(tag_uses, extra_tags)
+
+    def remap_tags(self, tags: Iterable[str], old_tag: str, new_tag: Optional[str]) -> List[str]:
+        """Apply a rename (or delete when new_tag is None) to a list of tags"""
+        result: List[str] = []
+        for tag in tags:
+            if is_tag_within(tag, old_tag):
+                if new_tag is None:
+                    continue
+                tag = reparent_tag(tag, old_tag, new_tag)
+            if tag not in result:
+                result.append(tag)
+        return result
+
+    # Changes ----------------------------------------------------------------------------
+
+    def rename_tag(self, database: Any, old_tag: str, new_tag: str) -> List[str]:
+        """
+        Rename a tag and the tags below it on every asset
+
+        Returns:
+            Keys of the assets that changed
+
+        Raises:
+            ValueError: If the new name is empty
+        """
+        new_tag = normalize_tag(new_tag)
+        if not new_tag:
+            raise ValueError("A tag needs a name")
+        return self._apply(database, [(old_tag, new_tag)])
+
+    def merge_tags(self, database: Any, source_tags: Iterable[str], target_tag: str) -> List[str]:
+        """
+        Fold tags into one (chars and character into characters), keeping sub-tags
+
+        Returns:
+            Keys of the assets that changed
+        """
+        target_tag = normalize_tag(target_tag)
+        if not target_tag:
+            raise ValueError("Pick a tag to merge into")
+        sources = [tag for tag in source_tags if tag.lower() != target_tag.lower()]
+        return self._apply(database, [(source, target_tag) for source in sources])
+
+    def delete_tag(self, database: Any, tag: str) -> List[str]:
+        """
+        Remove a tag and the tags below it from every asset
+
+        Returns:
+            Keys of the assets that changed
+        """
+        return self._apply(database, [(tag, None)])
+
+    def _apply(self, database: Any, changes: List[tuple]) -> List[str]:
+        """Turn (old, new) changes into per-tag replacements and write them"""
+        replacements: Dict[str, Optional[str]] = {}
+        for tag in database.get_all_tags():
+            for old_tag, new_tag in changes:
+                if is_tag_within(tag, old_tag):
+                    replacements[tag] = reparent_tag(tag, old_tag, new_tag) if new_tag else None
+                    break
+        if not replacements:
+            return []
+        changed = database.replace_tags(replacements)
+        self._update_sidecars(database, changed, replacements)
+        print(f"[OK] Updated tags on {len(changed)} asset(s)")
+        return changed
+
+    def _update_sidecars(
+        self, database: Any, asset_keys: List[str], replacements: Dict[str, Optional[str]]
+    ) -> None:
+        """Keep the tags in .meta sidecars in step with the database"""
+        for key in asset_keys:
+            sidecar = Path(database.library_root) / f"{key}{SIDECAR_SUFFIX}"
+            if not sidecar.is_file():
+                continue
+            try:
+                with open(sidecar, "r", encoding="utf-8") as f:
+                    metadata = json.load(f)
+                tags: List[str] = []
+                for tag in metadata.get("tags") or []:
+                    tag = replacements.get(tag, tag)
+                    if tag and tag not in tags:
+                        tags.append(tag)
+                metadata["tags"] = tags
+                with open(sidecar, "w", encoding="utf-8") as f:
+                    json.dump(metadata, f, indent=2)
+            except Exception as e:
+                self.logger.warning(f"Could not update tags in {sidecar}: {e}")
+
+
+# Singleton instance factory
+_tag_service_instance = None
+
+
+def get_tag_service() -> TagService:
+    """
+    Get singleton instance of TagService.
+
+    Returns:
+        TagService: Singleton service instance
+    """
+    global _tag_service_instance
+    if _tag_service_instance is None:
+        _tag_service_instance = TagService()
+    return _tag_service_instance
@@ -0,0 +1,318 @@
+# -*- coding: utf-8 -*-
+"""
+Texture Relink Service Implementation
+Find textures with broken paths and point them at the copies in the search roots
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Assets published on another machine often point at textures that only exist there.
+Missing files are looked up by name below the search roots; the candidate whose
+folders match most of the original path wins, and UDIM / frame tokens are kept::
+
+    D:/jobs/ship/textures/hull_<UDIM>.png            <- path stored in the asset
+    /mnt/library/assets/textures/hull_1001.png        <- tile found in a search root
+    /mnt/library/assets/textures/hull_<UDIM>.png      <- relinked path
+
+Search roots come from ~/.assetmanager/texture_relink.json, the
+ASSETMANAGER_TEXTURE_ROOTS environment variable (os.pathsep separated), the
+library's assets/textures folder, and the folder of the imported asset.
+"""
+
+import json
+import logging
+import os
+import re
+from dataclasses import dataclass, replace
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from .dependency_service_impl import DEPENDENCY_ATTRIBUTES, expand_file_pattern
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+ROOTS_ENVIRONMENT = "ASSETMANAGER_TEXTURE_ROOTS"
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "search_roots": [],
+    "relink_on_import": True,
+}
+
+# Node type -> file path attribute of texture nodes
+TEXTURE_ATTRIBUTES = {
+    node_type: attribute
+    for node_type, (attribute, category) in DEPENDENCY_ATTRIBUTES.items()
+    if category == "textures"
+}
+
+STATUS_FOUND = "found"
+STATUS_RELINKED = "relinked"
+STATUS_MISSING = "missing"
+
+# Tile / frame token -> pattern of the file names it stands for (#### is any number)
+_TOKEN_PATTERN = re.compile(r"<udim>|<uvtile>|<u>|<v>|<f>|#+", re.IGNORECASE)
+_TOKEN_REPLACEMENTS = {"<udim>": r"\d{4}", "<uvtile>": r"u\d+_v\d+"}
+_PATH_SEPARATORS = re.compile(r"[\\/]+")
+
+
+@dataclass(frozen=True)
+class TextureLink:
+    """Texture node and the file it points at"""
+
+    node: str
+    attribute: str
+    original_path: str  # Path the node had before relinking
+    path: str  # Current path
+    status: str = STATUS_FOUND
+
+    @property
+    def is_resolved(self) -> bool:
+        """Check if the node points at a file that exists"""
+        return self.status != STATUS_MISSING
+
+    @property
+    def file_name(self) -> str:
+        """Get the texture file name, whatever machine the path came from"""
+        return _PATH_SEPARATORS.split(self.original_path)[-1]
+
+
+class TextureRelinkService:
+    """
+    Texture Relink Service - Single Responsibility for texture repathing
+    Scene access goes through the cmds argument so relinking can be tested without Maya
+    """
+
+    def __init__(self, config_file: Optional[Path] = None):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "texture_relink.json"
+        self._config: Dict[str, Any] = self._load_config()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_configured_roots(self) -> List[Path]:
+        """Get the search roots the user configured"""
+        return [Path(root) for root in self._config.get("search_roots", [])]
+
+    def set_configured_roots(self, roots: List[Path]) -> None:
+        """Replace the configured search roots (call save_config to persist)"""
+        unique: List[str] = []
+        for root in roots:
+            if str(root) not in unique:
+                unique.append(str(root))
+        self._config["search_roots"] = unique
+
+    def is_relink_on_import(self) -> bool:
+        """Check if imported assets are relinked automatically"""
+        return bool(self._config.get("relink_on_import", True))
+
+    def set_relink_on_import(self, enabled: bool) -> None:
+        """Turn automatic relinking of imported assets on or off"""
+        self._config["relink_on_import"] = bool(enabled)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save texture relink config: {e}")
+            return False
+
+    def get_search_roots(
+        self, library_root: Optional[Path] = None, asset_file: Optional[Path] = None
+    ) -> List[Path]:
+        """
+        Get the folders searched for missing textures, most specific first
+
+        Args:
+            library_root: Library whose assets/textures folder is searched
+            asset_file: Imported asset whose folder is searched
+
+        Returns:
+            Existing search folders
+        """
+        roots: List[Path] = []
+        if asset_file is not None:
+            roots.append(Path(asset_file).parent)
+        if library_root is not None:
+            roots.append(Path(library_root) / "assets" / "textures")
+        roots += self.get_configured_roots()
+        environment = os.environ.get(ROOTS_ENVIRONMENT, "")
+        roots += [Path(root) for root in environment.split(os.pathsep) if root]
+
+        unique: List[Path] = []
+        for root in roots:
+            if root.is_dir() and root not in unique:
+                unique.append(root)
+        return unique
+
+    # Search -----------------------------------------------------------------------------
+
+    def build_index(self, roots: List[Path]) -> Dict[str, List[Path]]:
+        """Get lower-case file name -> files below the roots, skipping hidden folders"""
+        index: Dict[str, List[Path]] = {}
+        for root in roots:
+            for folder, dirs, files in os.walk(root):
+                dirs[:] = sorted(d for d in dirs if not d.startswith("."))
+                for name in sorted(files):
+                    index.setdefault(name.lower(), []).append(Path(folder) / name)
+        return index
+
+    def find_texture(self, path: str, index: Dict[str, List[Path]]) -> Optional[str]:
+        """
+        Find where a texture lives below the search roots
+
+        Args:
+            path: Path stored on the texture node, possibly from another OS
+            index: File index from build_index()
+
+        Returns:
+            New path with the original file name (UDIM tokens kept), None if not found
+        """
+        parts = [part for part in _PATH_SEPARATORS.split(path) if part]
+        if not parts:
+            return None
+        file_name = parts[-1]
+        pattern = self._get_name_pattern(file_name)
+
+        if pattern is None:
+            candidates = index.get(file_name.lower(), [])
+        else:
+            candidates = [
+                candidate
+                for name, files in index.items()
+                if pattern.fullmatch(name)
+                for candidate in files
+            ]
+        if not candidates:
+            return None
+
+        original_folders = [part.lower() for part in parts[:-1]]
+        best = max(candidates, key=lambda candidate: self._score(candidate, original_folders))
+        # Tokenized names stay tokenized; plain names take the file's actual spelling
+        return (best.parent / file_name if pattern else best).as_posix()
+
+    def is_tokenized(self, file_name: str) -> bool:
+        """Check if a file name stands for UDIM tiles or frames (<UDIM>, ####)"""
+        return bool(_TOKEN_PATTERN.search(file_name))
+
+    def _get_name_pattern(self, file_name: str) -> Optional["re.Pattern[str]"]:
+        """Get the regex of a tokenized file name, None for plain file names"""
+        file_name = file_name.lower()
+        pattern, position = "", 0
+        for token in _TOKEN_PATTERN.finditer(file_name):
+            pattern += re.escape(file_name[position : token.start()])
+            pattern += _TOKEN_REPLACEMENTS.get(token.group(0), r"\d+")
+            position = token.end()
+        if not position:
+            return None
+        return re.compile(pattern + re.escape(file_name[position:]))
+
+    @staticmethod
+    def _score(candidate: Path, original_folders: List[str]) -> int:
+        """Count trailing folders a candidate shares with the original path"""
+        score = 0
+        for original, folder in zip(reversed(original_folders), reversed(candidate.parent.parts)):
+            if original != folder.lower():
+                break
+            score += 1
+        return score
+
+    # Scene ------------------------------------------------------------------------------
+
+    def scan(self, cmds: Any, nodes: Optional[List[str]] = None) -> List[TextureLink]:
+        """
+        Get the texture nodes of the scene, or among the given nodes
+
+        Args:
+            cmds: maya.cmds module
+            nodes: Nodes to look at (e.g. the nodes an import created); None for all
+
+        Returns:
+            Texture links with found / missing status
+        """
+        links = []
+        for node_type, attribute in TEXTURE_ATTRIBUTES.items():
+            if nodes is None:
+                texture_nodes = cmds.ls(type=node_type) or []
+            else:
+                texture_nodes = cmds.ls(nodes, type=node_type) if nodes else []
+            for node in texture_nodes or []:
+                path = cmds.getAttr(f"{node}.{attribute}") or ""
+                if not path:
+                    continue
+                status = STATUS_FOUND if expand_file_pattern(path) else STATUS_MISSING
+                links.append(TextureLink(node, attribute, path, path, status))
+        return links
+
+    def relink(
+        self, cmds: Any, roots: List[Path], nodes: Optional[List[str]] = None
+    ) -> List[TextureLink]:
+        """
+        Point every texture with a missing file at its copy below the search roots
+
+        Args:
+            cmds: maya.cmds module
+            roots: Search roots from get_search_roots()
+            nodes: Nodes to relink; None for the whole scene
+
+        Returns:
+            All texture links; ones still missing could not be found
+        """
+        links = self.scan(cmds, nodes)
+        missing = [link for link in links if not link.is_resolved]
+        if not missing:
+            return links
+
+        index = self.build_index(roots)
+        relinked = {}
+        cmds.undoInfo(openChunk=True, chunkName="Relink Textures")
+        try:
+            for link in missing:
+                new_path = self.find_texture(link.path, index)
+                if new_path is not None:
+                    relinked[link.node] = self.set_texture_path(cmds, link, new_path)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+        unresolved = len(missing) - len(relinked)
+        print(
+            f"[OK] Relinked {len(relinked)} of {len(missing)} missing texture(s)"
+            + (f", {unresolved} unresolved" if unresolved else "")
+        )
+        return [relinked.get(link.node, link) for link in links]
+
+    def set_texture_path(self, cmds: Any, link: TextureLink, path: str) -> TextureLink:
+        """Point a texture node at another file"""
+        cmds.setAttr(f"{link.node}.{link.attribute}", path, type="string")
+        status = STATUS_RELINKED if expand_file_pattern(path) else STATUS_MISSING
+        return replace(link, path=path, status=status)
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Load configuration from disk, falling back to defaults"""
+        config = dict(DEFAULT_CONFIG)
+        if self._config_file.exists():
+            try:
+                with open(self._config_file, "r", encoding="utf-8") as f:
+                    config.update(json.load(f))
+            except Exception as e:
+                self.logger.warning(f"Could not read texture relink config: {e}")
+        return config
+
+
+# Singleton instance factory
+_texture_relink_service_instance = None
+
+
+def get_texture_relink_service() -> TextureRelinkService:
+    """
+    Get singleton instance of TextureRelinkService.
+
+    Returns:
+        TextureRelinkService: Singleton service instance
+    """
+    global _texture_relink_service_instance
+    if _texture_relink_service_instance is None:
+        _texture_relink_service_instance = TextureRelinkService()
+    return _texture_relink_service_instance
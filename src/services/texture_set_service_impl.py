@@ -0,0 +1,413 @@
+# -*- coding: utf-8 -*-
+"""
+Texture Set Service Implementation
+Publish PBR texture maps as one asset and wire them into a surface shader
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A texture set asset is a small JSON descriptor like a material preset. The maps are
+copied into a hidden folder, UDIM tiles stay one map with a <UDIM> token, and every
+map keeps the colorspace it was published with::
+
+    assets/textures/hull_paint.texset
+    assets/textures/.texsets/hull_paint/hull_BaseColor.<UDIM>.png   <- 1001, 1002...
+    assets/textures/.texsets/hull_paint/hull_Roughness.<UDIM>.png
+    assets/textures/.thumbnails/hull_paint_screenshot.png           <- first base color tile
+
+Applying a set builds file nodes with the stored colorspaces and connects them to an
+aiStandardSurface or standardSurface; normal maps go through aiNormalMap or bump2d.
+"""
+
+import json
+import logging
+import re
+import shutil
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from .dependency_service_impl import expand_file_pattern, get_dependency_service
+from .material_service_impl import get_material_service
+from .version_service_impl import get_current_user
+
+TEXTURE_SET_EXTENSION = ".texset"
+TEXTURE_SET_FORMAT_VERSION = 1
+TEXTURE_SETS_DIR_NAME = ".texsets"
+THUMBNAILS_DIR_NAME = ".thumbnails"
+
+UDIM_TOKEN = "<UDIM>"
+
+# Map type -> file name words that identify it (compared lowercase, whole words)
+MAP_KEYWORDS: Dict[str, Tuple[str, ...]] = {
+    "basecolor": ("basecolor", "base_color", "albedo", "diffuse", "diff", "color", "col"),
+    "roughness": ("roughness", "rough", "rgh"),
+    "metalness": ("metalness", "metallic", "metal", "mtl"),
+    "specular": ("specular", "spec"),
+    "normal": ("normal", "nrm", "nor", "norm"),
+    "height": ("height", "displacement", "disp", "dsp"),
+    "emission": ("emission", "emissive", "emit"),
+    "opacity": ("opacity", "alpha", "mask"),
+}
+
+# Color maps are painted in sRGB; data maps must not be color converted
+COLOR_MAPS = {"basecolor", "emission"}
+COLORSPACE_COLOR = "sRGB"
+COLORSPACE_DATA = "Raw"
+COLORSPACES = [
+    "sRGB",
+    "Raw",
+    "scene-linear Rec.709-sRGB",
+    "ACEScg",
+    "Utility - sRGB - Texture",
+    "Utility - Raw",
+]
+
+SHADER_TYPES = ("aiStandardSurface", "standardSurface")
+
+# Map type -> (file node output, shader input) for maps plugged straight into the shader
+SHADER_CONNECTIONS: Dict[str, Tuple[str, str]] = {
+    "basecolor": ("outColor", "baseColor"),
+    "roughness": ("outAlpha", "specularRoughness"),
+    "metalness": ("outAlpha", "metalness"),
+    "specular": ("outAlpha", "specular"),
+    "emission": ("outColor", "emissionColor"),
+    "opacity": ("outColor", "opacity"),
+}
+
+UV_TILING_OFF = 0
+UV_TILING_UDIM = 3  # file node uvTilingMode "UDIM (Mari)"
+
+_UDIM_PATTERN = re.compile(r"(?<=[._])(1\d{3})(?=\.[^.]+$)")
+_WORD_PATTERN = re.compile(r"[a-z]+")
+_NAME_SEPARATORS = re.compile(r"[_\-\s]+")
+_THUMBNAIL_EXTENSIONS = {".png", ".jpg", ".jpeg"}
+
+_PLACE2D_CONNECTIONS = [
+    ("outUV", "uvCoord"),
+    ("outUvFilterSize", "uvFilterSize"),
+    ("coverage", "coverage"),
+    ("translateFrame", "translateFrame"),
+    ("rotateFrame", "rotateFrame"),
+    ("repeatUV", "repeatUV"),
+    ("offset", "offset"),
+    ("rotateUV", "rotateUV"),
+    ("wrapU", "wrapU"),
+    ("wrapV", "wrapV"),
+]
+
+
+def detect_map_type(file_name: str) -> Optional[str]:
+    """Get the map type a texture file name stands for (hull_Roughness.1001.png -> roughness)"""
+    stem = Path(file_name).name.split(".", 1)[0].lower()
+    words = set(_WORD_PATTERN.findall(stem.replace("base_color", "basecolor")))
+    for map_type, keywords in MAP_KEYWORDS.items():
+        if words & set(keywords):
+            return map_type
+    return None
+
+
+def get_udim_pattern(file_path: Path) -> Tuple[Path, Optional[int]]:
+    """Get the <UDIM> pattern and tile number of a tile file (plain files have no tile)"""
+    file_path = Path(file_path)
+    match = _UDIM_PATTERN.search(file_path.name)
+    if match is None:
+        return file_path, None
+    name = file_path.name[: match.start()] + UDIM_TOKEN + file_path.name[match.end() :]
+    return file_path.with_name(name), int(match.group(1))
+
+
+def suggest_set_name(file_name: str) -> str:
+    """Get a texture set name from a map file name without its map word (hull_BaseColor -> hull)"""
+    stem = re.sub("base_color", "BaseColor", Path(file_name).name.split(".", 1)[0], flags=re.I)
+    keywords = {keyword for words in MAP_KEYWORDS.values() for keyword in words}
+    parts = [part for part in _NAME_SEPARATORS.split(stem) if part]
+    kept = [part for part in parts if part.lower() not in keywords]
+    return "_".join(kept or parts)
+
+
+def get_default_colorspace(map_type: str) -> str:
+    """Get the colorspace a map type is published with unless the artist changes it"""
+    return COLORSPACE_COLOR if map_type in COLOR_MAPS else COLORSPACE_DATA
+
+
+class TextureSetService:
+    """
+    Texture Set Service - Single Responsibility for texture set publish and apply
+    All Maya calls go through the cmds argument so sets can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def group_maps(self, files: List[Path]) -> Tuple[Dict[str, Dict[str, Any]], List[Path]]:
+        """
+        Group texture files into maps, UDIM tiles of one map together
+
+        Args:
+            files: Texture files picked by the artist
+
+        Returns:
+            Map type -> {"source", "tiles", "colorspace"}, and the files no map claimed
+        """
+        patterns: Dict[Path, List[int]] = {}
+        for file_path in sorted(Path(f) for f in files):
+            pattern, tile = get_udim_pattern(file_path)
+            tiles = patterns.setdefault(pattern, [])
+            if tile is not None:
+                tiles.append(tile)
+
+        maps: Dict[str, Dict[str, Any]] = {}
+        unmatched: List[Path] = []
+        for pattern, tiles in patterns.items():
+            map_type = detect_map_type(pattern.name)
+            if map_type is None or map_type in maps:
+                unmatched.extend(expand_file_pattern(str(pattern)) or [pattern])
+                continue
+            maps[map_type] = {
+                "source": pattern,
+                "tiles": tiles,
+                "colorspace": get_default_colorspace(map_type),
+            }
+        return maps, unmatched
+
+    def save_texture_set(
+        self, maps: Dict[str, Dict[str, Any]], descriptor_path: Path, notes: str = ""
+    ) -> Optional[Path]:
+        """
+        Copy texture maps into the library as a texture set asset
+
+        Args:
+            maps: Map type -> {"source": file or <UDIM> pattern, "colorspace": name}
+            descriptor_path: .texset file to write
+            notes: Free text description
+
+        Returns:
+            Written descriptor path, None if no map could be copied
+        """
+        descriptor_path = Path(descriptor_path).with_suffix(TEXTURE_SET_EXTENSION)
+        maps_dir = descriptor_path.parent / TEXTURE_SETS_DIR_NAME / descriptor_path.stem
+        dependency_service = get_dependency_service()
+
+        stored: Dict[str, Dict[str, Any]] = {}
+        for map_type, options in maps.items():
+            source = Path(options["source"])
+            files = expand_file_pattern(str(source))
+            if not files:
+                print(f"[WARNING] {map_type}: no files found for {source.name}")
+                continue
+            plan = {path.resolve(): maps_dir / path.name for path in files}
+            copied = dependency_service.copy_dependencies(plan)
+            if len(copied) < len(files):
+                print(f"[WARNING] {map_type}: {len(files) - len(copied)} file(s) not copied")
+            if not copied:
+                continue
+            tiles = sorted(
+                tile for tile in (get_udim_pattern(path)[1] for path in copied) if tile is not None
+            )
+            target = maps_dir / source.name
+            stored[map_type] = {
+                "file": target.relative_to(descriptor_path.parent).as_posix(),
+                "colorspace": options.get("colorspace") or get_default_colorspace(map_type),
+                "udim": UDIM_TOKEN in source.name,
+                "tiles": tiles,
+            }
+        if not stored:
+            print(f"[ERROR] No texture maps copied for {descriptor_path.stem}")
+            return None
+
+        descriptor = {
+            "type": "texture_set",
+            "format_version": TEXTURE_SET_FORMAT_VERSION,
+            "name": descriptor_path.stem,
+            "notes": notes,
+            "maps": stored,
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+        }
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+        self._write_thumbnail(descriptor_path, stored)
+
+        print(f"[OK] Saved texture set {descriptor_path.name} ({', '.join(stored)})")
+        return descriptor_path
+
+    def load_texture_set(self, descriptor_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .texset descriptor, None if it is not a valid texture set"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "texture_set":
+                return None
+            return descriptor
+        except Exception as e:
+            self.logger.error(f"Failed to read texture set {descriptor_path}: {e}")
+            return None
+
+    # Apply ------------------------------------------------------------------------------
+
+    def find_target_shaders(self, cmds: Any, selection: List[str]) -> List[str]:
+        """Get the standard surface shaders of the selection (shaders, groups, or meshes)"""
+        shaders = [node for node in selection if cmds.nodeType(node) in SHADER_TYPES]
+        material_service = get_material_service()
+        others = [node for node in selection if node not in shaders]
+        for engine in material_service.find_shading_engines(cmds, others) if others else []:
+            for shader in material_service.get_network_roots(cmds, engine).values():
+                if shader not in shaders and cmds.nodeType(shader) in SHADER_TYPES:
+                    shaders.append(shader)
+        return shaders
+
+    def apply_texture_set(self, cmds: Any, descriptor_path: Path, shader: str) -> List[str]:
+        """
+        Build file nodes for a texture set and connect them to a surface shader
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .texset file
+            shader: aiStandardSurface or standardSurface to texture
+
+        Returns:
+            Created file nodes, empty if the set or shader could not be used
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_texture_set(descriptor_path)
+        if descriptor is None:
+            return []
+        shader_type = cmds.nodeType(shader)
+        if shader_type not in SHADER_TYPES:
+            print(f"[ERROR] {shader} is a {shader_type}, not a standard surface shader")
+            return []
+
+        file_nodes = []
+        for map_type in descriptor.get("maps", {}):
+            file_node = self._create_file_node(cmds, descriptor_path, descriptor, map_type)
+            file_nodes.append(file_node)
+            if map_type in SHADER_CONNECTIONS:
+                output, shader_input = SHADER_CONNECTIONS[map_type]
+                cmds.connectAttr(f"{file_node}.{output}", f"{shader}.{shader_input}", force=True)
+                if map_type == "emission":
+                    cmds.setAttr(f"{shader}.emission", 1.0)
+            elif map_type == "normal":
+                self.connect_normal_map(cmds, file_node, shader, shader_type)
+            elif map_type == "height":
+                self._connect_height_map(cmds, file_node, shader)
+
+        print(f"[OK] Applied texture set {descriptor['name']} to {shader}: {len(file_nodes)} maps")
+        return file_nodes
+
+    def create_file_node(
+        self,
+        cmds: Any,
+        name: str,
+        path: str,
+        colorspace: str,
+        udim: bool = False,
+        luminance: bool = False,
+    ) -> str:
+        """
+        Create a file node with its placement, colorspace, and UDIM tiling
+
+        Args:
+            cmds: maya.cmds module
+            name: Node name
+            path: Texture file, or its <UDIM> pattern
+            colorspace: Kept over the scene's file rules
+            udim: Read the path as UDIM tiles
+            luminance: Single channel map read through outAlpha
+
+        Returns:
+            The file node
+        """
+        file_node = cmds.shadingNode("file", asTexture=True, name=name)
+        place = cmds.shadingNode("place2dTexture", asUtility=True, name=f"{file_node}_place2d")
+        for output, file_input in _PLACE2D_CONNECTIONS:
+            cmds.connectAttr(f"{place}.{output}", f"{file_node}.{file_input}", force=True)
+
+        cmds.setAttr(f"{file_node}.fileTextureName", path, type="string")
+        # Keep the published colorspace instead of the scene's file rules
+        cmds.setAttr(f"{file_node}.ignoreColorSpaceFileRules", True)
+        cmds.setAttr(f"{file_node}.colorSpace", colorspace, type="string")
+        cmds.setAttr(f"{file_node}.uvTilingMode", UV_TILING_UDIM if udim else UV_TILING_OFF)
+        if luminance:
+            cmds.setAttr(f"{file_node}.alphaIsLuminance", True)
+        return file_node
+
+    def connect_normal_map(
+        self, cmds: Any, file_node: str, shader: str, shader_type: str
+    ) -> None:
+        """Plug a tangent space normal map into the shader through the renderer's node"""
+        if shader_type == "aiStandardSurface":
+            normal = cmds.shadingNode("aiNormalMap", asUtility=True, name=f"{file_node}_normal")
+            cmds.connectAttr(f"{file_node}.outColor", f"{normal}.input", force=True)
+            cmds.connectAttr(f"{normal}.outValue", f"{shader}.normalCamera", force=True)
+            return
+        bump = cmds.shadingNode("bump2d", asUtility=True, name=f"{file_node}_bump")
+        cmds.setAttr(f"{bump}.bumpInterp", 1)  # Tangent space normals
+        cmds.connectAttr(f"{file_node}.outAlpha", f"{bump}.bumpValue", force=True)
+        cmds.connectAttr(f"{bump}.outNormal", f"{shader}.normalCamera", force=True)
+
+    # Internals --------------------------------------------------------------------------
+
+    def _create_file_node(
+        self, cmds: Any, descriptor_path: Path, descriptor: Dict[str, Any], map_type: str
+    ) -> str:
+        """Create the file node of one of a texture set's maps"""
+        stored = descriptor["maps"][map_type]
+        return self.create_file_node(
+            cmds,
+            f"{descriptor['name']}_{map_type}",
+            (descriptor_path.parent / stored["file"]).as_posix(),
+            stored["colorspace"],
+            udim=bool(stored.get("udim")),
+            luminance=map_type not in COLOR_MAPS and map_type != "normal",
+        )
+
+    def _connect_height_map(self, cmds: Any, file_node: str, shader: str) -> None:
+        """Plug a height map into the displacement slot of the shader's shading groups"""
+        engines = cmds.listConnections(shader, type="shadingEngine") or []
+        if not engines:
+            print(f"[WARNING] {shader} has no shading group, height map left unconnected")
+            return
+        displacement = cmds.shadingNode(
+            "displacementShader", asShader=True, name=f"{file_node}_displacement"
+        )
+        cmds.connectAttr(f"{file_node}.outAlpha", f"{displacement}.displacement", force=True)
+        for engine in dict.fromkeys(engines):
+            cmds.connectAttr(
+                f"{displacement}.displacement", f"{engine}.displacementShader", force=True
+            )
+
+    def _write_thumbnail(self, descriptor_path: Path, maps: Dict[str, Dict[str, Any]]) -> None:
+        """Use the first base color tile as the library thumbnail when it is a PNG or JPEG"""
+        basecolor = maps.get("basecolor")
+        if basecolor is None:
+            return
+        tiles = expand_file_pattern(str(descriptor_path.parent / basecolor["file"]))
+        if not tiles or tiles[0].suffix.lower() not in _THUMBNAIL_EXTENSIONS:
+            return
+        thumbnail_dir = descriptor_path.parent / THUMBNAILS_DIR_NAME
+        suffix = ".png" if tiles[0].suffix.lower() == ".png" else ".jpg"
+        try:
+            thumbnail_dir.mkdir(parents=True, exist_ok=True)
+            shutil.copy2(tiles[0], thumbnail_dir / f"{descriptor_path.stem}_screenshot{suffix}")
+        except Exception as e:
+            print(f"[WARNING] Could not write texture set thumbnail: {e}")
+
+
+# Singleton instance factory
+_texture_set_service_instance = None
+
+
+def get_texture_set_service() -> TextureSetService:
+    """
+    Get singleton instance of TextureSetService.
+
+    Returns:
+        TextureSetService: Singleton service instance
+    """
+    global _texture_set_service_instance
+    if _texture_set_service_instance is None:
+        _texture_set_service_instance = TextureSetService()
+    return _texture_set_service_instance
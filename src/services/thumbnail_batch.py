@@ -0,0 +1,345 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Batch Script
+Runs inside mayapy to render a still thumbnail and optional turntable for an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Launched by ThumbnailQueue in a separate process so rendering never blocks the
+artist's Maya session. Uses Viewport 2.0 batch rendering (ogsRender), which
+works without a UI.
+
+Material presets (.material) are rendered as a shaded sphere swatch, and light rigs
+(.lightrig) as a grey sphere lit by the rig.
+
+Preset cameras orbit the asset's bounding box; a custom camera renders from the world
+matrix captured in the artist's scene. The library's thumbnail settings pick both.
+
+Renders go through the project's OCIO config and view transform when one is given, so
+thumbnails match the artist's viewport instead of showing raw linear color.
+
+Usage::
+
+    mayapy thumbnail_batch.py --input hero.ma --still hero_screenshot.png
+        [--turntable hero_turntable.gif --frames 36 --fps 12] [--size 512]
+        [--camera three_quarter|front|top|custom --camera-matrix m00,...,m33
+         --focal-length 35] [--no-lighting] [--background 0.36,0.36,0.36]
+        [--ocio-config config.ocio --ocio-rendering-space ACEScg
+         --ocio-display sRGB --ocio-view "ACES 1.0 SDR-video"]
+"""
+
+import argparse
+import json
+import shutil
+import subprocess
+import sys
+import tempfile
+from pathlib import Path
+from typing import List, Tuple
+
+# Preset camera -> (camera tilt, orbit angle) around the bounding box center
+CAMERA_ANGLES = {"three_quarter": (-20, 35), "front": (0, 0), "top": (-90, 0)}
+
+# Neutral studio lights: (name, rotation, intensity)
+NEUTRAL_LIGHTS = [
+    ("thumbnailKey", (-40, 35, 0), 1.0),
+    ("thumbnailFill", (-15, -60, 0), 0.45),
+    ("thumbnailRim", (-30, 160, 0), 0.6),
+]
+
+
+def _parse_floats(text: str) -> Tuple[float, ...]:
+    """Parse a comma separated list of numbers"""
+    return tuple(float(value) for value in text.split(",") if value.strip())
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line"""
+    parser = argparse.ArgumentParser(description="Render Asset Manager thumbnails")
+    parser.add_argument("--input", required=True, help="Asset file to open")
+    parser.add_argument("--still", required=True, help="Output PNG for the still thumbnail")
+    parser.add_argument("--turntable", default="", help="Output .gif or .mp4 turntable")
+    parser.add_argument("--frames", type=int, default=36, help="Turntable frame count")
+    parser.add_argument("--fps", type=int, default=12, help="Turntable playback rate")
+    parser.add_argument("--size", type=int, default=512, help="Square render resolution")
+    parser.add_argument(
+        "--camera", default="three_quarter", help="three_quarter, front, top, or custom"
+    )
+    parser.add_argument(
+        "--camera-matrix", type=_parse_floats, default=(), help="Custom camera world matrix"
+    )
+    parser.add_argument("--focal-length", type=float, default=35.0, help="Custom camera lens")
+    parser.add_argument(
+        "--no-lighting", action="store_true", help="Keep the asset's lights (no neutral rig)"
+    )
+    parser.add_argument(
+        "--background", type=_parse_floats, default=(0.36, 0.36, 0.36), help="r,g,b color"
+    )
+    parser.add_argument("--ocio-config", default="", help="OCIO config file")
+    parser.add_argument("--ocio-rendering-space", default="", help="Rendering color space")
+    parser.add_argument("--ocio-display", default="", help="OCIO display")
+    parser.add_argument("--ocio-view", default="", help="OCIO view transform")
+    return parser.parse_args(argv)
+
+
+def _open_asset(cmds, file_path: Path) -> None:
+    """Open the asset as the batch scene"""
+    suffix = file_path.suffix.lower()
+    if suffix == ".material":
+        _build_material_swatch(cmds, file_path)
+        return
+    if suffix == ".lightrig":
+        _build_light_rig_preview(cmds, file_path)
+        return
+    if suffix in (".ma", ".mb"):
+        cmds.file(str(file_path), open=True, force=True, ignoreVersion=True)
+        return
+
+    cmds.file(new=True, force=True)
+    if suffix in (".usd", ".usda", ".usdc", ".usdz"):
+        cmds.loadPlugin("mayaUsdPlugin", quiet=True)
+        cmds.mayaUSDImport(file=str(file_path))
+    elif suffix == ".abc":
+        cmds.loadPlugin("AbcImport", quiet=True)
+        cmds.AbcImport(str(file_path))
+    elif suffix == ".fbx":
+        cmds.loadPlugin("fbxmaya", quiet=True)
+        cmds.file(str(file_path), i=True, type="FBX")
+    elif suffix == ".obj":
+        cmds.loadPlugin("objExport", quiet=True)
+        cmds.file(str(file_path), i=True, type="OBJ")
+    else:
+        cmds.file(str(file_path), i=True)
+
+
+def _build_material_swatch(cmds, descriptor_path: Path) -> None:
+    """Build a swatch scene: a sphere carrying the material preset"""
+    with open(descriptor_path, "r", encoding="utf-8") as f:
+        descriptor = json.load(f)
+
+    cmds.file(new=True, force=True)
+    if descriptor.get("renderer") == "arnold":
+        cmds.loadPlugin("mtoa", quiet=True)
+
+    network_path = descriptor_path.parent / descriptor["network"]
+    new_nodes = cmds.file(str(network_path), i=True, type="mayaAscii", returnNewNodes=True)
+    shaders = [node for node in new_nodes or [] if node.endswith(descriptor["shader"])]
+    if not shaders:
+        raise RuntimeError(f"Shader {descriptor['shader']} not found in {network_path.name}")
+
+    # Textures are stored relative to the network file
+    for node in cmds.ls(new_nodes, type="file") or []:
+        path = cmds.getAttr(f"{node}.fileTextureName") or ""
+        if path and not Path(path).is_absolute():
+            absolute = (network_path.parent / path).as_posix()
+            cmds.setAttr(f"{node}.fileTextureName", absolute, type="string")
+
+    sphere = cmds.polySphere(name="swatchSphere", subdivisionsX=64, subdivisionsY=48)[0]
+    engine = cmds.sets(renderable=True, noSurfaceShader=True, empty=True, name="swatchSG")
+    cmds.connectAttr(f"{shaders[0]}.outColor", f"{engine}.surfaceShader", force=True)
+    cmds.sets(sphere, edit=True, forceElement=engine)
+
+
+def _build_light_rig_preview(cmds, descriptor_path: Path) -> None:
+    """Build a preview scene: a neutral grey sphere lit only by the light rig"""
+    with open(descriptor_path, "r", encoding="utf-8") as f:
+        descriptor = json.load(f)
+
+    cmds.file(new=True, force=True)
+    renderer = descriptor.get("renderer")
+    if renderer == "arnold":
+        cmds.loadPlugin("mtoa", quiet=True)
+    elif renderer == "renderman":
+        cmds.loadPlugin("RenderMan_for_Maya", quiet=True)
+
+    hdri = (descriptor_path.parent / descriptor["hdri"]).as_posix() if descriptor["hdri"] else ""
+    if descriptor.get("kind") == "hdri":
+        if renderer == "renderman":
+            dome = cmds.createNode("PxrDomeLight", name="previewDomeShape")
+            cmds.setAttr(f"{dome}.lightColorMap", hdri, type="string")
+        else:
+            dome = cmds.createNode("aiSkyDomeLight", name="previewDomeShape")
+            texture = cmds.shadingNode("file", asTexture=True, name="previewHdri")
+            cmds.setAttr(f"{texture}.fileTextureName", hdri, type="string")
+            cmds.connectAttr(f"{texture}.outColor", f"{dome}.color", force=True)
+    else:
+        network_path = descriptor_path.parent / descriptor["network"]
+        new_nodes = cmds.file(str(network_path), i=True, type="mayaAscii", returnNewNodes=True)
+        for node in cmds.ls(new_nodes, type="file") or []:
+            path = cmds.getAttr(f"{node}.fileTextureName") or ""
+            if path and not Path(path).is_absolute():
+                absolute = (network_path.parent / path).as_posix()
+                cmds.setAttr(f"{node}.fileTextureName", absolute, type="string")
+
+    sphere = cmds.polySphere(name="previewSphere", subdivisionsX=64, subdivisionsY=48)[0]
+    shader = cmds.shadingNode("lambert", asShader=True, name="previewGrey")
+    cmds.setAttr(f"{shader}.color", 0.5, 0.5, 0.5, type="double3")
+    engine = cmds.sets(renderable=True, noSurfaceShader=True, empty=True, name="previewSG")
+    cmds.connectAttr(f"{shader}.outColor", f"{engine}.surfaceShader", force=True)
+    cmds.sets(sphere, edit=True, forceElement=engine)
+
+
+def _create_camera(cmds, size: int, args: argparse.Namespace):
+    """Create the thumbnail camera under a pivot at the scene bounding box center"""
+    meshes = cmds.ls(type="mesh", noIntermediate=True) or []
+    if not meshes:
+        raise RuntimeError("No geometry to render")
+
+    bbox = cmds.exactWorldBoundingBox(meshes)
+    center = [(bbox[0] + bbox[3]) / 2.0, (bbox[1] + bbox[4]) / 2.0, (bbox[2] + bbox[5]) / 2.0]
+
+    pivot = cmds.group(empty=True, name="thumbnailPivot")
+    cmds.xform(pivot, worldSpace=True, translation=center)
+
+    camera, shape = cmds.camera(name="thumbnailCam")
+    if args.camera == "custom" and len(args.camera_matrix) == 16:
+        # Placed by the artist - keep the framing, the pivot only orbits it for turntables
+        cmds.xform(camera, worldSpace=True, matrix=list(args.camera_matrix))
+        cmds.setAttr(f"{shape}.focalLength", args.focal_length)
+        camera = cmds.parent(camera, pivot)[0]
+    else:
+        tilt, orbit = CAMERA_ANGLES.get(args.camera, CAMERA_ANGLES["three_quarter"])
+        camera = cmds.parent(camera, pivot)[0]
+        translation = (0, 10, 0) if tilt == -90 else (0, 0, 10)
+        cmds.xform(camera, objectSpace=True, translation=translation, rotation=(tilt, 0, 0))
+        cmds.setAttr(f"{pivot}.rotateY", orbit)
+        cmds.viewFit(camera, meshes, fitFactor=0.85)
+
+    cmds.setAttr("defaultResolution.width", size)
+    cmds.setAttr("defaultResolution.height", size)
+    cmds.setAttr("defaultResolution.deviceAspectRatio", 1.0)
+    cmds.setAttr("defaultRenderGlobals.imageFormat", 32)  # PNG
+    return camera, pivot
+
+
+def _setup_lighting(cmds, neutral: bool, background: Tuple[float, ...]) -> None:
+    """Set the viewport background and replace the asset's lights with a neutral rig"""
+    cmds.displayPref(displayGradient=False)
+    cmds.displayRGBColor("background", *background[:3])
+    if not neutral:
+        return
+
+    for light in cmds.ls(lights=True) or []:
+        cmds.setAttr(f"{light}.visibility", False)
+    for name, rotation, intensity in NEUTRAL_LIGHTS:
+        shape = cmds.directionalLight(name=f"{name}Shape", intensity=intensity)
+        transform = cmds.listRelatives(shape, parent=True)[0]
+        cmds.xform(transform, worldSpace=True, rotation=rotation)
+
+
+def _setup_color_management(cmds, args: argparse.Namespace) -> None:
+    """Render through the project's OCIO view transform, like the artist's viewport"""
+    if not (args.ocio_config or args.ocio_view):
+        return
+
+    cmds.colorManagementPrefs(edit=True, cmEnabled=True)
+    if args.ocio_config:
+        cmds.colorManagementPrefs(edit=True, configFilePath=args.ocio_config)
+        cmds.colorManagementPrefs(edit=True, cmConfigFileEnabled=True)
+    if args.ocio_rendering_space:
+        cmds.colorManagementPrefs(edit=True, renderingSpaceName=args.ocio_rendering_space)
+    if args.ocio_display:
+        cmds.colorManagementPrefs(edit=True, displayName=args.ocio_display)
+    if args.ocio_view:
+        try:
+            cmds.colorManagementPrefs(edit=True, viewName=args.ocio_view)
+        except TypeError:
+            # Maya 2020 and older name the view transform only
+            cmds.colorManagementPrefs(edit=True, viewTransformName=args.ocio_view)
+    for target in ("renderer", "playblast"):
+        cmds.colorManagementPrefs(
+            edit=True,
+            outputTarget=target,
+            outputTransformEnabled=True,
+            outputUseViewTransform=True,
+        )
+
+
+def _render_frame(cmds, camera: str, output: Path, size: int) -> Path:
+    """Render the current frame with Viewport 2.0 and move it to output"""
+    rendered = cmds.ogsRender(camera=camera, width=size, height=size, enableMultisample=True)
+    output.parent.mkdir(parents=True, exist_ok=True)
+    shutil.move(rendered, str(output))
+    return output
+
+
+def _render_turntable(cmds, camera: str, pivot: str, frames: int, size: int, temp_dir: Path):
+    """Render a 360 degree orbit as a numbered PNG sequence"""
+    start_rotation = cmds.getAttr(f"{pivot}.rotateY")
+    images = []
+    for frame in range(frames):
+        cmds.setAttr(f"{pivot}.rotateY", start_rotation + 360.0 * frame / frames)
+        images.append(_render_frame(cmds, camera, temp_dir / f"frame.{frame:04d}.png", size))
+    return images
+
+
+def _assemble_turntable(images: List[Path], output: Path, fps: int) -> None:
+    """Write frames as GIF (Pillow or ffmpeg) or MP4 (ffmpeg)"""
+    output.parent.mkdir(parents=True, exist_ok=True)
+    if output.suffix.lower() == ".gif":
+        try:
+            from PIL import Image  # type: ignore
+
+            frames = [Image.open(str(image)) for image in images]
+            frames[0].save(
+                str(output),
+                save_all=True,
+                append_images=frames[1:],
+                duration=int(1000 / fps),
+                loop=0,
+            )
+            return
+        except ImportError:
+            pass
+
+    ffmpeg = shutil.which("ffmpeg")
+    if not ffmpeg:
+        raise RuntimeError("ffmpeg not found - cannot write turntable")
+
+    pattern = str(images[0].parent / "frame.%04d.png")
+    command = [ffmpeg, "-y", "-framerate", str(fps), "-i", pattern]
+    if output.suffix.lower() == ".mp4":
+        command += ["-c:v", "libx264", "-pix_fmt", "yuv420p"]
+    subprocess.run(command + [str(output)], check=True, capture_output=True)
+
+
+def main(argv: List[str]) -> int:
+    """Render still (and turntable) - returns process exit code"""
+    args = _parse_args(argv)
+
+    import maya.standalone  # type: ignore
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+
+        _open_asset(cmds, Path(args.input))
+        # A light rig preview is lit by the rig itself
+        neutral = not args.no_lighting and Path(args.input).suffix.lower() != ".lightrig"
+        _setup_lighting(cmds, neutral, args.background)
+        _setup_color_management(cmds, args)
+        camera, pivot = _create_camera(cmds, args.size, args)
+        _render_frame(cmds, camera, Path(args.still), args.size)
+        print(f"[OK] Rendered still thumbnail: {args.still}")
+
+        if args.turntable:
+            temp_dir = Path(tempfile.mkdtemp(prefix="assetManager_turntable_"))
+            try:
+                images = _render_turntable(cmds, camera, pivot, args.frames, args.size, temp_dir)
+                _assemble_turntable(images, Path(args.turntable), args.fps)
+                print(f"[OK] Rendered turntable: {args.turntable}")
+            finally:
+                shutil.rmtree(temp_dir, ignore_errors=True)
+        return 0
+
+    except Exception as e:
+        print(f"[ERROR] Thumbnail batch failed: {e}")
+        return 1
+
+    finally:
+        maya.standalone.uninitialize()
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
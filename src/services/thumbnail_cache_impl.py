@@ -0,0 +1,130 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Cache Implementation
+Bounded in-memory cache of decoded thumbnail images for the library grid
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Thumbnails are decoded when their item scrolls into view and kept in least recently
+used order. Once the cap is reached the image that was shown longest ago is dropped, so
+memory stays bounded however large the library is. An entry is decoded again when its
+file changes on disk (a new screenshot is saved over the old one).
+
+The cache is shared by the grid, which only peeks at decoded images while painting, and
+the decoder threads filling it, so every access is locked. Decoding itself runs outside
+the lock.
+"""
+
+import logging
+import threading
+from collections import OrderedDict
+from pathlib import Path
+from typing import Any, Callable, Optional, Tuple
+
+DEFAULT_MAX_THUMBNAILS = 500  # About 8 MB of 64x64 icons
+
+
+class ThumbnailCache:
+    """
+    Thumbnail Cache - Single Responsibility for keeping decoded images bounded
+    Decoding is delegated to the loader, so the cache works without Qt
+    """
+
+    def __init__(
+        self,
+        loader: Callable[[Path, str], Optional[Any]],
+        max_items: int = DEFAULT_MAX_THUMBNAILS,
+    ):
+        """
+        Args:
+            loader: Decodes (thumbnail file, variant) into an image, None if it cannot
+            max_items: Most images kept in memory
+        """
+        self.logger = logging.getLogger(__name__)
+        self._loader = loader
+        self._max_items = max(1, max_items)
+        # (file, variant) -> (file modification time, image)
+        self._entries: "OrderedDict[Tuple[str, str], Tuple[int, Any]]" = OrderedDict()
+        self._lock = threading.Lock()
+        self.hits = 0
+        self.misses = 0
+
+    def __len__(self) -> int:
+        return len(self._entries)
+
+    @property
+    def max_items(self) -> int:
+        """Get the most images kept in memory"""
+        return self._max_items
+
+    def get(self, thumbnail_path: Path, variant: str = "") -> Optional[Any]:
+        """
+        Get a decoded thumbnail, decoding it on first use or after the file changed
+
+        Args:
+            thumbnail_path: Thumbnail image file
+            variant: Rendering of the same file (e.g. with a status badge painted on)
+
+        Returns:
+            The decoded image, None if the file is missing or cannot be decoded
+        """
+        try:
+            mtime_ns = Path(thumbnail_path).stat().st_mtime_ns
+        except OSError:
+            self.discard(thumbnail_path)
+            return None
+
+        key = (str(thumbnail_path), variant)
+        with self._lock:
+            entry = self._entries.get(key)
+            if entry is not None and entry[0] == mtime_ns:
+                self._entries.move_to_end(key)
+                self.hits += 1
+                return entry[1]
+            self.misses += 1
+
+        try:
+            image = self._loader(Path(thumbnail_path), variant)
+        except Exception as e:
+            self.logger.warning(f"Failed to decode thumbnail {thumbnail_path}: {e}")
+            image = None
+        with self._lock:
+            if image is None:
+                self._entries.pop(key, None)
+                return None
+
+            self._entries[key] = (mtime_ns, image)
+            self._entries.move_to_end(key)
+            while len(self._entries) > self._max_items:
+                self._entries.popitem(last=False)
+        return image
+
+    def peek(self, thumbnail_path: Path, variant: str = "") -> Optional[Any]:
+        """Get an image already decoded, without touching the disk - for painting"""
+        key = (str(thumbnail_path), variant)
+        with self._lock:
+            entry = self._entries.get(key)
+            if entry is None:
+                return None
+            self._entries.move_to_end(key)
+            return entry[1]
+
+    def discard(self, thumbnail_path: Path) -> None:
+        """Drop every variant of a thumbnail, e.g. after it was regenerated"""
+        path = str(thumbnail_path)
+        with self._lock:
+            for key in [key for key in self._entries if key[0] == path]:
+                del self._entries[key]
+
+    def set_max_items(self, max_items: int) -> None:
+        """Change the cap, dropping the least recently used images over it"""
+        with self._lock:
+            self._max_items = max(1, max_items)
+            while len(self._entries) > self._max_items:
+                self._entries.popitem(last=False)
+
+    def clear(self) -> None:
+        """Drop every decoded image"""
+        with self._lock:
+            self._entries.clear()
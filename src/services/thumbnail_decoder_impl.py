@@ -0,0 +1,183 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Decoder Implementation
+Decode grid thumbnails on worker threads, the items in view first, then those ahead
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The grid paints only images already in the thumbnail cache, so scrolling never waits
+for a disk read. Each pass of the grid asks for the rows in view, then for the next
+screens in the direction it is scrolling; rows that scrolled away before a worker
+reached them are dropped unread::
+
+    rows 400-439 in view, scrolling down  -> decode 400-439, then prefetch 440-519
+    rows 400-439 in view, scrolling up    -> decode 400-439, then prefetch 399-320
+"""
+
+import logging
+import threading
+from concurrent.futures import Future, ThreadPoolExecutor, wait
+from pathlib import Path
+from typing import Callable, Iterable, List, Optional, Set, Tuple
+
+from .thumbnail_cache_impl import ThumbnailCache
+
+PREFETCH_SCREENS = 2  # Screens decoded ahead of the scroll direction
+DEFAULT_DECODE_WORKERS = 2
+
+DecodeRequest = Tuple[Path, str]  # (thumbnail file, cache variant)
+
+
+def find_visible_rows(
+    count: int,
+    row_top: Callable[[int], int],
+    row_bottom: Callable[[int], int],
+    view_top: int,
+    view_bottom: int,
+) -> Optional[Tuple[int, int]]:
+    """
+    Find the first and last row of a grid in view, by bisection
+
+    Rows of a wrapping grid are laid out top to bottom in order, so finding them takes
+    a few rectangle lookups instead of one per item of the library.
+
+    Args:
+        count: Number of rows (items) in the grid
+        row_top: Gets the top of a row's rectangle in viewport coordinates
+        row_bottom: Gets the bottom of a row's rectangle
+        view_top: Top of the viewport
+        view_bottom: Bottom of the viewport
+
+    Returns:
+        (first, last) row in view, None when no row is
+    """
+    low, high = 0, count
+    while low < high:  # First row ending below the top of the view
+        middle = (low + high) // 2
+        if row_bottom(middle) < view_top:
+            low = middle + 1
+        else:
+            high = middle
+    first = low
+    if first >= count or row_top(first) > view_bottom:
+        return None
+
+    low, high = first, count
+    while low < high:  # First row starting below the bottom of the view
+        middle = (low + high) // 2
+        if row_top(middle) <= view_bottom:
+            low = middle + 1
+        else:
+            high = middle
+    return first, low - 1
+
+
+def plan_prefetch_rows(
+    first: int, last: int, count: int, direction: int, screens: int = PREFETCH_SCREENS
+) -> List[int]:
+    """
+    Get the rows to decode ahead of the view, nearest first
+
+    Args:
+        first: First row in view
+        last: Last row in view
+        count: Number of rows in the grid
+        direction: Scroll direction, negative for up and anything else for down
+        screens: How many screens of rows to prefetch
+    """
+    ahead = (last - first + 1) * screens
+    if direction < 0:
+        return list(range(first - 1, max(first - 1 - ahead, -1), -1))
+    return list(range(last + 1, min(last + 1 + ahead, count)))
+
+
+class ThumbnailDecoder:
+    """
+    Thumbnail Decoder - Single Responsibility for decoding thumbnails off the UI thread
+    Callbacks fire from worker threads; the loader must not create GUI objects
+    """
+
+    def __init__(self, cache: ThumbnailCache, max_workers: int = DEFAULT_DECODE_WORKERS):
+        """
+        Args:
+            cache: Cache the decoded images go into, shared with the painting grid
+            max_workers: Decoding threads
+        """
+        self.logger = logging.getLogger(__name__)
+        self._cache = cache
+        self._executor = ThreadPoolExecutor(
+            max_workers=max_workers, thread_name_prefix="thumbdecode"
+        )
+        self._lock = threading.Lock()
+        self._wanted: Set[DecodeRequest] = set()  # Latest request, stale ones are skipped
+        self._queued: Set[DecodeRequest] = set()  # Waiting for a worker
+        self._futures: List[Future] = []
+        self._callbacks: List[Callable[[Path, str], None]] = []
+
+    def add_decoded_callback(self, callback: Callable[[Path, str], None]) -> None:
+        """Register callback run (on a worker thread) when an image is in the cache"""
+        self._callbacks.append(callback)
+
+    def request(
+        self, requests: Iterable[DecodeRequest], refresh: Iterable[DecodeRequest] = ()
+    ) -> int:
+        """
+        Replace what should be decoded, in priority order
+
+        Args:
+            requests: (thumbnail file, variant) pairs, the most wanted first; images
+                already cached are not read again
+            refresh: Requests to check against the file on disk even when cached, e.g.
+                items shown for the first time since the grid was filled
+
+        Returns:
+            Number of decodes queued
+        """
+        ordered = list(dict.fromkeys((Path(path), variant) for path, variant in requests))
+        refreshed = {(Path(path), variant) for path, variant in refresh}
+        queued = 0
+        with self._lock:
+            self._wanted = set(ordered)
+            self._futures = [future for future in self._futures if not future.done()]
+            for key in ordered:
+                if key in self._queued:
+                    continue
+                if key not in refreshed and self._cache.peek(*key) is not None:
+                    continue
+                self._queued.add(key)
+                self._futures.append(self._executor.submit(self._decode, key))
+                queued += 1
+        return queued
+
+    def pending_count(self) -> int:
+        """Get number of decodes waiting for a worker"""
+        with self._lock:
+            return len(self._queued)
+
+    def wait(self, timeout: Optional[float] = None) -> None:
+        """Block until queued decodes are done - for tests"""
+        with self._lock:
+            futures = list(self._futures)
+        wait(futures, timeout=timeout)
+
+    def shutdown(self) -> None:
+        """Drop queued decodes and stop the workers once the running ones finish"""
+        with self._lock:
+            self._wanted = set()
+        self._executor.shutdown(wait=False)
+
+    def _decode(self, key: DecodeRequest) -> None:
+        """Decode one thumbnail into the cache and notify listeners"""
+        with self._lock:
+            self._queued.discard(key)
+            if key not in self._wanted:
+                return  # Scrolled away before a worker got to it
+        if self._cache.get(*key) is None:
+            return
+
+        for callback in self._callbacks:
+            try:
+                callback(*key)
+            except Exception as e:
+                self.logger.error(f"Thumbnail decoded callback error: {e}")
@@ -0,0 +1,270 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Queue Implementation
+Background thumbnail and turntable generation through mayapy batch processes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Outputs go where the library already looks for custom screenshots::
+
+    assets/scenes/.thumbnails/hero_screenshot.png    <- still thumbnail
+    assets/scenes/.thumbnails/hero_turntable.gif     <- optional 360 turntable
+"""
+
+import logging
+import os
+import subprocess
+import sys
+import threading
+from concurrent.futures import Future, ThreadPoolExecutor, wait
+from dataclasses import dataclass
+from pathlib import Path
+from typing import Callable, List, Optional
+
+from ..core.models.color_transform import ColorTransform
+from ..core.models.thumbnail_settings import ThumbnailSettings
+from .color_management_service_impl import get_color_management_service
+from .thumbnail_settings_service_impl import get_thumbnail_settings_service
+
+THUMBNAIL_DIR_NAME = ".thumbnails"
+TURNTABLE_FORMATS = (".gif", ".mp4")
+BATCH_SCRIPT = Path(__file__).with_name("thumbnail_batch.py")
+
+
+@dataclass
+class ThumbnailJob:
+    """Single queued thumbnail request"""
+
+    asset_path: Path
+    turntable_format: str = ""  # "" for still only, else ".gif" / ".mp4"
+    frames: int = 36
+    size: int = 512
+    status: str = "queued"  # queued -> running -> done / failed
+    error: str = ""
+    settings: Optional[ThumbnailSettings] = None  # Camera and lighting, batch defaults if None
+    color: Optional[ColorTransform] = None  # OCIO view transform, Maya defaults if None
+
+    @property
+    def still_path(self) -> Path:
+        """Get still thumbnail output path"""
+        thumbnail_dir = self.asset_path.parent / THUMBNAIL_DIR_NAME
+        return thumbnail_dir / f"{self.asset_path.stem}_screenshot.png"
+
+    @property
+    def turntable_path(self) -> Optional[Path]:
+        """Get turntable output path, None for still-only jobs"""
+        if not self.turntable_format:
+            return None
+        return get_turntable_path(self.asset_path, self.turntable_format)
+
+
+def get_turntable_path(asset_path: Path, turntable_format: str = ".gif") -> Path:
+    """Get the turntable preview path for an asset (may not exist)"""
+    asset_path = Path(asset_path)
+    thumbnail_dir = asset_path.parent / THUMBNAIL_DIR_NAME
+    return thumbnail_dir / f"{asset_path.stem}_turntable{turntable_format}"
+
+
+def find_existing_turntable(asset_path: Path) -> Optional[Path]:
+    """Get the first turntable preview present on disk for an asset"""
+    for turntable_format in TURNTABLE_FORMATS:
+        candidate = get_turntable_path(asset_path, turntable_format)
+        if candidate.exists():
+            return candidate
+    return None
+
+
+def find_mayapy() -> Optional[Path]:
+    """Locate the mayapy interpreter of the running (or installed) Maya"""
+    executable = "mayapy.exe" if sys.platform == "win32" else "mayapy"
+    candidates = [Path(sys.executable).parent / executable]
+    maya_location = os.environ.get("MAYA_LOCATION")
+    if maya_location:
+        candidates.append(Path(maya_location) / "bin" / executable)
+
+    for candidate in candidates:
+        if candidate.is_file():
+            return candidate
+    return None
+
+
+class ThumbnailQueue:
+    """
+    Thumbnail Queue - Single Responsibility for background thumbnail jobs
+    Each job runs in its own mayapy process; callbacks fire from worker threads
+    """
+
+    def __init__(
+        self,
+        max_workers: int = 2,
+        runner: Optional[Callable[[ThumbnailJob], bool]] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._executor = ThreadPoolExecutor(max_workers=max_workers, thread_name_prefix="thumb")
+        self._runner = runner or self._run_batch_job
+        self._lock = threading.Lock()
+        self._jobs: List[ThumbnailJob] = []
+        self._futures: List[Future] = []
+        self._callbacks: List[Callable[[ThumbnailJob], None]] = []
+
+    def add_finished_callback(self, callback: Callable[[ThumbnailJob], None]) -> None:
+        """Register callback run (on a worker thread) when a job finishes"""
+        self._callbacks.append(callback)
+
+    def remove_finished_callback(self, callback: Callable[[ThumbnailJob], None]) -> None:
+        """Unregister a finished callback (e.g. when its window closes)"""
+        if callback in self._callbacks:
+            self._callbacks.remove(callback)
+
+    def enqueue(
+        self,
+        asset_path: Path,
+        turntable_format: str = "",
+        frames: int = 36,
+        size: int = 0,
+        settings: Optional[ThumbnailSettings] = None,
+        color: Optional[ColorTransform] = None,
+    ) -> Optional[ThumbnailJob]:
+        """
+        Queue thumbnail generation for an asset
+
+        Args:
+            asset_path: Library asset file
+            turntable_format: ".gif" or ".mp4" to also render a turntable, "" for still only
+            frames: Turntable frame count
+            size: Square render resolution, 0 for the settings' resolution (or 512)
+            settings: Camera, lighting, and background to render with
+            color: Project OCIO config and view to render through
+
+        Returns:
+            Queued job, None if the asset is already waiting in the queue
+        """
+        asset_path = Path(asset_path)
+        with self._lock:
+            for job in self._jobs:
+                if job.asset_path == asset_path and job.status in ("queued", "running"):
+                    return None
+            size = size or (settings.resolution if settings else 512)
+            job = ThumbnailJob(
+                asset_path, turntable_format, frames, size, settings=settings, color=color
+            )
+            self._jobs.append(job)
+
+            self._futures.append(self._executor.submit(self._process, job))
+        return job
+
+    def enqueue_many(
+        self,
+        asset_paths: List[Path],
+        settings_for: Optional[Callable[[Path], ThumbnailSettings]] = None,
+        **options,
+    ) -> List[ThumbnailJob]:
+        """
+        Queue thumbnail generation for several assets (bulk regeneration)
+
+        Args:
+            asset_paths: Library asset files
+            settings_for: Gets the settings of each asset (e.g. by its asset type)
+            **options: enqueue keyword arguments shared by every asset
+        """
+        jobs = []
+        for path in asset_paths:
+            settings = settings_for(Path(path)) if settings_for else None
+            jobs.append(self.enqueue(path, settings=settings, **options))
+        return [job for job in jobs if job is not None]
+
+    def pending_count(self) -> int:
+        """Get number of jobs not finished yet"""
+        with self._lock:
+            return sum(1 for job in self._jobs if job.status in ("queued", "running"))
+
+    def get_jobs(self) -> List[ThumbnailJob]:
+        """Get all jobs submitted since the last clear"""
+        with self._lock:
+            return list(self._jobs)
+
+    def clear_finished(self) -> None:
+        """Forget finished jobs"""
+        with self._lock:
+            self._jobs = [job for job in self._jobs if job.status in ("queued", "running")]
+            self._futures = [future for future in self._futures if not future.done()]
+
+    def wait(self, timeout: Optional[float] = None) -> None:
+        """Block until queued jobs are done - for batch tools and tests"""
+        with self._lock:
+            futures = list(self._futures)
+        wait(futures, timeout=timeout)
+
+    def build_batch_command(self, job: ThumbnailJob, mayapy: Path) -> List[str]:
+        """Build the mayapy command line for a job"""
+        command = [
+            str(mayapy),
+            str(BATCH_SCRIPT),
+            "--input",
+            str(job.asset_path),
+            "--still",
+            str(job.still_path),
+            "--size",
+            str(job.size),
+        ]
+        if job.turntable_path:
+            command += ["--turntable", str(job.turntable_path), "--frames", str(job.frames)]
+        if job.settings is not None:
+            command += get_thumbnail_settings_service().build_batch_arguments(job.settings)
+        if job.color is not None:
+            command += get_color_management_service().build_batch_arguments(job.color)
+        return command
+
+    def _process(self, job: ThumbnailJob) -> None:
+        """Run one job and notify listeners"""
+        job.status = "running"
+        try:
+            success = self._runner(job)
+            job.status = "done" if success else "failed"
+        except Exception as e:
+            job.status = "failed"
+            job.error = str(e)
+
+        if job.status == "failed":
+            print(f"[ERROR] Thumbnail job failed for {job.asset_path.name}: {job.error}")
+
+        for callback in self._callbacks:
+            try:
+                callback(job)
+            except Exception as e:
+                self.logger.error(f"Thumbnail callback error: {e}")
+
+    def _run_batch_job(self, job: ThumbnailJob) -> bool:
+        """Render a job in a separate mayapy process"""
+        mayapy = find_mayapy()
+        if mayapy is None:
+            job.error = "mayapy not found (set MAYA_LOCATION)"
+            return False
+
+        job.still_path.parent.mkdir(parents=True, exist_ok=True)
+        result = subprocess.run(
+            self.build_batch_command(job, mayapy), capture_output=True, text=True, check=False
+        )
+        if result.returncode != 0:
+            output = (result.stdout + result.stderr).strip().splitlines()
+            job.error = output[-1] if output else f"mayapy exited with {result.returncode}"
+            return False
+        return True
+
+
+# Singleton instance factory
+_thumbnail_queue_instance = None
+
+
+def get_thumbnail_queue() -> ThumbnailQueue:
+    """
+    Get singleton instance of ThumbnailQueue.
+
+    Returns:
+        ThumbnailQueue: Singleton queue instance
+    """
+    global _thumbnail_queue_instance
+    if _thumbnail_queue_instance is None:
+        _thumbnail_queue_instance = ThumbnailQueue()
+    return _thumbnail_queue_instance
@@ -565,6 +565,13 @@ class ThumbnailServiceImpl(IThumbnailService):
                 active_panel, edit=True, useDefaultMaterial=False
             )  # Use actual materials
 
+            # Capture through the scene's OCIO view transform, as the viewport shows it
+            from .color_management_service_impl import get_color_management_service
+
+            original_settings["color"] = get_color_management_service().enable_view_transform(
+                cmds
+            )
+
             print("[OK] Viewport configured for textured playblast")
             return original_settings
 
@@ -604,6 +611,12 @@ class ThumbnailServiceImpl(IThumbnailService):
                 cmds.modelEditor(
                     panel, edit=True, useDefaultMaterial=original_settings["useDefaultMaterial"]
                 )
+            if original_settings.get("color"):
+                from .color_management_service_impl import get_color_management_service
+
+                get_color_management_service().restore_view_transform(
+                    cmds, original_settings["color"]
+                )
 
             print("[OK] Viewport settings restored")
 
@@ -0,0 +1,219 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Settings Service Implementation
+Library thumbnail camera, lighting, background, and resolution per asset type
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Settings are stored with the library so every artist's thumbnails of an asset type
+look alike. An asset may keep its own settings (e.g. a camera chosen from the scene)
+in its library metadata, which win over its type's::
+
+    MyProject/.assetmanager/thumbnail_settings.json
+    {
+      "default": {"camera": "three_quarter", "lighting": true, "resolution": 512, ...},
+      "asset_types": {"Environments": {"camera": "top", "resolution": 1024, ...}}
+    }
+"""
+
+import json
+import logging
+from dataclasses import replace
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from ..core.models.thumbnail_settings import CAMERA_CUSTOM, ThumbnailSettings
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "thumbnail_settings.json"
+
+# Library metadata keys: the category an asset was published as, and its own settings
+ASSET_TYPE_METADATA_KEY = "asset_type"
+THUMBNAIL_METADATA_KEY = "thumbnail"
+
+
+def _format_values(values) -> str:
+    return ",".join(f"{value:g}" for value in values)
+
+
+class ThumbnailSettingsService:
+    """
+    Thumbnail Settings Service - Single Responsibility for thumbnail capture settings
+    Resolves the settings of an asset and turns them into thumbnail batch arguments
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Library settings -------------------------------------------------------------------
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the thumbnail settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def load_settings(
+        self, library_root: Optional[Path]
+    ) -> Tuple[ThumbnailSettings, Dict[str, ThumbnailSettings]]:
+        """
+        Get a library's default settings and the asset types that override them
+
+        Returns:
+            (default settings, asset type -> settings); built-in defaults with no
+            overrides when the library has no settings file
+        """
+        default = ThumbnailSettings()
+        asset_types: Dict[str, ThumbnailSettings] = {}
+        if library_root is None:
+            return default, asset_types
+
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return default, asset_types
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            default = ThumbnailSettings.from_dict(data.get("default", {}))
+            asset_types = {
+                asset_type: ThumbnailSettings.from_dict(values)
+                for asset_type, values in data.get("asset_types", {}).items()
+            }
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable thumbnail settings {settings_file}: {e}")
+        return default, asset_types
+
+    def save_settings(
+        self,
+        library_root: Path,
+        default: ThumbnailSettings,
+        asset_types: Dict[str, ThumbnailSettings],
+    ) -> bool:
+        """Write a library's default settings and asset type overrides"""
+        settings_file = self.get_settings_file(library_root)
+        data = {
+            "default": default.to_dict(),
+            "asset_types": {
+                asset_type: settings.to_dict() for asset_type, settings in asset_types.items()
+            },
+        }
+        try:
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump(data, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save thumbnail settings: {e}")
+            return False
+
+    def get_settings_for_asset_type(
+        self, library_root: Optional[Path], asset_type: str
+    ) -> ThumbnailSettings:
+        """Get the settings thumbnails of an asset type (category) are rendered with"""
+        default, asset_types = self.load_settings(library_root)
+        return asset_types.get(asset_type, default)
+
+    # Asset settings ---------------------------------------------------------------------
+
+    def get_asset_settings(
+        self, library_root: Optional[Path], asset_file: Path, database: Any = None
+    ) -> ThumbnailSettings:
+        """
+        Get the settings an asset's thumbnail is rendered with
+
+        Args:
+            library_root: Library holding the settings file
+            asset_file: Library asset file
+            database: Library metadata database; without one the default settings apply
+
+        Returns:
+            The asset's own settings, else its asset type's, else the library default
+        """
+        metadata: Dict[str, Any] = {}
+        if database is not None:
+            try:
+                metadata = database.get_asset_metadata(Path(asset_file)) or {}
+            except Exception as e:
+                self.logger.warning(f"Could not read metadata of {Path(asset_file).name}: {e}")
+
+        if metadata.get(THUMBNAIL_METADATA_KEY):
+            return ThumbnailSettings.from_dict(metadata[THUMBNAIL_METADATA_KEY])
+        return self.get_settings_for_asset_type(
+            library_root, metadata.get(ASSET_TYPE_METADATA_KEY, "")
+        )
+
+    def set_asset_settings(
+        self, database: Any, asset_file: Path, settings: Optional[ThumbnailSettings]
+    ) -> None:
+        """Store an asset's own settings, or None to go back to its asset type's"""
+        metadata = database.get_asset_metadata(Path(asset_file)) or {}
+        if settings is None:
+            metadata.pop(THUMBNAIL_METADATA_KEY, None)
+        else:
+            metadata[THUMBNAIL_METADATA_KEY] = settings.to_dict()
+        database.save_asset_metadata(Path(asset_file), metadata)
+
+    def capture_camera(
+        self, cmds: Any, camera: str, base: Optional[ThumbnailSettings] = None
+    ) -> ThumbnailSettings:
+        """
+        Get settings rendering through a scene camera's current placement
+
+        Args:
+            cmds: maya.cmds module
+            camera: Camera transform (or shape) in the open scene
+            base: Lighting, background, and resolution to keep, defaults otherwise
+
+        Returns:
+            Custom camera settings holding the camera's world matrix and focal length
+        """
+        if cmds.nodeType(camera) == "camera":
+            camera = cmds.listRelatives(camera, parent=True, fullPath=True)[0]
+        shapes = cmds.listRelatives(camera, shapes=True, type="camera", fullPath=True) or []
+        if not shapes:
+            raise ValueError(f"{camera} is not a camera")
+
+        matrix = cmds.xform(camera, query=True, worldSpace=True, matrix=True)
+        focal_length = cmds.getAttr(f"{shapes[0]}.focalLength")
+        return replace(
+            base or ThumbnailSettings(),
+            camera=CAMERA_CUSTOM,
+            camera_matrix=tuple(float(value) for value in matrix),
+            focal_length=float(focal_length),
+        )
+
+    # Batch ------------------------------------------------------------------------------
+
+    def build_batch_arguments(self, settings: ThumbnailSettings) -> List[str]:
+        """Build the thumbnail_batch.py arguments of settings (resolution is --size)"""
+        arguments = ["--background", _format_values(settings.background)]
+        if settings.is_custom:
+            arguments += [
+                "--camera",
+                CAMERA_CUSTOM,
+                "--camera-matrix",
+                _format_values(settings.camera_matrix),
+                "--focal-length",
+                f"{settings.focal_length:g}",
+            ]
+        elif settings.camera != CAMERA_CUSTOM:
+            arguments += ["--camera", settings.camera]
+        if not settings.lighting:
+            arguments.append("--no-lighting")
+        return arguments
+
+
+# Singleton instance factory
+_thumbnail_settings_service_instance = None
+
+
+def get_thumbnail_settings_service() -> ThumbnailSettingsService:
+    """
+    Get singleton instance of ThumbnailSettingsService.
+
+    Returns:
+        ThumbnailSettingsService: Singleton service instance
+    """
+    global _thumbnail_settings_service_instance
+    if _thumbnail_settings_service_instance is None:
+        _thumbnail_settings_service_instance = ThumbnailSettingsService()
+    return _thumbnail_settings_service_instance
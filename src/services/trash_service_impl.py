@@ -0,0 +1,299 @@
+# -*- coding: utf-8 -*-
+"""
+Trash Service Implementation
+Soft-delete library assets into a trash folder to restore or purge them later
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Deleting moves the asset with everything stored for it - thumbnails, collected
+dependencies, version history, LODs, and preset networks - into one trash entry that
+mirrors the library layout::
+
+    MyProject/.trash/20250131-140200_crate_3f2a/trash.json
+    MyProject/.trash/20250131-140200_crate_3f2a/files/assets/scenes/crate.ma
+    MyProject/.trash/20250131-140200_crate_3f2a/files/assets/scenes/.versions/crate/...
+    MyProject/.assetmanager/trash.json    <- {"retention_days": 30}
+
+Entries older than the library's retention period are purged automatically when the
+library is loaded; a retention of 0 days keeps them until someone purges them.
+"""
+
+import json
+import logging
+import shutil
+import uuid
+from datetime import datetime
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.models.gltf_export import GLTF_EXTENSIONS
+from ..core.models.trash_entry import TrashEntry
+from .alembic_service_impl import get_alembic_service
+from .dependency_service_impl import get_dependency_service
+from .groom_service_impl import GROOM_EXTENSION, GROOMS_DIR_NAME
+from .light_rig_service_impl import LIGHT_RIG_EXTENSION, RIGS_DIR_NAME
+from .lod_service_impl import get_lod_service
+from .material_service_impl import MATERIAL_EXTENSION, NETWORKS_DIR_NAME
+from .metadata_database_impl import SIDECAR_SUFFIX
+from .version_service_impl import get_current_user, get_version_service
+from .volume_service_impl import VOLUME_EXTENSION, VOLUMES_DIR_NAME
+
+TRASH_DIR_NAME = ".trash"
+ENTRY_FILE_NAME = "trash.json"
+ENTRY_FILES_DIR_NAME = "files"
+
+SETTINGS_DIR_NAME = ".assetmanager"
+SETTINGS_FILE_NAME = "trash.json"
+DEFAULT_RETENTION_DAYS = 30
+
+THUMBNAILS_DIR_NAME = ".thumbnails"
+
+
+class TrashService:
+    """
+    Trash Service - Single Responsibility for the library trash
+    Pure file operations, so deleting works the same inside and outside Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_trash_directory(self, library_root: Path) -> Path:
+        """Get the trash folder of a library"""
+        return Path(library_root) / TRASH_DIR_NAME
+
+    def get_settings_file(self, library_root: Path) -> Path:
+        """Get the trash settings file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / SETTINGS_FILE_NAME
+
+    def get_retention_days(self, library_root: Optional[Path]) -> int:
+        """Get how many days deleted assets are kept (0 keeps them until purged)"""
+        if library_root is None:
+            return DEFAULT_RETENTION_DAYS
+        settings_file = self.get_settings_file(library_root)
+        if not settings_file.is_file():
+            return DEFAULT_RETENTION_DAYS
+        try:
+            with open(settings_file, "r", encoding="utf-8") as f:
+                return max(0, int(json.load(f).get("retention_days", DEFAULT_RETENTION_DAYS)))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable trash settings {settings_file}: {e}")
+            return DEFAULT_RETENTION_DAYS
+
+    def set_retention_days(self, library_root: Path, days: int) -> bool:
+        """Store a library's retention period"""
+        settings_file = self.get_settings_file(library_root)
+        try:
+            settings_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(settings_file, "w", encoding="utf-8") as f:
+                json.dump({"retention_days": max(0, int(days))}, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save trash settings: {e}")
+            return False
+
+    # Delete -----------------------------------------------------------------------------
+
+    def get_asset_paths(self, asset_file: Path) -> List[Path]:
+        """Get the asset file and every file or folder stored for it that exists"""
+        asset_file = Path(asset_file)
+        folder, stem = asset_file.parent, asset_file.stem
+        thumbnails = folder / THUMBNAILS_DIR_NAME
+        candidates = [
+            asset_file,
+            asset_file.with_name(f"{asset_file.name}{SIDECAR_SUFFIX}"),
+            *sorted(thumbnails.glob(f"{stem}_screenshot*")),
+            *sorted(thumbnails.glob(f"{stem}_turntable*")),
+            *sorted(thumbnails.glob(f"{stem}_playblast*")),
+            get_dependency_service().get_dependency_directory(asset_file),
+            get_version_service().get_history_directory(asset_file),
+            get_alembic_service().get_sidecar_path(asset_file),
+        ]
+        extension = asset_file.suffix.lower()
+        if extension in (".ma", ".mb"):
+            candidates.append(get_lod_service().get_lod_directory(asset_file))
+            candidates.append(asset_file.with_suffix(".fbx"))  # FBX handoff
+            candidates.extend(asset_file.with_suffix(suffix) for suffix in GLTF_EXTENSIONS)
+        networks_dir = {
+            MATERIAL_EXTENSION: NETWORKS_DIR_NAME,
+            LIGHT_RIG_EXTENSION: RIGS_DIR_NAME,
+            GROOM_EXTENSION: GROOMS_DIR_NAME,
+        }
+        if extension in networks_dir:
+            network_path = folder / networks_dir[extension] / f"{stem}.ma"
+            candidates.append(network_path)
+            candidates.append(get_dependency_service().get_dependency_directory(network_path))
+            candidates.extend(sorted(network_path.parent.glob(f"{stem}__*.xgen")))
+        if extension == VOLUME_EXTENSION:
+            candidates.append(folder / VOLUMES_DIR_NAME / stem)  # The .vdb sequence
+
+        paths: List[Path] = []
+        for path in candidates:
+            if path.exists() and path not in paths:
+                paths.append(path)
+        return paths
+
+    def trash_asset(
+        self,
+        library_root: Path,
+        asset_file: Path,
+        metadata: Optional[Dict[str, Any]] = None,
+        user: Optional[str] = None,
+    ) -> Optional[TrashEntry]:
+        """
+        Move an asset and its stored files into the library trash
+
+        Args:
+            library_root: Library the asset belongs to
+            asset_file: Asset file to delete
+            metadata: Library database row, put back on restore
+            user: Artist deleting the asset (defaults to the current user)
+
+        Returns:
+            The trash entry, None if the asset is not a file inside the library
+        """
+        library_root, asset_file = Path(library_root), Path(asset_file)
+        try:
+            asset_path = asset_file.resolve().relative_to(library_root.resolve())
+        except (OSError, ValueError):
+            print(f"[ERROR] {asset_file.name} is not inside library {library_root}")
+            return None
+        if not asset_file.is_file():
+            print(f"[ERROR] Asset file not found: {asset_file}")
+            return None
+
+        deleted_date = datetime.now()
+        entry_id = f"{deleted_date.strftime('%Y%m%d-%H%M%S')}_{asset_file.stem}_"
+        entry_id += uuid.uuid4().hex[:4]
+        entry_dir = self.get_trash_directory(library_root) / entry_id
+        files_dir = entry_dir / ENTRY_FILES_DIR_NAME
+
+        moved: List[str] = []
+        size = 0
+        for path in self.get_asset_paths(asset_file):
+            relative = path.resolve().relative_to(library_root.resolve())
+            size += self._get_size(path)
+            target = files_dir / relative
+            target.parent.mkdir(parents=True, exist_ok=True)
+            shutil.move(str(path), str(target))
+            moved.append(relative.as_posix())
+
+        entry = TrashEntry(
+            entry_id=entry_id,
+            asset_path=asset_path.as_posix(),
+            files=tuple(moved),
+            deleted_by=user or get_current_user(),
+            deleted_date=deleted_date,
+            size=size,
+            metadata=dict(metadata or {}),
+        )
+        with open(entry_dir / ENTRY_FILE_NAME, "w", encoding="utf-8") as f:
+            json.dump(entry.to_dict(), f, indent=2, default=str)
+
+        print(f"[OK] Moved {asset_file.name} to the library trash ({len(moved)} item(s))")
+        return entry
+
+    # Trash view -------------------------------------------------------------------------
+
+    def list_entries(self, library_root: Optional[Path]) -> List[TrashEntry]:
+        """Get a library's trash entries, most recently deleted first"""
+        if library_root is None:
+            return []
+        trash_dir = self.get_trash_directory(library_root)
+        if not trash_dir.is_dir():
+            return []
+
+        entries = []
+        for entry_file in trash_dir.glob(f"*/{ENTRY_FILE_NAME}"):
+            try:
+                with open(entry_file, "r", encoding="utf-8") as f:
+                    entries.append(TrashEntry.from_dict(json.load(f)))
+            except Exception as e:
+                print(f"[WARNING] Skipping unreadable trash entry {entry_file.parent.name}: {e}")
+        return sorted(entries, key=lambda entry: entry.deleted_date or datetime.min, reverse=True)
+
+    def restore(self, library_root: Path, entry: TrashEntry) -> Path:
+        """
+        Move a trashed asset and its files back to where they were
+
+        Returns:
+            The restored asset file
+
+        Raises:
+            FileExistsError: If a new asset was published at the same path meanwhile
+        """
+        library_root = Path(library_root)
+        entry_dir = self.get_trash_directory(library_root) / entry.entry_id
+        asset_file = library_root / entry.asset_path
+        clashes = [relative for relative in entry.files if (library_root / relative).exists()]
+        if clashes:
+            raise FileExistsError(
+                f"Cannot restore {entry.name} - {clashes[0]} already exists in the library"
+            )
+
+        for relative in entry.files:
+            source = entry_dir / ENTRY_FILES_DIR_NAME / relative
+            if not source.exists():
+                print(f"[WARNING] Missing from the trash entry: {relative}")
+                continue
+            target = library_root / relative
+            target.parent.mkdir(parents=True, exist_ok=True)
+            shutil.move(str(source), str(target))
+        shutil.rmtree(entry_dir, ignore_errors=True)
+
+        print(f"[OK] Restored {asset_file.name} from the library trash")
+        return asset_file
+
+    def purge(self, library_root: Path, entry: TrashEntry) -> None:
+        """Delete a trash entry for good"""
+        shutil.rmtree(self.get_trash_directory(library_root) / entry.entry_id, ignore_errors=True)
+        print(f"[OK] Purged {entry.name} from the library trash")
+
+    def purge_expired(
+        self, library_root: Optional[Path], now: Optional[datetime] = None
+    ) -> List[TrashEntry]:
+        """
+        Purge entries older than the library's retention period
+
+        Returns:
+            The purged entries
+        """
+        if library_root is None:
+            return []
+        retention_days = self.get_retention_days(library_root)
+        expired = [
+            entry
+            for entry in self.list_entries(library_root)
+            if entry.is_expired(retention_days, now)
+        ]
+        for entry in expired:
+            self.purge(library_root, entry)
+        return expired
+
+    # Internals --------------------------------------------------------------------------
+
+    def _get_size(self, path: Path) -> int:
+        """Get the size of a file or of every file in a folder"""
+        if path.is_file():
+            return path.stat().st_size
+        return sum(child.stat().st_size for child in path.rglob("*") if child.is_file())
+
+
+# Singleton instance factory
+_trash_service_instance = None
+
+
+def get_trash_service() -> TrashService:
+    """
+    Get singleton instance of TrashService.
+
+    Returns:
+        TrashService: Singleton service instance
+    """
+    global _trash_service_instance
+    if _trash_service_instance is None:
+        _trash_service_instance = TrashService()
+    return _trash_service_instance
@@ -0,0 +1,204 @@
+# -*- coding: utf-8 -*-
+"""
+Undo Service Implementation
+Group import and apply operations into one named undo chunk that rolls back on failure
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Everything an import does (create nodes, rename, parent, assign shaders, place) is one
+step in Maya's undo queue, so one Ctrl+Z takes the whole import back. An operation that
+fails half way is undone at once instead of leaving part of the asset in the scene::
+
+    with get_undo_service().chunk(cmds, "Import crate") as chunk:
+        roots = import_crate()
+        if not roots:
+            chunk.abort()   # Rolled back when the chunk closes, like an exception
+
+Chunks opened inside another join it; only the outermost one rolls back. When undo is
+off, or the queue does not end with the chunk, the nodes and namespaces the operation
+created are deleted instead. A failure that recorded nothing is not undone, so an empty
+chunk never takes back the artist's previous step of the same name.
+"""
+
+import logging
+from contextlib import contextmanager
+from typing import Any, Iterator, List, Optional, Set
+
+
+class UndoChunk:
+    """
+    Undo Chunk - Single Responsibility for the state of one open undo chunk
+    """
+
+    def __init__(self, name: str):
+        self.name = name
+        self.aborted = False
+        self.rolled_back = False
+
+    def abort(self) -> None:
+        """Roll the operation back when its chunk closes"""
+        self.aborted = True
+
+
+class UndoService:
+    """
+    Undo Service - Single Responsibility for undo chunks around scene operations
+    Scene access goes through the cmds argument so rollbacks can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        self._open: List[UndoChunk] = []
+
+    @property
+    def is_open(self) -> bool:
+        """Check if an operation's chunk is open"""
+        return bool(self._open)
+
+    @contextmanager
+    def chunk(self, cmds: Any, name: str) -> Iterator[UndoChunk]:
+        """
+        Run an operation as one named undo chunk
+
+        Args:
+            cmds: maya.cmds module
+            name: Chunk name, shown by Maya's Undo menu item
+
+        Yields:
+            The chunk; abort() it to roll back a failure that did not raise
+        """
+        chunk = UndoChunk(name)
+        outermost = not self._open
+        nodes_before: Set[str] = set()
+        namespaces_before: Set[str] = set()
+        undo_name_before = None
+        if outermost:
+            nodes_before = set(cmds.ls(long=True) or [])
+            namespaces_before = set(self._list_namespaces(cmds))
+            undo_name_before = self._get_undo_name(cmds)
+        cmds.undoInfo(openChunk=True, chunkName=name)
+        self._open.append(chunk)
+        try:
+            yield chunk
+        except BaseException:
+            chunk.abort()
+            raise
+        finally:
+            self._open.pop()
+            cmds.undoInfo(closeChunk=True)
+            if chunk.aborted and not outermost:
+                self._open[0].abort()  # Part of the operation failed, so all of it is undone
+            elif chunk.aborted:
+                chunk.rolled_back = self._roll_back(
+                    cmds, chunk, nodes_before, namespaces_before, undo_name_before
+                )
+
+    def _roll_back(
+        self,
+        cmds: Any,
+        chunk: UndoChunk,
+        nodes_before: Set[str],
+        namespaces_before: Set[str],
+        undo_name_before: Optional[str],
+    ) -> bool:
+        """Undo a failed operation's chunk, deleting what undo did not take back"""
+        undone = False
+        try:
+            if cmds.undoInfo(query=True, state=True) and self._recorded(
+                cmds, chunk, nodes_before, namespaces_before, undo_name_before
+            ):
+                cmds.undo()
+                undone = True
+        except Exception as e:
+            self.logger.warning(f"Could not undo {chunk.name}: {e}")
+
+        leftovers = [node for node in cmds.ls(long=True) or [] if node not in nodes_before]
+        namespaces = [
+            namespace
+            for namespace in self._list_namespaces(cmds)
+            if namespace not in namespaces_before
+        ]
+        if not leftovers and not namespaces:
+            if undone:
+                print(f"[OK] Rolled back {chunk.name}")
+            return True
+
+        for node in leftovers:
+            self._delete_node(cmds, node)
+        # Deepest first, so parents are empty once their children are gone
+        for namespace in sorted(namespaces, key=lambda name: -name.count(":")):
+            try:
+                if cmds.namespace(exists=namespace):
+                    cmds.namespace(removeNamespace=namespace, deleteNamespaceContent=True)
+            except Exception as e:
+                self.logger.warning(f"Could not remove namespace {namespace}: {e}")
+
+        remaining = [node for node in cmds.ls(long=True) or [] if node not in nodes_before]
+        if remaining:
+            print(
+                f"[WARNING] Rolled back {chunk.name}, but {len(remaining)} node(s) could not "
+                "be deleted: " + ", ".join(remaining[:5])
+            )
+            return False
+        print(f"[OK] Rolled back {chunk.name} by deleting {len(leftovers)} new node(s)")
+        return True
+
+    def _recorded(
+        self,
+        cmds: Any,
+        chunk: UndoChunk,
+        nodes_before: Set[str],
+        namespaces_before: Set[str],
+        undo_name_before: Optional[str],
+    ) -> bool:
+        """Check if the queue ends with the chunk, and not an earlier step of the same name"""
+        if self._get_undo_name(cmds) != chunk.name:
+            return False
+        if undo_name_before != chunk.name:
+            return True
+        # Maya drops empty chunks, so only a changed scene tells this one from the last
+        return (
+            set(cmds.ls(long=True) or []) != nodes_before
+            or set(self._list_namespaces(cmds)) != namespaces_before
+        )
+
+    def _get_undo_name(self, cmds: Any) -> Optional[str]:
+        """Get the name of the step the next undo takes back"""
+        return cmds.undoInfo(query=True, undoName=True) or None
+
+    def _delete_node(self, cmds: Any, node: str) -> None:
+        """Delete a node an operation created, removing references through their file"""
+        try:
+            if not cmds.objExists(node):
+                return  # Went with its parent
+            if cmds.nodeType(node) == "reference":
+                cmds.file(referenceNode=node, removeReference=True)
+                return
+            if cmds.lockNode(node, query=True, lock=True)[0]:
+                cmds.lockNode(node, lock=False)
+            cmds.delete(node)
+        except Exception as e:
+            self.logger.warning(f"Could not delete {node}: {e}")
+
+    def _list_namespaces(self, cmds: Any) -> List[str]:
+        """Get every namespace in the scene"""
+        namespaces = cmds.namespaceInfo(":", listOnlyNamespaces=True, recurse=True) or []
+        return [namespace for namespace in namespaces if namespace not in ("UI", "shared")]
+
+
+# Singleton instance factory
+_undo_service_instance = None
+
+
+def get_undo_service() -> UndoService:
+    """
+    Get singleton instance of UndoService.
+
+    Returns:
+        UndoService: Singleton service instance
+    """
+    global _undo_service_instance
+    if _undo_service_instance is None:
+        _undo_service_instance = UndoService()
+    return _undo_service_instance
@@ -0,0 +1,429 @@
+# -*- coding: utf-8 -*-
+"""
+Unreal Service Implementation
+Send published assets to an Unreal Engine project as FBX and import them remotely
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The FBX is written with the library's Unreal preset into the project's Content
+folder, laid out the way the asset will appear in the Content Browser::
+
+    MyGame/Content/AssetManager/Props/Crate/SM_Crate.fbx   -> /Game/AssetManager/Props/Crate
+
+A running editor with the Python Editor Script Plugin and "Enable Remote Execution"
+turned on then imports it through UE's remote execution protocol: a UDP multicast
+ping finds the editor, which connects back over TCP to receive the import script.
+Settings are per artist, in ~/.assetmanager/unreal.json.
+"""
+
+import json
+import logging
+import re
+import socket
+import time
+import uuid
+from dataclasses import dataclass, field
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional, Tuple
+
+from ..core.models.fbx_preset import FbxExportPreset
+from .fbx_export_service_impl import DEFAULT_PRESETS, MelRunner, get_fbx_export_service
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+
+DEFAULT_CONFIG: Dict[str, Any] = {
+    "content_dir": "",  # <Project>/Content - FBX stays next to the asset when empty
+    "game_folder": "AssetManager",  # Content Browser folder under /Game
+    "fbx_preset": "Unreal",
+    "remote_import": True,
+}
+
+# Asset type -> Unreal naming prefix; mesh imports are static unless listed here
+SKELETAL_TYPES = ("Rigs", "Characters")
+ANIMATION_TYPES = ("Animations",)
+DEFAULT_PREFIX = "SM_"
+
+# Defaults of the editor's Python remote execution settings
+MULTICAST_GROUP = ("239.0.0.1", 6766)
+COMMAND_ENDPOINT = ("127.0.0.1", 6776)
+PROTOCOL_VERSION = 1
+PROTOCOL_MAGIC = "ue_py"
+
+# Runs a Python script in the editor and returns its command_result data
+RemoteRunner = Callable[[str], Dict[str, Any]]
+
+
+@dataclass(frozen=True)
+class UnrealSendResult:
+    """Outcome of sending an asset to Unreal"""
+
+    fbx_file: Path
+    game_path: str  # /Game/AssetManager/Props/Crate/SM_Crate
+    imported: bool = False
+    message: str = ""
+    imported_paths: Tuple[str, ...] = field(default_factory=tuple)
+
+
+class UnrealRemoteExecution:
+    """Client side of UE's Python remote execution protocol (one command per call)"""
+
+    def __init__(
+        self,
+        multicast_group: Tuple[str, int] = MULTICAST_GROUP,
+        command_endpoint: Tuple[str, int] = COMMAND_ENDPOINT,
+        timeout: float = 5.0,
+    ):
+        self._multicast_group = multicast_group
+        self._command_endpoint = command_endpoint
+        self._timeout = timeout
+        self._node_id = str(uuid.uuid4())
+
+    def find_editor(self) -> Optional[Dict[str, Any]]:
+        """Get the pong of the first editor answering, None when none is running"""
+        broadcast = self._open_broadcast()
+        try:
+            return self._discover(broadcast)
+        finally:
+            broadcast.close()
+
+    def run(self, command: str) -> Dict[str, Any]:
+        """
+        Run a Python script in the editor
+
+        Returns:
+            command_result data ({"success", "result", "output"})
+
+        Raises:
+            RuntimeError: When no editor answers or it never connects back
+        """
+        broadcast = self._open_broadcast()
+        server = socket.socket(socket.AF_INET, socket.SOCK_STREAM)
+        try:
+            editor = self._discover(broadcast)
+            if editor is None:
+                raise RuntimeError(
+                    "No Unreal Editor answered - enable Python remote execution in the project"
+                )
+            server.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
+            server.bind(self._command_endpoint)
+            server.listen(1)
+            server.settimeout(self._timeout)
+            host, port = self._command_endpoint
+            self._send_broadcast(
+                broadcast,
+                "open_connection",
+                {"command_ip": host, "command_port": port},
+                editor["node"],
+            )
+            try:
+                connection, _address = server.accept()
+            except socket.timeout:
+                raise RuntimeError(
+                    "The Unreal Editor did not open the command connection"
+                ) from None
+            try:
+                connection.settimeout(max(self._timeout, 60.0))  # Imports can take a while
+                connection.sendall(
+                    encode_message(
+                        "command",
+                        self._node_id,
+                        editor["node"],
+                        {"command": command, "unattended": True, "exec_mode": "ExecuteFile"},
+                    )
+                )
+                return self._receive(connection).get("data") or {}
+            finally:
+                self._send_broadcast(broadcast, "close_connection", None, editor["node"])
+                connection.close()
+        finally:
+            server.close()
+            broadcast.close()
+
+    def _open_broadcast(self) -> socket.socket:
+        """Join the multicast group editors announce themselves on"""
+        group, port = self._multicast_group
+        broadcast = socket.socket(socket.AF_INET, socket.SOCK_DGRAM, socket.IPPROTO_UDP)
+        broadcast.setsockopt(socket.SOL_SOCKET, socket.SO_REUSEADDR, 1)
+        broadcast.setsockopt(socket.IPPROTO_IP, socket.IP_MULTICAST_TTL, 0)
+        broadcast.setsockopt(socket.IPPROTO_IP, socket.IP_MULTICAST_LOOP, 1)
+        broadcast.bind(("", port))
+        membership = socket.inet_aton(group) + socket.inet_aton("0.0.0.0")
+        broadcast.setsockopt(socket.IPPROTO_IP, socket.IP_ADD_MEMBERSHIP, membership)
+        broadcast.settimeout(0.25)
+        return broadcast
+
+    def _send_broadcast(
+        self,
+        broadcast: socket.socket,
+        message_type: str,
+        data: Optional[Dict[str, Any]],
+        dest: Optional[str] = None,
+    ) -> None:
+        """Send a message to the multicast group"""
+        message = encode_message(message_type, self._node_id, dest, data)
+        broadcast.sendto(message, self._multicast_group)
+
+    def _discover(self, broadcast: socket.socket) -> Optional[Dict[str, Any]]:
+        """Ping until an editor pongs, returning its data with its node id"""
+        deadline = time.monotonic() + self._timeout
+        next_ping = 0.0
+        while time.monotonic() < deadline:
+            if time.monotonic() >= next_ping:
+                self._send_broadcast(broadcast, "ping", None)
+                next_ping = time.monotonic() + 1.0
+            try:
+                message = decode_message(broadcast.recv(4096))
+            except (socket.timeout, ValueError):
+                continue
+            if message.get("type") == "pong" and message.get("dest") == self._node_id:
+                return dict(message.get("data") or {}, node=message["source"])
+        return None
+
+    def _receive(self, connection: socket.socket) -> Dict[str, Any]:
+        """Read one JSON message from the command connection"""
+        payload = b""
+        while True:
+            chunk = connection.recv(4096)
+            if not chunk:
+                break
+            payload += chunk
+            try:
+                return decode_message(payload)
+            except ValueError:
+                continue  # Message not complete yet
+        return decode_message(payload)
+
+
+def encode_message(
+    message_type: str, source: str, dest: Optional[str], data: Optional[Dict[str, Any]]
+) -> bytes:
+    """Encode a remote execution message"""
+    message: Dict[str, Any] = {
+        "version": PROTOCOL_VERSION,
+        "magic": PROTOCOL_MAGIC,
+        "type": message_type,
+        "source": source,
+    }
+    if dest:
+        message["dest"] = dest
+    if data is not None:
+        message["data"] = data
+    return json.dumps(message, ensure_ascii=False).encode("utf-8")
+
+
+def decode_message(payload: bytes) -> Dict[str, Any]:
+    """
+    Decode a remote execution message
+
+    Raises:
+        ValueError: For incomplete JSON or messages of another protocol
+    """
+    message = json.loads(payload.decode("utf-8"))
+    if message.get("magic") != PROTOCOL_MAGIC or message.get("version") != PROTOCOL_VERSION:
+        raise ValueError("Not a UE remote execution message")
+    return message
+
+
+class UnrealService:
+    """
+    Unreal Service - Single Responsibility for the Send to Unreal publish target
+    Never blocks a publish; the FBX stays for a manual import when the editor is unreachable
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        remote_runner: Optional[RemoteRunner] = None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "unreal.json"
+        self._remote_runner = remote_runner
+        self._config: Dict[str, Any] = self._load_config()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_config(self) -> Dict[str, Any]:
+        """Get the configuration"""
+        return dict(self._config)
+
+    def set_config(self, **values: Any) -> None:
+        """Update configuration values (call save_config to persist)"""
+        unknown = set(values) - set(DEFAULT_CONFIG)
+        if unknown:
+            raise ValueError(f"Unknown Unreal settings: {', '.join(sorted(unknown))}")
+        self._config.update(values)
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save Unreal config: {e}")
+            return False
+
+    # Destination ------------------------------------------------------------------------
+
+    def get_asset_name(self, asset_name: str, asset_type: str) -> str:
+        """Get the Unreal asset name with its naming prefix (SM_Crate, SK_Hero, A_Walk)"""
+        if asset_type in ANIMATION_TYPES:
+            prefix = "A_"
+        elif asset_type in SKELETAL_TYPES:
+            prefix = "SK_"
+        else:
+            prefix = DEFAULT_PREFIX
+        return f"{prefix}{_safe_name(asset_name)}"
+
+    def get_game_folder(self, asset_name: str, asset_type: str) -> str:
+        """Get the Content Browser folder an asset imports to (/Game/AssetManager/Props/Crate)"""
+        parts = [self._config.get("game_folder") or "", asset_type, asset_name]
+        return "/Game/" + "/".join(_safe_name(part) for part in parts if _safe_name(part))
+
+    def get_export_path(self, asset_file: Path, asset_type: str) -> Path:
+        """Get the FBX written for an asset: in the Content folder, else beside the asset"""
+        asset_file = Path(asset_file)
+        file_name = f"{self.get_asset_name(asset_file.stem, asset_type)}.fbx"
+        content_dir = self._config.get("content_dir")
+        if not content_dir:
+            return asset_file.parent / file_name
+        folder = self.get_game_folder(asset_file.stem, asset_type)[len("/Game/") :]
+        return Path(content_dir) / folder / file_name
+
+    def get_preset(self, library_root: Optional[Path]) -> FbxExportPreset:
+        """Get the FBX preset sends use, the built-in Unreal preset if it is gone"""
+        presets, _asset_types = get_fbx_export_service().load_presets(library_root)
+        name = self._config.get("fbx_preset") or "Unreal"
+        return presets.get(name) or DEFAULT_PRESETS["Unreal"]
+
+    # Send -------------------------------------------------------------------------------
+
+    def build_import_command(
+        self, fbx_file: Path, game_folder: str, asset_name: str, asset_type: str
+    ) -> str:
+        """Build the editor script that imports the FBX with an AssetImportTask"""
+        skeletal = asset_type in SKELETAL_TYPES or asset_type in ANIMATION_TYPES
+        animation = asset_type in ANIMATION_TYPES
+        return "\n".join(
+            [
+                "import unreal",
+                "task = unreal.AssetImportTask()",
+                f"task.filename = {json.dumps(Path(fbx_file).as_posix())}",
+                f"task.destination_path = {json.dumps(game_folder)}",
+                f"task.destination_name = {json.dumps(asset_name)}",
+                "task.automated = True",
+                "task.replace_existing = True",
+                "task.save = True",
+                "options = unreal.FbxImportUI()",
+                f"options.import_mesh = {not animation}",
+                f"options.import_as_skeletal = {skeletal}",
+                f"options.import_animations = {animation}",
+                "options.import_materials = True",
+                "options.import_textures = True",
+                "task.options = options",
+                "unreal.AssetToolsHelpers.get_asset_tools().import_asset_tasks([task])",
+                "print('\\n'.join(task.imported_object_paths))",
+            ]
+        )
+
+    def send(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        asset_type: str,
+        selection: Optional[List[str]] = None,
+        library_root: Optional[Path] = None,
+        mel_runner: Optional[MelRunner] = None,
+    ) -> UnrealSendResult:
+        """
+        Export an asset for Unreal and import it into the running editor
+
+        Args:
+            cmds: maya.cmds module
+            asset_file: Published asset; its name becomes the Unreal asset name
+            asset_type: Library category - picks the folder and static/skeletal import
+            selection: Nodes to export; None or empty exports the scene
+            library_root: Library holding the FBX presets
+            mel_runner: Runs MEL commands (maya.mel.eval when omitted)
+
+        Raises:
+            RuntimeError: When the FBX could not be written
+        """
+        asset_file = Path(asset_file)
+        fbx_file = self.get_export_path(asset_file, asset_type)
+        fbx_file.parent.mkdir(parents=True, exist_ok=True)
+        get_fbx_export_service().export(
+            cmds, fbx_file, self.get_preset(library_root), selection, mel_runner
+        )
+
+        game_folder = self.get_game_folder(asset_file.stem, asset_type)
+        asset_name = self.get_asset_name(asset_file.stem, asset_type)
+        game_path = f"{game_folder}/{asset_name}"
+        if not self._config.get("remote_import"):
+            return UnrealSendResult(fbx_file, game_path, message="remote import is off")
+
+        command = self.build_import_command(fbx_file, game_folder, asset_name, asset_type)
+        try:
+            runner = self._remote_runner or UnrealRemoteExecution().run
+            result = runner(command)
+        except Exception as e:
+            print(f"[WARNING] Unreal remote import of {asset_name} failed: {e}")
+            return UnrealSendResult(fbx_file, game_path, message=str(e))
+
+        output = "\n".join(
+            str(line.get("output", "")) for line in result.get("output") or []
+        ).strip()
+        if not result.get("success"):
+            message = str(result.get("result") or output or "import script failed")
+            print(f"[WARNING] Unreal import of {asset_name} failed: {message}")
+            return UnrealSendResult(fbx_file, game_path, message=message)
+
+        imported = tuple(line.strip() for line in output.splitlines() if line.strip())
+        print(f"[OK] Imported {asset_name} into Unreal at {game_folder}")
+        return UnrealSendResult(fbx_file, game_path, True, "imported", imported)
+
+    def find_editor(self) -> Optional[Dict[str, Any]]:
+        """Get the running editor's details (project_name, engine_version...), None if none"""
+        try:
+            return UnrealRemoteExecution().find_editor()
+        except OSError as e:
+            self.logger.warning(f"Unreal discovery failed: {e}")
+            return None
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Read configuration from disk"""
+        config = dict(DEFAULT_CONFIG)
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            if isinstance(data, dict):
+                config.update({k: v for k, v in data.items() if k in DEFAULT_CONFIG})
+        except Exception as e:
+            self.logger.warning(f"Ignoring unreadable Unreal config: {e}")
+        return config
+
+
+def _safe_name(text: str) -> str:
+    """Make text usable in Unreal paths (letters, digits, and underscores)"""
+    return re.sub(r"[^A-Za-z0-9_]+", "_", str(text)).strip("_")
+
+
+# Singleton instance factory
+_unreal_service_instance = None
+
+
+def get_unreal_service() -> UnrealService:
+    """
+    Get singleton instance of UnrealService.
+
+    Returns:
+        UnrealService: Singleton service instance
+    """
+    global _unreal_service_instance
+    if _unreal_service_instance is None:
+        _unreal_service_instance = UnrealService()
+    return _unreal_service_instance
@@ -0,0 +1,165 @@
+# -*- coding: utf-8 -*-
+"""
+Usage Statistics Service Implementation
+Count imports and publishes of a library, only when the library opted in
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Counters are kept in the library itself and never sent anywhere, so leads see which
+assets are used and which categories are neglected without any telemetry::
+
+    MyProject/.assetmanager/usage_stats.json   <- opt-in flag, imports, publishes
+
+Imports are counted per asset and publishes per artist and day. Tags and categories
+are looked up when the statistics are shown, so retagging an asset moves its imports.
+"""
+
+import json
+import logging
+from dataclasses import replace
+from datetime import date
+from pathlib import Path
+from typing import Any, Callable, Dict, List, Optional
+
+from ..core.models.usage_stats import UsageStats
+from .hook_service_impl import HOOK_POST_IMPORT, HOOK_POST_PUBLISH
+from .metadata_database_impl import get_metadata_database
+from .version_service_impl import get_current_user
+
+SETTINGS_DIR_NAME = ".assetmanager"
+STATS_FILE_NAME = "usage_stats.json"
+
+
+class UsageStatsService:
+    """
+    Usage Stats Service - Single Responsibility for a library's local usage counters
+    Counting never blocks the import or publish it records; a failed write is only reported
+    """
+
+    def __init__(self, database_factory: Optional[Callable[[Path], Any]] = None):
+        self.logger = logging.getLogger(__name__)
+        self._database_factory = database_factory or get_metadata_database
+
+    # Settings ---------------------------------------------------------------------------
+
+    def get_stats_file(self, library_root: Path) -> Path:
+        """Get the usage statistics file of a library"""
+        return Path(library_root) / SETTINGS_DIR_NAME / STATS_FILE_NAME
+
+    def load(self, library_root: Path) -> UsageStats:
+        """Get a library's usage statistics, off and empty when none are saved"""
+        stats_file = self.get_stats_file(library_root)
+        if not stats_file.is_file():
+            return UsageStats()
+        try:
+            with open(stats_file, "r", encoding="utf-8") as f:
+                return UsageStats.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Ignoring unreadable usage statistics {stats_file}: {e}")
+            return UsageStats()
+
+    def set_enabled(self, library_root: Path, enabled: bool) -> bool:
+        """
+        Opt a library in to counting, or stop counting (the counts so far are kept)
+
+        Returns:
+            True if the setting was saved
+        """
+        stats = self.load(library_root)
+        since = (stats.since or date.today()) if enabled else stats.since
+        return self._save(library_root, replace(stats, enabled=enabled, since=since))
+
+    def clear(self, library_root: Path) -> bool:
+        """Drop every count of a library, counting on from today if it is opted in"""
+        stats = self.load(library_root)
+        return self._save(
+            library_root,
+            UsageStats(enabled=stats.enabled, since=date.today() if stats.enabled else None),
+        )
+
+    # Recording --------------------------------------------------------------------------
+
+    def record_import(
+        self, library_root: Optional[Path], asset_file: Path, day: Optional[date] = None
+    ) -> bool:
+        """Count an import of an asset; nothing is counted unless the library opted in"""
+        if library_root is None:
+            return False
+        stats = self.load(library_root)
+        if not stats.enabled:
+            return False
+        asset_key = self.get_asset_key(library_root, asset_file)
+        return self._save(library_root, stats.with_import(asset_key, day or date.today()))
+
+    def record_publish(
+        self, library_root: Optional[Path], user: Optional[str] = None, day: Optional[date] = None
+    ) -> bool:
+        """Count a publish by an artist (the current one by default) in an opted-in library"""
+        if library_root is None:
+            return False
+        stats = self.load(library_root)
+        if not stats.enabled:
+            return False
+        return self._save(
+            library_root, stats.with_publish(user or get_current_user(), day or date.today())
+        )
+
+    def record_hook(
+        self, hook: str, library_root: Optional[Path], context: Dict[str, Any]
+    ) -> bool:
+        """Count the import or publish behind a post-action hook point"""
+        if hook == HOOK_POST_IMPORT and context.get("asset_file"):
+            return self.record_import(library_root, Path(context["asset_file"]))
+        if hook == HOOK_POST_PUBLISH:
+            return self.record_publish(library_root, context.get("user"))
+        return False
+
+    # Queries ----------------------------------------------------------------------------
+
+    def get_asset_key(self, library_root: Path, asset_file: Path) -> str:
+        """Get the library-relative key imports of an asset are counted under"""
+        try:
+            return self._database_factory(Path(library_root)).get_asset_key(Path(asset_file))
+        except Exception as e:
+            self.logger.warning(f"Could not open the library database: {e}")
+            return Path(asset_file).as_posix()
+
+    def get_tags(self, library_root: Path) -> Dict[str, List[str]]:
+        """Get the tags of every asset in a library's metadata, by asset key"""
+        try:
+            metadata = self._database_factory(Path(library_root)).get_all_metadata()
+        except Exception as e:
+            self.logger.warning(f"Could not read library tags: {e}")
+            return {}
+        return {key: list(values.get("tags") or []) for key, values in metadata.items()}
+
+    def _save(self, library_root: Path, stats: UsageStats) -> bool:
+        """Write a library's usage statistics"""
+        try:
+            stats_file = self.get_stats_file(library_root)
+            stats_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(stats_file, "w", encoding="utf-8") as f:
+                json.dump(stats.to_dict(), f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save usage statistics: {e}")
+            print(f"[WARNING] Usage statistics not updated: {e}")
+            return False
+
+
+# Singleton instance factory
+_usage_stats_service_instance = None
+
+
+def get_usage_stats_service() -> UsageStatsService:
+    """
+    Get singleton instance of UsageStatsService.
+
+    Returns:
+        UsageStatsService: Singleton service instance
+    """
+    global _usage_stats_service_instance
+    if _usage_stats_service_instance is None:
+        _usage_stats_service_instance = UsageStatsService()
+    return _usage_stats_service_instance
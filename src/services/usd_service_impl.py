@@ -15,6 +15,9 @@ import logging
 
 logger = logging.getLogger(__name__)
 
+# Hidden sibling folder holding geometry payload layers of published USD assets
+USD_PAYLOAD_DIR_NAME = ".usd_payloads"
+
 
 class UsdService:
     """USD Service for Maya integration with Universal Scene Description"""
@@ -495,6 +498,145 @@ class UsdService:
             print(f"[ERROR] USD import failed: {e}")
             return []
 
+    def get_payload_layer_path(self, root_layer: Path) -> Path:
+        """
+        Get the geometry payload layer that belongs to a root asset layer
+
+        Payloads live in a hidden folder next to the root layer so the library
+        only lists the root layer as an asset.
+
+        Args:
+            root_layer: Root (interface) layer of the asset
+
+        Returns:
+            Path of the .usdc payload layer
+        """
+        root_layer = Path(root_layer)
+        return root_layer.parent / USD_PAYLOAD_DIR_NAME / f"{root_layer.stem}_geo.usdc"
+
+    def export_selection_as_asset(
+        self, root_layer: Path, prim_name: Optional[str] = None
+    ) -> Dict[str, Any]:
+        """
+        Export selected Maya geometry as a USD asset with payload structure
+
+        Writes a lightweight root layer whose default prim (kind=component)
+        payloads the geometry layer, so stages can load the asset unloaded.
+
+        Args:
+            root_layer: Destination root layer (.usd/.usda/.usdc)
+            prim_name: Default prim name (defaults to the file stem)
+
+        Returns:
+            Dictionary with success flag, layer paths and error message
+        """
+        result: Dict[str, Any] = {
+            "success": False,
+            "root_layer": None,
+            "payload_layer": None,
+            "error": None,
+        }
+
+        if not (self._mayausd_available and self._pxr_available):
+            result["error"] = "USD export requires mayaUsdPlugin and the pxr Python API"
+            return result
+
+        try:
+            import maya.cmds as cmds  # type: ignore
+            from pxr import Usd, UsdGeom, Sdf, Kind  # type: ignore
+
+            if not cmds.ls(selection=True):
+                result["error"] = "Nothing selected to export"
+                return result
+
+            root_layer = Path(root_layer)
+            prim_name = prim_name or root_layer.stem
+            payload_layer = self.get_payload_layer_path(root_layer)
+            payload_layer.parent.mkdir(parents=True, exist_ok=True)
+
+            # Geometry payload - wrapped in a scope named after the asset
+            cmds.mayaUSDExport(
+                file=str(payload_layer),
+                selection=True,
+                parentScope=prim_name,
+                exportUVs=True,
+                exportColorSets=True,
+                shadingMode="useRegistry",
+                convertMaterialsTo=["UsdPreviewSurface"],
+                defaultUSDFormat="usdc",
+            )
+            geo_layer = Sdf.Layer.FindOrOpen(str(payload_layer))
+            if geo_layer is None:
+                result["error"] = f"Payload layer was not written: {payload_layer}"
+                return result
+            geo_layer.defaultPrim = prim_name
+            geo_layer.Save()
+
+            # Root interface layer referencing the payload by relative path
+            if root_layer.exists():
+                root_layer.unlink()
+            stage = Usd.Stage.CreateNew(str(root_layer))
+            maya_up = cmds.upAxis(query=True, axis=True)
+            up_axis = UsdGeom.Tokens.z if maya_up == "z" else UsdGeom.Tokens.y
+            UsdGeom.SetStageUpAxis(stage, up_axis)
+            UsdGeom.SetStageMetersPerUnit(stage, UsdGeom.LinearUnits.centimeters)
+
+            prim = UsdGeom.Xform.Define(stage, f"/{prim_name}").GetPrim()
+            Usd.ModelAPI(prim).SetKind(Kind.Tokens.component)
+            relative_payload = payload_layer.relative_to(root_layer.parent).as_posix()
+            prim.GetPayloads().AddPayload(f"./{relative_payload}")
+            stage.SetDefaultPrim(prim)
+            stage.GetRootLayer().Save()
+
+            result.update(
+                {"success": True, "root_layer": root_layer, "payload_layer": payload_layer}
+            )
+            print(f"[OK] Exported USD asset: {root_layer.name} (payload: {payload_layer.name})")
+
+        except Exception as e:
+            logger.error(f"Error exporting USD asset: {e}")
+            print(f"[ERROR] USD asset export failed: {e}")
+            result["error"] = str(e)
+
+        return result
+
+    def import_usd_as_stage(self, file_path: Path, name: Optional[str] = None) -> Optional[str]:
+        """
+        Bring a USD file into Maya as a live USD stage (mayaUsdProxyShape)
+
+        Unlike import_usd_file this keeps the data in USD - nothing is converted
+        to Maya geometry.
+
+        Args:
+            file_path: Path to USD file
+            name: Optional stage transform name (defaults to the file stem)
+
+        Returns:
+            Proxy shape node name, None if the stage could not be created
+        """
+        if not self._mayausd_available:
+            print("[INFO] mayaUSD not available, cannot create USD stage")
+            return None
+
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            stage_name = name or f"{Path(file_path).stem}_stage"
+            transform = cmds.createNode("transform", name=stage_name)
+            proxy_shape = cmds.createNode(
+                "mayaUsdProxyShape", name=f"{transform}Shape", parent=transform
+            )
+            cmds.setAttr(f"{proxy_shape}.filePath", str(file_path), type="string")
+            cmds.connectAttr("time1.outTime", f"{proxy_shape}.time")
+
+            print(f"[OK] Created USD stage: {proxy_shape} -> {Path(file_path).name}")
+            return proxy_shape
+
+        except Exception as e:
+            logger.error(f"Error creating USD stage: {e}")
+            print(f"[ERROR] USD stage creation failed: {e}")
+            return None
+
     def get_usd_stage_info(self, file_path: Path) -> Dict[str, Any]:
         """
         Get detailed USD stage information
@@ -0,0 +1,21 @@
+# -*- coding: utf-8 -*-
+"""
+Built-in Publish Validation Checks
+One module per check; discovered the same way as studio check folders
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+
+def get_meshes(cmds: Any, nodes: List[str]) -> List[str]:
+    """Get the non-intermediate mesh shapes directly below the given transforms"""
+    meshes: List[str] = []
+    for node in nodes:
+        shapes = cmds.listRelatives(
+            node, shapes=True, type="mesh", fullPath=True, noIntermediate=True
+        )
+        meshes.extend(shapes or [])
+    return meshes
@@ -0,0 +1,43 @@
+# -*- coding: utf-8 -*-
+"""
+Construction History Check
+Published meshes must not carry modelling history
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from . import get_meshes
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import ValidationIssue
+
+# History nodes that are part of a clean asset rather than leftover modelling steps
+ALLOWED_HISTORY_TYPES = {"groupId", "groupParts", "shadingEngine", "objectSet"}
+
+
+class ConstructionHistoryCheck(IValidationCheck):
+    """Construction History Check - Single Responsibility for leftover history"""
+
+    check_id = "construction_history"
+    name = "Deleted History"
+    description = "Meshes have no construction history (deformers such as skinClusters are OK)"
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        with_history = []
+        for mesh in get_meshes(cmds, nodes):
+            history = cmds.listHistory(mesh, pruneDagObjects=True) or []
+            for node in history:
+                if cmds.nodeType(node) in ALLOWED_HISTORY_TYPES:
+                    continue
+                # Deformers are rig content, not modelling history
+                if "geometryFilter" in (cmds.nodeType(node, inherited=True) or []):
+                    continue
+                with_history.append(mesh)
+                break
+
+        if not with_history:
+            return []
+        message = f"{len(with_history)} mesh(es) have construction history"
+        return [self.issue(message, with_history)]
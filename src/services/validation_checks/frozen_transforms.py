@@ -0,0 +1,41 @@
+# -*- coding: utf-8 -*-
+"""
+Frozen Transforms Check
+Published geometry must have translate/rotate 0 and scale 1
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import ValidationIssue
+
+TOLERANCE = 1e-4
+
+
+class FrozenTransformsCheck(IValidationCheck):
+    """Frozen Transforms Check - Single Responsibility for unfrozen transforms"""
+
+    check_id = "frozen_transforms"
+    name = "Frozen Transforms"
+    description = "Transforms have zero translate/rotate and unit scale (joints are skipped)"
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        unfrozen = []
+        for node in nodes:
+            if cmds.nodeType(node) == "joint":
+                continue
+            translate = cmds.getAttr(f"{node}.translate")[0]
+            rotate = cmds.getAttr(f"{node}.rotate")[0]
+            scale = cmds.getAttr(f"{node}.scale")[0]
+            if (
+                any(abs(value) > TOLERANCE for value in translate + rotate)
+                or any(abs(value - 1.0) > TOLERANCE for value in scale)
+            ):
+                unfrozen.append(node)
+
+        if not unfrozen:
+            return []
+        return [self.issue(f"{len(unfrozen)} transform(s) not frozen", unfrozen)]
@@ -0,0 +1,65 @@
+# -*- coding: utf-8 -*-
+"""
+Naming Convention Check
+No default Maya names and no duplicate short names in the published hierarchy
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Studios with stricter rules can subclass NamingConventionCheck in their own
+checks folder and override ``REQUIRED_PATTERN``.
+"""
+
+import re
+from collections import Counter
+from typing import Any, List, Optional, Pattern
+
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import SEVERITY_WARNING, ValidationIssue
+
+DEFAULT_NAME_PATTERN = re.compile(
+    r"^(pCube|pSphere|pCylinder|pCone|pPlane|pTorus|pPipe|pDisc|polySurface|"
+    r"nurbsSphere|nurbsCube|nurbsCylinder|nurbsPlane|nurbsCircle|curve|"
+    r"group|null|transform|locator)\d*$"
+)
+
+
+class NamingConventionCheck(IValidationCheck):
+    """Naming Convention Check - Single Responsibility for node names"""
+
+    check_id = "naming_convention"
+    name = "Naming Convention"
+    description = "No default names (pCube1, group3...) and no duplicate short names"
+    default_severity = SEVERITY_WARNING
+
+    # Optional studio rule every short name must match, e.g. r"^[a-z]+_[A-Za-z0-9]+_(geo|grp)$"
+    REQUIRED_PATTERN: Optional[Pattern[str]] = None
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        short_names = {node: node.rsplit("|", 1)[-1] for node in nodes}
+        issues = []
+
+        default_named = [
+            n for n, short in short_names.items() if DEFAULT_NAME_PATTERN.match(short)
+        ]
+        if default_named:
+            message = f"{len(default_named)} node(s) keep default names"
+            issues.append(self.issue(message, default_named))
+
+        counts = Counter(short_names.values())
+        duplicates = [n for n, short in short_names.items() if counts[short] > 1]
+        if duplicates:
+            issues.append(self.issue(f"{len(duplicates)} node(s) share a short name", duplicates))
+
+        if self.REQUIRED_PATTERN is not None:
+            mismatched = [
+                n for n, short in short_names.items() if not self.REQUIRED_PATTERN.match(short)
+            ]
+            if mismatched:
+                issues.append(
+                    self.issue(
+                        f"{len(mismatched)} node(s) do not match {self.REQUIRED_PATTERN.pattern}",
+                        mismatched,
+                    )
+                )
+        return issues
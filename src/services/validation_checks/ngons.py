@@ -0,0 +1,36 @@
+# -*- coding: utf-8 -*-
+"""
+N-gons Check
+Meshes contain only triangles and quads
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from . import get_meshes
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import ValidationIssue
+
+
+class NgonsCheck(IValidationCheck):
+    """N-gons Check - Single Responsibility for faces with more than four sides"""
+
+    check_id = "ngons"
+    name = "No N-gons"
+    description = "Meshes have no faces with more than four vertices"
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        ngon_faces = []
+        for mesh in get_meshes(cmds, nodes):
+            # Each entry reads "FACE     12:     40     41     57     56 \n"
+            face_info = cmds.polyInfo(f"{mesh}.f[*]", faceToVertex=True) or []
+            for entry in face_info:
+                label, vertices = entry.split(":", 1)
+                if len(vertices.split()) > 4:
+                    ngon_faces.append(f"{mesh}.f[{label.split()[-1]}]")
+
+        if not ngon_faces:
+            return []
+        return [self.issue(f"{len(ngon_faces)} n-gon face(s) found", ngon_faces)]
@@ -0,0 +1,33 @@
+# -*- coding: utf-8 -*-
+"""
+Unknown Nodes Check
+Scenes must not contain nodes from plugins that are not loaded
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import ValidationIssue
+
+
+class UnknownNodesCheck(IValidationCheck):
+    """Unknown Nodes Check - Single Responsibility for unknown plugin nodes"""
+
+    check_id = "unknown_nodes"
+    name = "No Unknown Nodes"
+    description = "Scene has no unknown nodes or references to missing plugins"
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        issues = []
+        # Unknown nodes break every artist who opens the asset, so check the whole scene
+        unknown = cmds.ls(type=["unknown", "unknownDag", "unknownTransform"], long=True) or []
+        if unknown:
+            issues.append(self.issue(f"{len(unknown)} unknown node(s) in scene", unknown))
+
+        plugins = cmds.unknownPlugin(query=True, list=True) or []
+        if plugins:
+            issues.append(self.issue(f"Scene requires missing plugin(s): {', '.join(plugins)}"))
+        return issues
@@ -0,0 +1,47 @@
+# -*- coding: utf-8 -*-
+"""
+UV Range Check
+Mesh UVs stay inside the 0-1 tile
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Defaults to a warning because UDIM assets legitimately use tiles beyond 0-1.
+"""
+
+from typing import Any, List
+
+from . import get_meshes
+from ...core.interfaces.validation_check import IValidationCheck
+from ...core.models.validation_result import SEVERITY_WARNING, ValidationIssue
+
+TOLERANCE = 1e-4
+
+
+class UvRangeCheck(IValidationCheck):
+    """UV Range Check - Single Responsibility for UVs outside 0-1"""
+
+    check_id = "uv_range"
+    name = "UVs in 0-1"
+    description = "Every mesh has UVs and they lie within the 0-1 range"
+    default_severity = SEVERITY_WARNING
+
+    def run(self, cmds: Any, nodes: List[str]) -> List[ValidationIssue]:
+        outside, missing = [], []
+        for mesh in get_meshes(cmds, nodes):
+            if not cmds.polyEvaluate(mesh, uvcoord=True):
+                missing.append(mesh)
+                continue
+            bounds = cmds.polyEvaluate(mesh, boundingBox2d=True)
+            if not isinstance(bounds, (list, tuple)):
+                continue
+            (u_min, u_max), (v_min, v_max) = bounds
+            if min(u_min, v_min) < -TOLERANCE or max(u_max, v_max) > 1.0 + TOLERANCE:
+                outside.append(mesh)
+
+        issues = []
+        if missing:
+            issues.append(self.issue(f"{len(missing)} mesh(es) have no UVs", missing))
+        if outside:
+            issues.append(self.issue(f"{len(outside)} mesh(es) have UVs outside 0-1", outside))
+        return issues
@@ -0,0 +1,269 @@
+# -*- coding: utf-8 -*-
+"""
+Validation Service Implementation
+Discovers pre-publish checks from plugin folders and runs them on the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Check folders, in load order (a later check with the same id replaces an earlier one)::
+
+    src/services/validation_checks/            <- built-in checks
+    ~/.assetmanager/validation_checks/         <- per-artist checks
+    $ASSET_MANAGER_VALIDATION_PATH             <- studio folders (os.pathsep separated)
+    <library>/.assetmanager/validation_checks/ <- checks shipped with a shared library
+
+Enabled state and severity per check are stored in ~/.assetmanager/validation.json.
+"""
+
+import importlib
+import importlib.util
+import inspect
+import json
+import logging
+import os
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from ..core.interfaces.asset_type_plugin import IAssetTypePlugin
+from ..core.interfaces.validation_check import IValidationCheck
+from ..core.models.validation_result import (
+    SEVERITY_ERROR,
+    SEVERITY_WARNING,
+    ValidationReport,
+)
+
+VALIDATION_PATH_ENV = "ASSET_MANAGER_VALIDATION_PATH"
+CHECKS_DIR_NAME = "validation_checks"
+BUILTIN_CHECKS_DIR = Path(__file__).with_name(CHECKS_DIR_NAME)
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+SEVERITIES = (SEVERITY_ERROR, SEVERITY_WARNING)
+
+# Cameras every scene has - never part of a published asset
+DEFAULT_CAMERAS = {"|persp", "|top", "|front", "|side"}
+
+
+class ValidationServiceImpl:
+    """
+    Validation Service Implementation - Single Responsibility for publish checks
+    A check that raises is reported as failed instead of aborting the whole run
+    """
+
+    def __init__(
+        self, config_file: Optional[Path] = None, user_checks_dir: Optional[Path] = None
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "validation.json"
+        self._user_checks_dir = user_checks_dir or USER_CONFIG_DIR / CHECKS_DIR_NAME
+        self._config: Dict[str, Dict[str, Any]] = self._load_config()
+
+    # Discovery --------------------------------------------------------------------------
+
+    def get_check_directories(self, library_root: Optional[Path] = None) -> List[Path]:
+        """Get check folders in load order (built-in folder is loaded separately)"""
+        directories = [self._user_checks_dir]
+        for entry in os.environ.get(VALIDATION_PATH_ENV, "").split(os.pathsep):
+            if entry.strip():
+                directories.append(Path(entry.strip()))
+        if library_root:
+            directories.append(Path(library_root) / ".assetmanager" / CHECKS_DIR_NAME)
+        return directories
+
+    def discover_checks(self, library_root: Optional[Path] = None) -> List[IValidationCheck]:
+        """
+        Load every check with the configured enabled state and severity applied
+
+        Args:
+            library_root: Current library, whose own checks folder is included
+
+        Returns:
+            Check instances ordered by load order (disabled checks included)
+        """
+        classes: Dict[str, type] = {}
+        for module in self._load_builtin_modules():
+            self._collect_check_classes(module, classes)
+        for directory in self.get_check_directories(library_root):
+            for module in self._load_folder_modules(directory):
+                self._collect_check_classes(module, classes)
+
+        checks = []
+        for check_id, check_class in classes.items():
+            try:
+                check = check_class()
+            except Exception as e:
+                print(f"[WARNING] Could not create validation check {check_id}: {e}")
+                continue
+            config = self._config.get(check_id, {})
+            if config.get("severity") in SEVERITIES:
+                check.severity = config["severity"]
+            checks.append(check)
+        return checks
+
+    def is_enabled(self, check: IValidationCheck) -> bool:
+        """Check if a check runs on publish"""
+        return bool(self._config.get(check.check_id, {}).get("enabled", check.enabled_by_default))
+
+    def set_check_config(
+        self, check_id: str, enabled: Optional[bool] = None, severity: Optional[str] = None
+    ) -> None:
+        """Override enabled state and/or severity of a check (call save_config to persist)"""
+        config = self._config.setdefault(check_id, {})
+        if enabled is not None:
+            config["enabled"] = enabled
+        if severity is not None:
+            if severity not in SEVERITIES:
+                raise ValueError(f"Unknown severity: {severity}")
+            config["severity"] = severity
+
+    def save_config(self) -> bool:
+        """Write check configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save validation config: {e}")
+            return False
+
+    # Running ----------------------------------------------------------------------------
+
+    def gather_nodes(self, cmds: Any, selection: Optional[List[str]] = None) -> List[str]:
+        """Get full paths of every transform being published (selection or whole scene)"""
+        if selection:
+            roots = cmds.ls(selection, long=True, transforms=True) or []
+        else:
+            roots = cmds.ls(assemblies=True, long=True) or []
+            roots = [root for root in roots if root not in DEFAULT_CAMERAS]
+
+        nodes = []
+        for root in roots:
+            nodes.append(root)
+            descendants = cmds.listRelatives(
+                root, allDescendents=True, type="transform", fullPath=True
+            )
+            nodes.extend(reversed(descendants or []))  # parents before children
+        return list(dict.fromkeys(nodes))
+
+    def validate(
+        self,
+        cmds: Any = None,
+        selection: Optional[List[str]] = None,
+        library_root: Optional[Path] = None,
+        asset_type: Optional[IAssetTypePlugin] = None,
+    ) -> ValidationReport:
+        """
+        Run all enabled checks on the content about to be published
+
+        Args:
+            cmds: maya.cmds module (imported when omitted)
+            selection: Published selection; None or empty validates the whole scene
+            library_root: Current library for library-specific checks
+            asset_type: Studio asset type being published, whose own checks run too
+
+        Returns:
+            Report with every issue found
+        """
+        if cmds is None:
+            import maya.cmds as cmds  # type: ignore
+
+        report = ValidationReport()
+        nodes = self.gather_nodes(cmds, selection)
+        for check in self.discover_checks(library_root):
+            if not self.is_enabled(check):
+                continue
+            report.checks_run.append(check.check_id)
+            try:
+                report.issues.extend(check.run(cmds, nodes) or [])
+            except Exception as e:
+                self.logger.error(f"Validation check {check.check_id} failed: {e}")
+                print(f"[WARNING] Validation check '{check.name or check.check_id}' failed: {e}")
+                report.failed_checks.append(check.check_id)
+
+        if asset_type is not None:
+            report.checks_run.append(asset_type.type_id)
+            try:
+                report.issues.extend(asset_type.validate(cmds, nodes) or [])
+            except Exception as e:
+                self.logger.error(f"Asset type {asset_type.type_id} validation failed: {e}")
+                print(f"[WARNING] Checks of asset type '{asset_type.name}' failed: {e}")
+                report.failed_checks.append(asset_type.type_id)
+
+        print(f"[INFO] Validation: {report.summary()}")
+        return report
+
+    # Internals --------------------------------------------------------------------------
+
+    def _load_builtin_modules(self) -> List[Any]:
+        """Import built-in checks as package modules (they use relative imports)"""
+        modules = []
+        package = f"{__package__}.{CHECKS_DIR_NAME}"
+        for path in sorted(BUILTIN_CHECKS_DIR.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            try:
+                modules.append(importlib.import_module(f"{package}.{path.stem}"))
+            except Exception as e:
+                print(f"[WARNING] Could not load built-in check {path.name}: {e}")
+        return modules
+
+    def _load_folder_modules(self, directory: Path) -> List[Any]:
+        """Import every check file of a plugin folder"""
+        modules: List[Any] = []
+        if not directory.is_dir():
+            return modules
+
+        for path in sorted(directory.glob("*.py")):
+            if path.name.startswith("_"):
+                continue
+            module_name = f"asset_manager_validation_{abs(hash(str(path)))}_{path.stem}"
+            try:
+                spec = importlib.util.spec_from_file_location(module_name, path)
+                if spec is None or spec.loader is None:
+                    continue
+                module = importlib.util.module_from_spec(spec)
+                spec.loader.exec_module(module)
+                modules.append(module)
+            except Exception as e:
+                # A broken studio check must never stop artists from publishing
+                print(f"[WARNING] Could not load validation check {path}: {e}")
+        return modules
+
+    def _collect_check_classes(self, module: Any, classes: Dict[str, type]) -> None:
+        """Add concrete check classes defined in a module"""
+        for _, member in inspect.getmembers(module, inspect.isclass):
+            if member.__module__ != module.__name__ or inspect.isabstract(member):
+                continue
+            # Duck typing - plugins may import the interface under another package path
+            check_id = getattr(member, "check_id", "")
+            if check_id and callable(getattr(member, "run", None)):
+                classes[check_id] = member
+
+    def _load_config(self) -> Dict[str, Dict[str, Any]]:
+        """Read check configuration from disk"""
+        if not self._config_file.exists():
+            return {}
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+            return data if isinstance(data, dict) else {}
+        except Exception as e:
+            self.logger.warning(f"Ignoring unreadable validation config: {e}")
+            return {}
+
+
+# Singleton instance factory
+_validation_service_instance = None
+
+
+def get_validation_service() -> ValidationServiceImpl:
+    """
+    Get singleton instance of ValidationServiceImpl.
+
+    Returns:
+        ValidationServiceImpl: Singleton service instance
+    """
+    global _validation_service_instance
+    if _validation_service_instance is None:
+        _validation_service_instance = ValidationServiceImpl()
+    return _validation_service_instance
@@ -0,0 +1,175 @@
+# -*- coding: utf-8 -*-
+"""
+Vendor Library Service Implementation
+Read-only libraries of purchased asset packs and the license terms of their assets
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A library holding a vendor file is a vendor library: its assets can be imported but
+nothing can be published, edited, reviewed, or deleted in it, whatever the artist's
+role. Admins can still change its settings and license terms::
+
+    KitBash_City/.assetmanager/vendor.json
+    {
+      "vendor": "KitBash3D",
+      "license_type": "Royalty Free",
+      "source_url": "https://kitbash3d.com/pages/license",
+      "attribution": "",
+      "redistributable": false,
+      "assets": {"assets/signs/": {"license_type": "Editorial Only"}}   <- optional
+    }
+
+Assets that are not redistributable must not leave the studio; exporting them into a
+delivery bundle asks first, and the bundle manifest lists the terms of every vendor
+file it carries.
+"""
+
+import json
+import logging
+from pathlib import Path
+from typing import Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.library_permissions import (
+    ACTION_APPROVE,
+    ACTION_DELETE,
+    ACTION_EDIT,
+    ACTION_PUBLISH,
+)
+from ..core.models.vendor_license import VendorLibrary, VendorLicense
+
+VENDOR_DIR_NAME = ".assetmanager"
+VENDOR_FILE_NAME = "vendor.json"
+
+# What a vendor library refuses, for every role
+READ_ONLY_ACTIONS = (ACTION_PUBLISH, ACTION_EDIT, ACTION_APPROVE, ACTION_DELETE)
+
+# Stands in for a broken vendor file: still read-only, terms unknown
+_UNKNOWN_TERMS = VendorLibrary()
+
+
+class VendorLibraryService:
+    """
+    Vendor Library Service - Single Responsibility for vendor license terms
+    Vendor files are re-read when they change on disk
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        # Vendor file -> (modification time, parsed terms)
+        self._cache: Dict[str, Tuple[int, VendorLibrary]] = {}
+
+    # Vendor libraries -------------------------------------------------------------------
+
+    def get_vendor_file(self, library_root: Path) -> Path:
+        """Get the vendor file of a library"""
+        return Path(library_root) / VENDOR_DIR_NAME / VENDOR_FILE_NAME
+
+    def load_vendor_library(self, library_root: Optional[Path]) -> Optional[VendorLibrary]:
+        """Get a library's license terms, None when it is not a vendor library"""
+        if library_root is None:
+            return None
+        vendor_file = self.get_vendor_file(library_root)
+        try:
+            modified = vendor_file.stat().st_mtime_ns
+        except OSError:
+            return None  # No vendor file
+
+        cached = self._cache.get(str(vendor_file))
+        if cached is not None and cached[0] == modified:
+            return cached[1]
+
+        try:
+            with open(vendor_file, "r", encoding="utf-8") as f:
+                vendor_library = VendorLibrary.from_dict(json.load(f))
+        except Exception as e:
+            print(f"[WARNING] Unreadable vendor file {vendor_file}, license unknown: {e}")
+            vendor_library = _UNKNOWN_TERMS
+        self._cache[str(vendor_file)] = (modified, vendor_library)
+        return vendor_library
+
+    def is_read_only(self, library_root: Optional[Path]) -> bool:
+        """Check if a library is a vendor library"""
+        return self.load_vendor_library(library_root) is not None
+
+    def save_vendor_library(self, library_root: Path, vendor_library: VendorLibrary) -> bool:
+        """Make a library a vendor library, or change its license terms"""
+        vendor_file = self.get_vendor_file(library_root)
+        try:
+            vendor_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(vendor_file, "w", encoding="utf-8") as f:
+                json.dump(vendor_library.to_dict(), f, indent=2)
+            self._cache.pop(str(vendor_file), None)
+            print(f"[OK] Saved vendor license {vendor_file}")
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save vendor license: {e}")
+            return False
+
+    def remove_vendor_library(self, library_root: Path) -> bool:
+        """Make a vendor library an ordinary, writable library again"""
+        vendor_file = self.get_vendor_file(library_root)
+        try:
+            vendor_file.unlink()
+        except FileNotFoundError:
+            return False
+        except OSError as e:
+            self.logger.error(f"Failed to remove vendor license: {e}")
+            return False
+        self._cache.pop(str(vendor_file), None)
+        print(f"[OK] {Path(library_root).name} is no longer a vendor library")
+        return True
+
+    # License terms ----------------------------------------------------------------------
+
+    def find_vendor_root(self, path: Path) -> Optional[Path]:
+        """Get the vendor library a file lies in, None when it is in no vendor library"""
+        for folder in Path(path).parents:
+            if self.get_vendor_file(folder).is_file():
+                return folder
+        return None
+
+    def get_license(self, path: Path) -> Optional[VendorLicense]:
+        """Get the license terms of a file, None when it is not from a vendor library"""
+        vendor_root = self.find_vendor_root(path)
+        if vendor_root is None:
+            return None
+        vendor_library = self.load_vendor_library(vendor_root)
+        relative = Path(path).relative_to(vendor_root).as_posix()
+        return vendor_library.get_license(relative) if vendor_library else None
+
+    def find_licenses(self, files: Iterable[Path]) -> List[VendorLicense]:
+        """Get the distinct license terms of the vendor files among some files"""
+        licenses: List[VendorLicense] = []
+        vendor_roots: Dict[Path, Optional[Path]] = {}  # Folder -> vendor root, per call
+        for path in files:
+            path = Path(path)
+            if path.parent not in vendor_roots:
+                vendor_roots[path.parent] = self.find_vendor_root(path)
+            vendor_root = vendor_roots[path.parent]
+            if vendor_root is None:
+                continue
+            vendor_library = self.load_vendor_library(vendor_root)
+            if vendor_library is None:
+                continue
+            terms = vendor_library.get_license(path.relative_to(vendor_root).as_posix())
+            if terms not in licenses:
+                licenses.append(terms)
+        return licenses
+
+
+# Singleton instance factory
+_vendor_library_service_instance = None
+
+
+def get_vendor_library_service() -> VendorLibraryService:
+    """
+    Get singleton instance of VendorLibraryService.
+
+    Returns:
+        VendorLibraryService: Singleton service instance
+    """
+    global _vendor_library_service_instance
+    if _vendor_library_service_instance is None:
+        _vendor_library_service_instance = VendorLibraryService()
+    return _vendor_library_service_instance
@@ -0,0 +1,294 @@
+# -*- coding: utf-8 -*-
+"""
+Version Service Implementation
+File-based asset version history stored next to each library asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Layout for an asset at ``assets/scenes/hero.ma``::
+
+    assets/scenes/hero.ma                          <- current (latest) content
+    assets/scenes/.versions/hero/versions.json     <- version manifest
+    assets/scenes/.versions/hero/v001/hero.ma      <- immutable snapshots
+    assets/scenes/.versions/hero/v002/hero.ma
+
+Versions a retention policy pruned keep their manifest entry with "pruned" in extra.
+Files sharing a stem (``hero.ma`` and its ``hero.fbx`` handoff) share the history folder,
+so each one numbers, sees, restores, and prunes the snapshots of its own file type; their
+v001 snapshots sit side by side in ``.versions/hero/v001``.
+"""
+
+import json
+import logging
+import shutil
+import threading
+from dataclasses import replace
+from datetime import datetime
+from pathlib import Path
+from typing import List, Optional
+
+from ..core.interfaces.version_service import IVersionService
+from ..core.models.asset_version import AssetVersion, format_version_label
+from .identity_service_impl import get_identity_service
+
+VERSIONS_DIR_NAME = ".versions"
+MANIFEST_FILE_NAME = "versions.json"
+
+
+def get_current_user() -> str:
+    """Get the current artist name used for authoring metadata (the signed-in identity)"""
+    return get_identity_service().get_user_name()
+
+
+class VersionServiceImpl(IVersionService):
+    """
+    Version Service Implementation - Single Responsibility for version history
+    Snapshots are plain file copies so they survive outside the Asset Manager
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        self._lock = threading.Lock()
+
+    def get_history_directory(self, asset_path: Path) -> Path:
+        """
+        Get the directory holding all versions of an asset
+
+        Args:
+            asset_path: Library asset file
+
+        Returns:
+            History directory path (may not exist yet)
+        """
+        asset_path = Path(asset_path)
+        return asset_path.parent / VERSIONS_DIR_NAME / asset_path.stem
+
+    def publish_version(
+        self,
+        asset_path: Path,
+        notes: str = "",
+        author: Optional[str] = None,
+        companion_files: Optional[List[Path]] = None,
+    ) -> Optional[AssetVersion]:
+        """Snapshot the current asset file as a new immutable version"""
+        asset_path = Path(asset_path)
+        if not asset_path.is_file():
+            self.logger.warning(f"Cannot version missing asset: {asset_path}")
+            return None
+
+        with self._lock:
+            try:
+                history_dir = self.get_history_directory(asset_path)
+                versions = self._read_manifest(history_dir)
+                own_versions = self._get_own_versions(asset_path, versions)
+                number = own_versions[-1].number + 1 if own_versions else 1
+
+                version_dir = history_dir / format_version_label(number)
+                version_file = version_dir / asset_path.name
+                if version_file.exists():
+                    raise FileExistsError(f"{version_file} is already a snapshot")
+                version_dir.mkdir(parents=True, exist_ok=True)
+                shutil.copy2(asset_path, version_file)
+                companions = self._copy_companions(
+                    companion_files or [], asset_path.parent, version_dir
+                )
+
+                version = AssetVersion(
+                    asset_name=asset_path.stem,
+                    number=number,
+                    file_path=version_file,
+                    author=author or get_current_user(),
+                    created_date=datetime.now(),
+                    notes=notes,
+                    extra={"companions": companions} if companions else {},
+                )
+                versions.append(version)
+                self._write_manifest(history_dir, versions)
+
+                print(f"[VERSION] Published {asset_path.stem} {version.label}")
+                return version
+
+            except Exception as e:
+                self.logger.error(f"Failed to publish version for {asset_path}: {e}")
+                print(f"[ERROR] Failed to publish version: {e}")
+                return None
+
+    def get_versions(self, asset_path: Path) -> List[AssetVersion]:
+        """Get version history for an asset, oldest first"""
+        return self._get_own_versions(
+            asset_path, self._read_manifest(self.get_history_directory(asset_path))
+        )
+
+    def get_versioned_files(self, history_dir: Path) -> List[Path]:
+        """Get the asset files a history folder holds snapshots of (same-stem siblings)"""
+        history_dir = Path(history_dir)
+        names = [version.file_path.name for version in self._read_manifest(history_dir)]
+        return [history_dir.parent.parent / name for name in dict.fromkeys(names)]
+
+    def get_version(self, asset_path: Path, number: int) -> Optional[AssetVersion]:
+        """Get a specific version of an asset"""
+        for version in self.get_versions(asset_path):
+            if version.number == number:
+                return version
+        return None
+
+    def get_latest_version(self, asset_path: Path) -> Optional[AssetVersion]:
+        """Get the most recent version of an asset"""
+        versions = self.get_versions(asset_path)
+        return versions[-1] if versions else None
+
+    def rollback_to_version(
+        self, asset_path: Path, number: int, author: Optional[str] = None
+    ) -> Optional[AssetVersion]:
+        """Restore an older version as the current asset"""
+        asset_path = Path(asset_path)
+        version = self.get_version(asset_path, number)
+        if version is None or not version.exists:
+            self.logger.warning(f"Version {number} not available for {asset_path.name}")
+            return None
+
+        companions = version.extra.get("companions", [])
+        try:
+            shutil.copy2(version.file_path, asset_path)
+            for relative in companions:
+                target = asset_path.parent / relative
+                target.parent.mkdir(parents=True, exist_ok=True)
+                shutil.copy2(version.file_path.parent / relative, target)
+        except Exception as e:
+            self.logger.error(f"Failed to restore {version.label} of {asset_path.name}: {e}")
+            print(f"[ERROR] Rollback failed: {e}")
+            return None
+
+        return self.publish_version(
+            asset_path,
+            notes=f"Rolled back to {version.label}",
+            author=author,
+            companion_files=[asset_path.parent / relative for relative in companions],
+        )
+
+    def prune_version(self, asset_path: Path, number: int) -> int:
+        """
+        Delete the files of an old version, keeping its manifest entry marked as pruned
+
+        Returns:
+            Bytes freed
+
+        Raises:
+            ValueError: If the version does not exist or is the latest one
+        """
+        asset_path = Path(asset_path)
+        with self._lock:
+            history_dir = self.get_history_directory(asset_path)
+            versions = self._read_manifest(history_dir)
+            own_versions = self._get_own_versions(asset_path, versions)
+            version = next((v for v in own_versions if v.number == number), None)
+            if version is None:
+                raise ValueError(f"{asset_path.name} has no version {number}")
+            index = next(i for i, v in enumerate(versions) if v is version)
+            if number == own_versions[-1].number:
+                raise ValueError(f"The latest version of {asset_path.name} cannot be pruned")
+
+            freed = self._delete_snapshot(version, versions)
+            extra = dict(versions[index].extra)
+            extra["pruned"] = datetime.now().isoformat(timespec="seconds")
+            versions[index] = replace(versions[index], extra=extra)
+            self._write_manifest(history_dir, versions)
+            print(f"[VERSION] Pruned {asset_path.stem} {format_version_label(number)}")
+            return freed
+
+    def _get_own_versions(
+        self, asset_path: Path, versions: List[AssetVersion]
+    ) -> List[AssetVersion]:
+        """Get the versions snapshotting an asset's file type, not a same-stem sibling's"""
+        suffix = Path(asset_path).suffix.lower()
+        return [version for version in versions if version.file_path.suffix.lower() == suffix]
+
+    def _delete_snapshot(self, version: AssetVersion, versions: List[AssetVersion]) -> int:
+        """Delete a version's files, keeping those a same-stem sibling's snapshot still uses"""
+        version_dir = version.file_path.parent
+        kept = set()
+        for other in versions:
+            if other is not version and other.file_path.parent == version_dir:
+                if not other.is_pruned:
+                    kept.add(other.file_path.name)
+                    kept.update(other.extra.get("companions", []))
+
+        freed = 0
+        relatives = [version.file_path.name, *version.extra.get("companions", [])]
+        for relative in relatives:
+            path = version_dir / relative
+            if relative not in kept and path.is_file():
+                freed += path.stat().st_size
+                path.unlink()
+        if version_dir.is_dir() and not any(p.is_file() for p in version_dir.rglob("*")):
+            shutil.rmtree(version_dir)
+        return freed
+
+    def _copy_companions(
+        self, companion_files: List[Path], asset_dir: Path, version_dir: Path
+    ) -> List[str]:
+        """Copy companion files into a version folder, keeping relative layout"""
+        copied = []
+        for companion in companion_files:
+            companion = Path(companion)
+            if not companion.is_file():
+                self.logger.warning(f"Skipping missing companion file: {companion}")
+                continue
+            relative = companion.relative_to(asset_dir)
+            target = version_dir / relative
+            target.parent.mkdir(parents=True, exist_ok=True)
+            shutil.copy2(companion, target)
+            copied.append(relative.as_posix())
+        return copied
+
+    def _read_manifest(self, history_dir: Path) -> List[AssetVersion]:
+        """Read version manifest - returns empty history if none recorded"""
+        manifest_file = history_dir / MANIFEST_FILE_NAME
+        if not manifest_file.exists():
+            return []
+
+        try:
+            with open(manifest_file, "r", encoding="utf-8") as f:
+                data = json.load(f)
+
+            versions = []
+            for entry in data.get("versions", []):
+                version = AssetVersion.from_dict(entry)
+                # File paths are stored relative to the history directory
+                versions.append(replace(version, file_path=history_dir / version.file_path))
+            return sorted(versions, key=lambda v: v.number)
+
+        except Exception as e:
+            self.logger.error(f"Failed to read version manifest {manifest_file}: {e}")
+            return []
+
+    def _write_manifest(self, history_dir: Path, versions: List[AssetVersion]) -> None:
+        """Write version manifest with history-relative file paths"""
+        entries = []
+        for version in versions:
+            entry = version.to_dict()
+            entry["file_path"] = version.file_path.relative_to(history_dir).as_posix()
+            entries.append(entry)
+
+        history_dir.mkdir(parents=True, exist_ok=True)
+        manifest_file = history_dir / MANIFEST_FILE_NAME
+        with open(manifest_file, "w", encoding="utf-8") as f:
+            json.dump({"asset_name": history_dir.name, "versions": entries}, f, indent=2)
+
+
+# Singleton instance factory
+_version_service_instance = None
+
+
+def get_version_service() -> VersionServiceImpl:
+    """
+    Get singleton instance of VersionServiceImpl.
+
+    Returns:
+        VersionServiceImpl: Singleton service instance
+    """
+    global _version_service_instance
+    if _version_service_instance is None:
+        _version_service_instance = VersionServiceImpl()
+    return _version_service_instance
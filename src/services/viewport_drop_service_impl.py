@@ -0,0 +1,318 @@
+# -*- coding: utf-8 -*-
+"""
+Viewport Drop Service Implementation
+Place assets dragged from the library at the point they are dropped in a Maya viewport
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+The library list adds ASSET_DROP_MIME_TYPE to its drag data. A Maya external drop
+callback claims drops carrying that format over a model panel, casts a ray from the
+camera through the cursor onto the nearest visible mesh (or the ground plane), and
+hands the file to the window, which imports or references it. The new nodes are
+grouped under one root, tagged with their source file, and moved so the bottom
+centre of their bounding box sits on the hit point::
+
+    drag          -> import
+    Ctrl + drag   -> reference
+    Shift + drag  -> instance of a copy already in the scene (imported on first drop)
+
+Import as Instance places the same way without a drop point, and loads the first
+copy as a reference so every placement shares one file's geometry.
+"""
+
+import logging
+from pathlib import Path
+from typing import Any, Callable, List, Optional, Tuple
+
+# Drag data format carrying the dropped asset's file path
+ASSET_DROP_MIME_TYPE = "application/x-assetmanager-asset"
+
+DROP_MODE_IMPORT = "import"
+DROP_MODE_REFERENCE = "reference"
+DROP_MODE_INSTANCE = "instance"
+
+# Attribute tagging dropped roots with their asset file, so later drops can instance them
+SOURCE_ATTRIBUTE = "assetManagerSource"
+
+# Meshes further than this along the ray are ignored (scene units)
+MAX_RAY_DISTANCE = 100000.0
+
+Vector = Tuple[float, float, float]
+
+# Loads an asset in a drop mode ("import" / "reference"), returns success
+DropLoader = Callable[[str], bool]
+
+# Called with file path, drop mode, and world position when an asset is dropped
+DropHandler = Callable[[Path, str, Vector], None]
+
+
+def drop_mode_for_modifiers(shift: bool, control: bool) -> str:
+    """Get the drop mode chosen by the keyboard modifiers held while dropping"""
+    if control:
+        return DROP_MODE_REFERENCE
+    if shift:
+        return DROP_MODE_INSTANCE
+    return DROP_MODE_IMPORT
+
+
+def intersect_ground_plane(
+    origin: Vector, direction: Vector, height: float = 0.0
+) -> Optional[Vector]:
+    """Intersect a ray with the horizontal plane y = height, None if the ray misses it"""
+    if abs(direction[1]) < 1e-9:
+        return None
+    distance = (height - origin[1]) / direction[1]
+    if distance < 0:
+        return None
+    return (
+        origin[0] + direction[0] * distance,
+        height,
+        origin[2] + direction[2] * distance,
+    )
+
+
+class ViewportDropService:
+    """
+    Viewport Drop Service - Single Responsibility for placing dropped assets in the scene
+    Scene edits go through the cmds argument so placement can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+        self._callback: Any = None
+
+    # Placement --------------------------------------------------------------------------
+
+    def find_scene_copy(self, cmds: Any, file_path: Path) -> Optional[str]:
+        """Get a root previously dropped from this asset file, None if there is none"""
+        key = Path(file_path).as_posix()
+        for node in cmds.ls(assemblies=True, long=True) or []:
+            if not cmds.attributeQuery(SOURCE_ATTRIBUTE, node=node, exists=True):
+                continue
+            if cmds.getAttr(f"{node}.{SOURCE_ATTRIBUTE}") == key:
+                return node
+        return None
+
+    def place_asset(
+        self,
+        cmds: Any,
+        file_path: Path,
+        mode: str,
+        position: Optional[Vector],
+        loader: DropLoader,
+        source_mode: str = DROP_MODE_IMPORT,
+    ) -> Optional[str]:
+        """
+        Load an asset and move it to a drop position as one undoable step
+
+        Args:
+            cmds: maya.cmds module
+            file_path: Dropped asset file
+            mode: DROP_MODE_IMPORT, DROP_MODE_REFERENCE, or DROP_MODE_INSTANCE
+            position: World space drop point, None to leave the asset where it loads
+            loader: Imports or references the file for the given mode
+            source_mode: How an instance placement loads the first copy of an asset
+
+        Returns:
+            Root transform of the placed asset, None if nothing was loaded
+        """
+        file_path = Path(file_path)
+        cmds.undoInfo(openChunk=True, chunkName=f"Place {file_path.stem}")
+        try:
+            source = self.find_scene_copy(cmds, file_path) if mode == DROP_MODE_INSTANCE else None
+            if source is not None:
+                roots = cmds.instance(source) or []
+            else:
+                # First instance placement of an asset loads the copy later ones instance
+                load_mode = source_mode if mode == DROP_MODE_INSTANCE else mode
+                before = set(cmds.ls(assemblies=True, long=True) or [])
+                if not loader(load_mode):
+                    return None
+                roots = [
+                    node
+                    for node in cmds.ls(assemblies=True, long=True) or []
+                    if node not in before
+                ]
+
+            root = self._make_single_root(cmds, roots, file_path.stem)
+            if root is None:
+                print(f"[WARNING] {file_path.name} added no top-level nodes to place")
+                return None
+
+            if not cmds.attributeQuery(SOURCE_ATTRIBUTE, node=root, exists=True):
+                cmds.addAttr(root, longName=SOURCE_ATTRIBUTE, dataType="string")
+            cmds.setAttr(f"{root}.{SOURCE_ATTRIBUTE}", file_path.as_posix(), type="string")
+
+            cmds.select(root, replace=True)
+            if position is None:
+                print(f"[OK] Placed {file_path.name} ({mode})")
+                return root
+            self.move_to_position(cmds, root, position)
+            placed_at = tuple(round(value, 3) for value in position)
+            print(f"[OK] Dropped {file_path.name} ({mode}) at {placed_at}")
+            return root
+        finally:
+            cmds.undoInfo(closeChunk=True)
+
+    def move_to_position(self, cmds: Any, root: str, position: Vector) -> None:
+        """Move a root so the bottom centre of its bounding box sits on a point"""
+        x_min, y_min, z_min, x_max, _, z_max = cmds.exactWorldBoundingBox(root)
+        cmds.move(
+            position[0] - (x_min + x_max) / 2.0,
+            position[1] - y_min,
+            position[2] - (z_min + z_max) / 2.0,
+            root,
+            relative=True,
+            worldSpace=True,
+        )
+
+    def _make_single_root(self, cmds: Any, roots: List[str], name: str) -> Optional[str]:
+        """Group several new top-level nodes so every drop moves and instances as one"""
+        if not roots:
+            return None
+        if len(roots) == 1:
+            return roots[0]
+        return cmds.group(roots, name=f"{name}_grp")
+
+    # Raycast ----------------------------------------------------------------------------
+
+    def get_drop_position(self, cmds: Any, panel: str) -> Vector:
+        """
+        Get the world point under the cursor in a model panel
+
+        Casts a ray through the cursor onto the nearest visible mesh, then the ground
+        plane; the world origin is used when the camera looks away from both.
+        """
+        origin, direction = self._get_cursor_ray(panel)
+        hit = self._raycast_meshes(cmds, origin, direction)
+        if hit is None:
+            hit = intersect_ground_plane(origin, direction)
+        return hit or (0.0, 0.0, 0.0)
+
+    def _get_cursor_ray(self, panel: str) -> Tuple[Vector, Vector]:
+        """Get the world space ray from a panel's camera through the mouse cursor"""
+        import maya.api.OpenMayaUI as omui  # type: ignore
+        from PySide6.QtGui import QCursor
+        from PySide6.QtWidgets import QWidget
+        from shiboken6 import wrapInstance
+
+        view = omui.M3dView.getM3dViewFromModelPanel(panel)
+        widget = wrapInstance(int(view.widget()), QWidget)
+        local = widget.mapFromGlobal(QCursor.pos())
+
+        # M3dView uses device pixels with the origin at the bottom left
+        ratio = widget.devicePixelRatioF()
+        x = int(local.x() * ratio)
+        y = view.portHeight() - int(local.y() * ratio)
+        point, vector = view.viewToWorld(x, y)
+        return (point.x, point.y, point.z), (vector.x, vector.y, vector.z)
+
+    def _raycast_meshes(self, cmds: Any, origin: Vector, direction: Vector) -> Optional[Vector]:
+        """Get the closest point where the ray hits a visible mesh"""
+        import maya.api.OpenMaya as om  # type: ignore
+
+        ray_source = om.MFloatPoint(*origin)
+        ray_direction = om.MFloatVector(*direction)
+        closest: Optional[Vector] = None
+        closest_param = MAX_RAY_DISTANCE
+
+        for mesh in cmds.ls(type="mesh", visible=True, noIntermediate=True, long=True) or []:
+            try:
+                selection = om.MSelectionList()
+                selection.add(mesh)
+                fn_mesh = om.MFnMesh(selection.getDagPath(0))
+                hit = fn_mesh.closestIntersection(
+                    ray_source, ray_direction, om.MSpace.kWorld, closest_param, False
+                )
+            except Exception as e:
+                self.logger.debug(f"Raycast skipped {mesh}: {e}")
+                continue
+            if hit and hit[2] >= 0 and hit[1] < closest_param:
+                closest_param = hit[1]
+                closest = (hit[0].x, hit[0].y, hit[0].z)
+        return closest
+
+    # Maya drop callback -----------------------------------------------------------------
+
+    def install(self, handler: DropHandler) -> bool:
+        """
+        Start claiming library drops in Maya viewports
+
+        Args:
+            handler: Loads and places the asset; called deferred, after the drop returns
+
+        Returns:
+            True if the callback is registered
+        """
+        try:
+            import maya.OpenMayaUI as omui1  # type: ignore
+            import maya.cmds as cmds  # type: ignore
+            import maya.utils  # type: ignore
+        except ImportError:
+            return False
+
+        self.uninstall()
+        service = self
+
+        class _AssetDropCallback(omui1.MExternalDropCallback):
+            def externalDropCallback(self, do_drop, control_name, data):  # noqa: N802
+                passthrough = omui1.MExternalDropCallback.kMayaDefault
+                if not data.hasFormat(ASSET_DROP_MIME_TYPE):
+                    return passthrough
+
+                panel = cmds.getPanel(underPointer=True)
+                if not panel or cmds.getPanel(typeOf=panel) != "modelPanel":
+                    return passthrough
+                if not do_drop:
+                    return omui1.MExternalDropCallback.kNoMayaDefaultAndAccept
+
+                modifiers = data.keyboardModifiers()
+                mode = drop_mode_for_modifiers(
+                    bool(modifiers & omui1.MExternalDropData.kShiftModifier),
+                    bool(modifiers & omui1.MExternalDropData.kControlModifier),
+                )
+                file_path = Path(data.data(ASSET_DROP_MIME_TYPE).strip())
+                try:
+                    # Read the cursor now; it moves on before deferred code runs
+                    position = service.get_drop_position(cmds, panel)
+                except Exception as e:
+                    print(f"[WARNING] Could not find drop point, using origin: {e}")
+                    position = (0.0, 0.0, 0.0)
+
+                maya.utils.executeDeferred(lambda: handler(file_path, mode, position))
+                return omui1.MExternalDropCallback.kNoMayaDefaultAndAccept
+
+        self._callback = _AssetDropCallback()
+        omui1.MExternalDropCallback.addCallback(self._callback)
+        print("[OK] Viewport drop callback installed")
+        return True
+
+    def uninstall(self) -> None:
+        """Stop claiming library drops in Maya viewports"""
+        if self._callback is None:
+            return
+        try:
+            import maya.OpenMayaUI as omui1  # type: ignore
+
+            omui1.MExternalDropCallback.removeCallback(self._callback)
+        except Exception as e:
+            self.logger.warning(f"Failed to remove viewport drop callback: {e}")
+        self._callback = None
+
+
+# Singleton instance factory
+_viewport_drop_service_instance = None
+
+
+def get_viewport_drop_service() -> ViewportDropService:
+    """
+    Get singleton instance of ViewportDropService.
+
+    Returns:
+        ViewportDropService: Singleton service instance
+    """
+    global _viewport_drop_service_instance
+    if _viewport_drop_service_instance is None:
+        _viewport_drop_service_instance = ViewportDropService()
+    return _viewport_drop_service_instance
@@ -0,0 +1,403 @@
+# -*- coding: utf-8 -*-
+"""
+Volume Service Implementation
+Publish OpenVDB sequences as volume assets and load them as Arnold volumes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+A volume asset is a JSON descriptor like a light rig. The .vdb files of the sequence are
+copied into a folder of their own, and the descriptor keeps the frame range and the grid
+names read from the file headers::
+
+    assets/fx/smoke_plume.volume
+    assets/fx/.volumes/smoke_plume/smoke_plume.1001.vdb ... smoke_plume.1100.vdb
+    assets/fx/.thumbnails/smoke_plume_screenshot.png     <- density slice of frame 1050
+
+The thumbnail is a slice through the middle of the mid frame's density grid, drawn when
+the OpenVDB Python module (pyopenvdb) is installed. Volumes load as an aiVolume reading
+the sequence, or without Arnold as an empty fluid container that marks where the cache
+goes and which file it stands for.
+"""
+
+import importlib
+import json
+import logging
+import math
+import re
+import struct
+import zlib
+from datetime import datetime
+from pathlib import Path
+from typing import Any, BinaryIO, Dict, List, Optional, Tuple
+
+from .dependency_service_impl import get_dependency_service
+from .version_service_impl import get_current_user
+
+VOLUME_EXTENSION = ".volume"
+VOLUME_FORMAT_VERSION = 1
+VOLUMES_DIR_NAME = ".volumes"
+VDB_EXTENSION = ".vdb"
+
+IMPORT_MODE_AUTO = "auto"  # Arnold when MtoA loads, a fluid container otherwise
+IMPORT_MODE_ARNOLD = "arnold"
+IMPORT_MODE_FLUID = "fluid"
+
+# Attribute on the transform of an imported volume, holding its descriptor path
+VOLUME_ATTRIBUTE = "assetManagerVolume"
+
+DENSITY_GRID = "density"
+SLICE_SIZE = 256
+
+# OpenVDB file header
+VDB_MAGIC = 0x56444220
+MIN_VDB_FILE_VERSION = 220  # Text UUIDs in the header (OpenVDB 2.0 and later)
+VDB_COMPRESSION_PER_GRID_VERSION = 222
+VDB_GRID_NAME_SEPARATOR = "\x1e"  # Before the counter of repeated grid names
+
+# smoke.1001.vdb / smoke_1001.vdb -> ("smoke.", "1001")
+_FRAME_PATTERN = re.compile(r"^(?P<prefix>.*[._])(?P<frame>\d+)\.vdb$", re.IGNORECASE)
+
+
+def find_sequence(vdb_file: Path) -> Tuple[str, List[Tuple[int, Path]]]:
+    """
+    Get the frames of the .vdb sequence a file belongs to
+
+    Returns:
+        (#-padded file name like smoke.####.vdb, sorted (frame, file) pairs); a file
+        without a frame number is one still frame 0 under its own name
+    """
+    vdb_file = Path(vdb_file)
+    match = _FRAME_PATTERN.match(vdb_file.name)
+    if match is None:
+        return vdb_file.name, [(0, vdb_file)]
+
+    prefix, digits = match.group("prefix"), match.group("frame")
+    frames = []
+    for sibling in vdb_file.parent.iterdir():
+        sibling_match = _FRAME_PATTERN.match(sibling.name)
+        if (
+            sibling_match is not None
+            and sibling_match.group("prefix") == prefix
+            and len(sibling_match.group("frame")) == len(digits)
+            and sibling.is_file()
+        ):
+            frames.append((int(sibling_match.group("frame")), sibling))
+    return f"{prefix}{'#' * len(digits)}{VDB_EXTENSION}", sorted(frames)
+
+
+def read_grid_names(vdb_file: Path) -> List[str]:
+    """Get the grid names stored in a .vdb file's header, [] if it cannot be read"""
+    try:
+        with open(vdb_file, "rb") as f:
+            return _read_grid_names(f)
+    except (OSError, ValueError, struct.error) as e:
+        print(f"[WARNING] Could not read the grids of {Path(vdb_file).name}: {e}")
+        return []
+
+
+def render_slice(grid: Any, size: int = SLICE_SIZE) -> Tuple[int, int, bytes]:
+    """
+    Sample the middle Z slice of a grid's active voxels as a greyscale image
+
+    Args:
+        grid: OpenVDB Python grid (evalActiveVoxelBoundingBox, getConstAccessor)
+        size: Pixels along the longer side of the slice
+
+    Returns:
+        (width, height, row-major bytes from the top row), values scaled to the maximum
+    """
+    (x0, y0, z0), (x1, y1, z1) = grid.evalActiveVoxelBoundingBox()
+    span_x, span_y = max(x1 - x0, 0) + 1, max(y1 - y0, 0) + 1
+    scale = size / max(span_x, span_y)
+    width, height = max(1, round(span_x * scale)), max(1, round(span_y * scale))
+    z = (z0 + z1) // 2
+
+    accessor = grid.getConstAccessor()
+    values = []
+    for row in range(height):
+        y = y1 - int(row / scale)  # +Y up
+        for column in range(width):
+            value = accessor.getValue((x0 + int(column / scale), y, z))
+            if isinstance(value, (tuple, list)):  # Vector grids show their magnitude
+                value = math.sqrt(sum(component * component for component in value))
+            values.append(abs(float(value)))
+
+    peak = max(values) if values else 0.0
+    if peak <= 0.0:
+        return width, height, bytes(width * height)
+    return width, height, bytes(min(255, int(value / peak * 255)) for value in values)
+
+
+def write_png(path: Path, width: int, height: int, pixels: bytes) -> None:
+    """Write 8-bit greyscale pixels (row-major from the top row) as a PNG file"""
+
+    def chunk(kind: bytes, data: bytes) -> bytes:
+        body = kind + data
+        return struct.pack(">I", len(data)) + body + struct.pack(">I", zlib.crc32(body))
+
+    rows = b"".join(b"\x00" + pixels[row * width : (row + 1) * width] for row in range(height))
+    path = Path(path)
+    path.parent.mkdir(parents=True, exist_ok=True)
+    with open(path, "wb") as f:
+        f.write(b"\x89PNG\r\n\x1a\n")
+        f.write(chunk(b"IHDR", struct.pack(">IIBBBBB", width, height, 8, 0, 0, 0, 0)))
+        f.write(chunk(b"IDAT", zlib.compress(rows)))
+        f.write(chunk(b"IEND", b""))
+
+
+def get_openvdb_module() -> Optional[Any]:
+    """Get the OpenVDB Python module, None when it is not installed"""
+    for module_name in ("pyopenvdb", "openvdb"):
+        try:
+            return importlib.import_module(module_name)
+        except ImportError:
+            continue
+    return None
+
+
+class VolumeService:
+    """
+    Volume Service - Single Responsibility for OpenVDB volume publish and load
+    All Maya calls go through the cmds argument so volumes can be tested without Maya
+    """
+
+    def __init__(self):
+        self.logger = logging.getLogger(__name__)
+
+    # Publish ----------------------------------------------------------------------------
+
+    def save_volume(
+        self, vdb_file: Path, descriptor_path: Path, notes: str = "", thumbnail: bool = True
+    ) -> Optional[Path]:
+        """
+        Copy a .vdb sequence into the library as a volume asset
+
+        Args:
+            vdb_file: Any frame of the sequence
+            descriptor_path: .volume file to write
+            notes: Free text description
+            thumbnail: Draw the mid-frame density slice as the asset's thumbnail
+
+        Returns:
+            Written descriptor path, None if the sequence could not be copied
+        """
+        vdb_file = Path(vdb_file)
+        descriptor_path = Path(descriptor_path).with_suffix(VOLUME_EXTENSION)
+        if vdb_file.suffix.lower() != VDB_EXTENSION or not vdb_file.is_file():
+            print(f"[ERROR] Not an OpenVDB file: {vdb_file}")
+            return None
+
+        pattern, frames = find_sequence(vdb_file)
+        sequence_dir = descriptor_path.parent / VOLUMES_DIR_NAME / descriptor_path.stem
+        plan = {source.resolve(): sequence_dir / source.name for _frame, source in frames}
+        copied = get_dependency_service().copy_dependencies(plan)
+        if len(copied) != len(plan):
+            print(f"[ERROR] Copied {len(copied)} of {len(plan)} frames of {pattern}")
+            return None
+
+        mid_frame = frames[len(frames) // 2][1]
+        grids = read_grid_names(mid_frame)
+        still = len(frames) == 1 and _FRAME_PATTERN.match(vdb_file.name) is None
+        descriptor = {
+            "type": "volume",
+            "format_version": VOLUME_FORMAT_VERSION,
+            "name": descriptor_path.stem,
+            "sequence": (sequence_dir / pattern).relative_to(descriptor_path.parent).as_posix(),
+            "start_frame": None if still else frames[0][0],
+            "end_frame": None if still else frames[-1][0],
+            "frame_count": len(frames),
+            "grids": grids,
+            "size_bytes": sum(path.stat().st_size for path in copied),
+            "notes": notes,
+            "author": get_current_user(),
+            "created_date": datetime.now().isoformat(),
+        }
+        descriptor_path.parent.mkdir(parents=True, exist_ok=True)
+        with open(descriptor_path, "w", encoding="utf-8") as f:
+            json.dump(descriptor, f, indent=2)
+
+        if thumbnail:
+            self.write_slice_thumbnail(sequence_dir / mid_frame.name, descriptor_path, grids)
+        print(
+            f"[OK] Saved volume {descriptor_path.name} ({self.describe(descriptor)}, "
+            f"{len(copied)} files)"
+        )
+        return descriptor_path
+
+    def write_slice_thumbnail(
+        self, vdb_file: Path, descriptor_path: Path, grids: List[str], size: int = SLICE_SIZE
+    ) -> Optional[Path]:
+        """Draw a slice of a frame's density grid (or its first grid) as the thumbnail"""
+        openvdb = get_openvdb_module()
+        if openvdb is None:
+            print("[INFO] OpenVDB Python module not installed - no volume slice thumbnail")
+            return None
+        if not grids:
+            return None
+        grid_name = DENSITY_GRID if DENSITY_GRID in grids else grids[0]
+        descriptor_path = Path(descriptor_path)
+        thumbnail_dir = descriptor_path.parent / ".thumbnails"
+        thumbnail = thumbnail_dir / f"{descriptor_path.stem}_screenshot.png"
+        try:
+            grid = openvdb.read(str(vdb_file), grid_name)
+            width, height, pixels = render_slice(grid, size)
+            write_png(thumbnail, width, height, pixels)
+        except Exception as e:
+            print(f"[WARNING] Could not draw a slice of {Path(vdb_file).name}: {e}")
+            return None
+        return thumbnail
+
+    def load_volume(self, descriptor_path: Path) -> Optional[Dict[str, Any]]:
+        """Read a .volume descriptor, None if it is not a valid volume"""
+        try:
+            with open(descriptor_path, "r", encoding="utf-8") as f:
+                descriptor = json.load(f)
+            if descriptor.get("type") != "volume":
+                return None
+            return descriptor
+        except Exception as e:
+            self.logger.error(f"Failed to read volume {descriptor_path}: {e}")
+            return None
+
+    def describe(self, descriptor: Dict[str, Any]) -> str:
+        """Get a summary of a volume (Frames 1001-1100, grids density, temperature)"""
+        start, end = descriptor.get("start_frame"), descriptor.get("end_frame")
+        frames = f"Frames {start}-{end}" if start is not None and end is not None else "Still"
+        grids = ", ".join(descriptor.get("grids") or []) or "unknown"
+        return f"{frames}, grids {grids}"
+
+    # Import -----------------------------------------------------------------------------
+
+    def import_volume(
+        self, cmds: Any, descriptor_path: Path, mode: str = IMPORT_MODE_AUTO
+    ) -> Optional[str]:
+        """
+        Load a volume as an aiVolume reading its sequence, or as a fluid container
+
+        Args:
+            cmds: maya.cmds module
+            descriptor_path: .volume file
+            mode: IMPORT_MODE_ARNOLD, IMPORT_MODE_FLUID, or IMPORT_MODE_AUTO
+
+        Returns:
+            Transform of the imported volume, None if it could not be loaded
+        """
+        descriptor_path = Path(descriptor_path)
+        descriptor = self.load_volume(descriptor_path)
+        if descriptor is None:
+            return None
+        sequence = (descriptor_path.parent / descriptor["sequence"]).as_posix()
+
+        if mode in (IMPORT_MODE_AUTO, IMPORT_MODE_ARNOLD):
+            try:
+                cmds.loadPlugin("mtoa", quiet=True)
+                arnold = True
+            except Exception as e:
+                arnold = False
+                if mode == IMPORT_MODE_ARNOLD:
+                    print(f"[ERROR] Arnold (mtoa) is needed to load {descriptor['name']}: {e}")
+                    return None
+            mode = IMPORT_MODE_ARNOLD if arnold else IMPORT_MODE_FLUID
+
+        if mode == IMPORT_MODE_ARNOLD:
+            transform = self._create_ai_volume(cmds, descriptor, sequence)
+        else:
+            transform = self._create_fluid_container(cmds, descriptor)
+            print(
+                f"[WARNING] {descriptor['name']} loaded as an empty fluid container - "
+                "load it with Arnold to see the voxels"
+            )
+        cmds.addAttr(transform, longName=VOLUME_ATTRIBUTE, dataType="string")
+        cmds.setAttr(f"{transform}.{VOLUME_ATTRIBUTE}", sequence, type="string")
+        print(f"[OK] Imported volume {descriptor['name']} as {transform} ({mode})")
+        return transform
+
+    # Internals --------------------------------------------------------------------------
+
+    def _create_ai_volume(self, cmds: Any, descriptor: Dict[str, Any], sequence: str) -> str:
+        """Create an aiVolume reading the sequence at the scene's frame"""
+        name = descriptor["name"]
+        shape = cmds.createNode("aiVolume", name=f"{name}VolumeShape")
+        transform = cmds.listRelatives(shape, parent=True, fullPath=True)[0]
+        cmds.setAttr(f"{shape}.filename", sequence, type="string")
+        if descriptor.get("grids"):
+            cmds.setAttr(f"{shape}.grids", " ".join(descriptor["grids"]), type="string")
+        if descriptor.get("start_frame") is not None:
+            cmds.setAttr(f"{shape}.useFrameExtension", True)
+            cmds.connectAttr("time1.outTime", f"{shape}.frame", force=True)
+        return transform
+
+    def _create_fluid_container(self, cmds: Any, descriptor: Dict[str, Any]) -> str:
+        """Create a fluid container standing in for the volume"""
+        shape = cmds.createNode("fluidShape", name=f"{descriptor['name']}_fluidShape")
+        transform = cmds.listRelatives(shape, parent=True, fullPath=True)[0]
+        cmds.connectAttr("time1.outTime", f"{shape}.currentTime", force=True)
+        return transform
+
+
+def _read(f: BinaryIO, size: int) -> bytes:
+    """Read exactly size bytes"""
+    data = f.read(size)
+    if len(data) != size:
+        raise ValueError("file ends inside the header")
+    return data
+
+
+def _read_uint32(f: BinaryIO) -> int:
+    return struct.unpack("<I", _read(f, 4))[0]
+
+
+def _read_string(f: BinaryIO) -> str:
+    """Read a length-prefixed string"""
+    return _read(f, _read_uint32(f)).decode("utf-8", errors="replace")
+
+
+def _read_grid_names(f: BinaryIO) -> List[str]:
+    """Read the header and grid descriptors of an OpenVDB file"""
+    magic, version = struct.unpack("<qI", _read(f, 12))
+    if magic != VDB_MAGIC:
+        raise ValueError("not an OpenVDB file")
+    if version < MIN_VDB_FILE_VERSION:
+        raise ValueError(f"file format {version} is too old to read")
+    _read(f, 8)  # Library major and minor version
+    has_grid_offsets = _read(f, 1) != b"\x00"
+    if version < VDB_COMPRESSION_PER_GRID_VERSION:
+        _read(f, 1)  # File-wide compression flag
+    _read(f, 36)  # UUID
+
+    for _index in range(_read_uint32(f)):  # File metadata: name, type, value
+        _read_string(f)
+        _read_string(f)
+        _read(f, _read_uint32(f))
+    if not has_grid_offsets:
+        raise ValueError("grids are not indexed (written as a stream)")
+
+    names: List[str] = []
+    for _index in range(_read_uint32(f)):
+        unique_name = _read_string(f)
+        _read_string(f)  # Grid type
+        _read_string(f)  # Instance parent
+        _grid_pos, _block_pos, end_pos = struct.unpack("<qqq", _read(f, 24))
+        name = unique_name.split(VDB_GRID_NAME_SEPARATOR, 1)[0]
+        if name not in names:
+            names.append(name)
+        f.seek(end_pos)
+    return names
+
+
+# Singleton instance factory
+_volume_service_instance = None
+
+
+def get_volume_service() -> VolumeService:
+    """
+    Get singleton instance of VolumeService.
+
+    Returns:
+        VolumeService: Singleton service instance
+    """
+    global _volume_service_instance
+    if _volume_service_instance is None:
+        _volume_service_instance = VolumeService()
+    return _volume_service_instance
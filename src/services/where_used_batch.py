@@ -0,0 +1,81 @@
+# -*- coding: utf-8 -*-
+"""
+Where Used Batch Script
+Runs inside mayapy to list the references of Maya binary scenes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Launched by WhereUsedService so a project-wide scan never locks the artist's
+session. Maya ASCII scenes are parsed as text by the service; binary scenes are
+opened here without loading their references. The job file lists the scenes and
+where to write what was found::
+
+    {"scenes": ["shots/sh010/shot010.mb"], "output": "references.json"}
+
+    references.json
+    {"shots/sh010/shot010.mb": [["/lib/assets/scenes/crate.ma{1}", "crate"]]}
+
+Usage::
+
+    mayapy where_used_batch.py --job where_used.json
+"""
+
+import argparse
+import json
+import sys
+from typing import Any, Dict, List
+
+
+def _parse_args(argv: List[str]) -> argparse.Namespace:
+    """Parse batch command line"""
+    parser = argparse.ArgumentParser(description="List Asset Manager scene references")
+    parser.add_argument("--job", required=True, help="JSON job file written by the service")
+    return parser.parse_args(argv)
+
+
+def _read_references(cmds: Any, scene: str) -> List[List[str]]:
+    """Open a scene without its references and list (path, namespace) of each"""
+    cmds.file(scene, open=True, force=True, ignoreVersion=True, loadReferenceDepth="none")
+    references = []
+    for path in cmds.file(query=True, reference=True) or []:
+        namespace = cmds.file(path, query=True, namespace=True) or ""
+        references.append([path, namespace])
+    return references
+
+
+def main(argv: List[str]) -> int:
+    """List the references of every scene - returns process exit code"""
+    args = _parse_args(argv)
+    with open(args.job, "r", encoding="utf-8") as f:
+        job = json.load(f)
+
+    import maya.standalone  # type: ignore
+
+    maya.standalone.initialize(name="python")
+    try:
+        import maya.cmds as cmds  # type: ignore
+
+        found: Dict[str, List[List[str]]] = {}
+        for scene in job["scenes"]:
+            try:
+                found[scene] = _read_references(cmds, scene)
+            except Exception as e:
+                # One unreadable scene should not hide the others
+                print(f"[WARNING] Could not read {scene}: {e}")
+
+        with open(job["output"], "w", encoding="utf-8") as f:
+            json.dump(found, f)
+        print(f"[OK] Listed references of {len(found)} scene(s)")
+        return 0
+
+    except Exception as e:
+        print(f"[ERROR] Where used batch failed: {e}")
+        return 1
+
+    finally:
+        maya.standalone.uninitialize()
+
+
+if __name__ == "__main__":
+    sys.exit(main(sys.argv[1:]))
@@ -0,0 +1,286 @@
+# -*- coding: utf-8 -*-
+"""
+Where Used Service Implementation
+Finds every project scene referencing a library asset, and the version each one loads
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Maya ASCII scenes are parsed as text. Maya binary scenes are opened by a mayapy
+process (where_used_batch.py) so their references are read exactly; without mayapy
+their paths are recovered from the file's strings. Scans run on a worker thread, so
+the artist keeps working while a large shot tree is searched.
+
+The shot tree defaults to the Maya project and the library; a studio layout can be
+configured in ~/.assetmanager/where_used.json::
+
+    {"shot_roots": ["/mnt/show/shots"]}
+"""
+
+import json
+import logging
+import re
+import shutil
+import subprocess
+import tempfile
+import threading
+from pathlib import Path
+from typing import Any, Callable, Dict, Iterable, List, Optional, Tuple
+
+from ..core.models.scene_usage import SceneUsage
+from .maya_ascii_references import COPY_NUMBER, find_references, resolve_reference_path
+from .reference_update_service_impl import get_reference_update_service
+from .thumbnail_queue_impl import find_mayapy
+
+USER_CONFIG_DIR = Path.home() / ".assetmanager"
+WHERE_USED_SCRIPT = Path(__file__).with_name("where_used_batch.py")
+SCENE_EXTENSIONS = {".ma", ".mb"}
+
+# Printable runs of a binary file, and where an absolute path can start inside one
+_BINARY_STRING = re.compile(rb"[\x20-\x7e]{4,}")
+_PATH_START = re.compile(r"[A-Za-z]:[\\/]|/|\$")
+
+# (reference path as stored, namespace)
+Reference = Tuple[str, str]
+BinaryReader = Callable[[List[Path]], Dict[Path, List[Reference]]]
+
+
+class WhereUsedService:
+    """
+    Where Used Service - Single Responsibility for project-wide asset usage scans
+    Scenes are read from disk; the open scene is never touched
+    """
+
+    def __init__(
+        self,
+        config_file: Optional[Path] = None,
+        binary_reader: Optional[BinaryReader] = None,
+        reference_service=None,
+    ):
+        self.logger = logging.getLogger(__name__)
+        self._config_file = config_file or USER_CONFIG_DIR / "where_used.json"
+        self._config: Dict[str, Any] = self._load_config()
+        self._binary_reader = binary_reader or self._read_binary_references
+        self._reference_service = reference_service or get_reference_update_service()
+
+    # Configuration ----------------------------------------------------------------------
+
+    def get_shot_roots(self) -> List[Path]:
+        """Get the shot tree folders the user configured"""
+        return [Path(root) for root in self._config.get("shot_roots", [])]
+
+    def set_shot_roots(self, roots: List[Path]) -> None:
+        """Replace the configured shot tree folders (call save_config to persist)"""
+        self._config["shot_roots"] = list(dict.fromkeys(str(root) for root in roots))
+
+    def get_search_roots(self, project_roots: List[Path]) -> List[Path]:
+        """Get the folders scanned: the configured shot tree, else the project folders"""
+        return self.get_shot_roots() or [Path(root) for root in project_roots]
+
+    def save_config(self) -> bool:
+        """Write configuration to disk"""
+        try:
+            self._config_file.parent.mkdir(parents=True, exist_ok=True)
+            with open(self._config_file, "w", encoding="utf-8") as f:
+                json.dump(self._config, f, indent=2)
+            return True
+        except Exception as e:
+            self.logger.error(f"Failed to save where used config: {e}")
+            return False
+
+    # Scanning ---------------------------------------------------------------------------
+
+    def find_scenes(self, search_roots: Iterable[Path]) -> List[Path]:
+        """Get the .ma / .mb scenes below the search roots, skipping hidden folders"""
+        scenes: Dict[Path, Path] = {}
+        for root in search_roots:
+            root = Path(root)
+            if not root.is_dir():
+                continue
+            for path in sorted(root.rglob("*")):
+                relative = path.relative_to(root)
+                if any(part.startswith(".") for part in relative.parts[:-1]):
+                    continue
+                if path.is_file() and path.suffix.lower() in SCENE_EXTENSIONS:
+                    scenes.setdefault(path.resolve(), path)
+        return sorted(scenes.values())
+
+    def scan(
+        self,
+        search_roots: Iterable[Path],
+        asset_file: Path,
+        progress: Optional[Callable[[int, int], bool]] = None,
+    ) -> List[SceneUsage]:
+        """
+        Find the scenes referencing an asset
+
+        Args:
+            search_roots: Folders searched for scenes
+            asset_file: Library asset; references of its version snapshots and LODs count
+            progress: Called with (scenes read, total); return False to stop early
+
+        Returns:
+            One usage per reference, in scene order
+        """
+        asset_file = Path(asset_file).resolve()
+        scenes = self.find_scenes(search_roots)
+        ascii_scenes = [scene for scene in scenes if scene.suffix.lower() == ".ma"]
+        binary_scenes = [scene for scene in scenes if scene.suffix.lower() == ".mb"]
+
+        references: Dict[Path, List[Reference]] = {}
+        for index, scene in enumerate(ascii_scenes, 1):
+            try:
+                references[scene] = self.read_ascii_references(scene)
+            except (OSError, UnicodeDecodeError) as e:
+                self.logger.warning(f"Skipping unreadable scene {scene.name}: {e}")
+            if progress is not None and not progress(index, len(scenes)):
+                return self._match_all(references, asset_file)
+
+        if binary_scenes:
+            references.update(self._binary_reader(binary_scenes))
+            if progress is not None:
+                progress(len(scenes), len(scenes))
+
+        usages = self._match_all(references, asset_file)
+        print(f"[INFO] {asset_file.name}: {len(usages)} use(s) in {len(scenes)} scene(s) searched")
+        return usages
+
+    def scan_in_background(
+        self,
+        search_roots: Iterable[Path],
+        asset_file: Path,
+        finished: Callable[[List[SceneUsage]], None],
+        progress: Optional[Callable[[int, int], bool]] = None,
+    ) -> threading.Thread:
+        """Scan on a worker thread; finished (and progress) are called from that thread"""
+
+        def run():
+            try:
+                usages = self.scan(search_roots, asset_file, progress)
+            except Exception as e:
+                print(f"[ERROR] Where used scan failed: {e}")
+                usages = []
+            finished(usages)
+
+        thread = threading.Thread(target=run, name="where-used", daemon=True)
+        thread.start()
+        return thread
+
+    def read_ascii_references(self, scene: Path) -> List[Reference]:
+        """Get the (path, namespace) of each reference statement of a Maya ASCII scene"""
+        text = Path(scene).read_text(encoding="utf-8")
+        # A reference has a -rdi header statement and a -r load statement
+        references: Dict[Reference, None] = {}
+        for reference in find_references(text):
+            references[(reference.path, reference.namespace)] = None
+        return list(references)
+
+    def build_batch_command(self, job_file: Path, mayapy: Path) -> List[str]:
+        """Build the mayapy command line reading binary scenes' references"""
+        return [str(mayapy), str(WHERE_USED_SCRIPT), "--job", str(job_file)]
+
+    # Internals --------------------------------------------------------------------------
+
+    def _match_all(
+        self, references: Dict[Path, List[Reference]], asset_file: Path
+    ) -> List[SceneUsage]:
+        """Get the usages of an asset among the references of scenes"""
+        usages = []
+        for scene in sorted(references):
+            for path, namespace in references[scene]:
+                usage = self._match(scene, path, namespace, asset_file)
+                if usage is not None:
+                    usages.append(usage)
+        return usages
+
+    def _match(
+        self, scene: Path, path: str, namespace: str, asset_file: Path
+    ) -> Optional[SceneUsage]:
+        """Get the usage a reference makes of the asset, None if it loads something else"""
+        stored = COPY_NUMBER.sub("", path)
+        resolved = resolve_reference_path(scene, stored)
+        if resolved is None:
+            return None
+        referenced, version = self._reference_service.resolve(resolved)
+        if Path(referenced).resolve() != asset_file:
+            return None
+        return SceneUsage(scene, stored, version or 0, namespace)
+
+    def _read_binary_references(self, scenes: List[Path]) -> Dict[Path, List[Reference]]:
+        """Read binary scenes' references with mayapy, else from their strings"""
+        mayapy = find_mayapy()
+        if mayapy is None:
+            return {scene: self._read_binary_strings(scene) for scene in scenes}
+
+        work_dir = Path(tempfile.mkdtemp(prefix="assetManager_where_used_"))
+        try:
+            job_file = work_dir / "where_used.json"
+            output = work_dir / "references.json"
+            with open(job_file, "w", encoding="utf-8") as f:
+                json.dump({"scenes": [str(s) for s in scenes], "output": str(output)}, f)
+            result = subprocess.run(
+                self.build_batch_command(job_file, mayapy),
+                capture_output=True,
+                text=True,
+                check=False,
+            )
+            if result.returncode != 0 or not output.exists():
+                lines = (result.stdout + result.stderr).strip().splitlines()
+                print(f"[WARNING] mayapy could not read binary scenes: {lines[-1:] or ''}")
+                return {scene: self._read_binary_strings(scene) for scene in scenes}
+            with open(output, "r", encoding="utf-8") as f:
+                found = json.load(f)
+            return {
+                Path(scene): [(path, namespace) for path, namespace in refs]
+                for scene, refs in found.items()
+            }
+        finally:
+            shutil.rmtree(work_dir, ignore_errors=True)
+
+    def _read_binary_strings(self, scene: Path) -> List[Reference]:
+        """Recover scene file paths from the printable strings of a binary scene"""
+        try:
+            content = Path(scene).read_bytes()
+        except OSError as e:
+            self.logger.warning(f"Skipping unreadable scene {Path(scene).name}: {e}")
+            return []
+
+        references = []
+        for match in _BINARY_STRING.finditer(content):
+            text = match.group(0).decode("ascii")
+            if not COPY_NUMBER.sub("", text).lower().endswith((".ma", ".mb")):
+                continue
+            # A path may follow other printable bytes - start at its root
+            starts = [m.start() for m in _PATH_START.finditer(text)]
+            if starts:
+                references.append((text[starts[0]:], ""))
+        return references
+
+    def _load_config(self) -> Dict[str, Any]:
+        """Load configuration from disk"""
+        config: Dict[str, Any] = {"shot_roots": []}
+        if not self._config_file.exists():
+            return config
+        try:
+            with open(self._config_file, "r", encoding="utf-8") as f:
+                config.update(json.load(f))
+        except Exception as e:
+            self.logger.warning(f"Failed to load where used config: {e}")
+        return config
+
+
+# Singleton instance factory
+_where_used_service_instance = None
+
+
+def get_where_used_service() -> WhereUsedService:
+    """
+    Get singleton instance of WhereUsedService.
+
+    Returns:
+        WhereUsedService: Singleton service instance
+    """
+    global _where_used_service_instance
+    if _where_used_service_instance is None:
+        _where_used_service_instance = WhereUsedService()
+    return _where_used_service_instance
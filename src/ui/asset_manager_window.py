@@ -12,7 +12,7 @@ import logging
 import shutil
 import functools
 from pathlib import Path
-from typing import Optional, Dict, Any
+from typing import Optional, Dict, Any, Callable, List, Tuple
 
 try:
     from PySide6.QtWidgets import (
@@ -28,9 +28,13 @@ try:
         QInputDialog,
         QDialog,
         QGroupBox,
+        QComboBox,
+        QProgressDialog,
+        QApplication,
+        QTabWidget,
     )
     from PySide6.QtCore import Qt, QTimer, Signal
-    from PySide6.QtGui import QIcon, QKeySequence, QAction, QColor
+    from PySide6.QtGui import QIcon, QKeySequence, QAction, QActionGroup, QColor
 except ImportError as e:
     print(f"[ERROR] PySide6 import failed: {e}")
     print("[TOOL] Maya 2025+ requires PySide6. Please ensure it's properly installed.")
@@ -38,9 +42,58 @@ except ImportError as e:
 
 from ..core.container import get_container
 from ..core.interfaces.asset_repository import IAssetRepository
+from ..core.interfaces.asset_type_plugin import IAssetTypePlugin
 from ..core.interfaces.event_publisher import IEventPublisher, EventType
 from .collection_manager_dialog import CollectionManagerDialog
+from ..core.models.activity_event import ACTIVITY_PUBLISH, ACTIVITY_RENAME
+from ..core.models.alembic_cache import AlembicCacheInfo
 from ..core.models.asset import Asset
+from ..core.models.asset_version import AssetVersion, format_version_label
+from ..core.models.color_transform import ColorTransform
+from ..core.models.farm_job import FARM_STATE_COMPLETED, FARM_STEP_TURNTABLE, FARM_STEP_USD
+from ..core.models.geometry_stats import GeometryStats
+from ..core.models.import_conflict import (
+    CONFLICT_ABORT,
+    CONFLICT_NAMESPACE,
+    CONFLICT_SKIP,
+    CONFLICT_SUFFIX,
+    ImportConflictReport,
+)
+from ..core.models.import_placement_options import ImportPlacementOptions
+from ..core.models.mesh_topology import MeshTopology
+from ..core.models.playblast_settings import PlayblastSettings
+from ..core.models.proxy_representation import PROXY_LABELS, PROXY_STANDIN, STANDIN_FORMATS
+from ..core.models.thumbnail_settings import ThumbnailSettings
+from ..core.models.library_permissions import (
+    ACTION_DELETE,
+    ACTION_EDIT,
+    ACTION_IMPORT,
+    ACTION_MANAGE,
+    ACTION_PUBLISH,
+)
+from ..services.hook_service_impl import (
+    HOOK_ASSET_DELETED,
+    HOOK_POST_IMPORT,
+    HOOK_POST_PUBLISH,
+    HOOK_PRE_IMPORT,
+    HOOK_PRE_PUBLISH,
+)
+from ..services.hotkey_service_impl import (
+    COMMAND_ADVANCED_SEARCH,
+    COMMAND_IMPORT_LAST,
+    COMMAND_IMPORT_SELECTED,
+    COMMAND_OPEN_BROWSER,
+    COMMAND_PUBLISH_SELECTED,
+    COMMAND_SCRATCH_EXPORT,
+    COMMAND_SEARCH,
+    get_hotkey_service,
+)
+from ..services.localization_service_impl import get_localization_service, tr
+from ..services.viewport_drop_service_impl import (
+    DROP_MODE_IMPORT,
+    DROP_MODE_INSTANCE,
+    DROP_MODE_REFERENCE,
+)
 
 # Import plugin version for dynamic version display - DRY Principle
 try:
@@ -60,6 +113,9 @@ from .dialogs.tag_manager_dialog import TagManagerDialog
 
 logger = logging.getLogger(__name__)
 
+# Create Asset formats that publish a USD root layer + geometry payload
+USD_PUBLISH_FORMATS = {".usd", ".usda", ".usdc"}
+
 # Add global variable for singleton reference (replaces reliance on external module attribute)
 _asset_manager_window = None
 
@@ -73,6 +129,9 @@ class AssetManagerWindow(QMainWindow):
     # Signals for clean event communication
     asset_selected = Signal(Asset)
     asset_imported = Signal(Asset)
+    # Background thumbnail jobs finish on worker threads - re-emitted onto the UI thread
+    thumbnail_job_finished = Signal(object)
+    maintenance_finished = Signal(object)
 
     def __init__(self, parent=None):
         super().__init__(parent)
@@ -87,6 +146,152 @@ class AssetManagerWindow(QMainWindow):
 
         self._library_service = self._container.resolve(ILibraryService)
 
+        # Resolve version service (immutable publish history)
+        from ..core.interfaces.version_service import IVersionService
+
+        self._version_service = self._container.resolve(IVersionService)
+
+        # Resolve lock service (check-out / check-in for shared libraries)
+        from ..core.interfaces.lock_service import ILockService
+
+        self._lock_service = self._container.resolve(ILockService)
+
+        # Resolve source control (Perforce depot storage, used when enabled)
+        from ..core.interfaces.source_control import ISourceControl
+
+        self._source_control = self._container.resolve(ISourceControl)
+
+        # Background thumbnail queue (mayapy batch renders)
+        from ..services.thumbnail_queue_impl import get_thumbnail_queue
+
+        self._thumbnail_queue = get_thumbnail_queue()
+        self._thumbnail_queue.add_finished_callback(self._on_thumbnail_job_done_threaded)
+        self._color_checks_done: set = set()  # (library, ColorTransform) offered to regenerate
+        self.thumbnail_job_finished.connect(self._on_thumbnail_job_finished)
+
+        # Assets dragged from the library onto a Maya viewport are placed at the cursor
+        from ..services.viewport_drop_service_impl import get_viewport_drop_service
+
+        self._viewport_drop_service = get_viewport_drop_service()
+        self._viewport_drop_service.install(self._on_viewport_drop)
+
+        # Scene references are compared with the library's version history periodically
+        from ..services.reference_update_service_impl import get_reference_update_service
+
+        self._reference_update_service = get_reference_update_service()
+        self._reference_update_service.install(
+            lambda: QTimer.singleShot(0, self._on_maya_scene_changed)
+        )
+        self._reference_update_timer = QTimer(self)
+        self._reference_update_timer.setInterval(30000)
+        self._reference_update_timer.timeout.connect(self._check_reference_updates)
+        self._reference_update_banner: Optional[Any] = None
+
+        # Turntables and USD conversions sent to the render farm report back through
+        # job records in the library, looked at while any job is outstanding
+        from ..services.render_farm_service_impl import get_render_farm_service
+
+        self._render_farm_service = get_render_farm_service()
+        self._farm_timer = QTimer(self)
+        self._farm_timer.setInterval(60000)
+        self._farm_timer.timeout.connect(self._check_farm_jobs)
+
+        # Library assets in the open scene, listed by the Scene Assets panel
+        from ..services.scene_asset_service_impl import get_scene_asset_service
+
+        self._scene_asset_service = get_scene_asset_service()
+        self._scene_assets_widget: Optional[Any] = None
+
+        # References whose files are missing stay unloaded, with a box standing in
+        from ..services.missing_asset_service_impl import get_missing_asset_service
+
+        self._missing_asset_service = get_missing_asset_service()
+        self._missing_asset_service.install()
+
+        # Studio callbacks around publishes, imports, and deletes (hook folders)
+        from ..services.hook_service_impl import get_hook_service
+
+        self._hook_service = get_hook_service()
+
+        # Who published, imported, deleted, or reviewed what (library activity log)
+        from ..services.activity_service_impl import get_activity_service
+
+        self._activity_service = get_activity_service()
+
+        # Import and publish counts of libraries that opted in, kept in the library
+        from ..services.usage_stats_service_impl import get_usage_stats_service
+
+        self._usage_stats_service = get_usage_stats_service()
+
+        # Review notes on assets; @mentions are looked for while the manager is open
+        from ..services.comment_service_impl import get_comment_service
+
+        self._comment_service = get_comment_service()
+        self._unread_mentions = 0
+        self._mention_timer = QTimer(self)
+        self._mention_timer.setInterval(60000)
+        self._mention_timer.timeout.connect(self._check_mentions)
+        self._mention_timer.start()
+
+        from ..services.permission_service_impl import get_permission_service
+
+        self._permission_service = get_permission_service()
+
+        # Personal, project, and studio libraries; a Maya project can mount its own
+        from ..services.library_registry_impl import get_library_registry
+
+        self._library_registry = get_library_registry()
+        self._library_registry.install(
+            lambda: QTimer.singleShot(0, self._on_maya_project_changed)
+        )
+        self._library_combo: Optional[QComboBox] = None
+
+        # Local mirror of a library for working while it cannot be reached
+        from ..services.offline_cache_impl import get_offline_cache_service
+
+        self._offline_cache = get_offline_cache_service()
+        self._offline_library: Optional[Path] = None  # Library whose cache is loaded
+        self._offline_timer = QTimer(self)
+        self._offline_timer.setInterval(60000)
+        self._offline_timer.timeout.connect(self._check_library_reachable)
+
+        # Scheduled library maintenance, run from idle Maya sessions when the library allows
+        from ..services.maintenance_service_impl import get_maintenance_service
+
+        self._maintenance_service = get_maintenance_service()
+        self._maintenance_running = False
+        self._maintenance_timer = QTimer(self)
+        self._maintenance_timer.setInterval(60000)
+        self._maintenance_timer.timeout.connect(self._run_idle_maintenance)
+        self._maintenance_timer.start()
+        self.maintenance_finished.connect(self._on_maintenance_finished)
+
+        # Layers a paired Houdini/Blender session sends, imported on the UI thread
+        from ..services.interchange_service_impl import get_interchange_service
+
+        self._interchange_service = get_interchange_service()
+        self._interchange_timer = QTimer(self)
+        self._interchange_timer.setInterval(1000)
+        self._interchange_timer.timeout.connect(self._import_interchange_layers)
+        self._start_interchange_listening()
+
+        # Exports dropped in the artist's watch folders, ingested while Maya is open
+        from ..services.ingest_service_impl import get_ingest_service
+
+        self._ingest_service = get_ingest_service()
+        self._ingest_timer = QTimer(self)
+        self._ingest_timer.timeout.connect(self._ingest_watch_folders)
+        self._start_watching_folders()
+
+        # Library the last scratch asset was promoted to, chosen first next time
+        self._last_promote_library: Optional[Path] = None
+
+        # Manager commands with artist shortcuts, also run from Maya hotkeys and marking menu
+        self._hotkey_service = get_hotkey_service()
+        self._command_actions: Dict[str, QAction] = {}
+        # Set when the artist stops a multi-asset import at a name clash
+        self._import_stopped = False
+
         # UI components
         self._library_widget: Optional[AssetLibraryWidget] = None
         self._preview_widget: Optional[AssetPreviewWidget] = None
@@ -104,9 +309,11 @@ class AssetManagerWindow(QMainWindow):
 
         # Load initial data
         QTimer.singleShot(100, self._load_initial_data)
+        QTimer.singleShot(2000, self._check_reference_updates)
 
         # Load window state
         self._load_window_state()
+        QTimer.singleShot(0, self._on_maya_project_changed)
 
         # Set global singleton reference to this instance
         global _asset_manager_window  # pylint: disable=global-statement
@@ -114,7 +321,7 @@ class AssetManagerWindow(QMainWindow):
 
     def _setup_window(self) -> None:
         """Setup main window properties with Maya integration - Single Responsibility"""
-        self.setWindowTitle("Asset Manager")
+        self.setWindowTitle(tr("Asset Manager"))
         self.setMinimumSize(1000, 600)
         self.resize(1200, 800)
 
@@ -196,61 +403,171 @@ class AssetManagerWindow(QMainWindow):
         """)
 
         # File menu
-        file_menu = menubar.addMenu("&File")
+        file_menu = menubar.addMenu(tr("&File"))
 
-        new_project_action = QAction("&New Project...", self)
+        new_project_action = QAction(tr("&New Project..."), self)
         new_project_action.setShortcut(QKeySequence.StandardKey.New)
         new_project_action.triggered.connect(self._on_new_project)
         file_menu.addAction(new_project_action)
 
-        open_project_action = QAction("&Open Project...", self)
+        open_project_action = QAction(tr("&Open Project..."), self)
         open_project_action.setShortcut(QKeySequence.StandardKey.Open)
         open_project_action.triggered.connect(self._on_open_project)
         file_menu.addAction(open_project_action)
 
         # Add Set Project menu item for switching between existing projects
-        set_project_action = QAction("&Set Project...", self)
+        set_project_action = QAction(tr("&Set Project..."), self)
         set_project_action.setShortcut(QKeySequence("Ctrl+Shift+O"))
-        set_project_action.setStatusTip("Set an existing project as the current Asset Library")
+        set_project_action.setStatusTip(tr("Set an existing project as the current Asset Library"))
         set_project_action.triggered.connect(self._on_set_project)
         file_menu.addAction(set_project_action)
 
+        manage_libraries_action = QAction(tr("Manage &Libraries..."), self)
+        manage_libraries_action.setStatusTip(
+            tr("Register personal, project, and studio libraries")
+        )
+        manage_libraries_action.triggered.connect(self._on_manage_libraries)
+        file_menu.addAction(manage_libraries_action)
+
+        # Library packages - move a whole library with its metadata to a new location
+        export_package_action = QAction(tr("E&xport Library Package..."), self)
+        export_package_action.setStatusTip(
+            tr("Package the library's files, thumbnails, and metadata into one file")
+        )
+        export_package_action.triggered.connect(self._on_export_library_package)
+        file_menu.addAction(export_package_action)
+
+        import_package_action = QAction(tr("&Import Library Package..."), self)
+        import_package_action.setStatusTip(
+            tr("Unpack a library package into a new folder and open it")
+        )
+        import_package_action.triggered.connect(self._on_import_library_package)
+        file_menu.addAction(import_package_action)
+
+        # Asset bundles - selected assets for vendors, and the bundles they send back
+        export_bundle_action = QAction(tr("Export Asset &Bundle..."), self)
+        export_bundle_action.setStatusTip(
+            tr("Zip the selected assets with their dependencies, thumbnails, and a manifest")
+        )
+        export_bundle_action.triggered.connect(self._on_export_bundle)
+        file_menu.addAction(export_bundle_action)
+
+        import_bundle_action = QAction(tr("Import Asset B&undle..."), self)
+        import_bundle_action.setStatusTip(tr("Add the assets of a delivery bundle to the library"))
+        import_bundle_action.triggered.connect(self._on_import_bundle)
+        file_menu.addAction(import_bundle_action)
+
+        migrate_library_action = QAction(tr("Mi&grate Moved Library..."), self)
+        migrate_library_action.setStatusTip(
+            tr(
+                "Point a library copied to a new location at its new paths and upgrade its "
+                "metadata"
+            )
+        )
+        migrate_library_action.triggered.connect(self._on_migrate_library)
+        file_menu.addAction(migrate_library_action)
+
         # Save Project options
-        save_project_action = QAction("&Save Project...", self)
+        save_project_action = QAction(tr("&Save Project..."), self)
         save_project_action.setShortcut(QKeySequence.StandardKey.Save)
-        save_project_action.setStatusTip("Save the current project and asset library")
+        save_project_action.setStatusTip(tr("Save the current project and asset library"))
         save_project_action.triggered.connect(self._on_save_project)
         file_menu.addAction(save_project_action)
 
-        save_project_as_action = QAction("Save Project &As...", self)
+        save_project_as_action = QAction(tr("Save Project &As..."), self)
         save_project_as_action.setShortcut(QKeySequence.StandardKey.SaveAs)
-        save_project_as_action.setStatusTip("Save the current project to a new location")
+        save_project_as_action.setStatusTip(tr("Save the current project to a new location"))
         save_project_as_action.triggered.connect(self._on_save_project_as)
         file_menu.addAction(save_project_as_action)
 
         # Add Delete Project menu item for removing projects permanently
-        delete_project_action = QAction("&Delete Project...", self)
+        delete_project_action = QAction(tr("&Delete Project..."), self)
         delete_project_action.setShortcut(QKeySequence("Ctrl+Shift+D"))
-        delete_project_action.setStatusTip("Permanently delete an existing Asset Manager project")
+        delete_project_action.setStatusTip(
+            tr("Permanently delete an existing Asset Manager project")
+        )
         delete_project_action.triggered.connect(self._on_delete_project)
         file_menu.addAction(delete_project_action)
 
+        # Multi-user mode - shared network library with asset check-out locks
+        self._multi_user_action = QAction(tr("&Multi-User Mode (Asset Locking)"), self)
+        self._multi_user_action.setCheckable(True)
+        self._multi_user_action.setStatusTip(
+            tr("Share the project path with other artists and lock assets while editing")
+        )
+        self._multi_user_action.toggled.connect(self._on_toggle_multi_user_mode)
+        file_menu.addAction(self._multi_user_action)
+
+        # Perforce mode - library lives in a depot; publishes submit, imports sync
+        self._perforce_action = QAction(tr("&Perforce Library (P4)"), self)
+        self._perforce_action.setCheckable(True)
+        self._perforce_action.setStatusTip(
+            tr("Submit publishes to Perforce, sync before import, and show depot revisions")
+        )
+        self._perforce_action.toggled.connect(self._on_toggle_perforce_mode)
+        file_menu.addAction(self._perforce_action)
+
+        # Offline mode - browse, import, and publish into a local cache of the library
+        self._offline_action = QAction(tr("Work &Offline (Local Cache)"), self)
+        self._offline_action.setCheckable(True)
+        self._offline_action.setStatusTip(
+            tr(
+                "Work from a local copy of the most used assets and queue publishes for the "
+                "library"
+            )
+        )
+        self._offline_action.toggled.connect(self._on_toggle_offline_mode)
+        file_menu.addAction(self._offline_action)
+
+        update_cache_action = QAction(tr("&Update Offline Cache"), self)
+        update_cache_action.setStatusTip(
+            tr("Copy the most used assets of the library to the cache")
+        )
+        update_cache_action.triggered.connect(self._on_update_offline_cache)
+        file_menu.addAction(update_cache_action)
+
+        sync_offline_action = QAction(tr("S&ync Offline Publishes"), self)
+        sync_offline_action.setStatusTip(tr("Copy assets published while offline to the library"))
+        sync_offline_action.triggered.connect(self._on_sync_offline_publishes)
+        file_menu.addAction(sync_offline_action)
+
+        file_menu.addSeparator()
+
+        sign_in_action = QAction(tr("Sign &In..."), self)
+        sign_in_action.setStatusTip(
+            tr("Sign in with your studio account, or switch artist on a shared workstation")
+        )
+        sign_in_action.triggered.connect(self._on_sign_in)
+        file_menu.addAction(sign_in_action)
+
+        sign_out_action = QAction(tr("Sign Ou&t"), self)
+        sign_out_action.setStatusTip(tr("End your session so the next artist can sign in"))
+        sign_out_action.triggered.connect(self._on_sign_out)
+        file_menu.addAction(sign_out_action)
+
+        identity_settings_action = QAction(tr("&Identity Settings..."), self)
+        identity_settings_action.setStatusTip(
+            tr("Choose whether artists sign in through LDAP / Active Directory or OAuth")
+        )
+        identity_settings_action.triggered.connect(self._on_identity_settings)
+        file_menu.addAction(identity_settings_action)
+
         file_menu.addSeparator()
 
-        refresh_action = QAction("&Refresh Library", self)
+        refresh_action = QAction(tr("&Refresh Library"), self)
         refresh_action.setShortcut(QKeySequence.StandardKey.Refresh)
-        refresh_action.triggered.connect(self._on_refresh_library)
+        refresh_action.triggered.connect(lambda: self._on_refresh_library(full_scan=True))
         file_menu.addAction(refresh_action)
 
         file_menu.addSeparator()
 
         # Add Asset Management Options
-        add_asset_action = QAction("&Add Asset to Library...", self)
+        add_asset_action = QAction(tr("&Add Asset to Library..."), self)
         add_asset_action.setShortcut(QKeySequence("Ctrl+A"))
         add_asset_action.triggered.connect(self._on_add_asset_to_library)
         file_menu.addAction(add_asset_action)
 
-        add_multiple_assets_action = QAction("Add &Multiple Assets from Folders...", self)
+        add_multiple_assets_action = QAction(tr("Add &Multiple Assets from Folders..."), self)
         add_multiple_assets_action.setShortcut(QKeySequence("Ctrl+Shift+A"))
         add_multiple_assets_action.triggered.connect(self._on_add_multiple_assets)
         file_menu.addAction(add_multiple_assets_action)
@@ -258,84 +575,645 @@ class AssetManagerWindow(QMainWindow):
         file_menu.addSeparator()
 
         # Add missing Remove Selected Asset menu item
-        remove_selected_action = QAction("&Remove Selected Asset...", self)
+        remove_selected_action = QAction(tr("&Remove Selected Asset..."), self)
         remove_selected_action.setShortcut(QKeySequence.StandardKey.Delete)
         remove_selected_action.triggered.connect(self._on_remove_selected_asset)
         file_menu.addAction(remove_selected_action)
 
         # Add Delete Selected Asset(s) menu item with Ctrl+Del hotkey
-        delete_selected_action = QAction("&Delete Selected Asset(s)...", self)
+        delete_selected_action = QAction(tr("&Delete Selected Asset(s)..."), self)
         delete_selected_action.setShortcut(QKeySequence("Ctrl+Del"))
-        delete_selected_action.setStatusTip("Delete Selected Asset(s) from Set Project")
+        delete_selected_action.setStatusTip(tr("Delete Selected Asset(s) from Set Project"))
         delete_selected_action.triggered.connect(self._on_delete_selected_asset)
         file_menu.addAction(delete_selected_action)
 
+        trash_action = QAction(tr("Library &Trash..."), self)
+        trash_action.setStatusTip(tr("Restore or purge assets deleted from the library"))
+        trash_action.triggered.connect(self._on_show_trash)
+        file_menu.addAction(trash_action)
+
         file_menu.addSeparator()
 
-        exit_action = QAction("E&xit", self)
+        exit_action = QAction(tr("E&xit"), self)
         exit_action.setShortcut(QKeySequence.StandardKey.Quit)
         exit_action.triggered.connect(self.close)
         file_menu.addAction(exit_action)
 
         # Edit menu
-        edit_menu = menubar.addMenu("&Edit")
+        edit_menu = menubar.addMenu(tr("&Edit"))
 
-        search_action = QAction("&Advanced Search...", self)
-        search_action.setShortcut(QKeySequence("Ctrl+F"))
+        search_library_action = QAction(tr("&Search Library"), self)
+        search_library_action.setStatusTip(tr("Jump to the library search field"))
+        search_library_action.triggered.connect(self._on_focus_search)
+        edit_menu.addAction(search_library_action)
+        self._command_actions[COMMAND_SEARCH] = search_library_action
+
+        search_action = QAction(tr("&Advanced Search..."), self)
         search_action.triggered.connect(self._on_advanced_search)
         edit_menu.addAction(search_action)
+        self._command_actions[COMMAND_ADVANCED_SEARCH] = search_action
 
         edit_menu.addSeparator()
 
         # Thumbnail management
-        refresh_thumbnails_action = QAction("Refresh &Thumbnails", self)
+        refresh_thumbnails_action = QAction(tr("Refresh &Thumbnails"), self)
         refresh_thumbnails_action.setShortcut(QKeySequence("F5"))
         refresh_thumbnails_action.triggered.connect(self._on_refresh_thumbnails)
         edit_menu.addAction(refresh_thumbnails_action)
 
-        clear_thumbnail_cache_action = QAction("&Clear Thumbnail Cache", self)
+        regenerate_thumbnails_action = QAction(tr("Regenerate Thumbnails in &Background..."), self)
+        regenerate_thumbnails_action.setStatusTip(
+            tr("Render stills and optional turntables for selected (or all) assets via mayapy")
+        )
+        regenerate_thumbnails_action.triggered.connect(self._on_regenerate_thumbnails)
+        edit_menu.addAction(regenerate_thumbnails_action)
+
+        # Render farm - heavy steps run on Deadline or Tractor instead of this machine
+        submit_farm_action = QAction(tr("Submit to Render &Farm..."), self)
+        submit_farm_action.setStatusTip(
+            tr("Render turntables and convert to USD for the selected assets on the farm")
+        )
+        submit_farm_action.triggered.connect(self._on_submit_to_farm)
+        edit_menu.addAction(submit_farm_action)
+
+        self._farm_publish_action = QAction(tr("Send Heavy Publish Steps to the Farm"), self)
+        self._farm_publish_action.setCheckable(True)
+        self._farm_publish_action.setStatusTip(
+            tr("Render the turntable and convert to USD on the farm after each scene publish")
+        )
+        edit_menu.addAction(self._farm_publish_action)
+
+        clear_thumbnail_cache_action = QAction(tr("&Clear Thumbnail Cache"), self)
         clear_thumbnail_cache_action.triggered.connect(self._on_clear_thumbnail_cache)
         edit_menu.addAction(clear_thumbnail_cache_action)
 
+        edit_menu.addSeparator()
+
+        find_duplicates_action = QAction(tr("Find &Duplicate Assets..."), self)
+        find_duplicates_action.setStatusTip(
+            tr("Find identical or near-identical assets and repath scenes to one of them")
+        )
+        find_duplicates_action.triggered.connect(self._on_find_duplicates)
+        edit_menu.addAction(find_duplicates_action)
+
+        dependency_graph_action = QAction(tr("Show Dependency &Graph..."), self)
+        dependency_graph_action.setStatusTip(
+            tr("See what the current asset uses and every asset and scene that uses it")
+        )
+        dependency_graph_action.triggered.connect(self._on_show_dependency_graph)
+        edit_menu.addAction(dependency_graph_action)
+
+        where_used_action = QAction(tr("&Where Used..."), self)
+        where_used_action.setStatusTip(
+            tr("List the project scenes referencing the current asset and the versions they load")
+        )
+        where_used_action.triggered.connect(self._on_where_used)
+        edit_menu.addAction(where_used_action)
+
+        compare_preview_action = QAction(tr("Compare &Preview..."), self)
+        compare_preview_action.setStatusTip(
+            tr("Render the two selected assets side by side offscreen and tumble them together")
+        )
+        compare_preview_action.triggered.connect(self._on_compare_preview)
+        edit_menu.addAction(compare_preview_action)
+
+        rename_asset_action = QAction(tr("Re&name / Move Asset..."), self)
+        rename_asset_action.setStatusTip(
+            tr("Rename or move the current asset and repath the scenes that reference it")
+        )
+        rename_asset_action.triggered.connect(self._on_rename_asset)
+        edit_menu.addAction(rename_asset_action)
+
+        repair_references_action = QAction(tr("&Repair Renamed References..."), self)
+        repair_references_action.setStatusTip(
+            tr("Repath project scenes still referencing old paths of renamed or moved assets")
+        )
+        repair_references_action.triggered.connect(self._on_repair_renamed_references)
+        edit_menu.addAction(repair_references_action)
+
+        audit_library_action = QAction(tr("A&udit Library..."), self)
+        audit_library_action.setStatusTip(
+            tr("Verify published files against their checksums and find orphaned folders")
+        )
+        audit_library_action.triggered.connect(self._on_audit_library)
+        edit_menu.addAction(audit_library_action)
+
+        maintenance_action = QAction(tr("Library &Maintenance..."), self)
+        maintenance_action.setStatusTip(
+            tr("Schedule thumbnail, audit, trash, stats, and lock jobs and read their report")
+        )
+        maintenance_action.triggered.connect(self._on_library_maintenance)
+        edit_menu.addAction(maintenance_action)
+
+        version_retention_action = QAction(tr("Version &Retention..."), self)
+        version_retention_action.setStatusTip(
+            tr("Choose which old versions maintenance prunes and preview them before deletion")
+        )
+        version_retention_action.triggered.connect(self._on_version_retention)
+        edit_menu.addAction(version_retention_action)
+
+        storage_usage_action = QAction(tr("&Storage Usage..."), self)
+        storage_usage_action.setStatusTip(
+            tr(
+                "See library size by category and asset, its growth, the storage quotas, "
+                "and which assets and categories are used"
+            )
+        )
+        storage_usage_action.triggered.connect(self._on_storage_usage)
+        edit_menu.addAction(storage_usage_action)
+
+        bulk_edit_action = QAction(tr("&Bulk Edit Metadata..."), self)
+        bulk_edit_action.setStatusTip(
+            tr("Rename a tag, change authors, or rewrite stored paths across the library")
+        )
+        bulk_edit_action.triggered.connect(self._on_bulk_edit_metadata)
+        edit_menu.addAction(bulk_edit_action)
+
+        activity_log_action = QAction(tr("Activity &Log..."), self)
+        activity_log_action.setStatusTip(
+            tr("See who published, imported, deleted, or reviewed library assets")
+        )
+        activity_log_action.triggered.connect(self._on_activity_log)
+        edit_menu.addAction(activity_log_action)
+
+        edit_menu.addSeparator()
+
+        keyboard_shortcuts_action = QAction(tr("&Keyboard Shortcuts..."), self)
+        keyboard_shortcuts_action.setStatusTip(
+            tr("Change manager shortcuts and add the commands to Maya's Hotkey Editor")
+        )
+        keyboard_shortcuts_action.triggered.connect(self._on_keyboard_shortcuts)
+        edit_menu.addAction(keyboard_shortcuts_action)
+
         # Assets menu
-        assets_menu = menubar.addMenu("&Assets")
+        assets_menu = menubar.addMenu(tr("&Assets"))
 
-        import_selected_action = QAction("&Import Selected", self)
-        import_selected_action.setShortcut(QKeySequence("Ctrl+I"))
+        import_selected_action = QAction(tr("&Import Selected"), self)
         import_selected_action.triggered.connect(self._on_import_selected)
         assets_menu.addAction(import_selected_action)
+        self._command_actions[COMMAND_IMPORT_SELECTED] = import_selected_action
+
+        import_last_action = QAction(tr("Import &Last Asset"), self)
+        import_last_action.setStatusTip(tr("Import the asset used most recently again"))
+        import_last_action.triggered.connect(self._on_import_last)
+        assets_menu.addAction(import_last_action)
+        self._command_actions[COMMAND_IMPORT_LAST] = import_last_action
+
+        publish_selected_action = QAction(tr("&Publish Selected..."), self)
+        publish_selected_action.setStatusTip(tr("Publish the Maya selection as a new asset"))
+        publish_selected_action.triggered.connect(self._on_create_asset)
+        assets_menu.addAction(publish_selected_action)
+        self._command_actions[COMMAND_PUBLISH_SELECTED] = publish_selected_action
+
+        scratch_export_action = QAction(tr("Quick E&xport to Scratch"), self)
+        scratch_export_action.setStatusTip(
+            tr("Export the Maya selection to your scratch library, named automatically")
+        )
+        scratch_export_action.triggered.connect(self._on_quick_export_scratch)
+        assets_menu.addAction(scratch_export_action)
+        self._command_actions[COMMAND_SCRATCH_EXPORT] = scratch_export_action
+
+        open_scratch_action = QAction(tr("Open Scratc&h Library"), self)
+        open_scratch_action.setStatusTip(
+            tr("Browse your quick exports and promote the ones worth keeping")
+        )
+        open_scratch_action.triggered.connect(self._on_open_scratch_library)
+        assets_menu.addAction(open_scratch_action)
+
+        reference_selected_action = QAction(tr("Import as &Reference..."), self)
+        reference_selected_action.setShortcut(QKeySequence("Ctrl+Shift+R"))
+        reference_selected_action.setStatusTip(tr("Reference the selected asset with a namespace"))
+        reference_selected_action.triggered.connect(lambda: self._on_asset_reference())
+        assets_menu.addAction(reference_selected_action)
+
+        instance_selected_action = QAction(tr("Import as I&nstance"), self)
+        instance_selected_action.setStatusTip(
+            tr(
+                "Place another copy of the selected asset sharing the geometry already in the "
+                "scene"
+            )
+        )
+        instance_selected_action.triggered.connect(self._on_import_as_instance)
+        assets_menu.addAction(instance_selected_action)
+
+        convert_instances_action = QAction(tr("Convert Duplicates to Ins&tances..."), self)
+        convert_instances_action.setStatusTip(
+            tr("Replace repeated imports of an asset with instances of one copy")
+        )
+        convert_instances_action.triggered.connect(self._on_convert_to_instances)
+        assets_menu.addAction(convert_instances_action)
+
+        # Material conversion - imported shaders of another renderer become the scene's own
+        self._convert_materials_action = QAction(tr("Convert Materials to Scene Renderer"), self)
+        self._convert_materials_action.setCheckable(True)
+        self._convert_materials_action.setChecked(True)
+        self._convert_materials_action.setStatusTip(
+            tr("Translate imported Arnold, V-Ray, or Redshift materials to the active renderer")
+        )
+        assets_menu.addAction(self._convert_materials_action)
+
+        # Shot cameras - layout's approved camera becomes the viewport camera on load
+        self._look_through_camera_action = QAction(tr("Look Through Imported Cameras"), self)
+        self._look_through_camera_action.setCheckable(True)
+        self._look_through_camera_action.setChecked(True)
+        self._look_through_camera_action.setStatusTip(
+            tr("Make an imported or referenced shot camera the active viewport's camera")
+        )
+        assets_menu.addAction(self._look_through_camera_action)
+
+        # Name clash pre-check - imports whose node names are taken ask how to proceed
+        self._check_clashes_action = QAction(tr("Check Name Clashes Before Importing"), self)
+        self._check_clashes_action.setCheckable(True)
+        self._check_clashes_action.setChecked(True)
+        self._check_clashes_action.setStatusTip(
+            tr("Ask how to import assets whose node names are already used in the scene")
+        )
+        assets_menu.addAction(self._check_clashes_action)
+
+        namespace_options_action = QAction(tr("Import Namespace &Options..."), self)
+        namespace_options_action.setStatusTip(
+            tr("Strip, merge, or prefix the namespaces imported assets bring in")
+        )
+        namespace_options_action.triggered.connect(self._on_namespace_options)
+        assets_menu.addAction(namespace_options_action)
+
+        placement_options_action = QAction(tr("Import P&lacement Options..."), self)
+        placement_options_action.setStatusTip(
+            tr("Place imports at the origin, selection, or camera focus and group them")
+        )
+        placement_options_action.triggered.connect(self._on_placement_options)
+        assets_menu.addAction(placement_options_action)
+
+        remove_namespaces_action = QAction(tr("Remove Empty Namespaces"), self)
+        remove_namespaces_action.setStatusTip(
+            tr("Delete namespaces of the scene that hold no nodes")
+        )
+        remove_namespaces_action.triggered.connect(self._on_remove_empty_namespaces)
+        assets_menu.addAction(remove_namespaces_action)
+
+        replace_reference_action = QAction(tr("Re&place Reference..."), self)
+        replace_reference_action.setStatusTip(
+            tr("Swap a scene reference to the selected asset or one of its versions")
+        )
+        replace_reference_action.triggered.connect(lambda: self._on_replace_reference())
+        assets_menu.addAction(replace_reference_action)
 
-        add_to_favorites_action = QAction("Add to &Favorites", self)
+        add_to_favorites_action = QAction(tr("Add to &Favorites"), self)
         add_to_favorites_action.setShortcut(QKeySequence("Ctrl+D"))
         add_to_favorites_action.triggered.connect(self._on_add_to_favorites)
         assets_menu.addAction(add_to_favorites_action)
 
-        export_selected_action = QAction("&Export Selected...", self)
+        export_selected_action = QAction(tr("&Export Selected..."), self)
         export_selected_action.setShortcut(QKeySequence("Ctrl+E"))
-        export_selected_action.setStatusTip("Export selected asset(s) to a new location")
+        export_selected_action.setStatusTip(tr("Export selected asset(s) to a new location"))
         export_selected_action.triggered.connect(self._on_export_selected)
         assets_menu.addAction(export_selected_action)
 
+        version_history_action = QAction(tr("Version &History..."), self)
+        version_history_action.setShortcut(QKeySequence("Ctrl+H"))
+        version_history_action.setStatusTip(tr("Browse, import, or roll back published versions"))
+        version_history_action.triggered.connect(lambda: self._on_version_history())
+        assets_menu.addAction(version_history_action)
+
+        import_usd_stage_action = QAction(tr("Import as USD &Stage"), self)
+        import_usd_stage_action.setStatusTip(
+            tr("Load the selected USD asset as a live stage instead of converting it")
+        )
+        import_usd_stage_action.triggered.connect(self._on_import_as_usd_stage)
+        assets_menu.addAction(import_usd_stage_action)
+
+        assets_menu.addSeparator()
+
+        template_asset_action = QAction(tr("Create Asset From Tem&plate..."), self)
+        template_asset_action.setStatusTip(
+            tr("Start a new scene from a studio-approved asset template")
+        )
+        template_asset_action.triggered.connect(self._on_create_asset_from_template)
+        assets_menu.addAction(template_asset_action)
+
+        save_template_action = QAction(tr("Sa&ve Scene as Template..."), self)
+        save_template_action.setStatusTip(
+            tr("Save the scene's groups and render settings as an asset template")
+        )
+        save_template_action.triggered.connect(self._on_save_asset_template)
+        assets_menu.addAction(save_template_action)
+
+        publish_rig_action = QAction(tr("Publish &Rig..."), self)
+        publish_rig_action.setStatusTip(
+            tr("Publish the selected rig with its controller sets and picker layout")
+        )
+        publish_rig_action.triggered.connect(self._on_publish_rig)
+        assets_menu.addAction(publish_rig_action)
+
+        publish_camera_action = QAction(tr("Publish Ca&mera..."), self)
+        publish_camera_action.setStatusTip(
+            tr("Publish the selected shot camera with its lens, animation, and image planes")
+        )
+        publish_camera_action.triggered.connect(self._on_publish_camera)
+        assets_menu.addAction(publish_camera_action)
+
+        export_clip_action = QAction(tr("Export Animation &Clip..."), self)
+        export_clip_action.setStatusTip(tr("Save the selected rig's animation as a reusable clip"))
+        export_clip_action.triggered.connect(self._on_export_anim_clip)
+        assets_menu.addAction(export_clip_action)
+
+        apply_clip_action = QAction(tr("&Apply Animation Clip..."), self)
+        apply_clip_action.setStatusTip(tr("Apply the selected clip to a rig in the scene"))
+        apply_clip_action.triggered.connect(lambda: self._on_apply_anim_clip())
+        assets_menu.addAction(apply_clip_action)
+
+        save_pose_action = QAction(tr("Save P&ose..."), self)
+        save_pose_action.setStatusTip(tr("Save the selected controls' values as a pose"))
+        save_pose_action.triggered.connect(self._on_save_pose)
+        assets_menu.addAction(save_pose_action)
+
+        publish_blendshapes_action = QAction(tr("Publish &Blendshapes..."), self)
+        publish_blendshapes_action.setStatusTip(
+            tr("Publish the selected mesh's blendShape targets, or sculpts selected before it")
+        )
+        publish_blendshapes_action.triggered.connect(self._on_publish_blendshapes)
+        assets_menu.addAction(publish_blendshapes_action)
+
+        publish_skin_weights_action = QAction(tr("Publish S&kin Weights..."), self)
+        publish_skin_weights_action.setStatusTip(
+            tr("Publish the selected meshes' skin weights and influence lists")
+        )
+        publish_skin_weights_action.triggered.connect(self._on_publish_skin_weights)
+        assets_menu.addAction(publish_skin_weights_action)
+
+        save_material_action = QAction(tr("Save &Material..."), self)
+        save_material_action.setStatusTip(
+            tr("Save the selected shading network as a material preset")
+        )
+        save_material_action.triggered.connect(self._on_save_material)
+        assets_menu.addAction(save_material_action)
+
+        import_materialx_action = QAction(tr("Import Material&X..."), self)
+        import_materialx_action.setStatusTip(
+            tr("Build a Standard Surface network from a MaterialX document (Houdini, Mari)")
+        )
+        import_materialx_action.triggered.connect(lambda: self._on_import_materialx())
+        assets_menu.addAction(import_materialx_action)
+
+        save_light_rig_action = QAction(tr("Save &Light Rig..."), self)
+        save_light_rig_action.setStatusTip(tr("Save the selected scene lights as a light rig"))
+        save_light_rig_action.triggered.connect(self._on_save_light_rig)
+        assets_menu.addAction(save_light_rig_action)
+
+        publish_groom_action = QAction(tr("Publish &Groom..."), self)
+        publish_groom_action.setStatusTip(
+            tr("Publish the selected XGen or Yeti grooms with their meshes and maps")
+        )
+        publish_groom_action.triggered.connect(self._on_publish_groom)
+        assets_menu.addAction(publish_groom_action)
+
+        publish_volume_action = QAction(tr("Publish &Volume (VDB)..."), self)
+        publish_volume_action.setStatusTip(
+            tr("Publish an OpenVDB sequence from FX with its frame range and grid names")
+        )
+        publish_volume_action.triggered.connect(self._on_publish_volume)
+        assets_menu.addAction(publish_volume_action)
+
+        save_assembly_action = QAction(tr("Save Asse&mbly..."), self)
+        save_assembly_action.setStatusTip(
+            tr("Save the scene's library assets with their placements and versions as an assembly")
+        )
+        save_assembly_action.triggered.connect(self._on_save_assembly)
+        assets_menu.addAction(save_assembly_action)
+
+        publish_hdri_action = QAction(tr("Publish &HDRI Environment..."), self)
+        publish_hdri_action.setStatusTip(tr("Copy an HDRI image into the library as a dome light"))
+        publish_hdri_action.triggered.connect(self._on_publish_hdri)
+        assets_menu.addAction(publish_hdri_action)
+
+        publish_texture_set_action = QAction(tr("Publish Te&xture Set..."), self)
+        publish_texture_set_action.setStatusTip(
+            tr("Publish texture maps (UDIM tiles included) as one texture set")
+        )
+        publish_texture_set_action.triggered.connect(self._on_publish_texture_set)
+        assets_menu.addAction(publish_texture_set_action)
+
+        relink_textures_action = QAction(tr("Relink &Textures..."), self)
+        relink_textures_action.setStatusTip(
+            tr("Find missing texture files in the search roots and fix the rest by hand")
+        )
+        relink_textures_action.triggered.connect(self._on_relink_textures)
+        assets_menu.addAction(relink_textures_action)
+
+        batch_publish_action = QAction(tr("&Batch Publish Scene..."), self)
+        batch_publish_action.setStatusTip(
+            tr("Publish every selection set or top-level group of the scene as its own asset")
+        )
+        batch_publish_action.triggered.connect(self._on_batch_publish_scene)
+        assets_menu.addAction(batch_publish_action)
+
+        publish_lod_action = QAction(tr("Publish &LOD Variant..."), self)
+        publish_lod_action.setStatusTip(
+            tr("Publish the selection as another level of detail of the current asset")
+        )
+        publish_lod_action.triggered.connect(lambda: self._on_publish_lod_variant())
+        assets_menu.addAction(publish_lod_action)
+
+        swap_lods_action = QAction(tr("S&wap LODs..."), self)
+        swap_lods_action.setStatusTip(tr("Switch imported or referenced assets to another LOD"))
+        swap_lods_action.triggered.connect(self._on_swap_lods)
+        assets_menu.addAction(swap_lods_action)
+
+        import_proxy_action = QAction(tr("Import as Pro&xy"), self)
+        import_proxy_action.setStatusTip(
+            tr("Bring in the selected asset's GPU cache or bounding box proxy")
+        )
+        import_proxy_action.triggered.connect(lambda: self._on_import_as_proxy())
+        assets_menu.addAction(import_proxy_action)
+
+        import_standin_action = QAction(tr("Import as Arnold &Standin"), self)
+        import_standin_action.setStatusTip(
+            tr("Bring in an aiStandIn that loads the asset's published .ass or USD at render time")
+        )
+        import_standin_action.triggered.connect(lambda: self._on_import_as_proxy(PROXY_STANDIN))
+        assets_menu.addAction(import_standin_action)
+
+        swap_to_full_action = QAction(tr("Swap Proxies to &Full Geometry"), self)
+        swap_to_full_action.setStatusTip(
+            tr("Replace every proxy in the scene with its full asset")
+        )
+        swap_to_full_action.triggered.connect(lambda: self._on_swap_proxies(True))
+        assets_menu.addAction(swap_to_full_action)
+
+        restore_proxies_action = QAction(tr("Restore Pro&xies"), self)
+        restore_proxies_action.setStatusTip(tr("Put proxies back in place of swapped full assets"))
+        restore_proxies_action.triggered.connect(lambda: self._on_swap_proxies(False))
+        assets_menu.addAction(restore_proxies_action)
+
+        self._render_swap_action = QAction(tr("Swap Proxies at Render &Time"), self)
+        self._render_swap_action.setCheckable(True)
+        self._render_swap_action.setStatusTip(
+            tr("Render full geometry in place of proxies, including batch renders of this scene")
+        )
+        self._render_swap_action.triggered.connect(self._on_toggle_render_swap)
+        assets_menu.addAction(self._render_swap_action)
+        assets_menu.aboutToShow.connect(self._update_render_swap_action)
+
+        reference_updates_action = QAction(tr("Reference &Updates..."), self)
+        reference_updates_action.setStatusTip(
+            tr("Update scene references that have newer published versions")
+        )
+        reference_updates_action.triggered.connect(self._on_review_reference_updates)
+        assets_menu.addAction(reference_updates_action)
+
+        assets_menu.addSeparator()
+
+        validate_scene_action = QAction(tr("&Validate Scene..."), self)
+        validate_scene_action.setStatusTip(tr("Run the publish checks on the selection or scene"))
+        validate_scene_action.triggered.connect(self._on_validate_scene)
+        assets_menu.addAction(validate_scene_action)
+
+        validation_settings_action = QAction(tr("Validation &Settings..."), self)
+        validation_settings_action.setStatusTip(tr("Choose which publish checks block or warn"))
+        validation_settings_action.triggered.connect(self._on_validation_settings)
+        assets_menu.addAction(validation_settings_action)
+
+        shotgrid_settings_action = QAction(tr("Shot&Grid Settings..."), self)
+        shotgrid_settings_action.setStatusTip(tr("Register publishes as ShotGrid PublishedFiles"))
+        shotgrid_settings_action.triggered.connect(self._on_shotgrid_settings)
+        assets_menu.addAction(shotgrid_settings_action)
+
+        kitsu_settings_action = QAction(tr("&Kitsu Settings..."), self)
+        kitsu_settings_action.setStatusTip(tr("Post publishes to Kitsu tasks with a preview"))
+        kitsu_settings_action.triggered.connect(self._on_kitsu_settings)
+        assets_menu.addAction(kitsu_settings_action)
+
+        kitsu_tasks_action = QAction(tr("Kitsu &Tasks..."), self)
+        kitsu_tasks_action.setStatusTip(tr("Pick the Kitsu task your publishes are linked to"))
+        kitsu_tasks_action.triggered.connect(self._on_kitsu_tasks)
+        assets_menu.addAction(kitsu_tasks_action)
+
+        fbx_presets_action = QAction(tr("&FBX Export Presets..."), self)
+        fbx_presets_action.setStatusTip(tr("Choose which asset types also publish an engine FBX"))
+        fbx_presets_action.triggered.connect(self._on_fbx_presets)
+        assets_menu.addAction(fbx_presets_action)
+
+        gltf_settings_action = QAction(tr("&glTF Review Export..."), self)
+        gltf_settings_action.setStatusTip(
+            tr("Choose which asset types also publish a glTF for the web viewer and AR review")
+        )
+        gltf_settings_action.triggered.connect(self._on_gltf_settings)
+        assets_menu.addAction(gltf_settings_action)
+
+        thumbnail_settings_action = QAction(tr("T&humbnail Settings..."), self)
+        thumbnail_settings_action.setStatusTip(
+            tr("Choose the thumbnail camera, lighting, background, and resolution per asset type")
+        )
+        thumbnail_settings_action.triggered.connect(self._on_thumbnail_settings)
+        assets_menu.addAction(thumbnail_settings_action)
+
+        color_management_action = QAction(tr("Thumbnail C&olor Management..."), self)
+        color_management_action.setStatusTip(
+            tr("Choose the OCIO config and view transform thumbnails and previews render with")
+        )
+        color_management_action.triggered.connect(self._on_color_management)
+        assets_menu.addAction(color_management_action)
+
+        thumbnail_camera_action = QAction(tr("Set Thumbnail &Camera from Scene..."), self)
+        thumbnail_camera_action.setStatusTip(
+            tr("Render the current asset's thumbnail through a camera placed in the scene")
+        )
+        thumbnail_camera_action.triggered.connect(self._on_set_thumbnail_camera)
+        assets_menu.addAction(thumbnail_camera_action)
+
+        unreal_settings_action = QAction(tr("&Unreal Settings..."), self)
+        unreal_settings_action.setStatusTip(tr("Set the Unreal project publishes are sent to"))
+        unreal_settings_action.triggered.connect(self._on_unreal_settings)
+        assets_menu.addAction(unreal_settings_action)
+
+        naming_templates_action = QAction(tr("&Naming Templates..."), self)
+        naming_templates_action.setStatusTip(
+            tr("Set the folder and file names publishes must use")
+        )
+        naming_templates_action.triggered.connect(self._on_naming_templates)
+        assets_menu.addAction(naming_templates_action)
+
+        tag_rules_action = QAction(tr("Ta&g Rules..."), self)
+        tag_rules_action.setStatusTip(
+            tr("Tag publishes by name, node types, polycount, and folder")
+        )
+        tag_rules_action.triggered.connect(self._on_tag_rules)
+        assets_menu.addAction(tag_rules_action)
+
+        watch_folders_action = QAction(tr("&Watch Folders..."), self)
+        watch_folders_action.setStatusTip(
+            tr("Ingest OBJ, FBX, and image exports dropped in folders as assets")
+        )
+        watch_folders_action.triggered.connect(self._on_watch_folders)
+        assets_menu.addAction(watch_folders_action)
+
+        pipeline_hooks_action = QAction(tr("Pipeline &Hooks..."), self)
+        pipeline_hooks_action.setStatusTip(
+            tr("Show and reload studio publish and import callbacks")
+        )
+        pipeline_hooks_action.triggered.connect(self._on_pipeline_hooks)
+        assets_menu.addAction(pipeline_hooks_action)
+
+        library_permissions_action = QAction(tr("Library &Permissions..."), self)
+        library_permissions_action.setStatusTip(tr("Choose who can publish, approve, and delete"))
+        library_permissions_action.triggered.connect(self._on_library_permissions)
+        assets_menu.addAction(library_permissions_action)
+
+        vendor_license_action = QAction(tr("&Vendor License..."), self)
+        vendor_license_action.setStatusTip(
+            tr("Make the library a read-only vendor library and record its license")
+        )
+        vendor_license_action.triggered.connect(self._on_vendor_license)
+        assets_menu.addAction(vendor_license_action)
+
+        path_mapping_action = QAction(tr("Path M&apping..."), self)
+        path_mapping_action.setStatusTip(
+            tr("Set where the library is mounted on Windows, Linux, and macOS")
+        )
+        path_mapping_action.triggered.connect(self._on_path_mapping)
+        assets_menu.addAction(path_mapping_action)
+
+        assets_menu.addSeparator()
+
+        review_action = QAction(tr("&Review Asset..."), self)
+        review_action.setStatusTip(tr("Approve or deprecate the selected asset (reviewers)"))
+        review_action.triggered.connect(self._on_review_asset)
+        assets_menu.addAction(review_action)
+
+        self._check_out_action = QAction(tr("Check &Out"), self)
+        self._check_out_action.setStatusTip(
+            tr("Lock the selected asset so only you can publish it")
+        )
+        self._check_out_action.setEnabled(False)
+        self._check_out_action.triggered.connect(lambda: self._on_check_out())
+        assets_menu.addAction(self._check_out_action)
+
+        self._check_in_action = QAction(tr("Check &In"), self)
+        self._check_in_action.setStatusTip(tr("Release your lock on the selected asset"))
+        self._check_in_action.setEnabled(False)
+        self._check_in_action.triggered.connect(lambda: self._on_check_in())
+        assets_menu.addAction(self._check_in_action)
+
         # Add separator before destructive action
         assets_menu.addSeparator()
 
         # Remove Asset action - matches File menu functionality
-        remove_asset_action = QAction("&Remove Selected Asset...", self)
+        remove_asset_action = QAction(tr("&Remove Selected Asset..."), self)
         remove_asset_action.setShortcut(QKeySequence.StandardKey.Delete)
         remove_asset_action.triggered.connect(self._on_remove_selected_asset)
         assets_menu.addAction(remove_asset_action)
 
         # View menu
-        view_menu = menubar.addMenu("&View")
+        view_menu = menubar.addMenu(tr("&View"))
 
-        self._show_preview_action = QAction("Show &Preview", self)
+        self._show_preview_action = QAction(tr("Show &Preview"), self)
         self._show_preview_action.setCheckable(True)
         self._show_preview_action.setChecked(True)
         self._show_preview_action.triggered.connect(self._on_toggle_preview_unified)
         view_menu.addAction(self._show_preview_action)
 
         # Add missing "Show Asset Information" option
-        show_asset_info_action = QAction("&Asset Information", self)
+        show_asset_info_action = QAction(tr("&Asset Information"), self)
         show_asset_info_action.setCheckable(True)
         show_asset_info_action.setChecked(True)  # Initially visible
         show_asset_info_action.triggered.connect(self._on_toggle_asset_info_unified)
@@ -344,50 +1222,125 @@ class AssetManagerWindow(QMainWindow):
         self._show_asset_info_action = show_asset_info_action
         view_menu.addAction(show_asset_info_action)
 
+        self._show_scene_assets_action = QAction(tr("&Scene Assets"), self)
+        self._show_scene_assets_action.setCheckable(True)
+        self._show_scene_assets_action.setStatusTip(
+            tr("List the library assets in the open scene with their version and lock")
+        )
+        self._show_scene_assets_action.toggled.connect(self._on_toggle_scene_assets)
+        view_menu.addAction(self._show_scene_assets_action)
+
+        # Show context - artists on one show stop picking up another franchise's props
+        self._show_filter_action = QAction(tr("Limit to Current S&how"), self)
+        self._show_filter_action.setCheckable(True)
+        self._show_filter_action.setStatusTip(
+            tr("Hide assets not tagged for the show set by ASSETMANAGER_SHOW or the workspace")
+        )
+        self._show_filter_action.toggled.connect(self._apply_show_context)
+        view_menu.addAction(self._show_filter_action)
+
         view_menu.addSeparator()
 
         # Color Coding Manager
-        color_coding_action = QAction("&Color Coding Manager...", self)
+        color_coding_action = QAction(tr("&Color Coding Manager..."), self)
         color_coding_action.triggered.connect(self._on_color_coding_manager)
         view_menu.addAction(color_coding_action)
 
         # Tag Manager
-        tag_manager_action = QAction("&Tag Manager...", self)
+        tag_manager_action = QAction(tr("&Tag Manager..."), self)
         tag_manager_action.triggered.connect(self._on_tag_manager)
         view_menu.addAction(tag_manager_action)
 
+        view_menu.addSeparator()
+
+        # UI language - translation files in src/ui/translations, applied on the next start
+        language_menu = view_menu.addMenu(tr("&Language"))
+        language_group = QActionGroup(self)
+        localization_service = get_localization_service()
+        current_language = localization_service.get_language()
+        for locale, language in localization_service.get_available_languages():
+            language_action = QAction(language, self)
+            language_action.setCheckable(True)
+            language_action.setChecked(locale == current_language)
+            language_action.triggered.connect(
+                lambda _checked=False, code=locale: self._on_set_language(code)
+            )
+            language_group.addAction(language_action)
+            language_menu.addAction(language_action)
+
         # Collections menu - moved to separate menu for better UX
-        collections_menu = menubar.addMenu("&Collections")
+        collections_menu = menubar.addMenu(tr("&Collections"))
 
-        new_collection_action = QAction("&New Collection...", self)
+        new_collection_action = QAction(tr("&New Collection..."), self)
         new_collection_action.setShortcut(QKeySequence("Ctrl+N"))
         new_collection_action.triggered.connect(self._on_new_collection)
         collections_menu.addAction(new_collection_action)
 
-        manage_collections_action = QAction("&Manage Collections...", self)
+        new_smart_collection_action = QAction(tr("New &Smart Collection..."), self)
+        new_smart_collection_action.setStatusTip(
+            tr("Create a collection from a saved search query that updates as the library changes")
+        )
+        new_smart_collection_action.triggered.connect(lambda: self._on_new_collection(smart=True))
+        collections_menu.addAction(new_smart_collection_action)
+
+        manage_collections_action = QAction(tr("&Manage Collections..."), self)
         manage_collections_action.triggered.connect(self._on_manage_collections)
         collections_menu.addAction(manage_collections_action)
 
+        regenerate_collection_action = QAction(tr("Regenerate Collection &Thumbnails..."), self)
+        regenerate_collection_action.triggered.connect(self._on_regenerate_collection_thumbnails)
+        collections_menu.addAction(regenerate_collection_action)
+
+        contact_sheet_action = QAction(tr("Export &Contact Sheet..."), self)
+        contact_sheet_action.setStatusTip(
+            tr("Export a collection as a PDF or PNG thumbnail wall, and an HTML gallery")
+        )
+        contact_sheet_action.triggered.connect(self._on_export_contact_sheet)
+        collections_menu.addAction(contact_sheet_action)
+
         # USD Pipeline menu - NEW! v1.4.0
-        usd_menu = menubar.addMenu("&USD Pipeline")
+        usd_menu = menubar.addMenu(tr("&USD Pipeline"))
 
-        usd_open_creator_action = QAction("&Open Creator", self)
+        usd_open_creator_action = QAction(tr("&Open Creator"), self)
         usd_open_creator_action.setShortcut(QKeySequence("Ctrl+U"))
-        usd_open_creator_action.setStatusTip("Open USD Pipeline Creator for import and export")
+        usd_open_creator_action.setStatusTip(tr("Open USD Pipeline Creator for import and export"))
         usd_open_creator_action.triggered.connect(self._on_usd_pipeline)
         usd_menu.addAction(usd_open_creator_action)
 
+        # Quick round trips with a paired Houdini/Blender session, nothing published
+        usd_menu.addSeparator()
+        interchange_send_action = QAction(tr("&Send Selection to DCC"), self)
+        interchange_send_action.setStatusTip(
+            tr("Write the selection to a temporary USD layer and load it in the paired session")
+        )
+        interchange_send_action.triggered.connect(self._on_interchange_send)
+        usd_menu.addAction(interchange_send_action)
+
+        interchange_receive_action = QAction(tr("&Receive from DCC"), self)
+        interchange_receive_action.setStatusTip(
+            tr("Import a USD layer of the paired session's selection")
+        )
+        interchange_receive_action.triggered.connect(self._on_interchange_receive)
+        usd_menu.addAction(interchange_receive_action)
+
+        interchange_settings_action = QAction(tr("DCC &Interchange Settings..."), self)
+        interchange_settings_action.setStatusTip(
+            tr("Pair Maya with the Houdini or Blender session layers go to")
+        )
+        interchange_settings_action.triggered.connect(self._on_interchange_settings)
+        usd_menu.addAction(interchange_settings_action)
+
         # Help menu
-        help_menu = menubar.addMenu("&Help")
+        help_menu = menubar.addMenu(tr("&Help"))
 
         # Add missing "Check for Update"
-        check_update_action = QAction("&Check for Update...", self)
+        check_update_action = QAction(tr("&Check for Update..."), self)
         check_update_action.triggered.connect(self._on_check_update)
         help_menu.addAction(check_update_action)
 
         help_menu.addSeparator()
 
-        about_action = QAction("&About", self)
+        about_action = QAction(tr("&About"), self)
         about_action.triggered.connect(self._on_about)
         help_menu.addAction(about_action)
 
@@ -405,6 +1358,16 @@ class AssetManagerWindow(QMainWindow):
         toolbar = self._create_main_toolbar()
         main_layout.addWidget(toolbar)
 
+        # Outdated scene references - hidden until a newer version is published
+        from .widgets.reference_update_banner import ReferenceUpdateBanner
+
+        self._reference_update_banner = ReferenceUpdateBanner()
+        self._reference_update_banner.review_requested.connect(self._on_review_reference_updates)
+        self._reference_update_banner.update_all_requested.connect(
+            self._on_update_all_references
+        )
+        main_layout.addWidget(self._reference_update_banner)
+
         # Small separator between toolbar and main content
         main_layout.addSpacing(4)
 
@@ -453,41 +1416,41 @@ class AssetManagerWindow(QMainWindow):
         left_layout.setSpacing(8)
 
         # Panel title
-        title_label = QLabel("Asset Controls")
+        title_label = QLabel(tr("Asset Controls"))
         title_label.setStyleSheet(
             "font-weight: bold; font-size: 14px; color: #cccccc; padding: 4px;"
         )
         left_layout.addWidget(title_label)
 
         # Tag Management Section
-        tag_group = QGroupBox("Tag Management")
+        tag_group = QGroupBox(tr("Tag Management"))
         tag_group.setStyleSheet("QGroupBox { font-weight: bold; color: #cccccc; }")
         tag_layout = QVBoxLayout(tag_group)
 
         # Create tag button
-        create_tag_btn = QPushButton("Create New Tag")
+        create_tag_btn = QPushButton(tr("Create New Tag"))
         create_tag_btn.clicked.connect(self._on_create_tag)
         tag_layout.addWidget(create_tag_btn)
 
         # Tag manager button
-        tag_manager_btn = QPushButton("Tag Manager...")
+        tag_manager_btn = QPushButton(tr("Tag Manager..."))
         tag_manager_btn.clicked.connect(self._on_tag_manager)
         tag_layout.addWidget(tag_manager_btn)
 
         left_layout.addWidget(tag_group)
 
         # Collections Section
-        collections_group = QGroupBox("Collections")
+        collections_group = QGroupBox(tr("Collections"))
         collections_group.setStyleSheet("QGroupBox { font-weight: bold; color: #cccccc; }")
         collections_layout = QVBoxLayout(collections_group)
 
         # Create collection button
-        create_collection_btn = QPushButton("Create Collection")
+        create_collection_btn = QPushButton(tr("Create Collection"))
         create_collection_btn.clicked.connect(self._on_new_collection)
         collections_layout.addWidget(create_collection_btn)
 
         # Manage collections button
-        manage_collections_btn = QPushButton("Manage Collections...")
+        manage_collections_btn = QPushButton(tr("Manage Collections..."))
         manage_collections_btn.clicked.connect(self._on_manage_collections)
         collections_layout.addWidget(manage_collections_btn)
 
@@ -515,7 +1478,7 @@ class AssetManagerWindow(QMainWindow):
         center_layout.setSpacing(4)
 
         # Panel title
-        title_label = QLabel("Asset Library")
+        title_label = QLabel(tr("Asset Library"))
         title_label.setStyleSheet(
             "font-weight: bold; font-size: 14px; color: #cccccc; padding: 4px;"
         )
@@ -526,6 +1489,30 @@ class AssetManagerWindow(QMainWindow):
         self._library_widget.asset_selected.connect(self._on_asset_selected)
         self._library_widget.asset_double_clicked.connect(self._on_asset_import)
         self._library_widget.asset_info_requested.connect(self._on_asset_info_requested)
+        self._library_widget.version_history_requested.connect(self._on_version_history)
+        self._library_widget.check_out_requested.connect(self._on_check_out)
+        self._library_widget.depot_sync_requested.connect(self._on_depot_sync)
+        self._library_widget.depot_pin_requested.connect(self._on_depot_pin)
+        self._library_widget.check_in_requested.connect(self._on_check_in)
+        self._library_widget.reference_requested.connect(self._on_asset_reference)
+        self._library_widget.replace_reference_requested.connect(self._on_replace_reference)
+        self._library_widget.update_in_place_requested.connect(self._on_update_in_place)
+        self._library_widget.promote_requested.connect(self._on_promote_scratch_asset)
+        self._library_widget.pose_apply_requested.connect(self._on_quick_apply_pose)
+        self._library_widget.material_assign_requested.connect(self._on_assign_material)
+        self._library_widget.materialx_export_requested.connect(self._on_export_materialx)
+        self._library_widget.gltf_export_requested.connect(self._on_export_gltf)
+        self._library_widget.light_rig_import_requested.connect(self._on_import_light_rig)
+        self._library_widget.texture_set_apply_requested.connect(self._on_apply_texture_set)
+        self._library_widget.assembly_import_requested.connect(self._on_import_assembly)
+        self._library_widget.assembly_update_requested.connect(self._on_update_assembly)
+        self._library_widget.alembic_import_requested.connect(self._on_import_alembic)
+        self._library_widget.volume_import_requested.connect(self._on_import_volume)
+        self._library_widget.collections_changed.connect(self._on_collections_changed)
+        self._library_widget.thumbnails_requested.connect(
+            lambda assets: self._queue_thumbnail_regeneration(assets, "the selected assets")
+        )
+        self._library_widget.bundle_export_requested.connect(self._on_export_bundle)
         # Connect selection to metadata display update
         self._library_widget.asset_selected.connect(self._update_asset_info_display)
         # Connect color scheme changes to update keychart
@@ -533,7 +1520,22 @@ class AssetManagerWindow(QMainWindow):
             self._library_widget.color_scheme_changed.connect(
                 self._on_library_color_scheme_changed
             )
-        center_layout.addWidget(self._library_widget, 1)  # Take most space
+        # Scene Assets panel below the library - hidden until shown from the View menu
+        from .widgets.scene_assets_widget import SceneAssetsWidget
+
+        center_splitter = QSplitter(Qt.Orientation.Vertical)
+        center_splitter.addWidget(self._library_widget)
+        self._scene_assets_widget = SceneAssetsWidget(
+            self._scene_asset_service, self._missing_asset_service
+        )
+        self._scene_assets_widget.show_in_library_requested.connect(self._on_show_in_library)
+        self._scene_assets_widget.replace_requested.connect(self._on_replace_scene_asset)
+        self._scene_assets_widget.status_message.connect(self._set_status)
+        self._scene_assets_widget.setVisible(False)
+        center_splitter.addWidget(self._scene_assets_widget)
+        center_splitter.setStretchFactor(0, 1)
+        center_splitter.setSizes([500, 200])
+        center_layout.addWidget(center_splitter, 1)  # Take most space
 
         # Initialize color keychart with current library colors
         if self._color_keychart and self._library_widget:
@@ -553,7 +1555,7 @@ class AssetManagerWindow(QMainWindow):
         preview_layout.setSpacing(4)
 
         # Panel title
-        title_label = QLabel("Asset Preview")
+        title_label = QLabel(tr("Asset Preview"))
         title_label.setStyleSheet(
             "font-weight: bold; font-size: 14px; color: #cccccc; padding: 4px;"
         )
@@ -573,15 +1575,47 @@ class AssetManagerWindow(QMainWindow):
         metadata_layout.setSpacing(4)
 
         # Panel title
-        title_label = QLabel("Asset Information")
+        title_label = QLabel(tr("Asset Information"))
         title_label.setStyleSheet(
             "font-weight: bold; font-size: 14px; color: #cccccc; padding: 4px;"
         )
         metadata_layout.addWidget(title_label)
 
         # Asset metadata display - Enhanced widget with larger font and scroll wheel control
+        self._info_tabs = QTabWidget()
         self._metadata_widget = EnhancedAssetInfoWidget(self)
-        metadata_layout.addWidget(self._metadata_widget, 1)
+        self._info_tabs.addTab(self._metadata_widget, tr("Details"))
+
+        # Review note threads of the selected asset
+        from .widgets.asset_comments_widget import AssetCommentsWidget
+
+        self._comments_widget = AssetCommentsWidget(self._comment_service)
+        self._comments_widget.status_message.connect(self._set_status)
+        self._comments_widget.open_threads_changed.connect(self._on_open_threads_changed)
+        self._info_tabs.addTab(self._comments_widget, tr("Comments"))
+
+        # Groups and meshes of the selected asset, to import only some of them
+        from ..services.part_import_service_impl import get_part_import_service
+        from .widgets.asset_parts_widget import AssetPartsWidget
+
+        self._parts_widget = AssetPartsWidget(get_part_import_service())
+        self._parts_widget.status_message.connect(self._set_status)
+        self._parts_widget.import_requested.connect(self._on_import_asset_parts)
+        self._info_tabs.addTab(self._parts_widget, tr("Parts"))
+        self._info_tabs.currentChanged.connect(
+            lambda _index: self._parts_widget.set_visible_tab(
+                self._info_tabs.currentWidget() is self._parts_widget
+            )
+        )
+        metadata_layout.addWidget(self._info_tabs, 1)
+
+        # Library schema fields (Approved by, Polycount budget...) - hidden without a schema
+        from .widgets.custom_fields_widget import CustomFieldsWidget
+
+        self._custom_fields_widget = CustomFieldsWidget()
+        self._custom_fields_widget.field_changed.connect(self._on_custom_field_changed)
+        self._custom_fields_asset: Optional[Asset] = None
+        metadata_layout.addWidget(self._custom_fields_widget)
 
         return metadata_widget
 
@@ -660,21 +1694,32 @@ class AssetManagerWindow(QMainWindow):
         # Set increased height for better visibility and button fit
         toolbar.setFixedHeight(56)  # Increased toolbar height for better button centering
 
+        # Library picker - registered and project-mounted libraries
+        toolbar_layout.addWidget(QLabel(tr("Library:")))
+        self._library_combo = QComboBox()
+        self._library_combo.setMinimumWidth(180)
+        self._library_combo.setToolTip(tr("Switch between registered and project libraries"))
+        self._library_combo.currentIndexChanged.connect(self._on_library_picked)
+        toolbar_layout.addWidget(self._library_combo)
+        self._refresh_library_picker()
+
+        toolbar_layout.addWidget(self._create_toolbar_separator())
+
         # Create Asset button (FIRST in new order)
-        create_btn = QPushButton("Create Asset")
-        create_btn.setToolTip("Create new asset from current scene")
+        create_btn = QPushButton(tr("Create Asset"))
+        create_btn.setToolTip(tr("Create new asset from current scene"))
         create_btn.clicked.connect(self._on_create_asset)
         toolbar_layout.addWidget(create_btn)
 
         # Import Asset button (SECOND in new order)
-        import_btn = QPushButton("Import Asset")
-        import_btn.setToolTip("Import selected asset into scene")
+        import_btn = QPushButton(tr("Import Asset"))
+        import_btn.setToolTip(tr("Import selected asset into scene"))
         import_btn.clicked.connect(self._on_import_selected)
         toolbar_layout.addWidget(import_btn)
 
         # Remove Asset button (THIRD in new order)
-        remove_btn = QPushButton("Remove Asset")
-        remove_btn.setToolTip("Remove selected asset from library")
+        remove_btn = QPushButton(tr("Remove Asset"))
+        remove_btn.setToolTip(tr("Remove selected asset from library"))
         remove_btn.clicked.connect(self._on_remove_selected_asset)
         toolbar_layout.addWidget(remove_btn)
 
@@ -683,14 +1728,14 @@ class AssetManagerWindow(QMainWindow):
         toolbar_layout.addWidget(separator1)
 
         # Refresh Library button (FOURTH in new order)
-        refresh_btn = QPushButton("Refresh Library")
-        refresh_btn.setToolTip("Refresh Asset Library and Reload All Assets from Project")
-        refresh_btn.clicked.connect(self._on_refresh_library)
+        refresh_btn = QPushButton(tr("Refresh Library"))
+        refresh_btn.setToolTip(tr("Refresh Asset Library and Reload All Assets from Project"))
+        refresh_btn.clicked.connect(lambda: self._on_refresh_library(full_scan=True))
         toolbar_layout.addWidget(refresh_btn)
 
         # Reset Icons button (NEW - next to Refresh Library)
-        reset_icons_btn = QPushButton("Reset Icons")
-        reset_icons_btn.setToolTip("Resets Library icons to the default size")
+        reset_icons_btn = QPushButton(tr("Reset Icons"))
+        reset_icons_btn.setToolTip(tr("Resets Library icons to the default size"))
         reset_icons_btn.clicked.connect(self._on_reset_icon_size)
         toolbar_layout.addWidget(reset_icons_btn)
 
@@ -700,20 +1745,20 @@ class AssetManagerWindow(QMainWindow):
 
         # Preview toggle button (FIFTH in new order) - increased width
         self._preview_btn = QPushButton(
-            "Hide Preview"
+            tr("Hide Preview")
         )  # Initially shows "Hide" since preview is visible
         self._preview_btn.setCheckable(True)
         self._preview_btn.setChecked(True)
-        self._preview_btn.setToolTip("Toggle preview panel")
+        self._preview_btn.setToolTip(tr("Toggle preview panel"))
         self._preview_btn.setMinimumWidth(120)  # Increased width for bold text legibility
         self._preview_btn.clicked.connect(self._on_toggle_preview_unified)
         toolbar_layout.addWidget(self._preview_btn)
 
         # Info button (SIXTH in new order) - increased width and matching color
-        self._info_btn = QPushButton("Hide Info")
+        self._info_btn = QPushButton(tr("Hide Info"))
         self._info_btn.setCheckable(True)
         self._info_btn.setChecked(True)  # Initially checked since panel is visible
-        self._info_btn.setToolTip("Toggle asset information panel")
+        self._info_btn.setToolTip(tr("Toggle asset information panel"))
         self._info_btn.setMinimumWidth(120)  # Increased width for bold text legibility
         self._info_btn.clicked.connect(self._on_toggle_asset_info_unified)
         toolbar_layout.addWidget(self._info_btn)
@@ -740,7 +1785,7 @@ class AssetManagerWindow(QMainWindow):
         status_bar = self.statusBar()
 
         # Status label
-        self._status_label = QLabel("Ready")
+        self._status_label = QLabel(tr("Ready"))
         status_bar.addWidget(self._status_label)
 
         # Progress bar
@@ -749,8 +1794,24 @@ class AssetManagerWindow(QMainWindow):
         self._progress_bar.setMaximumWidth(200)
         status_bar.addPermanentWidget(self._progress_bar)
 
+        # Unread @mentions, hidden while there are none
+        self._mentions_btn = QPushButton("")
+        self._mentions_btn.setFlat(True)
+        self._mentions_btn.setToolTip(tr("Review notes that mention you"))
+        self._mentions_btn.clicked.connect(self._on_show_mentions)
+        self._mentions_btn.setVisible(False)
+        status_bar.addPermanentWidget(self._mentions_btn)
+
+        # Who publishes, locks, and roles are recorded as; click to sign in or switch artist
+        self._identity_btn = QPushButton("")
+        self._identity_btn.setFlat(True)
+        self._identity_btn.setToolTip(tr("Sign in or switch artist"))
+        self._identity_btn.clicked.connect(self._on_sign_in)
+        status_bar.addPermanentWidget(self._identity_btn)
+        self._update_identity_status()
+
         # Asset count label
-        self._asset_count_label = QLabel("0 assets")
+        self._asset_count_label = QLabel(tr("0 assets"))
         status_bar.addPermanentWidget(self._asset_count_label)
 
     def _setup_event_subscriptions(self) -> None:
@@ -774,9 +1835,38 @@ class AssetManagerWindow(QMainWindow):
         select_all_action.triggered.connect(self._on_select_all)
         self.addAction(select_all_action)
 
+        self._apply_command_shortcuts()
+
+    def _apply_command_shortcuts(self) -> None:
+        """Give the manager command actions the artist's shortcuts - Single Responsibility"""
+        for command_id, shortcut in self._hotkey_service.get_shortcuts().items():
+            action = self._command_actions.get(command_id)
+            if action is not None:
+                action.setShortcut(QKeySequence(shortcut))
+
+    def _get_reserved_shortcuts(self) -> Dict[str, str]:
+        """Get the fixed shortcuts of window actions that are not manager commands"""
+        command_actions = list(self._command_actions.values())
+        reserved = {}
+        for action in self.findChildren(QAction):
+            shortcut = action.shortcut().toString(QKeySequence.SequenceFormat.PortableText)
+            if shortcut and action not in command_actions:
+                reserved[shortcut] = action.text().replace("&", "") or shortcut
+        return reserved
+
+    def run_manager_command(self, command_id: str) -> None:
+        """Run a manager command from a Maya hotkey or the marking menu"""
+        if command_id == COMMAND_OPEN_BROWSER:
+            return  # Showing the window was the command
+        action = self._command_actions.get(command_id)
+        if action is None:
+            print(f"[WARNING] Unknown Asset Manager command: {command_id}")
+            return
+        action.trigger()
+
     def _load_initial_data(self) -> None:
         """Load initial data asynchronously - Non-blocking initialization"""
-        self._set_status("Loading assets...", show_progress=True)
+        self._set_status(tr("Loading assets..."), show_progress=True)
 
         # Load data in background thread
         def load_data():
@@ -790,7 +1880,7 @@ class AssetManagerWindow(QMainWindow):
                     self._library_widget.set_recent_assets(recent_assets)
                     self._library_widget.set_favorite_assets(favorites)
 
-                self._set_status("Ready")
+                self._set_status(tr("Ready"))
 
             except Exception as e:
                 self._set_status(f"Error loading data: {e}")
@@ -843,6 +1933,8 @@ class AssetManagerWindow(QMainWindow):
 
     def _on_asset_import(self, asset: Asset) -> None:
         """Handle asset import request - Enhanced error handling"""
+        if not self._check_permission(ACTION_IMPORT) or not self._confirm_deprecated_use(asset):
+            return
         asset_name = asset.display_name if hasattr(asset, "display_name") else "Unknown"
         print(f"[IMPORT] Import request received for: {asset_name}")
         print(f"[IMPORT] Asset type: {type(asset)}")
@@ -850,17 +1942,85 @@ class AssetManagerWindow(QMainWindow):
             f"[IMPORT] Asset path: {asset.file_path if hasattr(asset, 'file_path') else 'No path'}"
         )
 
+        from ..services.anim_clip_service_impl import ANIM_CLIP_EXTENSION
+        from ..services.assembly_service_impl import ASSEMBLY_EXTENSION
+        from ..services.blendshape_service_impl import BLENDSHAPE_EXTENSION
+        from ..services.groom_service_impl import GROOM_EXTENSION
+        from ..services.light_rig_service_impl import LIGHT_RIG_EXTENSION
+        from ..services.material_service_impl import MATERIAL_EXTENSION
+        from ..services.materialx_service_impl import MATERIALX_EXTENSION
+        from ..services.pose_service_impl import POSE_EXTENSION
+        from ..services.skin_weights_service_impl import SKIN_WEIGHTS_EXTENSION
+        from ..services.texture_set_service_impl import TEXTURE_SET_EXTENSION
+        from ..services.volume_service_impl import VOLUME_EXTENSION
+
+        # Depot libraries: get head (or the pinned changelist) before reading the file
+        self._sync_asset_from_depot(asset)
+
+        # Assemblies rebuild their layout of other assets, between their own hooks
+        suffix = asset.file_path.suffix.lower()
+        if suffix == ASSEMBLY_EXTENSION:
+            self._on_import_assembly(asset)
+            return
+        # Clips, poses, blendshapes, skin weights, materials, light rigs, and texture sets
+        # are applied; grooms are bound to the scene meshes they grow from, and volumes
+        # load as a volume node reading their .vdb sequence
+        loaders = {
+            ANIM_CLIP_EXTENSION: ("apply", lambda: self._on_apply_anim_clip(asset)),
+            POSE_EXTENSION: ("apply", lambda: self._on_apply_pose(asset)),
+            BLENDSHAPE_EXTENSION: ("apply", lambda: self._on_apply_blendshapes(asset)),
+            SKIN_WEIGHTS_EXTENSION: ("apply", lambda: self._on_apply_skin_weights(asset)),
+            MATERIAL_EXTENSION: ("apply", lambda: self._on_assign_material(asset, True)),
+            MATERIALX_EXTENSION: ("apply", lambda: self._on_import_materialx(asset.file_path)),
+            LIGHT_RIG_EXTENSION: ("apply", lambda: self._on_import_light_rig(asset)),
+            TEXTURE_SET_EXTENSION: ("apply", lambda: self._on_apply_texture_set(asset)),
+            GROOM_EXTENSION: ("import", lambda: self._on_import_groom(asset)),
+            VOLUME_EXTENSION: ("import", lambda: self._on_import_volume(asset)),
+        }
+        if suffix in loaders:
+            mode, load = loaders[suffix]
+            self._run_with_import_hooks(asset, mode, load)
+            return
+
+        lod_level = None
+        if suffix in (".ma", ".mb"):
+            from ..services.lod_service_impl import get_lod_service
+
+            variants = get_lod_service().get_variants(asset.file_path)
+            if len(variants) > 1:
+                lod_level = self._choose_lod_level(asset, variants, "Import")
+                if lod_level is None:
+                    return
+
+        conflict = self._resolve_import_conflicts(asset, lod_level)
+        if conflict is None:
+            return
+
+        hook_context = {
+            "asset_file": asset.file_path,
+            "asset_name": asset.display_name,
+            "mode": "import",
+            "lod_level": lod_level,
+        }
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return
+
         try:
             # Try Maya import with fallback approach
-            success = self._import_asset_to_maya(asset)
+            success = self._import_tracked_asset(asset, lod_level, conflict)
 
             if success:
                 self._set_status(f"Imported: {asset.display_name}")
+                self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
                 self.asset_imported.emit(asset)
                 self._event_publisher.publish(EventType.ASSET_IMPORTED, {"asset": asset})
 
                 # Update access time for recent assets
                 self._repository.update_access_time(asset)
+                database = self._get_metadata_database()
+                if database is not None:
+                    database.record_access(asset.file_path)
+                self._cache_for_offline(asset)
 
                 # NOW that asset is in Maya scene, extract full metadata and generate thumbnails
                 print(
@@ -884,1429 +2044,7569 @@ class AssetManagerWindow(QMainWindow):
             print(error_msg)  # Log to console
             self._set_status(error_msg)
             QMessageBox.warning(
-                self, "Import Error", f"Failed to import {asset.display_name}:\n{str(e)}"
+                self, tr("Import Error"), f"Failed to import {asset.display_name}:\n{str(e)}"
             )
 
-    def _import_asset_to_maya(self, asset: Asset) -> bool:
-        """Import asset to Maya with proper error handling - Single Responsibility"""
-        try:
-            import maya.cmds as cmds  # type: ignore
+    def _on_viewport_drop(
+        self,
+        file_path: Path,
+        mode: str,
+        position: Optional[Tuple[float, float, float]],
+        source_mode: str = DROP_MODE_IMPORT,
+    ) -> None:
+        """Import, reference, or instance an asset dropped on a Maya viewport"""
+        if not self._check_permission(ACTION_IMPORT):
+            return
+        library_assets = getattr(self._library_widget, "_current_assets", []) or []
+        asset = next((a for a in library_assets if a.file_path == file_path), None)
+        if asset is None:
+            print(f"[WARNING] Dropped file is not in the loaded library: {file_path}")
+            return
 
-            # SAFE FILE PATH VALIDATION - Defensive Programming
-            if not self._validate_asset_file_path(asset):
-                return False
+        from ..services.anim_clip_service_impl import ANIM_CLIP_EXTENSION
+        from ..services.assembly_service_impl import ASSEMBLY_EXTENSION
+        from ..services.blendshape_service_impl import BLENDSHAPE_EXTENSION
+        from ..services.groom_service_impl import GROOM_EXTENSION
+        from ..services.light_rig_service_impl import LIGHT_RIG_EXTENSION
+        from ..services.material_service_impl import MATERIAL_EXTENSION
+        from ..services.materialx_service_impl import MATERIALX_EXTENSION
+        from ..services.pose_service_impl import POSE_EXTENSION
+        from ..services.skin_weights_service_impl import SKIN_WEIGHTS_EXTENSION
+        from ..services.texture_set_service_impl import TEXTURE_SET_EXTENSION
+        from ..services.volume_service_impl import VOLUME_EXTENSION
+
+        # Clips, poses, materials, and rigs have no position; apply them as on double-click
+        # Assemblies keep the placements they were saved with, grooms follow their meshes
+        applied_extensions = (
+            ANIM_CLIP_EXTENSION,
+            ASSEMBLY_EXTENSION,
+            GROOM_EXTENSION,
+            POSE_EXTENSION,
+            BLENDSHAPE_EXTENSION,
+            SKIN_WEIGHTS_EXTENSION,
+            MATERIAL_EXTENSION,
+            MATERIALX_EXTENSION,
+            LIGHT_RIG_EXTENSION,
+            TEXTURE_SET_EXTENSION,
+            VOLUME_EXTENSION,
+        )
+        if file_path.suffix.lower() in applied_extensions:
+            self._on_asset_import(asset)
+            return
+        if not self._confirm_deprecated_use(asset):
+            return
 
-            file_path = str(asset.file_path)
-            file_ext = asset.file_path.suffix.lower()
+        import maya.cmds as cmds  # type: ignore
 
-            # Handle different file types
-            if file_ext in [".ma", ".mb"]:
-                # Maya scene files
-                cmds.file(
-                    file_path, i=True, type="mayaAscii" if file_ext == ".ma" else "mayaBinary"
-                )
-                return True
-            elif file_ext in [".obj"]:
-                # OBJ files
-                cmds.file(file_path, i=True, type="OBJ")
-                return True
-            elif file_ext in [".fbx"]:
-                # FBX files
-                if cmds.pluginInfo("fbxmaya", query=True, loaded=True) or cmds.loadPlugin(
-                    "fbxmaya", quiet=True
-                ):
-                    cmds.file(file_path, i=True, type="FBX")
-                    return True
-                else:
-                    raise RuntimeError("FBX plugin not available")
-            elif file_ext in [".abc"]:
-                # Alembic files
-                if cmds.pluginInfo("AbcImport", query=True, loaded=True) or cmds.loadPlugin(
-                    "AbcImport", quiet=True
-                ):
-                    cmds.AbcImport(file_path)
-                    return True
-                else:
-                    raise RuntimeError("Alembic plugin not available")
-            elif file_ext in [".usd", ".usda", ".usdc", ".usdz"]:
-                # USD files - Use our custom import service for skinCluster reconstruction
-                try:
-                    from ..services.maya_integration_impl import MayaIntegrationImpl
+        from ..services.maya_integration_impl import REFERENCE_FILE_TYPES, MayaIntegrationImpl
 
-                    maya_integration = MayaIntegrationImpl()
-                    return maya_integration.import_asset(asset)
-                except Exception as usd_error:
-                    # Fallback error message
-                    raise RuntimeError(f"USD import failed: {usd_error}") from usd_error
-            else:
-                raise ValueError(f"Unsupported file type: {file_ext}")
+        self._sync_asset_from_depot(asset)
 
-        except Exception as e:
-            print(f"Maya import failed: {e}")
-            return False
+        # References and instances get a namespace, only imports can clash
+        conflict: Tuple[str, Optional[ImportConflictReport]] = ("", None)
+        if mode == DROP_MODE_IMPORT:
+            conflict = self._resolve_import_conflicts(asset)
+            if conflict is None:
+                return
 
-    def _validate_asset_file_path(self, asset: Asset) -> bool:
-        """
-        Validate asset file path before Maya operations - Defensive Programming
-        Prevents QUrl crashes from invalid/null paths
-        Single Responsibility: File path validation only
-        """
-        try:
-            # Check if asset exists
-            if not asset:
-                print("[ERROR] Asset validation failed: No asset provided")
-                return False
+        hook_context = {"asset_file": file_path, "asset_name": asset.display_name, "mode": mode}
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return
 
-            # Check if file_path attribute exists
-            if not hasattr(asset, "file_path"):
-                print("[ERROR] Asset validation failed: No file_path attribute")
-                return False
+        def load(load_mode: str) -> bool:
+            if load_mode == DROP_MODE_REFERENCE:
+                if file_path.suffix.lower() in REFERENCE_FILE_TYPES:
+                    return MayaIntegrationImpl().reference_asset(asset)
+                print(f"[INFO] {file_path.suffix} files cannot be referenced, importing instead")
+            # The drop point places the asset, not the import placement options
+            return self._import_asset_to_maya(asset, place=False, conflict=conflict)
 
-            # Check if file_path is not None/empty
-            if not asset.file_path:
-                print("[ERROR] Asset validation failed: Empty file path")
-                return False
+        try:
+            with self._undo_chunk(cmds, f"Place {asset.name}") as chunk:
+                root = self._viewport_drop_service.place_asset(
+                    cmds, file_path, mode, position, load, source_mode
+                )
+                if root is None:
+                    chunk.abort()
+        except Exception as e:
+            self._set_status(f"Drop failed: {e}")
+            QMessageBox.warning(
+                self, tr("Drop Error"), f"Failed to place {asset.display_name}:\n{str(e)}"
+            )
+            return
 
-            # Convert to Path object for validation
-            file_path = Path(asset.file_path)
+        if root is None:
+            self._set_status(f"Failed to place: {asset.display_name}")
+            return
 
-            # Check if path is absolute and exists
-            if not file_path.is_absolute():
-                print(f"[ERROR] Asset validation failed: Relative path not allowed: {file_path}")
-                return False
+        try:
+            self._scene_asset_service.tag_imported(
+                cmds, [root], file_path, self._get_library_root()
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not record provenance of dropped {file_path.name}: {e}")
+        if mode != DROP_MODE_INSTANCE:
+            self._setup_imported_rig(cmds, asset, [root])
+            self._setup_imported_camera(cmds, asset, [root])
+        if mode == DROP_MODE_IMPORT:
+            self._convert_imported_materials(cmds, asset, [root])
+        self._refresh_scene_assets()
+        placed_at = " at drop point" if position is not None else ""
+        self._set_status(f"Placed {asset.display_name} ({mode}){placed_at}")
+        self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
+        self.asset_imported.emit(asset)
+        self._event_publisher.publish(EventType.ASSET_IMPORTED, {"asset": asset})
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+
+    def _on_import_as_instance(self) -> None:
+        """Place the selected asset as an instance; the first copy is referenced"""
+        asset = self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to instance.")
+            )
+            return
+        try:
+            import maya.cmds  # type: ignore  # noqa: F401
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Importing instances needs Maya."))
+            return
+        self._on_viewport_drop(
+            asset.file_path, DROP_MODE_INSTANCE, None, source_mode=DROP_MODE_REFERENCE
+        )
 
-            if not file_path.exists():
-                print(f"[ERROR] Asset validation failed: File does not exist: {file_path}")
-                return False
+    def _on_convert_to_instances(self) -> None:
+        """Replace repeated imports of an asset with instances of one copy"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Converting to instances needs Maya.")
+            )
+            return
 
-            if not file_path.is_file():
-                print(f"[ERROR] Asset validation failed: Path is not a file: {file_path}")
-                return False
+        try:
+            from ..services.instance_service_impl import get_instance_service
 
-            # Check file extension
-            if not file_path.suffix:
-                print(f"[ERROR] Asset validation failed: No file extension: {file_path}")
-                return False
+            instance_service = get_instance_service()
+            groups = instance_service.find_duplicates(cmds)
+            if not groups:
+                QMessageBox.information(
+                    self,
+                    tr("No Duplicates"),
+                    tr("No imported asset appears more than once with unchanged geometry."),
+                )
+                return
 
-            print(f"[OK] Asset file path validated: {file_path.name}")
-            return True
+            listed = "\n".join(f"  {group.label}" for group in groups)
+            reply = QMessageBox.question(
+                self,
+                tr("Convert to Instances"),
+                f"Replace these copies with instances of the first one?\n\n{listed}\n\n"
+                "Instances share geometry and shading, so editing one changes them all.",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
 
+            count = sum(
+                len(instance_service.convert_to_instances(cmds, group)) for group in groups
+            )
+            self._refresh_scene_assets()
+            self._set_status(f"Converted {count} duplicate(s) to instances")
         except Exception as e:
-            print(f"[ERROR] Asset validation error: {e}")
-            return False
+            QMessageBox.critical(self, tr("Error"), f"Failed to convert duplicates:\n{e}")
 
-    def _on_new_project(self) -> None:
-        """Handle new project creation - Enhanced with functional dialog"""
-        try:
-            # Use built-in dialogs for robust functionality
-            from PySide6.QtWidgets import QFileDialog, QInputDialog
+    def _on_namespace_options(self) -> None:
+        """Choose what imports do with the namespaces they bring"""
+        from ..services.namespace_service_impl import get_namespace_service
+        from .dialogs.import_namespace_dialog import ImportNamespaceDialog
 
-            # Get project name
-            project_name, ok = QInputDialog.getText(
-                self, "New Project", "Project name:", text="MyProject"
+        namespace_service = get_namespace_service()
+        dialog = ImportNamespaceDialog(namespace_service.load_options(), self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+        if namespace_service.save_options(options):
+            self._set_status(f"Imports: {options.label.lower()}")
+        else:
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the namespace options.")
             )
 
-            if not ok or not project_name.strip():
-                return
+    def _on_placement_options(self) -> None:
+        """Choose where imports land and what they are grouped under"""
+        from ..services.import_placement_service_impl import get_import_placement_service
+        from .dialogs.import_placement_dialog import ImportPlacementDialog
 
-            # Get project location
-            parent_dir = QFileDialog.getExistingDirectory(
-                self, "Select Parent Directory for New Project", str(Path.home() / "Documents")
+        placement_service = get_import_placement_service()
+        dialog = ImportPlacementDialog(placement_service.load_options(), self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+        if placement_service.save_options(options):
+            self._set_status(f"Imports: {options.label.lower()}")
+        else:
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the placement options.")
             )
 
-            if not parent_dir:
-                return
-
-            # Create project directory structure
-            project_path = Path(parent_dir) / project_name.strip()
-            success = self._create_project_structure(project_path)
-
-            if success:
-                self._load_project(project_path)
-                self._set_status(f"Created new project: {project_name}")
-                QMessageBox.information(
-                    self,
-                    "Project Created",
-                    f"New project '{project_name}' created successfully!\n\nLocation: {project_path}",
-                )
-            else:
-                self._set_status("Failed to create project")
+    def _on_remove_empty_namespaces(self) -> None:
+        """Delete the scene's namespaces that hold no nodes"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Removing namespaces needs Maya."))
+            return
 
-        except Exception as e:
-            error_msg = f"Failed to create new project: {e}"
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Error", error_msg)
+        from ..services.namespace_service_impl import get_namespace_service
 
-    def _create_project_structure(self, project_path: Path) -> bool:
-        """Create standard project directory structure - Single Responsibility"""
+        cmds.undoInfo(openChunk=True, chunkName="removeEmptyNamespaces")
         try:
-            # Create main project directory
-            project_path.mkdir(parents=True, exist_ok=True)
-
-            # Create standard subdirectories for asset organization
-            subdirs = [
-                "assets",  # Main assets folder
-                "assets/models",  # 3D models
-                "assets/textures",  # Texture files
-                "assets/materials",  # Material definitions
-                "assets/rigs",  # Character/object rigs
-                "assets/animations",  # Animation files
-                "assets/scenes",  # Maya scene files
-                "assets/references",  # Reference files
-                "exports",  # Exported files
-                "temp",  # Temporary files
-                "docs",  # Project documentation
-            ]
+            removed = get_namespace_service().remove_empty_namespaces(cmds)
+        finally:
+            cmds.undoInfo(closeChunk=True)
+        if removed:
+            self._set_status(f"Removed {len(removed)} empty namespace(s)")
+        else:
+            self._set_status(tr("No empty namespaces in the scene"))
 
-            for subdir in subdirs:
-                (project_path / subdir).mkdir(parents=True, exist_ok=True)
+    def _on_asset_reference(self, asset: Optional[Asset] = None) -> None:
+        """Import asset as a Maya reference - Single Responsibility"""
+        if not self._check_permission(ACTION_IMPORT):
+            return
+        asset = asset or self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to reference.")
+            )
+            return
+        if not self._confirm_deprecated_use(asset):
+            return
 
-            # Create project configuration file
-            config_file = project_path / "project.json"
-            from datetime import datetime
+        from ..services.maya_integration_impl import (
+            REFERENCE_FILE_TYPES,
+            MayaIntegrationImpl,
+            make_unique_namespace,
+            sanitize_namespace,
+        )
 
-            project_config = {
-                "name": project_path.name,
-                "created": datetime.now().isoformat(),
-                "version": "1.0",
-                "asset_manager_version": "1.5.0",
-                "description": f"Asset Manager project: {project_path.name}",
-                "directories": {
-                    "assets": "assets",
-                    "exports": "exports",
-                    "temp": "temp",
-                    "docs": "docs",
-                },
-            }
+        if asset.file_path.suffix.lower() not in REFERENCE_FILE_TYPES:
+            QMessageBox.information(
+                self,
+                tr("Cannot Reference"),
+                f"{asset.file_path.suffix} files cannot be referenced. Use Import instead.",
+            )
+            return
 
-            with open(config_file, "w", encoding="utf-8") as f:
-                json.dump(project_config, f, indent=4)
+        maya_integration = MayaIntegrationImpl()
+        if not maya_integration.is_maya_available():
+            QMessageBox.warning(self, tr("Maya Required"), tr("Referencing requires Maya."))
+            return
 
-            # Create README file
-            readme_file = project_path / "README.md"
-            readme_content = f"""# {project_path.name}
+        from .dialogs.reference_import_dialog import ReferenceImportDialog
 
-Asset Manager Project created on {datetime.now().strftime('%Y-%m-%d %H:%M:%S')}
+        dialog = ReferenceImportDialog(asset.display_name, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-## Directory Structure
+        namespace: Optional[str] = None  # asset name, made unique by the integration
+        if options["namespace_mode"] == "none":
+            namespace = ""
+        elif options["namespace_mode"] == "custom" and options["namespace"]:
+            import maya.cmds as cmds  # type: ignore
 
-- **assets/** - Main asset library (only asset files will appear in the Asset Manager)
-  - **models/** - 3D models and geometry (.ma, .mb, .obj, .fbx, .abc, .usd)
-  - **textures/** - Texture and image files (.png, .jpg, .tiff, .exr, .hdr)
-  - **materials/** - Material definitions (.mtl, .mat)
-  - **rigs/** - Character and object rigs
-  - **animations/** - Animation files
-  - **scenes/** - Maya scene files
-  - **references/** - Reference files
-- **exports/** - Exported and published assets
-- **temp/** - Temporary working files (excluded from asset library)
-- **docs/** - Project documentation
+            existing = cmds.namespaceInfo(listOnlyNamespaces=True) or []
+            namespace = make_unique_namespace(sanitize_namespace(options["namespace"]), existing)
 
-## Important Notes
+        self._sync_asset_from_depot(asset)
+        referenced_asset = asset
+        if asset.file_path.suffix.lower() in (".ma", ".mb"):
+            from dataclasses import replace
 
-- **Project management files** (project.json, README.md) are automatically excluded from the asset library
-- **Hidden files** and **temp directories** are not shown as assets
-- Only supported asset file types appear in the Asset Manager interface
+            from ..services.lod_service_impl import get_lod_service
 
-## Usage
+            lod_service = get_lod_service()
+            variants = lod_service.get_variants(asset.file_path)
+            if len(variants) > 1:
+                lod_level = self._choose_lod_level(asset, variants, "Reference")
+                if lod_level is None:
+                    return
+                variant_path = lod_service.get_variant_path(asset.file_path, lod_level)
+                referenced_asset = replace(asset, file_path=variant_path)
+
+        hook_context = {
+            "asset_file": asset.file_path,
+            "asset_name": asset.display_name,
+            "mode": "reference",
+            "file_path": referenced_asset.file_path,
+            "namespace": namespace,
+        }
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return
 
-This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface to:
-- Browse and organize assets
-- Import assets into Maya
-- Create new assets from scenes
-- Manage asset metadata and tags
+        import maya.cmds as cmds  # type: ignore
 
-"""
+        before = set(cmds.ls(assemblies=True, long=True) or [])
+        with self._undo_chunk(cmds, f"Reference {asset.name}") as chunk:
+            referenced = maya_integration.reference_asset(
+                referenced_asset, namespace, options["group_name"]
+            )
+            if referenced:
+                after = cmds.ls(assemblies=True, long=True) or []
+                new_roots = [node for node in after if node not in before]
+                self._setup_imported_rig(cmds, asset, new_roots)
+                self._setup_imported_camera(cmds, asset, new_roots)
+            else:
+                chunk.abort()
+        if referenced:
+            self._set_status(f"Referenced: {asset.display_name}")
+            self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
+            self._check_reference_updates()
+            self.asset_imported.emit(asset)
+            self._event_publisher.publish(EventType.ASSET_IMPORTED, {"asset": asset})
+            self._repository.update_access_time(asset)
+            database = self._get_metadata_database()
+            if database is not None:
+                database.record_access(asset.file_path)
+        else:
+            self._set_status(f"Failed to reference: {asset.display_name}")
 
-            with open(readme_file, "w", encoding="utf-8") as f:
-                f.write(readme_content)
+    def _on_replace_reference(self, asset: Optional[Asset] = None) -> None:
+        """Swap an existing scene reference to another asset or version"""
+        asset = asset or self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Select the asset that should replace the reference.")
+            )
+            return
 
-            print(f"[OK] Created project structure at: {project_path}")
-            return True
+        from ..services.maya_integration_impl import MayaIntegrationImpl
 
-        except Exception as e:
-            print(f"[ERROR] Failed to create project structure: {e}")
-            return False
+        maya_integration = MayaIntegrationImpl()
+        references = maya_integration.get_scene_references()
+        if not references:
+            QMessageBox.information(
+                self, tr("No References"), tr("The current scene does not contain any references.")
+            )
+            return
 
-    def _on_open_project(self) -> None:
-        """Handle project opening - Enhanced with validation"""
-        from PySide6.QtWidgets import QFileDialog
+        from .dialogs.replace_reference_dialog import ReplaceReferenceDialog
 
-        project_path = QFileDialog.getExistingDirectory(
-            self, "Open Asset Manager Project", str(Path.home() / "Documents")
+        dialog = ReplaceReferenceDialog(
+            references, asset.file_path, self._version_service.get_versions(asset.file_path), self
         )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        selection = dialog.get_selection()
+        if selection is None:
+            return
 
-        if project_path:
-            project_dir = Path(project_path)
+        reference_node, file_path = selection
+        if maya_integration.replace_reference(reference_node, file_path):
+            self._set_status(f"Replaced {reference_node} with {file_path.name}")
+        else:
+            QMessageBox.warning(
+                self,
+                tr("Replace Failed"),
+                f"Could not replace {reference_node} with {file_path.name}.",
+            )
 
-            # Validate project directory
-            if self._validate_project_directory(project_dir):
-                self._load_project(project_dir)
-                self._set_status(f"Opened project: {project_dir.name}")
-            else:
-                # Ask if user wants to initialize as new project
-                reply = QMessageBox.question(
-                    self,
-                    "Initialize Project?",
-                    f"'{project_dir.name}' doesn't appear to be an Asset Manager project.\n\n"
-                    "Would you like to initialize it as a new project?",
-                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                    QMessageBox.StandardButton.No,
-                )
-
-                if reply == QMessageBox.StandardButton.Yes:
-                    success = self._create_project_structure(project_dir)
-                    if success:
-                        self._load_project(project_dir)
-                        self._set_status(f"Initialized and opened project: {project_dir.name}")
+    def _on_publish_rig(self) -> None:
+        """Publish the selected rig with its controller sets - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Publishing rigs requires Maya."))
+            return
 
-    def _on_set_project(self) -> None:
-        """Handle setting an existing project as the current Asset Library - Single Responsibility"""
-        from PySide6.QtWidgets import QFileDialog
+        from ..services.rig_service_impl import RIG_CATEGORY, get_rig_service
+        from .dialogs.rig_publish_dialog import RigPublishDialog
 
-        try:
-            project_path = QFileDialog.getExistingDirectory(
-                self, "Set Asset Manager Project", str(Path.home() / "Documents")
+        rig_service = get_rig_service()
+        selection = cmds.ls(selection=True, long=True) or []
+        top_nodes = rig_service.find_top_nodes(cmds, selection)
+        if len(top_nodes) != 1:
+            QMessageBox.information(
+                self, tr("Select the Rig"), tr("Select the top node of the rig to publish.")
             )
+            return
+        top_node = top_nodes[0]
+        controller_sets = rig_service.find_controller_sets(cmds, top_node)
+
+        playblast_defaults, cameras = self._get_playblast_defaults()
+        dialog = RigPublishDialog(
+            top_node.rsplit("|", 1)[-1],
+            controller_sets,
+            playblast_defaults,
+            cameras,
+            parent=self,
+            known_tags=self._get_known_tags(),
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-            if project_path:
-                project_dir = Path(project_path)
+        picker = None
+        if options["picker_file"] is not None:
+            picker = rig_service.load_picker_layout(options["picker_file"])
+            if picker is None:
+                QMessageBox.warning(
+                    self,
+                    tr("Invalid Picker Layout"),
+                    f"{options['picker_file'].name} is not a picker layout JSON file.",
+                )
+                return
 
-                # Validate project directory - must be a valid project
-                if self._validate_project_directory(project_dir):
-                    # Load the project directly
-                    self._load_project(project_dir)
-                    self._set_status(f"Set project: {project_dir.name}")
+        rig_metadata = rig_service.build_rig_metadata(
+            top_node,
+            {name: controller_sets[name] for name in options["controller_sets"]},
+            picker,
+            options["character_set"],
+        )
+        cmds.select(top_node, replace=True)
+        asset_data = {
+            "name": options["name"],
+            "category": RIG_CATEGORY,
+            "description": options["notes"],
+            "tags": options["tags"],
+            "format": options["format"],
+            "collect_dependencies": True,
+            "rig": rig_metadata,
+        }
+        if options["playblast"] is not None:
+            asset_data["playblast"] = options["playblast"].to_dict()
+        if self._create_asset_from_scene(asset_data):
+            self._set_status(f"Published rig: {options['name']}")
+            self._on_refresh_library()
+        else:
+            self._set_status(f"Failed to publish rig {options['name']}")
 
-                    # Show success message with project info
-                    project_info = self._get_project_info(project_dir)
-                    message = (
-                        f"Project '{project_dir.name}' has been set as the current Asset Library."
-                    )
-                    if project_info:
-                        message += f"\n\nProject Details:\n{project_info}"
+    def _on_publish_camera(self) -> None:
+        """Publish the selected shot camera with its animation and image planes"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Publishing cameras requires Maya."))
+            return
 
-                    QMessageBox.information(self, "Project Set", message)
+        from dataclasses import replace
 
-                else:
-                    # Provide more specific feedback for invalid projects
-                    QMessageBox.warning(
-                        self,
-                        "Invalid Project",
-                        f"'{project_dir.name}' is not a valid Asset Manager project.\n\n"
-                        "Please select a directory that contains:\n"
-                        "• A project.json file, or\n"
-                        "• An 'assets' folder with asset files\n\n"
-                        "Use 'File > New Project...' to create a new project, or\n"
-                        "Use 'File > Open Project...' to initialize an existing directory.",
-                    )
+        from ..services.camera_service_impl import CAMERA_CATEGORY, get_camera_service
+        from .dialogs.camera_publish_dialog import CameraPublishDialog
 
-        except Exception as e:
-            error_msg = f"Failed to set project: {str(e)}"
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Set Project Error", error_msg)
+        camera_service = get_camera_service()
+        selection = cmds.ls(selection=True, long=True) or []
+        cameras = camera_service.find_cameras(cmds, selection)
+        if len(cameras) != 1:
+            QMessageBox.information(
+                self, tr("Select the Camera"), tr("Select the one shot camera to publish.")
+            )
+            return
+        camera = cameras[0]
+        shot_camera = camera_service.read_camera(cmds, camera)
+
+        # Preview the shot through the camera over its keyed range
+        frame_range = None
+        if shot_camera.is_animated:
+            frame_range = (shot_camera.start_frame, shot_camera.end_frame)
+        playblast_defaults, scene_cameras = self._get_playblast_defaults(frame_range)
+        if playblast_defaults is not None:
+            playblast_defaults = replace(playblast_defaults, camera=camera.rsplit("|", 1)[-1])
+        dialog = CameraPublishDialog(
+            shot_camera,
+            playblast_defaults,
+            scene_cameras,
+            parent=self,
+            known_tags=self._get_known_tags(),
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        cmds.select(camera, replace=True)
+        asset_data = {
+            "name": options["name"],
+            "category": CAMERA_CATEGORY,
+            "description": options["notes"],
+            "tags": options["tags"],
+            "format": options["format"],
+            "collect_dependencies": True,
+            "camera": shot_camera.to_dict(),
+        }
+        if options["playblast"] is not None:
+            asset_data["playblast"] = options["playblast"].to_dict()
+        if self._create_asset_from_scene(asset_data):
+            self._set_status(tr("Published camera: {name}", name=options["name"]))
+            self._on_refresh_library()
+        else:
+            self._set_status(tr("Failed to publish camera {name}", name=options["name"]))
 
-    def _get_project_info(self, project_path: Path) -> str:
-        """Get project information for display - Single Responsibility"""
+    def _on_export_anim_clip(self) -> None:
+        """Capture the selected rig's animation into a clip asset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
         try:
-            info_parts = []
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Exporting clips requires Maya."))
+            return
 
-            # Check for project.json
-            config_file = project_path / "project.json"
-            if config_file.exists():
-                try:
-                    with open(config_file, "r", encoding="utf-8") as f:
-                        config = json.load(f)
+        from ..services.anim_clip_service_impl import get_anim_clip_service, time_unit_to_fps
+        from .dialogs.anim_clip_export_dialog import AnimClipExportDialog
 
-                    if "created" in config:
-                        from datetime import datetime
+        selection = cmds.ls(selection=True, long=True) or []
+        clip_service = get_anim_clip_service()
+        animated = clip_service.get_animated_nodes(cmds, selection)
+        if not animated:
+            QMessageBox.information(
+                self,
+                tr("No Animation"),
+                tr("Select the animated controls or the root of an animated rig."),
+            )
+            return
 
-                        created_date = datetime.fromisoformat(
-                            config["created"].replace("Z", "+00:00")
-                        )
-                        info_parts.append(f"Created: {created_date.strftime('%Y-%m-%d %H:%M')}")
+        frame_range = (
+            cmds.playbackOptions(query=True, minTime=True),
+            cmds.playbackOptions(query=True, maxTime=True),
+        )
+        playblast_defaults, cameras = self._get_playblast_defaults(frame_range)
+        dialog = AnimClipExportDialog(
+            clip_service.get_rig_identifier(cmds, selection[0]),
+            frame_range,
+            time_unit_to_fps(cmds.currentUnit(query=True, time=True)),
+            len(animated),
+            self,
+            playblast_defaults=playblast_defaults,
+            cameras=cameras,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-                    if "version" in config:
-                        info_parts.append(f"Version: {config['version']}")
+        clip = clip_service.capture_clip(
+            cmds, selection, options["frame_range"], options["name"], options["notes"]
+        )
+        if not clip["curves"]:
+            QMessageBox.information(
+                self, tr("No Keys"), tr("The selection has no keys inside the chosen frame range.")
+            )
+            return
 
-                    if "description" in config:
-                        info_parts.append(f"Description: {config['description']}")
+        clip_path = self._get_publish_directory("animation") / f"{options['name']}.animclip"
+        if clip_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Clip Exists"),
+                f"{clip_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
 
-                except Exception:
-                    pass  # Skip if config can't be read
+        clip_path = clip_service.save_clip(clip_path, clip)
+        if options["capture_thumbnail"]:
+            start, end = options["frame_range"]
+            current = cmds.currentTime(query=True)
+            clip_service.capture_thumbnail(cmds, clip_path, (start + end) / 2.0)
+            cmds.currentTime(current)
+        if options["playblast"] is not None:
+            self._capture_playblast(cmds, clip_path, options["playblast"])
 
-            # Count assets
-            assets_dir = project_path / "assets"
-            if assets_dir.exists():
-                asset_extensions = [
-                    ".ma",
-                    ".mb",
-                    ".obj",
-                    ".fbx",
-                    ".abc",
-                    ".usd",
-                    ".usda",
-                    ".usdc",
-                    ".usdz",
-                    ".png",
-                    ".jpg",
-                    ".jpeg",
-                    ".tiff",
-                    ".tga",
-                    ".exr",
-                    ".hdr",
-                ]
-                asset_count = 0
-                for ext in asset_extensions:
-                    asset_count += len(list(assets_dir.rglob(f"*{ext}")))
+        self._set_status(f"Exported animation clip: {clip_path.name}")
+        self._on_refresh_library()
 
-                info_parts.append(f"Assets: {asset_count} files")
+    def _on_apply_anim_clip(self, asset: Optional[Asset] = None) -> bool:
+        """Apply a clip asset to a rig chosen by namespace"""
+        from ..services.anim_clip_service_impl import ANIM_CLIP_EXTENSION, get_anim_clip_service
 
-            # Add location
-            info_parts.append(f"Location: {project_path}")
+        asset = asset or self._current_asset
+        if not asset or asset.file_path.suffix.lower() != ANIM_CLIP_EXTENSION:
+            QMessageBox.information(
+                self, tr("No Clip Selected"), tr("Please select an animation clip to apply.")
+            )
+            return False
 
-            return "\n".join(info_parts)
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Applying clips requires Maya."))
+            return False
 
-        except Exception:
-            return f"Location: {project_path}"
+        clip_service = get_anim_clip_service()
+        clip = clip_service.load_clip(asset.file_path)
+        if clip is None:
+            QMessageBox.warning(
+                self, tr("Invalid Clip"), f"{asset.file_path.name} is not a valid animation clip."
+            )
+            return False
 
-    def _on_delete_project(self) -> None:
-        """Handle deleting an existing project permanently - Single Responsibility with Safety Measures"""
-        from PySide6.QtWidgets import QFileDialog
+        from .dialogs.anim_clip_apply_dialog import AnimClipApplyDialog
 
-        try:
-            # Show warning dialog first
-            initial_warning = QMessageBox.warning(
-                self,
-                "[WARNING] Delete Project - WARNING",
-                "[DELETE] This action will PERMANENTLY DELETE an entire project and all its files.\n\n"
-                "This includes:\n"
-                "• All asset files (.ma, .mb, .obj, .fbx, textures, etc.)\n"
-                "• Project configuration and metadata\n"
-                "• All subdirectories and documentation\n"
-                "• Thumbnail cache and generated files\n\n"
-                "[TIP] Consider backing up important files before proceeding.\n\n"
-                "Are you sure you want to continue?",
-                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                QMessageBox.StandardButton.No,
-            )
+        dialog = AnimClipApplyDialog(
+            clip,
+            clip_service.get_scene_namespaces(cmds),
+            lambda namespace: clip_service.find_missing_nodes(cmds, clip, namespace),
+            self,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return False
+        options = dialog.get_options()
 
-            if initial_warning != QMessageBox.StandardButton.Yes:
-                return
+        with self._undo_chunk(cmds, "applyAnimClip"):
+            result = clip_service.apply_clip(
+                cmds, clip, options["namespace"], options["start_frame"], options["replace"]
+            )
 
-            # Select project to delete
-            project_path = QFileDialog.getExistingDirectory(
+        if result.missing:
+            QMessageBox.information(
                 self,
-                "[WARNING] Select Project to DELETE PERMANENTLY",
-                str(Path.home() / "Documents"),
+                tr("Clip Applied"),
+                f"Applied {result.applied_curves} curves. "
+                f"{len(result.missing)} channel(s) were not found on the target rig:\n"
+                + "\n".join(result.missing[:10])
+                + ("\n..." if len(result.missing) > 10 else ""),
             )
+        self._set_status(f"Applied clip {clip.get('name', asset.display_name)}")
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_save_pose(self) -> None:
+        """Capture the selected controls into a pose asset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Saving poses requires Maya."))
+            return
 
-            if not project_path:
-                return
-
-            project_dir = Path(project_path)
+        from ..services.anim_clip_service_impl import get_anim_clip_service
+        from ..services.pose_service_impl import get_pose_service
+        from .dialogs.pose_save_dialog import PoseSaveDialog
 
-            # Validate it's actually a project
-            if not self._validate_project_directory(project_dir):
-                QMessageBox.warning(
-                    self,
-                    "Not a Project",
-                    f"'{project_dir.name}' is not a valid Asset Manager project.\n\n"
-                    "Only Asset Manager projects can be deleted using this function.\n"
-                    "Please select a directory that contains project.json or an 'assets' folder.",
-                )
-                return
+        controls = cmds.ls(selection=True, transforms=True) or []
+        if not controls:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Select the rig controls to save.")
+            )
+            return
 
-            # Get project information for confirmation
-            project_info = self._get_project_info(project_dir)
+        pose_service = get_pose_service()
+        rig = get_anim_clip_service().get_rig_identifier(cmds, controls[0])
+        dialog = PoseSaveDialog(rig, len(controls), self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-            # Final confirmation with detailed info
-            final_confirmation = QMessageBox.critical(
+        pose = pose_service.capture_pose(cmds, controls, options["name"], options["notes"])
+        if not pose["controls"]:
+            QMessageBox.information(
                 self,
-                "[DELETE] FINAL CONFIRMATION - DELETE PROJECT",
-                f"[WARNING] LAST CHANCE TO CANCEL [WARNING]\n\n"
-                f"You are about to PERMANENTLY DELETE:\n"
-                f"[FOLDER] Project: {project_dir.name}\n\n"
-                f"Project Details:\n{project_info}\n\n"
-                f"[ALERT] THIS ACTION CANNOT BE UNDONE! [ALERT]\n\n"
-                f"Type the project name '{project_dir.name}' to confirm deletion.\n"
-                f"This is your final protection against accidental deletion.",
-                QMessageBox.StandardButton.Ok | QMessageBox.StandardButton.Cancel,
-                QMessageBox.StandardButton.Cancel,
+                tr("Nothing to Save"),
+                tr("The selected controls have no keyable attributes."),
             )
+            return
 
-            if final_confirmation != QMessageBox.StandardButton.Ok:
-                self._set_status("Project deletion cancelled")
-                return
-
-            # Ask user to type project name for final confirmation
-            from PySide6.QtWidgets import QInputDialog
-
-            typed_name, ok = QInputDialog.getText(
+        pose_path = self._get_publish_directory("poses") / f"{options['name']}.pose"
+        if pose_path.exists():
+            reply = QMessageBox.question(
                 self,
-                "Type Project Name to Confirm",
-                f"Type the exact project name to confirm deletion:\n'{project_dir.name}'",
-                text="",
+                tr("Pose Exists"),
+                f"{pose_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             )
-
-            if not ok or typed_name.strip() != project_dir.name:
-                QMessageBox.information(
-                    self,
-                    "Deletion Cancelled",
-                    "Project name did not match. Deletion cancelled for safety.",
-                )
-                self._set_status("Project deletion cancelled - name mismatch")
+            if reply != QMessageBox.StandardButton.Yes:
                 return
 
-            # Check if we're deleting the current project
-            current_project_path = None
-            if (
-                self._library_widget
-                and hasattr(self._library_widget, "current_project_path")
-                and self._library_widget.current_project_path
-            ):
-                current_project_path = Path(self._library_widget.current_project_path)
+        pose_path = pose_service.save_pose(pose_path, pose)
+        if options["capture_thumbnail"]:
+            pose_service.capture_thumbnail(cmds, pose_path)
 
-            is_current_project = (
-                current_project_path and current_project_path.resolve() == project_dir.resolve()
-            )
+        self._set_status(f"Saved pose: {pose_path.name}")
+        self._on_refresh_library()
 
-            # Perform the deletion
-            self._set_status("Deleting project...", show_progress=True)
+    def _on_apply_pose(self, asset: Asset) -> bool:
+        """Apply a pose asset with blend and mirror options"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Applying poses requires Maya."))
+            return False
 
-            try:
-                # Remove the entire project directory
-                shutil.rmtree(project_dir)
+        from ..services.anim_clip_service_impl import get_anim_clip_service
+        from .dialogs.pose_apply_dialog import PoseApplyDialog
 
-                # If we deleted the current project, clear the library
-                if is_current_project:
-                    if self._library_widget:
-                        self._library_widget.current_project_path = None
-                        self._library_widget.refresh_library()
-                    self._set_status(
-                        f"Deleted current project: {project_dir.name} - Library cleared"
-                    )
-                else:
-                    self._set_status(f"Deleted project: {project_dir.name}")
-
-                # Show success message
-                QMessageBox.information(
-                    self,
-                    "Project Deleted",
-                    f"Project '{project_dir.name}' has been permanently deleted.\n\n"
-                    f"All files and directories have been removed from:\n{project_dir}",
-                )
+        pose = self._load_pose_asset(asset)
+        if pose is None:
+            return False
 
-                print(f"[DELETE] Successfully deleted project: {project_dir}")
+        selection = cmds.ls(selection=True, transforms=True) or []
+        dialog = PoseApplyDialog(
+            pose, get_anim_clip_service().get_scene_namespaces(cmds), bool(selection), self
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return False
+        options = dialog.get_options()
+
+        return self._apply_pose(
+            cmds,
+            asset,
+            pose,
+            options["namespace"],
+            options["blend"],
+            options["mirror"],
+            selection if options["selected_only"] else None,
+        )
 
-            except PermissionError as e:
-                error_msg = (
-                    f"Permission denied: Cannot delete project.\n\n"
-                    f"Some files may be in use or you may not have "
-                    f"sufficient permissions.\n\nError: {str(e)}"
-                )
-                QMessageBox.critical(self, "Permission Error", error_msg)
-                self._set_status("Project deletion failed - permission error")
+    def _on_quick_apply_pose(self, asset: Asset, mirror: bool) -> None:
+        """Apply a pose at full strength to the namespace of the selection"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Applying poses requires Maya."))
+            return
 
-            except Exception as e:
-                error_msg = f"Failed to delete project: {str(e)}"
-                QMessageBox.critical(self, "Deletion Error", error_msg)
-                self._set_status("Project deletion failed")
+        from ..services.anim_clip_service_impl import get_namespace
 
-            finally:
-                self._progress_bar.setVisible(False)
+        pose = self._load_pose_asset(asset)
+        if pose is None:
+            return
 
-        except Exception as e:
-            error_msg = f"Failed to delete project: {str(e)}"
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Delete Project Error", error_msg)
+        # Target the rig the animator has selected, else the rig the pose came from
+        selection = cmds.ls(selection=True, transforms=True) or []
+        namespace = get_namespace(selection[0]) if selection else pose.get("source_namespace", "")
+        self._apply_pose(cmds, asset, pose, namespace, 1.0, mirror, None)
 
-    def _validate_project_directory(self, project_path: Path) -> bool:
-        """Validate if directory is a valid Asset Manager project - Single Responsibility"""
+    def _on_import_alembic(self, asset: Asset, mode: str) -> None:
+        """Load an Alembic cache as editable geometry or as a GPU cache"""
         try:
-            # Check for project.json file (preferred)
-            if (project_path / "project.json").exists():
-                return True
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Loading Alembic caches requires Maya.")
+            )
+            return
 
-            # Check for assets directory (fallback)
-            if (project_path / "assets").exists():
-                return True
+        from ..services.alembic_service_impl import IMPORT_MODE_GPU_CACHE, get_alembic_service
 
-            # Check if directory has any asset files
-            asset_extensions = [".ma", ".mb", ".obj", ".fbx", ".abc", ".png", ".jpg", ".jpeg"]
-            for ext in asset_extensions:
-                if list(project_path.rglob(f"*{ext}")):
-                    return True
+        self._sync_asset_from_depot(asset)
+        try:
+            with self._undo_chunk(cmds, f"Load {asset.file_path.stem}"):
+                roots = get_alembic_service().import_cache(cmds, asset.file_path, mode)
+        except Exception as e:
+            QMessageBox.warning(self, tr("Alembic Load Failed"), f"Could not load the cache:\n{e}")
+            return
 
-            return False
+        if roots:
+            cmds.select(roots, replace=True)
+        loaded_as = "GPU cache" if mode == IMPORT_MODE_GPU_CACHE else "geometry"
+        self._set_status(f"Loaded {asset.display_name} as {loaded_as}")
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
 
-        except Exception as e:
-            print(f"Error validating project directory: {e}")
+    def _load_pose_asset(self, asset: Asset) -> Optional[Dict[str, Any]]:
+        """Read a pose asset, warning the user when the file is not a valid pose"""
+        from ..services.pose_service_impl import get_pose_service
+
+        pose = get_pose_service().load_pose(asset.file_path)
+        if pose is None:
+            QMessageBox.warning(
+                self, tr("Invalid Pose"), f"{asset.file_path.name} is not a valid pose."
+            )
+        return pose
+
+    def _apply_pose(
+        self,
+        cmds: Any,
+        asset: Asset,
+        pose: Dict[str, Any],
+        namespace: str,
+        blend: float,
+        mirror: bool,
+        controls: Optional[List[str]],
+    ) -> bool:
+        """Apply a pose as one undo step and report missing channels"""
+        from ..services.pose_service_impl import get_pose_service
+
+        with self._undo_chunk(cmds, "applyPose"):
+            result = get_pose_service().apply_pose(cmds, pose, namespace, blend, mirror, controls)
+
+        if not result.success:
+            QMessageBox.information(
+                self,
+                tr("Pose Not Applied"),
+                f"None of the pose's controls were found in namespace '{namespace or ':'}'.",
+            )
             return False
 
-    def _on_save_project(self) -> None:
-        """Handle saving current project - Single Responsibility"""
+        self._set_status(
+            f"Applied pose {pose.get('name', asset.display_name)}"
+            f"{' (mirrored)' if mirror else ''} at {blend:.0%}"
+        )
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_publish_blendshapes(self) -> None:
+        """Publish a mesh's blendShape targets, or sculpted copies of it, as a blendshape set"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
         try:
-            # Check if we have a current project loaded
-            if (
-                not self._library_widget
-                or not hasattr(self._library_widget, "current_project_path")
-                or not self._library_widget.current_project_path
-            ):
-                QMessageBox.information(
-                    self,
-                    "No Project Loaded",
-                    "No project is currently loaded.\n\n"
-                    "Please open or create a project first using:\n"
-                    "• File > New Project...\n"
-                    "• File > Open Project...\n"
-                    "• File > Set Project...",
-                )
-                return
-
-            project_path = Path(self._library_widget.current_project_path)
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Publishing blendshapes requires Maya.")
+            )
+            return
 
-            # Save project configuration and refresh library
-            self._save_project_data(project_path)
+        from ..services.blendshape_service_impl import get_blendshape_service
+        from .dialogs.blendshape_publish_dialog import BlendshapePublishDialog
 
-            # Refresh the library to ensure consistency
-            if self._library_widget:
-                self._library_widget.refresh_library()
+        selection = cmds.ls(selection=True, transforms=True, long=True) or []
+        if not selection:
+            QMessageBox.information(
+                self,
+                tr("No Selection"),
+                tr(
+                    "Select the base mesh with its blendShape, or the sculpted targets and then "
+                    "the base mesh."
+                ),
+            )
+            return
 
-            self._set_status(f"Saved project: {project_path.name}")
+        # Like the blendShape command: targets first, the base mesh last
+        blendshape_service = get_blendshape_service()
+        base_mesh = selection[-1]
+        source_node = ""
+        mismatched: List[str] = []
+        if len(selection) > 1:
+            targets, mismatched = blendshape_service.read_mesh_targets(
+                cmds, base_mesh, selection[:-1]
+            )
+        else:
+            nodes = blendshape_service.find_blendshape_nodes(cmds, base_mesh)
+            source_node = nodes[0] if nodes else ""
+            targets = blendshape_service.read_node_targets(cmds, source_node) if nodes else {}
+        if not targets:
             QMessageBox.information(
                 self,
-                "Project Saved",
-                f"Project '{project_path.name}' has been saved successfully.\n\n"
-                "All project data and asset library have been updated.",
+                tr("No Targets"),
+                f"No blendShape targets with moved points were found for "
+                f"{base_mesh.rsplit('|', 1)[-1]}.",
             )
+            return
 
-        except Exception as e:
-            error_msg = f"Failed to save project: {str(e)}"
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Save Project Error", error_msg)
+        topology = blendshape_service.get_topology(cmds, base_mesh)
+        dialog = BlendshapePublishDialog(base_mesh, topology, list(targets), mismatched, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-    def _on_save_project_as(self) -> None:
-        """Handle saving current project to a new location - Single Responsibility"""
-        try:
-            # Check if we have a current project loaded
-            if (
-                not self._library_widget
-                or not hasattr(self._library_widget, "current_project_path")
-                or not self._library_widget.current_project_path
-            ):
-                QMessageBox.information(
-                    self,
-                    "No Project Loaded",
-                    "No project is currently loaded.\n\n"
-                    "Please open or create a project first using:\n"
-                    "• File > New Project...\n"
-                    "• File > Open Project...\n"
-                    "• File > Set Project...",
-                )
+        set_path = self._get_publish_directory("blendshapes") / f"{options['name']}.blendshape"
+        if set_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Blendshapes Exist"),
+                f"{set_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
                 return
 
-            from PySide6.QtWidgets import QFileDialog
+        blendshape = blendshape_service.create_blendshape_set(
+            cmds,
+            base_mesh,
+            {name: targets[name] for name in options["targets"]},
+            options["name"],
+            options["notes"],
+            source_node,
+        )
+        set_path = blendshape_service.save_blendshape_set(set_path, blendshape)
+        if options["capture_thumbnail"]:
+            blendshape_service.capture_thumbnail(cmds, set_path)
+        database = self._get_metadata_database()
+        if database is not None:
+            try:
+                blendshape_service.record_topology(database, set_path, topology)
+            except Exception as e:
+                print(f"[WARNING] Failed to store base mesh topology: {e}")
 
-            current_project_path = Path(self._library_widget.current_project_path)
+        self._set_status(f"Published blendshapes: {set_path.name}")
+        self._on_refresh_library()
 
-            # Get new project location
-            new_project_path = QFileDialog.getExistingDirectory(
-                self, "Save Project As - Select New Location", str(current_project_path.parent)
+    def _on_apply_blendshapes(self, asset: Asset) -> bool:
+        """Add a blendshape set's targets to the selected mesh, or the one that matches it"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Applying blendshapes requires Maya.")
             )
+            return False
 
-            if not new_project_path:
-                return
+        from ..services.blendshape_service_impl import get_blendshape_service
 
-            _ = Path(new_project_path) / f"{current_project_path.name}_copy"
+        blendshape_service = get_blendshape_service()
+        blendshape = blendshape_service.load_blendshape_set(asset.file_path)
+        if blendshape is None:
+            QMessageBox.warning(
+                self, tr("Invalid Blendshapes"), f"{asset.file_path.name} is not a blendshape set."
+            )
+            return False
 
-            # Ask for new project name
-            from PySide6.QtWidgets import QInputDialog
+        # Without a selection, look for the one scene mesh the set was made for
+        meshes = cmds.ls(selection=True, transforms=True, long=True) or []
+        if not meshes:
+            topology = MeshTopology.from_dict(blendshape.get("topology", {}))
+            meshes = blendshape_service.find_matching_meshes(cmds, topology)
+            if len(meshes) != 1:
+                QMessageBox.information(
+                    self,
+                    tr("Select a Mesh"),
+                    f"Select the mesh to apply {asset.display_name} to"
+                    + (
+                        f" ({len(meshes)} meshes match its topology)."
+                        if meshes
+                        else f". No mesh matches its topology ({topology.label})."
+                    ),
+                )
+                return False
+        mesh = meshes[0]
 
-            new_name, ok = QInputDialog.getText(
+        differences = blendshape_service.check_topology(cmds, blendshape, mesh)
+        if differences:
+            reply = QMessageBox.warning(
                 self,
-                "Save Project As",
-                "Enter new project name:",
-                text=f"{current_project_path.name}_copy",
+                tr("Topology Differs"),
+                f"{mesh.rsplit('|', 1)[-1]} does not match the mesh "
+                f"{asset.display_name} was made on "
+                f"({blendshape.get('base_mesh', 'unknown')}):\n\n"
+                + "\n".join(differences)
+                + "\n\nThe shapes will land on the wrong points. Apply anyway?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
             )
+            if reply != QMessageBox.StandardButton.Yes:
+                return False
 
-            if not ok or not new_name.strip():
-                return
-
-            final_project_path = Path(new_project_path) / new_name.strip()
-
-            # Check if destination already exists
-            if final_project_path.exists():
-                reply = QMessageBox.question(
-                    self,
-                    "Directory Exists",
-                    f"Directory '{final_project_path.name}' already exists.\n\n"
-                    "Do you want to overwrite it?",
-                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                    QMessageBox.StandardButton.No,
-                )
+        try:
+            with self._undo_chunk(cmds, "applyBlendshapes"):
+                result = blendshape_service.apply_blendshape_set(cmds, blendshape, mesh)
+        except Exception as e:
+            result = None
+            print(f"[ERROR] Failed to apply blendshapes {asset.display_name}: {e}")
 
-                if reply != QMessageBox.StandardButton.Yes:
-                    return
+        if result is None or not result.success:
+            QMessageBox.warning(
+                self, tr("Blendshapes Failed"), f"Could not apply {asset.display_name}."
+            )
+            return False
 
-                # Remove existing directory
-                shutil.rmtree(final_project_path)
-            # Copy project to new location
-            self._set_status("Copying project...", show_progress=True)
+        skipped = f", {result.skipped_points} points skipped" if result.skipped_points else ""
+        self._set_status(
+            f"Applied {len(result.targets)} targets to {mesh.rsplit('|', 1)[-1]} "
+            f"({result.node}){skipped}"
+        )
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_publish_skin_weights(self) -> None:
+        """Publish the skin weights of the selected meshes as a skin weights asset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Publishing skin weights requires Maya.")
+            )
+            return
 
-            try:
-                shutil.copytree(current_project_path, final_project_path)
+        from ..services.skin_weights_service_impl import (
+            SKIN_WEIGHTS_EXTENSION,
+            get_skin_weights_service,
+        )
+        from .dialogs.skin_weights_publish_dialog import SkinWeightsPublishDialog
 
-                # Update project configuration in new location
-                self._save_project_data(final_project_path, new_name=new_name.strip())
+        skin_weights_service = get_skin_weights_service()
+        selection = cmds.ls(selection=True, long=True) or []
+        meshes = skin_weights_service.find_meshes(cmds, selection) if selection else []
+        if not meshes:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Select the skinned meshes to publish weights for.")
+            )
+            return
 
-                # Ask if user wants to switch to the new project
-                reply = QMessageBox.question(
-                    self,
-                    "Project Copied",
-                    f"Project has been successfully copied to:\n{final_project_path}\n\n"
-                    "Would you like to switch to the new project location?",
-                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                    QMessageBox.StandardButton.Yes,
-                )
+        skinned = []
+        unskinned = []
+        for mesh in meshes:
+            skin_cluster = skin_weights_service.find_skin_cluster(cmds, mesh)
+            if skin_cluster:
+                influences = cmds.skinCluster(skin_cluster, query=True, influence=True) or []
+                skinned.append((mesh, len(influences)))
+            else:
+                unskinned.append(mesh)
+        if not skinned:
+            QMessageBox.information(
+                self, tr("No Skin Weights"), tr("None of the selected meshes has a skinCluster.")
+            )
+            return
 
-                if reply == QMessageBox.StandardButton.Yes:
-                    self._load_project(final_project_path)
-                    self._set_status(f"Switched to copied project: {new_name}")
-                else:
-                    self._set_status(f"Project copied successfully to: {final_project_path}")
+        dialog = SkinWeightsPublishDialog(skinned, unskinned, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
 
-            except Exception as e:
-                error_msg = f"Failed to copy project: {str(e)}"
-                QMessageBox.critical(self, "Copy Error", error_msg)
-                self._set_status(error_msg)
-            finally:
-                self._progress_bar.setVisible(False)
+        weights_path = self._get_publish_directory("skinweights") / (
+            f"{options['name']}{SKIN_WEIGHTS_EXTENSION}"
+        )
+        if weights_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Skin Weights Exist"),
+                f"{weights_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
 
+        try:
+            skin_weights = skin_weights_service.create_skin_weights(
+                cmds, options["meshes"], options["name"], options["notes"]
+            )
+            weights_path = skin_weights_service.save_skin_weights(weights_path, skin_weights)
         except Exception as e:
-            error_msg = f"Failed to save project as: {str(e)}"
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Save Project As Error", error_msg)
+            QMessageBox.critical(self, tr("Error"), f"Failed to publish skin weights:\n{e}")
+            return
+        if options["capture_thumbnail"]:
+            skin_weights_service.capture_thumbnail(cmds, weights_path)
 
-    def _save_project_data(self, project_path: Path, new_name: Optional[str] = None) -> None:
-        """Save project configuration data - Single Responsibility"""
-        try:
-            config_file = project_path / "project.json"
+        self._set_status(
+            f"Published skin weights: {weights_path.name} "
+            f"({len(skin_weights['meshes'])} meshes)"
+        )
+        self._on_refresh_library()
 
-            # Load existing config or create new one
-            project_config = {}
-            if config_file.exists():
-                try:
-                    with open(config_file, "r", encoding="utf-8") as f:
-                        project_config = json.load(f)
-                except Exception:
-                    pass  # Use empty config if can't read
+    def _on_apply_skin_weights(self, asset: Asset) -> bool:
+        """Skin the selected meshes (or same-named scene meshes) with a skin weights asset"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Applying skin weights requires Maya.")
+            )
+            return False
 
-            # Update configuration
-            from datetime import datetime
+        from ..services.skin_weights_service_impl import get_skin_weights_service
+        from .dialogs.skin_weights_apply_dialog import SkinWeightsApplyDialog
+
+        skin_weights_service = get_skin_weights_service()
+        skin_weights = skin_weights_service.load_skin_weights(asset.file_path)
+        if skin_weights is None or not skin_weights.get("meshes"):
+            QMessageBox.warning(
+                self,
+                tr("Invalid Skin Weights"),
+                f"{asset.file_path.name} is not a skin weights asset.",
+            )
+            return False
+
+        # Without a selection, offer every scene mesh; same names are paired up front
+        selection = cmds.ls(selection=True, long=True) or []
+        meshes = skin_weights_service.find_meshes(cmds, selection or None)
+        if not meshes:
+            QMessageBox.information(
+                self, tr("No Meshes"), tr("The scene has no meshes to apply skin weights to.")
+            )
+            return False
+        targets = skin_weights_service.suggest_targets(cmds, skin_weights, meshes)
+
+        dialog = SkinWeightsApplyDialog(
+            skin_weights_service, cmds, skin_weights, targets, meshes, self
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return False
+        method = dialog.get_method()
+        targets = {name: mesh for name, mesh in dialog.get_targets().items() if mesh}
+        if not targets:
+            return False
+
+        results = []
+        try:
+            with self._undo_chunk(cmds, "applySkinWeights"):
+                for name, mesh in targets.items():
+                    mesh_weights = skin_weights_service.get_mesh_weights(skin_weights, name)
+                    results.append(
+                        skin_weights_service.apply_skin_weights(cmds, mesh_weights, mesh, method)
+                    )
+        except Exception as e:
+            print(f"[ERROR] Failed to apply skin weights {asset.display_name}: {e}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to apply skin weights:\n{e}")
+            return False
+
+        applied = [result for result in results if result.success]
+        if not applied:
+            QMessageBox.warning(
+                self,
+                tr("Skin Weights Failed"),
+                f"Could not apply {asset.display_name}: none of its influences are in the "
+                "scene.",
+            )
+            return False
+
+        missing = sorted({name for result in results for name in result.missing_influences})
+        if missing:
+            QMessageBox.warning(
+                self,
+                tr("Missing Influences"),
+                "These influences are not in the scene. Their weights went to the other "
+                "influences of each vertex, and vertices weighted only to them kept their "
+                "bind weights:\n\n" + ", ".join(missing),
+            )
+        self._set_status(
+            f"Applied {asset.display_name} to "
+            + ", ".join(
+                f"{result.mesh.rsplit('|', 1)[-1]} ({result.method.replace('_', ' ')})"
+                for result in applied
+            )
+        )
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_save_material(self) -> None:
+        """Save the selection's shading network as a material preset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Saving materials requires Maya."))
+            return
+
+        from ..services.material_service_impl import get_material_service, get_renderer
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.material_save_dialog import MaterialSaveDialog
+
+        material_service = get_material_service()
+        selection = cmds.ls(selection=True, objectsOnly=True) or []
+        networks = []
+        for engine in material_service.find_shading_engines(cmds, selection):
+            roots = material_service.get_network_roots(cmds, engine)
+            shader = roots.get("aiSurfaceShader") or roots.get("surfaceShader")
+            if shader:
+                shader_type = cmds.nodeType(shader)
+                networks.append(
+                    {
+                        "engine": engine,
+                        "shader": shader,
+                        "shader_type": shader_type,
+                        "renderer": get_renderer(shader_type),
+                    }
+                )
+        if not networks:
+            QMessageBox.information(
+                self,
+                tr("No Material"),
+                tr("Select a shader, shading group, or mesh with a material assigned."),
+            )
+            return
+
+        dialog = MaterialSaveDialog(networks, find_mayapy() is not None, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_publish_directory("materials") / f"{options['name']}.material"
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Material Exists"),
+                f"{descriptor_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        saved = material_service.save_material(
+            cmds, options["engine"], descriptor_path, options["notes"]
+        )
+        if saved is None:
+            QMessageBox.warning(self, tr("Save Failed"), f"Could not save {options['engine']}.")
+            return
+
+        # The swatch renders in mayapy and shows up when the job finishes
+        if options["render_swatch"]:
+            self._thumbnail_queue.enqueue(saved, color=self._get_color_transform())
+
+        self._set_status(f"Saved material: {saved.name}")
+        self._on_refresh_library()
+
+    def _on_assign_material(self, asset: Asset, assign: bool = True) -> bool:
+        """Assign a material preset to the selected meshes or faces"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Assigning materials requires Maya.")
+            )
+            return False
+
+        from ..services.material_service_impl import get_material_service
+
+        material_service = get_material_service()
+        targets = []
+        if assign:
+            targets = material_service.get_assignable_targets(
+                cmds, cmds.ls(selection=True, flatten=False) or []
+            )
+            if not targets:
+                QMessageBox.information(
+                    self,
+                    tr("No Selection"),
+                    tr("Select the meshes or faces to assign the material to."),
+                )
+                return False
+
+        with self._undo_chunk(cmds, "assignMaterial"):
+            engine = material_service.assign_material(cmds, asset.file_path, targets)
+
+        if engine is None:
+            QMessageBox.warning(
+                self, tr("Material Failed"), f"Could not load material {asset.display_name}."
+            )
+            return False
+
+        # Give the artist their selection back after the sets edit
+        if targets:
+            cmds.select(targets, replace=True)
+            self._set_status(f"Assigned {asset.display_name} to {len(targets)} item(s)")
+        else:
+            self._set_status(f"Imported material {asset.display_name} as {engine}")
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_import_materialx(self, mtlx_path: Optional[Path] = None) -> bool:
+        """Build a MaterialX material in the scene and assign it to the selection"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Importing MaterialX requires Maya.")
+            )
+            return False
+
+        from ..services.material_service_impl import get_material_service
+        from ..services.materialx_service_impl import get_materialx_service
+
+        if mtlx_path is None:
+            from PySide6.QtWidgets import QFileDialog
+
+            mtlx_file, _filter = QFileDialog.getOpenFileName(
+                self, "Import MaterialX", "", "MaterialX (*.mtlx)"
+            )
+            if not mtlx_file:
+                return False
+            mtlx_path = Path(mtlx_file)
+
+        materialx_service = get_materialx_service()
+        materials = materialx_service.list_materials(mtlx_path)
+        if not materials:
+            QMessageBox.warning(
+                self, tr("No Materials"), f"{mtlx_path.name} has no materials to import."
+            )
+            return False
+        material_name = materials[0]
+        if len(materials) > 1:
+            material_name, ok = QInputDialog.getItem(
+                self, "Import MaterialX", f"Material of {mtlx_path.name}:", materials, 0, False
+            )
+            if not ok:
+                return False
+
+        targets = get_material_service().get_assignable_targets(
+            cmds, cmds.ls(selection=True, flatten=False) or []
+        )
+        with self._undo_chunk(cmds, "importMaterialX"):
+            result = materialx_service.import_document(cmds, mtlx_path, material_name)
+            if result is not None and targets:
+                cmds.sets(targets, edit=True, forceElement=result.material)
+
+        if result is None:
+            QMessageBox.warning(
+                self,
+                tr("MaterialX Failed"),
+                f"{material_name} in {mtlx_path.name} is not a standard_surface material.",
+            )
+            return False
+        if result.unsupported:
+            QMessageBox.information(
+                self,
+                tr("MaterialX Imported"),
+                f"{material_name} was imported, but these inputs use nodes Maya cannot "
+                "rebuild and keep their default values:\n\n" + "\n".join(result.unsupported),
+            )
+        if targets:
+            cmds.select(targets, replace=True)
+        self._set_status(
+            f"Imported MaterialX {material_name} as {result.material}"
+            + (f", assigned to {len(targets)} item(s)" if targets else "")
+        )
+        return True
+
+    def _on_export_materialx(self, asset: Asset) -> None:
+        """Write the MaterialX document of a material preset saved without one"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Exporting MaterialX requires Maya.")
+            )
+            return
+
+        from ..services.material_service_impl import get_material_service
+
+        try:
+            materialx_path = get_material_service().export_materialx(cmds, asset.file_path)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to export MaterialX:\n{e}")
+            return
+        if materialx_path is None:
+            QMessageBox.warning(
+                self,
+                tr("MaterialX Not Written"),
+                f"{asset.display_name} does not use an aiStandardSurface or standardSurface "
+                "shader, which MaterialX needs.",
+            )
+            return
+        self._set_status(f"Wrote MaterialX document: {materialx_path}")
+
+    def _on_export_gltf(self, asset: Asset) -> None:
+        """Write the web and AR review glTF of a library asset with the library settings"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Exporting glTF requires Maya."))
+            return
+
+        from ..services.gltf_export_service_impl import get_gltf_export_service
+
+        gltf_service = get_gltf_export_service()
+        settings = gltf_service.load_settings(self._get_library_root())
+        self._set_status(tr("Exporting {name} as glTF...", name=asset.display_name))
+        try:
+            gltf_file = gltf_service.export_asset(cmds, asset.file_path, settings)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), tr("Failed to export glTF:\n{error}", error=e))
+            return
+        self._set_status(
+            tr(
+                "Wrote review glTF: {file} ({settings})",
+                file=gltf_file,
+                settings=settings.description,
+            )
+        )
+
+    def _on_save_light_rig(self) -> None:
+        """Save the selected scene lights (all lights without a selection) as a light rig"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Saving light rigs requires Maya."))
+            return
+
+        from ..services.light_rig_service_impl import get_light_rig_service
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.light_rig_save_dialog import LightRigSaveDialog
+
+        light_rig_service = get_light_rig_service()
+        lights = light_rig_service.find_lights(cmds)
+        if not lights:
+            QMessageBox.information(
+                self, tr("No Lights"), tr("The scene has no lights to save as a light rig.")
+            )
+            return
+        selected = [
+            light
+            for light, _type in light_rig_service.find_lights(
+                cmds, cmds.ls(selection=True, long=True) or []
+            )
+        ]
+
+        dialog = LightRigSaveDialog(lights, selected, find_mayapy() is not None, parent=self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_light_rig_path(options["name"])
+        if descriptor_path is None:
+            return
+        saved = light_rig_service.save_light_rig(
+            cmds, options["lights"], descriptor_path, options["notes"]
+        )
+        if saved is None:
+            QMessageBox.warning(self, tr("Save Failed"), f"Could not save {options['name']}.")
+            return
+        self._on_light_rig_saved(saved, options["render_preview"])
+
+    def _on_publish_hdri(self) -> None:
+        """Copy an HDRI image into the library as an environment light rig"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        from PySide6.QtWidgets import QFileDialog
+
+        from ..services.light_rig_service_impl import get_light_rig_service
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.light_rig_save_dialog import LightRigSaveDialog
+
+        image_file, _filter = QFileDialog.getOpenFileName(
+            self, "Choose HDRI Image", "", "HDRI Images (*.hdr *.exr *.tx *.tif *.tiff)"
+        )
+        if not image_file:
+            return
+
+        dialog = LightRigSaveDialog(
+            [], [], find_mayapy() is not None, hdri_file=Path(image_file), parent=self
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_light_rig_path(options["name"])
+        if descriptor_path is None:
+            return
+        saved = get_light_rig_service().save_hdri(
+            Path(image_file), descriptor_path, options["renderer"], options["notes"]
+        )
+        if saved is None:
+            QMessageBox.warning(
+                self, tr("Publish Failed"), f"Could not publish {options['name']}."
+            )
+            return
+        self._on_light_rig_saved(saved, options["render_preview"])
+
+    def _get_light_rig_path(self, name: str) -> Optional[Path]:
+        """Get the descriptor path of a new light rig, None if the artist keeps the old one"""
+        descriptor_path = self._get_publish_directory("lighting") / f"{name}.lightrig"
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Light Rig Exists"),
+                f"{descriptor_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return None
+        return descriptor_path
+
+    def _on_light_rig_saved(self, descriptor_path: Path, render_preview: bool) -> None:
+        """Queue the sphere preview of a saved rig and show it in the library"""
+        # The preview renders in mayapy and shows up when the job finishes
+        if render_preview:
+            self._thumbnail_queue.enqueue(descriptor_path, color=self._get_color_transform())
+        self._set_status(f"Saved light rig: {descriptor_path.name}")
+        self._on_refresh_library()
+
+    def _on_import_light_rig(self, asset: Asset, replace_active: Optional[bool] = None) -> bool:
+        """Load a light rig, asking whether it replaces the active rig when there is one"""
+        if not self._check_permission(ACTION_IMPORT):
+            return False
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Loading light rigs requires Maya."))
+            return False
+
+        from ..services.light_rig_service_impl import get_light_rig_service
+
+        light_rig_service = get_light_rig_service()
+        active_rigs = light_rig_service.find_active_rigs(cmds)
+        if replace_active is None:
+            replace_active = False
+            if active_rigs:
+                names = ", ".join(rig.rsplit("|", 1)[-1] for rig in active_rigs)
+                box = QMessageBox(self)
+                box.setWindowTitle(tr("Light Rig Loaded"))
+                box.setText(f"The scene already has a light rig: {names}")
+                box.setInformativeText(f"Replace it with {asset.display_name}, or add both?")
+                replace_btn = box.addButton("Replace", QMessageBox.ButtonRole.AcceptRole)
+                add_btn = box.addButton("Add", QMessageBox.ButtonRole.ActionRole)
+                box.addButton(QMessageBox.StandardButton.Cancel)
+                box.exec()
+                if box.clickedButton() not in (replace_btn, add_btn):
+                    return False
+                replace_active = box.clickedButton() is replace_btn
+
+        try:
+            with self._undo_chunk(cmds, "importLightRig"):
+                group = light_rig_service.import_light_rig(cmds, asset.file_path, replace_active)
+        except Exception as e:
+            group = None
+            print(f"[ERROR] Failed to load light rig {asset.display_name}: {e}")
+
+        if group is None:
+            QMessageBox.warning(
+                self, tr("Light Rig Failed"), f"Could not load light rig {asset.display_name}."
+            )
+            return False
+
+        if replace_active and active_rigs:
+            self._set_status(f"Replaced the active light rig with {asset.display_name}")
+        else:
+            self._set_status(f"Loaded light rig {asset.display_name} as {group}")
+        self.asset_imported.emit(asset)
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_publish_groom(self) -> None:
+        """Publish the selected grooms (all grooms without a selection) as a groom asset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Publishing grooms requires Maya."))
+            return
+
+        from ..services.groom_service_impl import GROOM_EXTENSION, get_groom_service
+        from .dialogs.groom_publish_dialog import GroomPublishDialog
+
+        groom_service = get_groom_service()
+        grooms = groom_service.find_grooms(cmds)
+        if not grooms:
+            QMessageBox.information(
+                self, tr("No Grooms"), tr("The scene has no XGen or Yeti grooms to publish.")
+            )
+            return
+        selected = [
+            node
+            for node, _type in groom_service.find_grooms(
+                cmds, cmds.ls(selection=True, long=True) or []
+            )
+        ]
+
+        dialog = GroomPublishDialog(grooms, selected, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_publish_directory("grooms") / (
+            f"{options['name']}{GROOM_EXTENSION}"
+        )
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Groom Exists"),
+                f"{descriptor_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        saved = groom_service.save_groom(
+            cmds, options["grooms"], descriptor_path, options["notes"]
+        )
+        if saved is None:
+            QMessageBox.warning(
+                self, tr("Publish Failed"), f"Could not publish {options['name']}."
+            )
+            return
+        if options["capture_thumbnail"]:
+            groom_service.capture_thumbnail(cmds, saved)
+        self._set_status(f"Published groom: {saved.name}")
+        self._on_refresh_library()
+
+    def _on_import_groom(self, asset: Asset) -> bool:
+        """Import a groom bound to scene meshes, asking for them when names or topology differ"""
+        if not self._check_permission(ACTION_IMPORT):
+            return False
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Importing grooms requires Maya."))
+            return False
+
+        from ..services.groom_service_impl import get_groom_service
+        from .dialogs.groom_bind_dialog import GroomBindDialog
+
+        groom_service = get_groom_service()
+        descriptor = groom_service.load_groom(asset.file_path)
+        if descriptor is None:
+            QMessageBox.warning(
+                self, tr("Invalid Groom"), f"{asset.file_path.name} is not a groom asset."
+            )
+            return False
+
+        # Same-named meshes with the same topology bind without asking
+        targets = groom_service.suggest_targets(cmds, descriptor)
+        if groom_service.needs_remap(cmds, descriptor, targets):
+            scene_meshes = groom_service.find_scene_meshes(cmds)
+            dialog = GroomBindDialog(groom_service, cmds, descriptor, targets, scene_meshes, self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return False
+            targets = dialog.get_targets()
+
+        try:
+            with self._undo_chunk(cmds, "importGroom"):
+                result = groom_service.import_groom(cmds, asset.file_path, targets)
+        except Exception as e:
+            result = None
+            print(f"[ERROR] Failed to import groom {asset.display_name}: {e}")
+
+        if result is None or not result.success:
+            QMessageBox.warning(
+                self, tr("Groom Failed"), f"Could not import groom {asset.display_name}."
+            )
+            return False
+
+        unbound = f", {len(result.unbound)} left unbound" if result.unbound else ""
+        self._set_status(
+            f"Imported groom {asset.display_name} as {result.group}: "
+            f"{len(result.bindings)} growth meshes bound{unbound}"
+        )
+        self.asset_imported.emit(asset)
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_publish_volume(self) -> None:
+        """Copy an OpenVDB sequence into the library as a volume asset"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        from PySide6.QtWidgets import QFileDialog
+
+        from ..services.volume_service_impl import (
+            VOLUME_EXTENSION,
+            find_sequence,
+            get_openvdb_module,
+            get_volume_service,
+            read_grid_names,
+        )
+        from .dialogs.volume_publish_dialog import VolumePublishDialog
+
+        vdb_file, _filter = QFileDialog.getOpenFileName(
+            self, "Choose Any Frame of the VDB Sequence", "", "OpenVDB Files (*.vdb)"
+        )
+        if not vdb_file:
+            return
+
+        pattern, frames = find_sequence(Path(vdb_file))
+        grids = read_grid_names(frames[len(frames) // 2][1])
+        dialog = VolumePublishDialog(
+            pattern,
+            [frame for frame, _path in frames],
+            grids,
+            get_openvdb_module() is not None,
+            parent=self,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_publish_directory("fx") / (
+            f"{options['name']}{VOLUME_EXTENSION}"
+        )
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Volume Exists"),
+                f"{descriptor_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        self._set_status(f"Copying {len(frames)} frame(s) of {pattern}...")
+        saved = get_volume_service().save_volume(
+            Path(vdb_file), descriptor_path, options["notes"], options["thumbnail"]
+        )
+        if saved is None:
+            QMessageBox.warning(
+                self, tr("Publish Failed"), f"Could not publish {options['name']}."
+            )
+            return
+        self._set_status(f"Published volume: {saved.name}")
+        self._on_refresh_library()
+
+    def _on_import_volume(self, asset: Asset, mode: Optional[str] = None) -> bool:
+        """Load a volume as an Arnold aiVolume, or as a fluid container without Arnold"""
+        if not self._check_permission(ACTION_IMPORT):
+            return False
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Loading volumes requires Maya."))
+            return False
+
+        from ..services.volume_service_impl import IMPORT_MODE_AUTO, get_volume_service
+
+        self._sync_asset_from_depot(asset)
+        try:
+            with self._undo_chunk(cmds, f"Load {asset.file_path.stem}"):
+                transform = get_volume_service().import_volume(
+                    cmds, asset.file_path, mode or IMPORT_MODE_AUTO
+                )
+        except Exception as e:
+            transform = None
+            print(f"[ERROR] Failed to load volume {asset.display_name}: {e}")
+
+        if transform is None:
+            QMessageBox.warning(
+                self, tr("Volume Failed"), f"Could not load volume {asset.display_name}."
+            )
+            return False
+
+        cmds.select(transform, replace=True)
+        shapes = cmds.listRelatives(transform, shapes=True, fullPath=True) or []
+        fluid = any(cmds.nodeType(shape) == "fluidShape" for shape in shapes)
+        loaded_as = "fluid container" if fluid else "Arnold volume"
+        self._set_status(f"Loaded {asset.display_name} as {loaded_as}")
+        self.asset_imported.emit(asset)
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _on_save_assembly(self) -> None:
+        """Save the scene's library assets (those selected are checked) as an assembly"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Saving assemblies requires Maya."))
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Project"), tr("Load a project to save assemblies of its assets.")
+            )
+            return
+
+        from ..services.assembly_service_impl import ASSEMBLY_EXTENSION, get_assembly_service
+        from .dialogs.assembly_save_dialog import AssemblySaveDialog
+
+        assembly_service = get_assembly_service()
+        scene_assets = self._scene_asset_service.scan(cmds, library_root)
+        if not scene_assets:
+            QMessageBox.information(
+                self, tr("No Library Assets"), tr("The scene has no assets from this library.")
+            )
+            return
+        selected = assembly_service.get_selected(
+            cmds, scene_assets, cmds.ls(selection=True, long=True) or []
+        )
+        scene_name = Path(cmds.file(query=True, sceneName=True) or "").stem
+
+        dialog = AssemblySaveDialog(scene_assets, selected, scene_name or "assembly", parent=self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = (
+            self._get_publish_directory("assemblies") / f"{options['name']}{ASSEMBLY_EXTENSION}"
+        )
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Assembly Exists"),
+                f"{descriptor_path.name} already exists. Save this layout as its next version?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        try:
+            layout = assembly_service.save_assembly(
+                cmds,
+                options["scene_assets"],
+                descriptor_path,
+                library_root,
+                options["notes"],
+                options["pin_latest"],
+            )
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to save assembly:\n{e}")
+            return
+        if layout is None:
+            QMessageBox.warning(self, tr("Save Failed"), f"Could not save {options['name']}.")
+            return
+        self._publish_assembly_version(descriptor_path, options["notes"])
+        self._set_status(f"Saved assembly: {descriptor_path.name} ({layout.summary})")
+        self._on_refresh_library()
+
+    def _publish_assembly_version(self, descriptor_path: Path, notes: str) -> None:
+        """Snapshot a saved assembly so earlier layouts stay in its version history"""
+        version = self._version_service.publish_version(descriptor_path, notes=notes)
+        if version:
+            self._record_publish(descriptor_path, version)
+            self._activity_service.record(
+                self._get_library_root(), ACTIVITY_PUBLISH, descriptor_path, version=version.number
+            )
+
+    def _on_import_assembly(self, asset: Asset, use_latest: Optional[bool] = None) -> None:
+        """Rebuild an assembly, asking whether outdated children load their latest version"""
+        if not self._check_permission(ACTION_IMPORT):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Importing assemblies requires Maya.")
+            )
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Project"), tr("Load the assembly's project to import its assets.")
+            )
+            return
+
+        from ..services.assembly_service_impl import get_assembly_service
+
+        assembly_service = get_assembly_service()
+        layout = assembly_service.load_assembly(asset.file_path)
+        if layout is None:
+            QMessageBox.warning(
+                self, tr("Assembly Failed"), f"{asset.display_name} is not a readable assembly."
+            )
+            return
+
+        if use_latest is None:
+            use_latest = False
+            outdated = assembly_service.get_outdated(layout, library_root)
+            if outdated:
+                box = QMessageBox(self)
+                box.setWindowTitle(tr("Newer Versions Available"))
+                box.setText(f"{len(outdated)} placement(s) in {layout.name} have newer versions.")
+                box.setInformativeText("Load the pinned versions, or the latest ones?")
+                pinned_btn = box.addButton("Pinned", QMessageBox.ButtonRole.AcceptRole)
+                latest_btn = box.addButton("Latest", QMessageBox.ButtonRole.ActionRole)
+                box.addButton(QMessageBox.StandardButton.Cancel)
+                box.exec()
+                if box.clickedButton() not in (pinned_btn, latest_btn):
+                    return
+                use_latest = box.clickedButton() is latest_btn
+
+        hook_context = {
+            "asset_file": asset.file_path,
+            "asset_name": asset.display_name,
+            "mode": "assembly",
+        }
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return
+        try:
+            with self._undo_chunk(cmds, f"Import {asset.name}") as chunk:
+                group = assembly_service.import_assembly(
+                    cmds, asset.file_path, library_root, use_latest
+                )
+                if group is None:
+                    chunk.abort()
+        except Exception as e:
+            group = None
+            print(f"[ERROR] Failed to import assembly {asset.display_name}: {e}")
+        if group is None:
+            QMessageBox.warning(
+                self, tr("Assembly Failed"), f"Could not import assembly {asset.display_name}."
+            )
+            return
+
+        self._refresh_scene_assets()
+        versions = "latest" if use_latest else "pinned"
+        self._set_status(f"Imported assembly {asset.display_name} ({versions} versions)")
+        self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
+        self.asset_imported.emit(asset)
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+
+    def _on_update_assembly(self, asset: Asset) -> None:
+        """Pin an assembly's outdated children to their latest versions"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            return
+
+        from ..core.models.asset_version import format_version_label
+        from ..services.assembly_service_impl import get_assembly_service
+
+        assembly_service = get_assembly_service()
+        try:
+            layout = assembly_service.load_assembly(asset.file_path)
+            outdated = assembly_service.get_outdated(layout, library_root) if layout else []
+            if not outdated:
+                QMessageBox.information(
+                    self,
+                    tr("Assembly Up to Date"),
+                    f"Every asset in {asset.display_name} is pinned to its latest version.",
+                )
+                return
+
+            changes = sorted(
+                {
+                    f"  {child.label} -> {format_version_label(latest)}"
+                    for child, latest in outdated
+                }
+            )
+            reply = QMessageBox.question(
+                self,
+                tr("Update Assembly"),
+                f"Pin these assets of {asset.display_name} to their latest versions?\n\n"
+                + "\n".join(changes),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+            assembly_service.bump_to_latest(asset.file_path, library_root)
+            self._publish_assembly_version(
+                asset.file_path, f"Updated {len(outdated)} placement(s) to latest"
+            )
+            self._set_status(f"Updated {asset.display_name}: {len(outdated)} placement(s)")
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to update assembly:\n{e}")
+
+    def _on_publish_texture_set(self) -> None:
+        """Copy texture maps into the library as one texture set"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        from PySide6.QtWidgets import QFileDialog
+
+        from ..services.texture_set_service_impl import get_texture_set_service, suggest_set_name
+        from .dialogs.texture_set_publish_dialog import TextureSetPublishDialog
+
+        files, _filter = QFileDialog.getOpenFileNames(
+            self,
+            "Choose Texture Maps (all UDIM tiles)",
+            "",
+            "Textures (*.png *.jpg *.jpeg *.tif *.tiff *.exr *.tx *.tga)",
+        )
+        if not files:
+            return
+
+        texture_set_service = get_texture_set_service()
+        maps, unmatched = texture_set_service.group_maps([Path(f) for f in files])
+        if not maps:
+            QMessageBox.information(
+                self,
+                tr("No Texture Maps"),
+                tr("No map type (basecolor, roughness, normal...) was found in the file names."),
+            )
+            return
+
+        first_map = maps.get("basecolor") or next(iter(maps.values()))
+        default_name = suggest_set_name(first_map["source"].name)
+        dialog = TextureSetPublishDialog(maps, unmatched, default_name, parent=self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        descriptor_path = self._get_publish_directory("textures") / f"{options['name']}.texset"
+        if descriptor_path.exists():
+            reply = QMessageBox.question(
+                self,
+                tr("Texture Set Exists"),
+                f"{descriptor_path.name} already exists. Overwrite it?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        saved = texture_set_service.save_texture_set(
+            options["maps"], descriptor_path, options["notes"]
+        )
+        if saved is None:
+            QMessageBox.warning(
+                self, tr("Publish Failed"), f"Could not publish {options['name']}."
+            )
+            return
+        self._set_status(f"Published texture set: {saved.name}")
+        self._on_refresh_library()
+
+    def _on_apply_texture_set(self, asset: Asset) -> bool:
+        """Wire a texture set into the selected aiStandardSurface or standardSurface shaders"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Applying texture sets requires Maya.")
+            )
+            return False
+
+        from ..services.texture_set_service_impl import get_texture_set_service
+
+        texture_set_service = get_texture_set_service()
+        shaders = texture_set_service.find_target_shaders(
+            cmds, cmds.ls(selection=True, long=True) or []
+        )
+        if not shaders:
+            QMessageBox.information(
+                self,
+                tr("No Material Selected"),
+                tr("Select an aiStandardSurface or standardSurface, or a mesh that uses one."),
+            )
+            return False
+
+        try:
+            with self._undo_chunk(cmds, "applyTextureSet"):
+                applied = [
+                    shader
+                    for shader in shaders
+                    if texture_set_service.apply_texture_set(cmds, asset.file_path, shader)
+                ]
+        except Exception as e:
+            applied = []
+            print(f"[ERROR] Failed to apply texture set {asset.display_name}: {e}")
+
+        if not applied:
+            QMessageBox.warning(
+                self, tr("Texture Set Failed"), f"Could not apply {asset.display_name}."
+            )
+            return False
+
+        self._set_status(f"Applied {asset.display_name} to {', '.join(applied)}")
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+        return True
+
+    def _import_tracked_asset(
+        self,
+        asset: Asset,
+        lod_level: Optional[str] = None,
+        conflict: Tuple[str, Optional[ImportConflictReport]] = ("", None),
+    ) -> bool:
+        """Import an asset and record its provenance for the Scene Assets panel"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return self._import_asset_to_maya(asset, lod_level, conflict=conflict)
+
+        from ..services.import_placement_service_impl import get_import_placement_service
+
+        # Placed last, once provenance, rig sets, and materials know the import's roots
+        placement = get_import_placement_service().load_options()
+        target = self._get_import_target(cmds, placement)
+        # Loading, rig sets, materials, and placement are undone as one step
+        with self._undo_chunk(cmds, f"Import {asset.name}") as chunk:
+            roots = self._scene_asset_service.import_tracked(
+                cmds,
+                asset.file_path,
+                lambda: self._import_asset_to_maya(
+                    asset, lod_level, place=False, conflict=conflict
+                ),
+                self._get_library_root(),
+                lod_level or "",
+            )
+            if roots is None:
+                chunk.abort()
+                return False
+            self._setup_imported_rig(cmds, asset, roots)
+            self._setup_imported_camera(cmds, asset, roots)
+            self._convert_imported_materials(cmds, asset, roots)
+            self._place_import(cmds, asset, roots, placement, target)
+        self._refresh_scene_assets()
+        return True
+
+    def _undo_chunk(self, cmds: Any, name: str) -> Any:
+        """Get a context that runs an import or apply as one undo step, rolled back on failure"""
+        from ..services.undo_service_impl import get_undo_service
+
+        return get_undo_service().chunk(cmds, name)
+
+    def _convert_imported_materials(self, cmds: Any, asset: Asset, roots: List[str]) -> None:
+        """Convert an import's materials to the scene's renderer, listing what was left"""
+        if not self._convert_materials_action.isChecked() or not roots:
+            return
+        from ..services.shader_conversion_service_impl import get_shader_conversion_service
+
+        try:
+            report = get_shader_conversion_service().convert_imported(
+                cmds, roots, self._get_library_root()
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not convert materials of {asset.display_name}: {e}")
+            return
+        if report is None or (not report.converted and report.is_clean):
+            return
+        self._set_status(f"{asset.display_name}: {report.summary()}")
+        if report.is_clean:
+            return
+        message = QMessageBox(self)
+        message.setIcon(QMessageBox.Icon.Information)
+        message.setWindowTitle(tr("Material Conversion"))
+        message.setText(
+            f"{report.summary()}.\n\nThese nodes may not render as they did in the "
+            "asset's own renderer."
+        )
+        message.setDetailedText("\n".join(entry.label for entry in report.unconverted))
+        message.exec()
+
+    def _setup_imported_rig(self, cmds: Any, asset: Asset, roots: List[str]) -> None:
+        """Create the controller and character sets of a rig the artist just loaded"""
+        from ..services.rig_service_impl import RIG_METADATA_KEY, get_rig_service
+
+        database = self._get_metadata_database()
+        metadata = database.get_asset_metadata(asset.file_path) if database else None
+        rig_metadata = (metadata or {}).get(RIG_METADATA_KEY)
+        if not rig_metadata or not roots:
+            return
+        try:
+            created = get_rig_service().setup_rig(cmds, roots, rig_metadata, asset.display_name)
+        except Exception as e:
+            print(f"[WARNING] Could not set up rig {asset.display_name}: {e}")
+            return
+        if created:
+            self._set_status(f"Loaded rig {asset.display_name} with {len(created)} set(s)")
+
+    def _get_shot_camera(self, asset: Asset) -> Optional[Any]:
+        """Get the shot camera stored for a camera asset, None for other assets"""
+        from ..services.camera_service_impl import get_camera_service
+
+        database = self._get_metadata_database()
+        metadata = database.get_asset_metadata(asset.file_path) if database else None
+        return get_camera_service().get_camera(metadata)
+
+    def _setup_imported_camera(self, cmds: Any, asset: Asset, roots: List[str]) -> None:
+        """Look through a shot camera the artist just loaded, when they chose to"""
+        from ..services.camera_service_impl import get_camera_service
+
+        camera = self._get_shot_camera(asset)
+        if camera is None or not roots:
+            return
+        look_through = self._look_through_camera_action.isChecked()
+        try:
+            imported = get_camera_service().setup_camera(cmds, roots, camera, look_through)
+        except Exception as e:
+            print(f"[WARNING] Could not set up camera {asset.display_name}: {e}")
+            return
+        if imported:
+            values = {"name": asset.display_name, "description": camera.description}
+            if look_through:
+                self._set_status(tr("Looking through camera {name} ({description})", **values))
+            else:
+                self._set_status(tr("Loaded camera {name} ({description})", **values))
+
+    def _import_asset_to_maya(
+        self,
+        asset: Asset,
+        lod_level: Optional[str] = None,
+        place: bool = True,
+        conflict: Tuple[str, Optional[ImportConflictReport]] = ("", None),
+    ) -> bool:
+        """
+        Import asset to Maya, then tidy its namespaces and place it as the artist chose
+
+        Args:
+            conflict: (resolution, report) of the name clash pre-check; clashing imports
+                load into a namespace of their own, which renaming then flattens again
+        """
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            print("Maya import failed: Maya is not available")
+            return False
+
+        with self._undo_chunk(cmds, f"Import {asset.name}") as chunk:
+            if not self._import_into_scene(cmds, asset, lod_level, place, conflict):
+                chunk.abort()
+                return False
+        return True
+
+    def _import_into_scene(
+        self,
+        cmds: Any,
+        asset: Asset,
+        lod_level: Optional[str],
+        place: bool,
+        conflict: Tuple[str, Optional[ImportConflictReport]],
+    ) -> bool:
+        """Load an asset, resolve its name clashes and namespaces, and place it"""
+        from ..services.import_conflict_service_impl import get_import_conflict_service
+        from ..services.import_placement_service_impl import get_import_placement_service
+        from ..services.namespace_service_impl import get_namespace_service
+
+        resolution, report = conflict
+        conflict_service = get_import_conflict_service()
+        import_namespace = ""
+        if report is not None and resolution in (CONFLICT_NAMESPACE, CONFLICT_SUFFIX):
+            import_namespace = conflict_service.get_import_namespace(cmds, asset.name)
+
+        namespace_service = get_namespace_service()
+        options = namespace_service.load_options()
+        placement_service = get_import_placement_service()
+        placement = placement_service.load_options() if place else ImportPlacementOptions()
+        changes_scene = options.changes_namespaces or placement.changes_placement
+        if not changes_scene and not import_namespace:
+            return self._load_asset_into_maya(asset, lod_level)
+
+        namespaces_before = namespace_service.list_namespaces(cmds)
+        roots_before = set(placement_service.list_roots(cmds))
+        target = self._get_import_target(cmds, placement)
+        if not self._load_asset_into_maya(asset, lod_level, import_namespace):
+            return False
+        if resolution == CONFLICT_SUFFIX and report is not None:
+            renamed = conflict_service.rename_conflicts(cmds, import_namespace, report)
+            if renamed:
+                self._set_status(
+                    f"{asset.display_name}: renamed "
+                    + ", ".join(f"{old} -> {new}" for old, new in renamed.items())
+                )
+        # Cleaning up its own namespace would bring the clashes back
+        if resolution != CONFLICT_NAMESPACE:
+            try:
+                namespace_service.apply_import_options(
+                    cmds, options, namespaces_before, asset.name
+                )
+            except Exception as e:
+                print(f"[WARNING] Could not clean up namespaces of {asset.display_name}: {e}")
+        roots = [root for root in placement_service.list_roots(cmds) if root not in roots_before]
+        self._place_import(cmds, asset, roots, placement, target)
+        return True
+
+    def _get_import_target(
+        self, cmds: Any, placement: ImportPlacementOptions
+    ) -> Optional[Tuple[float, float, float]]:
+        """Read where an import should land before it changes the selection"""
+        from ..services.import_placement_service_impl import get_import_placement_service
+
+        try:
+            return get_import_placement_service().get_target_position(cmds, placement)
+        except Exception as e:
+            print(f"[WARNING] Could not read the import placement target: {e}")
+            return None
+
+    def _place_import(
+        self,
+        cmds: Any,
+        asset: Asset,
+        roots: List[str],
+        placement: ImportPlacementOptions,
+        target: Optional[Tuple[float, float, float]],
+    ) -> List[str]:
+        """Move and group an import's top-level nodes as the placement options say"""
+        from ..services.import_placement_service_impl import get_import_placement_service
+
+        if not placement.changes_placement:
+            return roots
+        if self._get_shot_camera(asset) is not None:
+            return roots  # Shot cameras keep their layout position
+        try:
+            return get_import_placement_service().place(
+                cmds, placement, roots, asset.name, target
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not place {asset.display_name}: {e}")
+            return roots
+
+    def _load_asset_into_maya(
+        self, asset: Asset, lod_level: Optional[str] = None, namespace: str = ""
+    ) -> bool:
+        """Import asset to Maya with proper error handling - Single Responsibility"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            # SAFE FILE PATH VALIDATION - Defensive Programming
+            if not self._validate_asset_file_path(asset):
+                return False
+
+            file_path = str(asset.file_path)
+            file_ext = asset.file_path.suffix.lower()
+            # Scene formats can go into a namespace of their own to avoid name clashes
+            namespace_flags = {"namespace": namespace} if namespace else {}
+
+            from ..services.asset_type_service_impl import get_asset_type_service
+
+            asset_type_service = get_asset_type_service()
+            asset_type_plugin = asset_type_service.get_plugin_for_extension(file_ext)
+
+            # Handle different file types
+            if file_ext in [".ma", ".mb"] and lod_level:
+                # One LOD of an asset with variants; roots are tagged for Swap LODs
+                from ..services.dependency_service_impl import get_dependency_service
+                from ..services.lod_service_impl import get_lod_service
+
+                _roots, new_nodes = get_lod_service().import_variant(
+                    cmds, asset.file_path, lod_level, namespace
+                )
+                get_dependency_service().resolve_relative_paths(asset.file_path, new_nodes)
+                self._relink_imported_textures(cmds, asset.file_path, new_nodes)
+                return True
+            elif file_ext in [".ma", ".mb"]:
+                # Maya scene files
+                new_nodes = cmds.file(
+                    file_path,
+                    i=True,
+                    type="mayaAscii" if file_ext == ".ma" else "mayaBinary",
+                    returnNewNodes=True,
+                    **namespace_flags,
+                )
+                # Collected dependencies are stored relative to the asset folder
+                from ..services.dependency_service_impl import get_dependency_service
+
+                get_dependency_service().resolve_relative_paths(asset.file_path, new_nodes or [])
+                self._relink_imported_textures(cmds, asset.file_path, new_nodes or [])
+                return True
+            elif file_ext in [".obj"]:
+                # OBJ files
+                new_nodes = cmds.file(
+                    file_path, i=True, type="OBJ", returnNewNodes=True, **namespace_flags
+                )
+                self._relink_imported_textures(cmds, asset.file_path, new_nodes or [])
+                return True
+            elif file_ext in [".fbx"]:
+                # FBX files
+                if cmds.pluginInfo("fbxmaya", query=True, loaded=True) or cmds.loadPlugin(
+                    "fbxmaya", quiet=True
+                ):
+                    new_nodes = cmds.file(
+                        file_path, i=True, type="FBX", returnNewNodes=True, **namespace_flags
+                    )
+                    self._relink_imported_textures(cmds, asset.file_path, new_nodes or [])
+                    return True
+                else:
+                    raise RuntimeError("FBX plugin not available")
+            elif file_ext == ".abc":
+                # Alembic caches - full geometry; GPU cache loading is in the context menu
+                from ..services.alembic_service_impl import (
+                    IMPORT_MODE_GEOMETRY,
+                    get_alembic_service,
+                )
+
+                get_alembic_service().import_cache(cmds, asset.file_path, IMPORT_MODE_GEOMETRY)
+                return True
+            elif file_ext in [".usd", ".usda", ".usdc", ".usdz"]:
+                # USD files - Use our custom import service for skinCluster reconstruction
+                try:
+                    from ..services.maya_integration_impl import MayaIntegrationImpl
+
+                    maya_integration = MayaIntegrationImpl()
+                    return maya_integration.import_asset(asset)
+                except Exception as usd_error:
+                    # Fallback error message
+                    raise RuntimeError(f"USD import failed: {usd_error}") from usd_error
+            elif asset_type_plugin is not None:
+                # Studio asset types load through their plugin
+                asset_type_service.import_asset(asset_type_plugin, cmds, asset.file_path)
+                return True
+            else:
+                raise ValueError(f"Unsupported file type: {file_ext}")
+
+        except Exception as e:
+            print(f"Maya import failed: {e}")
+            return False
+
+    def _relink_imported_textures(self, cmds: Any, asset_file: Path, nodes: List[str]) -> None:
+        """Relink missing textures of an imported asset and show what is still missing"""
+        from ..services.texture_relink_service_impl import get_texture_relink_service
+
+        relink_service = get_texture_relink_service()
+        if not nodes or not relink_service.is_relink_on_import():
+            return
+        try:
+            roots = relink_service.get_search_roots(self._get_library_root(), asset_file)
+            links = relink_service.relink(cmds, roots, nodes)
+        except Exception as e:
+            print(f"[WARNING] Texture relink failed for {asset_file.name}: {e}")
+            return
+
+        if any(not link.is_resolved for link in links):
+            self._show_texture_relink_dialog(cmds, links, asset_file)
+
+    def _on_relink_textures(self) -> None:
+        """Relink missing textures of the whole scene - Single Responsibility"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Texture relinking needs Maya."))
+            return
+
+        from ..services.texture_relink_service_impl import get_texture_relink_service
+
+        relink_service = get_texture_relink_service()
+        roots = relink_service.get_search_roots(self._get_library_root())
+        links = relink_service.relink(cmds, roots)
+        if not links:
+            QMessageBox.information(
+                self, tr("No Textures"), tr("The scene has no texture file nodes.")
+            )
+            return
+        self._show_texture_relink_dialog(cmds, links)
+
+    def _show_texture_relink_dialog(
+        self, cmds: Any, links: List[Any], asset_file: Optional[Path] = None
+    ) -> None:
+        """Open the texture fix-it dialog for relink results"""
+        try:
+            from ..services.texture_relink_service_impl import get_texture_relink_service
+            from .dialogs.texture_relink_dialog import TextureRelinkDialog
+
+            dialog = TextureRelinkDialog(
+                get_texture_relink_service(),
+                cmds,
+                links,
+                library_root=self._get_library_root(),
+                asset_file=asset_file,
+                parent=self,
+            )
+            dialog.exec()
+            missing = sum(1 for link in dialog.get_links() if not link.is_resolved)
+            self._set_status(
+                f"{missing} texture(s) still missing" if missing else "All textures linked"
+            )
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Texture Relink:\n{e}")
+
+    def _resolve_import_conflicts(
+        self, asset: Asset, lod_level: Optional[str] = None
+    ) -> Optional[Tuple[str, Optional[ImportConflictReport]]]:
+        """
+        Check an asset's node names against the scene and ask how to import clashes
+
+        Returns:
+            (resolution, report) to import with, ("", None) without clashes; None when
+            the asset is skipped or the import stopped
+        """
+        if not self._check_clashes_action.isChecked():
+            return "", None
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return "", None
+
+        from ..services.import_conflict_service_impl import get_import_conflict_service
+        from ..services.lod_service_impl import get_lod_service
+        from .dialogs.import_conflict_dialog import ImportConflictDialog
+
+        conflict_service = get_import_conflict_service()
+        file_path = asset.file_path
+        if lod_level:
+            file_path = get_lod_service().get_variant_path(asset.file_path, lod_level)
+        report = conflict_service.check_import(cmds, file_path)
+        if not report.has_conflicts:
+            return "", None
+
+        print(f"[WARNING] {report.summary()}")
+        namespace = conflict_service.get_import_namespace(cmds, asset.name)
+        dialog = ImportConflictDialog(asset.display_name, report, namespace, self)
+        resolution = CONFLICT_ABORT
+        if dialog.exec() == QDialog.DialogCode.Accepted:
+            resolution = dialog.get_resolution()
+        if resolution == CONFLICT_SKIP:
+            self._set_status(f"Skipped {asset.display_name}: {report.summary()}")
+            return None
+        if resolution == CONFLICT_ABORT:
+            self._import_stopped = True
+            self._set_status(tr("Import stopped"))
+            return None
+        return resolution, report
+
+    def _choose_lod_level(self, asset: Asset, variants: List[Any], action: str) -> Optional[str]:
+        """Ask which LOD of an asset with variants to load, None when cancelled"""
+        from .dialogs.lod_import_dialog import LodImportDialog
+
+        dialog = LodImportDialog(asset.display_name, variants, action, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return None
+        return dialog.get_level()
+
+    def _on_publish_lod_variant(self, asset: Optional[Asset] = None) -> None:
+        """Publish the selection as another LOD of the current asset - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        asset = asset or self._current_asset
+        if not asset or asset.file_path.suffix.lower() not in (".ma", ".mb"):
+            QMessageBox.information(
+                self, tr("No Maya Asset"), tr("Select the .ma / .mb asset the LOD belongs to.")
+            )
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Publishing LOD variants needs Maya.")
+            )
+            return
+
+        asset_file = asset.file_path
+        if not self._lock_service.can_publish(asset_file):
+            lock = self._lock_service.get_lock(asset_file)
+            owner = lock.description if lock else "another artist"
+            QMessageBox.warning(
+                self, tr("Asset Locked"), f"'{asset.display_name}' is checked out by {owner}."
+            )
+            return
+
+        selection = cmds.ls(selection=True) or []
+        if not selection:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Select the nodes that make up the LOD to publish.")
+            )
+            return
+
+        from ..services.lod_service_impl import get_lod_service
+        from .dialogs.lod_publish_dialog import LodPublishDialog
+
+        lod_service = get_lod_service()
+        dialog = LodPublishDialog(
+            asset.display_name, lod_service.get_variants(asset_file), len(selection), self
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+        level = options["level"]
+
+        if not self._run_publish_validation(selection):
+            self._set_status(f"Publish of {asset.display_name} {level} cancelled by validation")
+            return
+
+        depot_change: Optional[int] = None
+        if self._is_perforce_active(asset_file):
+            depot_change = self._source_control.open_for_publish(
+                self._get_depot_paths(asset_file), f"Publish {asset.display_name} {level}"
+            )
+            if depot_change is None:
+                QMessageBox.warning(
+                    self, tr("Perforce Error"), f"Could not open '{asset.display_name}' for edit."
+                )
+                return
+
+        try:
+            lod_service.publish_variant(cmds, asset_file, level, selection)
+
+            # New version of the asset: same base file and companions, updated LODs
+            lod_files = lod_service.get_lod_files(asset_file)
+            latest = self._version_service.get_latest_version(asset_file)
+            companion_files = [
+                asset_file.parent / companion
+                for companion in (latest.extra.get("companions", []) if latest else [])
+                if (asset_file.parent / companion).is_file()
+            ]
+            companion_files = [p for p in companion_files if p not in lod_files] + lod_files
+            notes = f"{level}: {options['notes']}" if options["notes"] else f"Published {level}"
+            version = self._version_service.publish_version(
+                asset_file, notes=notes, companion_files=companion_files
+            )
+            if version:
+                self._record_publish(asset_file, version)
+                self._activity_service.record(
+                    self._get_library_root(),
+                    ACTIVITY_PUBLISH,
+                    asset_file,
+                    version=version.number,
+                    lod_level=level,
+                )
+        except Exception as e:
+            if depot_change is not None:
+                self._source_control.revert_publish(depot_change)
+            QMessageBox.warning(self, tr("LOD Publish Failed"), f"Could not publish {level}:\n{e}")
+            return
+
+        status = f"Published {asset.display_name} {level}"
+        if version:
+            status += f" ({version.label})"
+        if depot_change is not None:
+            submitted = self._source_control.submit_publish(
+                depot_change, self._get_depot_paths(asset_file)
+            )
+            if submitted:
+                status += f", submitted change {submitted}"
+        self._set_status(status)
+
+    def _on_swap_lods(self) -> None:
+        """Switch LODs of assets loaded in the scene - Single Responsibility"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Swapping LODs needs Maya."))
+            return
+
+        try:
+            from ..services.lod_service_impl import get_lod_service
+            from .dialogs.lod_swap_dialog import LodSwapDialog
+
+            dialog = LodSwapDialog(get_lod_service(), cmds, self)
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Swap LODs:\n{e}")
+
+    def _on_import_as_proxy(self, kind: Optional[str] = None) -> None:
+        """Import the selected asset as its preferred proxy, or the proxy kind given"""
+        asset = self._current_asset
+        if not asset or not self._check_permission(ACTION_IMPORT):
+            return
+        if not self._confirm_deprecated_use(asset):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Importing proxies needs Maya."))
+            return
+
+        from ..services.proxy_service_impl import get_proxy_service
+
+        proxy_service = get_proxy_service()
+        self._sync_asset_from_depot(asset)
+        kinds = {proxy.kind for proxy in proxy_service.get_proxies(asset.file_path)}
+        if not kinds or (kind is not None and kind not in kinds):
+            wanted = "proxy" if kind is None else PROXY_LABELS[kind]
+            QMessageBox.information(
+                self,
+                tr("No Proxy"),
+                f"{asset.display_name} has no {wanted}.\n\n"
+                "Pick one in the Create Asset dialog when publishing it.",
+            )
+            return
+
+        def load() -> bool:
+            try:
+                with self._undo_chunk(cmds, f"Import {asset.name} proxy"):
+                    root = proxy_service.import_proxy(cmds, asset.file_path, kind)
+            except Exception as e:
+                QMessageBox.critical(self, tr("Error"), f"Failed to import proxy:\n{e}")
+                return False
+            self._set_status(f"Imported {asset.display_name} as proxy ({root})")
+            return True
+
+        self._run_with_import_hooks(asset, "proxy", load, kind=kind)
+
+    def _on_swap_proxies(self, to_full: bool) -> None:
+        """Swap every proxy in the scene to full geometry, or back"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Swapping proxies needs Maya."))
+            return
+
+        from ..services.proxy_service_impl import get_proxy_service
+
+        count = get_proxy_service().swap_all(cmds, to_full=to_full)
+        if to_full:
+            self._set_status(f"Swapped {count} proxy(s) to full geometry")
+        else:
+            self._set_status(f"Restored {count} proxy(s)")
+
+    def _on_toggle_render_swap(self, enabled: bool) -> None:
+        """Install or remove the scene's render-time proxy swap"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Render-time proxy swaps need Maya.")
+            )
+            self._render_swap_action.setChecked(False)
+            return
+
+        from ..services.proxy_service_impl import get_proxy_service
+
+        try:
+            if enabled:
+                get_proxy_service().install_render_swap(cmds)
+                self._set_status(
+                    tr("Proxies swap to full geometry at render time - save the scene")
+                )
+            else:
+                get_proxy_service().remove_render_swap(cmds)
+                self._set_status(tr("Proxies no longer swap at render time"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to update render settings:\n{e}")
+
+    def _update_render_swap_action(self) -> None:
+        """Tick the render swap action when the open scene has it installed"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            from ..services.proxy_service_impl import get_proxy_service
+
+            installed = get_proxy_service().is_render_swap_installed(cmds)
+        except Exception:
+            installed = False
+        self._render_swap_action.setChecked(installed)
+
+    def _on_maya_scene_changed(self) -> None:
+        """Stand in for missing references, then check the opened scene for updates"""
+        self._place_missing_asset_placeholders()
+        self._check_reference_updates()
+
+    def _place_missing_asset_placeholders(self) -> None:
+        """Place a box for every reference of the scene whose file is missing"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return
+
+        library_root = self._get_library_root()
+        try:
+            scene_assets = self._scene_asset_service.scan(cmds, library_root)
+            created = self._missing_asset_service.sync(cmds, scene_assets, library_root)
+        except Exception as e:
+            print(f"[WARNING] Missing reference check failed: {e}")
+            return
+        if created:
+            self._set_status(
+                f"{len(created)} referenced file(s) missing - placeholders shown, "
+                "resolve them in the Scene Assets panel"
+            )
+
+    def _check_reference_updates(self) -> None:
+        """Show outdated scene references in the banner and as library badges"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return
+        if not self._reference_update_timer.isActive():
+            self._reference_update_timer.start()
+
+        try:
+            outdated = self._reference_update_service.get_outdated(cmds)
+        except Exception as e:
+            print(f"[WARNING] Reference update check failed: {e}")
+            return
+
+        if self._reference_update_banner is not None:
+            self._reference_update_banner.set_updates(outdated)
+        self._refresh_scene_assets()
+        if self._library_widget:
+            badges: Dict[str, List[str]] = {}
+            for update in outdated:
+                badges.setdefault(str(update.asset_file), []).append(update.label)
+            self._library_widget.set_outdated_assets(
+                {path: ", ".join(labels) for path, labels in badges.items()}
+            )
+
+    def _apply_show_context(self, _checked: bool = False) -> None:
+        """Read the current show and limit the library to it while the show filter is on"""
+        if not self._library_widget:
+            return
+        show = None
+        if self._show_filter_action.isChecked():
+            from ..services.show_context_service_impl import get_show_context_service
+
+            try:
+                import maya.cmds as cmds  # type: ignore
+            except ImportError:
+                cmds = None
+            show = get_show_context_service().get_current_show(cmds)
+            if show is None:
+                self._set_status(
+                    tr("No current show - set ASSETMANAGER_SHOW or the workspace's show variable")
+                )
+            else:
+                self._set_status(f"Listing assets for {show.description}")
+        self._library_widget.set_show_context(show)
+
+    def _on_toggle_scene_assets(self, visible: bool) -> None:
+        """Show or hide the Scene Assets panel - Single Responsibility"""
+        if self._scene_assets_widget is None:
+            return
+        self._scene_assets_widget.setVisible(visible)
+        self._refresh_scene_assets()
+
+    def _refresh_scene_assets(self) -> None:
+        """Scan the scene for the Scene Assets panel while it is shown"""
+        if self._scene_assets_widget is None or not self._scene_assets_widget.isVisible():
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            cmds = None
+        self._scene_assets_widget.refresh(cmds, self._get_library_root())
+
+    def _on_show_in_library(self, asset_file: Path) -> None:
+        """Select a scene asset in the library browser"""
+        if self._library_widget and self._library_widget.reveal_asset(asset_file):
+            self._set_status(f"Showing {asset_file.stem} in the library")
+        else:
+            self._set_status(f"{asset_file.name} is not in the loaded library")
+
+    def _on_replace_scene_asset(self, scene_asset) -> None:
+        """Swap a scene asset for the asset selected in the library, keeping its placement"""
+        asset = self._current_asset
+        if asset is None or asset.file_path == scene_asset.asset_file:
+            self._set_status(tr("Select the replacement asset in the library first"))
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            self._scene_asset_service.replace(cmds, scene_asset, asset.file_path)
+        except Exception as e:
+            QMessageBox.warning(
+                self, tr("Replace Failed"), f"Could not replace {scene_asset.label}:\n{e}"
+            )
+            return
+        self._refresh_scene_assets()
+        self._set_status(f"Replaced {scene_asset.label} with {asset.display_name}")
+
+    def _on_import_asset_parts(self, asset_file: Path, part_paths: List[str]) -> None:
+        """Import only the parts checked in the Parts tab"""
+        asset = self._current_asset
+        if asset is None or asset.file_path != asset_file:
+            return
+        if not self._check_permission(ACTION_IMPORT) or not self._confirm_deprecated_use(asset):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Importing parts requires Maya."))
+            return
+
+        from ..services.maya_integration_impl import make_unique_namespace, sanitize_namespace
+        from ..services.part_import_service_impl import get_part_import_service
+
+        self._sync_asset_from_depot(asset)
+        existing = cmds.namespaceInfo(listOnlyNamespaces=True) or []
+        namespace = make_unique_namespace(sanitize_namespace(asset.display_name), existing)
+        hook_context = {
+            "asset_file": asset.file_path,
+            "asset_name": asset.display_name,
+            "mode": "parts",
+            "file_path": asset.file_path,
+            "namespace": namespace,
+            "parts": list(part_paths),
+        }
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return
+
+        self._set_status(f"Importing {len(part_paths)} part(s) of {asset.display_name}...")
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            with self._undo_chunk(cmds, f"Import parts of {asset.name}"):
+                result = get_part_import_service().import_parts(
+                    cmds, asset.file_path, part_paths, namespace
+                )
+        except Exception as e:
+            self._set_status(f"Part import failed: {e}")
+            QMessageBox.critical(
+                self, tr("Import Failed"), f"Failed to import parts of {asset.display_name}:\n{e}"
+            )
+            return
+        finally:
+            QApplication.restoreOverrideCursor()
+
+        try:
+            self._scene_asset_service.tag_imported(
+                cmds, list(result.roots), asset.file_path, self._get_library_root()
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not record provenance of {asset.file_path.name} parts: {e}")
+        self._refresh_scene_assets()
+        self._set_status(f"Imported {asset.display_name}: {result.summary}")
+        self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
+        self.asset_imported.emit(asset)
+        self._event_publisher.publish(EventType.ASSET_IMPORTED, {"asset": asset})
+        self._repository.update_access_time(asset)
+        database = self._get_metadata_database()
+        if database is not None:
+            database.record_access(asset.file_path)
+
+    def _on_update_in_place(self, asset: Asset) -> None:
+        """Transfer the latest version of an asset onto the copies imported before"""
+        if not self._check_permission(ACTION_IMPORT) or not self._confirm_deprecated_use(asset):
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Updating in place requires Maya."))
+            return
+
+        copies = [
+            scene_asset
+            for scene_asset in self._scene_asset_service.scan(cmds, self._get_library_root())
+            if not scene_asset.is_referenced and scene_asset.asset_file == asset.file_path
+        ]
+        if not copies:
+            QMessageBox.information(
+                self,
+                tr("No Imported Copies"),
+                tr(
+                    "Import {name} first - updates in place go onto copies already in the scene.",
+                    name=asset.display_name,
+                ),
+            )
+            return
+
+        from .dialogs.update_in_place_dialog import UpdateInPlaceDialog
+
+        dialog = UpdateInPlaceDialog(asset.display_name, len(copies), self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        reports, errors = [], []
+
+        def load() -> bool:
+            for scene_asset in copies:
+                try:
+                    _, report = self._scene_asset_service.update_in_place(
+                        cmds, scene_asset, options
+                    )
+                    reports.append(report)
+                except Exception as e:
+                    errors.append(f"{scene_asset.label}: {e}")
+            return bool(reports)
+
+        updated = self._run_with_import_hooks(asset, "update_in_place", load, copies=len(copies))
+        if not updated and not errors:
+            return  # Cancelled by a pre_import hook
+        skipped = [name for report in reports for name in report.skipped]
+        if errors:
+            QMessageBox.warning(self, tr("Update Failed"), "\n".join(errors))
+        elif skipped:
+            QMessageBox.information(self, tr("Some Meshes Skipped"), "\n".join(skipped[:20]))
+        self._refresh_scene_assets()
+        if len(reports) == 1:
+            self._set_status(f"Updated {asset.display_name} in place: {reports[0].summary()}")
+        elif reports:
+            self._set_status(f"Updated {len(reports)} copies of {asset.display_name} in place")
+
+    def _on_review_reference_updates(self) -> None:
+        """List outdated references with per-reference and update-all buttons"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Reference updates need Maya."))
+            return
+
+        from .dialogs.reference_updates_dialog import ReferenceUpdatesDialog
+
+        dialog = ReferenceUpdatesDialog(self._reference_update_service, cmds, self)
+        dialog.references_updated.connect(
+            lambda updated: self._set_status(f"Updated {len(updated)} reference(s) to latest")
+        )
+        dialog.exec()
+        self._check_reference_updates()
+
+    def _on_update_all_references(self) -> None:
+        """Update every outdated scene reference to its latest version"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return
+
+        outdated = self._reference_update_service.get_outdated(cmds)
+        updated, errors = self._reference_update_service.update_all(cmds, outdated)
+        if errors:
+            QMessageBox.warning(self, tr("Update Failed"), "\n".join(errors))
+        lost_edits = [edit for update in updated for edit in update.lost_edits]
+        if lost_edits:
+            from .dialogs.reference_edits_dialog import ReferenceEditsDialog
+
+            ReferenceEditsDialog(self._reference_update_service, cmds, lost_edits, self).exec()
+        self._set_status(f"Updated {len(updated)} of {len(outdated)} reference(s) to latest")
+        self._check_reference_updates()
+
+    def _validate_asset_file_path(self, asset: Asset) -> bool:
+        """
+        Validate asset file path before Maya operations - Defensive Programming
+        Prevents QUrl crashes from invalid/null paths
+        Single Responsibility: File path validation only
+        """
+        try:
+            # Check if asset exists
+            if not asset:
+                print("[ERROR] Asset validation failed: No asset provided")
+                return False
+
+            # Check if file_path attribute exists
+            if not hasattr(asset, "file_path"):
+                print("[ERROR] Asset validation failed: No file_path attribute")
+                return False
+
+            # Check if file_path is not None/empty
+            if not asset.file_path:
+                print("[ERROR] Asset validation failed: Empty file path")
+                return False
+
+            # Convert to Path object for validation
+            file_path = Path(asset.file_path)
+
+            # Check if path is absolute and exists
+            if not file_path.is_absolute():
+                print(f"[ERROR] Asset validation failed: Relative path not allowed: {file_path}")
+                return False
+
+            if not file_path.exists():
+                print(f"[ERROR] Asset validation failed: File does not exist: {file_path}")
+                return False
+
+            if not file_path.is_file():
+                print(f"[ERROR] Asset validation failed: Path is not a file: {file_path}")
+                return False
+
+            # Check file extension
+            if not file_path.suffix:
+                print(f"[ERROR] Asset validation failed: No file extension: {file_path}")
+                return False
+
+            print(f"[OK] Asset file path validated: {file_path.name}")
+            return True
+
+        except Exception as e:
+            print(f"[ERROR] Asset validation error: {e}")
+            return False
+
+    def _on_new_project(self) -> None:
+        """Handle new project creation - Enhanced with functional dialog"""
+        try:
+            # Use built-in dialogs for robust functionality
+            from PySide6.QtWidgets import QFileDialog, QInputDialog
+
+            # Get project name
+            project_name, ok = QInputDialog.getText(
+                self, "New Project", "Project name:", text="MyProject"
+            )
+
+            if not ok or not project_name.strip():
+                return
+
+            # Get project location
+            parent_dir = QFileDialog.getExistingDirectory(
+                self, "Select Parent Directory for New Project", str(Path.home() / "Documents")
+            )
+
+            if not parent_dir:
+                return
+
+            # Create project directory structure
+            project_path = Path(parent_dir) / project_name.strip()
+            success = self._create_project_structure(project_path)
+
+            if success:
+                self._load_project(project_path)
+                self._set_status(f"Created new project: {project_name}")
+                QMessageBox.information(
+                    self,
+                    tr("Project Created"),
+                    f"New project '{project_name}' created successfully!\n\nLocation: {project_path}",
+                )
+            else:
+                self._set_status(tr("Failed to create project"))
+
+        except Exception as e:
+            error_msg = f"Failed to create new project: {e}"
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Error"), error_msg)
+
+    def _create_project_structure(self, project_path: Path) -> bool:
+        """Create standard project directory structure - Single Responsibility"""
+        try:
+            # Create main project directory
+            project_path.mkdir(parents=True, exist_ok=True)
+
+            # Create standard subdirectories for asset organization
+            subdirs = [
+                "assets",  # Main assets folder
+                "assets/models",  # 3D models
+                "assets/textures",  # Texture files
+                "assets/materials",  # Material definitions
+                "assets/rigs",  # Character/object rigs
+                "assets/animations",  # Animation files
+                "assets/scenes",  # Maya scene files
+                "assets/references",  # Reference files
+                "exports",  # Exported files
+                "temp",  # Temporary files
+                "docs",  # Project documentation
+            ]
+
+            for subdir in subdirs:
+                (project_path / subdir).mkdir(parents=True, exist_ok=True)
+
+            # Create project configuration file
+            config_file = project_path / "project.json"
+            from datetime import datetime
+
+            project_config = {
+                "name": project_path.name,
+                "created": datetime.now().isoformat(),
+                "version": "1.0",
+                "asset_manager_version": "1.5.0",
+                "description": f"Asset Manager project: {project_path.name}",
+                "directories": {
+                    "assets": "assets",
+                    "exports": "exports",
+                    "temp": "temp",
+                    "docs": "docs",
+                },
+            }
+
+            with open(config_file, "w", encoding="utf-8") as f:
+                json.dump(project_config, f, indent=4)
+
+            # Create README file
+            readme_file = project_path / "README.md"
+            readme_content = f"""# {project_path.name}
+
+Asset Manager Project created on {datetime.now().strftime('%Y-%m-%d %H:%M:%S')}
+
+## Directory Structure
+
+- **assets/** - Main asset library (only asset files will appear in the Asset Manager)
+  - **models/** - 3D models and geometry (.ma, .mb, .obj, .fbx, .abc, .usd)
+  - **textures/** - Texture and image files (.png, .jpg, .tiff, .exr, .hdr)
+  - **materials/** - Material definitions (.mtl, .mat)
+  - **rigs/** - Character and object rigs
+  - **animations/** - Animation files
+  - **scenes/** - Maya scene files
+  - **references/** - Reference files
+- **exports/** - Exported and published assets
+- **temp/** - Temporary working files (excluded from asset library)
+- **docs/** - Project documentation
+
+## Important Notes
+
+- **Project management files** (project.json, README.md) are automatically excluded from the asset library
+- **Hidden files** and **temp directories** are not shown as assets
+- Only supported asset file types appear in the Asset Manager interface
+
+## Usage
+
+This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface to:
+- Browse and organize assets
+- Import assets into Maya
+- Create new assets from scenes
+- Manage asset metadata and tags
+
+"""
+
+            with open(readme_file, "w", encoding="utf-8") as f:
+                f.write(readme_content)
+
+            print(f"[OK] Created project structure at: {project_path}")
+            return True
+
+        except Exception as e:
+            print(f"[ERROR] Failed to create project structure: {e}")
+            return False
+
+    def _on_open_project(self) -> None:
+        """Handle project opening - Enhanced with validation"""
+        from PySide6.QtWidgets import QFileDialog
+
+        project_path = QFileDialog.getExistingDirectory(
+            self, "Open Asset Manager Project", str(Path.home() / "Documents")
+        )
+
+        if project_path:
+            project_dir = Path(project_path)
+
+            # Validate project directory
+            if self._validate_project_directory(project_dir):
+                self._load_project(project_dir)
+                self._set_status(f"Opened project: {project_dir.name}")
+            else:
+                # Ask if user wants to initialize as new project
+                reply = QMessageBox.question(
+                    self,
+                    tr("Initialize Project?"),
+                    f"'{project_dir.name}' doesn't appear to be an Asset Manager project.\n\n"
+                    "Would you like to initialize it as a new project?",
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.No,
+                )
+
+                if reply == QMessageBox.StandardButton.Yes:
+                    success = self._create_project_structure(project_dir)
+                    if success:
+                        self._load_project(project_dir)
+                        self._set_status(f"Initialized and opened project: {project_dir.name}")
+
+    def _on_set_project(self) -> None:
+        """Handle setting an existing project as the current Asset Library - Single Responsibility"""
+        from PySide6.QtWidgets import QFileDialog
+
+        try:
+            project_path = QFileDialog.getExistingDirectory(
+                self, "Set Asset Manager Project", str(Path.home() / "Documents")
+            )
+
+            if project_path:
+                project_dir = Path(project_path)
+
+                # Validate project directory - must be a valid project
+                if self._validate_project_directory(project_dir):
+                    # Load the project directly
+                    self._load_project(project_dir)
+                    self._set_status(f"Set project: {project_dir.name}")
+
+                    # Show success message with project info
+                    project_info = self._get_project_info(project_dir)
+                    message = (
+                        f"Project '{project_dir.name}' has been set as the current Asset Library."
+                    )
+                    if project_info:
+                        message += f"\n\nProject Details:\n{project_info}"
+
+                    QMessageBox.information(self, tr("Project Set"), message)
+
+                else:
+                    # Provide more specific feedback for invalid projects
+                    QMessageBox.warning(
+                        self,
+                        tr("Invalid Project"),
+                        f"'{project_dir.name}' is not a valid Asset Manager project.\n\n"
+                        "Please select a directory that contains:\n"
+                        "• A project.json file, or\n"
+                        "• An 'assets' folder with asset files\n\n"
+                        "Use 'File > New Project...' to create a new project, or\n"
+                        "Use 'File > Open Project...' to initialize an existing directory.",
+                    )
+
+        except Exception as e:
+            error_msg = f"Failed to set project: {str(e)}"
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Set Project Error"), error_msg)
+
+    def _refresh_library_picker(self) -> None:
+        """List known libraries in the toolbar picker with the loaded one selected"""
+        if self._library_combo is None:
+            return
+        from ..services.vendor_library_service_impl import get_vendor_library_service
+
+        vendor_service = get_vendor_library_service()
+        current = self._offline_library or self._get_library_root()
+        self._library_combo.blockSignals(True)
+        self._library_combo.clear()
+        for entry in self._library_registry.get_libraries():
+            self._library_combo.addItem(entry.label, str(entry.root_path))
+            index = self._library_combo.count() - 1
+            tooltip = str(entry.root_path)
+            if entry.is_mounted:
+                tooltip += f"\nMounted by Maya project {entry.mounted_by}"
+            vendor_library = vendor_service.load_vendor_library(entry.root_path)
+            if vendor_library is not None:
+                tooltip += f"\nRead-only vendor library: {vendor_library.license.label}"
+            self._library_combo.setItemData(index, tooltip, Qt.ItemDataRole.ToolTipRole)
+            if current is not None and Path(entry.root_path) == current:
+                self._library_combo.setCurrentIndex(index)
+        if current is None:
+            self._library_combo.setCurrentIndex(-1)
+        self._library_combo.blockSignals(False)
+
+    def _on_library_picked(self, index: int) -> None:
+        """Load the library chosen in the toolbar picker - Single Responsibility"""
+        if self._library_combo is None or index < 0:
+            return
+        root_path = Path(self._library_combo.itemData(index))
+        if root_path == (self._offline_library or self._get_library_root()):
+            return
+        if not root_path.is_dir() and not self._offline_cache.has_cache(root_path):
+            QMessageBox.warning(
+                self, tr("Library Not Found"), f"The library folder is not available:\n{root_path}"
+            )
+            self._refresh_library_picker()
+            return
+        self._load_project(root_path)
+
+    def _on_maya_project_changed(self) -> None:
+        """Mount the libraries the new Maya project lists and load its default one"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            maya_project: Optional[Path] = Path(cmds.workspace(query=True, rootDirectory=True))
+        except Exception:
+            maya_project = None
+
+        default_library = self._library_registry.mount_project(maya_project)
+        self._refresh_library_picker()
+        current = self._offline_library or self._get_library_root()
+        current_entry = self._library_registry.find_library(current) if current else None
+        if default_library is None or (current_entry is not None and current_entry.is_mounted):
+            return  # No project libraries, or one of them is already loaded
+        if default_library.root_path.is_dir() or self._offline_cache.has_cache(
+            default_library.root_path
+        ):
+            self._load_project(default_library.root_path)
+            self._set_status(f"Maya project set - loaded library {default_library.label}")
+        else:
+            print(f"[WARNING] Project library not found: {default_library.root_path}")
+
+    def _on_manage_libraries(self) -> None:
+        """Open the library registry - Single Responsibility"""
+        try:
+            from .dialogs.library_registry_dialog import LibraryRegistryDialog
+
+            dialog = LibraryRegistryDialog(self._library_registry, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._refresh_library_picker()
+                self._set_status(tr("Library registry saved"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Manage Libraries:\n{str(e)}")
+
+    def _on_export_library_package(self) -> None:
+        """Package the loaded library into one file - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load a library to export."))
+            return
+
+        from PySide6.QtWidgets import QFileDialog
+        from ..services.library_migration_service_impl import (
+            PACKAGE_EXTENSION,
+            get_library_migration_service,
+        )
+
+        package_file, _ = QFileDialog.getSaveFileName(
+            self,
+            "Export Library Package",
+            str(library_root.parent / f"{library_root.name}{PACKAGE_EXTENSION}"),
+            f"Library Packages (*{PACKAGE_EXTENSION})",
+        )
+        if not package_file:
+            return
+        try:
+            self._set_status(f"Packaging {library_root.name}...", show_progress=True)
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                report = get_library_migration_service().export_package(
+                    library_root, Path(package_file)
+                )
+            finally:
+                QApplication.restoreOverrideCursor()
+            self._set_status(f"Exported {Path(package_file).name}: {report.summary()}")
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to export library package:\n{e}")
+
+    def _on_import_library_package(self) -> None:
+        """Unpack a library package into a new folder and open it - Single Responsibility"""
+        from PySide6.QtWidgets import QFileDialog
+        from ..services.library_migration_service_impl import (
+            PACKAGE_EXTENSION,
+            get_library_migration_service,
+        )
+
+        package_file, _ = QFileDialog.getOpenFileName(
+            self, "Import Library Package", "", f"Library Packages (*{PACKAGE_EXTENSION})"
+        )
+        if not package_file:
+            return
+        target = QFileDialog.getExistingDirectory(self, "Unpack Library Into")
+        if not target:
+            return
+        try:
+            self._set_status(f"Unpacking {Path(package_file).name}...", show_progress=True)
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                report = get_library_migration_service().import_package(
+                    Path(package_file), Path(target)
+                )
+            finally:
+                QApplication.restoreOverrideCursor()
+        except FileExistsError as e:
+            QMessageBox.warning(self, tr("Folder Not Empty"), f"Choose an empty folder.\n\n{e}")
+            return
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to import library package:\n{e}")
+            return
+        self._show_migration_report("Library Imported", report)
+        self._load_project(Path(target))
+
+    def _on_export_bundle(self) -> None:
+        """Package the selected assets for delivery - Single Responsibility"""
+        library_root = self._get_library_root()
+        selected = self._library_widget.get_selected_assets() if self._library_widget else []
+        if not selected and self._current_asset:
+            selected = [self._current_asset]
+        if library_root is None or not selected:
+            QMessageBox.information(self, tr("No Selection"), tr("Select the assets to bundle."))
+            return
+
+        from PySide6.QtWidgets import QFileDialog
+        from ..services.asset_bundle_service_impl import (
+            BUNDLE_EXTENSION,
+            get_asset_bundle_service,
+        )
+        from .dialogs.bundle_export_dialog import BundleExportDialog
+
+        dialog = BundleExportDialog([asset.name for asset in selected], self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        asset_files = [Path(asset.file_path) for asset in selected]
+        try:
+            planned, _members = get_asset_bundle_service().plan_bundle(
+                library_root, asset_files, **dialog.get_options()
+            )
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to plan asset bundle:\n{e}")
+            return
+        if planned.restricted_licenses and not self._confirm_restricted_licenses(planned):
+            return
+        default_name = selected[0].name if len(selected) == 1 else library_root.name
+        bundle_file, _ = QFileDialog.getSaveFileName(
+            self,
+            "Export Asset Bundle",
+            str(library_root.parent / f"{default_name}_bundle{BUNDLE_EXTENSION}"),
+            f"Asset Bundles (*{BUNDLE_EXTENSION})",
+        )
+        if not bundle_file:
+            return
+        try:
+            self._set_status(f"Bundling {len(selected)} asset(s)...", show_progress=True)
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                bundle = get_asset_bundle_service().export_bundle(
+                    library_root,
+                    asset_files,
+                    Path(bundle_file),
+                    **dialog.get_options(),
+                )
+            finally:
+                QApplication.restoreOverrideCursor()
+            self._set_status(f"Exported {Path(bundle_file).name}: {bundle.summary()}")
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to export asset bundle:\n{e}")
+
+    def _on_import_bundle(self) -> None:
+        """Add the assets of a delivery bundle to the library - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load the library to import into."))
+            return
+
+        from PySide6.QtWidgets import QFileDialog
+        from ..services.asset_bundle_service_impl import (
+            BUNDLE_EXTENSION,
+            get_asset_bundle_service,
+        )
+
+        bundle_file, _ = QFileDialog.getOpenFileName(
+            self, "Import Asset Bundle", "", f"Asset Bundles (*{BUNDLE_EXTENSION})"
+        )
+        if not bundle_file:
+            return
+        service = get_asset_bundle_service()
+        try:
+            try:
+                report = service.import_bundle(Path(bundle_file), library_root)
+            except FileExistsError as e:
+                reply = QMessageBox.question(
+                    self,
+                    tr("Assets Already in Library"),
+                    f"{e}\n\nReplace them with the bundle's files?",
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.No,
+                )
+                if reply != QMessageBox.StandardButton.Yes:
+                    return
+                report = service.import_bundle(Path(bundle_file), library_root, overwrite=True)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to import asset bundle:\n{e}")
+            return
+        self._show_migration_report("Bundle Imported", report)
+        self._on_refresh_library(full_scan=True)
+
+    def _on_migrate_library(self) -> None:
+        """Rewrite the loaded library's paths from its old location - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load the moved library first."))
+            return
+
+        old_root, ok = QInputDialog.getText(
+            self,
+            "Migrate Moved Library",
+            f"Old location of {library_root.name}\n"
+            "(paths stored under it are moved to this library):",
+        )
+        if not ok:
+            return
+        try:
+            from ..services.library_migration_service_impl import get_library_migration_service
+
+            path_map = [(old_root.strip(), library_root.as_posix())] if old_root.strip() else []
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                report = get_library_migration_service().migrate(library_root, path_map)
+            finally:
+                QApplication.restoreOverrideCursor()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to migrate library:\n{e}")
+            return
+        self._show_migration_report("Library Migrated", report)
+        self._on_refresh_library(full_scan=True)
+
+    def _show_migration_report(self, title: str, report) -> None:
+        """Tell the artist what a migration moved and what still needs relinking"""
+        self._set_status(report.summary())
+        if report.is_clean:
+            QMessageBox.information(self, title, report.summary())
+            return
+        details = [f"Relink in Maya: {path.name}" for path in report.needs_relink[:20]]
+        details += report.warnings[:20]
+        QMessageBox.warning(self, title, report.summary() + "\n\n" + "\n".join(details))
+
+    def _on_toggle_offline_mode(self, enabled: bool) -> None:
+        """Switch between the library and its local cache - Single Responsibility"""
+        if enabled == (self._offline_library is not None):
+            return
+        if not enabled:
+            self._go_online()
+            return
+
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("Work Offline"), tr("Load a library before working offline.")
+            )
+            self._set_offline_action_checked(False)
+            return
+        self._set_status(tr("Caching most used assets for offline use..."), show_progress=True)
+        self._offline_cache.mirror_most_used(library_root)
+        self._enter_offline_mode(library_root, automatic=False)
+
+    def _on_update_offline_cache(self) -> None:
+        """Copy the most used assets of the loaded library to its local cache"""
+        library_root = self._get_library_root()
+        if library_root is None or self._offline_library is not None:
+            QMessageBox.information(
+                self,
+                tr("Update Offline Cache"),
+                tr("Load a reachable library to update its cache."),
+            )
+            return
+        self._set_status(tr("Caching most used assets for offline use..."), show_progress=True)
+        cached = self._offline_cache.mirror_most_used(library_root)
+        self._set_status(f"Cached {len(cached)} most used asset(s) for offline use")
+
+    def _on_sync_offline_publishes(self) -> None:
+        """Copy publishes queued in the local cache to the library on request"""
+        library_root = self._offline_library or self._get_library_root()
+        if library_root is None:
+            return
+        pending = self._offline_cache.get_pending_publishes(library_root)
+        if not pending:
+            self._set_status(tr("No offline publishes to sync"))
+            return
+        if not self._offline_cache.is_reachable(library_root):
+            QMessageBox.information(
+                self,
+                tr("Library Not Reachable"),
+                f"{len(pending)} publish(es) stay queued until the library is available:\n"
+                f"{library_root}",
+            )
+            return
+        self._sync_offline_publishes(library_root)
+        self._on_refresh_library()
+
+    def _update_identity_status(self) -> None:
+        """Show who is signed in, or that nobody is while a sign-in backend is set up"""
+        from ..services.identity_service_impl import get_identity_service
+
+        identity_service = get_identity_service()
+        identity = identity_service.get_identity()
+        if identity.verified:
+            self._identity_btn.setText(tr("Signed in as {name}", name=identity.label))
+        elif identity_service.requires_sign_in():
+            self._identity_btn.setText(tr("Not signed in"))
+        else:
+            self._identity_btn.setText(identity.user_name)
+
+    def _on_sign_in(self) -> None:
+        """Sign in with the studio account, replacing the artist signed in before"""
+        from ..services.identity_service_impl import BACKEND_OS, get_identity_service
+
+        identity_service = get_identity_service()
+        if identity_service.get_backend_name() == BACKEND_OS:
+            QMessageBox.information(
+                self,
+                tr("Sign-In Not Set Up"),
+                tr(
+                    "The workstation login is used until a sign-in backend is chosen in "
+                    "File > Identity Settings."
+                ),
+            )
+            return
+        try:
+            from .dialogs.sign_in_dialog import SignInDialog
+
+            dialog = SignInDialog(identity_service, self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+            identity = dialog.get_identity()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), tr("Failed to sign in:\n{error}", error=e))
+            return
+        self._update_identity_status()
+        self._check_mentions()
+        self._set_status(tr("Signed in as {name}", name=identity.label))
+
+    def _on_sign_out(self) -> None:
+        """End the session so another artist can sign in at this workstation"""
+        from ..services.identity_service_impl import get_identity_service
+
+        identity_service = get_identity_service()
+        identity = identity_service.get_identity()
+        if not identity.verified:
+            self._set_status(tr("Nobody is signed in"))
+            return
+        identity_service.sign_out()
+        self._update_identity_status()
+        self._check_mentions()
+        self._set_status(tr("Signed out {name}", name=identity.label))
+
+    def _on_identity_settings(self) -> None:
+        """Open the sign-in backend configuration - Single Responsibility"""
+        try:
+            from ..services.identity_service_impl import get_identity_service
+            from .dialogs.identity_settings_dialog import IdentitySettingsDialog
+
+            dialog = IdentitySettingsDialog(get_identity_service(), self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._update_identity_status()
+        except Exception as e:
+            QMessageBox.critical(
+                self, tr("Error"), tr("Failed to open identity settings:\n{error}", error=e)
+            )
+
+    def _enter_offline_mode(self, library_root: Path, automatic: bool) -> None:
+        """
+        Browse the local cache of a library instead of the library
+
+        Args:
+            library_root: Library the cache mirrors
+            automatic: The library is unreachable; go back online once it can be reached
+        """
+        self._offline_library = Path(library_root)
+        self._set_offline_action_checked(True)
+        if self._library_widget:
+            self._library_widget.load_project(self._offline_cache.get_cache_root(library_root))
+        self._refresh_library_picker()
+        self._refresh_collections_display()
+        if automatic:
+            self._offline_timer.start()
+
+        pending = len(self._offline_cache.get_pending_publishes(library_root))
+        queued = f" - {pending} publish(es) queued" if pending else ""
+        self._set_status(f"Working offline from the local cache of {library_root.name}{queued}")
+
+    def _leave_offline_mode(self) -> None:
+        """Forget the cached library before another library is loaded"""
+        self._offline_timer.stop()
+        self._offline_library = None
+        self._set_offline_action_checked(False)
+
+    def _go_online(self) -> None:
+        """Load the library of the cache again, syncing queued publishes first"""
+        library_root = self._offline_library
+        if library_root is None:
+            return
+        if not self._offline_cache.is_reachable(library_root):
+            QMessageBox.warning(
+                self,
+                tr("Library Not Reachable"),
+                f"The library is still not available:\n{library_root}\n\n"
+                "Publishes stay queued in the local cache.",
+            )
+            self._set_offline_action_checked(True)
+            return
+        self._load_project(library_root)
+
+    def _check_library_reachable(self) -> None:
+        """Go back online as soon as the library of the loaded cache can be reached"""
+        if self._offline_library is None:
+            self._offline_timer.stop()
+        elif self._offline_cache.is_reachable(self._offline_library):
+            print(f"[OK] Library reachable again: {self._offline_library}")
+            self._go_online()
+
+    def _sync_offline_publishes(self, library_root: Path) -> None:
+        """Copy publishes queued in the local cache to the library and report leftovers"""
+        if not self._offline_cache.get_pending_publishes(library_root):
+            return
+        result = self._offline_cache.sync(library_root)
+        if result.synced:
+            self._set_status(
+                f"Synced {len(result.synced)} offline publish(es) to {library_root.name}"
+            )
+        leftovers = [f"{path.name} (changed in the library)" for path in result.conflicts]
+        leftovers += [f"{path.name} (copy failed)" for path in result.failed]
+        if leftovers:
+            QMessageBox.warning(
+                self,
+                tr("Offline Publishes Not Synced"),
+                "These assets stay queued in the local cache:\n\n" + "\n".join(leftovers),
+            )
+
+    def _cache_for_offline(self, asset: Asset) -> None:
+        """Keep assets the artist imports in the offline cache of a cached library"""
+        library_root = self._get_library_root()
+        if self._offline_library is None and library_root is not None:
+            if self._offline_cache.has_cache(library_root):
+                self._offline_cache.cache_asset(library_root, asset.file_path)
+
+    def _set_offline_action_checked(self, checked: bool) -> None:
+        """Show the offline state in the menu without switching modes"""
+        self._offline_action.blockSignals(True)
+        self._offline_action.setChecked(checked)
+        self._offline_action.blockSignals(False)
+
+    def _get_project_info(self, project_path: Path) -> str:
+        """Get project information for display - Single Responsibility"""
+        try:
+            info_parts = []
+
+            # Check for project.json
+            config_file = project_path / "project.json"
+            if config_file.exists():
+                try:
+                    with open(config_file, "r", encoding="utf-8") as f:
+                        config = json.load(f)
+
+                    if "created" in config:
+                        from datetime import datetime
+
+                        created_date = datetime.fromisoformat(
+                            config["created"].replace("Z", "+00:00")
+                        )
+                        info_parts.append(f"Created: {created_date.strftime('%Y-%m-%d %H:%M')}")
+
+                    if "version" in config:
+                        info_parts.append(f"Version: {config['version']}")
+
+                    if "description" in config:
+                        info_parts.append(f"Description: {config['description']}")
+
+                except Exception:
+                    pass  # Skip if config can't be read
+
+            # Count assets
+            assets_dir = project_path / "assets"
+            if assets_dir.exists():
+                asset_extensions = [
+                    ".ma",
+                    ".mb",
+                    ".obj",
+                    ".fbx",
+                    ".abc",
+                    ".usd",
+                    ".usda",
+                    ".usdc",
+                    ".usdz",
+                    ".png",
+                    ".jpg",
+                    ".jpeg",
+                    ".tiff",
+                    ".tga",
+                    ".exr",
+                    ".hdr",
+                ]
+                asset_count = 0
+                for ext in asset_extensions:
+                    asset_count += len(list(assets_dir.rglob(f"*{ext}")))
+
+                info_parts.append(f"Assets: {asset_count} files")
+
+            # Add location
+            info_parts.append(f"Location: {project_path}")
+
+            return "\n".join(info_parts)
+
+        except Exception:
+            return f"Location: {project_path}"
+
+    def _on_delete_project(self) -> None:
+        """Handle deleting an existing project permanently - Single Responsibility with Safety Measures"""
+        from PySide6.QtWidgets import QFileDialog
+
+        try:
+            # Show warning dialog first
+            initial_warning = QMessageBox.warning(
+                self,
+                tr("[WARNING] Delete Project - WARNING"),
+                tr(
+                    "[DELETE] This action will PERMANENTLY DELETE an entire project and all its files.\n\n"
+                    "This includes:\n"
+                    "• All asset files (.ma, .mb, .obj, .fbx, textures, etc.)\n"
+                    "• Project configuration and metadata\n"
+                    "• All subdirectories and documentation\n"
+                    "• Thumbnail cache and generated files\n\n"
+                    "[TIP] Consider backing up important files before proceeding.\n\n"
+                    "Are you sure you want to continue?"
+                ),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+
+            if initial_warning != QMessageBox.StandardButton.Yes:
+                return
+
+            # Select project to delete
+            project_path = QFileDialog.getExistingDirectory(
+                self,
+                "[WARNING] Select Project to DELETE PERMANENTLY",
+                str(Path.home() / "Documents"),
+            )
+
+            if not project_path:
+                return
+
+            project_dir = Path(project_path)
+            if not self._check_permission(ACTION_MANAGE, project_dir):
+                return
+
+            # Validate it's actually a project
+            if not self._validate_project_directory(project_dir):
+                QMessageBox.warning(
+                    self,
+                    tr("Not a Project"),
+                    f"'{project_dir.name}' is not a valid Asset Manager project.\n\n"
+                    "Only Asset Manager projects can be deleted using this function.\n"
+                    "Please select a directory that contains project.json or an 'assets' folder.",
+                )
+                return
+
+            # Get project information for confirmation
+            project_info = self._get_project_info(project_dir)
+
+            # Final confirmation with detailed info
+            final_confirmation = QMessageBox.critical(
+                self,
+                tr("[DELETE] FINAL CONFIRMATION - DELETE PROJECT"),
+                f"[WARNING] LAST CHANCE TO CANCEL [WARNING]\n\n"
+                f"You are about to PERMANENTLY DELETE:\n"
+                f"[FOLDER] Project: {project_dir.name}\n\n"
+                f"Project Details:\n{project_info}\n\n"
+                f"[ALERT] THIS ACTION CANNOT BE UNDONE! [ALERT]\n\n"
+                f"Type the project name '{project_dir.name}' to confirm deletion.\n"
+                f"This is your final protection against accidental deletion.",
+                QMessageBox.StandardButton.Ok | QMessageBox.StandardButton.Cancel,
+                QMessageBox.StandardButton.Cancel,
+            )
+
+            if final_confirmation != QMessageBox.StandardButton.Ok:
+                self._set_status(tr("Project deletion cancelled"))
+                return
+
+            # Ask user to type project name for final confirmation
+            from PySide6.QtWidgets import QInputDialog
+
+            typed_name, ok = QInputDialog.getText(
+                self,
+                "Type Project Name to Confirm",
+                f"Type the exact project name to confirm deletion:\n'{project_dir.name}'",
+                text="",
+            )
+
+            if not ok or typed_name.strip() != project_dir.name:
+                QMessageBox.information(
+                    self,
+                    tr("Deletion Cancelled"),
+                    tr("Project name did not match. Deletion cancelled for safety."),
+                )
+                self._set_status(tr("Project deletion cancelled - name mismatch"))
+                return
+
+            # Check if we're deleting the current project
+            current_project_path = None
+            if (
+                self._library_widget
+                and hasattr(self._library_widget, "current_project_path")
+                and self._library_widget.current_project_path
+            ):
+                current_project_path = Path(self._library_widget.current_project_path)
+
+            is_current_project = (
+                current_project_path and current_project_path.resolve() == project_dir.resolve()
+            )
+
+            # Perform the deletion
+            self._set_status(tr("Deleting project..."), show_progress=True)
+
+            try:
+                # Remove the entire project directory
+                shutil.rmtree(project_dir)
+
+                # If we deleted the current project, clear the library
+                if is_current_project:
+                    if self._library_widget:
+                        self._library_widget.current_project_path = None
+                        self._library_widget.refresh_library()
+                    self._set_status(
+                        f"Deleted current project: {project_dir.name} - Library cleared"
+                    )
+                else:
+                    self._set_status(f"Deleted project: {project_dir.name}")
+
+                # Show success message
+                QMessageBox.information(
+                    self,
+                    tr("Project Deleted"),
+                    f"Project '{project_dir.name}' has been permanently deleted.\n\n"
+                    f"All files and directories have been removed from:\n{project_dir}",
+                )
+
+                print(f"[DELETE] Successfully deleted project: {project_dir}")
+
+            except PermissionError as e:
+                error_msg = (
+                    f"Permission denied: Cannot delete project.\n\n"
+                    f"Some files may be in use or you may not have "
+                    f"sufficient permissions.\n\nError: {str(e)}"
+                )
+                QMessageBox.critical(self, tr("Permission Error"), error_msg)
+                self._set_status(tr("Project deletion failed - permission error"))
+
+            except Exception as e:
+                error_msg = f"Failed to delete project: {str(e)}"
+                QMessageBox.critical(self, tr("Deletion Error"), error_msg)
+                self._set_status(tr("Project deletion failed"))
+
+            finally:
+                self._progress_bar.setVisible(False)
+
+        except Exception as e:
+            error_msg = f"Failed to delete project: {str(e)}"
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Delete Project Error"), error_msg)
+
+    def _validate_project_directory(self, project_path: Path) -> bool:
+        """Validate if directory is a valid Asset Manager project - Single Responsibility"""
+        try:
+            # Check for project.json file (preferred)
+            if (project_path / "project.json").exists():
+                return True
+
+            # Check for assets directory (fallback)
+            if (project_path / "assets").exists():
+                return True
+
+            # Check if directory has any asset files
+            asset_extensions = [".ma", ".mb", ".obj", ".fbx", ".abc", ".png", ".jpg", ".jpeg"]
+            for ext in asset_extensions:
+                if list(project_path.rglob(f"*{ext}")):
+                    return True
+
+            return False
+
+        except Exception as e:
+            print(f"Error validating project directory: {e}")
+            return False
+
+    def _on_save_project(self) -> None:
+        """Handle saving current project - Single Responsibility"""
+        try:
+            # Check if we have a current project loaded
+            if (
+                not self._library_widget
+                or not hasattr(self._library_widget, "current_project_path")
+                or not self._library_widget.current_project_path
+            ):
+                QMessageBox.information(
+                    self,
+                    tr("No Project Loaded"),
+                    tr(
+                        "No project is currently loaded.\n\n"
+                        "Please open or create a project first using:\n"
+                        "• File > New Project...\n"
+                        "• File > Open Project...\n"
+                        "• File > Set Project..."
+                    ),
+                )
+                return
+
+            project_path = Path(self._library_widget.current_project_path)
+
+            # Save project configuration and refresh library
+            self._save_project_data(project_path)
+
+            # Refresh the library to ensure consistency
+            if self._library_widget:
+                self._library_widget.refresh_library()
+
+            self._set_status(f"Saved project: {project_path.name}")
+            QMessageBox.information(
+                self,
+                tr("Project Saved"),
+                f"Project '{project_path.name}' has been saved successfully.\n\n"
+                "All project data and asset library have been updated.",
+            )
+
+        except Exception as e:
+            error_msg = f"Failed to save project: {str(e)}"
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Save Project Error"), error_msg)
+
+    def _on_save_project_as(self) -> None:
+        """Handle saving current project to a new location - Single Responsibility"""
+        try:
+            # Check if we have a current project loaded
+            if (
+                not self._library_widget
+                or not hasattr(self._library_widget, "current_project_path")
+                or not self._library_widget.current_project_path
+            ):
+                QMessageBox.information(
+                    self,
+                    tr("No Project Loaded"),
+                    tr(
+                        "No project is currently loaded.\n\n"
+                        "Please open or create a project first using:\n"
+                        "• File > New Project...\n"
+                        "• File > Open Project...\n"
+                        "• File > Set Project..."
+                    ),
+                )
+                return
+
+            from PySide6.QtWidgets import QFileDialog
+
+            current_project_path = Path(self._library_widget.current_project_path)
+
+            # Get new project location
+            new_project_path = QFileDialog.getExistingDirectory(
+                self, "Save Project As - Select New Location", str(current_project_path.parent)
+            )
+
+            if not new_project_path:
+                return
+
+            _ = Path(new_project_path) / f"{current_project_path.name}_copy"
+
+            # Ask for new project name
+            from PySide6.QtWidgets import QInputDialog
+
+            new_name, ok = QInputDialog.getText(
+                self,
+                "Save Project As",
+                "Enter new project name:",
+                text=f"{current_project_path.name}_copy",
+            )
+
+            if not ok or not new_name.strip():
+                return
+
+            final_project_path = Path(new_project_path) / new_name.strip()
+
+            # Check if destination already exists
+            if final_project_path.exists():
+                reply = QMessageBox.question(
+                    self,
+                    tr("Directory Exists"),
+                    f"Directory '{final_project_path.name}' already exists.\n\n"
+                    "Do you want to overwrite it?",
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.No,
+                )
+
+                if reply != QMessageBox.StandardButton.Yes:
+                    return
+
+                # Remove existing directory
+                shutil.rmtree(final_project_path)
+            # Copy project to new location
+            self._set_status(tr("Copying project..."), show_progress=True)
+
+            try:
+                shutil.copytree(current_project_path, final_project_path)
+
+                # Update project configuration in new location
+                self._save_project_data(final_project_path, new_name=new_name.strip())
+
+                # Ask if user wants to switch to the new project
+                reply = QMessageBox.question(
+                    self,
+                    tr("Project Copied"),
+                    f"Project has been successfully copied to:\n{final_project_path}\n\n"
+                    "Would you like to switch to the new project location?",
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.Yes,
+                )
+
+                if reply == QMessageBox.StandardButton.Yes:
+                    self._load_project(final_project_path)
+                    self._set_status(f"Switched to copied project: {new_name}")
+                else:
+                    self._set_status(f"Project copied successfully to: {final_project_path}")
+
+            except Exception as e:
+                error_msg = f"Failed to copy project: {str(e)}"
+                QMessageBox.critical(self, tr("Copy Error"), error_msg)
+                self._set_status(error_msg)
+            finally:
+                self._progress_bar.setVisible(False)
+
+        except Exception as e:
+            error_msg = f"Failed to save project as: {str(e)}"
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Save Project As Error"), error_msg)
+
+    def _save_project_data(self, project_path: Path, new_name: Optional[str] = None) -> None:
+        """Save project configuration data - Single Responsibility"""
+        try:
+            config_file = project_path / "project.json"
+
+            # Load existing config or create new one
+            project_config = {}
+            if config_file.exists():
+                try:
+                    with open(config_file, "r", encoding="utf-8") as f:
+                        project_config = json.load(f)
+                except Exception:
+                    pass  # Use empty config if can't read
+
+            # Update configuration
+            from datetime import datetime
 
             project_config.update(
                 {
-                    "name": new_name or project_path.name,
-                    "last_saved": datetime.now().isoformat(),
-                    "version": project_config.get("version", "1.0"),
-                    "asset_manager_version": "1.5.0",
-                    "description": project_config.get(
-                        "description", f"Asset Manager project: {new_name or project_path.name}"
-                    ),
-                    "directories": {
-                        "assets": "assets",
-                        "exports": "exports",
-                        "temp": "temp",
-                        "docs": "docs",
-                    },
+                    "name": new_name or project_path.name,
+                    "last_saved": datetime.now().isoformat(),
+                    "version": project_config.get("version", "1.0"),
+                    "asset_manager_version": "1.5.0",
+                    "description": project_config.get(
+                        "description", f"Asset Manager project: {new_name or project_path.name}"
+                    ),
+                    "directories": {
+                        "assets": "assets",
+                        "exports": "exports",
+                        "temp": "temp",
+                        "docs": "docs",
+                    },
+                }
+            )
+
+            # Ensure created date exists
+            if "created" not in project_config:
+                project_config["created"] = datetime.now().isoformat()
+
+            # Save updated configuration
+            with open(config_file, "w", encoding="utf-8") as f:
+                json.dump(project_config, f, indent=4)
+
+            print(f"[OK] Saved project configuration: {config_file}")
+
+        except Exception as e:
+            print(f"[ERROR] Failed to save project configuration: {e}")
+            raise
+
+    def _on_add_asset_to_library(self) -> None:
+        """Handle adding a single asset to the library - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            from PySide6.QtWidgets import QFileDialog
+
+            # Check if we have a project loaded (optional warning)
+            if (
+                not self._library_widget
+                or not hasattr(self._library_widget, "current_project_path")
+                or not self._library_widget.current_project_path
+            ):
+                reply = QMessageBox.question(
+                    self,
+                    tr("No Project Loaded"),
+                    tr(
+                        "No project is currently loaded. Assets will be added to the "
+                        "default location.\n\nWould you like to continue?"
+                    ),
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.Yes,
+                )
+                if reply == QMessageBox.StandardButton.No:
+                    return
+
+            # Get supported file extensions for the dialog filter
+            supported_exts = [
+                ".ma",
+                ".mb",
+                ".obj",
+                ".fbx",
+                ".abc",
+                ".usd",
+                ".usda",
+                ".usdc",
+                ".usdz",
+                ".png",
+                ".jpg",
+                ".jpeg",
+                ".tiff",
+                ".tga",
+                ".exr",
+                ".hdr",
+                ".mov",
+                ".mp4",
+                ".avi",
+                ".mtl",
+                ".mat",
+                ".zip",
+                ".rar",
+            ]
+            from ..services.asset_type_service_impl import get_asset_type_service
+
+            supported_exts += get_asset_type_service().get_extensions(self._get_library_root())
+
+            # Create filter string
+            filters = "Asset Files ("
+            for ext in supported_exts:
+                filters += f"*{ext} "
+            filters = filters.strip() + ");;"
+            filters += "Maya Files (*.ma *.mb);;"
+            filters += "3D Models (*.obj *.fbx *.abc *.usd *.usda *.usdc *.usdz);;"
+            filters += "Images (*.png *.jpg *.jpeg *.tiff *.tga *.exr *.hdr);;"
+            filters += "All Files (*)"
+
+            # Open file dialog
+            file_path, _ = QFileDialog.getOpenFileName(
+                self, "Add Asset to Library", str(Path.home()), filters
+            )
+
+            if file_path:
+                success = self._copy_asset_to_library(Path(file_path))
+                if success:
+                    self._set_status(f"Added asset: {Path(file_path).name}")
+                    self._on_refresh_library()
+                else:
+                    self._set_status(tr("Failed to add asset"))
+
+        except Exception as e:
+            error_msg = f"Error adding asset: {str(e)}"
+            print(error_msg)
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Add Asset Error"), error_msg)
+
+    def _on_add_multiple_assets(self) -> None:
+        """Handle adding multiple assets from folders - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            from PySide6.QtWidgets import QFileDialog
+
+            # Check if we have a project loaded (optional warning)
+            if (
+                not self._library_widget
+                or not hasattr(self._library_widget, "current_project_path")
+                or not self._library_widget.current_project_path
+            ):
+                reply = QMessageBox.question(
+                    self,
+                    tr("No Project Loaded"),
+                    tr(
+                        "No project is currently loaded. Assets will be added to the "
+                        "default location.\n\nWould you like to continue?"
+                    ),
+                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                    QMessageBox.StandardButton.Yes,
+                )
+                if reply == QMessageBox.StandardButton.No:
+                    return
+
+            # Get multiple files
+            file_paths, _ = QFileDialog.getOpenFileNames(
+                self,
+                "Add Multiple Assets to Library",
+                str(Path.home()),
+                "Asset Files (*.ma *.mb *.obj *.fbx *.abc *.usd *.usda *.usdc *.usdz "
+                "*.png *.jpg *.jpeg *.tiff *.tga *.exr *.hdr *.mov *.mp4 *.avi "
+                "*.mtl *.mat *.zip *.rar);;All Files (*)",
+            )
+
+            if file_paths:
+                # Show progress
+                self._set_status(f"Adding {len(file_paths)} assets...", show_progress=True)
+
+                added_count = 0
+                failed_count = 0
+
+                for file_path in file_paths:
+                    try:
+                        if self._copy_asset_to_library(Path(file_path)):
+                            added_count += 1
+                        else:
+                            failed_count += 1
+                    except Exception as e:
+                        print(f"Failed to add {file_path}: {e}")
+                        failed_count += 1
+
+                # Update status
+                if failed_count == 0:
+                    self._set_status(f"Successfully added {added_count} assets")
+                else:
+                    self._set_status(f"Added {added_count} assets, {failed_count} failed")
+
+                # Refresh library to show new assets
+                self._on_refresh_library()
+
+                # Show summary dialog
+                if added_count > 0:
+                    message = f"Successfully added {added_count} asset(s) to the library."
+                    message += "\n\nThumbnails have been generated for all imported assets."
+                    if failed_count > 0:
+                        message += f"\n\n{failed_count} file(s) could not be added."
+                    QMessageBox.information(self, tr("Assets Added"), message)
+                else:
+                    QMessageBox.warning(
+                        self,
+                        tr("No Assets Added"),
+                        tr("No assets were successfully added to the library."),
+                    )
+
+        except Exception as e:
+            error_msg = f"Error adding multiple assets: {str(e)}"
+            print(error_msg)
+            self._set_status(error_msg)
+            QMessageBox.critical(self, tr("Add Multiple Assets Error"), error_msg)
+
+    def _copy_asset_to_library(self, source_path: Path) -> bool:
+        """Copy asset file to the project library - Uses Library Service"""
+        try:
+            # Get project path
+            project_path = None
+            if (
+                self._library_widget
+                and hasattr(self._library_widget, "current_project_path")
+                and self._library_widget.current_project_path
+            ):
+                project_path = self._library_widget.current_project_path
+
+            if not project_path:
+                # Fallback to default assets directory
+                project_path = Path.home() / "Documents" / "maya" / "projects" / "default"
+                # Create default project structure if it doesn't exist
+                if not project_path.exists():
+                    project_path.mkdir(parents=True, exist_ok=True)
+
+            # Ensure project_path is a Path object
+            project_path = Path(project_path) if isinstance(project_path, str) else project_path
+
+            # Use library service to add asset
+            # This handles: file copy + repository update + recent assets with correct path
+            result = self._library_service.add_asset_to_library(source_path, project_path)
+
+            if result and result[0]:  # Success
+                target_path = result[1]
+                print("[OK] Asset successfully added to library via LibraryService")
+                print(f"[FOLDER] Source: {source_path}")
+                print(f"[FOLDER] Target: {target_path}")
+
+                # CRITICAL: Generate playblast thumbnails for Maya/USD files BEFORE importing to scene!
+                # This allows users to preview assets in library without cluttering their scene
+                if target_path and isinstance(target_path, Path):
+                    if target_path.suffix.lower() in {
+                        ".ma",
+                        ".mb",
+                        ".usd",
+                        ".usda",
+                        ".usdc",
+                        ".usdz",
+                    }:
+                        print(f"[THUMBNAIL] Generating preview thumbnail for: {target_path.name}")
+                        # Schedule thumbnail generation after library refresh
+                        QTimer.singleShot(
+                            200, lambda: self._generate_thumbnail_for_library_asset(target_path)
+                        )
+
+                # Refresh library to show new asset
+                QTimer.singleShot(100, self._on_refresh_library)
+
+                return True
+            else:
+                print("[ERROR] Failed to add asset to library")
+                return False
+
+        except Exception as e:
+            print(f"[ERROR] Error copying asset to library: {e}")
+            import traceback
+
+            traceback.print_exc()
+            return False
+
+    def _generate_thumbnail_for_library_asset(self, asset_path: Path) -> None:
+        """
+        Generate PLAYBLAST thumbnail for asset when added to library.
+
+        This is the KEY feature - generates thumbnails WITHOUT importing to user's scene!
+        Uses isolated temporary namespace for preview generation, then cleans up completely.
+
+        Single Responsibility: Playblast thumbnail generation for library preview
+        """
+        try:
+            # Render in a background mayapy process when possible - never blocks Maya
+            from ..services.thumbnail_queue_impl import find_mayapy
+
+            if find_mayapy() is not None:
+                settings = self._get_thumbnail_settings(asset_path)
+                self._thumbnail_queue.enqueue(
+                    asset_path, settings=settings, color=self._get_color_transform()
+                )
+                print(f"[THUMBNAIL] Queued background thumbnail for: {asset_path.name}")
+                return
+
+            # Get thumbnail service from container
+            from ..core.container import get_container
+            from ..core.interfaces.thumbnail_service import IThumbnailService
+
+            container = get_container()
+            thumbnail_service = container.resolve(IThumbnailService)
+
+            print(f"[THUMBNAIL] Generating playblast preview for library: {asset_path.name}")
+
+            # Generate playblast thumbnail with force_playblast=True
+            # This imports to isolated namespace, captures, and cleans up - NO scene pollution!
+            thumbnail_path = thumbnail_service.generate_thumbnail(
+                asset_path, size=(256, 256), force_playblast=True
+            )
+
+            if thumbnail_path:
+                print(f"[THUMB] Generated large playblast thumbnail: {asset_path.name}")
+                if self._library_widget:
+                    self._library_widget.record_thumbnail_color(asset_path)
+                # Also generate smaller thumbnail for list view
+                small_thumbnail_path = thumbnail_service.generate_thumbnail(
+                    asset_path, size=(64, 64), force_playblast=True
+                )
+                if small_thumbnail_path:
+                    print(f"[THUMB] Generated small playblast thumbnail: {asset_path.name}")
+
+                    # Trigger UI refresh for this specific asset
+                    if (
+                        self._library_widget
+                        and hasattr(self._library_widget, "refresh_thumbnails_for_assets")
+                        and callable(
+                            getattr(self._library_widget, "refresh_thumbnails_for_assets")
+                        )
+                    ):
+                        # Use QTimer to ensure this runs after thumbnails are saved
+                        def refresh_thumbnail():
+                            try:
+                                self._library_widget.refresh_thumbnails_for_assets([asset_path])  # type: ignore
+                            except Exception as e:
+                                print(f"[ERROR] Failed to refresh thumbnail in UI: {e}")
+
+                        QTimer.singleShot(500, refresh_thumbnail)
+                        print(f"[REFRESH] Scheduled thumbnail refresh for: {asset_path.name}")
+                else:
+                    print(f"[WARNING] Could not generate small thumbnail for: {asset_path.name}")
+            else:
+                print(f"[WARNING] Could not generate thumbnail for: {asset_path.name}")
+
+        except Exception as e:
+            print(f"[ERROR] Thumbnail generation failed for {asset_path}: {e}")
+            import traceback
+
+            traceback.print_exc()
+            # Don't fail the library addition if thumbnail generation fails
+
+    def _get_thumbnail_settings(self, asset_path: Path) -> ThumbnailSettings:
+        """Get the camera, lighting, and resolution an asset's thumbnail renders with"""
+        from ..services.thumbnail_settings_service_impl import get_thumbnail_settings_service
+
+        return get_thumbnail_settings_service().get_asset_settings(
+            self._get_library_root(), Path(asset_path), self._get_metadata_database()
+        )
+
+    def _get_color_transform(self) -> ColorTransform:
+        """Get the OCIO config and view the library's thumbnails render through"""
+        from ..services.color_management_service_impl import get_color_management_service
+
+        return get_color_management_service().load_project_transform(self._get_library_root())
+
+    def _offer_color_thumbnail_regeneration(self, library_root: Path) -> None:
+        """Offer to re-render thumbnails made with another OCIO config or view"""
+        from ..services.color_management_service_impl import get_color_management_service
+        from ..services.thumbnail_queue_impl import find_mayapy
+
+        database = self._get_metadata_database()
+        if database is None or not self._library_widget or find_mayapy() is None:
+            return
+        service = get_color_management_service()
+        transform = service.load_project_transform(library_root)
+        # Asked once per library and config per session
+        if (str(library_root), transform) in self._color_checks_done:
+            return
+        self._color_checks_done.add((str(library_root), transform))
+
+        assets = list(getattr(self._library_widget, "_current_assets", []))
+        stale_files = {
+            str(path)
+            for path in service.find_stale_thumbnails(
+                database, transform, [Path(asset.file_path) for asset in assets]
+            )
+        }
+        stale = [asset for asset in assets if str(asset.file_path) in stale_files]
+        if not stale:
+            return
+        reply = QMessageBox.question(
+            self,
+            tr("Color Management Changed"),
+            f"{len(stale)} thumbnail(s) were not rendered with this library's color "
+            f"management ({transform.label}).\n\nRegenerate them now?",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+        )
+        if reply == QMessageBox.StandardButton.Yes:
+            self._queue_thumbnail_regeneration(stale, "thumbnails with outdated color")
+
+    def _on_thumbnail_job_done_threaded(self, job) -> None:
+        """Queue callback (worker thread) - hand the job over to the UI thread"""
+        self.thumbnail_job_finished.emit(job)
+
+    def _on_thumbnail_job_finished(self, job) -> None:
+        """Update the library grid as background thumbnails finish"""
+        if job.status == "done" and self._library_widget:
+            self._library_widget.refresh_thumbnails_for_assets([job.asset_path])
+        database = self._get_metadata_database()
+        if job.status == "done" and job.color is not None and database is not None:
+            from ..services.color_management_service_impl import get_color_management_service
+
+            get_color_management_service().record_thumbnail_transform(
+                database, job.asset_path, job.color
+            )
+
+        remaining = self._thumbnail_queue.pending_count()
+        if remaining:
+            self._set_status(f"Thumbnails: {remaining} remaining...")
+        else:
+            failed = [j for j in self._thumbnail_queue.get_jobs() if j.status == "failed"]
+            self._thumbnail_queue.clear_finished()
+            if failed:
+                self._set_status(f"Thumbnails finished - {len(failed)} failed ({failed[0].error})")
+            else:
+                self._set_status(tr("Background thumbnails finished"))
+
+    def _queue_thumbnail_regeneration(self, assets: list, scope_name: str) -> None:
+        """Ask for options and queue background thumbnails for assets"""
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.thumbnail_batch_dialog import ThumbnailBatchDialog
+
+        if not assets:
+            QMessageBox.information(
+                self, tr("No Assets"), tr("There are no assets to regenerate.")
+            )
+            return
+
+        if find_mayapy() is None:
+            QMessageBox.warning(
+                self,
+                tr("mayapy Not Found"),
+                tr("Background thumbnails need mayapy. Set MAYA_LOCATION to your Maya install."),
+            )
+            return
+
+        dialog = ThumbnailBatchDialog(len(assets), scope_name, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+
+        jobs = self._thumbnail_queue.enqueue_many(
+            [Path(asset.file_path) for asset in assets],
+            self._get_thumbnail_settings,
+            color=self._get_color_transform(),
+            **dialog.get_options(),
+        )
+        self._set_status(f"Queued {len(jobs)} thumbnail(s) for background rendering")
+
+    def _on_regenerate_thumbnails(self) -> None:
+        """Regenerate selected (or all visible) asset thumbnails in the background"""
+        if not self._library_widget:
+            return
+        selected = self._library_widget.get_selected_assets()
+        if selected:
+            self._queue_thumbnail_regeneration(selected, "the selected assets")
+        else:
+            assets = list(getattr(self._library_widget, "_current_assets", []))
+            self._queue_thumbnail_regeneration(assets, "all assets in the library")
+
+    def _on_submit_to_farm(self) -> None:
+        """Send turntables and USD conversions of the selected assets to the render farm"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        from .dialogs.farm_submit_dialog import FarmSubmitDialog
+
+        library_root = self._get_library_root()
+        assets = self._library_widget.get_selected_assets() if self._library_widget else []
+        if library_root is None or not assets:
+            QMessageBox.information(
+                self, tr("No Assets"), tr("Select the assets to send to the render farm.")
+            )
+            return
+
+        farms = self._render_farm_service.get_available_farms()
+        if not farms:
+            QMessageBox.warning(
+                self,
+                tr("Render Farm Unavailable"),
+                tr(
+                    "Neither deadlinecommand nor tractor-spool was found. Install the farm "
+                    "client, or set DEADLINE_PATH to the Deadline client's bin folder."
+                ),
+            )
+            return
+
+        convertible = [
+            asset
+            for asset in assets
+            if len(self._render_farm_service.get_steps(Path(asset.file_path))) > 1
+        ]
+        dialog = FarmSubmitDialog(len(assets), len(convertible), farms, self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+
+        submitted = self._submit_farm_jobs(
+            library_root,
+            [Path(asset.file_path) for asset in assets],
+            dialog.get_steps(),
+            **dialog.get_options(),
+        )
+        self._set_status(f"Submitted {submitted} farm job(s)")
+
+    def _submit_publish_to_farm(self, asset_file: Path) -> None:
+        """Send the heavy steps of a publish to the farm last submitted to"""
+        library_root = self._get_library_root()
+        farms = self._render_farm_service.get_available_farms()
+        if library_root is None or not farms:
+            print(f"[WARNING] No render farm available for {asset_file.name}, skipping")
+            return
+
+        settings = QSettings("MikeStumbo", "AssetManager")
+        farm_name = str(settings.value("renderFarm", ""))
+        self._submit_farm_jobs(
+            library_root,
+            [asset_file],
+            self._render_farm_service.get_steps(asset_file),
+            farm_name=farm_name if farm_name in farms else farms[0],
+            pool=str(settings.value("renderFarmPool", "")),
+            priority=int(settings.value("renderFarmPriority", 50)),
+        )
+
+    def _submit_farm_jobs(
+        self, library_root: Path, asset_files: List[Path], steps: List[str], **options: Any
+    ) -> int:
+        """Submit steps of assets to the render farm; returns the number of jobs submitted"""
+        from ..services.render_farm_service_impl import RenderFarmError
+        from ..services.thumbnail_queue_impl import find_mayapy
+
+        submitted = 0
+        errors: List[str] = []
+        for asset_file in asset_files:
+            for step in steps:
+                if step not in self._render_farm_service.get_steps(asset_file):
+                    continue
+                step_options = dict(options)
+                if step == FARM_STEP_TURNTABLE:
+                    # Rendered with the asset type's camera and the project's color config
+                    step_options["settings"] = self._get_thumbnail_settings(asset_file)
+                    step_options["color"] = self._get_color_transform()
+                try:
+                    self._render_farm_service.submit(
+                        library_root, asset_file, step, mayapy=find_mayapy(), **step_options
+                    )
+                    submitted += 1
+                except RenderFarmError as e:
+                    errors.append(f"{asset_file.name}: {e}")
+
+        if errors:
+            QMessageBox.warning(
+                self,
+                tr("Farm Submission Failed"),
+                tr("Some jobs were not submitted:") + "\n\n" + "\n".join(errors[:10]),
+            )
+        self._check_farm_jobs()
+        return submitted
+
+    def _check_farm_jobs(self) -> None:
+        """Pick up farm results, refresh what they wrote, and badge assets still processing"""
+        library_root = self._get_library_root()
+        finished = self._render_farm_service.collect_finished(library_root)
+        processing = self._render_farm_service.get_processing(library_root)
+
+        if processing and not self._farm_timer.isActive():
+            self._farm_timer.start()
+        elif not processing:
+            self._farm_timer.stop()
+        if self._library_widget:
+            self._library_widget.set_farm_jobs(
+                {
+                    path: ", ".join(job.description for job in jobs)
+                    for path, jobs in processing.items()
                 }
             )
+        if not finished:
+            return
+
+        completed = [job for job in finished if job.state == FARM_STATE_COMPLETED]
+        if self._library_widget and completed:
+            self._library_widget.refresh_thumbnails_for_assets(
+                [job.asset_path for job in completed]
+            )
+            # USD conversions add assets of their own
+            if any(job.step == FARM_STEP_USD for job in completed):
+                self._on_refresh_library()
+        failed = len(finished) - len(completed)
+        if failed:
+            self._set_status(f"Farm: {len(completed)} job(s) finished, {failed} failed")
+        else:
+            self._set_status(f"Farm: {len(completed)} job(s) finished")
+
+    def _on_regenerate_collection_thumbnails(self) -> None:
+        """Regenerate thumbnails for every asset in a collection"""
+        from ..services.collection_service_impl import is_smart_collection
+
+        collections = getattr(self, "_collections", {})
+        names = sorted(
+            name
+            for name, data in collections.items()
+            if data.get("assets") or is_smart_collection(data)
+        )
+        if not names:
+            QMessageBox.information(
+                self, tr("No Collections"), tr("There are no collections with assets yet.")
+            )
+            return
+
+        name, ok = QInputDialog.getItem(
+            self, "Regenerate Thumbnails", "Collection:", names, 0, False
+        )
+        if not ok:
+            return
+
+        assets = self._library_widget.get_collection_assets(name)
+        self._queue_thumbnail_regeneration(assets, f"collection '{name}'")
+
+    def _on_export_contact_sheet(self) -> None:
+        """Export a collection as a contact sheet for reviews outside Maya"""
+        from ..services.collection_service_impl import is_smart_collection
+
+        collections = getattr(self, "_collections", {})
+        names = sorted(
+            name
+            for name, data in collections.items()
+            if data.get("assets") or is_smart_collection(data)
+        )
+        if not names:
+            QMessageBox.information(
+                self, tr("No Collections"), tr("There are no collections with assets yet.")
+            )
+            return
+
+        try:
+            from ..core.container import get_container
+            from ..core.interfaces.thumbnail_service import IThumbnailService
+            from ..services.contact_sheet_service_impl import get_contact_sheet_service
+            from .dialogs.contact_sheet_dialog import ContactSheetDialog
+
+            dialog = ContactSheetDialog(names, output_dir=self._get_library_root(), parent=self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+
+            assets = self._library_widget.get_collection_assets(dialog.get_collection())
+            database = self._get_metadata_database()
+            thumbnail_service = get_container().resolve(IThumbnailService)
+
+            def key_of(asset: Any) -> str:
+                if database is None:
+                    return asset.file_path.name
+                return database.get_asset_key(asset.file_path)
+
+            def thumbnail_of(asset: Any) -> Optional[Path]:
+                if asset.thumbnail_path and Path(asset.thumbnail_path).is_file():
+                    return Path(asset.thumbnail_path)
+                for size in ((256, 256), (64, 64)):
+                    cached = thumbnail_service.get_cached_thumbnail(asset.file_path, size=size)
+                    if cached:
+                        return Path(cached)
+                return None
+
+            service = get_contact_sheet_service()
+            entries = service.build_entries(
+                assets,
+                key_of,
+                database.get_latest_version_numbers() if database is not None else {},
+                thumbnail_of,
+            )
+            written = service.export(entries, dialog.get_output_path(), dialog.get_options())
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to export contact sheet:\n{e}")
+            return
+
+        missing = sum(1 for entry in entries if entry.thumbnail is None)
+        self._set_status(
+            f"Exported contact sheet of {len(entries)} asset(s) to {written[0].name}"
+            + (f" ({missing} without thumbnails)" if missing else "")
+        )
+        QMessageBox.information(
+            self,
+            tr("Contact Sheet Exported"),
+            "Wrote:\n\n" + "\n".join(str(path) for path in written),
+        )
+
+    def _generate_thumbnail_for_imported_asset(self, asset_path: Path) -> None:
+        """
+        Generate PLAYBLAST thumbnail for asset AFTER it's imported into Maya scene.
+
+        NOTE: This is now redundant since thumbnails are generated when added to library.
+        Kept for backwards compatibility or manual thumbnail regeneration.
+
+        Single Responsibility: Playblast thumbnail generation with UI refresh
+        """
+        # Simply call the library asset thumbnail generator
+        self._generate_thumbnail_for_library_asset(asset_path)
+
+    def _extract_full_metadata_for_imported_asset(self, asset_path: Path) -> None:
+        """
+        Extract FULL Maya metadata for asset AFTER it's imported into Maya scene
+
+        IMPORTANT: This should ONLY be called when user explicitly imports asset to Maya,
+        NOT when adding to library! Adding to library gets basic metadata only.
+
+        This extracts detailed Maya scene data:
+        - Actual polygon counts from geometry
+        - Material and texture information
+        - Animation data and frame ranges
+        - Camera and light counts
+        - Scene hierarchy information
+
+        Single Responsibility: Full metadata extraction with asset update
+        """
+        try:
+            # Get repository service from container
+            from ..core.container import get_container
+
+            _ = get_container()
+
+            # Check if repository has extract_full_maya_metadata method
+            if hasattr(self._repository, "extract_full_maya_metadata"):
+                print(f"📊 Extracting full Maya metadata for imported asset: {asset_path.name}")
+
+                # Extract full metadata (this imports asset to analyze it)
+                full_metadata = self._repository.extract_full_maya_metadata(asset_path)  # type: ignore
+
+                if full_metadata and full_metadata.get("metadata_level") == "full":
+                    poly_count = full_metadata.get("poly_count", 0)
+                    material_count = full_metadata.get("material_count", 0)
+                    has_animation = full_metadata.get("has_animation", False)
+
+                    print(f"[OK] Full metadata extracted for {asset_path.name}:")
+                    print(f"   - Polygons: {poly_count:,}")
+                    print(f"   - Materials: {material_count}")
+                    print(f"   - Animation: {'Yes' if has_animation else 'No'}")
+
+                    # CRITICAL FIX: Persist extracted metadata to asset!
+                    try:
+                        # Get the asset from repository
+                        asset = self._repository.get_asset_by_path(asset_path)  # type: ignore
+                        if asset:
+                            # Update asset metadata with extracted data
+                            if not hasattr(asset, "metadata") or asset.metadata is None:
+                                asset.metadata = {}
+
+                            # Store extracted metadata
+                            asset.metadata["poly_count"] = poly_count
+                            asset.metadata["material_count"] = material_count
+                            asset.metadata["has_animation"] = has_animation
+                            asset.metadata["metadata_level"] = "full"
+
+                            # Save updated asset to repository
+                            if hasattr(self._repository, "update_asset"):
+                                self._repository.update_asset(asset)  # type: ignore
+                                print(f"[SAVE] Metadata persisted for {asset_path.name}")
+
+                                # ISSUE #3 FIX: Directly update asset info display for currently selected asset
+                                if (
+                                    self._current_asset
+                                    and self._current_asset.file_path == asset_path
+                                ):
+                                    print(
+                                        "[REFRESH] Updating metadata display for currently selected asset"
+                                    )
+                                    # Refresh the asset object from repository with new metadata
+                                    updated_asset = self._repository.get_asset_by_path(asset_path)  # type: ignore
+                                    if updated_asset:
+                                        self._current_asset = updated_asset
+                                        self._update_asset_info_display(updated_asset)
+
+                                # Also trigger library refresh
+                                if self._library_widget:
+                                    QTimer.singleShot(
+                                        100, self._library_widget.refresh_library  # type: ignore
+                                    )
+                            else:
+                                print("[WARNING] Repository doesn't support update_asset()")
+                        else:
+                            print(f"[WARNING] Asset not found in repository: {asset_path}")
+                    except Exception as persist_error:
+                        print(f"[WARNING] Could not persist metadata: {persist_error}")
+
+                else:
+                    print(f"[WARNING] Could not extract full metadata for: {asset_path.name}")
+            else:
+                print("[WARNING] Repository does not support full metadata extraction")
+
+        except Exception as e:
+            print(f"[ERROR] Metadata extraction error: {e}")
+
+    def _on_refresh_library(self, full_scan: bool = False) -> None:
+        """
+        Handle library refresh - Enhanced with proper error handling and user feedback
+
+        Args:
+            full_scan: Compare every library folder with the scan cache (manual refresh);
+                otherwise only folders the watcher reported are listed again
+        """
+        try:
+            # Check if library widget exists
+            if not self._library_widget:
+                QMessageBox.warning(self, tr("Warning"), tr("Library widget is not initialized."))
+                return
+
+            # Show progress and status feedback
+            self._set_status(tr("Refreshing library..."), show_progress=True)
+
+            # Perform the refresh
+            self._library_widget.refresh_library(full_scan=full_scan)
+
+            # Get current project path for feedback
+            if hasattr(self._library_widget, "current_project_path"):
+                project_path = self._library_widget.current_project_path
+                if project_path:
+                    # Ensure project_path is a Path object before accessing .name
+                    if isinstance(project_path, str):
+                        project_path = Path(project_path)
+                    self._set_status(f"Library refreshed from: {project_path.name}")
+                else:
+                    self._set_status(tr("No project loaded - nothing to refresh"))
+                    QMessageBox.information(
+                        self,
+                        tr("Info"),
+                        tr("No project is currently loaded.\nPlease open a project first."),
+                    )
+            else:
+                self._set_status(tr("Library refresh completed"))
+
+            # Hide progress
+            self._progress_bar.setVisible(False)
+
+        except Exception as e:
+            # Hide progress on error
+            self._progress_bar.setVisible(False)
+
+            error_msg = f"Failed to refresh library: {str(e)}"
+            self._set_status(f"Error: {error_msg}")
+            QMessageBox.critical(self, tr("Refresh Error"), error_msg)
+            logger.error("Library refresh error: %s", e)
+
+    def _on_reset_icon_size(self) -> None:
+        """Reset library icon size to default - Single Responsibility"""
+        try:
+            if self._library_widget and hasattr(self._library_widget, "reset_icon_size"):
+                self._library_widget.reset_icon_size()
+                self._set_status(tr("Icon size reset to default (64px)"))
+                print("[OK] Icon size reset to default: 64px")
+            else:
+                self._set_status(tr("Library widget not available"))
+        except Exception as e:
+            error_msg = f"Failed to reset icon size: {str(e)}"
+            self._set_status(f"Error: {error_msg}")
+            print(f"Reset icon size error: {e}")
+
+    def _on_advanced_search(self) -> None:
+        """Handle advanced search dialog"""
+        try:
+            from .dialogs.advanced_search_dialog import AdvancedSearchDialog
+
+            dialog = AdvancedSearchDialog(self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                criteria = dialog.get_search_criteria()
+                if criteria and self._library_widget:
+                    self._library_widget.search_with_criteria(criteria)
+        except ImportError:
+            QMessageBox.warning(
+                self,
+                tr("Feature Not Available"),
+                tr("Advanced Search dialog is not implemented yet."),
+            )
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to perform advanced search: {e}")
+
+    def _on_import_selected(self) -> None:
+        """Handle import selected assets with safe validation"""
+        try:
+            if not self._library_widget:
+                QMessageBox.warning(self, tr("Warning"), tr("Asset library is not available."))
+                return
+
+            selected_assets = self._library_widget.get_selected_assets()
+
+            # SAFE SELECTION VALIDATION - Defensive Programming
+            if not selected_assets:
+                QMessageBox.information(
+                    self, tr("No Selection"), tr("Please select one or more assets to import.")
+                )
+                return
+
+            # Validate each asset before import
+            valid_assets = []
+            for asset in selected_assets:
+                if self._validate_asset_file_path(asset):
+                    valid_assets.append(asset)
+                else:
+                    print(
+                        f"[WARNING] Skipping invalid asset: {getattr(asset, 'display_name', 'Unknown')}"
+                    )
+
+            if not valid_assets:
+                QMessageBox.warning(
+                    self, tr("Invalid Assets"), tr("No valid assets selected for import.")
+                )
+                return
+
+            # Import valid assets, until the artist stops at a name clash
+            self._import_stopped = False
+            for asset in valid_assets:
+                self._on_asset_import(asset)
+                if self._import_stopped:
+                    break
+
+        except Exception as e:
+            print(f"[ERROR] Import selected error: {e}")
+            QMessageBox.critical(
+                self, tr("Import Error"), f"Failed to import selected assets:\n{str(e)}"
+            )
+
+    def _on_refresh_thumbnails(self) -> None:
+        """Handle manual thumbnail refresh - Single Responsibility"""
+        try:
+            if not self._library_widget:
+                QMessageBox.warning(self, tr("Warning"), tr("Library widget is not initialized."))
+                return
+
+            # Show progress
+            self._set_status(tr("Refreshing all thumbnails..."), show_progress=True)
+
+            # Force refresh all thumbnails
+            if hasattr(self._library_widget, "force_refresh_all_thumbnails"):
+                self._library_widget.force_refresh_all_thumbnails()  # type: ignore
+                self._set_status(tr("Thumbnail refresh completed"))
+            else:
+                # Fallback - just refresh the library
+                self._library_widget.refresh_library()
+                self._set_status(tr("Library refreshed"))
+
+            # Hide progress
+            self._progress_bar.setVisible(False)
+
+        except Exception as e:
+            self._progress_bar.setVisible(False)
+            error_msg = f"Failed to refresh thumbnails: {str(e)}"
+            self._set_status(f"Error: {error_msg}")
+            QMessageBox.critical(self, tr("Thumbnail Refresh Error"), error_msg)
+            print(f"Thumbnail refresh error: {e}")
+
+    def _on_clear_thumbnail_cache(self) -> None:
+        """Handle clearing thumbnail cache - Single Responsibility"""
+        try:
+            from ..core.container import get_container
+            from ..core.interfaces.thumbnail_service import IThumbnailService
+
+            # Ask for confirmation
+            reply = QMessageBox.question(
+                self,
+                tr("Clear Thumbnail Cache"),
+                tr(
+                    "This will delete all cached thumbnails.\n"
+                    "Thumbnails will be regenerated as needed.\n\n"
+                    "Are you sure you want to continue?"
+                ),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+
+            if reply == QMessageBox.StandardButton.Yes:
+                # Clear the cache
+                container = get_container()
+                thumbnail_service = container.resolve(IThumbnailService)
+                thumbnail_service.clear_cache()  # type: ignore
+
+                # Refresh thumbnails after clearing cache
+                if self._library_widget and hasattr(self._library_widget, "refresh_library"):
+                    self._library_widget.refresh_library()
+
+                self._set_status(tr("Thumbnail cache cleared"))
+                QMessageBox.information(
+                    self, tr("Cache Cleared"), tr("Thumbnail cache has been cleared successfully.")
+                )
+
+        except Exception as e:
+            error_msg = f"Failed to clear thumbnail cache: {str(e)}"
+            self._set_status(f"Error: {error_msg}")
+            QMessageBox.critical(self, tr("Cache Clear Error"), error_msg)
+            print(f"Cache clear error: {e}")
+
+    def _on_create_asset(self) -> None:
+        """Handle create asset button click - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        try:
+            from ..services.asset_type_service_impl import get_asset_type_service
+            from ..ui.dialogs.create_asset_dialog import CreateAssetDialog
+
+            playblast_defaults, cameras = self._get_playblast_defaults()
+            asset_types = get_asset_type_service().get_plugins(self._get_library_root())
+            dialog = CreateAssetDialog(
+                self,
+                frame_range=self._get_playback_range(),
+                playblast_defaults=playblast_defaults,
+                cameras=cameras,
+                known_tags=self._get_known_tags(),
+                defaults=self._get_publish_dialog_defaults(),
+                plugin_formats=[(plugin.format_label, plugin.extension) for plugin in asset_types],
+            )
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                asset_data = dialog.get_asset_data()
+                if asset_data:
+                    # Create the asset
+                    success = self._create_asset_from_scene(asset_data)
+                    if success:
+                        self._set_status(f"Created asset: {asset_data.get('name', 'Unknown')}")
+                        self._on_refresh_library()  # Refresh to show new asset
+                    else:
+                        self._set_status(tr("Failed to create asset"))
+
+        except ImportError:
+            # Fallback to simple dialog
+            self._show_simple_create_dialog()
+
+        except Exception as e:
+            error_msg = f"Create asset error: {str(e)}"
+            print(error_msg)
+            self._set_status(error_msg)
+            QMessageBox.warning(
+                self, tr("Create Asset Error"), f"Failed to create asset:\n{str(e)}"
+            )
+
+    def _on_quick_export_scratch(self) -> None:
+        """Export the selection to the scratch library under an automatic name"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Quick export needs a Maya session.")
+            )
+            return
+        from ..services.scratch_service_impl import get_scratch_service
+        from ..services.thumbnail_queue_impl import find_mayapy
+
+        scratch_service = get_scratch_service()
+        if not cmds.ls(selection=True):
+            self._set_status(tr("Select the objects to export to scratch"))
+            return
+        name, ok = QInputDialog.getText(
+            self,
+            tr("Quick Export to Scratch"),
+            "Name:",
+            text=scratch_service.suggest_name(cmds),
+        )
+        if not ok:
+            return
+        try:
+            scratch_service.ensure_library(self._library_registry)
+            export_path = scratch_service.export_selection(cmds, name)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to export to scratch:\n{e}")
+            return
+        if find_mayapy():
+            self._thumbnail_queue.enqueue(export_path)
+        self._refresh_library_picker()
+        if self._get_library_root() is not None and scratch_service.is_scratch(
+            self._get_library_root()
+        ):
+            self._on_refresh_library()
+        self._set_status(f"Exported {export_path.stem} to your scratch library")
+
+    def _on_open_scratch_library(self) -> None:
+        """Load the scratch library in the browser"""
+        from ..services.scratch_service_impl import get_scratch_service
+
+        try:
+            entry = get_scratch_service().ensure_library(self._library_registry)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open the scratch library:\n{e}")
+            return
+        self._refresh_library_picker()
+        self._load_project(entry.root_path)
+
+    def _on_promote_scratch_asset(self, asset: Asset) -> None:
+        """Move a scratch asset into a curated library as a new asset"""
+        from ..services.metadata_database_impl import get_metadata_database
+        from ..services.scratch_service_impl import get_scratch_service, suggest_library_name
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.promote_scratch_dialog import PromoteScratchDialog
+
+        scratch_service = get_scratch_service()
+        libraries = [
+            entry
+            for entry in self._library_registry.get_libraries()
+            if not scratch_service.is_scratch(entry.root_path)
+        ]
+        if not libraries:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Add a library in File > Manage Libraries to promote scratch assets to."),
+            )
+            return
+        dialog = PromoteScratchDialog(
+            asset.file_path,
+            suggest_library_name(asset.file_path.stem),
+            libraries,
+            self._last_promote_library,
+            self,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        library_root = dialog.get_library_root()
+        if not self._check_permission(ACTION_PUBLISH, library_root):
+            return
+        self._last_promote_library = library_root
+
+        try:
+            database = get_metadata_database(library_root)
+            asset_file, version = scratch_service.promote(
+                asset.file_path, library_root, dialog.get_name(), dialog.get_notes(), database
+            )
+            if version is not None:
+                from ..services.integrity_service_impl import get_integrity_service
+
+                versions = self._version_service.get_versions(asset_file)
+                database.record_versions(asset_file, versions)
+                get_integrity_service().record_publish(database, asset_file, version)
+        except FileExistsError as e:
+            QMessageBox.warning(self, tr("Asset Exists"), f"{e}\n\nChoose another name.")
+            return
+        except Exception as e:
+            QMessageBox.critical(
+                self, tr("Error"), f"Failed to promote {asset.display_name}:\n{e}"
+            )
+            return
+
+        self._activity_service.record(
+            library_root,
+            ACTIVITY_PUBLISH,
+            asset_file,
+            version=version.number if version else 0,
+            mode="promote",
+            notes=asset.file_path.name,
+        )
+        if find_mayapy():
+            self._thumbnail_queue.enqueue(asset_file)
+        self._on_refresh_library()
+        self._set_status(
+            f"Promoted {asset.display_name} to {library_root.name} as {asset_file.stem}"
+        )
+
+    def _get_publish_dialog_defaults(self) -> Dict[str, Any]:
+        """Get the values the publish dialog opens with, the asset's own when publishing again"""
+        from ..services.publish_settings_service_impl import get_publish_settings_service
+
+        template_defaults = self._get_template_publish_defaults()
+        service = get_publish_settings_service()
+        database = self._get_metadata_database()
+        # A work scene maps to the asset it published; an asset opened from the library is
+        # published again as itself
+        scene_file = self._get_scene_file()
+        asset_file = service.get_scene_asset(scene_file) or scene_file
+        if asset_file is not None and service.get_asset_settings(database, asset_file):
+            self._set_status(f"Filled in from the last publish of {asset_file.stem}")
+        return service.get_dialog_defaults(database, asset_file, template_defaults)
+
+    def _get_scene_file(self) -> Optional[Path]:
+        """Get the open Maya scene's file, None when untitled or outside Maya"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return None
+        scene_name = cmds.file(query=True, sceneName=True)
+        return Path(scene_name) if scene_name else None
+
+    def _get_template_publish_defaults(self) -> Optional[Dict[str, Any]]:
+        """Get the publish values of the asset template the open scene came from"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return None
+        from ..services.asset_template_service_impl import get_asset_template_service
+
+        template_service = get_asset_template_service()
+        try:
+            found = template_service.get_scene_template(cmds, self._get_library_root())
+        except Exception as e:
+            print(f"[WARNING] Could not read the scene's asset template: {e}")
+            return None
+        return template_service.get_publish_defaults(*found) if found else None
+
+    def _on_create_asset_from_template(self) -> None:
+        """Open a new scene seeded from a library asset template - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Open a library to create assets from its templates.")
+            )
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Creating assets from templates needs Maya.")
+            )
+            return
+
+        from ..services.asset_template_service_impl import get_asset_template_service
+        from .dialogs.asset_template_dialog import AssetTemplateDialog
+
+        template_service = get_asset_template_service()
+        dialog = AssetTemplateDialog(
+            template_service,
+            library_root,
+            self._permission_service.is_allowed(library_root, ACTION_MANAGE),
+            self,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        template, name = dialog.get_selection()
+        if template is None:
+            return
+        if cmds.file(query=True, modified=True):
+            reply = QMessageBox.question(
+                self,
+                tr("Unsaved Changes"),
+                tr("The open scene has unsaved changes. Discard them and start a new scene?"),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+        try:
+            template_service.create_scene(cmds, library_root, template, name)
+        except Exception as e:
+            QMessageBox.critical(
+                self,
+                tr("Error"),
+                tr("Failed to create a scene from the template:\n{error}", error=e),
+            )
+            return
+        asset_name = template.get_asset_name(name)
+        self._set_status(
+            tr(
+                "New scene for {asset} from template {template}",
+                asset=asset_name,
+                template=template.name,
+            )
+        )
+        QMessageBox.information(
+            self,
+            tr("Scene Ready"),
+            tr(
+                "The scene for {asset} is set up from '{template}'.\n\nBuild the asset, then "
+                "use Create Asset to publish it - the dialog starts with the template's name, "
+                "category, and tags.",
+                asset=asset_name,
+                template=template.name,
+            ),
+        )
+
+    def _on_save_asset_template(self) -> None:
+        """Save the open scene as a library asset template - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Open a library to save the template into.")
+            )
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Saving templates needs Maya."))
+            return
+
+        from ..services.asset_template_service_impl import get_asset_template_service
+        from .dialogs.save_asset_template_dialog import SaveAssetTemplateDialog
+
+        template_service = get_asset_template_service()
+        dialog = SaveAssetTemplateDialog(
+            [template.name for template in template_service.list_templates(library_root)],
+            known_tags=self._get_known_tags(),
+            parent=self,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        template = dialog.get_template()
+        if template is None:
+            return
+        saved = template_service.save_scene_as_template(cmds, library_root, template)
+        if saved is None:
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save {name}.", name=template.name)
+            )
+            return
+        self._set_status(tr("Saved asset template: {name}", name=saved.name))
+
+    def _on_batch_publish_scene(self) -> None:
+        """Publish sets or groups of the scene as separate assets - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Open a library to publish into."))
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Batch publishing needs Maya."))
+            return
+
+        from ..services.batch_service_impl import get_batch_service
+        from ..services.publish_worker_pool_impl import DEFAULT_WORKERS
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.batch_publish_dialog import BatchPublishDialog
+
+        batch_service = get_batch_service()
+        dialog = BatchPublishDialog(
+            lambda source: batch_service.find_scene_items(cmds, source),
+            self,
+            known_tags=self._get_known_tags(),
+            default_workers=DEFAULT_WORKERS if find_mayapy() is not None else 0,
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        items = dialog.get_items()
+        options = dialog.get_options()
+
+        progress_dialog = QProgressDialog("Publishing assets...", "Stop", 0, len(items), self)
+        progress_dialog.setWindowTitle(tr("Batch Publish"))
+        progress_dialog.setWindowModality(Qt.WindowModality.WindowModal)
+        progress_dialog.setMinimumDuration(0)
+
+        def on_progress(index, item) -> bool:
+            progress_dialog.setValue(index)
+            if options["workers"]:
+                # Called as each worker finishes, index counting finished exports
+                label = f"Exported {item.name} ({index}/{len(items)})..."
+            else:
+                label = f"Publishing {item.name} ({index + 1}/{len(items)})..."
+            progress_dialog.setLabelText(label)
+            QApplication.processEvents()
+            return not progress_dialog.wasCanceled()
+
+        if options["workers"]:
+            progress_dialog.setLabelText(f"Checking and exporting {len(items)} asset(s)...")
+        results = batch_service.publish_scene_items(
+            cmds,
+            items,
+            library_root,
+            thumbnail=False,
+            progress=on_progress,
+            **options,
+        )
+        progress_dialog.setValue(len(items))
+
+        published = [result.file_path for result in results if result.success]
+        for asset_file in published:
+            self._generate_thumbnail_for_library_asset(asset_file)
+        if published and self._library_widget:
+            self._library_widget.mark_changed(published)
+            self._on_refresh_library()
+
+        failed = [result for result in results if not result.success]
+        summary = f"Published {len(published)} of {len(items)} asset(s)"
+        if len(results) < len(items):
+            summary += f", stopped before {len(items) - len(results)}"
+        self._set_status(summary)
+        if failed or len(results) < len(items):
+            message = QMessageBox(self)
+            message.setIcon(QMessageBox.Icon.Warning)
+            message.setWindowTitle(tr("Batch Publish"))
+            text = f"{summary}."
+            if failed:
+                text += f" {len(failed)} failed:\n\n" + "\n".join(
+                    result.description for result in failed[:10]
+                )
+            message.setText(text)
+            message.setDetailedText("\n".join(result.description for result in results))
+            message.exec()
+
+    def _get_playback_range(self) -> Tuple[float, float]:
+        """Get the scene playback range, (1, 120) outside Maya"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            from ..services.alembic_service_impl import get_alembic_service
+
+            return get_alembic_service().get_playback_range(cmds)
+        except Exception:
+            return (1.0, 120.0)
+
+    def _show_simple_create_dialog(self) -> None:
+        """Simple create asset dialog fallback - YAGNI implementation"""
+        name, ok = QInputDialog.getText(self, "Create Asset", "Asset name:")
+        if ok and name.strip():
+            asset_data = {
+                "name": name.strip(),
+                "description": "",
+                "tags": [],
+                "category": "General",
+            }
+            success = self._create_asset_from_scene(asset_data)
+            if success:
+                self._set_status(f"Created asset: {name}")
+                self._on_refresh_library()
+            else:
+                self._set_status(tr("Failed to create asset"))
+
+    def _create_asset_from_scene(self, asset_data: dict) -> bool:
+        """Create asset from current Maya scene - Single Responsibility"""
+        if not self._check_permission(ACTION_PUBLISH):
+            return False
+        depot_change: Optional[int] = None
+        transaction = None
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            from ..services.alembic_service_impl import ALEMBIC_EXTENSION, get_alembic_service
+            from ..services.asset_type_service_impl import get_asset_type_service
+            from ..services.publish_transaction_service_impl import (
+                get_publish_transaction_service,
+            )
+
+            file_format = asset_data.get("format", ".ma")
+            is_usd = file_format in USD_PUBLISH_FORMATS
+            is_alembic = file_format == ALEMBIC_EXTENSION
+            # Studio asset types are written by their plugin
+            asset_type_service = get_asset_type_service()
+            asset_type_plugin = asset_type_service.get_plugin_for_extension(file_format)
+            maya_file_type = "mayaBinary" if file_format == ".mb" else "mayaAscii"
+
+            # Create asset filename
+            asset_name = asset_data["name"]
+            safe_name = "".join(
+                c for c in asset_name if c.isalnum() or c in (" ", "-", "_")
+            ).rstrip()
+
+            # The library's naming template decides folder and file name (and version)
+            from ..services.naming_template_service_impl import get_naming_template_service
+
+            template_version: Optional[int] = None
+            templated = get_naming_template_service().resolve_publish_path(
+                self._get_library_root(), safe_name, asset_data.get("category", ""), file_format
+            )
+            if templated is not None:
+                asset_file, template_version = templated
+            elif asset_type_plugin is not None:
+                library_path = self._get_publish_directory(asset_type_plugin.folder)
+                asset_file = library_path / f"{safe_name}{file_format}"
+            else:
+                library_path = self._get_publish_directory(
+                    "models" if is_usd or is_alembic else "scenes"
+                )
+                asset_file = library_path / f"{safe_name}{file_format}"
+
+            # Make sure directory exists
+            asset_file.parent.mkdir(parents=True, exist_ok=True)
+
+            # USD assets version their geometry payload together with the root layer
+            companion_files = []
+            if is_usd:
+                from ..services.usd_service_impl import get_usd_service
+
+                usd_service = get_usd_service()
+                companion_files = [usd_service.get_payload_layer_path(asset_file)]
+            elif is_alembic:
+                # Cache settings are versioned together with the cache
+                sidecar = get_alembic_service().get_sidecar_path(asset_file)
+                companion_files = [sidecar] if sidecar.is_file() else []
+            elif asset_type_plugin is not None:
+                companion_files = []  # The plugin reports the files it writes on export
+            else:
+                # Previously collected textures/caches belong to the existing content
+                from ..services.dependency_service_impl import get_dependency_service
+
+                dependency_dir = get_dependency_service().get_dependency_directory(asset_file)
+                if dependency_dir.is_dir():
+                    companion_files = [p for p in dependency_dir.rglob("*") if p.is_file()]
+                # LOD variants stay with the asset across publishes of the base level
+                from ..services.lod_service_impl import get_lod_service
+
+                companion_files += get_lod_service().get_lod_files(asset_file)
+                # ...and so do viewport proxies
+                from ..services.proxy_service_impl import get_proxy_service
+
+                companion_files += get_proxy_service().get_proxy_files(asset_file)
+
+            # Never publish over an asset another artist has checked out
+            if not self._lock_service.can_publish(asset_file):
+                lock = self._lock_service.get_lock(asset_file)
+                owner = lock.description if lock else "another artist"
+                QMessageBox.warning(
+                    self,
+                    tr("Asset Locked"),
+                    f"'{safe_name}' is checked out by {owner}.\n\n"
+                    "Ask them to check it in before publishing.",
+                )
+                return False
+
+            # Pre-publish checks - errors block, warnings ask before writing anything
+            selection = cmds.ls(selection=True)
+            if not self._run_publish_validation(selection, asset_type_plugin):
+                self._set_status(f"Publish of {safe_name} cancelled by validation")
+                return False
+
+            # Rigs need the library's top node structure so animators get working sets
+            from ..services.rig_service_impl import RIG_CATEGORY
+
+            from ..services.camera_service_impl import CAMERA_CATEGORY
+
+            is_rig = asset_data.get("category") == RIG_CATEGORY and not (
+                is_usd or is_alembic or asset_type_plugin
+            )
+            if is_rig and not self._run_rig_validation(cmds, selection):
+                self._set_status(f"Publish of {safe_name} cancelled by rig validation")
+                return False
+
+            # Measure before export - USD export changes the selection
+            from ..services.geometry_stats_service_impl import get_geometry_stats_service
+
+            geometry_stats = get_geometry_stats_service().compute(cmds, selection)
+            publish_tags = self._get_publish_tags(
+                cmds, safe_name, selection, asset_data.get("tags") or [], geometry_stats
+            )
+
+            # Studio hooks may fix up the scene (e.g. renamers) or stop the publish
+            hook_context = {
+                "asset_file": asset_file,
+                "asset_name": safe_name,
+                "asset_type": asset_data.get("category", ""),
+                "file_format": file_format,
+                "selection": list(selection),
+                "notes": asset_data.get("description", ""),
+            }
+            if not self._run_pipeline_hook(HOOK_PRE_PUBLISH, **hook_context):
+                self._set_status(f"Publish of {safe_name} cancelled by a pipeline hook")
+                return False
+
+            # Depot files are read-only until opened - open them before anything is written
+            if self._is_perforce_active(asset_file):
+                description = asset_data.get("description", "").strip()
+                depot_change = self._source_control.open_for_publish(
+                    self._get_depot_paths(asset_file),
+                    f"Publish {safe_name}" + (f"\n\n{description}" if description else ""),
+                )
+                if depot_change is None:
+                    QMessageBox.warning(
+                        self,
+                        tr("Perforce Error"),
+                        f"Could not open '{safe_name}' for edit in Perforce.\n\n"
+                        "See the Script Editor for details.",
+                    )
+                    return False
+
+            # Keep pre-versioning content before the export overwrites it
+            if asset_file.exists() and not self._version_service.get_versions(asset_file):
+                self._version_service.publish_version(
+                    asset_file,
+                    notes="Baseline (pre-versioning)",
+                    companion_files=companion_files,
+                )
+
+            # Outputs are written to a hidden staging folder and only moved into the
+            # library once all of them are there, so a crash never leaves half an asset
+            transaction_service = get_publish_transaction_service()
+            transaction = transaction_service.begin(
+                asset_file,
+                notes=asset_data.get("description", ""),
+                register_version=template_version is None,
+            )
+            staged_file = transaction.staged_file
+
+            # Export selection or whole scene
+            expected_outputs: List[Path] = []
+            if is_usd:
+                # USD export always works from a selection - select everything if empty
+                if not selection:
+                    cmds.select(cmds.ls(assemblies=True), replace=True)
+                result = usd_service.export_selection_as_asset(staged_file, prim_name=safe_name)
+                if not result["success"]:
+                    raise RuntimeError(result["error"])
+                expected_outputs = [usd_service.get_payload_layer_path(staged_file)]
+                transaction_service.commit(transaction, expected_outputs)
+                self._set_status(f"Exported USD asset {safe_name} with geometry payload")
+            elif is_alembic:
+                cache_info = self._get_alembic_cache_info(cmds, asset_data, selection)
+                expected_outputs = [
+                    get_alembic_service().export_cache(cmds, staged_file, cache_info)
+                ]
+                transaction_service.commit(transaction, expected_outputs)
+                companion_files = [transaction.get_target_path(expected_outputs[0])]
+                self._set_status(f"Exported Alembic cache {safe_name} ({cache_info.description})")
+            elif asset_type_plugin is not None:
+                expected_outputs = asset_type_service.export(
+                    asset_type_plugin, cmds, staged_file, selection
+                )
+                transaction_service.commit(transaction, expected_outputs)
+                companion_files = [transaction.get_target_path(path) for path in expected_outputs]
+                self._set_status(f"Exported {asset_type_plugin.name} asset {safe_name}")
+            else:
+                collected = self._export_maya_asset(
+                    staged_file,
+                    maya_file_type,
+                    selection,
+                    collect_dependencies=asset_data.get("collect_dependencies", False),
+                )
+                fbx_file = self._export_fbx_handoff(
+                    cmds, staged_file, asset_data.get("category", ""), selection
+                )
+                gltf_file = self._export_gltf_handoff(
+                    cmds, staged_file, asset_data.get("category", ""), selection
+                )
+                handoff_files = [path for path in (fbx_file, gltf_file) if path is not None]
+                expected_outputs = collected + handoff_files
+                transaction_service.commit(transaction, expected_outputs)
+
+                # Proxies update the manifest of the asset's other proxies, so they are
+                # written once the asset is in place
+                if asset_data.get("proxy"):
+                    self._generate_proxy(cmds, asset_file, asset_data["proxy"], selection)
+                if asset_data.get("standin"):
+                    self._generate_proxy(
+                        cmds, asset_file, PROXY_STANDIN, selection, asset_data["standin"]
+                    )
+                collected = [transaction.get_target_path(path) for path in collected]
+                companion_files = (
+                    collected
+                    + get_lod_service().get_lod_files(asset_file)
+                    + get_proxy_service().get_proxy_files(asset_file)
+                )
+                companion_files = companion_files + [
+                    transaction.get_target_path(path) for path in handoff_files
+                ]
+                if asset_data.get("create_package"):
+                    package = get_dependency_service().create_package(asset_file, collected)
+                    if package:
+                        self._set_status(f"Packaged {safe_name} as {package.name}")
+
+            # Snapshot this publish as an immutable version (v001, v002...); under a
+            # versioned naming template every publish already has its own folder
+            version = None
+            if template_version is None:
+                version = self._version_service.publish_version(
+                    asset_file,
+                    notes=asset_data.get("description", ""),
+                    companion_files=companion_files,
+                )
+            transaction_service.finish(transaction)
+            version_number = version.number if version else template_version
+            if version_number:
+                self._set_status(f"Published {safe_name} {format_version_label(version_number)}")
+            if version:
+                self._record_publish(asset_file, version)
+            self._store_geometry_stats(asset_file, geometry_stats)
+            self._store_asset_type(asset_file, asset_data.get("category", ""))
+            self._store_publish_tags(asset_file, publish_tags)
+            self._store_thumbnail_camera(cmds, asset_file, asset_data)
+            self._remember_publish_settings(asset_file, asset_data)
+            if asset_data.get("send_to_unreal"):
+                self._send_to_unreal(cmds, asset_file, asset_data.get("category", ""), selection)
+            if is_rig:
+                self._store_rig_metadata(cmds, asset_file, selection, asset_data.get("rig"))
+            if asset_data.get("category") == CAMERA_CATEGORY:
+                self._store_camera_metadata(cmds, asset_file, selection, asset_data.get("camera"))
+            if asset_data.get("playblast"):
+                playblast = PlayblastSettings.from_dict(asset_data["playblast"])
+                self._capture_playblast(cmds, asset_file, playblast)
+            if self._library_widget:
+                # Publishing over an existing asset is not reported by the folder watcher
+                self._library_widget.mark_changed([asset_file])
+
+            if depot_change is not None:
+                submitted = self._source_control.submit_publish(
+                    depot_change, self._get_depot_paths(asset_file)
+                )
+                depot_change = None
+                if submitted:
+                    self._set_status(f"Published {safe_name}, submitted change {submitted}")
+
+            # Generate thumbnail - in the background with the asset type's camera and
+            # lighting when mayapy is available, else from the current viewport
+            from ..services.thumbnail_queue_impl import find_mayapy
+
+            if asset_type_plugin is not None:
+                # Studio asset types draw their own thumbnail, else the viewport is used
+                if asset_type_service.render_thumbnail(asset_type_plugin, asset_file) is None:
+                    self._generate_thumbnail_for_asset(str(asset_file.with_suffix(".png")))
+            elif find_mayapy() is not None:
+                settings = self._get_thumbnail_settings(asset_file)
+                self._thumbnail_queue.enqueue(
+                    asset_file, settings=settings, color=self._get_color_transform()
+                )
+            else:
+                thumbnail_path = asset_file.with_suffix(".png")
+                self._generate_thumbnail_for_asset(str(thumbnail_path))
+
+            # Turntable and USD conversion run on the farm while the artist works on
+            if self._farm_publish_action.isChecked():
+                self._submit_publish_to_farm(asset_file)
+
+            if version_number:
+                self._register_shotgrid_publish(
+                    asset_file, version_number, asset_data.get("description", "")
+                )
+                self._register_kitsu_publish(
+                    asset_file, version_number, asset_data.get("description", "")
+                )
+                self._run_pipeline_hook(
+                    HOOK_POST_PUBLISH,
+                    **hook_context,
+                    version=version_number,
+                    files=[asset_file] + list(companion_files),
+                )
+
+            return True
+
+        except Exception as e:
+            print(f"Asset creation failed: {e}")
+            if transaction is not None:
+                get_publish_transaction_service().rollback(transaction)
+            if depot_change is not None:
+                self._source_control.revert_publish(depot_change)
+            return False
+
+    def _record_publish(self, asset_file: Path, version: AssetVersion) -> None:
+        """Index a new version, store checksums of the files it published, and check quotas"""
+        self._check_storage_quota(asset_file)
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        database.record_versions(asset_file, self._version_service.get_versions(asset_file))
+        try:
+            from ..services.integrity_service_impl import get_integrity_service
+
+            get_integrity_service().record_publish(database, asset_file, version)
+        except Exception as e:
+            print(f"[WARNING] Could not store checksums for {asset_file.name}: {e}")
+
+    def _store_geometry_stats(self, asset_file: Path, stats: GeometryStats) -> None:
+        """Keep publish-time geometry stats with the asset's library metadata"""
+        from ..services.geometry_stats_service_impl import STATS_METADATA_KEY
+
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        try:
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[STATS_METADATA_KEY] = stats.to_dict()
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Failed to store geometry stats: {e}")
+            return
+        self._set_status(f"{asset_file.stem}: {stats.triangles:,} tris, {stats.vertices:,} verts")
+
+    def _get_publish_tags(
+        self,
+        cmds: Any,
+        asset_name: str,
+        selection: List[str],
+        tags: List[str],
+        stats: GeometryStats,
+    ) -> List[str]:
+        """
+        Get the tags typed for a publish plus those the library's tag rules add, and the
+        current show's while the library is limited to it
+        """
+        from ..services.auto_tag_service_impl import get_auto_tag_service
+        from ..services.show_context_service_impl import get_show_context_service
+
+        try:
+            rule_tags = get_auto_tag_service().get_publish_tags(
+                cmds, self._get_library_root(), asset_name, selection, stats.triangles
+            )
+        except Exception as e:
+            print(f"[WARNING] Could not apply tag rules: {e}")
+            rule_tags = []
+        added = [tag for tag in rule_tags if tag not in tags]
+        if added:
+            self._set_status(f"{asset_name}: tagged {', '.join(added)} by library rules")
+        show = self._library_widget.get_show_context() if self._library_widget else None
+        return get_show_context_service().get_publish_tags(list(tags) + added, show)
+
+    def _store_publish_tags(self, asset_file: Path, tags: List[str]) -> None:
+        """Add a publish's tags to those the asset already has"""
+        database = self._get_metadata_database()
+        if database is None or not tags:
+            return
+        try:
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata["tags"] = list(dict.fromkeys(list(metadata.get("tags") or []) + tags))
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Failed to store tags: {e}")
+
+    def _store_asset_type(self, asset_file: Path, asset_type: str) -> None:
+        """Keep the category an asset was published as, which picks its thumbnail settings"""
+        from ..services.thumbnail_settings_service_impl import ASSET_TYPE_METADATA_KEY
+
+        database = self._get_metadata_database()
+        if database is None or not asset_type:
+            return
+        try:
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[ASSET_TYPE_METADATA_KEY] = asset_type
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Failed to store asset type: {e}")
+
+    def _store_thumbnail_camera(self, cmds: Any, asset_file: Path, asset_data: dict) -> None:
+        """Render the asset's thumbnails through the camera picked in the publish dialog"""
+        from dataclasses import replace
+
+        from ..services.publish_settings_service_impl import SCENE_CAMERA_PREFIX
+        from ..services.thumbnail_settings_service_impl import get_thumbnail_settings_service
+
+        camera = asset_data.get("thumbnail_camera")
+        database = self._get_metadata_database()
+        if database is None or not camera:
+            return
+        service = get_thumbnail_settings_service()
+        base = service.get_settings_for_asset_type(
+            self._get_library_root(), asset_data.get("category", "")
+        )
+        try:
+            if camera.startswith(SCENE_CAMERA_PREFIX):
+                settings = service.capture_camera(cmds, camera[len(SCENE_CAMERA_PREFIX) :], base)
+            else:
+                settings = replace(base, camera=camera, camera_matrix=())
+            service.set_asset_settings(database, asset_file, settings)
+        except Exception as e:
+            print(f"[WARNING] Failed to store thumbnail camera: {e}")
+
+    def _remember_publish_settings(self, asset_file: Path, asset_data: dict) -> None:
+        """Keep the publish dialog's values for the asset's next publish and the artist's"""
+        from ..services.publish_settings_service_impl import get_publish_settings_service
+
+        get_publish_settings_service().remember_publish(
+            asset_data, asset_file, self._get_scene_file(), self._get_metadata_database()
+        )
+
+    def _get_playblast_defaults(
+        self, frame_range: Optional[Tuple[float, float]] = None
+    ) -> Tuple[Optional[PlayblastSettings], List[str]]:
+        """Get default playblast settings and the scene cameras, (None, []) outside Maya"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            return None, []
+
+        from ..services.playblast_service_impl import get_playblast_service
+
+        playblast_service = get_playblast_service()
+        defaults = playblast_service.get_default_settings(cmds)
+        if frame_range is not None:
+            defaults = PlayblastSettings(frame_range[0], frame_range[1])
+        return defaults, playblast_service.list_cameras(cmds)
+
+    def _capture_playblast(self, cmds: Any, asset_file: Path, settings: PlayblastSettings) -> None:
+        """Capture an asset's animated preview and keep its settings in library metadata"""
+        from ..services.playblast_service_impl import (
+            PLAYBLAST_METADATA_KEY,
+            get_playblast_service,
+        )
+
+        self._set_status(f"Capturing playblast of {asset_file.stem}...")
+        movie = get_playblast_service().capture(cmds, asset_file, settings)
+        if movie is None:
+            self._set_status(f"{asset_file.stem}: playblast preview failed (see Script Editor)")
+            return
+
+        database = self._get_metadata_database()
+        if database is not None:
+            try:
+                metadata = database.get_asset_metadata(asset_file) or {}
+                metadata[PLAYBLAST_METADATA_KEY] = settings.to_dict()
+                database.save_asset_metadata(asset_file, metadata)
+            except Exception as e:
+                print(f"[WARNING] Failed to store playblast settings: {e}")
+        self._set_status(f"Captured playblast preview: {movie.name}")
+
+    def _run_rig_validation(self, cmds: Any, selection: List[str]) -> bool:
+        """Check the rig structure; returns False when the publish must not go ahead"""
+        from ..services.rig_service_impl import get_rig_service
+        from .dialogs.validation_report_dialog import ValidationReportDialog
+
+        report = get_rig_service().validate_rig(cmds, selection, self._get_library_root())
+        if report.is_clean:
+            return True
+
+        accepted = ValidationReportDialog(report, self, publishing=True).exec()
+        return report.can_publish and accepted == QDialog.DialogCode.Accepted
+
+    def _store_rig_metadata(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        selection: List[str],
+        rig_metadata: Optional[Dict[str, Any]] = None,
+    ) -> None:
+        """Keep a published rig's controller sets and picker layout in its library metadata"""
+        from ..services.rig_service_impl import RIG_METADATA_KEY, get_rig_service
+
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        try:
+            if rig_metadata is None:
+                # Published from Create Asset - every controller set of the rig is kept
+                rig_service = get_rig_service()
+                top_node = rig_service.find_top_nodes(cmds, selection)[0]
+                rig_metadata = rig_service.build_rig_metadata(
+                    top_node, rig_service.find_controller_sets(cmds, top_node)
+                )
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[RIG_METADATA_KEY] = rig_metadata
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Failed to store rig metadata: {e}")
+
+    def _store_camera_metadata(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        selection: List[str],
+        camera_metadata: Optional[Dict[str, Any]] = None,
+    ) -> None:
+        """Keep a published camera's lens, key range, and image planes in its library metadata"""
+        from ..services.camera_service_impl import CAMERA_METADATA_KEY, get_camera_service
+
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        try:
+            if camera_metadata is None:
+                # Published from Create Asset - the first selected camera is described
+                camera_service = get_camera_service()
+                cameras = camera_service.find_cameras(cmds, selection)
+                if not cameras:
+                    print("[WARNING] No camera in the published selection")
+                    return
+                camera_metadata = camera_service.build_camera_metadata(cmds, cameras[0])
+            metadata = database.get_asset_metadata(asset_file) or {}
+            metadata[CAMERA_METADATA_KEY] = camera_metadata
+            database.save_asset_metadata(asset_file, metadata)
+        except Exception as e:
+            print(f"[WARNING] Failed to store camera metadata: {e}")
+
+    def _export_fbx_handoff(
+        self, cmds: Any, asset_file: Path, asset_type: str, selection: List[str]
+    ) -> Optional[Path]:
+        """Write the engine FBX next to a published scene when its type has a preset"""
+        from ..services.fbx_export_service_impl import get_fbx_export_service
+
+        fbx_service = get_fbx_export_service()
+        preset = fbx_service.get_preset_for_asset_type(self._get_library_root(), asset_type)
+        if preset is None:
+            return None
+        try:
+            fbx_file = fbx_service.export(cmds, asset_file.with_suffix(".fbx"), preset, selection)
+        except Exception as e:
+            print(f"[WARNING] FBX handoff export failed: {e}")
+            QMessageBox.warning(
+                self,
+                tr("FBX Export Failed"),
+                f"{asset_file.name} was published, but its '{preset.name}' FBX could not be "
+                f"written:\n{e}",
+            )
+            return None
+        self._set_status(f"Exported {fbx_file.name} with FBX preset '{preset.name}'")
+        return fbx_file
+
+    def _export_gltf_handoff(
+        self, cmds: Any, asset_file: Path, asset_type: str, selection: List[str]
+    ) -> Optional[Path]:
+        """Write the web and AR review glTF next to a published scene when its type has one"""
+        from ..services.gltf_export_service_impl import get_gltf_export_service
+
+        gltf_service = get_gltf_export_service()
+        settings = gltf_service.load_settings(self._get_library_root())
+        if not settings.publishes(asset_type):
+            return None
+        try:
+            gltf_file = gltf_service.export(
+                cmds, gltf_service.get_output_path(asset_file, settings), settings, selection
+            )
+        except Exception as e:
+            print(f"[WARNING] glTF handoff export failed: {e}")
+            QMessageBox.warning(
+                self,
+                tr("glTF Export Failed"),
+                tr(
+                    "{name} was published, but its review glTF could not be written:\n{error}",
+                    name=asset_file.name,
+                    error=e,
+                ),
+            )
+            return None
+        self._set_status(
+            tr(
+                "Exported {name} for review ({settings})",
+                name=gltf_file.name,
+                settings=settings.description,
+            )
+        )
+        return gltf_file
+
+    def _generate_proxy(
+        self,
+        cmds: Any,
+        asset_file: Path,
+        kind: str,
+        selection: List[str],
+        standin_format: str = STANDIN_FORMATS[0],
+    ) -> Optional[Path]:
+        """Export the proxy or standin picked in the create dialog next to the asset"""
+        from ..services.proxy_service_impl import get_proxy_service
+
+        try:
+            proxy_file = get_proxy_service().generate(
+                cmds,
+                asset_file,
+                kind,
+                selection or cmds.ls(assemblies=True),
+                standin_format=standin_format,
+            )
+        except Exception as e:
+            print(f"[WARNING] Proxy generation failed: {e}")
+            QMessageBox.warning(
+                self,
+                tr("Proxy Failed"),
+                f"{asset_file.name} was published, but its proxy could not be written:\n{e}",
+            )
+            return None
+        self._set_status(f"Generated proxy {proxy_file.name}")
+        return proxy_file
 
-            # Ensure created date exists
-            if "created" not in project_config:
-                project_config["created"] = datetime.now().isoformat()
+    def _send_to_unreal(
+        self, cmds: Any, asset_file: Path, asset_type: str, selection: List[str]
+    ) -> None:
+        """Export the publish for Unreal and import it into the running editor"""
+        from ..services.unreal_service_impl import get_unreal_service
 
-            # Save updated configuration
-            with open(config_file, "w", encoding="utf-8") as f:
-                json.dump(project_config, f, indent=4)
+        self._set_status(f"Sending {asset_file.stem} to Unreal...")
+        try:
+            result = get_unreal_service().send(
+                cmds, asset_file, asset_type, selection, self._get_library_root()
+            )
+        except Exception as e:
+            print(f"[WARNING] Send to Unreal failed: {e}")
+            QMessageBox.warning(
+                self,
+                tr("Send to Unreal Failed"),
+                f"{asset_file.name} was published, but its Unreal FBX could not be "
+                f"written:\n{e}",
+            )
+            return
+        if result.imported:
+            self._set_status(f"Imported {asset_file.stem} into Unreal at {result.game_path}")
+        else:
+            self._set_status(
+                f"Wrote {result.fbx_file.name} for Unreal ({result.message}) - import it by hand"
+            )
 
-            print(f"[OK] Saved project configuration: {config_file}")
+    def _get_alembic_cache_info(
+        self, cmds: Any, asset_data: dict, selection: List[str]
+    ) -> AlembicCacheInfo:
+        """Get cache settings from the create dialog, defaulting to the playback range"""
+        from ..services.alembic_service_impl import get_alembic_service
+
+        alembic_service = get_alembic_service()
+        options = asset_data.get("alembic") or {}
+        start_frame, end_frame = alembic_service.get_playback_range(cmds)
+        return AlembicCacheInfo(
+            start_frame=float(options.get("start_frame", start_frame)),
+            end_frame=float(options.get("end_frame", end_frame)),
+            step=float(options.get("step", 1.0)),
+            world_space=bool(options.get("world_space", True)),
+            roots=tuple(alembic_service.get_export_roots(cmds, selection)),
+        )
+
+    def _export_maya_asset(
+        self,
+        asset_file: Path,
+        file_type: str,
+        selection: List[str],
+        collect_dependencies: bool = False,
+    ) -> List[Path]:
+        """
+        Export selection (or whole scene) as a Maya file - Single Responsibility
+
+        With collect_dependencies, textures and caches are copied next to the
+        asset and the export points at the copies; the working scene keeps its
+        original paths.
+
+        Returns:
+            Dependency files collected for the asset
+        """
+        import maya.cmds as cmds  # type: ignore
+
+        from ..services.dependency_service_impl import get_dependency_service
+
+        dependency_service = get_dependency_service()
+        dependencies = []
+        result = None
+        if collect_dependencies:
+            dependencies = dependency_service.scan_dependencies(selection or None)
+            result = dependency_service.collect(asset_file, dependencies)
+            if result.missing:
+                self._set_status(
+                    f"{len(result.missing)} dependencies missing on disk - left unchanged"
+                )
+
+        try:
+            if selection:
+                # Export selected objects
+                cmds.file(
+                    str(asset_file), force=True, options="v=0", type=file_type, exportSelected=True
+                )
+                self._set_status(
+                    f"Exported {len(selection)} selected objects to {asset_file.stem}"
+                )
+            else:
+                # Export whole scene
+                cmds.file(
+                    str(asset_file), force=True, options="v=0", type=file_type, exportAll=True
+                )
+                self._set_status(f"Exported entire scene to {asset_file.stem}")
+        finally:
+            if result is not None:
+                dependency_service.restore_paths(dependencies, result.repathed)
+
+        return result.copied_files if result is not None else []
+
+    def _get_publish_directory(self, subfolder: str = "scenes") -> Path:
+        """Get directory new assets are published into - Single Responsibility"""
+        # Prefer the matching assets folder of the currently loaded project
+        if self._library_widget and self._library_widget.current_project_path:
+            library_path = Path(self._library_widget.current_project_path) / "assets" / subfolder
+            library_path.mkdir(parents=True, exist_ok=True)
+            return library_path
+
+        # Try to use Documents/Maya/projects/default/assets
+        try:
+            user_docs = Path.home() / "Documents"
+            library_path = user_docs / "maya" / "projects" / "default" / "assets"
+            library_path.mkdir(parents=True, exist_ok=True)
+        except Exception:
+            # Ultimate fallback - current directory
+            library_path = Path.cwd() / "assets"
+            library_path.mkdir(parents=True, exist_ok=True)
+        return library_path
+
+    def _get_library_root(self) -> Optional[Path]:
+        """Get the loaded library (project) root, None if no project is loaded"""
+        if self._library_widget and self._library_widget.current_project_path:
+            return Path(self._library_widget.current_project_path)
+        return None
+
+    def _run_publish_validation(
+        self, selection: List[str], asset_type: Optional[IAssetTypePlugin] = None
+    ) -> bool:
+        """Run publish checks (and a studio asset type's own); False stops the publish"""
+        from ..services.validation_service_impl import get_validation_service
+        from .dialogs.validation_report_dialog import ValidationReportDialog
+
+        report = get_validation_service().validate(
+            selection=selection, library_root=self._get_library_root(), asset_type=asset_type
+        )
+        if report.is_clean:
+            return True
+
+        accepted = ValidationReportDialog(report, self, publishing=True).exec()
+        return report.can_publish and accepted == QDialog.DialogCode.Accepted
+
+    def _on_validate_scene(self) -> None:
+        """Run publish checks without publishing - Single Responsibility"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            from ..services.validation_service_impl import get_validation_service
+            from .dialogs.validation_report_dialog import ValidationReportDialog
 
+            report = get_validation_service().validate(
+                cmds, cmds.ls(selection=True), library_root=self._get_library_root()
+            )
+            self._set_status(f"Validation: {report.summary()}")
+            ValidationReportDialog(report, self, publishing=False).exec()
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Scene validation requires Maya."))
         except Exception as e:
-            print(f"[ERROR] Failed to save project configuration: {e}")
-            raise
+            QMessageBox.critical(self, tr("Validation Error"), f"Failed to validate scene:\n{e}")
 
-    def _on_add_asset_to_library(self) -> None:
-        """Handle adding a single asset to the library - Single Responsibility"""
+    def _on_validation_settings(self) -> None:
+        """Open publish check configuration - Single Responsibility"""
         try:
-            from PySide6.QtWidgets import QFileDialog
+            from ..services.validation_service_impl import get_validation_service
+            from .dialogs.validation_settings_dialog import ValidationSettingsDialog
 
-            # Check if we have a project loaded (optional warning)
-            if (
-                not self._library_widget
-                or not hasattr(self._library_widget, "current_project_path")
-                or not self._library_widget.current_project_path
-            ):
-                reply = QMessageBox.question(
-                    self,
-                    "No Project Loaded",
-                    "No project is currently loaded. Assets will be added to the "
-                    "default location.\n\nWould you like to continue?",
-                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                    QMessageBox.StandardButton.Yes,
+            dialog = ValidationSettingsDialog(
+                get_validation_service(), self._get_library_root(), self
+            )
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open validation settings:\n{e}")
+
+    def _on_shotgrid_settings(self) -> None:
+        """Open ShotGrid publish configuration - Single Responsibility"""
+        try:
+            from ..services.shotgrid_service_impl import get_shotgrid_service
+            from .dialogs.shotgrid_settings_dialog import ShotGridSettingsDialog
+
+            dialog = ShotGridSettingsDialog(get_shotgrid_service(), self)
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open ShotGrid settings:\n{e}")
+
+    def _on_kitsu_settings(self) -> None:
+        """Open Kitsu publish configuration - Single Responsibility"""
+        try:
+            from ..services.kitsu_service_impl import get_kitsu_service
+            from .dialogs.kitsu_settings_dialog import KitsuSettingsDialog
+
+            dialog = KitsuSettingsDialog(get_kitsu_service(), self)
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Kitsu settings:\n{e}")
+
+    def _on_kitsu_tasks(self) -> None:
+        """Browse the artist's Kitsu tasks and link publishes to one"""
+        try:
+            from ..services.kitsu_service_impl import get_kitsu_service
+            from .dialogs.kitsu_tasks_dialog import KitsuTasksDialog
+
+            dialog = KitsuTasksDialog(get_kitsu_service(), self)
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Kitsu tasks:\n{e}")
+
+    def _on_fbx_presets(self) -> None:
+        """Open the library FBX preset configuration - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - FBX presets are stored with it."),
+            )
+            return
+        try:
+            from ..services.fbx_export_service_impl import get_fbx_export_service
+            from .dialogs.fbx_presets_dialog import FbxPresetsDialog
+
+            dialog = FbxPresetsDialog(get_fbx_export_service(), library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(tr("FBX presets saved"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open FBX presets:\n{e}")
+
+    def _on_gltf_settings(self) -> None:
+        """Open the library glTF review export settings - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - glTF settings are stored with it."),
+            )
+            return
+        try:
+            from ..services.gltf_export_service_impl import get_gltf_export_service
+            from .dialogs.gltf_export_dialog import GltfExportDialog
+
+            dialog = GltfExportDialog(get_gltf_export_service(), library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(tr("glTF settings saved"))
+        except Exception as e:
+            QMessageBox.critical(
+                self, tr("Error"), tr("Failed to open glTF settings:\n{error}", error=e)
+            )
+
+    def _on_thumbnail_settings(self) -> None:
+        """Open the library thumbnail settings - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - thumbnail settings are stored with it."),
+            )
+            return
+        try:
+            from ..services.thumbnail_settings_service_impl import (
+                get_thumbnail_settings_service,
+            )
+            from .dialogs.thumbnail_settings_dialog import ThumbnailSettingsDialog
+
+            dialog = ThumbnailSettingsDialog(get_thumbnail_settings_service(), library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(
+                    tr("Thumbnail settings saved - regenerate thumbnails to apply them")
                 )
-                if reply == QMessageBox.StandardButton.No:
-                    return
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open thumbnail settings:\n{e}")
 
-            # Get supported file extensions for the dialog filter
-            supported_exts = [
-                ".ma",
-                ".mb",
-                ".obj",
-                ".fbx",
-                ".abc",
-                ".usd",
-                ".usda",
-                ".usdc",
-                ".usdz",
-                ".png",
-                ".jpg",
-                ".jpeg",
-                ".tiff",
-                ".tga",
-                ".exr",
-                ".hdr",
-                ".mov",
-                ".mp4",
-                ".avi",
-                ".mtl",
-                ".mat",
-                ".zip",
-                ".rar",
-            ]
+    def _on_color_management(self) -> None:
+        """Open the library's thumbnail color management - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - color settings are stored with it."),
+            )
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            cmds = None
 
-            # Create filter string
-            filters = "Asset Files ("
-            for ext in supported_exts:
-                filters += f"*{ext} "
-            filters = filters.strip() + ");;"
-            filters += "Maya Files (*.ma *.mb);;"
-            filters += "3D Models (*.obj *.fbx *.abc *.usd *.usda *.usdc *.usdz);;"
-            filters += "Images (*.png *.jpg *.jpeg *.tiff *.tga *.exr *.hdr);;"
-            filters += "All Files (*)"
+        try:
+            from ..services.color_management_service_impl import get_color_management_service
+            from .dialogs.color_management_dialog import ColorManagementDialog
 
-            # Open file dialog
-            file_path, _ = QFileDialog.getOpenFileName(
-                self, "Add Asset to Library", str(Path.home()), filters
+            dialog = ColorManagementDialog(
+                get_color_management_service(), library_root, cmds, self
             )
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open color management:\n{e}")
+            return
 
-            if file_path:
-                success = self._copy_asset_to_library(Path(file_path))
-                if success:
-                    self._set_status(f"Added asset: {Path(file_path).name}")
-                    self._on_refresh_library()
-                else:
-                    self._set_status("Failed to add asset")
+        self._set_status(f"Thumbnail color management: {dialog.transform.label}")
+        self._offer_color_thumbnail_regeneration(library_root)
+
+    def _on_set_thumbnail_camera(self) -> None:
+        """Render the current asset's thumbnail through a scene camera's placement"""
+        asset = self._current_asset
+        database = self._get_metadata_database()
+        if asset is None or database is None:
+            QMessageBox.information(self, tr("No Asset"), tr("Select a library asset first."))
+            return
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.information(self, tr("Maya Required"), tr("Scene cameras need Maya."))
+            return
+
+        try:
+            from ..services.playblast_service_impl import get_playblast_service
+            from ..services.thumbnail_settings_service_impl import (
+                get_thumbnail_settings_service,
+            )
+
+            cameras = get_playblast_service().list_cameras(cmds)
+            if not cameras:
+                QMessageBox.information(self, tr("No Cameras"), tr("The scene has no cameras."))
+                return
+            camera, ok = QInputDialog.getItem(
+                self, "Thumbnail Camera", f"Render {asset.name} through:", cameras, 0, False
+            )
+            if not ok:
+                return
 
+            asset_path = Path(asset.file_path)
+            settings_service = get_thumbnail_settings_service()
+            base = settings_service.get_asset_settings(
+                self._get_library_root(), asset_path, database
+            )
+            settings = settings_service.capture_camera(cmds, camera, base)
+            settings_service.set_asset_settings(database, asset_path, settings)
+            self._set_status(f"{asset.name}: thumbnail camera set from {camera}")
+            self._generate_thumbnail_for_library_asset(asset_path)
         except Exception as e:
-            error_msg = f"Error adding asset: {str(e)}"
-            print(error_msg)
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Add Asset Error", error_msg)
+            QMessageBox.critical(self, tr("Error"), f"Failed to set the thumbnail camera:\n{e}")
 
-    def _on_add_multiple_assets(self) -> None:
-        """Handle adding multiple assets from folders - Single Responsibility"""
+    def _on_unreal_settings(self) -> None:
+        """Open Send to Unreal configuration - Single Responsibility"""
         try:
-            from PySide6.QtWidgets import QFileDialog
+            from ..services.fbx_export_service_impl import get_fbx_export_service
+            from ..services.unreal_service_impl import get_unreal_service
+            from .dialogs.unreal_settings_dialog import UnrealSettingsDialog
 
-            # Check if we have a project loaded (optional warning)
-            if (
-                not self._library_widget
-                or not hasattr(self._library_widget, "current_project_path")
-                or not self._library_widget.current_project_path
-            ):
-                reply = QMessageBox.question(
-                    self,
-                    "No Project Loaded",
-                    "No project is currently loaded. Assets will be added to the "
-                    "default location.\n\nWould you like to continue?",
-                    QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                    QMessageBox.StandardButton.Yes,
+            presets, _asset_types = get_fbx_export_service().load_presets(
+                self._get_library_root()
+            )
+            dialog = UnrealSettingsDialog(get_unreal_service(), sorted(presets), self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(tr("Unreal settings saved"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Unreal settings:\n{e}")
+
+    def _on_naming_templates(self) -> None:
+        """Open the library naming templates - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - naming templates are stored with it."),
+            )
+            return
+        try:
+            from ..services.naming_template_service_impl import get_naming_template_service
+            from .dialogs.naming_templates_dialog import NamingTemplatesDialog
+
+            dialog = NamingTemplatesDialog(get_naming_template_service(), library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(tr("Naming templates saved"))
+                self._on_refresh_library()  # Re-check existing assets against the templates
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open naming templates:\n{e}")
+
+    def _on_tag_rules(self) -> None:
+        """Open the library's auto-tag rules - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library first - tag rules are stored with it.")
+            )
+            return
+        try:
+            from ..services.auto_tag_service_impl import get_auto_tag_service
+            from .dialogs.tag_rules_dialog import TagRulesDialog
+
+            dialog = TagRulesDialog(get_auto_tag_service(), library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._set_status(tr("Tag rules saved - they apply to the next publishes"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open tag rules:\n{e}")
+
+    def _on_pipeline_hooks(self) -> None:
+        """Show the loaded pipeline hooks - Single Responsibility"""
+        from .dialogs.pipeline_hooks_dialog import PipelineHooksDialog
+
+        PipelineHooksDialog(self._hook_service, self._get_library_root(), self).exec()
+
+    def _on_library_permissions(self) -> None:
+        """Open the library role assignments - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self,
+                tr("No Library"),
+                tr("Load a library first - permissions are stored with it."),
+            )
+            return
+        try:
+            from .dialogs.library_permissions_dialog import LibraryPermissionsDialog
+
+            dialog = LibraryPermissionsDialog(self._permission_service, library_root, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                role = self._permission_service.get_role(library_root)
+                self._set_status(f"Library permissions saved - your role: {role}")
+        except Exception as e:
+            QMessageBox.critical(
+                self, tr("Error"), f"Failed to open Library Permissions:\n{str(e)}"
+            )
+
+    def _on_path_mapping(self) -> None:
+        """Edit the library's mount on each platform - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library first - its mounts are stored with it.")
+            )
+            return
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        try:
+            from ..services.path_mapping_service_impl import get_path_mapping_service
+            from .dialogs.path_mapping_dialog import PathMappingDialog
+
+            path_mapping_service = get_path_mapping_service()
+            dialog = PathMappingDialog(path_mapping_service, library_root, self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+            mapping = dialog.get_mapping()
+            if mapping is None or not path_mapping_service.save_mapping(library_root, mapping):
+                QMessageBox.warning(
+                    self, tr("Save Failed"), tr("Could not save the path mapping.")
                 )
-                if reply == QMessageBox.StandardButton.No:
-                    return
+                return
+            self._install_path_mapping(library_root)
+            self._set_status(f"Path mapping saved - library paths start with {mapping.variable}")
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Path Mapping:\n{e}")
+
+    def _install_path_mapping(self, library_root: Path) -> None:
+        """Resolve the library's root token and other platforms' mounts on this machine"""
+        from ..services.path_mapping_service_impl import get_path_mapping_service
+
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            cmds = None
+        get_path_mapping_service().install(library_root, cmds)
 
-            # Get multiple files
-            file_paths, _ = QFileDialog.getOpenFileNames(
-                self,
-                "Add Multiple Assets to Library",
-                str(Path.home()),
-                "Asset Files (*.ma *.mb *.obj *.fbx *.abc *.usd *.usda *.usdc *.usdz "
-                "*.png *.jpg *.jpeg *.tiff *.tga *.exr *.hdr *.mov *.mp4 *.avi "
-                "*.mtl *.mat *.zip *.rar);;All Files (*)",
+    def _on_vendor_license(self) -> None:
+        """Edit the library's vendor license terms - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library first - its license is stored with it.")
             )
+            return
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        try:
+            from ..services.vendor_library_service_impl import get_vendor_library_service
+            from .dialogs.vendor_license_dialog import VendorLicenseDialog
 
-            if file_paths:
-                # Show progress
-                self._set_status(f"Adding {len(file_paths)} assets...", show_progress=True)
+            vendor_service = get_vendor_library_service()
+            dialog = VendorLicenseDialog(vendor_service, library_root, self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+            vendor_library = dialog.get_vendor_library()
+            if vendor_library is not None:
+                saved = vendor_service.save_vendor_library(library_root, vendor_library)
+                message = f"{library_root.name} is a read-only vendor library"
+            elif vendor_service.is_read_only(library_root):
+                saved = vendor_service.remove_vendor_library(library_root)
+                message = f"{library_root.name} is an ordinary library again"
+            else:
+                return
+            if not saved:
+                QMessageBox.warning(
+                    self, tr("Save Failed"), tr("Could not save the vendor license.")
+                )
+                return
+            self._set_status(message)
+            self._refresh_library_picker()
+            if self._current_asset:
+                self._update_asset_info_display(self._current_asset)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Vendor License:\n{e}")
 
-                added_count = 0
-                failed_count = 0
+    def _confirm_restricted_licenses(self, bundle: Any) -> bool:
+        """Ask before a bundle carries vendor assets that may not be delivered"""
+        terms = "\n".join(f"  • {restricted.label}" for restricted in bundle.restricted_licenses)
+        reply = QMessageBox.warning(
+            self,
+            tr("Restricted Licenses"),
+            tr(
+                "The bundle carries vendor assets whose license does not allow delivering "
+                "them to clients:\n\n{terms}\n\nExport the bundle anyway?",
+                terms=terms,
+            ),
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        return reply == QMessageBox.StandardButton.Yes
 
-                for file_path in file_paths:
-                    try:
-                        if self._copy_asset_to_library(Path(file_path)):
-                            added_count += 1
-                        else:
-                            failed_count += 1
-                    except Exception as e:
-                        print(f"Failed to add {file_path}: {e}")
-                        failed_count += 1
+    def _on_review_asset(self) -> None:
+        """Review the selected asset - Single Responsibility"""
+        if not self._current_asset or not self._library_widget:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to review.")
+            )
+            return
+        self._library_widget.review_asset(self._current_asset)
 
-                # Update status
-                if failed_count == 0:
-                    self._set_status(f"Successfully added {added_count} assets")
-                else:
-                    self._set_status(f"Added {added_count} assets, {failed_count} failed")
+    def _confirm_deprecated_use(self, asset: Any) -> bool:
+        """Ask before bringing a deprecated asset into the scene"""
+        from ..services.asset_status_service_impl import get_asset_status_service
 
-                # Refresh library to show new assets
-                self._on_refresh_library()
+        status = get_asset_status_service().get_status(getattr(asset, "metadata", None))
+        if not status.is_deprecated:
+            return True
+        reply = QMessageBox.question(
+            self,
+            tr("Deprecated Asset"),
+            f"'{asset.display_name}' is deprecated.\n\n{status.description}\n\n"
+            "Import it anyway?",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        return reply == QMessageBox.StandardButton.Yes
 
-                # Show summary dialog
-                if added_count > 0:
-                    message = f"Successfully added {added_count} asset(s) to the library."
-                    message += "\n\nThumbnails have been generated for all imported assets."
-                    if failed_count > 0:
-                        message += f"\n\n{failed_count} file(s) could not be added."
-                    QMessageBox.information(self, "Assets Added", message)
-                else:
-                    QMessageBox.warning(
-                        self,
-                        "No Assets Added",
-                        "No assets were successfully added to the library.",
-                    )
+    def _run_pipeline_hook(self, hook: str, **context: Any) -> bool:
+        """Run studio callbacks of a hook point; False when a pre_ hook cancelled"""
+        from ..services.hook_service_impl import HookCancelled
 
-        except Exception as e:
-            error_msg = f"Error adding multiple assets: {str(e)}"
-            print(error_msg)
-            self._set_status(error_msg)
-            QMessageBox.critical(self, "Add Multiple Assets Error", error_msg)
+        try:
+            self._hook_service.run(hook, self._get_library_root(), **context)
+        except HookCancelled as e:
+            reason = str(e) or "A pipeline hook stopped this action."
+            self._set_status(f"Cancelled by pipeline hook: {reason}")
+            QMessageBox.information(self, tr("Cancelled by Pipeline Hook"), reason)
+            return False
+        self._usage_stats_service.record_hook(hook, self._get_library_root(), context)
+        if self._activity_service.record_hook(hook, self._get_library_root(), context):
+            # The Recent tab lists this artist's logged imports and publishes
+            if self._library_widget:
+                self._library_widget.reload_recent_assets()
+        return True
+
+    def _run_with_import_hooks(
+        self, asset: Asset, mode: str, load: Callable[[], bool], **context: Any
+    ) -> bool:
+        """Load an asset between the pre_ and post_import hooks; False when nothing loaded"""
+        hook_context = {
+            "asset_file": asset.file_path,
+            "asset_name": asset.display_name,
+            "mode": mode,
+            **context,
+        }
+        if not self._run_pipeline_hook(HOOK_PRE_IMPORT, **hook_context):
+            return False
+        if not load():
+            return False
+        self._run_pipeline_hook(HOOK_POST_IMPORT, **hook_context)
+        return True
+
+    def _check_permission(self, action: str, library_root: Optional[Path] = None) -> bool:
+        """Check the artist's library role; False (after telling them) when not allowed"""
+        from ..services.permission_service_impl import PermissionDenied
 
-    def _copy_asset_to_library(self, source_path: Path) -> bool:
-        """Copy asset file to the project library - Uses Library Service"""
         try:
-            # Get project path
-            project_path = None
-            if (
-                self._library_widget
-                and hasattr(self._library_widget, "current_project_path")
-                and self._library_widget.current_project_path
-            ):
-                project_path = self._library_widget.current_project_path
+            self._permission_service.check(library_root or self._get_library_root(), action)
+        except PermissionDenied as e:
+            self._set_status(str(e))
+            QMessageBox.warning(self, tr("Permission Denied"), str(e))
+            return False
+        return True
 
-            if not project_path:
-                # Fallback to default assets directory
-                project_path = Path.home() / "Documents" / "maya" / "projects" / "default"
-                # Create default project structure if it doesn't exist
-                if not project_path.exists():
-                    project_path.mkdir(parents=True, exist_ok=True)
+    def _register_shotgrid_publish(
+        self, asset_file: Path, version_number: int, description: str
+    ) -> None:
+        """Track a library publish as a ShotGrid PublishedFile - Single Responsibility"""
+        from ..services.shotgrid_service_impl import get_shotgrid_service
 
-            # Ensure project_path is a Path object
-            project_path = Path(project_path) if isinstance(project_path, str) else project_path
+        shotgrid_service = get_shotgrid_service()
+        if not shotgrid_service.is_enabled():
+            return
 
-            # Use library service to add asset
-            # This handles: file copy + repository update + recent assets with correct path
-            result = self._library_service.add_asset_to_library(source_path, project_path)
+        thumbnail = self._find_publish_thumbnail(asset_file)
+        published = shotgrid_service.publish(asset_file, version_number, description, thumbnail)
+        if published:
+            self._set_status(
+                f"Registered {asset_file.stem} v{version_number:03d} in ShotGrid"
+            )
 
-            if result and result[0]:  # Success
-                target_path = result[1]
-                print("[OK] Asset successfully added to library via LibraryService")
-                print(f"[FOLDER] Source: {source_path}")
-                print(f"[FOLDER] Target: {target_path}")
+    def _register_kitsu_publish(
+        self, asset_file: Path, version_number: int, description: str
+    ) -> None:
+        """Post a library publish to the linked Kitsu task - Single Responsibility"""
+        from ..services.kitsu_service_impl import get_kitsu_service
+        from ..services.playblast_service_impl import find_preview_movie
 
-                # CRITICAL: Generate playblast thumbnails for Maya/USD files BEFORE importing to scene!
-                # This allows users to preview assets in library without cluttering their scene
-                if target_path and isinstance(target_path, Path):
-                    if target_path.suffix.lower() in {
-                        ".ma",
-                        ".mb",
-                        ".usd",
-                        ".usda",
-                        ".usdc",
-                        ".usdz",
-                    }:
-                        print(f"[THUMBNAIL] Generating preview thumbnail for: {target_path.name}")
-                        # Schedule thumbnail generation after library refresh
-                        QTimer.singleShot(
-                            200, lambda: self._generate_thumbnail_for_library_asset(target_path)
-                        )
+        kitsu_service = get_kitsu_service()
+        if not kitsu_service.is_enabled():
+            return
 
-                # Refresh library to show new asset
-                QTimer.singleShot(100, self._on_refresh_library)
+        # Reviewers see motion when the publish captured a playblast
+        preview = find_preview_movie(asset_file) or self._find_publish_thumbnail(asset_file)
+        posted = kitsu_service.publish(asset_file, version_number, description, preview)
+        if posted:
+            self._set_status(f"Posted {asset_file.stem} v{version_number:03d} to Kitsu")
+
+    def _find_publish_thumbnail(self, asset_file: Path) -> Optional[Path]:
+        """Get the thumbnail written by the publish, None if there is none"""
+        # Playblast may add frame padding to the image name (crate.0001.png)
+        candidates = [asset_file.with_suffix(".png")]
+        candidates += sorted(asset_file.parent.glob(f"{asset_file.stem}.*.png"))
+        return next((path for path in candidates if path.exists()), None)
+
+    def _on_version_history(self, asset: Optional[Asset] = None) -> None:
+        """Open version history browser for an asset - Single Responsibility"""
+        asset = asset or self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to view its version history.")
+            )
+            return
 
-                return True
-            else:
-                print("[ERROR] Failed to add asset to library")
-                return False
+        try:
+            from .dialogs.version_history_dialog import VersionHistoryDialog
 
+            dialog = VersionHistoryDialog(
+                Path(asset.file_path),
+                self._version_service,
+                self,
+                lock_service=self._lock_service,
+                can_roll_back=self._permission_service.is_allowed(
+                    self._get_library_root(), ACTION_PUBLISH
+                ),
+            )
+            dialog.import_version_requested.connect(self._on_import_version_file)
+            dialog.version_rolled_back.connect(
+                lambda version: self._set_status(
+                    f"Rolled back {version.asset_name} - now {version.label}"
+                )
+            )
+            dialog.version_rolled_back.connect(
+                lambda _version: self._library_widget.mark_changed([Path(asset.file_path)])
+            )
+            dialog.version_rolled_back.connect(
+                lambda version: self._record_publish(Path(asset.file_path), version)
+            )
+            dialog.version_rolled_back.connect(
+                lambda version: self._activity_service.record(
+                    self._get_library_root(),
+                    ACTIVITY_PUBLISH,
+                    asset.file_path,
+                    version=version.number,
+                    mode="rollback",
+                    notes=version.notes,
+                )
+            )
+            dialog.version_rolled_back.connect(lambda _version: self._on_refresh_library())
+            dialog.compare_requested.connect(self._on_compare_versions)
+            dialog.preview_requested.connect(
+                lambda before, after: self._show_compare_preview(
+                    before.file_path,
+                    after.file_path,
+                    f"{before.asset_name} {before.label}",
+                    f"{after.asset_name} {after.label}",
+                )
+            )
+            dialog.exec()
         except Exception as e:
-            print(f"[ERROR] Error copying asset to library: {e}")
-            import traceback
-
-            traceback.print_exc()
-            return False
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Version History:\n{str(e)}")
 
-    def _generate_thumbnail_for_library_asset(self, asset_path: Path) -> None:
-        """
-        Generate PLAYBLAST thumbnail for asset when added to library.
+    def _on_compare_versions(self, before: AssetVersion, after: AssetVersion) -> None:
+        """Show what changed between two versions of an asset - Single Responsibility"""
+        from ..services.asset_diff_service_impl import get_asset_diff_service
+        from .dialogs.asset_diff_dialog import AssetDiffDialog
 
-        This is the KEY feature - generates thumbnails WITHOUT importing to user's scene!
-        Uses isolated temporary namespace for preview generation, then cleans up completely.
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            cmds = None  # Maya ASCII versions still compare outside Maya
 
-        Single Responsibility: Playblast thumbnail generation for library preview
-        """
         try:
-            # Get thumbnail service from container
-            from ..core.container import get_container
-            from ..core.interfaces.thumbnail_service import IThumbnailService
+            diff = get_asset_diff_service().compare_files(before.file_path, after.file_path, cmds)
+        except Exception as e:
+            QMessageBox.warning(
+                self,
+                tr("Compare Failed"),
+                f"Could not compare {before.label} and {after.label}:\n{e}",
+            )
+            return
 
-            container = get_container()
-            thumbnail_service = container.resolve(IThumbnailService)
+        self._set_status(f"{before.asset_name} {before.label} → {after.label}: {diff.summary}")
+        AssetDiffDialog(diff, before.label, after.label, self).exec()
 
-            print(f"[THUMBNAIL] Generating playblast preview for library: {asset_path.name}")
+    def _on_compare_preview(self) -> None:
+        """Render the two selected assets side by side - Single Responsibility"""
+        selected = self._library_widget.get_selected_assets() if self._library_widget else []
+        if len(selected) != 2:
+            QMessageBox.information(
+                self,
+                tr("Select Two Assets"),
+                tr("Select two assets to compare, or compare versions from Version History."),
+            )
+            return
+        left, right = selected
+        self._show_compare_preview(
+            Path(left.file_path), Path(right.file_path), left.name, right.name
+        )
 
-            # Generate playblast thumbnail with force_playblast=True
-            # This imports to isolated namespace, captures, and cleans up - NO scene pollution!
-            thumbnail_path = thumbnail_service.generate_thumbnail(
-                asset_path, size=(256, 256), force_playblast=True
+    def _show_compare_preview(
+        self, left_file: Path, right_file: Path, left_label: str, right_label: str
+    ) -> None:
+        """Open the A/B preview of two asset files, rendered offscreen by mayapy"""
+        from ..services.compare_preview_service_impl import get_compare_preview_service
+        from ..services.thumbnail_queue_impl import find_mayapy
+        from .dialogs.compare_preview_dialog import ComparePreviewDialog
+
+        if find_mayapy() is None:
+            QMessageBox.warning(
+                self,
+                tr("mayapy Not Found"),
+                tr("Compare previews need mayapy. Set MAYA_LOCATION to your Maya install."),
             )
+            return
 
-            if thumbnail_path:
-                print(f"[THUMB] Generated large playblast thumbnail: {asset_path.name}")
-                # Also generate smaller thumbnail for list view
-                small_thumbnail_path = thumbnail_service.generate_thumbnail(
-                    asset_path, size=(64, 64), force_playblast=True
-                )
-                if small_thumbnail_path:
-                    print(f"[THUMB] Generated small playblast thumbnail: {asset_path.name}")
+        try:
+            service = get_compare_preview_service()
+            preview = service.create_preview(left_file, right_file, left_label, right_label)
+            self._set_status(f"Rendering compare preview: {preview.label}")
+            ComparePreviewDialog(service, preview, self._get_color_transform(), self).exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open the compare preview:\n{e}")
 
-                    # Trigger UI refresh for this specific asset
-                    if (
-                        self._library_widget
-                        and hasattr(self._library_widget, "refresh_thumbnails_for_assets")
-                        and callable(
-                            getattr(self._library_widget, "refresh_thumbnails_for_assets")
-                        )
-                    ):
-                        # Use QTimer to ensure this runs after thumbnails are saved
-                        def refresh_thumbnail():
-                            try:
-                                self._library_widget.refresh_thumbnails_for_assets([asset_path])  # type: ignore
-                            except Exception as e:
-                                print(f"[ERROR] Failed to refresh thumbnail in UI: {e}")
+    def _on_find_duplicates(self) -> None:
+        """Open the duplicate asset review - Single Responsibility"""
+        if not self._check_permission(ACTION_DELETE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to search for duplicates.")
+            )
+            return
 
-                        QTimer.singleShot(500, refresh_thumbnail)
-                        print(f"[REFRESH] Scheduled thumbnail refresh for: {asset_path.name}")
-                else:
-                    print(f"[WARNING] Could not generate small thumbnail for: {asset_path.name}")
-            else:
-                print(f"[WARNING] Could not generate thumbnail for: {asset_path.name}")
+        search_roots = self._get_scene_search_roots(library_root)
+        try:
+            from ..services.duplicate_service_impl import get_duplicate_service
+            from .dialogs.duplicate_assets_dialog import DuplicateAssetsDialog
 
+            merged = []
+            dialog = DuplicateAssetsDialog(
+                get_duplicate_service(), library_root, search_roots, self
+            )
+            dialog.duplicate_redirected.connect(self._redirect_open_scene_references)
+            dialog.duplicate_merged.connect(lambda duplicate, _canonical: merged.append(duplicate))
+            dialog.duplicate_merged.connect(self._remove_merged_duplicate)
+            dialog.exec()
+            if merged:
+                self._on_refresh_library()
         except Exception as e:
-            print(f"[ERROR] Thumbnail generation failed for {asset_path}: {e}")
-            import traceback
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Duplicate Assets:\n{e}")
 
-            traceback.print_exc()
-            # Don't fail the library addition if thumbnail generation fails
-
-    def _generate_thumbnail_for_imported_asset(self, asset_path: Path) -> None:
-        """
-        Generate PLAYBLAST thumbnail for asset AFTER it's imported into Maya scene.
+    def _get_scene_search_roots(self, library_root: Path) -> List[Path]:
+        """Get the folders whose scenes may reference library assets"""
+        # Scenes referencing library assets live in the library and the Maya project
+        search_roots = [library_root]
+        try:
+            import maya.cmds as cmds  # type: ignore
 
-        NOTE: This is now redundant since thumbnails are generated when added to library.
-        Kept for backwards compatibility or manual thumbnail regeneration.
+            workspace = Path(cmds.workspace(query=True, rootDirectory=True))
+            if workspace.is_dir() and workspace.resolve() != library_root.resolve():
+                search_roots.append(workspace)
+        except Exception:
+            pass
+        return search_roots
+
+    def _on_show_dependency_graph(self) -> None:
+        """Open the dependency graph of the current asset - Single Responsibility"""
+        library_root = self._get_library_root()
+        asset = self._current_asset
+        if library_root is None or asset is None:
+            QMessageBox.information(self, tr("No Asset"), tr("Select a library asset first."))
+            return
 
-        Single Responsibility: Playblast thumbnail generation with UI refresh
-        """
-        # Simply call the library asset thumbnail generator
-        self._generate_thumbnail_for_library_asset(asset_path)
+        try:
+            from ..services.dependency_graph_service_impl import get_dependency_graph_service
+            from .dialogs.dependency_graph_dialog import DependencyGraphDialog
 
-    def _extract_full_metadata_for_imported_asset(self, asset_path: Path) -> None:
-        """
-        Extract FULL Maya metadata for asset AFTER it's imported into Maya scene
+            self._set_status(tr("Scanning the project for dependencies..."))
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                graph = get_dependency_graph_service().build(
+                    library_root, self._get_scene_search_roots(library_root)
+                )
+            finally:
+                QApplication.restoreOverrideCursor()
+            asset_path = Path(asset.file_path).resolve()
+            self._set_status(f"{asset.name}: {graph.describe_affected(asset_path)}")
+            DependencyGraphDialog(graph, asset_path, self).exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to show the dependency graph:\n{e}")
+
+    def _on_where_used(self) -> None:
+        """List the scenes referencing the current asset - Single Responsibility"""
+        library_root = self._get_library_root()
+        asset = self._current_asset
+        if library_root is None or asset is None:
+            QMessageBox.information(self, tr("No Asset"), tr("Select a library asset first."))
+            return
 
-        IMPORTANT: This should ONLY be called when user explicitly imports asset to Maya,
-        NOT when adding to library! Adding to library gets basic metadata only.
+        try:
+            from ..services.where_used_service_impl import get_where_used_service
+            from .dialogs.where_used_dialog import WhereUsedDialog
 
-        This extracts detailed Maya scene data:
-        - Actual polygon counts from geometry
-        - Material and texture information
-        - Animation data and frame ranges
-        - Camera and light counts
-        - Scene hierarchy information
+            dialog = WhereUsedDialog(
+                get_where_used_service(),
+                Path(asset.file_path),
+                self._get_scene_search_roots(library_root),
+                self,
+            )
+            dialog.exec()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Where Used:\n{e}")
+
+    def _on_rename_asset(self) -> None:
+        """Rename or move the current asset - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        asset = self._current_asset
+        if library_root is None or asset is None:
+            QMessageBox.information(self, tr("No Asset"), tr("Select a library asset first."))
+            return
 
-        Single Responsibility: Full metadata extraction with asset update
-        """
         try:
-            # Get repository service from container
-            from ..core.container import get_container
+            from ..services.asset_rename_service_impl import get_asset_rename_service
+            from .dialogs.rename_asset_dialog import RenameAssetDialog
 
-            _ = get_container()
+            asset_file = Path(asset.file_path)
+            dialog = RenameAssetDialog(get_asset_rename_service(), library_root, asset_file, self)
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return
+            new_file = dialog.new_file
+            redirects = get_asset_rename_service().rename(
+                library_root, asset_file, new_file, self._get_metadata_database()
+            )
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to rename asset:\n{e}")
+            return
 
-            # Check if repository has extract_full_maya_metadata method
-            if hasattr(self._repository, "extract_full_maya_metadata"):
-                print(f"📊 Extracting full Maya metadata for imported asset: {asset_path.name}")
+        self._activity_service.record(
+            library_root, ACTIVITY_RENAME, new_file, **{"from": asset_file.as_posix()}
+        )
+        self._set_status(f"Renamed {asset_file.name} to {new_file.name}")
+        if dialog.repair_references:
+            self._repair_references(library_root, redirects)
+        self._on_refresh_library()
+
+    def _on_repair_renamed_references(self) -> None:
+        """Repath scenes using old paths of every renamed asset - Single Responsibility"""
+        library_root = self._get_library_root()
+        database = self._get_metadata_database()
+        if library_root is None or database is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to repair references.")
+            )
+            return
 
-                # Extract full metadata (this imports asset to analyze it)
-                full_metadata = self._repository.extract_full_maya_metadata(asset_path)  # type: ignore
+        from ..services.asset_rename_service_impl import get_asset_rename_service
 
-                if full_metadata and full_metadata.get("metadata_level") == "full":
-                    poly_count = full_metadata.get("poly_count", 0)
-                    material_count = full_metadata.get("material_count", 0)
-                    has_animation = full_metadata.get("has_animation", False)
+        redirects = get_asset_rename_service().get_redirects(database)
+        if not redirects:
+            QMessageBox.information(
+                self, tr("Repair Renamed References"), tr("No asset of this library was renamed.")
+            )
+            return
+        self._repair_references(library_root, redirects)
 
-                    print(f"[OK] Full metadata extracted for {asset_path.name}:")
-                    print(f"   - Polygons: {poly_count:,}")
-                    print(f"   - Materials: {material_count}")
-                    print(f"   - Animation: {'Yes' if has_animation else 'No'}")
+    def _repair_references(self, library_root: Path, redirects: Dict[Path, Path]) -> None:
+        """Repath project scenes and the open scene from old asset paths to new ones"""
+        from ..services.asset_rename_service_impl import get_asset_rename_service
 
-                    # CRITICAL FIX: Persist extracted metadata to asset!
-                    try:
-                        # Get the asset from repository
-                        asset = self._repository.get_asset_by_path(asset_path)  # type: ignore
-                        if asset:
-                            # Update asset metadata with extracted data
-                            if not hasattr(asset, "metadata") or asset.metadata is None:
-                                asset.metadata = {}
+        try:
+            self._set_status(tr("Repathing scenes that use renamed assets..."), show_progress=True)
+            QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+            try:
+                repathed, skipped = get_asset_rename_service().repair_references(
+                    self._get_scene_search_roots(library_root), redirects
+                )
+                for old_file, new_file in redirects.items():
+                    self._redirect_open_scene_references(old_file, new_file)
+            finally:
+                QApplication.restoreOverrideCursor()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to repair references:\n{e}")
+            return
 
-                            # Store extracted metadata
-                            asset.metadata["poly_count"] = poly_count
-                            asset.metadata["material_count"] = material_count
-                            asset.metadata["has_animation"] = has_animation
-                            asset.metadata["metadata_level"] = "full"
+        self._set_status(f"Repathed {len(repathed)} scene(s)")
+        if skipped:
+            QMessageBox.warning(
+                self,
+                tr("Scenes Left Unchanged"),
+                f"Repathed {len(repathed)} scene(s). These scenes still use old paths and "
+                "need to be opened in Maya and repathed (Reference Editor):\n\n"
+                + "\n".join(f"• {scene}" for scene in skipped[:20]),
+            )
 
-                            # Save updated asset to repository
-                            if hasattr(self._repository, "update_asset"):
-                                self._repository.update_asset(asset)  # type: ignore
-                                print(f"[SAVE] Metadata persisted for {asset_path.name}")
+    def _on_audit_library(self) -> None:
+        """Open the library integrity audit - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        database = self._get_metadata_database()
+        if database is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load a library to audit."))
+            return
 
-                                # ISSUE #3 FIX: Directly update asset info display for currently selected asset
-                                if (
-                                    self._current_asset
-                                    and self._current_asset.file_path == asset_path
-                                ):
-                                    print(
-                                        "[REFRESH] Updating metadata display for currently selected asset"
-                                    )
-                                    # Refresh the asset object from repository with new metadata
-                                    updated_asset = self._repository.get_asset_by_path(asset_path)  # type: ignore
-                                    if updated_asset:
-                                        self._current_asset = updated_asset
-                                        self._update_asset_info_display(updated_asset)
+        try:
+            from ..services.integrity_service_impl import get_integrity_service
+            from .dialogs.library_audit_dialog import LibraryAuditDialog
 
-                                # Also trigger library refresh
-                                if self._library_widget:
-                                    QTimer.singleShot(
-                                        100, self._library_widget.refresh_library  # type: ignore
-                                    )
-                            else:
-                                print("[WARNING] Repository doesn't support update_asset()")
-                        else:
-                            print(f"[WARNING] Asset not found in repository: {asset_path}")
-                    except Exception as persist_error:
-                        print(f"[WARNING] Could not persist metadata: {persist_error}")
+            dialog = LibraryAuditDialog(get_integrity_service(), database, self)
+            dialog.exec()
+            if dialog.changed:
+                self._on_refresh_library()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to audit library:\n{e}")
 
-                else:
-                    print(f"[WARNING] Could not extract full metadata for: {asset_path.name}")
-            else:
-                print("[WARNING] Repository does not support full metadata extraction")
+    def _on_bulk_edit_metadata(self) -> None:
+        """Open the library-wide metadata find-and-replace - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        database = self._get_metadata_database()
+        if database is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to edit its metadata.")
+            )
+            return
+
+        try:
+            from ..services.bulk_edit_service_impl import get_bulk_edit_service
+            from .dialogs.bulk_edit_dialog import BulkEditDialog
 
+            selected_keys = [
+                database.get_asset_key(asset.file_path)
+                for asset in self._library_widget.get_selected_assets()
+            ]
+            dialog = BulkEditDialog(
+                get_bulk_edit_service(), database.library_root, selected_keys, self
+            )
+            dialog.edits_changed.connect(self._on_refresh_library)
+            dialog.exec()
         except Exception as e:
-            print(f"[ERROR] Metadata extraction error: {e}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Bulk Edit:\n{e}")
 
-    def _on_refresh_library(self) -> None:
-        """Handle library refresh - Enhanced with proper error handling and user feedback"""
+    def _on_keyboard_shortcuts(self) -> None:
+        """Edit the manager command shortcuts - Single Responsibility"""
         try:
-            # Check if library widget exists
-            if not self._library_widget:
-                QMessageBox.warning(self, "Warning", "Library widget is not initialized.")
+            from .dialogs.hotkey_editor_dialog import HotkeyEditorDialog
+
+            dialog = HotkeyEditorDialog(
+                self._hotkey_service, self._get_reserved_shortcuts(), self
+            )
+            if dialog.exec() != QDialog.DialogCode.Accepted:
                 return
+            if not self._hotkey_service.set_shortcuts(dialog.get_shortcuts()):
+                QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the shortcuts."))
+                return
+            self._apply_command_shortcuts()
+            self._set_status(tr("Keyboard shortcuts updated"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to edit keyboard shortcuts:\n{e}")
 
-            # Show progress and status feedback
-            self._set_status("Refreshing library...", show_progress=True)
+    def _on_import_last(self) -> None:
+        """Import the most recently used asset again - Single Responsibility"""
+        recent_assets = self._repository.get_recent_assets(1)
+        if not recent_assets:
+            QMessageBox.information(
+                self, tr("No Recent Asset"), tr("No asset has been imported yet.")
+            )
+            return
+        self._on_asset_import(recent_assets[0])
 
-            # Perform the refresh
-            self._library_widget.refresh_library()
+    def _on_focus_search(self) -> None:
+        """Jump to the library search field - Single Responsibility"""
+        if self._library_widget:
+            self.activateWindow()
+            self._library_widget.focus_search()
 
-            # Get current project path for feedback
-            if hasattr(self._library_widget, "current_project_path"):
-                project_path = self._library_widget.current_project_path
-                if project_path:
-                    # Ensure project_path is a Path object before accessing .name
-                    if isinstance(project_path, str):
-                        project_path = Path(project_path)
-                    self._set_status(f"Library refreshed from: {project_path.name}")
-                else:
-                    self._set_status("No project loaded - nothing to refresh")
-                    QMessageBox.information(
-                        self,
-                        "Info",
-                        "No project is currently loaded.\nPlease open a project first.",
-                    )
-            else:
-                self._set_status("Library refresh completed")
+    def _on_set_language(self, locale: str) -> None:
+        """Store the artist's UI language - Single Responsibility"""
+        if not get_localization_service().set_language(locale):
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the language setting."))
+            return
+        QMessageBox.information(
+            self,
+            tr("Language"),
+            tr("The new language applies the next time Asset Manager opens."),
+        )
 
-            # Hide progress
-            self._progress_bar.setVisible(False)
+    def _on_library_maintenance(self) -> None:
+        """Open the library maintenance schedule and report - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load a library to maintain."))
+            return
+
+        try:
+            from .dialogs.maintenance_dialog import MaintenanceDialog
 
+            MaintenanceDialog(self._maintenance_service, library_root, self).exec()
         except Exception as e:
-            # Hide progress on error
-            self._progress_bar.setVisible(False)
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Library Maintenance:\n{e}")
 
-            error_msg = f"Failed to refresh library: {str(e)}"
-            self._set_status(f"Error: {error_msg}")
-            QMessageBox.critical(self, "Refresh Error", error_msg)
-            logger.error("Library refresh error: %s", e)
+    def _on_version_retention(self) -> None:
+        """Open the library's version retention policy - Single Responsibility"""
+        if not self._check_permission(ACTION_MANAGE):
+            return
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to set its version retention.")
+            )
+            return
 
-    def _on_reset_icon_size(self) -> None:
-        """Reset library icon size to default - Single Responsibility"""
         try:
-            if self._library_widget and hasattr(self._library_widget, "reset_icon_size"):
-                self._library_widget.reset_icon_size()
-                self._set_status("Icon size reset to default (64px)")
-                print("[OK] Icon size reset to default: 64px")
-            else:
-                self._set_status("Library widget not available")
+            from ..services.retention_service_impl import get_retention_service
+            from .dialogs.retention_policy_dialog import RetentionPolicyDialog
+
+            RetentionPolicyDialog(get_retention_service(), library_root, self).exec()
         except Exception as e:
-            error_msg = f"Failed to reset icon size: {str(e)}"
-            self._set_status(f"Error: {error_msg}")
-            print(f"Reset icon size error: {e}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Version Retention:\n{e}")
 
-    def _on_advanced_search(self) -> None:
-        """Handle advanced search dialog"""
-        try:
-            from .dialogs.advanced_search_dialog import AdvancedSearchDialog
+    def _on_storage_usage(self) -> None:
+        """Open the library disk-use and usage dashboard - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to see its storage usage.")
+            )
+            return
 
-            dialog = AdvancedSearchDialog(self)
-            if dialog.exec() == QDialog.DialogCode.Accepted:
-                criteria = dialog.get_search_criteria()
-                if criteria and self._library_widget:
-                    self._library_widget.search_with_criteria(criteria)
-        except ImportError:
-            QMessageBox.warning(
-                self, "Feature Not Available", "Advanced Search dialog is not implemented yet."
+        try:
+            from ..services.storage_service_impl import get_storage_service
+            from .dialogs.storage_dashboard_dialog import StorageDashboardDialog
+
+            dialog = StorageDashboardDialog(
+                get_storage_service(),
+                library_root,
+                can_edit_quota=self._permission_service.is_allowed(library_root, ACTION_MANAGE),
+                usage_service=self._usage_stats_service,
+                parent=self,
             )
+            dialog.asset_selected.connect(self._on_storage_asset_selected)
+            dialog.exec()
         except Exception as e:
-            QMessageBox.critical(self, "Error", f"Failed to perform advanced search: {e}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Storage Usage:\n{e}")
 
-    def _on_import_selected(self) -> None:
-        """Handle import selected assets with safe validation"""
+    def _on_storage_asset_selected(self, asset_file: Path) -> None:
+        """Select an asset picked in the storage dashboard"""
+        if not self._library_widget or not self._library_widget.reveal_asset(asset_file):
+            self._set_status(f"{asset_file.name} is not shown in the library")
+
+    def _check_storage_quota(self, asset_file: Path) -> None:
+        """Warn when a publish brings its asset or category near a storage quota"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            return
         try:
-            if not self._library_widget:
-                QMessageBox.warning(self, "Warning", "Asset library is not available.")
-                return
+            from ..services.storage_service_impl import get_storage_service
 
-            selected_assets = self._library_widget.get_selected_assets()
+            warnings = get_storage_service().check_publish(library_root, asset_file)
+        except Exception as e:
+            print(f"[WARNING] Storage quota check failed for {asset_file.name}: {e}")
+            return
+        if not warnings:
+            return
+        for warning in warnings:
+            print(f"[WARNING] {warning.description}")
+        self._set_status(warnings[0].description)
+        exceeded = [warning for warning in warnings if warning.is_exceeded]
+        if exceeded:
+            QMessageBox.warning(
+                self,
+                tr("Storage Quota Exceeded"),
+                "\n".join(warning.description for warning in exceeded)
+                + "\n\nFree space or ask a library admin to raise the quota "
+                "(Edit > Storage Usage).",
+            )
 
-            # SAFE SELECTION VALIDATION - Defensive Programming
-            if not selected_assets:
-                QMessageBox.information(
-                    self, "No Selection", "Please select one or more assets to import."
-                )
+    def _run_idle_maintenance(self) -> None:
+        """Run the library's due maintenance jobs while this Maya session is idle"""
+        try:
+            import maya.cmds  # type: ignore  # noqa: F401
+        except ImportError:
+            return
+        library_root = self._get_library_root()
+        if library_root is None or self._maintenance_running or self._offline_library:
+            return
+        if QApplication.activeModalWidget() is not None:
+            return  # The artist is busy in a dialog
+        try:
+            if not self._maintenance_service.load_schedule(library_root).run_on_idle:
                 return
+            if not self._maintenance_service.get_due_jobs(library_root):
+                return
+        except Exception as e:
+            print(f"[WARNING] Maintenance schedule check failed: {e}")
+            return
 
-            # Validate each asset before import
-            valid_assets = []
-            for asset in selected_assets:
-                if self._validate_asset_file_path(asset):
-                    valid_assets.append(asset)
-                else:
-                    print(
-                        f"[WARNING] Skipping invalid asset: {getattr(asset, 'display_name', 'Unknown')}"
-                    )
+        self._maintenance_running = True
+        self._maintenance_service.run_in_background(
+            library_root, self.maintenance_finished.emit, trigger="idle"
+        )
 
-            if not valid_assets:
-                QMessageBox.warning(self, "Invalid Assets", "No valid assets selected for import.")
-                return
+    def _on_maintenance_finished(self, results) -> None:
+        """Report idle maintenance in the status bar"""
+        self._maintenance_running = False
+        if results:
+            failed = sum(1 for result in results if not result.success)
+            self._set_status(f"Library maintenance ran {len(results)} job(s), {failed} failed")
+
+    def _on_activity_log(self) -> None:
+        """Open the library-wide activity log - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(
+                self, tr("No Library"), tr("Load a library to see its activity.")
+            )
+            return
 
-            # Import valid assets
-            for asset in valid_assets:
-                self._on_asset_import(asset)
+        try:
+            from .dialogs.activity_log_dialog import ActivityLogDialog
 
+            ActivityLogDialog(self._activity_service, library_root, self).exec()
         except Exception as e:
-            print(f"[ERROR] Import selected error: {e}")
-            QMessageBox.critical(
-                self, "Import Error", f"Failed to import selected assets:\n{str(e)}"
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Activity Log:\n{e}")
+
+    def _redirect_open_scene_references(self, duplicate: Path, canonical: Path) -> None:
+        """Repath references of a duplicate in the open Maya scene"""
+        from ..services.maya_integration_impl import MayaIntegrationImpl
+
+        maya_integration = MayaIntegrationImpl()
+        for reference in maya_integration.get_scene_references():
+            if Path(reference["file_path"]).resolve() != Path(duplicate).resolve():
+                continue
+            if maya_integration.replace_reference(reference["reference_node"], canonical):
+                self._set_status(f"Repathed {reference['reference_node']} to {canonical.name}")
+
+    def _remove_merged_duplicate(self, duplicate: Path, canonical: Path) -> None:
+        """Remove a duplicate merged into its canonical asset from the library"""
+        asset = self._repository.get_asset_by_path(duplicate)  # type: ignore
+        if asset is not None and self._library_service.remove_asset_from_library(asset):
+            self._set_status(f"Merged {duplicate.name} into {canonical.name}")
+            self._run_pipeline_hook(
+                HOOK_ASSET_DELETED,
+                asset_file=duplicate,
+                asset_name=asset.display_name,
+                mode="merged",
+                merged_into=canonical,
             )
+        else:
+            print(f"[WARNING] Could not remove merged duplicate {duplicate}")
 
-    def _on_refresh_thumbnails(self) -> None:
-        """Handle manual thumbnail refresh - Single Responsibility"""
-        try:
-            if not self._library_widget:
-                QMessageBox.warning(self, "Warning", "Library widget is not initialized.")
+    def _on_toggle_multi_user_mode(self, enabled: bool) -> None:
+        """Enable asset locking for a library shared by several artists"""
+        self._check_out_action.setEnabled(enabled)
+        self._check_in_action.setEnabled(enabled)
+        if self._library_widget:
+            self._library_widget.set_multi_user_mode(enabled)
+        self._set_status("Multi-user mode enabled" if enabled else "Multi-user mode disabled")
+
+    def _on_toggle_perforce_mode(self, enabled: bool) -> None:
+        """Store the library in a Perforce depot - Single Responsibility"""
+        if enabled and not self._source_control.is_available():
+            self._source_control.reset()
+            if not self._source_control.is_available():
+                QMessageBox.warning(
+                    self,
+                    tr("Perforce Unavailable"),
+                    tr(
+                        "The p4 command line client was not found or no workspace is set.\n\n"
+                        "Set P4PORT, P4USER, and P4CLIENT (or P4CONFIG) and try again."
+                    ),
+                )
+                self._perforce_action.setChecked(False)
                 return
+        if self._library_widget:
+            self._library_widget.set_perforce_mode(enabled)
+        self._set_status("Perforce mode enabled" if enabled else "Perforce mode disabled")
+
+    def _is_perforce_active(self, asset_file: Path) -> bool:
+        """Check if an asset file is handled through the depot"""
+        return self._perforce_action.isChecked() and self._source_control.is_managed(asset_file)
+
+    def _get_depot_paths(self, asset_file: Path, include_history: bool = True) -> List[Path]:
+        """Get the depot paths an asset's publish writes: file, dependencies, versions"""
+        from ..services.alembic_service_impl import ALEMBIC_EXTENSION, get_alembic_service
+        from ..core.models.gltf_export import GLTF_EXTENSIONS
+        from ..services.dependency_service_impl import get_dependency_service
+        from ..services.lod_service_impl import get_lod_service
+
+        paths = [asset_file, get_dependency_service().get_dependency_directory(asset_file) / "..."]
+        if asset_file.suffix.lower() == ALEMBIC_EXTENSION:
+            paths.append(get_alembic_service().get_sidecar_path(asset_file))
+        if asset_file.suffix.lower() in (".ma", ".mb"):
+            paths.append(asset_file.with_suffix(".fbx"))  # FBX handoff of preset asset types
+            paths.extend(asset_file.with_suffix(extension) for extension in GLTF_EXTENSIONS)
+            paths.append(get_lod_service().get_lod_directory(asset_file) / "...")
+        if include_history:
+            paths.append(self._version_service.get_history_directory(asset_file) / "...")
+        return paths
+
+    def _sync_asset_from_depot(self, asset: Asset) -> None:
+        """Sync an asset to head (or its pinned changelist) before it is loaded"""
+        if not self._is_perforce_active(asset.file_path):
+            return
+        database = self._get_metadata_database()
+        change = database.get_depot_pin(asset.file_path) if database is not None else None
+        paths = self._get_depot_paths(asset.file_path, include_history=False)
+        if self._source_control.sync(paths, change):
+            target = f"changelist {change}" if change else "head"
+            self._set_status(f"Synced {asset.display_name} to {target}")
+
+    def _on_depot_sync(self, asset: Asset) -> None:
+        """Sync an asset from the depot and show its new revision"""
+        self._sync_asset_from_depot(asset)
+        self._on_refresh_library()
+
+    def _on_depot_pin(self, asset: Asset) -> None:
+        """Pin an asset to a changelist so imports sync that revision instead of head"""
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        revision = self._library_widget.get_depot_revision(asset) if self._library_widget else None
+        current = database.get_depot_pin(asset.file_path)
+        default = current or (revision.head_change if revision else 0)
 
-            # Show progress
-            self._set_status("Refreshing all thumbnails...", show_progress=True)
+        change, ok = QInputDialog.getInt(
+            self,
+            "Pin to Changelist",
+            f"Changelist to sync '{asset.display_name}' to (0 = always head):",
+            default,
+            0,
+        )
+        if not ok:
+            return
+        database.set_depot_pin(asset.file_path, change or None)
+        self._set_status(
+            f"Pinned {asset.display_name} to changelist {change}"
+            if change
+            else f"{asset.display_name} follows head"
+        )
+        self._on_depot_sync(asset)
 
-            # Force refresh all thumbnails
-            if hasattr(self._library_widget, "force_refresh_all_thumbnails"):
-                self._library_widget.force_refresh_all_thumbnails()  # type: ignore
-                self._set_status("Thumbnail refresh completed")
-            else:
-                # Fallback - just refresh the library
-                self._library_widget.refresh_library()
-                self._set_status("Library refreshed")
+    def _on_check_out(self, asset: Optional[Asset] = None) -> None:
+        """Check out (lock) an asset for the current artist - Single Responsibility"""
+        asset = asset or self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to check out.")
+            )
+            return
 
-            # Hide progress
-            self._progress_bar.setVisible(False)
+        lock = self._lock_service.check_out(Path(asset.file_path))
+        if lock is None:
+            current = self._lock_service.get_lock(Path(asset.file_path))
+            owner = current.description if current else "another artist"
+            QMessageBox.warning(
+                self, tr("Asset Locked"), f"'{asset.display_name}' is checked out by {owner}."
+            )
+            return
 
-        except Exception as e:
-            self._progress_bar.setVisible(False)
-            error_msg = f"Failed to refresh thumbnails: {str(e)}"
-            self._set_status(f"Error: {error_msg}")
-            QMessageBox.critical(self, "Thumbnail Refresh Error", error_msg)
-            print(f"Thumbnail refresh error: {e}")
+        self._set_status(f"Checked out {asset.display_name}")
+        self._on_refresh_library()
 
-    def _on_clear_thumbnail_cache(self) -> None:
-        """Handle clearing thumbnail cache - Single Responsibility"""
-        try:
-            from ..core.container import get_container
-            from ..core.interfaces.thumbnail_service import IThumbnailService
+    def _on_check_in(self, asset: Optional[Asset] = None) -> None:
+        """Check in (unlock) an asset - offers to break other artists' locks"""
+        asset = asset or self._current_asset
+        if not asset:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to check in.")
+            )
+            return
 
-            # Ask for confirmation
+        asset_path = Path(asset.file_path)
+        lock = self._lock_service.get_lock(asset_path)
+        if lock is None:
+            self._set_status(f"{asset.display_name} is not checked out")
+            return
+
+        force = False
+        if not self._lock_service.can_publish(asset_path):
             reply = QMessageBox.question(
                 self,
-                "Clear Thumbnail Cache",
-                "This will delete all cached thumbnails.\n"
-                "Thumbnails will be regenerated as needed.\n\n"
-                "Are you sure you want to continue?",
+                tr("Break Lock"),
+                f"'{asset.display_name}' is checked out by {lock.description}.\n\n"
+                "Break their lock? Any changes they publish later may overwrite yours.",
                 QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
-                QMessageBox.StandardButton.No,
             )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+            force = True
 
-            if reply == QMessageBox.StandardButton.Yes:
-                # Clear the cache
-                container = get_container()
-                thumbnail_service = container.resolve(IThumbnailService)
-                thumbnail_service.clear_cache()  # type: ignore
-
-                # Refresh thumbnails after clearing cache
-                if self._library_widget and hasattr(self._library_widget, "refresh_library"):
-                    self._library_widget.refresh_library()
-
-                self._set_status("Thumbnail cache cleared")
-                QMessageBox.information(
-                    self, "Cache Cleared", "Thumbnail cache has been cleared successfully."
-                )
-
-        except Exception as e:
-            error_msg = f"Failed to clear thumbnail cache: {str(e)}"
-            self._set_status(f"Error: {error_msg}")
-            QMessageBox.critical(self, "Cache Clear Error", error_msg)
-            print(f"Cache clear error: {e}")
+        if self._lock_service.check_in(asset_path, force=force):
+            self._set_status(f"Checked in {asset.display_name}")
+            self._on_refresh_library()
+        else:
+            QMessageBox.warning(
+                self, tr("Check In Failed"), f"Could not release lock on {asset.display_name}."
+            )
 
-    def _on_create_asset(self) -> None:
-        """Handle create asset button click - Single Responsibility"""
+    def _on_import_version_file(self, version_file: Path) -> None:
+        """Import a historical version into Maya without touching the current asset"""
         try:
-            from ..ui.dialogs.create_asset_dialog import CreateAssetDialog
-
-            dialog = CreateAssetDialog(self)
-            if dialog.exec() == QDialog.DialogCode.Accepted:
-                asset_data = dialog.get_asset_data()
-                if asset_data:
-                    # Create the asset
-                    success = self._create_asset_from_scene(asset_data)
-                    if success:
-                        self._set_status(f"Created asset: {asset_data.get('name', 'Unknown')}")
-                        self._on_refresh_library()  # Refresh to show new asset
-                    else:
-                        self._set_status("Failed to create asset")
-
-        except ImportError:
-            # Fallback to simple dialog
-            self._show_simple_create_dialog()
-
+            version_file = Path(version_file)
+            stat_info = version_file.stat()
+            version_asset = Asset(
+                id=str(version_file),
+                name=version_file.stem,
+                file_path=version_file,
+                file_extension=version_file.suffix,
+                file_size=stat_info.st_size,
+            )
+            if self._import_asset_to_maya(version_asset):
+                self._set_status(f"Imported {version_file.parent.name} of {version_file.stem}")
+            else:
+                self._set_status(f"Failed to import {version_file.parent.name}")
         except Exception as e:
-            error_msg = f"Create asset error: {str(e)}"
-            print(error_msg)
-            self._set_status(error_msg)
-            QMessageBox.warning(self, "Create Asset Error", f"Failed to create asset:\n{str(e)}")
+            QMessageBox.warning(self, tr("Import Error"), f"Failed to import version:\n{str(e)}")
 
-    def _show_simple_create_dialog(self) -> None:
-        """Simple create asset dialog fallback - YAGNI implementation"""
-        name, ok = QInputDialog.getText(self, "Create Asset", "Asset name:")
-        if ok and name.strip():
-            asset_data = {
-                "name": name.strip(),
-                "description": "",
-                "tags": [],
-                "category": "General",
-            }
-            success = self._create_asset_from_scene(asset_data)
-            if success:
-                self._set_status(f"Created asset: {name}")
-                self._on_refresh_library()
-            else:
-                self._set_status("Failed to create asset")
+    def _on_import_as_usd_stage(self) -> None:
+        """Load selected USD asset through a mayaUsdProxyShape - Single Responsibility"""
+        asset = self._current_asset
+        if not asset or asset.file_extension.lower() not in USD_PUBLISH_FORMATS | {".usdz"}:
+            QMessageBox.information(
+                self, tr("No USD Asset"), tr("Please select a USD asset to import as a stage.")
+            )
+            return
 
-    def _create_asset_from_scene(self, asset_data: dict) -> bool:
-        """Create asset from current Maya scene - Single Responsibility"""
         try:
-            import maya.cmds as cmds  # type: ignore
-
-            # Get asset library path - simple fallback approach
-            # Try to use Documents/Maya/projects/default/assets
-            try:
-                user_docs = Path.home() / "Documents"
-                maya_projects = user_docs / "maya" / "projects" / "default" / "assets"
-                if not maya_projects.exists():
-                    maya_projects.mkdir(parents=True, exist_ok=True)
-                library_path = maya_projects
-            except Exception:
-                # Ultimate fallback - current directory
-                library_path = Path.cwd() / "assets"
-                library_path.mkdir(parents=True, exist_ok=True)
+            from ..services.usd_service_impl import get_usd_service
 
-            # Create asset filename
-            asset_name = asset_data["name"]
-            safe_name = "".join(
-                c for c in asset_name if c.isalnum() or c in (" ", "-", "_")
-            ).rstrip()
-            asset_file = library_path / f"{safe_name}.ma"
-
-            # Make sure directory exists
-            asset_file.parent.mkdir(parents=True, exist_ok=True)
-
-            # Export selection or whole scene
-            selection = cmds.ls(selection=True)
-            if selection:
-                # Export selected objects
-                cmds.file(
-                    str(asset_file),
-                    force=True,
-                    options="v=0",
-                    type="mayaAscii",
-                    exportSelected=True,
-                )
-                self._set_status(f"Exported {len(selection)} selected objects to {safe_name}")
+            proxy_shape = get_usd_service().import_usd_as_stage(Path(asset.file_path), asset.name)
+            if proxy_shape:
+                self._set_status(f"Loaded {asset.name} as USD stage ({proxy_shape})")
             else:
-                # Export whole scene
-                cmds.file(
-                    str(asset_file), force=True, options="v=0", type="mayaAscii", exportAll=True
+                QMessageBox.warning(
+                    self,
+                    tr("USD Stage Import Failed"),
+                    tr("Could not create a USD stage. Make sure the mayaUsdPlugin is available."),
                 )
-                self._set_status(f"Exported entire scene to {safe_name}")
-
-            # Generate thumbnail
-            thumbnail_path = asset_file.with_suffix(".png")
-            self._generate_thumbnail_for_asset(str(thumbnail_path))
-
-            return True
-
         except Exception as e:
-            print(f"Asset creation failed: {e}")
-            return False
+            QMessageBox.critical(self, tr("Error"), f"Failed to import USD stage:\n{str(e)}")
 
     def _generate_thumbnail_for_asset(self, thumbnail_path: str) -> None:
         """Generate thumbnail for newly created asset - Single Responsibility"""
@@ -2340,7 +9640,10 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
     def _on_add_to_favorites(self) -> None:
         """Handle add to favorites"""
         if self._current_asset:
-            self._repository.add_to_favorites(self._current_asset)
+            if self._library_widget:
+                self._library_widget.add_to_favorites(self._current_asset)
+            else:
+                self._repository.add_to_favorites(self._current_asset)
             self._set_status(f"Added to favorites: {self._current_asset.name}")
 
     def _on_export_selected(self) -> None:
@@ -2349,14 +9652,14 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             # Check if we have selected assets
             if not self._library_widget or not hasattr(self._library_widget, "_selected_assets"):
                 QMessageBox.information(
-                    self, "No Selection", "Please select one or more assets to export."
+                    self, tr("No Selection"), tr("Please select one or more assets to export.")
                 )
                 return
 
             selected_assets = getattr(self._library_widget, "_selected_assets", [])
             if not selected_assets:
                 QMessageBox.information(
-                    self, "No Selection", "Please select one or more assets to export."
+                    self, tr("No Selection"), tr("Please select one or more assets to export.")
                 )
                 return
 
@@ -2373,7 +9676,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             export_path = Path(export_dir)
 
             # Show progress for multiple assets
-            self._set_status("Exporting assets...", show_progress=True)
+            self._set_status(tr("Exporting assets..."), show_progress=True)
 
             try:
                 exported_count = 0
@@ -2424,20 +9727,20 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                         if len(failed_exports) > 5:
                             message += f"\n... and {len(failed_exports) - 5} more"
 
-                    QMessageBox.information(self, "Export Complete", message)
+                    QMessageBox.information(self, tr("Export Complete"), message)
                     self._set_status(f"Exported {exported_count} asset(s)")
                 else:
                     QMessageBox.warning(
                         self,
-                        "Export Failed",
+                        tr("Export Failed"),
                         "No assets could be exported.\n\nErrors:\n"
                         + "\n".join(failed_exports[:3]),
                     )
-                    self._set_status("Export failed")
+                    self._set_status(tr("Export failed"))
 
             except Exception as e:
                 error_msg = f"Export operation failed: {str(e)}"
-                QMessageBox.critical(self, "Export Error", error_msg)
+                QMessageBox.critical(self, tr("Export Error"), error_msg)
                 self._set_status(error_msg)
             finally:
                 self._progress_bar.setVisible(False)
@@ -2445,7 +9748,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         except Exception as e:
             error_msg = f"Failed to export assets: {str(e)}"
             self._set_status(error_msg)
-            QMessageBox.critical(self, "Export Error", error_msg)
+            QMessageBox.critical(self, tr("Export Error"), error_msg)
 
     def _on_select_all(self) -> None:
         """Handle select all assets"""
@@ -2549,12 +9852,68 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
     def _load_project(self, project_path: Path) -> None:
         """Load project from path - Single Responsibility"""
+        # An unreachable library with a local cache is browsed offline instead of failing
+        if not self._offline_cache.is_reachable(project_path) and self._offline_cache.has_cache(
+            project_path
+        ):
+            print(f"[WARNING] Library unreachable, working offline: {project_path}")
+            self._enter_offline_mode(project_path, automatic=True)
+            return
+        self._leave_offline_mode()
+
         try:
             self._set_status(f"Loading project: {project_path.name}...", show_progress=True)
 
+            # Paths stored under the root token or another platform's mount resolve here
+            self._install_path_mapping(project_path)
+
+            # Publishes queued while offline reach the library before it is listed
+            if self._offline_cache.has_cache(project_path):
+                self._sync_offline_publishes(project_path)
+
+            # Publishes a crash interrupted are finished or cleaned up before listing
+            from ..services.publish_transaction_service_impl import (
+                get_publish_transaction_service,
+            )
+
+            recovered = get_publish_transaction_service().recover(project_path)
+            if recovered:
+                print(f"[INFO] Recovered {len(recovered)} interrupted publish(es)")
+
+            # Files of the library's studio asset types are listed along with the rest
+            from ..services.asset_type_service_impl import get_asset_type_service
+
+            get_asset_type_service().load(project_path)
+
             if self._library_widget:
                 self._library_widget.load_project(project_path)
 
+            # Opened projects stay in the library picker
+            self._library_registry.remember_library(project_path)
+            self._refresh_library_picker()
+
+            # Deleted assets past the library's retention period are purged on load
+            from ..services.trash_service_impl import get_trash_service
+
+            purged = get_trash_service().purge_expired(project_path)
+            if purged:
+                print(f"[INFO] Purged {len(purged)} expired asset(s) from the library trash")
+
+            # Farm jobs that finished while the library was closed are picked up on load
+            self._check_farm_jobs()
+
+            # The Maya project may have changed along with the library, and its show
+            self._apply_show_context()
+
+            # Notes that mentioned the artist while the library was closed
+            self._check_mentions()
+
+            # Collections are stored in the library database, not only in memory
+            self._refresh_collections_display()
+
+            # Thumbnails rendered before the project's color config changed look off
+            self._offer_color_thumbnail_regeneration(project_path)
+
             # Auto-select first asset if available
             try:
                 if self._library_widget and hasattr(self._library_widget, "_current_assets"):
@@ -2569,7 +9928,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
         except Exception as e:
             self._set_status(f"Error loading project: {e}")
-            QMessageBox.critical(self, "Project Load Error", str(e))
+            QMessageBox.critical(self, tr("Project Load Error"), str(e))
 
     # Event system handlers
 
@@ -2622,6 +9981,34 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             else:
                 print("[INFO] No saved window state found")
 
+            # Restore multi-user mode (shared library locking)
+            multi_user = settings.value("multiUserMode", False)
+            self._multi_user_action.setChecked(str(multi_user).lower() == "true")
+
+            # Restore Perforce mode (depot library)
+            perforce = settings.value("perforceMode", False)
+            self._perforce_action.setChecked(str(perforce).lower() == "true")
+
+            # Restore import-time material conversion (on unless the artist turned it off)
+            convert_materials = settings.value("convertMaterials", True)
+            self._convert_materials_action.setChecked(str(convert_materials).lower() == "true")
+
+            # Restore looking through imported shot cameras (on unless turned off)
+            look_through = settings.value("lookThroughCameras", True)
+            self._look_through_camera_action.setChecked(str(look_through).lower() == "true")
+
+            # Restore sending heavy publish steps to the render farm
+            farm_publish = settings.value("farmPublishSteps", False)
+            self._farm_publish_action.setChecked(str(farm_publish).lower() == "true")
+
+            # Restore the import name clash pre-check (on unless the artist turned it off)
+            check_clashes = settings.value("checkImportClashes", True)
+            self._check_clashes_action.setChecked(str(check_clashes).lower() == "true")
+
+            # Restore limiting the library to the current show
+            show_filter = settings.value("showContextFilter", False)
+            self._show_filter_action.setChecked(str(show_filter).lower() == "true")
+
             # Load last project path
             last_project = settings.value("lastProject")
             if last_project and self._library_widget:
@@ -2633,6 +10020,12 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             else:
                 print("[INFO] No last project found to restore")
 
+            # Restore offline mode chosen by the artist (the cache is not updated at startup)
+            offline = settings.value("offlineMode", False)
+            library_root = self._get_library_root()
+            if str(offline).lower() == "true" and self._offline_library is None and library_root:
+                self._enter_offline_mode(library_root, automatic=False)
+
         except Exception as e:
             print(f"[ERROR] Error loading window state: {e}")
 
@@ -2649,8 +10042,21 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             # Save window state
             settings.setValue("windowState", self.saveState())
 
-            # Save current project path
-            if (
+            settings.setValue("multiUserMode", self._multi_user_action.isChecked())
+            settings.setValue("perforceMode", self._perforce_action.isChecked())
+            settings.setValue("convertMaterials", self._convert_materials_action.isChecked())
+            settings.setValue("lookThroughCameras", self._look_through_camera_action.isChecked())
+            settings.setValue("checkImportClashes", self._check_clashes_action.isChecked())
+            settings.setValue("farmPublishSteps", self._farm_publish_action.isChecked())
+            settings.setValue("showContextFilter", self._show_filter_action.isChecked())
+            # Switched offline by the artist, not because the library was unreachable
+            offline = self._offline_library is not None and not self._offline_timer.isActive()
+            settings.setValue("offlineMode", offline)
+
+            # Save current project path (the library, not its offline cache)
+            if self._offline_library is not None:
+                settings.setValue("lastProject", str(self._offline_library))
+            elif (
                 hasattr(self, "_library_widget")
                 and self._library_widget
                 and hasattr(self._library_widget, "current_project_path")
@@ -2723,7 +10129,9 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             dialog.color_scheme_changed.connect(lambda: self._on_color_scheme_changed(dialog))
             dialog.exec()
         except Exception as e:
-            QMessageBox.critical(self, "Error", f"Failed to open Color Coding Manager:\n{str(e)}")
+            QMessageBox.critical(
+                self, tr("Error"), f"Failed to open Color Coding Manager:\n{str(e)}"
+            )
 
     def _on_color_scheme_changed(self, dialog=None) -> None:
         """Handle color scheme changes - Single Responsibility"""
@@ -2759,11 +10167,14 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
     def _on_tag_manager(self) -> None:
         """Open Tag Manager dialog - Single Responsibility"""
         try:
+            if self._library_widget:
+                self._library_widget.open_tag_manager()  # Library registry and database
+                return
             dialog = TagManagerDialog(self)
             dialog.tags_changed.connect(self._on_tags_changed)
             dialog.exec()
         except Exception as e:
-            QMessageBox.critical(self, "Error", f"Failed to open Tag Manager:\n{str(e)}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Tag Manager:\n{str(e)}")
 
     def _on_tags_changed(self) -> None:
         """Handle tag system changes - Single Responsibility"""
@@ -2774,17 +10185,22 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         except Exception as e:
             print(f"Error refreshing tags: {e}")
 
-    def _on_new_collection(self) -> None:
-        """Create new collection - Single Responsibility"""
-        text, ok = QInputDialog.getText(self, "New Collection", "Collection name:")
-        if ok and text:
-            QMessageBox.information(self, "Collection Created", f"Created new collection: {text}")
+    def _on_new_collection(self, smart: bool = False) -> None:
+        """Create new (optionally smart) collection - Single Responsibility"""
+        if self._library_widget:
+            self._library_widget.create_collection(smart)
+
+    def _get_known_tags(self) -> List[str]:
+        """Get the tags publish dialogs offer as the artist types"""
+        if self._library_widget:
+            return self._library_widget.get_known_tags()
+        return []
 
     def _on_create_tag(self) -> None:
         """Create new tag - Single Responsibility"""
         text, ok = QInputDialog.getText(self, "Create Tag", "Tag name:")
         if ok and text:
-            QMessageBox.information(self, "Tag Created", f"Created new tag: {text}")
+            QMessageBox.information(self, tr("Tag Created"), f"Created new tag: {text}")
 
     def _on_manage_collections(self) -> None:
         """Open Collections Manager dialog - Single Responsibility"""
@@ -2807,13 +10223,22 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                 self._refresh_collections_display()
 
         except Exception as e:
-            QMessageBox.critical(self, "Error", f"Failed to open Collection Manager:\n{str(e)}")
+            QMessageBox.critical(
+                self, tr("Error"), f"Failed to open Collection Manager:\n{str(e)}"
+            )
+
+    def _get_metadata_database(self) -> Any:
+        """Get the metadata database of the loaded library (None if no project)"""
+        if not self._library_widget:
+            return None
+        return self._library_widget.get_metadata_database()
 
     def _on_collection_created(self, collection_name: str, collection_data: dict) -> None:
         """Handle new collection creation - Single Responsibility"""
         if not hasattr(self, "_collections"):
             self._collections = {}
         self._collections[collection_name] = collection_data
+        self._persist_collection(collection_name, collection_data)
         print(f"✓ Collection created: {collection_name}")
 
     def _on_collection_updated(self, collection_name: str, collection_data: dict) -> None:
@@ -2821,12 +10246,16 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         if not hasattr(self, "_collections"):
             self._collections = {}
         self._collections[collection_name] = collection_data
+        self._persist_collection(collection_name, collection_data)
         print(f"✓ Collection updated: {collection_name}")
 
     def _on_collection_deleted(self, collection_name: str) -> None:
         """Handle collection deletion - Single Responsibility"""
         if hasattr(self, "_collections") and collection_name in self._collections:
             del self._collections[collection_name]
+            database = self._get_metadata_database()
+            if database is not None:
+                database.delete_collection(collection_name)
             print(f"✓ Collection deleted: {collection_name}")
 
     def _on_collections_imported(self, collections: list) -> None:
@@ -2835,15 +10264,32 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             self._collections = {}
         for collection in collections:
             self._collections.update(collection)
+            for collection_name, collection_data in collection.items():
+                self._persist_collection(collection_name, collection_data)
         print(f"✓ Imported {len(collections)} collections")
 
+    def _persist_collection(self, collection_name: str, collection_data: dict) -> None:
+        """Write a collection to the library database - Single Responsibility"""
+        database = self._get_metadata_database()
+        if database is None:
+            return
+        try:
+            database.save_collection(collection_name, collection_data)
+        except Exception as e:
+            print(f"[WARNING] Failed to save collection {collection_name}: {e}")
+
     def _refresh_collections_display(self) -> None:
         """Refresh the collections display in the UI - Single Responsibility"""
-        # This would update any collection-related UI elements
-        # For now, just log the action
+        if self._library_widget:
+            # Reloads from the database and emits collections_changed
+            self._library_widget.reload_collections()
         collection_count = len(getattr(self, "_collections", {}))
         print(f"[REFRESH] Collections display refreshed - {collection_count} collections loaded")
 
+    def _on_collections_changed(self, collections: dict) -> None:
+        """Keep the window's collections in step with the library widget"""
+        self._collections = collections
+
     def _on_usd_pipeline(self) -> None:
         """Open USD Pipeline Creator dialog - Single Responsibility
 
@@ -2868,7 +10314,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             traceback.print_exc()
             QMessageBox.critical(
                 self,
-                "Import Error",
+                tr("Import Error"),
                 f"Failed to load USD Pipeline dialog:\n{e}\n\n"
                 "USD Pipeline requires Python USD libraries (pxr).",
             )
@@ -2876,7 +10322,179 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             import traceback
 
             traceback.print_exc()
-            QMessageBox.critical(self, "Error", f"Failed to open USD Pipeline:\n{str(e)}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to open USD Pipeline:\n{str(e)}")
+
+    def _on_interchange_send(self) -> None:
+        """Send the selection to the paired DCC as a temporary USD layer"""
+        peer = self._interchange_service.get_peer_name()
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(self, tr("Maya Required"), tr("Sending a selection needs Maya."))
+            return
+
+        self._set_status(tr("Sending the selection to {peer}...", peer=peer))
+        try:
+            layer = self._interchange_service.send_selection(cmds)
+        except Exception as e:
+            self._set_status(tr("Send to {peer} failed", peer=peer))
+            QMessageBox.critical(
+                self,
+                tr("Send Failed"),
+                tr("Failed to send to {peer}:\n{error}", peer=peer, error=e),
+            )
+            return
+        if layer.delivered:
+            self._set_status(tr("Sent {name} to {peer}", name=layer.name, peer=peer))
+            return
+        self._set_status(tr("{peer} did not take {file}", peer=peer, file=layer.file_path.name))
+        QMessageBox.warning(
+            self,
+            tr("Not Delivered"),
+            tr(
+                "{peer} did not answer ({message}).\n\nThe layer was written to {file} - "
+                "start the interchange peer in {peer} or load it by hand.",
+                peer=peer,
+                message=layer.message,
+                file=layer.file_path,
+            ),
+        )
+
+    def _on_interchange_receive(self) -> None:
+        """Import a layer of the paired DCC's selection"""
+        peer = self._interchange_service.get_peer_name()
+        self._set_status(tr("Asking {peer} for its selection...", peer=peer), show_progress=True)
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            layer = self._interchange_service.receive()
+            nodes = self._interchange_service.import_layer(layer)
+        except Exception as e:
+            self._set_status(tr("Receive from {peer} failed", peer=peer))
+            QMessageBox.critical(
+                self,
+                tr("Receive Failed"),
+                tr("Failed to receive from {peer}:\n{error}", peer=peer, error=e),
+            )
+            return
+        finally:
+            QApplication.restoreOverrideCursor()
+        self._set_status(
+            tr(
+                "Received {name} from {peer} ({count} nodes)",
+                name=layer.name,
+                peer=peer,
+                count=len(nodes),
+            )
+        )
+
+    def _on_interchange_settings(self) -> None:
+        """Open DCC interchange pairing - Single Responsibility"""
+        try:
+            from .dialogs.interchange_settings_dialog import InterchangeSettingsDialog
+
+            dialog = InterchangeSettingsDialog(self._interchange_service, self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._interchange_service.stop_listening()
+                self._start_interchange_listening()
+                self._set_status(tr("Interchange settings saved"))
+        except Exception as e:
+            QMessageBox.critical(
+                self, tr("Error"), tr("Failed to open interchange settings:\n{error}", error=e)
+            )
+
+    def _start_interchange_listening(self) -> None:
+        """Accept layers from the paired DCC while the setting is on"""
+        if self._interchange_service.get_config().get("listen"):
+            if self._interchange_service.start_listening():
+                self._interchange_timer.start()
+                return
+        self._interchange_timer.stop()
+
+    def _import_interchange_layers(self) -> None:
+        """Import the layers the paired DCC sent since the last look"""
+        for layer in self._interchange_service.take_received():
+            try:
+                nodes = self._interchange_service.import_layer(layer)
+            except Exception as e:
+                print(f"[ERROR] Could not import {layer.file_path.name}: {e}")
+                continue
+            self._set_status(
+                tr(
+                    "Received {name} from {peer} ({count} nodes)",
+                    name=layer.name,
+                    peer=layer.dcc,
+                    count=len(nodes),
+                )
+            )
+
+    def _on_watch_folders(self) -> None:
+        """Choose the folders dropped exports are ingested from - Single Responsibility"""
+        try:
+            from .dialogs.watch_folders_dialog import WatchFoldersDialog
+
+            dialog = WatchFoldersDialog(self._ingest_service, self._get_library_root(), self)
+            if dialog.exec() == QDialog.DialogCode.Accepted:
+                self._start_watching_folders()
+                self._set_status(tr("Watch folder settings saved"))
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open watch folders:\n{e}")
+
+    def _start_watching_folders(self) -> None:
+        """Look at the watch folders on their interval while the setting is on"""
+        config = self._ingest_service.get_config()
+        if config.get("enabled") and config.get("folders"):
+            self._ingest_timer.setInterval(int(config.get("interval_seconds", 15)) * 1000)
+            self._ingest_timer.start()
+        else:
+            self._ingest_timer.stop()
+
+    def _ingest_watch_folders(self) -> None:
+        """Ingest the exports dropped since the last look, with thumbnails and versions"""
+        from ..core.models.watch_folder import MODEL_EXTENSIONS
+        from ..services.thumbnail_queue_impl import find_mayapy
+
+        library_root = self._get_library_root()
+        if library_root is None or self._offline_library is not None:
+            return
+        if not self._permission_service.is_allowed(library_root, ACTION_PUBLISH):
+            return
+        results = self._ingest_service.scan(library_root, self._get_metadata_database())
+        if not results:
+            return
+        ingested = [result for result in results if result.succeeded]
+        for result in ingested:
+            version = self._version_service.get_version(result.asset_file, result.version)
+            if version:
+                self._record_publish(result.asset_file, version)
+            self._activity_service.record(
+                library_root,
+                ACTIVITY_PUBLISH,
+                result.asset_file,
+                version=result.version,
+                mode="ingest",
+                notes=str(result.source),
+            )
+            # Images are their own thumbnails; models render headless in mayapy
+            if result.asset_file.suffix.lower() in MODEL_EXTENSIONS and find_mayapy():
+                self._thumbnail_queue.enqueue(
+                    result.asset_file,
+                    settings=self._get_thumbnail_settings(result.asset_file),
+                    color=self._get_color_transform(),
+                )
+        for result in results:
+            if not result.succeeded:
+                print(f"[WARNING] Could not ingest {result.summary}")
+        if ingested:
+            if self._library_widget:
+                self._library_widget.mark_changed([result.asset_file for result in ingested])
+            self._on_refresh_library()
+        failed = len(results) - len(ingested)
+        message = "; ".join(result.summary for result in ingested[:3])
+        if len(ingested) > 3:
+            message += f" and {len(ingested) - 3} more"
+        if failed:
+            message += f"{'; ' if message else ''}{failed} file(s) could not be ingested"
+        self._set_status(f"Watch folders: {message}")
 
     def _on_check_update(self) -> None:
         """Check for plugin updates from GitHub - BULLETPROOF VERSION
@@ -2888,7 +10506,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         print("🔍 Starting bulletproof update check...")
 
         # Show progress immediately
-        self._set_status("Checking for updates...", show_progress=True)
+        self._set_status(tr("Checking for updates..."), show_progress=True)
 
         def safe_hide_progress():
             """Safely hide progress bar"""
@@ -2907,16 +10525,16 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
                     QMessageBox.information(
                         self,
-                        "Update Available",
+                        tr("Update Available"),
                         f"New version available!\n\nCurrent: v{current}\nLatest: v{latest}\n\nVisit GitHub to download.",
                     )
                 else:
                     from PySide6.QtWidgets import QMessageBox
 
                     QMessageBox.information(
-                        self, "Up to Date", f"You are running the latest version (v{current})."
+                        self, tr("Up to Date"), f"You are running the latest version (v{current})."
                     )
-                self._set_status("Ready")
+                self._set_status(tr("Ready"))
                 print("[OK] Update result shown safely")
             except Exception as e:
                 print(f"[WARNING] Could not show result: {e}")
@@ -2928,10 +10546,10 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
                 QMessageBox.warning(
                     self,
-                    "Update Check Failed",
+                    tr("Update Check Failed"),
                     f"Could not check for updates.\n\nError: {error_msg}",
                 )
-                self._set_status("Ready")
+                self._set_status(tr("Ready"))
                 print("[OK] Error shown safely")
             except Exception as e:
                 print(f"[WARNING] Could not show error: {e}")
@@ -3011,7 +10629,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
                 # Show dialog with auto-install option
                 msg = QMessageBox(self)
-                msg.setWindowTitle("Update Available")
+                msg.setWindowTitle(tr("Update Available"))
                 msg.setIcon(QMessageBox.Icon.Information)
                 msg.setText(
                     f"<h3>New version available!</h3>"
@@ -3052,31 +10670,31 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                 # Running latest version
                 QMessageBox.information(
                     self,
-                    "Check for Updates",
+                    tr("Check for Updates"),
                     f"Update Checker\n\n"
                     f"Asset Manager v{current_version}\n"
                     f"You are running the latest version.\n"
                     f"Check back later for updates.",
                 )
 
-            self._set_status("Ready")
+            self._set_status(tr("Ready"))
 
         except Exception as e:
             QMessageBox.warning(
-                self, "Update Check Error", f"Error displaying update information:\n{str(e)}"
+                self, tr("Update Check Error"), f"Error displaying update information:\n{str(e)}"
             )
-            self._set_status("Ready")
+            self._set_status(tr("Ready"))
 
     def _show_update_error(self, error_msg: str) -> None:
         """Show update check error - runs on main thread"""
         QMessageBox.warning(
             self,
-            "Update Check Failed",
+            tr("Update Check Failed"),
             f"Could not check for updates.\n\n"
             f"Error: {error_msg}\n\n"
             f"Please check your internet connection.",
         )
-        self._set_status("Ready")
+        self._set_status(tr("Ready"))
 
     def _download_and_install_update(self, zip_url: str, version: str) -> None:
         """Download and install update automatically - NON-BLOCKING (Threading)
@@ -3197,10 +10815,10 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
     def _show_install_success(self, version: str, backup_dir: Optional[str] = None) -> None:
         """Show installation success dialog - runs on main thread"""
-        self._set_status("Ready")
+        self._set_status(tr("Ready"))
 
         msg = QMessageBox(self)
-        msg.setWindowTitle("Update Installed")
+        msg.setWindowTitle(tr("Update Installed"))
         msg.setIcon(QMessageBox.Icon.Information)
         msg.setText(f"<h3>Asset Manager v{version} installed successfully!</h3>")
         msg.setInformativeText(
@@ -3212,11 +10830,11 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
     def _show_install_error(self, error_msg: str) -> None:
         """Show installation error dialog - runs on main thread"""
-        self._set_status("Ready")
+        self._set_status(tr("Ready"))
 
         QMessageBox.critical(
             self,
-            "Installation Failed",
+            tr("Installation Failed"),
             f"<h3>Failed to install update</h3>"
             f"<p>Error: {error_msg}</p>"
             f"<p>Please try manual installation from GitHub.</p>",
@@ -3225,12 +10843,16 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
     # Missing toolbar and UI action handlers - Clean Code implementation
     def _on_remove_selected_asset(self) -> None:
         """Remove selected asset from library - Single Responsibility"""
+        if not self._check_permission(ACTION_DELETE):
+            return
         if not self._library_widget:
             return
 
         selected_assets = self._library_widget.get_selected_assets()
         if not selected_assets:
-            QMessageBox.information(self, "No Selection", "Please select an asset to remove.")
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select an asset to remove.")
+            )
             return
 
         # Validate assets using duck typing
@@ -3246,7 +10868,9 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                 print("[WARNING] Invalid asset object skipped during removal validation")
 
         if not valid_assets:
-            QMessageBox.warning(self, "Invalid Selection", "No valid assets selected for removal.")
+            QMessageBox.warning(
+                self, tr("Invalid Selection"), tr("No valid assets selected for removal.")
+            )
             return
 
         # Confirm removal
@@ -3255,7 +10879,7 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
         reply = QMessageBox.question(
             self,
-            "Confirm Removal",
+            tr("Confirm Removal"),
             message,
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
@@ -3279,6 +10903,12 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                         removed_count += 1
                         print(f"[OK] Successfully removed asset: {asset.display_name}")
                         self._set_status(f"Removed asset: {asset.display_name}")
+                        self._run_pipeline_hook(
+                            HOOK_ASSET_DELETED,
+                            asset_file=asset.file_path,
+                            asset_name=asset.display_name,
+                            mode="remove",
+                        )
                     else:
                         failed_removals.append(asset.display_name)
                         print(
@@ -3298,18 +10928,18 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                     message = f"Removed {removed_count} asset(s) successfully.\n\n"
                     message += f"Failed to remove {len(failed_removals)} asset(s):\n"
                     message += "\n".join([f"• {failure}" for failure in failed_removals])
-                    QMessageBox.warning(self, "Partial Success", message)
+                    QMessageBox.warning(self, tr("Partial Success"), message)
                 else:
                     QMessageBox.information(
                         self,
-                        "Removal Complete",
+                        tr("Removal Complete"),
                         f"Successfully removed {removed_count} asset(s) from library.",
                     )
             else:
                 QMessageBox.warning(
                     self,
-                    "Removal Failed",
-                    "No assets were removed. Check the console for error details.",
+                    tr("Removal Failed"),
+                    tr("No assets were removed. Check the console for error details."),
                 )
 
             # Refresh library to reflect changes
@@ -3318,6 +10948,8 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
     def _on_delete_selected_asset(self) -> None:
         """Delete selected asset(s) from file system and project - Single Responsibility"""
+        if not self._check_permission(ACTION_DELETE):
+            return
         if not self._library_widget:
             return
 
@@ -3325,20 +10957,26 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         if not selected_assets:
             QMessageBox.information(
                 self,
-                "No Selection",
-                "Please select one or more assets to delete from the project.",
+                tr("No Selection"),
+                tr("Please select one or more assets to delete from the project."),
             )
             return
 
-        # Confirm deletion with stronger warning
-        _ = [asset.display_name for asset in selected_assets]
-        message = "[WARNING] WARNING: This will permanently delete the following files:\n\n"
-        message += f"{chr(10).join([f'• {asset.display_name} ({asset.file_path})' for asset in selected_assets])}\n\n"
-        message += "This action cannot be undone. Are you sure you want to delete these files from the project?"
+        from ..services.trash_service_impl import get_trash_service
 
-        reply = QMessageBox.critical(
+        # Deleted assets go to the library trash until restored or purged
+        library_root = self._get_library_root()
+        retention_days = get_trash_service().get_retention_days(library_root)
+        kept = f"for {retention_days} days" if retention_days else "until purged"
+        message = "The following assets will be moved to the library trash:\n\n"
+        message += "\n".join(
+            f"• {asset.display_name} ({asset.file_path})" for asset in selected_assets
+        )
+        message += f"\n\nThey are kept {kept} and can be restored from File > Library Trash."
+
+        reply = QMessageBox.warning(
             self,
-            "Confirm File Deletion",
+            tr("Confirm Asset Deletion"),
             message,
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
@@ -3347,23 +10985,35 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         if reply == QMessageBox.StandardButton.Yes:
             deleted_count = 0
             failed_deletions = []
+            db = self._get_metadata_database()
 
             for asset in selected_assets:
                 try:
                     # First remove from repository
                     self._repository.remove_asset(asset)
 
-                    # Then delete the actual file from file system
-                    file_path = (
-                        Path(asset.file_path)
-                        if isinstance(asset.file_path, str)
-                        else asset.file_path
-                    )
+                    # Then move the file and everything stored for it into the trash
+                    file_path = Path(asset.file_path)
                     if file_path.exists():
-                        file_path.unlink()  # Delete the file
+                        entry = get_trash_service().trash_asset(
+                            library_root or file_path.parent,
+                            file_path,
+                            metadata=db.get_asset_metadata(file_path) if db else None,
+                        )
+                        if entry is None:
+                            failed_deletions.append(f"{asset.display_name}: not in the library")
+                            continue
+                        if db:
+                            db.remove_asset(file_path)
                         deleted_count += 1
-                        self._set_status(f"Deleted asset: {asset.display_name}")
-                        print(f"[DELETE] Deleted file: {file_path}")
+                        self._set_status(f"Moved {asset.display_name} to the library trash")
+                        self._run_pipeline_hook(
+                            HOOK_ASSET_DELETED,
+                            asset_file=file_path,
+                            asset_name=asset.display_name,
+                            mode="trash",
+                            trash_entry=entry.entry_id,
+                        )
                     else:
                         # Remove from repository even if file doesn't exist
                         deleted_count += 1
@@ -3380,23 +11030,58 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
                     message = f"Deleted {deleted_count} asset(s) successfully.\n\n"
                     message += f"Failed to delete {len(failed_deletions)} asset(s):\n"
                     message += "\n".join([f"• {failure}" for failure in failed_deletions])
-                    QMessageBox.warning(self, "Partial Success", message)
+                    QMessageBox.warning(self, tr("Partial Success"), message)
                 else:
                     QMessageBox.information(
                         self,
-                        "Deletion Complete",
-                        f"Successfully deleted {deleted_count} asset(s) from the project.",
+                        tr("Deletion Complete"),
+                        f"Moved {deleted_count} asset(s) to the library trash.",
                     )
             else:
                 QMessageBox.warning(
                     self,
-                    "Deletion Failed",
-                    "No assets were deleted. Check the console for error details.",
+                    tr("Deletion Failed"),
+                    tr("No assets were deleted. Check the console for error details."),
                 )
 
             # Refresh library to reflect changes
             self._on_refresh_library()
 
+    def _on_show_trash(self) -> None:
+        """Open the library trash to restore or purge deleted assets - Single Responsibility"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            QMessageBox.information(self, tr("No Library"), tr("Load a library to see its trash."))
+            return
+
+        try:
+            from ..services.trash_service_impl import get_trash_service
+            from .dialogs.trash_dialog import TrashDialog
+
+            restored = []
+            dialog = TrashDialog(
+                get_trash_service(),
+                library_root,
+                can_purge=self._permission_service.is_allowed(library_root, ACTION_DELETE),
+                can_manage=self._permission_service.is_allowed(library_root, ACTION_MANAGE),
+                parent=self,
+            )
+            dialog.entry_restored.connect(self._on_trash_entry_restored)
+            dialog.entry_restored.connect(lambda entry, _asset_file: restored.append(entry))
+            dialog.exec()
+            if restored:
+                self._on_refresh_library()
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to open Library Trash:\n{e}")
+
+    def _on_trash_entry_restored(self, entry: Any, asset_file: Path) -> None:
+        """Put a restored asset back into the repository and library database"""
+        self._repository.restore_asset(asset_file)
+        db = self._get_metadata_database()
+        if db and entry.metadata:
+            db.save_asset_metadata(asset_file, entry.metadata)
+        self._set_status(f"Restored {asset_file.name} from the library trash")
+
     def _update_asset_info_display(self, asset: Asset) -> None:
         """Update asset information display in RIGHT_B metadata panel - Single Responsibility"""
         name = asset.display_name if hasattr(asset, "display_name") else "Unknown"
@@ -3418,9 +11103,81 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
             if hasattr(asset, "tags") and asset.tags:
                 info_text += f"[TAG] Tags: {', '.join(asset.tags)}\n"
 
-            if hasattr(asset, "metadata") and asset.metadata:
+            from ..services.asset_status_service_impl import get_asset_status_service
+
+            info_text += (
+                f"[STATUS] {get_asset_status_service().get_status(asset.metadata).description}\n"
+            )
+
+            from ..services.geometry_stats_service_impl import get_geometry_stats_service
+
+            stats = get_geometry_stats_service().get_stats(asset.metadata)
+            if stats is not None:
+                info_text += "\n[STATS] Geometry (at publish):\n"
+                info_text += f"  • Triangles: {stats.triangles:,}\n"
+                info_text += f"  • Vertices: {stats.vertices:,}\n"
+                info_text += f"  • Meshes: {stats.mesh_count}\n"
+                info_text += f"  • UV sets: {stats.uv_sets}\n"
+                info_text += f"  • Bounding box: {stats.bounding_box_label}\n"
+                info_text += f"  • Textures: {stats.texture_summary}\n"
+                info_text += f"  • Skinned: {'yes' if stats.has_skin_cluster else 'no'}\n"
+
+            from ..services.camera_service_impl import get_camera_service
+
+            camera = get_camera_service().get_camera(asset.metadata)
+            if camera is not None:
+                info_text += f"\n[CAMERA] {camera.name}:\n"
+                info_text += f"  • Lens: {camera.focal_length:g}mm\n"
+                info_text += f"  • Film back: {camera.film_back_label} ({camera.film_fit} fit)\n"
+                info_text += f"  • Frames: {camera.frame_range_label}\n"
+                info_text += f"  • Clipping: {camera.near_clip:g}-{camera.far_clip:g}\n"
+                for plane in camera.image_planes:
+                    info_text += f"  • Image plane {plane.name}: {plane.image or '-'}\n"
+
+            from ..services.volume_service_impl import VOLUME_EXTENSION, get_volume_service
+
+            if asset.file_path.suffix.lower() == VOLUME_EXTENSION:
+                volume = get_volume_service().load_volume(asset.file_path)
+                if volume is not None:
+                    size_mb = (volume.get("size_bytes") or 0) / (1024 * 1024)
+                    files = volume.get("frame_count", 0)
+                    info_text += f"\n[VOLUME] {volume.get('sequence', '')}:\n"
+                    info_text += f"  • {get_volume_service().describe(volume)}\n"
+                    info_text += f"  • Files: {files} ({size_mb:,.1f} MB)\n"
+
+            from ..services.vendor_library_service_impl import get_vendor_library_service
+
+            terms = get_vendor_library_service().get_license(asset.file_path)
+            if terms is not None:
+                info_text += f"\n[LICENSE] {terms.label} (read-only vendor asset)\n"
+                if terms.source_url:
+                    info_text += f"  • Source: {terms.source_url}\n"
+                if terms.attribution:
+                    info_text += f"  • Attribution required: {terms.attribution}\n"
+                delivery = "allowed" if terms.redistributable else "not allowed"
+                info_text += f"  • Delivery to clients: {delivery}\n"
+
+            library_root = self._get_library_root()
+            events = []
+            if library_root is not None:
+                try:
+                    events = self._activity_service.get_asset_events(library_root, asset.file_path)
+                except Exception as e:
+                    print(f"[WARNING] Could not read activity of {asset.display_name}: {e}")
+            if events:
+                info_text += "\n[ACTIVITY] Recent activity:\n"
+                for event in events:
+                    info_text += f"  • {event.description}\n"
+
+            # Custom fields are edited in the panel below; stats and status are listed above
+            extra_metadata = {
+                key: value
+                for key, value in (asset.metadata or {}).items()
+                if key not in ("fields", "stats", "status", "camera")
+            }
+            if extra_metadata:
                 info_text += "\n📊 Additional Metadata:\n"
-                for key, value in asset.metadata.items():
+                for key, value in extra_metadata.items():
                     info_text += f"  • {key}: {value}\n"
 
             print("📊 Setting asset info in metadata widget...")
@@ -3429,10 +11186,102 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
         else:
             print("[WARNING] Metadata widget not available yet")
 
+        if self._library_widget:
+            from ..services.metadata_schema_service_impl import get_metadata_schema_service
+
+            self._custom_fields_asset = asset
+            self._custom_fields_widget.set_schema(self._library_widget.get_metadata_fields())
+            self._custom_fields_widget.set_values(
+                get_metadata_schema_service().get_values(asset.metadata)
+            )
+
+        versions = self._version_service.get_versions(asset.file_path)
+        self._comments_widget.set_asset(
+            self._get_library_root(),
+            asset.file_path,
+            sorted((version.number for version in versions), reverse=True),
+        )
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            cmds = None
+        self._parts_widget.set_asset(asset.file_path, cmds)
+
         # Also update the preview widget if it exists
         if self._preview_widget:
             self._preview_widget.set_asset(asset)
 
+    def _on_custom_field_changed(self, schema_field: Any, value: Any) -> None:
+        """Store an edited custom field of the selected asset"""
+        asset = self._custom_fields_asset
+        if asset is None or not self._library_widget:
+            return
+
+        from ..services.metadata_schema_service_impl import get_metadata_schema_service
+
+        schema_service = get_metadata_schema_service()
+        values = schema_service.get_values(asset.metadata)
+        if not self._check_permission(ACTION_EDIT):
+            self._custom_fields_widget.set_values(values)  # Put the stored values back
+            return
+        try:
+            values = schema_service.set_value(values, schema_field, value)
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Value"), str(e))
+            self._custom_fields_widget.set_values(values)
+            return
+
+        self._library_widget.save_custom_field_values(asset, values)
+        shown = schema_field.format_value(values.get(schema_field.name)) or "cleared"
+        self._set_status(f"{asset.display_name}: {schema_field.name} {shown}")
+
+    def _on_open_threads_changed(self, count: int) -> None:
+        """Show the selected asset's open threads on the Comments tab"""
+        index = self._info_tabs.indexOf(self._comments_widget)
+        label = tr("Comments ({count})", count=count) if count else tr("Comments")
+        self._info_tabs.setTabText(index, label)
+
+    def _check_mentions(self) -> None:
+        """Update the unread mention count and say when new ones arrived"""
+        library_root = self._get_library_root()
+        count = 0
+        if library_root is not None:
+            try:
+                count = len(self._comment_service.get_notifications(library_root))
+            except Exception as e:
+                print(f"[WARNING] Could not read mentions: {e}")
+        if count > self._unread_mentions:
+            self._set_status(f"You were mentioned in {count - self._unread_mentions} new note(s)")
+        self._unread_mentions = count
+        self._mentions_btn.setText(tr("@ {count} mention(s)", count=count))
+        self._mentions_btn.setVisible(bool(count))
+
+    def _on_show_mentions(self) -> None:
+        """List the notes mentioning the artist and open the one picked"""
+        library_root = self._get_library_root()
+        if library_root is None:
+            return
+
+        from .dialogs.comment_notifications_dialog import CommentNotificationsDialog
+
+        dialog = CommentNotificationsDialog(self._comment_service, library_root, self)
+        dialog.notification_opened.connect(self._on_open_mention)
+        dialog.exec()
+        self._check_mentions()
+
+    def _on_open_mention(self, notification: Any) -> None:
+        """Select the asset a mention is on and show its note"""
+        library_root = self._get_library_root()
+        comment = notification.comment
+        asset_file = library_root / comment.asset_key if library_root else Path(comment.asset_key)
+        if not self._library_widget or not self._library_widget.reveal_asset(asset_file):
+            self._set_status(f"{comment.asset_name} is not in the loaded library")
+            return
+        self._info_tabs.setCurrentWidget(self._comments_widget)
+        self._comments_widget.refresh()  # The asset may have been shown before the note
+        self._comments_widget.select_comment(comment.comment_id)
+        self._set_status(f"Showing {comment.user}'s note on {comment.asset_name}")
+
     def closeEvent(self, event) -> None:
         """Handle window close event - Clean shutdown and singleton cleanup"""
         # Save window state
@@ -3440,6 +11289,22 @@ This project is managed by Asset Manager v1.5.0. Use the Asset Manager interface
 
         # Clean up resources
         self._event_publisher.clear_all_subscriptions()
+        self._thumbnail_queue.remove_finished_callback(self._on_thumbnail_job_done_threaded)
+        self._viewport_drop_service.uninstall()
+        self._reference_update_timer.stop()
+        self._offline_timer.stop()
+        self._maintenance_timer.stop()
+        self._interchange_timer.stop()
+        self._interchange_service.stop_listening()
+        self._ingest_timer.stop()
+        self._reference_update_service.uninstall()
+        self._missing_asset_service.uninstall()
+        self._library_registry.uninstall()
+
+        # Shared workstations end the artist's session along with Maya
+        from ..services.identity_service_impl import get_identity_service
+
+        get_identity_service().on_exit()
 
         # Clear global singleton reference (replaces external module attribute access)
         global _asset_manager_window  # pylint: disable=global-statement
@@ -36,6 +36,7 @@ from PySide6.QtGui import QFont
 
 # Import the unified theme
 from .theme import UITheme
+from ..services.localization_service_impl import tr
 
 
 class CollectionManagerDialog(QDialog):
@@ -78,7 +79,7 @@ class CollectionManagerDialog(QDialog):
 
     def _setup_ui(self) -> None:
         """Setup the user interface - Single Responsibility"""
-        self.setWindowTitle("Collection Manager - Asset Manager v1.5.0")
+        self.setWindowTitle(tr("Collection Manager - Asset Manager v1.5.0"))
         self.setModal(True)
         self.resize(1000, 700)
 
@@ -113,14 +114,14 @@ class CollectionManagerDialog(QDialog):
         header_layout = QVBoxLayout(header_frame)
 
         # Title
-        title_label = QLabel("Collection Manager")
+        title_label = QLabel(tr("Collection Manager"))
         title_label.setProperty("title", True)  # Use theme styling
         title_label.setAlignment(Qt.AlignmentFlag.AlignCenter)
         header_layout.addWidget(title_label)
 
         # Description
         desc_label = QLabel(
-            "Organize and manage your asset collections for better project workflow"
+            tr("Organize and manage your asset collections for better project workflow")
         )
         desc_label.setAlignment(Qt.AlignmentFlag.AlignCenter)
         desc_label.setStyleSheet("color: #666666; font-style: italic;")
@@ -130,9 +131,9 @@ class CollectionManagerDialog(QDialog):
         search_layout = QHBoxLayout()
 
         self.search_input = QLineEdit()
-        self.search_input.setPlaceholderText("Search collections...")
+        self.search_input.setPlaceholderText(tr("Search collections..."))
         self.search_input.textChanged.connect(self._filter_collections)
-        search_layout.addWidget(QLabel("Search:"))
+        search_layout.addWidget(QLabel(tr("Search:")))
         search_layout.addWidget(self.search_input)
 
         self.category_filter = QComboBox()
@@ -151,7 +152,7 @@ class CollectionManagerDialog(QDialog):
             ]
         )
         self.category_filter.currentTextChanged.connect(self._filter_collections)
-        search_layout.addWidget(QLabel("Category:"))
+        search_layout.addWidget(QLabel(tr("Category:")))
         search_layout.addWidget(self.category_filter)
 
         header_layout.addLayout(search_layout)
@@ -164,16 +165,16 @@ class CollectionManagerDialog(QDialog):
 
         # Collections list header
         collections_header = QHBoxLayout()
-        collections_label = QLabel("Collections")
+        collections_label = QLabel(tr("Collections"))
         collections_label.setFont(QFont("Arial", 12, QFont.Weight.Bold))
         collections_header.addWidget(collections_label)
 
         # Quick action buttons - Increased width for full text visibility
-        self.new_collection_btn = QPushButton("New")
+        self.new_collection_btn = QPushButton(tr("New"))
         self.new_collection_btn.setMinimumWidth(80)  # Increased from 60 for better text fit
-        self.duplicate_collection_btn = QPushButton("Copy")
+        self.duplicate_collection_btn = QPushButton(tr("Copy"))
         self.duplicate_collection_btn.setMinimumWidth(80)  # Increased from 60 for better text fit
-        self.delete_collection_btn = QPushButton("Delete")
+        self.delete_collection_btn = QPushButton(tr("Delete"))
         self.delete_collection_btn.setMinimumWidth(80)  # Increased from 60 for better text fit
 
         collections_header.addStretch()
@@ -190,7 +191,7 @@ class CollectionManagerDialog(QDialog):
         left_layout.addWidget(self.collections_list)
 
         # Collection statistics
-        self.stats_label = QLabel("0 collections loaded")
+        self.stats_label = QLabel(tr("0 collections loaded"))
         self.stats_label.setStyleSheet("color: #666666; font-size: 11px;")
         left_layout.addWidget(self.stats_label)
 
@@ -203,7 +204,7 @@ class CollectionManagerDialog(QDialog):
         right_layout = QVBoxLayout(right_widget)
 
         # Collection details form
-        self.details_group = QGroupBox("Collection Details")
+        self.details_group = QGroupBox(tr("Collection Details"))
         details_layout = QVBoxLayout(self.details_group)
 
         # Basic information
@@ -211,7 +212,7 @@ class CollectionManagerDialog(QDialog):
 
         # Name
         name_layout = QHBoxLayout()
-        name_layout.addWidget(QLabel("Name:"))
+        name_layout.addWidget(QLabel(tr("Name:")))
         self.name_input = QLineEdit()
         self.name_input.textChanged.connect(self._mark_modified)
         name_layout.addWidget(self.name_input)
@@ -219,7 +220,7 @@ class CollectionManagerDialog(QDialog):
 
         # Category - Enhanced height for proper text display
         category_layout = QHBoxLayout()
-        category_layout.addWidget(QLabel("Category:"))
+        category_layout.addWidget(QLabel(tr("Category:")))
         self.category_input = QComboBox()
         self.category_input.setEditable(True)
         self.category_input.setMinimumHeight(36)  # Increased height for legible font
@@ -233,7 +234,7 @@ class CollectionManagerDialog(QDialog):
         basic_layout.addLayout(category_layout)
 
         # Description
-        basic_layout.addWidget(QLabel("Description:"))
+        basic_layout.addWidget(QLabel(tr("Description:")))
         self.description_input = QTextEdit()
         self.description_input.setMaximumHeight(80)
         self.description_input.textChanged.connect(self._mark_modified)
@@ -242,14 +243,14 @@ class CollectionManagerDialog(QDialog):
         details_layout.addLayout(basic_layout)
 
         # Assets in collection
-        assets_group = QGroupBox("Assets in Collection")
+        assets_group = QGroupBox(tr("Assets in Collection"))
         assets_layout = QVBoxLayout(assets_group)
 
         # Asset management controls
         asset_controls = QHBoxLayout()
-        self.add_asset_btn = QPushButton("Add Assets")
-        self.remove_asset_btn = QPushButton("Remove Selected")
-        self.clear_assets_btn = QPushButton("Clear All")
+        self.add_asset_btn = QPushButton(tr("Add Assets"))
+        self.remove_asset_btn = QPushButton(tr("Remove Selected"))
+        self.clear_assets_btn = QPushButton(tr("Clear All"))
 
         asset_controls.addWidget(self.add_asset_btn)
         asset_controls.addWidget(self.remove_asset_btn)
@@ -263,20 +264,20 @@ class CollectionManagerDialog(QDialog):
         assets_layout.addWidget(self.assets_list)
 
         # Asset count
-        self.asset_count_label = QLabel("0 assets")
+        self.asset_count_label = QLabel(tr("0 assets"))
         self.asset_count_label.setStyleSheet("color: #666666; font-size: 11px;")
         assets_layout.addWidget(self.asset_count_label)
 
         details_layout.addWidget(assets_group)
 
         # Collection metadata
-        metadata_group = QGroupBox("Metadata")
+        metadata_group = QGroupBox(tr("Metadata"))
         metadata_layout = QVBoxLayout(metadata_group)
 
         # Auto-include options
-        self.auto_include_by_tag = QCheckBox("Auto-include assets with matching tags")
-        self.auto_include_by_type = QCheckBox("Auto-include assets of same type")
-        self.auto_include_by_folder = QCheckBox("Auto-include assets from same folder")
+        self.auto_include_by_tag = QCheckBox(tr("Auto-include assets with matching tags"))
+        self.auto_include_by_type = QCheckBox(tr("Auto-include assets of same type"))
+        self.auto_include_by_folder = QCheckBox(tr("Auto-include assets from same folder"))
 
         metadata_layout.addWidget(self.auto_include_by_tag)
         metadata_layout.addWidget(self.auto_include_by_type)
@@ -296,8 +297,8 @@ class CollectionManagerDialog(QDialog):
         button_layout = QHBoxLayout()
 
         # Left side buttons
-        self.import_btn = QPushButton("Import Collections")
-        self.export_btn = QPushButton("Export Collections")
+        self.import_btn = QPushButton(tr("Import Collections"))
+        self.export_btn = QPushButton(tr("Export Collections"))
         button_layout.addWidget(self.import_btn)
         button_layout.addWidget(self.export_btn)
 
@@ -308,9 +309,9 @@ class CollectionManagerDialog(QDialog):
         button_layout.addWidget(self.save_status_label)
 
         # Right side buttons
-        self.save_btn = QPushButton("Save Changes")
+        self.save_btn = QPushButton(tr("Save Changes"))
         self.save_btn.setEnabled(False)
-        self.close_btn = QPushButton("Close")
+        self.close_btn = QPushButton(tr("Close"))
 
         button_layout.addWidget(self.save_btn)
         button_layout.addWidget(self.close_btn)
@@ -621,7 +622,7 @@ class CollectionManagerDialog(QDialog):
         name = name.strip()
         if name in self.existing_collections:
             QMessageBox.warning(
-                self, "Duplicate Name", f"A collection named '{name}' already exists."
+                self, tr("Duplicate Name"), f"A collection named '{name}' already exists."
             )
             return
 
@@ -652,7 +653,7 @@ class CollectionManagerDialog(QDialog):
         """Duplicate the selected collection - Single Responsibility"""
         if not self.current_collection:
             QMessageBox.information(
-                self, "No Selection", "Please select a collection to duplicate."
+                self, tr("No Selection"), tr("Please select a collection to duplicate.")
             )
             return
 
@@ -668,7 +669,7 @@ class CollectionManagerDialog(QDialog):
         name = name.strip()
         if name in self.existing_collections:
             QMessageBox.warning(
-                self, "Duplicate Name", f"A collection named '{name}' already exists."
+                self, tr("Duplicate Name"), f"A collection named '{name}' already exists."
             )
             return
 
@@ -684,13 +685,15 @@ class CollectionManagerDialog(QDialog):
     def _delete_collection(self) -> None:
         """Delete the selected collection - Single Responsibility"""
         if not self.current_collection:
-            QMessageBox.information(self, "No Selection", "Please select a collection to delete.")
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select a collection to delete.")
+            )
             return
 
         # Confirm deletion
         reply = QMessageBox.question(
             self,
-            "Confirm Deletion",
+            tr("Confirm Deletion"),
             f"Are you sure you want to delete the collection '{self.current_collection}'?",
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
@@ -728,7 +731,9 @@ class CollectionManagerDialog(QDialog):
         """Remove selected assets from collection - Single Responsibility"""
         selected_items = self.assets_list.selectedItems()
         if not selected_items:
-            QMessageBox.information(self, "No Selection", "Please select assets to remove.")
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select assets to remove.")
+            )
             return
 
         for item in selected_items:
@@ -745,8 +750,8 @@ class CollectionManagerDialog(QDialog):
 
         reply = QMessageBox.question(
             self,
-            "Clear Assets",
-            "Are you sure you want to remove all assets from this collection?",
+            tr("Clear Assets"),
+            tr("Are you sure you want to remove all assets from this collection?"),
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
         )
@@ -760,18 +765,22 @@ class CollectionManagerDialog(QDialog):
         """Import collections from file - Single Responsibility"""
         QMessageBox.information(
             self,
-            "Import Collections",
-            "Import functionality will be implemented in a future version.\n"
-            "This will allow importing collection definitions from JSON files.",
+            tr("Import Collections"),
+            tr(
+                "Import functionality will be implemented in a future version.\n"
+                "This will allow importing collection definitions from JSON files."
+            ),
         )
 
     def _export_collections(self) -> None:
         """Export collections to file - Single Responsibility"""
         QMessageBox.information(
             self,
-            "Export Collections",
-            "Export functionality will be implemented in a future version.\n"
-            "This will allow exporting collection definitions to JSON files.",
+            tr("Export Collections"),
+            tr(
+                "Export functionality will be implemented in a future version.\n"
+                "This will allow exporting collection definitions to JSON files."
+            ),
         )
 
     def _mark_modified(self) -> None:
@@ -783,10 +792,10 @@ class CollectionManagerDialog(QDialog):
     def _update_save_status(self) -> None:
         """Update the save status label - Single Responsibility"""
         if self.modified:
-            self.save_status_label.setText("❋ Unsaved changes")
+            self.save_status_label.setText(tr("❋ Unsaved changes"))
             self.save_status_label.setStyleSheet("color: #e74c3c; font-weight: bold;")
         else:
-            self.save_status_label.setText("✓ All changes saved")
+            self.save_status_label.setText(tr("✓ All changes saved"))
             self.save_status_label.setStyleSheet("color: #27ae60; font-weight: bold;")
 
     def _update_asset_count(self) -> None:
@@ -831,7 +840,7 @@ class CollectionManagerDialog(QDialog):
         if new_name != self.current_collection:
             if new_name in self.existing_collections:
                 QMessageBox.warning(
-                    self, "Duplicate Name", f"A collection named '{new_name}' already exists."
+                    self, tr("Duplicate Name"), f"A collection named '{new_name}' already exists."
                 )
                 return
 
@@ -857,8 +866,8 @@ class CollectionManagerDialog(QDialog):
         if self.modified:
             reply = QMessageBox.question(
                 self,
-                "Unsaved Changes",
-                "You have unsaved changes. Do you want to save them before closing?",
+                tr("Unsaved Changes"),
+                tr("You have unsaved changes. Do you want to save them before closing?"),
                 QMessageBox.StandardButton.Save
                 | QMessageBox.StandardButton.Discard
                 | QMessageBox.StandardButton.Cancel,
@@ -879,8 +888,8 @@ class CollectionManagerDialog(QDialog):
         if self.modified:
             reply = QMessageBox.question(
                 self,
-                "Unsaved Changes",
-                "You have unsaved changes. Do you want to save them before closing?",
+                tr("Unsaved Changes"),
+                tr("You have unsaved changes. Do you want to save them before closing?"),
                 QMessageBox.StandardButton.Save
                 | QMessageBox.StandardButton.Discard
                 | QMessageBox.StandardButton.Cancel,
@@ -0,0 +1,200 @@
+# -*- coding: utf-8 -*-
+"""
+Activity Log Dialog
+Library-wide activity, filtered by artist, action, and date, for leads
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import csv
+from pathlib import Path
+from typing import List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QComboBox,
+    QDateEdit,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QFileDialog,
+    QMessageBox,
+)
+from PySide6.QtCore import QDate
+
+from ..theme import UITheme
+from ...core.models.activity_event import ACTIVITY_ACTIONS, ACTIVITY_LABELS, ActivityEvent
+from ...services.activity_service_impl import ACTIVITY_VIEW_LIMIT
+from ...services.localization_service_impl import tr
+
+# Days the log shows when the dialog opens
+DEFAULT_DAYS = 30
+
+
+class ActivityLogDialog(QDialog):
+    """
+    Activity Log Dialog - Single Responsibility for browsing the library activity log
+    """
+
+    COLUMNS = ["When", "Artist", "Machine", "Action", "Asset", "Details"]
+
+    def __init__(self, activity_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = activity_service
+        self._library_root = Path(library_root)
+        self._events: List[ActivityEvent] = []
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Activity Log"))
+        self.setMinimumSize(760, 440)
+        self.resize(920, 560)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Library Activity"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Every publish, import, delete, rename, and status change in this library, "
+                "with the artist and machine it came from. Entries stay after an asset is deleted."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        filter_layout = QHBoxLayout()
+        filter_layout.addWidget(QLabel(tr("Artist:")))
+        self._user_combo = QComboBox()
+        self._user_combo.addItem("All artists", "")
+        for user in self._service.get_users(self._library_root):
+            self._user_combo.addItem(user, user)
+        filter_layout.addWidget(self._user_combo)
+
+        filter_layout.addWidget(QLabel(tr("Action:")))
+        self._action_combo = QComboBox()
+        self._action_combo.addItem("All actions", "")
+        for action in ACTIVITY_ACTIONS:
+            self._action_combo.addItem(ACTIVITY_LABELS[action], action)
+        filter_layout.addWidget(self._action_combo)
+
+        today = QDate.currentDate()
+        filter_layout.addWidget(QLabel(tr("From:")))
+        self._since_edit = QDateEdit(today.addDays(-DEFAULT_DAYS))
+        self._since_edit.setCalendarPopup(True)
+        filter_layout.addWidget(self._since_edit)
+        filter_layout.addWidget(QLabel(tr("To:")))
+        self._until_edit = QDateEdit(today)
+        self._until_edit.setCalendarPopup(True)
+        filter_layout.addWidget(self._until_edit)
+        filter_layout.addStretch()
+
+        for widget in (self._user_combo, self._action_combo):
+            widget.currentIndexChanged.connect(lambda _index: self._refresh())
+        for widget in (self._since_edit, self._until_edit):
+            widget.dateChanged.connect(lambda _date: self._refresh())
+        main_layout.addLayout(filter_layout)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(5, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        button_layout = QHBoxLayout()
+
+        export_btn = QPushButton(tr("Export CSV..."))
+        export_btn.clicked.connect(self._on_export)
+        button_layout.addWidget(export_btn)
+
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """Load the events matching the filters"""
+        self._events = self._service.get_events(
+            self._library_root,
+            user=self._user_combo.currentData(),
+            since=self._since_edit.date().toPython(),
+            until=self._until_edit.date().toPython(),
+            action=self._action_combo.currentData(),
+        )
+
+        self._table.setRowCount(len(self._events))
+        for row, event in enumerate(self._events):
+            for column, text in enumerate(self._get_row(event)):
+                item = QTableWidgetItem(text)
+                if column == 4:
+                    item.setToolTip(event.asset_key)
+                self._table.setItem(row, column, item)
+        self._table.resizeColumnsToContents()
+
+        count = len(self._events)
+        summary = f"{count} event(s)"
+        if count >= ACTIVITY_VIEW_LIMIT:
+            summary += f" - showing the latest {ACTIVITY_VIEW_LIMIT}, narrow the dates for more"
+        self._summary_label.setText(summary)
+
+    def _get_row(self, event: ActivityEvent) -> List[str]:
+        """Get the table texts of an event"""
+        return [
+            f"{event.timestamp:%Y-%m-%d %H:%M}",
+            event.user,
+            event.machine,
+            event.label,
+            event.asset_name,
+            event.summary,
+        ]
+
+    def _on_export(self) -> None:
+        """Save the filtered events as CSV"""
+        default = self._library_root / "activity_log.csv"
+        file_path, _ = QFileDialog.getSaveFileName(
+            self, "Export Activity Log", str(default), "CSV (*.csv);;All Files (*.*)"
+        )
+        if not file_path:
+            return
+        try:
+            with open(file_path, "w", encoding="utf-8", newline="") as f:
+                writer = csv.writer(f)
+                writer.writerow(["Timestamp", "Artist", "Machine", "Action", "Asset", "Details"])
+                for event in self._events:
+                    writer.writerow(
+                        [
+                            event.timestamp.isoformat(),
+                            event.user,
+                            event.machine,
+                            event.action,
+                            event.asset_key,
+                            event.summary,
+                        ]
+                    )
+        except OSError as e:
+            QMessageBox.warning(self, tr("Export Failed"), f"Could not write {file_path}:\n{e}")
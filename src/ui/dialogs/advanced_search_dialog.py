@@ -12,6 +12,7 @@ from typing import Optional
 # PySide6 for Maya 2022+
 from PySide6.QtWidgets import QDialog, QVBoxLayout, QLabel, QPushButton
 from ...core.models.search_criteria import SearchCriteria
+from ...services.localization_service_impl import tr
 
 PYSIDE_AVAILABLE = True
 
@@ -24,21 +25,21 @@ class AdvancedSearchDialog(QDialog):
             return
 
         super().__init__(parent)
-        self.setWindowTitle("Advanced Search")
+        self.setWindowTitle(tr("Advanced Search"))
         self.setModal(True)
 
         # Create placeholder UI
         layout = QVBoxLayout(self)
 
-        label = QLabel("Advanced search functionality will be implemented here.")
+        label = QLabel(tr("Advanced search functionality will be implemented here."))
         layout.addWidget(label)
 
         # OK/Cancel buttons
-        ok_btn = QPushButton("OK")
+        ok_btn = QPushButton(tr("OK"))
         ok_btn.clicked.connect(self.accept)
         layout.addWidget(ok_btn)
 
-        cancel_btn = QPushButton("Cancel")
+        cancel_btn = QPushButton(tr("Cancel"))
         cancel_btn.clicked.connect(self.reject)
         layout.addWidget(cancel_btn)
 
@@ -0,0 +1,129 @@
+# -*- coding: utf-8 -*-
+"""
+Animation Clip Apply Dialog
+Choose the target rig namespace and timing for applying a clip
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Callable, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QCheckBox,
+    QDoubleSpinBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class AnimClipApplyDialog(QDialog):
+    """
+    Animation Clip Apply Dialog - Single Responsibility for clip apply options
+    Shows how many channels the chosen namespace is missing before anything is keyed
+    """
+
+    def __init__(
+        self,
+        clip: Dict[str, Any],
+        namespaces: List[str],
+        missing_lookup: Callable[[str], List[str]],
+        parent=None,
+    ):
+        super().__init__(parent)
+
+        self._clip = clip
+        self._namespaces = namespaces
+        self._missing_lookup = missing_lookup
+
+        self._setup_ui()
+        self._update_match_label()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Apply Animation Clip"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Apply {self._clip.get('name', 'clip')}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        start, end = self._clip.get("frame_range", [0, 0])
+        desc_label = QLabel(
+            f"Rig: {self._clip.get('rig') or 'unknown'}  |  Frames {start:g}-{end:g} "
+            f"at {self._clip.get('fps', 24):g} fps  |  {len(self._clip.get('curves', []))} curves"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._namespace_combo = QComboBox()
+        for namespace in self._namespaces:
+            self._namespace_combo.addItem(namespace or ": (root)", namespace)
+        # Preselect the namespace the clip was captured from when it exists here
+        source = self._clip.get("source_namespace", "")
+        if source in self._namespaces:
+            self._namespace_combo.setCurrentIndex(self._namespaces.index(source))
+        self._namespace_combo.currentIndexChanged.connect(self._update_match_label)
+        form_layout.addRow("Target namespace:", self._namespace_combo)
+
+        self._match_label = QLabel()
+        self._match_label.setWordWrap(True)
+        form_layout.addRow("", self._match_label)
+
+        self._start_spin = QDoubleSpinBox()
+        self._start_spin.setRange(-100000, 100000)
+        self._start_spin.setDecimals(1)
+        self._start_spin.setValue(start)
+        form_layout.addRow("Start at frame:", self._start_spin)
+
+        self._replace_check = QCheckBox(tr("Replace existing keys in the clip range"))
+        self._replace_check.setChecked(True)
+        form_layout.addRow("", self._replace_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._apply_btn = QPushButton(tr("Apply"))
+        self._apply_btn.setProperty("accent", True)
+        self._apply_btn.setDefault(True)
+        self._apply_btn.clicked.connect(self.accept)
+        button_layout.addWidget(self._apply_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _update_match_label(self) -> None:
+        """Show how well the clip matches the selected namespace"""
+        total = len(self._clip.get("curves", []))
+        missing = self._missing_lookup(self._namespace_combo.currentData() or "")
+        matched = total - len(missing)
+        self._match_label.setText(f"{matched} of {total} channels found on this rig")
+        self._apply_btn.setEnabled(matched > 0)
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get apply options (namespace, start_frame, replace)"""
+        return {
+            "namespace": self._namespace_combo.currentData() or "",
+            "start_frame": self._start_spin.value(),
+            "replace": self._replace_check.isChecked(),
+        }
@@ -0,0 +1,151 @@
+# -*- coding: utf-8 -*-
+"""
+Animation Clip Export Dialog
+Name, frame range, and notes for saving a rig's animation as a clip asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QDoubleSpinBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ..widgets.playblast_options_widget import PlayblastOptionsGroup
+from ...core.models.playblast_settings import PlayblastSettings
+from ...services.localization_service_impl import tr
+
+
+class AnimClipExportDialog(QDialog):
+    """
+    Animation Clip Export Dialog - Single Responsibility for clip export options
+    """
+
+    def __init__(
+        self,
+        rig_identifier: str,
+        frame_range: tuple,
+        fps: float,
+        animated_count: int,
+        parent=None,
+        playblast_defaults: Optional[PlayblastSettings] = None,
+        cameras: Optional[List[str]] = None,
+    ):
+        super().__init__(parent)
+
+        self._rig_identifier = rig_identifier
+        self._frame_range = frame_range
+        self._fps = fps
+        self._animated_count = animated_count
+        self._playblast_defaults = playblast_defaults
+        self._cameras = cameras or []
+        self._playblast_group: Optional[PlayblastOptionsGroup] = None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Export Animation Clip"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Export Animation Clip"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            f"{self._animated_count} animated control(s) on rig '{self._rig_identifier}' "
+            f"at {self._fps:g} fps. Clips can be applied to any rig with matching control "
+            "names, in any namespace."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._name_edit = QLineEdit()
+        self._name_edit.setPlaceholderText(tr("e.g. walk_cycle"))
+        form_layout.addRow("Clip name:", self._name_edit)
+
+        range_layout = QHBoxLayout()
+        self._start_spin = QDoubleSpinBox()
+        self._end_spin = QDoubleSpinBox()
+        spins = ((self._start_spin, self._frame_range[0]), (self._end_spin, self._frame_range[1]))
+        for spin, value in spins:
+            spin.setRange(-100000, 100000)
+            spin.setDecimals(1)
+            spin.setValue(value)
+            range_layout.addWidget(spin)
+        form_layout.addRow("Frame range:", range_layout)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._thumbnail_check = QCheckBox(tr("Capture viewport thumbnail (middle frame)"))
+        self._thumbnail_check.setChecked(True)
+        form_layout.addRow("", self._thumbnail_check)
+        main_layout.addLayout(form_layout)
+
+        if self._playblast_defaults is not None:
+            self._playblast_group = PlayblastOptionsGroup(self._playblast_defaults, self._cameras)
+            main_layout.addWidget(self._playblast_group)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        export_btn = QPushButton(tr("Export Clip"))
+        export_btn.setProperty("accent", True)
+        export_btn.setDefault(True)
+        export_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(export_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a clip name."))
+            return
+        if self._end_spin.value() < self._start_spin.value():
+            QMessageBox.warning(
+                self, tr("Invalid Range"), tr("End frame is before the start frame.")
+            )
+            return
+        playblast_error = self._playblast_group.validate() if self._playblast_group else None
+        if playblast_error:
+            QMessageBox.warning(self, tr("Invalid Range"), playblast_error)
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get export options (name, frame_range, notes, capture_thumbnail, playblast)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "frame_range": (self._start_spin.value(), self._end_spin.value()),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "capture_thumbnail": self._thumbnail_check.isChecked(),
+            "playblast": self._playblast_group.get_settings() if self._playblast_group else None,
+        }
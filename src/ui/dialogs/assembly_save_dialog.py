@@ -0,0 +1,151 @@
+# -*- coding: utf-8 -*-
+"""
+Assembly Save Dialog
+Pick the scene's library assets to save as an assembly, and how their versions are pinned
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class AssemblySaveDialog(QDialog):
+    """
+    Assembly Save Dialog - Single Responsibility for assembly save options
+    Lists the library assets in the scene, checking the selected ones
+    """
+
+    def __init__(
+        self,
+        scene_assets: List[Any],
+        selected: List[Any],
+        default_name: str,
+        parent=None,
+    ):
+        """
+        Args:
+            scene_assets: SceneAsset entries of the open scene
+            selected: Scene assets checked at first (those under the Maya selection)
+            default_name: Assembly name offered (the open scene's name)
+        """
+        super().__init__(parent)
+
+        self._scene_assets = scene_assets
+        self._selected = selected
+        self._default_name = default_name
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Save Assembly"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Save Assembly"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The checked assets are saved with their placement and version. Importing the "
+                "assembly rebuilds the layout, loading each asset the way it is loaded now."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._asset_list = QListWidget()
+        self._asset_list.setMinimumHeight(160)
+        for scene_asset in self._scene_assets:
+            mode = "reference" if scene_asset.is_referenced else "import"
+            item = QListWidgetItem(f"{scene_asset.label} ({mode}, {scene_asset.version_label})")
+            item.setData(Qt.UserRole, scene_asset)  # type: ignore
+            checked = not self._selected or scene_asset in self._selected
+            item.setCheckState(Qt.Checked if checked else Qt.Unchecked)  # type: ignore
+            self._asset_list.addItem(item)
+        form_layout.addRow("Assets:", self._asset_list)
+
+        self._name_edit = QLineEdit(self._default_name)
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._latest_check = QCheckBox(tr("Pin the latest published versions"))
+        self._latest_check.setToolTip(
+            tr("Pin each asset's newest version instead of the version loaded in the scene")
+        )
+        form_layout.addRow("", self._latest_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Assembly"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_checked_assets(self) -> List[Any]:
+        """Get the scene assets left checked"""
+        checked = []
+        for row in range(self._asset_list.count()):
+            item = self._asset_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                checked.append(item.data(Qt.UserRole))  # type: ignore
+        return checked
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        if not self._get_checked_assets():
+            QMessageBox.warning(self, tr("No Assets"), tr("Check at least one asset to save."))
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get save options (name, notes, scene_assets, pin_latest)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "scene_assets": self._get_checked_assets(),
+            "pin_latest": self._latest_check.isChecked(),
+        }
@@ -0,0 +1,185 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Diff Dialog
+Side-by-side report of what changed between two versions of an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import List, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+)
+from PySide6.QtGui import QFont
+
+from ..theme import UITheme
+from ...core.models.asset_diff import UNKNOWN_COUNT, AssetDiff, Vector
+from ...services.localization_service_impl import tr
+
+
+def _format_size(size: int) -> str:
+    """Format a file size for the report (1.4 MB)"""
+    value = float(size)
+    for unit in ("B", "KB", "MB"):
+        if value < 1024:
+            return f"{value:.0f} {unit}" if unit == "B" else f"{value:.1f} {unit}"
+        value /= 1024
+    return f"{value:.1f} GB"
+
+
+def _format_count(count: int) -> str:
+    """Format a face or vertex count"""
+    return "?" if count == UNKNOWN_COUNT else f"{count:,}"
+
+
+def _format_vector(vector: Vector) -> str:
+    """Format a translate, rotate, or scale value"""
+    return ", ".join(f"{value:g}" for value in vector)
+
+
+class AssetDiffDialog(QDialog):
+    """
+    Asset Diff Dialog - Single Responsibility for presenting a version comparison
+    Section rows group the changes; unchanged sections are left out
+    """
+
+    def __init__(self, diff: AssetDiff, before_label: str, after_label: str, parent=None):
+        super().__init__(parent)
+
+        self._diff = diff
+        self._before_label = before_label
+        self._after_label = after_label
+
+        self._setup_ui()
+        self._populate()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(f"Compare {self._before_label} and {self._after_label}")
+        self.setMinimumSize(640, 420)
+        self.resize(780, 560)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"{self._before_label} → {self._after_label}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            f"{self._diff.summary}.\n"
+            f"{self._diff.before.file_path.name} → {self._diff.after.file_path.name}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, 3)
+        self._table.setHorizontalHeaderLabels(["Change", self._before_label, self._after_label])
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        header = self._table.horizontalHeader()
+        header.setSectionResizeMode(0, QHeaderView.ResizeMode.ResizeToContents)
+        header.setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(2, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _populate(self) -> None:
+        """Fill the report table section by section"""
+        diff = self._diff
+        before, after = diff.before, diff.after
+
+        self._add_section(
+            "Totals",
+            [
+                ("File size", _format_size(before.file_size), _format_size(after.file_size)),
+                ("Nodes", f"{before.node_count:,}", f"{after.node_count:,}"),
+                ("Meshes", f"{len(before.meshes):,}", f"{len(after.meshes):,}"),
+                ("Faces", f"{before.face_count:,}", f"{after.face_count:,}"),
+                ("Vertices", f"{before.vertex_count:,}", f"{after.vertex_count:,}"),
+            ],
+        )
+        self._add_section(
+            "Node Types",
+            [
+                (node_type, f"{old:,}", f"{new:,}")
+                for node_type, old, new in diff.node_type_changes
+            ],
+        )
+        self._add_section(
+            "Meshes",
+            [(mesh, "-", "added") for mesh in diff.added_meshes]
+            + [(mesh, "removed", "-") for mesh in diff.removed_meshes],
+        )
+        self._add_section(
+            "Geometry",
+            [
+                (
+                    mesh,
+                    f"{_format_count(old[0])} faces, {_format_count(old[1])} verts",
+                    f"{_format_count(new[0])} faces, {_format_count(new[1])} verts",
+                )
+                for mesh, old, new in diff.geometry_changes
+            ],
+        )
+        self._add_section(
+            "Transforms",
+            [
+                (f"{path} {attribute}", _format_vector(old), _format_vector(new))
+                for path, attribute, old, new in diff.transform_changes
+            ],
+        )
+        self._add_section(
+            "Shader Assignments",
+            [
+                (mesh, ", ".join(old) or "(none)", ", ".join(new) or "(none)")
+                for mesh, old, new in diff.shader_changes
+            ],
+        )
+
+    def _add_section(self, title: str, rows: List[Tuple[str, str, str]]) -> None:
+        """Append a bold section heading and its rows, skipping empty sections"""
+        if not rows:
+            return
+        heading = QTableWidgetItem(title)
+        font = QFont(heading.font())
+        font.setBold(True)
+        heading.setFont(font)
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+        self._table.setItem(row, 0, heading)
+        self._table.setSpan(row, 0, 1, 3)
+
+        for change, old, new in rows:
+            row = self._table.rowCount()
+            self._table.insertRow(row)
+            self._table.setItem(row, 0, QTableWidgetItem(f"  {change}"))
+            self._table.setItem(row, 1, QTableWidgetItem(old))
+            item = QTableWidgetItem(new)
+            if old != new:
+                font = QFont(item.font())
+                font.setBold(True)
+                item.setFont(font)
+            self._table.setItem(row, 2, item)
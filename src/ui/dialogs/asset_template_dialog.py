@@ -0,0 +1,190 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Template Dialog
+Pick a library asset template and name the new asset to start a scene from it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...core.models.asset_template import AssetTemplate
+from ...services.localization_service_impl import tr
+
+
+class AssetTemplateDialog(QDialog):
+    """
+    Asset Template Dialog - Single Responsibility for choosing a template
+    Managers can also delete templates that are no longer approved
+    """
+
+    def __init__(self, template_service, library_root: Path, can_manage: bool, parent=None):
+        """
+        Args:
+            template_service: AssetTemplateService of the library
+            library_root: Library whose templates are listed
+            can_manage: Whether the artist may delete templates
+        """
+        super().__init__(parent)
+
+        self._service = template_service
+        self._library_root = Path(library_root)
+        self._can_manage = can_manage
+
+        self._setup_ui()
+        self._load_templates()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Create Asset From Template"))
+        self.setMinimumSize(480, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Create Asset From Template"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "A new scene opens with the template's groups and render settings, and the "
+                "Create Asset dialog starts with its category, tags, and naming. The open "
+                "scene is closed without saving."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._template_list = QListWidget()
+        self._template_list.currentItemChanged.connect(self._on_template_changed)
+        self._template_list.itemDoubleClicked.connect(self._on_create_clicked)
+        main_layout.addWidget(self._template_list, 1)
+
+        self._details_label = QLabel()
+        self._details_label.setWordWrap(True)
+        main_layout.addWidget(self._details_label)
+
+        form_layout = QFormLayout()
+        self._name_edit = QLineEdit()
+        self._name_edit.setPlaceholderText(tr("Enter asset name..."))
+        self._name_edit.textChanged.connect(self._update_preview)
+        form_layout.addRow(tr("Asset name:"), self._name_edit)
+        self._preview_label = QLabel()
+        form_layout.addRow(tr("Publishes as:"), self._preview_label)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        self._delete_btn = QPushButton(tr("Delete Template"))
+        self._delete_btn.setVisible(self._can_manage)
+        self._delete_btn.clicked.connect(self._on_delete_clicked)
+        button_layout.addWidget(self._delete_btn)
+        button_layout.addStretch()
+
+        self._create_btn = QPushButton(tr("Create Asset"))
+        self._create_btn.setProperty("accent", True)
+        self._create_btn.setDefault(True)
+        self._create_btn.clicked.connect(self._on_create_clicked)
+        button_layout.addWidget(self._create_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_templates(self) -> None:
+        """List the library's templates"""
+        self._template_list.clear()
+        for template in self._service.list_templates(self._library_root):
+            item = QListWidgetItem(f"{template.name} ({template.category})")
+            item.setData(Qt.UserRole, template)  # type: ignore
+            self._template_list.addItem(item)
+        if self._template_list.count():
+            self._template_list.setCurrentRow(0)
+        else:
+            self._details_label.setText(
+                tr("The library has no templates yet - use Assets > Save Scene as Template...")
+            )
+        self._on_template_changed()
+
+    def _get_template(self) -> Optional[AssetTemplate]:
+        """Get the highlighted template"""
+        item = self._template_list.currentItem()
+        return item.data(Qt.UserRole) if item else None  # type: ignore
+
+    def _on_template_changed(self, *_args) -> None:
+        """Show the highlighted template's details"""
+        template = self._get_template()
+        self._create_btn.setEnabled(template is not None)
+        self._delete_btn.setEnabled(template is not None)
+        if template is not None:
+            details = [template.description] if template.description else []
+            details.append(tr("Naming: {pattern}", pattern=template.name_pattern))
+            if template.tags:
+                details.append(tr("Tags: {tags}", tags=", ".join(template.tags)))
+            if template.created_by:
+                details.append(
+                    tr(
+                        "Saved by {user} on {date}",
+                        user=template.created_by,
+                        date=template.created_date[:10],
+                    )
+                )
+            self._details_label.setText("\n".join(details))
+        self._update_preview()
+
+    def _update_preview(self) -> None:
+        """Show the name the asset publishes as"""
+        template = self._get_template()
+        name = self._name_edit.text().strip()
+        self._preview_label.setText(template.get_asset_name(name) if template and name else "")
+
+    def _on_delete_clicked(self) -> None:
+        """Delete the highlighted template after confirmation"""
+        template = self._get_template()
+        if template is None:
+            return
+        reply = QMessageBox.question(
+            self,
+            tr("Delete Template"),
+            tr("Delete the asset template '{name}'?", name=template.name),
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        if reply == QMessageBox.StandardButton.Yes:
+            self._service.delete_template(self._library_root, template.name)
+            self._load_templates()
+
+    def _on_create_clicked(self, *_args) -> None:
+        """Validate input before closing"""
+        if self._get_template() is None:
+            return
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        self.accept()
+
+    def get_selection(self) -> Tuple[Optional[AssetTemplate], str]:
+        """Get the chosen template and the asset name typed"""
+        return self._get_template(), self._name_edit.text().strip()
@@ -0,0 +1,206 @@
+# -*- coding: utf-8 -*-
+"""
+Batch Publish Dialog
+Map the selection sets or top-level groups of a scene to assets published in one run
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Callable, Dict, List, Optional
+
+from PySide6.QtCore import Qt
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QLineEdit,
+    QCheckBox,
+    QSpinBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ..widgets.tag_completer import TagCompleter
+from ...core.models.tag_hierarchy import parse_tags
+from ...services.batch_service_impl import SOURCE_GROUPS, SOURCE_SETS, ScenePublishItem
+from ...services.localization_service_impl import tr
+
+SOURCE_LABELS = {SOURCE_SETS: "Selection Sets", SOURCE_GROUPS: "Top-Level Groups"}
+
+
+class BatchPublishDialog(QDialog):
+    """
+    Batch Publish Dialog - Single Responsibility for multi-asset publish options
+    Items are found again whenever the artist switches between sets and groups
+    """
+
+    def __init__(
+        self,
+        find_items: Callable[[str], List[ScenePublishItem]],
+        parent=None,
+        known_tags: Optional[List[str]] = None,
+        default_workers: int = 0,
+    ):
+        """
+        Args:
+            find_items: Gets the scene's publish items for SOURCE_SETS or SOURCE_GROUPS
+            known_tags: Library tags offered as the artist types
+            default_workers: Worker processes offered, 0 when mayapy is not available
+        """
+        super().__init__(parent)
+
+        self._find_items = find_items
+        self._known_tags = known_tags or []
+        self._default_workers = default_workers
+        self._items: List[ScenePublishItem] = []
+
+        self._setup_ui()
+        self._on_source_changed()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Batch Publish Scene"))
+        self.setMinimumSize(560, 480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Batch Publish Scene"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Each checked set or group becomes its own asset. Double-click a name to rename "
+                "the asset; assets that fail are reported and the rest still publish."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        source_layout = QHBoxLayout()
+        source_layout.addWidget(QLabel(tr("One asset per:")))
+        self._source_combo = QComboBox()
+        for source in (SOURCE_SETS, SOURCE_GROUPS):
+            self._source_combo.addItem(SOURCE_LABELS[source], source)
+        self._source_combo.currentIndexChanged.connect(self._on_source_changed)
+        source_layout.addWidget(self._source_combo)
+        source_layout.addStretch()
+        main_layout.addLayout(source_layout)
+
+        self._table = QTableWidget(0, 3)
+        self._table.setHorizontalHeaderLabels(["Set / Group", "Asset Name", "Nodes"])
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        self._table.verticalHeader().setVisible(False)
+        main_layout.addWidget(self._table)
+
+        form_layout = QFormLayout()
+
+        self._tags_edit = QLineEdit()
+        self._tags_edit.setPlaceholderText(tr("e.g. props, kitchen (added to every asset)"))
+        TagCompleter(self._known_tags, self._tags_edit)
+        form_layout.addRow("Shared Tags:", self._tags_edit)
+
+        self._notes_edit = QLineEdit()
+        self._notes_edit.setPlaceholderText(tr("Version notes for every asset"))
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._format_combo = QComboBox()
+        self._format_combo.addItem("Maya ASCII (.ma)", ".ma")
+        self._format_combo.addItem("Maya Binary (.mb)", ".mb")
+        form_layout.addRow("Format:", self._format_combo)
+
+        self._workers_spin = QSpinBox()
+        self._workers_spin.setRange(0, 16)
+        self._workers_spin.setValue(self._default_workers)
+        self._workers_spin.setSpecialValueText("None (export in this session)")
+        self._workers_spin.setToolTip(
+            tr("Export this many assets at once in background mayapy processes")
+        )
+        form_layout.addRow("Export Workers:", self._workers_spin)
+
+        self._validate_check = QCheckBox(tr("Run publish checks on each asset"))
+        self._validate_check.setChecked(True)
+        form_layout.addRow("", self._validate_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish All"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.clicked.connect(self._on_publish_clicked)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_source_changed(self) -> None:
+        """List the sets or groups of the scene"""
+        self._items = self._find_items(self._source_combo.currentData())
+        self._table.setRowCount(0)
+        for item in self._items:
+            row = self._table.rowCount()
+            self._table.insertRow(row)
+
+            source_item = QTableWidgetItem(item.source.split("|")[-1])
+            source_item.setFlags(
+                (source_item.flags() | Qt.ItemFlag.ItemIsUserCheckable)
+                & ~Qt.ItemFlag.ItemIsEditable
+            )
+            source_item.setCheckState(Qt.CheckState.Checked)
+            source_item.setToolTip(item.source)
+            count_item = QTableWidgetItem(str(len(item.nodes)))
+            count_item.setFlags(count_item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+
+            self._table.setItem(row, 0, source_item)
+            self._table.setItem(row, 1, QTableWidgetItem(item.name))
+            self._table.setItem(row, 2, count_item)
+
+    def _on_publish_clicked(self) -> None:
+        """Check the asset names and close"""
+        items = self.get_items()
+        if not items:
+            QMessageBox.information(
+                self, tr("Nothing to Publish"), tr("Check at least one asset.")
+            )
+            return
+        names = [item.name.lower() for item in items]
+        if "" in names or len(set(names)) != len(names):
+            QMessageBox.warning(self, tr("Invalid Names"), tr("Every asset needs a unique name."))
+            return
+        self.accept()
+
+    def get_items(self) -> List[ScenePublishItem]:
+        """Get the checked items with the names entered in the table"""
+        items = []
+        for row, item in enumerate(self._items):
+            if self._table.item(row, 0).checkState() != Qt.CheckState.Checked:
+                continue
+            name = self._table.item(row, 1).text().strip()
+            items.append(ScenePublishItem(item.source, name, item.nodes, item.tags))
+        return items
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get options shared by every asset (tags, notes, file_format, validate, workers)"""
+        return {
+            "tags": parse_tags(self._tags_edit.text()),
+            "notes": self._notes_edit.text().strip(),
+            "file_format": self._format_combo.currentData(),
+            "validate": self._validate_check.isChecked(),
+            "workers": self._workers_spin.value(),
+        }
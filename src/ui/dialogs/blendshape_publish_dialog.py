@@ -0,0 +1,154 @@
+# -*- coding: utf-8 -*-
+"""
+Blendshape Publish Dialog
+Pick the targets to publish as a blendshape set and name it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...core.models.mesh_topology import MeshTopology
+from ...services.localization_service_impl import tr
+
+
+class BlendshapePublishDialog(QDialog):
+    """
+    Blendshape Publish Dialog - Single Responsibility for blendshape set options
+    Lists the captured targets and the sculpts left out for a different topology
+    """
+
+    def __init__(
+        self,
+        base_mesh: str,
+        topology: MeshTopology,
+        target_names: List[str],
+        mismatched: List[str],
+        parent=None,
+    ):
+        """
+        Args:
+            base_mesh: Mesh the targets deform
+            topology: Fingerprint of the base mesh, stored with the set
+            target_names: Captured targets, all checked at first
+            mismatched: Selected sculpts skipped because their topology differs
+        """
+        super().__init__(parent)
+
+        self._base_mesh = base_mesh.rsplit("|", 1)[-1]
+        self._topology = topology
+        self._target_names = target_names
+        self._mismatched = mismatched
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Blendshapes"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Blendshapes"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        description = (
+            f"The checked targets of {self._base_mesh} ({self._topology.label}) are stored "
+            "as point deltas. They apply to any mesh with the same topology."
+        )
+        if self._mismatched:
+            skipped = ", ".join(mesh.rsplit("|", 1)[-1] for mesh in self._mismatched)
+            description += f"\n\nSkipped, topology differs from the base mesh: {skipped}"
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._target_list = QListWidget()
+        self._target_list.setMaximumHeight(160)
+        for name in self._target_names:
+            item = QListWidgetItem(name)
+            item.setCheckState(Qt.Checked)  # type: ignore
+            self._target_list.addItem(item)
+        form_layout.addRow("Targets:", self._target_list)
+
+        self._name_edit = QLineEdit(f"{self._base_mesh}_shapes")
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._thumbnail_check = QCheckBox(tr("Capture viewport thumbnail"))
+        self._thumbnail_check.setChecked(True)
+        form_layout.addRow("", self._thumbnail_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_checked_targets(self) -> List[str]:
+        """Get the target names left checked"""
+        targets = []
+        for row in range(self._target_list.count()):
+            item = self._target_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                targets.append(item.text())
+        return targets
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        if not self._get_checked_targets():
+            QMessageBox.warning(
+                self, tr("No Targets"), tr("Check at least one target to publish.")
+            )
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, notes, targets, capture_thumbnail)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "targets": self._get_checked_targets(),
+            "capture_thumbnail": self._thumbnail_check.isChecked(),
+        }
@@ -0,0 +1,371 @@
+# -*- coding: utf-8 -*-
+"""
+Bulk Edit Dialog
+Preview and apply library-wide find-and-replace on asset metadata, and undo past runs
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import json
+from pathlib import Path
+from typing import Any, List, Optional
+
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtWidgets import (
+    QApplication,
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QPushButton,
+    QTabWidget,
+    QWidget,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.bulk_edit import (
+    OPERATION_LABELS,
+    OPERATION_RENAME_TAG,
+    OPERATION_REPLACE_PATH,
+    OPERATION_SET_AUTHOR,
+    OPERATIONS,
+    BulkEdit,
+)
+from ...services.localization_service_impl import tr
+
+# Operation -> (find label, replace label)
+_FIELD_LABELS = {
+    OPERATION_RENAME_TAG: ("Tag:", "Rename to:"),
+    OPERATION_SET_AUTHOR: ("Author:", "Change to:"),
+    OPERATION_REPLACE_PATH: ("Path prefix:", "Replace with:"),
+}
+
+
+def _format_value(value: Any) -> str:
+    """Get table text for a metadata value"""
+    if value is None:
+        return "-"
+    if isinstance(value, list) and all(isinstance(item, str) for item in value):
+        return ", ".join(value)
+    if isinstance(value, (dict, list)):
+        return json.dumps(value)
+    return str(value)
+
+
+class BulkEditDialog(QDialog):
+    """
+    Bulk Edit Dialog - Single Responsibility for the metadata find-and-replace admin tool
+    Applying writes exactly the changes the last preview listed
+    """
+
+    edits_changed = Signal()  # Asset metadata was changed or restored
+
+    PREVIEW_COLUMNS = ["Asset", "Field", "Old Value", "New Value"]
+    LOG_COLUMNS = ["Date", "User", "Edit", "State"]
+
+    def __init__(
+        self,
+        bulk_edit_service,
+        library_root: Path,
+        selected_keys: Optional[List[str]] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            bulk_edit_service: BulkEditService planning and applying the edits
+            library_root: Library whose assets are edited
+            selected_keys: Asset keys of the selected assets, for author changes
+        """
+        super().__init__(parent)
+
+        self._service = bulk_edit_service
+        self._library_root = Path(library_root)
+        self._selected_keys = list(selected_keys or [])
+        self._preview: Optional[BulkEdit] = None
+        self._log: List[BulkEdit] = []
+
+        self._setup_ui()
+        self._on_operation_changed()
+        self._refresh_log()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Bulk Edit Metadata"))
+        self.setMinimumSize(760, 540)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Bulk Edit {name}", name=self._library_root.name))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Rename a tag across all assets, change the author of a batch, or rewrite a "
+                "path prefix in stored paths. Preview first; every applied edit is logged "
+                "and can be undone from History."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        tabs = QTabWidget()
+        tabs.addTab(self._create_edit_tab(), tr("Find and Replace"))
+        tabs.addTab(self._create_log_tab(), tr("History"))
+        main_layout.addWidget(tabs, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+        main_layout.addLayout(button_layout)
+
+    def _create_edit_tab(self) -> QWidget:
+        """Create the find-and-replace fields and preview"""
+        widget = QWidget()
+        layout = QVBoxLayout(widget)
+
+        form_layout = QFormLayout()
+        self._operation_combo = QComboBox()
+        for operation in OPERATIONS:
+            self._operation_combo.addItem(OPERATION_LABELS[operation], operation)
+        self._operation_combo.currentIndexChanged.connect(self._on_operation_changed)
+        form_layout.addRow("Edit:", self._operation_combo)
+
+        self._find_label = QLabel()
+        self._find_edit = QLineEdit()
+        form_layout.addRow(self._find_label, self._find_edit)
+        self._replace_label = QLabel()
+        self._replace_edit = QLineEdit()
+        form_layout.addRow(self._replace_label, self._replace_edit)
+        layout.addLayout(form_layout)
+
+        self._find_edit.textChanged.connect(self._clear_preview)
+        self._replace_edit.textChanged.connect(self._clear_preview)
+
+        self._preview_table = QTableWidget(0, len(self.PREVIEW_COLUMNS))
+        self._preview_table.setHorizontalHeaderLabels(self.PREVIEW_COLUMNS)
+        header = self._preview_table.horizontalHeader()
+        header.setSectionResizeMode(2, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(3, QHeaderView.ResizeMode.Stretch)
+        self._preview_table.verticalHeader().setVisible(False)
+        self._preview_table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._preview_table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        layout.addWidget(self._preview_table, 1)
+
+        self._status_label = QLabel()
+        layout.addWidget(self._status_label)
+
+        button_layout = QHBoxLayout()
+        preview_btn = QPushButton(tr("Preview"))
+        preview_btn.setToolTip(tr("List the assets the edit changes, without changing them"))
+        preview_btn.clicked.connect(self._on_preview_clicked)
+        button_layout.addWidget(preview_btn)
+        button_layout.addStretch()
+
+        self._apply_btn = QPushButton(tr("Apply"))
+        self._apply_btn.setProperty("accent", True)
+        self._apply_btn.setToolTip(tr("Change the previewed assets and log the edit"))
+        self._apply_btn.setEnabled(False)
+        self._apply_btn.clicked.connect(self._on_apply_clicked)
+        button_layout.addWidget(self._apply_btn)
+        layout.addLayout(button_layout)
+        return widget
+
+    def _create_log_tab(self) -> QWidget:
+        """Create the transaction log with undo"""
+        widget = QWidget()
+        layout = QVBoxLayout(widget)
+
+        self._log_table = QTableWidget(0, len(self.LOG_COLUMNS))
+        self._log_table.setHorizontalHeaderLabels(self.LOG_COLUMNS)
+        self._log_table.horizontalHeader().setSectionResizeMode(
+            2, QHeaderView.ResizeMode.Stretch
+        )
+        self._log_table.verticalHeader().setVisible(False)
+        self._log_table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._log_table.setSelectionMode(QTableWidget.SelectionMode.SingleSelection)
+        self._log_table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        self._log_table.itemSelectionChanged.connect(self._on_log_selection_changed)
+        layout.addWidget(self._log_table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+        self._undo_btn = QPushButton(tr("Undo Edit"))
+        self._undo_btn.setToolTip(tr("Put back the values the selected edit replaced"))
+        self._undo_btn.setEnabled(False)
+        self._undo_btn.clicked.connect(self._on_undo_clicked)
+        button_layout.addWidget(self._undo_btn)
+        layout.addLayout(button_layout)
+        return widget
+
+    # Find and replace -------------------------------------------------------------------
+
+    def _on_operation_changed(self) -> None:
+        """Label the fields for the chosen edit"""
+        operation = self._operation_combo.currentData()
+        find_label, replace_label = _FIELD_LABELS[operation]
+        self._find_label.setText(find_label)
+        self._replace_label.setText(replace_label)
+        if operation == OPERATION_SET_AUTHOR:
+            self._find_edit.setPlaceholderText(
+                tr("Empty changes the {count} selected asset(s)", count=len(self._selected_keys))
+            )
+        elif operation == OPERATION_REPLACE_PATH:
+            self._find_edit.setPlaceholderText("//old-server/textures")
+        else:
+            self._find_edit.setPlaceholderText("")
+        self._clear_preview()
+
+    def _clear_preview(self) -> None:
+        """Forget the preview once the fields change"""
+        self._preview = None
+        self._preview_table.setRowCount(0)
+        self._apply_btn.setEnabled(False)
+        self._status_label.setText(tr("Preview to see which assets the edit changes"))
+
+    def _plan(self) -> BulkEdit:
+        """Plan the edit in the fields"""
+        operation = self._operation_combo.currentData()
+        find, new = self._find_edit.text(), self._replace_edit.text()
+        if operation == OPERATION_RENAME_TAG:
+            return self._service.plan_rename_tag(self._library_root, find, new)
+        if operation == OPERATION_SET_AUTHOR:
+            return self._service.plan_set_author(
+                self._library_root, new, find, self._selected_keys
+            )
+        return self._service.plan_replace_path(self._library_root, find, new)
+
+    def _on_preview_clicked(self) -> None:
+        """List the changes of the edit in the fields"""
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            preview = self._plan()
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Bulk Edit"), str(e))
+            return
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to preview the edit:\n{e}")
+            return
+        finally:
+            QApplication.restoreOverrideCursor()
+
+        self._clear_preview()
+        self._preview = preview
+        for change in preview.changes:
+            row = self._preview_table.rowCount()
+            self._preview_table.insertRow(row)
+            values = [
+                change.asset_name,
+                change.field,
+                _format_value(change.old_value),
+                _format_value(change.new_value),
+            ]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(change.asset_key if column == 0 else text)
+                self._preview_table.setItem(row, column, item)
+        self._status_label.setText(
+            f"{len(preview.changes)} change(s) to {len(preview.asset_keys)} asset(s)"
+            if preview.changes
+            else "No assets match"
+        )
+        self._apply_btn.setEnabled(bool(preview.changes))
+
+    def _on_apply_clicked(self) -> None:
+        """Write the previewed changes after confirmation"""
+        if self._preview is None or not self._preview.changes:
+            return
+        reply = QMessageBox.question(
+            self,
+            tr("Apply Bulk Edit"),
+            f"{self._preview.description}?\n\nThe edit can be undone from History.",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        if reply != QMessageBox.StandardButton.Yes:
+            return
+
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            applied = self._service.apply(self._library_root, self._preview)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to apply the edit:\n{e}")
+            return
+        finally:
+            QApplication.restoreOverrideCursor()
+        self._clear_preview()
+        self._status_label.setText(f"Applied {applied.description}")
+        self._refresh_log()
+        self.edits_changed.emit()
+
+    # History ----------------------------------------------------------------------------
+
+    def _refresh_log(self) -> None:
+        """List the library's logged edits, newest first"""
+        self._log = self._service.get_log(self._library_root)
+        self._log_table.setRowCount(0)
+        for edit in self._log:
+            row = self._log_table.rowCount()
+            self._log_table.insertRow(row)
+            values = [
+                edit.date.strftime("%Y-%m-%d %H:%M") if edit.date else "-",
+                edit.user,
+                edit.description,
+                f"Undone {edit.undone_date:%Y-%m-%d %H:%M}" if edit.undone_date else "Applied",
+            ]
+            for column, text in enumerate(values):
+                self._log_table.setItem(row, column, QTableWidgetItem(text))
+        self._on_log_selection_changed()
+
+    def _get_selected_edit(self) -> Optional[BulkEdit]:
+        """Get the logged edit selected in History"""
+        rows = self._log_table.selectionModel().selectedRows()
+        return self._log[rows[0].row()] if rows else None
+
+    def _on_log_selection_changed(self) -> None:
+        """Only applied edits can be undone"""
+        edit = self._get_selected_edit()
+        self._undo_btn.setEnabled(edit is not None and not edit.is_undone)
+
+    def _on_undo_clicked(self) -> None:
+        """Put back the values of the selected edit"""
+        edit = self._get_selected_edit()
+        if edit is None:
+            return
+        reply = QMessageBox.question(
+            self,
+            tr("Undo Bulk Edit"),
+            f"Undo {edit.description}?",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        if reply != QMessageBox.StandardButton.Yes:
+            return
+        try:
+            _undone, conflicts = self._service.undo(self._library_root, edit.edit_id)
+        except Exception as e:
+            QMessageBox.critical(self, tr("Error"), f"Failed to undo the edit:\n{e}")
+            return
+        self._refresh_log()
+        self.edits_changed.emit()
+        if conflicts:
+            QMessageBox.information(
+                self,
+                tr("Some Values Kept"),
+                "These values were changed after the edit and were left as they are:\n\n"
+                + "\n".join(conflicts[:20]),
+            )
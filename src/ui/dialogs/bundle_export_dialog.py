@@ -0,0 +1,104 @@
+# -*- coding: utf-8 -*-
+"""
+Bundle Export Dialog
+Choose what a delivery bundle of selected assets carries
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QListWidget,
+    QCheckBox,
+    QRadioButton,
+    QGroupBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class BundleExportDialog(QDialog):
+    """
+    Bundle Export Dialog - Single Responsibility for delivery bundle options
+    Thumbnails, collected dependencies, and textures always travel with an asset
+    """
+
+    def __init__(self, asset_names: List[str], parent=None):
+        super().__init__(parent)
+
+        self._asset_names = list(asset_names)
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Export Asset Bundle"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Export {len(self._asset_names)} Asset(s) as a Bundle")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The bundle is a zip with a bundle.json manifest, for vendors to open as is. "
+                "Each asset takes its thumbnails, collected dependencies, and the textures and "
+                "caches it uses; Import Asset Bundle brings a returned bundle into a library."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        asset_list = QListWidget()
+        asset_list.addItems(self._asset_names)
+        asset_list.setMaximumHeight(120)
+        main_layout.addWidget(asset_list)
+
+        versions_box = QGroupBox(tr("Versions"))
+        versions_layout = QVBoxLayout(versions_box)
+        self._latest_radio = QRadioButton(tr("Latest version only"))
+        self._all_radio = QRadioButton(tr("All versions (with version history)"))
+        self._latest_radio.setChecked(True)
+        versions_layout.addWidget(self._latest_radio)
+        versions_layout.addWidget(self._all_radio)
+        main_layout.addWidget(versions_box)
+
+        self._dependencies_check = QCheckBox(tr("Include library assets they reference or place"))
+        self._dependencies_check.setChecked(True)
+        main_layout.addWidget(self._dependencies_check)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        export_btn = QPushButton(tr("Export..."))
+        export_btn.setProperty("accent", True)
+        export_btn.setDefault(True)
+        export_btn.clicked.connect(self.accept)
+        button_layout.addWidget(export_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get export_bundle keyword arguments"""
+        return {
+            "latest_only": self._latest_radio.isChecked(),
+            "include_dependencies": self._dependencies_check.isChecked(),
+        }
@@ -0,0 +1,156 @@
+# -*- coding: utf-8 -*-
+"""
+Camera Publish Dialog
+Name a shot camera and review its lens, animation, and image planes before publishing
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ..widgets.playblast_options_widget import PlayblastOptionsGroup
+from ..widgets.tag_completer import TagCompleter
+from ...core.models.playblast_settings import PlayblastSettings
+from ...core.models.shot_camera import ShotCamera
+from ...core.models.tag_hierarchy import parse_tags
+from ...services.localization_service_impl import tr
+
+
+class CameraPublishDialog(QDialog):
+    """
+    Camera Publish Dialog - Single Responsibility for shot camera publish options
+    The playblast looks through the published camera unless the artist picks another
+    """
+
+    def __init__(
+        self,
+        camera: ShotCamera,
+        playblast_defaults: Optional[PlayblastSettings] = None,
+        cameras: Optional[List[str]] = None,
+        parent=None,
+        known_tags: Optional[List[str]] = None,
+    ):
+        """
+        Args:
+            camera: Lens and animation read from the camera being published
+            playblast_defaults: Playblast settings shown at first (the camera's key range)
+            cameras: Scene cameras a playblast can look through
+            known_tags: Library tags offered as the artist types
+        """
+        super().__init__(parent)
+
+        self._camera = camera
+        self._playblast_defaults = playblast_defaults
+        self._cameras = cameras or []
+        self._known_tags = known_tags or []
+        self._playblast_group: Optional[PlayblastOptionsGroup] = None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Camera"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Camera"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "{camera}: {description}. The camera's keys and image planes are published "
+                "with it, and image plane files are collected.",
+                camera=self._camera.name,
+                description=self._camera.description,
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        name = self._camera.name
+        default_name = name[:-4] if name.lower().endswith("_cam") else name
+        self._name_edit = QLineEdit(default_name)
+        form_layout.addRow(tr("Name:"), self._name_edit)
+
+        self._format_combo = QComboBox()
+        self._format_combo.addItem("Maya ASCII (.ma)", ".ma")
+        self._format_combo.addItem("Maya Binary (.mb)", ".mb")
+        form_layout.addRow(tr("Format:"), self._format_combo)
+
+        self._tags_edit = QLineEdit()
+        self._tags_edit.setPlaceholderText(tr("Comma separated (shot/sh010, layout)"))
+        TagCompleter(self._known_tags, self._tags_edit)
+        form_layout.addRow(tr("Tags:"), self._tags_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow(tr("Notes:"), self._notes_edit)
+
+        for plane in self._camera.image_planes:
+            plane_label = QLabel(plane.image or tr("(no image)"))
+            plane_label.setWordWrap(True)
+            form_layout.addRow(f"{plane.name}:", plane_label)
+        main_layout.addLayout(form_layout)
+
+        if self._playblast_defaults is not None:
+            self._playblast_group = PlayblastOptionsGroup(self._playblast_defaults, self._cameras)
+            main_layout.addWidget(self._playblast_group)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish Camera"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        playblast_error = self._playblast_group.validate() if self._playblast_group else None
+        if playblast_error:
+            QMessageBox.warning(self, tr("Invalid Range"), playblast_error)
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, format, tags, notes, playblast)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "format": self._format_combo.currentData(),
+            "tags": parse_tags(self._tags_edit.text()),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "playblast": self._playblast_group.get_settings() if self._playblast_group else None,
+        }
@@ -28,6 +28,7 @@ from PySide6.QtGui import QColor, QPixmap, QPainter, QBrush
 
 # Import the unified theme
 from ..theme import UITheme
+from ...services.localization_service_impl import tr
 
 PYSIDE_VERSION = "PySide6"
 
@@ -78,7 +79,7 @@ class ColorCodingManagerDialog(QDialog):
 
     def _setup_ui(self) -> None:
         """Setup dialog UI - Single Responsibility"""
-        self.setWindowTitle("Color Coding Manager")
+        self.setWindowTitle(tr("Color Coding Manager"))
         self.setMinimumSize(600, 400)
         self.resize(700, 500)
 
@@ -91,14 +92,16 @@ class ColorCodingManagerDialog(QDialog):
         main_layout.setSpacing(12)
 
         # Title
-        title_label = QLabel("Asset Color Coding Manager")
+        title_label = QLabel(tr("Asset Color Coding Manager"))
         title_label.setProperty("title", True)  # Use theme styling
         main_layout.addWidget(title_label)
 
         # Description
         desc_label = QLabel(
-            "Assign colors to different asset types for better visual "
-            "organization in the asset library."
+            tr(
+                "Assign colors to different asset types for better visual "
+                "organization in the asset library."
+            )
         )
         desc_label.setWordWrap(True)
         desc_label.setProperty("description", True)  # Use theme styling
@@ -123,12 +126,12 @@ class ColorCodingManagerDialog(QDialog):
         button_layout = QHBoxLayout()
         button_layout.addStretch()
 
-        apply_btn = QPushButton("Apply Colors")
+        apply_btn = QPushButton(tr("Apply Colors"))
         apply_btn.setProperty("accent", True)  # Use theme accent styling
         apply_btn.clicked.connect(self._apply_colors)
         button_layout.addWidget(apply_btn)
 
-        close_btn = QPushButton("Close")
+        close_btn = QPushButton(tr("Close"))
         close_btn.clicked.connect(self.accept)
         button_layout.addWidget(close_btn)
 
@@ -136,7 +139,7 @@ class ColorCodingManagerDialog(QDialog):
 
     def _create_schemes_panel(self) -> QGroupBox:
         """Create color schemes selection panel - Single Responsibility"""
-        group = QGroupBox("Color Schemes")
+        group = QGroupBox(tr("Color Schemes"))
 
         layout = QVBoxLayout(group)
 
@@ -151,7 +154,7 @@ class ColorCodingManagerDialog(QDialog):
         layout.addWidget(self._scheme_list)
 
         # Custom scheme button
-        custom_btn = QPushButton("Create Custom Scheme")
+        custom_btn = QPushButton(tr("Create Custom Scheme"))
         custom_btn.setProperty("danger", True)  # Use theme danger styling
         custom_btn.clicked.connect(self._create_custom_scheme)
         layout.addWidget(custom_btn)
@@ -160,7 +163,7 @@ class ColorCodingManagerDialog(QDialog):
 
     def _create_colors_panel(self) -> QGroupBox:
         """Create asset type colors panel - Single Responsibility"""
-        group = QGroupBox("Asset Type Colors")
+        group = QGroupBox(tr("Asset Type Colors"))
 
         layout = QVBoxLayout(group)
 
@@ -172,7 +175,7 @@ class ColorCodingManagerDialog(QDialog):
         # Color edit buttons
         btn_layout = QHBoxLayout()
 
-        edit_color_btn = QPushButton("Edit Color")
+        edit_color_btn = QPushButton(tr("Edit Color"))
         edit_color_btn.setStyleSheet("""
             QPushButton {
                 background-color: #f39c12;
@@ -188,7 +191,7 @@ class ColorCodingManagerDialog(QDialog):
         edit_color_btn.clicked.connect(self._edit_selected_color)
         btn_layout.addWidget(edit_color_btn)
 
-        reset_btn = QPushButton("Reset to Default")
+        reset_btn = QPushButton(tr("Reset to Default"))
         reset_btn.setStyleSheet("""
             QPushButton {
                 background-color: #95a5a6;
@@ -244,7 +247,7 @@ class ColorCodingManagerDialog(QDialog):
         current_item = self._colors_list.currentItem()
         if not current_item:
             QMessageBox.information(
-                self, "No Selection", "Please select an asset type to edit its color."
+                self, tr("No Selection"), tr("Please select an asset type to edit its color.")
             )
             return
 
@@ -274,8 +277,8 @@ class ColorCodingManagerDialog(QDialog):
         """Reset colors to default scheme - Single Responsibility"""
         reply = QMessageBox.question(
             self,
-            "Reset Colors",
-            "Are you sure you want to reset all colors to the default scheme?",
+            tr("Reset Colors"),
+            tr("Are you sure you want to reset all colors to the default scheme?"),
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
         )
@@ -289,9 +292,11 @@ class ColorCodingManagerDialog(QDialog):
         """Create custom color scheme - Single Responsibility"""
         QMessageBox.information(
             self,
-            "Custom Schemes",
-            "Custom color scheme creation will be available in a future version.\n\n"
-            "For now, you can modify the existing schemes by editing individual asset type colors.",
+            tr("Custom Schemes"),
+            tr(
+                "Custom color scheme creation will be available in a future version.\n\n"
+                "For now, you can modify the existing schemes by editing individual asset type colors."
+            ),
         )
 
     def _apply_colors(self) -> None:
@@ -302,13 +307,13 @@ class ColorCodingManagerDialog(QDialog):
 
             QMessageBox.information(
                 self,
-                "Colors Applied",
+                tr("Colors Applied"),
                 f"Color scheme '{self._current_scheme}' has been applied!\n\n"
                 "Asset thumbnails and icons will now use the selected colors.",
             )
 
         except Exception as e:
-            QMessageBox.critical(self, "Error", f"Failed to apply color scheme:\n{str(e)}")
+            QMessageBox.critical(self, tr("Error"), f"Failed to apply color scheme:\n{str(e)}")
 
     def get_current_color_scheme(self) -> Dict[str, QColor]:
         """Get the currently selected color scheme - Single Responsibility"""
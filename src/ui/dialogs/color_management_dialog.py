@@ -0,0 +1,163 @@
+# -*- coding: utf-8 -*-
+"""
+Color Management Dialog
+Choose the OCIO config and view transform the library's thumbnails render with
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QPushButton,
+    QFileDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.color_transform import ColorTransform
+from ...services.localization_service_impl import tr
+
+
+class ColorManagementDialog(QDialog):
+    """
+    Color Management Dialog - Single Responsibility for the library's OCIO settings
+    Empty fields fall back to the config's defaults
+    """
+
+    def __init__(
+        self, color_service, library_root: Path, cmds: Optional[Any] = None, parent=None
+    ):
+        super().__init__(parent)
+
+        self._service = color_service
+        self._library_root = Path(library_root)
+        self._cmds = cmds  # maya.cmds when running in Maya, for "From Scene"
+        self._transform = color_service.load_project_transform(self._library_root)
+
+        self._setup_ui()
+        self._show_transform(self._transform)
+
+    @property
+    def transform(self) -> ColorTransform:
+        """Get the transform saved by the dialog"""
+        return self._transform
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Color Management"))
+        self.setMinimumWidth(560)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Thumbnail Color Management"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "Thumbnails and previews render through this OCIO config and view so they "
+            "match the viewport. Leave the config empty to use $OCIO or Maya's default. "
+            f"Settings are shared by everyone using this library:\n"
+            f"{self._service.get_settings_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        config_layout = QHBoxLayout()
+        self._config_edit = QLineEdit()
+        self._config_edit.setPlaceholderText("//studio/ocio/aces_1.2/config.ocio")
+        config_layout.addWidget(self._config_edit, 1)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse_config)
+        config_layout.addWidget(browse_btn)
+        form_layout.addRow("OCIO config:", config_layout)
+
+        self._rendering_space_edit = QLineEdit()
+        self._rendering_space_edit.setPlaceholderText(tr("ACEScg"))
+        form_layout.addRow("Rendering space:", self._rendering_space_edit)
+
+        self._display_edit = QLineEdit()
+        self._display_edit.setPlaceholderText("sRGB")
+        form_layout.addRow("Display:", self._display_edit)
+
+        self._view_edit = QLineEdit()
+        self._view_edit.setPlaceholderText(tr("ACES 1.0 SDR-video"))
+        form_layout.addRow("View:", self._view_edit)
+
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+
+        scene_btn = QPushButton(tr("From Scene"))
+        scene_btn.setToolTip(tr("Use the color management preferences of the open scene"))
+        scene_btn.setEnabled(self._cmds is not None)
+        scene_btn.clicked.connect(self._on_from_scene)
+        button_layout.addWidget(scene_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _show_transform(self, transform: ColorTransform) -> None:
+        """Fill the fields from a transform"""
+        self._config_edit.setText(transform.config_path)
+        self._rendering_space_edit.setText(transform.rendering_space)
+        self._display_edit.setText(transform.display)
+        self._view_edit.setText(transform.view)
+
+    def _on_browse_config(self) -> None:
+        """Pick an OCIO config file"""
+        config_file, _ = QFileDialog.getOpenFileName(
+            self, "OCIO Config", self._config_edit.text(), "OCIO Config (*.ocio)"
+        )
+        if config_file:
+            self._config_edit.setText(config_file)
+
+    def _on_from_scene(self) -> None:
+        """Copy the open scene's config and view"""
+        try:
+            self._show_transform(self._service.read_scene_transform(self._cmds))
+        except Exception as e:
+            QMessageBox.warning(self, tr("From Scene"), f"Could not read scene preferences:\n{e}")
+
+    def _on_save_clicked(self) -> None:
+        """Store the transform in the library and close"""
+        transform = ColorTransform(
+            config_path=self._config_edit.text().strip(),
+            rendering_space=self._rendering_space_edit.text().strip(),
+            display=self._display_edit.text().strip(),
+            view=self._view_edit.text().strip(),
+        )
+        if transform.config_path and not Path(transform.config_path).is_file():
+            QMessageBox.warning(
+                self, tr("Config Not Found"), f"{transform.config_path} does not exist."
+            )
+            return
+        if not self._service.save_project_transform(self._library_root, transform):
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the color settings."))
+            return
+        self._transform = self._service.load_project_transform(self._library_root)
+        self.accept()
@@ -0,0 +1,117 @@
+# -*- coding: utf-8 -*-
+"""
+Comment Notifications Dialog
+Review notes that mention the current artist, opened on the asset they are about
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QListWidget,
+    QListWidgetItem,
+)
+from PySide6.QtCore import Qt, Signal
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class CommentNotificationsDialog(QDialog):
+    """
+    Comment Notifications Dialog - Single Responsibility for unread @mentions
+    Opening a mention marks it read; the rest stay until Mark All Read
+    """
+
+    notification_opened = Signal(object)  # CommentNotification
+
+    def __init__(self, comment_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = comment_service
+        self._library_root = library_root
+        self._notifications: List[Any] = []
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Mentions"))
+        self.setMinimumSize(520, 300)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Notes Mentioning You"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        self._list = QListWidget()
+        self._list.itemDoubleClicked.connect(self._on_open)
+        main_layout.addWidget(self._list, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._open_btn = QPushButton(tr("Open Asset"))
+        self._open_btn.setProperty("accent", True)
+        self._open_btn.clicked.connect(lambda: self._on_open(self._list.currentItem()))
+        button_layout.addWidget(self._open_btn)
+
+        self._read_all_btn = QPushButton(tr("Mark All Read"))
+        self._read_all_btn.clicked.connect(self._on_mark_all_read)
+        button_layout.addWidget(self._read_all_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """List the unread mentions, newest first"""
+        self._notifications = self._service.get_notifications(self._library_root)
+        self._list.clear()
+        for notification in self._notifications:
+            item = QListWidgetItem(notification.description)
+            item.setToolTip(f"{notification.comment.header}\n\n{notification.comment.body}")
+            item.setData(Qt.ItemDataRole.UserRole, notification)
+            self._list.addItem(item)
+        if self._notifications:
+            self._list.setCurrentRow(0)
+
+        count = len(self._notifications)
+        self._summary_label.setText(
+            f"{count} unread mention(s)." if count else "No unread mentions."
+        )
+        self._open_btn.setEnabled(bool(count))
+        self._read_all_btn.setEnabled(bool(count))
+
+    def _on_open(self, item: Any) -> None:
+        """Mark a mention read and show its note"""
+        if item is None:
+            return
+        notification = item.data(Qt.ItemDataRole.UserRole)
+        self._service.mark_read(self._library_root, [notification])
+        self.notification_opened.emit(notification)
+        self.accept()
+
+    def _on_mark_all_read(self) -> None:
+        """Clear every unread mention"""
+        self._service.mark_read(self._library_root)
+        self._refresh()
@@ -0,0 +1,202 @@
+# -*- coding: utf-8 -*-
+"""
+Contact Sheet Dialog
+Choose the collection, layout, and file of a review contact sheet
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QSpinBox,
+    QCheckBox,
+    QPushButton,
+    QFileDialog,
+)
+
+from ..theme import UITheme
+from ...core.models.contact_sheet import (
+    FORMAT_LABELS,
+    FORMAT_PNG,
+    FORMATS,
+    ContactSheetOptions,
+)
+from ...services.localization_service_impl import tr
+
+
+class ContactSheetDialog(QDialog):
+    """
+    Contact Sheet Dialog - Single Responsibility for contact sheet export choices
+    The file name follows the collection until the artist picks one
+    """
+
+    def __init__(
+        self,
+        collection_names: List[str],
+        current_collection: str = "",
+        output_dir: Optional[Path] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            collection_names: Collections that have assets
+            current_collection: Collection chosen first
+            output_dir: Folder the sheet is written to by default
+        """
+        super().__init__(parent)
+
+        self._collection_names = collection_names
+        self._current_collection = current_collection
+        self._output_dir = Path(output_dir) if output_dir else Path.home()
+        self._output_chosen = False
+
+        self._setup_ui()
+        self._on_collection_changed()
+        self._on_format_changed()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Export Contact Sheet"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Export Contact Sheet"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Lay out a collection's thumbnails with their names, versions, and statuses, "
+                "so it can be reviewed without opening Maya."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._collection_combo = QComboBox()
+        self._collection_combo.addItems(self._collection_names)
+        if self._current_collection in self._collection_names:
+            self._collection_combo.setCurrentText(self._current_collection)
+        self._collection_combo.currentIndexChanged.connect(self._on_collection_changed)
+        form_layout.addRow("Collection:", self._collection_combo)
+
+        self._title_edit = QLineEdit()
+        form_layout.addRow("Title:", self._title_edit)
+
+        self._format_combo = QComboBox()
+        for file_format in FORMATS:
+            self._format_combo.addItem(FORMAT_LABELS[file_format], file_format)
+        self._format_combo.currentIndexChanged.connect(self._on_format_changed)
+        form_layout.addRow("Format:", self._format_combo)
+
+        self._columns_spin = QSpinBox()
+        self._columns_spin.setRange(1, 12)
+        self._columns_spin.setValue(ContactSheetOptions().columns)
+        form_layout.addRow("Columns:", self._columns_spin)
+
+        self._cell_size_spin = QSpinBox()
+        self._cell_size_spin.setRange(64, 1024)
+        self._cell_size_spin.setSingleStep(64)
+        self._cell_size_spin.setSuffix(" px")
+        self._cell_size_spin.setValue(ContactSheetOptions().cell_size)
+        self._cell_size_spin.setToolTip(tr("Width of each thumbnail on the PNG sheet"))
+        form_layout.addRow("Thumbnail size:", self._cell_size_spin)
+
+        self._gallery_check = QCheckBox(tr("Also write an HTML gallery"))
+        self._gallery_check.setToolTip(
+            tr("A folder with index.html and the thumbnails, next to the sheet")
+        )
+        form_layout.addRow("", self._gallery_check)
+
+        output_layout = QHBoxLayout()
+        self._output_edit = QLineEdit()
+        self._output_edit.textEdited.connect(self._on_output_edited)
+        output_layout.addWidget(self._output_edit, 1)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse_clicked)
+        output_layout.addWidget(browse_btn)
+        form_layout.addRow("Save to:", output_layout)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._export_btn = QPushButton(tr("Export"))
+        self._export_btn.setProperty("accent", True)
+        self._export_btn.setDefault(True)
+        self._export_btn.setEnabled(bool(self._collection_names))
+        self._export_btn.clicked.connect(self.accept)
+        button_layout.addWidget(self._export_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_collection_changed(self) -> None:
+        """Title and name the sheet after the collection"""
+        name = self._collection_combo.currentText()
+        self._title_edit.setText(name)
+        if not self._output_chosen:
+            self._output_edit.setText(str(self._output_dir / f"{name}_contact_sheet"))
+            self._on_format_changed()
+
+    def _on_format_changed(self) -> None:
+        """Give the file the format's extension; thumbnail size is PNG-only"""
+        file_format = self._format_combo.currentData()
+        self._cell_size_spin.setEnabled(file_format == FORMAT_PNG)
+        text = self._output_edit.text().strip()
+        if text:
+            self._output_edit.setText(str(Path(text).with_suffix(f".{file_format}")))
+
+    def _on_output_edited(self, _text: str) -> None:
+        """Keep a typed file name when the collection changes"""
+        self._output_chosen = True
+
+    def _on_browse_clicked(self) -> None:
+        """Pick the sheet file"""
+        file_format = self._format_combo.currentData()
+        file_path, _ = QFileDialog.getSaveFileName(
+            self,
+            tr("Save Contact Sheet"),
+            self._output_edit.text(),
+            f"{file_format.upper()} (*.{file_format})",
+        )
+        if file_path:
+            self._output_chosen = True
+            self._output_edit.setText(str(Path(file_path).with_suffix(f".{file_format}")))
+
+    def get_collection(self) -> str:
+        """Get the collection to export"""
+        return self._collection_combo.currentText()
+
+    def get_output_path(self) -> Path:
+        """Get the sheet file"""
+        return Path(self._output_edit.text().strip()).expanduser()
+
+    def get_options(self) -> ContactSheetOptions:
+        """Get the layout chosen"""
+        return ContactSheetOptions(
+            title=self._title_edit.text().strip(),
+            file_format=self._format_combo.currentData(),
+            columns=self._columns_spin.value(),
+            cell_size=self._cell_size_spin.value(),
+            html_gallery=self._gallery_check.isChecked(),
+        )
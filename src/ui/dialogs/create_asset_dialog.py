@@ -6,7 +6,7 @@ Dialog for creating new assets from the current Maya scene
 Author: Mike Stumbo
 """
 
-from typing import Dict, Any, Optional
+from typing import Dict, Any, List, Optional, Tuple
 
 try:
     from PySide6.QtWidgets import (
@@ -18,6 +18,7 @@ try:
         QTextEdit,
         QComboBox,
         QCheckBox,
+        QDoubleSpinBox,
         QPushButton,
         QMessageBox,
         QGroupBox,
@@ -28,82 +29,246 @@ except ImportError as e:
     print(f"[ERROR] PySide6 import failed: {e}")
     raise
 
+from ...core.models.playblast_settings import PlayblastSettings
+from ...core.models.proxy_representation import (
+    PROXY_GPU_CACHE,
+    PROXY_LABELS,
+    STANDIN_FORMAT_LABELS,
+    STANDIN_FORMATS,
+    VIEWPORT_PROXY_KINDS,
+)
+from ...core.models.tag_hierarchy import parse_tags
+from ...core.models.thumbnail_settings import CAMERA_CUSTOM, CAMERA_LABELS, CAMERA_PRESETS
+from ..widgets.playblast_options_widget import PlayblastOptionsGroup
+from ..widgets.tag_completer import TagCompleter
+from ...services.localization_service_impl import tr
+from ...services.publish_settings_service_impl import SCENE_CAMERA_PREFIX
+
 
 class CreateAssetDialog(QDialog):
     """Dialog for creating new assets from current Maya scene"""
 
-    def __init__(self, parent=None):
+    # Asset types - library FBX presets are assigned per category
+    CATEGORIES = [
+        "General",
+        "Characters",
+        "Props",
+        "Environments",
+        "Vehicles",
+        "Textures",
+        "Materials",
+        "Rigs",
+        "Animations",
+        "Cameras",
+    ]
+
+    # (combo label, file extension) - first entry is the default
+    EXPORT_FORMATS = [
+        ("Maya ASCII (.ma)", ".ma"),
+        ("Maya Binary (.mb)", ".mb"),
+        ("USD (.usd + payload)", ".usd"),
+        ("USD Crate (.usdc + payload)", ".usdc"),
+        ("Alembic Cache (.abc)", ".abc"),
+    ]
+
+    # Categories whose assets get an animated playblast preview by default
+    PLAYBLAST_CATEGORIES = ("Rigs", "Animations")
+
+    # Categories heavy enough to publish a viewport proxy by default
+    PROXY_CATEGORIES = ("Environments",)
+
+    # Formats that can swap proxies for full geometry
+    PROXY_FORMATS = (".ma", ".mb")
+
+    def __init__(
+        self,
+        parent=None,
+        frame_range: Tuple[float, float] = (1.0, 120.0),
+        playblast_defaults: Optional[PlayblastSettings] = None,
+        cameras: Optional[List[str]] = None,
+        known_tags: Optional[List[str]] = None,
+        defaults: Optional[Dict[str, Any]] = None,
+        plugin_formats: Optional[List[Tuple[str, str]]] = None,
+    ):
+        """
+        Args:
+            frame_range: Scene playback range, the default Alembic cache range
+            playblast_defaults: Playblast settings shown at first, None outside Maya
+            cameras: Scene cameras a playblast can look through
+            known_tags: Library tags offered as the artist types
+            defaults: Values to start with - the asset's last publish when publishing
+                it again, else the artist's last export options and the asset template
+                the scene was created from
+            plugin_formats: (combo label, file extension) of studio asset types, which
+                their plugins export
+        """
         super().__init__(parent)
-        self.setWindowTitle("Create Asset")
+        self.setWindowTitle(tr("Create Asset"))
         self.setModal(True)
         self.resize(400, 500)
 
         self._asset_data: Optional[Dict[str, Any]] = None
+        self._frame_range = frame_range
+        self._playblast_defaults = playblast_defaults
+        self._cameras = cameras or []
+        self._known_tags = known_tags or []
+        self._playblast_group: Optional[PlayblastOptionsGroup] = None
+        self._plugin_formats = plugin_formats or []
+        self._plugin_extensions = {extension for _label, extension in self._plugin_formats}
 
         self._setup_ui()
         self._setup_connections()
+        if defaults:
+            self._apply_defaults(defaults)
 
     def _setup_ui(self) -> None:
         """Setup the dialog UI"""
         layout = QVBoxLayout(self)
 
         # Asset Information Group
-        info_group = QGroupBox("Asset Information")
+        info_group = QGroupBox(tr("Asset Information"))
         info_layout = QFormLayout(info_group)
 
         # Asset Name
         self._name_edit = QLineEdit()
-        self._name_edit.setPlaceholderText("Enter asset name...")
+        self._name_edit.setPlaceholderText(tr("Enter asset name..."))
         info_layout.addRow("Name*:", self._name_edit)
 
         # Asset Type/Category
         self._category_combo = QComboBox()
-        self._category_combo.addItems(
-            [
-                "General",
-                "Characters",
-                "Props",
-                "Environments",
-                "Vehicles",
-                "Textures",
-                "Materials",
-                "Rigs",
-                "Animations",
-            ]
-        )
+        self._category_combo.addItems(self.CATEGORIES)
         info_layout.addRow("Category:", self._category_combo)
 
         # Description
         self._description_edit = QTextEdit()
-        self._description_edit.setPlaceholderText("Enter asset description...")
+        self._description_edit.setPlaceholderText(tr("Enter asset description..."))
         self._description_edit.setMaximumHeight(80)
         info_layout.addRow("Description:", self._description_edit)
 
         # Tags
         self._tags_edit = QLineEdit()
-        self._tags_edit.setPlaceholderText("Enter tags separated by commas...")
+        self._tags_edit.setPlaceholderText(tr("Comma separated, nest with / (environment/forest)"))
+        TagCompleter(self._known_tags, self._tags_edit)
         info_layout.addRow("Tags:", self._tags_edit)
 
         layout.addWidget(info_group)
 
         # Export Options Group
-        export_group = QGroupBox("Export Options")
+        export_group = QGroupBox(tr("Export Options"))
         export_layout = QVBoxLayout(export_group)
 
-        self._export_selected_check = QCheckBox("Export selected objects only")
+        # File format - USD publishes a root layer plus a geometry payload
+        format_layout = QFormLayout()
+        self._format_combo = QComboBox()
+        for label, extension in self.EXPORT_FORMATS + self._plugin_formats:
+            self._format_combo.addItem(label, extension)
+        format_layout.addRow("Format:", self._format_combo)
+
+        # Viewport stand-in, swapped for the full asset at render time
+        self._proxy_combo = QComboBox()
+        self._proxy_combo.addItem("None", None)
+        for kind in VIEWPORT_PROXY_KINDS:
+            self._proxy_combo.addItem(PROXY_LABELS[kind], kind)
+        self._proxy_combo.setToolTip(
+            tr("Publish a lightweight proxy to import for viewport speed (Maya scenes only)")
+        )
+        format_layout.addRow("Proxy:", self._proxy_combo)
+
+        # Arnold standin, alongside the proxy, for render-heavy assets
+        self._standin_combo = QComboBox()
+        self._standin_combo.addItem("None", None)
+        for standin_format in STANDIN_FORMATS:
+            self._standin_combo.addItem(STANDIN_FORMAT_LABELS[standin_format], standin_format)
+        self._standin_combo.setToolTip(
+            tr(
+                "Publish an Arnold standin that loads the geometry only at render time (needs "
+                "mtoa)"
+            )
+        )
+        format_layout.addRow("Render standin:", self._standin_combo)
+        export_layout.addLayout(format_layout)
+
+        self._export_selected_check = QCheckBox(tr("Export selected objects only"))
         self._export_selected_check.setChecked(True)
         export_layout.addWidget(self._export_selected_check)
 
-        self._generate_thumbnail_check = QCheckBox("Generate thumbnail")
+        self._generate_thumbnail_check = QCheckBox(tr("Generate thumbnail"))
         self._generate_thumbnail_check.setChecked(True)
         export_layout.addWidget(self._generate_thumbnail_check)
 
-        self._include_materials_check = QCheckBox("Include materials")
+        # Thumbnail camera - kept with the asset for its next publish and re-renders
+        thumbnail_layout = QFormLayout()
+        self._thumbnail_camera_combo = QComboBox()
+        self._thumbnail_camera_combo.addItem("Asset type default", "")
+        for camera in CAMERA_PRESETS:
+            if camera != CAMERA_CUSTOM:
+                self._thumbnail_camera_combo.addItem(CAMERA_LABELS[camera], camera)
+        for camera in self._cameras:
+            self._thumbnail_camera_combo.addItem(
+                f"Scene camera: {camera}", f"{SCENE_CAMERA_PREFIX}{camera}"
+            )
+        self._thumbnail_camera_combo.setToolTip(
+            tr("Camera the library thumbnail renders through (scene cameras as placed now)")
+        )
+        thumbnail_layout.addRow("Thumbnail camera:", self._thumbnail_camera_combo)
+        export_layout.addLayout(thumbnail_layout)
+
+        self._include_materials_check = QCheckBox(tr("Include materials"))
         self._include_materials_check.setChecked(True)
         export_layout.addWidget(self._include_materials_check)
 
+        # Portability - copy textures/caches next to the asset and repath the export
+        self._collect_dependencies_check = QCheckBox(
+            tr("Collect dependencies (textures, caches) into the asset folder")
+        )
+        self._collect_dependencies_check.setChecked(True)
+        export_layout.addWidget(self._collect_dependencies_check)
+
+        self._create_package_check = QCheckBox(tr("Also create a zip package"))
+        self._create_package_check.setChecked(False)
+        export_layout.addWidget(self._create_package_check)
+
+        # Publish target - engine FBX imported into the artist's Unreal project
+        self._send_to_unreal_check = QCheckBox(tr("Send to Unreal Engine"))
+        self._send_to_unreal_check.setToolTip(
+            tr("Export an Unreal FBX into the project set in Unreal Settings and import it")
+        )
+        export_layout.addWidget(self._send_to_unreal_check)
+
         layout.addWidget(export_group)
 
+        # Alembic cache settings - stored with the cache as library metadata
+        self._alembic_group = QGroupBox(tr("Alembic Cache"))
+        alembic_layout = QFormLayout(self._alembic_group)
+
+        self._start_frame_spin = self._create_frame_spin(self._frame_range[0])
+        alembic_layout.addRow("Start frame:", self._start_frame_spin)
+
+        self._end_frame_spin = self._create_frame_spin(self._frame_range[1])
+        alembic_layout.addRow("End frame:", self._end_frame_spin)
+
+        self._step_spin = QDoubleSpinBox()
+        self._step_spin.setRange(0.05, 100.0)
+        self._step_spin.setSingleStep(0.25)
+        self._step_spin.setValue(1.0)
+        self._step_spin.setToolTip(tr("Frames between samples - 0.5 writes two samples per frame"))
+        alembic_layout.addRow("Sampling step:", self._step_spin)
+
+        self._world_space_check = QCheckBox(tr("World space"))
+        self._world_space_check.setChecked(True)
+        self._world_space_check.setToolTip(tr("Bake parent transforms into the cached geometry"))
+        alembic_layout.addRow("", self._world_space_check)
+
+        self._alembic_group.setVisible(False)
+        layout.addWidget(self._alembic_group)
+
+        # Static thumbnails say little about rigs and animation - offer a playblast movie
+        if self._playblast_defaults is not None:
+            self._playblast_group = PlayblastOptionsGroup(
+                self._playblast_defaults, self._cameras, checked=False
+            )
+            layout.addWidget(self._playblast_group)
+
         # Spacer
         spacer = QSpacerItem(20, 20, QSizePolicy.Policy.Minimum, QSizePolicy.Policy.Expanding)
         layout.addItem(spacer)
@@ -111,10 +276,10 @@ class CreateAssetDialog(QDialog):
         # Buttons
         button_layout = QHBoxLayout()
 
-        self._create_button = QPushButton("Create Asset")
+        self._create_button = QPushButton(tr("Create Asset"))
         self._create_button.setDefault(True)
 
-        self._cancel_button = QPushButton("Cancel")
+        self._cancel_button = QPushButton(tr("Cancel"))
 
         button_layout.addStretch()
         button_layout.addWidget(self._create_button)
@@ -122,33 +287,118 @@ class CreateAssetDialog(QDialog):
 
         layout.addLayout(button_layout)
 
+    def _create_frame_spin(self, value: float) -> QDoubleSpinBox:
+        """Create a frame number field"""
+        spin = QDoubleSpinBox()
+        spin.setRange(-100000.0, 100000.0)
+        spin.setDecimals(1)
+        spin.setValue(value)
+        return spin
+
     def _setup_connections(self) -> None:
         """Setup signal connections"""
         self._create_button.clicked.connect(self._on_create_clicked)
         self._cancel_button.clicked.connect(self.reject)
         self._name_edit.textChanged.connect(self._validate_input)
+        self._collect_dependencies_check.toggled.connect(self._create_package_check.setEnabled)
+        self._generate_thumbnail_check.toggled.connect(self._thumbnail_camera_combo.setEnabled)
+        self._format_combo.currentIndexChanged.connect(self._on_format_changed)
+        self._category_combo.currentTextChanged.connect(self._on_category_changed)
 
         # Initial validation
         self._validate_input()
 
+    def _apply_defaults(self, defaults: Dict[str, Any]) -> None:
+        """Fill in remembered or template values; options not given keep their defaults"""
+        self._name_edit.setText(defaults.get("name") or "")
+        category_index = self._category_combo.findText(defaults.get("category") or "")
+        if category_index >= 0:
+            self._category_combo.setCurrentIndex(category_index)  # Sets category defaults
+        self._description_edit.setPlainText(defaults.get("description") or "")
+        self._tags_edit.setText(", ".join(defaults.get("tags") or []))
+        format_index = self._format_combo.findData(defaults.get("format"))
+        if format_index >= 0:
+            self._format_combo.setCurrentIndex(format_index)
+
+        checks = {
+            "export_selected": self._export_selected_check,
+            "generate_thumbnail": self._generate_thumbnail_check,
+            "include_materials": self._include_materials_check,
+            "collect_dependencies": self._collect_dependencies_check,
+            "create_package": self._create_package_check,
+            "send_to_unreal": self._send_to_unreal_check,
+        }
+        for key, check in checks.items():
+            if defaults.get(key) is not None:
+                check.setChecked(bool(defaults[key]))
+        for key, combo in [
+            ("proxy", self._proxy_combo),
+            ("standin", self._standin_combo),
+            ("thumbnail_camera", self._thumbnail_camera_combo),
+        ]:
+            index = combo.findData(defaults[key]) if key in defaults else -1
+            if index >= 0:
+                combo.setCurrentIndex(index)
+        alembic = defaults.get("alembic") or {}
+        if "step" in alembic:
+            self._step_spin.setValue(float(alembic["step"]))
+        if "world_space" in alembic:
+            self._world_space_check.setChecked(bool(alembic["world_space"]))
+
     def _validate_input(self) -> None:
         """Validate user input and enable/disable create button"""
         name = self._name_edit.text().strip()
         self._create_button.setEnabled(bool(name))
 
+    def _writes_own_files(self) -> bool:
+        """Check if the format's exporter decides what is written (caches, studio types)"""
+        extension = self._format_combo.currentData()
+        return extension == ".abc" or extension in self._plugin_extensions
+
+    def _on_format_changed(self) -> None:
+        """Show cache settings for Alembic; caches and studio types write their own files"""
+        is_alembic = self._format_combo.currentData() == ".abc"
+        own_files = self._writes_own_files()
+        self._alembic_group.setVisible(is_alembic)
+        self._include_materials_check.setEnabled(not own_files)
+        self._collect_dependencies_check.setEnabled(not own_files)
+        self._create_package_check.setEnabled(
+            not own_files and self._collect_dependencies_check.isChecked()
+        )
+        self._proxy_combo.setEnabled(self._format_combo.currentData() in self.PROXY_FORMATS)
+        self._standin_combo.setEnabled(self._format_combo.currentData() in self.PROXY_FORMATS)
+
+    def _on_category_changed(self, category: str) -> None:
+        """Capture a playblast for rigs and animation, and a proxy for environments"""
+        if self._playblast_group is not None and self._playblast_group.isEnabled():
+            self._playblast_group.setChecked(category in self.PLAYBLAST_CATEGORIES)
+        proxy_index = self._proxy_combo.findData(PROXY_GPU_CACHE)
+        self._proxy_combo.setCurrentIndex(proxy_index if category in self.PROXY_CATEGORIES else 0)
+
     def _on_create_clicked(self) -> None:
         """Handle create button click"""
         name = self._name_edit.text().strip()
 
         if not name:
-            QMessageBox.warning(self, "Validation Error", "Asset name is required.")
+            QMessageBox.warning(self, tr("Validation Error"), tr("Asset name is required."))
+            return
+
+        is_alembic = self._format_combo.currentData() == ".abc"
+        if is_alembic and self._end_frame_spin.value() < self._start_frame_spin.value():
+            QMessageBox.warning(
+                self,
+                tr("Validation Error"),
+                tr("The end frame must not be before the start frame."),
+            )
+            return
+        playblast_error = self._playblast_group.validate() if self._playblast_group else None
+        if playblast_error:
+            QMessageBox.warning(self, tr("Validation Error"), playblast_error)
             return
 
         # Prepare asset data
-        tags = []
-        tags_text = self._tags_edit.text().strip()
-        if tags_text:
-            tags = [tag.strip() for tag in tags_text.split(",") if tag.strip()]
+        tags = parse_tags(self._tags_edit.text())
+        own_files = self._writes_own_files()
 
         self._asset_data = {
             "name": name,
@@ -158,7 +408,35 @@ class CreateAssetDialog(QDialog):
             "export_selected": self._export_selected_check.isChecked(),
             "generate_thumbnail": self._generate_thumbnail_check.isChecked(),
             "include_materials": self._include_materials_check.isChecked(),
+            "format": self._format_combo.currentData(),
+            "collect_dependencies": (
+                not own_files and self._collect_dependencies_check.isChecked()
+            ),
+            "create_package": (
+                not own_files
+                and self._collect_dependencies_check.isChecked()
+                and self._create_package_check.isChecked()
+            ),
         }
+        if self._send_to_unreal_check.isChecked():
+            self._asset_data["send_to_unreal"] = True
+        if self._thumbnail_camera_combo.isEnabled() and self._thumbnail_camera_combo.currentData():
+            self._asset_data["thumbnail_camera"] = self._thumbnail_camera_combo.currentData()
+        if self._proxy_combo.isEnabled() and self._proxy_combo.currentData():
+            self._asset_data["proxy"] = self._proxy_combo.currentData()
+        if self._standin_combo.isEnabled() and self._standin_combo.currentData():
+            self._asset_data["standin"] = self._standin_combo.currentData()
+        if is_alembic:
+            self._asset_data["alembic"] = {
+                "start_frame": self._start_frame_spin.value(),
+                "end_frame": self._end_frame_spin.value(),
+                "step": self._step_spin.value(),
+                "world_space": self._world_space_check.isChecked(),
+            }
+
+        playblast = self._playblast_group.get_settings() if self._playblast_group else None
+        if playblast is not None:
+            self._asset_data["playblast"] = playblast.to_dict()
 
         self.accept()
 
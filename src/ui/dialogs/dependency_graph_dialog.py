@@ -0,0 +1,212 @@
+# -*- coding: utf-8 -*-
+"""
+Dependency Graph Dialog
+Graph of what an asset uses and of every asset and scene using it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+
+Users are laid out to the left of the asset and what it uses to the right, one
+column per step away::
+
+    shot010.ma  ->  town.assembly  ->  [ crate.ma ]  ->  wood.png
+"""
+
+from pathlib import Path
+from typing import Dict, List, Tuple
+
+from PySide6.QtCore import Qt, QPointF, QTimer
+from PySide6.QtGui import QBrush, QColor, QPainter, QPen
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QGraphicsScene,
+    QGraphicsView,
+    QGraphicsRectItem,
+    QGraphicsSimpleTextItem,
+    QSplitter,
+    QTreeWidget,
+    QTreeWidgetItem,
+)
+
+from ..theme import UITheme
+from ...core.models.dependency_graph import NODE_ASSET, NODE_FILE, NODE_SCENE, DependencyGraph
+from ...services.localization_service_impl import tr
+
+NODE_WIDTH = 170
+NODE_HEIGHT = 34
+COLUMN_SPACING = 240
+ROW_SPACING = 48
+
+NODE_COLORS = {
+    NODE_ASSET: "#2f5f8a",
+    NODE_SCENE: "#6a4f8a",
+    NODE_FILE: "#4f6a4f",
+}
+
+
+class GraphNodeItem(QGraphicsRectItem):
+    """One file of the graph - double-click focuses the graph on it"""
+
+    def __init__(self, path: Path, kind: str, focused: bool, on_activated):
+        super().__init__(0, 0, NODE_WIDTH, NODE_HEIGHT)
+        self.path = path
+        self._on_activated = on_activated
+        self.setBrush(QBrush(QColor(NODE_COLORS.get(kind, NODE_COLORS[NODE_FILE]))))
+        self.setPen(QPen(QColor(UITheme.TEXT_ACCENT if focused else UITheme.BORDER_LIGHT), 2))
+        self.setToolTip(f"{path}\n({kind})")
+
+        label = QGraphicsSimpleTextItem(self._elide(path.name), self)
+        label.setBrush(QBrush(QColor(UITheme.TEXT_PRIMARY)))
+        bounds = label.boundingRect()
+        label.setPos((NODE_WIDTH - bounds.width()) / 2, (NODE_HEIGHT - bounds.height()) / 2)
+
+    @staticmethod
+    def _elide(name: str) -> str:
+        return name if len(name) <= 24 else name[:21] + "..."
+
+    def mouseDoubleClickEvent(self, event) -> None:
+        super().mouseDoubleClickEvent(event)
+        self._on_activated(self.path)
+
+
+class DependencyGraphDialog(QDialog):
+    """
+    Dependency Graph Dialog - Single Responsibility for showing an asset's blast radius
+    The graph is scanned by the caller; the dialog only lays it out
+    """
+
+    def __init__(self, graph: DependencyGraph, asset_path: Path, parent=None):
+        super().__init__(parent)
+
+        self._graph = graph
+        self._asset_path = Path(asset_path).resolve()
+        self._focused_path = self._asset_path
+
+        self._setup_ui()
+        self._show_graph()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Dependency Graph"))
+        self.setMinimumSize(760, 480)
+        self.resize(1000, 600)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        self._title_label = QLabel()
+        self._title_label.setProperty("title", True)
+        main_layout.addWidget(self._title_label)
+
+        self._desc_label = QLabel()
+        self._desc_label.setWordWrap(True)
+        self._desc_label.setProperty("description", True)
+        main_layout.addWidget(self._desc_label)
+
+        splitter = QSplitter(Qt.Orientation.Horizontal)
+
+        self._scene = QGraphicsScene(self)
+        self._view = QGraphicsView(self._scene)
+        self._view.setRenderHint(QPainter.RenderHint.Antialiasing)
+        self._view.setDragMode(QGraphicsView.DragMode.ScrollHandDrag)
+        self._view.setBackgroundBrush(QBrush(QColor(UITheme.DARK_BG)))
+        splitter.addWidget(self._view)
+
+        self._affected_tree = QTreeWidget()
+        self._affected_tree.setHeaderLabels(["Affected by a Change"])
+        splitter.addWidget(self._affected_tree)
+        splitter.setSizes([720, 260])
+        main_layout.addWidget(splitter, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        back_btn = QPushButton(tr("Show Selected Asset"))
+        back_btn.setToolTip(tr("Focus the graph on the asset it was opened for again"))
+        back_btn.clicked.connect(lambda: self._focus(self._asset_path))
+        button_layout.addWidget(back_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.setProperty("accent", True)
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _focus(self, path: Path) -> None:
+        """Lay the graph out around another node once the click that asked is handled"""
+        self._focused_path = Path(path)
+        QTimer.singleShot(0, self._show_graph)
+
+    def _show_graph(self) -> None:
+        """Lay out the focused node's users and dependencies in columns"""
+        focused = self._focused_path
+        self._title_label.setText(f"Dependencies of {focused.name}")
+        self._desc_label.setText(
+            f"{self._graph.describe_affected(focused)}. Assets and scenes using it are on "
+            "the left, what it uses on the right. Double-click a file to focus on it."
+        )
+
+        upstream = self._graph.get_upstream(focused)
+        downstream = self._graph.get_downstream(focused)
+        columns: Dict[int, List[Path]] = {0: [focused]}
+        for depth, edge in upstream:
+            columns.setdefault(depth, []).append(edge.target)
+        for depth, edge in downstream:
+            columns.setdefault(-depth, []).append(edge.source)
+
+        self._scene.clear()
+        positions: Dict[Path, QPointF] = {}
+        for column, paths in columns.items():
+            top = -(len(paths) - 1) * ROW_SPACING / 2
+            for row, path in enumerate(paths):
+                item = GraphNodeItem(
+                    path, self._graph.get_kind(path), path == focused, self._focus
+                )
+                item.setPos(column * COLUMN_SPACING, top + row * ROW_SPACING)
+                item.setZValue(1)
+                self._scene.addItem(item)
+                positions[path] = item.pos()
+
+        pen = QPen(QColor(UITheme.TEXT_SECONDARY), 1.5)
+        for _depth, edge in upstream + downstream:
+            self._add_edge(positions, edge.source, edge.target, edge.kind, pen)
+        self._scene.setSceneRect(self._scene.itemsBoundingRect().adjusted(-40, -40, 40, 40))
+        self._view.fitInView(self._scene.sceneRect(), Qt.AspectRatioMode.KeepAspectRatio)
+
+        self._show_affected(focused)
+
+    def _add_edge(
+        self, positions: Dict[Path, QPointF], source: Path, target: Path, kind: str, pen: QPen
+    ) -> None:
+        """Draw a line from a user's right edge to the used file's left edge"""
+        if source not in positions or target not in positions:
+            return
+        start = positions[source] + QPointF(NODE_WIDTH, NODE_HEIGHT / 2)
+        end = positions[target] + QPointF(0, NODE_HEIGHT / 2)
+        line = self._scene.addLine(start.x(), start.y(), end.x(), end.y(), pen)
+        line.setToolTip(f"{source.name} uses {target.name} ({kind})")
+
+    def _show_affected(self, focused: Path) -> None:
+        """List the library assets and project scenes a change of the node reaches"""
+        self._affected_tree.clear()
+        assets, scenes = self._graph.get_affected(focused)
+        groups: List[Tuple[str, List[Path]]] = [("Library Assets", assets), ("Scenes", scenes)]
+        for label, paths in groups:
+            group = QTreeWidgetItem([f"{label} ({len(paths)})"])
+            for path in paths:
+                child = QTreeWidgetItem([path.name])
+                child.setToolTip(0, str(path))
+                group.addChild(child)
+            self._affected_tree.addTopLevelItem(group)
+            group.setExpanded(True)
+
+    def resizeEvent(self, event) -> None:
+        super().resizeEvent(event)
+        self._view.fitInView(self._scene.sceneRect(), Qt.AspectRatioMode.KeepAspectRatio)
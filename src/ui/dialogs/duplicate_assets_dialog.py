@@ -0,0 +1,255 @@
+# -*- coding: utf-8 -*-
+"""
+Duplicate Assets Dialog
+Review duplicate library assets and redirect or merge them into a canonical asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QDoubleSpinBox,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QHeaderView,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtGui import QFont
+
+from ..theme import UITheme
+from ...core.models.duplicate_group import DuplicateGroup
+from ...services.duplicate_service_impl import DEFAULT_SIMILARITY_THRESHOLD
+from ...services.localization_service_impl import tr
+
+
+class DuplicateAssetsDialog(QDialog):
+    """
+    Duplicate Assets Dialog - Single Responsibility for duplicate review
+    Scenes on disk are repathed here; the open scene and library are left to the owner
+    """
+
+    # Emitted with (duplicate, canonical) after scenes on disk were repathed
+    duplicate_redirected = Signal(object, object)
+    # Emitted with (duplicate, canonical) when the duplicate should leave the library
+    duplicate_merged = Signal(object, object)
+
+    COLUMNS = ["Asset", "Match", "Referenced By"]
+
+    def __init__(
+        self, duplicate_service, library_root: Path, search_roots: List[Path], parent=None
+    ):
+        super().__init__(parent)
+
+        self._service = duplicate_service
+        self._library_root = Path(library_root)
+        self._search_roots = [Path(root) for root in search_roots]
+        self._groups: List[DuplicateGroup] = []
+
+        self._setup_ui()
+        self._scan()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Duplicate Assets"))
+        self.setMinimumSize(680, 420)
+        self.resize(820, 520)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Duplicate Assets"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Assets with identical files or nearly identical geometry. Redirect repaths "
+                "scenes referencing a duplicate to the canonical (bold) asset; Merge also moves "
+                "its tags and collections over and removes it from the library."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        scan_layout = QHBoxLayout()
+        scan_layout.addWidget(QLabel(tr("Minimum similarity:")))
+        self._threshold_spin = QDoubleSpinBox()
+        self._threshold_spin.setRange(50.0, 100.0)
+        self._threshold_spin.setSingleStep(1.0)
+        self._threshold_spin.setDecimals(0)
+        self._threshold_spin.setSuffix(" %")
+        self._threshold_spin.setValue(DEFAULT_SIMILARITY_THRESHOLD * 100)
+        scan_layout.addWidget(self._threshold_spin)
+        scan_btn = QPushButton(tr("Scan Library"))
+        scan_btn.clicked.connect(self._scan)
+        scan_layout.addWidget(scan_btn)
+        scan_layout.addStretch()
+        main_layout.addLayout(scan_layout)
+
+        self._tree = QTreeWidget()
+        self._tree.setColumnCount(len(self.COLUMNS))
+        self._tree.setHeaderLabels(self.COLUMNS)
+        self._tree.header().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        self._tree.itemSelectionChanged.connect(self._on_selection_changed)
+        main_layout.addWidget(self._tree, 1)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        button_layout = QHBoxLayout()
+
+        self._canonical_btn = QPushButton(tr("Set as Canonical"))
+        self._canonical_btn.clicked.connect(self._on_set_canonical)
+        button_layout.addWidget(self._canonical_btn)
+
+        self._redirect_btn = QPushButton(tr("Redirect References"))
+        self._redirect_btn.clicked.connect(lambda: self._on_redirect(merge=False))
+        button_layout.addWidget(self._redirect_btn)
+
+        self._merge_btn = QPushButton(tr("Merge into Canonical"))
+        self._merge_btn.setProperty("accent", True)
+        self._merge_btn.clicked.connect(lambda: self._on_redirect(merge=True))
+        button_layout.addWidget(self._merge_btn)
+
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+        self._on_selection_changed()
+
+    def _scan(self) -> None:
+        """Find duplicate groups and list them - Single Responsibility"""
+        self._groups = self._service.find_duplicates(
+            self._library_root, self._threshold_spin.value() / 100.0
+        )
+        self._populate()
+
+    def _populate(self) -> None:
+        """Show each group with its canonical asset first"""
+        self._tree.clear()
+        bold = QFont()
+        bold.setBold(True)
+
+        for index, group in enumerate(self._groups):
+            group_item = QTreeWidgetItem([group.label, "", ""])
+            group_item.setData(0, Qt.ItemDataRole.UserRole, (index, None))
+            for path in group.files:
+                ascii_scenes, binary_scenes = self._service.find_referencing_scenes(
+                    self._search_roots, path
+                )
+                references = len(ascii_scenes) + len(binary_scenes)
+                match = "Canonical" if path == group.canonical else group.describe_similarity(path)
+                item = QTreeWidgetItem(
+                    [self._get_display_path(path), match, f"{references} scene(s)"]
+                )
+                item.setData(0, Qt.ItemDataRole.UserRole, (index, path))
+                item.setToolTip(0, str(path))
+                if path == group.canonical:
+                    item.setFont(0, bold)
+                group_item.addChild(item)
+            self._tree.addTopLevelItem(group_item)
+            group_item.setExpanded(True)
+
+        duplicates = sum(len(group.duplicates) for group in self._groups)
+        self._summary_label.setText(
+            f"{len(self._groups)} group(s), {duplicates} duplicate asset(s)"
+            if self._groups
+            else "No duplicate assets found"
+        )
+        self._tree.resizeColumnToContents(1)
+        self._tree.resizeColumnToContents(2)
+        self._on_selection_changed()
+
+    def _get_display_path(self, path: Path) -> str:
+        """Get a library-relative path for display"""
+        try:
+            return path.relative_to(self._library_root).as_posix()
+        except ValueError:
+            return str(path)
+
+    def _get_selected(self) -> Tuple[Optional[DuplicateGroup], Optional[Path]]:
+        """Get the selected group and asset (asset is None for a group row)"""
+        items = self._tree.selectedItems()
+        if not items:
+            return None, None
+        index, path = items[0].data(0, Qt.ItemDataRole.UserRole)
+        return self._groups[index], path
+
+    def _on_selection_changed(self) -> None:
+        """Enable the actions the selected asset allows"""
+        group, path = self._get_selected()
+        is_duplicate = group is not None and path is not None and path != group.canonical
+        self._canonical_btn.setEnabled(is_duplicate)
+        self._redirect_btn.setEnabled(is_duplicate)
+        self._merge_btn.setEnabled(is_duplicate)
+
+    def _on_set_canonical(self) -> None:
+        """Make the selected asset the one its group is merged into"""
+        group, path = self._get_selected()
+        if group is None or path is None:
+            return
+        self._groups[self._groups.index(group)] = group.with_canonical(path)
+        self._populate()
+
+    def _on_redirect(self, merge: bool) -> None:
+        """Repath scenes from the selected duplicate to its canonical asset"""
+        group, duplicate = self._get_selected()
+        if group is None or duplicate is None or duplicate == group.canonical:
+            return
+        canonical = group.canonical
+
+        action = "Merge" if merge else "Redirect"
+        message = (
+            f"Repath every scene referencing {duplicate.name} to {canonical.name}?"
+            if not merge
+            else f"Repath every scene referencing {duplicate.name} to {canonical.name}, move "
+            f"its tags and collections over, and remove {duplicate.name} from the library?"
+        )
+        reply = QMessageBox.question(
+            self,
+            f"{action} Duplicate",
+            message,
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        if reply != QMessageBox.StandardButton.Yes:
+            return
+
+        repathed, skipped = self._service.redirect(self._search_roots, duplicate, canonical)
+        self.duplicate_redirected.emit(duplicate, canonical)
+        if merge:
+            if skipped:
+                QMessageBox.warning(
+                    self,
+                    tr("Merge Stopped"),
+                    f"{duplicate.name} is kept because these scenes still reference it and "
+                    "could not be repathed (binary or read-only):\n"
+                    + "\n".join(str(scene) for scene in skipped),
+                )
+            else:
+                self._service.merge_metadata(self._library_root, duplicate, canonical)
+                self.duplicate_merged.emit(duplicate, canonical)
+
+        summary = f"Repathed {len(repathed)} scene(s) to {canonical.name}."
+        if skipped and not merge:
+            summary += "\n\nNot repathed (binary or read-only):\n" + "\n".join(
+                str(scene) for scene in skipped
+            )
+        QMessageBox.information(self, f"{action} Complete", summary)
+        self._scan()
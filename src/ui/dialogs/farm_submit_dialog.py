@@ -0,0 +1,164 @@
+# -*- coding: utf-8 -*-
+"""
+Farm Submit Dialog
+Options for sending turntable renders and USD conversions of assets to a render farm
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QCheckBox,
+    QComboBox,
+    QLineEdit,
+    QSpinBox,
+    QPushButton,
+)
+from PySide6.QtCore import QSettings
+
+from ..theme import UITheme
+from ...core.models.farm_job import FARM_STEP_TURNTABLE, FARM_STEP_USD
+from ...services.localization_service_impl import tr
+from ...services.render_farm_service_impl import FARM_LABELS
+
+
+class FarmSubmitDialog(QDialog):
+    """
+    Farm Submit Dialog - Single Responsibility for farm submission options
+    Farm, pool, and priority are remembered for the next submission and for publishes
+    """
+
+    # (combo label, turntable extension)
+    TURNTABLE_FORMATS = [("Movie (.mp4)", ".mp4"), ("Animated GIF (.gif)", ".gif")]
+
+    def __init__(self, asset_count: int, convertible_count: int, farms: List[str], parent=None):
+        """
+        Args:
+            asset_count: Assets being submitted
+            convertible_count: How many of them are Maya scenes that can be converted to USD
+            farms: Farms whose submission client is installed
+        """
+        super().__init__(parent)
+
+        self._asset_count = asset_count
+        self._convertible_count = convertible_count
+        self._farms = farms
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Submit to Render Farm"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Submit {count} asset(s)", count=self._asset_count))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Each step runs as a farm job. The assets show as processing until the farm "
+                "writes the results back to the library."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._farm_combo = QComboBox()
+        for name in self._farms:
+            self._farm_combo.addItem(FARM_LABELS.get(name, name), name)
+        last_farm = self._farm_combo.findData(str(self._settings.value("renderFarm", "")))
+        self._farm_combo.setCurrentIndex(max(last_farm, 0))
+        form_layout.addRow("Farm:", self._farm_combo)
+
+        self._pool_edit = QLineEdit(str(self._settings.value("renderFarmPool", "")))
+        self._pool_edit.setPlaceholderText(tr("Default pool"))
+        form_layout.addRow("Pool:", self._pool_edit)
+
+        self._priority_spin = QSpinBox()
+        self._priority_spin.setRange(0, 100)
+        self._priority_spin.setValue(int(self._settings.value("renderFarmPriority", 50)))
+        form_layout.addRow("Priority:", self._priority_spin)
+
+        self._turntable_check = QCheckBox(tr("Render 360° turntable preview"))
+        self._turntable_check.setChecked(True)
+        form_layout.addRow("", self._turntable_check)
+
+        self._format_combo = QComboBox()
+        for label, extension in self.TURNTABLE_FORMATS:
+            self._format_combo.addItem(label, extension)
+        form_layout.addRow("Turntable format:", self._format_combo)
+
+        self._frames_spin = QSpinBox()
+        self._frames_spin.setRange(8, 360)
+        self._frames_spin.setValue(36)
+        form_layout.addRow("Turntable frames:", self._frames_spin)
+
+        self._turntable_check.toggled.connect(self._format_combo.setEnabled)
+        self._turntable_check.toggled.connect(self._frames_spin.setEnabled)
+
+        self._usd_check = QCheckBox(
+            tr("Convert to USD ({count} Maya scene(s))", count=self._convertible_count)
+        )
+        self._usd_check.setEnabled(self._convertible_count > 0)
+        form_layout.addRow("", self._usd_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        submit_btn = QPushButton(tr("Submit"))
+        submit_btn.setProperty("accent", True)
+        submit_btn.setDefault(True)
+        submit_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(submit_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Remember farm, pool, and priority, then close"""
+        if not self.get_steps():
+            return  # Nothing to submit
+        self._settings.setValue("renderFarm", self._farm_combo.currentData())
+        self._settings.setValue("renderFarmPool", self._pool_edit.text().strip())
+        self._settings.setValue("renderFarmPriority", self._priority_spin.value())
+        self.accept()
+
+    def get_steps(self) -> List[str]:
+        """Get the chosen steps"""
+        steps = []
+        if self._turntable_check.isChecked():
+            steps.append(FARM_STEP_TURNTABLE)
+        if self._usd_check.isChecked() and self._usd_check.isEnabled():
+            steps.append(FARM_STEP_USD)
+        return steps
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get options matching RenderFarmService.submit keyword arguments"""
+        return {
+            "farm_name": self._farm_combo.currentData(),
+            "pool": self._pool_edit.text().strip(),
+            "priority": self._priority_spin.value(),
+            "turntable_format": self._format_combo.currentData(),
+            "frames": self._frames_spin.value(),
+        }
@@ -0,0 +1,228 @@
+# -*- coding: utf-8 -*-
+"""
+FBX Presets Dialog
+Edit the library's FBX export presets and choose which asset types publish an FBX
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QGroupBox,
+    QLabel,
+    QListWidget,
+    QComboBox,
+    QCheckBox,
+    QPushButton,
+    QInputDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from .create_asset_dialog import CreateAssetDialog
+from ...core.models.fbx_preset import UP_AXES, UNITS, FbxExportPreset
+from ...services.localization_service_impl import tr
+
+NO_PRESET = "(no FBX)"
+
+
+class FbxPresetsDialog(QDialog):
+    """
+    FBX Presets Dialog - Single Responsibility for library FBX preset configuration
+    """
+
+    def __init__(self, fbx_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = fbx_service
+        self._library_root = Path(library_root)
+        self._presets: Dict[str, FbxExportPreset]
+        self._presets, self._asset_types = fbx_service.load_presets(self._library_root)
+        self._current_name = ""
+
+        self._setup_ui()
+        self._refresh_preset_list()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("FBX Export Presets"))
+        self.setMinimumSize(560, 480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("FBX Export Presets"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "Publishing an asset of an assigned type also writes an .fbx next to the Maya "
+            f"file. Presets are shared by everyone using this library:\n"
+            f"{self._service.get_presets_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        presets_layout = QHBoxLayout()
+
+        list_layout = QVBoxLayout()
+        self._preset_list = QListWidget()
+        self._preset_list.currentTextChanged.connect(self._on_preset_selected)
+        list_layout.addWidget(self._preset_list)
+
+        list_buttons = QHBoxLayout()
+        add_btn = QPushButton(tr("Add..."))
+        add_btn.clicked.connect(self._on_add_preset)
+        list_buttons.addWidget(add_btn)
+        remove_btn = QPushButton(tr("Remove"))
+        remove_btn.clicked.connect(self._on_remove_preset)
+        list_buttons.addWidget(remove_btn)
+        list_layout.addLayout(list_buttons)
+        presets_layout.addLayout(list_layout)
+
+        settings_group = QGroupBox(tr("Preset Settings"))
+        settings_layout = QFormLayout(settings_group)
+
+        self._up_axis_combo = QComboBox()
+        for axis in UP_AXES:
+            self._up_axis_combo.addItem(f"{axis.upper()} up", axis)
+        settings_layout.addRow("Axis conversion:", self._up_axis_combo)
+
+        self._units_combo = QComboBox()
+        self._units_combo.addItems(list(UNITS))
+        settings_layout.addRow("Units:", self._units_combo)
+
+        self._smoothing_check = QCheckBox(tr("Smoothing groups"))
+        settings_layout.addRow("", self._smoothing_check)
+        self._triangulate_check = QCheckBox(tr("Triangulate"))
+        settings_layout.addRow("", self._triangulate_check)
+        self._embed_media_check = QCheckBox(tr("Embed media (textures)"))
+        settings_layout.addRow("", self._embed_media_check)
+        self._bake_check = QCheckBox(tr("Bake animation (playback range)"))
+        settings_layout.addRow("", self._bake_check)
+
+        presets_layout.addWidget(settings_group, 1)
+        main_layout.addLayout(presets_layout, 1)
+
+        types_group = QGroupBox(tr("Asset Types"))
+        types_layout = QFormLayout(types_group)
+        self._type_combos: Dict[str, QComboBox] = {}
+        for asset_type in CreateAssetDialog.CATEGORIES:
+            combo = QComboBox()
+            self._type_combos[asset_type] = combo
+            types_layout.addRow(f"{asset_type}:", combo)
+        main_layout.addWidget(types_group)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh_preset_list(self) -> None:
+        """Show presets and offer them for every asset type"""
+        self._current_name = ""
+        self._preset_list.clear()
+        self._preset_list.addItems(sorted(self._presets))
+
+        for asset_type, combo in self._type_combos.items():
+            combo.clear()
+            combo.addItem(NO_PRESET, "")
+            for name in sorted(self._presets):
+                combo.addItem(name, name)
+            index = combo.findData(self._asset_types.get(asset_type, ""))
+            combo.setCurrentIndex(max(index, 0))
+
+        if self._preset_list.count():
+            self._preset_list.setCurrentRow(0)
+
+    def _on_preset_selected(self, name: str) -> None:
+        """Keep edits of the previous preset and show the selected one"""
+        self._store_current_preset()
+        self._current_name = name
+        preset = self._presets.get(name)
+        if preset is None:
+            return
+        self._up_axis_combo.setCurrentIndex(max(self._up_axis_combo.findData(preset.up_axis), 0))
+        self._units_combo.setCurrentText(preset.units)
+        self._smoothing_check.setChecked(preset.smoothing_groups)
+        self._triangulate_check.setChecked(preset.triangulate)
+        self._embed_media_check.setChecked(preset.embed_media)
+        self._bake_check.setChecked(preset.bake_animation)
+
+    def _store_current_preset(self) -> None:
+        """Write the settings fields back into the preset being edited"""
+        if self._current_name not in self._presets:
+            return
+        self._presets[self._current_name] = FbxExportPreset(
+            name=self._current_name,
+            up_axis=self._up_axis_combo.currentData(),
+            units=self._units_combo.currentText(),
+            smoothing_groups=self._smoothing_check.isChecked(),
+            triangulate=self._triangulate_check.isChecked(),
+            embed_media=self._embed_media_check.isChecked(),
+            bake_animation=self._bake_check.isChecked(),
+        )
+
+    def _store_asset_types(self) -> None:
+        """Read the asset type assignments from their combos"""
+        self._asset_types = {
+            asset_type: combo.currentData()
+            for asset_type, combo in self._type_combos.items()
+            if combo.currentData()
+        }
+
+    def _on_add_preset(self) -> None:
+        """Add a preset starting from the selected one's settings"""
+        name, ok = QInputDialog.getText(self, "Add FBX Preset", "Preset name:")
+        name = name.strip()
+        if not ok or not name:
+            return
+        if name in self._presets:
+            QMessageBox.warning(
+                self, tr("Preset Exists"), f"A preset named '{name}' already exists."
+            )
+            return
+
+        self._store_current_preset()
+        self._store_asset_types()
+        source = self._presets.get(self._current_name)
+        values = source.to_dict() if source else {}
+        self._presets[name] = FbxExportPreset.from_dict(dict(values, name=name))
+        self._refresh_preset_list()
+        self._preset_list.setCurrentRow(sorted(self._presets).index(name))
+
+    def _on_remove_preset(self) -> None:
+        """Remove the selected preset and its asset type assignments"""
+        if self._current_name not in self._presets:
+            return
+        self._store_asset_types()
+        del self._presets[self._current_name]
+        self._refresh_preset_list()
+
+    def _on_save_clicked(self) -> None:
+        """Store presets in the library and close"""
+        self._store_current_preset()
+        self._store_asset_types()
+        if not self._service.save_presets(self._library_root, self._presets, self._asset_types):
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the FBX presets."))
+            return
+        self.accept()
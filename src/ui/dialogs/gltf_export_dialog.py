@@ -0,0 +1,180 @@
+# -*- coding: utf-8 -*-
+"""
+glTF Export Dialog
+Edit the library's glTF review export settings and choose which asset types publish one
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QGridLayout,
+    QFormLayout,
+    QGroupBox,
+    QLabel,
+    QComboBox,
+    QCheckBox,
+    QSpinBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from .create_asset_dialog import CreateAssetDialog
+from ...core.models.gltf_export import DRACO_LEVELS, GltfExportSettings
+from ...services.gltf_export_service_impl import CONVERTER_ENV_VAR, find_converter
+from ...services.localization_service_impl import tr
+
+TEXTURE_SIZES = (512, 1024, 2048, 4096)
+
+
+class GltfExportDialog(QDialog):
+    """
+    glTF Export Dialog - Single Responsibility for library glTF export configuration
+    """
+
+    def __init__(self, gltf_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = gltf_service
+        self._library_root = Path(library_root)
+        self._settings: GltfExportSettings = gltf_service.load_settings(self._library_root)
+
+        self._setup_ui()
+        self._load_settings()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("glTF Review Export"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("glTF Review Export"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        converter = find_converter()
+        desc_label = QLabel(
+            "Publishing an asset of a checked type also writes a glTF next to the Maya file "
+            "for the web viewer and AR review app. Settings are shared by everyone using "
+            f"this library:\n{self._service.get_settings_file(self._library_root)}\n\n"
+            + (
+                f"Converter: {converter}"
+                if converter
+                else f"FBX2glTF was not found - install it on PATH or set {CONVERTER_ENV_VAR}."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        settings_group = QGroupBox(tr("Export Settings"))
+        settings_layout = QFormLayout(settings_group)
+
+        self._format_combo = QComboBox()
+        self._format_combo.addItem(tr("Binary (.glb)"), True)
+        self._format_combo.addItem(tr("JSON with embedded buffers (.gltf)"), False)
+        settings_layout.addRow(tr("Format:"), self._format_combo)
+
+        self._draco_check = QCheckBox(tr("Draco mesh compression"))
+        self._draco_check.toggled.connect(self._on_draco_toggled)
+        settings_layout.addRow("", self._draco_check)
+
+        self._draco_level_spin = QSpinBox()
+        self._draco_level_spin.setRange(DRACO_LEVELS[0], DRACO_LEVELS[-1])
+        self._draco_level_spin.setToolTip(tr("Higher levels write smaller files more slowly"))
+        settings_layout.addRow(tr("Compression level:"), self._draco_level_spin)
+
+        self._bake_check = QCheckBox(tr("Bake PBR materials from Standard Surface"))
+        self._bake_check.setToolTip(
+            tr("Rebuild base color, metalness, roughness, normal, and emission for glTF")
+        )
+        self._bake_check.toggled.connect(self._on_bake_toggled)
+        settings_layout.addRow("", self._bake_check)
+
+        self._texture_size_combo = QComboBox()
+        for size in TEXTURE_SIZES:
+            self._texture_size_combo.addItem(tr("{size} px", size=size), size)
+        settings_layout.addRow(tr("Texture size:"), self._texture_size_combo)
+
+        main_layout.addWidget(settings_group)
+
+        types_group = QGroupBox(tr("Publish glTF for Asset Types"))
+        types_layout = QGridLayout(types_group)
+        self._type_checks: Dict[str, QCheckBox] = {}
+        for index, asset_type in enumerate(CreateAssetDialog.CATEGORIES):
+            check = QCheckBox(asset_type)
+            self._type_checks[asset_type] = check
+            types_layout.addWidget(check, index // 3, index % 3)
+        main_layout.addWidget(types_group)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_settings(self) -> None:
+        """Show the library's saved settings"""
+        settings = self._settings
+        self._format_combo.setCurrentIndex(max(self._format_combo.findData(settings.binary), 0))
+        self._draco_check.setChecked(settings.draco)
+        self._draco_level_spin.setValue(settings.draco_level)
+        self._bake_check.setChecked(settings.bake_materials)
+        size_index = self._texture_size_combo.findData(settings.texture_size)
+        if size_index < 0:
+            self._texture_size_combo.addItem(
+                tr("{size} px", size=settings.texture_size), settings.texture_size
+            )
+            size_index = self._texture_size_combo.count() - 1
+        self._texture_size_combo.setCurrentIndex(size_index)
+        for asset_type, check in self._type_checks.items():
+            check.setChecked(settings.publishes(asset_type))
+        self._on_draco_toggled(settings.draco)
+        self._on_bake_toggled(settings.bake_materials)
+
+    def _on_draco_toggled(self, checked: bool) -> None:
+        """Offer the compression level only with Draco"""
+        self._draco_level_spin.setEnabled(checked)
+
+    def _on_bake_toggled(self, checked: bool) -> None:
+        """Offer the texture size only when materials are baked"""
+        self._texture_size_combo.setEnabled(checked)
+
+    def get_settings(self) -> GltfExportSettings:
+        """Get the settings as edited"""
+        return GltfExportSettings(
+            asset_types=tuple(
+                asset_type for asset_type, check in self._type_checks.items() if check.isChecked()
+            ),
+            binary=bool(self._format_combo.currentData()),
+            draco=self._draco_check.isChecked(),
+            draco_level=self._draco_level_spin.value(),
+            bake_materials=self._bake_check.isChecked(),
+            texture_size=int(self._texture_size_combo.currentData()),
+        )
+
+    def _on_save_clicked(self) -> None:
+        """Store the settings in the library and close"""
+        if not self._service.save_settings(self._library_root, self.get_settings()):
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the glTF settings."))
+            return
+        self.accept()
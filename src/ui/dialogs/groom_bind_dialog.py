@@ -0,0 +1,154 @@
+# -*- coding: utf-8 -*-
+"""
+Groom Bind Dialog
+Choose the scene mesh each growth mesh of a groom binds to when names or topology differ
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QComboBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class GroomBindDialog(QDialog):
+    """
+    Groom Bind Dialog - Single Responsibility for remapping growth meshes
+    Each row shows whether the chosen mesh follows exactly or needs a wrap
+    """
+
+    COLUMN_GROWTH = 0
+    COLUMN_TARGET = 1
+    COLUMN_STATUS = 2
+
+    def __init__(
+        self,
+        groom_service,
+        cmds: Any,
+        descriptor: Dict[str, Any],
+        targets: Dict[str, str],
+        scene_meshes: List[str],
+        parent=None,
+    ):
+        """
+        Args:
+            groom_service: GroomService checking each choice's topology
+            cmds: maya.cmds module
+            descriptor: Loaded .groom descriptor
+            targets: Suggested scene mesh per growth mesh ("" when none was found)
+            scene_meshes: Mesh transforms that can be chosen
+        """
+        super().__init__(parent)
+
+        self._service = groom_service
+        self._cmds = cmds
+        self._descriptor = descriptor
+        self._targets = targets
+        self._scene_meshes = scene_meshes
+        self._combos: Dict[str, QComboBox] = {}
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Bind Groom"))
+        self.setMinimumSize(620, 320)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Bind {name}", name=self._descriptor.get("name", "")))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Choose the scene mesh each growth mesh binds to. Meshes with the same "
+                "topology drive the groom point for point; other meshes drive it through a "
+                "proximity wrap."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        growth_meshes = [entry.get("name", "") for entry in self._descriptor["growth_meshes"]]
+        self._table = QTableWidget(len(growth_meshes), 3)
+        self._table.setHorizontalHeaderLabels(
+            [tr("Growth Mesh"), tr("Scene Mesh"), tr("Binding")]
+        )
+        self._table.verticalHeader().setVisible(False)
+        header = self._table.horizontalHeader()
+        header.setSectionResizeMode(self.COLUMN_TARGET, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(self.COLUMN_STATUS, QHeaderView.ResizeMode.Stretch)
+
+        for row, growth_mesh in enumerate(growth_meshes):
+            self._table.setItem(row, self.COLUMN_GROWTH, QTableWidgetItem(growth_mesh))
+            combo = QComboBox()
+            combo.addItem(tr("(leave unbound)"), "")
+            for mesh in self._scene_meshes:
+                combo.addItem(mesh.rsplit("|", 1)[-1], mesh)
+            index = combo.findData(self._targets.get(growth_mesh, ""))
+            combo.setCurrentIndex(max(index, 0))
+            combo.currentIndexChanged.connect(
+                lambda _index, growth_mesh=growth_mesh: self._update_status(growth_mesh)
+            )
+            self._table.setCellWidget(row, self.COLUMN_TARGET, combo)
+            self._table.setItem(row, self.COLUMN_STATUS, QTableWidgetItem())
+            self._combos[growth_mesh] = combo
+            self._update_status(growth_mesh)
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        bind_btn = QPushButton(tr("Import and Bind"))
+        bind_btn.setProperty("accent", True)
+        bind_btn.setDefault(True)
+        bind_btn.clicked.connect(self.accept)
+        button_layout.addWidget(bind_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _update_status(self, growth_mesh: str) -> None:
+        """Show how the growth mesh binds to the mesh chosen for it"""
+        row = list(self._combos).index(growth_mesh)
+        mesh = self._combos[growth_mesh].currentData()
+        status = self._table.item(row, self.COLUMN_STATUS)
+        if not mesh:
+            status.setText(tr("Not bound - stays at its published position"))
+            status.setToolTip("")
+            return
+        differences = self._service.check_target(
+            self._cmds, self._descriptor, growth_mesh, mesh
+        )
+        if differences:
+            status.setText(tr("Wrap - topology differs"))
+            status.setToolTip("\n".join(differences))
+        else:
+            status.setText(tr("Follow - same topology"))
+            status.setToolTip("")
+
+    def get_targets(self) -> Dict[str, str]:
+        """Get the chosen scene mesh per growth mesh ("" leaves it unbound)"""
+        return {growth_mesh: combo.currentData() for growth_mesh, combo in self._combos.items()}
@@ -0,0 +1,150 @@
+# -*- coding: utf-8 -*-
+"""
+Groom Publish Dialog
+Pick the XGen or Yeti grooms to publish as a groom asset and name it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...services.groom_service_impl import GROOM_NODE_TYPES, SYSTEM_LABELS
+from ...services.localization_service_impl import tr
+
+
+class GroomPublishDialog(QDialog):
+    """
+    Groom Publish Dialog - Single Responsibility for groom publish options
+    Growth meshes are found from the checked grooms when the asset is saved
+    """
+
+    def __init__(self, grooms: List[Tuple[str, str]], selected: List[str], parent=None):
+        """
+        Args:
+            grooms: (node, node type) pairs of the scene's grooms
+            selected: Grooms checked at first (the Maya selection)
+        """
+        super().__init__(parent)
+
+        self._grooms = grooms
+        self._selected = selected
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Groom"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Groom"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The checked grooms, the meshes they grow from, their collection files, and "
+                "their maps are copied into the library. Importing binds them to a scene mesh."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._groom_list = QListWidget()
+        self._groom_list.setMaximumHeight(140)
+        for node, node_type in self._grooms:
+            system = SYSTEM_LABELS[GROOM_NODE_TYPES[node_type]]
+            item = QListWidgetItem(f"{node.rsplit('|', 1)[-1]} ({system} {node_type})")
+            item.setData(Qt.UserRole, (node, node_type))  # type: ignore
+            checked = not self._selected or node in self._selected
+            item.setCheckState(Qt.Checked if checked else Qt.Unchecked)  # type: ignore
+            self._groom_list.addItem(item)
+        form_layout.addRow("Grooms:", self._groom_list)
+
+        default_name = self._selected[0] if self._selected else "groom"
+        self._name_edit = QLineEdit(default_name.rsplit("|", 1)[-1].rsplit(":", 1)[-1])
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._thumbnail_check = QCheckBox(tr("Capture viewport thumbnail"))
+        self._thumbnail_check.setChecked(True)
+        form_layout.addRow("", self._thumbnail_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish Groom"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_checked_grooms(self) -> List[Tuple[str, str]]:
+        """Get the (node, node type) pairs left checked"""
+        grooms = []
+        for row in range(self._groom_list.count()):
+            item = self._groom_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                grooms.append(item.data(Qt.UserRole))  # type: ignore
+        return grooms
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        grooms = self._get_checked_grooms()
+        if not grooms:
+            QMessageBox.warning(self, tr("No Grooms"), tr("Check at least one groom to publish."))
+            return
+        if len({GROOM_NODE_TYPES[node_type] for _node, node_type in grooms}) > 1:
+            QMessageBox.warning(
+                self,
+                tr("Mixed Grooms"),
+                tr("A groom asset holds XGen or Yeti grooms, not both. Publish them separately."),
+            )
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, notes, grooms, capture_thumbnail)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "grooms": self._get_checked_grooms(),
+            "capture_thumbnail": self._thumbnail_check.isChecked(),
+        }
@@ -0,0 +1,183 @@
+# -*- coding: utf-8 -*-
+"""
+Hotkey Editor Dialog
+Change the shortcuts of manager commands and add them to Maya's Hotkey Editor
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QKeySequenceEdit,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtGui import QKeySequence
+
+from ..theme import UITheme
+from ...services.hotkey_service_impl import MARKING_MENU_PRESS, MARKING_MENU_RELEASE
+from ...services.localization_service_impl import tr
+
+
+class HotkeyEditorDialog(QDialog):
+    """
+    Hotkey Editor Dialog - Single Responsibility for editing command shortcuts
+    Shortcuts apply inside the window; Maya's hotkeys run the same commands anywhere
+    """
+
+    COLUMN_COMMAND = 0
+    COLUMN_SHORTCUT = 1
+    COLUMN_RUNTIME = 2
+
+    def __init__(self, hotkey_service, reserved: Dict[str, str], parent=None):
+        """
+        Args:
+            hotkey_service: HotkeyService with the commands and current shortcuts
+            reserved: Fixed shortcuts of other window actions (shortcut -> action label)
+        """
+        super().__init__(parent)
+
+        self._service = hotkey_service
+        self._reserved = reserved
+        self._edits: Dict[str, QKeySequenceEdit] = {}
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Keyboard Shortcuts"))
+        self.setMinimumSize(640, 360)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Keyboard Shortcuts"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Click a shortcut and press the new keys. Shortcuts work while the Asset "
+                "Manager window has focus; bind the runtime commands in Maya's Hotkey Editor "
+                "to run the commands from anywhere in Maya."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        commands = self._service.get_commands()
+        shortcuts = self._service.get_shortcuts()
+        self._table = QTableWidget(len(commands), 3)
+        self._table.setHorizontalHeaderLabels(
+            [tr("Command"), tr("Shortcut"), tr("Maya Runtime Command")]
+        )
+        self._table.verticalHeader().setVisible(False)
+        header = self._table.horizontalHeader()
+        header.setSectionResizeMode(self.COLUMN_COMMAND, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(self.COLUMN_SHORTCUT, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(self.COLUMN_RUNTIME, QHeaderView.ResizeMode.Stretch)
+
+        for row, command in enumerate(commands):
+            command_item = QTableWidgetItem(command.label)
+            command_item.setToolTip(command.annotation)
+            self._table.setItem(row, self.COLUMN_COMMAND, command_item)
+            if command.in_window:
+                edit = QKeySequenceEdit(QKeySequence(shortcuts.get(command.command_id, "")))
+                self._table.setCellWidget(row, self.COLUMN_SHORTCUT, edit)
+                self._edits[command.command_id] = edit
+            else:
+                self._table.setItem(
+                    row, self.COLUMN_SHORTCUT, QTableWidgetItem(tr("Maya hotkey only"))
+                )
+            self._table.setItem(row, self.COLUMN_RUNTIME, QTableWidgetItem(command.runtime_name))
+        main_layout.addWidget(self._table, 1)
+
+        marking_menu_label = QLabel(
+            tr(
+                "Marking menu: bind {press} to a key press and {release} to its release "
+                "(Custom Scripts > Asset Manager in Maya's Hotkey Editor).",
+                press=MARKING_MENU_PRESS,
+                release=MARKING_MENU_RELEASE,
+            )
+        )
+        marking_menu_label.setWordWrap(True)
+        marking_menu_label.setProperty("description", True)
+        main_layout.addWidget(marking_menu_label)
+
+        button_layout = QHBoxLayout()
+
+        defaults_btn = QPushButton(tr("Restore Defaults"))
+        defaults_btn.clicked.connect(self._on_restore_defaults)
+        button_layout.addWidget(defaults_btn)
+
+        register_btn = QPushButton(tr("Add to Maya Hotkey Editor"))
+        register_btn.setToolTip(tr("Create the runtime commands and the marking menu commands"))
+        register_btn.clicked.connect(self._on_register_runtime_commands)
+        button_layout.addWidget(register_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_restore_defaults(self) -> None:
+        """Put every shortcut back to its default"""
+        for command_id, edit in self._edits.items():
+            command = self._service.get_command(command_id)
+            edit.setKeySequence(QKeySequence(command.default_shortcut))
+
+    def _on_register_runtime_commands(self) -> None:
+        """Create the runtime commands so Maya's Hotkey Editor lists them"""
+        try:
+            import maya.cmds as cmds  # type: ignore
+        except ImportError:
+            QMessageBox.warning(
+                self, tr("Maya Required"), tr("Runtime commands can only be added inside Maya.")
+            )
+            return
+        names = self._service.register_runtime_commands(cmds)
+        QMessageBox.information(
+            self,
+            tr("Runtime Commands Added"),
+            tr(
+                "{count} commands are listed under Custom Scripts > Asset Manager in Maya's "
+                "Hotkey Editor.",
+                count=len(names),
+            ),
+        )
+
+    def _on_accept(self) -> None:
+        """Validate shortcuts before closing"""
+        conflicts = self._service.find_conflicts(self.get_shortcuts(), self._reserved)
+        if conflicts:
+            QMessageBox.warning(self, tr("Shortcut Conflict"), "\n".join(conflicts))
+            return
+        self.accept()
+
+    def get_shortcuts(self) -> Dict[str, str]:
+        """Get the shortcut per command id ("" removes a command's shortcut)"""
+        return {
+            command_id: edit.keySequence().toString(QKeySequence.SequenceFormat.PortableText)
+            for command_id, edit in self._edits.items()
+        }
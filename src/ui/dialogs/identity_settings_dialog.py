@@ -0,0 +1,204 @@
+# -*- coding: utf-8 -*-
+"""
+Identity Settings Dialog
+Choose how artists sign in: workstation login, LDAP / Active Directory, or OAuth
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QGroupBox,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QCheckBox,
+    QSpinBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.identity_service_impl import (
+    BACKEND_LDAP,
+    BACKEND_OAUTH,
+    IDENTITY_CONFIG_ENV,
+    get_backend_labels,
+)
+from ...services.localization_service_impl import tr
+
+
+class IdentitySettingsDialog(QDialog):
+    """
+    Identity Settings Dialog - Single Responsibility for the sign-in backend settings
+    Read-only when the studio settings file decides them
+    """
+
+    def __init__(self, identity_service, parent=None):
+        super().__init__(parent)
+
+        self._service = identity_service
+
+        self._setup_ui()
+        self._on_backend_changed()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Identity Settings"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        config = self._service.get_config()
+        managed = self._service.is_managed()
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Identity Settings"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        description = tr(
+            "With a sign-in backend, publish records, locks, and library roles use the "
+            "studio account artists sign in with instead of the workstation login, and "
+            "artists who have not signed in can only import."
+        )
+        if managed:
+            description += "\n\n" + tr(
+                "These settings come from {path} ({variable}) and can only be changed there.",
+                path=self._service.get_studio_config_file(),
+                variable=IDENTITY_CONFIG_ENV,
+            )
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._backend_combo = QComboBox()
+        for name, label in get_backend_labels().items():
+            self._backend_combo.addItem(label, name)
+        self._backend_combo.setCurrentIndex(
+            max(self._backend_combo.findData(config["backend"]), 0)
+        )
+        self._backend_combo.currentIndexChanged.connect(lambda _index: self._on_backend_changed())
+        form_layout.addRow(tr("Sign in with:"), self._backend_combo)
+
+        self._session_spin = QSpinBox()
+        self._session_spin.setRange(0, 24 * 30)
+        self._session_spin.setSuffix(" h")
+        self._session_spin.setSpecialValueText(tr("Until signed out"))
+        self._session_spin.setValue(int(config["session_hours"] or 0))
+        form_layout.addRow(tr("Stay signed in:"), self._session_spin)
+
+        self._sign_out_check = QCheckBox(tr("Sign out when Maya closes (shared workstations)"))
+        self._sign_out_check.setChecked(bool(config["sign_out_on_exit"]))
+        form_layout.addRow("", self._sign_out_check)
+        main_layout.addLayout(form_layout)
+
+        ldap = config[BACKEND_LDAP]
+        self._ldap_group = QGroupBox(tr("LDAP / Active Directory"))
+        ldap_layout = QFormLayout(self._ldap_group)
+        self._server_edit = QLineEdit(ldap["server"])
+        self._server_edit.setPlaceholderText("ldaps://dc01.studio.local")
+        ldap_layout.addRow(tr("Server:"), self._server_edit)
+        self._bind_edit = QLineEdit(ldap["bind_format"])
+        self._bind_edit.setPlaceholderText("{user}@studio.local")
+        self._bind_edit.setToolTip(tr("How the user name is bound: {user}@domain for AD"))
+        ldap_layout.addRow(tr("Bind as:"), self._bind_edit)
+        self._base_dn_edit = QLineEdit(ldap["base_dn"])
+        self._base_dn_edit.setPlaceholderText("dc=studio,dc=local")
+        ldap_layout.addRow(tr("Search base:"), self._base_dn_edit)
+        self._filter_edit = QLineEdit(ldap["user_filter"])
+        ldap_layout.addRow(tr("User filter:"), self._filter_edit)
+        main_layout.addWidget(self._ldap_group)
+
+        oauth = config[BACKEND_OAUTH]
+        self._oauth_group = QGroupBox(tr("OAuth / OpenID Connect"))
+        oauth_layout = QFormLayout(self._oauth_group)
+        self._issuer_edit = QLineEdit(oauth["issuer"])
+        self._issuer_edit.setPlaceholderText("https://login.microsoftonline.com/<tenant>/v2.0")
+        oauth_layout.addRow(tr("Issuer:"), self._issuer_edit)
+        self._client_edit = QLineEdit(oauth["client_id"])
+        self._client_edit.setToolTip(tr("A public client allowed to use device sign-in"))
+        oauth_layout.addRow(tr("Client ID:"), self._client_edit)
+        self._scopes_edit = QLineEdit(oauth["scopes"])
+        oauth_layout.addRow(tr("Scopes:"), self._scopes_edit)
+        self._claim_edit = QLineEdit(oauth["username_claim"])
+        oauth_layout.addRow(tr("User name claim:"), self._claim_edit)
+        self._strip_check = QCheckBox(tr("Drop the email domain from user names"))
+        self._strip_check.setChecked(bool(oauth["strip_email_domain"]))
+        oauth_layout.addRow("", self._strip_check)
+        main_layout.addWidget(self._oauth_group)
+
+        for widget in (
+            self._backend_combo,
+            self._session_spin,
+            self._sign_out_check,
+            self._ldap_group,
+            self._oauth_group,
+        ):
+            widget.setEnabled(not managed)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.setEnabled(not managed)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Close") if managed else tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_backend_changed(self) -> None:
+        """Show the settings of the chosen backend only"""
+        backend = self._backend_combo.currentData()
+        self._ldap_group.setVisible(backend == BACKEND_LDAP)
+        self._oauth_group.setVisible(backend == BACKEND_OAUTH)
+        self.adjustSize()
+
+    def _on_save_clicked(self) -> None:
+        """Store the settings and close"""
+        backend = self._backend_combo.currentData()
+        if backend == BACKEND_LDAP and not self._server_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Server"), tr("Enter the LDAP server address."))
+            return
+        if backend == BACKEND_OAUTH and not (
+            self._issuer_edit.text().strip() and self._client_edit.text().strip()
+        ):
+            QMessageBox.warning(
+                self, tr("Missing Provider"), tr("Enter the issuer and the client ID.")
+            )
+            return
+
+        self._service.set_config(
+            backend=backend,
+            session_hours=self._session_spin.value(),
+            sign_out_on_exit=self._sign_out_check.isChecked(),
+            ldap={
+                "server": self._server_edit.text().strip(),
+                "bind_format": self._bind_edit.text().strip() or "{user}",
+                "base_dn": self._base_dn_edit.text().strip(),
+                "user_filter": self._filter_edit.text().strip(),
+            },
+            oauth={
+                "issuer": self._issuer_edit.text().strip(),
+                "client_id": self._client_edit.text().strip(),
+                "scopes": self._scopes_edit.text().strip() or "openid profile email",
+                "username_claim": self._claim_edit.text().strip() or "preferred_username",
+                "strip_email_domain": self._strip_check.isChecked(),
+            },
+        )
+        if not self._service.save_config():
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save identity settings."))
+            return
+        self.accept()
@@ -0,0 +1,130 @@
+# -*- coding: utf-8 -*-
+"""
+Import Conflict Dialog
+Choose how to import an asset whose node names are already taken in the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QListWidget,
+    QRadioButton,
+    QButtonGroup,
+    QGroupBox,
+    QPushButton,
+)
+from PySide6.QtCore import QSettings
+
+from ..theme import UITheme
+from ...core.models.import_conflict import (
+    CONFLICT_NAMESPACE,
+    CONFLICT_RESOLUTIONS,
+    ImportConflictReport,
+)
+from ...services.localization_service_impl import tr
+
+
+class ImportConflictDialog(QDialog):
+    """
+    Import Conflict Dialog - Single Responsibility for the name clash choice
+    The last choice is preselected for the next clash; closing the dialog stops the import
+    """
+
+    def __init__(
+        self, asset_name: str, report: ImportConflictReport, namespace: str, parent=None
+    ):
+        """
+        Args:
+            asset_name: Asset being imported
+            report: Clashing nodes found by the pre-check
+            namespace: Namespace the asset would get of its own
+        """
+        super().__init__(parent)
+
+        self._asset_name = asset_name
+        self._report = report
+        self._namespace = namespace
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Name Clashes"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Import {name}", name=self._asset_name))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Nodes of this asset have the same names as nodes in the scene. Maya would "
+                "rename them on import, breaking constraints and scripts that use the names."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        conflict_list = QListWidget()
+        conflict_list.addItems([conflict.label for conflict in self._report.conflicts])
+        conflict_list.setMaximumHeight(140)
+        main_layout.addWidget(conflict_list)
+
+        resolution_box = QGroupBox(tr("Import"))
+        resolution_layout = QVBoxLayout(resolution_box)
+        self._resolution_group = QButtonGroup(self)
+        for index, text in enumerate(
+            [
+                tr("Into its own namespace ({namespace}:)", namespace=self._namespace),
+                tr("Renaming the clashing nodes (body -> body_1)"),
+                tr("Skip this asset"),
+                tr("Stop importing"),
+            ]
+        ):
+            radio = QRadioButton(text)
+            self._resolution_group.addButton(radio, index)
+            resolution_layout.addWidget(radio)
+        main_layout.addWidget(resolution_box)
+
+        last_resolution = str(self._settings.value("importConflictResolution", ""))
+        if last_resolution not in CONFLICT_RESOLUTIONS:
+            last_resolution = CONFLICT_NAMESPACE
+        self._resolution_group.button(CONFLICT_RESOLUTIONS.index(last_resolution)).setChecked(
+            True
+        )
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        continue_btn = QPushButton(tr("Continue"))
+        continue_btn.setProperty("accent", True)
+        continue_btn.setDefault(True)
+        continue_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(continue_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Remember the chosen resolution and close"""
+        self._settings.setValue("importConflictResolution", self.get_resolution())
+        self.accept()
+
+    def get_resolution(self) -> str:
+        """Get the chosen resolution"""
+        return CONFLICT_RESOLUTIONS[max(self._resolution_group.checkedId(), 0)]
@@ -0,0 +1,125 @@
+# -*- coding: utf-8 -*-
+"""
+Import Namespace Dialog
+Choose what imports do with the namespaces they bring into the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QLineEdit,
+    QCheckBox,
+    QRadioButton,
+    QButtonGroup,
+    QGroupBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...core.models.import_namespace_options import NAMESPACE_MODES, ImportNamespaceOptions
+from ...services.localization_service_impl import tr
+
+
+class ImportNamespaceDialog(QDialog):
+    """
+    Import Namespace Dialog - Single Responsibility for import namespace options
+    The options apply to every import until changed; references keep their namespace
+    """
+
+    def __init__(self, options: ImportNamespaceOptions, parent=None):
+        super().__init__(parent)
+
+        self._options = options
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Import Namespace Options"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Import Namespace Options"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Only the namespaces an import creates are changed, so vendor files arriving "
+                "as vendorA:export:crate:body can land as body, crate:body, or crate_body. "
+                "References keep their namespace."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        namespace_box = QGroupBox(tr("Imported Namespaces"))
+        namespace_layout = QVBoxLayout(namespace_box)
+        self._mode_group = QButtonGroup(self)
+
+        keep_radio = QRadioButton(tr("Keep as they are"))
+        strip_radio = QRadioButton(tr("Strip (move everything into the root namespace)"))
+        merge_radio = QRadioButton(tr("Merge into:"))
+        prefix_radio = QRadioButton(tr("Flatten to a prefix:"))
+        for index, radio in enumerate([keep_radio, strip_radio, merge_radio, prefix_radio]):
+            self._mode_group.addButton(radio, index)
+
+        namespace_layout.addWidget(keep_radio)
+        namespace_layout.addWidget(strip_radio)
+        merge_layout = QHBoxLayout()
+        merge_layout.addWidget(merge_radio)
+        self._namespace_edit = QLineEdit(self._options.namespace)
+        self._namespace_edit.setPlaceholderText(tr("Asset name, or an existing namespace"))
+        merge_layout.addWidget(self._namespace_edit, 1)
+        namespace_layout.addLayout(merge_layout)
+        prefix_layout = QHBoxLayout()
+        prefix_layout.addWidget(prefix_radio)
+        self._prefix_edit = QLineEdit(self._options.prefix)
+        self._prefix_edit.setPlaceholderText(tr("Asset name and an underscore (crate_)"))
+        prefix_layout.addWidget(self._prefix_edit, 1)
+        namespace_layout.addLayout(prefix_layout)
+        merge_radio.toggled.connect(self._namespace_edit.setEnabled)
+        prefix_radio.toggled.connect(self._prefix_edit.setEnabled)
+        main_layout.addWidget(namespace_box)
+
+        self._mode_group.button(NAMESPACE_MODES.index(self._options.mode)).setChecked(True)
+        self._namespace_edit.setEnabled(merge_radio.isChecked())
+        self._prefix_edit.setEnabled(prefix_radio.isChecked())
+
+        self._remove_empty_check = QCheckBox(tr("Remove empty namespaces after each import"))
+        self._remove_empty_check.setChecked(self._options.remove_empty)
+        main_layout.addWidget(self._remove_empty_check)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self.accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_options(self) -> ImportNamespaceOptions:
+        """Get the chosen namespace options"""
+        return ImportNamespaceOptions(
+            mode=NAMESPACE_MODES[max(self._mode_group.checkedId(), 0)],
+            namespace=self._namespace_edit.text().strip(),
+            prefix=self._prefix_edit.text().strip(),
+            remove_empty=self._remove_empty_check.isChecked(),
+        )
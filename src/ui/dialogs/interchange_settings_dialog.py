@@ -0,0 +1,197 @@
+# -*- coding: utf-8 -*-
+"""
+Interchange Settings Dialog
+Pair Maya with the Houdini or Blender session used by Send to / Receive from DCC
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QSpinBox,
+    QCheckBox,
+    QPushButton,
+    QFileDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.interchange_service_impl import PEER_DCCS
+from ...services.localization_service_impl import tr
+
+
+class InterchangeSettingsDialog(QDialog):
+    """
+    Interchange Settings Dialog - Single Responsibility for DCC interchange pairing
+    """
+
+    def __init__(self, interchange_service, parent=None):
+        super().__init__(parent)
+
+        self._service = interchange_service
+        self._saved_config = interchange_service.get_config()  # Restored on cancel
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("DCC Interchange Settings"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("DCC Interchange"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Send to and Receive from pass the selection through a temporary USD layer, "
+                "without publishing. In Houdini or Blender, run dcc_interchange_peer.start() "
+                "from the Asset Manager scripts folder using the same ports and token."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        config = self._service.get_config()
+        form_layout = QFormLayout()
+
+        self._peer_combo = QComboBox()
+        for dcc, name in PEER_DCCS.items():
+            self._peer_combo.addItem(name, dcc)
+        self._peer_combo.setCurrentIndex(max(self._peer_combo.findData(config["peer_dcc"]), 0))
+        form_layout.addRow(tr("Paired application:"), self._peer_combo)
+
+        self._host_edit = QLineEdit(config.get("peer_host", ""))
+        form_layout.addRow(tr("Host:"), self._host_edit)
+
+        self._peer_port_spin = QSpinBox()
+        self._peer_port_spin.setRange(1024, 65535)
+        self._peer_port_spin.setValue(int(config.get("peer_port") or 0))
+        self._peer_port_spin.setToolTip(tr("Port the peer script listens on"))
+        form_layout.addRow(tr("Peer port:"), self._peer_port_spin)
+
+        self._listen_port_spin = QSpinBox()
+        self._listen_port_spin.setRange(1024, 65535)
+        self._listen_port_spin.setValue(int(config.get("listen_port") or 0))
+        self._listen_port_spin.setToolTip(tr("Port Maya listens on for layers the peer sends"))
+        form_layout.addRow(tr("Maya port:"), self._listen_port_spin)
+
+        self._token_edit = QLineEdit(config.get("token", ""))
+        self._token_edit.setEchoMode(QLineEdit.EchoMode.Password)
+        self._token_edit.setPlaceholderText(tr("Optional shared secret"))
+        form_layout.addRow(tr("Token:"), self._token_edit)
+
+        folder_layout = QHBoxLayout()
+        self._folder_edit = QLineEdit(config.get("exchange_dir", ""))
+        self._folder_edit.setPlaceholderText(str(self._service.get_exchange_dir()))
+        folder_layout.addWidget(self._folder_edit, 1)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse)
+        folder_layout.addWidget(browse_btn)
+        form_layout.addRow(tr("Layer folder:"), folder_layout)
+
+        main_layout.addLayout(form_layout)
+
+        self._listen_check = QCheckBox(tr("Import layers the paired application sends"))
+        self._listen_check.setChecked(bool(config.get("listen")))
+        main_layout.addWidget(self._listen_check)
+
+        self._stage_check = QCheckBox(tr("Bring received layers in as a USD stage"))
+        self._stage_check.setToolTip(tr("Keep the data in USD instead of converting to Maya"))
+        self._stage_check.setChecked(bool(config.get("import_as_stage")))
+        main_layout.addWidget(self._stage_check)
+
+        button_layout = QHBoxLayout()
+
+        test_btn = QPushButton(tr("Test Connection"))
+        test_btn.setToolTip(tr("Check that the peer script answers on the peer port"))
+        test_btn.clicked.connect(self._on_test_connection)
+        button_layout.addWidget(test_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def reject(self) -> None:
+        """Drop the fields tried with Test Connection"""
+        self._service.set_config(**self._saved_config)
+        super().reject()
+
+    def _apply_fields(self) -> None:
+        """Copy the fields into the service configuration"""
+        self._service.set_config(
+            peer_dcc=self._peer_combo.currentData(),
+            peer_host=self._host_edit.text().strip() or "127.0.0.1",
+            peer_port=self._peer_port_spin.value(),
+            listen_port=self._listen_port_spin.value(),
+            token=self._token_edit.text().strip(),
+            exchange_dir=self._folder_edit.text().strip(),
+            listen=self._listen_check.isChecked(),
+            import_as_stage=self._stage_check.isChecked(),
+        )
+
+    def _on_browse(self) -> None:
+        """Pick the folder temporary layers are written to"""
+        start = self._folder_edit.text().strip() or str(self._service.get_exchange_dir())
+        folder = QFileDialog.getExistingDirectory(self, tr("Interchange Layer Folder"), start)
+        if folder:
+            self._folder_edit.setText(str(Path(folder)))
+
+    def _on_test_connection(self) -> None:
+        """Ping the peer with the settings in the fields"""
+        self._apply_fields()
+        pong = self._service.ping_peer()
+        if pong is None:
+            QMessageBox.warning(
+                self,
+                tr("No Answer"),
+                tr(
+                    "{peer} did not answer on port {port}.\n\nRun "
+                    "dcc_interchange_peer.start() there with the same port and token.",
+                    peer=self._service.get_peer_name(),
+                    port=self._peer_port_spin.value(),
+                ),
+            )
+            return
+        QMessageBox.information(
+            self,
+            tr("Connected"),
+            tr(
+                "{peer} is listening",
+                peer=PEER_DCCS.get(pong.get("dcc"), pong.get("dcc", "Peer")),
+            ),
+        )
+
+    def _on_save_clicked(self) -> None:
+        """Store interchange configuration and close"""
+        self._apply_fields()
+        if not self._service.save_config():
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save interchange settings.")
+            )
+            return
+        self.accept()
@@ -0,0 +1,122 @@
+# -*- coding: utf-8 -*-
+"""
+Kitsu Settings Dialog
+Turn on publish posting and set the Kitsu connection
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class KitsuSettingsDialog(QDialog):
+    """
+    Kitsu Settings Dialog - Single Responsibility for Kitsu publish configuration
+    """
+
+    def __init__(self, kitsu_service, parent=None):
+        super().__init__(parent)
+
+        self._service = kitsu_service
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Kitsu Settings"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Kitsu Publishing"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        task = self._service.get_active_task()
+        desc_label = QLabel(
+            "Publishes are posted to the task picked in Kitsu Tasks as a comment with a "
+            "preview and an output file entry. A bot token replaces the login. "
+            "KITSU_HOST, KITSU_EMAIL, KITSU_PASSWORD, and KITSU_TOKEN override these "
+            f"fields.\n\nLinked task: {task.description if task else 'None'}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        # Stored values only, so saving never writes an environment password to disk
+        config = self._service.get_config(apply_environment=False)
+
+        self._enabled_check = QCheckBox(tr("Post every library publish to the linked task"))
+        self._enabled_check.setChecked(self._service.is_enabled())
+        main_layout.addWidget(self._enabled_check)
+
+        form_layout = QFormLayout()
+
+        self._host_edit = QLineEdit(config.get("host", ""))
+        self._host_edit.setPlaceholderText("https://kitsu.studio.com/api")
+        form_layout.addRow("Host:", self._host_edit)
+
+        self._email_edit = QLineEdit(config.get("email", ""))
+        form_layout.addRow("Email:", self._email_edit)
+
+        self._password_edit = QLineEdit(config.get("password", ""))
+        self._password_edit.setEchoMode(QLineEdit.EchoMode.Password)
+        form_layout.addRow("Password:", self._password_edit)
+
+        self._token_edit = QLineEdit(config.get("token", ""))
+        self._token_edit.setEchoMode(QLineEdit.EchoMode.Password)
+        self._token_edit.setPlaceholderText(tr("Optional"))
+        form_layout.addRow("Bot token:", self._token_edit)
+
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_save_clicked(self) -> None:
+        """Store Kitsu configuration and close"""
+        host = self._host_edit.text().strip()
+        if self._enabled_check.isChecked() and not host:
+            QMessageBox.warning(self, tr("Missing Host"), tr("Enter the Kitsu API address."))
+            return
+
+        self._service.set_config(
+            enabled=self._enabled_check.isChecked(),
+            host=host,
+            email=self._email_edit.text().strip(),
+            password=self._password_edit.text(),
+            token=self._token_edit.text().strip(),
+        )
+
+        if not self._service.save_config():
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save Kitsu settings."))
+            return
+        self.accept()
@@ -0,0 +1,166 @@
+# -*- coding: utf-8 -*-
+"""
+Kitsu Tasks Dialog
+The artist's Kitsu tasks, and which one library publishes are posted to
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+)
+from PySide6.QtGui import QFont
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class KitsuTasksDialog(QDialog):
+    """
+    Kitsu Tasks Dialog - Single Responsibility for linking publishes to a task
+    The linked task is saved, so it holds until another one is picked
+    """
+
+    COLUMNS = ["Project", "Shot / Asset", "Task", "Status"]
+
+    def __init__(self, kitsu_service, parent=None):
+        super().__init__(parent)
+
+        self._service = kitsu_service
+        self._tasks: List = []
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Kitsu Tasks"))
+        self.setMinimumSize(620, 380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("My Kitsu Tasks"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._desc_label = QLabel()
+        self._desc_label.setWordWrap(True)
+        self._desc_label.setProperty("description", True)
+        main_layout.addWidget(self._desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.SingleSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        self._table.itemSelectionChanged.connect(self._update_buttons)
+        self._table.itemDoubleClicked.connect(lambda _item: self._on_link())
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+
+        refresh_btn = QPushButton(tr("Refresh"))
+        refresh_btn.clicked.connect(self._refresh)
+        button_layout.addWidget(refresh_btn)
+
+        self._unlink_btn = QPushButton(tr("Unlink"))
+        self._unlink_btn.setToolTip(tr("Stop posting publishes to Kitsu"))
+        self._unlink_btn.clicked.connect(self._on_unlink)
+        button_layout.addWidget(self._unlink_btn)
+
+        button_layout.addStretch()
+
+        self._link_btn = QPushButton(tr("Link Publishes"))
+        self._link_btn.setProperty("accent", True)
+        self._link_btn.clicked.connect(self._on_link)
+        button_layout.addWidget(self._link_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """Pull the artist's tasks from Kitsu"""
+        error: Optional[str] = None
+        try:
+            self._tasks = self._service.get_my_tasks()
+        except Exception as e:
+            self._tasks = []
+            error = str(e)
+
+        active = self._service.get_active_task()
+        self._table.setRowCount(len(self._tasks))
+        for row, task in enumerate(self._tasks):
+            texts = [
+                task.project_name,
+                f"{task.entity_label} ({task.entity_type})",
+                task.task_type_name,
+                task.task_status_name,
+            ]
+            for column, text in enumerate(texts):
+                item = QTableWidgetItem(text)
+                if active is not None and task.id == active.id:
+                    font = QFont(item.font())
+                    font.setBold(True)
+                    item.setFont(font)
+                self._table.setItem(row, column, item)
+        self._table.resizeColumnsToContents()
+
+        if error:
+            self._table.setRowCount(1)
+            self._table.setItem(0, 0, QTableWidgetItem(f"Could not reach Kitsu: {error}"))
+        elif not self._tasks:
+            self._table.setRowCount(1)
+            self._table.setItem(0, 0, QTableWidgetItem("No tasks assigned to you"))
+
+        linked = active.description if active else "none - publishes are not posted"
+        self._desc_label.setText(
+            "Pick the task library publishes are posted to (bold). Each publish adds a "
+            f"comment with its preview and an output file version.\n\nLinked: {linked}"
+        )
+        self._update_buttons()
+
+    def _selected_task(self):
+        """Get the task of the selected row"""
+        rows = self._table.selectionModel().selectedRows()
+        if not rows or rows[0].row() >= len(self._tasks):
+            return None
+        return self._tasks[rows[0].row()]
+
+    def _update_buttons(self) -> None:
+        """Enable linking once a task is selected"""
+        self._link_btn.setEnabled(self._selected_task() is not None)
+        self._unlink_btn.setEnabled(self._service.get_active_task() is not None)
+
+    def _on_link(self) -> None:
+        """Post publishes to the selected task"""
+        task = self._selected_task()
+        if task is None:
+            return
+        self._service.set_active_task(task)
+        if self.parent() is not None and hasattr(self.parent(), "_set_status"):
+            self.parent()._set_status(f"Publishes post to Kitsu task {task.description}")
+        self._refresh()
+
+    def _on_unlink(self) -> None:
+        """Stop posting publishes to a task"""
+        self._service.set_active_task(None)
+        self._refresh()
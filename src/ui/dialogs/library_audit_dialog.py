@@ -0,0 +1,237 @@
+# -*- coding: utf-8 -*-
+"""
+Library Audit Dialog
+Verify published files against their checksums and repair what the audit finds
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import json
+from pathlib import Path
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QApplication,
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+    QFileDialog,
+    QProgressDialog,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...core.models.integrity_report import (
+    ISSUE_UNVERIFIED,
+    REPAIR_DELETE,
+    IntegrityIssue,
+    IntegrityReport,
+)
+from ...services.localization_service_impl import tr
+
+
+class LibraryAuditDialog(QDialog):
+    """
+    Library Audit Dialog - Single Responsibility for integrity audit review
+    Each finding carries a suggested repair; deleting folders asks first
+    """
+
+    COLUMNS = ["Finding", "File", "Asset", "Suggested Action"]
+
+    def __init__(self, integrity_service, database, parent=None):
+        super().__init__(parent)
+
+        self._service = integrity_service
+        self._database = database
+        self._report: Optional[IntegrityReport] = None
+        self._issues: List[IntegrityIssue] = []
+        # True once a repair touched library files, so the owner refreshes
+        self.changed = False
+
+        self._setup_ui()
+        self._run_audit()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Audit Library"))
+        self.setMinimumSize(720, 420)
+        self.resize(900, 520)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Library Integrity"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Every published file is checked against the SHA-256 stored when it was "
+                "published. Damaged files restore from an intact copy with the same checksum, "
+                "usually the version snapshot. Files published before checksums were stored "
+                "are listed so they can be recorded."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        self._table.itemSelectionChanged.connect(self._update_buttons)
+        main_layout.addWidget(self._table, 1)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        button_layout = QHBoxLayout()
+
+        audit_btn = QPushButton(tr("Run Audit"))
+        audit_btn.clicked.connect(self._run_audit)
+        button_layout.addWidget(audit_btn)
+
+        self._export_btn = QPushButton(tr("Export Report..."))
+        self._export_btn.clicked.connect(self._on_export)
+        button_layout.addWidget(self._export_btn)
+
+        self._record_all_btn = QPushButton(tr("Record All Checksums"))
+        self._record_all_btn.setToolTip(tr("Trust the current bytes of every file without one"))
+        self._record_all_btn.clicked.connect(self._on_record_all)
+        button_layout.addWidget(self._record_all_btn)
+
+        button_layout.addStretch()
+
+        self._repair_btn = QPushButton(tr("Repair Selected"))
+        self._repair_btn.setProperty("accent", True)
+        self._repair_btn.clicked.connect(self._on_repair_selected)
+        button_layout.addWidget(self._repair_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _run_audit(self) -> None:
+        """Audit the library with a progress bar - hashing network files takes a while"""
+        progress_dialog = QProgressDialog("Verifying published files...", "", 0, 0, self)
+        progress_dialog.setWindowTitle(tr("Audit Library"))
+        progress_dialog.setWindowModality(Qt.WindowModality.WindowModal)
+        progress_dialog.setCancelButton(None)
+        progress_dialog.setMinimumDuration(500)
+
+        def on_progress(done: int, total: int) -> None:
+            progress_dialog.setMaximum(total)
+            progress_dialog.setValue(done)
+            QApplication.processEvents()
+
+        try:
+            self._report = self._service.audit(self._database, on_progress)
+        finally:
+            progress_dialog.close()
+        self._populate()
+
+    def _populate(self) -> None:
+        """List problems first, files without a checksum last"""
+        report = self._report
+        self._issues = list(report.problems) + list(report.get_issues(ISSUE_UNVERIFIED))
+        self._table.setRowCount(len(self._issues))
+        for row, issue in enumerate(self._issues):
+            path = self._get_display_path(issue.path)
+            texts = [issue.label, path, issue.asset_key, issue.advice]
+            for column, text in enumerate(texts):
+                item = QTableWidgetItem(text)
+                item.setToolTip(str(issue.path) if column == 1 else text)
+                self._table.setItem(row, column, item)
+        self._table.resizeColumnToContents(0)
+
+        if report.is_clean and not self._issues:
+            self._summary_label.setText(f"[OK] {report.summary} - every file verified")
+        else:
+            self._summary_label.setText(report.summary)
+        self._update_buttons()
+
+    def _get_display_path(self, path: Path) -> str:
+        """Get a library-relative path for display"""
+        try:
+            return path.relative_to(self._database.library_root).as_posix()
+        except ValueError:
+            return str(path)
+
+    def _selected_issues(self) -> List[IntegrityIssue]:
+        """Get the findings of the selected rows"""
+        rows = sorted(index.row() for index in self._table.selectionModel().selectedRows())
+        return [self._issues[row] for row in rows if row < len(self._issues)]
+
+    def _update_buttons(self) -> None:
+        """Enable repairs once findings are selected"""
+        selected = self._selected_issues()
+        self._repair_btn.setEnabled(any(issue.repair for issue in selected))
+        self._record_all_btn.setEnabled(
+            self._report is not None and bool(self._report.get_issues(ISSUE_UNVERIFIED))
+        )
+        self._export_btn.setEnabled(self._report is not None)
+
+    def _on_repair_selected(self) -> None:
+        """Apply the suggested repair of each selected finding"""
+        issues = self._selected_issues()
+        folders = [issue for issue in issues if issue.repair == REPAIR_DELETE]
+        if folders:
+            answer = QMessageBox.question(
+                self,
+                tr("Delete Orphaned Folders"),
+                f"Permanently delete {len(folders)} orphaned folder(s)?\n\n"
+                + "\n".join(self._get_display_path(issue.path) for issue in folders[:10]),
+            )
+            if answer != QMessageBox.StandardButton.Yes:
+                issues = [issue for issue in issues if issue.repair != REPAIR_DELETE]
+        self._repair(issues)
+
+    def _on_record_all(self) -> None:
+        """Record checksums of every file published before checksums were stored"""
+        self._repair(list(self._report.get_issues(ISSUE_UNVERIFIED)))
+
+    def _repair(self, issues: List[IntegrityIssue]) -> None:
+        """Repair findings, audit again, and say what could not be fixed"""
+        if not issues:
+            return
+        failed = [issue for issue in issues if not self._service.repair(self._database, issue)]
+        self.changed = True
+        self._run_audit()
+        if failed:
+            QMessageBox.warning(
+                self,
+                tr("Repair Failed"),
+                f"Could not repair {len(failed)} of {len(issues)} finding(s):\n\n"
+                + "\n".join(self._get_display_path(issue.path) for issue in failed[:10])
+                + "\n\nSee the Script Editor for details.",
+            )
+
+    def _on_export(self) -> None:
+        """Save the report as JSON for the pipeline team"""
+        default = self._database.library_root / "library_audit.json"
+        file_path, _ = QFileDialog.getSaveFileName(
+            self, "Export Audit Report", str(default), "JSON (*.json);;All Files (*.*)"
+        )
+        if not file_path:
+            return
+        try:
+            with open(file_path, "w", encoding="utf-8") as f:
+                json.dump(self._report.to_dict(), f, indent=2)
+        except OSError as e:
+            QMessageBox.warning(self, tr("Export Failed"), f"Could not write {file_path}:\n{e}")
@@ -0,0 +1,188 @@
+# -*- coding: utf-8 -*-
+"""
+Library Permissions Dialog
+Assign artists their role in a shared library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QComboBox,
+    QPushButton,
+    QInputDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.library_permissions import (
+    ACTION_MANAGE,
+    ROLE_ADMIN,
+    ROLE_ARTIST,
+    ROLES,
+    LibraryPermissions,
+)
+from ...services.localization_service_impl import tr
+from ...services.permission_service_impl import PermissionDenied
+from ...services.version_service_impl import get_current_user
+
+
+class LibraryPermissionsDialog(QDialog):
+    """
+    Library Permissions Dialog - Single Responsibility for editing library roles
+    Everyone can see the roles; only admins can change them
+    """
+
+    def __init__(self, permission_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = permission_service
+        self._library_root = Path(library_root)
+        self._permissions = permission_service.load_permissions(self._library_root)
+        self._user = get_current_user()
+        self._can_edit = permission_service.is_allowed(self._library_root, ACTION_MANAGE)
+
+        self._setup_ui()
+        self._load_users()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Library Permissions"))
+        self.setMinimumSize(520, 440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Library Permissions"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        if self._permissions is None:
+            state = "This library is unrestricted - saving roles restricts it."
+        else:
+            role = self._service.get_role(self._library_root, self._user)
+            state = f"You are {self._user} ({role})."
+        desc_label = QLabel(
+            "junior: import | artist: + publish, edit | reviewer: + approve | "
+            "lead: + delete | "
+            f"admin: + manage library settings. {state}\n"
+            f"{self._service.get_permissions_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._default_combo = QComboBox()
+        self._default_combo.addItems(ROLES)
+        default_role = self._permissions.default_role if self._permissions else ROLE_ARTIST
+        self._default_combo.setCurrentText(default_role)
+        form_layout.addRow("Role of unlisted artists:", self._default_combo)
+        main_layout.addLayout(form_layout)
+
+        self._table = QTableWidget(0, 2)
+        self._table.setHorizontalHeaderLabels(["Artist", "Role"])
+        self._table.horizontalHeader().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        self._table.verticalHeader().setVisible(False)
+        self._table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        main_layout.addWidget(self._table)
+
+        user_layout = QHBoxLayout()
+        self._add_btn = QPushButton(tr("Add Artist..."))
+        self._add_btn.clicked.connect(self._on_add_user)
+        user_layout.addWidget(self._add_btn)
+        self._remove_btn = QPushButton(tr("Remove Artist"))
+        self._remove_btn.clicked.connect(self._on_remove_user)
+        user_layout.addWidget(self._remove_btn)
+        user_layout.addStretch()
+        main_layout.addLayout(user_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._save_btn = QPushButton(tr("Save"))
+        self._save_btn.setProperty("accent", True)
+        self._save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(self._save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+        for widget in (self._default_combo, self._add_btn, self._remove_btn, self._save_btn):
+            widget.setEnabled(self._can_edit)
+        if not self._can_edit:
+            self._save_btn.setToolTip(tr("Only library admins can change roles"))
+
+    def _load_users(self) -> None:
+        """Fill the table; a new restriction starts with the current artist as admin"""
+        users = list(self._permissions.users) if self._permissions else [(self._user, ROLE_ADMIN)]
+        for user, role in users:
+            self._add_row(user, role)
+
+    def _add_row(self, user: str, role: str) -> None:
+        """Add one artist with a role picker"""
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+        self._table.setItem(row, 0, QTableWidgetItem(user))
+        role_combo = QComboBox()
+        role_combo.addItems(ROLES)
+        role_combo.setCurrentText(role)
+        role_combo.setEnabled(self._can_edit)
+        self._table.setCellWidget(row, 1, role_combo)
+
+    def _on_add_user(self) -> None:
+        """Ask for an artist's login name and add them"""
+        user, ok = QInputDialog.getText(self, "Add Artist", "Artist login name:")
+        user = user.strip()
+        if not ok or not user:
+            return
+        for row in range(self._table.rowCount()):
+            if self._table.item(row, 0).text().lower() == user.lower():
+                self._table.selectRow(row)
+                return
+        self._add_row(user, self._default_combo.currentText())
+
+    def _on_remove_user(self) -> None:
+        """Remove the selected artist; they get the default role"""
+        rows = sorted({index.row() for index in self._table.selectedIndexes()}, reverse=True)
+        for row in rows:
+            self._table.removeRow(row)
+
+    def _on_save_clicked(self) -> None:
+        """Store the roles in the library and close"""
+        permissions = LibraryPermissions(
+            default_role=self._default_combo.currentText(),
+            role_actions=self._permissions.role_actions if self._permissions else (),
+        )
+        for row in range(self._table.rowCount()):
+            user = self._table.item(row, 0).text().strip()
+            if user:
+                role = self._table.cellWidget(row, 1).currentText()
+                permissions = permissions.with_user(user, role)
+
+        try:
+            saved = self._service.save_permissions(self._library_root, permissions)
+        except PermissionDenied as e:
+            QMessageBox.warning(self, tr("Permission Denied"), str(e))
+            return
+        if not saved:
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the library permissions.")
+            )
+            return
+        self.accept()
@@ -0,0 +1,184 @@
+# -*- coding: utf-8 -*-
+"""
+Library Registry Dialog
+Register the personal, project, and studio libraries shown in the library picker
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+
+from PySide6.QtCore import Qt
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QComboBox,
+    QPushButton,
+    QFileDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.library_entry import SCOPE_LABELS, SCOPES, LibraryEntry
+from ...services.localization_service_impl import tr
+
+
+class LibraryRegistryDialog(QDialog):
+    """
+    Library Registry Dialog - Single Responsibility for editing registered libraries
+    Libraries mounted by the Maya project are listed but edited in its config file
+    """
+
+    def __init__(self, library_registry, parent=None):
+        super().__init__(parent)
+
+        self._registry = library_registry
+        self._entries = library_registry.get_libraries()
+
+        self._setup_ui()
+        for entry in self._entries:
+            self._add_row(entry)
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Manage Libraries"))
+        self.setMinimumSize(620, 400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Manage Libraries"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        maya_project = self._registry.get_mounted_project()
+        config_hint = (
+            f"Project libraries come from {self._registry.get_project_config_file(maya_project)}."
+            if maya_project
+            else "Set a Maya project to mount the libraries it lists."
+        )
+        desc_label = QLabel(
+            "Each library keeps its own database and settings. Removing a library only "
+            f"takes it off the picker; its files are left alone. {config_hint}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, 3)
+        self._table.setHorizontalHeaderLabels(["Name", "Scope", "Folder"])
+        self._table.horizontalHeader().setSectionResizeMode(2, QHeaderView.ResizeMode.Stretch)
+        self._table.verticalHeader().setVisible(False)
+        self._table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        main_layout.addWidget(self._table)
+
+        library_layout = QHBoxLayout()
+        add_btn = QPushButton(tr("Add Library..."))
+        add_btn.clicked.connect(self._on_add_library)
+        library_layout.addWidget(add_btn)
+        remove_btn = QPushButton(tr("Remove Library"))
+        remove_btn.clicked.connect(self._on_remove_library)
+        library_layout.addWidget(remove_btn)
+        library_layout.addStretch()
+        main_layout.addLayout(library_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _add_row(self, entry: LibraryEntry) -> None:
+        """Add one library; mounted libraries are read-only"""
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+
+        name_item = QTableWidgetItem(entry.name)
+        name_item.setData(Qt.ItemDataRole.UserRole, entry.is_mounted)
+        folder_item = QTableWidgetItem(str(entry.root_path))
+        folder_item.setFlags(folder_item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+        scope_combo = QComboBox()
+        for scope in SCOPES:
+            scope_combo.addItem(SCOPE_LABELS[scope], scope)
+        scope_combo.setCurrentIndex(SCOPES.index(entry.scope))
+
+        if entry.is_mounted:
+            name_item.setFlags(name_item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+            name_item.setToolTip(f"Mounted by Maya project {entry.mounted_by}")
+            scope_combo.setEnabled(False)
+
+        self._table.setItem(row, 0, name_item)
+        self._table.setCellWidget(row, 1, scope_combo)
+        self._table.setItem(row, 2, folder_item)
+
+    def _is_mounted_row(self, row: int) -> bool:
+        """Check if a row comes from the Maya project config"""
+        return bool(self._table.item(row, 0).data(Qt.ItemDataRole.UserRole))
+
+    def _on_add_library(self) -> None:
+        """Pick a library folder and add it"""
+        folder = QFileDialog.getExistingDirectory(self, "Add Library", str(Path.home()))
+        if not folder:
+            return
+        for row in range(self._table.rowCount()):
+            if Path(self._table.item(row, 2).text()) == Path(folder):
+                self._table.selectRow(row)
+                return
+        self._add_row(LibraryEntry(name=Path(folder).name, root_path=Path(folder)))
+
+    def _on_remove_library(self) -> None:
+        """Remove the selected libraries from the picker"""
+        rows = sorted({index.row() for index in self._table.selectedIndexes()}, reverse=True)
+        for row in rows:
+            if self._is_mounted_row(row):
+                continue  # Listed by the Maya project config
+            self._table.removeRow(row)
+
+    def _on_save_clicked(self) -> None:
+        """Store the registered libraries and close"""
+        kept = []
+        for row in range(self._table.rowCount()):
+            if not self._is_mounted_row(row):
+                scope = self._table.cellWidget(row, 1).currentData()
+                name = self._table.item(row, 0).text()
+                kept.append((name.strip(), Path(self._table.item(row, 2).text()), scope))
+
+        names = [name.lower() for name, _, _ in kept]
+        if "" in names or len(set(names)) != len(names):
+            QMessageBox.warning(
+                self, tr("Invalid Library"), tr("Every library needs a unique name.")
+            )
+            return
+
+        for entry in self._entries:
+            if not entry.is_mounted and entry.root_path not in [path for _, path, _ in kept]:
+                self._registry.unregister_library(entry.root_path)
+        try:
+            for name, root_path, scope in kept:
+                self._registry.register_library(name, root_path, scope)
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Library"), str(e))
+            return
+
+        if not self._registry.save():
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the library registry.")
+            )
+            return
+        self.accept()
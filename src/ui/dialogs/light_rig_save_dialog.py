@@ -0,0 +1,174 @@
+# -*- coding: utf-8 -*-
+"""
+Light Rig Save Dialog
+Pick the lights to save as a light rig, or the renderer of an HDRI environment, and name it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class LightRigSaveDialog(QDialog):
+    """
+    Light Rig Save Dialog - Single Responsibility for light rig and HDRI options
+    Lists the scene's lights for a rig, or offers a renderer when an HDRI is given
+    """
+
+    def __init__(
+        self,
+        lights: List[Tuple[str, str]],
+        selected: List[str],
+        can_render_preview: bool,
+        hdri_file: Optional[Path] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            lights: (transform, light type) pairs of the scene's lights
+            selected: Lights checked at first (the Maya selection)
+            can_render_preview: Whether background mayapy rendering is available
+            hdri_file: Image to publish as an HDRI environment instead of lights
+        """
+        super().__init__(parent)
+
+        self._lights = lights
+        self._selected = selected
+        self._can_render_preview = can_render_preview
+        self._hdri_file = hdri_file
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        is_hdri = self._hdri_file is not None
+        self.setWindowTitle("Publish HDRI Environment" if is_hdri else "Save Light Rig")
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel("Publish HDRI Environment" if is_hdri else "Save Light Rig")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "The image is copied into the library and loads as a dome light for the "
+            "chosen renderer."
+            if is_hdri
+            else "The checked lights, their renderer settings, and their textures are "
+            "copied into the library. Loading the rig can replace the active one."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._light_list: Optional[QListWidget] = None
+        self._renderer_combo: Optional[QComboBox] = None
+        if self._hdri_file is not None:
+            form_layout.addRow("Image:", QLabel(self._hdri_file.name))
+            self._renderer_combo = QComboBox()
+            self._renderer_combo.addItem("Arnold (aiSkyDomeLight)", "arnold")
+            self._renderer_combo.addItem("RenderMan (PxrDomeLight)", "renderman")
+            form_layout.addRow("Renderer:", self._renderer_combo)
+            default_name = self._hdri_file.stem
+        else:
+            self._light_list = QListWidget()
+            self._light_list.setMaximumHeight(140)
+            for light, light_type in self._lights:
+                item = QListWidgetItem(f"{light.rsplit('|', 1)[-1]} ({light_type})")
+                item.setData(Qt.UserRole, light)  # type: ignore
+                checked = not self._selected or light in self._selected
+                item.setCheckState(Qt.Checked if checked else Qt.Unchecked)  # type: ignore
+                self._light_list.addItem(item)
+            form_layout.addRow("Lights:", self._light_list)
+            default_name = "light_rig"
+
+        self._name_edit = QLineEdit(default_name)
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._preview_check = QCheckBox(tr("Render sphere preview thumbnail"))
+        self._preview_check.setChecked(self._can_render_preview)
+        self._preview_check.setEnabled(self._can_render_preview)
+        if not self._can_render_preview:
+            self._preview_check.setToolTip(
+                tr("Previews are rendered with mayapy (set MAYA_LOCATION)")
+            )
+        form_layout.addRow("", self._preview_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton("Publish HDRI" if is_hdri else "Save Light Rig")
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_checked_lights(self) -> List[str]:
+        """Get the light transforms left checked"""
+        if self._light_list is None:
+            return []
+        lights = []
+        for row in range(self._light_list.count()):
+            item = self._light_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                lights.append(item.data(Qt.UserRole))  # type: ignore
+        return lights
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        if self._light_list is not None and not self._get_checked_lights():
+            QMessageBox.warning(self, tr("No Lights"), tr("Check at least one light to save."))
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get save options (name, notes, lights, renderer, render_preview)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "lights": self._get_checked_lights(),
+            "renderer": self._renderer_combo.currentData() if self._renderer_combo else "",
+            "render_preview": self._preview_check.isChecked(),
+        }
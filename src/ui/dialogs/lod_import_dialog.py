@@ -0,0 +1,109 @@
+# -*- coding: utf-8 -*-
+"""
+LOD Import Dialog
+Choose which level of detail of an asset to bring into the scene
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+)
+from PySide6.QtCore import Qt, QSettings
+
+from ..theme import UITheme
+from ...core.models.lod_variant import LodVariant
+from ...services.localization_service_impl import tr
+
+
+class LodImportDialog(QDialog):
+    """
+    LOD Import Dialog - Single Responsibility for LOD choice on import
+    The last chosen level is preselected for the next asset
+    """
+
+    def __init__(
+        self, asset_name: str, variants: List[LodVariant], action: str = "Import", parent=None
+    ):
+        super().__init__(parent)
+
+        self._asset_name = asset_name
+        self._variants = variants
+        self._action = action
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(f"{self._action} LOD")
+        self.setMinimumWidth(360)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"{self._action} {self._asset_name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "This asset has several levels of detail. Use Swap LODs later to switch "
+                "the loaded level without re-placing the asset."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._level_list = QListWidget()
+        last_level = str(self._settings.value("lodImportLevel", ""))
+        for variant in self._variants:
+            item = QListWidgetItem(variant.label)
+            item.setData(Qt.ItemDataRole.UserRole, variant.level)
+            if variant.author:
+                published = variant.published.strftime("%Y-%m-%d") if variant.published else ""
+                item.setToolTip(f"Published by {variant.author} {published}".strip())
+            self._level_list.addItem(item)
+            if variant.level == last_level:
+                self._level_list.setCurrentItem(item)
+        if self._level_list.currentItem() is None:
+            self._level_list.setCurrentRow(0)
+        self._level_list.itemDoubleClicked.connect(lambda _item: self._on_accept())
+        main_layout.addWidget(self._level_list)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        action_btn = QPushButton(self._action)
+        action_btn.setProperty("accent", True)
+        action_btn.setDefault(True)
+        action_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(action_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Remember the chosen level and close"""
+        self._settings.setValue("lodImportLevel", self.get_level())
+        self.accept()
+
+    def get_level(self) -> Optional[str]:
+        """Get the chosen LOD level"""
+        item = self._level_list.currentItem()
+        return item.data(Qt.ItemDataRole.UserRole) if item else None
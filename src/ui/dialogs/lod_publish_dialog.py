@@ -0,0 +1,102 @@
+# -*- coding: utf-8 -*-
+"""
+LOD Publish Dialog
+Choose which level of detail the selection is published as
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QLineEdit,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...core.models.lod_variant import LOD_LEVELS, LodVariant
+from ...services.localization_service_impl import tr
+
+
+class LodPublishDialog(QDialog):
+    """
+    LOD Publish Dialog - Single Responsibility for LOD variant publish options
+    """
+
+    def __init__(
+        self, asset_name: str, variants: List[LodVariant], selection_count: int, parent=None
+    ):
+        super().__init__(parent)
+
+        self._asset_name = asset_name
+        self._variants = {variant.level: variant for variant in variants}
+        self._selection_count = selection_count
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish LOD Variant"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Publish LOD of {self._asset_name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        base = next(variant for variant in self._variants.values() if variant.is_base)
+        desc_label = QLabel(
+            f"Exports the {self._selection_count} selected node(s) as another representation "
+            f"of {self._asset_name}. The asset file itself is {base.level}."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._level_combo = QComboBox()
+        for level in LOD_LEVELS:
+            if level == base.level:
+                continue
+            label = f"{level} (replace published)" if level in self._variants else level
+            self._level_combo.addItem(label, level)
+        form_layout.addRow("Level:", self._level_combo)
+
+        self._notes_edit = QLineEdit()
+        self._notes_edit.setPlaceholderText(tr("e.g. 2k triangles for mid distance"))
+        form_layout.addRow("Notes:", self._notes_edit)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self.accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (level, notes)"""
+        return {
+            "level": self._level_combo.currentData(),
+            "notes": self._notes_edit.text().strip(),
+        }
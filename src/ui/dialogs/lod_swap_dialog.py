@@ -0,0 +1,162 @@
+# -*- coding: utf-8 -*-
+"""
+LOD Swap Dialog
+Switch asset LODs already loaded in the scene to another level
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QComboBox,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.lod_variant import LOD_LEVELS
+from ...services.localization_service_impl import tr
+
+
+class LodSwapDialog(QDialog):
+    """
+    LOD Swap Dialog - Single Responsibility for scene LOD switching
+    Each swap is one undo step in Maya
+    """
+
+    COLUMNS = ["Asset", "Loaded", "Current", "Swap To"]
+    KEEP = "(keep)"
+
+    def __init__(self, lod_service, cmds: Any, parent=None):
+        super().__init__(parent)
+
+        self._service = lod_service
+        self._cmds = cmds
+        self._scene_lods: List[Any] = []
+        self._level_combos: List[QComboBox] = []
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Swap LODs"))
+        self.setMinimumSize(560, 340)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Swap LODs"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Assets loaded with a level of detail. References keep their edits; imported "
+                "assets are re-imported in place."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.NoSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        all_layout = QHBoxLayout()
+        all_layout.addWidget(QLabel(tr("Set all to:")))
+        self._all_combo = QComboBox()
+        self._all_combo.addItem(self.KEEP, "")
+        for level in LOD_LEVELS:
+            self._all_combo.addItem(level, level)
+        self._all_combo.currentIndexChanged.connect(self._on_set_all)
+        all_layout.addWidget(self._all_combo)
+        all_layout.addStretch()
+        main_layout.addLayout(all_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        swap_btn = QPushButton(tr("Swap"))
+        swap_btn.setProperty("accent", True)
+        swap_btn.clicked.connect(self._on_swap)
+        button_layout.addWidget(swap_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """List the scene's loaded LODs with the levels each asset offers"""
+        self._scene_lods = self._service.find_scene_lods(self._cmds)
+        self._level_combos = []
+        self._table.setRowCount(len(self._scene_lods))
+
+        for row, scene_lod in enumerate(self._scene_lods):
+            loaded = scene_lod.reference_node or ", ".join(
+                node.split("|")[-1] for node in scene_lod.nodes
+            )
+            for column, text in enumerate([scene_lod.asset_file.stem, loaded, scene_lod.level]):
+                item = QTableWidgetItem(text)
+                item.setToolTip(str(scene_lod.asset_file))
+                self._table.setItem(row, column, item)
+
+            combo = QComboBox()
+            combo.addItem(self.KEEP, "")
+            for variant in self._service.get_variants(scene_lod.asset_file):
+                if variant.level != scene_lod.level:
+                    combo.addItem(variant.label, variant.level)
+            self._table.setCellWidget(row, 3, combo)
+            self._level_combos.append(combo)
+
+        self._table.resizeColumnsToContents()
+        if not self._scene_lods:
+            self._table.setRowCount(1)
+            self._table.setItem(0, 0, QTableWidgetItem("No asset LODs loaded in the scene"))
+
+    def _on_set_all(self) -> None:
+        """Pick the same level for every asset that offers it"""
+        level = self._all_combo.currentData()
+        for combo in self._level_combos:
+            index = combo.findData(level) if level else 0
+            if index >= 0:
+                combo.setCurrentIndex(index)
+
+    def _on_swap(self) -> None:
+        """Swap every row with a new level chosen"""
+        swapped, failed = 0, []
+        for scene_lod, combo in zip(self._scene_lods, self._level_combos):
+            level = combo.currentData()
+            if not level:
+                continue
+            try:
+                self._service.swap(self._cmds, scene_lod, level)
+                swapped += 1
+            except Exception as e:
+                failed.append(f"{scene_lod.label}: {e}")
+
+        if failed:
+            QMessageBox.warning(self, tr("Swap Failed"), "\n".join(failed))
+        self._all_combo.setCurrentIndex(0)
+        self._refresh()
+        if swapped and self.parent() is not None and hasattr(self.parent(), "_set_status"):
+            self.parent()._set_status(f"Swapped {swapped} LOD(s)")
@@ -0,0 +1,290 @@
+# -*- coding: utf-8 -*-
+"""
+Library Maintenance Dialog
+Schedule a library's maintenance jobs, run them now, and read what they reported
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List
+
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QCheckBox,
+    QSpinBox,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPlainTextEdit,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.maintenance_schedule import (
+    MaintenanceJob,
+    MaintenanceResult,
+    MaintenanceSchedule,
+)
+from ...services.localization_service_impl import tr
+
+
+class MaintenanceDialog(QDialog):
+    """
+    Maintenance Dialog - Single Responsibility for the library maintenance admin panel
+    Jobs run on a worker thread; results come back through a signal
+    """
+
+    run_finished = Signal(list)
+
+    SCHEDULE_COLUMNS = ["Task", "Schedule", "Enabled", "Last Run", "Result"]
+    REPORT_COLUMNS = ["Finished", "Task", "Result", "Summary", "Host"]
+
+    def __init__(self, maintenance_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = maintenance_service
+        self._library_root = Path(library_root)
+        self._schedule = self._service.load_schedule(self._library_root)
+        self._report: List[MaintenanceResult] = []
+        self._running = False
+
+        self.run_finished.connect(self._on_run_finished)
+
+        self._setup_ui()
+        self._load_schedule()
+        self._load_report()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Library Maintenance"))
+        self.setMinimumSize(760, 560)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Maintenance of {self._library_root.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Schedules are cron expressions (minute hour day month weekday). Due jobs run "
+                "from maintenance_batch.py in mayapy, or from idle Maya sessions when allowed."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._schedule_table = QTableWidget(0, len(self.SCHEDULE_COLUMNS))
+        self._schedule_table.setHorizontalHeaderLabels(self.SCHEDULE_COLUMNS)
+        self._schedule_table.horizontalHeader().setSectionResizeMode(
+            4, QHeaderView.ResizeMode.Stretch
+        )
+        self._schedule_table.verticalHeader().setVisible(False)
+        self._schedule_table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._schedule_table.setColumnWidth(0, 170)
+        self._schedule_table.setColumnWidth(1, 110)
+        self._schedule_table.setColumnWidth(3, 130)
+        main_layout.addWidget(self._schedule_table)
+
+        options_layout = QHBoxLayout()
+        self._idle_check = QCheckBox(tr("Run due jobs from idle Maya sessions"))
+        self._idle_check.setToolTip(
+            tr("Artists' Maya sessions check for due jobs every minute while nothing is open")
+        )
+        options_layout.addWidget(self._idle_check)
+        options_layout.addStretch()
+        options_layout.addWidget(QLabel(tr("Release locks older than:")))
+        self._lock_hours_spin = QSpinBox()
+        self._lock_hours_spin.setRange(1, 24 * 90)
+        self._lock_hours_spin.setSuffix(" h")
+        options_layout.addWidget(self._lock_hours_spin)
+        main_layout.addLayout(options_layout)
+
+        report_label = QLabel(tr("Maintenance Report"))
+        main_layout.addWidget(report_label)
+
+        self._report_table = QTableWidget(0, len(self.REPORT_COLUMNS))
+        self._report_table.setHorizontalHeaderLabels(self.REPORT_COLUMNS)
+        self._report_table.horizontalHeader().setSectionResizeMode(
+            3, QHeaderView.ResizeMode.Stretch
+        )
+        self._report_table.verticalHeader().setVisible(False)
+        self._report_table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._report_table.setSelectionMode(QTableWidget.SelectionMode.SingleSelection)
+        self._report_table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        self._report_table.setColumnWidth(0, 130)
+        self._report_table.setColumnWidth(1, 150)
+        self._report_table.itemSelectionChanged.connect(self._on_report_selected)
+        main_layout.addWidget(self._report_table, 1)
+
+        self._details_edit = QPlainTextEdit()
+        self._details_edit.setReadOnly(True)
+        self._details_edit.setMaximumHeight(110)
+        self._details_edit.setPlaceholderText(tr("Select a report entry to see its details"))
+        main_layout.addWidget(self._details_edit)
+
+        self._status_label = QLabel()
+        main_layout.addWidget(self._status_label)
+
+        button_layout = QHBoxLayout()
+
+        self._run_btn = QPushButton(tr("Run Selected Now"))
+        self._run_btn.setToolTip(tr("Run the selected tasks whatever their schedule"))
+        self._run_btn.clicked.connect(self._on_run_selected)
+        button_layout.addWidget(self._run_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Schedule"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.reject)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_schedule(self) -> None:
+        """Fill the schedule table with every task's job"""
+        self._schedule_table.setRowCount(0)
+        for job in self._schedule.jobs:
+            row = self._schedule_table.rowCount()
+            self._schedule_table.insertRow(row)
+
+            task_item = QTableWidgetItem(job.label)
+            task_item.setData(Qt.ItemDataRole.UserRole, job.task)
+            task_item.setFlags(task_item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+            self._schedule_table.setItem(row, 0, task_item)
+
+            self._schedule_table.setItem(row, 1, QTableWidgetItem(job.schedule))
+
+            enabled_item = QTableWidgetItem()
+            enabled_item.setFlags(Qt.ItemFlag.ItemIsEnabled | Qt.ItemFlag.ItemIsUserCheckable)
+            enabled_item.setCheckState(
+                Qt.CheckState.Checked if job.enabled else Qt.CheckState.Unchecked
+            )
+            self._schedule_table.setItem(row, 2, enabled_item)
+
+            for column in (3, 4):
+                item = QTableWidgetItem()
+                item.setFlags(item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+                self._schedule_table.setItem(row, column, item)
+
+        self._idle_check.setChecked(self._schedule.run_on_idle)
+        self._lock_hours_spin.setValue(self._schedule.stale_lock_hours)
+
+    def _load_report(self) -> None:
+        """Fill the report table, newest first"""
+        self._report = self._service.get_report(self._library_root)
+        self._report_table.setRowCount(0)
+        for result in self._report:
+            row = self._report_table.rowCount()
+            self._report_table.insertRow(row)
+            values = [
+                result.finished.strftime("%Y-%m-%d %H:%M"),
+                result.label,
+                "OK" if result.success else "Failed",
+                result.summary,
+                result.host,
+            ]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(f"{result.trigger.capitalize()} run, {result.duration:.1f} s")
+                self._report_table.setItem(row, column, item)
+        self._details_edit.clear()
+        self._status_label.setText(f"{len(self._report)} report entries")
+        self._show_last_runs()
+
+    def _show_last_runs(self) -> None:
+        """Show when each task last ran, keeping unsaved schedule edits"""
+        last_results = {}
+        for result in reversed(self._report):
+            last_results[result.task] = result
+        for row in range(self._schedule_table.rowCount()):
+            task = self._schedule_table.item(row, 0).data(Qt.ItemDataRole.UserRole)
+            result = last_results.get(task)
+            last_run = result.finished.strftime("%Y-%m-%d %H:%M") if result else "Never"
+            self._schedule_table.item(row, 3).setText(last_run)
+            self._schedule_table.item(row, 4).setText(result.summary if result else "")
+
+    def _on_report_selected(self) -> None:
+        """Show the details of the selected report entry"""
+        rows = {index.row() for index in self._report_table.selectedIndexes()}
+        if not rows:
+            self._details_edit.clear()
+            return
+        result = self._report[min(rows)]
+        self._details_edit.setPlainText("\n".join(result.details) or result.summary)
+
+    def _read_schedule(self) -> MaintenanceSchedule:
+        """Build the schedule from the table; raises ValueError for invalid cron"""
+        jobs = []
+        for row in range(self._schedule_table.rowCount()):
+            task = self._schedule_table.item(row, 0).data(Qt.ItemDataRole.UserRole)
+            schedule = self._schedule_table.item(row, 1).text().strip()
+            enabled = self._schedule_table.item(row, 2).checkState() == Qt.CheckState.Checked
+            try:
+                jobs.append(MaintenanceJob(task, schedule, enabled))
+            except ValueError as e:
+                label = self._schedule_table.item(row, 0).text()
+                raise ValueError(f"{label}: {e}") from None
+        return MaintenanceSchedule(
+            jobs=tuple(jobs),
+            run_on_idle=self._idle_check.isChecked(),
+            stale_lock_hours=self._lock_hours_spin.value(),
+        )
+
+    def _on_save_clicked(self) -> None:
+        """Store the schedule in the library"""
+        try:
+            schedule = self._read_schedule()
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Schedule"), str(e))
+            return
+        if not self._service.save_schedule(self._library_root, schedule):
+            QMessageBox.critical(self, tr("Error"), tr("Could not save the maintenance schedule."))
+            return
+        self._schedule = schedule
+        self._status_label.setText(tr("Schedule saved"))
+
+    def _on_run_selected(self) -> None:
+        """Run the selected tasks on a worker thread"""
+        if self._running:
+            return
+        rows = sorted({index.row() for index in self._schedule_table.selectedIndexes()})
+        tasks = [
+            self._schedule_table.item(row, 0).data(Qt.ItemDataRole.UserRole) for row in rows
+        ]
+        if not tasks:
+            QMessageBox.information(self, tr("No Task"), tr("Select the tasks to run first."))
+            return
+        self._running = True
+        self._run_btn.setEnabled(False)
+        self._status_label.setText(f"Running {len(tasks)} task(s)...")
+        self._service.run_in_background(
+            self._library_root, self.run_finished.emit, tasks, trigger="manual"
+        )
+
+    def _on_run_finished(self, results: List[MaintenanceResult]) -> None:
+        """Show what the worker ran"""
+        self._running = False
+        self._run_btn.setEnabled(True)
+        self._load_report()
+        if not results:
+            self._status_label.setText(tr("Maintenance is already running on another machine"))
+            return
+        failed = sum(1 for result in results if not result.success)
+        self._status_label.setText(f"Ran {len(results)} task(s), {failed} failed")
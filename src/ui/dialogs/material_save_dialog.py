@@ -0,0 +1,138 @@
+# -*- coding: utf-8 -*-
+"""
+Material Save Dialog
+Pick the shading group to save as a material preset and name it
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class MaterialSaveDialog(QDialog):
+    """
+    Material Save Dialog - Single Responsibility for material preset options
+    """
+
+    def __init__(self, networks: List[Dict[str, str]], can_render_swatch: bool, parent=None):
+        """
+        Args:
+            networks: One dict per shading group (engine, shader, shader_type, renderer)
+            can_render_swatch: Whether background mayapy rendering is available
+        """
+        super().__init__(parent)
+
+        self._networks = networks
+        self._can_render_swatch = can_render_swatch
+
+        self._setup_ui()
+        self._on_network_changed()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Save Material"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Save Material Preset"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The shading network and its file textures are copied into the library. "
+                "Assign it later to meshes or faces straight from the browser."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._network_combo = QComboBox()
+        for network in self._networks:
+            self._network_combo.addItem(
+                f"{network['shader']} ({network['shader_type']})", network["engine"]
+            )
+        self._network_combo.currentIndexChanged.connect(self._on_network_changed)
+        form_layout.addRow("Shading group:", self._network_combo)
+
+        self._renderer_label = QLabel()
+        form_layout.addRow("Renderer:", self._renderer_label)
+
+        self._name_edit = QLineEdit()
+        form_layout.addRow("Material name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._swatch_check = QCheckBox(tr("Render swatch thumbnail"))
+        self._swatch_check.setChecked(self._can_render_swatch)
+        self._swatch_check.setEnabled(self._can_render_swatch)
+        if not self._can_render_swatch:
+            self._swatch_check.setToolTip(
+                tr("Swatches are rendered with mayapy (set MAYA_LOCATION)")
+            )
+        form_layout.addRow("", self._swatch_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Material"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_network_changed(self) -> None:
+        """Default the name to the chosen shader"""
+        network = self._networks[self._network_combo.currentIndex()]
+        self._renderer_label.setText(network["renderer"].capitalize())
+        self._name_edit.setText(network["shader"])
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a material name."))
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get save options (engine, name, notes, render_swatch)"""
+        return {
+            "engine": self._network_combo.currentData(),
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "render_swatch": self._swatch_check.isChecked(),
+        }
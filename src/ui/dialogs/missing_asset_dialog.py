@@ -0,0 +1,176 @@
+# -*- coding: utf-8 -*-
+"""
+Missing Asset Dialog
+Choose how to resolve a referenced asset whose file is missing
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QRadioButton,
+    QButtonGroup,
+    QGroupBox,
+    QPushButton,
+    QFileDialog,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+from ...services.maya_integration_impl import REFERENCE_FILE_TYPES
+from ...services.missing_asset_service_impl import (
+    RESOLUTIONS,
+    RESOLVE_REMOVE,
+    RESOLVE_REPATH,
+    RESOLVE_VERSION,
+)
+
+
+class MissingAssetDialog(QDialog):
+    """
+    Missing Asset Dialog - Single Responsibility for the missing reference choice
+    Another version is offered first when one is on disk, otherwise a repath
+    """
+
+    def __init__(
+        self,
+        scene_asset: Any,
+        choices: List[Tuple[str, Path]],
+        info: Optional[Dict[str, Any]] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            scene_asset: Missing referenced asset
+            choices: (label, file) of the versions that could be loaded instead
+            info: Asset metadata the placeholder carries
+        """
+        super().__init__(parent)
+
+        self._scene_asset = scene_asset
+        self._choices = choices
+        self._info = info or {}
+
+        self._setup_ui()
+        self._on_resolution_changed()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Resolve Missing File"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Resolve {name}", name=self._scene_asset.label))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        missing_file = self._scene_asset.file_path or self._scene_asset.asset_file
+        details = [f"Missing: {missing_file}"]
+        if self._scene_asset.loaded_version:
+            details.append(f"Version: {self._scene_asset.version_label}")
+        for key in ("author", "description"):
+            if self._info.get(key):
+                details.append(f"{key.title()}: {self._info[key]}")
+        desc_label = QLabel("\n".join(details))
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        resolution_box = QGroupBox(tr("Resolve by"))
+        resolution_layout = QVBoxLayout(resolution_box)
+        self._resolution_group = QButtonGroup(self)
+
+        version_radio = QRadioButton(tr("Loading another version"))
+        version_radio.setEnabled(bool(self._choices))
+        self._resolution_group.addButton(version_radio, RESOLUTIONS.index(RESOLVE_VERSION))
+        resolution_layout.addWidget(version_radio)
+        self._version_combo = QComboBox()
+        for label, file_path in self._choices:
+            self._version_combo.addItem(label, file_path)
+        resolution_layout.addWidget(self._version_combo)
+
+        repath_radio = QRadioButton(tr("Loading the reference from another file"))
+        self._resolution_group.addButton(repath_radio, RESOLUTIONS.index(RESOLVE_REPATH))
+        resolution_layout.addWidget(repath_radio)
+        path_layout = QHBoxLayout()
+        self._path_edit = QLineEdit()
+        self._path_edit.setPlaceholderText(tr("File on another server or drive"))
+        self._path_edit.textChanged.connect(self._on_resolution_changed)
+        path_layout.addWidget(self._path_edit, 1)
+        self._browse_btn = QPushButton(tr("Browse..."))
+        self._browse_btn.clicked.connect(self._on_browse_clicked)
+        path_layout.addWidget(self._browse_btn)
+        resolution_layout.addLayout(path_layout)
+
+        remove_radio = QRadioButton(tr("Removing the reference and its placeholder"))
+        self._resolution_group.addButton(remove_radio, RESOLUTIONS.index(RESOLVE_REMOVE))
+        resolution_layout.addWidget(remove_radio)
+        main_layout.addWidget(resolution_box)
+
+        (version_radio if self._choices else repath_radio).setChecked(True)
+        for radio in (version_radio, repath_radio, remove_radio):
+            radio.toggled.connect(self._on_resolution_changed)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._resolve_btn = QPushButton(tr("Resolve"))
+        self._resolve_btn.setProperty("accent", True)
+        self._resolve_btn.setDefault(True)
+        self._resolve_btn.clicked.connect(self.accept)
+        button_layout.addWidget(self._resolve_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_resolution_changed(self, *_args) -> None:
+        """Enable the inputs of the chosen resolution"""
+        resolution = self.get_resolution()
+        self._version_combo.setEnabled(resolution == RESOLVE_VERSION)
+        self._path_edit.setEnabled(resolution == RESOLVE_REPATH)
+        self._browse_btn.setEnabled(resolution == RESOLVE_REPATH)
+        self._resolve_btn.setEnabled(
+            resolution != RESOLVE_REPATH or bool(self._path_edit.text().strip())
+        )
+
+    def _on_browse_clicked(self) -> None:
+        """Pick the file the reference loads"""
+        patterns = " ".join(f"*{extension}" for extension in REFERENCE_FILE_TYPES)
+        missing_file = Path(self._scene_asset.file_path or self._scene_asset.asset_file)
+        file_path, _ = QFileDialog.getOpenFileName(
+            self,
+            tr("Choose Reference File"),
+            self._path_edit.text() or missing_file.name,
+            f"Referenceable files ({patterns})",
+        )
+        if file_path:
+            self._path_edit.setText(file_path)
+
+    def get_resolution(self) -> str:
+        """Get the chosen resolution"""
+        return RESOLUTIONS[max(self._resolution_group.checkedId(), 0)]
+
+    def get_file_path(self) -> Optional[Path]:
+        """Get the file to load, None when removing"""
+        resolution = self.get_resolution()
+        if resolution == RESOLVE_VERSION:
+            return self._version_combo.currentData()
+        if resolution == RESOLVE_REPATH:
+            return Path(self._path_edit.text().strip()).expanduser()
+        return None
@@ -0,0 +1,157 @@
+# -*- coding: utf-8 -*-
+"""
+Naming Templates Dialog
+Edit the folder and file naming templates scene publishes of a library follow
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from datetime import datetime
+from pathlib import Path
+from typing import Dict, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QGroupBox,
+    QLabel,
+    QLineEdit,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from .create_asset_dialog import CreateAssetDialog
+from ...core.models.naming_template import NamingTemplate
+from ...services.localization_service_impl import tr
+from ...services.version_service_impl import get_current_user
+
+EXAMPLE_TEMPLATE = "{category}/{assetName}/{version}/{assetName}_{version}.ma"
+
+
+class NamingTemplatesDialog(QDialog):
+    """
+    Naming Templates Dialog - Single Responsibility for library naming configuration
+    """
+
+    def __init__(self, naming_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = naming_service
+        self._library_root = Path(library_root)
+        self._default, self._overrides = naming_service.load_templates(self._library_root)
+
+        self._setup_ui()
+        self._update_preview()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Naming Templates"))
+        self.setMinimumSize(560, 480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Naming Templates"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "Scene publishes land at this path inside the library's assets folder. Tokens: "
+            "{assetName}, {type} or {category}, {version}, {artist}, {date}. The publish "
+            "format replaces the extension; with {version} every publish gets its own file. "
+            "Leave the template empty to publish into assets/scenes and assets/models.\n"
+            f"{self._service.get_templates_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        default_group = QGroupBox(tr("Default Template"))
+        default_layout = QFormLayout(default_group)
+        self._default_edit = QLineEdit(self._default.pattern if self._default else "")
+        self._default_edit.setPlaceholderText(EXAMPLE_TEMPLATE)
+        self._default_edit.textChanged.connect(self._update_preview)
+        default_layout.addRow("Template:", self._default_edit)
+        self._preview_label = QLabel()
+        self._preview_label.setWordWrap(True)
+        default_layout.addRow("Example:", self._preview_label)
+        main_layout.addWidget(default_group)
+
+        types_group = QGroupBox(tr("Asset Type Overrides"))
+        types_layout = QFormLayout(types_group)
+        self._type_edits: Dict[str, QLineEdit] = {}
+        for asset_type in CreateAssetDialog.CATEGORIES:
+            override = self._overrides.get(asset_type)
+            edit = QLineEdit(override.pattern if override else "")
+            edit.setPlaceholderText(tr("(default template)"))
+            self._type_edits[asset_type] = edit
+            types_layout.addRow(f"{asset_type}:", edit)
+        main_layout.addWidget(types_group, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _update_preview(self) -> None:
+        """Show where a third publish of a prop would land under the default template"""
+        pattern = self._default_edit.text().strip()
+        if not pattern:
+            self._preview_label.setText("assets/scenes/crate.ma")
+            return
+        try:
+            template = NamingTemplate(pattern)
+        except ValueError as e:
+            self._preview_label.setText(str(e))
+            return
+        values = {
+            "assetName": "crate",
+            "type": "Props",
+            "artist": get_current_user(),
+            "date": datetime.now().strftime("%Y-%m-%d"),
+        }
+        self._preview_label.setText(f"assets/{template.render(values, '.ma', 3)}")
+
+    def _parse(self, pattern: str, label: str) -> Optional[NamingTemplate]:
+        """Create a template from a field, raising ValueError naming the field"""
+        pattern = pattern.strip()
+        if not pattern:
+            return None
+        try:
+            return NamingTemplate(pattern)
+        except ValueError as e:
+            raise ValueError(f"{label}: {e}") from e
+
+    def _on_save_clicked(self) -> None:
+        """Store the templates in the library and close"""
+        try:
+            default = self._parse(self._default_edit.text(), "Default template")
+            overrides = {}
+            for asset_type, edit in self._type_edits.items():
+                template = self._parse(edit.text(), asset_type)
+                if template is not None:
+                    overrides[asset_type] = template
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Template"), str(e))
+            return
+        if not self._service.save_templates(self._library_root, default, overrides):
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the naming templates.")
+            )
+            return
+        self.accept()
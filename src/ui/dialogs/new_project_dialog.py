@@ -14,6 +14,8 @@ from pathlib import Path
 # PySide6 for Maya 2022+
 from PySide6.QtWidgets import QDialog, QVBoxLayout, QLabel, QPushButton, QLineEdit, QFileDialog
 
+from ...services.localization_service_impl import tr
+
 PYSIDE_AVAILABLE = True
 
 
@@ -22,7 +24,7 @@ class NewProjectDialog(QDialog):
 
     def __init__(self, parent=None):
         super().__init__(parent)
-        self.setWindowTitle("New Project")
+        self.setWindowTitle(tr("New Project"))
         self.setModal(True)
 
         self._project_path = None
@@ -30,25 +32,25 @@ class NewProjectDialog(QDialog):
         # Create placeholder UI
         layout = QVBoxLayout(self)
 
-        label = QLabel("Project creation functionality will be implemented here.")
+        label = QLabel(tr("Project creation functionality will be implemented here."))
         layout.addWidget(label)
 
         # Path input
         self._path_input = QLineEdit()
-        self._path_input.setPlaceholderText("Project path...")
+        self._path_input.setPlaceholderText(tr("Project path..."))
         layout.addWidget(self._path_input)
 
         # Browse button
-        browse_btn = QPushButton("Browse...")
+        browse_btn = QPushButton(tr("Browse..."))
         browse_btn.clicked.connect(self._browse_for_path)
         layout.addWidget(browse_btn)
 
         # OK/Cancel buttons
-        ok_btn = QPushButton("OK")
+        ok_btn = QPushButton(tr("OK"))
         ok_btn.clicked.connect(self.accept)
         layout.addWidget(ok_btn)
 
-        cancel_btn = QPushButton("Cancel")
+        cancel_btn = QPushButton(tr("Cancel"))
         cancel_btn.clicked.connect(self.reject)
         layout.addWidget(cancel_btn)
 
@@ -0,0 +1,135 @@
+# -*- coding: utf-8 -*-
+"""
+Path Mapping Dialog
+Edit where a library is mounted on each operating system
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Dict, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.path_mapping import PLATFORM_LABELS, PLATFORMS, PathMapping, get_platform
+from ...services.localization_service_impl import tr
+
+
+class PathMappingDialog(QDialog):
+    """
+    Path Mapping Dialog - Single Responsibility for a library's mount table
+    Blank platforms fall back to the folder the library is opened from
+    """
+
+    def __init__(self, path_mapping_service, library_root: Path, parent=None):
+        """
+        Args:
+            path_mapping_service: PathMappingService reading the library's current table
+            library_root: Library being described
+        """
+        super().__init__(parent)
+
+        self._library_root = Path(library_root)
+        self._service = path_mapping_service
+        self._mapping = path_mapping_service.get_mapping(self._library_root)
+        self._result: Optional[PathMapping] = None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Path Mapping"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Path Mapping - {name}", name=self._library_root.name))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Paths written into scenes start with the root token instead of the drive or "
+                "mount, and the token is set to this machine's root when the library opens. "
+                "Set the token in Maya.env too, so scenes opened first find their references."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._token_edit = QLineEdit(self._mapping.token)
+        self._token_edit.setPlaceholderText(tr("Environment variable, e.g. ASSET_ROOT"))
+        form_layout.addRow("Root token:", self._token_edit)
+
+        self._root_edits: Dict[str, QLineEdit] = {}
+        for platform in PLATFORMS:
+            edit = QLineEdit(self._mapping.get_root(platform))
+            edit.setPlaceholderText(tr("Where the library is mounted on this platform"))
+            self._root_edits[platform] = edit
+            form_layout.addRow(f"{PLATFORM_LABELS[platform]}:", edit)
+        main_layout.addLayout(form_layout)
+
+        local_platform = get_platform()
+        local_root = self._service.get_local_root(self._library_root, self._mapping)
+        local_label = QLabel(
+            f"This machine ({PLATFORM_LABELS[local_platform]}) uses {local_root or '-'}"
+        )
+        local_label.setProperty("description", True)
+        main_layout.addWidget(local_label)
+
+        button_layout = QHBoxLayout()
+        this_btn = QPushButton(tr("Use This Folder"))
+        this_btn.setToolTip(tr("Enter the folder the library is open from for this platform"))
+        this_btn.clicked.connect(
+            lambda: self._root_edits[local_platform].setText(self._library_root.as_posix())
+        )
+        button_layout.addWidget(this_btn)
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        try:
+            self._result = PathMapping.from_dict(
+                {
+                    "token": self._token_edit.text(),
+                    "roots": {
+                        platform: edit.text() for platform, edit in self._root_edits.items()
+                    },
+                }
+            )
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Path Mapping"), str(e))
+            return
+        self.accept()
+
+    def get_mapping(self) -> Optional[PathMapping]:
+        """Get the entered table, None until saved"""
+        return self._result
@@ -0,0 +1,101 @@
+# -*- coding: utf-8 -*-
+"""
+Pipeline Hooks Dialog
+List the studio callbacks registered for each hook point and reload hook files
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.hook_service_impl import HOOK_POINTS
+from ...services.localization_service_impl import tr
+
+
+class PipelineHooksDialog(QDialog):
+    """
+    Pipeline Hooks Dialog - Single Responsibility for showing loaded hook callbacks
+    """
+
+    def __init__(self, hook_service, library_root: Optional[Path], parent=None):
+        super().__init__(parent)
+
+        self._service = hook_service
+        self._library_root = library_root
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Pipeline Hooks"))
+        self.setMinimumSize(620, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Pipeline Hooks"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        folders = "\n".join(
+            str(folder) for folder in self._service.get_hook_directories(self._library_root)
+        )
+        desc_label = QLabel(
+            "Python files in these folders add callbacks to publishes, imports, and "
+            f"deletes. Edit them and press Reload to pick up changes:\n{folders}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._tree = QTreeWidget()
+        self._tree.setHeaderLabels(["Hook / Callback", "Source"])
+        self._tree.setColumnWidth(0, 240)
+        main_layout.addWidget(self._tree)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        reload_btn = QPushButton(tr("Reload Hooks"))
+        reload_btn.setProperty("accent", True)
+        reload_btn.clicked.connect(self._on_reload)
+        button_layout.addWidget(reload_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """Show every hook point with its callbacks in run order"""
+        self._tree.clear()
+        for hook in HOOK_POINTS:
+            callbacks = self._service.get_callbacks(hook, self._library_root)
+            hook_item = QTreeWidgetItem([hook, f"{len(callbacks)} callbacks"])
+            for source, callback in callbacks:
+                name = getattr(callback, "__name__", repr(callback))
+                QTreeWidgetItem(hook_item, [name, source])
+            self._tree.addTopLevelItem(hook_item)
+            hook_item.setExpanded(bool(callbacks))
+
+    def _on_reload(self) -> None:
+        """Read the hook files again"""
+        self._service.load(self._library_root)
+        self._refresh()
@@ -0,0 +1,134 @@
+# -*- coding: utf-8 -*-
+"""
+Pose Apply Dialog
+Choose target namespace, blend percentage, and mirroring for applying a pose
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtCore import QSettings, Qt
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QCheckBox,
+    QSlider,
+    QSpinBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class PoseApplyDialog(QDialog):
+    """
+    Pose Apply Dialog - Single Responsibility for pose apply options
+    Blend and mirror choices are remembered between sessions
+    """
+
+    def __init__(
+        self,
+        pose: Dict[str, Any],
+        namespaces: List[str],
+        has_selection: bool,
+        parent=None,
+    ):
+        super().__init__(parent)
+
+        self._pose = pose
+        self._namespaces = namespaces
+        self._has_selection = has_selection
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Apply Pose"))
+        self.setMinimumWidth(380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Apply {self._pose.get('name', 'pose')}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            f"Rig: {self._pose.get('rig') or 'unknown'}  |  "
+            f"{len(self._pose.get('controls', {}))} controls"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._namespace_combo = QComboBox()
+        for namespace in self._namespaces:
+            self._namespace_combo.addItem(namespace or ": (root)", namespace)
+        source = self._pose.get("source_namespace", "")
+        if source in self._namespaces:
+            self._namespace_combo.setCurrentIndex(self._namespaces.index(source))
+        form_layout.addRow("Target namespace:", self._namespace_combo)
+
+        blend_layout = QHBoxLayout()
+        self._blend_slider = QSlider(Qt.Orientation.Horizontal)
+        self._blend_slider.setRange(0, 100)
+        self._blend_spin = QSpinBox()
+        self._blend_spin.setRange(0, 100)
+        self._blend_spin.setSuffix("%")
+        self._blend_slider.valueChanged.connect(self._blend_spin.setValue)
+        self._blend_spin.valueChanged.connect(self._blend_slider.setValue)
+        self._blend_slider.setValue(int(self._settings.value("poseBlend", 100)))
+        blend_layout.addWidget(self._blend_slider, 1)
+        blend_layout.addWidget(self._blend_spin)
+        form_layout.addRow("Blend:", blend_layout)
+
+        self._mirror_check = QCheckBox(tr("Mirror to the opposite side"))
+        self._mirror_check.setChecked(self._settings.value("poseMirror", False, type=bool))
+        form_layout.addRow("", self._mirror_check)
+
+        self._selected_only_check = QCheckBox(tr("Selected controls only"))
+        self._selected_only_check.setEnabled(self._has_selection)
+        form_layout.addRow("", self._selected_only_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        apply_btn = QPushButton(tr("Apply"))
+        apply_btn.setProperty("accent", True)
+        apply_btn.setDefault(True)
+        apply_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(apply_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Remember blend and mirror choices"""
+        self._settings.setValue("poseBlend", self._blend_spin.value())
+        self._settings.setValue("poseMirror", self._mirror_check.isChecked())
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get apply options (namespace, blend 0-1, mirror, selected_only)"""
+        return {
+            "namespace": self._namespace_combo.currentData() or "",
+            "blend": self._blend_spin.value() / 100.0,
+            "mirror": self._mirror_check.isChecked(),
+            "selected_only": self._selected_only_check.isChecked(),
+        }
@@ -0,0 +1,110 @@
+# -*- coding: utf-8 -*-
+"""
+Pose Save Dialog
+Name and notes for saving the selected controls as a pose asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class PoseSaveDialog(QDialog):
+    """
+    Pose Save Dialog - Single Responsibility for pose capture options
+    """
+
+    def __init__(self, rig_identifier: str, control_count: int, parent=None):
+        super().__init__(parent)
+
+        self._rig_identifier = rig_identifier
+        self._control_count = control_count
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Save Pose"))
+        self.setMinimumWidth(380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Save Pose"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Stores the keyable values of {count} selected control(s) on rig '{rig}'.",
+                count=self._control_count,
+                rig=self._rig_identifier,
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._name_edit = QLineEdit()
+        self._name_edit.setPlaceholderText(tr("e.g. hand_fist"))
+        form_layout.addRow(tr("Pose name:"), self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow(tr("Notes:"), self._notes_edit)
+
+        self._thumbnail_check = QCheckBox(tr("Capture viewport thumbnail"))
+        self._thumbnail_check.setChecked(True)
+        form_layout.addRow("", self._thumbnail_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Pose"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a pose name."))
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get save options (name, notes, capture_thumbnail)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "capture_thumbnail": self._thumbnail_check.isChecked(),
+        }
@@ -0,0 +1,135 @@
+# -*- coding: utf-8 -*-
+"""
+Promote Scratch Dialog
+Choose the library and name a scratch asset is promoted under
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class PromoteScratchDialog(QDialog):
+    """
+    Promote Scratch Dialog - Single Responsibility for where a scratch asset goes
+    The suggested name is the scratch name without its time stamp
+    """
+
+    def __init__(
+        self,
+        scratch_file: Path,
+        suggested_name: str,
+        libraries: List,
+        current_library: Optional[Path] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            scratch_file: Scratch export being promoted
+            suggested_name: Name the asset is given in the library
+            libraries: LibraryEntry of every library it can go to (not the scratch one)
+            current_library: Library chosen first, the last one worked in
+        """
+        super().__init__(parent)
+
+        self._scratch_file = Path(scratch_file)
+        self._suggested_name = suggested_name
+        self._libraries = libraries
+        self._current_library = current_library
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Promote to Library"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Promote {name}", name=self._scratch_file.stem))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The scratch asset becomes a new asset of the library, with its first "
+                "version, and leaves your scratch library."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._library_combo = QComboBox()
+        for entry in self._libraries:
+            self._library_combo.addItem(entry.label, str(entry.root_path))
+            if self._current_library is not None and Path(entry.root_path) == Path(
+                self._current_library
+            ):
+                self._library_combo.setCurrentIndex(self._library_combo.count() - 1)
+        form_layout.addRow("Library:", self._library_combo)
+
+        self._name_edit = QLineEdit(self._suggested_name)
+        self._name_edit.selectAll()
+        form_layout.addRow("Asset name:", self._name_edit)
+
+        self._notes_edit = QLineEdit()
+        self._notes_edit.setPlaceholderText(
+            tr("Promoted from scratch {name}", name=self._scratch_file.name)
+        )
+        form_layout.addRow("Notes:", self._notes_edit)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._promote_btn = QPushButton(tr("Promote"))
+        self._promote_btn.setProperty("accent", True)
+        self._promote_btn.setDefault(True)
+        self._promote_btn.setEnabled(bool(self._libraries))
+        self._promote_btn.clicked.connect(self.accept)
+        button_layout.addWidget(self._promote_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+        self._name_edit.textChanged.connect(self._on_name_changed)
+
+    def _on_name_changed(self, text: str) -> None:
+        """Promote needs a name"""
+        self._promote_btn.setEnabled(bool(text.strip()) and bool(self._libraries))
+
+    def get_library_root(self) -> Path:
+        """Get the library chosen"""
+        return Path(self._library_combo.currentData())
+
+    def get_name(self) -> str:
+        """Get the asset name in the library"""
+        return self._name_edit.text().strip()
+
+    def get_notes(self) -> str:
+        """Get the first version's notes ("" for the default)"""
+        return self._notes_edit.text().strip()
@@ -0,0 +1,165 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Edits Dialog
+Keep, discard, or retarget the reference edits an update could not apply
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QComboBox,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.reference_edit import (
+    EDIT_ACTION_LABELS,
+    EDIT_ACTIONS,
+    EDIT_KEEP,
+    EDIT_RETARGET,
+)
+from ...services.localization_service_impl import tr
+
+
+class ReferenceEditsDialog(QDialog):
+    """
+    Reference Edits Dialog - Single Responsibility for edits lost on reference updates
+    Kept edits stay on the reference node and apply again if the node comes back
+    """
+
+    COLUMN_REFERENCE = 0
+    COLUMN_EDIT = 1
+    COLUMN_PROBLEM = 2
+    COLUMN_ACTION = 3
+    COLUMN_TARGET = 4
+
+    def __init__(self, update_service, cmds: Any, lost_edits: List[Any], parent=None):
+        """
+        Args:
+            update_service: ReferenceUpdateService resolving the edits
+            cmds: maya.cmds module
+            lost_edits: ReferenceEdit of every target whose edits failed on the update
+        """
+        super().__init__(parent)
+
+        self._service = update_service
+        self._cmds = cmds
+        self._edits = lost_edits
+        self._action_combos: List[QComboBox] = []
+        self._target_combos: List[QComboBox] = []
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Reference Edits"))
+        self.setMinimumSize(760, 340)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Edits Lost on Update"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        count = sum(len(edit.edits) for edit in self._edits)
+        desc_label = QLabel(
+            tr(
+                "{count} reference edit(s), such as animation offsets, no longer apply because "
+                "the new version renamed or removed what they change. Kept edits apply again "
+                "if a later version brings it back; retargeted edits move to another node.",
+                count=count,
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(len(self._edits), 5)
+        self._table.setHorizontalHeaderLabels(
+            [tr("Reference"), tr("Edit"), tr("Problem"), tr("Action"), tr("Retarget To")]
+        )
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.NoSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        header = self._table.horizontalHeader()
+        header.setSectionResizeMode(self.COLUMN_EDIT, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(self.COLUMN_TARGET, QHeaderView.ResizeMode.Stretch)
+
+        for row, edit in enumerate(self._edits):
+            self._table.setItem(row, self.COLUMN_REFERENCE, QTableWidgetItem(edit.reference_node))
+            edit_item = QTableWidgetItem(edit.label)
+            edit_item.setToolTip("\n".join(edit.edits))
+            self._table.setItem(row, self.COLUMN_EDIT, edit_item)
+            self._table.setItem(row, self.COLUMN_PROBLEM, QTableWidgetItem(edit.problem))
+
+            suggestions = self._service.suggest_targets(self._cmds, edit)
+            action_combo = QComboBox()
+            for action in EDIT_ACTIONS:
+                action_combo.addItem(EDIT_ACTION_LABELS[action], action)
+            target_combo = QComboBox()
+            target_combo.setEditable(True)
+            target_combo.addItems(suggestions)
+            target_combo.setToolTip(tr("Node (or node.attribute) the edits should change"))
+            target_combo.setEnabled(False)
+            action_combo.currentIndexChanged.connect(
+                lambda _index, row=row: self._on_action_changed(row)
+            )
+            self._table.setCellWidget(row, self.COLUMN_ACTION, action_combo)
+            self._table.setCellWidget(row, self.COLUMN_TARGET, target_combo)
+            self._action_combos.append(action_combo)
+            self._target_combos.append(target_combo)
+            # A close match for a renamed node is most likely where the edits belong
+            if suggestions:
+                action_combo.setCurrentIndex(action_combo.findData(EDIT_RETARGET))
+        self._table.resizeColumnsToContents()
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        apply_btn = QPushButton(tr("Apply"))
+        apply_btn.setProperty("accent", True)
+        apply_btn.setDefault(True)
+        apply_btn.clicked.connect(self._on_apply_clicked)
+        button_layout.addWidget(apply_btn)
+
+        keep_btn = QPushButton(tr("Keep All"))
+        keep_btn.setToolTip(tr("Leave every edit on its reference node"))
+        keep_btn.clicked.connect(self.reject)
+        button_layout.addWidget(keep_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_action_changed(self, row: int) -> None:
+        """Retarget To only applies to retargeted edits"""
+        action = self._action_combos[row].currentData()
+        self._target_combos[row].setEnabled(action == EDIT_RETARGET)
+
+    def _on_apply_clicked(self) -> None:
+        """Discard and retarget the edits as chosen"""
+        choices = []
+        for row, edit in enumerate(self._edits):
+            action = self._action_combos[row].currentData()
+            if action != EDIT_KEEP:
+                choices.append((edit, action, self._target_combos[row].currentText()))
+        still_failed, errors = self._service.resolve_edits(self._cmds, choices)
+        if still_failed:
+            errors += [f"{edit.label}: still does not apply" for edit in still_failed]
+        if errors:
+            QMessageBox.warning(self, tr("Some Edits Not Resolved"), "\n".join(errors[:20]))
+        self.accept()
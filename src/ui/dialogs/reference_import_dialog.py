@@ -0,0 +1,139 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Import Dialog
+Namespace and grouping options for importing an asset as a Maya reference
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QLineEdit,
+    QCheckBox,
+    QRadioButton,
+    QButtonGroup,
+    QGroupBox,
+    QPushButton,
+)
+from PySide6.QtCore import QSettings
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class ReferenceImportDialog(QDialog):
+    """
+    Reference Import Dialog - Single Responsibility for reference options
+    Last used namespace mode and grouping are remembered between sessions
+    """
+
+    NAMESPACE_MODES = ["asset", "custom", "none"]
+
+    def __init__(self, asset_name: str, parent=None):
+        super().__init__(parent)
+
+        self._asset_name = asset_name
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+        self._restore_settings()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Import as Reference"))
+        self.setMinimumWidth(380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Reference {self._asset_name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The asset stays linked to the library file. Use Replace Reference later to "
+                "swap it to another asset or version without losing scene edits."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        namespace_box = QGroupBox(tr("Namespace"))
+        namespace_layout = QVBoxLayout(namespace_box)
+        self._namespace_group = QButtonGroup(self)
+
+        self._asset_radio = QRadioButton(f"Asset name ({self._asset_name})")
+        self._custom_radio = QRadioButton(tr("Custom:"))
+        self._none_radio = QRadioButton(tr("None (merge into root namespace)"))
+        for index, radio in enumerate([self._asset_radio, self._custom_radio, self._none_radio]):
+            self._namespace_group.addButton(radio, index)
+
+        namespace_layout.addWidget(self._asset_radio)
+        custom_layout = QHBoxLayout()
+        custom_layout.addWidget(self._custom_radio)
+        self._custom_edit = QLineEdit(self._asset_name)
+        self._custom_edit.setEnabled(False)
+        custom_layout.addWidget(self._custom_edit, 1)
+        namespace_layout.addLayout(custom_layout)
+        namespace_layout.addWidget(self._none_radio)
+        self._custom_radio.toggled.connect(self._custom_edit.setEnabled)
+        main_layout.addWidget(namespace_box)
+
+        group_layout = QHBoxLayout()
+        self._group_check = QCheckBox(tr("Group under:"))
+        group_layout.addWidget(self._group_check)
+        self._group_edit = QLineEdit(f"{self._asset_name}_grp")
+        self._group_edit.setEnabled(False)
+        group_layout.addWidget(self._group_edit, 1)
+        self._group_check.toggled.connect(self._group_edit.setEnabled)
+        main_layout.addLayout(group_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        reference_btn = QPushButton(tr("Reference"))
+        reference_btn.setProperty("accent", True)
+        reference_btn.setDefault(True)
+        reference_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(reference_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _restore_settings(self) -> None:
+        """Restore last used options"""
+        mode = str(self._settings.value("referenceNamespaceMode", "asset"))
+        index = self.NAMESPACE_MODES.index(mode) if mode in self.NAMESPACE_MODES else 0
+        self._namespace_group.button(index).setChecked(True)
+        self._group_check.setChecked(
+            str(self._settings.value("referenceGroup", "false")).lower() == "true"
+        )
+
+    def _on_accept(self) -> None:
+        """Remember options and close"""
+        self._settings.setValue("referenceNamespaceMode", self.get_options()["namespace_mode"])
+        self._settings.setValue("referenceGroup", self._group_check.isChecked())
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get reference options (namespace_mode, namespace, group_name)"""
+        mode = self.NAMESPACE_MODES[max(self._namespace_group.checkedId(), 0)]
+        group_name = self._group_edit.text().strip() if self._group_check.isChecked() else ""
+        return {
+            "namespace_mode": mode,
+            "namespace": self._custom_edit.text().strip() if mode == "custom" else "",
+            "group_name": group_name or None,
+        }
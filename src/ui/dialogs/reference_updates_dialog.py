@@ -0,0 +1,140 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Updates Dialog
+Update outdated scene references one by one or all to latest
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+)
+from PySide6.QtCore import Signal
+
+from ..theme import UITheme
+from ...core.models.asset_version import format_version_label
+from ...services.localization_service_impl import tr
+
+
+class ReferenceUpdatesDialog(QDialog):
+    """
+    Reference Updates Dialog - Single Responsibility for updating outdated references
+    Reference edits survive the update because the reference node is reloaded in place
+    """
+
+    COLUMNS = ["Namespace", "Asset", "Loaded", "Latest", ""]
+
+    references_updated = Signal(list)  # List[ReferenceUpdate]
+
+    def __init__(self, update_service, cmds: Any, parent=None):
+        super().__init__(parent)
+
+        self._service = update_service
+        self._cmds = cmds
+        self._updates: List[Any] = []
+
+        self._setup_ui()
+        self._refresh()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Reference Updates"))
+        self.setMinimumSize(560, 320)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Outdated References"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setWordWrap(True)
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.NoSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._update_all_btn = QPushButton(tr("Update All to Latest"))
+        self._update_all_btn.setProperty("accent", True)
+        self._update_all_btn.clicked.connect(self._on_update_all)
+        button_layout.addWidget(self._update_all_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _refresh(self) -> None:
+        """List the references that are behind their asset's latest version"""
+        self._updates = self._service.get_outdated(self._cmds)
+        self._table.setRowCount(len(self._updates))
+
+        for row, update in enumerate(self._updates):
+            loaded = format_version_label(update.loaded_version)
+            if update.pinned:
+                loaded += " (pinned)"
+            latest = format_version_label(update.latest_version)
+            if update.latest_author:
+                latest += f" by {update.latest_author}"
+            values = [update.namespace or ":", update.asset_file.name, loaded, latest]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(str(update.file_path))
+                self._table.setItem(row, column, item)
+
+            update_btn = QPushButton(tr("Update"))
+            update_btn.clicked.connect(lambda _checked=False, u=update: self._on_update([u]))
+            self._table.setCellWidget(row, 4, update_btn)
+        self._table.resizeColumnsToContents()
+
+        count = len(self._updates)
+        self._summary_label.setText(
+            f"{count} reference(s) have newer published versions. Updating reloads the "
+            "reference from the current asset file."
+            if count
+            else "All scene references are up to date."
+        )
+        self._update_all_btn.setEnabled(bool(count))
+
+    def _on_update_all(self) -> None:
+        """Update every outdated reference"""
+        self._on_update(self._updates)
+
+    def _on_update(self, updates: List[Any]) -> None:
+        """Reload the given references at latest and report failures"""
+        updated, errors = self._service.update_all(self._cmds, updates)
+        if errors:
+            QMessageBox.warning(self, tr("Update Failed"), "\n".join(errors))
+        lost_edits = [edit for update in updated for edit in update.lost_edits]
+        if lost_edits:
+            from .reference_edits_dialog import ReferenceEditsDialog
+
+            ReferenceEditsDialog(self._service, self._cmds, lost_edits, self).exec()
+        if updated:
+            self.references_updated.emit(updated)
+        self._refresh()
@@ -0,0 +1,142 @@
+# -*- coding: utf-8 -*-
+"""
+Rename Asset Dialog
+Choose a new name and library folder for an asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QCheckBox,
+    QPushButton,
+    QFileDialog,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class RenameAssetDialog(QDialog):
+    """
+    Rename Asset Dialog - Single Responsibility for picking an asset's new path
+    The rename service checks the path; the dialog shows why it cannot be used
+    """
+
+    def __init__(self, rename_service, library_root: Path, asset_file: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = rename_service
+        self._library_root = Path(library_root)
+        self._asset_file = Path(asset_file)
+
+        self._setup_ui()
+        self._update_preview()
+
+    @property
+    def new_file(self) -> Path:
+        """Get the asset file path chosen"""
+        folder = Path(self._folder_edit.text().strip() or self._asset_file.parent)
+        return folder / f"{self._name_edit.text().strip()}{self._asset_file.suffix}"
+
+    @property
+    def repair_references(self) -> bool:
+        """Check if project scenes should be repathed after the rename"""
+        return self._repair_check.isChecked()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Rename / Move Asset"))
+        self.setMinimumWidth(520)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Rename {self._asset_file.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Thumbnails, version history, LODs, and metadata move with the asset. The old "
+                "path is remembered, so scenes still using it can be repathed now or later "
+                "(Edit > Repair Renamed References)."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._name_edit = QLineEdit(self._asset_file.stem)
+        self._name_edit.textChanged.connect(self._update_preview)
+        form_layout.addRow("Name:", self._name_edit)
+
+        folder_layout = QHBoxLayout()
+        self._folder_edit = QLineEdit(str(self._asset_file.parent))
+        self._folder_edit.textChanged.connect(self._update_preview)
+        folder_layout.addWidget(self._folder_edit, 1)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse_folder)
+        folder_layout.addWidget(browse_btn)
+        form_layout.addRow("Folder:", folder_layout)
+
+        self._repair_check = QCheckBox(tr("Repath references in project scenes"))
+        self._repair_check.setChecked(True)
+        form_layout.addRow("", self._repair_check)
+
+        main_layout.addLayout(form_layout)
+
+        self._problem_label = QLabel()
+        self._problem_label.setWordWrap(True)
+        self._problem_label.setProperty("description", True)
+        main_layout.addWidget(self._problem_label)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._rename_btn = QPushButton(tr("Rename"))
+        self._rename_btn.setProperty("accent", True)
+        self._rename_btn.clicked.connect(self.accept)
+        button_layout.addWidget(self._rename_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_browse_folder(self) -> None:
+        folder = QFileDialog.getExistingDirectory(
+            self, "Move Asset To", self._folder_edit.text() or str(self._library_root)
+        )
+        if folder:
+            self._folder_edit.setText(folder)
+
+    def _update_preview(self) -> None:
+        """Show where the asset goes, or why it cannot"""
+        problem = "Enter a name for the asset"
+        if self._name_edit.text().strip():
+            problem = self._service.check_rename(
+                self._library_root, self._asset_file, self.new_file
+            )
+        if problem:
+            self._problem_label.setText(problem)
+        else:
+            try:
+                shown = self.new_file.relative_to(self._library_root)
+            except ValueError:
+                shown = self.new_file
+            self._problem_label.setText(f"New path: {shown}")
+        self._rename_btn.setEnabled(not problem)
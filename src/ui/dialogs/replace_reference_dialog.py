@@ -0,0 +1,137 @@
+# -*- coding: utf-8 -*-
+"""
+Replace Reference Dialog
+Pick a scene reference and the asset file or version it should point to
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List, Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+)
+
+from ..theme import UITheme
+from ...core.models.asset_version import AssetVersion
+from ...services.localization_service_impl import tr
+
+
+class ReplaceReferenceDialog(QDialog):
+    """
+    Replace Reference Dialog - Single Responsibility for choosing a reference swap
+    The reference node is reused, so edits made on top of it in the scene survive
+    """
+
+    COLUMNS = ["Namespace", "File", "Loaded"]
+
+    def __init__(
+        self,
+        references: List[Dict[str, Any]],
+        asset_path: Path,
+        versions: List[AssetVersion],
+        parent=None,
+    ):
+        super().__init__(parent)
+
+        self._references = references
+        self._asset_path = Path(asset_path)
+        self._versions = [version for version in versions if version.exists]
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Replace Reference"))
+        self.setMinimumSize(560, 300)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Replace a reference with {self._asset_path.stem}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Choose the scene reference to swap. Reference edits such as transforms, "
+                "shader assignments, and animation are kept."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(len(self._references), len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.SingleSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+
+        preselect = 0
+        for row, reference in enumerate(self._references):
+            file_path = Path(reference["file_path"])
+            values = [
+                reference.get("namespace") or ":",
+                file_path.name,
+                "Yes" if reference.get("loaded") else "No",
+            ]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(str(file_path))
+                self._table.setItem(row, column, item)
+            # Most often the artist swaps versions of the same asset
+            if file_path.stem.startswith(self._asset_path.stem):
+                preselect = row
+        self._table.resizeColumnsToContents()
+        if self._references:
+            self._table.selectRow(preselect)
+        main_layout.addWidget(self._table, 1)
+
+        form_layout = QFormLayout()
+        self._version_combo = QComboBox()
+        self._version_combo.addItem(f"Current ({self._asset_path.name})", str(self._asset_path))
+        for version in reversed(self._versions):
+            notes = f" - {version.notes}" if version.notes else ""
+            self._version_combo.addItem(f"{version.label}{notes}", str(version.file_path))
+        form_layout.addRow("Replace with:", self._version_combo)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        replace_btn = QPushButton(tr("Replace"))
+        replace_btn.setProperty("accent", True)
+        replace_btn.setEnabled(bool(self._references))
+        replace_btn.clicked.connect(self.accept)
+        button_layout.addWidget(replace_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_selection(self) -> Optional[Tuple[str, Path]]:
+        """Get (reference node, replacement file), None if nothing is selected"""
+        rows = self._table.selectionModel().selectedRows()
+        if not rows:
+            return None
+        reference = self._references[rows[0].row()]
+        return reference["reference_node"], Path(self._version_combo.currentData())
@@ -0,0 +1,242 @@
+# -*- coding: utf-8 -*-
+"""
+Version Retention Dialog
+Edit a library's version retention policy and preview what it prunes before it runs
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Optional
+
+from PySide6.QtCore import Qt
+from PySide6.QtWidgets import (
+    QApplication,
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QCheckBox,
+    QSpinBox,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.asset_version import format_version_label
+from ...core.models.retention_policy import RetentionPolicy, RetentionReport
+from ...core.models.storage_usage import format_size
+from ...services.localization_service_impl import tr
+
+
+class RetentionPolicyDialog(QDialog):
+    """
+    Retention Policy Dialog - Single Responsibility for the version retention admin panel
+    Pruning from here removes exactly the versions the last preview listed
+    """
+
+    PREVIEW_COLUMNS = ["Asset", "Version", "Published", "Author", "Size"]
+
+    def __init__(self, retention_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = retention_service
+        self._library_root = Path(library_root)
+        self._policy = self._service.load_policy(self._library_root)
+        self._preview: Optional[RetentionReport] = None
+
+        self._setup_ui()
+        self._load_policy()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Version Retention"))
+        self.setMinimumSize(680, 520)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Version Retention of {self._library_root.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The Version pruning maintenance job applies this policy. The latest version "
+                "of an asset is always kept; pruned versions stay listed in the history."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._enabled_check = QCheckBox(tr("Prune versions by this policy in maintenance"))
+        form_layout.addRow("", self._enabled_check)
+
+        self._keep_last_spin = QSpinBox()
+        self._keep_last_spin.setRange(1, 999)
+        self._keep_last_spin.setSuffix(" versions")
+        form_layout.addRow("Keep the last:", self._keep_last_spin)
+
+        self._keep_approved_check = QCheckBox(tr("Keep approved versions forever"))
+        self._keep_approved_check.setToolTip(
+            tr("The version that was latest when an asset was approved is never pruned")
+        )
+        form_layout.addRow("", self._keep_approved_check)
+
+        self._age_spin = QSpinBox()
+        self._age_spin.setRange(0, 3650)
+        self._age_spin.setSuffix(" days")
+        self._age_spin.setSpecialValueText(tr("Any age"))
+        form_layout.addRow("Prune other versions after:", self._age_spin)
+
+        self._dry_run_check = QCheckBox(tr("Dry run: only report what would be pruned"))
+        self._dry_run_check.setToolTip(
+            tr("The maintenance report lists the versions until dry run is switched off")
+        )
+        form_layout.addRow("", self._dry_run_check)
+        main_layout.addLayout(form_layout)
+
+        self._preview_table = QTableWidget(0, len(self.PREVIEW_COLUMNS))
+        self._preview_table.setHorizontalHeaderLabels(self.PREVIEW_COLUMNS)
+        self._preview_table.horizontalHeader().setSectionResizeMode(
+            0, QHeaderView.ResizeMode.Stretch
+        )
+        self._preview_table.verticalHeader().setVisible(False)
+        self._preview_table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._preview_table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        main_layout.addWidget(self._preview_table, 1)
+
+        self._status_label = QLabel(tr("Preview to see which versions the policy prunes"))
+        main_layout.addWidget(self._status_label)
+
+        button_layout = QHBoxLayout()
+
+        preview_btn = QPushButton(tr("Preview"))
+        preview_btn.setToolTip(tr("List the versions the policy above would prune now"))
+        preview_btn.clicked.connect(self._on_preview_clicked)
+        button_layout.addWidget(preview_btn)
+
+        self._prune_btn = QPushButton(tr("Prune Now"))
+        self._prune_btn.setProperty("danger", True)
+        self._prune_btn.setToolTip(tr("Delete the previewed versions, even in a dry run"))
+        self._prune_btn.setEnabled(False)
+        self._prune_btn.clicked.connect(self._on_prune_clicked)
+        button_layout.addWidget(self._prune_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Policy"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.reject)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_policy(self) -> None:
+        """Show the library's saved policy"""
+        self._enabled_check.setChecked(self._policy.enabled)
+        self._keep_last_spin.setValue(self._policy.keep_last)
+        self._keep_approved_check.setChecked(self._policy.keep_approved)
+        self._age_spin.setValue(self._policy.max_wip_age_days)
+        self._dry_run_check.setChecked(self._policy.dry_run)
+
+    def _read_policy(self) -> RetentionPolicy:
+        """Build the policy from the fields"""
+        return RetentionPolicy(
+            enabled=self._enabled_check.isChecked(),
+            keep_last=self._keep_last_spin.value(),
+            keep_approved=self._keep_approved_check.isChecked(),
+            max_wip_age_days=self._age_spin.value(),
+            dry_run=self._dry_run_check.isChecked(),
+        )
+
+    def _on_preview_clicked(self) -> None:
+        """List the versions the policy in the fields would prune"""
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            self._preview = self._service.plan(self._library_root, self._read_policy())
+        except Exception as e:
+            self._preview = None
+            QMessageBox.critical(self, tr("Error"), f"Failed to preview pruning:\n{e}")
+            return
+        finally:
+            QApplication.restoreOverrideCursor()
+        self._show_report(self._preview)
+        self._prune_btn.setEnabled(bool(self._preview.versions))
+
+    def _show_report(self, report: RetentionReport) -> None:
+        """Fill the table with a report's versions"""
+        self._preview_table.setRowCount(0)
+        for version in report.versions:
+            row = self._preview_table.rowCount()
+            self._preview_table.insertRow(row)
+            published = (
+                version.created_date.strftime("%Y-%m-%d") if version.created_date else "-"
+            )
+            values = [
+                version.asset_path.stem,
+                format_version_label(version.number),
+                published,
+                version.author,
+                format_size(version.size_bytes),
+            ]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(str(version.asset_path))
+                self._preview_table.setItem(row, column, item)
+        self._status_label.setText(
+            f"{report.summary} ({report.versions_kept} kept in {report.assets_checked} "
+            "histories)"
+        )
+
+    def _on_prune_clicked(self) -> None:
+        """Delete the previewed versions after confirmation"""
+        if self._preview is None or not self._preview.versions:
+            return
+        reply = QMessageBox.question(
+            self,
+            tr("Prune Versions"),
+            f"Delete {len(self._preview.versions)} version(s), "
+            f"{format_size(self._preview.freed_bytes)}? Pruned versions cannot be restored.",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            QMessageBox.StandardButton.No,
+        )
+        if reply != QMessageBox.StandardButton.Yes:
+            return
+
+        QApplication.setOverrideCursor(Qt.CursorShape.WaitCursor)
+        try:
+            result = self._service.prune(self._library_root, self._preview)
+        finally:
+            QApplication.restoreOverrideCursor()
+        self._preview = None
+        self._prune_btn.setEnabled(False)
+        self._show_report(result)
+        if result.failed:
+            QMessageBox.warning(
+                self,
+                tr("Pruning Incomplete"),
+                "These versions could not be removed:\n\n" + "\n".join(result.failed[:20]),
+            )
+
+    def _on_save_clicked(self) -> None:
+        """Store the policy in the library"""
+        policy = self._read_policy()
+        if not self._service.save_policy(self._library_root, policy):
+            QMessageBox.critical(self, tr("Error"), tr("Could not save the retention policy."))
+            return
+        self._policy = policy
+        self._status_label.setText(f"Policy saved: {policy.description}")
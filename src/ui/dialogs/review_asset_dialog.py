@@ -0,0 +1,98 @@
+# -*- coding: utf-8 -*-
+"""
+Review Asset Dialog
+Approve, deprecate, or send back a library asset with a review note
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QTextEdit,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...core.models.asset_status import (
+    STATUS_APPROVED,
+    STATUS_LABELS,
+    STATUSES,
+    AssetStatus,
+)
+from ...services.localization_service_impl import tr
+
+
+class ReviewAssetDialog(QDialog):
+    """
+    Review Asset Dialog - Single Responsibility for choosing a review outcome
+    Starts on Approved so the common case is one click
+    """
+
+    def __init__(self, asset_name: str, current_status: AssetStatus, parent=None):
+        super().__init__(parent)
+
+        self._asset_name = asset_name
+        self._current_status = current_status
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Review Asset"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Review {self._asset_name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(f"Current status: {self._current_status.description}")
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._state_combo = QComboBox()
+        for state in STATUSES:
+            self._state_combo.addItem(STATUS_LABELS[state], state)
+        self._state_combo.setCurrentIndex(STATUSES.index(STATUS_APPROVED))
+        form_layout.addRow("New status:", self._state_combo)
+
+        self._note_edit = QTextEdit()
+        self._note_edit.setMaximumHeight(70)
+        self._note_edit.setPlaceholderText(tr("e.g. UVs overlap on the lid, please fix"))
+        form_layout.addRow("Note:", self._note_edit)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Set Status"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self.accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_state(self) -> str:
+        """Get the chosen status"""
+        return self._state_combo.currentData()
+
+    def get_note(self) -> str:
+        """Get the review note"""
+        return self._note_edit.toPlainText().strip()
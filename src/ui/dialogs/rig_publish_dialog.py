@@ -0,0 +1,207 @@
+# -*- coding: utf-8 -*-
+"""
+Rig Publish Dialog
+Name a rig, pick its controller sets, and attach an optional picker layout
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QFileDialog,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ..widgets.playblast_options_widget import PlayblastOptionsGroup
+from ..widgets.tag_completer import TagCompleter
+from ...core.models.playblast_settings import PlayblastSettings
+from ...core.models.tag_hierarchy import parse_tags
+from ...services.localization_service_impl import tr
+
+
+class RigPublishDialog(QDialog):
+    """
+    Rig Publish Dialog - Single Responsibility for rig publish options
+    The sets checked here are rebuilt for animators whenever the rig is loaded
+    """
+
+    def __init__(
+        self,
+        top_node: str,
+        controller_sets: Dict[str, List[str]],
+        playblast_defaults: Optional[PlayblastSettings] = None,
+        cameras: Optional[List[str]] = None,
+        parent=None,
+        known_tags: Optional[List[str]] = None,
+    ):
+        """
+        Args:
+            top_node: Short name of the rig's top node
+            controller_sets: Set name -> controls of the rig's controller sets
+            playblast_defaults: Playblast settings shown at first (scene playback range)
+            cameras: Scene cameras a playblast can look through
+            known_tags: Library tags offered as the artist types
+        """
+        super().__init__(parent)
+
+        self._top_node = top_node
+        self._controller_sets = controller_sets
+        self._playblast_defaults = playblast_defaults
+        self._cameras = cameras or []
+        self._known_tags = known_tags or []
+        self._playblast_group: Optional[PlayblastOptionsGroup] = None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Rig"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Rig"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            f"{self._top_node} is checked for the library's rig structure before it is "
+            "published. Loading the rig creates the checked controller sets and a "
+            "character set of every control."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        default_name = self._top_node[:-4] if self._top_node.endswith("_rig") else self._top_node
+        self._name_edit = QLineEdit(default_name)
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._format_combo = QComboBox()
+        self._format_combo.addItem("Maya ASCII (.ma)", ".ma")
+        self._format_combo.addItem("Maya Binary (.mb)", ".mb")
+        form_layout.addRow("Format:", self._format_combo)
+
+        self._tags_edit = QLineEdit()
+        self._tags_edit.setPlaceholderText(tr("Comma separated (character/biped, hero)"))
+        TagCompleter(self._known_tags, self._tags_edit)
+        form_layout.addRow("Tags:", self._tags_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._set_list = QListWidget()
+        self._set_list.setMaximumHeight(140)
+        for set_name, members in sorted(self._controller_sets.items()):
+            item = QListWidgetItem(f"{set_name} ({len(members)} controls)")
+            item.setData(Qt.UserRole, set_name)  # type: ignore
+            item.setCheckState(Qt.Checked)  # type: ignore
+            self._set_list.addItem(item)
+        if not self._controller_sets:
+            self._set_list.addItem("No controller sets - one set of every control is made")
+            self._set_list.setEnabled(False)
+        form_layout.addRow("Controller sets:", self._set_list)
+
+        self._character_check = QCheckBox(tr("Create a character set of every control on load"))
+        self._character_check.setChecked(True)
+        form_layout.addRow("", self._character_check)
+
+        picker_layout = QHBoxLayout()
+        self._picker_edit = QLineEdit()
+        self._picker_edit.setPlaceholderText(tr("Optional picker layout (.json)"))
+        picker_layout.addWidget(self._picker_edit)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse_picker)
+        picker_layout.addWidget(browse_btn)
+        form_layout.addRow("Picker:", picker_layout)
+        main_layout.addLayout(form_layout)
+
+        if self._playblast_defaults is not None:
+            self._playblast_group = PlayblastOptionsGroup(self._playblast_defaults, self._cameras)
+            main_layout.addWidget(self._playblast_group)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish Rig"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_browse_picker(self) -> None:
+        """Choose the picker layout file"""
+        picker_file, _filter = QFileDialog.getOpenFileName(
+            self, "Choose Picker Layout", "", "Picker Layouts (*.json)"
+        )
+        if picker_file:
+            self._picker_edit.setText(picker_file)
+
+    def _get_checked_sets(self) -> List[str]:
+        """Get the controller sets left checked"""
+        if not self._controller_sets:
+            return []
+        names = []
+        for row in range(self._set_list.count()):
+            item = self._set_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                names.append(item.data(Qt.UserRole))  # type: ignore
+        return names
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        picker_file = self._picker_edit.text().strip()
+        if picker_file and not Path(picker_file).is_file():
+            QMessageBox.warning(self, tr("Picker Not Found"), f"{picker_file} does not exist.")
+            return
+        playblast_error = self._playblast_group.validate() if self._playblast_group else None
+        if playblast_error:
+            QMessageBox.warning(self, tr("Invalid Range"), playblast_error)
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, format, tags, notes, sets, picker file, playblast)"""
+        picker_file = self._picker_edit.text().strip()
+        return {
+            "name": self._name_edit.text().strip(),
+            "format": self._format_combo.currentData(),
+            "tags": parse_tags(self._tags_edit.text()),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "controller_sets": self._get_checked_sets(),
+            "character_set": self._character_check.isChecked(),
+            "picker_file": Path(picker_file) if picker_file else None,
+            "playblast": self._playblast_group.get_settings() if self._playblast_group else None,
+        }
@@ -0,0 +1,156 @@
+# -*- coding: utf-8 -*-
+"""
+Save Asset Template Dialog
+Name the open scene as a library asset template and set the values new assets start with
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ..widgets.tag_completer import TagCompleter
+from .create_asset_dialog import CreateAssetDialog
+from ...core.models.asset_template import ASSET_NAME_TOKEN, NODE_NAME_TOKEN, AssetTemplate
+from ...core.models.tag_hierarchy import parse_tags
+from ...services.localization_service_impl import tr
+
+
+class SaveAssetTemplateDialog(QDialog):
+    """
+    Save Asset Template Dialog - Single Responsibility for template options
+    Render settings are captured from the scene when the template is saved
+    """
+
+    def __init__(
+        self,
+        existing_names: List[str],
+        known_tags: Optional[List[str]] = None,
+        parent=None,
+    ):
+        """
+        Args:
+            existing_names: Templates already in the library, replaced after confirmation
+            known_tags: Library tags offered as the artist types
+        """
+        super().__init__(parent)
+
+        self._existing_names = existing_names
+        self._known_tags = known_tags or []
+        self._template: Optional[AssetTemplate] = None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Save Scene as Template"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Save Scene as Template"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The whole scene and its render settings are saved into the library. Name "
+                "nodes with {token} (e.g. {token}_grp) to have them renamed for each new asset.",
+                token=NODE_NAME_TOKEN,
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._name_edit = QLineEdit()
+        self._name_edit.setPlaceholderText(tr("Hero Prop"))
+        form_layout.addRow(tr("Template name:"), self._name_edit)
+
+        self._category_combo = QComboBox()
+        self._category_combo.addItems(CreateAssetDialog.CATEGORIES)
+        form_layout.addRow(tr("Category:"), self._category_combo)
+
+        self._description_edit = QTextEdit()
+        self._description_edit.setMaximumHeight(70)
+        form_layout.addRow(tr("Description:"), self._description_edit)
+
+        self._pattern_edit = QLineEdit(ASSET_NAME_TOKEN)
+        self._pattern_edit.setToolTip(tr("Name new assets publish as, e.g. PRP_{assetName}"))
+        form_layout.addRow(tr("Asset naming:"), self._pattern_edit)
+
+        self._tags_edit = QLineEdit()
+        self._tags_edit.setPlaceholderText(tr("Comma separated, nest with / (environment/forest)"))
+        TagCompleter(self._known_tags, self._tags_edit)
+        form_layout.addRow(tr("Tags:"), self._tags_edit)
+
+        self._format_combo = QComboBox()
+        for label, extension in CreateAssetDialog.EXPORT_FORMATS:
+            if extension in (".ma", ".mb"):
+                self._format_combo.addItem(label, extension)
+        form_layout.addRow(tr("Publish format:"), self._format_combo)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Template"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        try:
+            template = AssetTemplate(
+                name=self._name_edit.text().strip(),
+                category=self._category_combo.currentText(),
+                description=self._description_edit.toPlainText().strip(),
+                name_pattern=self._pattern_edit.text().strip(),
+                tags=tuple(parse_tags(self._tags_edit.text())),
+                export_format=self._format_combo.currentData(),
+            )
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Template"), str(e))
+            return
+        if template.name in self._existing_names:
+            reply = QMessageBox.question(
+                self,
+                tr("Replace Template"),
+                tr("Replace the asset template '{name}'?", name=template.name),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+        self._template = template
+        self.accept()
+
+    def get_template(self) -> Optional[AssetTemplate]:
+        """Get the template to save, without render settings yet"""
+        return self._template
@@ -42,8 +42,12 @@ try:
     from ...core.models.asset import Asset  # type: ignore
     from ...core.interfaces.thumbnail_service import IThumbnailService  # type: ignore
     from ...core.container import get_container  # type: ignore
+    from ...services.localization_service_impl import tr  # type: ignore
 except ImportError:
     # Fallback definitions for when imports fail
+    def tr(text, **values):  # type: ignore
+        return text.format(**values) if values else text
+
     class Asset:  # type: ignore
         def __init__(self):
             self.file_path = None
@@ -102,7 +106,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
 
         def _setup_dialog(self) -> None:
             """Setup dialog properties - Single Responsibility"""
-            self.setWindowTitle("Capture Maya Screenshot")
+            self.setWindowTitle(tr("Capture Maya Screenshot"))
             self.setModal(True)
             self.resize(420, 400)
 
@@ -181,7 +185,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             """Create resolution options section - Single Responsibility"""
             res_group = QWidget()  # type: ignore
             res_layout = QVBoxLayout(res_group)  # type: ignore
-            res_layout.addWidget(QLabel("[THUMB] Screenshot Resolution:"))  # type: ignore
+            res_layout.addWidget(QLabel(tr("[THUMB] Screenshot Resolution:")))  # type: ignore
 
             self._res_combo = QComboBox()  # type: ignore
             resolution_options = [
@@ -212,7 +216,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             """Create quality options section - Single Responsibility"""
             quality_group = QWidget()  # type: ignore
             quality_layout = QVBoxLayout(quality_group)  # type: ignore
-            quality_layout.addWidget(QLabel("[NEW] Image Quality:"))  # type: ignore
+            quality_layout.addWidget(QLabel(tr("[NEW] Image Quality:")))  # type: ignore
 
             self._quality_combo = QComboBox()  # type: ignore
             self._quality_combo.addItem("High Quality (PNG)", "png")  # type: ignore
@@ -235,20 +239,20 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             """Create viewport settings section - Single Responsibility"""
             viewport_group = QWidget()  # type: ignore
             viewport_layout = QVBoxLayout(viewport_group)  # type: ignore
-            viewport_layout.addWidget(QLabel("[TOOL] Viewport Settings:"))  # type: ignore
+            viewport_layout.addWidget(QLabel(tr("[TOOL] Viewport Settings:")))  # type: ignore
 
             # Viewport options with professional defaults
-            self._smooth_shading = QCheckBox("Smooth Shading")  # type: ignore
+            self._smooth_shading = QCheckBox(tr("Smooth Shading"))  # type: ignore
             self._smooth_shading.setChecked(True)  # type: ignore
-            self._smooth_shading.setToolTip("Enable smooth shading for professional quality")  # type: ignore
+            self._smooth_shading.setToolTip(tr("Enable smooth shading for professional quality"))  # type: ignore
 
-            self._wireframe_on_shaded = QCheckBox("Wireframe on Shaded")  # type: ignore
+            self._wireframe_on_shaded = QCheckBox(tr("Wireframe on Shaded"))  # type: ignore
             self._wireframe_on_shaded.setChecked(False)  # type: ignore
-            self._wireframe_on_shaded.setToolTip("Show wireframe overlay on shaded geometry")  # type: ignore
+            self._wireframe_on_shaded.setToolTip(tr("Show wireframe overlay on shaded geometry"))  # type: ignore
 
-            self._show_grid = QCheckBox("Show Grid")  # type: ignore
+            self._show_grid = QCheckBox(tr("Show Grid"))  # type: ignore
             self._show_grid.setChecked(False)  # type: ignore
-            self._show_grid.setToolTip("Display Maya's grid in the screenshot")  # type: ignore
+            self._show_grid.setToolTip(tr("Display Maya's grid in the screenshot"))  # type: ignore
 
             # Style checkboxes
             checkbox_style = """
@@ -278,8 +282,10 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             button_layout = QHBoxLayout()  # type: ignore
 
             # Preview settings button
-            preview_btn = QPushButton("🔍 Preview Settings")  # type: ignore
-            preview_btn.setToolTip("Apply viewport settings to see live preview")  # type: ignore
+            preview_btn = QPushButton(tr("🔍 Preview Settings"))  # type: ignore
+            preview_btn.setToolTip(
+                tr("Apply viewport settings to see live preview")
+            )  # type: ignore
             preview_btn.setStyleSheet("""
                 QPushButton {
                     background-color: #f0f0f0;
@@ -297,8 +303,8 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             preview_btn.clicked.connect(self._apply_viewport_settings)  # type: ignore
 
             # Capture screenshot button (primary action)
-            capture_btn = QPushButton("[CAMERA] Capture Screenshot")  # type: ignore
-            capture_btn.setToolTip("Capture high-resolution screenshot and save as asset thumbnail")  # type: ignore
+            capture_btn = QPushButton(tr("[CAMERA] Capture Screenshot"))  # type: ignore
+            capture_btn.setToolTip(tr("Capture high-resolution screenshot and save as asset thumbnail"))  # type: ignore
             capture_btn.setStyleSheet("""
                 QPushButton {
                     background-color: #4CAF50;
@@ -319,7 +325,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             capture_btn.clicked.connect(self._capture_screenshot)  # type: ignore
 
             # Cancel button
-            cancel_btn = QPushButton("Cancel")  # type: ignore
+            cancel_btn = QPushButton(tr("Cancel"))  # type: ignore
             cancel_btn.setStyleSheet("""
                 QPushButton {
                     background-color: #f0f0f0;
@@ -356,7 +362,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
                     if model_panels:
                         active_panel = model_panels[0]
                     else:
-                        QMessageBox.warning(self, "No Viewport", "No Maya viewport found for preview.")  # type: ignore
+                        QMessageBox.warning(self, tr("No Viewport"), tr("No Maya viewport found for preview."))  # type: ignore
                         return
 
                 # Apply viewport settings
@@ -382,7 +388,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             except Exception as e:
                 print(f"Error applying viewport settings: {e}")
                 error_msg = f"Failed to apply viewport settings:\n{str(e)}"
-                QMessageBox.warning(self, "Settings Error", error_msg)  # type: ignore
+                QMessageBox.warning(self, tr("Settings Error"), error_msg)  # type: ignore
 
         def _capture_screenshot(self) -> None:
             """Capture screenshot using Maya playblast - Main Action"""
@@ -419,21 +425,31 @@ if PYSIDE_AVAILABLE and QDialog is not None:
                     f"[CAMERA] Capturing screenshot: {resolution}x{resolution} {file_format.upper()}"
                 )
 
-                _ = cmds.playblast(
-                    filename=temp_path,
-                    format="image",
-                    compression=file_format,
-                    quality=100,
-                    percent=100,
-                    width=resolution,
-                    height=resolution,
-                    viewer=False,
-                    showOrnaments=False,
-                    offScreen=True,
-                    frame=cmds.currentTime(query=True),
-                    completeFilename=temp_path,
+                # Capture through the scene's OCIO view transform, as the viewport shows it
+                from ...services.color_management_service_impl import (
+                    get_color_management_service,
                 )
 
+                color_service = get_color_management_service()
+                color_output = color_service.enable_view_transform(cmds)
+                try:
+                    _ = cmds.playblast(
+                        filename=temp_path,
+                        format="image",
+                        compression=file_format,
+                        quality=100,
+                        percent=100,
+                        width=resolution,
+                        height=resolution,
+                        viewer=False,
+                        showOrnaments=False,
+                        offScreen=True,
+                        frame=cmds.currentTime(query=True),
+                        completeFilename=temp_path,
+                    )
+                finally:
+                    color_service.restore_view_transform(cmds, color_output)
+
                 # Maya adds frame number to filename, find the actual file
                 actual_files = [
                     f for f in os.listdir(temp_dir) if f.startswith("maya_screenshot_")
@@ -457,7 +473,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
                     f"Asset: {Path(self._asset.file_path).name}\n\n"
                     f"The asset thumbnail has been updated automatically."
                 )
-                QMessageBox.information(self, "Screenshot Captured! [CAMERA]", success_msg)  # type: ignore
+                QMessageBox.information(self, tr("Screenshot Captured! [CAMERA]"), success_msg)  # type: ignore
 
                 # Trigger refresh callback
                 if self._callback_refresh:
@@ -473,7 +489,7 @@ if PYSIDE_AVAILABLE and QDialog is not None:
             except Exception as e:
                 print(f"[ERROR] Screenshot capture error: {e}")
                 error_msg = f"Failed to capture screenshot:\n{str(e)}"
-                QMessageBox.warning(self, "Capture Failed", error_msg)  # type: ignore
+                QMessageBox.warning(self, tr("Capture Failed"), error_msg)  # type: ignore
 
         def _save_screenshot_as_thumbnail(
             self, temp_path: str, file_format: str, resolution: int
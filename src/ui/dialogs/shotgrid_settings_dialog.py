@@ -0,0 +1,131 @@
+# -*- coding: utf-8 -*-
+"""
+ShotGrid Settings Dialog
+Turn on PublishedFile registration and set the connection used outside Toolkit
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QSpinBox,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class ShotGridSettingsDialog(QDialog):
+    """
+    ShotGrid Settings Dialog - Single Responsibility for ShotGrid publish configuration
+    """
+
+    def __init__(self, shotgrid_service, parent=None):
+        super().__init__(parent)
+
+        self._service = shotgrid_service
+        self._has_toolkit = shotgrid_service.get_toolkit_engine() is not None
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("ShotGrid Settings"))
+        self.setMinimumWidth(440)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("ShotGrid Publishing"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        if self._has_toolkit:
+            context = self._service.get_context().description
+            text = (
+                f"Toolkit context: {context}\n\n"
+                "Publishes are registered through the running Toolkit engine and linked "
+                "to its project, shot or asset, and task."
+            )
+        else:
+            text = (
+                "No Toolkit engine is running. Publishes are registered with the script "
+                "key below and linked to the fallback project. SHOTGRID_SITE, "
+                "SHOTGRID_SCRIPT_NAME, and SHOTGRID_SCRIPT_KEY override these fields."
+            )
+        desc_label = QLabel(text)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        # Stored values only, so saving never writes an environment key to disk
+        config = self._service.get_config(apply_environment=False)
+
+        self._enabled_check = QCheckBox(tr("Create a PublishedFile for every library publish"))
+        self._enabled_check.setChecked(self._service.is_enabled())
+        main_layout.addWidget(self._enabled_check)
+
+        form_layout = QFormLayout()
+
+        self._site_edit = QLineEdit(config.get("site", ""))
+        self._site_edit.setPlaceholderText("https://studio.shotgrid.autodesk.com")
+        form_layout.addRow("Site:", self._site_edit)
+
+        self._script_name_edit = QLineEdit(config.get("script_name", ""))
+        form_layout.addRow("Script name:", self._script_name_edit)
+
+        self._script_key_edit = QLineEdit(config.get("script_key", ""))
+        self._script_key_edit.setEchoMode(QLineEdit.EchoMode.Password)
+        form_layout.addRow("Script key:", self._script_key_edit)
+
+        self._project_spin = QSpinBox()
+        self._project_spin.setRange(0, 2**31 - 1)
+        self._project_spin.setSpecialValueText("None")
+        self._project_spin.setValue(int(config.get("project_id") or 0))
+        form_layout.addRow("Fallback project id:", self._project_spin)
+
+        for row in range(form_layout.rowCount()):
+            field = form_layout.itemAt(row, QFormLayout.ItemRole.FieldRole)
+            if field is not None and field.widget() is not None:
+                field.widget().setEnabled(not self._has_toolkit)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_save_clicked(self) -> None:
+        """Store ShotGrid configuration and close"""
+        self._service.set_config(
+            enabled=self._enabled_check.isChecked(),
+            site=self._site_edit.text().strip(),
+            script_name=self._script_name_edit.text().strip(),
+            script_key=self._script_key_edit.text().strip(),
+            project_id=self._project_spin.value(),
+        )
+
+        if not self._service.save_config():
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save ShotGrid settings."))
+            return
+        self.accept()
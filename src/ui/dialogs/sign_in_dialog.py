@@ -0,0 +1,167 @@
+# -*- coding: utf-8 -*-
+"""
+Sign In Dialog
+Sign in with the studio account, or switch to another artist on a shared workstation
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, QTimer, QUrl
+from PySide6.QtGui import QDesktopServices
+
+from ..theme import UITheme
+from ...core.models.user_identity import UserIdentity
+from ...services.identity_service_impl import AuthenticationError, get_backend_labels
+from ...services.localization_service_impl import tr
+
+
+class SignInDialog(QDialog):
+    """
+    Sign In Dialog - Single Responsibility for proving the artist's studio identity
+    Password backends ask for credentials; browser backends show a code to enter
+    """
+
+    def __init__(self, identity_service, parent=None):
+        super().__init__(parent)
+
+        self._service = identity_service
+        self._backend = identity_service.get_backend()
+        self._device_sign_in = None
+        self._identity: Optional[UserIdentity] = None
+        self._poll_timer = QTimer(self)
+        self._poll_timer.timeout.connect(self._on_poll)
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Sign In"))
+        self.setMinimumWidth(400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Sign In"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        backend_label = get_backend_labels().get(self._service.get_backend_name(), "")
+        current = self._service.get_identity()
+        description = tr(
+            "Publishes, locks, and library roles use the account you sign in with ({backend}).",
+            backend=backend_label,
+        )
+        if current.verified:
+            description += "\n\n" + tr(
+                "Signed in as {name}. Signing in as another artist signs {user} out.",
+                name=current.label,
+                user=current.user_name,
+            )
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._user_edit = QLineEdit()
+        self._password_edit = QLineEdit()
+        self._code_label = QLabel("")
+        self._code_label.setWordWrap(True)
+        self._code_label.setTextInteractionFlags(Qt.TextSelectableByMouse)  # type: ignore
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        if self._backend is None or self._backend.uses_password:
+            form_layout = QFormLayout()
+            self._user_edit.setPlaceholderText(current.os_user or current.user_name)
+            form_layout.addRow(tr("User name:"), self._user_edit)
+            self._password_edit.setEchoMode(QLineEdit.EchoMode.Password)
+            self._password_edit.returnPressed.connect(self._on_sign_in_clicked)
+            form_layout.addRow(tr("Password:"), self._password_edit)
+            main_layout.addLayout(form_layout)
+            self._sign_in_btn = QPushButton(tr("Sign In"))
+            self._sign_in_btn.clicked.connect(self._on_sign_in_clicked)
+        else:
+            main_layout.addWidget(self._code_label)
+            self._sign_in_btn = QPushButton(tr("Sign In with Browser"))
+            self._sign_in_btn.clicked.connect(self._on_browser_clicked)
+        self._sign_in_btn.setProperty("accent", True)
+        self._sign_in_btn.setDefault(True)
+        button_layout.addWidget(self._sign_in_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_sign_in_clicked(self) -> None:
+        """Check the user name and password with the backend"""
+        user_name = self._user_edit.text().strip() or self._user_edit.placeholderText()
+        try:
+            self._identity = self._service.sign_in(user_name, self._password_edit.text())
+        except AuthenticationError as e:
+            self._password_edit.clear()
+            QMessageBox.warning(self, tr("Sign In Failed"), str(e))
+            return
+        self.accept()
+
+    def _on_browser_clicked(self) -> None:
+        """Get a code, open the identity provider's page, and wait for the artist"""
+        try:
+            self._device_sign_in = self._service.start_browser_sign_in()
+        except AuthenticationError as e:
+            QMessageBox.warning(self, tr("Sign In Failed"), str(e))
+            return
+        self._code_label.setText(
+            tr(
+                "Enter the code {code} on {url} to finish signing in. This window closes by "
+                "itself once you have.",
+                code=self._device_sign_in.user_code,
+                url=self._device_sign_in.verification_uri,
+            )
+        )
+        QDesktopServices.openUrl(QUrl(self._device_sign_in.verification_uri))
+        self._sign_in_btn.setEnabled(False)
+        self._poll_timer.start(self._device_sign_in.interval * 1000)
+
+    def _on_poll(self) -> None:
+        """Ask the identity provider whether the code was entered"""
+        try:
+            identity = self._service.finish_browser_sign_in(self._device_sign_in)
+        except AuthenticationError as e:
+            self._poll_timer.stop()
+            self._sign_in_btn.setEnabled(True)
+            self._code_label.setText("")
+            QMessageBox.warning(self, tr("Sign In Failed"), str(e))
+            return
+        if identity is None:
+            self._poll_timer.setInterval(self._device_sign_in.interval * 1000)
+            return
+        self._poll_timer.stop()
+        self._identity = identity
+        self.accept()
+
+    def reject(self) -> None:
+        """Stop waiting for a browser sign-in"""
+        self._poll_timer.stop()
+        super().reject()
+
+    def get_identity(self) -> Optional[UserIdentity]:
+        """Get who signed in"""
+        return self._identity
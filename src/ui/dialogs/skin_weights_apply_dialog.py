@@ -0,0 +1,185 @@
+# -*- coding: utf-8 -*-
+"""
+Skin Weights Apply Dialog
+Choose the mesh each stored mesh's weights go onto and how they are transferred
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QComboBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+from ...services.skin_weights_service_impl import (
+    METHOD_AUTO,
+    METHOD_LABELS,
+    METHOD_POINT_ORDER,
+    METHOD_UV,
+    METHOD_WORLD_POSITION,
+)
+
+
+class SkinWeightsApplyDialog(QDialog):
+    """
+    Skin Weights Apply Dialog - Single Responsibility for weight transfer choices
+    Each row shows the transfer the chosen mesh gets and why
+    """
+
+    COLUMN_STORED = 0
+    COLUMN_TARGET = 1
+    COLUMN_TRANSFER = 2
+
+    def __init__(
+        self,
+        skin_weights_service,
+        cmds: Any,
+        skin_weights: Dict[str, Any],
+        targets: Dict[str, str],
+        meshes: List[str],
+        parent=None,
+    ):
+        """
+        Args:
+            skin_weights_service: SkinWeightsService checking each choice's topology
+            cmds: maya.cmds module
+            skin_weights: Loaded .skinweights asset
+            targets: Suggested mesh per stored mesh ("" when none was found)
+            meshes: Mesh transforms that can be chosen
+        """
+        super().__init__(parent)
+
+        self._service = skin_weights_service
+        self._cmds = cmds
+        self._skin_weights = skin_weights
+        self._targets = targets
+        self._meshes = meshes
+        self._combos: Dict[str, QComboBox] = {}
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Apply Skin Weights"))
+        self.setMinimumSize(620, 320)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Apply {name}", name=self._skin_weights.get("name", "")))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Choose the mesh each published mesh's weights go onto. Point order needs "
+                "the same topology; world position and UV give each vertex the weights of "
+                "the nearest published vertex. Influences are found by name."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._method_combo = QComboBox()
+        for method in (METHOD_AUTO, METHOD_POINT_ORDER, METHOD_WORLD_POSITION, METHOD_UV):
+            self._method_combo.addItem(METHOD_LABELS[method], method)
+        self._method_combo.setToolTip(
+            tr("Automatic uses point order for the same topology, else UV, else world position")
+        )
+        self._method_combo.currentIndexChanged.connect(lambda _index: self._update_all())
+        form_layout.addRow("Transfer by:", self._method_combo)
+        main_layout.addLayout(form_layout)
+
+        names = [entry.get("name", "") for entry in self._skin_weights.get("meshes", [])]
+        self._table = QTableWidget(len(names), 3)
+        self._table.setHorizontalHeaderLabels(
+            [tr("Published Mesh"), tr("Scene Mesh"), tr("Transfer")]
+        )
+        self._table.verticalHeader().setVisible(False)
+        header = self._table.horizontalHeader()
+        header.setSectionResizeMode(self.COLUMN_TARGET, QHeaderView.ResizeMode.Stretch)
+        header.setSectionResizeMode(self.COLUMN_TRANSFER, QHeaderView.ResizeMode.Stretch)
+
+        for row, name in enumerate(names):
+            self._table.setItem(row, self.COLUMN_STORED, QTableWidgetItem(name))
+            combo = QComboBox()
+            combo.addItem(tr("(skip)"), "")
+            for mesh in self._meshes:
+                combo.addItem(mesh.rsplit("|", 1)[-1], mesh)
+            index = combo.findData(self._targets.get(name, ""))
+            combo.setCurrentIndex(max(index, 0))
+            combo.currentIndexChanged.connect(lambda _index, name=name: self._update_status(name))
+            self._table.setCellWidget(row, self.COLUMN_TARGET, combo)
+            self._table.setItem(row, self.COLUMN_TRANSFER, QTableWidgetItem())
+            self._combos[name] = combo
+        self._update_all()
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        apply_btn = QPushButton(tr("Apply"))
+        apply_btn.setProperty("accent", True)
+        apply_btn.setDefault(True)
+        apply_btn.clicked.connect(self.accept)
+        button_layout.addWidget(apply_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _update_all(self) -> None:
+        """Refresh every row after the method changed"""
+        for name in self._combos:
+            self._update_status(name)
+
+    def _update_status(self, name: str) -> None:
+        """Show how the chosen mesh receives the published mesh's weights"""
+        row = list(self._combos).index(name)
+        mesh = self._combos[name].currentData()
+        status = self._table.item(row, self.COLUMN_TRANSFER)
+        if not mesh:
+            status.setText(tr("Skipped"))
+            status.setToolTip("")
+            return
+        mesh_weights = self._service.get_mesh_weights(self._skin_weights, name)
+        differences = self._service.check_topology(self._cmds, mesh_weights, mesh)
+        method = self._method_combo.currentData()
+        if method == METHOD_AUTO:
+            method = self._service.choose_method(self._cmds, mesh_weights, mesh)
+        if method == METHOD_POINT_ORDER and differences:
+            status.setText(tr("Point order - topology differs, check the result"))
+        elif method == METHOD_UV and not any(mesh_weights.get("uvs", [])):
+            status.setText(tr("UV - published without UVs, choose another method"))
+        elif differences:
+            status.setText(tr("{method} - topology differs", method=METHOD_LABELS[method]))
+        else:
+            status.setText(tr("{method} - same topology", method=METHOD_LABELS[method]))
+        status.setToolTip("\n".join(differences))
+
+    def get_method(self) -> str:
+        """Get the chosen transfer method (METHOD_* constant)"""
+        return self._method_combo.currentData()
+
+    def get_targets(self) -> Dict[str, str]:
+        """Get the chosen mesh per published mesh ("" skips it)"""
+        return {name: combo.currentData() for name, combo in self._combos.items()}
@@ -0,0 +1,150 @@
+# -*- coding: utf-8 -*-
+"""
+Skin Weights Publish Dialog
+Pick the skinned meshes whose weights are published and name the asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QListWidget,
+    QListWidgetItem,
+    QPushButton,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class SkinWeightsPublishDialog(QDialog):
+    """
+    Skin Weights Publish Dialog - Single Responsibility for skin weight asset options
+    Lists the selected skinned meshes and the meshes left out for having no skinCluster
+    """
+
+    def __init__(
+        self,
+        meshes: List[Tuple[str, int]],
+        unskinned: List[str],
+        parent=None,
+    ):
+        """
+        Args:
+            meshes: Skinned meshes (full paths) and their influence counts, all checked
+            unskinned: Selected meshes skipped because nothing skins them
+        """
+        super().__init__(parent)
+
+        self._meshes = meshes
+        self._unskinned = unskinned
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Skin Weights"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Skin Weights"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        description = (
+            "The weights of the checked meshes are stored with their influence lists, "
+            "vertex positions, and UVs. They apply by point order to meshes with the same "
+            "topology, and by world position or UV to revised meshes."
+        )
+        if self._unskinned:
+            skipped = ", ".join(mesh.rsplit("|", 1)[-1] for mesh in self._unskinned)
+            description += f"\n\nSkipped, no skinCluster: {skipped}"
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        self._mesh_list = QListWidget()
+        self._mesh_list.setMaximumHeight(160)
+        for mesh, influence_count in self._meshes:
+            item = QListWidgetItem(f"{mesh.rsplit('|', 1)[-1]} ({influence_count} influences)")
+            item.setData(Qt.UserRole, mesh)  # type: ignore
+            item.setCheckState(Qt.Checked)  # type: ignore
+            self._mesh_list.addItem(item)
+        form_layout.addRow("Meshes:", self._mesh_list)
+
+        first_mesh = self._meshes[0][0].rsplit("|", 1)[-1].rsplit(":", 1)[-1]
+        self._name_edit = QLineEdit(f"{first_mesh}_skin")
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+
+        self._thumbnail_check = QCheckBox(tr("Capture viewport thumbnail"))
+        self._thumbnail_check.setChecked(True)
+        form_layout.addRow("", self._thumbnail_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_checked_meshes(self) -> List[str]:
+        """Get the meshes left checked"""
+        meshes = []
+        for row in range(self._mesh_list.count()):
+            item = self._mesh_list.item(row)
+            if item.checkState() == Qt.Checked:  # type: ignore
+                meshes.append(item.data(Qt.UserRole))  # type: ignore
+        return meshes
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        if not self._get_checked_meshes():
+            QMessageBox.warning(
+                self, tr("No Meshes"), tr("Check at least one mesh to publish.")
+            )
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, notes, meshes, capture_thumbnail)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "meshes": self._get_checked_meshes(),
+            "capture_thumbnail": self._thumbnail_check.isChecked(),
+        }
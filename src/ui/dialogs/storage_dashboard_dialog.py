@@ -0,0 +1,520 @@
+# -*- coding: utf-8 -*-
+"""
+Storage Dashboard Dialog
+Library size by category and asset, its growth, the quotas that warn about it, and
+which assets and categories are used
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional
+
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QCheckBox,
+    QDoubleSpinBox,
+    QSpinBox,
+    QPushButton,
+    QTabWidget,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.storage_usage import (
+    AssetUsage,
+    StorageQuota,
+    StorageReport,
+    UsageSnapshot,
+    format_size,
+)
+from ...core.models.usage_stats import PUBLISH_WINDOW_DAYS
+from ...services.localization_service_impl import tr
+
+# Assets listed in the largest assets table
+LARGEST_ASSET_COUNT = 50
+# Assets listed in the most imported table
+MOST_IMPORTED_COUNT = 50
+
+
+class _SizeItem(QTableWidgetItem):
+    """Table item shown as a size label and sorted by bytes"""
+
+    def __init__(self, size: int):
+        super().__init__(format_size(size))
+        self.setData(Qt.ItemDataRole.UserRole, size)
+
+    def __lt__(self, other: QTableWidgetItem) -> bool:
+        return (self.data(Qt.ItemDataRole.UserRole) or 0) < (
+            other.data(Qt.ItemDataRole.UserRole) or 0
+        )
+
+
+class StorageDashboardDialog(QDialog):
+    """
+    Storage Dashboard Dialog - Single Responsibility for the library disk-use dashboard
+    Measuring runs on a worker thread; the report comes back through a signal
+    """
+
+    measure_finished = Signal(object)
+    asset_selected = Signal(Path)
+
+    CATEGORY_COLUMNS = ["Category", "Assets", "Size", "Share", "Quota (GB)"]
+    ASSET_COLUMNS = ["Asset", "Category", "Size", "Files", "Path"]
+    HISTORY_COLUMNS = ["Day", "Size", "Assets", "Change"]
+    MOST_IMPORTED_COLUMNS = ["Asset", "Imports", "Last Imported", "Path"]
+    TAG_COLUMNS = ["Tag", "Imports"]
+    PUBLISHER_COLUMNS = ["Artist", f"Publishes ({PUBLISH_WINDOW_DAYS} days)", "Per Week", "Last"]
+    CATEGORY_USE_COLUMNS = ["Category", "Assets", "Used", "Used %", "Imports"]
+
+    def __init__(
+        self,
+        storage_service,
+        library_root: Path,
+        can_edit_quota: bool,
+        usage_service=None,
+        parent=None,
+    ):
+        """
+        Args:
+            storage_service: StorageService measuring the library
+            library_root: Library (project) root
+            can_edit_quota: Whether the artist may change the library's quotas and
+                opt the library in to usage statistics
+            usage_service: UsageStatsService for the usage tabs, None to leave them out
+        """
+        super().__init__(parent)
+
+        self._service = storage_service
+        self._usage_service = usage_service
+        self._library_root = Path(library_root)
+        self._can_edit_quota = can_edit_quota
+        self._quota = self._service.load_quota(self._library_root)
+        self._report: Optional[StorageReport] = None
+        self._largest: List[AssetUsage] = []
+        self._measuring = False
+
+        self.measure_finished.connect(self._on_measure_finished)
+
+        self._setup_ui()
+        self._load_quota()
+        self._load_usage()
+        self._on_refresh_clicked()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Storage Usage"))
+        self.setMinimumSize(780, 580)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Storage of {self._library_root.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._summary_label = QLabel()
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        self._warnings_label = QLabel()
+        self._warnings_label.setWordWrap(True)
+        self._warnings_label.setVisible(False)
+        main_layout.addWidget(self._warnings_label)
+
+        self._tabs = QTabWidget()
+
+        self._category_table = self._create_table(self.CATEGORY_COLUMNS, stretch=0)
+        self._category_table.setEditTriggers(
+            QTableWidget.EditTrigger.DoubleClicked | QTableWidget.EditTrigger.EditKeyPressed
+        )
+        self._tabs.addTab(self._category_table, tr("Categories"))
+
+        self._asset_table = self._create_table(self.ASSET_COLUMNS, stretch=4)
+        self._asset_table.setToolTip(tr("Double-click an asset to select it in the library"))
+        self._asset_table.cellDoubleClicked.connect(self._on_asset_double_clicked)
+        self._tabs.addTab(self._asset_table, tr("Largest Assets"))
+
+        self._history_table = self._create_table(self.HISTORY_COLUMNS, stretch=0)
+        self._tabs.addTab(self._history_table, tr("Growth"))
+
+        if self._usage_service is not None:
+            self._most_imported_table = self._create_table(self.MOST_IMPORTED_COLUMNS, stretch=3)
+            self._most_imported_table.setToolTip(
+                tr("Double-click an asset to select it in the library")
+            )
+            self._most_imported_table.cellDoubleClicked.connect(
+                self._on_most_imported_double_clicked
+            )
+            self._tabs.addTab(self._most_imported_table, tr("Most Used"))
+            self._tag_table = self._create_table(self.TAG_COLUMNS, stretch=0)
+            self._tabs.addTab(self._tag_table, tr("Tags Used"))
+            self._publisher_table = self._create_table(self.PUBLISHER_COLUMNS, stretch=0)
+            self._tabs.addTab(self._publisher_table, tr("Publishers"))
+            self._category_use_table = self._create_table(self.CATEGORY_USE_COLUMNS, stretch=0)
+            self._category_use_table.setToolTip(
+                tr("Categories none of whose assets were imported are listed first")
+            )
+            self._tabs.addTab(self._category_use_table, tr("Category Use"))
+        main_layout.addWidget(self._tabs, 1)
+
+        if self._usage_service is not None:
+            usage_layout = QHBoxLayout()
+            self._usage_check = QCheckBox(tr("Count imports and publishes in this library"))
+            self._usage_check.setToolTip(
+                tr("Counts are kept in the library's .assetmanager folder and never sent anywhere")
+            )
+            self._usage_check.setEnabled(self._can_edit_quota)
+            self._usage_check.setChecked(self._usage_service.load(self._library_root).enabled)
+            self._usage_check.toggled.connect(self._on_usage_toggled)
+            usage_layout.addWidget(self._usage_check)
+            self._usage_label = QLabel()
+            self._usage_label.setProperty("description", True)
+            usage_layout.addWidget(self._usage_label, 1)
+            clear_usage_btn = QPushButton(tr("Clear Counts"))
+            clear_usage_btn.setEnabled(self._can_edit_quota)
+            clear_usage_btn.clicked.connect(self._on_clear_usage_clicked)
+            usage_layout.addWidget(clear_usage_btn)
+            main_layout.addLayout(usage_layout)
+
+        quota_layout = QHBoxLayout()
+        quota_layout.addWidget(QLabel(tr("Library quota:")))
+        self._library_quota_spin = self._create_gb_spin()
+        quota_layout.addWidget(self._library_quota_spin)
+        quota_layout.addWidget(QLabel(tr("Per asset:")))
+        self._asset_quota_spin = self._create_gb_spin()
+        self._asset_quota_spin.setToolTip(
+            tr("Warn when one publish, with its versions and caches, grows past this size")
+        )
+        quota_layout.addWidget(self._asset_quota_spin)
+        quota_layout.addWidget(QLabel(tr("Warn at:")))
+        self._warn_spin = QSpinBox()
+        self._warn_spin.setRange(1, 100)
+        self._warn_spin.setSuffix(" %")
+        self._warn_spin.setToolTip(tr("Share of a quota at which warnings start"))
+        quota_layout.addWidget(self._warn_spin)
+        quota_layout.addStretch()
+        main_layout.addLayout(quota_layout)
+
+        self._status_label = QLabel()
+        main_layout.addWidget(self._status_label)
+
+        button_layout = QHBoxLayout()
+
+        self._refresh_btn = QPushButton(tr("Refresh"))
+        self._refresh_btn.setToolTip(tr("Measure the library again"))
+        self._refresh_btn.clicked.connect(self._on_refresh_clicked)
+        button_layout.addWidget(self._refresh_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save Quotas"))
+        save_btn.setProperty("accent", True)
+        save_btn.setEnabled(self._can_edit_quota)
+        if not self._can_edit_quota:
+            save_btn.setToolTip(tr("Only library admins change quotas"))
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.reject)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+        for spin in (self._library_quota_spin, self._asset_quota_spin, self._warn_spin):
+            spin.setEnabled(self._can_edit_quota)
+
+    def _create_table(self, columns: List[str], stretch: int) -> QTableWidget:
+        """Create a read-only, sortable table"""
+        table = QTableWidget(0, len(columns))
+        table.setHorizontalHeaderLabels(columns)
+        table.horizontalHeader().setSectionResizeMode(stretch, QHeaderView.ResizeMode.Stretch)
+        table.verticalHeader().setVisible(False)
+        table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        return table
+
+    def _create_gb_spin(self) -> QDoubleSpinBox:
+        """Create a gigabyte limit field, 0 showing as no limit"""
+        spin = QDoubleSpinBox()
+        spin.setRange(0.0, 1024.0 * 1024.0)
+        spin.setDecimals(1)
+        spin.setSuffix(" GB")
+        spin.setSpecialValueText(tr("None"))
+        return spin
+
+    def _load_quota(self) -> None:
+        """Show the library's saved quotas"""
+        self._library_quota_spin.setValue(self._quota.library_gb)
+        self._asset_quota_spin.setValue(self._quota.asset_gb)
+        self._warn_spin.setValue(self._quota.warn_percent)
+
+    def _on_refresh_clicked(self) -> None:
+        """Measure the library on a worker thread"""
+        if self._measuring:
+            return
+        self._measuring = True
+        self._refresh_btn.setEnabled(False)
+        self._status_label.setText(tr("Measuring library..."))
+        self._service.measure_in_background(self._library_root, self.measure_finished.emit)
+
+    def _on_measure_finished(self, report: Optional[StorageReport]) -> None:
+        """Show what the worker measured"""
+        self._measuring = False
+        self._refresh_btn.setEnabled(True)
+        if report is None:
+            self._status_label.setText(tr("Could not measure the library"))
+            return
+        self._report = report
+        self._summary_label.setText(self._get_summary_text(report))
+        self._show_warnings(report)
+        self._load_categories(report)
+        self._load_largest(report)
+        self._load_history(report)
+        self._load_usage()
+        self._status_label.setText(f"Measured {report.measured.strftime('%Y-%m-%d %H:%M')}")
+
+    def _get_summary_text(self, report: StorageReport) -> str:
+        """Get the summary line with the week's and month's growth"""
+        text = report.summary
+        for days, label in ((7, "7 days"), (30, "30 days")):
+            growth = report.get_growth(days)
+            if growth is not None:
+                sign = "+" if growth >= 0 else "-"
+                text += f", {sign}{format_size(abs(growth))} in {label}"
+        return text
+
+    def _show_warnings(self, report: StorageReport) -> None:
+        """List the quota warnings above the tables"""
+        self._warnings_label.setVisible(bool(report.warnings))
+        lines = [
+            f"{'[EXCEEDED]' if warning.is_exceeded else '[WARNING]'} {warning.description}"
+            for warning in report.warnings[:10]
+        ]
+        if len(report.warnings) > 10:
+            lines.append(f"... and {len(report.warnings) - 10} more")
+        self._warnings_label.setText("\n".join(lines))
+
+    def _load_categories(self, report: StorageReport) -> None:
+        """Fill the category table, largest first, with the editable quota column"""
+        self._category_table.setSortingEnabled(False)
+        self._category_table.setRowCount(0)
+        names = sorted(report.category_bytes, key=lambda name: -report.category_bytes[name])
+        names += sorted(name for name in self._quota.category_gb if name not in names)
+        for name in names:
+            size = report.category_bytes.get(name, 0)
+            row = self._category_table.rowCount()
+            self._category_table.insertRow(row)
+            share = 100.0 * size / report.total_bytes if report.total_bytes else 0.0
+            items = [
+                QTableWidgetItem(name),
+                QTableWidgetItem(str(len(report.get_category_assets(name)))),
+                _SizeItem(size),
+                QTableWidgetItem(f"{share:.1f}%"),
+            ]
+            for column, item in enumerate(items):
+                item.setFlags(item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+                self._category_table.setItem(row, column, item)
+
+            limit = self._quota.category_gb.get(name, 0.0)
+            quota_item = QTableWidgetItem(f"{limit:g}" if limit else "")
+            if not self._can_edit_quota:
+                quota_item.setFlags(quota_item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+            quota_item.setToolTip(tr("Gigabytes the category may use; empty for no limit"))
+            self._category_table.setItem(row, 4, quota_item)
+        self._category_table.setSortingEnabled(True)
+
+    def _load_largest(self, report: StorageReport) -> None:
+        """Fill the largest assets table"""
+        self._largest = report.get_largest(LARGEST_ASSET_COUNT)
+        self._asset_table.setSortingEnabled(False)
+        self._asset_table.setRowCount(0)
+        for usage in self._largest:
+            row = self._asset_table.rowCount()
+            self._asset_table.insertRow(row)
+            name_item = QTableWidgetItem(usage.name)
+            name_item.setData(Qt.ItemDataRole.UserRole, str(usage.asset_path))
+            path_text = self._get_relative_path(usage.asset_path)
+            items = [
+                name_item,
+                QTableWidgetItem(usage.category),
+                _SizeItem(usage.size_bytes),
+                QTableWidgetItem(str(usage.file_count)),
+                QTableWidgetItem(path_text),
+            ]
+            for column, item in enumerate(items):
+                self._asset_table.setItem(row, column, item)
+        self._asset_table.setSortingEnabled(True)
+
+    def _load_history(self, report: StorageReport) -> None:
+        """Fill the growth table, newest day first"""
+        self._history_table.setRowCount(0)
+        previous = None
+        rows = []
+        for snapshot in report.history:
+            change = "" if previous is None else self._get_change_text(snapshot, previous)
+            rows.append((snapshot, change))
+            previous = snapshot
+        for snapshot, change in reversed(rows):
+            row = self._history_table.rowCount()
+            self._history_table.insertRow(row)
+            values = [
+                QTableWidgetItem(snapshot.day.isoformat()),
+                _SizeItem(snapshot.total_bytes),
+                QTableWidgetItem(str(snapshot.asset_count)),
+                QTableWidgetItem(change),
+            ]
+            for column, item in enumerate(values):
+                self._history_table.setItem(row, column, item)
+
+    def _load_usage(self) -> None:
+        """Fill the usage tabs from the library's counts and the last measurement"""
+        if self._usage_service is None:
+            return
+        stats = self._usage_service.load(self._library_root)
+        if stats.enabled:
+            since = stats.since.isoformat() if stats.since else "-"
+            self._usage_label.setText(
+                f"Counting since {since}: {stats.import_count} import(s), "
+                f"{stats.publish_count} publish(es)"
+            )
+        else:
+            self._usage_label.setText(tr("Usage statistics are off for this library"))
+
+        rows = []
+        for asset_key, imports in stats.get_most_imported(MOST_IMPORTED_COUNT):
+            last = stats.last_imports.get(asset_key)
+            last_text = last.isoformat() if last else ""
+            rows.append([Path(asset_key).stem, imports, last_text, asset_key])
+        self._fill_table(self._most_imported_table, rows)
+
+        tags = self._usage_service.get_tags(self._library_root)
+        self._fill_table(self._tag_table, [list(use) for use in stats.get_tag_use(tags)])
+
+        rows = [
+            [
+                activity.user,
+                activity.publish_count,
+                f"{activity.per_week:.1f}",
+                activity.last_publish.isoformat() if activity.last_publish else "",
+            ]
+            for activity in stats.get_publishers()
+        ]
+        self._fill_table(self._publisher_table, rows)
+
+        categories = {}
+        for usage in self._report.assets if self._report is not None else ():
+            asset_key = self._usage_service.get_asset_key(self._library_root, usage.asset_path)
+            categories[asset_key] = usage.category
+        rows = [
+            [
+                use.name,
+                use.asset_count,
+                use.used_count,
+                f"{use.used_percent:.0f}%",
+                use.import_count,
+            ]
+            for use in stats.get_category_use(categories)
+        ]
+        self._fill_table(self._category_use_table, rows)
+
+    def _fill_table(self, table: QTableWidget, rows: List[list]) -> None:
+        """Replace a table's rows, keeping numbers sortable as numbers"""
+        table.setSortingEnabled(False)
+        table.setRowCount(0)
+        for values in rows:
+            row = table.rowCount()
+            table.insertRow(row)
+            for column, value in enumerate(values):
+                item = QTableWidgetItem()
+                item.setData(Qt.ItemDataRole.DisplayRole, value)
+                table.setItem(row, column, item)
+        table.setSortingEnabled(True)
+
+    def _on_most_imported_double_clicked(self, row: int, _column: int) -> None:
+        """Select the double-clicked asset in the library"""
+        item = self._most_imported_table.item(row, 3)
+        if item is not None:
+            self.asset_selected.emit(self._library_root / item.text())
+
+    def _on_usage_toggled(self, enabled: bool) -> None:
+        """Opt the library in to counting or stop counting"""
+        if not self._usage_service.set_enabled(self._library_root, enabled):
+            QMessageBox.critical(self, tr("Error"), tr("Could not save the usage settings."))
+        self._load_usage()
+
+    def _on_clear_usage_clicked(self) -> None:
+        """Drop the library's counts after confirming"""
+        answer = QMessageBox.question(
+            self,
+            tr("Clear Counts"),
+            tr("Clear every import and publish counted in this library?"),
+        )
+        if answer != QMessageBox.StandardButton.Yes:
+            return
+        if not self._usage_service.clear(self._library_root):
+            QMessageBox.critical(self, tr("Error"), tr("Could not clear the usage statistics."))
+        self._load_usage()
+
+    def _get_change_text(self, snapshot: UsageSnapshot, previous: UsageSnapshot) -> str:
+        """Get the size change since the previous snapshot (+1.2 GB)"""
+        change = snapshot.total_bytes - previous.total_bytes
+        return f"{'+' if change >= 0 else '-'}{format_size(abs(change))}"
+
+    def _get_relative_path(self, path: Path) -> str:
+        """Get a path relative to the library root when it is inside it"""
+        try:
+            return path.relative_to(self._library_root).as_posix()
+        except ValueError:
+            return str(path)
+
+    def _on_asset_double_clicked(self, row: int, _column: int) -> None:
+        """Select the double-clicked asset in the library"""
+        item = self._asset_table.item(row, 0)
+        if item is not None:
+            self.asset_selected.emit(Path(item.data(Qt.ItemDataRole.UserRole)))
+
+    def _read_quota(self) -> StorageQuota:
+        """Build the quotas from the fields; raises ValueError for invalid limits"""
+        category_gb = {}
+        for row in range(self._category_table.rowCount()):
+            name = self._category_table.item(row, 0).text()
+            text = self._category_table.item(row, 4).text().strip()
+            if not text:
+                continue
+            try:
+                limit = float(text)
+            except ValueError:
+                raise ValueError(f"Quota of '{name}' is not a number of gigabytes") from None
+            if limit:
+                category_gb[name] = limit
+        return StorageQuota(
+            library_gb=self._library_quota_spin.value(),
+            asset_gb=self._asset_quota_spin.value(),
+            category_gb=category_gb,
+            warn_percent=self._warn_spin.value(),
+        )
+
+    def _on_save_clicked(self) -> None:
+        """Store the quotas in the library and measure against them"""
+        try:
+            quota = self._read_quota()
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Quota"), str(e))
+            return
+        if not self._service.save_quota(self._library_root, quota):
+            QMessageBox.critical(self, tr("Error"), tr("Could not save the storage quotas."))
+            return
+        self._quota = quota
+        self._on_refresh_clicked()
@@ -1,7 +1,7 @@
 # -*- coding: utf-8 -*-
 """
 Tag Manager Dialog
-Manages asset tags with CRUD operations
+Manages the tag hierarchy: add, rename, merge, and delete tags library-wide
 
 Author: Mike Stumbo
 Enterprise Refactoring: Clean Code & SOLID Principles
@@ -12,7 +12,9 @@ try:
         QDialog,
         QVBoxLayout,
         QHBoxLayout,
-        QListWidget,
+        QTreeWidget,
+        QTreeWidgetItem,
+        QAbstractItemView,
         QPushButton,
         QInputDialog,
         QMessageBox,
@@ -21,7 +23,6 @@ try:
         QTextEdit,
         QGroupBox,
         QSplitter,
-        QListWidgetItem,
         QColorDialog,
     )
     from PySide6.QtCore import Signal, Qt
@@ -30,31 +31,43 @@ except ImportError as e:
     print(f"[ERROR] PySide6 import failed: {e}")
     raise
 
-from typing import Dict
+from typing import Any, Dict, List, Optional
+
+from ...core.models.tag_hierarchy import (
+    TagNode,
+    build_tag_tree,
+    is_tag_within,
+    normalize_tag,
+    reparent_tag,
+)
+from ...services.localization_service_impl import tr
 
 
 class TagManagerDialog(QDialog):
     """
     Tag Manager Dialog - Single Responsibility for tag management
-    Provides CRUD operations for asset tags
+    With a library database, rename, merge, and delete change every asset's tags
     """
 
     # Signal emitted when tags are modified
     tags_changed = Signal()
 
-    def __init__(self, parent=None):
+    def __init__(self, parent=None, tag_service: Any = None, database: Any = None):
         super().__init__(parent)
 
         # Tags data - will be loaded from parent widget
         # Format: {"tag_name": {"color": "#RRGGBB", "description": "...", "predefined": bool}}
         self._tags = {}
+        self._tag_service = tag_service
+        self._database = database  # Library MetadataDatabase, None edits the registry only
+        self._changed_assets: List[str] = []
 
         self._setup_ui()
         # Don't populate yet - wait for set_tags() to be called by parent
 
     def _setup_ui(self) -> None:
         """Setup dialog UI - Single Responsibility"""
-        self.setWindowTitle("Tag Manager")
+        self.setWindowTitle(tr("Tag Manager"))
         self.setMinimumSize(600, 400)
         self.resize(700, 500)
 
@@ -64,7 +77,7 @@ class TagManagerDialog(QDialog):
         layout.setSpacing(10)
 
         # Title
-        title_label = QLabel("Asset Tag Management")
+        title_label = QLabel(tr("Asset Tag Management"))
         title_label.setStyleSheet(
             "font-weight: bold; font-size: 16px; color: #cccccc; padding: 5px;"
         )
@@ -90,7 +103,7 @@ class TagManagerDialog(QDialog):
         button_layout.addStretch()
 
         # Close button
-        close_btn = QPushButton("Close")
+        close_btn = QPushButton(tr("Close"))
         close_btn.clicked.connect(self.accept)
         button_layout.addWidget(close_btn)
 
@@ -98,27 +111,38 @@ class TagManagerDialog(QDialog):
 
     def _create_tags_list_panel(self) -> QGroupBox:
         """Create left panel with tags list and controls - Single Responsibility"""
-        group = QGroupBox("Tags")
+        group = QGroupBox(tr("Tags"))
         layout = QVBoxLayout(group)
 
-        # Tags list
-        self._tags_list = QListWidget()
-        self._tags_list.itemSelectionChanged.connect(self._on_tag_selection_changed)
-        layout.addWidget(self._tags_list)
+        hint_label = QLabel(tr("Nest tags with / (environment/exterior/forest)"))
+        hint_label.setStyleSheet("color: #999999;")
+        layout.addWidget(hint_label)
+
+        # Tags tree - ctrl-click several tags to merge them
+        self._tags_tree = QTreeWidget()
+        self._tags_tree.setHeaderLabels(["Tag", "Assets"])
+        self._tags_tree.setSelectionMode(QAbstractItemView.SelectionMode.ExtendedSelection)
+        self._tags_tree.itemSelectionChanged.connect(self._on_tag_selection_changed)
+        layout.addWidget(self._tags_tree)
 
         # Buttons
         button_layout = QHBoxLayout()
 
-        add_btn = QPushButton("Add Tag")
+        add_btn = QPushButton(tr("Add Tag"))
         add_btn.clicked.connect(self._on_add_tag)
         button_layout.addWidget(add_btn)
 
-        self._edit_btn = QPushButton("Edit Tag")
+        self._edit_btn = QPushButton(tr("Rename"))
         self._edit_btn.setEnabled(False)
         self._edit_btn.clicked.connect(self._on_edit_tag)
         button_layout.addWidget(self._edit_btn)
 
-        self._delete_btn = QPushButton("Delete Tag")
+        self._merge_btn = QPushButton(tr("Merge Into..."))
+        self._merge_btn.setEnabled(False)
+        self._merge_btn.clicked.connect(self._on_merge_tags)
+        button_layout.addWidget(self._merge_btn)
+
+        self._delete_btn = QPushButton(tr("Delete Tag"))
         self._delete_btn.setEnabled(False)
         self._delete_btn.clicked.connect(self._on_delete_tag)
         button_layout.addWidget(self._delete_btn)
@@ -129,26 +153,26 @@ class TagManagerDialog(QDialog):
 
     def _create_tag_details_panel(self) -> QGroupBox:
         """Create right panel with tag details - Single Responsibility"""
-        group = QGroupBox("Tag Details")
+        group = QGroupBox(tr("Tag Details"))
         layout = QVBoxLayout(group)
 
         # Tag name
         self._name_edit = QLineEdit()
-        self._name_edit.setPlaceholderText("Tag name...")
+        self._name_edit.setPlaceholderText(tr("Tag name..."))
         self._name_edit.textChanged.connect(self._on_tag_details_changed)
-        layout.addWidget(QLabel("Name:"))
+        layout.addWidget(QLabel(tr("Name:")))
         layout.addWidget(self._name_edit)
 
         # Description
         self._description_edit = QTextEdit()
-        self._description_edit.setPlaceholderText("Tag description...")
+        self._description_edit.setPlaceholderText(tr("Tag description..."))
         self._description_edit.setMaximumHeight(100)
         self._description_edit.textChanged.connect(self._on_tag_details_changed)
-        layout.addWidget(QLabel("Description:"))
+        layout.addWidget(QLabel(tr("Description:")))
         layout.addWidget(self._description_edit)
 
         # Color section with label and buttons
-        layout.addWidget(QLabel("Color:"))
+        layout.addWidget(QLabel(tr("Color:")))
 
         # Color display and edit controls
         color_layout = QHBoxLayout()
@@ -160,7 +184,7 @@ class TagManagerDialog(QDialog):
         color_layout.addWidget(self._color_edit)
 
         # Edit Color button
-        self._edit_color_btn = QPushButton("Edit Color")
+        self._edit_color_btn = QPushButton(tr("Edit Color"))
         self._edit_color_btn.setEnabled(False)
         self._edit_color_btn.setStyleSheet("""
             QPushButton {
@@ -181,7 +205,7 @@ class TagManagerDialog(QDialog):
         color_layout.addWidget(self._edit_color_btn)
 
         # Reset to Default button
-        self._reset_color_btn = QPushButton("Reset to Default")
+        self._reset_color_btn = QPushButton(tr("Reset to Default"))
         self._reset_color_btn.setEnabled(False)
         self._reset_color_btn.setStyleSheet("""
             QPushButton {
@@ -205,7 +229,7 @@ class TagManagerDialog(QDialog):
         layout.addLayout(color_layout)
 
         # Save button
-        self._save_btn = QPushButton("Save Changes")
+        self._save_btn = QPushButton(tr("Save Changes"))
         self._save_btn.setEnabled(False)
         self._save_btn.clicked.connect(self._on_save_tag_details)
         layout.addWidget(self._save_btn)
@@ -214,26 +238,54 @@ class TagManagerDialog(QDialog):
 
         return group
 
-    def _populate_tags(self) -> None:
-        """Populate tags list - Single Responsibility"""
-        self._tags_list.clear()
-        for tag_name in sorted(self._tags.keys()):
-            item = QListWidgetItem(tag_name)
-            self._tags_list.addItem(item)
+    def _populate_tags(self, select: Optional[str] = None) -> None:
+        """Populate the tags tree from the registry and the library - Single Responsibility"""
+        self._tags_tree.clear()
+        tag_uses = self._database.get_all_tags() if self._database is not None else {}
+        tree = build_tag_tree(tag_uses, self._tags)
+
+        def add_nodes(parent: Any, nodes: List[TagNode]) -> None:
+            for node in nodes:
+                uses = str(node.total_uses) if node.total_uses else ""
+                item = QTreeWidgetItem([node.name, uses])
+                item.setData(0, Qt.ItemDataRole.UserRole, node.path)
+                item.setToolTip(0, node.path)
+                if parent is None:
+                    self._tags_tree.addTopLevelItem(item)
+                else:
+                    parent.addChild(item)
+                add_nodes(item, list(node.children))
+                if node.path == select:
+                    self._tags_tree.setCurrentItem(item)
+
+        add_nodes(None, tree)
+        self._tags_tree.expandAll()
+        self._tags_tree.resizeColumnToContents(0)
+
+    def _get_selected_tags(self) -> List[str]:
+        """Get the full tags of the selected tree items"""
+        return [
+            item.data(0, Qt.ItemDataRole.UserRole) for item in self._tags_tree.selectedItems()
+        ]
+
+    def _get_current_tag(self) -> Optional[str]:
+        """Get the selected tag (None unless exactly one is selected)"""
+        tags = self._get_selected_tags()
+        return tags[0] if len(tags) == 1 else None
 
     def _on_tag_selection_changed(self) -> None:
         """Handle tag selection change - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        has_selection = current_item is not None
+        tag_name = self._get_current_tag()
+        has_selection = tag_name is not None
 
         # Enable/disable buttons
         self._edit_btn.setEnabled(has_selection)
-        self._delete_btn.setEnabled(has_selection)
+        self._merge_btn.setEnabled(bool(self._get_selected_tags()))
+        self._delete_btn.setEnabled(bool(self._get_selected_tags()))
         self._edit_color_btn.setEnabled(has_selection)
         self._reset_color_btn.setEnabled(has_selection)
 
         if has_selection:
-            tag_name = current_item.text()
             tag_data = self._tags.get(tag_name, {})
 
             # Update details panel
@@ -256,11 +308,17 @@ class TagManagerDialog(QDialog):
 
     def _on_add_tag(self) -> None:
         """Add new tag - Single Responsibility"""
-        text, ok = QInputDialog.getText(self, "Add Tag", "Tag name:")
-        if ok and text.strip():
-            tag_name = text.strip()
+        parent_tag = self._get_current_tag()
+        text, ok = QInputDialog.getText(
+            self,
+            "Add Tag",
+            "Tag name (a/b nests b under a):",
+            text=f"{parent_tag}/" if parent_tag else "",
+        )
+        tag_name = normalize_tag(text) if ok else ""
+        if tag_name:
             if tag_name in self._tags:
-                QMessageBox.warning(self, "Duplicate Tag", f"Tag '{tag_name}' already exists.")
+                QMessageBox.warning(self, tr("Duplicate Tag"), f"Tag '{tag_name}' already exists.")
                 return
 
             print(f"[TAG]  Tag Manager: Creating new custom tag '{tag_name}'")
@@ -272,91 +330,164 @@ class TagManagerDialog(QDialog):
                 "predefined": False,  # Mark as custom tag
             }
 
-            # Refresh list and select new tag
-            self._populate_tags()
-            items = self._tags_list.findItems(tag_name, Qt.MatchFlag.MatchExactly)
-            if items:
-                self._tags_list.setCurrentItem(items[0])
+            # Refresh tree and select new tag
+            self._populate_tags(select=tag_name)
 
             print(f"[TAG]  [NEW] Custom tag '{tag_name}' added to Tag Manager")
             self.tags_changed.emit()
 
     def _on_edit_tag(self) -> None:
-        """Edit selected tag - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        if not current_item:
+        """Rename the selected tag and the tags below it - Single Responsibility"""
+        old_name = self._get_current_tag()
+        if not old_name:
             return
 
-        old_name = current_item.text()
-        new_name, ok = QInputDialog.getText(self, "Edit Tag", "Tag name:", text=old_name)
-
-        if ok and new_name.strip() and new_name.strip() != old_name:
-            new_name = new_name.strip()
+        new_name, ok = QInputDialog.getText(self, "Rename Tag", "Tag name:", text=old_name)
+        new_name = normalize_tag(new_name) if ok else ""
+        if not new_name or new_name == old_name:
+            return
+        if self._is_predefined(old_name):
+            QMessageBox.warning(
+                self,
+                tr("Cannot Rename"),
+                f"'{old_name}' is a predefined tag and cannot be renamed.",
+            )
+            return
 
-            if new_name in self._tags:
-                QMessageBox.warning(self, "Duplicate Tag", f"Tag '{new_name}' already exists.")
-                return
+        if self._change_library(
+            lambda: self._tag_service.rename_tag(self._database, old_name, new_name)
+        ):
+            self._rename_in_registry(old_name, new_name)
+            self._populate_tags(select=new_name)
+            self.tags_changed.emit()
 
-            # Rename tag
-            tag_data = self._tags.pop(old_name)
-            self._tags[new_name] = tag_data
+    def _on_merge_tags(self) -> None:
+        """Fold the selected tags into another tag - Single Responsibility"""
+        sources = self._get_selected_tags()
+        if not sources:
+            return
 
-            # Refresh list and select renamed tag
-            self._populate_tags()
-            items = self._tags_list.findItems(new_name, Qt.MatchFlag.MatchExactly)
-            if items:
-                self._tags_list.setCurrentItem(items[0])
+        known = sorted(
+            {normalize_tag(tag) for tag in self._tags}
+            | set(self._database.get_all_tags() if self._database is not None else {}),
+            key=str.lower,
+        )
+        candidates = [tag for tag in known if tag not in sources]
+        target, ok = QInputDialog.getItem(
+            self,
+            "Merge Tags",
+            f"Merge {', '.join(sources)} into:\n\n(Sub-tags move along with their parent)",
+            candidates,
+            0,
+            True,  # A new tag name merges into a new tag
+        )
+        target = normalize_tag(target) if ok else ""
+        if not target:
+            return
 
+        predefined = [tag for tag in sources if self._is_predefined(tag)]
+        if predefined:
+            QMessageBox.warning(
+                self,
+                tr("Cannot Merge"),
+                f"'{predefined[0]}' is a predefined tag and cannot be merged.",
+            )
+            return
+        if self._change_library(
+            lambda: self._tag_service.merge_tags(self._database, sources, target)
+        ):
+            for source in sources:
+                if source.lower() != target.lower():
+                    self._rename_in_registry(source, target)
+            self._populate_tags(select=target)
             self.tags_changed.emit()
 
     def _on_delete_tag(self) -> None:
-        """Delete selected tag - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        if not current_item:
+        """Delete selected tags from the registry and every asset - Single Responsibility"""
+        tags = self._get_selected_tags()
+        if not tags:
             return
 
-        tag_name = current_item.text()
-        tag_data = self._tags.get(tag_name, {})
-
-        # Prevent deletion of predefined tags
-        if tag_data.get("predefined", False):
+        predefined = [tag for tag in tags if self._is_predefined(tag)]
+        if predefined:
             QMessageBox.warning(
                 self,
-                "Cannot Delete",
-                f"Cannot delete predefined tag '{tag_name}'.\n\n"
+                tr("Cannot Delete"),
+                f"Cannot delete predefined tag '{predefined[0]}'.\n\n"
                 "Predefined tags are part of the core system.",
             )
             return
 
+        tag_uses = self._database.get_all_tags() if self._database is not None else {}
+        uses = sum(
+            count for tag, count in tag_uses.items() if any(is_tag_within(tag, t) for t in tags)
+        )
+        where = (
+            f"This removes the tag and the tags below it from {uses} asset tag(s)."
+            if self._database is not None
+            else "Assets using this tag will keep it until you manually remove it."
+        )
         reply = QMessageBox.question(
             self,
-            "Delete Tag",
-            f"Are you sure you want to delete tag '{tag_name}'?\n\n"
-            "This will remove the tag from the global registry.\n"
-            "Assets using this tag will keep it until you manually remove it.",
+            tr("Delete Tag"),
+            f"Are you sure you want to delete {', '.join(repr(tag) for tag in tags)}?\n\n{where}",
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
         )
 
-        if reply == QMessageBox.StandardButton.Yes:
-            # Remove tag
-            if tag_name in self._tags:
-                del self._tags[tag_name]
-                print(f"[TAG]  Tag Manager: Deleted custom tag '{tag_name}'")
+        if reply == QMessageBox.StandardButton.Yes and self._change_library(
+            lambda: [
+                key for tag in tags for key in self._tag_service.delete_tag(self._database, tag)
+            ]
+        ):
+            for tag_name in tags:
+                self._rename_in_registry(tag_name, None)
+                print(f"[TAG]  Tag Manager: Deleted tag '{tag_name}'")
 
-            # Refresh list
+            # Refresh tree
             self._populate_tags()
             self.tags_changed.emit()
 
+    def _is_predefined(self, tag_name: str) -> bool:
+        """Check if a tag is one of the built-in tags"""
+        return bool(self._tags.get(tag_name, {}).get("predefined", False))
+
+    def _rename_in_registry(self, old_tag: str, new_tag: Optional[str]) -> None:
+        """Rename (or delete when new_tag is None) a registry tag and its sub-tags"""
+        for tag_name in [tag for tag in self._tags if is_tag_within(tag, old_tag)]:
+            tag_data = self._tags.pop(tag_name)
+            if new_tag is not None:
+                self._tags.setdefault(reparent_tag(tag_name, old_tag, new_tag), tag_data)
+
+    def _change_library(self, change) -> bool:
+        """Run a library-wide tag change, False if it was refused"""
+        if self._tag_service is None or self._database is None:
+            return True  # Registry only
+        try:
+            self._record_changes(change())
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Tag Not Changed"), str(e))
+            return False
+        return True
+
+    def _record_changes(self, asset_keys: List[str]) -> None:
+        """Remember which assets had their tags changed"""
+        for key in asset_keys:
+            if key not in self._changed_assets:
+                self._changed_assets.append(key)
+
+    @property
+    def changed_assets(self) -> List[str]:
+        """Get keys of the library assets whose tags were changed"""
+        return list(self._changed_assets)
+
     def _on_save_tag_details(self) -> None:
         """Save tag details changes - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        if not current_item:
-            return
-
-        tag_name = current_item.text()
-        if tag_name not in self._tags:
+        tag_name = self._get_current_tag()
+        if not tag_name:
             return
+        # Library tags without registry details get an entry when first saved
+        self._tags.setdefault(tag_name, {"predefined": False})
 
         # Update tag data
         self._tags[tag_name]["description"] = self._description_edit.toPlainText()
@@ -366,16 +497,17 @@ class TagManagerDialog(QDialog):
         self._save_btn.setEnabled(False)
 
         self.tags_changed.emit()
-        QMessageBox.information(self, "Saved", f"Tag '{tag_name}' details saved successfully.")
+        QMessageBox.information(self, tr("Saved"), f"Tag '{tag_name}' details saved successfully.")
 
     def _on_edit_color(self) -> None:
         """Open color picker to edit tag color - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        if not current_item:
-            QMessageBox.information(self, "No Selection", "Please select a tag to edit its color.")
+        tag_name = self._get_current_tag()
+        if not tag_name:
+            QMessageBox.information(
+                self, tr("No Selection"), tr("Please select a tag to edit its color.")
+            )
             return
 
-        tag_name = current_item.text()
         current_color_hex = self._color_edit.text() or "#FFFFFF"
 
         # Parse current color
@@ -399,15 +531,13 @@ class TagManagerDialog(QDialog):
 
     def _on_reset_color(self) -> None:
         """Reset tag color to default - Single Responsibility"""
-        current_item = self._tags_list.currentItem()
-        if not current_item:
+        tag_name = self._get_current_tag()
+        if not tag_name:
             return
 
-        tag_name = current_item.text()
-
         reply = QMessageBox.question(
             self,
-            "Reset Color",
+            tr("Reset Color"),
             f"Reset color for tag '{tag_name}' to default (#CCCCCC)?",
             QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
             QMessageBox.StandardButton.No,
@@ -0,0 +1,204 @@
+# -*- coding: utf-8 -*-
+"""
+Tag Rules Dialog
+Edit the rules that tag publishes of a library automatically
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QAbstractItemView,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.tag_hierarchy import parse_tags
+from ...core.models.tag_rule import TagRule
+from ...services.auto_tag_service_impl import DEFAULT_RULES
+from ...services.localization_service_impl import tr
+
+
+class TagRulesDialog(QDialog):
+    """
+    Tag Rules Dialog - Single Responsibility for library auto-tag configuration
+    One row per rule; conditions left empty are not tested
+    """
+
+    COLUMNS = ["Tags", "Asset Name", "Node Types", "Min Tris", "Max Tris", "Source Folder"]
+
+    def __init__(self, auto_tag_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = auto_tag_service
+        self._library_root = Path(library_root)
+        self._reset = False
+
+        self._setup_ui()
+        self._fill_table(auto_tag_service.load_rules(self._library_root))
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Tag Rules"))
+        self.setMinimumSize(760, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Tag Rules"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "Publishes get the tags of every rule they match, on top of the tags the artist "
+            "types. A rule matches when all of its filled-in conditions hold. Names and "
+            "folders take wildcards (chr_*, */characters/*); node types are comma "
+            "separated and any of them counts (skinCluster, pgYeti*).\n"
+            f"{self._service.get_rules_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(2, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+
+        add_btn = QPushButton(tr("Add Rule"))
+        add_btn.clicked.connect(self._on_add_clicked)
+        button_layout.addWidget(add_btn)
+
+        remove_btn = QPushButton(tr("Remove Rule"))
+        remove_btn.clicked.connect(self._on_remove_clicked)
+        button_layout.addWidget(remove_btn)
+
+        defaults_btn = QPushButton(tr("Restore Defaults"))
+        defaults_btn.setToolTip(
+            tr("Use the built-in rules: deformers -> rigged, Yeti/XGen -> groom")
+        )
+        defaults_btn.clicked.connect(self._on_defaults_clicked)
+        button_layout.addWidget(defaults_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _fill_table(self, rules: List[TagRule]) -> None:
+        """Show one row per rule"""
+        self._table.setRowCount(0)
+        for rule in rules:
+            self._add_row(
+                [
+                    ", ".join(rule.tags),
+                    rule.name_pattern,
+                    ", ".join(rule.node_types),
+                    "" if rule.min_triangles is None else str(rule.min_triangles),
+                    "" if rule.max_triangles is None else str(rule.max_triangles),
+                    rule.source_directory,
+                ]
+            )
+        self._table.resizeColumnsToContents()
+
+    def _add_row(self, texts: List[str]) -> None:
+        """Append a rule row"""
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+        for column, text in enumerate(texts):
+            self._table.setItem(row, column, QTableWidgetItem(text))
+
+    def _on_add_clicked(self) -> None:
+        """Append an empty rule and start editing its tags"""
+        self._add_row([""] * len(self.COLUMNS))
+        row = self._table.rowCount() - 1
+        self._table.setCurrentCell(row, 0)
+        self._table.editItem(self._table.item(row, 0))
+
+    def _on_remove_clicked(self) -> None:
+        """Remove the selected rules"""
+        rows = sorted({index.row() for index in self._table.selectedIndexes()}, reverse=True)
+        for row in rows:
+            self._table.removeRow(row)
+
+    def _on_defaults_clicked(self) -> None:
+        """Show the built-in rules; saving goes back to them"""
+        self._fill_table(list(DEFAULT_RULES))
+        self._reset = True
+
+    def _get_text(self, row: int, column: int) -> str:
+        """Get the trimmed text of a cell"""
+        item = self._table.item(row, column)
+        return item.text().strip() if item else ""
+
+    def _parse_count(self, row: int, column: int) -> Optional[int]:
+        """Get a triangle count cell, None when empty"""
+        text = self._get_text(row, column).replace(",", "").replace("_", "")
+        if not text:
+            return None
+        if not text.isdigit():
+            raise ValueError(f"{self.COLUMNS[column]} must be a whole number")
+        return int(text)
+
+    def _parse_rules(self) -> List[TagRule]:
+        """Create rules from the table, leaving out blank rows"""
+        rules = []
+        for row in range(self._table.rowCount()):
+            texts = [self._get_text(row, column) for column in range(len(self.COLUMNS))]
+            if not any(texts):
+                continue
+            try:
+                rules.append(
+                    TagRule(
+                        tags=tuple(parse_tags(texts[0])),
+                        name_pattern=texts[1],
+                        node_types=tuple(part.strip() for part in texts[2].split(",")),
+                        min_triangles=self._parse_count(row, 3),
+                        max_triangles=self._parse_count(row, 4),
+                        source_directory=texts[5],
+                    )
+                )
+            except ValueError as e:
+                raise ValueError(f"Rule {row + 1}: {e}") from e
+        return rules
+
+    def _on_save_clicked(self) -> None:
+        """Store the rules in the library and close"""
+        try:
+            rules = self._parse_rules()
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Rule"), str(e))
+            return
+        if self._reset and rules == list(DEFAULT_RULES):
+            saved = self._service.reset_rules(self._library_root)
+        else:
+            saved = self._service.save_rules(self._library_root, rules)
+        if not saved:
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save the tag rules."))
+            return
+        self.accept()
@@ -0,0 +1,240 @@
+# -*- coding: utf-8 -*-
+"""
+Texture Relink Dialog
+Report texture relinking and fix the textures that could not be found
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import replace
+from pathlib import Path
+from typing import Any, List, Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QGroupBox,
+    QLabel,
+    QPushButton,
+    QCheckBox,
+    QListWidget,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QFileDialog,
+)
+from PySide6.QtGui import QColor
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+from ...services.texture_relink_service_impl import (
+    STATUS_MISSING,
+    STATUS_RELINKED,
+    TextureLink,
+)
+
+STATUS_LABELS = {STATUS_RELINKED: "Relinked", STATUS_MISSING: "Missing"}
+
+
+class TextureRelinkDialog(QDialog):
+    """
+    Texture Relink Dialog - Single Responsibility for unresolved texture fixing
+    Every change is applied to the scene right away
+    """
+
+    COLUMNS = ["Node", "File", "Status", "Path"]
+
+    def __init__(
+        self,
+        relink_service,
+        cmds: Any,
+        links: List[TextureLink],
+        library_root: Optional[Path] = None,
+        asset_file: Optional[Path] = None,
+        parent=None,
+    ):
+        super().__init__(parent)
+
+        self._service = relink_service
+        self._cmds = cmds
+        self._links = list(links)
+        self._library_root = library_root
+        self._asset_file = asset_file
+
+        self._setup_ui()
+        self._populate()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        title = "Texture Relink"
+        self.setWindowTitle(f"{title} - {self._asset_file.name}" if self._asset_file else title)
+        self.setMinimumSize(720, 460)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Texture Relink"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setWordWrap(True)
+        self._summary_label.setProperty("description", True)
+        main_layout.addWidget(self._summary_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.SingleSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(3, QHeaderView.ResizeMode.Stretch)
+        self._table.itemSelectionChanged.connect(self._on_selection_changed)
+        self._table.itemDoubleClicked.connect(lambda _item: self._on_browse())
+        main_layout.addWidget(self._table, 1)
+
+        roots_group = QGroupBox(tr("Search Roots"))
+        roots_layout = QHBoxLayout(roots_group)
+        self._roots_list = QListWidget()
+        self._roots_list.setMaximumHeight(90)
+        self._roots_list.addItems([str(root) for root in self._service.get_configured_roots()])
+        roots_layout.addWidget(self._roots_list, 1)
+
+        roots_buttons = QVBoxLayout()
+        add_root_btn = QPushButton(tr("Add..."))
+        add_root_btn.clicked.connect(self._on_add_root)
+        roots_buttons.addWidget(add_root_btn)
+        remove_root_btn = QPushButton(tr("Remove"))
+        remove_root_btn.clicked.connect(self._on_remove_root)
+        roots_buttons.addWidget(remove_root_btn)
+        roots_buttons.addStretch()
+        roots_layout.addLayout(roots_buttons)
+        main_layout.addWidget(roots_group)
+
+        self._relink_on_import_check = QCheckBox(tr("Relink textures automatically on import"))
+        self._relink_on_import_check.setChecked(self._service.is_relink_on_import())
+        self._relink_on_import_check.toggled.connect(self._on_relink_on_import_toggled)
+        main_layout.addWidget(self._relink_on_import_check)
+
+        button_layout = QHBoxLayout()
+
+        self._browse_btn = QPushButton(tr("Browse..."))
+        self._browse_btn.setEnabled(False)
+        self._browse_btn.clicked.connect(self._on_browse)
+        button_layout.addWidget(self._browse_btn)
+
+        search_btn = QPushButton(tr("Search Again"))
+        search_btn.setProperty("accent", True)
+        search_btn.clicked.connect(self._on_search_again)
+        button_layout.addWidget(search_btn)
+
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_links(self) -> List[TextureLink]:
+        """Get the texture links with their current paths"""
+        return list(self._links)
+
+    def _populate(self) -> None:
+        """Show missing textures first, then relinked and found ones"""
+        order = {STATUS_MISSING: 0, STATUS_RELINKED: 1}
+        self._links.sort(key=lambda link: (order.get(link.status, 2), link.node))
+        self._table.setRowCount(len(self._links))
+
+        for row, link in enumerate(self._links):
+            status = STATUS_LABELS.get(link.status, "Found")
+            values = [link.node, link.file_name, status, link.path]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setToolTip(f"Originally: {link.original_path}")
+                if link.status == STATUS_MISSING:
+                    item.setForeground(QColor("#e06c75"))
+                self._table.setItem(row, column, item)
+        self._table.resizeColumnsToContents()
+
+        missing = sum(1 for link in self._links if not link.is_resolved)
+        relinked = sum(1 for link in self._links if link.status == STATUS_RELINKED)
+        summary = f"{len(self._links)} texture(s), {relinked} relinked, {missing} missing."
+        if missing:
+            summary += " Browse to each missing file, or add a search root and search again."
+        self._summary_label.setText(summary)
+        self._on_selection_changed()
+
+    def _get_selected_row(self) -> Optional[int]:
+        """Get the selected table row"""
+        rows = self._table.selectionModel().selectedRows()
+        return rows[0].row() if rows else None
+
+    def _on_selection_changed(self) -> None:
+        """Enable browsing when a texture is selected"""
+        self._browse_btn.setEnabled(self._get_selected_row() is not None)
+
+    def _on_browse(self) -> None:
+        """Point the selected texture at a file the artist picks"""
+        row = self._get_selected_row()
+        if row is None:
+            return
+        link = self._links[row]
+        file_path, _ = QFileDialog.getOpenFileName(
+            self, f"Locate {link.file_name}", "", "Images (*.*)"
+        )
+        if not file_path:
+            return
+
+        new_path = Path(file_path)
+        if self._service.is_tokenized(link.file_name):
+            # A picked tile stands for the whole UDIM / frame sequence
+            new_path = new_path.parent / link.file_name
+        self._links[row] = self._service.set_texture_path(self._cmds, link, new_path.as_posix())
+        self._populate()
+
+    def _on_search_again(self) -> None:
+        """Relink the still missing textures with the current search roots"""
+        roots = self._service.get_search_roots(self._library_root, self._asset_file)
+        nodes = [link.node for link in self._links if not link.is_resolved]
+        if nodes:
+            updated = {link.node: link for link in self._service.relink(self._cmds, roots, nodes)}
+            # Keep the original paths recorded when the dialog opened
+            self._links = [
+                replace(updated[link.node], original_path=link.original_path)
+                if link.node in updated
+                else link
+                for link in self._links
+            ]
+        self._populate()
+
+    def _on_add_root(self) -> None:
+        """Add a folder to the configured search roots"""
+        folder = QFileDialog.getExistingDirectory(self, "Add Texture Search Root")
+        if not folder:
+            return
+        self._service.set_configured_roots(self._service.get_configured_roots() + [Path(folder)])
+        self._service.save_config()
+        self._roots_list.clear()
+        self._roots_list.addItems([str(root) for root in self._service.get_configured_roots()])
+        self._on_search_again()
+
+    def _on_remove_root(self) -> None:
+        """Remove the selected configured search root"""
+        item = self._roots_list.currentItem()
+        if item is None:
+            return
+        roots = [root for root in self._service.get_configured_roots() if str(root) != item.text()]
+        self._service.set_configured_roots(roots)
+        self._service.save_config()
+        self._roots_list.takeItem(self._roots_list.row(item))
+
+    def _on_relink_on_import_toggled(self, enabled: bool) -> None:
+        """Store whether imports relink automatically"""
+        self._service.set_relink_on_import(enabled)
+        self._service.save_config()
@@ -0,0 +1,157 @@
+# -*- coding: utf-8 -*-
+"""
+Texture Set Publish Dialog
+Review the maps found in the picked textures, set their colorspaces, and name the set
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QComboBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+from ...services.texture_set_service_impl import COLORSPACES
+
+
+class TextureSetPublishDialog(QDialog):
+    """
+    Texture Set Publish Dialog - Single Responsibility for texture set options
+    One row per map; the colorspace picked here is what file nodes get on apply
+    """
+
+    def __init__(
+        self,
+        maps: Dict[str, Dict[str, Any]],
+        unmatched: List[Path],
+        default_name: str,
+        parent=None,
+    ):
+        """
+        Args:
+            maps: Map type -> {"source", "tiles", "colorspace"} grouped from the files
+            unmatched: Files no map type was found for (left out of the set)
+            default_name: Name shown at first (a map file name without its map word)
+        """
+        super().__init__(parent)
+
+        self._maps = maps
+        self._unmatched = unmatched
+        self._default_name = default_name
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Texture Set"))
+        self.setMinimumWidth(560)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Texture Set"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        description = (
+            "The maps are copied into the library and can be applied to a selected "
+            "aiStandardSurface or standardSurface. Color maps default to sRGB, data "
+            "maps to Raw."
+        )
+        if self._unmatched:
+            names = ", ".join(path.name for path in self._unmatched[:5])
+            more = f" and {len(self._unmatched) - 5} more" if len(self._unmatched) > 5 else ""
+            description += f"\nLeft out (no map type in the name): {names}{more}"
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._name_edit = QLineEdit(self._default_name)
+        form_layout.addRow("Name:", self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow("Notes:", self._notes_edit)
+        main_layout.addLayout(form_layout)
+
+        self._table = QTableWidget(0, 4)
+        self._table.setHorizontalHeaderLabels(["Map", "File", "UDIM Tiles", "Colorspace"])
+        self._table.horizontalHeader().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        self._table.verticalHeader().setVisible(False)
+        self._table.setSelectionBehavior(QTableWidget.SelectionBehavior.SelectRows)
+        self._table.setEditTriggers(QTableWidget.EditTrigger.NoEditTriggers)
+        for map_type, options in self._maps.items():
+            self._add_map_row(map_type, options)
+        main_layout.addWidget(self._table)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish Texture Set"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _add_map_row(self, map_type: str, options: Dict[str, Any]) -> None:
+        """Add a map with an editable colorspace (studio OCIO names can be typed in)"""
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+        self._table.setItem(row, 0, QTableWidgetItem(map_type))
+        self._table.setItem(row, 1, QTableWidgetItem(Path(options["source"]).name))
+        tiles = options.get("tiles") or []
+        tile_text = f"{len(tiles)} ({tiles[0]}-{tiles[-1]})" if tiles else "-"
+        self._table.setItem(row, 2, QTableWidgetItem(tile_text))
+
+        colorspace_combo = QComboBox()
+        colorspace_combo.setEditable(True)
+        colorspace_combo.addItems(COLORSPACES)
+        colorspace_combo.setCurrentText(options["colorspace"])
+        self._table.setCellWidget(row, 3, colorspace_combo)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a texture set name."))
+            return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, notes, maps with the chosen colorspaces)"""
+        maps = {}
+        for row in range(self._table.rowCount()):
+            map_type = self._table.item(row, 0).text()
+            colorspace = self._table.cellWidget(row, 3).currentText().strip()
+            maps[map_type] = dict(self._maps[map_type], colorspace=colorspace)
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "maps": maps,
+        }
@@ -0,0 +1,118 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Batch Dialog
+Options for background thumbnail / turntable regeneration of many assets
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QCheckBox,
+    QComboBox,
+    QSpinBox,
+    QPushButton,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class ThumbnailBatchDialog(QDialog):
+    """
+    Thumbnail Batch Dialog - Single Responsibility for regeneration options
+    Rendering itself happens in the background thumbnail queue
+    """
+
+    # (combo label, turntable extension)
+    TURNTABLE_FORMATS = [("Animated GIF (.gif)", ".gif"), ("Movie (.mp4)", ".mp4")]
+    SIZES = [256, 512, 1024]
+
+    def __init__(self, asset_count: int, scope_name: str = "selected assets", parent=None):
+        super().__init__(parent)
+
+        self._asset_count = asset_count
+        self._scope_name = scope_name
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Regenerate Thumbnails"))
+        self.setMinimumWidth(380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Regenerate {self._asset_count} thumbnail(s)")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            f"Thumbnails for {self._scope_name} are rendered in background mayapy "
+            "processes. Maya stays responsive and the library updates as each one finishes."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+
+        # Each asset type's thumbnail settings choose the resolution unless overridden
+        self._size_combo = QComboBox()
+        self._size_combo.addItem("Asset type setting", 0)
+        for size in self.SIZES:
+            self._size_combo.addItem(f"{size} x {size}", size)
+        form_layout.addRow("Resolution:", self._size_combo)
+
+        self._turntable_check = QCheckBox(tr("Render 360° turntable preview"))
+        form_layout.addRow("", self._turntable_check)
+
+        self._format_combo = QComboBox()
+        for label, extension in self.TURNTABLE_FORMATS:
+            self._format_combo.addItem(label, extension)
+        self._format_combo.setEnabled(False)
+        form_layout.addRow("Turntable format:", self._format_combo)
+
+        self._frames_spin = QSpinBox()
+        self._frames_spin.setRange(8, 360)
+        self._frames_spin.setValue(36)
+        self._frames_spin.setEnabled(False)
+        form_layout.addRow("Turntable frames:", self._frames_spin)
+
+        self._turntable_check.toggled.connect(self._format_combo.setEnabled)
+        self._turntable_check.toggled.connect(self._frames_spin.setEnabled)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        start_btn = QPushButton(tr("Start"))
+        start_btn.setProperty("accent", True)
+        start_btn.setDefault(True)
+        start_btn.clicked.connect(self.accept)
+        button_layout.addWidget(start_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get queue options matching ThumbnailQueue.enqueue keyword arguments"""
+        turntable = self._turntable_check.isChecked()
+        return {
+            "size": self._size_combo.currentData(),
+            "turntable_format": self._format_combo.currentData() if turntable else "",
+            "frames": self._frames_spin.value(),
+        }
@@ -0,0 +1,203 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Settings Dialog
+Edit the library's thumbnail camera, lighting, background, and resolution per asset type
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+
+from PySide6.QtGui import QColor
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QGroupBox,
+    QLabel,
+    QListWidget,
+    QComboBox,
+    QCheckBox,
+    QPushButton,
+    QColorDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from .create_asset_dialog import CreateAssetDialog
+from ...core.models.thumbnail_settings import (
+    CAMERA_CUSTOM,
+    CAMERA_LABELS,
+    CAMERA_PRESETS,
+    RESOLUTIONS,
+    ThumbnailSettings,
+)
+from ...services.localization_service_impl import tr
+
+LIBRARY_DEFAULT = "Library Default"
+
+
+class ThumbnailSettingsDialog(QDialog):
+    """
+    Thumbnail Settings Dialog - Single Responsibility for library thumbnail settings
+    Asset types without their own settings use the library default
+    """
+
+    def __init__(self, settings_service, library_root: Path, parent=None):
+        super().__init__(parent)
+
+        self._service = settings_service
+        self._library_root = Path(library_root)
+        self._default, self._asset_types = settings_service.load_settings(self._library_root)
+        self._background = self._default.background
+        self._current_name = ""
+
+        self._setup_ui()
+        self._entry_list.setCurrentRow(0)
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Thumbnail Settings"))
+        self.setMinimumSize(560, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Thumbnail Settings"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            "Background thumbnails of each asset type render with these settings. An asset "
+            "can also use a camera from the scene (Assets > Set Thumbnail Camera). "
+            f"Settings are shared by everyone using this library:\n"
+            f"{self._service.get_settings_file(self._library_root)}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        settings_layout = QHBoxLayout()
+
+        self._entry_list = QListWidget()
+        self._entry_list.addItems([LIBRARY_DEFAULT] + list(CreateAssetDialog.CATEGORIES))
+        self._entry_list.currentTextChanged.connect(self._on_entry_selected)
+        settings_layout.addWidget(self._entry_list)
+
+        settings_group = QGroupBox(tr("Capture Settings"))
+        form_layout = QFormLayout(settings_group)
+
+        self._override_check = QCheckBox(tr("Use settings of its own"))
+        self._override_check.toggled.connect(self._update_enabled)
+        form_layout.addRow("", self._override_check)
+
+        # Scene cameras are chosen per asset, they only make sense for one asset's framing
+        self._camera_combo = QComboBox()
+        for camera in CAMERA_PRESETS:
+            if camera != CAMERA_CUSTOM:
+                self._camera_combo.addItem(CAMERA_LABELS[camera], camera)
+        form_layout.addRow("Camera:", self._camera_combo)
+
+        self._lighting_check = QCheckBox(tr("Neutral lighting (key, fill, rim)"))
+        form_layout.addRow("", self._lighting_check)
+
+        self._background_btn = QPushButton()
+        self._background_btn.clicked.connect(self._on_choose_background)
+        form_layout.addRow("Background:", self._background_btn)
+
+        self._resolution_combo = QComboBox()
+        for resolution in RESOLUTIONS:
+            self._resolution_combo.addItem(f"{resolution} x {resolution}", resolution)
+        form_layout.addRow("Resolution:", self._resolution_combo)
+
+        settings_layout.addWidget(settings_group, 1)
+        main_layout.addLayout(settings_layout, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_entry_selected(self, name: str) -> None:
+        """Keep edits of the previous entry and show the selected one"""
+        self._store_current_entry()
+        self._current_name = name
+        is_default = name == LIBRARY_DEFAULT
+        settings = self._default if is_default else self._asset_types.get(name, self._default)
+
+        self._override_check.setVisible(not is_default)
+        self._override_check.setChecked(is_default or name in self._asset_types)
+        index = self._camera_combo.findData(settings.camera)
+        self._camera_combo.setCurrentIndex(max(index, 0))
+        self._lighting_check.setChecked(settings.lighting)
+        self._set_background(settings.background)
+        index = self._resolution_combo.findData(settings.resolution)
+        self._resolution_combo.setCurrentIndex(max(index, 0))
+        self._update_enabled()
+
+    def _update_enabled(self) -> None:
+        """Only entries with settings of their own can be edited"""
+        enabled = self._override_check.isChecked()
+        for widget in (
+            self._camera_combo,
+            self._lighting_check,
+            self._background_btn,
+            self._resolution_combo,
+        ):
+            widget.setEnabled(enabled)
+
+    def _set_background(self, background) -> None:
+        """Show the background color on its button"""
+        self._background = tuple(background)
+        color = QColor.fromRgbF(*self._background[:3])
+        self._background_btn.setText(color.name())
+        self._background_btn.setStyleSheet(f"background-color: {color.name()};")
+
+    def _on_choose_background(self) -> None:
+        """Pick the background color"""
+        color = QColorDialog.getColor(QColor.fromRgbF(*self._background[:3]), self)
+        if color.isValid():
+            self._set_background((color.redF(), color.greenF(), color.blueF()))
+
+    def _read_settings(self) -> ThumbnailSettings:
+        """Get the settings shown in the fields"""
+        return ThumbnailSettings(
+            camera=self._camera_combo.currentData(),
+            lighting=self._lighting_check.isChecked(),
+            background=tuple(round(value, 3) for value in self._background),
+            resolution=self._resolution_combo.currentData(),
+        )
+
+    def _store_current_entry(self) -> None:
+        """Write the fields back into the entry being edited"""
+        if not self._current_name:
+            return
+        if self._current_name == LIBRARY_DEFAULT:
+            self._default = self._read_settings()
+        elif self._override_check.isChecked():
+            self._asset_types[self._current_name] = self._read_settings()
+        else:
+            self._asset_types.pop(self._current_name, None)
+
+    def _on_save_clicked(self) -> None:
+        """Store settings in the library and close"""
+        self._store_current_entry()
+        if not self._service.save_settings(self._library_root, self._default, self._asset_types):
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the thumbnail settings.")
+            )
+            return
+        self.accept()
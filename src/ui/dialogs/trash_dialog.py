@@ -0,0 +1,219 @@
+# -*- coding: utf-8 -*-
+"""
+Trash Dialog
+Restore or purge deleted library assets and set how long the trash keeps them
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QSpinBox,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QHeaderView,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, Signal
+
+from ..theme import UITheme
+from ...core.models.trash_entry import TrashEntry
+from ...services.localization_service_impl import tr
+
+
+def _format_size(size: float) -> str:
+    """Get a short size label (12.4 MB)"""
+    if size < 1024:
+        return f"{size:.0f} B"
+    for unit in ("KB", "MB"):
+        size /= 1024
+        if size < 1024:
+            return f"{size:.1f} {unit}"
+    return f"{size / 1024:.1f} GB"
+
+
+class TrashDialog(QDialog):
+    """
+    Trash Dialog - Single Responsibility for reviewing the library trash
+    Restored files are moved back here; the library database and browser are left to the owner
+    """
+
+    # Emitted with (entry, restored asset file) after the files were moved back
+    entry_restored = Signal(object, object)
+
+    COLUMNS = ["Asset", "Original Location", "Deleted By", "Deleted", "Purged", "Size"]
+
+    def __init__(
+        self, trash_service, library_root: Path, can_purge: bool, can_manage: bool, parent=None
+    ):
+        """
+        Args:
+            trash_service: TrashService of the library
+            library_root: Library whose trash is shown
+            can_purge: Whether the artist may delete assets for good
+            can_manage: Whether the artist may change the retention period
+        """
+        super().__init__(parent)
+
+        self._service = trash_service
+        self._library_root = Path(library_root)
+        self._can_purge = can_purge
+        self._can_manage = can_manage
+        self._entries: List[TrashEntry] = []
+
+        self._setup_ui()
+        self._load_entries()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Library Trash"))
+        self.setMinimumSize(680, 380)
+        self.resize(820, 480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Library Trash"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Deleted assets are kept here with their thumbnails, versions, and LODs. "
+                "Restore puts them back where they were; Purge deletes them for good."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._tree = QTreeWidget()
+        self._tree.setHeaderLabels(self.COLUMNS)
+        self._tree.setRootIsDecorated(False)
+        self._tree.setSelectionMode(QTreeWidget.SelectionMode.ExtendedSelection)
+        self._tree.header().setSectionResizeMode(1, QHeaderView.ResizeMode.Stretch)
+        self._tree.itemSelectionChanged.connect(self._update_buttons)
+        main_layout.addWidget(self._tree, 1)
+
+        retention_layout = QHBoxLayout()
+        retention_layout.addWidget(QLabel(tr("Purge deleted assets automatically after")))
+        self._retention_spin = QSpinBox()
+        self._retention_spin.setRange(0, 3650)
+        self._retention_spin.setSuffix(" days")
+        self._retention_spin.setSpecialValueText("Never")
+        self._retention_spin.setValue(self._service.get_retention_days(self._library_root))
+        self._retention_spin.setEnabled(self._can_manage)
+        if not self._can_manage:
+            self._retention_spin.setToolTip(tr("Only library admins change the retention period"))
+        self._retention_spin.valueChanged.connect(self._on_retention_changed)
+        retention_layout.addWidget(self._retention_spin)
+        retention_layout.addStretch()
+        main_layout.addLayout(retention_layout)
+
+        button_layout = QHBoxLayout()
+        self._empty_btn = QPushButton(tr("Empty Trash"))
+        self._empty_btn.clicked.connect(self._on_empty)
+        button_layout.addWidget(self._empty_btn)
+        button_layout.addStretch()
+
+        self._restore_btn = QPushButton(tr("Restore"))
+        self._restore_btn.setProperty("accent", True)
+        self._restore_btn.clicked.connect(self._on_restore)
+        button_layout.addWidget(self._restore_btn)
+
+        self._purge_btn = QPushButton(tr("Purge"))
+        self._purge_btn.clicked.connect(self._on_purge)
+        button_layout.addWidget(self._purge_btn)
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_entries(self) -> None:
+        """List the trash entries with the date automatic purging removes them"""
+        self._entries = self._service.list_entries(self._library_root)
+        retention_days = self._service.get_retention_days(self._library_root)
+        self._tree.clear()
+        for entry in self._entries:
+            expiry = entry.get_expiry(retention_days)
+            deleted = entry.deleted_date.strftime("%Y-%m-%d %H:%M") if entry.deleted_date else "-"
+            item = QTreeWidgetItem(
+                [
+                    entry.name,
+                    str(Path(entry.asset_path).parent),
+                    entry.deleted_by or "-",
+                    deleted,
+                    expiry.strftime("%Y-%m-%d") if expiry else "Never",
+                    _format_size(entry.size),
+                ]
+            )
+            item.setData(0, Qt.ItemDataRole.UserRole, entry)
+            item.setToolTip(0, "\n".join(entry.files))
+            self._tree.addTopLevelItem(item)
+        self._update_buttons()
+
+    def _selected_entries(self) -> List[TrashEntry]:
+        """Get the entries of the selected rows"""
+        return [item.data(0, Qt.ItemDataRole.UserRole) for item in self._tree.selectedItems()]
+
+    def _update_buttons(self) -> None:
+        """Enable the actions that apply to the selection"""
+        selected = bool(self._tree.selectedItems())
+        self._restore_btn.setEnabled(selected)
+        self._purge_btn.setEnabled(selected and self._can_purge)
+        self._empty_btn.setEnabled(bool(self._entries) and self._can_purge)
+
+    def _on_restore(self) -> None:
+        """Move the selected assets back into the library and report clashes"""
+        errors = []
+        for entry in self._selected_entries():
+            try:
+                asset_file = self._service.restore(self._library_root, entry)
+            except Exception as e:
+                errors.append(str(e))
+                continue
+            self.entry_restored.emit(entry, asset_file)
+        if errors:
+            QMessageBox.warning(self, tr("Restore Failed"), "\n".join(errors))
+        self._load_entries()
+
+    def _on_purge(self) -> None:
+        """Delete the selected entries for good after confirming"""
+        self._purge(self._selected_entries())
+
+    def _on_empty(self) -> None:
+        """Delete every entry for good after confirming"""
+        self._purge(list(self._entries))
+
+    def _purge(self, entries: List[TrashEntry]) -> None:
+        """Purge entries once the artist confirms it cannot be undone"""
+        if not entries:
+            return
+        names = "\n".join(entry.name for entry in entries[:10])
+        answer = QMessageBox.question(
+            self,
+            tr("Purge from Trash"),
+            f"Permanently delete {len(entries)} asset(s)? This cannot be undone.\n\n{names}",
+        )
+        if answer != QMessageBox.StandardButton.Yes:
+            return
+        for entry in entries:
+            self._service.purge(self._library_root, entry)
+        self._load_entries()
+
+    def _on_retention_changed(self, days: int) -> None:
+        """Store the retention period and show the new purge dates"""
+        if self._service.set_retention_days(self._library_root, days):
+            self._load_entries()
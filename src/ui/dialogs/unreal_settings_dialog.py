@@ -0,0 +1,167 @@
+# -*- coding: utf-8 -*-
+"""
+Unreal Settings Dialog
+Set the Unreal project folder, FBX preset, and remote import used by Send to Unreal
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QComboBox,
+    QCheckBox,
+    QPushButton,
+    QFileDialog,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class UnrealSettingsDialog(QDialog):
+    """
+    Unreal Settings Dialog - Single Responsibility for Send to Unreal configuration
+    """
+
+    def __init__(self, unreal_service, preset_names: List[str], parent=None):
+        super().__init__(parent)
+
+        self._service = unreal_service
+        self._preset_names = preset_names
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Unreal Settings"))
+        self.setMinimumWidth(480)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Send to Unreal"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Publishes sent to Unreal write an FBX with the chosen preset into the project's "
+                "Content folder, in the folder they import to. Remote import needs the Python "
+                "Editor Script Plugin with Enable Remote Execution turned on in the editor."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        config = self._service.get_config()
+        form_layout = QFormLayout()
+
+        content_layout = QHBoxLayout()
+        self._content_edit = QLineEdit(config.get("content_dir", ""))
+        self._content_edit.setPlaceholderText(
+            tr("MyGame/Content - empty keeps the FBX by the asset")
+        )
+        content_layout.addWidget(self._content_edit, 1)
+        browse_btn = QPushButton(tr("Browse..."))
+        browse_btn.clicked.connect(self._on_browse)
+        content_layout.addWidget(browse_btn)
+        form_layout.addRow("Content folder:", content_layout)
+
+        self._game_folder_edit = QLineEdit(config.get("game_folder", ""))
+        self._game_folder_edit.setToolTip(tr("Assets import to /Game/<folder>/<type>/<name>"))
+        form_layout.addRow("Game folder:", self._game_folder_edit)
+
+        self._preset_combo = QComboBox()
+        self._preset_combo.addItems(self._preset_names)
+        index = self._preset_combo.findText(config.get("fbx_preset", ""))
+        self._preset_combo.setCurrentIndex(max(index, 0))
+        form_layout.addRow("FBX preset:", self._preset_combo)
+
+        main_layout.addLayout(form_layout)
+
+        self._remote_check = QCheckBox(tr("Import into the running Unreal Editor"))
+        self._remote_check.setChecked(bool(config.get("remote_import")))
+        main_layout.addWidget(self._remote_check)
+
+        button_layout = QHBoxLayout()
+
+        test_btn = QPushButton(tr("Find Editor"))
+        test_btn.setToolTip(tr("Check that a running editor answers remote execution"))
+        test_btn.clicked.connect(self._on_find_editor)
+        button_layout.addWidget(test_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_browse(self) -> None:
+        """Pick the project's Content folder"""
+        start = self._content_edit.text().strip() or str(Path.home())
+        folder = QFileDialog.getExistingDirectory(self, "Unreal Content Folder", start)
+        if folder:
+            self._content_edit.setText(folder)
+
+    def _on_find_editor(self) -> None:
+        """Ping for a running editor and say which project it has open"""
+        editor = self._service.find_editor()
+        if editor is None:
+            QMessageBox.warning(
+                self,
+                tr("No Editor"),
+                tr(
+                    "No Unreal Editor answered.\n\nOpen the project and turn on Enable Remote "
+                    "Execution in Project Settings > Plugins > Python."
+                ),
+            )
+            return
+        QMessageBox.information(
+            self,
+            tr("Editor Found"),
+            f"{editor.get('project_name', 'Unreal project')} "
+            f"(Unreal {editor.get('engine_version', '?')}) on {editor.get('machine', '?')}",
+        )
+
+    def _on_save_clicked(self) -> None:
+        """Store Unreal configuration and close"""
+        content_dir = self._content_edit.text().strip()
+        if content_dir and Path(content_dir).name != "Content":
+            answer = QMessageBox.question(
+                self,
+                tr("Content Folder"),
+                f"{content_dir} is not named Content.\n\nUse it anyway?",
+            )
+            if answer != QMessageBox.StandardButton.Yes:
+                return
+
+        self._service.set_config(
+            content_dir=content_dir,
+            game_folder=self._game_folder_edit.text().strip(),
+            fbx_preset=self._preset_combo.currentText(),
+            remote_import=self._remote_check.isChecked(),
+        )
+        if not self._service.save_config():
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save Unreal settings."))
+            return
+        self.accept()
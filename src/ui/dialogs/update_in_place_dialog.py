@@ -0,0 +1,120 @@
+# -*- coding: utf-8 -*-
+"""
+Update in Place Dialog
+Choose what the latest version of an asset transfers onto its imported copies
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QCheckBox,
+    QPushButton,
+)
+from PySide6.QtCore import QSettings
+
+from ..theme import UITheme
+from ...core.models.merge_import import MergeImportOptions
+from ...services.localization_service_impl import tr
+
+
+class UpdateInPlaceDialog(QDialog):
+    """
+    Update in Place Dialog - Single Responsibility for merge import options
+    The choices are remembered for the next update
+    """
+
+    def __init__(self, label: str, copy_count: int, parent=None):
+        """
+        Args:
+            label: Asset being updated
+            copy_count: Imported copies of it in the scene
+        """
+        super().__init__(parent)
+
+        self._label = label
+        self._copy_count = copy_count
+        self._settings = QSettings("MikeStumbo", "AssetManager")
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Update in Place"))
+        self.setMinimumWidth(380)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(
+            tr("Update {count} copy(ies) of {name}", count=self._copy_count, name=self._label)
+        )
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "The scene keeps its nodes, so constraints, skinning, and keys on them stay. "
+                "Deformed meshes whose topology changed are skipped."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        # MergeImportOptions field -> check box
+        self._checks = {
+            "shapes": QCheckBox(tr("Shapes")),
+            "uvs": QCheckBox(tr("UVs")),
+            "shading": QCheckBox(tr("Shading assignments")),
+            "add_new": QCheckBox(tr("New nodes")),
+        }
+        self._checks["shapes"].setToolTip(tr("Copy the new mesh data into the existing shapes"))
+        self._checks["uvs"].setToolTip(tr("Copy only the UVs when shapes are left as they are"))
+        self._checks["shading"].setToolTip(tr("Assign the new version's shading groups"))
+        self._checks["add_new"].setToolTip(
+            tr("Add the nodes the new version has under their parents")
+        )
+        saved = str(self._settings.value("updateInPlaceOptions", ",".join(self._checks)))
+        for field, check in self._checks.items():
+            check.setChecked(field in saved.split(","))
+            check.toggled.connect(self._update_enabled)
+            main_layout.addWidget(check)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        self._update_btn = QPushButton(tr("Update"))
+        self._update_btn.setProperty("accent", True)
+        self._update_btn.setDefault(True)
+        self._update_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(self._update_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+        self._update_enabled()
+
+    def _update_enabled(self) -> None:
+        """Allow updating once something is checked"""
+        self._update_btn.setEnabled(any(check.isChecked() for check in self._checks.values()))
+
+    def _on_accept(self) -> None:
+        """Remember the choices, then close"""
+        checked = [field for field, check in self._checks.items() if check.isChecked()]
+        self._settings.setValue("updateInPlaceOptions", ",".join(checked))
+        self.accept()
+
+    def get_options(self) -> MergeImportOptions:
+        """Get the chosen options"""
+        return MergeImportOptions(
+            **{field: check.isChecked() for field, check in self._checks.items()}
+        )
@@ -50,6 +50,7 @@ from PySide6.QtWidgets import (
 
 # Import our services
 from ...core.interfaces.usd_export_service import USDExportOptions
+from ...services.localization_service_impl import tr
 from ...services.usd import MayaSceneParserImpl, USDExportServiceImpl, USDRigConverterImpl
 from ...services.usd.maya_rig_exporter import MayaRigExporter
 from ...services.usd.usd_material_converter_impl import USDMaterialConverterImpl
@@ -99,7 +100,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _setup_window(self) -> None:
         """Setup window properties"""
-        self.setWindowTitle("USD Pipeline Creator")
+        self.setWindowTitle(tr("USD Pipeline Creator"))
         self.setMinimumSize(700, 800)
         self.resize(750, 850)
 
@@ -154,7 +155,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
             logger.error(f"Failed to initialize services: {e}")
             QMessageBox.warning(
                 self,
-                "Service Initialization Error",
+                tr("Service Initialization Error"),
                 f"Failed to initialize USD pipeline services:\n{e}\n\nSome features may not work.",
             )
 
@@ -165,7 +166,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         main_layout.setSpacing(12)
 
         # Title
-        title_label = QLabel("USD PIPELINE CREATOR")
+        title_label = QLabel(tr("USD PIPELINE CREATOR"))
         title_font = QFont()
         title_font.setPointSize(14)
         title_font.setBold(True)
@@ -200,7 +201,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         export_layout.addWidget(progress_group)
 
         export_layout.addStretch()
-        self._tab_widget.addTab(export_tab, "Export to USD")
+        self._tab_widget.addTab(export_tab, tr("Export to USD"))
 
         # Import Tab
         import_tab = QWidget()
@@ -227,7 +228,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         import_layout.addWidget(import_progress_group)
 
         import_layout.addStretch()
-        self._tab_widget.addTab(import_tab, "Import from USD")
+        self._tab_widget.addTab(import_tab, tr("Import from USD"))
 
         # Buttons (shared between tabs)
         button_layout = self._create_button_section()
@@ -235,22 +236,22 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_source_section(self) -> QGroupBox:
         """Create source selection section"""
-        group = QGroupBox("Source Selection")
+        group = QGroupBox(tr("Source Selection"))
         layout = QVBoxLayout(group)
         layout.setSpacing(8)
 
         # Source type selection
         source_type_layout = QHBoxLayout()
-        source_type_layout.addWidget(QLabel("Export From:"))
+        source_type_layout.addWidget(QLabel(tr("Export From:")))
 
         self._source_type_group = QButtonGroup(self)
-        self._current_scene_radio = QRadioButton("Current Scene")
+        self._current_scene_radio = QRadioButton(tr("Current Scene"))
         self._current_scene_radio.setChecked(True)
         self._current_scene_radio.toggled.connect(self._on_source_type_changed)
         self._source_type_group.addButton(self._current_scene_radio, 0)
         source_type_layout.addWidget(self._current_scene_radio)
 
-        self._file_radio = QRadioButton("Maya File")
+        self._file_radio = QRadioButton(tr("Maya File"))
         self._file_radio.toggled.connect(self._on_source_type_changed)
         self._source_type_group.addButton(self._file_radio, 1)
         source_type_layout.addWidget(self._file_radio)
@@ -260,13 +261,13 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Maya scene file (initially disabled)
         file_layout = QHBoxLayout()
-        file_layout.addWidget(QLabel("Source Maya Scene:"))
+        file_layout.addWidget(QLabel(tr("Source Maya Scene:")))
         self._source_file_edit = QLineEdit()
-        self._source_file_edit.setPlaceholderText("Select a Maya scene file (.ma or .mb)")
+        self._source_file_edit.setPlaceholderText(tr("Select a Maya scene file (.ma or .mb)"))
         self._source_file_edit.setEnabled(False)
         file_layout.addWidget(self._source_file_edit, 1)
 
-        self._browse_source_btn = QPushButton("Browse...")
+        self._browse_source_btn = QPushButton(tr("Browse..."))
         self._browse_source_btn.clicked.connect(self._on_browse_source)
         self._browse_source_btn.setEnabled(False)
         file_layout.addWidget(self._browse_source_btn)
@@ -274,39 +275,41 @@ class USDPipelineDialog(QDialog):  # type: ignore
         layout.addLayout(file_layout)
 
         # Include options
-        self._include_geometry_cb = QCheckBox("Include Geometry")
+        self._include_geometry_cb = QCheckBox(tr("Include Geometry"))
         self._include_geometry_cb.setChecked(True)
         layout.addWidget(self._include_geometry_cb)
 
         # XGen mesh filtering
-        self._exclude_xgen_cb = QCheckBox("Exclude XGen/Hair Meshes")
+        self._exclude_xgen_cb = QCheckBox(tr("Exclude XGen/Hair Meshes"))
         self._exclude_xgen_cb.setChecked(True)
         self._exclude_xgen_cb.setToolTip(
-            "[LOOKDEV] XGen/Hair Mesh Filtering\n\n"
-            "Excludes scalp meshes, hair guides, and groom geometry\n"
-            "from the USD export.\n\n"
-            "These meshes are typically:\n"
-            "• Scalp geometry used by XGen\n"
-            "• Hair guide curves converted to mesh\n"
-            "• Groom/fur placeholder geometry\n\n"
-            "Patterns matched: _scalp, _xgen, _hair, _fur, _guide, _groom"
+            tr(
+                "[LOOKDEV] XGen/Hair Mesh Filtering\n\n"
+                "Excludes scalp meshes, hair guides, and groom geometry\n"
+                "from the USD export.\n\n"
+                "These meshes are typically:\n"
+                "• Scalp geometry used by XGen\n"
+                "• Hair guide curves converted to mesh\n"
+                "• Groom/fur placeholder geometry\n\n"
+                "Patterns matched: _scalp, _xgen, _hair, _fur, _guide, _groom"
+            )
         )
         layout.addWidget(self._exclude_xgen_cb)
 
-        self._include_nurbs_cb = QCheckBox("Include NURBS Curves (Controllers)")
+        self._include_nurbs_cb = QCheckBox(tr("Include NURBS Curves (Controllers)"))
         self._include_nurbs_cb.setChecked(True)
-        self._include_nurbs_cb.setToolTip("Export NURBS curves to USD (rig controllers)")
+        self._include_nurbs_cb.setToolTip(tr("Export NURBS curves to USD (rig controllers)"))
         layout.addWidget(self._include_nurbs_cb)
 
-        self._include_rigging_cb = QCheckBox("Include Rigging (Skin Clusters)")
+        self._include_rigging_cb = QCheckBox(tr("Include Rigging (Skin Clusters)"))
         self._include_rigging_cb.setChecked(True)
         layout.addWidget(self._include_rigging_cb)
 
-        self._include_materials_cb = QCheckBox("Include Materials (RenderMan)")
+        self._include_materials_cb = QCheckBox(tr("Include Materials (RenderMan)"))
         self._include_materials_cb.setChecked(True)
         layout.addWidget(self._include_materials_cb)
 
-        self._include_animation_cb = QCheckBox("Include Animation")
+        self._include_animation_cb = QCheckBox(tr("Include Animation"))
         self._include_animation_cb.setChecked(False)
         layout.addWidget(self._include_animation_cb)
 
@@ -314,13 +317,13 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_export_options_section(self) -> QGroupBox:
         """Create USD options section"""
-        group = QGroupBox("USD Options")
+        group = QGroupBox(tr("USD Options"))
         layout = QVBoxLayout(group)
         layout.setSpacing(10)
 
         # Export preset dropdown
         preset_layout = QHBoxLayout()
-        preset_label = QLabel("Export Preset:")
+        preset_label = QLabel(tr("Export Preset:"))
         preset_label.setStyleSheet("font-weight: bold;")
         preset_layout.addWidget(preset_label)
 
@@ -328,10 +331,12 @@ class USDPipelineDialog(QDialog):  # type: ignore
         self._export_preset_combo.addItem("Full Rig (Animation)", "full_rig")
         self._export_preset_combo.addItem("Geometry + Materials Only (Texturing)", "geo_materials")
         self._export_preset_combo.setToolTip(
-            "Quick presets for common workflows:\n\n"
-            "• Full Rig: Export everything for animation\n"
-            "• Geometry + Materials: For texture painting in Substance 3D, Mari, etc.\n"
-            "  (No skeleton, animation, or controllers - just meshes + UVs + shaders)"
+            tr(
+                "Quick presets for common workflows:\n\n"
+                "• Full Rig: Export everything for animation\n"
+                "• Geometry + Materials: For texture painting in Substance 3D, Mari, etc.\n"
+                "  (No skeleton, animation, or controllers - just meshes + UVs + shaders)"
+            )
         )
         self._export_preset_combo.currentIndexChanged.connect(self._on_export_preset_changed)
         preset_layout.addWidget(self._export_preset_combo, 1)
@@ -345,30 +350,32 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # USD format
         format_layout = QHBoxLayout()
-        format_layout.addWidget(QLabel("USD Format:"))
+        format_layout.addWidget(QLabel(tr("USD Format:")))
 
         self._format_group = QButtonGroup(self)
-        self._usda_radio = QRadioButton(".usda (ASCII)")
+        self._usda_radio = QRadioButton(tr(".usda (ASCII)"))
         self._usda_radio.setChecked(True)
         self._format_group.addButton(self._usda_radio, 0)
         format_layout.addWidget(self._usda_radio)
 
-        self._usdc_radio = QRadioButton(".usdc (Binary)")
+        self._usdc_radio = QRadioButton(tr(".usdc (Binary)"))
         self._format_group.addButton(self._usdc_radio, 1)
         format_layout.addWidget(self._usdc_radio)
 
-        self._usdz_radio = QRadioButton(".usdz (Package)")
+        self._usdz_radio = QRadioButton(tr(".usdz (Package)"))
         self._usdz_radio.setToolTip(
-            "[PACKAGE] USDZ Package Format\n\n"
-            "Creates a single .usdz file containing:\n"
-            "• USD geometry and materials\n"
-            "• .rig.mb Maya rig file (bundled automatically)\n"
-            "• Referenced textures (optional)\n\n"
-            "Benefits:\n"
-            "• Single-file distribution - no lost files!\n"
-            "• Import automatically extracts .rig.mb\n"
-            "• Industry-standard AR/mobile format\n\n"
-            "Best for sharing rigs and archival."
+            tr(
+                "[PACKAGE] USDZ Package Format\n\n"
+                "Creates a single .usdz file containing:\n"
+                "• USD geometry and materials\n"
+                "• .rig.mb Maya rig file (bundled automatically)\n"
+                "• Referenced textures (optional)\n\n"
+                "Benefits:\n"
+                "• Single-file distribution - no lost files!\n"
+                "• Import automatically extracts .rig.mb\n"
+                "• Industry-standard AR/mobile format\n\n"
+                "Best for sharing rigs and archival."
+            )
         )
         self._format_group.addButton(self._usdz_radio, 2)
         format_layout.addWidget(self._usdz_radio)
@@ -378,29 +385,29 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Output path
         output_layout = QHBoxLayout()
-        output_layout.addWidget(QLabel("Output Path:"))
+        output_layout.addWidget(QLabel(tr("Output Path:")))
         self._output_path_edit = QLineEdit()
-        self._output_path_edit.setPlaceholderText("Select output USD file path")
+        self._output_path_edit.setPlaceholderText(tr("Select output USD file path"))
         output_layout.addWidget(self._output_path_edit, 1)
 
-        self._browse_output_btn = QPushButton("Browse...")
+        self._browse_output_btn = QPushButton(tr("Browse..."))
         self._browse_output_btn.clicked.connect(self._on_browse_output)
         output_layout.addWidget(self._browse_output_btn)
 
         layout.addLayout(output_layout)
 
         # Material conversion
-        material_label = QLabel("Material Conversion:")
+        material_label = QLabel(tr("Material Conversion:"))
         material_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
         layout.addWidget(material_label)
 
         self._material_group = QButtonGroup(self)
-        self._preview_surface_radio = QRadioButton("UsdPreviewSurface (Universal)")
+        self._preview_surface_radio = QRadioButton(tr("UsdPreviewSurface (Universal)"))
         self._preview_surface_radio.setChecked(True)
         self._material_group.addButton(self._preview_surface_radio, 0)
         layout.addWidget(self._preview_surface_radio)
 
-        self._renderman_radio = QRadioButton("Preserve RenderMan (Disney/Pixar)")
+        self._renderman_radio = QRadioButton(tr("Preserve RenderMan (Disney/Pixar)"))
         self._material_group.addButton(self._renderman_radio, 1)
         layout.addWidget(self._renderman_radio)
 
@@ -409,22 +416,24 @@ class USDPipelineDialog(QDialog):  # type: ignore
         rma_lib_layout = QHBoxLayout(self._rma_lib_widget)
         rma_lib_layout.setContentsMargins(20, 0, 0, 0)
         rma_lib_layout.setSpacing(4)
-        rma_lib_label = QLabel("Asset Library:")
+        rma_lib_label = QLabel(tr("Asset Library:"))
         rma_lib_label.setToolTip(
-            "Path to the folder containing your original source textures\n"
-            "(PNG/TIFF exported from Substance 3D Painter before RenderMan\n"
-            "compiled them to .tex). Used to read pixel-accurate diffuse\n"
-            "colors without needing OpenImageIO to decode .tex files.\n\n"
-            "Supported layouts:\n"
-            "  Flat folder:  D:/Maya/projects/<Seq>/renderman/<Asset>/\n"
-            "  RMA library:  D:/Maya/RenderManAssetLibrary/Materials/<Asset>/\n\n"
-            "The exporter looks for a PNG whose name matches the .tex file\n"
-            "(e.g. Body_Base_color_1001.png for Body_Base_color_1001.png.tex)."
+            tr(
+                "Path to the folder containing your original source textures\n"
+                "(PNG/TIFF exported from Substance 3D Painter before RenderMan\n"
+                "compiled them to .tex). Used to read pixel-accurate diffuse\n"
+                "colors without needing OpenImageIO to decode .tex files.\n\n"
+                "Supported layouts:\n"
+                "  Flat folder:  D:/Maya/projects/<Seq>/renderman/<Asset>/\n"
+                "  RMA library:  D:/Maya/RenderManAssetLibrary/Materials/<Asset>/\n\n"
+                "The exporter looks for a PNG whose name matches the .tex file\n"
+                "(e.g. Body_Base_color_1001.png for Body_Base_color_1001.png.tex)."
+            )
         )
         self._rma_lib_edit = QLineEdit()
-        self._rma_lib_edit.setPlaceholderText("e.g.  D:/Maya/RenderManAssetLibrary/Materials")
+        self._rma_lib_edit.setPlaceholderText(tr("e.g.  D:/Maya/RenderManAssetLibrary/Materials"))
         self._rma_lib_edit.setToolTip(rma_lib_label.toolTip())
-        self._rma_lib_browse_btn = QPushButton("Browse...")
+        self._rma_lib_browse_btn = QPushButton(tr("Browse..."))
         self._rma_lib_browse_btn.setFixedWidth(72)
         self._rma_lib_browse_btn.clicked.connect(self._on_browse_rma_library)
         rma_lib_layout.addWidget(rma_lib_label)
@@ -436,79 +445,85 @@ class USDPipelineDialog(QDialog):  # type: ignore
         # Show the library row only when RenderMan radio is selected
         self._renderman_radio.toggled.connect(self._rma_lib_widget.setVisible)
 
-        rigging_label = QLabel("Rigging Options:")
+        rigging_label = QLabel(tr("Rigging Options:"))
         rigging_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
         layout.addWidget(rigging_label)
 
-        self._create_usdskel_cb = QCheckBox("Create UsdSkel structure")
+        self._create_usdskel_cb = QCheckBox(tr("Create UsdSkel structure"))
         self._create_usdskel_cb.setChecked(True)
         layout.addWidget(self._create_usdskel_cb)
 
-        self._preserve_weights_cb = QCheckBox("Preserve skin weights")
+        self._preserve_weights_cb = QCheckBox(tr("Preserve skin weights"))
         self._preserve_weights_cb.setChecked(True)
         layout.addWidget(self._preserve_weights_cb)
 
         # Viewport-friendly skeleton option (default OFF for testing multi-skinCluster fix)
-        self._viewport_friendly_cb = QCheckBox("Viewport-friendly skeleton")
+        self._viewport_friendly_cb = QCheckBox(tr("Viewport-friendly skeleton"))
         self._viewport_friendly_cb.setChecked(False)
         self._viewport_friendly_cb.setToolTip(
-            "[VIEWPORT] Viewport-Friendly Mode (DEFAULT: OFF)\n\n"
-            "Exports skeleton hierarchy WITHOUT USD skin bindings.\n\n"
-            "[OK] CHECKED:\n"
-            "• Meshes visible in USD viewport\n"
-            "• Materials and textures display correctly\n"
-            "• Full skinning preserved in .rig.mb backup\n"
-            "• Import uses Maya proxy joints\n\n"
-            "[ERROR] UNCHECKED (default - for USD skin deformation):\n"
-            "• Writes jointIndices/jointWeights to USD meshes\n"
-            "• Enables native USD skeleton deformation\n"
-            "• Auto-fixes multi-skinCluster meshes\n"
-            "• Required for 'Extract Full Skin Weights' import option\n"
-            "• May cause viewport display issues with complex rigs\n\n"
-            "Check this option for viewport compatibility (skips skinning)."
+            tr(
+                "[VIEWPORT] Viewport-Friendly Mode (DEFAULT: OFF)\n\n"
+                "Exports skeleton hierarchy WITHOUT USD skin bindings.\n\n"
+                "[OK] CHECKED:\n"
+                "• Meshes visible in USD viewport\n"
+                "• Materials and textures display correctly\n"
+                "• Full skinning preserved in .rig.mb backup\n"
+                "• Import uses Maya proxy joints\n\n"
+                "[ERROR] UNCHECKED (default - for USD skin deformation):\n"
+                "• Writes jointIndices/jointWeights to USD meshes\n"
+                "• Enables native USD skeleton deformation\n"
+                "• Auto-fixes multi-skinCluster meshes\n"
+                "• Required for 'Extract Full Skin Weights' import option\n"
+                "• May cause viewport display issues with complex rigs\n\n"
+                "Check this option for viewport compatibility (skips skinning)."
+            )
         )
         layout.addWidget(self._viewport_friendly_cb)
 
-        self._include_bind_pose_cb = QCheckBox("Include bind pose")
+        self._include_bind_pose_cb = QCheckBox(tr("Include bind pose"))
         self._include_bind_pose_cb.setChecked(True)
         layout.addWidget(self._include_bind_pose_cb)
 
         # USD Animation Layers (Phase 3.3 - Disney Workflow)
-        anim_layers_label = QLabel("USD Animation Layers (Disney Workflow):")
+        anim_layers_label = QLabel(tr("USD Animation Layers (Disney Workflow):"))
         anim_layers_label.setStyleSheet("font-weight: bold; margin-top: 8px; color: #FF9800;")
         layout.addWidget(anim_layers_label)
 
         self._export_merge_skeletons_cb = QCheckBox(
-            "[SKELETON] Merge Skeletons (unified animation)"
+            tr("[SKELETON] Merge Skeletons (unified animation)")
         )
         self._export_merge_skeletons_cb.setChecked(False)
         self._export_merge_skeletons_cb.setToolTip(
-            "[SKELETON] Merge Skeletons for USD-Native Animation\n\n"
-            "Creates a unified skeleton for direct USD animation:\n"
-            "• All 121+ Skeleton prims → 1 UnifiedSkeleton\n"
-            "• Enables keyframing skeleton joints directly\n"
-            "• Deforming meshes display in viewport\n\n"
-            "Uses USD Layers to preserve mesh data:\n"
-            "   geometry.usdc - meshes (untouched)\n"
-            "   animation.usda - unified skeleton + keyframes\n"
-            "   character.usda - root layer"
+            tr(
+                "[SKELETON] Merge Skeletons for USD-Native Animation\n\n"
+                "Creates a unified skeleton for direct USD animation:\n"
+                "• All 121+ Skeleton prims → 1 UnifiedSkeleton\n"
+                "• Enables keyframing skeleton joints directly\n"
+                "• Deforming meshes display in viewport\n\n"
+                "Uses USD Layers to preserve mesh data:\n"
+                "   geometry.usdc - meshes (untouched)\n"
+                "   animation.usda - unified skeleton + keyframes\n"
+                "   character.usda - root layer"
+            )
         )
         self._export_merge_skeletons_cb.setStyleSheet("font-weight: bold;")
         layout.addWidget(self._export_merge_skeletons_cb)
 
-        self._export_usd_layers_cb = QCheckBox("[PACKAGE] Use USD Layers (non-destructive)")
+        self._export_usd_layers_cb = QCheckBox(tr("[PACKAGE] Use USD Layers (non-destructive)"))
         self._export_usd_layers_cb.setChecked(True)
         self._export_usd_layers_cb.setToolTip(
-            "[PACKAGE] USD Layers Export (RECOMMENDED)\n\n"
-            "Creates separate layers for clean organization:\n"
-            "• geometry.usdc - all meshes (read-only)\n"
-            "• animation.usda - keyframes (editable)\n"
-            "• character.usda - root composition\n\n"
-            "Benefits:\n"
-            "• Mesh data never corrupted\n"
-            "• Non-destructive animation editing\n"
-            "• Team collaboration (different layers)\n"
-            "• Swap animations without re-exporting geometry"
+            tr(
+                "[PACKAGE] USD Layers Export (RECOMMENDED)\n\n"
+                "Creates separate layers for clean organization:\n"
+                "• geometry.usdc - all meshes (read-only)\n"
+                "• animation.usda - keyframes (editable)\n"
+                "• character.usda - root composition\n\n"
+                "Benefits:\n"
+                "• Mesh data never corrupted\n"
+                "• Non-destructive animation editing\n"
+                "• Team collaboration (different layers)\n"
+                "• Swap animations without re-exporting geometry"
+            )
         )
         layout.addWidget(self._export_usd_layers_cb)
 
@@ -518,22 +533,24 @@ class USDPipelineDialog(QDialog):  # type: ignore
         )
 
         # Export Rig File (Maya Binary/ASCII)
-        rig_export_label = QLabel("Rig Export:")
+        rig_export_label = QLabel(tr("Rig Export:"))
         rig_export_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
         layout.addWidget(rig_export_label)
 
-        self._export_rig_cb = QCheckBox("Export Rig File")
+        self._export_rig_cb = QCheckBox(tr("Export Rig File"))
         self._export_rig_cb.setChecked(True)
         self._export_rig_cb.setToolTip(
-            "Export rig data as a Maya file (fast & reliable).\n\n"
-            "Creates a Maya file with:\n"
-            "• NURBS controllers\n"
-            "• Constraints (parent, orient, point, aim)\n"
-            "• IK handles\n"
-            "• Set Driven Keys\n"
-            "• Blendshapes\n"
-            "• Custom attributes\n\n"
-            "Imports in ~0.3 seconds regardless of rig complexity!"
+            tr(
+                "Export rig data as a Maya file (fast & reliable).\n\n"
+                "Creates a Maya file with:\n"
+                "• NURBS controllers\n"
+                "• Constraints (parent, orient, point, aim)\n"
+                "• IK handles\n"
+                "• Set Driven Keys\n"
+                "• Blendshapes\n"
+                "• Custom attributes\n\n"
+                "Imports in ~0.3 seconds regardless of rig complexity!"
+            )
         )
         self._export_rig_cb.setStyleSheet("font-weight: bold; color: #4CAF50;")
         layout.addWidget(self._export_rig_cb)
@@ -542,9 +559,9 @@ class USDPipelineDialog(QDialog):  # type: ignore
         rig_format_layout = QHBoxLayout()
         rig_format_layout.addSpacing(20)  # Indent
         self._rig_format_group = QButtonGroup(self)
-        self._rig_mb_radio = QRadioButton("Maya Binary (.mb) - Faster")
+        self._rig_mb_radio = QRadioButton(tr("Maya Binary (.mb) - Faster"))
         self._rig_mb_radio.setChecked(True)
-        self._rig_ma_radio = QRadioButton("Maya ASCII (.ma) - Readable")
+        self._rig_ma_radio = QRadioButton(tr("Maya ASCII (.ma) - Readable"))
         self._rig_format_group.addButton(self._rig_mb_radio, 0)
         self._rig_format_group.addButton(self._rig_ma_radio, 1)
         rig_format_layout.addWidget(self._rig_mb_radio)
@@ -557,38 +574,44 @@ class USDPipelineDialog(QDialog):  # type: ignore
         self._export_rig_cb.toggled.connect(self._rig_ma_radio.setEnabled)
 
         # Organize in subfolder checkbox
-        self._organize_subfolder_cb = QCheckBox("Organize in subfolder (AssetName_USD/)")
+        self._organize_subfolder_cb = QCheckBox(tr("Organize in subfolder (AssetName_USD/)"))
         self._organize_subfolder_cb.setChecked(False)
         self._organize_subfolder_cb.setToolTip(
-            "Put all export files in an organized subfolder.\n"
-            "Example: Veteran_USD/Veteran.usdz, Veteran.usdc, Veteran.rig.mb\n"
-            "Keeps your asset directory clean and organized."
+            tr(
+                "Put all export files in an organized subfolder.\n"
+                "Example: Veteran_USD/Veteran.usdz, Veteran.usdc, Veteran.rig.mb\n"
+                "Keeps your asset directory clean and organized."
+            )
         )
         layout.addWidget(self._organize_subfolder_cb)
 
         # Cleanup intermediate files checkbox
-        self._cleanup_intermediates_cb = QCheckBox("Clean up intermediate files")
+        self._cleanup_intermediates_cb = QCheckBox(tr("Clean up intermediate files"))
         self._cleanup_intermediates_cb.setChecked(True)
         self._cleanup_intermediates_cb.setToolTip(
-            "Delete .usdc and .rig.mb files after bundling into USDZ.\n"
-            "The USDZ package contains everything needed.\n"
-            "Uncheck to keep the separate files alongside the USDZ."
+            tr(
+                "Delete .usdc and .rig.mb files after bundling into USDZ.\n"
+                "The USDZ package contains everything needed.\n"
+                "Uncheck to keep the separate files alongside the USDZ."
+            )
         )
         layout.addWidget(self._cleanup_intermediates_cb)
 
         # Create ZIP archive checkbox
-        self._create_zip_cb = QCheckBox("Create ZIP archive")
+        self._create_zip_cb = QCheckBox(tr("Create ZIP archive"))
         self._create_zip_cb.setChecked(False)
         self._create_zip_cb.setToolTip(
-            "Create a compressed ZIP archive of all export files.\n"
-            "Better compression and protection for asset distribution.\n"
-            "Great for sharing or archiving assets."
+            tr(
+                "Create a compressed ZIP archive of all export files.\n"
+                "Better compression and protection for asset distribution.\n"
+                "Great for sharing or archiving assets."
+            )
         )
         layout.addWidget(self._create_zip_cb)
 
         # Max influences
         influences_layout = QHBoxLayout()
-        influences_layout.addWidget(QLabel("Max Influences:"))
+        influences_layout.addWidget(QLabel(tr("Max Influences:")))
         self._max_influences_spin = QSpinBox()
         self._max_influences_spin.setMinimum(1)
         self._max_influences_spin.setMaximum(16)
@@ -601,20 +624,20 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_validation_section(self) -> QGroupBox:
         """Create validation section"""
-        group = QGroupBox("Pre-Export Validation")
+        group = QGroupBox(tr("Pre-Export Validation"))
         layout = QVBoxLayout(group)
 
         self._validation_text = QTextEdit()
         self._validation_text.setReadOnly(True)
         self._validation_text.setMaximumHeight(120)
-        self._validation_text.setPlaceholderText("Validation results will appear here...")
+        self._validation_text.setPlaceholderText(tr("Validation results will appear here..."))
         layout.addWidget(self._validation_text)
 
         return group
 
     def _create_progress_section(self) -> QGroupBox:
         """Create progress section"""
-        group = QGroupBox("Export Progress")
+        group = QGroupBox(tr("Export Progress"))
         layout = QVBoxLayout(group)
 
         # Progress bar
@@ -624,7 +647,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         layout.addWidget(self._progress_bar)
 
         # Status label
-        self._status_label = QLabel("Ready")
+        self._status_label = QLabel(tr("Ready"))
         self._status_label.setAlignment(Qt.AlignmentFlag.AlignCenter)
         layout.addWidget(self._status_label)
 
@@ -635,14 +658,14 @@ class USDPipelineDialog(QDialog):  # type: ignore
         layout = QHBoxLayout()
 
         # Validate button (for export tab)
-        self._validate_btn = QPushButton("Validate Only")
+        self._validate_btn = QPushButton(tr("Validate Only"))
         self._validate_btn.clicked.connect(self._on_validate)
         layout.addWidget(self._validate_btn)
 
         layout.addStretch()
 
         # Export button (for export tab)
-        self._export_btn = QPushButton("Convert to USD")
+        self._export_btn = QPushButton(tr("Convert to USD"))
         self._export_btn.setDefault(True)
         self._export_btn.clicked.connect(self._on_export)
         self._export_btn.setStyleSheet(
@@ -665,7 +688,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         layout.addWidget(self._export_btn)
 
         # Import button (for import tab)
-        self._import_btn = QPushButton("Import from USD")
+        self._import_btn = QPushButton(tr("Import from USD"))
         self._import_btn.clicked.connect(self._perform_import)
         self._import_btn.setStyleSheet(
             """
@@ -687,12 +710,12 @@ class USDPipelineDialog(QDialog):  # type: ignore
         layout.addWidget(self._import_btn)
 
         # Cancel button
-        self._cancel_btn = QPushButton("Cancel")
+        self._cancel_btn = QPushButton(tr("Cancel"))
         self._cancel_btn.clicked.connect(self._on_cancel)
         layout.addWidget(self._cancel_btn)
 
         # Close button
-        self._close_btn = QPushButton("Close")
+        self._close_btn = QPushButton(tr("Close"))
         self._close_btn.clicked.connect(self.close)
         layout.addWidget(self._close_btn)
 
@@ -848,7 +871,9 @@ class USDPipelineDialog(QDialog):  # type: ignore
     def _on_export(self) -> None:
         """Start USD export using Clean Architecture Pipeline"""
         if self._is_exporting:
-            QMessageBox.warning(self, "Export in Progress", "An export is already in progress.")
+            QMessageBox.warning(
+                self, tr("Export in Progress"), tr("An export is already in progress.")
+            )
             return
 
         # Build options
@@ -867,11 +892,13 @@ class USDPipelineDialog(QDialog):  # type: ignore
             # Export from file
             source_file = Path(self._source_file_edit.text())
             if not source_file.exists():
-                QMessageBox.critical(self, "Invalid Source", "Source Maya file does not exist.")
+                QMessageBox.critical(
+                    self, tr("Invalid Source"), tr("Source Maya file does not exist.")
+                )
                 return
 
         if not self._pipeline:
-            QMessageBox.critical(self, "Service Error", "USD Pipeline is not available.")
+            QMessageBox.critical(self, tr("Service Error"), tr("USD Pipeline is not available."))
             return
 
         # Start export
@@ -882,7 +909,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         self._browse_source_btn.setEnabled(False)
         self._browse_output_btn.setEnabled(False)
 
-        self._status_label.setText("[START] Starting USD export...")
+        self._status_label.setText(tr("[START] Starting USD export..."))
         self._progress_bar.setValue(0)
 
         self.export_started.emit()
@@ -926,7 +953,9 @@ class USDPipelineDialog(QDialog):  # type: ignore
             self._pipeline.set_progress_callback(progress_callback)
 
             # Execute export
-            self._status_label.setText("[START] Exporting with mayaUSD (maximum conversion)...")
+            self._status_label.setText(
+                tr("[START] Exporting with mayaUSD (maximum conversion)...")
+            )
             result = self._pipeline.export(
                 source_path=source_file if source_file else Path("current_scene"),
                 output_path=options.output_path,
@@ -942,7 +971,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                 # Build success message from result
                 message_parts = [result.get_summary()]
 
-                self._status_label.setText("[OK] Export complete!")
+                self._status_label.setText(tr("[OK] Export complete!"))
 
                 # Show completion dialog
                 if not self._completion_dialog_shown:
@@ -998,7 +1027,9 @@ class USDPipelineDialog(QDialog):  # type: ignore
     def _on_export_legacy(self) -> None:
         """Legacy export method (v2.0) - kept for reference"""
         if self._is_exporting:
-            QMessageBox.warning(self, "Export in Progress", "An export is already in progress.")
+            QMessageBox.warning(
+                self, tr("Export in Progress"), tr("An export is already in progress.")
+            )
             return
 
         # Build options
@@ -1017,11 +1048,13 @@ class USDPipelineDialog(QDialog):  # type: ignore
             # Export from file
             source_file = Path(self._source_file_edit.text())
             if not source_file.exists():
-                QMessageBox.critical(self, "Invalid Source", "Source Maya file does not exist.")
+                QMessageBox.critical(
+                    self, tr("Invalid Source"), tr("Source Maya file does not exist.")
+                )
                 return
 
         if not self._export_service:
-            QMessageBox.critical(self, "Service Error", "Export service is not available.")
+            QMessageBox.critical(self, tr("Service Error"), tr("Export service is not available."))
             return
 
         # Start export
@@ -1032,7 +1065,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         self._browse_source_btn.setEnabled(False)
         self._browse_output_btn.setEnabled(False)
 
-        self._status_label.setText("Starting export...")
+        self._status_label.setText(tr("Starting export..."))
         self._progress_bar.setValue(0)
 
         self.export_started.emit()
@@ -1125,7 +1158,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
             usdz_packaged = False
             usdz_path: Optional[Path] = None
             if success and options.file_format == "usdz":
-                self._status_label.setText("Packaging into USDZ...")
+                self._status_label.setText(tr("Packaging into USDZ..."))
                 self._progress_bar.setValue(95)
 
                 try:
@@ -1217,7 +1250,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                         else:
                             message_parts.append(f"\nRig export failed: {rig_message}")
 
-                self._status_label.setText("Export complete!")
+                self._status_label.setText(tr("Export complete!"))
 
                 # Show completion dialog after a short delay to let Maya stabilize
                 if not self._completion_dialog_shown:
@@ -1294,7 +1327,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         """Cancel export"""
         if self._is_exporting and self._export_service:
             self._export_service.cancel_export()
-            self._status_label.setText("Cancelling...")
+            self._status_label.setText(tr("Cancelling..."))
 
     def _update_progress(self) -> None:
         """Update progress display"""
@@ -1312,11 +1345,15 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Only check source path if using file mode
         if not use_current_scene and not source_path:
-            QMessageBox.warning(self, "Missing Source", "Please select a source Maya scene file.")
+            QMessageBox.warning(
+                self, tr("Missing Source"), tr("Please select a source Maya scene file.")
+            )
             return None
 
         if not output_path:
-            QMessageBox.warning(self, "Missing Output", "Please select an output USD file path.")
+            QMessageBox.warning(
+                self, tr("Missing Output"), tr("Please select an output USD file path.")
+            )
             return None
 
         # Determine format
@@ -1346,42 +1383,44 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_import_source_section(self) -> QGroupBox:
         """Create import source selection section"""
-        group = QGroupBox("Import Sources")
+        group = QGroupBox(tr("Import Sources"))
         layout = QVBoxLayout(group)
 
         # USD file selection
         usd_layout = QHBoxLayout()
-        usd_layout.addWidget(QLabel("USD File:"))
+        usd_layout.addWidget(QLabel(tr("USD File:")))
         self._usd_file_edit = QLineEdit()
         self._usd_file_edit.setPlaceholderText(
-            "Select USD file (geometry, materials, skeleton)..."
+            tr("Select USD file (geometry, materials, skeleton)...")
         )
         usd_layout.addWidget(self._usd_file_edit)
 
-        self._usd_browse_btn = QPushButton("Browse...")
+        self._usd_browse_btn = QPushButton(tr("Browse..."))
         self._usd_browse_btn.clicked.connect(self._browse_usd_file)
         usd_layout.addWidget(self._usd_browse_btn)
         layout.addLayout(usd_layout)
 
         # ============ IMPORT WORKFLOW SELECTION ============
-        workflow_label = QLabel("Import Workflow:")
+        workflow_label = QLabel(tr("Import Workflow:"))
         workflow_label.setStyleSheet("font-weight: bold; margin-top: 10px;")
         layout.addWidget(workflow_label)
 
         self._import_workflow_group = QButtonGroup(self)
 
         # Option 1: Look-Dev / Layout (USD Proxy)
-        self._lookdev_workflow_radio = QRadioButton("[LOOKDEV] Look-Dev / Layout (USD Proxy)")
+        self._lookdev_workflow_radio = QRadioButton(tr("[LOOKDEV] Look-Dev / Layout (USD Proxy)"))
         self._lookdev_workflow_radio.setChecked(True)
         self._lookdev_workflow_radio.setToolTip(
-            "[LOOKDEV] LOOK-DEV / LAYOUT WORKFLOW\n\n"
-            "Creates a mayaUsdProxyShape containing USD data:\n"
-            "• Meshes display in viewport (static geometry)\n"
-            "• Materials and textures visible\n"
-            "• Skeleton hierarchy for reference\n"
-            "• Fast loading, non-destructive\n\n"
-            "Best for: Lighting, rendering, layout, look development\n"
-            "Note: No live deformation (meshes are static poses)"
+            tr(
+                "[LOOKDEV] LOOK-DEV / LAYOUT WORKFLOW\n\n"
+                "Creates a mayaUsdProxyShape containing USD data:\n"
+                "• Meshes display in viewport (static geometry)\n"
+                "• Materials and textures visible\n"
+                "• Skeleton hierarchy for reference\n"
+                "• Fast loading, non-destructive\n\n"
+                "Best for: Lighting, rendering, layout, look development\n"
+                "Note: No live deformation (meshes are static poses)"
+            )
         )
         self._import_workflow_group.addButton(self._lookdev_workflow_radio, 0)
         layout.addWidget(self._lookdev_workflow_radio)
@@ -1399,55 +1438,63 @@ class USDPipelineDialog(QDialog):  # type: ignore
         # `setVisible(False)` call below once the vendor stack catches up.
         # ─────────────────────────────────────────────────────────────────
         self._usd_animation_radio = QRadioButton(
-            "[ANIMATION] USD Animation - Experimental [WARNING]"
+            tr("[ANIMATION] USD Animation - Experimental [WARNING]")
         )
         self._usd_animation_radio.setToolTip(
-            "[ANIMATION] USD ANIMATION (EXPERIMENTAL)\n\n"
-            "Pure USD workflow - keeps USD proxy reference:\n"
-            "• Preserves USD for pipeline integration\n"
-            "• USD Layer system support\n"
-            "• Asset Resolver compatible\n"
-            "• Non-destructive editing\n\n"
-            "[WARNING] KNOWN ISSUES (Maya 2026):\n"
-            "• Display bugs with complex skeletons (>100 joints)\n"
-            "• Meshes may not deform in viewport\n"
-            "• Fixed in mayaUSD v0.25.0+\n\n"
-            "Best for: Simple rigs, pipeline testing, future mayaUSD versions"
+            tr(
+                "[ANIMATION] USD ANIMATION (EXPERIMENTAL)\n\n"
+                "Pure USD workflow - keeps USD proxy reference:\n"
+                "• Preserves USD for pipeline integration\n"
+                "• USD Layer system support\n"
+                "• Asset Resolver compatible\n"
+                "• Non-destructive editing\n\n"
+                "[WARNING] KNOWN ISSUES (Maya 2026):\n"
+                "• Display bugs with complex skeletons (>100 joints)\n"
+                "• Meshes may not deform in viewport\n"
+                "• Fixed in mayaUSD v0.25.0+\n\n"
+                "Best for: Simple rigs, pipeline testing, future mayaUSD versions"
+            )
         )
         self._import_workflow_group.addButton(self._usd_animation_radio, 1)
         layout.addWidget(self._usd_animation_radio)
         self._usd_animation_radio.setVisible(False)  # FROZEN — see comment above
 
         # Option 3: Hybrid Animation (Recommended - Convert USD to Maya)
-        self._hybrid_workflow_radio = QRadioButton("[HYBRID] Hybrid Animation - Recommended [OK]")
+        self._hybrid_workflow_radio = QRadioButton(
+            tr("[HYBRID] Hybrid Animation - Recommended [OK]")
+        )
         self._hybrid_workflow_radio.setToolTip(
-            "[HYBRID] HYBRID ANIMATION (RECOMMENDED)\n\n"
-            "Converts USD to Maya + adds NURBS controllers:\n"
-            "• USD meshes → Native Maya meshes\n"
-            "• UsdSkel → Maya joints + skinClusters\n"
-            "• Materials → RenderMan/Maya shaders\n"
-            "• NURBS controllers from .rig.mb\n\n"
-            "[OK] ADVANTAGES:\n"
-            "• Works with complex skeletons\n"
-            "• Full deformation support\n"
-            "• All Maya tools available\n"
-            "• No viewport bugs\n\n"
-            "Best for: Animation production work TODAY"
+            tr(
+                "[HYBRID] HYBRID ANIMATION (RECOMMENDED)\n\n"
+                "Converts USD to Maya + adds NURBS controllers:\n"
+                "• USD meshes → Native Maya meshes\n"
+                "• UsdSkel → Maya joints + skinClusters\n"
+                "• Materials → RenderMan/Maya shaders\n"
+                "• NURBS controllers from .rig.mb\n\n"
+                "[OK] ADVANTAGES:\n"
+                "• Works with complex skeletons\n"
+                "• Full deformation support\n"
+                "• All Maya tools available\n"
+                "• No viewport bugs\n\n"
+                "Best for: Animation production work TODAY"
+            )
         )
         self._import_workflow_group.addButton(self._hybrid_workflow_radio, 2)
         layout.addWidget(self._hybrid_workflow_radio)
 
         # Option 4: Full Maya Rig (.rig.mb only)
-        self._animation_workflow_radio = QRadioButton("[PACKAGE] Full Maya Rig (.rig.mb)")
+        self._animation_workflow_radio = QRadioButton(tr("[PACKAGE] Full Maya Rig (.rig.mb)"))
         self._animation_workflow_radio.setToolTip(
-            "[PACKAGE] FULL MAYA RIG (FALLBACK)\n\n"
-            "Ignores USD, imports .rig.mb backup only:\n"
-            "• Complete original Maya rig\n"
-            "• All constraints and IK systems\n"
-            "• BlendShapes and Set Driven Keys\n"
-            "• NURBS controllers\n"
-            "• Custom attributes\n\n"
-            "Best for: When USD export had issues, or traditional Maya workflow"
+            tr(
+                "[PACKAGE] FULL MAYA RIG (FALLBACK)\n\n"
+                "Ignores USD, imports .rig.mb backup only:\n"
+                "• Complete original Maya rig\n"
+                "• All constraints and IK systems\n"
+                "• BlendShapes and Set Driven Keys\n"
+                "• NURBS controllers\n"
+                "• Custom attributes\n\n"
+                "Best for: When USD export had issues, or traditional Maya workflow"
+            )
         )
         self._import_workflow_group.addButton(self._animation_workflow_radio, 3)
         layout.addWidget(self._animation_workflow_radio)
@@ -1463,28 +1510,30 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Namespace
         namespace_layout = QHBoxLayout()
-        namespace_layout.addWidget(QLabel("Namespace:"))
+        namespace_layout.addWidget(QLabel(tr("Namespace:")))
         self._namespace_edit = QLineEdit()
-        self._namespace_edit.setPlaceholderText("Optional namespace for imported objects")
+        self._namespace_edit.setPlaceholderText(tr("Optional namespace for imported objects"))
         namespace_layout.addWidget(self._namespace_edit)
         layout.addLayout(namespace_layout)
 
         # Advanced: Full weight extraction option
         layout.addWidget(QLabel(""))  # Spacer
-        self._extract_full_weights_cb = QCheckBox("🔬 Extract Full Skin Weights from USD")
+        self._extract_full_weights_cb = QCheckBox(tr("🔬 Extract Full Skin Weights from USD"))
         self._extract_full_weights_cb.setChecked(False)
         self._extract_full_weights_cb.setToolTip(
-            "🔬 Extract Full Skin Weights from USD\n\n"
-            "Reads jointIndices/jointWeights primvars from USD meshes.\n\n"
-            "[WARNING] REQUIRES: USDZ exported with 'Viewport-friendly skeleton' UNCHECKED\n\n"
-            "[OK] CHECKED:\n"
-            "• Reads USD skin binding data directly\n"
-            "• Creates Maya skinClusters from USD weights\n"
-            "• Best for self-contained USDZ pipelines\n\n"
-            "[ERROR] UNCHECKED (default):\n"
-            "• Uses proxy joint connection (faster)\n"
-            "• Animate proxy joints to drive USD skeleton\n"
-            "• Works with all USDZ files"
+            tr(
+                "🔬 Extract Full Skin Weights from USD\n\n"
+                "Reads jointIndices/jointWeights primvars from USD meshes.\n\n"
+                "[WARNING] REQUIRES: USDZ exported with 'Viewport-friendly skeleton' UNCHECKED\n\n"
+                "[OK] CHECKED:\n"
+                "• Reads USD skin binding data directly\n"
+                "• Creates Maya skinClusters from USD weights\n"
+                "• Best for self-contained USDZ pipelines\n\n"
+                "[ERROR] UNCHECKED (default):\n"
+                "• Uses proxy joint connection (faster)\n"
+                "• Animate proxy joints to drive USD skeleton\n"
+                "• Works with all USDZ files"
+            )
         )
         layout.addWidget(self._extract_full_weights_cb)
 
@@ -1492,7 +1541,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_usd_animation_layers_section(self) -> QGroupBox:
         """Create USD Animation Layers info section for the Import tab."""
-        group = QGroupBox("[ANIMATION] USD Layered Stage (Disney Workflow)")
+        group = QGroupBox(tr("[ANIMATION] USD Layered Stage (Disney Workflow)"))
         group.setStyleSheet(
             """
             QGroupBox {
@@ -1514,9 +1563,11 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Info label
         info_label = QLabel(
-            "When importing via the Animation workflow, a layered USD stage\n"
-            "is built automatically. Each layer is editable independently\n"
-            "and the original asset is never modified."
+            tr(
+                "When importing via the Animation workflow, a layered USD stage\n"
+                "is built automatically. Each layer is editable independently\n"
+                "and the original asset is never modified."
+            )
         )
         info_label.setWordWrap(True)
         info_label.setStyleSheet("color: #888; font-style: italic;")
@@ -1524,14 +1575,16 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Layer structure visualization — matches _build_layered_stage output
         structure_label = QLabel(
-            "Import Layer Structure:\n"
-            "   character.root.usda\n"
-            "   ├── animation.usda      (keyframes — strongest)\n"
-            "   ├── controllers.usda    (NURBS curves from .rig.mb)\n"
-            "   ├── materials.usda      (shader overrides)\n"
-            "   ├── skeleton.usda       (joint metadata & mappings)\n"
-            "   ├── geometry.usda       (geo overrides)\n"
-            "   └── character.usdc      (base asset — read-only)"
+            tr(
+                "Import Layer Structure:\n"
+                "   character.root.usda\n"
+                "   ├── animation.usda      (keyframes — strongest)\n"
+                "   ├── controllers.usda    (NURBS curves from .rig.mb)\n"
+                "   ├── materials.usda      (shader overrides)\n"
+                "   ├── skeleton.usda       (joint metadata & mappings)\n"
+                "   ├── geometry.usda       (geo overrides)\n"
+                "   └── character.usdc      (base asset — read-only)"
+            )
         )
         structure_label.setStyleSheet(
             "font-family: monospace; background: #2d2d2d; "
@@ -1541,9 +1594,11 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
         # Rig.mb integration note
         rig_note = QLabel(
-            "If the USDZ contains a .rig.mb, NURBS controllers and\n"
-            "controller-to-joint mappings are extracted into the\n"
-            "controllers and skeleton sublayers automatically."
+            tr(
+                "If the USDZ contains a .rig.mb, NURBS controllers and\n"
+                "controller-to-joint mappings are extracted into the\n"
+                "controllers and skeleton sublayers automatically."
+            )
         )
         rig_note.setWordWrap(True)
         rig_note.setStyleSheet("color: #FF9800; font-size: 11px; margin-top: 8px;")
@@ -1553,107 +1608,119 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_import_options_section(self) -> QGroupBox:
         """Create import options section"""
-        group = QGroupBox("Import Options")
+        group = QGroupBox(tr("Import Options"))
         layout = QVBoxLayout(group)
 
         # USD Import Options
-        usd_label = QLabel("USD Import:")
+        usd_label = QLabel(tr("USD Import:"))
         usd_label.setStyleSheet("font-weight: bold;")
         layout.addWidget(usd_label)
 
-        self._import_geometry_cb = QCheckBox("Import Geometry")
+        self._import_geometry_cb = QCheckBox(tr("Import Geometry"))
         self._import_geometry_cb.setChecked(True)
         layout.addWidget(self._import_geometry_cb)
 
-        self._import_materials_cb = QCheckBox("Import Materials")
+        self._import_materials_cb = QCheckBox(tr("Import Materials"))
         self._import_materials_cb.setChecked(True)
         layout.addWidget(self._import_materials_cb)
 
-        self._import_skeleton_cb = QCheckBox("Import Skeleton (UsdSkel)")
+        self._import_skeleton_cb = QCheckBox(tr("Import Skeleton (UsdSkel)"))
         self._import_skeleton_cb.setChecked(True)
         layout.addWidget(self._import_skeleton_cb)
 
-        self._convert_skeleton_to_maya_cb = QCheckBox("Convert USD Skeleton to Maya Joints")
+        self._convert_skeleton_to_maya_cb = QCheckBox(tr("Convert USD Skeleton to Maya Joints"))
         self._convert_skeleton_to_maya_cb.setChecked(False)
         self._convert_skeleton_to_maya_cb.setToolTip(
-            "Extracts UsdSkel as standard Maya joints for direct manipulation.\n\n"
-            "Leave unchecked for the Animation workflow \u2014 the USD proxy displays\n"
-            "the skeleton via UsdSkelImaging without needing Maya joints.\n\n"
-            "Enable only if you need to keyframe joints directly in Maya\n"
-            "(e.g. for non-USD animation pipelines)."
+            tr(
+                "Extracts UsdSkel as standard Maya joints for direct manipulation.\n\n"
+                "Leave unchecked for the Animation workflow \u2014 the USD proxy displays\n"
+                "the skeleton via UsdSkelImaging without needing Maya joints.\n\n"
+                "Enable only if you need to keyframe joints directly in Maya\n"
+                "(e.g. for non-USD animation pipelines)."
+            )
         )
         layout.addWidget(self._convert_skeleton_to_maya_cb)
 
-        self._import_skin_weights_cb = QCheckBox("Apply Skin Weights (Auto-create skinClusters)")
+        self._import_skin_weights_cb = QCheckBox(
+            tr("Apply Skin Weights (Auto-create skinClusters)")
+        )
         self._import_skin_weights_cb.setChecked(True)
         self._import_skin_weights_cb.setToolTip(
-            "Applies USD skin weights as Maya skinClusters (Animation/.rig.mb workflow only).\n\n"
-            "Not used in USD Proxy mode \u2014 UsdSkelImaging handles skin deformation\n"
-            "natively inside the proxy shape without needing Maya skinClusters."
+            tr(
+                "Applies USD skin weights as Maya skinClusters (Animation/.rig.mb workflow only).\n\n"
+                "Not used in USD Proxy mode \u2014 UsdSkelImaging handles skin deformation\n"
+                "natively inside the proxy shape without needing Maya skinClusters."
+            )
         )
         layout.addWidget(self._import_skin_weights_cb)
 
         # Maya Rig Fallback Options (from .rig.mb bundled in USDZ)
-        rig_label = QLabel("Rig Data Import (.rig.mb fallback):")
+        rig_label = QLabel(tr("Rig Data Import (.rig.mb fallback):"))
         rig_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
         layout.addWidget(rig_label)
 
-        self._import_controllers_cb = QCheckBox("Import Controllers (NURBS curves)")
+        self._import_controllers_cb = QCheckBox(tr("Import Controllers (NURBS curves)"))
         self._import_controllers_cb.setChecked(True)
         layout.addWidget(self._import_controllers_cb)
 
-        self._import_constraints_cb = QCheckBox("Import Constraints")
+        self._import_constraints_cb = QCheckBox(tr("Import Constraints"))
         self._import_constraints_cb.setChecked(True)
         layout.addWidget(self._import_constraints_cb)
 
-        self._import_space_switches_cb = QCheckBox("Import Space Switches")
+        self._import_space_switches_cb = QCheckBox(tr("Import Space Switches"))
         self._import_space_switches_cb.setChecked(True)
         layout.addWidget(self._import_space_switches_cb)
 
-        self._import_blendshapes_cb = QCheckBox("Import Blendshapes & SDKs")
+        self._import_blendshapes_cb = QCheckBox(tr("Import Blendshapes & SDKs"))
         self._import_blendshapes_cb.setChecked(True)
         layout.addWidget(self._import_blendshapes_cb)
 
-        self._import_custom_attrs_cb = QCheckBox("Import Custom Attributes")
+        self._import_custom_attrs_cb = QCheckBox(tr("Import Custom Attributes"))
         self._import_custom_attrs_cb.setChecked(True)
         layout.addWidget(self._import_custom_attrs_cb)
 
         # Unified Rig Option (v1.5.0)
-        unified_label = QLabel("Unified Rig:")
+        unified_label = QLabel(tr("Unified Rig:"))
         unified_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
         layout.addWidget(unified_label)
 
-        self._create_unified_rig_cb = QCheckBox("Create Unified Rig Group")
+        self._create_unified_rig_cb = QCheckBox(tr("Create Unified Rig Group"))
         self._create_unified_rig_cb.setChecked(True)
         self._create_unified_rig_cb.setStyleSheet("font-weight: bold; color: #4CAF50;")
         self._create_unified_rig_cb.setToolTip(
-            "Creates a unified rig structure:\n"
-            "• Root group containing USD geometry and rig controls\n"
-            "• Organized hierarchy: GEO_GRP, CTRL_GRP, SKELETON_GRP\n"
-            "• All connections preserved between controls and skeleton\n"
-            "• Ready for animation!"
+            tr(
+                "Creates a unified rig structure:\n"
+                "• Root group containing USD geometry and rig controls\n"
+                "• Organized hierarchy: GEO_GRP, CTRL_GRP, SKELETON_GRP\n"
+                "• All connections preserved between controls and skeleton\n"
+                "• Ready for animation!"
+            )
         )
         layout.addWidget(self._create_unified_rig_cb)
 
         # ── Animation Authoring Method (only visible in USD Animation proxy mode) ──
-        authoring_label = QLabel("Animation Authoring Method:")
+        authoring_label = QLabel(tr("Animation Authoring Method:"))
         authoring_label.setStyleSheet("font-weight: bold; margin-top: 8px;")
 
-        self._edit_as_maya_rb = QRadioButton("Edit As Maya Data  (Option A)")
+        self._edit_as_maya_rb = QRadioButton(tr("Edit As Maya Data  (Option A)"))
         self._edit_as_maya_rb.setToolTip(
-            "After the USD stage loads, the proxy prims can be duplicated as live\n"
-            "Maya joints/meshes via  USD > Edit As Maya Data.\n\n"
-            "Use when you need to keyframe joints or apply Maya-native deformers.\n"
-            "(You can do this manually from the menu at any time.)"
+            tr(
+                "After the USD stage loads, the proxy prims can be duplicated as live\n"
+                "Maya joints/meshes via  USD > Edit As Maya Data.\n\n"
+                "Use when you need to keyframe joints or apply Maya-native deformers.\n"
+                "(You can do this manually from the menu at any time.)"
+            )
         )
 
-        self._open_layer_editor_rb = QRadioButton("USD Layer Editor  (Option B)")
+        self._open_layer_editor_rb = QRadioButton(tr("USD Layer Editor  (Option B)"))
         self._open_layer_editor_rb.setChecked(True)
         self._open_layer_editor_rb.setToolTip(
-            "After the USD stage loads, opens the mayaUSD Layer Editor so you can\n"
-            "author a new animation layer non-destructively on top of the stage.\n\n"
-            "This is the Disney/Pixar pipeline approach — edits are stored as a\n"
-            "lightweight USD layer and the original asset is never modified."
+            tr(
+                "After the USD stage loads, opens the mayaUSD Layer Editor so you can\n"
+                "author a new animation layer non-destructively on top of the stage.\n\n"
+                "This is the Disney/Pixar pipeline approach — edits are stored as a\n"
+                "lightweight USD layer and the original asset is never modified."
+            )
         )
 
         self._authoring_method_group = QButtonGroup(self)
@@ -1709,13 +1776,17 @@ class USDPipelineDialog(QDialog):  # type: ignore
         if hasattr(self, "_usd_info_label"):
             if is_lookdev:
                 self._usd_info_label.setText(
-                    "[LOOKDEV] Look-Dev Mode: USD will be loaded as proxy shape.\n"
-                    "Meshes display as static geometry. Fast and non-destructive."
+                    tr(
+                        "[LOOKDEV] Look-Dev Mode: USD will be loaded as proxy shape.\n"
+                        "Meshes display as static geometry. Fast and non-destructive."
+                    )
                 )
             else:
                 self._usd_info_label.setText(
-                    "[ANIMATION] Animation Mode: .rig.mb will be extracted for full Maya rig.\n"
-                    "Live skinning, constraints, and controllers available."
+                    tr(
+                        "[ANIMATION] Animation Mode: .rig.mb will be extracted for full Maya rig.\n"
+                        "Live skinning, constraints, and controllers available."
+                    )
                 )
 
         # Show animation authoring method only when USD Animation (proxy) mode is selected
@@ -1725,14 +1796,14 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_import_validation_section(self) -> QGroupBox:
         """Create import validation section"""
-        group = QGroupBox("Validation")
+        group = QGroupBox(tr("Validation"))
         layout = QVBoxLayout(group)
 
-        self._validate_usd_btn = QPushButton("Validate USD File")
+        self._validate_usd_btn = QPushButton(tr("Validate USD File"))
         self._validate_usd_btn.clicked.connect(self._validate_usd_file)
         layout.addWidget(self._validate_usd_btn)
 
-        self._usd_info_label = QLabel("Select a USD file to see validation results")
+        self._usd_info_label = QLabel(tr("Select a USD file to see validation results"))
         self._usd_info_label.setWordWrap(True)
         layout.addWidget(self._usd_info_label)
 
@@ -1740,14 +1811,14 @@ class USDPipelineDialog(QDialog):  # type: ignore
 
     def _create_import_progress_section(self) -> QGroupBox:
         """Create import progress section"""
-        group = QGroupBox("Import Progress")
+        group = QGroupBox(tr("Import Progress"))
         layout = QVBoxLayout(group)
 
         self._import_progress_bar = QProgressBar()
         self._import_progress_bar.setVisible(False)
         layout.addWidget(self._import_progress_bar)
 
-        self._import_status_label = QLabel("Ready to import")
+        self._import_status_label = QLabel(tr("Ready to import"))
         layout.addWidget(self._import_status_label)
 
         return group
@@ -1780,7 +1851,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
         """Validate the selected USD file"""
         usd_path = self._usd_file_edit.text().strip()
         if not usd_path:
-            self._usd_info_label.setText("No USD file selected")
+            self._usd_info_label.setText(tr("No USD file selected"))
             return
 
         try:
@@ -1803,11 +1874,13 @@ class USDPipelineDialog(QDialog):  # type: ignore
         """Perform USD import using Clean Architecture Pipeline"""
         usd_path = self._usd_file_edit.text().strip()
         if not usd_path:
-            QMessageBox.warning(self, "Missing Source", "Please select a USD file to import.")
+            QMessageBox.warning(
+                self, tr("Missing Source"), tr("Please select a USD file to import.")
+            )
             return
 
         if not self._pipeline:
-            QMessageBox.warning(self, "Service Error", "USD Pipeline is not available.")
+            QMessageBox.warning(self, tr("Service Error"), tr("USD Pipeline is not available."))
             return
 
         # Determine workflow mode
@@ -1829,7 +1902,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                 # ============ LOOK-DEV WORKFLOW ============
                 # Create USD proxy shape - fast, non-destructive
                 self._import_status_label.setText(
-                    "[LOOKDEV] Look-Dev Mode: Creating USD proxy shape..."
+                    tr("[LOOKDEV] Look-Dev Mode: Creating USD proxy shape...")
                 )
 
                 import_options = ImportOptions(
@@ -1850,7 +1923,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                 # ============ USD ANIMATION (EXPERIMENTAL) ============
                 # Keep USD as proxy - for pipeline integration with USD Layers
                 self._import_status_label.setText(
-                    "[ANIMATION] USD Animation: Creating USD stage in Maya..."
+                    tr("[ANIMATION] USD Animation: Creating USD stage in Maya...")
                 )
 
                 import_options = ImportOptions(
@@ -1874,7 +1947,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                 # ============ HYBRID WORKFLOW (RECOMMENDED) ============
                 # Convert USD to Maya + import controllers
                 self._import_status_label.setText(
-                    "[HYBRID] Hybrid Mode: Converting USD to Maya + controllers..."
+                    tr("[HYBRID] Hybrid Mode: Converting USD to Maya + controllers...")
                 )
 
                 import_options = ImportOptions(
@@ -1897,7 +1970,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
                 # ============ ANIMATION WORKFLOW ============
                 # Extract .rig.mb for full Maya functionality
                 self._import_status_label.setText(
-                    "[ANIMATION] Animation Mode: Extracting full Maya rig..."
+                    tr("[ANIMATION] Animation Mode: Extracting full Maya rig...")
                 )
 
                 import_options = ImportOptions(
@@ -1933,7 +2006,7 @@ class USDPipelineDialog(QDialog):  # type: ignore
             self._pipeline.set_progress_callback(progress_callback)
 
             # Execute import
-            self._import_status_label.setText("[START] Importing with mayaUSD...")
+            self._import_status_label.setText(tr("[START] Importing with mayaUSD..."))
             result = self._pipeline.import_usd(usd_path=Path(usd_path), options=import_options)
 
             self._import_progress_bar.setValue(100)
@@ -1942,17 +2015,17 @@ class USDPipelineDialog(QDialog):  # type: ignore
             if result.success:
                 # Build success message
                 message = result.get_summary()
-                self._import_status_label.setText("[OK] Import complete!")
-                QMessageBox.information(self, "Import Complete", message)
+                self._import_status_label.setText(tr("[OK] Import complete!"))
+                QMessageBox.information(self, tr("Import Complete"), message)
             else:
                 error_msg = result.error_message or "Unknown error"
                 self._import_status_label.setText(f"[ERROR] Import failed: {error_msg}")
-                QMessageBox.warning(self, "Import Failed", f"USD import failed:\n{error_msg}")
+                QMessageBox.warning(self, tr("Import Failed"), f"USD import failed:\n{error_msg}")
 
         except Exception as e:
             self._import_progress_bar.setVisible(False)
             self._import_status_label.setText(f"[ERROR] Import error: {e}")
-            QMessageBox.critical(self, "Import Error", f"An error occurred:\n{e}")
+            QMessageBox.critical(self, tr("Import Error"), f"An error occurred:\n{e}")
             logger.error(f"Import error: {e}")
             import traceback
 
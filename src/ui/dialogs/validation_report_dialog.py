@@ -0,0 +1,143 @@
+# -*- coding: utf-8 -*-
+"""
+Validation Report Dialog
+Shows pre-publish check results and lets the artist select offending nodes
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+)
+from PySide6.QtCore import Qt
+from PySide6.QtGui import QColor
+
+from ..theme import UITheme
+from ...core.models.validation_result import ValidationReport
+from ...services.localization_service_impl import tr
+
+
+class ValidationReportDialog(QDialog):
+    """
+    Validation Report Dialog - Single Responsibility for presenting check results
+    Accepting the dialog means "publish anyway"; only offered when there are no errors
+    """
+
+    COLUMNS = ["Severity", "Check", "Issue", "Nodes"]
+    ERROR_COLOR = QColor(220, 90, 90)
+    WARNING_COLOR = QColor(230, 180, 80)
+
+    def __init__(self, report: ValidationReport, parent=None, publishing: bool = True):
+        super().__init__(parent)
+
+        self._report = report
+        self._publishing = publishing
+
+        self._setup_ui()
+        self._load_issues()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Validation"))
+        self.setMinimumSize(640, 320)
+        self.resize(760, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        if self._report.errors:
+            title = "Publish blocked"
+            description = "Fix the errors below before publishing. Click a row to select "
+            description += "the affected nodes in the scene."
+        elif self._report.issues:
+            title = "Validation warnings"
+            description = "The asset can be published, but the checks below reported problems."
+        else:
+            title = "All checks passed"
+            description = "The scene is ready to publish."
+        if self._report.failed_checks:
+            description += (
+                f"\n{len(self._report.failed_checks)} check(s) could not run: "
+                + ", ".join(self._report.failed_checks)
+            )
+
+        title_label = QLabel(f"{title} - {self._report.summary()}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(description)
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(2, QHeaderView.ResizeMode.Stretch)
+        self._table.itemSelectionChanged.connect(self._on_selection_changed)
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        if self._publishing and self._report.can_publish:
+            publish_btn = QPushButton("Publish Anyway" if self._report.issues else "Publish")
+            publish_btn.setProperty("accent", True)
+            publish_btn.clicked.connect(self.accept)
+            button_layout.addWidget(publish_btn)
+
+        close_btn = QPushButton("Cancel" if self._publishing else "Close")
+        close_btn.clicked.connect(self.reject)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_issues(self) -> None:
+        """Populate the table errors-first - Single Responsibility"""
+        issues = self._report.errors + self._report.warnings
+        self._table.setRowCount(len(issues))
+
+        for row, issue in enumerate(issues):
+            short_nodes = [node.rsplit("|", 1)[-1] for node in issue.nodes[:5]]
+            if len(issue.nodes) > 5:
+                short_nodes.append(f"(+{len(issue.nodes) - 5} more)")
+            nodes_text = ", ".join(short_nodes)
+            values = [issue.severity.upper(), issue.check_name, issue.message, nodes_text]
+            for column, text in enumerate(values):
+                item = QTableWidgetItem(text)
+                item.setData(Qt.ItemDataRole.UserRole, list(issue.nodes))
+                if column == 0:
+                    item.setForeground(self.ERROR_COLOR if issue.is_error else self.WARNING_COLOR)
+                self._table.setItem(row, column, item)
+
+        self._table.resizeColumnsToContents()
+
+    def _on_selection_changed(self) -> None:
+        """Select the nodes of the chosen issues in Maya"""
+        nodes = []
+        for index in self._table.selectionModel().selectedRows():
+            item = self._table.item(index.row(), 0)
+            nodes.extend(item.data(Qt.ItemDataRole.UserRole) or [])
+        if not nodes:
+            return
+
+        try:
+            import maya.cmds as cmds  # type: ignore
+
+            existing = [node for node in nodes if cmds.objExists(node)]
+            cmds.select(existing, replace=True)
+        except Exception as e:
+            print(f"[WARNING] Could not select nodes: {e}")
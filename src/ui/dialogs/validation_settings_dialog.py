@@ -0,0 +1,131 @@
+# -*- coding: utf-8 -*-
+"""
+Validation Settings Dialog
+Enable or disable publish checks and choose whether each one blocks or warns
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QComboBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...core.models.validation_result import SEVERITY_ERROR, SEVERITY_WARNING
+from ...services.localization_service_impl import tr
+
+
+class ValidationSettingsDialog(QDialog):
+    """
+    Validation Settings Dialog - Single Responsibility for check configuration
+    """
+
+    COLUMNS = ["Enabled", "Check", "On Failure", "Description"]
+    SEVERITY_LABELS = [("Block publish", SEVERITY_ERROR), ("Warn only", SEVERITY_WARNING)]
+
+    def __init__(self, validation_service, library_root: Optional[Path] = None, parent=None):
+        super().__init__(parent)
+
+        self._service = validation_service
+        self._checks = validation_service.discover_checks(library_root)
+        self._library_root = library_root
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Validation Settings"))
+        self.setMinimumSize(640, 320)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Validation Checks"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        directories = self._service.get_check_directories(self._library_root)
+        folders = "\n".join(str(directory) for directory in directories)
+        desc_label = QLabel(
+            "Checks run before an asset is written to the library. Add studio checks as "
+            f"Python files in:\n{folders}"
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        desc_label.setTextInteractionFlags(Qt.TextInteractionFlag.TextSelectableByMouse)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(len(self._checks), len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.NoSelection)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(3, QHeaderView.ResizeMode.Stretch)
+
+        for row, check in enumerate(self._checks):
+            enabled_item = QTableWidgetItem()
+            enabled_item.setFlags(Qt.ItemFlag.ItemIsUserCheckable | Qt.ItemFlag.ItemIsEnabled)
+            enabled = self._service.is_enabled(check)
+            enabled_item.setCheckState(
+                Qt.CheckState.Checked if enabled else Qt.CheckState.Unchecked
+            )
+            self._table.setItem(row, 0, enabled_item)
+
+            name_item = QTableWidgetItem(check.name or check.check_id)
+            name_item.setFlags(Qt.ItemFlag.ItemIsEnabled)
+            self._table.setItem(row, 1, name_item)
+
+            severity_combo = QComboBox()
+            for label, severity in self.SEVERITY_LABELS:
+                severity_combo.addItem(label, severity)
+            severity_combo.setCurrentIndex(severity_combo.findData(check.severity))
+            self._table.setCellWidget(row, 2, severity_combo)
+
+            description_item = QTableWidgetItem(check.description)
+            description_item.setFlags(Qt.ItemFlag.ItemIsEnabled)
+            self._table.setItem(row, 3, description_item)
+
+        self._table.resizeColumnsToContents()
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _on_save_clicked(self) -> None:
+        """Store check configuration and close"""
+        for row, check in enumerate(self._checks):
+            enabled = self._table.item(row, 0).checkState() == Qt.CheckState.Checked
+            severity = self._table.cellWidget(row, 2).currentData()
+            self._service.set_check_config(check.check_id, enabled=enabled, severity=severity)
+
+        if not self._service.save_config():
+            QMessageBox.warning(self, tr("Save Failed"), tr("Could not save validation settings."))
+            return
+        self.accept()
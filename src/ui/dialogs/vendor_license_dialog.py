@@ -0,0 +1,169 @@
+# -*- coding: utf-8 -*-
+"""
+Vendor License Dialog
+Make a library a read-only vendor library and record the license of its assets
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from dataclasses import replace
+from pathlib import Path
+from typing import Optional
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...core.models.vendor_license import VendorLibrary, VendorLicense
+from ...services.localization_service_impl import tr
+
+
+class VendorLicenseDialog(QDialog):
+    """
+    Vendor License Dialog - Single Responsibility for a library's vendor terms
+    Per-asset terms of the vendor file are kept as they are
+    """
+
+    def __init__(self, vendor_service, library_root: Path, parent=None):
+        """
+        Args:
+            vendor_service: VendorLibraryService reading the library's current terms
+            library_root: Library being described
+        """
+        super().__init__(parent)
+
+        self._library_root = Path(library_root)
+        self._vendor_library = vendor_service.load_vendor_library(self._library_root)
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Vendor License"))
+        self.setMinimumWidth(460)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Vendor License - {name}", name=self._library_root.name))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Purchased asset packs are kept as vendor libraries: their assets can be "
+                "imported, but nothing can be published, edited, or deleted in them. The "
+                "license is shown with every asset and travels in delivery bundles."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        terms = self._vendor_library.license if self._vendor_library else VendorLicense()
+        self._vendor_check = QCheckBox(tr("Read-only vendor library"))
+        self._vendor_check.setChecked(self._vendor_library is not None)
+        self._vendor_check.toggled.connect(self._update_enabled)
+        main_layout.addWidget(self._vendor_check)
+
+        form_layout = QFormLayout()
+        self._vendor_edit = QLineEdit(terms.vendor)
+        self._vendor_edit.setPlaceholderText(tr("Who sold the assets"))
+        form_layout.addRow("Vendor:", self._vendor_edit)
+
+        self._license_edit = QLineEdit(terms.license_type)
+        self._license_edit.setPlaceholderText(tr("Royalty Free, Editorial Only, CC BY 4.0..."))
+        form_layout.addRow("License:", self._license_edit)
+
+        self._source_edit = QLineEdit(terms.source_url)
+        self._source_edit.setPlaceholderText(tr("Where the pack and its license text come from"))
+        form_layout.addRow("Source URL:", self._source_edit)
+
+        self._attribution_edit = QTextEdit()
+        self._attribution_edit.setPlainText(terms.attribution)
+        self._attribution_edit.setPlaceholderText(tr("Credit the license requires, if any"))
+        self._attribution_edit.setMaximumHeight(70)
+        form_layout.addRow("Attribution:", self._attribution_edit)
+
+        self._redistributable_check = QCheckBox(tr("Assets may be delivered to clients"))
+        self._redistributable_check.setChecked(terms.redistributable)
+        self._redistributable_check.setToolTip(
+            tr("Bundles carrying assets that may not be delivered ask before they are written")
+        )
+        form_layout.addRow("", self._redistributable_check)
+        main_layout.addLayout(form_layout)
+
+        if self._vendor_library is not None and self._vendor_library.asset_overrides:
+            overrides_label = QLabel(
+                tr(
+                    "{count} asset(s) or folder(s) have terms of their own in the vendor file.",
+                    count=len(self._vendor_library.asset_overrides),
+                )
+            )
+            overrides_label.setProperty("description", True)
+            main_layout.addWidget(overrides_label)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.setDefault(True)
+        save_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+        self._update_enabled()
+
+    def _update_enabled(self) -> None:
+        """Only vendor libraries have license terms to edit"""
+        enabled = self._vendor_check.isChecked()
+        for widget in (
+            self._vendor_edit,
+            self._license_edit,
+            self._source_edit,
+            self._attribution_edit,
+            self._redistributable_check,
+        ):
+            widget.setEnabled(enabled)
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if self._vendor_check.isChecked() and not self._license_edit.text().strip():
+            QMessageBox.warning(
+                self, tr("Missing License"), tr("Enter the license the assets were bought under.")
+            )
+            return
+        self.accept()
+
+    def get_vendor_library(self) -> Optional[VendorLibrary]:
+        """Get the library's vendor terms, None to make it an ordinary library"""
+        if not self._vendor_check.isChecked():
+            return None
+        terms = VendorLicense(
+            vendor=self._vendor_edit.text().strip(),
+            license_type=self._license_edit.text().strip(),
+            source_url=self._source_edit.text().strip(),
+            attribution=self._attribution_edit.toPlainText().strip(),
+            redistributable=self._redistributable_check.isChecked(),
+        )
+        if self._vendor_library is None:
+            return VendorLibrary(license=terms)
+        return replace(self._vendor_library, license=terms)
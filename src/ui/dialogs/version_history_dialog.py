@@ -0,0 +1,246 @@
+# -*- coding: utf-8 -*-
+"""
+Version History Dialog
+Browse an asset's published versions, import, compare, or roll back
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List, Optional, Tuple
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTableWidget,
+    QTableWidgetItem,
+    QAbstractItemView,
+    QHeaderView,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, Signal
+
+from ..theme import UITheme
+from ...core.interfaces.lock_service import ILockService
+from ...core.interfaces.version_service import IVersionService
+from ...core.models.asset_version import AssetVersion
+from ...services.localization_service_impl import tr
+
+
+class VersionHistoryDialog(QDialog):
+    """
+    Version History Dialog - Single Responsibility for version browsing
+    Importing a version never touches the current asset file
+    """
+
+    # Emitted with the version file path the user wants imported into Maya
+    import_version_requested = Signal(object)
+    # Emitted with the new AssetVersion after a successful rollback
+    version_rolled_back = Signal(object)
+    # Emitted with the older and newer AssetVersion the user wants compared
+    compare_requested = Signal(object, object)
+    # Emitted with the older and newer AssetVersion the user wants rendered side by side
+    preview_requested = Signal(object, object)
+
+    COLUMNS = ["Version", "Date", "Author", "Notes"]
+
+    def __init__(
+        self,
+        asset_path: Path,
+        version_service: IVersionService,
+        parent=None,
+        lock_service: Optional[ILockService] = None,
+        can_roll_back: bool = True,
+    ):
+        super().__init__(parent)
+
+        self._asset_path = Path(asset_path)
+        self._version_service = version_service
+        self._lock_service = lock_service
+        self._can_roll_back = can_roll_back  # The artist's library role allows publishing
+        self._versions: List[AssetVersion] = []
+
+        self._setup_ui()
+        self._load_versions()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(f"Version History - {self._asset_path.stem}")
+        self.setMinimumSize(600, 350)
+        self.resize(720, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Version History: {self._asset_path.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Every publish is kept as an immutable version. Import any version "
+                "without changing the current asset, or roll back to make it current again. "
+                "Compare a version with the latest one, or select two versions to compare them."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.setSelectionMode(QAbstractItemView.SelectionMode.ExtendedSelection)
+        self._table.setEditTriggers(QAbstractItemView.EditTrigger.NoEditTriggers)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(3, QHeaderView.ResizeMode.Stretch)
+        self._table.itemSelectionChanged.connect(self._on_selection_changed)
+        self._table.itemDoubleClicked.connect(lambda _item: self._on_import_clicked())
+        main_layout.addWidget(self._table, 1)
+
+        button_layout = QHBoxLayout()
+
+        self._import_btn = QPushButton(tr("Import Version"))
+        self._import_btn.setProperty("accent", True)
+        self._import_btn.setEnabled(False)
+        self._import_btn.clicked.connect(self._on_import_clicked)
+        button_layout.addWidget(self._import_btn)
+
+        self._rollback_btn = QPushButton(tr("Roll Back to Version"))
+        self._rollback_btn.setEnabled(False)
+        if not self._can_roll_back:
+            self._rollback_btn.setToolTip(tr("Your role in this library cannot publish assets"))
+        self._rollback_btn.clicked.connect(self._on_rollback_clicked)
+        button_layout.addWidget(self._rollback_btn)
+
+        self._compare_btn = QPushButton(tr("Compare..."))
+        self._compare_btn.setEnabled(False)
+        self._compare_btn.clicked.connect(self._on_compare_clicked)
+        button_layout.addWidget(self._compare_btn)
+
+        self._preview_btn = QPushButton(tr("Compare Preview..."))
+        self._preview_btn.setToolTip(
+            tr("Render both versions side by side without importing them")
+        )
+        self._preview_btn.setEnabled(False)
+        self._preview_btn.clicked.connect(self._on_preview_clicked)
+        button_layout.addWidget(self._preview_btn)
+
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _load_versions(self) -> None:
+        """Populate the table newest-first - Single Responsibility"""
+        self._versions = list(reversed(self._version_service.get_versions(self._asset_path)))
+        self._table.setRowCount(len(self._versions))
+
+        for row, version in enumerate(self._versions):
+            date_text = (
+                version.created_date.strftime("%Y-%m-%d %H:%M") if version.created_date else "-"
+            )
+            label = version.label
+            if not version.exists:
+                label += " (pruned)" if version.is_pruned else " (missing)"
+            for column, text in enumerate([label, date_text, version.author, version.notes]):
+                item = QTableWidgetItem(text)
+                item.setData(Qt.ItemDataRole.UserRole, version.number)
+                self._table.setItem(row, column, item)
+
+        self._table.resizeColumnsToContents()
+        if not self._versions:
+            self._table.setRowCount(1)
+            self._table.setItem(0, 0, QTableWidgetItem("No versions published yet"))
+
+    def _get_selected_versions(self) -> List[AssetVersion]:
+        """Get the versions for the selected rows, newest first"""
+        rows = sorted(index.row() for index in self._table.selectionModel().selectedRows())
+        return [self._versions[row] for row in rows if row < len(self._versions)]
+
+    def _get_selected_version(self) -> Optional[AssetVersion]:
+        """Get the version for the selected row (None unless exactly one is selected)"""
+        versions = self._get_selected_versions()
+        return versions[0] if len(versions) == 1 else None
+
+    def _get_compare_pair(self) -> Optional[Tuple[AssetVersion, AssetVersion]]:
+        """Get the (older, newer) versions to compare from the selection"""
+        versions = self._get_selected_versions()
+        if len(versions) == 1 and self._versions and versions[0] is not self._versions[0]:
+            versions.insert(0, self._versions[0])  # One older version against the latest
+        if len(versions) != 2 or not all(version.exists for version in versions):
+            return None
+        return versions[1], versions[0]
+
+    def _on_selection_changed(self) -> None:
+        """Enable actions only for versions still on disk"""
+        version = self._get_selected_version()
+        available = version is not None and version.exists
+        latest = self._versions[0] if self._versions else None
+        self._import_btn.setEnabled(available)
+        self._rollback_btn.setEnabled(
+            self._can_roll_back and available and version is not latest
+        )
+        self._compare_btn.setEnabled(self._get_compare_pair() is not None)
+        self._preview_btn.setEnabled(self._get_compare_pair() is not None)
+
+    def _on_compare_clicked(self) -> None:
+        """Request a diff of the selected versions"""
+        pair = self._get_compare_pair()
+        if pair is not None:
+            self.compare_requested.emit(*pair)
+
+    def _on_preview_clicked(self) -> None:
+        """Request a side-by-side render of the selected versions"""
+        pair = self._get_compare_pair()
+        if pair is not None:
+            self.preview_requested.emit(*pair)
+
+    def _on_import_clicked(self) -> None:
+        """Request import of the selected version"""
+        version = self._get_selected_version()
+        if version is not None and version.exists:
+            self.import_version_requested.emit(version.file_path)
+
+    def _on_rollback_clicked(self) -> None:
+        """Roll back the current asset to the selected version"""
+        version = self._get_selected_version()
+        if version is None:
+            return
+
+        if self._lock_service and not self._lock_service.can_publish(self._asset_path):
+            lock = self._lock_service.get_lock(self._asset_path)
+            owner = lock.description if lock else "another artist"
+            QMessageBox.warning(
+                self,
+                tr("Asset Locked"),
+                f"Cannot roll back - the asset is checked out by {owner}.",
+            )
+            return
+
+        reply = QMessageBox.question(
+            self,
+            tr("Roll Back Asset"),
+            f"Make {version.label} the current version of '{self._asset_path.stem}'?\n\n"
+            "The current content stays in history as an older version.",
+            QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+        )
+        if reply != QMessageBox.StandardButton.Yes:
+            return
+
+        new_version = self._version_service.rollback_to_version(self._asset_path, version.number)
+        if new_version is None:
+            QMessageBox.warning(self, tr("Rollback Failed"), f"Could not restore {version.label}.")
+            return
+
+        self.version_rolled_back.emit(new_version)
+        self._load_versions()
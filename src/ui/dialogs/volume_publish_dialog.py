@@ -0,0 +1,164 @@
+# -*- coding: utf-8 -*-
+"""
+Volume Publish Dialog
+Review the frames and grids of an OpenVDB sequence and name the volume asset
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QLineEdit,
+    QTextEdit,
+    QCheckBox,
+    QPushButton,
+    QMessageBox,
+)
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class VolumePublishDialog(QDialog):
+    """
+    Volume Publish Dialog - Single Responsibility for volume publish options
+    Frames and grids are read from the files; only the name and notes are entered
+    """
+
+    def __init__(
+        self,
+        pattern: str,
+        frames: List[int],
+        grids: List[str],
+        can_draw_slice: bool,
+        parent=None,
+    ):
+        """
+        Args:
+            pattern: #-padded file name of the sequence (smoke.####.vdb)
+            frames: Frame numbers found on disk
+            grids: Grid names read from the mid frame
+            can_draw_slice: Whether the OpenVDB Python module is installed
+        """
+        super().__init__(parent)
+
+        self._pattern = pattern
+        self._frames = frames
+        self._grids = grids
+        self._can_draw_slice = can_draw_slice
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Publish Volume"))
+        self.setMinimumWidth(420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Publish Volume"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        desc_label = QLabel(
+            tr(
+                "Every frame of the sequence is copied into the library. The volume loads "
+                "as an Arnold aiVolume, or as a fluid container without Arnold."
+            )
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        form_layout.addRow(tr("Sequence:"), QLabel(self._pattern))
+        missing = self._get_missing_frames()
+        frames_text = self._get_frames_label()
+        if missing:
+            frames_text += tr(" ({count} missing)", count=len(missing))
+        form_layout.addRow(tr("Frames:"), QLabel(frames_text))
+        form_layout.addRow(tr("Grids:"), QLabel(", ".join(self._grids) or tr("(not readable)")))
+
+        self._name_edit = QLineEdit(self._get_default_name())
+        form_layout.addRow(tr("Name:"), self._name_edit)
+
+        self._notes_edit = QTextEdit()
+        self._notes_edit.setMaximumHeight(70)
+        form_layout.addRow(tr("Notes:"), self._notes_edit)
+
+        self._slice_check = QCheckBox(tr("Draw a density slice of the mid frame as thumbnail"))
+        self._slice_check.setChecked(self._can_draw_slice and bool(self._grids))
+        self._slice_check.setEnabled(self._can_draw_slice and bool(self._grids))
+        if not self._can_draw_slice:
+            self._slice_check.setToolTip(tr("Slices are drawn with the OpenVDB Python module"))
+        form_layout.addRow("", self._slice_check)
+        main_layout.addLayout(form_layout)
+
+        button_layout = QHBoxLayout()
+        button_layout.addStretch()
+
+        publish_btn = QPushButton(tr("Publish Volume"))
+        publish_btn.setProperty("accent", True)
+        publish_btn.setDefault(True)
+        publish_btn.clicked.connect(self._on_accept)
+        button_layout.addWidget(publish_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _get_default_name(self) -> str:
+        """Get the sequence name without frame token and separator (smoke.####.vdb -> smoke)"""
+        stem = self._pattern.rsplit(".", 1)[0]
+        return stem.rstrip("#").rstrip("._") or stem
+
+    def _get_frames_label(self) -> str:
+        """Get the frame range text (1001-1100, 100 frames)"""
+        if len(self._frames) == 1 and "#" not in self._pattern:
+            return "Still (one file)"
+        return f"{self._frames[0]}-{self._frames[-1]}, {len(self._frames)} frames"
+
+    def _get_missing_frames(self) -> List[int]:
+        """Get the frames between the first and last that have no file"""
+        if len(self._frames) < 2:
+            return []
+        present = set(self._frames)
+        return [f for f in range(self._frames[0], self._frames[-1] + 1) if f not in present]
+
+    def _on_accept(self) -> None:
+        """Validate input before closing"""
+        if not self._name_edit.text().strip():
+            QMessageBox.warning(self, tr("Missing Name"), tr("Please enter a name."))
+            return
+        missing = self._get_missing_frames()
+        if missing:
+            reply = QMessageBox.question(
+                self,
+                tr("Frames Missing"),
+                f"{len(missing)} frame(s) between {self._frames[0]} and {self._frames[-1]} "
+                "have no file. Publish the sequence anyway?",
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+        self.accept()
+
+    def get_options(self) -> Dict[str, Any]:
+        """Get publish options (name, notes, thumbnail)"""
+        return {
+            "name": self._name_edit.text().strip(),
+            "notes": self._notes_edit.toPlainText().strip(),
+            "thumbnail": self._slice_check.isChecked(),
+        }
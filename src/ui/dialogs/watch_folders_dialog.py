@@ -0,0 +1,239 @@
+# -*- coding: utf-8 -*-
+"""
+Watch Folders Dialog
+Choose the drop folders whose new exports are ingested into the library
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List
+
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QFormLayout,
+    QLabel,
+    QCheckBox,
+    QSpinBox,
+    QTableWidget,
+    QTableWidgetItem,
+    QHeaderView,
+    QAbstractItemView,
+    QPushButton,
+    QMessageBox,
+    QFileDialog,
+)
+from PySide6.QtCore import Qt
+
+from ..theme import UITheme
+from ...core.models.watch_folder import DEFAULT_EXTENSIONS, WatchFolder
+from ...services.ingest_service_impl import compile_name_pattern
+from ...services.localization_service_impl import tr
+
+
+class WatchFoldersDialog(QDialog):
+    """
+    Watch Folders Dialog - Single Responsibility for the artist's ingest folders
+    One row per folder; new folders only ingest files dropped after they are added
+    """
+
+    COLUMNS = ["Folder", "Asset Type", "Name Pattern", "Extensions", "Review", "Watch"]
+    CHECK_COLUMNS = (4, 5)
+
+    def __init__(self, ingest_service, library_root, parent=None):
+        super().__init__(parent)
+
+        self._service = ingest_service
+        self._library_root = library_root
+
+        self._setup_ui()
+        self._fill_table(ingest_service.get_folders())
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Watch Folders"))
+        self.setMinimumSize(820, 420)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        config = self._service.get_config()
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(tr("Watch Folders"))
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        library = self._library_root or "the library that is open"
+        desc_label = QLabel(
+            "OBJ, FBX, and image files dropped in these folders are ingested into "
+            f"{library} as new assets, or as new versions of the asset their name matches. "
+            "Name patterns use {assetName}, {type}, and {version} (e.g. "
+            "{type}_{assetName}_v{version}); empty patterns drop a trailing _v004. Model "
+            "thumbnails render in the background when mayapy is available."
+        )
+        desc_label.setWordWrap(True)
+        desc_label.setProperty("description", True)
+        main_layout.addWidget(desc_label)
+
+        form_layout = QFormLayout()
+        self._enabled_check = QCheckBox(tr("Watch the folders while Maya is open"))
+        self._enabled_check.setChecked(bool(config["enabled"]))
+        form_layout.addRow("", self._enabled_check)
+
+        self._interval_spin = QSpinBox()
+        self._interval_spin.setRange(5, 3600)
+        self._interval_spin.setSuffix(" s")
+        self._interval_spin.setValue(int(config["interval_seconds"]))
+        form_layout.addRow("Look every:", self._interval_spin)
+        main_layout.addLayout(form_layout)
+
+        self._table = QTableWidget(0, len(self.COLUMNS))
+        self._table.setHorizontalHeaderLabels(self.COLUMNS)
+        self._table.setSelectionBehavior(QAbstractItemView.SelectionBehavior.SelectRows)
+        self._table.verticalHeader().setVisible(False)
+        self._table.horizontalHeader().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        main_layout.addWidget(self._table, 1)
+
+        self._ingest_existing_check = QCheckBox(
+            tr("Also ingest the files already in newly added folders")
+        )
+        main_layout.addWidget(self._ingest_existing_check)
+
+        button_layout = QHBoxLayout()
+
+        add_btn = QPushButton(tr("Add Folder..."))
+        add_btn.clicked.connect(self._on_add_clicked)
+        button_layout.addWidget(add_btn)
+
+        remove_btn = QPushButton(tr("Remove Folder"))
+        remove_btn.clicked.connect(self._on_remove_clicked)
+        button_layout.addWidget(remove_btn)
+
+        button_layout.addStretch()
+
+        save_btn = QPushButton(tr("Save"))
+        save_btn.setProperty("accent", True)
+        save_btn.clicked.connect(self._on_save_clicked)
+        button_layout.addWidget(save_btn)
+
+        cancel_btn = QPushButton(tr("Cancel"))
+        cancel_btn.clicked.connect(self.reject)
+        button_layout.addWidget(cancel_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _fill_table(self, folders: List[WatchFolder]) -> None:
+        """Show one row per folder"""
+        self._table.setRowCount(0)
+        for folder in folders:
+            self._add_row(folder)
+        self._table.resizeColumnsToContents()
+
+    def _add_row(self, folder: WatchFolder) -> None:
+        """Append a folder row"""
+        row = self._table.rowCount()
+        self._table.insertRow(row)
+        texts = [
+            folder.path,
+            folder.category,
+            folder.name_pattern,
+            ", ".join(extension.lstrip(".") for extension in folder.extensions),
+        ]
+        for column, text in enumerate(texts):
+            item = QTableWidgetItem(text)
+            if column == 0:
+                item.setFlags(item.flags() & ~Qt.ItemFlag.ItemIsEditable)
+                item.setToolTip(text)
+            self._table.setItem(row, column, item)
+        for column, checked in zip(self.CHECK_COLUMNS, (folder.flag_review, folder.enabled)):
+            item = QTableWidgetItem()
+            item.setFlags(Qt.ItemFlag.ItemIsEnabled | Qt.ItemFlag.ItemIsUserCheckable)
+            item.setCheckState(Qt.CheckState.Checked if checked else Qt.CheckState.Unchecked)
+            self._table.setItem(row, column, item)
+
+    def _on_add_clicked(self) -> None:
+        """Pick a folder to watch"""
+        directory = QFileDialog.getExistingDirectory(self, tr("Watch Folder"), str(Path.home()))
+        if not directory:
+            return
+        if directory in self._get_paths():
+            QMessageBox.information(
+                self, tr("Already Watched"), f"{directory} is already a watch folder."
+            )
+            return
+        self._add_row(WatchFolder(path=directory))
+        self._table.setCurrentCell(self._table.rowCount() - 1, 1)
+
+    def _on_remove_clicked(self) -> None:
+        """Remove the selected folders"""
+        rows = sorted({index.row() for index in self._table.selectedIndexes()}, reverse=True)
+        for row in rows:
+            self._table.removeRow(row)
+
+    def _get_paths(self) -> List[str]:
+        """Get the folder of every row"""
+        return [self._get_text(row, 0) for row in range(self._table.rowCount())]
+
+    def _get_text(self, row: int, column: int) -> str:
+        """Get the trimmed text of a cell"""
+        item = self._table.item(row, column)
+        return item.text().strip() if item else ""
+
+    def _is_checked(self, row: int, column: int) -> bool:
+        """Check if a check box cell is ticked"""
+        item = self._table.item(row, column)
+        return item is not None and item.checkState() == Qt.CheckState.Checked
+
+    def _parse_folders(self) -> List[WatchFolder]:
+        """Create folders from the table, checking their name patterns"""
+        folders = []
+        for row in range(self._table.rowCount()):
+            name_pattern = self._get_text(row, 2)
+            if name_pattern:
+                try:
+                    compile_name_pattern(name_pattern)
+                except ValueError as e:
+                    raise ValueError(f"Folder {row + 1}: {e}") from e
+            extensions = [
+                f".{part.strip().lower().lstrip('.')}"
+                for part in self._get_text(row, 3).replace(";", ",").split(",")
+                if part.strip()
+            ]
+            folders.append(
+                WatchFolder(
+                    path=self._get_text(row, 0),
+                    category=self._get_text(row, 1) or "General",
+                    name_pattern=name_pattern,
+                    extensions=tuple(extensions) or DEFAULT_EXTENSIONS,
+                    flag_review=self._is_checked(row, 4),
+                    enabled=self._is_checked(row, 5),
+                )
+            )
+        return folders
+
+    def _on_save_clicked(self) -> None:
+        """Store the folders and close; new folders remember the files already in them"""
+        try:
+            folders = self._parse_folders()
+        except ValueError as e:
+            QMessageBox.warning(self, tr("Invalid Watch Folder"), str(e))
+            return
+        previous = {folder.path for folder in self._service.get_folders()}
+        self._service.set_config(
+            enabled=self._enabled_check.isChecked(),
+            interval_seconds=self._interval_spin.value(),
+            folders=[folder for folder in folders if folder.path in previous],
+        )
+        for folder in folders:
+            if folder.path not in previous:
+                self._service.add_folder(folder, self._ingest_existing_check.isChecked())
+        if not self._service.save_config():
+            QMessageBox.warning(
+                self, tr("Save Failed"), tr("Could not save the watch folder settings.")
+            )
+            return
+        self.accept()
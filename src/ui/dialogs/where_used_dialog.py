@@ -0,0 +1,171 @@
+# -*- coding: utf-8 -*-
+"""
+Where Used Dialog
+Lists the project scenes referencing an asset and the version each one loads
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import List
+
+from PySide6.QtCore import Signal
+from PySide6.QtWidgets import (
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QFileDialog,
+)
+
+from ..theme import UITheme
+from ...core.models.scene_usage import SceneUsage
+from ...services.localization_service_impl import tr
+
+
+class WhereUsedDialog(QDialog):
+    """
+    Where Used Dialog - Single Responsibility for showing an asset's scene usages
+    The scan runs on a worker thread; results come back through signals
+    """
+
+    scan_progress = Signal(int, int)
+    scan_finished = Signal(list)
+
+    def __init__(
+        self, where_used_service, asset_file: Path, project_roots: List[Path], parent=None
+    ):
+        super().__init__(parent)
+
+        self._service = where_used_service
+        self._asset_file = Path(asset_file)
+        self._project_roots = list(project_roots)
+        self._cancelled = False
+        self._scanning = False
+
+        self.scan_progress.connect(self._on_scan_progress)
+        self.scan_finished.connect(self._on_scan_finished)
+
+        self._setup_ui()
+        self._start_scan()
+
+    def _setup_ui(self) -> None:
+        """Setup dialog UI - Single Responsibility"""
+        self.setWindowTitle(tr("Where Used"))
+        self.setMinimumSize(640, 400)
+        self.setStyleSheet(UITheme.get_dialog_stylesheet())
+
+        main_layout = QVBoxLayout(self)
+        main_layout.setContentsMargins(16, 16, 16, 16)
+        main_layout.setSpacing(12)
+
+        title_label = QLabel(f"Scenes Using {self._asset_file.name}")
+        title_label.setProperty("title", True)
+        main_layout.addWidget(title_label)
+
+        self._desc_label = QLabel()
+        self._desc_label.setWordWrap(True)
+        self._desc_label.setProperty("description", True)
+        main_layout.addWidget(self._desc_label)
+
+        self._usage_tree = QTreeWidget()
+        self._usage_tree.setHeaderLabels(["Scene", "Version", "Namespace", "Folder"])
+        self._usage_tree.setRootIsDecorated(False)
+        self._usage_tree.setSortingEnabled(True)
+        self._usage_tree.setColumnWidth(0, 200)
+        main_layout.addWidget(self._usage_tree, 1)
+
+        self._status_label = QLabel()
+        main_layout.addWidget(self._status_label)
+
+        button_layout = QHBoxLayout()
+
+        shot_tree_btn = QPushButton(tr("Shot Tree..."))
+        shot_tree_btn.setToolTip(tr("Choose the folder searched instead of the Maya project"))
+        shot_tree_btn.clicked.connect(self._on_choose_shot_tree)
+        button_layout.addWidget(shot_tree_btn)
+
+        self._rescan_btn = QPushButton(tr("Rescan"))
+        self._rescan_btn.clicked.connect(self._start_scan)
+        button_layout.addWidget(self._rescan_btn)
+
+        button_layout.addStretch()
+
+        close_btn = QPushButton(tr("Close"))
+        close_btn.setProperty("accent", True)
+        close_btn.clicked.connect(self.accept)
+        button_layout.addWidget(close_btn)
+
+        main_layout.addLayout(button_layout)
+
+    def _start_scan(self) -> None:
+        """Search the shot tree on a worker thread"""
+        if self._scanning:
+            return
+        roots = self._service.get_search_roots(self._project_roots)
+        self._desc_label.setText(
+            "Searching .ma and .mb scenes in: " + ", ".join(str(root) for root in roots)
+        )
+        self._usage_tree.clear()
+        self._status_label.setText(tr("Scanning scenes..."))
+        self._rescan_btn.setEnabled(False)
+        self._scanning = True
+        self._cancelled = False
+        self._service.scan_in_background(
+            roots, self._asset_file, self.scan_finished.emit, self._report_progress
+        )
+
+    def _report_progress(self, done: int, total: int) -> bool:
+        """Forward worker progress to the UI thread; stop once the dialog closes"""
+        if not self._cancelled:
+            self.scan_progress.emit(done, total)
+        return not self._cancelled
+
+    def _on_scan_progress(self, done: int, total: int) -> None:
+        self._status_label.setText(f"Scanning scenes... {done} of {total}")
+
+    def _on_scan_finished(self, usages: List[SceneUsage]) -> None:
+        """Show the usages found by the worker"""
+        self._scanning = False
+        if self._cancelled:
+            return
+        self._rescan_btn.setEnabled(True)
+
+        self._usage_tree.setSortingEnabled(False)
+        for usage in usages:
+            item = QTreeWidgetItem(
+                [usage.scene.name, usage.version_label, usage.namespace, str(usage.scene.parent)]
+            )
+            item.setToolTip(0, usage.reference_path)
+            self._usage_tree.addTopLevelItem(item)
+        self._usage_tree.setSortingEnabled(True)
+
+        scenes = len({usage.scene for usage in usages})
+        pinned = sum(1 for usage in usages if usage.is_pinned)
+        if usages:
+            self._status_label.setText(
+                f"{len(usages)} reference(s) in {scenes} scene(s), {pinned} pinned to a version"
+            )
+        else:
+            self._status_label.setText(tr("No scene references this asset"))
+
+    def _on_choose_shot_tree(self) -> None:
+        """Pick the folder searched for scenes, remembered for later scans"""
+        roots = self._service.get_search_roots(self._project_roots)
+        folder = QFileDialog.getExistingDirectory(
+            self, "Choose Shot Tree", str(roots[0]) if roots else ""
+        )
+        if not folder:
+            return
+        self._service.set_shot_roots([Path(folder)])
+        self._service.save_config()
+        self._start_scan()
+
+    def done(self, result: int) -> None:
+        # The worker thread finishes on its own; tell it to stop reading scenes
+        self._cancelled = True
+        super().done(result)
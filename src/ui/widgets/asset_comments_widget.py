@@ -0,0 +1,239 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Comments Widget
+Review note threads of the selected asset, with replies, resolving, and @mentions
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, List, Optional
+
+from PySide6.QtWidgets import (
+    QWidget,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QCheckBox,
+    QComboBox,
+    QPlainTextEdit,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtGui import QColor
+
+from ...services.localization_service_impl import tr
+
+RESOLVED_COLOR = "#777777"
+
+
+class AssetCommentsWidget(QWidget):
+    """
+    Asset Comments Widget - Single Responsibility for reading and writing review notes
+    Threads are reloaded after every change, so notes from other artists show up too
+    """
+
+    open_threads_changed = Signal(int)  # Unresolved threads of the shown asset
+    status_message = Signal(str)
+
+    def __init__(self, comment_service, parent=None):
+        super().__init__(parent)
+
+        self._service = comment_service
+        self._library_root: Optional[Path] = None
+        self._asset_file: Optional[Path] = None
+        self._threads: List[Any] = []
+
+        self._setup_ui()
+        self.set_asset(None, None)
+
+    def _setup_ui(self) -> None:
+        """Setup widget UI - Single Responsibility"""
+        layout = QVBoxLayout(self)
+        layout.setContentsMargins(0, 4, 0, 0)
+        layout.setSpacing(4)
+
+        header_layout = QHBoxLayout()
+        self._summary_label = QLabel("")
+        self._summary_label.setStyleSheet("color: #999999;")
+        header_layout.addWidget(self._summary_label, 1)
+        self._show_resolved_check = QCheckBox(tr("Show resolved"))
+        self._show_resolved_check.toggled.connect(lambda _checked: self._populate())
+        header_layout.addWidget(self._show_resolved_check)
+        layout.addLayout(header_layout)
+
+        self._tree = QTreeWidget()
+        self._tree.setHeaderHidden(True)
+        self._tree.setWordWrap(True)
+        self._tree.itemSelectionChanged.connect(self._update_buttons)
+        layout.addWidget(self._tree, 1)
+
+        self._note_edit = QPlainTextEdit()
+        self._note_edit.setPlaceholderText(tr("Write a note... @name notifies an artist"))
+        self._note_edit.setMaximumHeight(70)
+        self._note_edit.textChanged.connect(self._update_buttons)
+        layout.addWidget(self._note_edit)
+
+        button_layout = QHBoxLayout()
+        self._version_combo = QComboBox()
+        self._version_combo.setToolTip(
+            tr("Version a new thread is about; replies keep their thread's")
+        )
+        button_layout.addWidget(self._version_combo)
+        button_layout.addStretch()
+
+        self._resolve_btn = QPushButton(tr("Resolve"))
+        self._resolve_btn.setToolTip(tr("Mark the selected thread resolved, or open it again"))
+        self._resolve_btn.clicked.connect(self._on_resolve)
+        button_layout.addWidget(self._resolve_btn)
+
+        self._reply_btn = QPushButton(tr("Reply"))
+        self._reply_btn.setToolTip(tr("Answer the selected thread"))
+        self._reply_btn.clicked.connect(lambda: self._on_post(reply=True))
+        button_layout.addWidget(self._reply_btn)
+
+        self._post_btn = QPushButton(tr("Post Note"))
+        self._post_btn.setToolTip(tr("Start a new thread on this asset"))
+        self._post_btn.clicked.connect(lambda: self._on_post(reply=False))
+        button_layout.addWidget(self._post_btn)
+        layout.addLayout(button_layout)
+
+    def set_asset(
+        self,
+        library_root: Optional[Path],
+        asset_file: Optional[Path],
+        versions: Optional[List[int]] = None,
+    ) -> None:
+        """
+        Show the notes of an asset
+
+        Args:
+            library_root: Library the asset belongs to, None clears the list
+            asset_file: Asset whose notes are shown
+            versions: Version numbers a new note can be about, newest first
+        """
+        self._library_root = library_root
+        self._asset_file = asset_file
+        self._version_combo.clear()
+        self._version_combo.addItem(tr("Whole asset"), 0)
+        for number in versions or []:
+            self._version_combo.addItem(f"v{number:03d}", number)
+        self.setEnabled(library_root is not None and asset_file is not None)
+        self.refresh()
+
+    def refresh(self) -> None:
+        """Load the threads of the shown asset again"""
+        self._threads = []
+        if self._library_root is not None and self._asset_file is not None:
+            try:
+                self._threads = self._service.get_threads(self._library_root, self._asset_file)
+            except Exception as e:
+                self.status_message.emit(f"Could not read notes: {e}")
+        self._populate()
+        self.open_threads_changed.emit(sum(1 for t in self._threads if not t.resolved))
+
+    def select_comment(self, comment_id: int) -> None:
+        """Select the thread holding a note, showing it even when resolved"""
+        for thread in self._threads:
+            if comment_id in [comment.comment_id for comment in thread.comments]:
+                if thread.resolved:
+                    self._show_resolved_check.setChecked(True)
+                break
+        for index in range(self._tree.topLevelItemCount()):
+            item = self._tree.topLevelItem(index)
+            thread = item.data(0, Qt.ItemDataRole.UserRole)
+            if comment_id in [comment.comment_id for comment in thread.comments]:
+                self._tree.setCurrentItem(item)
+                item.setExpanded(True)
+                return
+
+    def _populate(self) -> None:
+        """Fill the tree with threads and their replies"""
+        self._tree.clear()
+        show_resolved = self._show_resolved_check.isChecked()
+        for thread in [t for t in self._threads if show_resolved or not t.resolved]:
+            item = self._create_item(thread.root, thread.resolved)
+            item.setData(0, Qt.ItemDataRole.UserRole, thread)
+            for reply in thread.replies:
+                child = self._create_item(reply, thread.resolved)
+                child.setData(0, Qt.ItemDataRole.UserRole, thread)
+                item.addChild(child)
+            self._tree.addTopLevelItem(item)
+            item.setExpanded(not thread.resolved)
+
+        open_count = sum(1 for thread in self._threads if not thread.resolved)
+        if self._asset_file is None:
+            self._summary_label.setText(tr("Select an asset to read its notes"))
+        elif not self._threads:
+            self._summary_label.setText(tr("No notes yet"))
+        else:
+            resolved = len(self._threads) - open_count
+            self._summary_label.setText(f"{open_count} open, {resolved} resolved")
+        self._update_buttons()
+
+    def _create_item(self, comment: Any, resolved: bool) -> QTreeWidgetItem:
+        """Create the row of one note"""
+        header = comment.header + (" [resolved]" if resolved and not comment.is_reply else "")
+        item = QTreeWidgetItem([f"{header}\n{comment.body}"])
+        item.setToolTip(0, comment.body)
+        if resolved:
+            item.setForeground(0, QColor(RESOLVED_COLOR))
+        return item
+
+    def _get_selected_thread(self) -> Optional[Any]:
+        """Get the thread of the selected row"""
+        item = self._tree.currentItem()
+        return item.data(0, Qt.ItemDataRole.UserRole) if item is not None else None
+
+    def _update_buttons(self) -> None:
+        """Enable posting once there is text, and replying once a thread is picked"""
+        thread = self._get_selected_thread()
+        has_text = bool(self._note_edit.toPlainText().strip())
+        self._post_btn.setEnabled(has_text)
+        self._reply_btn.setEnabled(has_text and thread is not None)
+        self._resolve_btn.setEnabled(thread is not None)
+        self._resolve_btn.setText(
+            tr("Reopen") if thread is not None and thread.resolved else tr("Resolve")
+        )
+
+    def _on_post(self, reply: bool) -> None:
+        """Store the typed note as a new thread or a reply"""
+        thread = self._get_selected_thread() if reply else None
+        if self._library_root is None or self._asset_file is None or (reply and not thread):
+            return
+        try:
+            comment = self._service.add_comment(
+                self._library_root,
+                self._asset_file,
+                self._note_edit.toPlainText(),
+                version=self._version_combo.currentData() or 0,
+                parent_id=thread.root.comment_id if thread else None,
+            )
+        except Exception as e:
+            QMessageBox.warning(self, tr("Note Not Posted"), str(e))
+            return
+
+        self._note_edit.clear()
+        self.refresh()
+        self.select_comment(comment.comment_id)
+        mentions = ", ".join(name for name in comment.mentions if name != comment.user)
+        notified = f" (notified {mentions})" if mentions else ""
+        self.status_message.emit(f"Note posted on {comment.asset_name}{notified}")
+
+    def _on_resolve(self) -> None:
+        """Resolve the selected thread, or open it again"""
+        thread = self._get_selected_thread()
+        if thread is None or self._library_root is None:
+            return
+        try:
+            self._service.set_resolved(self._library_root, thread, not thread.resolved)
+        except Exception as e:
+            QMessageBox.warning(self, tr("Note Not Updated"), str(e))
+            return
+        self.refresh()
+        state = "reopened" if thread.resolved else "resolved"
+        self.status_message.emit(f"Thread by {thread.root.user} {state}")
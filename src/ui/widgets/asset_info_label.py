@@ -12,6 +12,8 @@ from PySide6.QtWidgets import QLabel
 from PySide6.QtCore import Qt, Signal
 from PySide6.QtGui import QWheelEvent
 
+from ...services.localization_service_impl import tr
+
 
 class AssetInfoLabel(QLabel):
     """
@@ -43,7 +45,7 @@ class AssetInfoLabel(QLabel):
 
         # Set initial text
         self.setText(
-            "No asset selected\n\nTip: Hold Ctrl and scroll mouse wheel to adjust font size"
+            tr("No asset selected\n\nTip: Hold Ctrl and scroll mouse wheel to adjust font size")
         )
 
     def _update_stylesheet(self) -> None:
@@ -9,6 +9,7 @@ Enterprise Refactoring: Clean Code & SOLID Principles
 
 from __future__ import annotations
 
+from pathlib import Path
 from typing import List, Optional, Dict, Any, TYPE_CHECKING
 
 # Runtime imports
@@ -23,9 +24,10 @@ try:
         QPushButton,
         QTabWidget,
         QListWidgetItem,
+        QComboBox,
     )
-    from PySide6.QtCore import Qt, Signal, QTimer, QSize, QMimeData
-    from PySide6.QtGui import QColor, QIcon, QDrag
+    from PySide6.QtCore import Qt, Signal, QTimer, QSize, QMimeData, QFileSystemWatcher
+    from PySide6.QtGui import QColor, QDrag
 
     PYSIDE_AVAILABLE = True
 except ImportError:
@@ -48,11 +50,29 @@ try:
     from ...core.interfaces.thumbnail_service import IThumbnailService  # type: ignore
     from ...core.interfaces.event_publisher import IEventPublisher, EventType  # type: ignore
     from ...core.container import get_container  # type: ignore
+    from ...core.models.library_permissions import (  # type: ignore
+        ACTION_APPROVE,
+        ACTION_DELETE,
+        ACTION_EDIT,
+    )
+    from ...core.models.asset_status import (  # type: ignore
+        STATUS_APPROVED,
+        STATUS_COLORS,
+        STATUS_DEPRECATED,
+        STATUS_LABELS,
+        STATUS_PENDING_REVIEW,
+        STATUS_WIP,
+        STATUSES,
+    )
+    from ...services.localization_service_impl import tr  # type: ignore
 
     IMPORTS_AVAILABLE = True
 except ImportError as e:
     print(f"[WARNING] Import warning for asset library: {e}")
 
+    def tr(text, **values):  # type: ignore
+        return text.format(**values) if values else text
+
     # Create enhanced fallback classes with required methods and attributes
     class Asset:
         def __init__(self):
@@ -113,21 +133,54 @@ except ImportError as e:
 
         return MockContainer()
 
+    ACTION_APPROVE, ACTION_DELETE, ACTION_EDIT = "approve", "delete", "edit"
+    STATUS_WIP, STATUS_PENDING_REVIEW = "wip", "pending_review"
+    STATUS_APPROVED, STATUS_DEPRECATED = "approved", "deprecated"
+    STATUSES = (STATUS_WIP, STATUS_PENDING_REVIEW, STATUS_APPROVED, STATUS_DEPRECATED)
+    STATUS_LABELS = {state: state.replace("_", " ").title() for state in STATUSES}
+    STATUS_COLORS = {}  # Every asset is WIP without the core package
+
     IMPORTS_AVAILABLE = False
 
+# Above this many folders the library is refreshed by timestamp diffing instead of
+# watched (operating systems cap file watches per process)
+MAX_WATCHED_DIRECTORIES = 4000
+WATCH_SETTLE_MS = 1500  # Wait for a burst of file changes (a copy) to finish
+
+THUMBNAIL_ROLE = 0x0101  # Qt.UserRole + 1: thumbnail path of a list item
+DETAILS_LOADED_ROLE = 0x0102  # Qt.UserRole + 2: item was in view, its files were read
+VISIBLE_ITEMS_DELAY_MS = 40  # Look at what is in view this often while scrolling
+REPAINT_DELAY_MS = 16  # Repaint decoded thumbnails at most once a frame
+
+# Text of the colored status strip painted over thumbnails (WIP assets have none)
+STATUS_BADGE_TEXT = {
+    STATUS_PENDING_REVIEW: "REVIEW",
+    STATUS_APPROVED: "APPROVED",
+    STATUS_DEPRECATED: "DEPRECATED",
+}
+
 if PYSIDE_AVAILABLE and QWidget is not None:
 
     class DragEnabledAssetList(QListWidget):  # type: ignore
         """
         Custom QListWidget with drag support for Maya viewport
         Implements proper mime data for asset drag operations
+
+        Dropping on a viewport imports at the cursor; hold Ctrl to reference, Shift to instance
         """
 
+        viewport_resized = Signal()  # Other items may have come into view
+
         def __init__(self, parent=None):
             super().__init__(parent)
             self.setDragEnabled(True)
             self.setAcceptDrops(False)  # We only drag out, not drop in
 
+        def resizeEvent(self, event):  # type: ignore
+            """Let the library load items a larger view now shows"""
+            super().resizeEvent(event)
+            self.viewport_resized.emit()
+
         def startDrag(self, supportedActions):  # type: ignore
             """Override to provide custom drag behavior with asset file path"""
             item = self.currentItem()
@@ -156,15 +209,26 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Also set text representation
             mime_data.setText(str(asset.file_path))  # type: ignore
 
+            # Marks the drag as ours so the viewport drop callback places it at the cursor
+            from ...services.viewport_drop_service_impl import ASSET_DROP_MIME_TYPE
+
+            file_bytes = str(asset.file_path).encode("utf-8")
+            mime_data.setData(ASSET_DROP_MIME_TYPE, file_bytes)  # type: ignore
+
             print(f"[OK] Mime data prepared with file URL: {file_url.toString()}")
 
             # Create drag object
             drag = QDrag(self)  # type: ignore
             drag.setMimeData(mime_data)  # type: ignore
 
-            # Set drag icon (use the item's icon if available)
-            if item.icon():
-                drag.setPixmap(item.icon().pixmap(64, 64))  # type: ignore
+            # Set drag icon (the item's thumbnail if it has one)
+            thumbnail_path = item.data(THUMBNAIL_ROLE)  # type: ignore
+            if thumbnail_path:
+                from PySide6.QtGui import QPixmap
+
+                pixmap = QPixmap(str(thumbnail_path))
+                if not pixmap.isNull():
+                    drag.setPixmap(pixmap.scaled(64, 64, Qt.KeepAspectRatio))  # type: ignore
 
             # Execute drag
             result = drag.exec(Qt.CopyAction)  # type: ignore
@@ -193,6 +257,29 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             asset_double_clicked = Signal(Asset)  # type: ignore
             selection_changed = Signal(list)  # List[Asset]  # type: ignore
             asset_info_requested = Signal(Asset)  # type: ignore - Request to show asset info in panel
+            version_history_requested = Signal(Asset)  # type: ignore - Open version browser
+            thumbnails_requested = Signal(list)  # type: ignore - Regenerate for the selection
+            thumbnail_decoded = Signal()  # type: ignore - From decoder threads, queued to the UI
+            bundle_export_requested = Signal()  # type: ignore - Export the selection as a bundle
+            check_out_requested = Signal(Asset)  # type: ignore - Lock asset (multi-user mode)
+            check_in_requested = Signal(Asset)  # type: ignore - Unlock asset (multi-user mode)
+            reference_requested = Signal(Asset)  # type: ignore - Import as Maya reference
+            replace_reference_requested = Signal(Asset)  # type: ignore - Swap a scene reference
+            update_in_place_requested = Signal(Asset)  # type: ignore - Merge onto imported copies
+            pose_apply_requested = Signal(Asset, bool)  # type: ignore - Apply pose (mirrored)
+            material_assign_requested = Signal(Asset, bool)  # type: ignore - Assign (or import)
+            materialx_export_requested = Signal(Asset)  # type: ignore - Write preset's .mtlx
+            gltf_export_requested = Signal(Asset)  # type: ignore - Write the review glTF
+            light_rig_import_requested = Signal(Asset, bool)  # type: ignore - Replace (or add)
+            texture_set_apply_requested = Signal(Asset)  # type: ignore - Texture selected shader
+            assembly_import_requested = Signal(Asset, bool)  # type: ignore - Latest (or pinned)
+            assembly_update_requested = Signal(Asset)  # type: ignore - Pin children to latest
+            alembic_import_requested = Signal(Asset, str)  # type: ignore - Geometry or GPU cache
+            volume_import_requested = Signal(Asset, str)  # type: ignore - aiVolume or fluid
+            collections_changed = Signal(dict)  # type: ignore - Collections reloaded from database
+            depot_sync_requested = Signal(Asset)  # type: ignore - Sync to head or pinned change
+            depot_pin_requested = Signal(Asset)  # type: ignore - Pin to a depot changelist
+            promote_requested = Signal(Asset)  # type: ignore - Scratch asset into a library
             color_scheme_changed = Signal(
                 dict
             )  # Dict[str, QColor] - Emitted when color scheme is updated
@@ -262,10 +349,67 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Use string instead of Path to avoid type issues
             self._current_project_path: Optional[str] = None
 
+            # Multi-user mode - lock state is read from the shared library on refresh
+            from ...services.lock_service_impl import get_lock_service
+
+            self._lock_service = get_lock_service()
+            self._multi_user_mode = False
+
+            # Perforce mode - depot revisions are read in one fstat batch on refresh
+            from ...services.perforce_service_impl import get_perforce_service
+
+            self._source_control = get_perforce_service()
+            self._perforce_mode = False
+            self._depot_revisions: Dict[str, Any] = {}  # str(file path) -> DepotRevision
+
+            # Alembic caches show the frame range stored in their settings sidecar
+            from ...services.alembic_service_impl import get_alembic_service
+
+            self._alembic_service = get_alembic_service()
+
+            # Volumes show the frame range and grids of their descriptor
+            from ...services.volume_service_impl import get_volume_service
+
+            self._volume_service = get_volume_service()
+
+            # Assets referenced in the open scene at an older version -> badge tooltip
+            self._outdated_assets: Dict[str, str] = {}
+
+            # Assets with heavy publish steps still on the render farm -> badge tooltip
+            self._farm_jobs: Dict[str, str] = {}
+
+            # Scene assets outside the library's naming template -> reason, set on scan
+            from ...services.naming_template_service_impl import get_naming_template_service
+
+            self._naming_service = get_naming_template_service()
+            self._naming_violations: Dict[str, str] = {}
+
+            # Asset key -> metadata dict, refreshed from the library database
+            self._metadata_cache: Dict[str, Dict[str, Any]] = {}
+            from ...services.search_engine_impl import SearchIndex
+
+            self._search_index = SearchIndex()
+
+            # This artist's favorites (None without a library database) and everyone's ratings
+            from ...services.favorites_service_impl import get_favorites_service
+
+            self._favorites_service = get_favorites_service()
+            self._favorite_files: Optional[set] = None  # str(file path) of favorite assets
+            self._ratings: Dict[str, Any] = {}  # asset key -> AssetRating
+
+            # Collection name -> collection dict, loaded from the library database
+            self._collections: Dict[str, Dict[str, Any]] = {}
+
             # UI components
             self._search_input: Optional[QLineEdit] = None  # type: ignore
+            self._sort_combo: Optional[QComboBox] = None  # type: ignore
+            self._stat_sort = ""  # Geometry stat the All Assets list is sorted by, "" for none
+            self._status_filter = ""  # Review status All Assets is limited to, "" for all
+            self._show_context: Any = None  # ShowContext the lists are limited to, if any
             self._asset_list: Optional[QListWidget] = None  # type: ignore
             self._tab_widget: Optional[QTabWidget] = None  # type: ignore
+            self._poses_list: Optional[QListWidget] = None  # type: ignore
+            self._collections_widget: Any = None
 
             # Icon size control (mouse wheel zoom)
             self._icon_size = 64  # Default icon size
@@ -297,10 +441,44 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Load custom tags from config file
             self._load_custom_tags()
 
+            # Thumbnails in view and ahead of the scroll are decoded on worker threads into a
+            # bounded cache; the grid delegate paints only what is already decoded
+            from ...services.thumbnail_cache_impl import ThumbnailCache
+            from ...services.thumbnail_decoder_impl import ThumbnailDecoder
+
+            self._icon_cache = ThumbnailCache(self._decode_thumbnail)
+            self._thumbnail_decoder = ThumbnailDecoder(self._icon_cache)
+            self._thumbnail_decoder.add_decoded_callback(
+                lambda _path, _variant: self.thumbnail_decoded.emit()
+            )
+            self.thumbnail_decoded.connect(self._schedule_repaint)  # type: ignore
+            self.destroyed.connect(self._thumbnail_decoder.shutdown)  # type: ignore
+            self._scroll_positions: Dict[int, int] = {}  # id(list widget) -> scroll value
+            self._scroll_directions: Dict[int, int] = {}  # id(list widget) -> -1 up, 1 down
+            self._visible_items_timer = QTimer()  # type: ignore
+            self._visible_items_timer.setSingleShot(True)  # type: ignore
+            self._visible_items_timer.timeout.connect(self._load_visible_items)  # type: ignore
+            self._repaint_timer = QTimer()  # type: ignore
+            self._repaint_timer.setSingleShot(True)  # type: ignore
+            self._repaint_timer.timeout.connect(self._repaint_asset_lists)  # type: ignore
+
             # Setup UI
             self._create_ui()
             self._setup_connections()
 
+            # Library folder watcher - refreshes re-list only the folders it reports
+            from ...services.library_scan_service_impl import get_library_scan_service
+
+            self._scanner = get_library_scan_service()
+            self._watcher = QFileSystemWatcher()  # type: ignore
+            self._watcher.directoryChanged.connect(  # type: ignore
+                self._on_library_directory_changed
+            )
+            self._changed_directories: set = set()
+            self._watch_timer = QTimer()  # type: ignore
+            self._watch_timer.setSingleShot(True)  # type: ignore
+            self._watch_timer.timeout.connect(self._apply_library_changes)  # type: ignore
+
             # Auto-refresh timer
             self._refresh_timer = QTimer()  # type: ignore
             self._refresh_timer.timeout.connect(self._auto_refresh)  # type: ignore
@@ -518,29 +696,45 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
             # Tab widget for different views
             self._tab_widget = QTabWidget()  # type: ignore
+            self._tab_widget.currentChanged.connect(self._schedule_visible_items)  # type: ignore
             layout.addWidget(self._tab_widget)  # type: ignore
 
             # All Assets tab
             self._asset_list = self._create_asset_list()
-            self._tab_widget.addTab(self._asset_list, "All Assets")  # type: ignore
+            self._tab_widget.addTab(self._asset_list, tr("All Assets"))  # type: ignore
 
             # Recent Assets tab
             recent_list = self._create_asset_list()
-            self._tab_widget.addTab(recent_list, "Recent")  # type: ignore
+            self._tab_widget.addTab(recent_list, tr("Recent"))  # type: ignore
 
             # Favorites tab
             favorites_list = self._create_asset_list()
-            self._tab_widget.addTab(favorites_list, "Favorites")  # type: ignore
+            self._tab_widget.addTab(favorites_list, tr("Favorites"))  # type: ignore
+
+            # Poses tab - pose assets only, double-click to apply
+            self._poses_list = self._create_asset_list()
+            self._poses_list.setToolTip(  # type: ignore
+                tr("Double-click a pose to apply it with blend and mirror options")
+            )
+            self._tab_widget.addTab(self._poses_list, tr("Poses"))  # type: ignore
 
             # Collections tab with proper implementation
             from .collections_widget import CollectionsDisplayWidget
 
             collections_widget = CollectionsDisplayWidget(self)  # type: ignore
+            collections_widget.set_member_loader(self._load_collection_members)
             # Connect collection widget signals to library widget signals
             collections_widget.asset_selected.connect(self.asset_selected.emit)  # type: ignore
             collections_widget.asset_double_clicked.connect(self.asset_double_clicked.emit)  # type: ignore
             collections_widget.collection_manager_requested.connect(self._show_collection_manager)  # type: ignore
-            self._tab_widget.addTab(collections_widget, "Collections")  # type: ignore
+            collections_widget.collection_created.connect(self._save_collection)  # type: ignore
+            collections_widget.assets_dropped.connect(self._on_assets_dropped)  # type: ignore
+            self._collections_widget = collections_widget
+            self._tab_widget.addTab(collections_widget, tr("Collections"))  # type: ignore
+
+            # Hovering a drag over a tab switches to it, so assets can be dropped on Collections
+            self._tab_widget.tabBar().setChangeCurrentOnDrag(True)  # type: ignore
+            self._tab_widget.tabBar().setAcceptDrops(True)  # type: ignore
 
         def _create_search_section(self):  # type: ignore
             """Create search input section - Single Responsibility"""
@@ -548,21 +742,72 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
             # Search input
             self._search_input = QLineEdit()  # type: ignore
-            self._search_input.setPlaceholderText("Search assets...")  # type: ignore
+            self._search_input.setPlaceholderText(
+                tr("Search assets...  e.g. tag:vehicle AND author:mike -tag:wip")
+            )  # type: ignore
+            self._search_input.setToolTip(
+                tr(
+                    "Words match names, tags, and authors as you type.\n"
+                    "Filters: tag:  type:model|rig|texture|anim|pose|shape  author:  ext:  category:\n"
+                    "Dates: after:2024-01  before:2024-06-30  date:2024-03  updated:7d\n"
+                    "Geometry: tris:>100k  verts:<5000  uvsets:>1  texres:>=4096  skinned:yes\n"
+                    "Review: status:approved  status:review  status:deprecated  status:wip\n"
+                    "Operators: AND  OR  NOT  -term  ( )  \"exact phrase\"  is:favorite"
+                )
+            )  # type: ignore
+            self._search_input.setClearButtonEnabled(True)  # type: ignore
             search_layout.addWidget(self._search_input)  # type: ignore
 
             # Search button
-            search_btn = QPushButton("Search")  # type: ignore
-            search_btn.setToolTip("Search for assets by name or properties")  # type: ignore
+            search_btn = QPushButton(tr("Search"))  # type: ignore
+            search_btn.setToolTip(tr("Search for assets by name or properties"))  # type: ignore
             search_btn.clicked.connect(self._perform_search)  # type: ignore
             search_layout.addWidget(search_btn)  # type: ignore
 
             # Advanced search button
-            advanced_btn = QPushButton("Advanced...")  # type: ignore
-            advanced_btn.setToolTip("Open advanced search options and filters")  # type: ignore
+            advanced_btn = QPushButton(tr("Advanced..."))  # type: ignore
+            advanced_btn.setToolTip(tr("Open advanced search options and filters"))  # type: ignore
             advanced_btn.clicked.connect(self._show_advanced_search)  # type: ignore
             search_layout.addWidget(advanced_btn)  # type: ignore
 
+            # Sort by geometry stats captured at publish time
+            self._sort_combo = QComboBox()  # type: ignore
+            for label, filter_name in (
+                ("Library Order", ""),
+                ("Most Triangles", "tris"),
+                ("Most Vertices", "verts"),
+                ("Most UV Sets", "uvsets"),
+                ("Largest Textures", "texres"),
+                ("Largest Bounds", "bbox"),
+            ):
+                self._sort_combo.addItem(label, filter_name)  # type: ignore
+            self._sort_combo.setToolTip(  # type: ignore
+                tr("Sort All Assets by geometry stats; assets published without stats go last")
+            )
+            self._sort_combo.currentIndexChanged.connect(self._on_sort_changed)  # type: ignore
+            search_layout.addWidget(self._sort_combo)  # type: ignore
+
+            # Limit to one review status
+            self._status_combo = QComboBox()  # type: ignore
+            self._status_combo.addItem("All Statuses", "")  # type: ignore
+            for state in STATUSES:
+                self._status_combo.addItem(STATUS_LABELS[state], state)  # type: ignore
+            self._status_combo.setToolTip(
+                tr("Show only assets in one review status")
+            )  # type: ignore
+            self._status_combo.currentIndexChanged.connect(  # type: ignore
+                self._on_status_filter_changed
+            )
+            search_layout.addWidget(self._status_combo)  # type: ignore
+
+            # Limit to the current show - shown while the View menu's show filter is on
+            self._show_filter_btn = QPushButton()  # type: ignore
+            self._show_filter_btn.setCheckable(True)  # type: ignore
+            self._show_filter_btn.setChecked(True)  # type: ignore
+            self._show_filter_btn.setVisible(False)  # type: ignore
+            self._show_filter_btn.toggled.connect(self._on_show_filter_toggled)  # type: ignore
+            search_layout.addWidget(self._show_filter_btn)  # type: ignore
+
             return search_layout
 
         def _create_asset_list(self):  # type: ignore
@@ -570,7 +815,13 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             print(
                 "[LOOKDEV] Creating asset list widget with drag support and event connections..."
             )
+            from .thumbnail_grid_delegate import ThumbnailGridDelegate, enable_gpu_viewport
+
             asset_list = DragEnabledAssetList()  # Use custom drag-enabled list
+            enable_gpu_viewport(asset_list)
+            asset_list.setItemDelegate(  # type: ignore
+                ThumbnailGridDelegate(self._get_item_thumbnail_image, asset_list)
+            )
             asset_list.setViewMode(QListWidget.IconMode)  # type: ignore
             asset_list.setGridSize(QSize(80, 100))  # type: ignore
             asset_list.setIconSize(QSize(64, 64))  # type: ignore
@@ -578,6 +829,17 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             asset_list.setSelectionMode(QListWidget.ExtendedSelection)  # type: ignore
             # Drag mode is set in DragEnabledAssetList constructor
 
+            # Lay out big libraries in batches and read files only for items in view
+            asset_list.setUniformItemSizes(True)  # type: ignore
+            asset_list.setLayoutMode(QListWidget.Batched)  # type: ignore
+            asset_list.setBatchSize(200)  # type: ignore
+            scroll_bar = asset_list.verticalScrollBar()  # type: ignore
+            scroll_bar.valueChanged.connect(  # type: ignore
+                lambda value, view=asset_list: self._on_grid_scrolled(view, value)
+            )
+            scroll_bar.rangeChanged.connect(self._schedule_visible_items)  # type: ignore
+            asset_list.viewport_resized.connect(self._schedule_visible_items)  # type: ignore
+
             # Enable custom context menu
             asset_list.setContextMenuPolicy(Qt.CustomContextMenu)  # type: ignore
             asset_list.customContextMenuRequested.connect(self._show_context_menu)  # type: ignore
@@ -619,26 +881,225 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             if not all(hasattr(asset, attr) for attr in required_attrs):
                 return
 
+            # Right-clicking inside a multi-selection acts on the whole selection
+            if len(self._selected_assets) > 1 and asset in self._selected_assets:
+                self._show_batch_menu(sender, position)
+                return
+
             # Create context menu
             menu = QMenu(self)  # type: ignore
 
             # Import action
-            import_action = menu.addAction("Import Asset...")
-            import_action.setToolTip("Import selected asset(s) into Maya")
+            import_action = menu.addAction(tr("Import Asset..."))
+            import_action.setToolTip(tr("Import selected asset(s) into Maya"))
             import_action.triggered.connect(lambda: self._import_asset(asset))
 
+            # Poses are applied to a rig instead of imported
+            if asset.file_path.suffix.lower() == ".pose":
+                apply_pose_action = menu.addAction(tr("Apply Pose..."))
+                apply_pose_action.triggered.connect(lambda: self._import_asset(asset))
+                mirror_pose_action = menu.addAction(tr("Apply Mirrored"))
+                mirror_pose_action.setToolTip(tr("Apply to the opposite side at full strength"))
+                mirror_pose_action.triggered.connect(
+                    lambda: self.pose_apply_requested.emit(asset, True)
+                )
+                menu.addSeparator()
+
+            # Blendshape sets add their targets to a mesh with the same topology
+            if asset.file_path.suffix.lower() == ".blendshape":
+                apply_shapes_action = menu.addAction(tr("Apply to Selected Mesh"))
+                apply_shapes_action.setToolTip(
+                    tr("Add the targets as a new blendShape, or to the one mesh that matches")
+                )
+                apply_shapes_action.triggered.connect(lambda: self._import_asset(asset))
+                menu.addSeparator()
+
+            # Skin weights are transferred onto meshes by point order, position, or UV
+            if asset.file_path.suffix.lower() == ".skinweights":
+                apply_weights_action = menu.addAction(tr("Apply to Meshes..."))
+                apply_weights_action.setToolTip(
+                    tr("Skin the selected or same-named meshes, choosing how weights transfer")
+                )
+                apply_weights_action.triggered.connect(lambda: self._import_asset(asset))
+                menu.addSeparator()
+
+            # Material presets are assigned to the Maya selection
+            if asset.file_path.suffix.lower() == ".material":
+                assign_action = menu.addAction(tr("Assign to Selection"))
+                assign_action.setToolTip(tr("Assign the material to the selected meshes or faces"))
+                assign_action.triggered.connect(
+                    lambda: self.material_assign_requested.emit(asset, True)
+                )
+                import_material_action = menu.addAction(tr("Import Material Only"))
+                import_material_action.triggered.connect(
+                    lambda: self.material_assign_requested.emit(asset, False)
+                )
+                export_materialx_action = menu.addAction(tr("Export MaterialX Document"))
+                export_materialx_action.setToolTip(
+                    tr("Write the .mtlx of a Standard Surface preset saved without one")
+                )
+                export_materialx_action.triggered.connect(
+                    lambda: self.materialx_export_requested.emit(asset)
+                )
+                menu.addSeparator()
+
+            # MaterialX documents are built as Standard Surface networks
+            if asset.file_path.suffix.lower() == ".mtlx":
+                import_materialx_action = menu.addAction(tr("Import and Assign to Selection"))
+                import_materialx_action.triggered.connect(lambda: self._import_asset(asset))
+                menu.addSeparator()
+
+            # Light rigs replace the scene's active rig or load alongside it
+            if asset.file_path.suffix.lower() == ".lightrig":
+                replace_rig_action = menu.addAction(tr("Replace Active Light Rig"))
+                replace_rig_action.setToolTip(tr("Load the rig and delete the rigs loaded before"))
+                replace_rig_action.triggered.connect(
+                    lambda: self.light_rig_import_requested.emit(asset, True)
+                )
+                add_rig_action = menu.addAction(tr("Add Light Rig"))
+                add_rig_action.triggered.connect(
+                    lambda: self.light_rig_import_requested.emit(asset, False)
+                )
+                menu.addSeparator()
+
+            # Grooms are imported onto the scene meshes they grow from
+            if asset.file_path.suffix.lower() == ".groom":
+                bind_groom_action = menu.addAction(tr("Import and Bind to Mesh..."))
+                bind_groom_action.setToolTip(
+                    tr("Bind the groom to scene meshes, remapping when names or topology differ")
+                )
+                bind_groom_action.triggered.connect(lambda: self._import_asset(asset))
+                menu.addSeparator()
+
+            # Texture sets are wired into the shader of the Maya selection
+            if asset.file_path.suffix.lower() == ".texset":
+                apply_textures_action = menu.addAction(tr("Apply to Selected Material"))
+                apply_textures_action.setToolTip(
+                    tr("Connect the maps to the selected aiStandardSurface or standardSurface")
+                )
+                apply_textures_action.triggered.connect(
+                    lambda: self.texture_set_apply_requested.emit(asset)
+                )
+                menu.addSeparator()
+
+            # Assemblies load their pinned versions, or the latest of every child
+            if asset.file_path.suffix.lower() == ".assembly":
+                pinned_assembly_action = menu.addAction(tr("Import Pinned Versions"))
+                pinned_assembly_action.setToolTip(tr("Rebuild the layout as it was saved"))
+                pinned_assembly_action.triggered.connect(
+                    lambda: self.assembly_import_requested.emit(asset, False)
+                )
+                latest_assembly_action = menu.addAction(tr("Import Latest Versions"))
+                latest_assembly_action.setToolTip(
+                    tr("Rebuild the layout with the newest version of every asset")
+                )
+                latest_assembly_action.triggered.connect(
+                    lambda: self.assembly_import_requested.emit(asset, True)
+                )
+                update_assembly_action = menu.addAction(tr("Update Assembly to Latest..."))
+                update_assembly_action.setToolTip(
+                    tr("Pin the assembly's outdated assets to their latest versions")
+                )
+                update_assembly_action.triggered.connect(
+                    lambda: self.assembly_update_requested.emit(asset)
+                )
+                menu.addSeparator()
+
+            # Alembic caches load as editable geometry or as a fast-drawing GPU cache
+            if asset.file_path.suffix.lower() == ".abc":
+                from ...services.alembic_service_impl import (
+                    IMPORT_MODE_GEOMETRY,
+                    IMPORT_MODE_GPU_CACHE,
+                )
+
+                geometry_action = menu.addAction(tr("Import Full Geometry"))
+                geometry_action.setToolTip(tr("Import editable meshes animated by the cache"))
+                geometry_action.triggered.connect(
+                    lambda: self.alembic_import_requested.emit(asset, IMPORT_MODE_GEOMETRY)
+                )
+                gpu_cache_action = menu.addAction(tr("Import as GPU Cache"))
+                gpu_cache_action.setToolTip(
+                    tr("Draw the cache as one gpuCache node (not editable)")
+                )
+                gpu_cache_action.triggered.connect(
+                    lambda: self.alembic_import_requested.emit(asset, IMPORT_MODE_GPU_CACHE)
+                )
+                menu.addSeparator()
+
+            # Volumes load as an Arnold volume, or as a fluid container without Arnold
+            if asset.file_path.suffix.lower() == ".volume":
+                from ...services.volume_service_impl import (
+                    IMPORT_MODE_ARNOLD,
+                    IMPORT_MODE_FLUID,
+                )
+
+                arnold_action = menu.addAction(tr("Import as Arnold Volume"))
+                arnold_action.setToolTip(tr("Create an aiVolume reading the .vdb sequence"))
+                arnold_action.triggered.connect(
+                    lambda: self.volume_import_requested.emit(asset, IMPORT_MODE_ARNOLD)
+                )
+                fluid_action = menu.addAction(tr("Import as Fluid Container"))
+                fluid_action.setToolTip(
+                    tr("Create an empty fluid container standing in for the volume")
+                )
+                fluid_action.triggered.connect(
+                    lambda: self.volume_import_requested.emit(asset, IMPORT_MODE_FLUID)
+                )
+                menu.addSeparator()
+
+            reference_action = menu.addAction(tr("Import as Reference..."))
+            reference_action.setToolTip(
+                tr("Reference the asset instead of merging it into the scene")
+            )
+            reference_action.triggered.connect(lambda: self.reference_requested.emit(asset))
+
+            replace_action = menu.addAction(tr("Replace Reference with This..."))
+            replace_action.setToolTip(
+                tr("Point an existing scene reference at this asset or version")
+            )
+            replace_action.triggered.connect(lambda: self.replace_reference_requested.emit(asset))
+
+            # Maya scenes can be merged onto the copies already imported into the scene
+            if asset.file_path.suffix.lower() in (".ma", ".mb"):
+                update_in_place_action = menu.addAction(tr("Update in Place..."))
+                update_in_place_action.setToolTip(
+                    tr("Transfer this version onto its imported copies, keeping their rigging")
+                )
+                update_in_place_action.triggered.connect(
+                    lambda: self.update_in_place_requested.emit(asset)
+                )
+                export_gltf_action = menu.addAction(tr("Export glTF for Review"))
+                export_gltf_action.setToolTip(
+                    tr("Write a .glb next to the asset for the web viewer and AR review app")
+                )
+                export_gltf_action.triggered.connect(
+                    lambda: self.gltf_export_requested.emit(asset)
+                )
+
+            # Quick exports in the scratch library become curated assets when they are kept
+            from ...services.scratch_service_impl import get_scratch_service
+
+            if get_scratch_service().is_scratch(asset.file_path):
+                promote_action = menu.addAction(tr("Promote to Library..."))
+                promote_action.setToolTip(tr("Move this scratch asset into a curated library"))
+                promote_action.triggered.connect(lambda: self.promote_requested.emit(asset))
+
+            # Review workflow - artists submit, reviewers approve or deprecate
+            self._add_status_menu(menu, asset)
+
             # Remove asset action
-            remove_action = menu.addAction("Remove Asset...")
-            remove_action.setToolTip("Remove selected asset(s) from library")
+            remove_action = menu.addAction(tr("Remove Asset..."))
+            remove_action.setToolTip(tr("Remove selected asset(s) from library"))
+            remove_action.setEnabled(self._is_allowed(ACTION_DELETE))
             remove_action.triggered.connect(lambda: self._remove_asset(asset))
 
             # Separator for screenshot section
             menu.addSeparator()
 
             # Screenshot capture action with custom icon
-            screenshot_action = menu.addAction("Capture Screenshot")
+            screenshot_action = menu.addAction(tr("Capture Screenshot"))
             screenshot_action.setToolTip(
-                "Create custom captured screenshot from Maya's currently selected viewport"
+                tr("Create custom captured screenshot from Maya's currently selected viewport")
             )
             # Try to use custom screenshot icon
             try:
@@ -657,24 +1118,43 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Favorites actions - show both, enable/disable based on status
             is_favorite = getattr(asset, "is_favorite", False)
 
-            add_fav_action = menu.addAction("Add to Favorites")
+            add_fav_action = menu.addAction(tr("Add to Favorites"))
             add_fav_action.setEnabled(not is_favorite)
             add_fav_action.triggered.connect(lambda: self._add_to_favorites(asset))
             if is_favorite:
-                add_fav_action.setToolTip("Asset is already in favorites")
+                add_fav_action.setToolTip(tr("Asset is already in favorites"))
 
-            remove_fav_action = menu.addAction("Remove from Favorites")
+            remove_fav_action = menu.addAction(tr("Remove from Favorites"))
             remove_fav_action.setEnabled(is_favorite)
             remove_fav_action.triggered.connect(lambda: self._remove_from_favorites(asset))
             if not is_favorite:
-                remove_fav_action.setToolTip("Asset is not in favorites")
+                remove_fav_action.setToolTip(tr("Asset is not in favorites"))
+
+            # Rating submenu - one rating per artist, the list shows everyone's average
+            rating = self.get_asset_rating(asset)
+            if rating is not None:
+                from ...core.models.asset_rating import MAX_RATING
+
+                rate_menu = menu.addMenu(tr("Rate"))
+                rate_menu.setToolTip(rating.label)
+                for stars in range(MAX_RATING, 0, -1):
+                    star_action = rate_menu.addAction("★" * stars + "☆" * (MAX_RATING - stars))
+                    star_action.setCheckable(True)
+                    star_action.setChecked(rating.user_rating == stars)
+                    star_action.triggered.connect(
+                        lambda _checked=False, s=stars: self._rate_asset(asset, s)
+                    )
+                rate_menu.addSeparator()
+                clear_rating_action = rate_menu.addAction(tr("Clear My Rating"))
+                clear_rating_action.setEnabled(rating.user_rating > 0)
+                clear_rating_action.triggered.connect(lambda: self._rate_asset(asset, 0))
 
             # Separator
             menu.addSeparator()
 
             # Color Coding submenu - Synced with Asset Type Colors
-            color_menu = menu.addMenu("Color Coding")
-            color_menu.setToolTip("Apply asset type colors for visual organization")
+            color_menu = menu.addMenu(tr("Color Coding"))
+            color_menu.setToolTip(tr("Apply asset type colors for visual organization"))
 
             # Add asset type color options using the class dictionary
             # Note: Color descriptions removed - colors are now dynamically managed via Color Manager
@@ -689,56 +1169,83 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     )
 
             color_menu.addSeparator()
-            action = color_menu.addAction("Clear Color")
+            action = color_menu.addAction(tr("Clear Color"))
             if action is not None:
                 action.triggered.connect(lambda: self._clear_asset_color(asset))
-            action = color_menu.addAction("Manage Colors...")
+            action = color_menu.addAction(tr("Manage Colors..."))
             if action is not None:
                 action.triggered.connect(self._open_color_manager)
 
             # Tag Manager submenu
-            tag_menu = menu.addMenu("Tags")
+            tag_menu = menu.addMenu(tr("Tags"))
 
             # Show current tags if any
             if hasattr(asset, "tags") and asset.tags:
                 tag_menu.addAction(f"Current Tags: {', '.join(asset.tags)}").setEnabled(False)
                 tag_menu.addSeparator()
 
-            tag_menu.addAction("Add Tag...").triggered.connect(
+            tag_menu.addAction(tr("Add Tag...")).triggered.connect(
                 lambda: self._add_tag_to_asset(asset)
             )
 
             # Only show remove option if asset has tags
             if hasattr(asset, "tags") and asset.tags:
-                tag_menu.addAction("Remove Tag...").triggered.connect(
+                tag_menu.addAction(tr("Remove Tag...")).triggered.connect(
                     lambda: self._remove_tags_from_asset(asset)
                 )
 
             tag_menu.addSeparator()
-            tag_menu.addAction("Manage Tags...").triggered.connect(self._open_tag_manager)
+            tag_menu.addAction(tr("Manage Tags...")).triggered.connect(self.open_tag_manager)
 
             # Collections submenu
-            collections_menu = menu.addMenu("Collections")
-            collections_menu.addAction("Add to Collection...").triggered.connect(
+            collections_menu = menu.addMenu(tr("Collections"))
+            collections_menu.addAction(tr("Add to Collection...")).triggered.connect(
                 lambda: self._add_to_collection(asset)
             )
-            collections_menu.addAction("New Collection...").triggered.connect(
+            collections_menu.addAction(tr("New Collection...")).triggered.connect(
                 self._create_new_collection
             )
             collections_menu.addSeparator()
-            collections_menu.addAction("Manage Collections...").triggered.connect(
+            collections_menu.addAction(tr("Manage Collections...")).triggered.connect(
                 self._open_collections_manager
             )
 
             # Separator
             menu.addSeparator()
 
+            # Version history action
+            history_action = menu.addAction(tr("Version History..."))
+            history_action.setToolTip(tr("Browse, import, or roll back published versions"))
+            history_action.triggered.connect(lambda: self.version_history_requested.emit(asset))
+
+            # Check-out / check-in actions for shared libraries
+            if self._multi_user_mode:
+                lock = self._lock_service.get_lock(asset.file_path)
+                if lock is None:
+                    check_out_action = menu.addAction(tr("Check Out"))
+                    check_out_action.triggered.connect(
+                        lambda: self.check_out_requested.emit(asset)
+                    )
+                else:
+                    check_in_action = menu.addAction(f"Check In ({lock.user})")
+                    check_in_action.triggered.connect(lambda: self.check_in_requested.emit(asset))
+
+            # Depot actions for libraries stored in Perforce
+            if self._perforce_mode:
+                depot_menu = menu.addMenu(tr("Perforce"))
+                depot_menu.addAction(tr("Sync")).triggered.connect(
+                    lambda: self.depot_sync_requested.emit(asset)
+                )
+                depot_menu.addAction(tr("Pin to Changelist...")).triggered.connect(
+                    lambda: self.depot_pin_requested.emit(asset)
+                )
+
             # Show in folder action
-            show_folder_action = menu.addAction("Show in Folder")
+            show_folder_action = menu.addAction(tr("Show in Folder"))
             show_folder_action.triggered.connect(lambda: self._show_in_folder(asset))
 
             # Properties action
-            properties_action = menu.addAction("Properties")
+            properties_action = menu.addAction(tr("Properties"))
             properties_action.triggered.connect(lambda: self._show_properties(asset))
 
             # Show menu at cursor position
@@ -750,69 +1257,365 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
         def _remove_asset(self, asset: Any) -> None:
             """Remove asset from library - Single Responsibility"""
+            if not self._check_permission(ACTION_DELETE):
+                return
             try:
                 from PySide6.QtWidgets import QMessageBox
 
                 # Confirm deletion
                 reply = QMessageBox.question(
                     self,
-                    "Remove Asset",
+                    tr("Remove Asset"),
                     f"Are you sure you want to remove '{asset.display_name}' from the library?\n\n"
-                    f"This will delete the asset file from disk and cannot be undone.",
+                    f"The asset moves to the library trash (File > Library Trash) with its "
+                    f"thumbnails and versions, where it can be restored.",
                     QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
                     QMessageBox.StandardButton.No,
                 )
 
                 if reply == QMessageBox.StandardButton.Yes:
-                    # Delete the file from disk
-                    import os
-                    from pathlib import Path
-
-                    file_path = (
-                        Path(asset.file_path)
-                        if isinstance(asset.file_path, str)
-                        else asset.file_path
-                    )
-                    if file_path.exists():
-                        os.remove(file_path)
-                        print(f"[DELETE] Deleted asset file: {file_path}")
-
-                        # Also remove thumbnail if it exists
-                        try:
-                            self._thumbnail_service.clear_cache_for_file(asset.file_path)
-                        except Exception as e:
-                            print(f"[WARNING] Could not clear thumbnail cache: {e}")
-
-                        # Refresh the library to update the display
-                        self.refresh_library()
-
-                        self._set_status(f"Removed asset: {asset.display_name}")
-
-                        QMessageBox.information(
+                    file_path = Path(asset.file_path)
+                    if not file_path.exists():
+                        QMessageBox.warning(
                             self,
-                            "Asset Removed",
-                            f"'{asset.display_name}' has been removed from the library.",
+                            tr("File Not Found"),
+                            f"The asset file could not be found:\n{file_path}",
                         )
-                    else:
+                        return
+                    if not self._trash_asset(asset):
                         QMessageBox.warning(
                             self,
-                            "File Not Found",
-                            f"The asset file could not be found:\n{file_path}",
+                            tr("Remove Failed"),
+                            f"The asset is not inside the loaded library:\n{file_path}",
                         )
+                        return
+
+                    # Refresh the library to update the display
+                    self.refresh_library()
+
+                    self._set_status(f"Removed asset: {asset.display_name}")
+
+                    QMessageBox.information(
+                        self,
+                        tr("Asset Removed"),
+                        f"'{asset.display_name}' has been moved to the library trash.",
+                    )
 
             except Exception as e:
                 from PySide6.QtWidgets import QMessageBox
 
                 print(f"[ERROR] Error removing asset: {e}")
-                QMessageBox.critical(self, "Error", f"Failed to remove asset:\n{str(e)}")
+                QMessageBox.critical(self, tr("Error"), f"Failed to remove asset:\n{str(e)}")
+
+        def _trash_asset(self, asset: Any) -> bool:
+            """Move an asset and everything stored for it into the library trash"""
+            from ...services.hook_service_impl import HOOK_ASSET_DELETED, get_hook_service
+            from ...services.trash_service_impl import get_trash_service
+
+            file_path = Path(asset.file_path)
+            library_root = self._current_project_path
+            db = self.get_metadata_database()
+            entry = get_trash_service().trash_asset(
+                Path(library_root) if library_root else file_path.parent,
+                file_path,
+                metadata=db.get_asset_metadata(file_path) if db else None,
+            )
+            if entry is None:
+                return False
+            if db:
+                db.remove_asset(file_path)
+
+            # Also remove thumbnail if it exists
+            try:
+                self._thumbnail_service.clear_cache_for_file(asset.file_path)
+            except Exception as e:
+                print(f"[WARNING] Could not clear thumbnail cache: {e}")
+
+            # Studio callbacks (e.g. ShotGrid status updates)
+            get_hook_service().run(
+                HOOK_ASSET_DELETED,
+                Path(library_root) if library_root else None,
+                asset_file=file_path,
+                asset_name=asset.display_name,
+                mode="trash",
+                trash_entry=entry.entry_id,
+            )
+            return True
+
+        def _show_batch_menu(self, sender: Any, position: Any) -> None:
+            """Show the actions that run on every selected asset at once"""
+            assets = list(self._selected_assets)
+            menu = QMenu(self)  # type: ignore
+            menu.addAction(tr("{count} assets selected", count=len(assets))).setEnabled(False)
+            menu.addSeparator()
+
+            can_edit = self._is_allowed(ACTION_EDIT)
+            add_tags_action = menu.addAction(tr("Add Tags..."))
+            add_tags_action.setEnabled(can_edit)
+            add_tags_action.triggered.connect(lambda: self._batch_add_tags(assets))
+            remove_tags_action = menu.addAction(tr("Remove Tags..."))
+            remove_tags_action.setEnabled(can_edit)
+            remove_tags_action.triggered.connect(lambda: self._batch_remove_tags(assets))
+
+            status_menu = menu.addMenu(tr("Change Status"))
+            for state in STATUSES:
+                status_menu.addAction(STATUS_LABELS[state]).triggered.connect(
+                    lambda _checked=False, s=state: self._batch_set_status(assets, s)
+                )
+
+            move_action = menu.addAction(tr("Move to Collection..."))
+            move_action.setToolTip(
+                tr("Add the assets to one collection and take them out of others")
+            )
+            move_action.triggered.connect(lambda: self._batch_move_to_collection(assets))
+            menu.addSeparator()
+
+            thumbnails_action = menu.addAction(tr("Regenerate Thumbnails..."))
+            thumbnails_action.triggered.connect(lambda: self.thumbnails_requested.emit(assets))
+            bundle_action = menu.addAction(tr("Export Bundle..."))
+            bundle_action.triggered.connect(self.bundle_export_requested.emit)
+            menu.addSeparator()
+
+            delete_action = menu.addAction(tr("Delete..."))
+            delete_action.setToolTip(tr("Move the selected assets to the library trash"))
+            delete_action.setEnabled(self._is_allowed(ACTION_DELETE))
+            delete_action.triggered.connect(lambda: self._batch_delete(assets))
+
+            menu.exec(sender.mapToGlobal(position))  # type: ignore
+
+        def _run_batch(self, action: str, assets: List[Any], operation: Any) -> Any:
+            """Run one operation over the assets behind a progress bar and report the result"""
+            from PySide6.QtWidgets import QApplication, QMessageBox, QProgressDialog
+
+            from ...core.models.batch_operation import BATCH_ACTION_LABELS
+            from ...services.asset_batch_service_impl import get_asset_batch_service
+
+            label = BATCH_ACTION_LABELS.get(action, action)
+            progress_dialog = QProgressDialog(label, tr("Stop"), 0, len(assets), self)
+            progress_dialog.setWindowTitle(tr("Batch Operation"))
+            progress_dialog.setWindowModality(Qt.WindowModality.WindowModal)  # type: ignore
+            progress_dialog.setMinimumDuration(0)
+
+            def progress(done: int, total: int) -> bool:
+                progress_dialog.setValue(done)
+                if done < total:
+                    progress_dialog.setLabelText(
+                        f"{label}: {assets[done].display_name} ({done + 1} of {total})"
+                    )
+                QApplication.processEvents()
+                return not progress_dialog.wasCanceled()
+
+            report = get_asset_batch_service().run(action, assets, operation, progress)
+            progress_dialog.close()
+
+            self._set_status(report.summary())
+            if not report.is_clean:
+                box = QMessageBox(self)
+                box.setIcon(QMessageBox.Icon.Warning)
+                box.setWindowTitle(tr("Batch Operation"))
+                box.setText(report.summary())
+                if report.failed:
+                    box.setDetailedText(
+                        "\n".join(f"{name}: {reason}" for name, reason in report.failed)
+                    )
+                box.exec()
+            return report
+
+        def _refresh_batch_assets(self, assets: List[Any]) -> None:
+            """Redraw assets changed by a batch and the info panel of the selection"""
+            for asset in assets:
+                self._refresh_asset_display(asset)
+            if self._selected_assets:
+                self.asset_selected.emit(self._selected_assets[0])
+
+        def _batch_add_tags(self, assets: List[Any]) -> None:
+            """Add tags to every selected asset"""
+            from PySide6.QtWidgets import QInputDialog
+
+            from ...core.models.batch_operation import BATCH_ADD_TAGS
+            from ...core.models.tag_hierarchy import parse_tags
+            from ...services.asset_batch_service_impl import get_asset_batch_service
+
+            if not self._check_permission(ACTION_EDIT):
+                return
+            text, ok = QInputDialog.getItem(
+                self,
+                tr("Add Tags"),
+                tr("Tags to add to {count} assets (separate with commas):", count=len(assets)),
+                self.get_known_tags(),
+                0,
+                True,
+            )
+            tags = parse_tags(text) if ok else []
+            if not tags:
+                return
+
+            service = get_asset_batch_service()
+
+            def add(asset: Any) -> bool:
+                if not service.add_tags(asset, tags):
+                    return False
+                self._save_asset_metadata(asset)
+                return True
+
+            self._run_batch(BATCH_ADD_TAGS, assets, add)
+            self._all_used_tags.update(service.get_tags(assets))
+            self._refresh_batch_assets(assets)
+
+        def _batch_remove_tags(self, assets: List[Any]) -> None:
+            """Remove a tag from every selected asset that has it"""
+            from PySide6.QtWidgets import QInputDialog, QMessageBox
+
+            from ...core.models.batch_operation import BATCH_REMOVE_TAGS
+            from ...services.asset_batch_service_impl import get_asset_batch_service
+
+            if not self._check_permission(ACTION_EDIT):
+                return
+            service = get_asset_batch_service()
+            used_tags = service.get_tags(assets)
+            if not used_tags:
+                QMessageBox.information(
+                    self, tr("No Tags"), tr("None of the selected assets have tags.")
+                )
+                return
+            tag, ok = QInputDialog.getItem(
+                self,
+                tr("Remove Tags"),
+                tr("Tag to remove from {count} assets:", count=len(assets)),
+                used_tags,
+                0,
+                False,
+            )
+            if not ok or not tag:
+                return
+
+            def remove(asset: Any) -> bool:
+                if not service.remove_tags(asset, [tag]):
+                    return False
+                self._save_asset_metadata(asset)
+                return True
+
+            self._run_batch(BATCH_REMOVE_TAGS, assets, remove)
+            self._refresh_batch_assets(assets)
+
+        def _batch_set_status(self, assets: List[Any], state: str) -> None:
+            """Move every selected asset to one review state"""
+            from PySide6.QtWidgets import QMessageBox
+
+            from ...core.models.batch_operation import BATCH_SET_STATUS
+            from ...services.asset_status_service_impl import get_asset_status_service
+
+            reply = QMessageBox.question(
+                self,
+                tr("Change Status"),
+                tr(
+                    "Set the status of {count} assets to {status}?",
+                    count=len(assets),
+                    status=STATUS_LABELS[state],
+                ),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+            service = get_asset_status_service()
+
+            def set_status(asset: Any) -> bool:
+                if service.get_status(getattr(asset, "metadata", None)).state == state:
+                    return False
+                self._apply_status(asset, state)
+                return True
+
+            self._run_batch(BATCH_SET_STATUS, assets, set_status)
+            if self._selected_assets:
+                self.asset_selected.emit(self._selected_assets[0])
+
+        def _batch_move_to_collection(self, assets: List[Any]) -> None:
+            """Move every selected asset into one manual collection"""
+            from PySide6.QtWidgets import QInputDialog, QMessageBox
+
+            from ...core.models.batch_operation import BATCH_MOVE_TO_COLLECTION
+            from ...services.collection_service_impl import (
+                get_collection_service,
+                is_smart_collection,
+            )
+
+            names = sorted(
+                name for name, data in self._collections.items() if not is_smart_collection(data)
+            )
+            if not names:
+                QMessageBox.information(
+                    self,
+                    tr("No Collections"),
+                    tr("Create a collection first (Collections > New Collection...)."),
+                )
+                return
+            target, ok = QInputDialog.getItem(
+                self,
+                tr("Move to Collection"),
+                tr(
+                    "Move {count} assets to the collection (and out of other collections):",
+                    count=len(assets),
+                ),
+                names,
+                0,
+                False,
+            )
+            if not ok or not target:
+                return
+
+            service = get_collection_service()
+            changed_collections: List[str] = []
+
+            def move(asset: Any) -> bool:
+                changed = service.move_members(
+                    self._collections, [self._get_asset_key(asset)], target
+                )
+                changed_collections.extend(n for n in changed if n not in changed_collections)
+                return bool(changed)
+
+            self._run_batch(BATCH_MOVE_TO_COLLECTION, assets, move)
+            for name in changed_collections:
+                self._save_collection(name)
+
+        def _batch_delete(self, assets: List[Any]) -> None:
+            """Move every selected asset to the library trash"""
+            from PySide6.QtWidgets import QMessageBox
+
+            from ...core.models.batch_operation import BATCH_DELETE
+
+            if not self._check_permission(ACTION_DELETE):
+                return
+            reply = QMessageBox.question(
+                self,
+                tr("Delete Assets"),
+                tr(
+                    "Move {count} assets to the library trash?\n\n"
+                    "They can be restored from File > Library Trash.",
+                    count=len(assets),
+                ),
+                QMessageBox.StandardButton.Yes | QMessageBox.StandardButton.No,
+                QMessageBox.StandardButton.No,
+            )
+            if reply != QMessageBox.StandardButton.Yes:
+                return
+
+            def delete(asset: Any) -> bool:
+                if not Path(asset.file_path).exists():
+                    raise FileNotFoundError(f"File not found: {asset.file_path}")
+                if not self._trash_asset(asset):
+                    raise ValueError("Not inside the loaded library")
+                return True
+
+            report = self._run_batch(BATCH_DELETE, assets, delete)
+            if report.changed:
+                self.refresh_library()
 
         def _add_to_favorites(self, asset: Any) -> None:
             """Add asset to favorites - Single Responsibility"""
             self._repository.add_to_favorites(asset)
-
-            # Persist favorite status to metadata file
-            asset.is_favorite = True
-            self._save_asset_metadata(asset)
+            self._set_user_favorite(asset, True)
 
             # Refresh the Favorites tab to show the newly added asset
             self._load_favorite_assets()
@@ -822,15 +1625,12 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 self.asset_selected.emit(asset)
 
             self._set_status(f"[FAVORITE] Added to favorites: {asset.name}")
-            print(f"[FAVORITE] Added '{asset.display_name}' to favorites and saved to metadata")
+            print(f"[FAVORITE] Added '{asset.display_name}' to favorites")
 
         def _remove_from_favorites(self, asset: Any) -> None:
             """Remove asset from favorites - Single Responsibility"""
             self._repository.remove_from_favorites(asset)
-
-            # Persist favorite status to metadata file
-            asset.is_favorite = False
-            self._save_asset_metadata(asset)
+            self._set_user_favorite(asset, False)
 
             # Refresh the Favorites tab to remove the asset
             self._load_favorite_assets()
@@ -840,12 +1640,55 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 self.asset_selected.emit(asset)
 
             self._set_status(f"[FAVORITE] Removed from favorites: {asset.name}")
-            print(
-                f"[FAVORITE] Removed '{asset.display_name}' from favorites and saved to metadata"
+            print(f"[FAVORITE] Removed '{asset.display_name}' from favorites")
+
+        def _set_user_favorite(self, asset: Any, favorite: bool) -> None:
+            """Store a favorite for this artist - sidecar metadata without a library database"""
+            asset.is_favorite = favorite
+            if self._favorite_files is None:
+                self._save_asset_metadata(asset)
+                return
+            self._favorites_service.set_favorite(
+                Path(self._current_project_path), asset.file_path, favorite
             )
+            if favorite:
+                self._favorite_files.add(str(asset.file_path))
+            else:
+                self._favorite_files.discard(str(asset.file_path))
+            self._refresh_asset_display(asset)
+
+        def _rate_asset(self, asset: Any, stars: int) -> None:
+            """Store this artist's star rating of an asset - Single Responsibility"""
+            database = self.get_metadata_database()
+            if database is None:
+                self._set_status(tr("Ratings need a library database"))
+                return
+            try:
+                rating = self._favorites_service.rate(
+                    Path(self._current_project_path), asset.file_path, stars
+                )
+            except Exception as e:
+                print(f"[ERROR] Failed to rate asset: {e}")
+                return
+            self._ratings[database.get_asset_key(asset.file_path)] = rating
+            self._refresh_asset_display(asset)
+            if asset in self._selected_assets:
+                self.asset_selected.emit(asset)
+            self._set_status(f"[RATING] {asset.name}: {rating.label}")
+
+        def get_asset_rating(self, asset: Any) -> Any:
+            """Get an asset's rating averaged over every artist (None without a database)"""
+            database = self.get_metadata_database()
+            if database is None:
+                return None
+            from ...core.models.asset_rating import AssetRating
+
+            return self._ratings.get(database.get_asset_key(asset.file_path), AssetRating())
 
         def _capture_screenshot(self, asset: Any) -> None:
             """Capture screenshot for asset - Single Responsibility"""
+            if not self._check_permission(ACTION_EDIT):
+                return
             try:
                 asset_name = asset.display_name if hasattr(asset, "display_name") else "Unknown"
                 print(f"[CAMERA] Opening screenshot dialog for: {asset_name}")
@@ -868,6 +1711,9 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                             except Exception as cache_error:
                                 print(f"[WARNING] Could not clear cache: {cache_error}")
 
+                        # The capture went through the open scene's view transform
+                        self.record_thumbnail_color(asset.file_path)
+
                         # Update thumbnail for just this specific asset across all list views
                         # This is more efficient than refreshing the entire library
                         print(f"[REFRESH] Refreshing thumbnail for: {asset.display_name}")
@@ -888,6 +1734,25 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 print(f"Traceback:\n{traceback.format_exc()}")
                 self._set_status(f"Screenshot error: {e}")
 
+        def record_thumbnail_color(self, asset_path: Any) -> None:
+            """Remember the open scene's view transform for a thumbnail captured in Maya"""
+            database = self.get_metadata_database()
+            if database is None:
+                return
+            try:
+                import maya.cmds as cmds  # type: ignore
+
+                from ...services.color_management_service_impl import (
+                    get_color_management_service,
+                )
+
+                service = get_color_management_service()
+                service.record_thumbnail_transform(
+                    database, Path(asset_path), service.read_scene_transform(cmds)
+                )
+            except Exception as e:
+                print(f"[WARNING] Thumbnail color management not recorded: {e}")
+
         def _get_screenshot_icon_path(self) -> Optional[str]:
             """Get the path to the custom screenshot icon - Single Responsibility"""
             try:
@@ -1023,6 +1888,8 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                             widget.setIconSize(new_icon_size)  # type: ignore
                             widget.setGridSize(new_grid_size)  # type: ignore
 
+                # Thumbnails are decoded again at the new size
+                self._schedule_visible_items()
                 print(f"🔍 Icon size adjusted to {self._icon_size}px")
 
             except Exception as e:
@@ -1112,6 +1979,9 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 ("ID", getattr(asset, "id", "Unknown")),
                 ("Is Favorite", "Yes" if getattr(asset, "is_favorite", False) else "No"),
             ]
+            rating = self.get_asset_rating(asset)
+            if rating is not None:
+                properties.append(("Rating", rating.label))
 
             # Add file-specific properties if file exists
             try:
@@ -1146,7 +2016,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Add description/notes section if available
             description = getattr(asset, "description", "") or getattr(asset, "notes", "")
             if description:
-                layout.addWidget(QLabel("Description:"))
+                layout.addWidget(QLabel(tr("Description:")))
                 desc_text = QTextEdit()
                 desc_text.setPlainText(description)
                 desc_text.setMaximumHeight(100)
@@ -1156,7 +2026,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             # Add tags if available
             tags = getattr(asset, "tags", [])
             if tags:
-                tags_label = QLabel("Tags:")
+                tags_label = QLabel(tr("Tags:"))
                 layout.addWidget(tags_label)
                 tags_text = QLabel(", ".join(tags) if tags else "None")
                 tag_style = (
@@ -1172,7 +2042,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             button_layout.addStretch()
 
             # OK button
-            ok_button = QPushButton("OK")
+            ok_button = QPushButton(tr("OK"))
             ok_button.clicked.connect(dialog.accept)
             button_layout.addWidget(ok_button)
 
@@ -1188,25 +2058,62 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
         def load_project(self, project_path: Any) -> None:
             """Load assets from project directory - Single Responsibility"""
+            if self._current_project_path and project_path != self._current_project_path:
+                self._stop_library_watch()
             self._current_project_path = project_path
             self.refresh_library()
 
-        def refresh_library(self) -> None:
-            """Refresh asset library - Single Responsibility"""
+        def refresh_library(self, full_scan: bool = False) -> None:
+            """
+            Refresh asset library - Single Responsibility
+
+            Args:
+                full_scan: Compare every folder with the scan cache instead of only the
+                    folders the watcher reported (manual refresh)
+            """
             if not self._current_project_path:
                 return
 
             try:
+                if full_scan:
+                    self._scanner.request_full_scan(Path(self._current_project_path))
+
                 # Load assets from repository
                 assets = self._repository.find_all(self._current_project_path)
+                self._update_library_watch()
                 self._current_assets = assets
 
+                # Flagged, not hidden - existing assets keep working until they are moved
+                self._naming_violations = self._naming_service.find_violations(
+                    Path(self._current_project_path), [asset.file_path for asset in assets]
+                )
+                if self._naming_violations:
+                    print(
+                        f"[WARNING] {len(self._naming_violations)} asset(s) do not follow "
+                        "the library naming template"
+                    )
+
+                # One database query for the whole library instead of a JSON read per asset
+                database = self.get_metadata_database()
+                self._metadata_cache = database.get_all_metadata() if database else {}
+                self._load_favorites_and_ratings(database)
+                self._load_depot_revisions(assets)
+
                 # Update main asset list
                 self._populate_asset_list(self._asset_list, assets)
 
-                # Load recent and favorites
+                # Index after metadata is loaded so tags and favorites are searchable
+                self._rebuild_search_index(assets, database)
+                if self._search_input and self._search_input.text().strip():
+                    self._perform_search()
+
+                # Load recent, favorites, and poses
                 self._load_recent_assets()
                 self._load_favorite_assets()
+                self._load_pose_assets()
+
+                # Smart collections re-run their queries against the new index
+                self.reload_collections()
 
                 # Publish library refreshed event with asset count
                 self._event_publisher.publish(
@@ -1225,78 +2132,60 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             list_widget.clear()  # type: ignore
             print(f"[REFRESH] Populating asset list with {len(assets) if assets else 0} assets")
 
+            # Stat sorting needs every asset's stored stats before items are laid out
+            if list_widget is self._asset_list and self._stat_sort and assets:
+                from ...services.geometry_stats_service_impl import get_geometry_stats_service
+
+                for asset in assets:
+                    self._load_asset_metadata(asset)
+                assets = get_geometry_stats_service().sort_assets(assets, self._stat_sort)
+
+            # So does limiting the list to one review status
+            if list_widget is self._asset_list and self._status_filter and assets:
+                from ...services.asset_status_service_impl import get_asset_status_service
+
+                for asset in assets:
+                    self._load_asset_metadata(asset)
+                assets = get_asset_status_service().filter_assets(assets, self._status_filter)
+
+            # Every list hides assets of other shows while the show filter is on
+            if self._is_show_filtering() and assets:
+                from ...services.show_context_service_impl import get_show_context_service
+
+                for asset in assets:
+                    self._load_asset_metadata(asset)
+                assets = get_show_context_service().filter_assets(assets, self._show_context)
+
             # Use a set to track asset IDs to prevent duplicates
             seen_asset_ids = set()
 
-            for i, asset in enumerate(assets):
+            for asset in assets:
                 # Skip if we've already added this asset
                 if asset.id in seen_asset_ids:
                     continue
 
                 seen_asset_ids.add(asset.id)
 
-                # Load metadata (tags, favorites, etc.) from sidecar file
+                # Metadata comes from the library database loaded once per refresh
                 self._load_asset_metadata(asset)
+                # Favorites are per artist, so the shared metadata flag is overridden
+                if self._favorite_files is not None:
+                    asset.is_favorite = str(asset.file_path) in self._favorite_files
 
+                # Lock, cache, and thumbnail files are read once the item scrolls into view
                 item = QListWidgetItem()  # type: ignore
-
-                # Build display name with tag indicator
-                display_text = asset.display_name
-                tooltip_text = asset.display_name
-
-                if hasattr(asset, "tags") and asset.tags:
-                    # Add tag indicator
-                    tag_count = len(asset.tags)
-                    display_text = f"{display_text} [TAG]×{tag_count}"
-
-                    # Add tag list to tooltip
-                    tags_str = ", ".join(asset.tags)
-                    tooltip_text = f"{asset.display_name}\n\nTags: {tags_str}"
-
-                item.setText(display_text)  # type: ignore
-                item.setToolTip(tooltip_text)  # type: ignore
                 item.setData(Qt.UserRole, asset)  # type: ignore
-
-                print(f"[REFRESH] Adding asset [{i+1}]: {asset.display_name}")
-                print(f"   ID: {asset.id}")
-                if hasattr(asset, "file_path") and asset.file_path:
-                    from pathlib import Path as PathLib
-
-                    file_exists = PathLib(asset.file_path).exists()
-                    print(f"   Path: {asset.file_path}")
-                    print(f"   Exists: {file_exists}")
-                else:
-                    print("   Path: N/A")
+                self._update_item_text(item, asset)
 
                 # Apply color coding if asset has color
                 asset_color = self._asset_colors.get(asset.id)
                 if asset_color:
                     self._apply_color_coding(item, asset_color)
 
-                # Set thumbnail if available - FIX: Include size parameter for proper cache lookup
-                icon_size = (64, 64)  # Match the QListWidget icon size
-                thumbnail_path = self._thumbnail_service.get_cached_thumbnail(
-                    asset.file_path, size=icon_size
-                )  # type: ignore
-                if thumbnail_path:
-                    try:
-                        icon = QIcon(thumbnail_path)  # type: ignore
-                        item.setIcon(icon)  # type: ignore
-                        print(f"[THUMB] Loaded cached thumbnail for: {asset.display_name}")
-                    except Exception as e:
-                        print(
-                            f"[ERROR] Failed to load thumbnail icon for {asset.display_name}: {e}"
-                        )
-                        # Generate thumbnail in background if icon loading fails
-                        self._generate_thumbnail_async(asset, item, icon_size)
-                else:
-                    print(f"[THUMB] No cached thumbnail for {asset.display_name}, generating...")
-                    # Generate thumbnail in background
-                    self._generate_thumbnail_async(asset, item, icon_size)
-
                 list_widget.addItem(item)  # type: ignore
 
             print(f"[OK] Populated asset list with {len(seen_asset_ids)} unique assets")
+            self._schedule_visible_items()
 
         def _generate_thumbnail_async(self, asset: Asset, item, size: tuple = (64, 64)) -> None:  # type: ignore
             """Generate thumbnail asynchronously - Non-blocking UI with proper size parameter"""
@@ -1311,6 +2200,8 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     )  # type: ignore
                     if thumbnail_path:
                         print(f"[OK] Async thumbnail path received: {thumbnail_path}")
+                        # A regenerated file may still be decoded under the same path
+                        self._icon_cache.discard(Path(thumbnail_path))
                         # Update UI on main thread
                         QTimer.singleShot(0, lambda: self._set_item_thumbnail(item, thumbnail_path))  # type: ignore
                         print(
@@ -1341,13 +2232,9 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     print(f"[ERROR] Thumbnail file does not exist: {thumbnail_path}")
                     return
 
-                icon = QIcon(thumbnail_path)  # type: ignore
-                if icon.isNull():
-                    print(f"[ERROR] Failed to create QIcon from: {thumbnail_path}")
-                    return
-
-                item.setIcon(icon)  # type: ignore
-                print(f"[OK] Set thumbnail icon for item: {thumbnail_path}")
+                # The grid delegate paints it once a decoder thread has read it
+                item.setData(THUMBNAIL_ROLE, str(thumbnail_path))  # type: ignore
+                self._schedule_visible_items()
 
             except Exception as e:
                 print(f"[ERROR] Error setting thumbnail icon: {e}")
@@ -1355,10 +2242,265 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
                 traceback.print_exc()
 
+        def _get_asset_status(self, asset: Any) -> Any:
+            """Get the review status stored with an asset's metadata"""
+            from ...services.asset_status_service_impl import get_asset_status_service
+
+            return get_asset_status_service().get_status(getattr(asset, "metadata", None))
+
+        def _get_thumbnail_variant(self, asset: Any) -> str:
+            """Get the cache variant of an asset's thumbnail: grid icon size and status"""
+            return f"{self._icon_size}:{self._get_asset_status(asset).state}"
+
+        def _get_item_thumbnail_image(self, index: Any) -> Any:
+            """Get an item's decoded thumbnail for the grid delegate, None while decoding"""
+            thumbnail_path = index.data(THUMBNAIL_ROLE)
+            asset = index.data(Qt.UserRole)  # type: ignore
+            if not thumbnail_path or asset is None:
+                return None
+            return self._icon_cache.peek(Path(thumbnail_path), self._get_thumbnail_variant(asset))
+
+        def _decode_thumbnail(self, thumbnail_path: Path, variant: str) -> Any:
+            """
+            Decode a thumbnail for the cache, at grid size with the status strip painted on
+
+            Runs on decoder threads, so only QImage is used; QPixmap is UI thread only.
+            The variant is "<icon size>:<status>" as _get_thumbnail_variant makes it.
+            """
+            from PySide6.QtCore import QRect
+            from PySide6.QtGui import QGuiApplication, QImageReader, QPainter
+
+            size_text, _, state = variant.partition(":")
+            reader = QImageReader(str(thumbnail_path))
+            # Decoded at the size it is shown, so big screenshots stay small in the cache
+            app = QGuiApplication.instance()
+            ratio = app.devicePixelRatio() if app is not None else 1
+            source_size = reader.size()
+            if size_text.isdigit() and source_size.isValid():
+                target = int(int(size_text) * ratio)
+                source_size.scale(target, target, Qt.KeepAspectRatio)  # type: ignore
+                reader.setScaledSize(source_size)
+            image = reader.read()
+            if image.isNull():
+                return None
+            image.setDevicePixelRatio(ratio)
+            badge_text = STATUS_BADGE_TEXT.get(state)
+            if not badge_text:
+                return image
+
+            strip_height = max(image.height() // 5, 10)
+            strip = QRect(0, image.height() - strip_height, image.width(), strip_height)
+            painter = QPainter(image)
+            painter.fillRect(strip, QColor(STATUS_COLORS[state]))
+            font = painter.font()
+            font.setBold(True)
+            font.setPixelSize(max(strip_height - 4, 7))
+            painter.setFont(font)
+            painter.setPen(QColor("white"))
+            painter.drawText(strip, Qt.AlignCenter, badge_text)  # type: ignore
+            painter.end()
+            return image
+
+        def _update_item_text(self, item: Any, asset: Any) -> None:
+            """Set an item's badges and tooltip; file-based badges once it has been shown"""
+            details_loaded = bool(item.data(DETAILS_LOADED_ROLE))  # type: ignore
+            display_text = asset.display_name
+            tooltip_text = asset.display_name
+
+            # Star this artist's favorites and show the average rating
+            if getattr(asset, "is_favorite", False):
+                display_text = f"★ {display_text}"
+            rating = self.get_asset_rating(asset)
+            if rating is not None and rating.is_rated:
+                display_text = f"{display_text} [{rating.average:.1f}★]"
+
+            if hasattr(asset, "tags") and asset.tags:
+                display_text = f"{display_text} [TAG]×{len(asset.tags)}"
+                tooltip_text = f"{asset.display_name}\n\nTags: {', '.join(asset.tags)}"
+
+            # Add lock indicator in multi-user mode
+            if self._multi_user_mode and details_loaded:
+                lock = self._lock_service.get_lock(asset.file_path)
+                if lock is not None:
+                    display_text = f"{display_text} [LOCKED:{lock.user}]"
+                    tooltip_text = f"{tooltip_text}\n\nChecked out by {lock.description}"
+
+            # Add depot revision in Perforce mode (#have/#head)
+            revision = self._depot_revisions.get(str(asset.file_path))
+            if revision is not None:
+                display_text = f"{display_text} [{revision.label}]"
+                tooltip_text = f"{tooltip_text}\n\nDepot: {revision.description}"
+
+            # Add outdated badge for assets the open scene references at an older version
+            outdated = self._outdated_assets.get(str(asset.file_path))
+            if outdated is not None:
+                display_text = f"{display_text} [OUTDATED]"
+                tooltip_text = f"{tooltip_text}\n\nIn scene: {outdated}"
+
+            # Add processing badge while the render farm works on the asset
+            farm_jobs = self._farm_jobs.get(str(asset.file_path))
+            if farm_jobs is not None:
+                display_text = f"{display_text} [PROCESSING]"
+                tooltip_text = f"{tooltip_text}\n\nOn the farm: {farm_jobs}"
+
+            # Add naming badge for assets outside the library's naming template
+            violation = self._naming_violations.get(str(asset.file_path))
+            if violation is not None:
+                display_text = f"{display_text} [NAMING]"
+                tooltip_text = f"{tooltip_text}\n\nNaming: {violation}"
+
+            # Add review status; deprecated assets are flagged in list view too
+            status = self._get_asset_status(asset)
+            if status.is_deprecated:
+                display_text = f"{display_text} [DEPRECATED]"
+            if status.state != STATUS_WIP:
+                tooltip_text = f"{tooltip_text}\n\nStatus: {status.description}"
+
+            if rating is not None and rating.is_rated:
+                mine = f" (yours: {rating.user_rating})" if rating.user_rating else ""
+                tooltip_text = f"{tooltip_text}\n\nRating: {rating.label}{mine}"
+
+            # Add geometry stats captured at publish time
+            stats = (getattr(asset, "metadata", None) or {}).get("stats")
+            if isinstance(stats, dict):
+                tooltip_text = (
+                    f"{tooltip_text}\n\nGeometry: {stats.get('triangles', 0):,} tris, "
+                    f"{stats.get('vertices', 0):,} verts"
+                )
+
+            # Add frame range of Alembic caches published with settings
+            if details_loaded and asset.file_path.suffix.lower() == ".abc":
+                cache_info = self._alembic_service.read_cache_info(asset.file_path)
+                if cache_info is not None:
+                    display_text = f"{display_text} [{cache_info.label}]"
+                    tooltip_text = f"{tooltip_text}\n\nAlembic: {cache_info.description}"
+
+            # Add frame range and grids of volumes
+            if details_loaded and asset.file_path.suffix.lower() == ".volume":
+                volume = self._volume_service.load_volume(asset.file_path)
+                if volume is not None:
+                    tooltip_text = (
+                        f"{tooltip_text}\n\nVolume: {self._volume_service.describe(volume)}"
+                    )
+
+            item.setText(display_text)  # type: ignore
+            item.setToolTip(tooltip_text)  # type: ignore
+
+        def _get_asset_list_widgets(self) -> List[Any]:
+            """Get every asset grid (All Assets, Recent, Favorites, Poses)"""
+            list_widgets = [self._asset_list, self._poses_list]
+            if self._tab_widget:
+                list_widgets += [self._tab_widget.widget(1), self._tab_widget.widget(2)]
+            return [widget for widget in list_widgets if isinstance(widget, QListWidget)]
+
+        def _schedule_visible_items(self, *_args: Any) -> None:
+            """Load newly visible items, at most once per interval while scrolling"""
+            if not self._visible_items_timer.isActive():  # type: ignore
+                self._visible_items_timer.start(VISIBLE_ITEMS_DELAY_MS)  # type: ignore
+
+        def _on_grid_scrolled(self, list_widget: Any, value: int) -> None:
+            """Remember which way a grid scrolls, so thumbnails ahead of it are prefetched"""
+            previous = self._scroll_positions.get(id(list_widget), value)
+            if value != previous:
+                self._scroll_directions[id(list_widget)] = 1 if value > previous else -1
+            self._scroll_positions[id(list_widget)] = value
+            self._schedule_visible_items()
+
+        def _schedule_repaint(self) -> None:
+            """Repaint the grids once decoded thumbnails stop arriving for a frame"""
+            if not self._repaint_timer.isActive():  # type: ignore
+                self._repaint_timer.start(REPAINT_DELAY_MS)  # type: ignore
+
+        def _repaint_asset_lists(self) -> None:
+            """Repaint the visible grids with the thumbnails decoded since the last frame"""
+            for list_widget in self._get_asset_list_widgets():
+                if list_widget.isVisible():
+                    list_widget.viewport().update()  # type: ignore
+
+        def _get_visible_rows(self, list_widget: Any) -> Optional[Any]:
+            """Get the first and last row of a grid in view, None when it shows none"""
+            from ...services.thumbnail_decoder_impl import find_visible_rows
+
+            def edge(row: int, bottom: bool) -> int:
+                rect = list_widget.visualItemRect(list_widget.item(row))  # type: ignore
+                if not rect.isValid():
+                    return 1 << 30  # Not laid out yet, so after the rows that are
+                return rect.bottom() if bottom else rect.top()
+
+            viewport = list_widget.viewport().rect()  # type: ignore
+            return find_visible_rows(
+                list_widget.count(),  # type: ignore
+                lambda row: edge(row, False),
+                lambda row: edge(row, True),
+                viewport.top(),
+                viewport.bottom(),
+            )
+
+        def _load_visible_items(self) -> None:
+            """Load the items in view, then queue their thumbnails and those ahead of the scroll"""
+            from ...services.thumbnail_decoder_impl import plan_prefetch_rows
+
+            requests: List[Any] = []
+            first_shown: List[Any] = []
+            for list_widget in self._get_asset_list_widgets():
+                if not list_widget.isVisible():
+                    continue  # Loaded when its tab is shown
+                rows = self._get_visible_rows(list_widget)
+                if rows is None:
+                    continue
+                first, last = rows
+                direction = self._scroll_directions.get(id(list_widget), 1)
+                ahead = plan_prefetch_rows(first, last, list_widget.count(), direction)
+                for row in list(range(first, last + 1)) + ahead:
+                    item = list_widget.item(row)  # type: ignore
+                    loaded = bool(item.data(DETAILS_LOADED_ROLE))  # type: ignore
+                    if not loaded:
+                        self._load_item_details(item)
+                    thumbnail_path = item.data(THUMBNAIL_ROLE)  # type: ignore
+                    if not thumbnail_path:
+                        continue
+                    asset = item.data(Qt.UserRole)  # type: ignore
+                    request = (Path(thumbnail_path), self._get_thumbnail_variant(asset))
+                    requests.append(request)
+                    if not loaded:
+                        first_shown.append(request)  # Decoded again if its file changed
+
+            # Room for the whole window, or prefetched images would push out those in view
+            if len(requests) > self._icon_cache.max_items:
+                self._icon_cache.set_max_items(len(requests) * 2)
+            self._thumbnail_decoder.request(requests, first_shown)
+
+        def _load_item_details(self, item: Any) -> None:
+            """Add an item's file-based badges and its thumbnail"""
+            asset = item.data(Qt.UserRole)  # type: ignore
+            item.setData(DETAILS_LOADED_ROLE, True)  # type: ignore
+            self._update_item_text(item, asset)
+
+            icon_size = (64, 64)  # Generated size; the grid scales it to its icon size
+            thumbnail_path = self._thumbnail_service.get_cached_thumbnail(
+                asset.file_path, size=icon_size
+            )  # type: ignore
+            if thumbnail_path:
+                # Queued for decoding by the pass that loaded the item
+                item.setData(THUMBNAIL_ROLE, str(thumbnail_path))  # type: ignore
+            else:
+                # Generate thumbnail in background
+                self._generate_thumbnail_async(asset, item, icon_size)
+
         def _load_recent_assets(self) -> None:
             """Load recent assets into tab - Single Responsibility"""
             print("[RECENT] Loading recent assets...")
-            recent_assets = self._repository.get_recent_assets(20)  # type: ignore
+            if self._favorite_files is not None:
+                # This artist's last imports and publishes from the library activity log
+                assets_by_file = {str(asset.file_path): asset for asset in self._current_assets}
+                recent_files = self._favorites_service.get_recent(Path(self._current_project_path))
+                recent_assets = [
+                    assets_by_file[str(path)]
+                    for path in recent_files
+                    if str(path) in assets_by_file
+                ]
+            else:
+                recent_assets = self._repository.get_recent_assets(20)  # type: ignore
             recent_list = (
                 self._tab_widget.widget(1) if self._tab_widget else None
             )  # Recent tab  # type: ignore
@@ -1379,7 +2521,14 @@ if PYSIDE_AVAILABLE and QWidget is not None:
         def _load_favorite_assets(self) -> None:
             """Load favorite assets into tab - Single Responsibility"""
             print("[FAVORITE] Loading favorite assets...")
-            favorite_assets = self._repository.get_favorites()  # type: ignore
+            if self._favorite_files is not None:
+                favorite_assets = [
+                    asset
+                    for asset in self._current_assets
+                    if str(asset.file_path) in self._favorite_files
+                ]
+            else:
+                favorite_assets = self._repository.get_favorites()  # type: ignore
             favorites_list = (
                 self._tab_widget.widget(2) if self._tab_widget else None
             )  # Favorites tab  # type: ignore
@@ -1399,6 +2548,30 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             else:
                 print("[ERROR] Favorites tab widget not found or invalid")
 
+        def _load_favorites_and_ratings(self, database: Any) -> None:
+            """Load this artist's favorites and every asset's rating - one query each"""
+            self._favorite_files, self._ratings = None, {}
+            if database is None:
+                return
+            try:
+                library_root = Path(self._current_project_path)
+                favorites = self._favorites_service.get_favorites(library_root)
+                self._favorite_files = {str(path) for path in favorites}
+                self._ratings = self._favorites_service.get_ratings(library_root)
+            except Exception as e:
+                print(f"[WARNING] Favorites and ratings unavailable: {e}")
+
+        def _load_pose_assets(self) -> None:
+            """Load pose assets into the Poses tab - Single Responsibility"""
+            poses = [
+                asset
+                for asset in self._current_assets
+                if asset.file_path.suffix.lower() == ".pose"
+            ]
+            if self._poses_list is not None:
+                self._populate_asset_list(self._poses_list, poses)
+                print(f"[POSE] Populated poses tab with {len(poses)} items")
+
         def _perform_search(self) -> None:
             """Perform asset search - Single Responsibility"""
             if not self._search_input:
@@ -1409,10 +2582,32 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 self.refresh_library()
                 return
 
+            # Indexed query - no disk access, fast enough for every keystroke
+            if len(self._search_index):
+                results = self._search_index.search(search_text)
+                self._populate_asset_list(self._asset_list, results)
+                if self._tab_widget:
+                    self._tab_widget.setCurrentIndex(0)
+                return
+
             # Create search criteria
             criteria = SearchCriteria(search_text=search_text)
             self.search_with_criteria(criteria)
 
+        def _rebuild_search_index(self, assets: List[Any], database: Any) -> None:
+            """Rebuild the in-memory search index - Single Responsibility"""
+            try:
+                from pathlib import Path
+
+                authors = {}
+                if database is not None:
+                    root = Path(self._current_project_path)
+                    for key, names in database.get_version_authors().items():
+                        authors[str(root / key)] = names
+                self._search_index.build(assets, authors, self.get_metadata_fields())
+            except Exception as e:
+                print(f"[WARNING] Failed to build search index: {e}")
+
         def search_with_criteria(self, criteria: Any) -> None:
             """Search with specific criteria - Single Responsibility"""
             try:
@@ -1433,6 +2628,106 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             except Exception as e:
                 print(f"Search error: {e}")
 
+        def focus_search(self) -> None:
+            """Put the cursor in the search field, its text selected - Single Responsibility"""
+            if self._search_input:
+                self._search_input.setFocus()  # type: ignore
+                self._search_input.selectAll()  # type: ignore
+
+        def _on_sort_changed(self, _index: int) -> None:
+            """Re-order All Assets by the chosen geometry stat - Single Responsibility"""
+            self._stat_sort = self._sort_combo.currentData() or ""  # type: ignore
+            if self._search_input and self._search_input.text().strip():
+                self._perform_search()
+            elif self._current_assets:
+                self._populate_asset_list(self._asset_list, self._current_assets)
+
+        def _on_status_filter_changed(self, _index: int) -> None:
+            """Limit All Assets to the chosen review status - Single Responsibility"""
+            self._status_filter = self._status_combo.currentData() or ""  # type: ignore
+            if self._search_input and self._search_input.text().strip():
+                self._perform_search()
+            elif self._current_assets:
+                self._populate_asset_list(self._asset_list, self._current_assets)
+
+        def set_multi_user_mode(self, enabled: bool) -> None:
+            """Show check-out locks and lock actions - Single Responsibility"""
+            self._multi_user_mode = enabled
+            self.refresh_library()
+
+        def set_perforce_mode(self, enabled: bool) -> None:
+            """Show depot revisions and Perforce actions - Single Responsibility"""
+            self._perforce_mode = enabled
+            self.refresh_library()
+
+        def set_outdated_assets(self, outdated: Dict[str, str]) -> None:
+            """Badge assets the scene references at an older version (path -> description)"""
+            if outdated == self._outdated_assets:
+                return
+            self._outdated_assets = dict(outdated)
+            if self._current_assets:
+                self._populate_asset_list(self._asset_list, self._current_assets)
+
+        def set_show_context(self, show: Any) -> None:
+            """Limit the lists to assets tagged for a ShowContext, None to list every show"""
+            if show == self._show_context:
+                return
+            self._show_context = show
+            if show is not None:
+                self._show_filter_btn.setText(tr("Show: {show}", show=show.show))  # type: ignore
+                self._show_filter_btn.setToolTip(  # type: ignore
+                    tr(
+                        "Listing assets tagged {tag} or show:all ({source}). "
+                        "Uncheck to list every show.",
+                        tag=show.tag,
+                        source=show.source,
+                    )
+                )
+            self._show_filter_btn.setVisible(show is not None)  # type: ignore
+            self._on_show_filter_toggled(self._show_filter_btn.isChecked())  # type: ignore
+
+        def get_show_context(self) -> Any:
+            """Get the ShowContext the lists are limited to, None when the filter is off"""
+            return self._show_context
+
+        def _is_show_filtering(self) -> bool:
+            """Check if the show filter is on and not overridden"""
+            return self._show_context is not None and self._show_filter_btn.isChecked()
+
+        def _on_show_filter_toggled(self, _checked: bool) -> None:
+            """Hide or list again the assets of other shows - Single Responsibility"""
+            if self._search_input and self._search_input.text().strip():
+                self._perform_search()
+            elif self._current_assets:
+                self._populate_asset_list(self._asset_list, self._current_assets)
+
+        def set_farm_jobs(self, farm_jobs: Dict[str, str]) -> None:
+            """Badge assets the render farm is processing (path -> description)"""
+            if farm_jobs == self._farm_jobs:
+                return
+            self._farm_jobs = dict(farm_jobs)
+            if self._current_assets:
+                self._populate_asset_list(self._asset_list, self._current_assets)
+
+        def get_depot_revision(self, asset: Any) -> Any:
+            """Get the depot revision read on the last refresh, None outside Perforce mode"""
+            return self._depot_revisions.get(str(asset.file_path))
+
+        def _load_depot_revisions(self, assets: List[Any]) -> None:
+            """Read depot state for every asset in one batched query"""
+            from pathlib import Path
+
+            self._depot_revisions = {}
+            if not self._perforce_mode:
+                return
+            revisions = self._source_control.get_revisions([a.file_path for a in assets])
+            # fstat reports workspace paths; match them to the library's paths
+            by_resolved = {str(Path(key).resolve()): rev for key, rev in revisions.items()}
+            for asset in assets:
+                revision = by_resolved.get(str(asset.file_path.resolve()))
+                if revision is not None:
+                    self._depot_revisions[str(asset.file_path)] = revision
+
         def get_selected_assets(self) -> List[Any]:
             """Get currently selected assets - Single Responsibility"""
             return self._selected_assets.copy()
@@ -1451,6 +2746,14 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             ):  # Check if it's a list widget  # type: ignore
                 self._populate_asset_list(recent_list, assets)
 
+        def add_to_favorites(self, asset: Any) -> None:
+            """Add an asset to this artist's favorites and refresh the Favorites tab"""
+            self._add_to_favorites(asset)
+
+        def reload_recent_assets(self) -> None:
+            """Reload the Recent tab after this artist imported or published"""
+            self._load_recent_assets()
+
         def set_favorite_assets(self, assets: List[Any]) -> None:
             """Set favorite assets from external source - Single Responsibility"""
             favorites_list = self._tab_widget.widget(2) if self._tab_widget else None  # type: ignore
@@ -1467,6 +2770,110 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             current_widget = self._tab_widget.currentWidget()  # type: ignore
             return current_widget if (current_widget and hasattr(current_widget, "clear")) else None  # type: ignore
 
+        def mark_changed(self, paths: List[Any]) -> None:
+            """
+            Queue files or folders written by the manager to be re-read on next refresh
+
+            The watcher only reports added, removed, and renamed entries, so in-place
+            writes (publishing over an asset, rollback) are marked here.
+            """
+            if self._current_project_path:
+                self._scanner.mark_changed(Path(self._current_project_path), paths)
+
+        def reveal_asset(self, file_path: Any) -> bool:
+            """
+            Select an asset in All Assets, clearing the search or filters hiding it
+
+            Returns:
+                False if the asset is not in the loaded library
+            """
+            target = Path(file_path)
+            if not any(Path(asset.file_path) == target for asset in self._current_assets):
+                return False
+            if self._tab_widget:
+                self._tab_widget.setCurrentIndex(0)  # type: ignore
+
+            item = self._find_asset_item(self._asset_list, target)
+            if item is None:
+                if self._search_input:
+                    self._search_input.blockSignals(True)  # type: ignore
+                    self._search_input.clear()  # type: ignore
+                    self._search_input.blockSignals(False)  # type: ignore
+                self._status_combo.blockSignals(True)  # type: ignore
+                self._status_combo.setCurrentIndex(0)  # type: ignore
+                self._status_combo.blockSignals(False)  # type: ignore
+                self._status_filter = ""
+                self._show_filter_btn.blockSignals(True)  # type: ignore
+                self._show_filter_btn.setChecked(False)  # type: ignore
+                self._show_filter_btn.blockSignals(False)  # type: ignore
+                self._populate_asset_list(self._asset_list, self._current_assets)
+                item = self._find_asset_item(self._asset_list, target)
+            if item is None:
+                return False
+
+            self._asset_list.setCurrentItem(item)  # type: ignore
+            self._asset_list.scrollToItem(  # type: ignore
+                item, QListWidget.ScrollHint.PositionAtCenter
+            )
+            return True
+
+        def _find_asset_item(self, list_widget: Any, file_path: Path) -> Any:
+            """Get the list item showing an asset file, None if it is not listed"""
+            for row in range(list_widget.count()):
+                item = list_widget.item(row)
+                asset = item.data(Qt.UserRole)  # type: ignore
+                if asset is not None and Path(asset.file_path) == file_path:
+                    return item
+            return None
+
+        def _update_library_watch(self) -> None:
+            """Watch the scanned library folders, or fall back to timestamp diffing"""
+            library_root = Path(self._current_project_path)
+            directories = [str(path) for path in self._scanner.get_directories(library_root)]
+            watched = set(self._watcher.directories())  # type: ignore
+
+            if len(directories) > MAX_WATCHED_DIRECTORIES:
+                if watched:
+                    self._watcher.removePaths(list(watched))  # type: ignore
+                    print(
+                        f"[INFO] Library has {len(directories)} folders - refreshes compare "
+                        "timestamps instead of watching folders"
+                    )
+                self._scanner.set_watched(library_root, False)
+                return
+
+            stale = watched - set(directories)
+            if stale:
+                self._watcher.removePaths(list(stale))  # type: ignore
+            new_directories = [path for path in directories if path not in watched]
+            if new_directories:
+                failed = self._watcher.addPaths(new_directories)  # type: ignore
+                if failed:
+                    self.mark_changed([Path(path) for path in failed])
+            self._scanner.set_watched(library_root, bool(directories))
+
+        def _stop_library_watch(self) -> None:
+            """Stop watching the current library"""
+            watched = self._watcher.directories()  # type: ignore
+            if watched:
+                self._watcher.removePaths(watched)  # type: ignore
+            self._scanner.set_watched(Path(self._current_project_path), False)
+            self._changed_directories.clear()
+
+        def _on_library_directory_changed(self, path: str) -> None:
+            """Collect watcher reports and refresh once a burst of changes settles"""
+            self._changed_directories.add(path)
+            self._watch_timer.start(WATCH_SETTLE_MS)  # type: ignore
+
+        def _apply_library_changes(self) -> None:
+            """Re-list the folders that changed and refresh the views"""
+            if not self._current_project_path or not self._changed_directories:
+                return
+            changed = [Path(path) for path in self._changed_directories]
+            self._changed_directories.clear()
+            self._scanner.mark_changed(Path(self._current_project_path), changed)
+            self.refresh_library()
+
         def _auto_refresh(self) -> None:
             """Auto-refresh library periodically - Single Responsibility"""
             # Only refresh if we have a project loaded and it's not already refreshing
@@ -1486,7 +2893,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 self._search_timer.timeout.connect(self._perform_search)  # type: ignore
                 self._search_timer.setSingleShot(True)  # type: ignore
             self._search_timer.stop()  # type: ignore
-            self._search_timer.start(500)  # 500ms delay
+            self._search_timer.start(150)  # Indexed search - short delay keeps it live
 
         def _on_selection_changed(self) -> None:
             """Handle asset selection changes - Single Responsibility"""
@@ -1626,19 +3033,12 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                         items_found += 1
                         print(f"   Found item #{items_found} to update")
 
-                        # Update display text with tag indicator
-                        display_text = asset.display_name
-                        tooltip_text = asset.display_name
+                        # Update badges and tooltip
+                        self._update_item_text(item, asset)
 
-                        if hasattr(asset, "tags") and asset.tags:
-                            tag_count = len(asset.tags)
-                            display_text = f"{display_text} [TAG]×{tag_count}"
-                            tags_str = ", ".join(asset.tags)
-                            tooltip_text = f"{asset.display_name}\n\nTags: {tags_str}"
-                            print(f"   Setting display text with {tag_count} tags: {display_text}")
-
-                        item.setText(display_text)  # type: ignore
-                        item.setToolTip(tooltip_text)  # type: ignore
+                        # Repaint the status strip on the thumbnail (a new cache variant)
+                        if item.data(THUMBNAIL_ROLE):  # type: ignore
+                            self._schedule_visible_items()
 
                         # Update background color (preserves icon)
                         color = self._asset_colors.get(asset.id)
@@ -1698,15 +3098,17 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
                 QMessageBox.information(
                     self,
-                    "Color Manager",
-                    "Color Coding Manager dialog is being loaded...\n\n"
-                    "This feature allows you to customize asset type colors.",
+                    tr("Color Manager"),
+                    tr(
+                        "Color Coding Manager dialog is being loaded...\n\n"
+                        "This feature allows you to customize asset type colors."
+                    ),
                 )
             except Exception as e:
                 print(f"[ERROR] Error opening color manager: {e}")
                 from PySide6.QtWidgets import QMessageBox
 
-                QMessageBox.critical(self, "Error", f"Failed to open Color Manager:\n{str(e)}")
+                QMessageBox.critical(self, tr("Error"), f"Failed to open Color Manager:\n{str(e)}")
 
         def _on_color_scheme_changed(self, dialog) -> None:  # type: ignore
             """Handle color scheme changes from color manager - Single Responsibility"""
@@ -1746,11 +3148,16 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             """Add tag to asset - Single Responsibility"""
             from PySide6.QtWidgets import QInputDialog, QMessageBox
 
+            from ...core.models.tag_hierarchy import normalize_tag
+
+            if not self._check_permission(ACTION_EDIT):
+                return
+
             asset_name = asset.display_name if hasattr(asset, "display_name") else asset.name
             print(f"[TAG]  _add_tag_to_asset called for: {asset_name}")
 
-            # Build dynamic tag list: predefined tags + all used tags (sorted)
-            available_tags = sorted(list(self._all_used_tags))
+            # Build dynamic tag list: predefined, used, and parent tags (sorted)
+            available_tags = self.get_known_tags()
             custom_tag_count = len(self._all_used_tags) - len(self._predefined_tags)
             print(
                 f"[TAG]  Available tags: {len(available_tags)} total "
@@ -1772,7 +3179,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             )
 
             if ok and tag:
-                tag = tag.strip()  # Remove any extra whitespace
+                tag = normalize_tag(tag)  # Remove extra whitespace, tidy nested tags
                 if not tag:
                     print("[WARNING]  Empty tag name, ignoring")
                     return
@@ -1823,17 +3230,22 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     print("[OK] Tag addition complete!")
                 else:
                     print(f"[WARNING]  Tag '{tag}' already exists on this asset")
-                    QMessageBox.information(self, "Tag Exists", f"Asset already has tag '{tag}'")
+                    QMessageBox.information(
+                        self, tr("Tag Exists"), f"Asset already has tag '{tag}'"
+                    )
 
         def _remove_tags_from_asset(self, asset: Any) -> None:
             """Remove tags from asset - Single Responsibility"""
             from PySide6.QtWidgets import QInputDialog, QMessageBox
 
+            if not self._check_permission(ACTION_EDIT):
+                return
+
             # Check if asset has any tags
             if not hasattr(asset, "tags") or not asset.tags:
                 asset_name = asset.display_name if hasattr(asset, "display_name") else asset.name
                 QMessageBox.information(
-                    self, "No Tags", f"Asset '{asset_name}' has no tags to remove."
+                    self, tr("No Tags"), f"Asset '{asset_name}' has no tags to remove."
                 )
                 return
 
@@ -1864,11 +3276,151 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     print("[REFRESH] Refreshing info panel after tag removal")
                     self.asset_selected.emit(asset)
 
+        def set_asset_status(self, asset: Any, state: str, note: str = "") -> bool:
+            """Move an asset to another review state - Single Responsibility"""
+            from PySide6.QtWidgets import QMessageBox
+            from ...services.permission_service_impl import PermissionDenied
+
+            try:
+                status = self._apply_status(asset, state, note)
+            except (PermissionDenied, ValueError) as e:
+                QMessageBox.warning(self, tr("Permission Denied"), str(e))
+                return False
+
+            self._set_status(f"{asset.display_name}: {status.label}")
+            if asset in self._selected_assets:
+                self.asset_selected.emit(asset)
+            return True
+
+        def _apply_status(self, asset: Any, state: str, note: str = "") -> Any:
+            """Change, save, and record an asset's review state; raises when not allowed"""
+            from ...core.models.activity_event import ACTIVITY_STATUS
+            from ...services.activity_service_impl import get_activity_service
+            from ...services.asset_status_service_impl import (
+                STATUS_METADATA_KEY,
+                get_asset_status_service,
+            )
+
+            if not isinstance(getattr(asset, "metadata", None), dict):
+                asset.metadata = {}
+            library_root = Path(self._current_project_path) if self._current_project_path else None
+            status = get_asset_status_service().change_status(
+                library_root, asset.metadata, state, note
+            )
+            asset.metadata[STATUS_METADATA_KEY] = status.to_dict()
+            self._save_asset_metadata(asset)
+            get_activity_service().record(
+                library_root,
+                ACTIVITY_STATUS,
+                asset.file_path,
+                status.changed_by,
+                status=status.label,
+                note=status.note,
+            )
+            self._refresh_asset_display(asset)
+            return status
+
+        def review_asset(self, asset: Any) -> bool:
+            """Let a reviewer approve, deprecate, or send back an asset - Single Responsibility"""
+            if not self._check_permission(ACTION_APPROVE):
+                return False
+            from PySide6.QtWidgets import QDialog
+            from ..dialogs.review_asset_dialog import ReviewAssetDialog
+            from ...services.asset_status_service_impl import get_asset_status_service
+
+            dialog = ReviewAssetDialog(
+                asset.display_name,
+                get_asset_status_service().get_status(getattr(asset, "metadata", None)),
+                self,
+            )
+            if dialog.exec() != QDialog.DialogCode.Accepted:
+                return False
+            return self.set_asset_status(asset, dialog.get_state(), dialog.get_note())
+
+        def _add_status_menu(self, menu: Any, asset: Any) -> None:
+            """Add the review status submenu to an asset context menu"""
+            from ...services.asset_status_service_impl import get_asset_status_service
+
+            service = get_asset_status_service()
+            library_root = Path(self._current_project_path) if self._current_project_path else None
+            current = service.get_status(getattr(asset, "metadata", None))
+
+            status_menu = menu.addMenu(f"Status: {current.label}")
+            submit_action = status_menu.addAction(tr("Submit for Review"))
+            submit_action.setToolTip(tr("Ask a reviewer to approve the asset"))
+            submit_action.setEnabled(
+                current.state == STATUS_WIP
+                and service.can_change(library_root, current.state, STATUS_PENDING_REVIEW)
+            )
+            submit_action.triggered.connect(
+                lambda: self.set_asset_status(asset, STATUS_PENDING_REVIEW)
+            )
+            review_action = status_menu.addAction(tr("Review Asset..."))
+            review_action.setToolTip(tr("Approve, deprecate, or send the asset back to WIP"))
+            review_action.setEnabled(self._is_allowed(ACTION_APPROVE))
+            review_action.triggered.connect(lambda: self.review_asset(asset))
+
+        def _is_allowed(self, action: str) -> bool:
+            """Check if the artist's role in the current library allows an action"""
+            from ...services.permission_service_impl import get_permission_service
+
+            library_root = Path(self._current_project_path) if self._current_project_path else None
+            return get_permission_service().is_allowed(library_root, action)
+
+        def _check_permission(self, action: str) -> bool:
+            """Tell the artist when their role does not allow an action"""
+            from PySide6.QtWidgets import QMessageBox
+            from ...services.permission_service_impl import (
+                PermissionDenied,
+                get_permission_service,
+            )
+
+            library_root = Path(self._current_project_path) if self._current_project_path else None
+            try:
+                get_permission_service().check(library_root, action)
+            except PermissionDenied as e:
+                QMessageBox.warning(self, tr("Permission Denied"), str(e))
+                return False
+            return True
+
+        def get_metadata_database(self) -> Any:
+            """Get the SQLite metadata database of the current library (None if no project)"""
+            if not self._current_project_path:
+                return None
+            try:
+                from pathlib import Path
+
+                from ...services.metadata_database_impl import get_metadata_database
+
+                return get_metadata_database(Path(self._current_project_path))
+            except Exception as e:
+                print(f"[WARNING] Metadata database unavailable: {e}")
+                return None
+
+        def get_metadata_fields(self) -> List[Any]:
+            """Get the custom metadata fields of the current library's schema"""
+            if not self._current_project_path:
+                return []
+            from pathlib import Path
+
+            from ...services.metadata_schema_service_impl import get_metadata_schema_service
+
+            return get_metadata_schema_service().load_schema(Path(self._current_project_path))
+
+        def save_custom_field_values(self, asset: Any, values: Dict[str, Any]) -> None:
+            """Store an asset's custom field values and make them searchable"""
+            if not isinstance(getattr(asset, "metadata", None), dict):
+                return
+            if values:
+                asset.metadata["fields"] = dict(values)
+            else:
+                asset.metadata.pop("fields", None)
+            self._save_asset_metadata(asset)
+            self._rebuild_search_index(self._current_assets, self.get_metadata_database())
+
         def _save_asset_metadata(self, asset: Any) -> None:
             """Save asset metadata including tags - Single Responsibility"""
             try:
-                # In production, this would save to a database or metadata file
-                # For now, we'll save tags to a sidecar JSON file
                 import json
                 from pathlib import Path
 
@@ -1884,7 +3436,26 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                     "category": getattr(asset, "category", "general"),
                     "modified_date": str(getattr(asset, "modified_date", "")),
                 }
-
+                # Custom schema field values travel with the rest of the metadata
+                custom_values = (getattr(asset, "metadata", None) or {}).get("fields")
+                if custom_values:
+                    metadata["fields"] = custom_values
+                # So do the geometry stats captured at publish time
+                stats = (getattr(asset, "metadata", None) or {}).get("stats")
+                if stats:
+                    metadata["stats"] = stats
+                # And the review status
+                status = (getattr(asset, "metadata", None) or {}).get("status")
+                if status:
+                    metadata["status"] = status
+
+                # Library database is the primary store
+                database = self.get_metadata_database()
+                if database is not None:
+                    database.save_asset_metadata(file_path, metadata)
+                    self._metadata_cache[database.get_asset_key(file_path)] = metadata
+
+                # Sidecar JSON kept for plugin versions without the database
                 with open(metadata_path, "w", encoding="utf-8") as f:
                     json.dump(metadata, f, indent=2)
 
@@ -1896,7 +3467,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 # Don't show error to user - metadata saving is non-critical
 
         def _load_asset_metadata(self, asset: Any) -> None:
-            """Load asset metadata including tags from sidecar file - Single Responsibility"""
+            """Load asset metadata - library database first, sidecar file as fallback"""
             try:
                 import json
                 from pathlib import Path
@@ -1905,6 +3476,15 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 file_path = (
                     Path(asset.file_path) if isinstance(asset.file_path, str) else asset.file_path
                 )
+
+                # Database rows are loaded in one query per refresh (see refresh_library)
+                database = self.get_metadata_database()
+                if database is not None:
+                    metadata = self._metadata_cache.get(database.get_asset_key(file_path))
+                    if metadata is not None:
+                        self._apply_asset_metadata(asset, metadata)
+                        return
+
                 metadata_path = file_path.with_suffix(file_path.suffix + ".meta")
 
                 if not metadata_path.exists():
@@ -1913,39 +3493,55 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 with open(metadata_path, "r", encoding="utf-8") as f:
                     metadata = json.load(f)
 
-                # Apply loaded metadata to asset
-                if "tags" in metadata:
-                    asset.tags = metadata["tags"]
-                    # Add these tags to our global tag set
-                    if asset.tags:
-                        before_count = len(self._all_used_tags)
-                        self._all_used_tags.update(asset.tags)
-                        after_count = len(self._all_used_tags)
-                        new_tags_count = after_count - before_count
-                        print(f"[LOAD] Loaded {len(asset.tags)} tags from metadata: {asset.tags}")
-                        if new_tags_count > 0:
-                            print(
-                                f"[LOAD] [NEW] Added {new_tags_count} NEW tags to registry. Total: {after_count}"
-                            )
-                        else:
-                            print(
-                                f"[LOAD] No new tags (all already in registry). Total: {after_count}"
-                            )
+                # Sidecar added after migration (e.g. by another artist) - import it
+                if database is not None:
+                    database.save_asset_metadata(file_path, metadata)
+                    self._metadata_cache[database.get_asset_key(file_path)] = metadata
 
-                if "is_favorite" in metadata:
-                    asset.is_favorite = metadata["is_favorite"]
-
-                if "category" in metadata:
-                    asset.category = metadata["category"]
-
-                asset_name = asset.display_name if hasattr(asset, "display_name") else asset.name
-                tag_count = len(asset.tags) if hasattr(asset, "tags") and asset.tags else 0
-                print(f"[LOAD] Loaded metadata for {asset_name}: {tag_count} tags")
+                self._apply_asset_metadata(asset, metadata)
 
             except Exception as e:
                 print(f"[WARNING] Failed to load asset metadata: {e}")
                 # Don't show error to user - metadata loading is non-critical
 
+        def _apply_asset_metadata(self, asset: Any, metadata: Dict[str, Any]) -> None:
+            """Apply loaded metadata to asset - Single Responsibility"""
+            if "tags" in metadata:
+                asset.tags = metadata["tags"]
+                # Add these tags to our global tag set
+                if asset.tags:
+                    before_count = len(self._all_used_tags)
+                    self._all_used_tags.update(asset.tags)
+                    after_count = len(self._all_used_tags)
+                    new_tags_count = after_count - before_count
+                    print(f"[LOAD] Loaded {len(asset.tags)} tags from metadata: {asset.tags}")
+                    if new_tags_count > 0:
+                        print(
+                            f"[LOAD] [NEW] Added {new_tags_count} NEW tags to registry. Total: {after_count}"
+                        )
+                    else:
+                        print(
+                            f"[LOAD] No new tags (all already in registry). Total: {after_count}"
+                        )
+
+            if "is_favorite" in metadata:
+                asset.is_favorite = metadata["is_favorite"]
+
+            if "category" in metadata:
+                asset.category = metadata["category"]
+
+            if "fields" in metadata and isinstance(getattr(asset, "metadata", None), dict):
+                asset.metadata["fields"] = dict(metadata["fields"])
+
+            if "stats" in metadata and isinstance(getattr(asset, "metadata", None), dict):
+                asset.metadata["stats"] = dict(metadata["stats"])
+            if "status" in metadata and isinstance(getattr(asset, "metadata", None), dict):
+                asset.metadata["status"] = dict(metadata["status"])
+
+            asset_name = asset.display_name if hasattr(asset, "display_name") else asset.name
+            tag_count = len(asset.tags) if hasattr(asset, "tags") and asset.tags else 0
+            print(f"[LOAD] Loaded metadata for {asset_name}: {tag_count} tags")
+
         def refresh_thumbnails_for_assets(self, asset_paths: List[Any]) -> None:
             """Force refresh thumbnails for specific assets - Single Responsibility"""
             print(f"[REFRESH] Refreshing thumbnails for {len(asset_paths)} assets...")
@@ -1990,6 +3586,11 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                             asset_name = (
                                 asset.display_name if hasattr(asset, "display_name") else "Unknown"
                             )
+                            thumbnail_path = item.data(THUMBNAIL_ROLE)  # type: ignore
+                            if thumbnail_path:
+                                self._icon_cache.discard(Path(thumbnail_path))
+                            if not item.data(DETAILS_LOADED_ROLE):  # type: ignore
+                                continue  # Generated when the item scrolls into view
                             print(f"[REFRESH] Refreshing thumbnail for list item: {asset_name}")
                             # Generate new thumbnail asynchronously
                             self._generate_thumbnail_async(asset, item, icon_size)
@@ -2002,14 +3603,18 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             asset_paths = [asset.file_path for asset in self._current_assets]
             self.refresh_thumbnails_for_assets(asset_paths)
 
-        def _open_tag_manager(self) -> None:
+        def open_tag_manager(self) -> None:
             """Open tag manager - Single Responsibility"""
             from ..dialogs.tag_manager_dialog import TagManagerDialog
+            from ...services.tag_service_impl import get_tag_service
+
+            if not self._check_permission(ACTION_EDIT):
+                return
 
             print(f"[TAG]  Opening Tag Manager with {len(self._all_used_tags)} tags")
 
-            # Create dialog and pass current tag registry
-            dialog = TagManagerDialog(self)
+            # Create dialog and pass current tag registry - renames reach every library asset
+            dialog = TagManagerDialog(self, get_tag_service(), self.get_metadata_database())
 
             # Convert set to dict format expected by dialog (tag_name -> {color, description})
             current_tags = {}
@@ -2053,6 +3658,11 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 # Save custom tags to config file
                 self._save_custom_tags()
 
+                # Reload asset tags changed library-wide
+                if dialog.changed_assets:
+                    self._set_status(f"Updated tags on {len(dialog.changed_assets)} asset(s)")
+                    self.refresh_library()
+
                 # Refresh the currently selected asset's info panel if any
                 if self._selected_assets and len(self._selected_assets) == 1:
                     selected_asset = self._selected_assets[0]
@@ -2067,6 +3677,17 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
                 print("[TAG]  Tag Manager updates complete!")
 
+        def get_known_tags(self) -> List[str]:
+            """Get library and registry tags with their parent tags, for autocomplete"""
+            from ...services.tag_service_impl import get_tag_service
+
+            try:
+                database = self.get_metadata_database()
+                return get_tag_service().get_known_tags(database, self._all_used_tags)
+            except Exception as e:
+                print(f"[WARNING]  Could not read library tags: {e}")
+                return sorted(self._all_used_tags, key=str.lower)
+
         def _load_custom_tags(self) -> None:
             """Load custom tags from config file - Single Responsibility"""
             try:
@@ -2133,27 +3754,137 @@ if PYSIDE_AVAILABLE and QWidget is not None:
 
                 QMessageBox.warning(
                     self,
-                    "Feature Not Available",
-                    "Collection Manager is not yet fully implemented.",
+                    tr("Feature Not Available"),
+                    tr("Collection Manager is not yet fully implemented."),
                 )
             except Exception as e:
                 from PySide6.QtWidgets import QMessageBox
 
-                QMessageBox.critical(self, "Error", f"Failed to open Collection Manager: {e}")
+                QMessageBox.critical(self, tr("Error"), f"Failed to open Collection Manager: {e}")
+
+        def get_collections(self) -> Dict[str, Dict[str, Any]]:
+            """Get the collections of the current library"""
+            return self._collections
+
+        def reload_collections(self) -> None:
+            """Reload collections from the library database and refresh the Collections tab"""
+            database = self.get_metadata_database()
+            if database is not None:
+                try:
+                    self._collections = database.get_collections()
+                except Exception as e:
+                    print(f"[WARNING] Failed to load collections: {e}")
+            if self._collections_widget is not None:
+                self._collections_widget.update_collections(self._collections)
+            self.collections_changed.emit(self._collections)
+
+        def get_collection_assets(self, collection_name: str) -> List[Any]:
+            """Get the library assets in a collection (smart collections run their query)"""
+            from ...services.collection_service_impl import get_collection_service
+
+            data = self._collections.get(collection_name)
+            if data is None:
+                return []
+            return get_collection_service().resolve_members(
+                data, self._current_assets, self._search_index, self._get_asset_key
+            )
+
+        def create_collection(self, smart: bool = False) -> None:
+            """Show the Collections tab and prompt for a new (optionally smart) collection"""
+            if self._collections_widget is None:
+                return
+            if self._tab_widget:
+                self._tab_widget.setCurrentWidget(self._collections_widget)  # type: ignore
+            if self._search_input:
+                self._collections_widget.default_smart_query = self._search_input.text().strip()
+            self._collections_widget.create_collection(smart)
+
+        def _get_asset_key(self, asset: Any) -> str:
+            """Get the library key collections store for an asset"""
+            database = self.get_metadata_database()
+            if database is None:
+                return asset.file_path.name
+            return database.get_asset_key(asset.file_path)
+
+        def _load_collection_members(self, list_widget, name: str) -> int:  # type: ignore
+            """Fill the Collections tab list with a collection's assets"""
+            try:
+                members = self.get_collection_assets(name)
+            except Exception as e:
+                print(f"[WARNING] Failed to resolve collection {name}: {e}")
+                members = []
+            self._populate_asset_list(list_widget, members)
+            return len(members)
+
+        def _save_collection(self, collection_name: str) -> None:
+            """Write a collection to the library database and refresh the tab"""
+            database = self.get_metadata_database()
+            if database is None:
+                return
+            try:
+                database.save_collection(collection_name, self._collections[collection_name])
+            except Exception as e:
+                print(f"[WARNING] Failed to save collection {collection_name}: {e}")
+            self.reload_collections()
+
+        def _on_assets_dropped(self, collection_name: str, paths: List[str]) -> None:
+            """Add assets dragged onto the Collections tab to its collection"""
+            from pathlib import Path
+
+            dropped = {str(Path(path)) for path in paths}
+            assets = [a for a in self._current_assets if str(a.file_path) in dropped]
+            self._add_assets_to_collection(collection_name, assets)
+
+        def _add_assets_to_collection(self, collection_name: str, assets: List[Any]) -> None:
+            """Add assets to a manual collection and save it"""
+            from ...services.collection_service_impl import get_collection_service
+
+            data = self._collections.get(collection_name)
+            if data is None or not assets:
+                return
+            keys = [self._get_asset_key(asset) for asset in assets]
+            added = get_collection_service().add_members(data, keys)
+            if added:
+                self._save_collection(collection_name)
+            print(f"[OK] Added {added} asset(s) to collection {collection_name}")
 
         def _add_to_collection(self, asset: Any) -> None:
-            """Add asset to collection - Single Responsibility"""
-            print(f"Adding asset {asset.name} to collection")
-            # TODO: Implement collections system
+            """Add asset (and the rest of the selection) to a collection - Single Responsibility"""
+            from PySide6.QtWidgets import QInputDialog, QMessageBox
+
+            from ...services.collection_service_impl import is_smart_collection, make_collection
+
+            assets = self._selected_assets if asset in self._selected_assets else [asset]
+            new_label = "New Collection..."
+            names = sorted(
+                name for name, data in self._collections.items() if not is_smart_collection(data)
+            )
+            name, ok = QInputDialog.getItem(
+                self, "Add to Collection", "Collection:", names + [new_label], 0, False
+            )
+            if not ok:
+                return
+
+            if name == new_label:
+                name, ok = QInputDialog.getText(self, "New Collection", "Collection name:")
+                name = name.strip()
+                if not ok or not name:
+                    return
+                if name in self._collections:
+                    QMessageBox.warning(
+                        self,
+                        tr("Collection Exists"),
+                        f"A collection named '{name}' already exists.",
+                    )
+                    return
+                self._collections[name] = make_collection()
+
+            self._add_assets_to_collection(name, assets)
+            self._set_status(f"Added {len(assets)} asset(s) to collection '{name}'")
 
         def _create_new_collection(self) -> None:
             """Create new collection - Single Responsibility"""
-            from ..dialogs.collection_dialog import CollectionDialog
-
-            dialog = CollectionDialog(self)
-            if dialog.exec():
-                collection_name = dialog.get_collection_name()
-                print(f"Creating new collection: {collection_name}")
+            self.create_collection()
 
         def _open_collections_manager(self) -> None:
             """Open collections manager - Single Responsibility"""
@@ -2194,9 +3925,11 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 from PySide6.QtWidgets import QMessageBox
 
                 QMessageBox.warning(
-                    self, "Feature Not Available", "Advanced Search dialog is not implemented yet."
+                    self,
+                    tr("Feature Not Available"),
+                    tr("Advanced Search dialog is not implemented yet."),
                 )
             except Exception as e:
                 from PySide6.QtWidgets import QMessageBox
 
-                QMessageBox.critical(self, "Error", f"Failed to open advanced search: {e}")
+                QMessageBox.critical(self, tr("Error"), f"Failed to open advanced search: {e}")
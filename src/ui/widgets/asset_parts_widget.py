@@ -0,0 +1,214 @@
+# -*- coding: utf-8 -*-
+"""
+Asset Parts Widget
+Outline of the selected asset's groups and meshes, to import only the checked ones
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, Dict, List, Optional
+
+from PySide6.QtWidgets import (
+    QWidget,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QHeaderView,
+)
+from PySide6.QtCore import Qt, Signal
+
+from ...services.localization_service_impl import tr
+
+# Listing these formats imports the file once, so they wait for the List Parts button
+PART_FILE_TYPES = (".ma", ".mb", ".fbx", ".obj", ".abc")
+
+
+class AssetPartsWidget(QWidget):
+    """
+    Asset Parts Widget - Single Responsibility for picking sub-parts of an asset
+    Checking a group picks everything in it; the groups above a pick come along
+    """
+
+    import_requested = Signal(object, list)  # Asset file, picked part paths
+    status_message = Signal(str)
+
+    def __init__(self, part_service, parent=None):
+        super().__init__(parent)
+
+        self._service = part_service
+        self._asset_file: Optional[Path] = None
+        self._cmds: Any = None
+        self._is_listed = False
+        self._wants_outline = False  # Tab on screen: list .ma outlines right away
+
+        self._setup_ui()
+        self.set_asset(None)
+
+    def _setup_ui(self) -> None:
+        """Setup widget UI - Single Responsibility"""
+        layout = QVBoxLayout(self)
+        layout.setContentsMargins(0, 4, 0, 0)
+        layout.setSpacing(4)
+
+        self._summary_label = QLabel("")
+        self._summary_label.setStyleSheet("color: #999999;")
+        self._summary_label.setWordWrap(True)
+        layout.addWidget(self._summary_label)
+
+        self._tree = QTreeWidget()
+        self._tree.setColumnCount(2)
+        self._tree.setHeaderLabels(["Part", "Content"])
+        self._tree.header().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        self._tree.itemChanged.connect(lambda _item, _column: self._update_buttons())
+        layout.addWidget(self._tree, 1)
+
+        button_layout = QHBoxLayout()
+
+        self._list_btn = QPushButton(tr("List Parts"))
+        self._list_btn.setToolTip(tr("Read the asset's top-level groups and meshes"))
+        self._list_btn.clicked.connect(self._on_list_clicked)
+        button_layout.addWidget(self._list_btn)
+
+        self._clear_btn = QPushButton(tr("Clear"))
+        self._clear_btn.setToolTip(tr("Uncheck every part"))
+        self._clear_btn.clicked.connect(lambda: self._set_all_checked(False))
+        button_layout.addWidget(self._clear_btn)
+
+        button_layout.addStretch()
+
+        self._import_btn = QPushButton(tr("Import Checked Parts"))
+        self._import_btn.setProperty("accent", True)
+        self._import_btn.setToolTip(
+            tr("Import the asset but keep only the checked parts and the groups above them")
+        )
+        self._import_btn.clicked.connect(self._on_import_clicked)
+        button_layout.addWidget(self._import_btn)
+        layout.addLayout(button_layout)
+
+    def set_asset(self, asset_file: Optional[Path], cmds: Any = None) -> None:
+        """
+        Show the parts of an asset
+
+        Args:
+            asset_file: Asset whose parts are listed, None clears the outline
+            cmds: maya.cmds module, needed to list files other than Maya ASCII
+        """
+        self._asset_file = Path(asset_file) if asset_file else None
+        self._cmds = cmds
+        self._is_listed = False
+        self._tree.clear()
+        supported = self._asset_file is not None and (
+            self._asset_file.suffix.lower() in PART_FILE_TYPES
+        )
+        self.setEnabled(supported)
+        if self._asset_file is None:
+            self._summary_label.setText(tr("Select an asset to pick parts of it"))
+        elif not supported:
+            self._summary_label.setText(f"{self._asset_file.suffix} assets have no parts to pick")
+        elif self._service.needs_maya(self._asset_file):
+            self._summary_label.setText(
+                tr("Listing the parts of this asset imports it once in the background")
+            )
+        elif self._wants_outline:
+            self.refresh()
+        else:
+            self._summary_label.setText(tr("List the parts to pick the ones to import"))
+        self._update_buttons()
+
+    def set_visible_tab(self, visible: bool) -> None:
+        """List Maya ASCII outlines as soon as the tab is shown"""
+        self._wants_outline = visible
+        if visible and self._asset_file is not None and not self._is_listed:
+            if self.isEnabled() and not self._service.needs_maya(self._asset_file):
+                self.refresh()
+
+    def refresh(self) -> None:
+        """Read the shown asset's outline again"""
+        if self._asset_file is None:
+            return
+        try:
+            parts = self._service.get_parts(self._asset_file, self._cmds)
+        except Exception as e:
+            self._summary_label.setText(f"Could not list parts: {e}")
+            self.status_message.emit(f"Could not list parts of {self._asset_file.name}: {e}")
+            return
+        self._populate(parts)
+
+    def get_checked_parts(self) -> List[str]:
+        """Get the topmost checked parts (a checked group stands for what it holds)"""
+        checked: List[str] = []
+
+        def collect(item: QTreeWidgetItem) -> None:
+            if item.checkState(0) == Qt.CheckState.Checked:
+                checked.append(item.data(0, Qt.ItemDataRole.UserRole))
+                return
+            for index in range(item.childCount()):
+                collect(item.child(index))
+
+        for index in range(self._tree.topLevelItemCount()):
+            collect(self._tree.topLevelItem(index))
+        return checked
+
+    def _populate(self, parts: List[Any]) -> None:
+        """Fill the tree with the parts, top-level parts expanded"""
+        self._tree.blockSignals(True)
+        self._tree.clear()
+        items: Dict[str, QTreeWidgetItem] = {}
+        for part in parts:
+            item = QTreeWidgetItem([part.name, part.summary])
+            item.setData(0, Qt.ItemDataRole.UserRole, part.path)
+            item.setToolTip(0, part.path)
+            item.setFlags(
+                item.flags() | Qt.ItemFlag.ItemIsUserCheckable | Qt.ItemFlag.ItemIsAutoTristate
+            )
+            item.setCheckState(0, Qt.CheckState.Unchecked)
+            parent = items.get(part.parent_path)
+            if parent is None:
+                self._tree.addTopLevelItem(item)
+            else:
+                parent.addChild(item)
+            items[part.path] = item
+        for index in range(self._tree.topLevelItemCount()):
+            self._tree.topLevelItem(index).setExpanded(True)
+        self._tree.blockSignals(False)
+
+        self._is_listed = True
+        top_level = self._tree.topLevelItemCount()
+        meshes = sum(1 for part in parts if part.is_mesh)
+        self._summary_label.setText(
+            f"{top_level} top-level part(s), {meshes} mesh(es) - check the ones to import"
+        )
+        self._update_buttons()
+
+    def _set_all_checked(self, checked: bool) -> None:
+        """Check or uncheck every top-level part (children follow)"""
+        state = Qt.CheckState.Checked if checked else Qt.CheckState.Unchecked
+        for index in range(self._tree.topLevelItemCount()):
+            self._tree.topLevelItem(index).setCheckState(0, state)
+
+    def _update_buttons(self) -> None:
+        """Enable importing once a part is checked"""
+        has_parts = self._tree.topLevelItemCount() > 0
+        self._list_btn.setText(tr("Refresh") if self._is_listed else tr("List Parts"))
+        self._clear_btn.setEnabled(has_parts)
+        self._import_btn.setEnabled(has_parts and bool(self.get_checked_parts()))
+
+    def _on_list_clicked(self) -> None:
+        """List the parts, asking the window for Maya when the file needs it"""
+        if self._asset_file is None:
+            return
+        if self._service.needs_maya(self._asset_file) and self._cmds is None:
+            self._summary_label.setText(tr("Listing the parts of this asset needs Maya"))
+            return
+        self.refresh()
+
+    def _on_import_clicked(self) -> None:
+        """Ask the window to import the checked parts"""
+        parts = self.get_checked_parts()
+        if self._asset_file is not None and parts:
+            self.import_requested.emit(self._asset_file, parts)
@@ -21,7 +21,7 @@ try:
         QPushButton,
     )
     from PySide6.QtCore import Qt
-    from PySide6.QtGui import QPixmap, QIcon
+    from PySide6.QtGui import QPixmap, QIcon, QMovie
 
     PYSIDE_AVAILABLE = True
     PYSIDE_VERSION = "PySide6"
@@ -40,6 +40,20 @@ except ImportError as e:
     Qt = None
     QPixmap = None
     QIcon = None
+    QMovie = None
+
+# Movie previews (playblasts, MP4 turntables) need Qt Multimedia, which some Maya builds lack
+try:
+    from PySide6.QtCore import QUrl
+    from PySide6.QtMultimedia import QMediaPlayer
+    from PySide6.QtMultimediaWidgets import QVideoWidget
+
+    MULTIMEDIA_AVAILABLE = True
+except ImportError:
+    MULTIMEDIA_AVAILABLE = False
+    QUrl = None
+    QMediaPlayer = None
+    QVideoWidget = None
 
 # Import core models and interfaces - suppress import warnings for standalone testing
 try:
@@ -47,8 +61,12 @@ try:
     from ...core.interfaces.thumbnail_service import IThumbnailService  # type: ignore
     from ...core.container import get_container  # type: ignore
     from ..dialogs.screenshot_capture_dialog import ScreenshotCaptureDialog  # type: ignore
+    from ...services.localization_service_impl import tr  # type: ignore
 except ImportError:
     # Fallback definitions for when imports fail
+    def tr(text, **values):  # type: ignore
+        return text.format(**values) if values else text
+
     class Asset:  # type: ignore
         def __init__(self):
             self.file_path = None
@@ -122,6 +140,12 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             self._zoom_factor: float = 1.0
             self._original_pixmap: Optional[QPixmap] = None  # type: ignore
             self._scroll_area: Optional[QScrollArea] = None  # type: ignore
+            self._turntable_movie: Optional[QMovie] = None  # type: ignore
+            self._preview_movie: Optional[Path] = None  # Playblast of the current asset
+            self._movie_pinned = False  # Started by the play button, keeps playing off hover
+            self._media_player = None
+            self._video_widget = None
+            self._play_btn: Optional[QPushButton] = None  # type: ignore
 
             # Setup UI
             self._create_ui()
@@ -263,7 +287,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             self._preview_label.setAlignment(Qt.AlignCenter)  # type: ignore
             self._preview_label.setMinimumSize(200, 200)  # type: ignore
             self._preview_label.setStyleSheet("border: none; background: transparent;")  # type: ignore
-            self._preview_label.setText("No asset selected")  # type: ignore
+            self._preview_label.setText(tr("No asset selected"))  # type: ignore
 
             # Scroll area for preview with auto-sizing
             self._scroll_area = QScrollArea()  # type: ignore
@@ -274,11 +298,18 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             self._scroll_area.setStyleSheet(scroll_style)  # type: ignore
             layout.addWidget(self._scroll_area)  # type: ignore
 
+            # Playblast movies play in place of the still, on hover or from the play button
+            if MULTIMEDIA_AVAILABLE:
+                self._video_widget = QVideoWidget()  # type: ignore
+                self._video_widget.setMinimumSize(200, 200)  # type: ignore
+                self._video_widget.setVisible(False)  # type: ignore
+                layout.addWidget(self._video_widget)  # type: ignore
+
             # Zoom controls
             zoom_layout = QHBoxLayout()  # type: ignore
 
-            zoom_out_btn = QPushButton("Zoom -")  # type: ignore
-            zoom_out_btn.setToolTip("Zoom out to see more of the preview")  # type: ignore
+            zoom_out_btn = QPushButton(tr("Zoom -"))  # type: ignore
+            zoom_out_btn.setToolTip(tr("Zoom out to see more of the preview"))  # type: ignore
             zoom_out_btn.clicked.connect(self._zoom_out)  # type: ignore
             zoom_layout.addWidget(zoom_out_btn)  # type: ignore
 
@@ -287,21 +318,29 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             self._zoom_label.setMinimumWidth(60)  # type: ignore
             zoom_layout.addWidget(self._zoom_label)  # type: ignore
 
-            zoom_in_btn = QPushButton("Zoom +")  # type: ignore
-            zoom_in_btn.setToolTip("Zoom in to see more detail")  # type: ignore
+            zoom_in_btn = QPushButton(tr("Zoom +"))  # type: ignore
+            zoom_in_btn.setToolTip(tr("Zoom in to see more detail"))  # type: ignore
             zoom_in_btn.clicked.connect(self._zoom_in)  # type: ignore
             zoom_layout.addWidget(zoom_in_btn)  # type: ignore
 
-            zoom_fit_btn = QPushButton("Fit")  # type: ignore
-            zoom_fit_btn.setToolTip("Fit preview to window size")  # type: ignore
+            zoom_fit_btn = QPushButton(tr("Fit"))  # type: ignore
+            zoom_fit_btn.setToolTip(tr("Fit preview to window size"))  # type: ignore
             zoom_fit_btn.clicked.connect(self._zoom_fit)  # type: ignore
             zoom_layout.addWidget(zoom_fit_btn)  # type: ignore
 
             zoom_100_btn = QPushButton("1:1")  # type: ignore
-            zoom_100_btn.setToolTip("Reset zoom to actual size (100%)")  # type: ignore
+            zoom_100_btn.setToolTip(tr("Reset zoom to actual size (100%)"))  # type: ignore
             zoom_100_btn.clicked.connect(self._zoom_100)  # type: ignore
             zoom_layout.addWidget(zoom_100_btn)  # type: ignore
 
+            self._play_btn = QPushButton(tr("Play"))  # type: ignore
+            self._play_btn.setToolTip(
+                tr("Play the animated preview (plays on hover)")
+            )  # type: ignore
+            self._play_btn.clicked.connect(self._on_play_clicked)  # type: ignore
+            self._play_btn.setVisible(False)  # type: ignore
+            zoom_layout.addWidget(self._play_btn)  # type: ignore
+
             # Screenshot capture button (Manual screenshot feature)
             screenshot_icon_path = self._get_screenshot_icon_path()
             if screenshot_icon_path:
@@ -316,7 +355,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 screenshot_btn = QPushButton("[CAMERA]")  # type: ignore
                 print("[CAMERA] Using emoji fallback for screenshot button")
 
-            screenshot_btn.setToolTip("Capture Maya viewport screenshot as asset thumbnail")  # type: ignore
+            screenshot_btn.setToolTip(tr("Capture Maya viewport screenshot as asset thumbnail"))  # type: ignore
             screenshot_btn.setFixedSize(28, 28)  # type: ignore
             screenshot_btn.setStyleSheet("""
                 QPushButton {
@@ -338,8 +377,8 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             zoom_layout.addWidget(screenshot_btn)  # type: ignore
 
             # Clear Previewer button
-            clear_btn = QPushButton("Clear")  # type: ignore
-            clear_btn.setToolTip("Clear the preview and reset view")  # type: ignore
+            clear_btn = QPushButton(tr("Clear"))  # type: ignore
+            clear_btn.setToolTip(tr("Clear the preview and reset view"))  # type: ignore
             clear_btn.setStyleSheet("""
                 QPushButton {
                     background-color: #f44336;
@@ -375,9 +414,11 @@ if PYSIDE_AVAILABLE and QWidget is not None:
         def clear_preview(self) -> None:
             """Clear the preview - Single Responsibility"""
             self._current_asset = None
+            self._stop_turntable()
+            self._set_preview_movie(None)
             if self._preview_label:
                 self._preview_label.clear()  # type: ignore
-                self._preview_label.setText("No asset selected")  # type: ignore
+                self._preview_label.setText(tr("No asset selected"))  # type: ignore
 
         def _on_clear_preview(self) -> None:
             """Clear preview and reset zoom/pan - User action handler"""
@@ -394,7 +435,24 @@ if PYSIDE_AVAILABLE and QWidget is not None:
             if not self._current_asset or not self._preview_label:
                 return
 
+            self._stop_turntable()
+            self._set_preview_movie(None)
             try:
+                # Playblast (or MP4 turntable) movies play over the still on hover or click
+                if MULTIMEDIA_AVAILABLE:
+                    from ...services.playblast_service_impl import find_preview_movie
+
+                    asset_path = Path(self._current_asset.file_path)  # type: ignore
+                    self._set_preview_movie(find_preview_movie(asset_path))
+
+                # Animated turntable previews (background thumbnail queue) win over stills
+                from ...services.thumbnail_queue_impl import find_existing_turntable
+
+                turntable = find_existing_turntable(Path(self._current_asset.file_path))
+                if turntable and turntable.suffix.lower() == ".gif":
+                    self._play_turntable(turntable)
+                    return
+
                 # Get thumbnail
                 thumbnail_path = self._thumbnail_service.get_cached_thumbnail(  # type: ignore
                     self._current_asset.file_path, (256, 256)  # type: ignore
@@ -428,6 +486,74 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                 print(f"Error updating preview: {e}")
                 self._show_placeholder()
 
+        def _play_turntable(self, turntable_path: Path) -> None:
+            """Loop a GIF turntable in the preview label"""
+            self._turntable_movie = QMovie(str(turntable_path))  # type: ignore
+            self._original_pixmap = None
+            self._preview_label.setMovie(self._turntable_movie)  # type: ignore
+            self._turntable_movie.start()  # type: ignore
+
+        def _stop_turntable(self) -> None:
+            """Stop and release a playing turntable"""
+            if self._turntable_movie is not None:
+                self._turntable_movie.stop()  # type: ignore
+                self._turntable_movie = None
+
+        def _set_preview_movie(self, movie: Optional[Path]) -> None:
+            """Offer a movie preview for the current asset (None stops and hides it)"""
+            self._stop_movie()
+            self._preview_movie = movie
+            if self._play_btn is not None:
+                self._play_btn.setVisible(movie is not None)  # type: ignore
+
+        def _on_play_clicked(self) -> None:
+            """Toggle the movie preview; a clicked movie keeps playing off hover"""
+            if self._movie_pinned:
+                self._stop_movie()
+                return
+            self._play_movie()
+            self._movie_pinned = self._media_player is not None
+
+        def _play_movie(self) -> None:
+            """Loop the movie preview in place of the still"""
+            if self._preview_movie is None or self._video_widget is None:
+                return
+            if self._media_player is None:
+                self._media_player = QMediaPlayer(self)  # type: ignore
+                self._media_player.setVideoOutput(self._video_widget)  # type: ignore
+                self._media_player.setLoops(QMediaPlayer.Loops.Infinite)  # type: ignore
+            source = QUrl.fromLocalFile(str(self._preview_movie))  # type: ignore
+            self._media_player.setSource(source)  # type: ignore
+            self._scroll_area.setVisible(False)  # type: ignore
+            self._video_widget.setVisible(True)  # type: ignore
+            self._media_player.play()  # type: ignore
+            if self._play_btn is not None:
+                self._play_btn.setText(tr("Stop"))  # type: ignore
+
+        def _stop_movie(self) -> None:
+            """Stop the movie preview and show the still again"""
+            self._movie_pinned = False
+            if self._media_player is not None:
+                self._media_player.stop()  # type: ignore
+            if self._video_widget is not None:
+                self._video_widget.setVisible(False)  # type: ignore
+            if self._scroll_area is not None:
+                self._scroll_area.setVisible(True)  # type: ignore
+            if self._play_btn is not None:
+                self._play_btn.setText(tr("Play"))  # type: ignore
+
+        def enterEvent(self, event) -> None:  # type: ignore
+            """Play the movie preview while the pointer is over the preview"""
+            super().enterEvent(event)  # type: ignore
+            if not self._movie_pinned:
+                self._play_movie()
+
+        def leaveEvent(self, event) -> None:  # type: ignore
+            """Stop a hover-started movie preview"""
+            super().leaveEvent(event)  # type: ignore
+            if not self._movie_pinned:
+                self._stop_movie()
+
         def _show_placeholder(self) -> None:
             """Show placeholder when no preview available - Single Responsibility"""
             if not self._current_asset or not self._preview_label:
@@ -511,7 +637,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                         from PySide6.QtWidgets import QMessageBox  # type: ignore
 
                         msg = "Please select an asset first to capture a screenshot thumbnail."
-                        QMessageBox.information(self.parent(), "No Asset Selected", msg)  # type: ignore
+                        QMessageBox.information(self.parent(), tr("No Asset Selected"), msg)  # type: ignore
                     except ImportError:
                         print("Please select an asset first to capture a screenshot")
                 return
@@ -546,7 +672,7 @@ if PYSIDE_AVAILABLE and QWidget is not None:
                         from PySide6.QtWidgets import QMessageBox  # type: ignore
 
                         error_msg = f"Failed to open screenshot capture:\n{str(e)}"
-                        QMessageBox.warning(self.parent(), "Screenshot Error", error_msg)  # type: ignore
+                        QMessageBox.warning(self.parent(), tr("Screenshot Error"), error_msg)  # type: ignore
                     except ImportError:
                         print(f"Screenshot capture error: {e}")
 
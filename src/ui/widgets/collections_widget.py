@@ -10,7 +10,7 @@ Version: 1.5.0
 License: MIT
 """
 
-from typing import List, Dict, Optional, Any
+from typing import Any, Callable, Dict, List, Optional
 
 from PySide6.QtWidgets import (
     QWidget,
@@ -29,6 +29,13 @@ from PySide6.QtWidgets import (
 from PySide6.QtCore import Qt, Signal, QSize
 from PySide6.QtGui import QIcon, QFont, QPixmap, QPainter, QColor
 
+from ...services.collection_service_impl import (
+    get_collection_service,
+    is_smart_collection,
+    make_collection,
+)
+from ...services.localization_service_impl import tr
+
 
 class CollectionsDisplayWidget(QWidget):
     """
@@ -40,15 +47,18 @@ class CollectionsDisplayWidget(QWidget):
     - Show assets within each collection
     - Collection switching and navigation
     - Asset thumbnail display with metadata
+    - Smart collections defined by a saved search query
+    - Drop assets from the library onto the current collection
     - Integration with Collection Manager
     """
 
     # Signals for communication - Single Responsibility
     collection_selected = Signal(str)  # collection_name
-    asset_selected = Signal(str)  # asset_path
-    asset_double_clicked = Signal(str)  # asset_path
+    asset_selected = Signal(object)  # Asset
+    asset_double_clicked = Signal(object)  # Asset
     collection_manager_requested = Signal()
     collection_created = Signal(str)  # collection_name - emitted when new collection is created
+    assets_dropped = Signal(str, list)  # collection_name, List[str] file paths
 
     def __init__(self, parent=None):
         """
@@ -60,9 +70,14 @@ class CollectionsDisplayWidget(QWidget):
         super().__init__(parent)
         self.collections_data: Dict[str, Any] = {}
         self.current_collection: Optional[str] = None
+        # Suggested query for new smart collections (the library's current search)
+        self.default_smart_query = ""
+        # Fills the asset list with a collection's library assets, returns the count
+        self._member_loader: Optional[Callable[[QListWidget, str], int]] = None
 
+        self.setAcceptDrops(True)
         self._setup_ui()
-        self._load_sample_collections()  # Temporary sample data
+        self._populate_collection_selector()
 
     def _setup_ui(self) -> None:
         """Setup the user interface - Single Responsibility"""
@@ -86,25 +101,25 @@ class CollectionsDisplayWidget(QWidget):
         header_layout = QVBoxLayout(header_frame)
 
         # Title
-        title_label = QLabel("Asset Collections")
+        title_label = QLabel(tr("Asset Collections"))
         title_label.setFont(QFont("Arial", 14, QFont.Weight.Bold))
         title_label.setStyleSheet("color: #cccccc; padding: 4px;")
         header_layout.addWidget(title_label)
 
         # Collection selector
         selector_layout = QHBoxLayout()
-        selector_layout.addWidget(QLabel("Collection:"))
+        selector_layout.addWidget(QLabel(tr("Collection:")))
 
         self.collection_selector = QComboBox()
         self.collection_selector.setMinimumHeight(32)
-        self.collection_selector.setToolTip("Select a collection to view its assets")
+        self.collection_selector.setToolTip(tr("Select a collection to view its assets"))
         self.collection_selector.currentTextChanged.connect(self._on_collection_changed)
         selector_layout.addWidget(self.collection_selector)
 
         # Refresh button
-        refresh_btn = QPushButton("Refresh")
+        refresh_btn = QPushButton(tr("Refresh"))
         refresh_btn.setMaximumWidth(80)
-        refresh_btn.setToolTip("Refresh Collection Tabs and Update Asset Lists")
+        refresh_btn.setToolTip(tr("Refresh Collection Tabs and Update Asset Lists"))
         refresh_btn.clicked.connect(self._refresh_collections)
         selector_layout.addWidget(refresh_btn)
 
@@ -136,12 +151,14 @@ class CollectionsDisplayWidget(QWidget):
         info_layout.setContentsMargins(8, 8, 8, 8)
 
         # Collection name and description
-        self.collection_name_label = QLabel("No Collection Selected")
+        self.collection_name_label = QLabel(tr("No Collection Selected"))
         self.collection_name_label.setFont(QFont("Arial", 12, QFont.Weight.Bold))
         self.collection_name_label.setStyleSheet("color: #ffffff; padding: 4px;")
         info_layout.addWidget(self.collection_name_label)
 
-        self.collection_desc_label = QLabel("Select a collection to view its assets and details.")
+        self.collection_desc_label = QLabel(
+            tr("Select a collection to view its assets and details.")
+        )
         self.collection_desc_label.setWordWrap(True)
         self.collection_desc_label.setStyleSheet(
             "color: #999999; font-style: italic; padding: 4px;"
@@ -149,7 +166,7 @@ class CollectionsDisplayWidget(QWidget):
         info_layout.addWidget(self.collection_desc_label)
 
         # Statistics
-        self.stats_label = QLabel("Assets: 0 | Created: --")
+        self.stats_label = QLabel(tr("Assets: 0 | Created: --"))
         self.stats_label.setStyleSheet("color: #666666; font-size: 11px; padding: 4px;")
         info_layout.addWidget(self.stats_label)
 
@@ -202,88 +219,49 @@ class CollectionsDisplayWidget(QWidget):
         footer_layout = QHBoxLayout()
 
         # Collection management buttons
-        manage_btn = QPushButton("Manage Collections...")
-        manage_btn.setToolTip("Open Collection Manager to create, edit, and organize collections")
+        manage_btn = QPushButton(tr("Manage Collections..."))
+        manage_btn.setToolTip(
+            tr("Open Collection Manager to create, edit, and organize collections")
+        )
         manage_btn.clicked.connect(self.collection_manager_requested.emit)
         footer_layout.addWidget(manage_btn)
 
         footer_layout.addStretch()
 
         # Asset count display
-        self.asset_count_label = QLabel("0 assets")
+        self.asset_count_label = QLabel(tr("0 assets"))
         self.asset_count_label.setStyleSheet("color: #666666; font-size: 11px;")
         footer_layout.addWidget(self.asset_count_label)
 
         parent_layout.addLayout(footer_layout)
 
-    def _load_sample_collections(self) -> None:
-        """Load sample collections for demonstration - Single Responsibility"""
-        sample_collections = {
-            "Characters": {
-                "description": "Character models and rigs for animation projects",
-                "assets": ["Hero_Character.ma", "Villain_Character.ma", "NPC_Guard.ma"],
-                "created": "2024-01-15",
-                "category": "Characters",
-                "asset_count": 3,
-            },
-            "Environments": {
-                "description": "Environment scenes and props for level design",
-                "assets": [
-                    "Forest_Scene.ma",
-                    "Castle_Interior.ma",
-                    "Desert_Landscape.ma",
-                    "Ocean_Vista.ma",
-                ],
-                "created": "2024-01-20",
-                "category": "Environments",
-                "asset_count": 4,
-            },
-            "Props": {
-                "description": "Miscellaneous props and objects for scene decoration",
-                "assets": [
-                    "Sword_Medieval.ma",
-                    "Shield_Knight.ma",
-                    "Treasure_Chest.ma",
-                    "Magic_Staff.ma",
-                    "Ancient_Book.ma",
-                ],
-                "created": "2024-01-25",
-                "category": "Props",
-                "asset_count": 5,
-            },
-            "Vehicles": {
-                "description": "Transportation and mechanical assets",
-                "assets": ["Spaceship_Fighter.ma", "Motorcycle_Racing.ma"],
-                "created": "2024-02-01",
-                "category": "Vehicles",
-                "asset_count": 2,
-            },
-        }
-
-        self.collections_data = sample_collections
-        self._populate_collection_selector()
-
     def _populate_collection_selector(self) -> None:
         """Populate collection selector dropdown - Single Responsibility"""
         self.collection_selector.clear()
 
         if not self.collections_data:
             self.collection_selector.addItem("No collections available")
-            # Always add New Collection option even when no collections exist
+            # Always add New Collection options even when no collections exist
             self.collection_selector.addItem("New Collection...", "CREATE_NEW")
+            self.collection_selector.addItem("New Smart Collection...", "CREATE_SMART")
             return
 
         self.collection_selector.addItem("Select a collection...")
 
-        # Add New Collection option at the top for easy access
+        # Add New Collection options at the top for easy access
         self.collection_selector.addItem("New Collection...", "CREATE_NEW")
+        self.collection_selector.addItem("New Smart Collection...", "CREATE_SMART")
 
         # Add separator line for visual clarity
-        self.collection_selector.insertSeparator(2)
+        self.collection_selector.insertSeparator(3)
 
         for collection_name in sorted(self.collections_data.keys()):
-            asset_count = len(self.collections_data[collection_name].get("assets", []))
-            display_text = f"{collection_name} ({asset_count} assets)"
+            collection_data = self.collections_data[collection_name]
+            if is_smart_collection(collection_data):
+                display_text = f"{collection_name} (smart)"
+            else:
+                asset_count = len(collection_data.get("assets", []))
+                display_text = f"{collection_name} ({asset_count} assets)"
             self.collection_selector.addItem(display_text, collection_name)
 
     def _on_collection_changed(self, text: str) -> None:
@@ -294,8 +272,8 @@ class CollectionsDisplayWidget(QWidget):
 
         # Handle New Collection creation
         collection_data = self.collection_selector.currentData()
-        if collection_data == "CREATE_NEW":
-            self._create_new_collection()
+        if collection_data in ("CREATE_NEW", "CREATE_SMART"):
+            self._create_new_collection(smart=collection_data == "CREATE_SMART")
             return
 
         # Extract collection name from display text
@@ -305,11 +283,12 @@ class CollectionsDisplayWidget(QWidget):
             self._display_collection(collection_name)
             self.collection_selected.emit(collection_name)
 
-    def _create_new_collection(self) -> None:
-        """Create a new collection - Single Responsibility"""
+    def _create_new_collection(self, smart: bool = False) -> None:
+        """Create a new (optionally smart) collection - Single Responsibility"""
+        title = "New Smart Collection" if smart else "New Collection"
         # Prompt user for collection name
         collection_name, ok = QInputDialog.getText(
-            self, "New Collection", "Enter collection name:", text="My Collection"
+            self, title, "Enter collection name:", text="My Collection"
         )
 
         if not ok or not collection_name.strip():
@@ -323,12 +302,27 @@ class CollectionsDisplayWidget(QWidget):
         if collection_name in self.collections_data:
             QMessageBox.warning(
                 self,
-                "Collection Exists",
+                tr("Collection Exists"),
                 f"A collection named '{collection_name}' already exists.\nPlease choose a different name.",
             )
             self._reset_dropdown_selection()
             return
 
+        # Smart collections are defined by a search query instead of members
+        query = ""
+        if smart:
+            query, ok = QInputDialog.getText(
+                self,
+                title,
+                "Search query (e.g. tag:environment updated:7d):",
+                text=self.default_smart_query,
+            )
+            if not ok or not get_collection_service().validate_query(query):
+                if ok:
+                    QMessageBox.warning(self, title, tr("Please enter a search query."))
+                self._reset_dropdown_selection()
+                return
+
         # Prompt for description (optional)
         description, ok = QInputDialog.getText(
             self,
@@ -341,13 +335,10 @@ class CollectionsDisplayWidget(QWidget):
             description = "A new asset collection"
 
         # Create new collection data structure
-        new_collection = {
-            "description": description.strip() or "A new asset collection",
-            "created": "2025-09-06",  # Current date
-            "assets": [],  # Empty initially
-            "tags": ["user-created"],
-            "category": "user",
-        }
+        new_collection = make_collection(
+            description.strip() or "A new asset collection", query
+        )
+        new_collection["tags"] = ["user-created"]
 
         # Add to collections data
         self.collections_data[collection_name] = new_collection
@@ -362,11 +353,15 @@ class CollectionsDisplayWidget(QWidget):
         self.collection_created.emit(collection_name)
 
         # Show success message
+        next_step = (
+            "It updates automatically as the library changes."
+            if smart
+            else "Drag assets onto this tab or use Add to Collection to fill it."
+        )
         QMessageBox.information(
             self,
-            "Collection Created",
-            f"Collection '{collection_name}' has been created successfully.\n\n"
-            "You can now add assets to this collection.",
+            tr("Collection Created"),
+            f"Collection '{collection_name}' has been created successfully.\n\n{next_step}",
         )
 
     def _reset_dropdown_selection(self) -> None:
@@ -393,15 +388,20 @@ class CollectionsDisplayWidget(QWidget):
         # Update info panel
         self.collection_name_label.setText(collection_name)
         description = collection_data.get("description", "No description available")
+        if is_smart_collection(collection_data):
+            description = f"{description}\nSmart collection: {collection_data['query']}"
         self.collection_desc_label.setText(description)
 
+        # Populate asset list - from the library when connected, else by name
+        if self._member_loader is not None:
+            asset_count = self._member_loader(self.asset_list, collection_name)
+        else:
+            self._populate_asset_list(collection_data.get("assets", []))
+            asset_count = len(collection_data.get("assets", []))
+
         created_date = collection_data.get("created", "Unknown")
-        asset_count = len(collection_data.get("assets", []))
         self.stats_label.setText(f"Assets: {asset_count} | Created: {created_date}")
 
-        # Populate asset list
-        self._populate_asset_list(collection_data.get("assets", []))
-
         # Update footer count
         self.asset_count_label.setText(f"{asset_count} assets")
 
@@ -460,10 +460,12 @@ class CollectionsDisplayWidget(QWidget):
     def _clear_display(self) -> None:
         """Clear the display when no collection is selected - Single Responsibility"""
         self.current_collection = None
-        self.collection_name_label.setText("No Collection Selected")
-        self.collection_desc_label.setText("Select a collection to view its assets and details.")
-        self.stats_label.setText("Assets: 0 | Created: --")
-        self.asset_count_label.setText("0 assets")
+        self.collection_name_label.setText(tr("No Collection Selected"))
+        self.collection_desc_label.setText(
+            tr("Select a collection to view its assets and details.")
+        )
+        self.stats_label.setText(tr("Assets: 0 | Created: --"))
+        self.asset_count_label.setText(tr("0 assets"))
 
         self.asset_list.clear()
         placeholder_item = QListWidgetItem("[SELECT] Select a collection from the dropdown above")
@@ -485,21 +487,57 @@ class CollectionsDisplayWidget(QWidget):
 
     def _refresh_collections(self) -> None:
         """Refresh collections display - Single Responsibility"""
-        # In a real implementation, this would reload from the collection manager
-        self._populate_collection_selector()
-        QMessageBox.information(
-            self, "Collections Refreshed", f"Refreshed {len(self.collections_data)} collections"
-        )
+        self.update_collections(self.collections_data)
+
+    def create_collection(self, smart: bool = False) -> None:
+        """Prompt for a new manual or smart collection"""
+        self._create_new_collection(smart)
+
+    def set_member_loader(self, loader: Callable[[QListWidget, str], int]) -> None:
+        """Set the callback that fills the asset list with a collection's assets"""
+        self._member_loader = loader
+
+    def refresh_current_collection(self) -> None:
+        """Re-resolve the shown collection, e.g. after the library changed"""
+        if self.current_collection in self.collections_data:
+            self._display_collection(self.current_collection)
 
     def update_collections(self, collections_data: Dict[str, Any]) -> None:
         """Update collections data from external source - Single Responsibility"""
+        current = self.current_collection
         self.collections_data = collections_data
+
+        self.collection_selector.blockSignals(True)
         self._populate_collection_selector()
+        self.collection_selector.blockSignals(False)
 
-        # If current collection no longer exists, clear display
-        if self.current_collection and self.current_collection not in collections_data:
+        # Keep showing the current collection; clear if it no longer exists
+        if current and current in collections_data:
+            self._select_collection_in_dropdown(current)
+            self.current_collection = current
+            self._display_collection(current)
+        else:
             self._clear_display()
 
+    def dragEnterEvent(self, event) -> None:
+        """Accept library assets dragged onto a manual collection"""
+        data = self.collections_data.get(self.current_collection or "")
+        if event.mimeData().hasUrls() and data is not None and not is_smart_collection(data):
+            event.acceptProposedAction()
+        else:
+            event.ignore()
+
+    def dragMoveEvent(self, event) -> None:
+        """Keep accepting while the drag moves over child widgets"""
+        self.dragEnterEvent(event)
+
+    def dropEvent(self, event) -> None:
+        """Add dropped assets to the current collection"""
+        paths = [url.toLocalFile() for url in event.mimeData().urls() if url.isLocalFile()]
+        if self.current_collection and paths:
+            self.assets_dropped.emit(self.current_collection, paths)
+            event.acceptProposedAction()
+
     def get_current_collection(self) -> Optional[str]:
         """Get currently selected collection name - Single Responsibility"""
         return self.current_collection
@@ -24,6 +24,7 @@ from PySide6.QtGui import QColor, QFont
 
 # Import the unified theme
 from ..theme import UITheme
+from ...services.localization_service_impl import tr
 
 PYSIDE_VERSION = "PySide6"
 
@@ -66,7 +67,7 @@ class ColorCodingKeychartWidget(QWidget):  # type: ignore
         layout.setSpacing(4)
 
         # Create group box
-        self._keychart_group = QGroupBox("Asset Type Colors")
+        self._keychart_group = QGroupBox(tr("Asset Type Colors"))
 
         # Create keychart content
         self._create_keychart_content()
@@ -74,8 +75,8 @@ class ColorCodingKeychartWidget(QWidget):  # type: ignore
         layout.addWidget(self._keychart_group)
 
         # Manage colors button
-        manage_btn = QPushButton("Manage Colors...")
-        manage_btn.setToolTip("Customize asset type colors and create custom types")
+        manage_btn = QPushButton(tr("Manage Colors..."))
+        manage_btn.setToolTip(tr("Customize asset type colors and create custom types"))
         manage_btn.setProperty("accent", True)  # Use theme accent styling
         manage_btn.clicked.connect(self.color_coding_requested.emit)
         layout.addWidget(manage_btn)
@@ -137,7 +138,7 @@ class ColorCodingKeychartWidget(QWidget):  # type: ignore
         keychart_layout.addSpacing(4)
 
         # Add info label
-        info_label = QLabel("Colors help identify asset types")
+        info_label = QLabel(tr("Colors help identify asset types"))
         info_label.setFont(QFont("Arial", 8))
         info_label.setStyleSheet("color: #7f8c8d; font-style: italic;")
         info_label.setAlignment(Qt.AlignmentFlag.AlignCenter)
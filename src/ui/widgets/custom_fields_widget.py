@@ -0,0 +1,114 @@
+# -*- coding: utf-8 -*-
+"""
+Custom Fields Widget
+Editable custom metadata fields of the selected asset, laid out from the library schema
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, Dict, List, Optional
+
+from PySide6.QtWidgets import (
+    QGroupBox,
+    QFormLayout,
+    QLineEdit,
+    QComboBox,
+    QCheckBox,
+    QWidget,
+)
+from PySide6.QtCore import Signal
+from PySide6.QtGui import QDoubleValidator
+
+from ...core.models.metadata_field import (
+    FIELD_TYPE_BOOLEAN,
+    FIELD_TYPE_ENUM,
+    FIELD_TYPE_NUMBER,
+    MetadataField,
+)
+
+
+class CustomFieldsWidget(QGroupBox):
+    """
+    Custom Fields Widget - Single Responsibility for custom metadata editing
+    Hidden while the library has no schema; every edit is emitted right away
+    """
+
+    field_changed = Signal(object, object)  # MetadataField, entered value
+
+    def __init__(self, parent=None):
+        super().__init__("Custom Fields", parent)
+
+        self._fields: List[MetadataField] = []
+        self._editors: Dict[str, QWidget] = {}
+        self._loading = False
+
+        self.setStyleSheet("QGroupBox { font-weight: bold; color: #cccccc; }")
+        self._layout = QFormLayout(self)
+        self.setVisible(False)
+
+    def set_schema(self, fields: List[MetadataField]) -> None:
+        """Build one editor per schema field"""
+        if fields == self._fields:
+            return
+        self._fields = list(fields)
+        while self._layout.rowCount():
+            self._layout.removeRow(0)
+        self._editors = {}
+
+        for schema_field in self._fields:
+            editor = self._create_editor(schema_field)
+            editor.setToolTip(schema_field.description or f"Search with {schema_field.key}:")
+            editor.setEnabled(False)
+            self._editors[schema_field.name] = editor
+            self._layout.addRow(f"{schema_field.name}:", editor)
+        self.setVisible(bool(self._fields))
+
+    def set_values(self, values: Optional[Dict[str, Any]]) -> None:
+        """Show an asset's values; None clears and disables the editors"""
+        self._loading = True
+        try:
+            for schema_field in self._fields:
+                editor = self._editors[schema_field.name]
+                editor.setEnabled(values is not None)
+                value = (values or {}).get(schema_field.name)
+                if isinstance(editor, QCheckBox):
+                    editor.setChecked(bool(value))
+                elif isinstance(editor, QComboBox):
+                    index = editor.findText(str(value)) if value is not None else 0
+                    editor.setCurrentIndex(max(index, 0))
+                else:
+                    editor.setText(schema_field.format_value(value))
+        finally:
+            self._loading = False
+
+    def _create_editor(self, schema_field: MetadataField) -> QWidget:
+        """Create the input matching a field type"""
+        if schema_field.field_type == FIELD_TYPE_BOOLEAN:
+            check = QCheckBox()
+            check.toggled.connect(lambda checked: self._emit(schema_field, checked))
+            return check
+
+        if schema_field.field_type == FIELD_TYPE_ENUM:
+            combo = QComboBox()
+            combo.addItem("")  # Not set
+            combo.addItems(list(schema_field.options))
+            combo.currentTextChanged.connect(lambda text: self._emit(schema_field, text))
+            return combo
+
+        line_edit = QLineEdit()
+        if schema_field.field_type == FIELD_TYPE_NUMBER:
+            line_edit.setValidator(QDoubleValidator(line_edit))
+        line_edit.editingFinished.connect(lambda: self._on_text_edited(schema_field, line_edit))
+        return line_edit
+
+    def _on_text_edited(self, schema_field: MetadataField, line_edit: QLineEdit) -> None:
+        """Report typed values once, when editing finishes with a change"""
+        if line_edit.isModified():
+            line_edit.setModified(False)
+            self._emit(schema_field, line_edit.text())
+
+    def _emit(self, schema_field: MetadataField, value: Any) -> None:
+        """Report an edit made by the artist"""
+        if not self._loading:
+            self.field_changed.emit(schema_field, value)
@@ -0,0 +1,91 @@
+# -*- coding: utf-8 -*-
+"""
+Reference Update Banner Widget
+Non-blocking notice that scene references have newer published versions
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import Any, List
+
+from PySide6.QtWidgets import QFrame, QHBoxLayout, QLabel, QPushButton
+from PySide6.QtCore import Signal
+
+from ..theme import UITheme
+from ...services.localization_service_impl import tr
+
+
+class ReferenceUpdateBanner(QFrame):
+    """
+    Reference Update Banner - Single Responsibility for outdated reference notices
+    Stays hidden while nothing is outdated or the current notice was dismissed
+    """
+
+    update_all_requested = Signal()
+    review_requested = Signal()
+
+    def __init__(self, parent=None):
+        super().__init__(parent)
+
+        self._dismissed: frozenset = frozenset()
+        self._current: frozenset = frozenset()
+
+        self._setup_ui()
+        self.setVisible(False)
+
+    def _setup_ui(self) -> None:
+        """Setup banner UI - Single Responsibility"""
+        self.setStyleSheet(f"""
+            QFrame {{
+                background-color: #5c4b1f;
+                border: 1px solid #b8860b;
+                border-radius: 4px;
+            }}
+            QLabel {{
+                color: {UITheme.TEXT_PRIMARY};
+                border: none;
+                padding: 2px 4px;
+            }}
+        """)
+
+        layout = QHBoxLayout(self)
+        layout.setContentsMargins(8, 4, 8, 4)
+
+        self._message_label = QLabel("")
+        layout.addWidget(self._message_label, 1)
+
+        review_btn = QPushButton(tr("Review..."))
+        review_btn.clicked.connect(self.review_requested.emit)
+        layout.addWidget(review_btn)
+
+        update_all_btn = QPushButton(tr("Update All"))
+        update_all_btn.clicked.connect(self.update_all_requested.emit)
+        layout.addWidget(update_all_btn)
+
+        dismiss_btn = QPushButton(tr("Dismiss"))
+        dismiss_btn.clicked.connect(self._on_dismiss)
+        layout.addWidget(dismiss_btn)
+
+    def set_updates(self, updates: List[Any]) -> None:
+        """Show the outdated references; a dismissed set stays hidden until it changes"""
+        self._current = frozenset((u.reference_node, u.latest_version) for u in updates)
+        if not updates:
+            self.setVisible(False)
+            return
+
+        if len(updates) == 1:
+            update = updates[0]
+            author = f" by {update.latest_author}" if update.latest_author else ""
+            message = f"{update.label} - a newer version was published{author}"
+        else:
+            assets = sorted({update.asset_file.stem for update in updates})
+            message = f"{len(updates)} scene references are outdated: {', '.join(assets)}"
+        self._message_label.setText(message)
+        self._message_label.setToolTip("\n".join(update.label for update in updates))
+        self.setVisible(not self._current <= self._dismissed)
+
+    def _on_dismiss(self) -> None:
+        """Hide the notice until another reference becomes outdated"""
+        self._dismissed = self._current
+        self.setVisible(False)
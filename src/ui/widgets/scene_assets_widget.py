@@ -0,0 +1,296 @@
+# -*- coding: utf-8 -*-
+"""
+Scene Assets Widget
+Outliner of the library assets in the open scene with their version, lock, and updates
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from pathlib import Path
+from typing import Any, List, Optional
+
+from PySide6.QtWidgets import (
+    QWidget,
+    QDialog,
+    QVBoxLayout,
+    QHBoxLayout,
+    QLabel,
+    QPushButton,
+    QTreeWidget,
+    QTreeWidgetItem,
+    QHeaderView,
+    QMenu,
+    QMessageBox,
+)
+from PySide6.QtCore import Qt, Signal
+from PySide6.QtGui import QColor
+
+from ...services.localization_service_impl import tr
+
+OUTDATED_COLOR = "#d7a84a"
+LOCKED_COLOR = "#e07b6a"
+MISSING_COLOR = "#e74c3c"
+
+
+class SceneAssetsWidget(QWidget):
+    """
+    Scene Assets Widget - Single Responsibility for listing assets loaded in the scene
+    Rows are rebuilt from a fresh scan, so they never outlive the nodes they describe
+    """
+
+    COLUMNS = ["Asset", "Type", "Version", "Lock", "Status"]
+
+    show_in_library_requested = Signal(object)  # Path of the library asset
+    replace_requested = Signal(object)  # SceneAsset to replace with the library selection
+    status_message = Signal(str)
+
+    def __init__(self, scene_asset_service, missing_asset_service=None, parent=None):
+        super().__init__(parent)
+
+        self._service = scene_asset_service
+        self._missing_service = missing_asset_service  # Places boxes for missing references
+        self._cmds: Any = None
+        self._library_root: Optional[Path] = None
+        self._scene_assets: List[Any] = []
+
+        self._setup_ui()
+
+    def _setup_ui(self) -> None:
+        """Setup widget UI - Single Responsibility"""
+        layout = QVBoxLayout(self)
+        layout.setContentsMargins(4, 4, 4, 4)
+        layout.setSpacing(4)
+
+        header_layout = QHBoxLayout()
+        title_label = QLabel(tr("Scene Assets"))
+        title_label.setStyleSheet(
+            "font-weight: bold; font-size: 14px; color: #cccccc; padding: 4px;"
+        )
+        header_layout.addWidget(title_label)
+        self._summary_label = QLabel("")
+        self._summary_label.setStyleSheet("color: #999999;")
+        header_layout.addWidget(self._summary_label, 1)
+        refresh_btn = QPushButton(tr("Refresh"))
+        refresh_btn.setToolTip(tr("Scan the open scene again"))
+        refresh_btn.clicked.connect(lambda: self.refresh())
+        header_layout.addWidget(refresh_btn)
+        layout.addLayout(header_layout)
+
+        self._tree = QTreeWidget()
+        self._tree.setHeaderLabels(self.COLUMNS)
+        self._tree.setRootIsDecorated(False)
+        self._tree.setSelectionMode(QTreeWidget.SelectionMode.ExtendedSelection)
+        self._tree.header().setSectionResizeMode(0, QHeaderView.ResizeMode.Stretch)
+        self._tree.setContextMenuPolicy(Qt.ContextMenuPolicy.CustomContextMenu)
+        self._tree.customContextMenuRequested.connect(self._show_context_menu)
+        self._tree.itemDoubleClicked.connect(lambda item, _column: self._on_select([item]))
+        layout.addWidget(self._tree, 1)
+
+    def refresh(self, cmds: Any = None, library_root: Optional[Path] = None) -> None:
+        """
+        List the managed assets of the open scene
+
+        Args:
+            cmds: maya.cmds module, kept for later refreshes and actions
+            library_root: Current library, kept like cmds
+        """
+        if cmds is not None:
+            self._cmds = cmds
+        if library_root is not None:
+            self._library_root = library_root
+        self._tree.clear()
+        if self._cmds is None:
+            self._summary_label.setText(tr("Scene assets are listed inside Maya"))
+            return
+
+        try:
+            self._scene_assets = self._service.scan(self._cmds, self._library_root)
+        except Exception as e:
+            self._scene_assets = []
+            self._summary_label.setText(f"Scan failed: {e}")
+            return
+        if self._missing_service is not None:
+            self._missing_service.sync(self._cmds, self._scene_assets, self._library_root)
+
+        for scene_asset in self._scene_assets:
+            item = QTreeWidgetItem(
+                [
+                    scene_asset.label,
+                    "Referenced" if scene_asset.is_referenced else "Imported",
+                    scene_asset.version_label,
+                    scene_asset.locked_by or "-",
+                    scene_asset.update_status,
+                ]
+            )
+            item.setData(0, Qt.ItemDataRole.UserRole, scene_asset)
+            tooltip = str(scene_asset.file_path or scene_asset.asset_file)
+            if scene_asset.provenance is not None:
+                tooltip += f"\n{scene_asset.provenance.description}"
+            item.setToolTip(0, tooltip)
+            if scene_asset.is_outdated:
+                item.setForeground(4, QColor(OUTDATED_COLOR))
+            if scene_asset.locked_by:
+                item.setForeground(3, QColor(LOCKED_COLOR))
+            if scene_asset.missing:
+                item.setForeground(0, QColor(MISSING_COLOR))
+                item.setForeground(4, QColor(MISSING_COLOR))
+            self._tree.addTopLevelItem(item)
+
+        outdated = sum(1 for scene_asset in self._scene_assets if scene_asset.is_outdated)
+        missing = sum(1 for scene_asset in self._scene_assets if scene_asset.missing)
+        summary = f"{len(self._scene_assets)} asset(s)"
+        if outdated:
+            summary += f", {outdated} outdated"
+        if missing:
+            summary += f", {missing} missing"
+        self._summary_label.setText(summary)
+
+    def _show_context_menu(self, position) -> None:
+        """Offer select, resolve, update, replace, remove, and show in library for the rows"""
+        items = self._tree.selectedItems()
+        if not items or self._cmds is None:
+            return
+        scene_assets = [item.data(0, Qt.ItemDataRole.UserRole) for item in items]
+
+        menu = QMenu(self)
+        select_action = menu.addAction(tr("Select in Scene"))
+        select_action.triggered.connect(lambda: self._on_select(items))
+
+        missing = [scene_asset for scene_asset in scene_assets if scene_asset.missing]
+        resolve_action = menu.addAction(tr("Resolve Missing File..."))
+        resolve_action.setToolTip(tr("Load another version, repath the reference, or remove it"))
+        resolve_action.setEnabled(len(missing) == 1 and self._missing_service is not None)
+        resolve_action.triggered.connect(lambda: self._on_resolve_missing(missing[0]))
+
+        update_action = menu.addAction(tr("Update to Latest"))
+        update_action.setEnabled(any(scene_asset.is_outdated for scene_asset in scene_assets))
+        update_action.triggered.connect(lambda: self._on_update(scene_assets))
+
+        imported = [scene_asset for scene_asset in scene_assets if not scene_asset.is_referenced]
+        merge_action = menu.addAction(tr("Update in Place..."))
+        merge_action.setToolTip(
+            tr("Transfer the latest shapes, UVs, and shading onto the imported nodes")
+        )
+        merge_action.setEnabled(bool(imported))
+        merge_action.triggered.connect(lambda: self._on_update_in_place(imported))
+
+        replace_action = menu.addAction(tr("Replace with Library Selection"))
+        replace_action.setEnabled(len(scene_assets) == 1)
+        replace_action.triggered.connect(lambda: self.replace_requested.emit(scene_assets[0]))
+
+        remove_action = menu.addAction(tr("Remove from Scene"))
+        remove_action.triggered.connect(lambda: self._on_remove(scene_assets))
+
+        menu.addSeparator()
+        show_action = menu.addAction(tr("Show in Library"))
+        show_action.setEnabled(len(scene_assets) == 1)
+        show_action.triggered.connect(
+            lambda: self.show_in_library_requested.emit(scene_assets[0].asset_file)
+        )
+
+        menu.exec(self._tree.viewport().mapToGlobal(position))
+
+    def _on_select(self, items: List[QTreeWidgetItem]) -> None:
+        """Select the rows' nodes in Maya"""
+        if self._cmds is None:
+            return
+        nodes: List[str] = []
+        for item in items:
+            scene_asset = item.data(0, Qt.ItemDataRole.UserRole)
+            if scene_asset.missing and self._missing_service is not None:
+                placeholders = self._missing_service.find_placeholders(self._cmds)
+                if scene_asset.reference_node in placeholders:
+                    nodes.append(placeholders[scene_asset.reference_node])
+                continue  # An unloaded reference has no nodes of its own
+            nodes.extend(self._service.get_nodes(self._cmds, scene_asset))
+        if nodes:
+            self._cmds.select(nodes, replace=True)
+        self.status_message.emit(f"Selected {len(nodes)} node(s)")
+
+    def _on_update(self, scene_assets: List[Any]) -> None:
+        """Load the latest version of the outdated rows and report failures"""
+        updated, errors = 0, []
+        for scene_asset in scene_assets:
+            if not scene_asset.is_outdated:
+                continue
+            try:
+                self._service.update(self._cmds, scene_asset)
+                updated += 1
+            except Exception as e:
+                errors.append(f"{scene_asset.label}: {e}")
+        if errors:
+            QMessageBox.warning(self, tr("Update Failed"), "\n".join(errors))
+        self.status_message.emit(f"Updated {updated} scene asset(s) to latest")
+        self.refresh()
+
+    def _on_update_in_place(self, scene_assets: List[Any]) -> None:
+        """Transfer the latest version onto imported rows and report what was skipped"""
+        from ..dialogs.update_in_place_dialog import UpdateInPlaceDialog
+
+        label = scene_assets[0].label if len(scene_assets) == 1 else "the selection"
+        dialog = UpdateInPlaceDialog(label, len(scene_assets), self)
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+        options = dialog.get_options()
+
+        notes, errors = [], []
+        for scene_asset in scene_assets:
+            try:
+                _, report = self._service.update_in_place(self._cmds, scene_asset, options)
+                notes.extend(f"{scene_asset.label} - {name}" for name in report.skipped)
+            except Exception as e:
+                errors.append(f"{scene_asset.label}: {e}")
+        if errors:
+            QMessageBox.warning(self, tr("Update Failed"), "\n".join(errors))
+        elif notes:
+            QMessageBox.information(self, tr("Some Meshes Skipped"), "\n".join(notes[:20]))
+        updated = len(scene_assets) - len(errors)
+        self.status_message.emit(f"Updated {updated} scene asset(s) in place")
+        self.refresh()
+
+    def _on_remove(self, scene_assets: List[Any]) -> None:
+        """Remove the rows' assets from the scene after confirming"""
+        names = "\n".join(scene_asset.label for scene_asset in scene_assets[:10])
+        answer = QMessageBox.question(
+            self,
+            tr("Remove from Scene"),
+            f"Remove {len(scene_assets)} asset(s) from the scene?\n\n{names}",
+        )
+        if answer != QMessageBox.StandardButton.Yes:
+            return
+
+        errors = []
+        for scene_asset in scene_assets:
+            try:
+                self._service.remove(self._cmds, scene_asset)
+            except Exception as e:
+                errors.append(f"{scene_asset.label}: {e}")
+        if errors:
+            QMessageBox.warning(self, tr("Remove Failed"), "\n".join(errors))
+        self.status_message.emit(f"Removed {len(scene_assets) - len(errors)} scene asset(s)")
+        self.refresh()
+
+    def _on_resolve_missing(self, scene_asset: Any) -> None:
+        """Load another version of a missing reference, repath it, or remove it"""
+        from ..dialogs.missing_asset_dialog import MissingAssetDialog
+
+        placeholder = self._missing_service.find_placeholders(self._cmds).get(
+            scene_asset.reference_node
+        )
+        info = self._missing_service.read_info(self._cmds, placeholder) if placeholder else {}
+        dialog = MissingAssetDialog(
+            scene_asset, self._missing_service.get_version_choices(scene_asset), info, self
+        )
+        if dialog.exec() != QDialog.DialogCode.Accepted:
+            return
+
+        try:
+            self._missing_service.resolve(
+                self._cmds, scene_asset, dialog.get_resolution(), dialog.get_file_path()
+            )
+        except Exception as e:
+            QMessageBox.warning(self, tr("Resolve Failed"), f"{scene_asset.label}: {e}")
+            return
+        self.status_message.emit(f"Resolved missing {scene_asset.label}")
+        self.refresh()
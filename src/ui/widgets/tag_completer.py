@@ -0,0 +1,44 @@
+# -*- coding: utf-8 -*-
+"""
+Tag Completer Widget
+Autocomplete for comma separated tag fields, matching any part of a nested tag
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+from typing import List
+
+from PySide6.QtWidgets import QCompleter, QLineEdit
+from PySide6.QtCore import Qt, QStringListModel
+
+
+class TagCompleter(QCompleter):
+    """
+    Tag Completer - Single Responsibility for completing the tag being typed
+    Only the text after the last comma is completed; earlier tags are kept as typed
+    """
+
+    def __init__(self, tags: List[str], line_edit: QLineEdit):
+        super().__init__(QStringListModel(sorted(tags, key=str.lower)), line_edit)
+        self.setCaseSensitivity(Qt.CaseSensitivity.CaseInsensitive)
+        # "forest" finds environment/exterior/forest
+        self.setFilterMode(Qt.MatchFlag.MatchContains)
+        self.setWidget(line_edit)
+        self.activated.connect(self._insert_tag)
+        line_edit.textEdited.connect(self._update_prefix)
+        self._line_edit = line_edit
+
+    def _update_prefix(self, text: str) -> None:
+        """Complete the tag after the last comma"""
+        current = text.rpartition(",")[2].strip()
+        if not current:
+            self.popup().hide()
+            return
+        self.setCompletionPrefix(current)
+        self.complete()
+
+    def _insert_tag(self, tag: str) -> None:
+        """Replace the tag being typed with the picked one"""
+        head = self._line_edit.text().rpartition(",")[0]
+        self._line_edit.setText(f"{head}, {tag}, " if head else f"{tag}, ")
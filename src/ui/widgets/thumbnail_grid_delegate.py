@@ -0,0 +1,101 @@
+# -*- coding: utf-8 -*-
+"""
+Thumbnail Grid Delegate
+Paint library grid items from decoded thumbnails, on a GPU viewport where available
+
+Author: Mike Stumbo
+Enterprise Refactoring: Clean Code & SOLID Principles
+"""
+
+import os
+from typing import Any, Callable, Optional
+
+from PySide6.QtWidgets import (
+    QAbstractItemView,
+    QApplication,
+    QStyle,
+    QStyledItemDelegate,
+    QStyleOptionViewItem,
+)
+from PySide6.QtCore import QModelIndex, QRect, QSize, Qt
+from PySide6.QtGui import QColor, QIcon, QImage, QPalette
+
+# Set to 0 for a software-painted grid, e.g. with remote desktops lacking OpenGL
+GPU_GRID_ENVIRONMENT = "ASSETMANAGER_GPU_GRID"
+
+
+def enable_gpu_viewport(view: QAbstractItemView) -> bool:
+    """
+    Paint a grid through OpenGL so thumbnails are drawn from textures on the GPU
+
+    Returns:
+        True when the view now has an OpenGL viewport
+    """
+    if os.environ.get(GPU_GRID_ENVIRONMENT, "1").strip() == "0":
+        return False
+    try:
+        from PySide6.QtOpenGLWidgets import QOpenGLWidget
+    except ImportError:
+        return False
+    try:
+        view.setViewport(QOpenGLWidget())
+        return True
+    except Exception as e:
+        print(f"[WARNING] Asset grid falls back to software painting: {e}")
+        return False
+
+
+class ThumbnailGridDelegate(QStyledItemDelegate):
+    """
+    Thumbnail Grid Delegate - Single Responsibility for painting grid items
+    Only thumbnails already decoded are drawn; the others show a placeholder until their
+    decode finishes, so painting never reads the disk
+    """
+
+    def __init__(self, image_for: Callable[[QModelIndex], Optional[QImage]], parent=None):
+        """
+        Args:
+            image_for: Gets the decoded thumbnail of an item, None while it is not
+        """
+        super().__init__(parent)
+        self._image_for = image_for
+
+    def _style_option(self, option: QStyleOptionViewItem, index: QModelIndex) -> Any:
+        """Get the item's style option, laid out as if it had an icon"""
+        view_option = QStyleOptionViewItem(option)
+        self.initStyleOption(view_option, index)
+        view_option.features |= QStyleOptionViewItem.ViewItemFeature.HasDecoration
+        view_option.icon = QIcon()  # The thumbnail is painted over the reserved space
+        return view_option
+
+    def paint(self, painter: Any, option: QStyleOptionViewItem, index: QModelIndex) -> None:
+        """Paint background, selection, and text as the style does, then the thumbnail"""
+        view_option = self._style_option(option, index)
+        widget = view_option.widget
+        style = widget.style() if widget is not None else QApplication.style()
+        style.drawControl(QStyle.ControlElement.CE_ItemViewItem, view_option, painter, widget)
+
+        target = style.subElementRect(
+            QStyle.SubElement.SE_ItemViewItemDecoration, view_option, widget
+        )
+        image = self._image_for(index)
+        if image is None or image.isNull():
+            placeholder = QColor(view_option.palette.color(QPalette.ColorRole.Mid))
+            placeholder.setAlpha(60)
+            painter.fillRect(target.adjusted(4, 4, -4, -4), placeholder)
+            return
+
+        size = image.deviceIndependentSize().toSize()
+        size.scale(target.size(), Qt.AspectRatioMode.KeepAspectRatio)
+        rect = QRect(target.topLeft(), size)
+        rect.moveCenter(target.center())
+        painter.drawImage(rect, image)
+
+    def sizeHint(self, option: QStyleOptionViewItem, index: QModelIndex) -> QSize:
+        """Get the item size with room for the thumbnail"""
+        view_option = self._style_option(option, index)
+        widget = view_option.widget
+        style = widget.style() if widget is not None else QApplication.style()
+        return style.sizeFromContents(
+            QStyle.ContentsType.CT_ItemViewItem, view_option, QSize(), widget
+        )
@@ -0,0 +1,102 @@
+"""
+Test suite for the library activity log
+
+Validates recording publishes, imports, deletes, and status changes with artist
+and machine, per-asset history, and library-wide filters by artist and date.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from datetime import date, timedelta
+from pathlib import Path
+
+
+def test_record_and_filter_activity():
+    """Events come back newest first, filtered by asset, artist, action, and day"""
+    from src.core.models.activity_event import (
+        ACTIVITY_IMPORT,
+        ACTIVITY_PUBLISH,
+        ACTIVITY_STATUS,
+    )
+    from src.services.activity_service_impl import ActivityService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_activity_"))
+    database = MetadataDatabase(root)
+    service = ActivityService(database_factory=lambda library_root: database)
+    car = root / "assets" / "scenes" / "car.ma"
+    tree = root / "assets" / "scenes" / "tree.ma"
+
+    service.record(root, ACTIVITY_PUBLISH, car, "anna", version=1)
+    service.record(root, ACTIVITY_PUBLISH, car, "anna", version=2, notes="")
+    service.record(root, ACTIVITY_IMPORT, car, "ben", mode="reference")
+    service.record(root, ACTIVITY_STATUS, car, "lead", status="Approved", note="Looks good")
+    event = service.record(root, ACTIVITY_PUBLISH, tree, "ben", version=1)
+    assert event.asset_key == "assets/scenes/tree.ma" and event.machine
+    assert event.details == {"version": 1}
+
+    recent = service.get_asset_events(root, car, limit=3)
+    assert [e.summary for e in recent] == [
+        "Status: Approved - Looks good",
+        "Imported (reference)",
+        "Published v002",
+    ]
+    assert recent[0].description.endswith("lead@" + recent[0].machine + ": " + recent[0].summary)
+
+    assert service.get_users(root) == ["anna", "ben", "lead"]
+    assert [e.asset_name for e in service.get_events(root, user="ben")] == ["tree", "car"]
+    publishes = service.get_events(root, action=ACTIVITY_PUBLISH)
+    assert len(publishes) == 3 and publishes[-1].details == {"version": 1}
+
+    today = date.today()
+    assert len(service.get_events(root, since=today, until=today)) == 5
+    assert service.get_events(root, until=today - timedelta(days=1)) == []
+    assert service.get_events(root, since=today + timedelta(days=1)) == []
+
+    # The trail outlives the asset
+    database.remove_asset(car)
+    assert len(service.get_asset_events(root, car)) == 5 - 1
+    database.close()
+
+
+def test_hooks_map_to_activity_and_failures_never_raise():
+    """Post-action hooks log their action; pre hooks and broken databases log nothing"""
+    from src.core.models.activity_event import ACTIVITY_DELETE, ACTIVITY_PUBLISH
+    from src.services.activity_service_impl import ActivityService
+    from src.services.hook_service_impl import (
+        HOOK_ASSET_DELETED,
+        HOOK_POST_PUBLISH,
+        HOOK_PRE_PUBLISH,
+    )
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_activity_"))
+    database = MetadataDatabase(root)
+    service = ActivityService(database_factory=lambda library_root: database)
+    car = root / "car.ma"
+    context = {
+        "asset_file": car,
+        "asset_name": "car",
+        "selection": ["|car_geo"],
+        "files": [car],
+        "notes": "",
+    }
+
+    assert service.record_hook(HOOK_PRE_PUBLISH, root, context) is None
+    published = service.record_hook(HOOK_POST_PUBLISH, root, dict(context, version=4))
+    assert published.action == ACTIVITY_PUBLISH and published.details == {"version": 4}
+    deleted = service.record_hook(
+        HOOK_ASSET_DELETED, root, {"asset_file": car, "mode": "trash", "trash_entry": "e1"}
+    )
+    assert deleted.action == ACTIVITY_DELETE and deleted.summary == "Deleted (trash)"
+    assert [e.action for e in service.get_asset_events(root, car)] == ["delete", "publish"]
+
+    assert service.record(None, ACTIVITY_PUBLISH, car) is None
+
+    def broken(library_root):
+        raise OSError("library is read-only")
+
+    assert ActivityService(database_factory=broken).record(root, ACTIVITY_PUBLISH, car) is None
+    database.close()
@@ -0,0 +1,101 @@
+"""
+Test suite for Alembic cache assets
+
+Validates the AbcExport job, the settings sidecar, and geometry vs. GPU cache loading
+against a stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import re
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for Alembic export and import"""
+
+    def __init__(self):
+        self.assemblies = ["|persp", "|crowd"]
+        self.jobs = []
+        self.nodes = []
+        self.attributes = {}
+
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            return list(self.assemblies)
+        return ["|crowd", "|crowd|agent1", "|props"]
+
+    def pluginInfo(self, plugin, **kwargs):
+        return True
+
+    def AbcExport(self, jobArg):
+        self.jobs.append(jobArg)
+        Path(re.search(r'-file "(.+)"', jobArg).group(1)).write_bytes(b"Ogawa")
+
+    def AbcImport(self, path, mode):
+        self.assemblies += ["|agent1", "|agent2"]
+
+    def createNode(self, node_type, name, parent=None):
+        self.nodes.append((node_type, name, parent))
+        return name
+
+    def setAttr(self, attribute, value, type=None):
+        self.attributes[attribute] = value
+
+
+def test_export_writes_cache_and_settings():
+    """Nested roots are dropped, and the frame range / step / space reach job and sidecar"""
+    from src.core.models.alembic_cache import AlembicCacheInfo
+    from src.services.alembic_service_impl import AlembicService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_abc_"))
+    cache_file = root / "crowd run.abc"
+    cmds = FakeCmds()
+    service = AlembicService()
+
+    roots = service.get_export_roots(cmds, ["crowd", "agent1", "props"])
+    assert roots == ["|crowd", "|props"]
+
+    info = AlembicCacheInfo(1001.0, 1100.0, step=0.5, world_space=True, roots=tuple(roots))
+    sidecar = service.export_cache(cmds, cache_file, info)
+
+    job = cmds.jobs[0]
+    assert "-frameRange 1001 1100" in job and "-step 0.5" in job and "-worldSpace" in job
+    assert "-root |crowd -root |props" in job
+    assert job.endswith(f'-file "{cache_file.as_posix()}"')
+    assert sidecar.name == "crowd run.abc.json"
+
+    stored = service.read_cache_info(cache_file)
+    assert stored == info
+    assert stored.frame_count == 199
+    assert stored.description == "Frames 1001-1100, step 0.5, world space"
+    assert service.read_cache_info(root / "plain.abc") is None
+
+    try:
+        service.export_cache(cmds, cache_file, AlembicCacheInfo(1.0, 10.0))
+        assert False, "export without roots must fail"
+    except RuntimeError:
+        pass
+
+
+def test_import_modes():
+    """Geometry import returns the new roots; GPU cache mode builds one gpuCache node"""
+    from src.services.alembic_service_impl import (
+        IMPORT_MODE_GEOMETRY,
+        IMPORT_MODE_GPU_CACHE,
+        AlembicService,
+    )
+
+    cmds = FakeCmds()
+    service = AlembicService()
+    cache_file = Path(tempfile.mkdtemp(prefix="assetManager_abc_")) / "crowd_run.abc"
+
+    roots = service.import_cache(cmds, cache_file, IMPORT_MODE_GEOMETRY)
+    assert roots == ["|agent1", "|agent2"]
+
+    roots = service.import_cache(cmds, cache_file, IMPORT_MODE_GPU_CACHE)
+    assert roots == ["crowd_run_gpu"]
+    assert cmds.nodes[-1] == ("gpuCache", "crowd_run_gpuShape", "crowd_run_gpu")
+    assert cmds.attributes["crowd_run_gpuShape.cacheFileName"] == str(cache_file)
@@ -0,0 +1,106 @@
+"""
+Test suite for the animation clip library
+
+Validates namespace remapping, clip storage, and applying clips onto a rig in another
+namespace and frame rate against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Records keys set through the clip service"""
+
+    def __init__(self, plugs, time_unit="film"):
+        self.plugs = set(plugs)
+        self.time_unit = time_unit
+        self.keys = []
+        self.cleared = []
+
+    def currentUnit(self, **kwargs):
+        return self.time_unit
+
+    def objExists(self, plug):
+        return plug in self.plugs
+
+    def cutKey(self, node, attribute, time, clear):
+        self.cleared.append((f"{node}.{attribute}", time))
+
+    def setKeyframe(self, node, attribute, time, value):
+        self.keys.append((f"{node}.{attribute}", time, value))
+
+    def keyTangent(self, *args, **kwargs):
+        pass
+
+    def setInfinity(self, *args, **kwargs):
+        pass
+
+
+def _clip(fps=24.0):
+    key = ["auto", "auto", 0.0, 0.0, 1.0, 1.0]
+    return {
+        "type": "anim_clip",
+        "name": "wave",
+        "frame_range": [10, 20],
+        "fps": fps,
+        "source_namespace": "heroA",
+        "curves": [
+            {
+                "node": "arm_ctrl",
+                "attribute": "rotateZ",
+                "keys": [[0, 0.0] + key, [10, 45.0] + key],
+            },
+            {"node": "hand_ctrl", "attribute": "rotateX", "keys": [[0, 5.0] + key]},
+        ],
+    }
+
+
+def test_namespace_helpers():
+    """Namespaces and DAG paths are stripped and the target namespace reapplied"""
+    from src.services.anim_clip_service_impl import (
+        get_namespace,
+        remap_node,
+        strip_namespace,
+        time_unit_to_fps,
+    )
+
+    assert strip_namespace("|heroA:root|heroA:arm_ctrl") == "arm_ctrl"
+    assert remap_node("arm_ctrl", "heroB") == "heroB:arm_ctrl"
+    assert remap_node("arm_ctrl", "") == "arm_ctrl"
+    assert get_namespace("heroA:arm_ctrl") == "heroA"
+    assert time_unit_to_fps("ntsc") == 30.0
+
+
+def test_save_and_load_round_trip():
+    """Clips are written to .animclip files and rejected when not clip data"""
+    from src.services.anim_clip_service_impl import AnimClipService
+
+    service = AnimClipService()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_animclip_"))
+    saved = service.save_clip(root / "wave", _clip())
+
+    assert saved.suffix == ".animclip"
+    assert service.load_clip(saved)["curves"][0]["node"] == "arm_ctrl"
+
+    (root / "bogus.animclip").write_text("{}", encoding="utf-8")
+    assert service.load_clip(root / "bogus.animclip") is None
+
+
+def test_apply_remaps_namespace_and_rescales_time():
+    """A 24 fps clip lands on the heroB rig at 30 fps; missing channels are reported"""
+    from src.services.anim_clip_service_impl import AnimClipService
+
+    cmds = FakeCmds({"heroB:arm_ctrl.rotateZ"}, time_unit="ntsc")
+    result = AnimClipService().apply_clip(cmds, _clip(), "heroB", start_frame=100)
+
+    assert result.applied_curves == 1
+    assert result.missing == ["heroB:hand_ctrl.rotateX"]
+    assert cmds.keys == [
+        ("heroB:arm_ctrl.rotateZ", 100.0, 0.0),
+        ("heroB:arm_ctrl.rotateZ", 112.5, 45.0),
+    ]
+    assert cmds.cleared == [("heroB:arm_ctrl.rotateZ", (100, 112.5))]
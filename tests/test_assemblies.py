@@ -0,0 +1,189 @@
+"""
+Test suite for scene assembly assets
+
+Validates saving a layout of library assets with placements and version pins,
+bumping pinned children to their latest versions, and rebuilding the layout
+against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _matrix(x):
+    return [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, x, 0.0, 0.0, 1.0]
+
+
+class FakeSceneAssets:
+    """Scene asset service stand-in: nodes come from the scene assets themselves"""
+
+    def __init__(self):
+        self.tagged = []
+
+    def get_nodes(self, cmds, scene_asset):
+        return list(scene_asset.nodes)
+
+    def tag_imported(self, cmds, roots, asset_file, library_root=None, level="", version=None):
+        self.tagged.append((tuple(roots), Path(asset_file).name, version))
+        return "provenance1"
+
+
+class FakeCmds:
+    """Tracks loaded files, top-level nodes, parenting, and matrices"""
+
+    def __init__(self):
+        self.assemblies = ["|persp"]
+        self.loaded = []
+        self.matrices = {}
+        self.attributes = {}
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def loadPlugin(self, plugin, quiet=False):
+        pass
+
+    def ls(self, nodes=None, long=False, assemblies=False):
+        if assemblies:
+            return list(self.assemblies)
+        return [nodes if nodes.startswith("|") else f"|{nodes}"]
+
+    def xform(self, node, query=False, matrix=None, worldSpace=False):
+        if query:
+            return self.matrices[node]
+        self.matrices[node] = list(matrix)
+
+    def file(self, path, reference=False, i=False, namespace=None, type=None, ignoreVersion=False):
+        stem = Path(path).stem
+        count = sum(1 for loaded in self.loaded if Path(loaded[0]).stem == stem)
+        prefix = f"{namespace}{count or ''}:" if reference else ""
+        suffix = str(count) if count and not reference else ""
+        self.loaded.append((path, "reference" if reference else "import"))
+        self.assemblies.append(f"|{prefix}{stem}_grp{suffix}")
+
+    def group(self, empty=False, name=None):
+        self.assemblies.append(f"|{name}")
+        return name
+
+    def parent(self, node, group):
+        self.assemblies.remove(node)
+        return [f"{group}|{node.lstrip('|')}"]
+
+    def delete(self, node):
+        self.assemblies.remove(node)
+
+    def addAttr(self, node, longName=None, dataType=None):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+
+def _make_library():
+    """Library with chair (v1, v2) and lamp (v1), both current at their latest"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_assembly_"))
+    props = root / "assets" / "props"
+    props.mkdir(parents=True)
+    version_service = VersionServiceImpl()
+    for name, versions in (("chair", 2), ("lamp", 1)):
+        for number in range(1, versions + 1):
+            (props / f"{name}.ma").write_text(f"// {name} v{number}")
+            version_service.publish_version(props / f"{name}.ma")
+    return root, version_service
+
+
+def test_save_pins_versions_and_bump_to_latest():
+    """Placements and loaded versions are saved; updating pins outdated children to latest"""
+    from src.services.assembly_service_impl import AssemblyService
+    from src.services.scene_asset_service_impl import SceneAsset
+
+    root, version_service = _make_library()
+    service = AssemblyService(version_service, FakeSceneAssets())
+    cmds = FakeCmds()
+    chair = root / "assets" / "props" / "chair.ma"
+    lamp = root / "assets" / "props" / "lamp.ma"
+    cmds.matrices = {
+        "|chair:chair_grp": _matrix(1.0),
+        "|chair1:chair_grp": _matrix(2.0),
+        "|lamp_grp": _matrix(3.0),
+        "|outside_grp": _matrix(0.0),
+    }
+    scene_assets = [
+        SceneAsset(chair, ("|chair:chair_grp",), "chairRN", loaded_version=1, latest_version=2),
+        SceneAsset(chair, ("|chair1:chair_grp",), "chairRN1", loaded_version=2, latest_version=2),
+        SceneAsset(lamp, ("|lamp_grp",), loaded_version=1, latest_version=1),
+        SceneAsset(Path("/elsewhere/outside.ma"), ("|outside_grp",)),
+    ]
+    assert service.get_selected(cmds, scene_assets, ["|lamp_grp|bulb"]) == [scene_assets[2]]
+
+    descriptor = root / "assets" / "assemblies" / "room.assembly"
+    layout = service.save_assembly(cmds, scene_assets, descriptor, root, "Living room")
+    assert layout.summary == "3 placement(s) of 2 asset(s)"
+    assert [(c.label, c.mode) for c in layout.children] == [
+        ("chair v001", "reference"),
+        ("chair v002", "reference"),
+        ("lamp v001", "import"),
+    ]
+    loaded = service.load_assembly(descriptor)
+    assert loaded == layout
+    assert loaded.children[0].placements == {"chair_grp": tuple(_matrix(1.0))}
+
+    outdated = service.get_outdated(loaded, root)
+    assert [(child.label, latest) for child, latest in outdated] == [("chair v001", 2)]
+    bumped = service.bump_to_latest(descriptor, root)
+    assert [child.version for child in bumped.children] == [2, 2, 1]
+    assert service.get_outdated(service.load_assembly(descriptor), root) == []
+
+    latest = service.save_assembly(cmds, scene_assets[:1], descriptor, root, pin_latest=True)
+    assert latest.children[0].version == 2
+
+
+def test_import_rebuilds_layout_from_pinned_snapshots():
+    """Pinned children load their snapshot, placed by root name, under a tagged group"""
+    from src.core.models.assembly_layout import AssemblyChild, AssemblyLayout
+    from src.services.assembly_service_impl import ASSEMBLY_ATTRIBUTE, AssemblyService
+
+    root, version_service = _make_library()
+    scene_asset_service = FakeSceneAssets()
+    service = AssemblyService(version_service, scene_asset_service)
+    descriptor = root / "assets" / "assemblies" / "room.assembly"
+    chair = "assets/props/chair.ma"
+    service.write_assembly(
+        descriptor,
+        AssemblyLayout(
+            name="room",
+            children=(
+                AssemblyChild(chair, 1, "reference", {"chair_grp": _matrix(1.0)}),
+                AssemblyChild(chair, 2, "reference", {"chair_grp": _matrix(2.0)}),
+                AssemblyChild("assets/props/lamp.ma", 1, "import", {"lamp_grp": _matrix(3.0)}),
+                AssemblyChild("assets/props/gone.ma", 0, "import", {"gone_grp": _matrix(0.0)}),
+            ),
+        ),
+    )
+
+    cmds = FakeCmds()
+    group = service.import_assembly(cmds, descriptor, root)
+    assert group == "|room_assembly"
+    assert cmds.attributes[f"{group}.{ASSEMBLY_ATTRIBUTE}"] == descriptor.as_posix()
+    assert [Path(path).parent.name for path, _mode in cmds.loaded] == ["v001", "v002", "v001"]
+    assert [mode for _path, mode in cmds.loaded] == ["reference", "reference", "import"]
+    assert cmds.matrices["|room_assembly|chair:chair_grp"] == _matrix(1.0)
+    assert cmds.matrices["|room_assembly|chair1:chair_grp"] == _matrix(2.0)
+    assert cmds.matrices["|room_assembly|lamp_grp"] == _matrix(3.0)
+    # Only imports get provenance, recording the snapshot's version
+    assert scene_asset_service.tagged == [(("|lamp_grp",), "lamp.ma", 1)]
+
+    latest_cmds = FakeCmds()
+    service.import_assembly(latest_cmds, descriptor, root, use_latest=True)
+    assert [Path(path).parent.name for path, _mode in latest_cmds.loaded] == ["props"] * 3
+
+    empty = root / "assets" / "assemblies" / "empty.assembly"
+    service.write_assembly(empty, AssemblyLayout("empty", (AssemblyChild("assets/gone.ma"),)))
+    empty_cmds = FakeCmds()
+    assert service.import_assembly(empty_cmds, empty, root) is None
+    assert empty_cmds.assemblies == ["|persp"]
@@ -0,0 +1,125 @@
+"""
+Test suite for vendor delivery bundles
+
+Validates what a bundle packs for its assets and how it unpacks into another library.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _create_library():
+    """Library with a crate referencing a bolt and using a texture from outside"""
+    from src.services.version_service_impl import get_version_service
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_bundle_"))
+    library = root / "props_lib"
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    texture = root / "vendor_textures" / "wood.png"
+    texture.parent.mkdir()
+    texture.write_bytes(b"png")
+
+    bolt = scenes / "bolt.ma"
+    bolt.write_text('//Maya ASCII scene\ncreateNode transform -n "bolt";\n', encoding="utf-8")
+    crate = scenes / "crate.ma"
+    crate.write_text(
+        "//Maya ASCII scene\n"
+        f'file -rdi 1 -ns "bolt" -rfn "boltRN" "{bolt.as_posix()}";\n'
+        f'file -r -ns "bolt" -dr 1 -rfn "boltRN" "{bolt.as_posix()}";\n'
+        'createNode file -n "wood";\n'
+        f'\tsetAttr ".ftn" -type "string" "{texture.as_posix()}";\n',
+        encoding="utf-8",
+    )
+    (scenes / ".thumbnails" / "crate_screenshot.png").write_bytes(b"png")
+    get_version_service().publish_version(crate, notes="First")
+    get_version_service().publish_version(crate, notes="Second")
+    return root, library, crate, texture
+
+
+def test_bundle_packs_assets_dependencies_and_thumbnails():
+    """A bundle should carry the asset, what it uses, and history only when asked"""
+    import json
+    import zipfile
+
+    from src.services.asset_bundle_service_impl import AssetBundleService
+
+    root, library, crate, _texture = _create_library()
+    service = AssetBundleService()
+
+    latest = service.export_bundle(
+        library, [crate], root / "latest.zip", latest_only=True, include_dependencies=True
+    )
+    with zipfile.ZipFile(root / "latest.zip") as zf:
+        names = set(zf.namelist())
+        manifest = json.loads(zf.read("bundle.json"))
+
+    assert "assets/scenes/crate.ma" in names
+    assert "assets/scenes/bolt.ma" in names
+    assert "assets/scenes/.thumbnails/crate_screenshot.png" in names
+    assert "assets/scenes/.dependencies/crate/textures/wood.png" in names
+    assert not any("/.versions/" in name for name in names)
+    assert [(a.name, a.dependency) for a in latest.assets] == [("crate", False), ("bolt", True)]
+    assert manifest["latest_only"] is True
+    assert "2 asset(s), 1 as a dependency" in latest.summary()
+
+    full = service.export_bundle(
+        library, [crate], root / "full.zip", latest_only=False, include_dependencies=False
+    )
+    with zipfile.ZipFile(root / "full.zip") as zf:
+        names = set(zf.namelist())
+    assert "assets/scenes/.versions/crate/versions.json" in names
+    assert "assets/scenes/bolt.ma" not in names
+    assert full.assets[0].versions == 2
+
+    try:
+        service.export_bundle(library, [root / "vendor_textures" / "wood.png"], root / "x.zip")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Files outside the library should not be bundled as assets")
+
+
+def test_import_bundle_repaths_into_the_new_library():
+    """Imported scenes should point at the receiving library, refusing to overwrite"""
+    from src.services.asset_bundle_service_impl import AssetBundleService
+    from src.services.metadata_database_impl import get_metadata_database
+
+    root, library, crate, texture = _create_library()
+    get_metadata_database(library).save_asset_metadata(crate, {"tags": ["hero"]})
+    service = AssetBundleService()
+    service.export_bundle(library, [crate], root / "delivery.zip", latest_only=True)
+
+    vendor = root / "vendor_lib"
+    report = service.import_bundle(root / "delivery.zip", vendor)
+
+    imported = vendor / "assets" / "scenes" / "crate.ma"
+    text = imported.read_text(encoding="utf-8")
+    bundled_texture = vendor / "assets/scenes/.dependencies/crate/textures/wood.png"
+    assert bundled_texture.is_file()
+    assert bundled_texture.resolve().as_posix() in text
+    assert texture.as_posix() not in text
+    assert (vendor / "assets/scenes/bolt.ma").resolve().as_posix() in text
+    assert library.as_posix() not in text
+    assert report.files >= 4 and report.paths_rewritten >= 3
+    metadata = get_metadata_database(vendor).get_asset_metadata(imported) or {}
+    assert metadata.get("tags") == ["hero"]
+
+    try:
+        service.import_bundle(root / "delivery.zip", vendor)
+    except FileExistsError:
+        pass
+    else:
+        raise AssertionError("Existing assets should not be replaced without overwrite")
+    assert service.import_bundle(root / "delivery.zip", vendor, overwrite=True).files >= 4
+
+    (root / "not_a_bundle.zip").write_bytes(b"plain text")
+    try:
+        service.read_bundle(root / "not_a_bundle.zip")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Files that are not bundles should be rejected")
@@ -0,0 +1,89 @@
+"""
+Test suite for asset review notes
+
+Validates threaded notes on assets and versions, resolving threads, and the
+notifications @mentions leave for the artists named.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def test_threads_and_replies():
+    """Replies join their thread and version; threads list by latest activity"""
+    from src.services.comment_service_impl import CommentService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_comments_"))
+    database = MetadataDatabase(root)
+    service = CommentService(database_factory=lambda library_root: database)
+    crate = root / "assets" / "scenes" / "crate.ma"
+    barrel = root / "assets" / "scenes" / "barrel.ma"
+
+    bevel = service.add_comment(root, crate, "  Bevel is too soft ", version=3, user="lead")
+    assert (bevel.body, bevel.version_label) == ("Bevel is too soft", "v003")
+    assert not bevel.is_reply
+    service.add_comment(root, crate, "Scale looks off", user="lead")
+    reply = service.add_comment(root, crate, "Done", parent_id=bevel.comment_id, user="ben")
+    assert (reply.parent_id, reply.version) == (bevel.comment_id, 3)
+    # Answering a reply stays in the same thread
+    again = service.add_comment(root, crate, "Thanks", parent_id=reply.comment_id, user="lead")
+    assert again.parent_id == bevel.comment_id
+
+    threads = service.get_threads(root, crate)
+    assert [thread.root.body for thread in threads] == ["Bevel is too soft", "Scale looks off"]
+    assert [c.user for c in threads[0].comments] == ["lead", "ben", "lead"]
+    assert threads[0].root.header.endswith(", on v003")
+    assert service.get_threads(root, barrel) == []
+
+    service.set_resolved(root, threads[0])
+    assert service.get_threads(root, crate)[0].resolved
+
+    # Notes follow renamed assets
+    database.rename_asset(crate, barrel)
+    assert len(service.get_threads(root, barrel)) == 2
+
+    for body, parent_id in (("   ", None), ("Looks good", 999)):
+        try:
+            service.add_comment(root, barrel, body, parent_id=parent_id, user="lead")
+        except ValueError:
+            pass
+        else:
+            raise AssertionError(f"Note {body!r} on {parent_id} should be rejected")
+
+
+def test_mentions_notify_artists():
+    """Each mentioned artist but the author is notified until they read it"""
+    from src.core.models.asset_comment import parse_mentions
+    from src.services.comment_service_impl import CommentService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    assert parse_mentions("@ben and @J.Doe, see @ben. Mail ben@studio.com") == ["ben", "J.Doe"]
+    assert parse_mentions("No mentions @ all") == []
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_mentions_"))
+    database = MetadataDatabase(root)
+    service = CommentService(database_factory=lambda library_root: database)
+    crate = root / "assets" / "scenes" / "crate.ma"
+
+    service.add_comment(root, crate, "@ben @lead please check the UVs", version=2, user="lead")
+    service.add_comment(root, crate, "@BEN also the pivot", user="lead")
+
+    notifications = service.get_notifications(root, "ben")
+    assert [n.comment.body for n in notifications] == [
+        "@BEN also the pivot",
+        "@ben @lead please check the UVs",
+    ]
+    assert notifications[1].description == (
+        "lead mentioned you on crate v002: @ben @lead please check the UVs"
+    )
+    assert service.get_notifications(root, "lead") == []
+
+    service.mark_read(root, notifications[:1], user="ben")
+    assert len(service.get_notifications(root, "ben")) == 1
+    service.mark_read(root, user="ben")
+    assert service.get_notifications(root, "ben") == []
+    assert len(service.get_notifications(root, "ben", unread_only=False)) == 2
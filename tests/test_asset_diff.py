@@ -0,0 +1,104 @@
+"""
+Test suite for asset version diffs
+
+Validates reading Maya ASCII versions without Maya, and the report of added and
+removed meshes, polycount, transform, and shader assignment changes between them.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+HEADER = """//Maya ASCII 2025 scene
+requires maya "2025";
+"""
+
+CRATE = """createNode transform -n "crate";
+\tsetAttr ".t" -type "double3" 0 1 0 ;
+createNode mesh -n "crateShape" -p "crate";
+\tsetAttr -k off ".v";
+\tsetAttr -s 8 ".vt[0:7]"  -0.5 -0.5 0.5 0.5 -0.5 0.5
+\t\t -0.5 0.5 0.5 0.5 0.5 0.5 -0.5 0.5 -0.5 0.5 0.5 -0.5 -0.5 -0.5 -0.5 0.5 -0.5 -0.5;
+\tsetAttr -s 6 -ch 24 ".fc[0:5]" -type "polyFaces"
+\t\tf 4 0 1 2 3 mu 0 4 0 1 3 2;
+createNode mesh -n "crateShapeOrig" -p "crate";
+\tsetAttr -k off ".v";
+\tsetAttr ".io" yes;
+\tsetAttr -s 8 ".vt[0:7]"  0 0 0 0 0 0;
+\tsetAttr -s 6 ".fc[0:5]" -type "polyFaces"
+\t\tf 4 0 1 2 3;
+createNode lambert -n "woodMtl";
+createNode shadingEngine -n "woodSG";
+"""
+
+LID = """createNode transform -n "lid" -p "crate";
+\tsetAttr ".s" -type "double3" 1 2 1 ;
+createNode mesh -n "lidShape" -p "|crate|lid";
+\tsetAttr -s 4 ".vt[0:3]"  0 0 0 1 0 0 0 0 1 1 0 1;
+\tsetAttr -s 1 ".fc[0]" -type "polyFaces"
+\t\tf 4 0 1 3 2;
+"""
+
+
+def _write_version(folder: Path, name: str, body: str, connections: str) -> Path:
+    """Write one .ma version of the crate asset"""
+    path = folder / f"{name}.ma"
+    path.write_text(HEADER + body + connections + "// End of crate.ma\n", encoding="utf-8")
+    return path
+
+
+def test_maya_ascii_snapshot():
+    """Statements spanning lines, parents, and intermediate shapes parse without Maya"""
+    from src.services.asset_diff_service_impl import AssetDiffService
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_diff_"))
+    connections = 'connectAttr "crateShape.iog" "woodSG.dsm" -na;\n'
+    connections += 'connectAttr "woodMtl.oc" "woodSG.ss";\n'
+    path = _write_version(folder, "crate_v001", CRATE, connections)
+
+    snapshot = AssetDiffService().take_snapshot(path)
+    assert snapshot.file_size == path.stat().st_size
+    assert snapshot.node_types == {"transform": 1, "mesh": 2, "lambert": 1, "shadingEngine": 1}
+    assert snapshot.meshes == {"|crate": (6, 8)}  # Orig shape is construction history
+    assert snapshot.transforms["|crate"] == ((0.0, 1.0, 0.0), (0.0, 0.0, 0.0), (1.0, 1.0, 1.0))
+    assert snapshot.shaders == {"|crate": ("woodMtl",)}
+
+    try:
+        AssetDiffService().take_snapshot(folder / "crate_v001.mb")
+        raise AssertionError("Binary files should need Maya")
+    except ValueError:
+        pass
+
+
+def test_asset_diff_report():
+    """Added meshes, transform moves, and shader swaps show up in the diff and summary"""
+    from src.services.asset_diff_service_impl import AssetDiffService
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_diff_"))
+    before = _write_version(
+        folder, "crate_v001", CRATE, 'connectAttr "crateShape.iog" "woodSG.dsm" -na;\n'
+    )
+    after_body = CRATE.replace('"double3" 0 1 0', '"double3" 0 1.5 0') + LID
+    after_body += 'createNode blinn -n "metalMtl";\ncreateNode shadingEngine -n "metalSG";\n'
+    connections = 'connectAttr "crateShape.iog" "metalSG.dsm" -na;\n'
+    connections += 'connectAttr "metalMtl.oc" "metalSG.ss";\n'
+    after = _write_version(folder, "crate_v002", after_body, connections)
+
+    service = AssetDiffService()
+    diff = service.compare_files(before, after)
+    assert diff.has_changes
+    assert diff.added_meshes == ["|crate|lid"] and diff.removed_meshes == []
+    assert diff.after.face_count == 7 and diff.after.vertex_count == 12
+    assert diff.transform_changes == [("|crate", "translate", (0.0, 1.0, 0.0), (0.0, 1.5, 0.0))]
+    assert diff.shader_changes == [("|crate", ("woodSG",), ("metalMtl",))]
+    assert ("blinn", 0, 1) in diff.node_type_changes
+    assert ("transform", 1, 2) in diff.node_type_changes
+    assert diff.summary == (
+        "1 mesh(es) added, 1 transform(s) changed, 1 shader assignment(s) changed"
+    )
+
+    unchanged = service.compare_files(before, before)
+    assert not unchanged.has_changes
+    assert unchanged.summary == "No content changes"
@@ -0,0 +1,66 @@
+"""
+Test suite for shared library asset locking
+
+Validates check-out, check-in, lock ownership, and breaking locks.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _make_asset(root: Path) -> Path:
+    """Create a fake library asset file"""
+    asset_file = root / "assets" / "scenes" / "hero.ma"
+    asset_file.parent.mkdir(parents=True, exist_ok=True)
+    asset_file.write_text("content", encoding="utf-8")
+    return asset_file
+
+
+def test_check_out_blocks_other_artists():
+    """Only the artist holding the lock may publish or check out again"""
+    from src.services.lock_service_impl import LockServiceImpl
+
+    service = LockServiceImpl()
+    asset_file = _make_asset(Path(tempfile.mkdtemp(prefix="assetManager_locks_")))
+
+    lock = service.check_out(asset_file, user="mike")
+    assert lock is not None
+    assert lock.user == "mike"
+    assert service.get_lock(asset_file).is_owned_by("Mike")
+
+    assert service.check_out(asset_file, user="anna") is None
+    assert service.check_out(asset_file, user="mike") is not None
+    assert service.can_publish(asset_file, user="mike")
+    assert not service.can_publish(asset_file, user="anna")
+
+
+def test_check_in_requires_owner_unless_forced():
+    """Other artists can only release a lock by breaking it explicitly"""
+    from src.services.lock_service_impl import LockServiceImpl
+
+    service = LockServiceImpl()
+    asset_file = _make_asset(Path(tempfile.mkdtemp(prefix="assetManager_locks_")))
+    service.check_out(asset_file, user="mike")
+
+    assert not service.check_in(asset_file, user="anna")
+    assert service.get_lock(asset_file) is not None
+
+    assert service.check_in(asset_file, user="anna", force=True)
+    assert service.get_lock(asset_file) is None
+    assert service.can_publish(asset_file, user="anna")
+
+
+def test_lock_files_hidden_from_library_scan():
+    """Repository scan should not list .locks files as assets"""
+    from src.services.lock_service_impl import LockServiceImpl
+    from src.services.standalone_services import StandaloneAssetRepository
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_locks_"))
+    asset_file = _make_asset(root)
+    LockServiceImpl().check_out(asset_file, user="mike")
+
+    assets = StandaloneAssetRepository().find_all(root)
+    assert [a.file_path for a in assets] == [asset_file]
@@ -0,0 +1,168 @@
+"""
+Test suite for selective part import
+
+Validates listing the groups and meshes of a Maya ASCII asset with their mesh and
+face counts, picking which branches an import deletes, and importing only the
+picked parts with the groups above them.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+BUILDING_MA = """//Maya ASCII 2024 scene
+requires maya "2024";
+createNode transform -n "building_grp";
+createNode transform -n "floor_01" -p "building_grp";
+createNode transform -n "slab" -p "floor_01";
+createNode mesh -n "slabShape" -p "slab";
+    setAttr -s 8 ".vt[0:7]" 0 0 0 1 1 1;
+    setAttr -s 6 ".fc[0:5]" -type "polyFaces" f 4 0 1 2 3;
+createNode transform -n "floor_02" -p "building_grp";
+createNode transform -n "window_03" -p "floor_02";
+createNode mesh -n "window_03Shape" -p "window_03";
+createNode transform -n "door" -p "floor_02";
+createNode transform -n "lamp";
+createNode mesh -n "lampShape" -p "lamp";
+    setAttr -s 1200 ".fc[0:1199]" -type "polyFaces" f 4 0 1 2 3;
+"""
+
+
+class FakeCmds:
+    """Scene that imports the building under a namespace, with one shading group"""
+
+    def __init__(self):
+        self.nodes = {
+            "|bld:building_grp",
+            "|bld:building_grp|bld:floor_01",
+            "|bld:building_grp|bld:floor_01|bld:slab",
+            "|bld:building_grp|bld:floor_02",
+            "|bld:building_grp|bld:floor_02|bld:window_03",
+            "|bld:building_grp|bld:floor_02|bld:door",
+            "|bld:lamp",
+        }
+        self.engines = {"bld:slabSG": ["|bld:building_grp|bld:floor_01|bld:slab"]}
+        self.scene = set()
+        self.deleted = []
+        self.imports = []
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def file(self, path, **kwargs):
+        self.imports.append(kwargs)
+        self.scene = set(self.nodes) | set(self.engines) | {"bld:slabMat"}
+        return sorted(self.scene)
+
+    def ls(self, nodes, type=None, **kwargs):
+        if type == "shadingEngine":
+            return [node for node in nodes if node in self.engines]
+        return [node for node in nodes if node.startswith("|")]
+
+    def objExists(self, node):
+        return node in self.scene
+
+    def delete(self, nodes):
+        for node in [nodes] if isinstance(nodes, str) else nodes:
+            self.deleted.append(node)
+            self.scene = {n for n in self.scene if n != node and not n.startswith(f"{node}|")}
+            for engine, members in self.engines.items():
+                members[:] = [m for m in members if m in self.scene]
+
+    def sets(self, engine, query=False):
+        return self.engines[engine] or None
+
+    def listConnections(self, node, **kwargs):
+        if node.endswith(".surfaceShader"):
+            return ["bld:slabMat"]
+        return [engine for engine in self.engines if engine in self.scene]
+
+
+def test_parts_listed_from_maya_ascii():
+    """Parts come with their meshes and faces; picks keep the groups above them"""
+    from src.services.part_import_service_impl import PartImportService, get_removed_paths
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_parts_"))
+    building = root / "building.ma"
+    building.write_text(BUILDING_MA)
+
+    service = PartImportService()
+    assert not service.needs_maya(building) and service.needs_maya(root / "building.mb")
+    parts = service.get_parts(building)
+    assert [part.path for part in parts] == [
+        "|building_grp",
+        "|building_grp|floor_01",
+        "|building_grp|floor_01|slab",
+        "|building_grp|floor_02",
+        "|building_grp|floor_02|door",
+        "|building_grp|floor_02|window_03",
+        "|lamp",
+    ]
+    group, _floor, slab, floor_02, door, window, lamp = parts
+    assert (group.mesh_count, group.face_count, group.child_count, group.depth) == (2, 6, 2, 0)
+    assert group.summary == "2 meshes, 6 faces"
+    assert (slab.name, slab.parent_path, slab.depth) == ("slab", "|building_grp|floor_01", 2)
+    assert window.is_mesh and window.summary == "faces unknown"
+    assert not door.is_mesh and door.summary == "" and floor_02.child_count == 2
+    assert lamp.is_mesh and lamp.summary == "1,200 faces"
+
+    # The outline is cached until the file changes
+    assert service.get_parts(building) == parts
+    assert service._outlines[str(building)][1] == parts
+
+    paths = [part.path for part in parts]
+    assert get_removed_paths(paths, ["|building_grp|floor_02|window_03"]) == [
+        "|building_grp|floor_01",
+        "|building_grp|floor_02|door",
+        "|lamp",
+    ]
+    assert get_removed_paths(paths, ["|building_grp", "|lamp"]) == []
+    assert get_removed_paths(paths, ["|lamp"]) == ["|building_grp"]
+
+    (root / "building.mb").write_bytes(b"FOR4")
+    try:
+        service.get_parts(root / "building.mb")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Listing a Maya binary asset without Maya should fail")
+
+
+def test_import_keeps_only_picked_parts():
+    """Unpicked branches and the shading groups only they used are deleted again"""
+    from src.services.part_import_service_impl import PartImportService
+
+    service = PartImportService()
+    cmds = FakeCmds()
+    result = service.import_parts(
+        cmds, Path("building.ma"), ["|building_grp|floor_02|window_03", "|lamp"], "bld"
+    )
+    assert cmds.imports[0]["namespace"] == "bld" and cmds.imports[0]["i"]
+    assert cmds.deleted == [
+        "|bld:building_grp|bld:floor_01",
+        "|bld:building_grp|bld:floor_02|bld:door",
+        "bld:slabSG",
+        "bld:slabMat",
+    ]
+    assert result.roots == ("|bld:building_grp", "|bld:lamp")
+    assert result.imported_parts == ("|building_grp|floor_02|window_03", "|lamp")
+    assert result.summary == "2 part(s), 2 other branch(es) skipped"
+    assert "|bld:building_grp|bld:floor_02|bld:window_03" in cmds.scene
+
+    # The root namespace imports without a namespace flag
+    cmds = FakeCmds()
+    result = service.import_parts(cmds, Path("building.ma"), ["|bld_missing", "|lamp"])
+    assert "namespace" not in cmds.imports[0] and result.roots == ("|bld:lamp",)
+    assert result.imported_parts == ("|lamp",) and "bld:slabSG" in cmds.deleted
+
+    for picked, error in [([], ValueError), (["|tower_grp"], RuntimeError)]:
+        cmds = FakeCmds()
+        try:
+            service.import_parts(cmds, Path("building.ma"), picked, "bld")
+        except error:
+            pass
+        else:
+            raise AssertionError(f"Importing {picked} should raise {error.__name__}")
+    assert not [node for node in cmds.scene if node.startswith("|")]  # Nothing left behind
@@ -0,0 +1,141 @@
+"""
+Test suite for asset renaming and reference repair
+
+Validates moving an asset with its thumbnails, history, and database rows,
+recording a redirect for the old path, and repathing scenes that still use it.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _make_library():
+    """Library with a versioned crate asset, its sidecar, and a screenshot"""
+    from src.services.version_service_impl import get_version_service
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_rename_"))
+    library = root / "library"
+    (library / ".thumbnails").mkdir(parents=True)
+    crate = library / "crate.ma"
+    crate.write_text("//Maya ASCII 2024 scene\n")
+    (library / "crate.ma.meta").write_text("{}")
+    (library / ".thumbnails" / "crate_screenshot.png").write_bytes(b"png")
+    get_version_service().publish_version(crate, notes="first")
+    return root, library, crate
+
+
+def test_rename_moves_files_database_rows_and_records_redirect():
+    """Files follow the new name; the database remembers where the asset was"""
+    from src.services.asset_rename_service_impl import AssetRenameService
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.version_service_impl import get_version_service
+
+    _root, library, crate = _make_library()
+    database = MetadataDatabase(library)
+    database.save_asset_metadata(crate, {"tags": ["wood"], "category": "props"})
+    database.save_collection("set dressing", {"assets": ["crate.ma"]})
+    barrel = library / "props" / "barrel.ma"
+    service = AssetRenameService()
+
+    assert service.check_rename(library, crate, library / "props" / "barrel.mb")
+    assert service.check_rename(library, crate, library.parent / "barrel.ma")
+    assert service.check_rename(library, crate, barrel) == ""
+
+    redirects = service.rename(library, crate, barrel, database)
+
+    assert not crate.exists() and barrel.is_file()
+    assert (library / "props" / "barrel.ma.meta").is_file()
+    assert (library / "props" / ".thumbnails" / "barrel_screenshot.png").is_file()
+    versions = get_version_service().get_versions(barrel)
+    assert [v.file_path.name for v in versions] == ["barrel.ma"]
+    assert versions[0].asset_name == "barrel"
+    assert redirects[crate] == barrel
+    assert database.get_asset_metadata(crate) is None
+    assert database.get_asset_metadata(barrel)["tags"] == ["wood"]
+    assert database.find_assets_by_tag("wood") == ["props/barrel.ma"]
+    assert database.get_collections()["set dressing"]["assets"] == ["props/barrel.ma"]
+    assert database.get_redirects() == {"crate.ma": "props/barrel.ma"}
+    database.close()
+
+
+def test_repair_repaths_ascii_scenes_and_reports_binary_ones():
+    """Stored redirects repath the asset and its snapshots; binary scenes are listed"""
+    from src.services.asset_rename_service_impl import AssetRenameService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root, library, crate = _make_library()
+    snapshot = crate.parent / ".versions" / "crate" / "v001" / "crate.ma"
+    shots = root / "shots"
+    shots.mkdir()
+    (shots / "shot010.ma").write_text(
+        "//Maya ASCII 2024 scene\n"
+        f'file -rdi 1 -ns "crate" -rfn "crateRN" -typ "mayaAscii" "{crate.as_posix()}";\n'
+        f'file -rdi 1 -ns "old" -rfn "oldRN" -typ "mayaAscii" "{snapshot.as_posix()}{{1}}";\n'
+    )
+    (shots / "shot020.mb").write_bytes(b"FOR4" + crate.as_posix().encode() + b"\x00")
+
+    database = MetadataDatabase(library)
+    service = AssetRenameService()
+    barrel = library / "barrel.ma"
+    service.rename(library, crate, barrel, database)
+
+    redirects = service.get_redirects(database)
+    repathed, skipped = service.repair_references([shots], redirects)
+
+    assert [scene.name for scene in repathed] == ["shot010.ma"]
+    assert [scene.name for scene in skipped] == ["shot020.mb"]
+    text = (shots / "shot010.ma").read_text()
+    assert f'"{barrel.as_posix()}"' in text
+    new_snapshot = library / ".versions" / "barrel" / "v001" / "barrel.ma"
+    assert f'"{new_snapshot.as_posix()}{{1}}"' in text
+    assert crate.as_posix() not in text
+    database.close()
+
+
+def test_repair_keeps_portable_reference_paths():
+    """Token paths of a mapped library are found and repathed with the token"""
+    import os
+
+    from src.core.models.path_mapping import PathMapping, get_platform
+    from src.services import path_mapping_service_impl
+    from src.services.asset_rename_service_impl import AssetRenameService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root, library, crate = _make_library()
+    shots = root / "shots"
+    shots.mkdir()
+    (shots / "shot010.ma").write_text(
+        "//Maya ASCII 2024 scene\n"
+        'file -rdi 1 -ns "crate" -rfn "crateRN" -typ "mayaAscii" "$ASSET_ROOT/crate.ma";\n'
+        f'file -rdi 1 -ns "abs" -rfn "absRN" -typ "mayaAscii" "{crate.as_posix()}{{1}}";\n'
+    )
+    (shots / "shot020.mb").write_bytes(b"FOR4$ASSET_ROOT/crate.ma\x00")
+
+    mapping_service = path_mapping_service_impl.PathMappingService()
+    assert mapping_service.save_mapping(
+        library, PathMapping(roots={get_platform(): library.as_posix()})
+    )
+    previous_service = path_mapping_service_impl._path_mapping_service_instance
+    previous_root = os.environ.pop("ASSET_ROOT", None)
+    path_mapping_service_impl._path_mapping_service_instance = mapping_service
+    try:
+        mapping_service.install(library)
+        database = MetadataDatabase(library)
+        service = AssetRenameService()
+        service.rename(library, crate, library / "barrel.ma", database)
+        repathed, skipped = service.repair_references([shots], service.get_redirects(database))
+        database.close()
+    finally:
+        path_mapping_service_impl._path_mapping_service_instance = previous_service
+        os.environ.pop("ASSET_ROOT", None)
+        if previous_root is not None:
+            os.environ["ASSET_ROOT"] = previous_root
+
+    assert [scene.name for scene in repathed] == ["shot010.ma"]
+    assert [scene.name for scene in skipped] == ["shot020.mb"]
+    text = (shots / "shot010.ma").read_text()
+    assert '"$ASSET_ROOT/barrel.ma"' in text and '"$ASSET_ROOT/barrel.ma{1}"' in text
+    assert "crate.ma" not in text
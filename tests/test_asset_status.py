@@ -0,0 +1,123 @@
+"""
+Test suite for the asset review status workflow
+
+Validates status parsing and storage, which roles may make each status change,
+filtering the library by status, and status: search filters.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def _asset(name, status=None):
+    """Create a lightweight stand-in for a library asset"""
+    return SimpleNamespace(
+        name=name,
+        display_name=name,
+        file_path=Path("/library/assets/scenes") / f"{name}.ma",
+        file_extension=".ma",
+        asset_type="maya_scene",
+        category="general",
+        tags=[],
+        metadata={"status": {"state": status}} if status else {},
+        modified_date=None,
+        is_favorite=False,
+    )
+
+
+def test_status_model():
+    """Typed names resolve to states; missing or unknown states are WIP"""
+    from src.core.models.asset_status import AssetStatus, parse_status
+
+    assert parse_status("review") == "pending_review"
+    assert parse_status("Pending Review") == "pending_review"
+    assert parse_status("approve") == "approved"
+    assert parse_status("dep") == "deprecated"
+    assert parse_status("ready") is None and parse_status("") is None
+
+    assert AssetStatus.from_dict(None).state == "wip"
+    assert AssetStatus.from_dict({"state": "final"}).state == "wip"
+    status = AssetStatus("deprecated", "kim", "2026-03-01T10:22:00", "use crate_v2")
+    assert status.is_deprecated and status.label == "Deprecated"
+    assert status.description == "Deprecated by kim on 2026-03-01 10:22:00: use crate_v2"
+    assert AssetStatus.from_dict(status.to_dict()) == status
+
+
+def test_status_changes_follow_roles():
+    """Artists may submit for review; only reviewers approve, deprecate, or reopen"""
+    from src.services.asset_status_service_impl import AssetStatusService
+    from src.services.permission_service_impl import PermissionDenied
+    from src.services.version_service_impl import get_current_user
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_status_"))
+    permissions_file = library / ".assetmanager" / "permissions.json"
+    permissions_file.parent.mkdir(parents=True)
+    user = get_current_user()
+
+    def set_role(role):
+        permissions_file.write_text(json.dumps({"default_role": "junior", "users": {user: role}}))
+
+    service = AssetStatusService()
+    set_role("artist")
+    metadata = {}
+    submitted = service.change_status(library, metadata, "pending_review", "  ready  ")
+    assert submitted.state == "pending_review" and submitted.changed_by == user
+    assert submitted.note == "ready"
+    metadata["status"] = submitted.to_dict()
+    assert not service.can_change(library, "pending_review", "approved")
+    try:
+        service.change_status(library, metadata, "approved")
+    except PermissionDenied as e:
+        assert "cannot review assets" in str(e)
+    else:
+        raise AssertionError("artists should not be able to approve")
+
+    set_role("reviewer")
+    metadata["status"] = service.change_status(library, metadata, "approved").to_dict()
+    assert service.get_status(metadata).state == "approved"
+
+    set_role("artist")
+    assert not service.can_change(library, "approved", "pending_review")
+    set_role("junior")
+    assert not service.can_change(library, "wip", "pending_review")
+
+    try:
+        service.change_status(library, metadata, "final")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("unknown states should be rejected")
+
+
+def test_status_filters():
+    """The status picker and status: search terms narrow the library"""
+    from src.services.asset_status_service_impl import AssetStatusService
+    from src.services.search_engine_impl import SearchIndex
+
+    assets = [
+        _asset("crate", "approved"),
+        _asset("barrel", "deprecated"),
+        _asset("lamp", "pending_review"),
+        _asset("chair"),
+    ]
+    service = AssetStatusService()
+    assert [a.name for a in service.filter_assets(assets, "approved")] == ["crate"]
+    assert [a.name for a in service.filter_assets(assets, "wip")] == ["chair"]
+    assert service.filter_assets(assets, "") == assets
+
+    index = SearchIndex()
+    index.build(assets)
+
+    def names(query):
+        return [result.name for result in index.search(query)]
+
+    assert names("status:approved") == ["crate"]
+    assert names("status:review") == ["lamp"]
+    assert names("-status:deprecated") == ["crate", "lamp", "chair"]
+    assert names("state:wip") == ["chair"]
+    assert names("status:final") == []
@@ -0,0 +1,155 @@
+"""
+Test suite for asset templates
+
+Validates storing studio starting scenes in a library, seeding a new scene from one
+for a named asset, and the values the publish dialog starts with for it.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Scene whose template holds a placeholder group, a set, and a lights group"""
+
+    TEMPLATE_NODES = [
+        "|ASSET_grp",
+        "|ASSET_grp|ASSET_geo",
+        "|ASSET_grp|ASSET_geo|ASSET_geoShape",
+        "|lights_grp",
+        "ASSET_set",
+    ]
+
+    def __init__(self):
+        self.attributes = {
+            "defaultRenderGlobals.currentRenderer": "arnold",
+            "defaultResolution.width": 2048,
+            "defaultResolution.height": 2048,
+        }
+        self.nodes = []
+        self.file_info = {}
+
+    def getAttr(self, attribute):
+        return self.attributes[attribute]
+
+    def setAttr(self, attribute, value, type=None):
+        self.attributes[attribute] = value
+
+    def file(self, path=None, **flags):
+        if flags.get("exportAll"):
+            Path(path).write_text("//Maya ASCII template")
+        elif flags.get("new"):
+            self.nodes, self.file_info, self.attributes = [], {}, {}
+        elif flags.get("i"):
+            self.nodes = list(self.TEMPLATE_NODES)
+            return list(self.nodes)
+
+    def ls(self, nodes, long=False):
+        return [node for node in nodes if node in self.nodes]
+
+    def rename(self, node, new_name):
+        parent = node.rsplit("|", 1)[0] + "|" if "|" in node else ""
+        renamed = parent + new_name
+        self.nodes = [
+            renamed + name[len(node) :] if name == node or name.startswith(node + "|") else name
+            for name in self.nodes
+        ]
+        return new_name
+
+    def fileInfo(self, key, value=None, query=False):
+        if query:
+            return [self.file_info[key]] if key in self.file_info else []
+        self.file_info[key] = value
+
+
+def test_templates_are_stored_in_the_library():
+    """Templates should round-trip through the library and validate their naming"""
+    from src.core.models.asset_template import AssetTemplate
+    from src.services.asset_template_service_impl import AssetTemplateService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_templates_"))
+    scene = library / "prop_start.ma"
+    scene.write_text("//Maya ASCII prop start")
+    service = AssetTemplateService()
+    assert service.list_templates(library) == []
+
+    template = AssetTemplate(
+        "Hero Prop",
+        category="Props",
+        name_pattern="PRP_{assetName}",
+        tags=("hero",),
+        render_settings=(("defaultResolution.width", 2048),),
+    )
+    assert template.get_asset_name(" crate ") == "PRP_crate"
+    assert AssetTemplate.from_dict(template.to_dict()) == template
+
+    assert service.save_template(library, template, scene)
+    assert service.get_scene_file(library, "Hero Prop").name == "Hero_Prop.ma"
+    assert service.list_templates(library) == [template]
+    assert service.get_template(library, "Hero Prop") == template
+    assert service.get_publish_defaults(template, "crate") == {
+        "name": "PRP_crate",
+        "category": "Props",
+        "description": "",
+        "tags": ["hero"],
+        "format": ".ma",
+    }
+
+    # A settings file without its scene is not offered
+    service.get_scene_file(library, "Hero Prop").unlink()
+    assert service.list_templates(library) == []
+    assert service.delete_template(library, "Hero Prop")
+    assert not service.delete_template(library, "Hero Prop")
+
+    for fields in ({"name": " "}, {"name_pattern": "PRP_"}, {"export_format": ".abc"}):
+        try:
+            AssetTemplate(**{"name": "Prop", **fields})
+        except ValueError:
+            pass
+        else:
+            raise AssertionError(f"{fields} should be rejected")
+
+
+def test_new_scene_from_template():
+    """A new scene should get the template's nodes, renamed, and its render settings"""
+    from src.core.models.asset_template import AssetTemplate
+    from src.services.asset_template_service_impl import AssetTemplateService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_templates_"))
+    service = AssetTemplateService()
+    cmds = FakeCmds()
+
+    saved = service.save_scene_as_template(
+        cmds, library, AssetTemplate("Hero Prop", category="Props", name_pattern="PRP_{assetName}")
+    )
+    assert saved.created_by and saved.created_date
+    assert dict(saved.render_settings) == cmds.attributes
+    assert service.get_scene_file(library, "Hero Prop").is_file()
+    assert service.get_scene_template(cmds, library) is None  # Not made from a template
+
+    renamed = service.create_scene(cmds, library, saved, "old crate")
+    assert renamed[0] == "PRP_old_crate_geoShape" and len(renamed) == 4
+    assert cmds.nodes == [
+        "|PRP_old_crate_grp",
+        "|PRP_old_crate_grp|PRP_old_crate_geo",
+        "|PRP_old_crate_grp|PRP_old_crate_geo|PRP_old_crate_geoShape",
+        "|lights_grp",
+        "PRP_old_crate_set",
+    ]
+    assert cmds.attributes["defaultRenderGlobals.currentRenderer"] == "arnold"
+
+    template, name = service.get_scene_template(cmds, library)
+    assert template == saved and name == "old crate"
+    assert service.get_publish_defaults(template, name)["name"] == "PRP_old crate"
+
+    service.delete_template(library, "Hero Prop")
+    assert service.get_scene_template(cmds, library) is None
+    try:
+        service.create_scene(cmds, library, saved, "barrel")
+    except FileNotFoundError:
+        pass
+    else:
+        raise AssertionError("A template without its scene should not create a scene")
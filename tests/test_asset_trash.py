@@ -0,0 +1,103 @@
+"""
+Test suite for the library trash
+
+Validates that deleting moves an asset with its thumbnails and version history into
+the library trash, that restoring puts everything back, and that expired entries are
+purged according to the library's retention period.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from datetime import datetime, timedelta
+from pathlib import Path
+
+
+def _make_library():
+    """Library with one scene asset, its sidecar, thumbnail, and a saved version"""
+    library = Path(tempfile.mkdtemp(prefix="assetManager_trash_"))
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    (scenes / ".versions" / "crate").mkdir(parents=True)
+    (scenes / "crate.ma").write_text("//Maya ASCII crate")
+    (scenes / "crate.ma.meta").write_text("{}")
+    (scenes / ".thumbnails" / "crate_screenshot.png").write_bytes(b"PNG")
+    (scenes / ".versions" / "crate" / "crate_v001.ma").write_text("//Maya ASCII v1")
+    (scenes / "barrel.ma").write_text("//Maya ASCII barrel")
+    return library, scenes
+
+
+def test_trash_and_restore_asset():
+    """Trashing moves the asset's files out of the library; restoring moves them back"""
+    from src.services.trash_service_impl import TrashService
+
+    service = TrashService()
+    library, scenes = _make_library()
+
+    entry = service.trash_asset(
+        library, scenes / "crate.ma", metadata={"tags": ["props"]}, user="rigger"
+    )
+    assert entry is not None and entry.name == "crate"
+    assert entry.asset_path == "assets/scenes/crate.ma" and entry.deleted_by == "rigger"
+    assert "assets/scenes/.versions/crate" in entry.files
+    assert "assets/scenes/.thumbnails/crate_screenshot.png" in entry.files
+    assert not (scenes / "crate.ma").exists() and not (scenes / ".versions" / "crate").exists()
+    assert (scenes / "barrel.ma").exists()  # Other assets stay
+
+    # Outside the library nothing is moved
+    outside = Path(tempfile.mkdtemp(prefix="assetManager_trash_")) / "loose.ma"
+    outside.write_text("//Maya ASCII")
+    assert service.trash_asset(library, outside) is None and outside.exists()
+
+    entries = service.list_entries(library)
+    assert [listed.entry_id for listed in entries] == [entry.entry_id]
+    assert entries[0].metadata == {"tags": ["props"]} and entries[0].size > 0
+
+    restored = service.restore(library, entries[0])
+    assert restored == scenes / "crate.ma" and restored.read_text() == "//Maya ASCII crate"
+    assert (scenes / ".versions" / "crate" / "crate_v001.ma").exists()
+    assert (scenes / ".thumbnails" / "crate_screenshot.png").exists()
+    assert service.list_entries(library) == []
+
+
+def test_restore_refuses_to_overwrite():
+    """An asset published at the same path after deleting is never overwritten"""
+    from src.services.trash_service_impl import TrashService
+
+    service = TrashService()
+    library, scenes = _make_library()
+    entry = service.trash_asset(library, scenes / "crate.ma")
+    (scenes / "crate.ma").write_text("//Maya ASCII new crate")
+
+    try:
+        service.restore(library, entry)
+    except FileExistsError:
+        pass
+    else:
+        raise AssertionError("Restoring over a newer asset should fail")
+    assert (scenes / "crate.ma").read_text() == "//Maya ASCII new crate"
+    assert len(service.list_entries(library)) == 1
+
+
+def test_purge_expired_respects_retention():
+    """Entries older than the retention period are purged; 0 days keeps them"""
+    from src.services.trash_service_impl import DEFAULT_RETENTION_DAYS, TrashService
+
+    service = TrashService()
+    library, scenes = _make_library()
+    assert service.get_retention_days(library) == DEFAULT_RETENTION_DAYS
+    service.trash_asset(library, scenes / "crate.ma")
+
+    soon = datetime.now() + timedelta(days=DEFAULT_RETENTION_DAYS - 1)
+    later = datetime.now() + timedelta(days=DEFAULT_RETENTION_DAYS + 1)
+    assert service.purge_expired(library, now=soon) == []
+
+    assert service.set_retention_days(library, 0)
+    assert service.get_retention_days(library) == 0
+    assert service.purge_expired(library, now=later) == []
+
+    service.set_retention_days(library, 7)
+    purged = service.purge_expired(library, now=datetime.now() + timedelta(days=8))
+    assert [entry.name for entry in purged] == ["crate"]
+    assert service.list_entries(library) == []
@@ -0,0 +1,174 @@
+"""
+Test suite for studio asset type plugins
+
+Validates discovering asset types from user and library folders, refusing built-in
+extensions, listing and searching their files, and running their export, import,
+thumbnail, and validation code.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+from types import SimpleNamespace
+
+CROWD_AGENT_FILE = '''
+from src.core.interfaces.asset_type_plugin import IAssetTypePlugin
+
+
+class CrowdAgentType(IAssetTypePlugin):
+    type_id = "crowd_agent"
+    name = "Crowd Agent"
+    extension = ".agent"
+    folder = "crowd"
+    search_keywords = ("crowd", "Agent")
+
+    def export(self, cmds, file_path, selection):
+        file_path.write_text("agent " + " ".join(selection))
+        motions = file_path.with_suffix(".motions")
+        motions.write_text("walk run")
+        return [file_path, motions]
+
+    def import_asset(self, cmds, file_path):
+        return [cmds.createNode("transform", name=file_path.stem)]
+
+    def render_thumbnail(self, file_path, thumbnail_path):
+        thumbnail_path.write_bytes(b"png")
+        return True
+
+    def validate(self, cmds, nodes):
+        return [] if nodes else [self.issue("Select the agent skeleton")]
+
+
+class SceneType(IAssetTypePlugin):
+    type_id = "studio_scene"
+    extension = ".MA"
+
+    def export(self, cmds, file_path, selection):
+        return []
+
+    def import_asset(self, cmds, file_path):
+        return []
+'''
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for plugin import and publish checks"""
+
+    def __init__(self, assemblies=()):
+        self.assemblies = list(assemblies)
+        self.created = []
+
+    def ls(self, *args, **kwargs):
+        return list(self.assemblies) if kwargs.get("assemblies") else []
+
+    def listRelatives(self, node, **kwargs):
+        return []
+
+    def createNode(self, node_type, name=""):
+        self.created.append((node_type, name))
+        return name
+
+
+def test_asset_types_discovered_from_folders():
+    """Later folders replace a type; built-in extensions and broken files are skipped"""
+    from src.services.asset_type_service_impl import AssetTypeService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_asset_types_"))
+    user_types = root / "user_types"
+    user_types.mkdir()
+    (user_types / "crowd.py").write_text(CROWD_AGENT_FILE.replace("Crowd Agent", "Old Agent"))
+    (user_types / "syntax_error.py").write_text("class Broken(:\n")
+    (user_types / "_private.py").write_text("raise RuntimeError('never imported')\n")
+    (user_types / "no_import.py").write_text(
+        "class HalfType:\n    type_id = 'half'\n    extension = '.half'\n\n"
+        "    def export(self, cmds, file_path, selection):\n        return []\n"
+    )
+    library = root / "library"
+    library_types = library / ".assetmanager" / "asset_types"
+    library_types.mkdir(parents=True)
+    (library_types / "crowd.py").write_text(CROWD_AGENT_FILE)
+
+    service = AssetTypeService(user_types_dir=user_types)
+    assert [plugin.name for plugin in service.get_plugins()] == ["Old Agent"]
+    assert service.get_plugin_for_extension("ma") is None  # .ma stays a Maya scene
+    assert service.get_extensions() == [".agent"]
+
+    # Loading the library reads its own folder, whose crowd agent type wins
+    plugin = service.get_plugin_for_extension(".AGENT", library)
+    assert plugin.name == "Crowd Agent" and plugin.format_label == "Crowd Agent (.agent)"
+    assert (plugin.folder, plugin.search_keywords) == ("crowd", ("crowd", "Agent"))
+    assert service.get_plugin_for_extension("agent").name == "Crowd Agent"  # No reload
+    assert service.get_type_directories(library)[-1] == library_types
+    assert service.load() == 1 and service.get_plugins()[0].name == "Old Agent"
+
+
+def test_plugin_types_listed_published_and_imported():
+    """Plugin files are assets of their type, searchable, exported, and checked"""
+    from src.services.asset_repository_impl import get_asset_type
+    from src.services.asset_type_service_impl import ASSET_TYPES_PATH_ENV, get_asset_type_service
+    from src.services.search_engine_impl import classify_asset_kinds
+    from src.services.standalone_services import StandaloneAssetRepository
+    from src.services.validation_service_impl import ValidationServiceImpl
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_asset_types_"))
+    studio_types = root / "studio_types"
+    studio_types.mkdir()
+    (studio_types / "crowd.py").write_text(CROWD_AGENT_FILE)
+    library = root / "library"
+    crowd = library / "assets" / "crowd"
+    crowd.mkdir(parents=True)
+    agent_file = crowd / "soldier.agent"
+
+    previous = os.environ.get(ASSET_TYPES_PATH_ENV)
+    os.environ[ASSET_TYPES_PATH_ENV] = str(studio_types)
+    service = get_asset_type_service()
+    try:
+        plugin = service.get_plugin_for_extension(".agent", library)
+        assert get_asset_type(agent_file) == "crowd_agent"
+        assert get_asset_type(crowd / "soldier.ma") == "maya_scene"
+        repository = StandaloneAssetRepository()
+        assert repository._is_supported_file(agent_file)
+        assert not repository._is_supported_file(crowd / "soldier.motions")
+        assert repository._get_asset_type(agent_file) == "crowd_agent"
+        asset = SimpleNamespace(
+            name="soldier", file_extension="agent", category="Characters", tags=[]
+        )
+        assert {"crowd_agent", "crowd", "agent"} <= classify_asset_kinds(asset)
+
+        # The plugin's own checks run with the publish checks
+        validation = ValidationServiceImpl(
+            config_file=root / "validation.json", user_checks_dir=root / "checks"
+        )
+        report = validation.validate(FakeCmds(), library_root=library, asset_type=plugin)
+        assert "crowd_agent" in report.checks_run and not report.can_publish
+        assert report.errors[0].message == "Select the agent skeleton"
+        report = validation.validate(FakeCmds(["|soldier"]), asset_type=plugin)
+        assert not [issue for issue in report.issues if issue.check_id == "crowd_agent"]
+
+        # Export reports the files besides the asset; import and thumbnail use the plugin
+        written = service.export(plugin, FakeCmds(), agent_file, ["|soldier"])
+        assert written == [crowd / "soldier.motions"]
+        assert agent_file.read_text() == "agent |soldier"
+        cmds = FakeCmds()
+        assert service.import_asset(plugin, cmds, agent_file) == ["soldier"]
+        assert cmds.created == [("transform", "soldier")]
+        thumbnail = service.render_thumbnail(plugin, agent_file)
+        assert thumbnail == crowd / ".thumbnails" / "soldier_screenshot.png"
+        assert thumbnail.read_bytes() == b"png"
+
+        try:
+            service.import_asset(plugin, None, agent_file)
+        except RuntimeError as e:
+            assert str(e).startswith("Crowd Agent import failed: ")
+        else:
+            raise AssertionError("A failing plugin import should raise RuntimeError")
+    finally:
+        if previous is None:
+            os.environ.pop(ASSET_TYPES_PATH_ENV)
+        else:
+            os.environ[ASSET_TYPES_PATH_ENV] = previous
+        service.load()
+    assert service.get_plugin_for_extension(".agent") is None
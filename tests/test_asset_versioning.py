@@ -0,0 +1,164 @@
+"""
+Test suite for asset versioning
+
+Validates immutable version snapshots, history ordering, and rollback.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _make_asset(root: Path, content: str) -> Path:
+    """Create a fake library asset file"""
+    asset_file = root / "assets" / "scenes" / "hero.ma"
+    asset_file.parent.mkdir(parents=True, exist_ok=True)
+    asset_file.write_text(content, encoding="utf-8")
+    return asset_file
+
+
+def test_publish_creates_sequential_versions():
+    """Each publish should create v001, v002... with its own file copy"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    service = VersionServiceImpl()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+    asset_file = _make_asset(root, "first")
+
+    v1 = service.publish_version(asset_file, notes="initial", author="mike")
+    asset_file.write_text("second", encoding="utf-8")
+    v2 = service.publish_version(asset_file, notes="fixes")
+
+    assert v1 is not None and v2 is not None
+    assert v1.label == "v001"
+    assert v2.label == "v002"
+    assert v1.author == "mike"
+    assert v1.file_path.read_text(encoding="utf-8") == "first"
+    assert v2.file_path.read_text(encoding="utf-8") == "second"
+
+    versions = service.get_versions(asset_file)
+    assert [v.number for v in versions] == [1, 2]
+    assert versions[0].notes == "initial"
+    assert service.get_latest_version(asset_file).number == 2
+
+
+def test_rollback_republishes_old_content():
+    """Rolling back should restore old content as a brand-new version"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    service = VersionServiceImpl()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+    asset_file = _make_asset(root, "first")
+    service.publish_version(asset_file)
+    asset_file.write_text("second", encoding="utf-8")
+    service.publish_version(asset_file)
+
+    v3 = service.rollback_to_version(asset_file, 1)
+
+    assert v3 is not None
+    assert v3.number == 3
+    assert "v001" in v3.notes
+    assert asset_file.read_text(encoding="utf-8") == "first"
+    # History is never rewritten
+    assert service.get_version(asset_file, 2).file_path.read_text(encoding="utf-8") == "second"
+
+
+def test_missing_asset_and_unknown_version():
+    """Versioning missing files or unknown numbers should fail gracefully"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    service = VersionServiceImpl()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+
+    assert service.publish_version(root / "missing.ma") is None
+    assert service.get_versions(root / "missing.ma") == []
+
+    asset_file = _make_asset(root, "content")
+    assert service.rollback_to_version(asset_file, 7) is None
+
+
+def test_same_stem_files_keep_their_own_history():
+    """A scene and its FBX handoff share a history folder but number their own snapshots"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    service = VersionServiceImpl()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+    scene = _make_asset(root, "scene v1")
+    handoff = scene.with_suffix(".fbx")
+    handoff.write_text("fbx v1", encoding="utf-8")
+
+    service.publish_version(scene)
+    service.publish_version(handoff)
+    scene.write_text("scene v2", encoding="utf-8")
+    service.publish_version(scene)
+    handoff.write_text("fbx v2", encoding="utf-8")
+
+    assert service.get_history_directory(scene) == service.get_history_directory(handoff)
+    assert [v.number for v in service.get_versions(scene)] == [1, 2]
+    assert [v.number for v in service.get_versions(handoff)] == [1]
+    first_fbx = service.get_latest_version(handoff)
+    assert first_fbx.file_path.suffix == ".fbx"
+    assert first_fbx.file_path.parent == service.get_version(scene, 1).file_path.parent
+    assert service.get_versioned_files(service.get_history_directory(scene)) == [scene, handoff]
+
+    # The scene's snapshot is not the FBX's to restore
+    assert service.rollback_to_version(handoff, 2) is None
+    assert handoff.read_text(encoding="utf-8") == "fbx v2"
+    restored = service.rollback_to_version(handoff, 1)
+    assert restored.number == 2 and handoff.read_text(encoding="utf-8") == "fbx v1"
+    assert scene.read_text(encoding="utf-8") == "scene v2"
+
+    # Pruning the scene's v001 leaves the FBX snapshot next to it
+    assert service.prune_version(scene, 1) == len("scene v1")
+    assert not service.get_version(scene, 1).exists and first_fbx.exists
+    try:
+        service.prune_version(scene, 2)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("The scene's latest version should not be prunable")
+    service.prune_version(handoff, 1)
+    assert not first_fbx.file_path.parent.exists()
+    try:
+        service.prune_version(handoff, 3)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("The FBX has no version 3")
+
+
+def test_version_folders_hidden_from_library_scan():
+    """Repository scan should not list .versions snapshots as assets"""
+    from src.services.standalone_services import StandaloneAssetRepository
+    from src.services.version_service_impl import VersionServiceImpl
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+    asset_file = _make_asset(root, "content")
+    VersionServiceImpl().publish_version(asset_file)
+
+    assets = StandaloneAssetRepository().find_all(root)
+    assert [a.file_path for a in assets] == [asset_file]
+
+
+def test_companion_files_versioned_and_restored():
+    """USD payloads published alongside the root layer should roll back with it"""
+    from src.services.usd_service_impl import UsdService
+    from src.services.version_service_impl import VersionServiceImpl
+
+    service = VersionServiceImpl()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_versions_"))
+    root_layer = root / "assets" / "models" / "hero.usd"
+    payload = UsdService().get_payload_layer_path(root_layer)
+    payload.parent.mkdir(parents=True, exist_ok=True)
+    root_layer.write_text("root v1", encoding="utf-8")
+    payload.write_text("geo v1", encoding="utf-8")
+
+    v1 = service.publish_version(root_layer, companion_files=[payload])
+    payload.write_text("geo v2", encoding="utf-8")
+    service.publish_version(root_layer, companion_files=[payload])
+
+    assert v1.extra["companions"] == [".usd_payloads/hero_geo.usdc"]
+    service.rollback_to_version(root_layer, 1)
+    assert payload.read_text(encoding="utf-8") == "geo v1"
@@ -0,0 +1,139 @@
+"""
+Test suite for publish-time auto-tagging
+
+Validates tag rules loaded from a library (name patterns, polycount thresholds, and
+source folders) and the built-in node type rules applied to a publish, against a
+minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Scene of a few roots with typed nodes below them and in their history"""
+
+    def __init__(self, scene_name="", types=None, children=None, history=None):
+        self.scene_name = scene_name
+        self.types = dict(types or {})
+        self.children = dict(children or {})
+        self.history = dict(history or {})
+
+    def ls(self, nodes=None, assemblies=False, dag=False, long=False, showType=False):
+        if assemblies:
+            return [node for node in self.types if node.count("|") == 1]
+        if dag:
+            found = []
+            for node in nodes:
+                found += [node] + self.children.get(node, [])
+            return found
+        if showType:
+            listing = []
+            for node in nodes:
+                listing += [node, self.types[node]]
+            return listing
+        return list(nodes or [])
+
+    def listHistory(self, nodes):
+        return [upstream for node in nodes for upstream in self.history.get(node, [])]
+
+    def file(self, query=False, sceneName=False):
+        return self.scene_name
+
+
+def test_library_rules():
+    """Library rules replace the built-in ones; invalid rules are skipped"""
+    from src.core.models.tag_rule import TagRule
+    from src.services.auto_tag_service_impl import DEFAULT_RULES, AutoTagService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_tag_rules_"))
+    service = AutoTagService()
+    assert service.load_rules(library) == list(DEFAULT_RULES)
+
+    rules_file = service.get_rules_file(library)
+    rules_file.parent.mkdir(parents=True)
+    rules_file.write_text(
+        json.dumps(
+            {
+                "rules": [
+                    {"tags": ["hero", "characters"], "name": "CHR_*", "min_triangles": 100000},
+                    {"tags": ["background"], "max_triangles": "5000"},
+                    {"tags": "environment/forest", "source_directory": "*/environments/forest*"},
+                    {"tags": ["everything"]},
+                    {"tags": ["odd"], "min_triangles": 10, "max_triangles": 5},
+                ]
+            }
+        )
+    )
+    rules = service.load_rules(library)
+    assert len(rules) == 3
+    assert rules[1].max_triangles == 5000
+    assert rules[0].description == "named CHR_*, at least 100,000 tris -> hero, characters"
+
+    forest = Path("/projects/show/environments/forest_a/scenes/pine.ma")
+    assert service.evaluate(rules, "chr_knight", set(), 250000, None) == ["hero", "characters"]
+    assert service.evaluate(rules, "chr_knight", set(), None, None) == []
+    assert service.evaluate(rules, "pine", set(), 1200, forest) == [
+        "background",
+        "environment/forest",
+    ]
+    assert service.evaluate(rules, "pine", set(), 9000, Path("/projects/props/pine.ma")) == []
+
+    # Saved rules round-trip; an empty list turns auto-tagging off, resetting restores it
+    rules.append(TagRule(tags=("rigged",), node_types=("skinCluster",), name_pattern="chr_*"))
+    assert service.save_rules(library, rules)
+    assert service.load_rules(library) == rules
+    assert service.save_rules(library, [])
+    assert service.load_rules(library) == []
+    assert service.reset_rules(library) and not rules_file.exists()
+    try:
+        TagRule(tags=(" / ",), name_pattern="*")
+        assert False, "a rule without tags is refused"
+    except ValueError:
+        pass
+
+
+def test_publish_tags_from_scene_content():
+    """Built-in rules tag deformed meshes rigged and Yeti grooms groom"""
+    from src.services.auto_tag_service_impl import AutoTagService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_tag_rules_"))
+    service = AutoTagService()
+    cmds = FakeCmds(
+        scene_name="/projects/show/characters/knight/knight_rig.ma",
+        types={
+            "|knight": "transform",
+            "|knight|body": "transform",
+            "|knight|body|bodyShape": "mesh",
+            "skinCluster1": "skinCluster",
+            "|knight|fur": "transform",
+            "|knight|fur|furShape": "pgYetiMaya",
+            "|persp": "transform",
+        },
+        children={
+            "|knight": ["|knight|body", "|knight|body|bodyShape"],
+            "|knight|fur": ["|knight|fur|furShape"],
+        },
+        history={"|knight|body|bodyShape": ["skinCluster1"]},
+    )
+    assert service.get_publish_tags(cmds, library, "knight", ["|knight"]) == ["rigged"]
+    assert service.get_publish_tags(cmds, library, "knight", ["|knight|fur"]) == ["groom"]
+
+    # Without a selection the whole scene is published, default cameras left out
+    cmds.children["|knight"].append("|knight|fur|furShape")
+    assert service.get_publish_tags(cmds, library, "knight") == ["rigged", "groom"]
+    cmds.history = {}
+    assert service.get_publish_tags(cmds, library, "knight", ["|knight|body"]) == []
+
+    rules_file = service.get_rules_file(library)
+    rules_file.parent.mkdir(parents=True)
+    rules_file.write_text(
+        json.dumps({"rules": [{"tags": ["characters"], "source_directory": "*/characters/*"}]})
+    )
+    assert service.get_publish_tags(cmds, library, "knight", ["|knight"]) == ["characters"]
+    cmds.scene_name = ""
+    assert service.get_publish_tags(cmds, library, "knight", ["|knight"]) == []
@@ -0,0 +1,126 @@
+"""
+Test suite for the headless mayapy command line
+
+Validates publish, re-export, thumbnail, and validate commands end to end against a
+stand-in for maya.cmds that writes scene files instead of running Maya.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Records opened scenes and writes exported or saved scenes to disk"""
+
+    def __init__(self):
+        self.opened = []
+        self.scene = ""
+
+    def file(self, path=None, **kwargs):
+        if kwargs.get("open"):
+            self.opened.append(Path(path))
+            self.scene = Path(path).read_text()
+        elif kwargs.get("rename"):
+            self.renamed = Path(kwargs["rename"])
+        elif kwargs.get("save"):
+            self.renamed.write_text(self.scene + "\n// saved")
+        elif kwargs.get("exportAll"):
+            Path(path).write_text(self.scene + f"\n// {kwargs['type']}")
+
+    def __getattr__(self, name):
+        # Scene queries of the validation checks find an empty scene
+        return lambda *args, **kwargs: []
+
+
+def _make_service():
+    from src.services.batch_service_impl import BatchService
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+
+    def render(job):
+        job.still_path.parent.mkdir(parents=True, exist_ok=True)
+        job.still_path.write_bytes(b"png")
+        return True
+
+    return BatchService(thumbnail_queue=ThumbnailQueue(max_workers=1, runner=render))
+
+
+def test_publish_command():
+    """publish exports into the library, versions it, tags it, and renders a thumbnail"""
+    from src.cli import EXIT_OK, build_parser, run_command
+    from src.services.metadata_database_impl import get_metadata_database
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_cli_"))
+    library = root / "library"
+    library.mkdir()
+    source = root / "car.ma"
+    source.write_text("//Maya ASCII car")
+
+    cmds = FakeCmds()
+    service = _make_service()
+    args = build_parser().parse_args(
+        ["publish", "--file", str(source), "--library", str(library), "--tags", "vehicle, hero"]
+    )
+    assert run_command(args, cmds, service) == EXIT_OK
+
+    asset_file = library / "assets" / "scenes" / "car.ma"
+    assert "//Maya ASCII car" in asset_file.read_text()
+    assert (asset_file.parent / ".thumbnails" / "car_screenshot.png").exists()
+    assert [v.number for v in service._version_service.get_versions(asset_file)] == [1]
+
+    args = build_parser().parse_args(
+        ["publish", "--file", str(source), "--library", str(library), "--tags", "red"]
+        + ["--format", ".mb", "--no-thumbnail", "--skip-validation"]
+    )
+    assert run_command(args, cmds, service) == EXIT_OK
+    assert "mayaBinary" in (asset_file.with_suffix(".mb")).read_text()
+
+    database = get_metadata_database(library)
+    assert database.get_asset_metadata(asset_file)["tags"] == ["vehicle", "hero"]
+    assert database.get_asset_metadata(asset_file.with_suffix(".mb"))["tags"] == ["red"]
+
+
+def test_maintenance_commands():
+    """reexport versions every matching scene; thumbnails and validate cover the library"""
+    from src.cli import EXIT_OK, EXIT_USAGE, build_parser, main, run_command
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_cli_"))
+    scenes = library / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    for name in ("car", "cart", "tree"):
+        (scenes / f"{name}.ma").write_text(f"//Maya ASCII {name}")
+    hidden = scenes / ".backup"
+    hidden.mkdir()
+    (hidden / "car.ma").write_text("hidden folders are not library assets")
+
+    cmds = FakeCmds()
+    service = _make_service()
+    parser = build_parser()
+
+    args = parser.parse_args(["reexport", "--library", str(library), "--asset", "car*"])
+    assert run_command(args, cmds, service) == EXIT_OK
+    assert [path.name for path in cmds.opened] == ["car.ma", "cart.ma"]
+    assert (scenes / "car.ma").read_text().endswith("// saved")
+    versions = service._version_service.get_versions(scenes / "cart.ma")
+    assert [v.notes for v in versions] == ["Baseline (pre-versioning)", "Batch re-export"]
+
+    (scenes / ".thumbnails").mkdir(exist_ok=True)
+    (scenes / ".thumbnails" / "tree_screenshot.png").write_bytes(b"png")
+    args = parser.parse_args(["thumbnails", "--library", str(library), "--missing-only"])
+    assert run_command(args, cmds, service) == EXIT_OK
+    assert (scenes / ".thumbnails" / "cart_screenshot.png").exists()
+
+    report = library / "nightly.json"
+    args = parser.parse_args(["validate", "--library", str(library), "--report", str(report)])
+    assert run_command(args, cmds, service) == EXIT_OK
+    with open(report, "r", encoding="utf-8") as f:
+        findings = json.load(f)
+    assert [Path(entry["file"]).name for entry in findings] == ["car.ma", "cart.ma", "tree.ma"]
+    assert all(entry["can_publish"] for entry in findings)
+
+    args = parser.parse_args(["validate", "--library", str(library / "missing")])
+    assert run_command(args, cmds, service) == EXIT_USAGE
+    assert main(["unknown-command"]) == EXIT_USAGE
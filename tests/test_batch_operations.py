@@ -0,0 +1,85 @@
+"""
+Test suite for multi-selection batch operations
+
+Validates running one action over many assets with progress and cancelling,
+the batch report, and the tag and collection changes the browser runs in batches.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from types import SimpleNamespace
+
+
+def _assets(*names):
+    return [SimpleNamespace(display_name=name, tags=[]) for name in names]
+
+
+def test_batch_run_reports_and_cancels():
+    """One failing asset should not stop a batch, and Stop should end it early"""
+    from src.core.models.batch_operation import BATCH_ADD_TAGS, BATCH_DELETE
+    from src.services.asset_batch_service_impl import AssetBatchService
+
+    service = AssetBatchService()
+    assets = _assets("crate", "barrel", "lamp")
+
+    def operation(asset):
+        if asset.display_name == "barrel":
+            raise ValueError("Not inside the loaded library")
+        return asset.display_name == "crate"
+
+    calls = []
+    report = service.run(BATCH_DELETE, assets, operation, lambda done, total: calls.append(done))
+    assert calls == [0, 1, 2, 3]
+    assert report.changed == ["crate"] and report.unchanged == ["lamp"]
+    assert report.failed == [("barrel", "Not inside the loaded library")]
+    assert not report.is_clean and report.processed == 3
+    assert report.summary() == "Delete: 1 of 3 asset(s) changed, 1 unchanged, 1 failed"
+
+    # Stop pressed while the second asset was showing
+    report = service.run(BATCH_ADD_TAGS, assets, lambda asset: True, lambda done, total: done < 2)
+    assert report.cancelled and report.changed == ["crate", "barrel"]
+    assert report.summary() == "Add tags: 2 of 3 asset(s) changed, cancelled after 2"
+
+    report = service.run(BATCH_ADD_TAGS, [], lambda asset: True)
+    assert report.is_clean and report.summary() == "Add tags: 0 of 0 asset(s) changed"
+
+
+def test_batch_tags_and_collection_moves():
+    """Tags should be added and removed once per asset and collections moved as a set"""
+    from src.services.asset_batch_service_impl import AssetBatchService
+    from src.services.collection_service_impl import CollectionService, make_collection
+
+    service = AssetBatchService()
+    crate, barrel = _assets("crate", "barrel")
+    crate.tags = ["Props"]
+
+    assert service.add_tags(crate, ["props", " env / forest "])
+    assert crate.tags == ["Props", "env/forest"]
+    assert not service.add_tags(crate, ["PROPS"])
+    assert service.add_tags(barrel, ["hero"])
+    assert service.get_tags([crate, barrel]) == ["env/forest", "hero", "Props"]
+    assert service.remove_tags(crate, ["props"]) and crate.tags == ["env/forest"]
+    assert not service.remove_tags(barrel, ["props"])
+
+    collections = {
+        "Hero": make_collection(),
+        "Forest": make_collection(),
+        "Approved": make_collection(query="status:approved"),
+    }
+    collections["Hero"]["assets"] = ["crate.ma"]
+    collections["Forest"]["assets"] = ["crate.ma", "barrel.ma"]
+    collection_service = CollectionService()
+    changed = collection_service.move_members(collections, ["crate.ma", "barrel.ma"], "Hero")
+    assert changed == ["Forest", "Hero"]
+    assert collections["Hero"]["assets"] == ["crate.ma", "barrel.ma"]
+    assert collections["Forest"]["assets"] == []
+    assert collection_service.move_members(collections, ["crate.ma"], "Hero") == []
+
+    for target in ("Approved", "Missing"):
+        try:
+            collection_service.move_members(collections, ["crate.ma"], target)
+        except ValueError:
+            pass
+        else:
+            raise AssertionError(f"Moving into '{target}' should be rejected")
@@ -0,0 +1,136 @@
+"""
+Test suite for publishing several assets out of one scene
+
+Validates finding selection sets and top-level groups, publishing each as its own
+asset with shared tags, and reporting assets that fail without stopping the run.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """A scene of props in sets and groups; exports write the selected nodes"""
+
+    SETS = {
+        "chair_set": ["|chair_grp"],
+        "table_set": ["|table_grp", "|table_cloth"],
+        "empty_set": [],
+        "defaultLightSet": ["|key_light"],
+    }
+    ASSEMBLIES = ["|persp", "|top", "|chair_grp", "|table_grp", "|prop:lamp_grp"]
+
+    def __init__(self):
+        self.selection = ["|chair_grp"]
+
+    def ls(self, *args, **kwargs):
+        if kwargs.get("exactType") == "objectSet":
+            return list(self.SETS)
+        if kwargs.get("assemblies"):
+            return list(self.ASSEMBLIES)
+        if kwargs.get("selection"):
+            return list(self.selection)
+        return []
+
+    def sets(self, name, query=False):
+        return list(self.SETS[name])
+
+    def select(self, nodes, replace=False, noExpand=False):
+        self.selection = list(nodes)
+
+    def file(self, path=None, **kwargs):
+        if kwargs.get("exportSelected"):
+            Path(path).write_text(" ".join(self.selection))
+
+    def __getattr__(self, name):
+        # Scene queries of the validation checks find nothing to report
+        return lambda *args, **kwargs: []
+
+
+def _make_service():
+    from src.services.batch_service_impl import BatchService
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+
+    def render(job):
+        job.still_path.parent.mkdir(parents=True, exist_ok=True)
+        job.still_path.write_bytes(b"png")
+        return True
+
+    return BatchService(thumbnail_queue=ThumbnailQueue(max_workers=1, runner=render))
+
+
+def test_find_scene_items():
+    """Non-empty user sets and top-level groups (not cameras) each become an item"""
+    from src.services.batch_service_impl import SOURCE_GROUPS, SOURCE_SETS
+
+    service = _make_service()
+    sets = service.find_scene_items(FakeCmds(), SOURCE_SETS)
+    assert [(item.source, item.name) for item in sets] == [
+        ("chair_set", "chair_set"),
+        ("table_set", "table_set"),
+    ]
+    assert sets[1].nodes == ["|table_grp", "|table_cloth"]
+
+    groups = service.find_scene_items(FakeCmds(), SOURCE_GROUPS)
+    assert [item.name for item in groups] == ["chair_grp", "table_grp", "lamp_grp"]
+    assert groups[2].nodes == ["|prop:lamp_grp"]
+
+    try:
+        service.find_scene_items(FakeCmds(), "layers")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("unknown scene sources should be rejected")
+
+
+def test_publish_scene_items():
+    """Each item is exported with its own nodes; a failing item does not stop the run"""
+    from src.services.batch_service_impl import SOURCE_SETS, ScenePublishItem
+    from src.services.lock_service_impl import get_lock_service
+    from src.services.metadata_database_impl import get_metadata_database
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_scene_batch_"))
+    cmds = FakeCmds()
+    service = _make_service()
+    chair, table = service.find_scene_items(cmds, SOURCE_SETS)
+    chair.name, chair.tags = "Chair", ["seating"]
+    table.name = "Table"
+
+    scenes = library / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    (scenes / "Locked.ma").write_text("checked out")
+    assert get_lock_service().check_out(scenes / "Locked.ma", user="another_artist")
+    locked_item = ScenePublishItem("lamp_set", "Locked", ["|lamp"])
+    skipped = ScenePublishItem("rug_set", "Rug", ["|rug"], enabled=False)
+
+    started = []
+    results = service.publish_scene_items(
+        cmds,
+        [chair, locked_item, skipped, table],
+        library,
+        tags=["kitchen"],
+        progress=lambda index, item: started.append(item.name) or True,
+    )
+    assert started == ["Chair", "Locked", "Table"]
+    assert [result.success for result in results] == [True, False, True]
+    assert results[0].details["source"] == "chair_set"
+    assert "checked out by another_artist" in results[1].message
+    assert (scenes / "Chair.ma").read_text() == "|chair_grp"
+    assert (scenes / "Table.ma").read_text() == "|table_grp |table_cloth"
+    assert (scenes / ".thumbnails" / "Table_screenshot.png").exists()
+    assert cmds.selection == ["|chair_grp"]  # The artist's selection is restored
+
+    database = get_metadata_database(library)
+    assert database.get_asset_metadata(scenes / "Chair.ma")["tags"] == ["kitchen", "seating"]
+    assert database.get_asset_metadata(scenes / "Table.ma")["tags"] == ["kitchen"]
+
+    # Stopping from the progress callback publishes nothing more
+    results = service.publish_scene_items(
+        cmds, [chair, table], library, thumbnail=False, progress=lambda index, item: index < 1
+    )
+    assert len(results) == 1
+    versions = service._version_service.get_versions(scenes / "Chair.ma")
+    assert [v.number for v in versions] == [1, 2]
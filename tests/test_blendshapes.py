@@ -0,0 +1,159 @@
+"""
+Test suite for blendshape target sets
+
+Validates topology fingerprints, capturing sculpted targets as sparse deltas, and
+applying a set to a matching mesh against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+# Two quads side by side, six vertices
+GRID_POINTS = [0.0, 0, 0, 1.0, 0, 0, 2.0, 0, 0, 0.0, 1.0, 0, 1.0, 1.0, 0, 2.0, 1.0, 0]
+GRID_FACES = [[0, 1, 4, 3], [1, 2, 5, 4]]
+
+
+class FakeCmds:
+    """Meshes with points and faces, and the blendShape nodes made on them"""
+
+    def __init__(self, meshes):
+        self.meshes = {name: (list(points), faces) for name, (points, faces) in meshes.items()}
+        self.attributes = {}
+        self.aliases = {}
+        self.nodes = {}  # blendShape node -> base mesh
+
+    def polyInfo(self, mesh, faceToVertex=False):
+        faces = self.meshes[mesh][1]
+        return [
+            f"FACE {index:6d}:" + "".join(f"{v:7d}" for v in face) + " \n"
+            for index, face in enumerate(faces)
+        ]
+
+    def polyEvaluate(self, mesh, vertex=False, edge=False, face=False):
+        points, faces = self.meshes[mesh]
+        if vertex:
+            return len(points) // 3
+        if face:
+            return len(faces)
+        edges = {
+            tuple(sorted((f[i], f[(i + 1) % len(f)]))) for f in faces for i in range(len(f))
+        }
+        return len(edges)
+
+    def xform(self, component, query=False, objectSpace=False, translation=False):
+        return list(self.meshes[component.split(".")[0]][0])
+
+    def listRelatives(self, node, shapes=False, parent=False, fullPath=False):
+        return []
+
+    def ls(self, nodes=None, type=None, noIntermediate=False, long=False):
+        if type == "mesh":
+            return list(self.meshes)
+        return [node for node in nodes or [] if node in self.nodes]
+
+    def listHistory(self, mesh):
+        return [node for node, base in self.nodes.items() if base == mesh]
+
+    def listConnections(self, plug, source=False, shapes=False):
+        return None
+
+    def blendShape(self, mesh, name="", frontOfChain=False, query=False, geometry=False):
+        if query:
+            return [self.nodes[mesh]]
+        self.nodes[name] = mesh
+        return [name]
+
+    def setAttr(self, plug, *values, type=None):
+        if type in ("pointArray", "componentList"):
+            values = list(values[1:])
+        self.attributes[plug] = values[0] if type is None else values
+
+    def getAttr(self, plug, multiIndices=False):
+        if multiIndices:
+            prefix = plug + "["
+            return [int(key[len(prefix):-1]) for key in self.attributes if key.startswith(prefix)]
+        return self.attributes.get(plug)
+
+    def aliasAttr(self, name, plug=None, query=False):
+        if query:
+            return self.aliases.get(name)
+        self.aliases[plug] = name
+
+
+def test_topology_and_sculpted_targets():
+    """Sculpts become sparse deltas; meshes with other topology are left out"""
+    from src.services.blendshape_service_impl import BlendshapeService
+
+    smile = list(GRID_POINTS)
+    smile[13] += 0.5  # Vertex 4 up
+    flipped = [GRID_FACES[0], [1, 4, 5, 2]]
+    cmds = FakeCmds(
+        {
+            "head": (GRID_POINTS, GRID_FACES),
+            "smile": (smile, GRID_FACES),
+            "other_head": (GRID_POINTS, flipped),
+            "cube": (GRID_POINTS[:12], [[0, 1, 3, 2]]),
+        }
+    )
+    service = BlendshapeService()
+
+    head = service.get_topology(cmds, "head")
+    assert (head.vertices, head.edges, head.faces) == (6, 7, 2)
+    assert head.matches(service.get_topology(cmds, "smile"))
+    assert head.differences(service.get_topology(cmds, "other_head")) == [
+        "same counts but vertices are connected differently"
+    ]
+    assert "vertex count 6 -> 4" in head.differences(service.get_topology(cmds, "cube"))
+    assert service.find_matching_meshes(cmds, head) == ["head", "smile"]
+
+    targets, mismatched = service.read_mesh_targets(cmds, "head", ["smile", "cube"])
+    assert targets == {"smile": {"indices": [4], "deltas": [[0.0, 0.5, 0.0]]}}
+    assert mismatched == ["cube"]
+
+    blendshape = service.create_blendshape_set(cmds, "head", targets, "face", "correctives")
+    library = Path(tempfile.mkdtemp(prefix="assetManager_blendshapes_"))
+    saved = service.save_blendshape_set(library / "face", blendshape)
+    assert saved.suffix == ".blendshape"
+    loaded = service.load_blendshape_set(saved)
+    assert loaded["topology"] == head.to_dict() and loaded["targets"] == targets
+    assert service.check_topology(cmds, loaded, "other_head")
+
+
+def test_apply_writes_deltas_into_a_new_blendshape_node():
+    """Applied targets are stored on the node and read back as the same set"""
+    from src.core.models.mesh_topology import MeshTopology
+    from src.services.blendshape_service_impl import BlendshapeService, parse_components
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    assert parse_components(["vtx[0:2]", "vtx[7]"]) == [0, 1, 2, 7]
+
+    service = BlendshapeService()
+    cmds = FakeCmds({"heroB:head": (GRID_POINTS, GRID_FACES), "small": (GRID_POINTS[:9], [])})
+    blendshape = {
+        "type": "blendshape",
+        "name": "face",
+        "topology": service.get_topology(cmds, "heroB:head").to_dict(),
+        "targets": {
+            "jawOpen": {"indices": [0, 1], "deltas": [[0, -0.4, 0.1], [0, -0.2, 0]]},
+            "smile": {"indices": [4], "deltas": [[0, 0.5, 0]]},
+        },
+    }
+
+    result = service.apply_blendshape_set(cmds, blendshape, "heroB:head")
+    assert result.success and result.node == "head_face_blendShape"
+    assert result.targets == ["jawOpen", "smile"] and result.skipped_points == 0
+    assert service.find_blendshape_nodes(cmds, "heroB:head") == [result.node]
+    assert service.read_node_targets(cmds, result.node) == blendshape["targets"]
+
+    partial = service.apply_blendshape_set(cmds, blendshape, "small", targets=["smile"])
+    assert partial.targets == ["smile"] and partial.skipped_points == 1
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_blendshapes_"))
+    database = MetadataDatabase(library)
+    set_file = library / "assets" / "blendshapes" / "face.blendshape"
+    service.record_topology(database, set_file, MeshTopology.from_dict(blendshape["topology"]))
+    assert database.get_asset_metadata(set_file)["topology"]["vertices"] == 6
+    database.close()
@@ -0,0 +1,132 @@
+"""
+Test suite for library-wide bulk metadata edits
+
+Validates previewing tag renames, author changes, and path prefix rewrites, applying
+them as one logged transaction, and undoing them without losing later changes.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+def _make_library(prefix: str):
+    from src.services.bulk_edit_service_impl import BulkEditService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = Path(tempfile.mkdtemp(prefix=prefix))
+    scenes = root / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    database = MetadataDatabase(root)
+    service = BulkEditService(database_factory=lambda _root: database)
+    return root, scenes, database, service
+
+
+def test_plan_bulk_edits():
+    """Previews list exactly the assets and fields an edit changes, writing nothing"""
+    from src.core.models.bulk_edit import OPERATION_SET_AUTHOR, BulkEdit
+    from src.services.bulk_edit_service_impl import replace_path_prefix
+
+    assert replace_path_prefix("//srv/lib/tex/wood.png", "//srv/lib/", "//nas/lib") == (
+        "//nas/lib/tex/wood.png"
+    )
+    assert replace_path_prefix("\\\\srv\\lib\\wood.png", "//srv/lib", "//nas") == "//nas\\wood.png"
+    assert replace_path_prefix("//srv/library/wood.png", "//srv/lib", "//nas") == (
+        "//srv/library/wood.png"
+    )
+    assert replace_path_prefix({"maps": [{"source": "/a/b.png"}], "n": 2}, "/a", "/c") == {
+        "maps": [{"source": "/c/b.png"}],
+        "n": 2,
+    }
+
+    root, scenes, database, service = _make_library("assetManager_bulkplan_")
+    database.save_asset_metadata(
+        scenes / "crate.ma",
+        {
+            "tags": ["props/wood", "wip"],
+            "author": "kim",
+            "ingest": {"source": "//old-server/drop/crate.obj", "user": "kim"},
+        },
+    )
+    database.save_asset_metadata(scenes / "rock.ma", {"tags": ["nature"], "author": "Kim"})
+    database.save_asset_metadata(scenes / "tree.ma", {"tags": ["props"], "author": "lee"})
+
+    rename = service.plan_rename_tag(root, "props", "set/props")
+    assert {change.asset_key: change.new_value for change in rename.changes} == {
+        "assets/scenes/crate.ma": ["set/props/wood", "wip"],
+        "assets/scenes/tree.ma": ["set/props"],
+    }
+    assert database.get_asset_metadata(scenes / "tree.ma")["tags"] == ["props"]
+
+    by_author = service.plan_set_author(root, "kim.lee", old_author="KIM")
+    assert by_author.asset_keys == ("assets/scenes/crate.ma", "assets/scenes/rock.ma")
+    assert by_author.description == "Change Author: KIM -> kim.lee, 2 asset(s)"
+    selected = service.plan_set_author(root, "lee", asset_keys=["assets/scenes/tree.ma"])
+    assert selected.changes == ()  # Already lee
+
+    paths = service.plan_replace_path(root, "//old-server", "//nas")
+    assert [(change.asset_name, change.field) for change in paths.changes] == [
+        ("crate", "ingest")
+    ]
+    assert paths.changes[0].new_value["source"] == "//nas/drop/crate.obj"
+
+    for plan, arguments in (
+        (service.plan_rename_tag, ("props", " props ")),
+        (service.plan_set_author, ("lee",)),
+        (service.plan_replace_path, ("/", "//nas")),
+    ):
+        try:
+            plan(root, *arguments)
+        except ValueError:
+            continue
+        raise AssertionError(f"{plan.__name__}{arguments} should be refused")
+
+    restored = BulkEdit.from_dict(json.loads(json.dumps(by_author.to_dict())))
+    assert restored == by_author and restored.operation == OPERATION_SET_AUTHOR
+
+
+def test_apply_and_undo_bulk_edit():
+    """Applied edits are logged; undo restores old values but keeps later changes"""
+    root, scenes, database, service = _make_library("assetManager_bulkapply_")
+    database.save_asset_metadata(scenes / "crate.ma", {"tags": ["props"], "author": "kim"})
+    database.save_asset_metadata(scenes / "tree.ma", {"tags": ["props", "nature"]})
+    (scenes / "crate.ma.meta").write_text(json.dumps({"tags": ["props"], "author": "kim"}))
+
+    applied = service.apply(root, service.plan_rename_tag(root, "props", "set"))
+    assert applied.user and applied.date is not None
+    assert database.get_asset_metadata(scenes / "crate.ma")["tags"] == ["set"]
+    assert database.get_asset_metadata(scenes / "tree.ma")["tags"] == ["set", "nature"]
+    assert json.loads((scenes / "crate.ma.meta").read_text())["tags"] == ["set"]
+
+    author_edit = service.apply(
+        root, service.plan_set_author(root, "lee", asset_keys=["assets/scenes/tree.ma"])
+    )
+    assert database.get_asset_metadata(scenes / "tree.ma")["author"] == "lee"
+    assert [entry.edit_id for entry in service.get_log(root)] == [
+        author_edit.edit_id,
+        applied.edit_id,
+    ]
+
+    # Someone retags the tree by hand after the rename
+    database.save_asset_metadata(
+        scenes / "tree.ma", dict(database.get_asset_metadata(scenes / "tree.ma"), tags=["tree"])
+    )
+    undone, conflicts = service.undo(root, applied.edit_id)
+    assert undone.is_undone and conflicts == ["assets/scenes/tree.ma: tags"]
+    assert database.get_asset_metadata(scenes / "crate.ma")["tags"] == ["props"]
+    assert database.get_asset_metadata(scenes / "tree.ma")["tags"] == ["tree"]
+    assert json.loads((scenes / "crate.ma.meta").read_text())["tags"] == ["props"]
+
+    # Undoing an author change on an asset that had none removes the author again
+    service.undo(root, author_edit.edit_id)
+    assert "author" not in database.get_asset_metadata(scenes / "tree.ma")
+    assert all(entry.is_undone for entry in service.get_log(root))
+    for edit_id, error in ((applied.edit_id, ValueError), ("missing", KeyError)):
+        try:
+            service.undo(root, edit_id)
+        except error:
+            continue
+        raise AssertionError(f"Undoing {edit_id} should raise {error.__name__}")
@@ -0,0 +1,159 @@
+"""
+Test suite for shot camera assets
+
+Validates finding the published camera, reading its lens, film back, key range, and
+image planes into library metadata, and looking through the imported or referenced
+camera in the active viewport.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+
+class FakeCmds:
+    """Camera transforms with shapes, keys, image planes, and viewports"""
+
+    def __init__(self):
+        self.types = {}  # full path -> node type
+        self.attributes = {}  # plug -> value
+        self.keys = {}  # node -> key times
+        self.image_planes = {}  # camera shape -> image plane shapes
+        self.panels = {"modelPanel1": "modelPanel", "outlinerPanel1": "outlinerPanel"}
+        self.focus = "outlinerPanel1"
+        self.panel_cameras = {}
+
+    def add(self, path, node_type="transform"):
+        self.types[path] = node_type
+
+    def add_camera(self, path, focal_length=35.0, keys=None):
+        self.add(path)
+        shape = f"{path}|{path.rsplit('|', 1)[-1]}Shape"
+        self.add(shape, "camera")
+        self.attributes.update(
+            {
+                f"{shape}.focalLength": focal_length,
+                f"{shape}.horizontalFilmAperture": 36.0 / 25.4,
+                f"{shape}.verticalFilmAperture": 24.0 / 25.4,
+                f"{shape}.filmFit": "horizontal",
+                f"{shape}.nearClipPlane": 1.0,
+                f"{shape}.farClipPlane": 5000.0,
+            }
+        )
+        if keys:
+            self.keys[path] = list(keys)
+        return shape
+
+    # Nodes
+    def ls(self, *args, **kwargs):
+        if kwargs.get("type") == "camera":
+            return [p for p, kind in self.types.items() if kind == "camera"]
+        return list(args[0]) if args else []
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def listRelatives(self, node, parent=False, shapes=False, allDescendents=False, **kwargs):
+        if parent:
+            return [node.rsplit("|", 1)[0]]
+        depth = node.count("|") + 1
+        if shapes:
+            found = [p for p in self.types if p.startswith(f"{node}|") and p.count("|") == depth]
+            return [p for p in found if self.types[p] == kwargs.get("type")] or None
+        if allDescendents:
+            found = [p for p in self.types if p.startswith(f"{node}|")]
+            return list(reversed([p for p in found if self.types[p] == "transform"])) or None
+        return None
+
+    def getAttr(self, plug, asString=False):
+        return self.attributes[plug]
+
+    def keyframe(self, nodes, query=False, timeChange=False):
+        return [time for node in nodes for time in self.keys.get(node, [])] or None
+
+    def listConnections(self, plug, source=True, destination=False, shapes=False):
+        return self.image_planes.get(plug.rsplit(".", 1)[0]) or None
+
+    # Viewports
+    def getPanel(self, withFocus=False, typeOf=None, visiblePanels=False):
+        if withFocus:
+            return self.focus
+        if typeOf:
+            return self.panels.get(typeOf)
+        return list(self.panels)
+
+    def modelPanel(self, panel, edit=False, camera=None):
+        self.panel_cameras[panel] = camera
+
+
+def test_camera_published_with_lens_keys_and_image_planes():
+    """The selected shot camera is described for the library, default cameras are skipped"""
+    from src.core.models.shot_camera import ShotCamera
+    from src.services.camera_service_impl import CameraService
+    from src.services.dependency_service_impl import DEPENDENCY_ATTRIBUTES
+
+    service = CameraService()
+    cmds = FakeCmds()
+    cmds.add_camera("|persp")
+    shape = cmds.add_camera("|sh010_cam", focal_length=40.0, keys=[1001, 1060, 1120])
+    cmds.image_planes[shape] = ["|sh010_cam|sh010_camShape|imagePlane1|imagePlaneShape1"]
+    cmds.attributes["|sh010_cam|sh010_camShape|imagePlane1|imagePlaneShape1.imageName"] = (
+        "P:/plates/sh010.####.exr"
+    )
+    cmds.add("|set_geo")
+
+    assert service.find_cameras(cmds) == ["|sh010_cam"]
+    assert service.find_cameras(cmds, ["|persp", shape, "|set_geo"]) == ["|sh010_cam"]
+
+    camera = service.read_camera(cmds, "|sh010_cam")
+    assert camera.is_animated and camera.frame_range_label == "1001-1120"
+    assert camera.film_back_label == "36.0 x 24.0 mm" and camera.film_fit == "horizontal"
+    assert camera.image_planes[0].image == "P:/plates/sh010.####.exr"
+    assert camera.description == (
+        "40mm lens, 36.0 x 24.0 mm film back, frames 1001-1120, 1 image plane(s)"
+    )
+
+    # Stored in the asset's metadata and read back for the details panel
+    metadata = {"camera": service.build_camera_metadata(cmds, "|sh010_cam")}
+    assert service.get_camera(metadata) == camera
+    assert service.get_camera({}) is None
+    assert ShotCamera("still_cam").frame_range_label == "still"
+
+    # Image plane plates are collected like textures
+    assert DEPENDENCY_ATTRIBUTES["imagePlane"] == ("imageName", "images")
+
+    try:
+        service.read_camera(cmds, "|set_geo")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("A transform without a camera shape should be rejected")
+
+
+def test_imported_camera_becomes_viewport_camera():
+    """The referenced camera is found under its group and set on the active viewport"""
+    from src.core.models.shot_camera import ShotCamera
+    from src.services.camera_service_impl import CameraService
+
+    service = CameraService()
+    cmds = FakeCmds()
+    cmds.add("|sh010_RN_grp")
+    cmds.add_camera("|sh010_RN_grp|sh010:sh010_cam")
+    cmds.add_camera("|sh010_RN_grp|sh010:witness_cam")
+    camera = ShotCamera("sh010_cam", focal_length=40.0)
+
+    # The outliner has focus, so the first visible viewport is used
+    imported = service.setup_camera(cmds, ["|sh010_RN_grp"], camera)
+    assert imported == "|sh010_RN_grp|sh010:sh010_cam"
+    assert cmds.panel_cameras == {"modelPanel1": imported}
+
+    # Looking through can be turned off; the camera is still found
+    cmds.panel_cameras.clear()
+    assert service.setup_camera(cmds, ["|sh010_RN_grp"], camera, look_through=False) == imported
+    assert cmds.panel_cameras == {}
+
+    # Two cameras and neither matches - nothing to look through
+    other = ShotCamera("sh020_cam")
+    assert service.setup_camera(cmds, ["|sh010_RN_grp"], other) is None
+
+    cmds.panels = {"outlinerPanel1": "outlinerPanel"}
+    assert service.get_active_model_panel(cmds) is None
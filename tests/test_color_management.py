@@ -0,0 +1,124 @@
+"""
+Test suite for color managed thumbnails
+
+Validates the library OCIO settings and their $OCIO fallback, the batch arguments
+they produce, and finding thumbnails rendered with an outdated config or view.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """colorManagementPrefs of a scene using an ACES config"""
+
+    def __init__(self, config_path):
+        self.prefs = {
+            "cmEnabled": True,
+            "cmConfigFileEnabled": True,
+            "configFilePath": config_path,
+            "renderingSpaceName": "ACEScg",
+            "displayName": "sRGB",
+            "viewName": "ACES 1.0 SDR-video",
+        }
+        self.outputs = {"renderer": [False, False], "playblast": [True, False]}
+
+    def colorManagementPrefs(self, query=False, edit=False, outputTarget=None, **flags):
+        if outputTarget is not None:
+            output = self.outputs[outputTarget]
+            if query:
+                return output[0] if "outputTransformEnabled" in flags else output[1]
+            output[0] = flags["outputTransformEnabled"]
+            output[1] = flags["outputUseViewTransform"]
+            return None
+        (name,) = flags
+        return self.prefs[name]
+
+
+def test_project_transform_settings_and_batch_arguments():
+    """Library settings win over $OCIO; the config hash follows the file contents"""
+    from src.core.models.color_transform import ColorTransform
+    from src.services.color_management_service_impl import ColorManagementService
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_color_"))
+    config = root / "aces_1.2" / "config.ocio"
+    config.parent.mkdir()
+    config.write_text("ocio_profile_version: 2\n")
+    service = ColorManagementService()
+
+    previous_ocio = os.environ.pop("OCIO", None)
+    try:
+        assert not service.load_project_transform(root).is_managed
+        os.environ["OCIO"] = str(config)
+        from_env = service.load_project_transform(root)
+        assert from_env.config_path == str(config) and from_env.config_hash
+        assert from_env.label == "Default view - aces_1.2"
+
+        chosen = ColorTransform(config_path=str(config), display="sRGB", view="Un-tone-mapped")
+        assert service.save_project_transform(root, chosen)
+        assert "config_hash" not in service.get_settings_file(root).read_text()
+        loaded = service.load_project_transform(root)
+        assert loaded.view == "Un-tone-mapped" and loaded.config_hash == from_env.config_hash
+    finally:
+        os.environ.pop("OCIO", None)
+        if previous_ocio is not None:
+            os.environ["OCIO"] = previous_ocio
+
+    config.write_text("ocio_profile_version: 2\nroles: {}\n")
+    assert service.hash_config(str(config)) != loaded.config_hash
+    assert service.hash_config(str(root / "missing.ocio")) == ""
+
+    queue = ThumbnailQueue(max_workers=1, runner=lambda job: True)
+    job = queue.enqueue(root / "crate.ma", color=loaded)
+    plain = queue.enqueue(root / "barrel.ma")
+    queue.wait()
+    command = queue.build_batch_command(job, Path("mayapy"))
+    assert command[command.index("--ocio-config") + 1] == str(config)
+    assert command[command.index("--ocio-view") + 1] == "Un-tone-mapped"
+    assert "--ocio-rendering-space" not in command
+    assert "--ocio-config" not in queue.build_batch_command(plain, Path("mayapy"))
+
+    from src.services.thumbnail_batch import _parse_args
+
+    args = _parse_args(["--input", "a.ma", "--still", "a.png"] + command[8:])
+    assert args.ocio_display == "sRGB" and args.ocio_view == "Un-tone-mapped"
+
+
+def test_recorded_thumbnail_transforms_find_stale_thumbnails():
+    """Thumbnails rendered before a config edit, or never recorded, are stale"""
+    from src.services.color_management_service_impl import ColorManagementService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_color_"))
+    config = library / "studio.ocio"
+    config.write_text("ocio_profile_version: 2\n")
+    crate, barrel = library / "crate.ma", library / "barrel.ma"
+    database = MetadataDatabase(library)
+    database.save_asset_metadata(crate, {"tags": ["wood"]})
+    service = ColorManagementService()
+
+    cmds = FakeCmds(str(config))
+    scene = service.read_scene_transform(cmds)
+    assert (scene.rendering_space, scene.display) == ("ACEScg", "sRGB")
+    assert scene.view == "ACES 1.0 SDR-video" and scene.config_hash
+
+    assert service.find_stale_thumbnails(database, scene, [crate, barrel]) == [crate, barrel]
+    service.record_thumbnail_transform(database, crate, scene)
+    assert service.get_thumbnail_transform(database, crate) == scene
+    assert database.get_asset_metadata(crate)["tags"] == ["wood"]
+    assert service.find_stale_thumbnails(database, scene, [crate, barrel]) == [barrel]
+
+    config.write_text("ocio_profile_version: 2\nroles: {}\n")
+    edited = service.read_scene_transform(cmds)
+    assert service.find_stale_thumbnails(database, edited, [crate]) == [crate]
+
+    previous = service.enable_view_transform(cmds)
+    assert cmds.outputs == {"renderer": [True, True], "playblast": [True, True]}
+    service.restore_view_transform(cmds, previous)
+    assert cmds.outputs == {"renderer": [False, False], "playblast": [True, False]}
+    database.close()
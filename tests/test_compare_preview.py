@@ -0,0 +1,82 @@
+"""
+Test suite for side-by-side compare previews
+
+Validates orbit frame layout, angle lookup, mayapy command lines, and render results.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def test_frames_are_laid_out_per_side_tilt_and_angle():
+    """Both sides should share the orbit steps so one angle shows both from the same view"""
+    from src.core.models.compare_preview import SIDE_LEFT, SIDE_RIGHT, ComparePreview
+
+    preview = ComparePreview(
+        Path("crate_v003.ma"), Path("crate.ma"), Path("/tmp/cmp"), "crate v3", frames=36
+    )
+
+    assert preview.label == "crate v3 | crate"
+    assert preview.frame_count == 36 * len(preview.tilts)
+    assert preview.frame_path(SIDE_LEFT, 1, 4) == Path("/tmp/cmp/left/tilt1.0004.png")
+    assert preview.frame_path(SIDE_RIGHT, 0, 36) == Path("/tmp/cmp/right/tilt0.0000.png")
+    assert preview.frame_for_angle(0) == 0
+    assert preview.frame_for_angle(36) == 4  # Nearest 10 degree step
+    assert preview.frame_for_angle(-10) == 35
+    assert preview.frame_for_angle(730) == 1
+
+    for invalid in ({"frames": 2}, {"tilts": ()}, {"size": 0}):
+        try:
+            ComparePreview(Path("a.ma"), Path("b.ma"), Path("/tmp/cmp"), **invalid)
+        except ValueError:
+            continue
+        raise AssertionError(f"{invalid} should be rejected")
+
+
+def test_render_runs_batch_and_checks_every_frame():
+    """A render should only succeed once both sides' frames are all on disk"""
+    from src.core.models.color_transform import ColorTransform
+    from src.core.models.compare_preview import SIDES
+    from src.services.compare_preview_service_impl import ComparePreviewService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_compare_test_"))
+    left, right = root / "crate_v003.ma", root / "crate.ma"
+    left.write_text("//Maya ASCII scene\n", encoding="utf-8")
+    right.write_text("//Maya ASCII scene\n", encoding="utf-8")
+
+    def fake_batch(preview, color):
+        # Leave out the last right frame to see it reported
+        for side in SIDES:
+            for tilt_index in range(len(preview.tilts)):
+                for frame in range(preview.frames):
+                    path = preview.frame_path(side, tilt_index, frame)
+                    if (side, tilt_index, frame) == ("right", 2, 7) and color is None:
+                        continue
+                    path.parent.mkdir(parents=True, exist_ok=True)
+                    path.write_bytes(b"png")
+        return ""
+
+    service = ComparePreviewService(runner=fake_batch)
+    preview = service.create_preview(left, right, "crate v3", "crate v5", frames=8, size=128)
+
+    assert "were not rendered" in service.render(preview)
+    color = ColorTransform(config_path="/show/config.ocio", view="ACES 1.0 SDR-video")
+    assert service.render(preview, color) == ""
+    assert preview.missing_frames() == []
+
+    command = service.build_batch_command(preview, Path("mayapy"), color)
+    assert command[1].endswith("compare_batch.py")
+    assert command[command.index("--left") + 1] == str(left)
+    assert command[command.index("--frames") + 1] == "8"
+    assert "--tilts=-45,-20,0" in command
+    assert command[command.index("--ocio-view") + 1] == "ACES 1.0 SDR-video"
+
+    missing = service.create_preview(root / "gone.ma", right)
+    assert service.render(missing) == "gone.ma does not exist"
+
+    service.discard(preview)
+    service.discard(missing)
+    assert not preview.output_dir.exists()
@@ -0,0 +1,94 @@
+"""
+Test suite for contact sheet exports
+
+Validates building contact sheet cells from collection assets, laying them out on
+pages, and writing the HTML gallery art directors review in a browser.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def _asset(name: str, state: str = "", thumbnail_path=None):
+    metadata = {"status": {"state": state}} if state else {}
+    return SimpleNamespace(
+        display_name=name,
+        file_path=Path(f"/library/assets/scenes/{name}.ma"),
+        metadata=metadata,
+        thumbnail_path=thumbnail_path,
+    )
+
+
+def test_contact_sheet_entries_and_layout():
+    """Cells carry name, latest version, status, and only thumbnails that exist"""
+    from src.core.models.contact_sheet import ContactSheetOptions
+    from src.services.contact_sheet_service_impl import ContactSheetService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_contactsheet_"))
+    thumbnail = root / "crate.png"
+    thumbnail.write_bytes(b"png")
+    thumbnails = {"crate": thumbnail, "rock": root / "missing.png"}
+
+    service = ContactSheetService()
+    entries = service.build_entries(
+        [_asset("crate", "approved"), _asset("rock"), _asset("tree", "bogus")],
+        key_of=lambda asset: f"assets/scenes/{asset.file_path.name}",
+        versions={"assets/scenes/crate.ma": 3},
+        thumbnail_of=lambda asset: thumbnails.get(asset.display_name),
+    )
+    crate, rock, tree = entries
+    assert crate.thumbnail == thumbnail and crate.caption == "v003 - Approved"
+    assert crate.status_color == "#27ae60"
+    assert rock.thumbnail is None and rock.caption == "- - WIP"
+    assert tree.status == "wip" and tree.asset_key == "assets/scenes/tree.ma"
+
+    # Five cells in rows of two: three rows on one PNG, or pages of two rows for PDF
+    options = ContactSheetOptions(columns=2)
+    assert options.get_pages(5) == [[(0, 0, 0), (1, 1, 0), (2, 0, 1), (3, 1, 1), (4, 0, 2)]]
+    pages = options.get_pages(5, rows_per_page=2)
+    assert [len(page) for page in pages] == [4, 1]
+    assert pages[1] == [(4, 0, 0)]
+    assert ContactSheetOptions(columns=0).get_pages(2) == [[(0, 0, 0), (1, 0, 1)]]
+    assert options.get_pages(0) == []
+
+    for bad_entries, bad_options in (
+        ([], options),
+        (entries, ContactSheetOptions(file_format="tiff")),
+    ):
+        try:
+            service.export(bad_entries, root / "sheet", bad_options)
+        except ValueError:
+            continue
+        raise AssertionError(f"Exporting {bad_options} should be refused")
+
+
+def test_html_gallery():
+    """The gallery copies thumbnails, escapes names, and marks missing thumbnails"""
+    from src.core.models.contact_sheet import ContactSheetEntry
+    from src.services.contact_sheet_service_impl import ContactSheetService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_gallery_"))
+    thumbnail = root / "Crate.PNG"
+    thumbnail.write_bytes(b"png")
+    entries = [
+        ContactSheetEntry("crate <hero>", "assets/scenes/crate.ma", 2, "approved", thumbnail),
+        ContactSheetEntry("rock", "assets/scenes/rock.ma"),
+    ]
+
+    service = ContactSheetService()
+    gallery_dir = service.get_gallery_dir(root / "props_review.pdf")
+    assert gallery_dir == root / "props_review_gallery"
+    index = service.write_gallery(entries, gallery_dir, "Props & Sets")
+    assert index == gallery_dir / "index.html"
+    assert [path.name for path in (gallery_dir / "images").iterdir()] == ["001_crate_hero.png"]
+    assert (gallery_dir / "images" / "001_crate_hero.png").read_bytes() == b"png"
+
+    page = index.read_text(encoding="utf-8")
+    assert "<title>Props &amp; Sets</title>" in page and "2 asset(s)" in page
+    assert '<img src="images/001_crate_hero.png" alt="crate &lt;hero&gt;">' in page
+    assert "v002" in page and ">Approved</span>" in page
+    assert page.count("No thumbnail") == 1 and ">WIP</span>" in page
@@ -0,0 +1,186 @@
+"""
+Test suite for DCC interchange
+
+Validates sending the selection as a temporary USD layer to a paired session, asking
+the session for a layer of its selection, accepting layers it sends, and refusing
+messages with the wrong token or of another protocol.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import importlib.util
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for writing a selection layer"""
+
+    def __init__(self, selection=()):
+        self.selection = list(selection)
+        self.exports = []
+
+    def ls(self, *args, **kwargs):
+        return list(self.selection) if kwargs.get("selection") else []
+
+    def pluginInfo(self, name, **kwargs):
+        return True
+
+    def upAxis(self, **kwargs):
+        return "y"
+
+    def mayaUSDExport(self, **kwargs):
+        self.exports.append(kwargs)
+        Path(kwargs["file"]).write_text("#usda 1.0\n")
+
+
+def _load_peer_script():
+    """Import the Houdini/Blender peer script the way another DCC would"""
+    path = Path(__file__).parent.parent / "src" / "scripts" / "dcc_interchange_peer.py"
+    spec = importlib.util.spec_from_file_location("dcc_interchange_peer", path)
+    module = importlib.util.module_from_spec(spec)
+    spec.loader.exec_module(module)
+    return module
+
+
+def _make_peer(module, token=""):
+    """Peer session with a fake Houdini scene, started on a free port"""
+    imported = []
+
+    def exporter(folder, name):
+        layer_path = Path(folder) / f"{name}.usda"
+        layer_path.write_text("#usda 1.0\n")
+        return str(layer_path)
+
+    def importer(layer_path, name):
+        imported.append((Path(layer_path).read_text(), name))
+        return [f"/obj/maya_{name}"]
+
+    peer = module.PeerSession("houdini", exporter, importer, port=0, token=token)
+    peer.start()
+    return peer, imported
+
+
+def test_send_and_receive_with_peer_session():
+    """The selection layer reaches the peer, and the peer's selection comes back"""
+    from src.services.interchange_service_impl import InterchangeService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_interchange_"))
+    peer, imported = _make_peer(_load_peer_script(), token="s3cret")
+    service = InterchangeService(config_file=root / "interchange.json")
+    try:
+        service.set_config(peer_port=peer.port, token="s3cret", exchange_dir=str(root / "layers"))
+        assert service.ping_peer() == {"dcc": "houdini"}
+        assert service.get_peer_name() == "Houdini"
+
+        cmds = FakeCmds(["|props|crate_GEO"])
+        layer = service.send_selection(cmds)
+        assert layer.delivered and layer.name == "crate_GEO" and layer.dcc == "maya"
+        assert layer.file_path.parent == root / "layers"
+        assert layer.file_path.name.startswith("crate_GEO_maya_")
+        assert layer.file_path.suffix == ".usda"
+        assert cmds.exports[0]["selection"] and cmds.exports[0]["defaultUSDFormat"] == "usda"
+        assert imported == [("#usda 1.0\n", "crate_GEO")]
+
+        received = service.receive(timeout=5.0)
+        assert received.file_path == root / "layers" / "houdini_selection.usda"
+        assert (received.name, received.dcc) == ("houdini_selection", "houdini")
+
+        # A wrong token is refused; the layer written for the send stays for a manual load
+        service.set_config(token="guess")
+        assert service.ping_peer() is None
+        layer = service.send_selection(cmds, name="crate")
+        assert not layer.delivered and layer.message == "The interchange token does not match"
+        assert layer.file_path.is_file() and len(imported) == 1
+        try:
+            service.receive(timeout=5.0)
+        except RuntimeError as e:
+            assert "token" in str(e)
+        else:
+            raise AssertionError("A receive with the wrong token should raise RuntimeError")
+
+        try:
+            service.send_selection(FakeCmds())
+        except RuntimeError as e:
+            assert str(e) == "Select the geometry to send first"
+        else:
+            raise AssertionError("Sending an empty selection should raise RuntimeError")
+
+        assert service.save_config()
+        saved = InterchangeService(config_file=root / "interchange.json").get_config()
+        assert saved["peer_port"] == peer.port and saved["token"] == "guess"
+    finally:
+        peer.stop()
+
+    assert service.ping_peer() is None  # Nothing listens any more
+    try:
+        service.set_config(peer_dcc="nuke")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("An unsupported peer application should be rejected")
+
+
+def test_layers_sent_by_peer_are_queued():
+    """Maya queues the peer's layers for the UI and refuses other messages"""
+    from src.services.interchange_service_impl import (
+        InterchangeService,
+        decode_message,
+        encode_message,
+        exchange_message,
+    )
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_interchange_"))
+    module = _load_peer_script()
+    service = InterchangeService(config_file=root / "interchange.json")
+    service.set_config(listen_port=0, exchange_dir=str(root))
+    assert service.listening_port is None
+    assert service.start_listening() and service.start_listening()
+    try:
+        port = service.listening_port
+        peer = module.PeerSession(
+            "blender",
+            lambda folder, name: str(Path(folder) / "rock.usda"),
+            lambda layer_path, name: [],
+            maya_address=("127.0.0.1", port),
+        )
+        (root / "rock.usda").write_text("#usda 1.0\n")
+        assert peer.send_selection("rock", folder=str(root)) == str(root / "rock.usda")
+
+        received = service.take_received()
+        assert [(layer.name, layer.dcc) for layer in received] == [("rock", "blender")]
+        assert received[0].file_path == root / "rock.usda"
+        assert service.take_received() == []
+
+        # Maya sends with Send Selection; missing layers and unknown messages are refused
+        address = ("127.0.0.1", port)
+        for message_type, data, expected in [
+            ("request", {}, "Maya sends layers with Send Selection"),
+            ("layer", {"file": str(root / "gone.usda")}, "Maya cannot read"),
+            ("explode", {}, "Unknown interchange message: explode"),
+        ]:
+            try:
+                exchange_message(address, message_type, data)
+            except RuntimeError as e:
+                assert str(e).startswith(expected)
+            else:
+                raise AssertionError(f"A {message_type} message should be refused")
+        assert exchange_message(address, "ping")["data"]["dcc"] == "maya"
+        assert service.take_received() == []
+    finally:
+        service.stop_listening()
+    assert service.listening_port is None
+
+    message = decode_message(encode_message("ping", {"dcc": "maya"}, "s3cret"))
+    assert (message["type"], message["token"]) == ("ping", "s3cret")
+    assert module.encode_message("ping", {"dcc": "maya"}, "s3cret") == encode_message(
+        "ping", {"dcc": "maya"}, "s3cret"
+    )
+    for payload in [b'{"magic": "ue_py", "version": 1}', b'{"magic": "am_interchange"}']:
+        try:
+            decode_message(payload)
+        except ValueError:
+            pass
+        else:
+            raise AssertionError("Messages of another protocol should be rejected")
@@ -0,0 +1,99 @@
+"""
+Test suite for dependency collection and packaging
+
+Validates copy planning, UDIM expansion, asset-relative repathing, and zip packages.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+import zipfile
+from pathlib import Path
+
+
+def _make_file(path: Path, content: str = "data") -> Path:
+    """Create a fake dependency file"""
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(content, encoding="utf-8")
+    return path
+
+
+def test_plan_copies_avoids_name_collisions():
+    """Same-named textures from different folders should not overwrite each other"""
+    from src.services.dependency_service_impl import AssetDependency, DependencyService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_deps_"))
+    first = _make_file(root / "local" / "a" / "wood.png")
+    second = _make_file(root / "local" / "b" / "wood.png")
+    asset_file = root / "assets" / "scenes" / "hero.ma"
+
+    plan = DependencyService().plan_copies(
+        [
+            AssetDependency("file1", "fileTextureName", str(first), "textures"),
+            AssetDependency("file2", "fileTextureName", str(second), "textures"),
+            AssetDependency("file3", "fileTextureName", str(first), "textures"),
+        ],
+        asset_file,
+    )
+
+    targets = sorted(p.name for p in plan.values())
+    assert targets == ["wood.png", "wood_1.png"]
+    assert all(".dependencies/hero/textures" in p.as_posix() for p in plan.values())
+
+
+def test_udim_textures_collected_with_token_path():
+    """UDIM tiles should all be copied while the scene keeps the <UDIM> token"""
+    from src.services.dependency_service_impl import AssetDependency, DependencyService
+
+    service = DependencyService()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_deps_"))
+    for tile in (1001, 1002):
+        _make_file(root / "local" / f"skin.{tile}.exr")
+    asset_file = root / "assets" / "scenes" / "hero.ma"
+    token_path = str(root / "local" / "skin.<UDIM>.exr")
+
+    dependency = AssetDependency("file1", "fileTextureName", token_path, "textures")
+    plan = service.plan_copies([dependency], asset_file)
+    copied = service.copy_dependencies(plan)
+
+    assert len(copied) == 2
+    assert all(p.exists() for p in copied)
+    relative = service.get_relative_path(token_path, plan, asset_file)
+    assert relative == ".dependencies/hero/textures/skin.<UDIM>.exr"
+
+
+def test_missing_dependency_not_planned():
+    """Files that are not on disk cannot be collected"""
+    from src.services.dependency_service_impl import AssetDependency, DependencyService
+
+    service = DependencyService()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_deps_"))
+    asset_file = root / "hero.ma"
+    missing = str(root / "nowhere" / "gone.png")
+
+    plan = service.plan_copies([AssetDependency("f", "fileTextureName", missing, "x")], asset_file)
+    assert plan == {}
+    assert service.get_relative_path(missing, plan, asset_file) is None
+
+
+def test_package_contains_asset_and_dependencies():
+    """Zip packages should keep the asset-relative layout"""
+    from src.services.dependency_service_impl import AssetDependency, DependencyService
+
+    service = DependencyService()
+    root = Path(tempfile.mkdtemp(prefix="assetManager_deps_"))
+    texture = _make_file(root / "local" / "wood.png")
+    asset_file = _make_file(root / "assets" / "scenes" / "hero.ma", "scene")
+
+    plan = service.plan_copies(
+        [AssetDependency("file1", "fileTextureName", str(texture), "textures")], asset_file
+    )
+    package = service.create_package(asset_file, service.copy_dependencies(plan))
+
+    assert package == asset_file.with_suffix(".zip")
+    with zipfile.ZipFile(package) as archive:
+        assert sorted(archive.namelist()) == [
+            ".dependencies/hero/textures/wood.png",
+            "hero.ma",
+        ]
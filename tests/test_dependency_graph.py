@@ -0,0 +1,106 @@
+"""
+Test suite for the asset dependency graph
+
+Validates scanning a library and project for references, assembly children, and
+textures, and walking the graph for what an asset uses and what a change affects.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+CRATE_SCENE = """//Maya ASCII 2024 scene
+createNode file -n "woodFile";
+    setAttr ".ftn" -type "string" "textures/wood.png";
+createNode AlembicNode -n "shrinkWrap";
+    setAttr ".fn" -type "string" "/caches/wrap.abc";
+"""
+
+SHOT_SCENE = """//Maya ASCII 2024 scene
+file -rdi 1 -ns "street" -rfn "streetRN" -typ "mayaAscii" "{street}";
+file -r -ns "crate" -dr 1 -rfn "crateRN" -typ "mayaAscii" "{crate}{{1}}";
+"""
+
+
+def _make_project():
+    """Library with a crate, a street assembly placing it, and a shot using both"""
+    root = Path(tempfile.mkdtemp(prefix="assetManager_dependencies_"))
+    library = root / "library"
+    scenes = library / "assets" / "scenes"
+    (scenes / "textures").mkdir(parents=True)
+    crate = scenes / "crate.ma"
+    crate.write_text(CRATE_SCENE)
+
+    street = library / "assets" / "assemblies" / "street.assembly"
+    street.parent.mkdir(parents=True)
+    children = [{"asset": "assets/scenes/crate.ma", "version": 2}]
+    street.write_text(json.dumps({"type": "assembly", "name": "street", "children": children}))
+
+    project = root / "project" / "scenes"
+    project.mkdir(parents=True)
+    shot = project / "shot010.ma"
+    snapshot = scenes / ".versions" / "crate" / "v002" / "crate.ma"
+    shot.write_text(SHOT_SCENE.format(street=street.as_posix(), crate=snapshot.as_posix()))
+    binary = project / "shot020.mb"
+    binary.write_bytes(b"Maya binary\x00" + crate.resolve().as_posix().encode("utf-8") + b"\x00")
+    (project / ".backup").mkdir()
+    (project / ".backup" / "shot010.ma").write_text(SHOT_SCENE.format(street="", crate=""))
+    return library, root / "project", crate.resolve(), street.resolve()
+
+
+def test_scan_finds_references_assemblies_and_textures():
+    """Every file type adds its uses; version snapshots count as their asset"""
+    from src.core.models.dependency_graph import NODE_ASSET, NODE_FILE, NODE_SCENE
+    from src.services.dependency_graph_service_impl import DependencyGraphService
+
+    library, project, crate, street = _make_project()
+    graph = DependencyGraphService().build(library, [library, project])
+
+    shot = (project / "scenes" / "shot010.ma").resolve()
+    assert [(e.target.name, e.kind) for e in graph.get_dependencies(shot)] == [
+        ("street.assembly", "reference"),
+        ("crate.ma", "reference"),
+    ]
+    assert [(e.target, e.kind) for e in graph.get_dependencies(street)] == [(crate, "assembly")]
+    assert [(e.target, e.kind) for e in graph.get_dependencies(crate)] == [
+        (crate.parent / "textures" / "wood.png", "texture"),
+        (Path("/caches/wrap.abc").resolve(), "cache"),
+    ]
+    users = [edge.source.name for edge in graph.get_dependents(crate)]
+    assert users == ["street.assembly", "shot010.ma", "shot020.mb"]
+    assert graph.get_kind(crate) == NODE_ASSET and graph.get_kind(shot) == NODE_SCENE
+    assert graph.get_kind(crate.parent / "textures" / "wood.png") == NODE_FILE
+
+
+def test_blast_radius_walks_every_user_once():
+    """Changing a texture reaches the asset using it and everything using that asset"""
+    from src.core.models.dependency_graph import DependencyEdge, DependencyGraph
+
+    wood, crate, street = Path("/lib/wood.png"), Path("/lib/crate.ma"), Path("/lib/street.ma")
+    shot = Path("/project/shot010.ma")
+    graph = DependencyGraph(
+        (
+            DependencyEdge(crate, wood, "texture"),
+            DependencyEdge(street, crate),
+            DependencyEdge(shot, street),
+            DependencyEdge(shot, crate),
+            DependencyEdge(crate, street),  # A cycle must not loop forever
+        ),
+        {crate: "asset", street: "asset", shot: "scene"},
+    )
+    assert [(depth, edge.source.name) for depth, edge in graph.get_downstream(wood)] == [
+        (1, "crate.ma"),
+        (2, "street.ma"),
+        (2, "shot010.ma"),
+    ]
+    assert graph.get_affected(wood) == ([crate, street], [shot])
+    assert graph.describe_affected(wood) == "Used by 2 assets and 1 scene"
+    assert graph.describe_affected(shot) == "Not used anywhere"
+    assert [edge.target.name for _depth, edge in graph.get_upstream(shot)] == [
+        "street.ma",
+        "crate.ma",
+        "wood.png",
+    ]
@@ -0,0 +1,124 @@
+"""
+Test suite for duplicate asset detection
+
+Validates content hashing, geometry fingerprints of renamed or slightly edited
+copies, and repathing of scenes that reference a duplicate.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+CUBE_DATA = """\tsetAttr -s 8 ".vt[0:7]"  -0.5 -0.5 0.5 0.5 -0.5 0.5 -0.5 0.5 0.5 0.5 0.5 0.5
+\t\t -0.5 0.5 -0.5 0.5 0.5 -0.5 -0.5 -0.5 -0.5 0.5 -0.5 -0.5;
+\tsetAttr -s 12 ".ed[0:11]"  0 1 0 2 3 0 4 5 0 6 7 0 0 2 0 1 3 0 2 4 0 3 5 0 4 6 0 5 7 0
+\t\t 6 0 0 7 1 0;
+\tsetAttr -s 6 -ch 24 ".fc[0:5]" -type "polyFaces"
+\t\tf 4 0 4 -2 -4
+\t\tmu 0 4 0 1 3 2
+\t\tf 4 1 6 -3 -5
+\t\tmu 0 4 2 3 5 4;
+"""
+
+
+def _write_scene(path: Path, node_name: str, data: str = CUBE_DATA, saved: str = "") -> Path:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(
+        f"//Maya ASCII 2024 scene\n//Last modified: {saved}\n"
+        f'createNode transform -n "{node_name}";\n'
+        f'createNode mesh -n "{node_name}Shape" -p "{node_name}";\n{data}'
+    )
+    return path
+
+
+def _age(path: Path, seconds: int) -> None:
+    stat = path.stat()
+    os.utime(path, (stat.st_atime - seconds, stat.st_mtime - seconds))
+
+
+def test_find_duplicates():
+    """Byte copies and renamed or slightly edited geometry group under the oldest asset"""
+    from src.services.duplicate_service_impl import DuplicateService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_dupes_"))
+    scenes = library / "assets" / "scenes"
+    crate = _write_scene(scenes / "crate.ma", "crate", saved="Mon")
+    _age(crate, 3600)
+    copy = scenes / "crate_copy.ma"
+    copy.write_bytes(crate.read_bytes())
+    box = _write_scene(scenes / "props" / "wooden_box.ma", "box", saved="Tue")
+    edited = _write_scene(
+        scenes / "box_edited.ma", "boxEdit", CUBE_DATA.replace("0.5 -0.5 -0.5;", "0.6 -0.5 -0.5;")
+    )
+    _write_scene(scenes / "tree.ma", "tree", CUBE_DATA.replace("0.5", "2.5"))
+    _write_scene(scenes / ".versions" / "crate" / "v001" / "crate.ma", "crate")
+    (scenes / "notes.obj").write_text("v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n")
+
+    service = DuplicateService()
+    assert service.hash_file(crate) == service.hash_file(copy)
+    assert service.get_geometry_signature(crate) == service.get_geometry_signature(box)
+    assert service.get_geometry_signature(library / "missing.mb") is None
+
+    groups = service.find_duplicates(library, threshold=0.9)
+    assert len(groups) == 1
+    group = groups[0]
+    assert group.canonical == crate
+    assert set(group.duplicates) == {copy, box, edited}
+    assert group.describe_similarity(copy) == "Identical"
+    assert group.get_similarity(box) == 1.0
+    assert 0.9 <= group.get_similarity(edited) < 1.0
+    assert not group.is_identical
+    assert group.label == "crate.ma - 3 duplicates"
+
+    regrouped = group.with_canonical(box)
+    assert regrouped.canonical == box and crate in regrouped.duplicates
+
+    assert service.find_duplicates(library, threshold=1.0)[0].duplicates != group.duplicates
+
+
+def test_redirect_and_merge():
+    """ASCII scenes are repathed keeping copy numbers; tags and collections move over"""
+    from src.services.duplicate_service_impl import DuplicateService
+    from src.services.metadata_database_impl import get_metadata_database
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_dupes_"))
+    scenes = library / "assets" / "scenes"
+    crate = _write_scene(scenes / "crate.ma", "crate")
+    box = _write_scene(scenes / "wooden_box.ma", "box")
+
+    shot = library / "shots" / "sh010.ma"
+    shot.parent.mkdir(parents=True)
+    shot.write_text(
+        f'file -rdi 1 -ns "box" -rfn "boxRN" -typ "mayaAscii" "{box.as_posix()}";\n'
+        'file -rdi 1 -ns "box1" -rfn "boxRN1" -typ "mayaAscii"\n'
+        '\t\t"../assets/scenes/wooden_box.ma{1}";\n'
+        f'file -r -ns "crate" -dr 1 -rfn "crateRN" -typ "mayaAscii" "{crate.as_posix()}";\n'
+    )
+    binary = library / "shots" / "sh020.mb"
+    binary.write_bytes(b"FOR4" + box.as_posix().encode() + b"\x00")
+
+    service = DuplicateService()
+    ascii_scenes, binary_scenes = service.find_referencing_scenes([library], box)
+    assert ascii_scenes == [shot] and binary_scenes == [binary]
+
+    repathed, skipped = service.redirect([library, library / "shots"], box, crate)
+    assert repathed == [shot] and skipped == [binary]
+    text = shot.read_text()
+    assert "wooden_box" not in text
+    assert f'"{crate.as_posix()}{{1}}";' in text
+    assert '-rfn "boxRN1"' in text
+    assert service.find_referencing_scenes([library], box) == ([], [binary])
+
+    database = get_metadata_database(library)
+    database.save_asset_metadata(crate, {"tags": ["prop"]})
+    database.save_asset_metadata(box, {"tags": ["wood", "prop"]})
+    key = database.get_asset_key
+    database.save_collection("Set Dressing", {"assets": [key(box), key(crate), "tree.ma"]})
+
+    service.merge_metadata(library, box, crate)
+    assert database.get_asset_metadata(crate)["tags"] == ["prop", "wood"]
+    assert database.get_asset_metadata(box) is None
+    assert database.get_collections()["Set Dressing"]["assets"] == [key(crate), "tree.ma"]
@@ -0,0 +1,83 @@
+"""
+Test suite for per-artist favorites, ratings, and recent assets
+
+Validates that favorites are kept per artist, ratings average over artists,
+and the Recent list follows an artist's own imports and publishes.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _make_service():
+    """Favorites service on a fresh library database"""
+    from src.services.activity_service_impl import ActivityService
+    from src.services.favorites_service_impl import FavoritesService
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_favorites_"))
+    database = MetadataDatabase(root)
+    activity = ActivityService(database_factory=lambda library_root: database)
+    service = FavoritesService(lambda library_root: database, activity)
+    return root, database, activity, service
+
+
+def test_favorites_and_ratings_are_per_artist():
+    """Each artist keeps their own favorites and stars; the average is shared"""
+    root, database, _activity, service = _make_service()
+    car = root / "assets" / "car.ma"
+    tree = root / "assets" / "tree.ma"
+
+    service.set_favorite(root, car, user="anna")
+    service.set_favorite(root, tree, user="anna")
+    service.set_favorite(root, tree, user="ben")
+    service.set_favorite(root, tree, favorite=False, user="anna")
+    assert service.get_favorites(root, user="anna") == [car]
+    assert service.get_favorites(root, user="ben") == [tree]
+
+    service.rate(root, car, 5, user="anna")
+    service.rate(root, car, 2, user="ben")
+    rating = service.rate(root, car, 3, user="ben")
+    assert (rating.average, rating.count, rating.user_rating) == (4.0, 2, 3)
+    assert rating.label == "★★★★☆ 4.0 from 2 artists"
+    assert service.get_ratings(root, user="anna")["assets/car.ma"].user_rating == 5
+
+    service.rate(root, car, 0, user="anna")
+    assert service.get_ratings(root, user="anna")["assets/car.ma"].count == 1
+    try:
+        service.rate(root, car, 6, user="anna")
+        raise AssertionError("Six stars should be rejected")
+    except ValueError:
+        pass
+
+    # Favorites and stars follow a renamed asset
+    database.rename_asset(car, root / "assets" / "truck.ma")
+    assert service.get_favorites(root, user="anna") == [root / "assets" / "truck.ma"]
+    assert list(service.get_ratings(root)) == ["assets/truck.ma"]
+    database.close()
+
+
+def test_recent_lists_own_imports_and_publishes_newest_first():
+    """Other artists' actions and non-import actions stay out of the Recent list"""
+    from src.core.models.activity_event import (
+        ACTIVITY_DELETE,
+        ACTIVITY_IMPORT,
+        ACTIVITY_PUBLISH,
+    )
+
+    root, database, activity, service = _make_service()
+    car, tree, rock = (root / "assets" / f"{name}.ma" for name in ("car", "tree", "rock"))
+
+    activity.record(root, ACTIVITY_PUBLISH, car, "anna", version=1)
+    activity.record(root, ACTIVITY_IMPORT, tree, "anna", mode="reference")
+    activity.record(root, ACTIVITY_IMPORT, rock, "ben", mode="import")
+    activity.record(root, ACTIVITY_DELETE, rock, "anna")
+    activity.record(root, ACTIVITY_IMPORT, car, "anna", mode="import")
+
+    assert service.get_recent(root, user="anna") == [car, tree]
+    assert service.get_recent(root, user="anna", limit=1) == [car]
+    assert service.get_recent(root, user="ben") == [rock]
+    database.close()
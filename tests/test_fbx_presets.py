@@ -0,0 +1,96 @@
+"""
+Test suite for FBX export presets
+
+Validates the preset command sequence, the library presets file, and preset exports
+against a stand-in for maya.cmds and maya.mel.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import re
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for an FBX export"""
+
+    def __init__(self):
+        self.selected = []
+
+    def pluginInfo(self, plugin, **kwargs):
+        return True
+
+    def playbackOptions(self, **kwargs):
+        return 1001.0 if kwargs.get("minTime") else 1048.0
+
+    def select(self, nodes, replace=False):
+        self.selected = list(nodes)
+
+
+def test_build_commands():
+    """Every preset setting reaches the MEL sequence, and baking adds the playback range"""
+    from src.core.models.fbx_preset import FbxExportPreset
+    from src.services.fbx_export_service_impl import FbxExportService
+
+    service = FbxExportService()
+    preset = FbxExportPreset("Unreal", up_axis="z", units="cm", triangulate=True)
+    commands = service.build_commands(preset, Path("/lib/crate.fbx"), (1.0, 24.0), True)
+
+    assert commands[0] == "FBXResetExport"
+    assert "FBXExportUpAxis z" in commands
+    assert "FBXExportConvertUnitString cm" in commands
+    assert "FBXExportTriangulate -v true" in commands
+    assert "FBXExportEmbeddedTextures -v false" in commands
+    assert not any(command.startswith("FBXExportBakeComplexStart") for command in commands)
+    assert commands[-1] == 'FBXExport -f "/lib/crate.fbx" -s'
+
+    baked = FbxExportPreset("Cinematic", bake_animation=True)
+    commands = service.build_commands(baked, Path("/lib/walk.fbx"), (1001.0, 1048.0), False)
+    assert "FBXExportBakeComplexStart -v 1001" in commands
+    assert "FBXExportBakeComplexEnd -v 1048" in commands
+    assert commands[-1] == 'FBXExport -f "/lib/walk.fbx"'
+
+
+def test_presets_file_and_export():
+    """Presets round-trip through the library, and asset types pick the export preset"""
+    from src.core.models.fbx_preset import FbxExportPreset
+    from src.services.fbx_export_service_impl import DEFAULT_PRESETS, FbxExportService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_fbx_"))
+    service = FbxExportService()
+
+    presets, asset_types = service.load_presets(library)
+    assert presets == DEFAULT_PRESETS and asset_types == {}
+    assert service.get_preset_for_asset_type(library, "Props") is None
+
+    presets["Mobile"] = FbxExportPreset("Mobile", units="m", triangulate=True)
+    assert service.save_presets(
+        library, presets, {"Props": "Unreal", "Characters": "Mobile", "Vehicles": "Removed"}
+    )
+    presets, asset_types = service.load_presets(library)
+    assert presets["Mobile"] == FbxExportPreset("Mobile", units="m", triangulate=True)
+    assert asset_types == {"Props": "Unreal", "Characters": "Mobile"}
+
+    executed = []
+
+    def run_mel(command):
+        executed.append(command)
+        match = re.match(r'FBXExport -f "(.+?)"', command)
+        if match:
+            Path(match.group(1)).write_bytes(b"Kaydara FBX Binary")
+
+    cmds = FakeCmds()
+    preset = service.get_preset_for_asset_type(library, "Props")
+    fbx_file = library / "assets" / "scenes" / "crate.fbx"
+    fbx_file.parent.mkdir(parents=True)
+    assert service.export(cmds, fbx_file, preset, ["crate_geo"], run_mel) == fbx_file
+    assert cmds.selected == ["crate_geo"]
+    assert "FBXExportUpAxis z" in executed and executed[-1].endswith(" -s")
+
+    try:
+        service.export(cmds, library / "missing.fbx", preset, [], lambda command: None)
+        assert False, "export that writes nothing must fail"
+    except RuntimeError:
+        pass
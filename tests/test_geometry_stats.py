@@ -0,0 +1,120 @@
+"""
+Test suite for publish-time geometry stats
+
+Validates measuring published meshes against a stand-in for maya.cmds, and
+filtering and sorting the library by the stored stats.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from pathlib import Path
+from types import SimpleNamespace
+
+
+class FakeCmds:
+    """Two meshes under one root, one skinned, with a shading network of file textures"""
+
+    meshes = {
+        "|crate|body|bodyShape": {"triangles": 1200, "vertices": 650, "uv_sets": ["map1"]},
+        "|crate|lid|lidShape": {"triangles": 300, "vertices": 170, "uv_sets": ["map1", "ao"]},
+    }
+    textures = {"diffuse": (4096, 4096), "normal": (4096, 4096), "mask": (1024, 512)}
+
+    def ls(self, nodes=None, assemblies=False, type=None, **kwargs):
+        if assemblies:
+            return ["|persp", "|crate"]
+        if type == "mesh":
+            return [mesh for mesh in self.meshes if any(mesh.startswith(n) for n in nodes)]
+        if type == "file":
+            return [node for node in nodes if node in self.textures]
+        if type == "skinCluster":
+            return [node for node in nodes if node == "skinCluster1"]
+        return []
+
+    def polyEvaluate(self, mesh, triangle=False, vertex=False):
+        return self.meshes[mesh]["triangles" if triangle else "vertices"]
+
+    def polyUVSet(self, mesh, query, allUVSets):
+        return self.meshes[mesh]["uv_sets"]
+
+    def listConnections(self, nodes, type):
+        return ["crateSG"]
+
+    def listHistory(self, nodes):
+        return list(nodes) + ["skinCluster1", "crate_mtl"] + list(self.textures)
+
+    def exactWorldBoundingBox(self, nodes):
+        return [-50.0, 0.0, -25.0, 50.0, 80.0, 25.0]
+
+    def getAttr(self, attribute):
+        node, name = attribute.split(".")
+        return self.textures[node][0 if name == "outSizeX" else 1]
+
+
+def test_compute_stats():
+    """Meshes under the published roots are measured and survive a metadata round trip"""
+    from src.core.models.geometry_stats import GeometryStats
+    from src.services.geometry_stats_service_impl import GeometryStatsService
+
+    service = GeometryStatsService()
+    stats = service.compute(FakeCmds())
+    assert stats.triangles == 1500
+    assert stats.vertices == 820
+    assert stats.mesh_count == 2
+    assert stats.uv_sets == 2
+    assert stats.bounding_box == (100.0, 80.0, 50.0)
+    assert stats.texture_resolutions == (("4096x4096", 2), ("1024x512", 1))
+    assert stats.texture_summary == "2 x 4096x4096, 1 x 1024x512"
+    assert stats.max_texture_resolution == 4096
+    assert stats.has_skin_cluster
+
+    assert service.compute(FakeCmds(), ["|crate|lid"]).triangles == 300
+    assert service.compute(FakeCmds(), ["|light"]) == GeometryStats()
+    assert service.get_stats({"stats": stats.to_dict()}) == stats
+    assert service.get_stats({"tags": []}) is None
+
+
+def test_search_and_sort_by_stats():
+    """Stats filter with number comparisons and yes/no; unmeasured assets never match"""
+    from src.core.models.geometry_stats import GeometryStats
+    from src.services.geometry_stats_service_impl import GeometryStatsService
+    from src.services.search_engine_impl import SearchIndex
+
+    def asset(name, category, stats=None):
+        return SimpleNamespace(
+            name=name,
+            display_name=name,
+            file_path=Path("/library/assets/scenes") / f"{name}.ma",
+            file_extension=".ma",
+            asset_type="maya_scene",
+            category=category,
+            tags=[],
+            metadata={"stats": stats.to_dict()} if stats else {},
+            modified_date=None,
+            is_favorite=False,
+        )
+
+    assets = [
+        asset("tree", "props", GeometryStats(triangles=250000, vertices=130000, uv_sets=1)),
+        asset("rock", "props", GeometryStats(triangles=8000, vertices=4100, uv_sets=2)),
+        asset("hero", "characters", GeometryStats(triangles=180000, has_skin_cluster=True)),
+        asset("legacy", "props"),
+    ]
+    index = SearchIndex()
+    index.build(assets)
+
+    def names(query):
+        return [result.name for result in index.search(query)]
+
+    assert names("category:props tris:>100k") == ["tree"]
+    assert names("tris:8000..200000") == ["rock", "hero"]
+    assert names("verts:<5000") == ["rock", "hero"]
+    assert names("uvsets:>1") == ["rock"]
+    assert names("skinned:yes") == ["hero"]
+    assert names("skinned:no") == ["tree", "rock"]
+    assert names("tris:lots") == []
+
+    ordered = GeometryStatsService().sort_assets(assets, "tris")
+    assert [item.name for item in ordered] == ["tree", "hero", "rock", "legacy"]
+    assert GeometryStatsService().sort_assets(assets, "") == assets
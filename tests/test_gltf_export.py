@@ -0,0 +1,253 @@
+"""
+Test suite for the glTF review export
+
+Validates the library glTF settings, the FBX2glTF command line, reading PBR materials
+from Standard Surface shaders, and baking them into the converted GLB.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import base64
+import json
+import os
+import struct
+
+
+class FakeCmds:
+    """Scene with one crate mesh shaded by a textured Standard Surface and a lambert"""
+
+    def __init__(self, texture_dir):
+        self.attributes = {
+            "crate:wood": {
+                "base": 0.8,
+                "baseColor": [(0.5, 0.25, 0.1)],
+                "opacity": [(1.0, 1.0, 1.0)],
+                "metalness": 0.0,
+                "specularRoughness": 0.4,
+                "emission": 0.0,
+                "emissionColor": [(1.0, 1.0, 1.0)],
+            },
+            "crate:glass": {"color": [(0.2, 0.4, 0.6)], "transparency": [(0.5, 0.5, 0.5)]},
+            "crate:woodFile": {"fileTextureName": str(texture_dir / "wood.png")},
+            "crate:roughFile": {"fileTextureName": str(texture_dir / "wood_rough.tif")},
+            "crate:bump": {"bumpInterp": 1},
+            "crate:normalFile": {"fileTextureName": str(texture_dir / "wood_normal.jpg")},
+        }
+        self.types = {
+            "crate:wood": "aiStandardSurface",
+            "crate:glass": "lambert",
+            "crate:woodFile": "file",
+            "crate:roughFile": "file",
+            "crate:bump": "bump2d",
+            "crate:normalFile": "file",
+        }
+        self.connections = {
+            "crate:wood.baseColor": ["crate:woodFile"],
+            "crate:wood.specularRoughness": ["crate:roughFile"],
+            "crate:wood.normalCamera": ["crate:bump"],
+            "crate:bump.bumpValue": ["crate:normalFile"],
+            "crate:woodSG.surfaceShader": ["crate:wood"],
+            "crate:glassSG.surfaceShader": ["crate:glass"],
+        }
+        self.shading = {
+            "|crate:root|crate:boxShape": ["crate:woodSG"],
+            "|crate:root|crate:lidShape": ["crate:woodSG", "crate:glassSG"],
+        }
+        self.selected = []
+
+    def ls(self, nodes=None, **kwargs):
+        if kwargs.get("type") == "mesh":
+            return sorted(self.shading)
+        return ["|crate:root"]
+
+    def listConnections(self, plug, type=None, **kwargs):
+        if type == "shadingEngine":
+            return self.shading.get(plug, [])
+        return self.connections.get(plug)
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def getAttr(self, plug):
+        node, attribute = plug.split(".", 1)
+        return self.attributes[node][attribute]
+
+    def attributeQuery(self, attribute, node, exists):
+        return attribute in self.attributes[node]
+
+    def pluginInfo(self, name, query, loaded):
+        return True
+
+    def playbackOptions(self, query, **kwargs):
+        return 1.0
+
+    def select(self, nodes, replace):
+        self.selected = list(nodes)
+
+
+def _make_glb(materials):
+    """Make the GLB FBX2glTF would write: one mesh buffer and the FBX's materials"""
+    from src.services.gltf_export_service_impl import write_glb
+
+    document = {
+        "asset": {"version": "2.0", "generator": "FBX2glTF"},
+        "buffers": [{"byteLength": 6}],
+        "bufferViews": [{"buffer": 0, "byteOffset": 0, "byteLength": 6}],
+        "materials": [{"name": name, "doubleSided": True} for name in materials],
+    }
+    return write_glb(document, b"meshes")
+
+
+def test_gltf_settings_and_converter_command():
+    """Settings round-trip through the library and drive the FBX2glTF flags"""
+    import tempfile
+    from pathlib import Path
+
+    from src.core.models.gltf_export import GltfExportSettings
+    from src.services.gltf_export_service_impl import (
+        CONVERTER_ENV_VAR,
+        GltfExportService,
+        find_converter,
+        read_glb,
+    )
+
+    with tempfile.TemporaryDirectory() as temp_dir:
+        library = Path(temp_dir)
+        service = GltfExportService()
+        defaults = service.load_settings(library)
+        assert defaults == GltfExportSettings() and not defaults.publishes("Props")
+        assert service.get_output_path(library / "crate.ma", defaults) == library / "crate.glb"
+
+        settings = GltfExportSettings(asset_types=("Props",), draco=True, draco_level=10)
+        assert service.save_settings(library, settings)
+        assert service.load_settings(library) == settings
+        assert settings.publishes("Props") and not settings.publishes("Characters")
+        assert settings.description == "GLB, Draco level 10, baked materials at 2048"
+        assert GltfExportSettings.from_dict({"draco_level": 99, "binary": False}) == (
+            GltfExportSettings(draco_level=10, binary=False)
+        )
+        service.get_settings_file(library).write_text("{broken")
+        assert service.load_settings(library) == GltfExportSettings()
+
+        command = service.build_converter_command(
+            "FBX2glTF", Path("/tmp/crate.fbx"), Path("/tmp/crate.glb"), settings
+        )
+        assert command == [
+            "FBX2glTF",
+            "-i",
+            str(Path("/tmp/crate.fbx")),
+            "-o",
+            str(Path("/tmp/crate")),
+            "--binary",
+            "--pbr-metallic-roughness",
+            "--draco",
+            "--draco-compression-level",
+            "10",
+        ]
+        text_command = service.build_converter_command(
+            "FBX2glTF", Path("a.fbx"), Path("a.gltf"), GltfExportSettings(binary=False)
+        )
+        assert "--embed" in text_command and "--draco" not in text_command
+
+        converter = library / "FBX2glTF"
+        converter.write_text("")
+        os.environ[CONVERTER_ENV_VAR] = str(converter)
+        try:
+            assert find_converter() == str(converter)
+        finally:
+            del os.environ[CONVERTER_ENV_VAR]
+
+        for data in (b"", b"x" * 24, struct.pack("<III", 0x46546C67, 1, 12) + b"x" * 8):
+            try:
+                read_glb(data)
+            except ValueError:
+                continue
+            raise AssertionError(f"{data!r} should not read as a GLB")
+
+
+def test_export_bakes_standard_surface_materials():
+    """Exports convert the FBX and rebuild its materials as PBR with embedded textures"""
+    import tempfile
+    from pathlib import Path
+
+    from src.core.models.gltf_export import ALPHA_BLEND, ALPHA_OPAQUE, GltfExportSettings
+    from src.services.gltf_export_service_impl import (
+        CONVERTER_ENV_VAR,
+        GltfExportService,
+        read_glb,
+    )
+
+    with tempfile.TemporaryDirectory() as temp_dir:
+        root = Path(temp_dir)
+        (root / "wood.png").write_bytes(b"\x89PNG wood")
+        (root / "wood_normal.jpg").write_bytes(b"\xff\xd8 normal")
+        cmds = FakeCmds(root)
+        service = GltfExportService()
+
+        wood, glass = service.read_materials(cmds, ["|crate:root"])
+        assert wood.name == "wood" and glass.name == "glass"
+        assert wood.base_color == (0.8, 0.8, 0.8, 1.0) and wood.roughness == 0.4
+        assert wood.base_color_texture == str(root / "wood.png")
+        assert wood.normal_texture == str(root / "wood_normal.jpg")
+        assert wood.roughness_texture == str(root / "wood_rough.tif")
+        assert glass.base_color == (0.2, 0.4, 0.6, 0.5) and glass.alpha_mode == ALPHA_BLEND
+
+        converted = []
+
+        def mel_runner(command):
+            if command.startswith("FBXExport -f"):
+                Path(command.split('"')[1]).write_bytes(b"FBX")
+
+        def runner(command):
+            converted.append(command)
+            output = Path(command[command.index("-o") + 1])
+            output.with_suffix(".glb").write_bytes(_make_glb(["crate:wood", "crate:glass"]))
+
+        converter = root / "FBX2glTF"
+        converter.write_text("")
+        os.environ[CONVERTER_ENV_VAR] = str(converter)
+        try:
+            output = service.export(
+                cmds,
+                root / "crate.glb",
+                GltfExportSettings(),
+                ["|crate:root"],
+                runner=runner,
+                mel_runner=mel_runner,
+            )
+        finally:
+            del os.environ[CONVERTER_ENV_VAR]
+        assert output == root / "crate.glb" and cmds.selected == ["|crate:root"]
+        assert converted[0][0] == str(converter)
+
+        document, binary = read_glb(output.read_bytes())
+        wood_material, glass_material = document["materials"]
+        pbr = wood_material["pbrMetallicRoughness"]
+        assert wood_material["name"] == "wood" and wood_material["doubleSided"] is True
+        assert pbr["baseColorFactor"] == [0.8, 0.8, 0.8, 1.0]
+        # The roughness map needs Qt to be packed, so the shader's value stands in
+        assert "metallicRoughnessTexture" not in pbr and pbr["roughnessFactor"] == 0.4
+        assert wood_material["alphaMode"] == ALPHA_OPAQUE
+        assert glass_material["alphaMode"] == ALPHA_BLEND
+
+        textures, images = document["textures"], document["images"]
+        base_image = images[textures[pbr["baseColorTexture"]["index"]]["source"]]
+        normal_image = images[textures[wood_material["normalTexture"]["index"]]["source"]]
+        assert (base_image["mimeType"], normal_image["mimeType"]) == ("image/png", "image/jpeg")
+        view = document["bufferViews"][base_image["bufferView"]]
+        start = view["byteOffset"]
+        assert bytes(binary[start : start + view["byteLength"]]) == b"\x89PNG wood"
+        assert bytes(binary[:6]) == b"meshes"
+        views = document["bufferViews"]
+        end = max(view["byteOffset"] + view["byteLength"] for view in views)
+        assert document["buffers"][0]["byteLength"] == end <= len(binary)
+
+        # Embedded .gltf documents carry the textures as data URIs
+        text = root / "crate.gltf"
+        text.write_text(json.dumps({"materials": [{"name": "wood"}]}))
+        assert service.bake_file(text, [wood], 2048) == 1
+        images = json.loads(text.read_text())["images"]
+        assert images[0]["uri"] == (
+            "data:image/png;base64," + base64.b64encode(b"\x89PNG wood").decode("ascii")
+        )
@@ -0,0 +1,244 @@
+"""
+Test suite for XGen and Yeti groom assets
+
+Validates publishing grooms with their growth meshes, collection files, and maps, and
+binding them on import to scene meshes that follow exactly or through a wrap.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+QUAD = ["FACE 0: 0 1 2 3 \n"]
+TRIANGLES = ["FACE 0: 0 1 2 \n", "FACE 1: 0 2 3 \n"]
+
+
+class FakeCmds:
+    """Typed nodes with parents, shape topology, and groom history"""
+
+    def __init__(self):
+        self.types = {}  # node -> node type
+        self.parents = {}  # node -> parent transform
+        self.attributes = {}  # plug -> value
+        self.faces = {}  # mesh shape -> polyInfo face lines
+        self.history = {}  # groom node -> growth mesh shapes
+        self.bindings = []  # (deformer, driver, driven)
+        self.exported = []
+        self.on_import = None  # Adds the nodes of cmds.file(i=True)
+        self.project = ""
+
+    def add_mesh(self, transform, faces):
+        self.types[transform] = "transform"
+        self.types[f"{transform}Shape"] = "mesh"
+        self.parents[f"{transform}Shape"] = transform
+        self.faces[f"{transform}Shape"] = faces
+
+    def _shape(self, node):
+        return node if node in self.faces else f"{node}Shape"
+
+    # Nodes
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            transforms = [node for node, kind in self.types.items() if kind == "transform"]
+            return [node for node in transforms if node not in self.parents]
+        nodes = args[0] if args else list(self.types)
+        types = kwargs.get("type")
+        types = [types] if isinstance(types, str) else types
+        return [node for node in nodes if types is None or self.types.get(node) in types]
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def listRelatives(self, node, shapes=False, parent=False, allDescendents=False, **kwargs):
+        if parent:
+            return [self.parents[node]] if node in self.parents else None
+        children = [child for child, owner in self.parents.items() if owner == node]
+        if shapes:
+            children = [child for child in children if self.types[child] != "transform"]
+        return children or None
+
+    def listHistory(self, nodes):
+        return [shape for node in nodes for shape in self.history.get(node, [])]
+
+    def group(self, nodes, name):
+        self.types[name] = "transform"
+        for node in nodes:
+            self.parents[node] = name
+        return name
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def getAttr(self, plug):
+        return self.attributes.get(plug, "")
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+    def workspace(self, query=False, rootDirectory=False):
+        return self.project
+
+    # Topology
+    def polyInfo(self, mesh, faceToVertex=False):
+        return self.faces[self._shape(mesh)]
+
+    def polyEvaluate(self, mesh, vertex=False, edge=False, face=False):
+        faces = self.faces[self._shape(mesh)]
+        if face:
+            return len(faces)
+        return 4 if vertex else 3 + len(faces)
+
+    # Binding
+    def blendShape(self, driver, driven, **kwargs):
+        self.bindings.append(("blendShape", driver, driven))
+
+    def deformer(self, driven, type, name):
+        self.bindings.append((type, "", driven))
+        return [name]
+
+    def proximityWrap(self, wrap, edit=False, addDrivers=None):
+        self.bindings.append(("proximityWrap", addDrivers[0], wrap))
+
+    # Files
+    def loadPlugin(self, name, quiet=False):
+        pass
+
+    def select(self, *args, **kwargs):
+        pass
+
+    def file(self, path, **kwargs):
+        if kwargs.get("exportSelected"):
+            Path(path).write_text("//Maya ASCII groom")
+            Path(path).with_name(f"{Path(path).stem}__hair_coll.xgen").write_text("Palette")
+            self.exported.append(Path(path).name)
+            return path
+        if kwargs.get("i"):
+            return self.on_import()
+        return None
+
+
+def make_groom_scene(project: Path) -> FakeCmds:
+    """Scene with an XGen collection on a head, a Yeti fur node, and their maps"""
+    maps = project / "xgen" / "collections" / "hair_coll" / "paintmaps"
+    maps.mkdir(parents=True)
+    (maps / "density.ptx").write_bytes(b"PTX")
+
+    cmds = FakeCmds()
+    cmds.project = project.as_posix() + "/"
+    cmds.add_mesh("head", QUAD)
+    cmds.types["hair_coll"] = "xgmPalette"
+    cmds.attributes["hair_coll.xgDataPath"] = "${PROJECT}xgen/collections/hair_coll"
+    cmds.history["hair_coll"] = ["headShape"]
+    cmds.types["fur"] = "transform"
+    cmds.types["furShape"] = "pgYetiMaya"
+    cmds.parents["furShape"] = "fur"
+    return cmds
+
+
+def make_import_scene(*meshes) -> FakeCmds:
+    """Scene with the given (name, faces) meshes that imports the hero_hair groom"""
+    cmds = FakeCmds()
+    for name, faces in meshes:
+        cmds.add_mesh(name, faces)
+
+    def importer():
+        cmds.add_mesh("hero_hair_groom:head", QUAD)
+        cmds.types["hero_hair_groom:hair_coll"] = "xgmPalette"
+        return ["hero_hair_groom:head", "hero_hair_groom:headShape", "hero_hair_groom:hair_coll"]
+
+    cmds.on_import = importer
+    return cmds
+
+
+def test_publish_groom_with_collection_and_maps():
+    """Grooms save their growth meshes' topology, collection files, and copied maps"""
+    from src.services.asset_repository_impl import get_asset_type
+    from src.services.groom_service_impl import GroomService
+
+    service = GroomService()
+    work = Path(tempfile.mkdtemp(prefix="assetManager_grooms_"))
+    grooms_dir = work / "library" / "assets" / "grooms"
+    cmds = make_groom_scene(work / "project")
+
+    assert service.find_grooms(cmds, ["fur", "head"]) == [("furShape", "pgYetiMaya")]
+    every_groom = service.find_grooms(cmds)
+    assert service.get_system(every_groom) == ""
+    assert service.save_groom(cmds, every_groom, grooms_dir / "mixed") is None
+    assert service.save_groom(cmds, [], grooms_dir / "empty") is None
+
+    descriptor_path = service.save_groom(
+        cmds, [("hair_coll", "xgmPalette")], grooms_dir / "hero_hair", notes="long"
+    )
+    assert descriptor_path == grooms_dir / "hero_hair.groom"
+    assert get_asset_type(descriptor_path) == "groom"
+    assert cmds.exported == ["hero_hair.ma"]
+
+    descriptor = json.loads(descriptor_path.read_text())
+    assert descriptor["system"] == "xgen" and descriptor["grooms"] == ["hair_coll"]
+    assert descriptor["network"] == ".grooms/hero_hair.ma"
+    assert descriptor["collection_files"] == [".grooms/hero_hair__hair_coll.xgen"]
+    assert [mesh["name"] for mesh in descriptor["growth_meshes"]] == ["head"]
+    assert descriptor["growth_meshes"][0]["topology"]["faces"] == 1
+    data_dir = grooms_dir / descriptor["data_dirs"]["hair_coll"]
+    assert (data_dir / "paintmaps" / "density.ptx").read_bytes() == b"PTX"
+    assert service.load_groom(descriptor_path) == descriptor
+
+    not_groom = grooms_dir / "other.groom"
+    not_groom.write_text(json.dumps({"type": "light_rig"}))
+    assert service.load_groom(not_groom) is None
+
+
+def test_import_groom_binds_and_remaps_meshes():
+    """Same topology follows, other topology wraps, and differences ask for a remap"""
+    from src.services.groom_service_impl import (
+        BIND_FOLLOW,
+        BIND_WRAP,
+        GROOM_ATTRIBUTE,
+        GroomService,
+    )
+
+    service = GroomService()
+    work = Path(tempfile.mkdtemp(prefix="assetManager_grooms_"))
+    cmds = make_groom_scene(work / "project")
+    descriptor_path = service.save_groom(
+        cmds, [("hair_coll", "xgmPalette")], work / "grooms" / "hero_hair"
+    )
+    descriptor = service.load_groom(descriptor_path)
+
+    # A scene with the same head binds without asking
+    scene = make_import_scene(("head", QUAD), ("body", TRIANGLES))
+    targets = service.suggest_targets(scene, descriptor)
+    assert targets == {"head": "head"}
+    assert not service.needs_remap(scene, descriptor, targets)
+
+    result = service.import_groom(scene, descriptor_path, targets)
+    assert result.group == "hero_hair_groom" and not result.unbound
+    assert result.bindings == [("head", "head", BIND_FOLLOW)]
+    assert scene.bindings == [("blendShape", "head", "hero_hair_groom:head")]
+    assert scene.attributes["hero_hair_groom:head.visibility"] is False
+    assert scene.attributes[f"{result.group}.{GROOM_ATTRIBUTE}"] == descriptor_path.as_posix()
+    data_path = scene.attributes["hero_hair_groom:hair_coll.xgDataPath"]
+    assert data_path.endswith(descriptor["data_dirs"]["hair_coll"])
+
+    # A renamed head with other topology has to be picked, and is wrapped
+    scene = make_import_scene(("charHead", TRIANGLES))
+    targets = service.suggest_targets(scene, descriptor)
+    assert targets == {"head": ""}
+    assert service.needs_remap(scene, descriptor, targets)
+    assert service.check_target(scene, descriptor, "head", "charHead")
+    assert service.needs_remap(scene, descriptor, {"head": "charHead"})
+
+    result = service.import_groom(scene, descriptor_path, {"head": "charHead"})
+    assert result.bindings == [("head", "charHead", BIND_WRAP)]
+    assert scene.bindings == [
+        ("proximityWrap", "", "hero_hair_groom:head"),
+        ("proximityWrap", "charHeadShape", "head_groomWrap"),
+    ]
+
+    # Growth meshes left unbound stay where they were published
+    scene = make_import_scene(("charHead", TRIANGLES))
+    result = service.import_groom(scene, descriptor_path, {"head": ""})
+    assert result.unbound == ["head"] and not scene.bindings
@@ -0,0 +1,138 @@
+"""
+Test suite for configurable shortcuts and Maya hotkey integration
+
+Validates that artists' shortcuts are stored apart from the defaults, that shortcuts used
+twice are refused, and that the manager commands and marking menu are registered as Maya
+runtime commands.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Runtime commands and popup menus by name"""
+
+    def __init__(self):
+        self.runtime_commands = {}  # name -> flags
+        self.popup_menus = {}  # name -> flags
+        self.menu_items = []  # flags of each menu item
+
+    def runTimeCommand(self, name, exists=False, edit=False, delete=False, **kwargs):
+        if exists:
+            return name in self.runtime_commands
+        if delete:
+            del self.runtime_commands[name]
+        elif edit:
+            self.runtime_commands[name].update(kwargs)
+        else:
+            self.runtime_commands[name] = dict(kwargs)
+        return name
+
+    def popupMenu(self, name, exists=False, **kwargs):
+        if exists:
+            return name in self.popup_menus
+        self.popup_menus[name] = kwargs
+        return name
+
+    def menuItem(self, **kwargs):
+        self.menu_items.append(kwargs)
+
+    def deleteUI(self, name):
+        del self.popup_menus[name]
+        self.menu_items = []
+
+
+def test_shortcuts_store_changes_and_refuse_conflicts():
+    """Only changed shortcuts are saved, and one key cannot run two commands"""
+    from src.services.hotkey_service_impl import (
+        COMMAND_IMPORT_LAST,
+        COMMAND_OPEN_BROWSER,
+        COMMAND_PUBLISH_SELECTED,
+        COMMAND_SEARCH,
+        HotkeyService,
+        normalize_shortcut,
+    )
+
+    config_file = Path(tempfile.mkdtemp(prefix="assetManager_hotkeys_")) / "hotkeys.json"
+    service = HotkeyService(config_file)
+    shortcuts = service.get_shortcuts()
+    assert shortcuts[COMMAND_IMPORT_LAST] == "Ctrl+Shift+I"
+    assert COMMAND_OPEN_BROWSER not in shortcuts  # Only Maya's hotkeys open the window
+
+    assert normalize_shortcut("shift+control+p") == "Ctrl+Shift+P"
+    assert normalize_shortcut(" ") == ""
+
+    shortcuts[COMMAND_IMPORT_LAST] = "alt+ctrl+i"
+    shortcuts[COMMAND_SEARCH] = ""
+    assert service.set_shortcuts(shortcuts)
+    stored = json.loads(config_file.read_text())["shortcuts"]
+    assert stored == {COMMAND_IMPORT_LAST: "Ctrl+Alt+I", COMMAND_SEARCH: ""}
+    assert HotkeyService(config_file).get_shortcuts()[COMMAND_IMPORT_LAST] == "Ctrl+Alt+I"
+
+    # Two commands, or a command and a fixed window action, cannot share a key
+    shortcuts[COMMAND_PUBLISH_SELECTED] = "Ctrl+Alt+I"
+    assert service.find_conflicts(shortcuts) == [
+        "Ctrl+Alt+I is used by both Publish Selected and Import Last Asset"
+    ]
+    try:
+        service.set_shortcuts(shortcuts)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Conflicting shortcuts should not be saved")
+    assert service.find_conflicts({COMMAND_SEARCH: "Ctrl+E"}, {"Ctrl+E": "Export Selected..."})
+
+    try:
+        service.set_shortcuts({COMMAND_OPEN_BROWSER: "Ctrl+B"})
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Commands without a window shortcut should be refused")
+
+    config_file.write_text("not json")
+    assert service.get_shortcuts()[COMMAND_IMPORT_LAST] == "Ctrl+Shift+I"
+
+
+def test_runtime_commands_and_marking_menu():
+    """Every command and the marking menu halves are listed in Maya's Hotkey Editor"""
+    from src.services.hotkey_service_impl import (
+        MARKING_MENU_NAME,
+        MARKING_MENU_PRESS,
+        MARKING_MENU_RELEASE,
+        RUNTIME_CATEGORY,
+        HotkeyService,
+    )
+
+    service = HotkeyService(Path(tempfile.mkdtemp(prefix="assetManager_hotkeys_")) / "h.json")
+    cmds = FakeCmds()
+    names = service.register_runtime_commands(cmds)
+    assert names[-2:] == [MARKING_MENU_PRESS, MARKING_MENU_RELEASE]
+    import_last = cmds.runtime_commands["AssetManagerImportLast"]
+    assert import_last["category"] == RUNTIME_CATEGORY
+    assert import_last["commandLanguage"] == "python"
+    assert "run_manager_command('import_last')" in import_last["command"]
+    assert "AssetManagerOpenBrowser" in names
+
+    # Loading the plugin again updates the commands instead of failing
+    cmds.runtime_commands["AssetManagerImportLast"]["command"] = "old"
+    assert service.register_runtime_commands(cmds) == names
+    assert cmds.runtime_commands["AssetManagerImportLast"]["category"] == RUNTIME_CATEGORY
+    assert "import_last" in cmds.runtime_commands["AssetManagerImportLast"]["command"]
+
+    menu = service.show_marking_menu(cmds)
+    assert cmds.popup_menus[menu]["markingMenu"] is True
+    positions = {item["label"]: item.get("radialPosition") for item in cmds.menu_items}
+    assert positions["Open Asset Browser"] == "N" and positions["Import Last Asset"] == "S"
+    assert positions["Advanced Search"] is None  # Listed below the radial slots
+    service.show_marking_menu(cmds)  # A second press replaces the menu
+    assert len(cmds.menu_items) == len(service.get_commands())
+
+    service.hide_marking_menu(cmds)
+    assert MARKING_MENU_NAME not in cmds.popup_menus
+    service.unregister_runtime_commands(cmds)
+    assert cmds.runtime_commands == {}
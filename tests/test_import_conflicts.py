@@ -0,0 +1,175 @@
+"""
+Test suite for import name clash checks
+
+Validates that the nodes of an asset whose names are taken in the scene are found
+before the import, from Maya ASCII text or by importing aside, and that clashing
+nodes can be given free names, against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _in_namespace(path, namespace):
+    return "|".join(f"{namespace}:{part}" if part else "" for part in path.split("|"))
+
+
+class FakeCmds:
+    """Nodes by long name; DAG nodes start with |, namespaces prefix each path part"""
+
+    def __init__(self, nodes, file_nodes=()):
+        self.nodes = dict(nodes)  # long name -> node type
+        self.file_nodes = list(file_nodes)  # What importing any file creates
+        self.namespaces = set()
+
+    def _short(self, node):
+        return node.rsplit("|", 1)[-1]
+
+    def ls(self, name, long=False):
+        return [node for node in self.nodes if self._short(node) == name.lstrip(":")]
+
+    def objExists(self, name):
+        return bool(self.ls(name))
+
+    def listRelatives(self, node, parent=False):
+        return [node.rsplit("|", 1)[0]] if node.count("|") > 1 else None
+
+    def nodeType(self, node):
+        return self.nodes[node]
+
+    def file(self, path, i=False, type=None, namespace="", returnNewNodes=False):
+        self.namespaces.add(f":{namespace}")
+        new_nodes = []
+        for node, node_type in self.file_nodes:
+            new_nodes.append(_in_namespace(node, namespace))
+            self.nodes[new_nodes[-1]] = node_type
+        return new_nodes
+
+    def rename(self, node, new_name):
+        old = self.ls(node)[0]
+        parts = old.split("|")
+        renamed = new_name.lstrip(":")
+        if "|" in old:
+            renamed = old.rsplit("|", 1)[0] + "|" + renamed
+        self.nodes = {
+            (renamed + name[len(old) :] if name.split("|")[: len(parts)] == parts else name): kind
+            for name, kind in self.nodes.items()
+        }
+        return renamed
+
+    def namespaceInfo(self, namespace, listOnlyNamespaces=False, recurse=False, **kwargs):
+        return sorted(self.namespaces)
+
+    def namespace(
+        self,
+        exists=None,
+        add=None,
+        moveNamespace=None,
+        force=False,
+        removeNamespace=None,
+        deleteNamespaceContent=False,
+    ):
+        if exists is not None:
+            return f":{exists.lstrip(':')}" in self.namespaces
+        if moveNamespace is not None:
+            prefix = moveNamespace[0].lstrip(":") + ":"
+            moved = {}
+            for name, node_type in self.nodes.items():
+                target = "|".join(part.replace(prefix, "", 1) for part in name.split("|"))
+                while force and target != name and (target in self.nodes or target in moved):
+                    target += "1"  # force renames nodes whose names are taken
+                moved[target] = node_type
+            self.nodes = moved
+        elif removeNamespace is not None:
+            prefix = removeNamespace.lstrip(":") + ":"
+            if deleteNamespaceContent:
+                self.nodes = {
+                    name: node_type
+                    for name, node_type in self.nodes.items()
+                    if not self._short(name).startswith(prefix)
+                    and not any(part.startswith(prefix) for part in name.split("|"))
+                }
+            self.namespaces.discard(f":{removeNamespace.lstrip(':')}")
+
+
+SCENE_NODES = {
+    "|crate": "transform",
+    "|crate|crateShape": "mesh",
+    "|props|crate_MAT": "transform",  # Deeper in the DAG, not a clash
+    "crate_SG": "shadingEngine",
+    "renderLayerManager": "renderLayerManager",
+}
+
+CRATE_NODES = [
+    ("|crate", "transform"),
+    ("|crate|crateShape", "mesh"),
+    ("crate_MAT", "lambert"),
+    ("crate_SG", "shadingEngine"),
+    ("renderLayerManager", "renderLayerManager"),
+]
+
+
+def test_clashes_are_found_before_the_import():
+    """Parentless nodes whose names the scene uses are reported, bookkeeping is not"""
+    from src.services.import_conflict_service_impl import ImportConflictService
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_conflicts_"))
+    ascii_file = folder / "crate.ma"
+    ascii_file.write_text(
+        "//Maya ASCII 2024 scene\n"
+        'createNode transform -s -n "persp";\n'
+        'createNode transform -n "crate";\n'
+        'createNode mesh -n "crateShape" -p "crate";\n'
+        'createNode lambert -n "crate_MAT";\n'
+        'createNode shadingEngine -n "crate_SG";\n'
+        'createNode renderLayerManager -n "renderLayerManager";\n',
+        encoding="utf-8",
+    )
+    service = ImportConflictService()
+    cmds = FakeCmds(SCENE_NODES)
+    report = service.check_import(cmds, ascii_file)
+    assert report.names == ("crate", "crate_SG")
+    assert report.conflicts[0].label == "crate (transform) - taken by |crate"
+    assert report.summary() == "2 node name(s) of crate.ma are taken in the scene"
+
+    # Binary and exchange files are imported aside, and the probe leaves no trace
+    cmds = FakeCmds(SCENE_NODES, CRATE_NODES)
+    report = service.check_import(cmds, folder / "crate.fbx")
+    assert report.names == ("crate", "crate_SG")
+    assert cmds.nodes == SCENE_NODES and cmds.namespaces == set()
+
+    assert not service.check_import(cmds, folder / "crate.abc").has_conflicts
+    assert not service.check_import(FakeCmds({}), ascii_file).has_conflicts
+    assert not service.check_import(cmds, folder / "missing.ma").has_conflicts
+
+
+def test_clashing_nodes_get_free_names():
+    """Renaming moves the import into the root with free names, the scene untouched"""
+    from src.core.models.import_conflict import ImportConflictReport, NameConflict
+    from src.services.import_conflict_service_impl import ImportConflictService
+
+    service = ImportConflictService()
+    cmds = FakeCmds({**SCENE_NODES, "|crate_1": "transform"}, CRATE_NODES)
+    cmds.namespaces.add(":crate")
+    namespace = service.get_import_namespace(cmds, "crate")
+    assert namespace == "crate_1"
+
+    report = ImportConflictReport(
+        Path("crate.ma"),
+        (NameConflict("crate", "transform", "|crate"), NameConflict("crate_SG", "", "crate_SG")),
+    )
+    cmds.file("crate.ma", i=True, namespace=namespace, returnNewNodes=True)
+    renamed = service.rename_conflicts(cmds, namespace, report)
+    assert renamed == {"crate": "crate_2", "crate_SG": "crate_SG_1"}
+    assert cmds.nodes == {
+        **SCENE_NODES,
+        "|crate_1": "transform",
+        "|crate_2": "transform",
+        "|crate_2|crateShape": "mesh",
+        "crate_MAT": "lambert",
+        "crate_SG_1": "shadingEngine",
+        "renderLayerManager1": "renderLayerManager",
+    }
@@ -0,0 +1,154 @@
+"""
+Test suite for import namespace cleanup
+
+Validates stripping, merging, and prefix flattening of the namespaces an import
+creates, removal of empty namespaces, and the artist's stored options, against a
+minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _namespace_of(name):
+    return ":" + name.rsplit(":", 1)[0] if ":" in name else ":"
+
+
+class FakeCmds:
+    """Namespaces and node names as Maya keeps them; nodes are found by UUID"""
+
+    def __init__(self, namespaces=(), nodes=()):
+        self.namespaces = set(namespaces)
+        self.nodes = {f"uuid-{index}": name for index, name in enumerate(nodes)}
+
+    def import_file(self, namespaces, nodes):
+        self.namespaces.update(namespaces)
+        for name in nodes:
+            self.nodes[f"uuid-{len(self.nodes)}"] = name
+
+    @property
+    def names(self):
+        return sorted(self.nodes.values())
+
+    def namespaceInfo(
+        self,
+        namespace,
+        listOnlyNamespaces=False,
+        listOnlyDependencyNodes=False,
+        recurse=False,
+        absoluteName=False,
+        dagPath=False,
+    ):
+        if listOnlyDependencyNodes:
+            return [name for name in self.nodes.values() if _namespace_of(name) == namespace]
+        if recurse:
+            return sorted(self.namespaces) + [":UI", ":shared"]
+        parent = "" if namespace == ":" else namespace
+        return [ns for ns in self.namespaces if ns.rsplit(":", 1)[0] == parent]
+
+    def namespace(
+        self, exists=None, add=None, moveNamespace=None, force=False, removeNamespace=None
+    ):
+        if exists is not None:
+            return exists in self.namespaces
+        if add is not None:
+            self.namespaces.add(f":{add}")
+        elif moveNamespace is not None:
+            source, target = moveNamespace
+            for uuid, name in list(self.nodes.items()):
+                if _namespace_of(name) == source:
+                    leaf = name.rsplit(":", 1)[-1]
+                    moved = leaf if target == ":" else f"{target[1:]}:{leaf}"
+                    while force and moved in self.nodes.values():
+                        moved += "1"
+                    self.nodes[uuid] = moved
+        elif removeNamespace is not None:
+            if self.namespaceInfo(removeNamespace, listOnlyDependencyNodes=True):
+                raise RuntimeError(f"{removeNamespace} is not empty")
+            if self.namespaceInfo(removeNamespace, listOnlyNamespaces=True):
+                raise RuntimeError(f"{removeNamespace} has child namespaces")
+            self.namespaces.remove(removeNamespace)
+
+    def ls(self, nodes, uuid=False, long=False):
+        if uuid:
+            return [key for key, name in self.nodes.items() if name in nodes]
+        return [self.nodes[nodes]] if nodes in self.nodes else []
+
+    def rename(self, node, new_name):
+        for uuid, name in self.nodes.items():
+            if name == node:
+                self.nodes[uuid] = new_name
+        return new_name
+
+
+VENDOR_NAMESPACES = [":vendorA", ":vendorA:export", ":vendorA:export:crate"]
+VENDOR_NODES = ["vendorA:export:crate:body", "vendorA:export:crate:lid", "vendorA:crate_grp"]
+
+
+def test_strip_and_merge_imported_namespaces():
+    """Only the namespaces the import created change; others stay as they were"""
+    from src.core.models.import_namespace_options import ImportNamespaceOptions
+    from src.services.namespace_service_impl import NamespaceService
+
+    service = NamespaceService(Path(tempfile.mkdtemp(prefix="assetManager_ns_")) / "ns.json")
+    cmds = FakeCmds([":rig", ":old"], ["rig:ctrl", "body"])
+    before = service.list_namespaces(cmds)
+    assert before == [":old", ":rig"]
+
+    cmds.import_file(VENDOR_NAMESPACES, VENDOR_NODES)
+    removed = service.apply_import_options(
+        cmds, ImportNamespaceOptions(mode="strip"), before, "crate"
+    )
+    assert removed == [":vendorA:export:crate", ":vendorA:export", ":vendorA"]
+    # The second body clashes with the one already in the root namespace
+    assert cmds.names == ["body", "body1", "crate_grp", "lid", "rig:ctrl"]
+    assert cmds.namespaces == {":rig", ":old"}
+
+    # Merging into an existing namespace keeps one level; empty namespaces can go too
+    cmds = FakeCmds([":props", ":old"], ["props:barrel"])
+    before = service.list_namespaces(cmds)
+    cmds.import_file(VENDOR_NAMESPACES, VENDOR_NODES)
+    options = ImportNamespaceOptions(mode="merge", namespace="props", remove_empty=True)
+    removed = service.apply_import_options(cmds, options, before, "crate")
+    assert removed[-1] == ":old" and len(removed) == 4
+    assert cmds.names == ["props:barrel", "props:body", "props:crate_grp", "props:lid"]
+    assert cmds.namespaces == {":props"}
+
+
+def test_prefix_flattening_and_options():
+    """Prefix mode moves nodes to the root under a prefix; options are kept per artist"""
+    from src.core.models.import_namespace_options import ImportNamespaceOptions
+    from src.services.namespace_service_impl import NamespaceService
+
+    config_file = Path(tempfile.mkdtemp(prefix="assetManager_ns_")) / "import_namespaces.json"
+    service = NamespaceService(config_file)
+    assert service.load_options() == ImportNamespaceOptions()
+    assert not service.load_options().changes_namespaces
+
+    cmds = FakeCmds([":rig"], ["rig:ctrl"])
+    before = service.list_namespaces(cmds)
+    cmds.import_file(VENDOR_NAMESPACES, VENDOR_NODES)
+    options = ImportNamespaceOptions(mode="prefix")
+    assert service.apply_import_options(cmds, options, before, "Old Crate") == [
+        ":vendorA:export:crate",
+        ":vendorA:export",
+        ":vendorA",
+    ]
+    assert cmds.names == ["Old_Crate_body", "Old_Crate_crate_grp", "Old_Crate_lid", "rig:ctrl"]
+
+    # Merging into a namespace the import itself made keeps that one
+    cmds = FakeCmds()
+    cmds.import_file([":crate", ":crate:vendor"], ["crate:vendor:body", "crate:grp"])
+    options = ImportNamespaceOptions(mode="merge")
+    assert service.apply_import_options(cmds, options, [], "crate") == [":crate:vendor"]
+    assert cmds.names == ["crate:body", "crate:grp"]
+
+    stored = ImportNamespaceOptions(mode="merge", namespace="props", remove_empty=True)
+    assert service.save_options(stored)
+    assert NamespaceService(config_file).load_options() == stored
+    assert stored.label == "Merge into props, remove empty namespaces"
+    config_file.write_text('{"mode": "flatten"}')
+    assert service.load_options().mode == "keep"
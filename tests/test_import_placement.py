@@ -0,0 +1,135 @@
+"""
+Test suite for import placement
+
+Validates the placement targets read before an import, moving and grounding what the
+import added, grouping it, and the artist's stored options, against a minimal
+stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Top-level transforms with a translation and a bounding box around it"""
+
+    def __init__(self, nodes=None, selection=()):
+        # Long name -> [x, y, z] of the node's bounding box minimum, each box one unit
+        self.nodes = {name: list(position) for name, position in (nodes or {}).items()}
+        self.selection = list(selection)
+        self.parents = {}
+
+    def ls(self, *names, assemblies=False, selection=False, type=None, long=False):
+        if selection:
+            return list(self.selection)
+        if names:
+            return [node for node in self.nodes if node.rsplit("|", 1)[-1] == names[0]]
+        return [node for node in self.nodes if node not in self.parents]
+
+    def objExists(self, node):
+        return node in self.nodes
+
+    def xform(self, node, query=False, worldSpace=False, rotatePivot=False):
+        return list(self.nodes[node])
+
+    def getPanel(self, withFocus=False, typeOf=None, visiblePanels=False):
+        if withFocus:
+            return "outlinerPanel1"
+        if visiblePanels:
+            return ["outlinerPanel1", "modelPanel4"]
+        return "modelPanel" if typeOf.startswith("modelPanel") else "outlinerPanel"
+
+    def modelPanel(self, panel, query=False, camera=False):
+        return "persp"
+
+    def camera(self, camera, query=False, worldCenterOfInterest=False):
+        return [4.0, 2.0, -6.0]
+
+    def exactWorldBoundingBox(self, *nodes):
+        mins = [self.nodes[node] for node in nodes]
+        low = [min(position[axis] for position in mins) for axis in range(3)]
+        high = [max(position[axis] for position in mins) + 1.0 for axis in range(3)]
+        return low + high
+
+    def move(self, x, y, z, *nodes, relative=False, worldSpace=False):
+        for node in nodes:
+            self.nodes[node] = [
+                value + offset for value, offset in zip(self.nodes[node], (x, y, z))
+            ]
+
+    def group(self, *nodes, name, world=False):
+        self.nodes[f"|{name}"] = [0.0, 0.0, 0.0]
+        return self.parent(*nodes, f"|{name}")[0].rsplit("|", 1)[0]
+
+    def parent(self, *nodes):
+        *children, group = nodes
+        moved = []
+        for child in children:
+            name = f"{group}|{child.rsplit('|', 1)[-1]}"
+            self.nodes[name] = self.nodes.pop(child)
+            self.parents[name] = group
+            moved.append(name)
+        return moved
+
+    def listRelatives(self, node, children=False, fullPath=False):
+        return [child for child, parent in self.parents.items() if parent == node]
+
+
+def test_targets_are_read_from_origin_selection_and_camera():
+    """Each mode should name the point an import lands on, or None to leave it"""
+    from src.core.models.import_placement_options import ImportPlacementOptions
+    from src.services.import_placement_service_impl import ImportPlacementService
+
+    service = ImportPlacementService(config_file=Path(tempfile.mkdtemp()) / "placement.json")
+    cmds = FakeCmds({"|table": [2.0, 0.0, 2.0], "|shelf": [4.0, 1.0, 0.0]}, ["|table", "|shelf"])
+
+    def target(mode):
+        return service.get_target_position(cmds, ImportPlacementOptions(mode=mode))
+
+    assert target("as_is") is None
+    assert target("origin") == (0.0, 0.0, 0.0)
+    assert target("selection") == (3.0, 0.5, 1.0)
+    assert target("camera_focus") == (4.0, 2.0, -6.0)
+    cmds.selection = []
+    assert target("selection") is None
+
+    assert service.load_options() == ImportPlacementOptions()
+    options = ImportPlacementOptions(mode="camera_focus", snap_to_ground=True, group_name="set")
+    assert service.save_options(options)
+    assert service.load_options() == options
+    assert options.label == "Place at camera focus, snap to ground, group under set"
+    assert ImportPlacementOptions.from_dict({"mode": "sideways"}).mode == "as_is"
+
+    try:
+        ImportPlacementOptions(mode="sideways")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Unknown placement modes should be rejected")
+
+
+def test_place_moves_grounds_and_groups_new_roots():
+    """New roots should move as one, rest on the ground, and share a named group"""
+    from src.core.models.import_placement_options import ImportPlacementOptions
+    from src.services.import_placement_service_impl import ImportPlacementService
+
+    service = ImportPlacementService(config_file=Path(tempfile.mkdtemp()) / "placement.json")
+    cmds = FakeCmds({"|crate": [10.0, 5.0, 10.0], "|lid": [12.0, 6.0, 10.0]})
+
+    options = ImportPlacementOptions(mode="origin", group_name="{asset}_grp")
+    placed = service.place(cmds, options, ["|crate", "|lid"], "crate 01", (0.0, 0.0, 0.0))
+
+    assert placed == ["|crate_01_grp|crate", "|crate_01_grp|lid"]
+    assert cmds.nodes["|crate_01_grp|crate"] == [-1.5, 0.0, -0.5]
+    assert cmds.nodes["|crate_01_grp|lid"] == [0.5, 1.0, -0.5]
+
+    cmds.nodes["|barrel"] = [3.0, -2.0, 3.0]
+    shared = ImportPlacementOptions(snap_to_ground=True, group_name="crate_01_grp")
+    assert service.place(cmds, shared, ["|barrel"], "barrel") == ["|crate_01_grp|barrel"]
+    assert cmds.nodes["|crate_01_grp|barrel"] == [3.0, 0.0, 3.0]
+    assert service.list_roots(cmds) == ["|crate_01_grp"]
+
+    assert service.place(cmds, options, ["|gone"], "gone") == []
@@ -0,0 +1,197 @@
+"""
+Test suite for instanced imports
+
+Validates placing an asset as an instance without a drop point, and converting
+repeated imports of one asset into instances of the first copy against a minimal
+stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from pathlib import Path
+
+IDENTITY = [1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0, 0.0, 0.0, 0.0, 0.0, 1.0]
+
+
+class FakeScene:
+    """Tracks tagged roots, their meshes, world matrices, and message connections"""
+
+    def __init__(self):
+        self.sources = {}
+        self.meshes = {}
+        self.shared = set()
+        self.matrices = {}
+        self.connections = {}
+        self.referenced = set()
+        self.instance_count = 0
+
+    def add_copy(self, root, asset, vertices, x=0.0):
+        self.sources[root] = asset
+        self.meshes[f"{root}|body|bodyShape"] = vertices
+        self.meshes[f"{root}|lid|lidShape"] = 4
+        self.matrices[root] = IDENTITY[:12] + [x, 0.0, 0.0, 1.0]
+
+    def _move(self, old, new):
+        """Rename a node and everything below it"""
+
+        def moved(path):
+            return new + path[len(old) :] if path == old or path.startswith(old + "|") else path
+
+        for table in (self.sources, self.meshes, self.matrices):
+            for path in list(table):
+                table[moved(path)] = table.pop(path)
+        self.shared = {moved(path) for path in self.shared}
+        for plug, source in self.connections.items():
+            node, attr = source.split(".", 1)
+            self.connections[plug] = f"{moved(node)}.{attr}"
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def ls(self, pattern, objectsOnly=False, long=False, recursive=False):
+        if pattern.startswith("*."):
+            return list(self.sources)
+        return [pattern]
+
+    def referenceQuery(self, node, isNodeReferenced=False):
+        return node in self.referenced
+
+    def objExists(self, node):
+        return node in self.sources
+
+    def listRelatives(
+        self, node, allDescendents=False, type=None, fullPath=False, allParents=False, parent=False
+    ):
+        if allDescendents:
+            return [shape for shape in self.meshes if shape.startswith(node + "|")]
+        if allParents:
+            return ["|a", "|b"] if node in self.shared else ["|a"]
+        parent_path = node.rsplit("|", 1)[0]
+        return [parent_path] if parent_path else None
+
+    def getAttr(self, plug):
+        node, attr = plug.rsplit(".", 1)
+        if attr == "intermediateObject":
+            return False
+        return self.sources[node]
+
+    def polyEvaluate(self, shape, vertex=False):
+        return self.meshes[shape]
+
+    def xform(self, node, query=False, matrix=None, worldSpace=False):
+        if query:
+            return list(self.matrices[node])
+        self.matrices[node] = list(matrix)
+
+    def listConnections(self, plug, source=False, destination=False, plugs=False):
+        if destination:
+            return [dst for dst, src in self.connections.items() if src == plug]
+        return [self.connections[plug]] if plug in self.connections else []
+
+    def instance(self, master):
+        self.instance_count += 1
+        copy = f"{master}_inst{self.instance_count}"
+        for shape in [s for s in self.meshes if s.startswith(master + "|")]:
+            self.meshes[copy + shape[len(master) :]] = self.meshes[shape]
+            self.shared.update([shape, copy + shape[len(master) :]])
+        self.sources[copy] = self.sources[master]
+        self.matrices[copy] = list(self.matrices[master])
+        return [copy]
+
+    def parent(self, node, target=None, world=False):
+        path = next(p for p in self.sources if p.endswith("|" + node) or p == node)
+        new = f"{'' if world else target}|{path.rsplit('|', 1)[-1]}"
+        self._move(path, new)
+        return [new]
+
+    def delete(self, node):
+        for table in (self.sources, self.meshes, self.matrices):
+            for path in [p for p in table if p == node or p.startswith(node + "|")]:
+                del table[path]
+        self.connections = {
+            dst: src for dst, src in self.connections.items() if src != f"{node}.message"
+        }
+
+    def rename(self, node, name):
+        self._move(node, f"{node.rsplit('|', 1)[0]}|{name}")
+        return name
+
+    def attributeQuery(self, name, node=None, exists=False):
+        return node in self.sources
+
+    def addAttr(self, node, longName=None, dataType=None):
+        self.sources[node] = ""
+
+    def setAttr(self, plug, value, type=None):
+        self.sources[plug.rsplit(".", 1)[0]] = value
+
+    def connectAttr(self, source, destination):
+        self.connections[destination] = source
+
+
+def test_import_as_instance_references_first_copy_in_place():
+    """Without a drop point nothing moves; the first copy loads with the source mode"""
+    from src.services.viewport_drop_service_impl import (
+        DROP_MODE_INSTANCE,
+        DROP_MODE_REFERENCE,
+        ViewportDropService,
+    )
+    from tests.test_viewport_drop import FakeCmds
+
+    service = ViewportDropService()
+    cmds = FakeCmds()
+    asset = Path("/library/props/crate.ma")
+    loads = []
+
+    def loader(mode):
+        loads.append(mode)
+        cmds.assemblies.append("|crate:crate_grp")
+        return True
+
+    root = service.place_asset(
+        cmds, asset, DROP_MODE_INSTANCE, None, loader, source_mode=DROP_MODE_REFERENCE
+    )
+    assert root == "|crate:crate_grp"
+    assert loads == [DROP_MODE_REFERENCE]
+    copy = service.place_asset(
+        cmds, asset, DROP_MODE_INSTANCE, None, loader, source_mode=DROP_MODE_REFERENCE
+    )
+    assert copy == "|crate:crate_grp_instance1"
+    assert loads == [DROP_MODE_REFERENCE]
+    assert cmds.moves == [] and cmds.selected == copy
+
+
+def test_convert_duplicates_to_instances():
+    """Unchanged copies become instances in place; edited and referenced copies stay"""
+    from src.services.instance_service_impl import InstanceService
+
+    service = InstanceService()
+    scene = FakeScene()
+    crate = "/library/props/crate.ma"
+    scene.add_copy("|crate_grp", crate, 8)
+    scene.add_copy("|set_grp|crate_grp1", crate, 8, x=5.0)
+    scene.add_copy("|yard_grp|crate_grp", crate, 8, x=9.0)
+    scene.add_copy("|set_grp|crate_grp2", crate, 12)  # Modelled on after import
+    scene.add_copy("|ref:crate_grp", crate, 8)
+    scene.referenced.add("|ref:crate_grp")
+    scene.add_copy("|barrel_grp", "/library/props/barrel.ma", 8)
+    scene.connections["provenance1.members[1]"] = "|set_grp|crate_grp1.message"
+
+    groups = service.find_duplicates(scene)
+    assert len(groups) == 1
+    group = groups[0]
+    assert group.master == "|crate_grp" and group.label == "crate.ma: 2 copies"
+    assert group.duplicates == ("|set_grp|crate_grp1", "|yard_grp|crate_grp")
+
+    converted = service.convert_to_instances(scene, group)
+    assert converted == ["|set_grp|crate_grp1", "|yard_grp|crate_grp"]
+    assert scene.matrices["|set_grp|crate_grp1"][12] == 5.0
+    assert scene.matrices["|yard_grp|crate_grp"][12] == 9.0
+    assert scene.sources["|yard_grp|crate_grp"] == crate
+    assert scene.connections["provenance1.members[1]"] == "|set_grp|crate_grp1.message"
+    assert "|set_grp|crate_grp1|body|bodyShape" in scene.shared
+    assert service.is_instanced(scene, "|crate_grp")
+
+    # Instances are never converted again
+    assert service.find_duplicates(scene) == []
@@ -0,0 +1,117 @@
+"""
+Test suite for Kitsu publish posting
+
+Validates pulling the artist's tasks, linking publishes to one, and posting the
+comment, preview, and output file of a publish through a stand-in REST client.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+TASK_ROW = {
+    "id": "task-9",
+    "project_id": "project-1",
+    "project_name": "Robots",
+    "entity_id": "shot-7",
+    "entity_name": "SH010",
+    "entity_type_name": "Shot",
+    "task_type_id": "type-3",
+    "task_type_name": "Animation",
+    "task_status_id": "status-wip",
+    "task_status_name": "WIP",
+    "sequence_name": "SQ01",
+}
+
+
+class FakeKitsu:
+    """Records REST calls and answers them from in-memory collections"""
+
+    def __init__(self):
+        self.output_types = [{"id": "ot-1", "name": "Alembic Cache"}]
+        self.posts = []
+        self.uploads = []
+
+    def get(self, path):
+        if path == "data/user/tasks":
+            return [dict(TASK_ROW, id="task-2", project_name="Apes", sequence_name=""), TASK_ROW]
+        if path == "data/output-types":
+            return list(self.output_types)
+        raise AssertionError(path)
+
+    def post(self, path, data):
+        entity = dict(data, id=f"id-{len(self.posts)}")
+        self.posts.append((path, data))
+        if path == "data/output-types":
+            self.output_types.append(entity)
+        return entity
+
+    def upload(self, path, file_path):
+        self.uploads.append((path, file_path.name))
+        return {}
+
+
+def test_tasks_and_publish_post():
+    """A linked publish posts a comment with its preview and an output file revision"""
+    from src.services.kitsu_service_impl import KitsuService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_kitsu_"))
+    asset_file = root / "robot.ma"
+    asset_file.write_text("//Maya ASCII")
+    movie = root / "robot_playblast.mp4"
+    movie.write_bytes(b"mp4")
+
+    client = FakeKitsu()
+    service = KitsuService(config_file=root / "kitsu.json", client_factory=lambda config: client)
+    tasks = service.get_my_tasks()
+    assert [task.description for task in tasks] == [
+        "Apes > SH010 > Animation",
+        "Robots > SQ01 / SH010 > Animation",
+    ]
+
+    service.set_active_task(tasks[1])
+    posted = service.publish(asset_file, 3, "Blocking pass", movie)
+    service.publish(asset_file, 4)
+
+    paths = [path for path, _data in client.posts]
+    assert paths[:4] == [
+        "actions/tasks/task-9/comment",
+        "actions/tasks/task-9/comments/id-0/add-preview",
+        "data/output-types",
+        "data/entities/shot-7/output-files/new",
+    ]
+    comment = client.posts[0][1]
+    assert comment["task_status_id"] == "status-wip"
+    assert comment["comment"] == "Published robot.ma v003\n\nBlocking pass"
+    assert client.uploads == [("pictures/preview-files/id-1", "robot_playblast.mp4")]
+
+    output_file = posted["output_file"]
+    assert output_file["revision"] == 3 and output_file["task_type_id"] == "type-3"
+    assert output_file["output_type_id"] == "id-2" and output_file["representation"] == "ma"
+    # The Maya Scene output type is created once and reused
+    assert paths.count("data/output-types") == 1
+    assert len(client.uploads) == 1 and posted["preview"]["id"] == "id-1"
+
+
+def test_active_task_persists_and_unlinked_publish_is_skipped():
+    """The linked task survives a restart; without one nothing is posted"""
+    from src.services.kitsu_service_impl import KitsuService, KitsuTask
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_kitsu_"))
+    client = FakeKitsu()
+    service = KitsuService(config_file=root / "kitsu.json", client_factory=lambda config: client)
+
+    assert service.publish(root / "robot.ma", 1) is None
+    assert client.posts == []
+
+    service.set_config(enabled=True, host="https://kitsu.example.com/api")
+    service.set_active_task(KitsuTask.from_api(TASK_ROW))
+    reloaded = KitsuService(config_file=root / "kitsu.json")
+    assert reloaded.is_enabled()
+    assert reloaded.get_active_task() == KitsuTask.from_api(TASK_ROW)
+    assert reloaded.get_active_task().entity_label == "SQ01 / SH010"
+
+    reloaded.set_active_task(None)
+    assert KitsuService(config_file=root / "kitsu.json").get_active_task() is None
@@ -0,0 +1,132 @@
+"""
+Test suite for publish checksums and the library audit
+
+Validates that publishes store SHA-256 checksums, and that the audit finds
+missing and corrupted files, orphaned asset folders, and unverified files.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def _publish(root: Path):
+    """Publish car.ma with a companion texture as v001 and store its checksums"""
+    from src.services.integrity_service_impl import IntegrityService
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.version_service_impl import VersionServiceImpl
+
+    scenes = root / "assets" / "scenes"
+    (scenes / "textures").mkdir(parents=True)
+    asset_file = scenes / "car.ma"
+    asset_file.write_text("//Maya ASCII 2024 scene\ncreateNode mesh;\n")
+    texture = scenes / "textures" / "car_diffuse.png"
+    texture.write_bytes(b"\x89PNG car")
+
+    version_service = VersionServiceImpl()
+    version = version_service.publish_version(asset_file, companion_files=[texture])
+    database = MetadataDatabase(root)
+    database.record_versions(asset_file, version_service.get_versions(asset_file))
+    service = IntegrityService(version_service)
+    assert service.record_publish(database, asset_file, version) == 4
+    return service, database, asset_file, version
+
+
+def test_audit_finds_damage_and_restores_intact_copies():
+    """A corrupted asset and a missing snapshot restore from their twins"""
+    from src.core.models.integrity_report import (
+        ISSUE_CORRUPTED,
+        ISSUE_MISSING,
+        REPAIR_RECORD,
+        REPAIR_RESTORE,
+    )
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_audit_"))
+    service, database, asset_file, version = _publish(root)
+    assert database.get_checksums()["assets/scenes/car.ma"]["asset"] == "assets/scenes/car.ma"
+
+    clean = service.audit(database)
+    assert clean.is_clean and clean.checked == 4 and not clean.issues
+
+    asset_file.write_text("//Maya ASCII truncated")
+    version.file_path.unlink()
+    progress = []
+    report = service.audit(database, lambda done, total: progress.append((done, total)))
+    assert progress[-1] == (4, 4)
+    assert not report.is_clean
+    assert report.summary == "4 file(s) checked, 1 missing, 1 corrupted"
+
+    corrupted = report.get_issues(ISSUE_CORRUPTED)[0]
+    missing = report.get_issues(ISSUE_MISSING)[0]
+    # Both copies of the scene had the same bytes; neither is intact anymore
+    assert corrupted.path == asset_file and corrupted.repair == REPAIR_RECORD
+    assert corrupted.source is None and missing.source is None
+
+    # Restore the live file first; the snapshot then restores from it
+    asset_file.write_text("//Maya ASCII 2024 scene\ncreateNode mesh;\n")
+    report = service.audit(database)
+    missing = report.get_issues(ISSUE_MISSING)[0]
+    assert missing.path == version.file_path and missing.source == asset_file
+    assert missing.repair == REPAIR_RESTORE
+    assert missing.advice == "Restore from scenes/car.ma"
+    assert service.repair(database, missing)
+    assert version.file_path.is_file() and service.audit(database).is_clean
+
+    texture = asset_file.parent / "textures" / "car_diffuse.png"
+    texture.write_bytes(b"\x89PNG repainted")
+    corrupted = service.audit(database).get_issues(ISSUE_CORRUPTED)[0]
+    assert corrupted.path == texture and corrupted.source.parent.parent.name == "v001"
+    assert service.repair(database, corrupted)
+    assert texture.read_bytes() == b"\x89PNG car"
+    database.close()
+
+
+def test_orphaned_folders_unverified_files_and_forgetting():
+    """History of deleted assets is orphaned; old publishes can be recorded"""
+    from src.core.models.integrity_report import (
+        ISSUE_MISSING,
+        ISSUE_ORPHANED,
+        ISSUE_UNVERIFIED,
+        REPAIR_FORGET,
+        REPAIR_RECORD,
+    )
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_audit_"))
+    service, database, asset_file, _version = _publish(root)
+
+    scenes = asset_file.parent
+    orphan = scenes / ".versions" / "deleted_truck" / "v001"
+    orphan.mkdir(parents=True)
+    (orphan / "deleted_truck.ma").write_text("//Maya ASCII")
+    (scenes / ".proxies" / "car").mkdir(parents=True)  # Belongs to car.ma
+    (root / ".trash" / "x" / ".versions" / "bus").mkdir(parents=True)
+
+    old_asset = scenes / "boat.ma"
+    old_asset.write_text("//Maya ASCII boat")
+    database.save_asset_metadata(old_asset, {"tags": ["vehicle"]})
+
+    report = service.audit(database)
+    orphaned = report.get_issues(ISSUE_ORPHANED)
+    assert [issue.path for issue in orphaned] == [scenes / ".versions" / "deleted_truck"]
+    unverified = report.get_issues(ISSUE_UNVERIFIED)
+    assert [issue.path for issue in unverified] == [old_asset]
+    assert unverified[0].repair == REPAIR_RECORD and not unverified[0].is_problem
+
+    assert service.repair(database, orphaned[0]) and service.repair(database, unverified[0])
+    report = service.audit(database)
+    assert report.is_clean and not report.issues and report.checked == 5
+
+    # Gone for good: no intact copy, so the record can only be forgotten
+    old_asset.unlink()
+    missing = service.audit(database).get_issues(ISSUE_MISSING)[0]
+    assert missing.repair == REPAIR_FORGET and missing.source is None
+    assert service.repair(database, missing)
+    assert "assets/scenes/boat.ma" not in database.get_checksums()
+
+    # Removing an asset drops its checksums with it
+    database.remove_asset(asset_file)
+    assets = [record["asset"] for record in database.get_checksums().values()]
+    assert "assets/scenes/car.ma" not in assets
+    database.close()
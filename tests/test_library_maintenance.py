@@ -0,0 +1,120 @@
+"""
+Test suite for scheduled library maintenance
+
+Validates cron-style job schedules, running due and named maintenance jobs against
+a library, and the maintenance report they leave behind.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from datetime import datetime, timedelta
+from pathlib import Path
+
+
+def _make_library():
+    """Library with two scene assets, one with a thumbnail and one checked out long ago"""
+    library = Path(tempfile.mkdtemp(prefix="assetManager_maintenance_"))
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    (scenes / ".locks").mkdir()
+    (scenes / "crate.ma").write_text("//Maya ASCII crate")
+    (scenes / "barrel.ma").write_text("//Maya ASCII barrel")
+    (scenes / ".thumbnails" / "barrel_screenshot.png").write_bytes(b"PNG")
+    lock = {
+        "asset_path": str(scenes / "crate.ma"),
+        "user": "modeler",
+        "host": "ws-12",
+        "locked_date": (datetime.now() - timedelta(days=10)).isoformat(),
+    }
+    (scenes / ".locks" / "crate.ma.lock").write_text(json.dumps(lock))
+    return library, scenes
+
+
+def test_cron_schedules_and_due_jobs():
+    """Cron fields should parse as crontab does and decide when a job is due"""
+    from src.core.models.maintenance_schedule import (
+        CronSchedule,
+        MaintenanceJob,
+        MaintenanceSchedule,
+    )
+
+    quarter = CronSchedule("*/15 9-17 * * 1-5")
+    assert quarter.next_run(datetime(2026, 3, 2, 9, 7)) == datetime(2026, 3, 2, 9, 15)
+    assert quarter.next_run(datetime(2026, 3, 6, 17, 50)) == datetime(2026, 3, 9, 9, 0)
+    assert quarter.matches(datetime(2026, 3, 2, 12, 30))
+    assert not quarter.matches(datetime(2026, 3, 7, 12, 30))  # Saturday
+
+    # Both day fields restricted: either one matching fires the job
+    either = CronSchedule("0 0 1 * 0")
+    assert either.matches(datetime(2026, 4, 1)) and either.matches(datetime(2026, 4, 5))
+    assert not either.matches(datetime(2026, 4, 2))
+
+    for expression in ("* * *", "61 * * * *", "0 0 31 2 *", "nightly"):
+        try:
+            CronSchedule(expression).next_run(datetime(2026, 1, 1))
+        except ValueError:
+            pass
+        else:
+            raise AssertionError(f"'{expression}' should be rejected")
+
+    job = MaintenanceJob("stats", "0 4 * * *", enabled=True)
+    assert job.is_due(None, datetime(2026, 3, 2, 1, 0))
+    assert not job.is_due(datetime(2026, 3, 2, 4, 0), datetime(2026, 3, 2, 23, 59))
+    assert job.is_due(datetime(2026, 3, 2, 4, 0), datetime(2026, 3, 3, 4, 0))
+    assert not MaintenanceJob("stats").is_due(None, datetime(2026, 3, 2))
+
+    schedule = MaintenanceSchedule(jobs=(job,), run_on_idle=True)
+    assert len(schedule.jobs) == 6 and schedule.enabled_jobs == (job,)
+    assert schedule.get_job("locks").schedule == "0 * * * *"
+    assert MaintenanceSchedule.from_dict(schedule.to_dict()) == schedule
+    restored = MaintenanceSchedule.from_dict({"jobs": [{"task": "defrag", "enabled": True}]})
+    assert restored.enabled_jobs == ()
+
+
+def test_jobs_run_and_fill_the_report():
+    """Running jobs should maintain the library and report newest first"""
+    from src.core.models.maintenance_schedule import MaintenanceJob, MaintenanceSchedule
+    from src.services.maintenance_service_impl import MaintenanceService
+
+    rendered = []
+
+    def runner(job):
+        rendered.append(job.asset_path.name)
+        job.still_path.write_bytes(b"PNG")
+        return True
+
+    service = MaintenanceService(thumbnail_runner=runner)
+    library, scenes = _make_library()
+    schedule = MaintenanceSchedule(
+        jobs=(MaintenanceJob("stats", enabled=True), MaintenanceJob("locks", enabled=True)),
+        stale_lock_hours=24,
+    )
+    assert service.save_schedule(library, schedule)
+    assert service.load_schedule(library) == schedule
+    assert [job.task for job in service.get_due_jobs(library)] == ["stats", "locks"]
+
+    results = service.run_due(library)
+    assert [result.task for result in results] == ["stats", "locks"]
+    assert all(result.success and result.trigger == "schedule" for result in results)
+    stats = json.loads((library / ".assetmanager" / "library_stats.json").read_text())
+    assert stats["assets"] == 2 and stats["by_type"] == {".ma": 2}
+    assert not (scenes / ".locks" / "crate.ma.lock").exists()
+    assert results[1].summary == "Released 1 stale lock(s)"
+    assert service.get_due_jobs(library) == []  # Not due again until their next run
+
+    results = service.run_jobs(library, ["thumbnails", "trash"])
+    assert rendered == ["crate.ma"] and (scenes / ".thumbnails" / "crate_screenshot.png").exists()
+    assert results[0].summary == "Rendered 1 of 1 thumbnail(s)"
+    assert results[1].summary == "Purged 0 expired asset(s)"
+
+    report = service.get_report(library)
+    assert [result.task for result in report] == ["trash", "thumbnails", "locks", "stats"]
+    assert report[0].trigger == "manual" and report[0].host
+
+    # Another machine holding the running file keeps this one out
+    (library / ".assetmanager" / "maintenance.running").write_text("render-07")
+    assert service.run_jobs(library, ["stats"]) == []
+    assert len(service.get_report(library)) == 4
@@ -0,0 +1,122 @@
+"""
+Test suite for library packages and migration
+
+Validates packaging a library with its metadata, unpacking it into a new root with
+stored paths rewritten, and migrating a moved JSON sidecar library to the database.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+import zipfile
+from pathlib import Path
+
+SCENE = """//Maya ASCII 2024 scene
+createNode file -n "woodFile";
+    setAttr ".ftn" -type "string" "{root}/assets/textures/wood.png";
+    setAttr ".cs" -type "string" "sRGB";
+"""
+
+
+def _make_library():
+    """Library with a published crate, its thumbnail, a lock, and stored absolute paths"""
+    root = Path(tempfile.mkdtemp(prefix="assetManager_migration_"))
+    library = root / "old_server" / "props"
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    (scenes / ".locks").mkdir()
+    (library / "assets" / "textures").mkdir()
+    prefix = library.resolve().as_posix()
+
+    crate = scenes / "crate.ma"
+    crate.write_text(SCENE.format(root=prefix), encoding="utf-8")
+    (scenes / "crate.mb").write_bytes(b"FOR4\x00" + prefix.encode("utf-8") + b"/wood.png\x00")
+    (scenes / ".thumbnails" / "crate_screenshot.png").write_bytes(b"png")
+    (scenes / ".locks" / "crate.ma.lock").write_text("{}")
+    return root, library, crate
+
+
+def test_package_round_trip_rewrites_stored_paths():
+    """Files, thumbnails, and metadata move; paths under the old root follow them"""
+    from src.services.library_migration_service_impl import LibraryMigrationService
+    from src.services.metadata_database_impl import get_metadata_database
+
+    root, library, crate = _make_library()
+    old_prefix = library.resolve().as_posix()
+    database = get_metadata_database(library)
+    database.save_asset_metadata(
+        crate, {"tags": ["props"], "source_file": f"{old_prefix}/work/crate_v12.ma"}
+    )
+    database.save_collection("Hero", {"assets": ["crate"]})
+
+    service = LibraryMigrationService()
+    package = root / "props.amlib"
+    exported = service.export_package(library, package)
+    assert exported.files == 3
+    names = zipfile.ZipFile(package).namelist()
+    assert "package.json" in names and "assets/scenes/.thumbnails/crate_screenshot.png" in names
+    assert "assets/scenes/.locks/crate.ma.lock" not in names
+    assert ".assetmanager/library.db" not in names
+
+    target = root / "new_server" / "props"
+    report = service.import_package(package, target)
+    new_crate = target / "assets" / "scenes" / "crate.ma"
+    new_prefix = target.resolve().as_posix()
+    metadata = get_metadata_database(target).get_asset_metadata(new_crate)
+    assert metadata["tags"] == ["props"]
+    assert metadata["source_file"] == f"{new_prefix}/work/crate_v12.ma"
+    assert "Hero" in get_metadata_database(target).get_collections()
+    assert f'"{new_prefix}/assets/textures/wood.png"' in new_crate.read_text()
+    assert report.needs_relink == [target / "assets" / "scenes" / "crate.mb"]
+    assert report.paths_rewritten == 2 and not report.is_clean
+
+    # A second import into the same folder would overwrite the first
+    try:
+        service.import_package(package, target)
+        assert False, "Expected FileExistsError"
+    except FileExistsError:
+        pass
+
+
+def test_migrate_moved_sidecar_library_to_database():
+    """A JSON sidecar library copied by hand is upgraded and pointed at its new root"""
+    from src.core.models.library_migration import BACKEND_DATABASE, BACKEND_JSON
+    from src.services.library_migration_service_impl import (
+        LibraryMigrationService,
+        rewrite_path,
+    )
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root, library, crate = _make_library()
+    sidecar = crate.with_name("crate.ma.meta")
+    sidecar.write_text(json.dumps({"tags": ["props"], "texture": "S:\\Props\\wood.png"}))
+
+    databases = []
+
+    def open_database(library_root):
+        databases.append(MetadataDatabase(library_root))
+        return databases[-1]
+
+    service = LibraryMigrationService(database_factory=open_database)
+    assert service.detect_backend(library) == BACKEND_JSON
+    report = service.migrate(library, [("s:/props", "//srv/props")])
+    assert service.detect_backend(library) == BACKEND_DATABASE
+    assert report.sidecars_migrated == 1
+    assert databases[0].get_asset_metadata(crate)["texture"] == "//srv/props/wood.png"
+    assert json.loads(sidecar.read_text())["texture"] == "//srv/props/wood.png"
+
+    assert rewrite_path("/srv/props2/a.ma", [("/srv/props", "/new")]) == "/srv/props2/a.ma"
+    assert rewrite_path("/srv/Props/a.ma", [("/srv/props", "/new")]) == "/srv/Props/a.ma"
+
+    # Packages may not unpack outside the library
+    package = root / "evil.amlib"
+    with zipfile.ZipFile(package, "w") as zf:
+        zf.writestr("package.json", json.dumps({"format": 1, "tables": {}}))
+        zf.writestr("../outside.txt", "x")
+    try:
+        service.import_package(package, root / "target")
+        assert False, "Expected ValueError"
+    except ValueError:
+        pass
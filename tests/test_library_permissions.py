@@ -0,0 +1,111 @@
+"""
+Test suite for library role-based permissions
+
+Validates role lookups and overrides, reading and writing permissions.json,
+falling back to the junior role for a broken file, and batch publish enforcement.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+def _write_permissions(library: Path, data) -> None:
+    permissions_file = library / ".assetmanager" / "permissions.json"
+    permissions_file.parent.mkdir(parents=True, exist_ok=True)
+    permissions_file.write_text(data if isinstance(data, str) else json.dumps(data))
+
+
+def test_roles_and_overrides():
+    """Unlisted artists get the default role; role overrides replace the built-in actions"""
+    from src.core.models.library_permissions import LibraryPermissions
+
+    permissions = LibraryPermissions.from_dict(
+        {
+            "default_role": "junior",
+            "users": {"Kim": "admin", "sam": "lead", "alex": "artist"},
+            "roles": {"artist": ["import", "publish", "edit", "delete"], "admin": ["import"]},
+        }
+    )
+    assert permissions.get_role("kim") == "admin"
+    assert permissions.get_role("newhire") == "junior"
+    assert permissions.allows("newhire", "import")
+    assert not permissions.allows("newhire", "publish")
+    assert permissions.allows("alex", "delete") and not permissions.allows("alex", "approve")
+    assert permissions.allows("sam", "approve") and not permissions.allows("sam", "manage")
+    assert permissions.allows("kim", "manage")  # Admins always keep manage
+
+    updated = permissions.with_user("newhire", "artist").with_user("sam", "junior")
+    assert updated.get_role("newhire") == "artist"
+    assert ("sam", "junior") not in updated.users and updated.get_role("sam") == "junior"
+    assert LibraryPermissions.from_dict(updated.to_dict()) == updated
+
+    for data in ({"default_role": "intern"}, {"roles": {"lead": ["rename"]}}):
+        try:
+            LibraryPermissions.from_dict(data)
+        except ValueError:
+            continue
+        raise AssertionError(f"from_dict should reject {data}")
+
+
+def test_permission_service():
+    """Libraries without a file are unrestricted; saving must keep the saver an admin"""
+    from src.core.models.library_permissions import LibraryPermissions
+    from src.services.permission_service_impl import PermissionDenied, PermissionService
+    from src.services.version_service_impl import get_current_user
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_permissions_"))
+    user = get_current_user()
+    service = PermissionService()
+    assert not service.is_restricted(library)
+    assert service.is_allowed(library, "delete") and service.get_role(library) == "admin"
+
+    try:
+        service.save_permissions(library, LibraryPermissions(default_role="lead"))
+    except PermissionDenied:
+        pass
+    else:
+        raise AssertionError("saving without keeping an admin should be denied")
+    assert not service.is_restricted(library)
+
+    assert service.save_permissions(library, LibraryPermissions().with_user(user, "admin"))
+    assert service.get_role(library) == "admin"
+    assert service.get_role(library, "someone_else") == "artist"
+
+    _write_permissions(library, {"default_role": "artist", "users": {user: "junior"}})
+    assert not service.is_allowed(library, "publish")
+    try:
+        service.check(library, "delete")
+    except PermissionDenied as e:
+        assert "(junior) cannot delete assets" in str(e)
+    else:
+        raise AssertionError("junior should not be allowed to delete")
+    try:
+        service.save_permissions(library, LibraryPermissions().with_user(user, "admin"))
+    except PermissionDenied:
+        pass
+    else:
+        raise AssertionError("a junior should not be able to promote themselves")
+
+    _write_permissions(library, '{"default_role": "admin", "users": ')
+    assert service.is_restricted(library)
+    assert service.get_role(library) == "junior" and not service.is_allowed(library, "publish")
+
+
+def test_batch_publish_denied():
+    """Batch publishes check the role before touching Maya"""
+    from src.services.batch_service_impl import BatchService
+    from src.services.version_service_impl import get_current_user
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_permissions_batch_"))
+    _write_permissions(
+        library, {"default_role": "artist", "users": {get_current_user(): "junior"}}
+    )
+
+    result = BatchService().publish(None, library / "source" / "crate.ma", library)
+    assert not result.success
+    assert "cannot publish assets" in result.message
+    assert not result.file_path.exists()
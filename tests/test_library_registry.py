@@ -0,0 +1,95 @@
+"""
+Test suite for the multi-library registry
+
+Validates registering and saving libraries, automatic names for opened projects,
+and mounting the libraries listed by a Maya project config.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+def test_register_and_save_libraries():
+    """Registered libraries survive a restart; names must be unique"""
+    from src.services.library_registry_impl import LibraryRegistryService
+
+    work = Path(tempfile.mkdtemp(prefix="assetManager_registry_"))
+    config_file = work / "libraries.json"
+    personal, studio = work / "personal", work / "studio"
+
+    registry = LibraryRegistryService(config_file=config_file)
+    assert registry.get_libraries() == []
+    registry.register_library("Mine", personal)
+    registry.register_library("Studio Props", studio, "studio")
+    registry.register_library("My Assets", personal)  # Renames, does not duplicate
+    try:
+        registry.register_library("studio props", work / "other")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("a second library with the same name should be rejected")
+    try:
+        registry.register_library("Cloud", work / "cloud", "cloud")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("unknown scopes should be rejected")
+    assert registry.save()
+
+    reloaded = LibraryRegistryService(config_file=config_file)
+    labels = [entry.label for entry in reloaded.get_libraries()]
+    assert labels == ["Studio: Studio Props", "Personal: My Assets"]
+
+    # Opened projects are remembered under their folder name, numbered when taken
+    crates = reloaded.remember_library(work / "a" / "My Assets")
+    assert crates.name == "My Assets 2"
+    assert reloaded.remember_library(personal).name == "My Assets"
+    assert len(LibraryRegistryService(config_file=config_file).get_libraries()) == 3
+
+    assert reloaded.unregister_library(studio)
+    assert not reloaded.unregister_library(studio)
+
+
+def test_mount_project_libraries():
+    """A Maya project config mounts its libraries and names the one to load"""
+    from src.services.library_registry_impl import LibraryRegistryService
+
+    work = Path(tempfile.mkdtemp(prefix="assetManager_registry_project_"))
+    maya_project = work / "show"
+    maya_project.mkdir()
+    (maya_project / "asset_libraries.json").write_text(
+        json.dumps(
+            {
+                "libraries": [
+                    {"name": "Show Assets", "path": "assets/library", "scope": "project"},
+                    {"name": "Studio", "path": str(work / "studio"), "scope": "studio"},
+                    {"name": "Broken"},
+                ],
+                "default": "studio",
+            }
+        )
+    )
+
+    registry = LibraryRegistryService(config_file=work / "libraries.json")
+    registry.register_library("Studio Copy", work / "studio")
+    default = registry.mount_project(maya_project)
+    assert default is not None and default.name == "Studio" and default.is_mounted
+
+    libraries = registry.get_libraries()
+    assert [entry.name for entry in libraries] == ["Show Assets", "Studio"]
+    assert libraries[0].root_path == maya_project / "assets" / "library"
+    assert registry.find_library(maya_project / "assets" / "library").scope == "project"
+
+    # Mounted libraries are never saved with the registered ones
+    assert registry.save()
+    saved = json.loads((work / "libraries.json").read_text())
+    assert [item["name"] for item in saved["libraries"]] == ["Studio Copy"]
+
+    assert registry.mount_project(work) is None  # Project without a config
+    assert [entry.name for entry in registry.get_libraries()] == ["Studio Copy"]
+    (work / "asset_libraries.json").write_text("{ not json")
+    assert registry.mount_project(work) is None
@@ -0,0 +1,119 @@
+"""
+Test suite for incremental library scanning
+
+Validates that scans only read new or changed asset files, detect deletions,
+skip bookkeeping folders, reuse the cache across sessions, and re-list only
+watcher-reported folders while a watcher covers the library.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+
+def _write(path: Path, text: str = "//Maya ASCII scene\n") -> Path:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text(text)
+    return path
+
+
+def _scan(service, library, built):
+    def build_record(path, stat):
+        built.append(path.relative_to(library).as_posix())
+        return {"size": stat.st_size}
+
+    return service.scan(library, "test", lambda path: path.suffix == ".ma", build_record)
+
+
+def test_incremental_scan():
+    """Unchanged files come from the cache; only new and changed files are read"""
+    from src.services.library_scan_service_impl import LibraryScanService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_scan_"))
+    library = root / "library"
+    crate = _write(library / "props" / "crate.ma")
+    _write(library / "props" / "barrel.ma")
+    _write(library / "chars" / "hero.ma")
+    _write(library / "chars" / "notes.txt")
+    _write(library / "props" / ".versions" / "crate_v001.ma")
+    _write(library / ".thumbnails" / "crate.ma")
+    cache_file = root / "scan_cache.db"
+
+    built = []
+    result = _scan(LibraryScanService(cache_file), library, built)
+    assert sorted(result.records) == ["chars/hero.ma", "props/barrel.ma", "props/crate.ma"]
+    assert sorted(built) == sorted(result.added) == sorted(result.records)
+
+    # A new session reads the cache file instead of the asset files
+    service = LibraryScanService(cache_file)
+    built = []
+    result = _scan(service, library, built)
+    assert built == [] and len(result.records) == 3
+    assert not (result.added or result.changed or result.removed)
+
+    crate.write_text("//Maya ASCII scene\ncreateNode transform -n lid;\n")
+    os.utime(crate, ns=(crate.stat().st_atime_ns, crate.stat().st_mtime_ns + 10**9))
+    _write(library / "props" / "sub" / "lamp.ma")
+    (library / "props" / "barrel.ma").unlink()
+
+    built = []
+    result = _scan(service, library, built)
+    assert sorted(built) == ["props/crate.ma", "props/sub/lamp.ma"]
+    assert result.added == ["props/sub/lamp.ma"]
+    assert result.changed == ["props/crate.ma"]
+    assert result.removed == ["props/barrel.ma"]
+    assert result.records["props/crate.ma"]["size"] == crate.stat().st_size
+
+    # Deltas were written back to the cache file
+    built = []
+    result = _scan(LibraryScanService(cache_file), library, built)
+    assert built == [] and sorted(result.records) == [
+        "chars/hero.ma",
+        "props/crate.ma",
+        "props/sub/lamp.ma",
+    ]
+
+
+def test_watched_scan_lists_marked_folders():
+    """With a watcher, only reported folders are listed until a full scan is requested"""
+    from src.services.library_scan_service_impl import LibraryScanService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_scan_watch_"))
+    library = root / "library"
+    _write(library / "props" / "crate.ma")
+    _write(library / "chars" / "hero.ma")
+
+    service = LibraryScanService(root / "scan_cache.db")
+    _scan(service, library, [])
+    service.set_watched(library, True)
+    assert service.is_watched(library)
+    assert sorted(service.get_directories(library)) == [
+        library,
+        library / "chars",
+        library / "props",
+    ]
+
+    _write(library / "props" / "barrel.ma")
+    _write(library / "chars" / "villain.ma")
+    built = []
+    result = _scan(service, library, built)
+    assert result.incremental and result.directories_listed == 0 and built == []
+
+    service.mark_changed(library, [library / "props" / "barrel.ma", root / "elsewhere"])
+    built = []
+    result = _scan(service, library, built)
+    assert built == ["props/barrel.ma"] and result.directories_listed == 1
+
+    service.request_full_scan(library)
+    built = []
+    result = _scan(service, library, built)
+    assert not result.incremental
+    assert built == ["chars/villain.ma"] and len(result.records) == 4
+
+    service.clear_cache(library)
+    built = []
+    _scan(LibraryScanService(root / "scan_cache.db"), library, built)
+    assert len(built) == 4
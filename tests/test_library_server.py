@@ -0,0 +1,114 @@
+"""
+Test suite for the read-only library HTTP server
+
+Validates asset listing, filtering, detail, and thumbnail endpoints against a small
+library on disk, and that the server answers real HTTP requests without Maya.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+import threading
+import urllib.request
+from pathlib import Path
+
+
+def _make_api():
+    """Library with two scenes (one tagged and approved, with a thumbnail) and a material"""
+    from src.services.library_scan_service_impl import LibraryScanService
+    from src.services.library_server_impl import LibraryApi
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_server_"))
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    (scenes / ".versions" / "crate").mkdir(parents=True)
+    (scenes / "crate.ma").write_text("//Maya ASCII crate")
+    (scenes / "barrel.mb").write_bytes(b"MB")
+    (scenes / ".thumbnails" / "crate_screenshot.png").write_bytes(b"PNG")
+    (scenes / ".versions" / "crate" / "crate_v001.ma").write_text("//Maya ASCII v1")
+    (library / "assets" / "materials").mkdir()
+    (library / "assets" / "materials" / "red_paint.material").write_text("{}")
+    (library / "notes.txt").write_text("not an asset")
+
+    database = MetadataDatabase(library)
+    database.save_asset_metadata(
+        scenes / "crate.ma",
+        {"name": "crate", "tags": ["Props", "wood"], "status": {"state": "approved"}},
+    )
+    scanner = LibraryScanService(cache_file=library.parent / f"{library.name}_scan.db")
+    return LibraryApi(library, metadata_database=database, scan_service=scanner)
+
+
+def _get_json(api, url):
+    status, content_type, body = api.handle(url)
+    assert content_type == "application/json"
+    return status, json.loads(body)
+
+
+def test_list_and_filter_assets():
+    """Listings skip bookkeeping folders and filter by text, type, tag, and status"""
+    api = _make_api()
+
+    status, listing = _get_json(api, "/api/assets")
+    assert status == 200 and listing["total"] == 3
+    keys = [asset["key"] for asset in listing["assets"]]
+    assert keys == [
+        "assets/materials/red_paint.material",
+        "assets/scenes/barrel.mb",
+        "assets/scenes/crate.ma",
+    ]
+    crate = listing["assets"][2]
+    assert crate["status"] == "approved" and crate["tags"] == ["Props", "wood"]
+    assert crate["thumbnail"] == "/api/thumbnails/assets/scenes/crate.ma"
+    assert listing["assets"][1]["thumbnail"] is None
+
+    assert _get_json(api, "/api/assets?type=material")[1]["total"] == 1
+    assert _get_json(api, "/api/assets?tag=props")[1]["total"] == 1
+    assert _get_json(api, "/api/assets?q=WOOD")[1]["total"] == 1
+    assert _get_json(api, "/api/assets?status=approved")[1]["total"] == 1
+    page = _get_json(api, "/api/assets?offset=1&limit=1")[1]
+    assert page["total"] == 3 and [asset["name"] for asset in page["assets"]] == ["barrel"]
+
+    assert _get_json(api, "/api/assets?status=nonsense")[0] == 400
+    assert _get_json(api, "/api/assets?limit=many")[0] == 400
+    assert _get_json(api, "/api/library")[1]["asset_count"] == 3
+    assert _get_json(api, "/api/tags")[1] == {"Props": 1, "wood": 1}
+
+
+def test_asset_detail_and_thumbnail():
+    """Details add metadata; files are only served for listed assets"""
+    api = _make_api()
+
+    status, detail = _get_json(api, "/api/assets/assets/scenes/crate.ma")
+    assert status == 200 and detail["metadata"]["name"] == "crate"
+    assert isinstance(detail["versions"], list)
+
+    status, content_type, body = api.handle("/api/thumbnails/assets/scenes/crate.ma")
+    assert (status, content_type, body) == (200, "image/png", b"PNG")
+
+    assert _get_json(api, "/api/assets/notes.txt")[0] == 404
+    assert _get_json(api, "/api/thumbnails/assets/scenes/barrel.mb")[0] == 404
+    assert _get_json(api, "/api/thumbnails/../../etc/passwd")[0] == 404
+    assert _get_json(api, "/api/unknown")[0] == 404
+    assert _get_json(api, "/index.html")[0] == 404
+
+
+def test_server_answers_http_requests():
+    """The HTTP server returns API responses with CORS headers for dashboards"""
+    from src.services.library_server_impl import create_server
+
+    api = _make_api()
+    server = create_server(Path("."), port=0, allow_origin="https://dashboard", api=api)
+    thread = threading.Thread(target=server.serve_forever, daemon=True)
+    thread.start()
+    try:
+        url = f"http://127.0.0.1:{server.server_port}/api/assets?type=maya_scene"
+        with urllib.request.urlopen(url, timeout=10) as response:
+            assert response.headers["Access-Control-Allow-Origin"] == "https://dashboard"
+            assert json.loads(response.read())["total"] == 2
+    finally:
+        server.shutdown()
+        server.server_close()
@@ -0,0 +1,171 @@
+"""
+Test suite for light rig and HDRI environment assets
+
+Validates saving scene lights and HDRI images as light rigs, rebuilding their dome
+lights on import, and replacing the active rig instead of stacking a second one.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Typed nodes with parents, string attributes, and connections"""
+
+    def __init__(self):
+        self.types = {}  # node -> node type
+        self.parents = {}  # node -> parent transform
+        self.attributes = {}  # plug -> value
+        self.connections = []  # (source plug, destination plug)
+        self.deleted = []
+        self.exported = []
+        self.on_import = None  # Adds the nodes of cmds.file(i=True)
+
+    def add_light(self, transform, light_type):
+        self.types[transform] = "transform"
+        self.types[f"{transform}Shape"] = light_type
+        self.parents[f"{transform}Shape"] = transform
+
+    # Nodes
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            transforms = [node for node, kind in self.types.items() if kind == "transform"]
+            return [node for node in transforms if node not in self.parents]
+        if args and isinstance(args[0], str) and args[0].startswith("*."):
+            suffix = "." + args[0][2:]
+            return [plug[: -len(suffix)] for plug in self.attributes if plug.endswith(suffix)]
+        nodes = args[0] if args else list(self.types)
+        types = kwargs.get("type")
+        return [node for node in nodes if types is None or self.types.get(node) in types]
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def listRelatives(self, node, shapes=False, parent=False, fullPath=False):
+        if parent:
+            return [self.parents[node]] if node in self.parents else None
+        children = [child for child, owner in self.parents.items() if owner == node]
+        return [child for child in children if self.types[child] != "transform"] or None
+
+    def createNode(self, node_type, name):
+        transform = name.replace("Shape", "")
+        self.add_light(transform, node_type)
+        return f"{transform}Shape"
+
+    def shadingNode(self, node_type, asTexture=False, name=""):
+        self.types[name] = node_type
+        return name
+
+    def group(self, nodes, name):
+        self.types[name] = "transform"
+        for node in nodes:
+            self.parents[node] = name
+        return name
+
+    def delete(self, nodes):
+        self.deleted.extend(nodes)
+        doomed = list(nodes)
+        while doomed:
+            node = doomed.pop()
+            doomed.extend(child for child, owner in self.parents.items() if owner == node)
+            self.types.pop(node, None)
+            self.parents.pop(node, None)
+            for plug in [plug for plug in self.attributes if plug.startswith(f"{node}.")]:
+                del self.attributes[plug]
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+    def connectAttr(self, source, destination, force=False):
+        self.connections.append((source, destination))
+
+    def loadPlugin(self, name, quiet=False):
+        pass
+
+    def select(self, *args, **kwargs):
+        pass
+
+    def file(self, path, **kwargs):
+        if kwargs.get("exportSelected"):
+            Path(path).write_text("//Maya ASCII light rig")
+            self.exported.append(Path(path).name)
+            return path
+        if kwargs.get("i"):
+            return self.on_import()
+        return None
+
+
+def test_save_and_import_hdri_environment():
+    """HDRI images are copied into the library and rebuilt as renderer dome lights"""
+    from src.services.light_rig_service_impl import LightRigService, RIG_ATTRIBUTE
+
+    service = LightRigService()
+    work = Path(tempfile.mkdtemp(prefix="assetManager_light_rigs_"))
+    image = work / "sunset.exr"
+    image.write_bytes(b"EXR")
+    lighting = work / "library" / "assets" / "lighting"
+
+    assert service.save_hdri(work / "notes.txt", lighting / "sunset") is None
+    assert service.save_hdri(image, lighting / "sunset", renderer="maya") is None
+    descriptor_path = service.save_hdri(image, lighting / "sunset", notes="warm")
+    assert descriptor_path == lighting / "sunset.lightrig"
+    descriptor = service.load_light_rig(descriptor_path)
+    assert descriptor["kind"] == "hdri" and descriptor["renderer"] == "arnold"
+    assert (lighting / descriptor["hdri"]).read_bytes() == b"EXR"
+    assert descriptor["hdri"].startswith(".rigs/.dependencies/sunset/")
+
+    cmds = FakeCmds()
+    group = service.import_light_rig(cmds, descriptor_path)
+    assert group == "sunset_lightRig"
+    assert cmds.nodeType("sunsetDomeShape") == "aiSkyDomeLight"
+    assert ("sunset_hdri.outColor", "sunsetDomeShape.color") in cmds.connections
+    assert cmds.attributes["sunset_hdri.fileTextureName"].endswith("sunset.exr")
+    assert cmds.attributes[f"{group}.{RIG_ATTRIBUTE}"] == descriptor_path.as_posix()
+    assert service.find_active_rigs(cmds) == [group]
+
+
+def test_save_rig_and_replace_active_rig():
+    """Scene lights save to a network; loading can replace the rig loaded before"""
+    from src.services.light_rig_service_impl import LightRigService
+
+    service = LightRigService()
+    lighting = Path(tempfile.mkdtemp(prefix="assetManager_light_rigs_")) / "lighting"
+    cmds = FakeCmds()
+    cmds.add_light("key", "aiAreaLight")
+    cmds.add_light("fill", "pointLight")
+    cmds.types["chair"] = "transform"
+
+    assert service.find_lights(cmds, ["fill", "chair"]) == [("fill", "pointLight")]
+    assert {light for light, _type in service.find_lights(cmds)} == {"key", "fill"}
+    assert service.save_light_rig(cmds, ["chair"], lighting / "empty") is None
+
+    descriptor_path = service.save_light_rig(cmds, ["key", "fill"], lighting / "studio")
+    assert cmds.exported == ["studio.ma"]
+    descriptor = json.loads(descriptor_path.read_text())
+    assert descriptor["kind"] == "rig" and descriptor["renderer"] == "arnold"
+    assert descriptor["network"] == ".rigs/studio.ma"
+    assert [light["type"] for light in descriptor["lights"]] == ["aiAreaLight", "pointLight"]
+
+    def importer():
+        cmds.add_light("rim", "spotLight")
+        return ["rim", "rimShape"]
+
+    cmds.on_import = importer
+    first = service.import_light_rig(cmds, descriptor_path)
+    assert cmds.parents["rim"] == first
+
+    # Replacing deletes the rig loaded before once the new one is in
+    hdri = lighting / "dusk.exr"
+    hdri.write_bytes(b"EXR")
+    dusk = service.save_hdri(hdri, lighting / "dusk", renderer="renderman")
+    second = service.import_light_rig(cmds, dusk, replace_active=True)
+    assert cmds.deleted == [first] and "rim" not in cmds.types
+    assert cmds.attributes["duskDomeShape.lightColorMap"].endswith("dusk.exr")
+    assert service.find_active_rigs(cmds) == [second]
@@ -0,0 +1,89 @@
+"""
+Test suite for UI localization
+
+Validates looking up UI strings in translation files, the artist's language setting,
+and keeping translation files in line with the tr() strings of the source.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+def _write_catalog(translations_dir, locale, language, strings):
+    catalog = {"language": language, "locale": locale, "strings": strings}
+    (translations_dir / f"{locale}.json").write_text(json.dumps(catalog), encoding="utf-8")
+
+
+def test_translation_and_language_setting():
+    """Strings should show in the chosen language, falling back to English"""
+    from src.services.localization_service_impl import LocalizationService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_i18n_"))
+    translations_dir = root / "translations"
+    translations_dir.mkdir()
+    _write_catalog(
+        translations_dir,
+        "fr",
+        "Français",
+        {"&File": "&Fichier", "Imported {name}": "{name} importé", "Delete": "", "Oops": "{x}"},
+    )
+    _write_catalog(translations_dir, "template", "Template", {"&File": ""})
+
+    def make_service():
+        return LocalizationService(root / "localization.json", translations_dir)
+
+    service = make_service()
+    assert service.get_language() == "en"
+    assert service.get_available_languages() == [("en", "English"), ("fr", "Français")]
+    assert service.translate("&File") == "&File"
+
+    assert not service.set_language("de")
+    assert service.set_language("fr")
+    service = make_service()  # The language applies from the next start
+    assert service.translate("&File") == "&Fichier"
+    assert service.translate("Imported {name}", name="crate") == "crate importé"
+    assert service.translate("Delete") == "Delete"  # Not translated yet
+    assert service.translate("Not in the file") == "Not in the file"
+    assert service.translate("Oops", name="crate") == "Oops"  # Broken placeholder
+
+
+def test_catalogs_follow_the_source():
+    """Updating a translation file should add new strings and keep translations"""
+    from src.services.localization_service_impl import LocalizationService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_i18n_"))
+    source_root = root / "src"
+    source_root.mkdir()
+    (source_root / "window.py").write_text(
+        'menu = addMenu(tr("&File"))\n'
+        'label = QLabel(tr("Imported {name}", name=asset))\n'
+        'other = tr(f"{count} assets")\n'
+        'plain = QLabel("Not wrapped")\n',
+        encoding="utf-8",
+    )
+    translations_dir = root / "translations"
+    translations_dir.mkdir()
+    _write_catalog(translations_dir, "ja", "日本語", {"&File": "ファイル(&F)", "Old": "古い"})
+
+    service = LocalizationService(root / "localization.json", translations_dir)
+    assert service.extract_strings(source_root) == ["&File", "Imported {name}"]
+
+    assert service.update_catalog("ja", source_root) == (1, 1)
+    catalog = json.loads((translations_dir / "ja.json").read_text(encoding="utf-8"))
+    assert catalog["language"] == "日本語"
+    assert catalog["strings"] == {"&File": "ファイル(&F)", "Imported {name}": ""}
+
+    service.update_catalog("template", source_root)
+    service.update_catalog("en", source_root)
+    assert set(service.load_catalog("en")) == {"&File", "Imported {name}"}
+    assert service.load_catalog("template") == {}  # Empty translations
+
+    # The shipped template lists every string the UI wraps
+    from src.services.localization_service_impl import TRANSLATIONS_DIR
+
+    shipped = json.loads((TRANSLATIONS_DIR / "template.json").read_text(encoding="utf-8"))
+    assert sorted(shipped["strings"]) == LocalizationService().extract_strings()
@@ -0,0 +1,172 @@
+"""
+Test suite for asset LOD variants
+
+Validates publishing levels of detail next to an asset, identifying loaded LODs,
+and swapping imported and referenced LODs against a stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Top-level transforms with string attributes, plus file references"""
+
+    def __init__(self):
+        self.assemblies = []
+        self.attributes = {}
+        self.references = {}  # reference node -> file
+        self.matrices = {}
+        self.parents = {}
+        self.imports = []
+        self.undo_chunks = []
+        self.selection = []
+
+    def select(self, nodes, replace=False):
+        self.selection = list(nodes)
+
+    def file(self, path=None, **kwargs):
+        if kwargs.get("exportSelected"):
+            Path(path).write_text("//Maya ASCII " + " ".join(self.selection))
+            return path
+        if kwargs.get("query"):
+            return [f"{file_path}" for file_path in self.references.values()]
+        if kwargs.get("loadReference"):
+            self.references[kwargs["loadReference"]] = path
+            return path
+        if kwargs.get("i"):
+            root = f"|{Path(path).stem}{len(self.imports)}"
+            self.imports.append(path)
+            self.assemblies.append(root)
+            return [root, f"{root}Shape"]
+        raise AssertionError(kwargs)
+
+    def ls(self, pattern=None, assemblies=False, **kwargs):
+        if assemblies:
+            return list(self.assemblies)
+        attribute = pattern.split(".")[-1]
+        return [node for node in self.assemblies if f"{node}.{attribute}" in self.attributes]
+
+    def attributeQuery(self, attribute, node, exists):
+        return f"{node}.{attribute}" in self.attributes
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, attribute, value, type=None):
+        self.attributes[attribute] = value
+
+    def getAttr(self, attribute):
+        return self.attributes[attribute]
+
+    def referenceQuery(self, target, isNodeReferenced=False, filename=False, **kwargs):
+        if isNodeReferenced:
+            return False
+        node = next(node for node, path in self.references.items() if path == target)
+        return target if filename else node
+
+    def listRelatives(self, node, parent, fullPath):
+        return [self.parents[node]] if node in self.parents else None
+
+    def xform(self, node, query=False, matrix=None, worldSpace=False):
+        if query:
+            return self.matrices.get(node, [1.0] * 16)
+        self.matrices[node] = matrix
+
+    def delete(self, nodes):
+        for node in nodes:
+            self.assemblies.remove(node)
+            for attribute in [a for a in self.attributes if a.startswith(f"{node}.")]:
+                del self.attributes[attribute]
+
+    def parent(self, node, parent):
+        return [f"{parent}{node}"]
+
+    def undoInfo(self, openChunk=False, closeChunk=False, chunkName=""):
+        self.undo_chunks.append("open" if openChunk else "close")
+
+
+def _make_asset() -> Path:
+    library = Path(tempfile.mkdtemp(prefix="assetManager_lods_"))
+    asset_file = library / "assets" / "scenes" / "crate.ma"
+    asset_file.parent.mkdir(parents=True)
+    asset_file.write_text("//Maya ASCII crate")
+    return asset_file
+
+
+def test_publish_variants():
+    """Variants are exported into the hidden LOD folder and listed base first"""
+    from src.services.lod_service_impl import LodService
+
+    asset_file = _make_asset()
+    cmds = FakeCmds()
+    service = LodService()
+    assert not service.has_variants(asset_file)
+    assert service.get_lod_files(asset_file) == []
+    assert service.identify(asset_file) is None
+
+    proxy = service.publish_variant(cmds, asset_file, "proxy", ["crate_proxy"], author="kim")
+    lod2 = service.publish_variant(cmds, asset_file, "LOD2", ["crate_low"])
+    assert proxy == asset_file.parent / ".lods" / "crate" / "crate_proxy.ma"
+    assert "crate_low" in lod2.read_text()
+
+    # The base level, unknown levels, and empty selections are refused
+    for level, selection in (("LOD0", ["crate"]), ("LOD9", ["crate"]), ("LOD1", [])):
+        try:
+            service.publish_variant(cmds, asset_file, level, selection)
+        except ValueError:
+            continue
+        raise AssertionError(f"{level} publish should be refused")
+
+    variants = service.get_variants(asset_file)
+    assert [variant.label for variant in variants] == ["LOD0 (base)", "LOD2", "proxy"]
+    assert variants[2].author == "kim" and variants[2].published is not None
+    assert service.identify(lod2) == (asset_file, "LOD2")
+    assert service.identify(asset_file) == (asset_file, "LOD0")
+    assert [path.name for path in service.get_lod_files(asset_file)] == [
+        "crate_LOD2.ma",
+        "crate_proxy.ma",
+        "lods.json",
+    ]
+
+
+def test_swap_scene_lods():
+    """Imported LODs are re-imported in place; referenced LODs are repathed"""
+    from src.services.lod_service_impl import LEVEL_ATTRIBUTE, LodService
+
+    asset_file = _make_asset()
+    cmds = FakeCmds()
+    service = LodService()
+    proxy = service.publish_variant(cmds, asset_file, "proxy", ["crate_proxy"])
+    lod1 = service.publish_variant(cmds, asset_file, "LOD1", ["crate_mid"])
+
+    roots, new_nodes = service.import_variant(cmds, asset_file, "proxy")
+    assert cmds.imports == [str(proxy)] and len(new_nodes) == 2
+    assert cmds.attributes[f"{roots[0]}.{LEVEL_ATTRIBUTE}"] == "proxy"
+    cmds.matrices[roots[0]] = [2.0] * 16
+    cmds.references["crateRN"] = str(lod1)
+
+    scene_lods = service.find_scene_lods(cmds)
+    assert [scene_lod.label for scene_lod in scene_lods] == [
+        "crate - LOD1, referenced",
+        "crate - proxy, imported",
+    ]
+    referenced, imported = scene_lods
+
+    swapped = service.swap(cmds, imported, "LOD0")
+    assert cmds.imports[-1] == str(asset_file)
+    assert roots[0] not in cmds.assemblies
+    assert cmds.matrices[swapped.nodes[0]] == [2.0] * 16
+    assert swapped.level == "LOD0"
+
+    swapped = service.swap(cmds, referenced, "proxy")
+    assert cmds.references["crateRN"] == str(proxy)
+    assert swapped.reference_node == "crateRN" and swapped.level == "proxy"
+    assert cmds.undo_chunks == ["open", "close"] * 2
+    assert sorted(scene_lod.level for scene_lod in service.find_scene_lods(cmds)) == [
+        "LOD0",
+        "proxy",
+    ]
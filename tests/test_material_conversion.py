@@ -0,0 +1,197 @@
+"""
+Test suite for renderer-aware material conversion
+
+Validates translating imported shader networks to the scene's renderer, the
+report of nodes left unconverted, and discovery of studio converter plugins
+against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+LAMBERT_CONVERTER = '''
+from src.core.interfaces.shader_converter import IShaderConverter
+
+
+class LambertToVRay(IShaderConverter):
+    converter_id = "lambert_to_vray"
+    source_types = ("lambert",)
+    target_renderer = "vray"
+    target_type = "VRayMtl"
+    attribute_map = {"color": "color"}
+'''
+
+
+class FakeCmds:
+    """Tracks node types, attribute values, and connections (destination -> source)"""
+
+    def __init__(self, renderer="vray"):
+        self.renderer = renderer
+        self.meshes = {}  # mesh -> shading group
+        self.types = {}
+        self.values = {}
+        self.connections = {}
+        self.referenced = set()
+
+    def add_material(self, mesh, engine, shader, node_type, **values):
+        self.meshes[mesh] = engine
+        self.types.update({engine: "shadingEngine", shader: node_type})
+        self.connections[f"{engine}.surfaceShader"] = f"{shader}.outColor"
+        for attribute, value in values.items():
+            self.values[f"{shader}.{attribute}"] = value
+
+    def connect(self, node, node_type, destination):
+        self.types[node] = node_type
+        self.connections[destination] = f"{node}.outColor"
+
+    def getAttr(self, plug):
+        if plug == "defaultRenderGlobals.currentRenderer":
+            return self.renderer
+        return self.values.get(plug, 0.0)
+
+    def setAttr(self, plug, *values, type=None):
+        self.values[plug] = [tuple(values)] if type == "double3" else values[0]
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def referenceQuery(self, node, isNodeReferenced=False):
+        return node in self.referenced
+
+    def attributeQuery(self, attribute, node=None, exists=False):
+        plug = f"{node}.{attribute}"
+        return plug in self.values or plug in self.connections
+
+    def listRelatives(self, roots, allDescendents=False, type=None, fullPath=False):
+        return [mesh for mesh in self.meshes if any(mesh.startswith(r + "|") for r in roots)]
+
+    def listConnections(
+        self, plug, source=False, destination=False, plugs=False, connections=False, type=None
+    ):
+        if type == "shadingEngine":
+            return [self.meshes[mesh] for mesh in plug]
+        if connections:
+            pairs = []
+            for dst, src in self.connections.items():
+                if dst.split(".", 1)[0] == plug:
+                    pairs.extend([dst, src])
+            return pairs
+        if plug not in self.connections:
+            return []
+        source_plug = self.connections[plug]
+        return [source_plug if plugs else source_plug.split(".", 1)[0]]
+
+    def listHistory(self, node):
+        history = [node]
+        for dst, src in self.connections.items():
+            if dst.split(".", 1)[0] == node:
+                history.extend(self.listHistory(src.split(".", 1)[0]))
+        return history
+
+    def shadingNode(self, node_type, asShader=False, name=None):
+        self.types[name] = node_type
+        return name
+
+    def connectAttr(self, source, destination, force=False):
+        self.connections[destination] = source
+
+    def delete(self, node):
+        del self.types[node]
+        for table in (self.values, self.connections):
+            for plug in [p for p in table if p.split(".", 1)[0] == node]:
+                del table[plug]
+
+    def rename(self, node, name):
+        def moved(plug):
+            owner, attribute = plug.split(".", 1)
+            return f"{name}.{attribute}" if owner == node else plug
+
+        self.types[name] = self.types.pop(node)
+        self.values = {moved(plug): value for plug, value in self.values.items()}
+        self.connections = {moved(dst): moved(src) for dst, src in self.connections.items()}
+        return name
+
+
+def test_arnold_materials_convert_to_vray_with_report():
+    """Values and texture inputs carry over; unmapped inputs and foreign nodes are listed"""
+    from src.services.shader_conversion_service_impl import ShaderConversionService
+
+    user_dir = Path(tempfile.mkdtemp(prefix="assetManager_converters_"))
+    service = ShaderConversionService(user_converters_dir=user_dir)
+    cmds = FakeCmds("vray")
+    cmds.add_material(
+        "|chair_grp|seat|seatShape", "woodSG", "wood", "aiStandardSurface", specularRoughness=0.3
+    )
+    cmds.connect("woodFile", "file", "wood.baseColor")
+    cmds.connect("grain", "aiNoise", "wood.specularColor")
+    cmds.connect("woodBump", "bump2d", "wood.normalCamera")
+    cmds.add_material("|chair_grp|leg|legShape", "metalSG", "metal", "standardSurface", coat=0.5)
+    cmds.add_material("|chair_grp|pad|padShape", "padSG", "pad", "lambert")
+    cmds.add_material("|chair_grp|glass|glassShape", "glassSG", "glass", "RedshiftMaterial")
+    cmds.add_material("|chair_grp|bolt|boltShape", "boltSG", "bolt", "VRayMtl")
+
+    report = service.convert_imported(cmds, ["|chair_grp"])
+    assert report.renderer == "vray"
+    assert report.converted == [("wood", "VRayMtl"), ("metal", "VRayMtl")]
+    assert cmds.types["wood"] == "VRayMtl" and "wood_vray" not in cmds.types
+    assert cmds.connections["woodSG.surfaceShader"] == "wood.outColor"
+    assert cmds.values["wood.useRoughness"] == 1
+    assert cmds.values["wood.reflectionGlossiness"] == 0.3
+    assert cmds.connections["wood.color"] == "woodFile.outColor"
+    assert cmds.connections["wood.reflectionColor"] == "grain.outColor"
+    assert cmds.values["metal.coatAmount"] == 0.5
+    assert cmds.types["pad"] == "lambert"  # Maya materials render everywhere
+
+    assert [entry.label for entry in report.unconverted] == [
+        "wood (aiStandardSurface): input on normalCamera not converted",
+        "glass (RedshiftMaterial): Redshift shader, no V-Ray converter",
+        "grain (aiNoise): Arnold node, not rendered by V-Ray",
+    ]
+    assert report.summary() == "2 material(s) converted to V-Ray, 3 node(s) not converted"
+
+    # Glossiness is inverted going back to Arnold; referenced shaders are left alone
+    arnold = FakeCmds("arnold")
+    arnold.add_material("|lamp_grp|shade|shadeShape", "shadeSG", "shade", "VRayMtl")
+    arnold.values.update({"shade.useRoughness": 0, "shade.reflectionGlossiness": 0.75})
+    arnold.add_material("|lamp_grp|cord|cordShape", "cordSG", "ref:cord", "VRayMtl")
+    arnold.referenced.add("ref:cord")
+    report = service.convert_imported(arnold, ["|lamp_grp"])
+    assert report.converted == [("shade", "aiStandardSurface")]
+    assert arnold.values["shade.specularRoughness"] == 0.25
+    assert arnold.values["shade.emission"] == 1.0
+    assert report.unconverted[0].node == "ref:cord"
+
+
+def test_plugin_converters_and_renderer_detection():
+    """Studio converters are discovered and preferred; Maya renderers convert nothing"""
+    from src.services.shader_conversion_service_impl import (
+        ShaderConversionService,
+        get_node_renderer,
+    )
+
+    node_types = ("aiNoise", "VRayMtl", "PxrSurface", "aimConstraint")
+    assert [get_node_renderer(t) for t in node_types] == ["arnold", "vray", "renderman", "maya"]
+
+    user_dir = Path(tempfile.mkdtemp(prefix="assetManager_converters_"))
+    (user_dir / "lambert_to_vray.py").write_text(LAMBERT_CONVERTER)
+    (user_dir / "broken.py").write_text("raise RuntimeError('unfinished')")
+    service = ShaderConversionService(user_converters_dir=user_dir)
+    converters = service.discover_converters()
+    ids = [converter.converter_id for converter in converters]
+    assert "standard_surface_to_vray" in ids and ids[-1] == "lambert_to_vray"
+    assert service.find_converter(converters, "lambert", "vray").converter_id == ids[-1]
+    assert service.find_converter(converters, "lambert", "arnold") is None
+
+    cmds = FakeCmds("vray")
+    cmds.add_material("|box|boxShape", "boxSG", "box", "lambert", color=[(0.2, 0.4, 0.6)])
+    report = service.convert_imported(cmds, ["|box"])
+    assert report.converted == [("box", "VRayMtl")] and report.is_clean
+    assert cmds.values["box.color"] == [(0.2, 0.4, 0.6)]
+
+    software = FakeCmds("mayaSoftware")
+    software.add_material("|box|boxShape", "boxSG", "box", "aiStandardSurface")
+    assert service.convert_imported(software, ["|box"]) is None
+    assert software.types["box"] == "aiStandardSurface"
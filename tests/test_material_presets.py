@@ -0,0 +1,188 @@
+"""
+Test suite for material presets
+
+Validates saving a shading group as a material asset, importing its network into a
+scene whose node names clash, and assigning the preset to meshes and faces, against a
+minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Scene with a car shaded by a paint lambert whose texture is named flakespaint"""
+
+    def __init__(self):
+        self.types = {
+            "|car": "transform",
+            "|car|carShape": "mesh",
+            "|ground": "transform",
+            "|ground|groundShape": "mesh",
+            "paintSG": "shadingEngine",
+            "emptySG": "shadingEngine",
+            "initialShadingGroup": "shadingEngine",
+            "paint": "lambert",
+            "flakespaint": "file",
+        }
+        self.connections = {"paintSG.surfaceShader": "paint", "paint.color": "flakespaint"}
+        self.engines = {
+            "|car|carShape": ["paintSG"],
+            "|ground|groundShape": ["initialShadingGroup"],
+        }
+        self.attributes = {"paint": {"outColor"}, "flakespaint": {"outColor"}}
+        self.values = {}
+        self.members = {}
+        self.selected = []
+        self.exported = []
+        self.network = ["flakespaint", "paint"]  # Nodes the network file holds
+        self.imports = 0
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def ls(self, type=None):
+        return [node for node, node_type in self.types.items() if node_type == type]
+
+    def listRelatives(self, node, shapes=False, fullPath=False, type=None, noIntermediate=False):
+        return [child for child in self.types if child.startswith(f"{node}|")]
+
+    def listConnections(self, nodes, type=None, source=False, destination=False):
+        if type == "shadingEngine":
+            return [engine for node in nodes for engine in self.engines.get(node, [])]
+        source_node = self.connections.get(nodes)
+        return [source_node] if source_node else []
+
+    def listHistory(self, nodes):
+        return list(nodes) + [
+            source for plug, source in self.connections.items() if plug.split(".")[0] in nodes
+        ]
+
+    def attributeQuery(self, attribute, node=None, exists=False):
+        if self.types[node] == "shadingEngine":
+            return attribute in ("surfaceShader", "displacementShader")
+        return attribute in self.attributes.get(node, set())
+
+    def select(self, nodes=None, replace=False, noExpand=False, clear=False):
+        self.selected = [] if clear else list(nodes)
+
+    def file(self, path, exportSelected=False, i=False, returnNewNodes=False, **flags):
+        if exportSelected:
+            self.exported.append(list(self.selected))
+            Path(path).write_text("//Maya ASCII network", encoding="utf-8")
+            return path
+        # Imported nodes that clash are prefixed with the file name, like Maya does
+        self.imports += 1
+        new_nodes = []
+        for node in self.network:
+            name = f"{Path(path).stem}_{node}" if node in self.types else node
+            self.types[name] = "lambert" if node == "paint" else "file"
+            self.attributes[name] = {"outColor"}
+            new_nodes.append(name)
+        return new_nodes
+
+    def sets(self, targets=None, name="", edit=False, forceElement=None, **flags):
+        if edit:
+            self.members.setdefault(forceElement, []).extend(targets)
+            return None
+        self.types[name] = "shadingEngine"
+        return name
+
+    def connectAttr(self, source, destination, force=False):
+        self.connections[destination] = source.split(".")[0]
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[node].add(longName)
+
+    def setAttr(self, plug, value, type=None):
+        self.values[plug] = value
+
+    def getAttr(self, plug):
+        return self.values.get(plug)
+
+
+def test_save_material_writes_descriptor_and_network():
+    """Saving exports the surface network only and describes it next to the asset"""
+    from src.services.material_service_impl import MaterialService
+
+    cmds = FakeCmds()
+    service = MaterialService()
+    materials = Path(tempfile.mkdtemp(prefix="assetManager_materials_")) / "materials"
+
+    engines = service.find_shading_engines(cmds, ["|car", "|ground", "paintSG"])
+    assert engines == ["paintSG"]  # The default group is never a preset
+    assert service.get_network_roots(cmds, "paintSG") == {"surfaceShader": "paint"}
+
+    descriptor_path = service.save_material(
+        cmds, "paintSG", materials / "red_car_paint", notes="Glossy red"
+    )
+    assert descriptor_path == materials / "red_car_paint.material"
+    assert cmds.exported == [["paint"]] and cmds.selected == []
+    assert (materials / ".networks" / "red_car_paint.ma").exists()
+
+    descriptor = json.loads(descriptor_path.read_text(encoding="utf-8"))
+    assert descriptor["type"] == "material" and descriptor["notes"] == "Glossy red"
+    assert (descriptor["shader"], descriptor["shader_type"]) == ("paint", "lambert")
+    assert descriptor["renderer"] == "maya" and descriptor["slots"] == {"surfaceShader": "paint"}
+    assert descriptor["network"] == ".networks/red_car_paint.ma"
+    assert descriptor["materialx"] == ""  # Only Standard Surface networks have one
+    assert service.load_material(descriptor_path)["name"] == "red_car_paint"
+
+    assert service.save_material(cmds, "emptySG", materials / "empty") is None
+    assert not (materials / "empty.material").exists()
+
+
+def test_import_binds_the_clash_renamed_shader():
+    """A renamed shader is found by its file-name prefix, never by a longer name"""
+    from src.services.material_service_impl import PRESET_ATTRIBUTE, MaterialService
+
+    cmds = FakeCmds()
+    service = MaterialService()
+    materials = Path(tempfile.mkdtemp(prefix="assetManager_materials_")) / "materials"
+    descriptor_path = service.save_material(cmds, "paintSG", materials / "red_car_paint")
+
+    # The scene still has paint and flakespaint, so both come in prefixed
+    engine = service.import_material(cmds, descriptor_path)
+    assert engine == "red_car_paint_paintSG"
+    assert cmds.connections[f"{engine}.surfaceShader"] == "red_car_paint_paint"
+    plug = f"red_car_paint_paint.{PRESET_ATTRIBUTE}"
+    assert cmds.values[plug] == descriptor_path.as_posix()
+    assert service.find_imported_material(cmds, descriptor_path) == engine
+
+    # Only flakespaint clashes, so the shader keeps its name
+    cmds = FakeCmds()
+    del cmds.types["paint"]
+    engine = service.import_material(cmds, descriptor_path)
+    assert cmds.connections[f"{engine}.surfaceShader"] == "paint"
+
+    # A network that lost its shader is not bound to a node that only ends like it
+    cmds = FakeCmds()
+    cmds.network = ["flakespaint"]
+    del cmds.types["flakespaint"]
+    assert service.import_material(cmds, descriptor_path) is None
+
+    assert service.import_material(cmds, materials / "missing.material") is None
+
+
+def test_assign_material_reuses_the_imported_preset():
+    """Assigning imports the preset once and adds meshes and faces to its group"""
+    from src.services.material_service_impl import MaterialService
+
+    cmds = FakeCmds()
+    service = MaterialService()
+    materials = Path(tempfile.mkdtemp(prefix="assetManager_materials_")) / "materials"
+    descriptor_path = service.save_material(cmds, "paintSG", materials / "red_car_paint")
+
+    targets = service.get_assignable_targets(cmds, ["|car", "|ground|groundShape.f[0:3]"])
+    assert targets == ["|car", "|ground|groundShape.f[0:3]"]
+    assert service.get_assignable_targets(cmds, ["paint"]) == []
+
+    engine = service.assign_material(cmds, descriptor_path, targets)
+    assert engine == "red_car_paint_paintSG" and cmds.imports == 1
+    assert service.assign_material(cmds, descriptor_path, ["|ground"]) == engine
+    assert cmds.imports == 1  # Already in the scene, so it is not imported again
+    assert cmds.members[engine] == ["|car", "|ground|groundShape.f[0:3]", "|ground"]
@@ -0,0 +1,187 @@
+"""
+Test suite for MaterialX material exchange
+
+Validates writing a Standard Surface network with file textures and a normal map as
+a MaterialX document, and rebuilding Maya networks from written and Mari-style
+documents, against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+import xml.etree.ElementTree as ET
+from pathlib import Path
+
+
+class FakeCmds:
+    """Scene nodes with attribute values and connections, recording new nodes"""
+
+    def __init__(self, types=None, values=None, connections=None):
+        self.types = dict(types or {})
+        self.values = dict(values or {})
+        self.connections = dict(connections or {})  # destination plug -> source plug
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def attributeQuery(self, attribute, node=None, exists=False):
+        plug = f"{node}.{attribute}"
+        return plug in self.values or plug in self.connections
+
+    def listConnections(self, plug, source=False, destination=False, plugs=False):
+        source_plug = self.connections.get(plug)
+        if source_plug is None:
+            return None
+        return [source_plug if plugs else source_plug.split(".")[0]]
+
+    def getAttr(self, plug):
+        return self.values.get(plug)
+
+    def loadPlugin(self, name, quiet=False):
+        raise RuntimeError(f"{name} is not installed")
+
+    def shadingNode(self, node_type, name="", **flags):
+        self.types[name] = node_type
+        return name
+
+    def sets(self, renderable=False, noSurfaceShader=False, empty=False, name=""):
+        self.types[name] = "shadingEngine"
+        return name
+
+    def connectAttr(self, source, destination, force=False):
+        self.connections[destination] = source
+
+    def setAttr(self, plug, *values, type=None):
+        self.values[plug] = values[0] if len(values) == 1 else values
+
+
+def test_write_standard_surface_document():
+    """Values, textures, and normal maps are written; other upstream nodes are reported"""
+    from src.services.materialx_service_impl import MaterialXService
+
+    networks = Path(tempfile.mkdtemp(prefix="assetManager_materialx_")) / ".networks"
+    textures = networks / ".dependencies" / "car_paint" / "textures"
+    cmds = FakeCmds(
+        types={
+            "car_paint": "aiStandardSurface",
+            "paint_col": "file",
+            "paint_nrm": "file",
+            "paint_nrm_normal": "aiNormalMap",
+            "flakes_noise": "aiNoise",
+        },
+        values={
+            "car_paint.base": 1.0,
+            "car_paint.specularRoughness": 0.3,
+            "car_paint.specularColor": [(1.0, 0.9, 0.8)],
+            "car_paint.thinWalled": False,
+            "paint_col.fileTextureName": (textures / "paint_col.1001.png").as_posix(),
+            "paint_col.uvTilingMode": 3,
+            "paint_col.colorSpace": "sRGB",
+            "paint_nrm.fileTextureName": (textures / "paint_nrm.png").as_posix(),
+            "paint_nrm.uvTilingMode": 0,
+            "paint_nrm.colorSpace": "Raw",
+        },
+        connections={
+            "car_paint.baseColor": "paint_col.outColor",
+            "car_paint.metalness": "flakes_noise.outAlpha",
+            "car_paint.normalCamera": "paint_nrm_normal.outValue",
+            "paint_nrm_normal.input": "paint_nrm.outColor",
+        },
+    )
+    cmds.values["car_paint.metalness"] = 0.8
+
+    mtlx_path = networks / "car_paint.mtlx"
+    result = MaterialXService().write_document(cmds, "car_paint", mtlx_path)
+    assert result.material == "car_paint" and result.shader == "SR_car_paint"
+    assert result.unsupported == ["metalness <- flakes_noise.outAlpha"]
+    assert result.textures == [
+        ".dependencies/car_paint/textures/paint_col.<UDIM>.png",
+        ".dependencies/car_paint/textures/paint_nrm.png",
+    ]
+
+    document = ET.parse(str(mtlx_path)).getroot()
+    inputs = {
+        element.get("name"): element.attrib for element in document.find("standard_surface")
+    }
+    assert inputs["base_color"]["nodename"] == "paint_col"
+    assert inputs["specular_roughness"]["value"] == "0.3"
+    assert inputs["specular_color"]["value"] == "1, 0.9, 0.8"
+    assert inputs["thin_walled"]["value"] == "false"
+    assert inputs["metalness"]["value"] == "0.8"
+    assert inputs["normal"]["nodename"] == "paint_nrm_vector3_normalmap"
+    color_file = document.find("image[@name='paint_col']/input")
+    assert color_file.get("colorspace") == "srgb_texture"
+    assert document.find("image[@name='paint_nrm_vector3']/input").get("colorspace") is None
+    material = document.find("surfacematerial")
+    assert material.get("name") == "car_paint"
+    assert material.find("input").get("nodename") == "SR_car_paint"
+
+
+def test_import_documents_as_standard_surface_networks():
+    """Images become file nodes with colorspaces; node graphs and fileprefix resolve"""
+    from src.services.materialx_service_impl import MaterialXService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_materialx_"))
+    mari = library / "mari" / "hull.mtlx"
+    mari.parent.mkdir()
+    mari.write_text(
+        '<?xml version="1.0"?>\n'
+        '<materialx version="1.38" colorspace="lin_rec709">\n'
+        '  <nodegraph name="NG_hull" fileprefix="textures/">\n'
+        '    <image name="hull_col" type="color3">\n'
+        '      <input name="file" type="filename" value="hull_BaseColor.&lt;UDIM&gt;.exr"'
+        ' colorspace="acescg" />\n'
+        "    </image>\n"
+        '    <image name="hull_rgh" type="float">\n'
+        '      <input name="file" type="filename" value="hull_Roughness.&lt;UDIM&gt;.exr" />\n'
+        "    </image>\n"
+        '    <image name="hull_nrm" type="vector3">\n'
+        '      <input name="file" type="filename" value="hull_Normal.exr" />\n'
+        "    </image>\n"
+        '    <normalmap name="hull_normalmap" type="vector3">\n'
+        '      <input name="in" type="vector3" nodename="hull_nrm" />\n'
+        "    </normalmap>\n"
+        '    <multiply name="dirt" type="float" />\n'
+        '    <output name="base_color_out" type="color3" nodename="hull_col" />\n'
+        '    <output name="roughness_out" type="float" nodename="hull_rgh" />\n'
+        '    <output name="normal_out" type="vector3" nodename="hull_normalmap" />\n'
+        '    <output name="metal_out" type="float" nodename="dirt" />\n'
+        "  </nodegraph>\n"
+        '  <standard_surface name="SR_hull" type="surfaceshader">\n'
+        '    <input name="base_color" type="color3" nodegraph="NG_hull"'
+        ' output="base_color_out" />\n'
+        '    <input name="specular_roughness" type="float" nodegraph="NG_hull"'
+        ' output="roughness_out" />\n'
+        '    <input name="normal" type="vector3" nodegraph="NG_hull" output="normal_out" />\n'
+        '    <input name="metalness" type="float" nodegraph="NG_hull" output="metal_out" />\n'
+        '    <input name="coat" type="float" value="0.25" />\n'
+        '    <input name="emission_color" type="color3" value="1, 0.5, 0" />\n'
+        "  </standard_surface>\n"
+        '  <surfacematerial name="hull" type="material">\n'
+        '    <input name="surfaceshader" type="surfaceshader" nodename="SR_hull" />\n'
+        "  </surfacematerial>\n"
+        "</materialx>\n"
+    )
+    service = MaterialXService()
+    assert service.list_materials(mari) == ["hull"]
+
+    # Without mtoa the network is built on Maya's standardSurface
+    cmds = FakeCmds()
+    result = service.import_document(cmds, mari)
+    assert result.material == "hullSG" and cmds.types["hull"] == "standardSurface"
+    assert cmds.connections["hullSG.surfaceShader"] == "hull.outColor"
+    assert cmds.connections["hull.baseColor"] == "hull_col.outColor"
+    assert cmds.connections["hull.specularRoughness"] == "hull_rgh.outAlpha"
+    assert cmds.connections["hull.normalCamera"] == "hull_nrm_bump.outNormal"
+    assert cmds.values["hull.coat"] == 0.25
+    assert cmds.values["hull.emissionColor"] == (1.0, 0.5, 0.0)
+    assert result.unsupported == ["metalness <- multiply"]
+    assert sorted(result.textures) == ["hull_col", "hull_nrm", "hull_rgh"]
+    textures = (library / "mari" / "textures").as_posix()
+    assert cmds.values["hull_col.fileTextureName"] == f"{textures}/hull_BaseColor.<UDIM>.exr"
+    assert cmds.values["hull_col.colorSpace"] == "ACEScg"
+    assert cmds.values["hull_col.uvTilingMode"] == 3
+    assert cmds.values["hull_rgh.colorSpace"] == "Raw"
+    assert cmds.values["hull_nrm.uvTilingMode"] == 0
+    assert service.import_document(cmds, mari, "missing") is None
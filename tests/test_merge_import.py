@@ -0,0 +1,377 @@
+"""
+Test suite for updating imported assets in place
+
+Validates reading node UUIDs from Maya ASCII files, pairing a new version's nodes
+with an imported copy, and transferring shapes and shading while the scene's nodes
+stay.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """DAG and dependency nodes kept by UUID, so renames and reparents follow them"""
+
+    def __init__(self):
+        self.nodes = {}  # uuid -> {name, type, parent, intermediate, mesh}
+        self.connections = []  # (source uuid, attribute, destination uuid, attribute)
+        self.members = {}  # shading group uuid -> [(uuid, component)]
+        self.namespaces = []
+        self.on_import = None  # Creates the nodes of cmds.file(i=True) in a namespace
+        self._counter = 0
+
+    # Helpers
+    def create(self, name, node_type="transform", parent=None, uuid=None, **values):
+        self._counter += 1
+        uuid = uuid or f"UUID-{self._counter}"
+        self.nodes[uuid] = {
+            "name": name.lstrip("|"),
+            "type": node_type,
+            "parent": self.find(parent) if parent else None,
+            "intermediate": values.get("intermediate", False),
+            "mesh": values.get("mesh"),
+        }
+        if node_type == "shadingEngine":
+            self.members[uuid] = []
+        return self.path(uuid)
+
+    def is_dag(self, uuid):
+        return self.nodes[uuid]["type"] in ("transform", "mesh")
+
+    def path(self, uuid):
+        if not self.is_dag(uuid):
+            return self.nodes[uuid]["name"]
+        names = []
+        while uuid:
+            names.append(self.nodes[uuid]["name"])
+            uuid = self.nodes[uuid]["parent"]
+        return "|" + "|".join(reversed(names))
+
+    def find(self, name):
+        if name in self.nodes:
+            return name
+        for uuid in self.nodes:
+            if self.path(uuid) == name:
+                return uuid
+        same_name = [uuid for uuid, node in self.nodes.items() if node["name"] == name]
+        return same_name[0] if len(same_name) == 1 else None
+
+    def children(self, uuid):
+        return [child for child, node in self.nodes.items() if node["parent"] == uuid]
+
+    def mesh_of(self, name):
+        return self.nodes[self.find(name)]["mesh"]
+
+    def assigned(self, group):
+        return sorted(self.path(uuid) for uuid, _ in self.members[self.find(group)])
+
+    # Nodes
+    def ls(self, *args, long=False, type=None, uuid=False):
+        names = args[0] if args else []
+        if isinstance(names, str) and names.endswith(":*"):
+            names = [u for u, node in self.nodes.items() if node["name"].startswith(names[:-1])]
+        elif isinstance(names, str):
+            names = [names]
+        found = []
+        for name in names:
+            node, dot, component = name.partition(".")
+            found_uuid = self.find(node)
+            if found_uuid is None or (type and self.nodes[found_uuid]["type"] != type):
+                continue
+            found.append(found_uuid if uuid else self.path(found_uuid) + dot + component)
+        return found
+
+    def objExists(self, name):
+        return self.find(name) is not None
+
+    def nodeType(self, name):
+        return self.nodes[self.find(name)]["type"]
+
+    def getAttr(self, plug):
+        return self.nodes[self.find(plug.split(".")[0])]["intermediate"]
+
+    def listRelatives(self, node, parent=False, allDescendents=False, shapes=False, **kwargs):
+        uuid = self.find(node)
+        if parent:
+            parent_uuid = self.nodes[uuid]["parent"]
+            return [self.path(parent_uuid)] if parent_uuid else None
+        found = []
+        if allDescendents:
+            pending = self.children(uuid)
+            while pending:
+                child = pending.pop(0)
+                found.append(child)
+                pending.extend(self.children(child))
+        else:
+            found = self.children(uuid)
+        if kwargs.get("type"):
+            found = [child for child in found if self.nodes[child]["type"] == kwargs["type"]]
+        if kwargs.get("noIntermediate"):
+            found = [child for child in found if not self.nodes[child]["intermediate"]]
+        return [self.path(child) for child in found] or None
+
+    def parent(self, node, target):
+        uuid = self.find(node)
+        self.nodes[uuid]["parent"] = self.find(target)
+        return [self.nodes[uuid]["name"]]
+
+    def delete(self, names):
+        doomed = set()
+        for name in [names] if isinstance(names, str) else names:
+            pending = [self.find(name)]
+            while pending:
+                uuid = pending.pop()
+                doomed.add(uuid)
+                pending.extend(self.children(uuid))
+        for uuid in doomed:
+            self.nodes.pop(uuid, None)
+            self.members.pop(uuid, None)
+        self.connections = [c for c in self.connections if not doomed & {c[0], c[2]}]
+        for group, members in self.members.items():
+            self.members[group] = [member for member in members if member[0] not in doomed]
+
+    # Connections
+    def _split(self, plug):
+        node, attribute = plug.split(".", 1)
+        return self.find(node), attribute
+
+    def connectAttr(self, source_plug, destination_plug, force=False):
+        destination = self._split(destination_plug)
+        self.connections = [c for c in self.connections if (c[2], c[3]) != destination]
+        self.connections.append(self._split(source_plug) + destination)
+
+    def disconnectAttr(self, source_plug, destination_plug):
+        connection = self._split(source_plug) + self._split(destination_plug)
+        self.connections.remove(connection)
+
+    def listConnections(self, target, source=True, destination=True, type=None):
+        if type == "shadingEngine":
+            uuid = self.find(target)
+            transform = self.nodes[uuid]["parent"]
+            groups = [
+                group
+                for group, members in self.members.items()
+                if any(m == (uuid, None) or (m[0] == transform and m[1]) for m in members)
+            ]
+            return [self.path(group) for group in groups] or None
+        uuid, attribute = self._split(target)
+        found = [c[0] for c in self.connections if (c[2], c[3]) == (uuid, attribute)]
+        return [self.path(node) for node in found] or None
+
+    def listHistory(self, node):
+        history, pending = [], [self.find(node)]
+        while pending:
+            uuid = pending.pop()
+            for source, _, destination, _ in self.connections:
+                if destination == uuid and source not in history:
+                    history.append(source)
+                    pending.append(source)
+        return [self.path(uuid) for uuid in history]
+
+    def dgeval(self, plug):
+        uuid, _ = self._split(plug)
+        for source, _, destination, attribute in self.connections:
+            if destination == uuid and attribute == "inMesh":
+                self.nodes[uuid]["mesh"] = self.nodes[source]["mesh"]
+
+    def polyEvaluate(self, shape, vertex=False, face=False):
+        vertices, faces, _ = self.mesh_of(shape)
+        return vertices if vertex else faces
+
+    def polyTransfer(self, *args, **kwargs):
+        raise AssertionError("Shapes carry their UVs along")
+
+    def sets(self, *args, query=False, edit=False, forceElement=None):
+        if query:
+            members = self.members[self.find(args[0])]
+            found = [self.path(uuid) + (f".{c}" if c else "") for uuid, c in members]
+            return found or None
+        group = self.find(forceElement)
+        for member in args[0]:
+            node, _, component = member.partition(".")
+            entry = (self.find(node), component or None)
+            for members in self.members.values():
+                if entry in members:
+                    members.remove(entry)
+            self.members[group].append(entry)
+
+    # Namespaces and files
+    def namespaceInfo(self, *args, **kwargs):
+        return [f":{namespace}" for namespace in self.namespaces]
+
+    def namespace(self, exists=None, moveNamespace=None, removeNamespace=None, **kwargs):
+        if exists is not None:
+            return exists.lstrip(":") in self.namespaces
+        if moveNamespace:
+            prefix = moveNamespace[0].lstrip(":") + ":"
+            for uuid, node in self.nodes.items():
+                if node["name"].startswith(prefix):
+                    name = node["name"][len(prefix) :]
+                    while name in [other["name"] for other in self.nodes.values()]:
+                        name += "1"
+                    node["name"] = name
+        if removeNamespace:
+            self.namespaces.remove(removeNamespace.lstrip(":"))
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def file(self, path, i=False, type=None, namespace="", returnNewNodes=False):
+        self.namespaces.append(namespace)
+        return self.on_import(namespace)
+
+
+def test_file_uuids_and_node_matching():
+    """UUIDs pair renamed nodes; names pair the rest; unpaired nodes are added or missing"""
+    from src.core.models.merge_import import MergeImportOptions, MergeImportReport
+    from src.services.merge_import_service_impl import (
+        MergeImportService,
+        match_nodes,
+        strip_namespaces,
+    )
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_merge_import_"))
+    asset_file = folder / "crate.ma"
+    asset_file.write_text(
+        "//Maya ASCII 2024 scene\n"
+        'createNode transform -n "crate_grp";\n'
+        '\trename -uid "G-1";\n'
+        'createNode transform -n "geo" -p "crate_grp";\n'
+        'createNode transform -n "top" -p "|crate_grp|geo";\n'
+        '\trename -uid "L-1";\n'
+        'createNode mesh -n "topShape" -p "top";\n'
+        '\trename -uid "LS-1";\n'
+        'createNode lambert -n "src:wood";\n'
+        '\trename -uid "W-1";\n'
+    )
+    uuids = MergeImportService().read_ascii_uuids(asset_file)
+    assert uuids == {
+        "crate_grp": "G-1",
+        "crate_grp|geo|top": "L-1",
+        "crate_grp|geo|top|topShape": "LS-1",
+        "wood": "W-1",
+    }
+    assert strip_namespaces("|a:crate_grp|a:b:geo") == "|crate_grp|geo"
+
+    scene_nodes = {
+        "hero_crate": "",  # Renamed root, no UUID in common
+        "hero_crate|geo": "",
+        "hero_crate|geo|lid": "L-1",  # Renamed in the new version, same node
+        "hero_crate|geo|body": "",
+        "hero_crate|strap": "",  # Moved out of geo by the artist
+        "hero_crate|handle": "",  # Gone from the new version
+    }
+    new_nodes = {
+        "crate_grp": "G-2",
+        "crate_grp|geo": "",
+        "crate_grp|geo|top": "L-1",
+        "crate_grp|geo|body": "",
+        "crate_grp|geo|strap": "",
+        "crate_grp|bolts": "",  # Added, together with its children
+        "crate_grp|bolts|bolt": "",
+    }
+    matched = match_nodes(scene_nodes, new_nodes)
+    assert matched == {
+        "crate_grp": "hero_crate",
+        "crate_grp|geo": "hero_crate|geo",
+        "crate_grp|geo|top": "hero_crate|geo|lid",
+        "crate_grp|geo|body": "hero_crate|geo|body",
+        "crate_grp|geo|strap": "hero_crate|strap",
+    }
+
+    report = MergeImportReport(asset_file, updated=("a", "b"), skipped=("c: topology",))
+    assert report.changed and report.summary() == "2 shape(s), 1 skipped"
+    assert MergeImportReport(asset_file).summary() == "nothing changed"
+    try:
+        MergeImportOptions(shapes=False, uvs=False, shading=False, add_new=False)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Options that transfer nothing should be rejected")
+
+
+def test_merge_keeps_scene_nodes():
+    """Shapes go into the scene's (Orig) shapes, shading groups are reused, new nodes join"""
+    from src.services.merge_import_service_impl import MergeImportService
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_merge_import_"))
+    asset_file = folder / "crate.ma"
+    asset_file.write_text(
+        'createNode transform -n "crate_grp";\n'
+        '\trename -uid "G";\n'
+        'createNode transform -n "top" -p "crate_grp";\n'
+        '\trename -uid "L";\n'
+    )
+
+    cmds = FakeCmds()
+    cmds.create("|crate_grp", uuid="G")
+    cmds.create("body", parent="|crate_grp", uuid="B")
+    cmds.create("bodyShape", "mesh", "|crate_grp|body", mesh=(8, 6, "v1"))
+    cmds.create("lid", parent="|crate_grp", uuid="L")
+    cmds.create("lidShape", "mesh", "|crate_grp|lid", mesh=(8, 6, "v1 skinned"))
+    cmds.create("lidShapeOrig", "mesh", "|crate_grp|lid", mesh=(8, 6, "v1"), intermediate=True)
+    cmds.create("strap", parent="|crate_grp")
+    cmds.create("strapShape", "mesh", "|crate_grp|strap", mesh=(8, 6, "v1 skinned"))
+    cmds.create(
+        "strapShapeOrig", "mesh", "|crate_grp|strap", mesh=(8, 6, "v1"), intermediate=True
+    )
+    cmds.create("handle", parent="|crate_grp")
+    cmds.create("skinCluster1", "skinCluster")
+    cmds.create("tweak1", "tweak")
+    cmds.create("parentConstraint1", "parentConstraint")
+    cmds.connectAttr("|crate_grp|lid|lidShapeOrig.outMesh", "skinCluster1.input")
+    cmds.connectAttr("skinCluster1.outputGeometry", "|crate_grp|lid|lidShape.inMesh")
+    cmds.connectAttr("|crate_grp|strap|strapShapeOrig.outMesh", "tweak1.input")
+    cmds.connectAttr("tweak1.outputGeometry", "|crate_grp|strap|strapShape.inMesh")
+    cmds.connectAttr("|crate_grp|body.translate", "parentConstraint1.target")
+    cmds.create("wood", "lambert")
+    cmds.create("woodSG", "shadingEngine")
+    cmds.connectAttr("wood.outColor", "woodSG.surfaceShader")
+    cmds.sets(["|crate_grp|body|bodyShape", "|crate_grp|lid|lidShape"], forceElement="woodSG")
+
+    def importer(namespace):
+        """The new version: body reshaped, lid renamed top and shaded metal, a bolt added"""
+        ns = f"{namespace}:"
+        cmds.create(f"|{ns}crate_grp")
+        meshes = {"body": (10, 8, "v2"), "top": (8, 6, "v2"), "strap": (12, 10, "v2")}
+        for name, mesh in meshes.items():
+            cmds.create(f"{ns}{name}", parent=f"|{ns}crate_grp")
+            cmds.create(f"{ns}{name}Shape", "mesh", f"|{ns}crate_grp|{ns}{name}", mesh=mesh)
+        cmds.create(f"{ns}bolt", parent=f"|{ns}crate_grp")
+        cmds.create(f"{ns}boltShape", "mesh", f"|{ns}crate_grp|{ns}bolt", mesh=(4, 2, "v2"))
+        for material in ("wood", "metal"):
+            cmds.create(f"{ns}{material}", "lambert")
+            cmds.create(f"{ns}{material}SG", "shadingEngine")
+            cmds.connectAttr(f"{ns}{material}.outColor", f"{ns}{material}SG.surfaceShader")
+        cmds.sets(
+            [f"{ns}bodyShape", f"{ns}strapShape", f"{ns}boltShape"], forceElement=f"{ns}woodSG"
+        )
+        cmds.sets([f"{ns}topShape"], forceElement=f"{ns}metalSG")
+        return [cmds.path(uuid) for uuid, node in cmds.nodes.items() if ns in node["name"]]
+
+    cmds.on_import = importer
+    report = MergeImportService().merge(cmds, ["|crate_grp"], asset_file)
+    assert report.updated == ("crate_grp|body", "crate_grp|lid")
+    assert report.reassigned == ("crate_grp|lid",)
+    assert report.skipped == ("crate_grp|strap: topology changed under a deformer",)
+    assert report.added == ("crate_grp|bolt",) and report.missing == ("crate_grp|handle",)
+    assert report.roots == ("|crate_grp",)
+
+    # The scene's nodes stay, with their connections; only their mesh data changed
+    assert cmds.ls("|crate_grp|body", uuid=True) == ["B"]
+    assert cmds.mesh_of("bodyShape") == (10, 8, "v2")
+    assert cmds.mesh_of("lidShapeOrig") == (8, 6, "v2")
+    assert cmds.mesh_of("strapShapeOrig") == (8, 6, "v1")
+    assert cmds.listConnections("|crate_grp|lid|lidShape.inMesh") == ["skinCluster1"]
+    assert cmds.listConnections("parentConstraint1.target") == ["|crate_grp|body"]
+
+    # The scene's wood is reused; the new metal comes in; the import is gone
+    assert cmds.assigned("woodSG") == ["|crate_grp|body|bodyShape", "|crate_grp|bolt|boltShape"]
+    assert cmds.assigned("metalSG") == ["|crate_grp|lid|lidShape"]
+    names = sorted(node["name"] for node in cmds.nodes.values())
+    assert names.count("wood") == 1 and "woodSG1" not in names and "metal" in names
+    assert not [name for name in names if ":" in name] and cmds.namespaces == []
@@ -0,0 +1,82 @@
+"""
+Test suite for the SQLite library metadata database
+
+Validates tag storage, JSON sidecar migration, collections, and usage stats.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+def _make_library() -> Path:
+    """Create an empty temporary library root"""
+    return Path(tempfile.mkdtemp(prefix="assetManager_metadata_db_"))
+
+
+def test_save_and_load_asset_metadata():
+    """Tags and flags round-trip through the database keyed by relative path"""
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = _make_library()
+    database = MetadataDatabase(root)
+    asset_file = root / "assets" / "scenes" / "car.ma"
+
+    database.save_asset_metadata(
+        asset_file, {"tags": ["vehicle", "hero"], "is_favorite": True, "category": "props"}
+    )
+    metadata = database.get_asset_metadata(asset_file)
+
+    assert database.get_asset_key(asset_file) == "assets/scenes/car.ma"
+    assert metadata["tags"] == ["vehicle", "hero"]
+    assert metadata["is_favorite"] is True
+    assert metadata["category"] == "props"
+    assert database.find_assets_by_tag("VEHICLE") == ["assets/scenes/car.ma"]
+
+    database.save_asset_metadata(asset_file, {"tags": ["hero"]})
+    assert database.get_all_tags() == {"hero": 1}
+    database.close()
+
+
+def test_migrate_from_json_sidecars_once():
+    """Existing .meta files are imported once; hidden folders are skipped"""
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = _make_library()
+    scenes = root / "assets" / "scenes"
+    (scenes / ".versions" / "car").mkdir(parents=True)
+    (scenes / "car.ma.meta").write_text(json.dumps({"tags": ["vehicle"]}), encoding="utf-8")
+    (scenes / ".versions" / "car" / "car_v001.ma.meta").write_text("{}", encoding="utf-8")
+
+    database = MetadataDatabase(root)
+    assert database.migrate_from_json() == 1
+    assert database.migrate_from_json() == 0
+    assert database.get_asset_metadata(scenes / "car.ma")["tags"] == ["vehicle"]
+    assert (scenes / "car.ma.meta").exists()
+    database.close()
+
+
+def test_collections_and_usage_stats():
+    """Collections keep member order; imports feed the most-used list"""
+    from src.services.metadata_database_impl import MetadataDatabase
+
+    root = _make_library()
+    database = MetadataDatabase(root)
+    scenes = root / "assets" / "scenes"
+
+    database.save_collection("Cars", {"assets": ["sedan", "truck"], "description": "Rides"})
+    assert database.get_collections() == {
+        "Cars": {"assets": ["sedan", "truck"], "description": "Rides"}
+    }
+    database.delete_collection("Cars")
+    assert database.get_collections() == {}
+
+    database.record_access(scenes / "truck.ma")
+    database.record_access(scenes / "truck.ma")
+    database.record_access(scenes / "sedan.ma")
+    assert database.get_most_used(limit=1) == ["assets/scenes/truck.ma"]
+    assert database.get_asset_metadata(scenes / "truck.ma")["access_count"] == 2
+    database.close()
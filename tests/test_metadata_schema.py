@@ -0,0 +1,117 @@
+"""
+Test suite for custom metadata schemas
+
+Validates loading library schema files, typing entered values, and filtering
+search results on custom fields.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def _write_schema(library: Path, fields) -> None:
+    schema_file = library / ".assetmanager" / "metadata_schema.json"
+    schema_file.parent.mkdir(parents=True, exist_ok=True)
+    schema_file.write_text(json.dumps({"fields": fields}))
+
+
+def test_schema_and_values():
+    """Invalid fields are skipped; values are typed to the field or rejected"""
+    from src.services.metadata_schema_service_impl import MetadataSchemaService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_schema_"))
+    service = MetadataSchemaService()
+    assert service.load_schema(library) == []
+
+    _write_schema(
+        library,
+        [
+            {"name": "Approved by"},
+            {"name": "Polycount budget", "type": "number"},
+            {"name": "Franchise", "type": "enum", "options": ["Racers", "Pirates"]},
+            {"name": "Game ready", "type": "boolean"},
+            {"name": "Broken", "type": "enum"},
+            {"name": "Rating", "type": "stars"},
+            {"name": "approved-by"},
+        ],
+    )
+    fields = {field.name: field for field in service.load_schema(library)}
+    assert list(fields) == ["Approved by", "Polycount budget", "Franchise", "Game ready"]
+    assert fields["Polycount budget"].key == "polycount_budget"
+
+    values = service.set_value({}, fields["Polycount budget"], "4500.0")
+    values = service.set_value(values, fields["Franchise"], "pirates")
+    values = service.set_value(values, fields["Game ready"], "yes")
+    values = service.set_value(values, fields["Approved by"], "  kim ")
+    assert values == {
+        "Polycount budget": 4500,
+        "Franchise": "Pirates",
+        "Game ready": True,
+        "Approved by": "kim",
+    }
+    assert "Approved by" not in service.set_value(values, fields["Approved by"], "")
+    for name, bad_value in (("Polycount budget", "lots"), ("Franchise", "Ninjas")):
+        try:
+            service.set_value(values, fields[name], bad_value)
+        except ValueError:
+            continue
+        raise AssertionError(f"{bad_value} should be rejected for {name}")
+
+    assert service.save_schema(library, list(fields.values()))
+    assert service.load_schema(library) == list(fields.values())
+    assert service.get_values({"tags": [], "fields": values}) == values
+
+
+def test_search_custom_fields():
+    """Custom fields filter by value, number range, and yes/no"""
+    from src.core.models.metadata_field import MetadataField
+    from src.services.search_engine_impl import SearchIndex
+
+    def asset(name, **values):
+        return SimpleNamespace(
+            name=name,
+            display_name=name,
+            file_path=Path("/library/assets/scenes") / f"{name}.ma",
+            file_extension=".ma",
+            asset_type="maya_scene",
+            category="general",
+            tags=[],
+            metadata={"fields": values},
+            modified_date=None,
+            is_favorite=False,
+        )
+
+    fields = [
+        MetadataField("Approved by"),
+        MetadataField("Polycount budget", "number"),
+        MetadataField("Franchise", "enum", ("Racers", "Pirates")),
+        MetadataField("Game ready", "boolean"),
+    ]
+    index = SearchIndex()
+    index.build(
+        [
+            asset("ship", **{"Franchise": "Pirates", "Polycount budget": 12000}),
+            asset("kart", **{"Franchise": "Racers", "Polycount budget": 3000, "Game ready": True}),
+            asset("barrel", **{"Approved by": "Kim Lee", "Polycount budget": 500}),
+        ],
+        fields=fields,
+    )
+
+    def names(query):
+        return [result.name for result in index.search(query)]
+
+    assert names("franchise:pirates") == ["ship"]
+    assert names("polycount_budget:<5000") == ["kart", "barrel"]
+    assert names("polycount_budget:1000..12000") == ["ship", "kart"]
+    assert names("polycount_budget:lots") == []
+    assert names("game_ready:yes") == ["kart"]
+    assert names("game_ready:no -franchise:pirates") == ["barrel"]
+    assert names('approved_by:"kim lee"') == ["barrel"]
+    assert names("approved_by:k*") == ["barrel"]
+    assert names("racers") == ["kart"]
+    assert names("kart:racers") == ["kart"]  # Not a field - plain words
@@ -0,0 +1,162 @@
+"""
+Test suite for placeholders of missing referenced assets
+
+Validates finding references whose files are missing, standing a box the size of the
+asset in for them, and resolving them by loading another version, repathing, or
+removing them.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+
+from tests.test_scene_assets import FakeCmds, _make_library, _make_service
+
+
+class PlaceholderCmds(FakeCmds):
+    """Scene with curves, multi-value attributes, and unloaded references with edits"""
+
+    def __init__(self):
+        super().__init__()
+        self.edits = {}  # reference node -> setAttr edit strings
+        self.curves = {}  # curve -> points
+
+    def curve(self, degree, point, name):
+        node = self.createNode("transform", name)
+        self.curves[node] = point
+        return node
+
+    def setAttr(self, plug, *values, type=None):
+        node, attribute = plug.rsplit(".", 1)
+        self.attributes[node][attribute] = values[0] if len(values) == 1 else values
+
+    def objExists(self, node):
+        return node in self.attributes or node in self.references
+
+    def referenceQuery(self, target, **kwargs):
+        if kwargs.get("editStrings"):
+            return self.edits.get(target, [])
+        if kwargs.get("isLoaded"):
+            return self.references[target][0].endswith("loaded.ma")
+        return super().referenceQuery(target, **kwargs)
+
+
+def _make_missing_service(versions, database):
+    from src.services.missing_asset_service_impl import MissingAssetService
+    from src.services.reference_update_service_impl import ReferenceUpdateService
+
+    scene_asset_service = _make_service(versions)
+    return scene_asset_service, MissingAssetService(
+        scene_asset_service=scene_asset_service,
+        version_service=versions,
+        reference_update_service=ReferenceUpdateService(version_service=versions),
+        database_factory=lambda _root: database,
+    )
+
+
+def test_placeholders_stand_in_for_missing_references():
+    """Missing references get one sized, placed box carrying the asset's metadata"""
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.missing_asset_service_impl import INFO_ATTRIBUTE, REFERENCE_ATTRIBUTE
+
+    library, crate, barrel, versions = _make_library()
+    database = MetadataDatabase(library)
+    database.save_asset_metadata(
+        crate, {"author": "kim", "stats": {"bounding_box": [2, 4, 0]}, "notes": "skip"}
+    )
+    scene_asset_service, service = _make_missing_service(versions, database)
+    crate.unlink()  # Deleted from the library while a scene still references it
+
+    cmds = PlaceholderCmds()
+    cmds.references["crateRN"] = (str(crate), "crate", [])
+    cmds.references["barrelRN"] = (str(barrel), "barrel", ["|barrel:root"])
+    cmds.edits["crateRN"] = [
+        'setAttr crate:crate_grp|lid.translate -type "double3" 9 9 9',
+        'setAttr crate:crate_grp.translate -type "double3" 1 2.5 -3',
+        'setAttr "crate:crate_grp.rotateY" 90',
+        "setAttr crate:crate_grp.s -type double3 2 2 2",
+    ]
+
+    scene_assets = scene_asset_service.scan(cmds, library)
+    missing = [scene_asset for scene_asset in scene_assets if scene_asset.missing]
+    assert [scene_asset.label for scene_asset in missing] == ["crate:crateRN"]
+    assert missing[0].update_status == "File missing"
+    assert service.get_placement(cmds, missing[0]) == {
+        "translate": (1.0, 2.5, -3.0),
+        "scale": (2.0, 2.0, 2.0),
+    }
+
+    (placeholder,) = service.sync(cmds, scene_assets, library)
+    assert placeholder == "crate_placeholder"
+    assert service.find_placeholders(cmds) == {"crateRN": placeholder}
+    values = cmds.attributes[placeholder]
+    assert values["translate"] == (1.0, 2.5, -3.0) and values["overrideColor"] == 13
+    assert values[REFERENCE_ATTRIBUTE] == "crateRN"
+    assert json.loads(values[INFO_ATTRIBUTE]) == service.read_info(cmds, placeholder)
+    assert service.read_info(cmds, placeholder)["author"] == "kim"
+    assert "notes" not in service.read_info(cmds, placeholder)
+    # Two wide, four tall, and the default side deep, standing on the origin
+    xs, ys, zs = zip(*cmds.curves[placeholder])
+    assert (min(xs), max(xs), min(ys), max(ys), min(zs), max(zs)) == (-1, 1, 0, 4, -0.5, 0.5)
+
+    # Syncing again places nothing new; once the reference loads, the box goes
+    assert service.sync(cmds, scene_asset_service.scan(cmds, library), library) == []
+    cmds.references["crateRN"] = (str(crate.parent / "loaded.ma"), "crate", [])
+    (crate.parent / "loaded.ma").write_text("//Maya ASCII")
+    service.sync(cmds, scene_asset_service.scan(cmds, library), library)
+    assert service.find_placeholders(cmds) == {} and placeholder in cmds.deleted
+
+
+def test_resolve_missing_references():
+    """Missing references load another version or file, or are removed with their box"""
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.missing_asset_service_impl import (
+        RESOLVE_REMOVE,
+        RESOLVE_REPATH,
+        RESOLVE_VERSION,
+    )
+
+    library, crate, barrel, versions = _make_library()
+    crate.write_text("//Maya ASCII crate v2")
+    versions.publish_version(crate, notes="second")
+    snapshot = versions.get_version(crate, 1).file_path
+    snapshot.unlink()  # Pruned while a scene still pinned it
+    scene_asset_service, service = _make_missing_service(versions, MetadataDatabase(library))
+
+    cmds = PlaceholderCmds()
+    cmds.references["crateRN"] = (str(snapshot), "crate", [])
+    (scene_asset,) = scene_asset_service.scan(cmds, library)
+    assert scene_asset.missing and scene_asset.asset_file == crate
+    service.sync(cmds, [scene_asset], library)
+
+    choices = service.get_version_choices(scene_asset)
+    assert choices == [
+        ("Latest (current file)", crate),
+        ("v002", versions.get_version(crate, 2).file_path),
+    ]
+    service.resolve(cmds, scene_asset, RESOLVE_VERSION, choices[1][1])
+    assert cmds.loaded == [("crateRN", "crate.ma")]
+    assert service.find_placeholders(cmds) == {}
+
+    notes = barrel.with_suffix(".txt")
+    notes.write_text("notes")
+    for resolution, file_path, error in (
+        (RESOLVE_REPATH, library / "nowhere.ma", FileNotFoundError),
+        (RESOLVE_REPATH, notes, ValueError),
+        (RESOLVE_VERSION, None, ValueError),
+        ("ignore", None, ValueError),
+    ):
+        try:
+            service.resolve(cmds, scene_asset, resolution, file_path)
+        except error:
+            continue
+        raise AssertionError(f"Resolving by {resolution} with {file_path} should fail")
+
+    service.resolve(cmds, scene_asset, RESOLVE_REPATH, barrel)
+    assert cmds.loaded[-1] == ("crateRN", "barrel.ma")
+
+    service.sync(cmds, [scene_asset], library)
+    assert list(service.find_placeholders(cmds)) == ["crateRN"]
+    service.resolve(cmds, scene_asset, RESOLVE_REMOVE)
+    assert cmds.references == {} and service.find_placeholders(cmds) == {}
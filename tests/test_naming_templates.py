@@ -0,0 +1,104 @@
+"""
+Test suite for naming templates
+
+Validates rendering publish paths from library templates, picking the next version
+folder of an asset, and flagging assets that do not follow the template on scan.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+STUDIO_TEMPLATE = "{category}/{assetName}/{version}/{assetName}_{version}.ma"
+
+
+def _make_library(templates=None):
+    """Library with the studio template and optional per-type overrides"""
+    library = Path(tempfile.mkdtemp(prefix="assetManager_naming_"))
+    settings = library / ".assetmanager"
+    settings.mkdir()
+    data = {"template": STUDIO_TEMPLATE, "types": templates or {}}
+    (settings / "naming_templates.json").write_text(json.dumps(data))
+    return library
+
+
+def test_naming_template_render_and_match():
+    """Tokens render as safe path segments and parse back from published paths"""
+    from src.core.models.naming_template import NamingTemplate
+
+    template = NamingTemplate(STUDIO_TEMPLATE)
+    assert template.tokens == ["category", "assetName", "version"]
+    assert template.has_version
+    values = {"assetName": "Old Crate", "type": "Props"}
+    assert template.render(values, ".mb", 12) == "Props/Old_Crate/v012/Old_Crate_v012.mb"
+
+    found = template.match("Props/crate/v003/crate_v003.usd")
+    assert found == {"category": "Props", "assetName": "crate", "version": "v003"}
+    assert template.match("Props/crate/v003/barrel_v003.ma") is None  # Name must repeat
+    assert template.match("Props/crate/v003/crate_v003.ma", {"assetName": "barrel"}) is None
+    assert template.match("scenes/crate.ma") is None
+
+    dated = NamingTemplate("{type}/{date}/{assetName}_{artist}")
+    assert not dated.has_version
+    assert dated.match("Props/2025-01-31/crate_kim.ma")["artist"] == "kim"
+    assert dated.match("Props/yesterday/crate_kim.ma") is None
+
+    for invalid in ("{category}/{name}.ma", "{category}/model.ma", "../{assetName}.ma"):
+        try:
+            NamingTemplate(invalid)
+        except ValueError:
+            continue
+        raise AssertionError(f"Template {invalid} should be rejected")
+
+
+def test_resolve_publish_path_and_find_violations():
+    """Publishes land in the next version folder; scans flag assets outside the template"""
+    from src.services.naming_template_service_impl import NamingTemplateService
+
+    service = NamingTemplateService()
+    assert service.resolve_publish_path(Path(tempfile.mkdtemp()), "crate", "Props", ".ma") is None
+
+    library = _make_library({"Rigs": "rigs/{assetName}/{assetName}_rig.ma"})
+    assets = library / "assets"
+    asset_file, version = service.resolve_publish_path(library, "crate", "Props", ".ma")
+    assert asset_file == assets / "Props" / "crate" / "v001" / "crate_v001.ma"
+    assert version == 1
+
+    for number in (1, 2, 5):
+        published = assets / "Props" / "crate" / f"v{number:03d}" / f"crate_v{number:03d}.ma"
+        published.parent.mkdir(parents=True)
+        published.write_text("//")
+    (assets / "Props" / "crate_old" / "v009").mkdir(parents=True)
+    (assets / "Props" / "crate_old" / "v009" / "crate_old_v009.ma").write_text("//")
+    asset_file, version = service.resolve_publish_path(library, "crate", "Props", ".usd")
+    assert asset_file == assets / "Props" / "crate" / "v006" / "crate_v006.usd"
+    assert version == 6
+
+    # Type overrides without {version} overwrite one file and keep the version history
+    rig_file, rig_version = service.resolve_publish_path(library, "hero", "Rigs", ".mb")
+    assert rig_file == assets / "rigs" / "hero" / "hero_rig.mb" and rig_version is None
+
+    legacy = assets / "scenes" / "barrel.ma"
+    violations = service.find_violations(
+        library,
+        [
+            assets / "Props" / "crate" / "v005" / "crate_v005.ma",
+            rig_file,
+            legacy,
+            library / "loose.ma",
+            assets / "materials" / "steel.material",  # Not a scene publish
+        ],
+    )
+    assert sorted(violations) == sorted([str(legacy), str(library / "loose.ma")])
+    assert violations[str(legacy)].startswith("scenes/barrel.ma does not follow")
+    assert "Outside the assets folder" in violations[str(library / "loose.ma")]
+
+    # Saving without any template removes the file and the scan check
+    default, overrides = service.load_templates(library)
+    assert default.pattern == STUDIO_TEMPLATE and list(overrides) == ["Rigs"]
+    assert service.save_templates(library, None, {})
+    assert service.load_templates(library) == (None, {})
+    assert service.find_violations(library, [legacy]) == {}
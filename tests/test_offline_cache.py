@@ -0,0 +1,107 @@
+"""
+Test suite for the offline library cache
+
+Validates mirroring the most used assets of a library into a local cache, queueing
+assets published into the cache while offline, and syncing them back without
+overwriting assets that changed in the library meanwhile.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+
+def _make_library():
+    """Library with a used crate (thumbnail, tags) and a barrel nobody imported"""
+    library = Path(tempfile.mkdtemp(prefix="assetManager_offline_library_"))
+    scenes = library / "assets" / "scenes"
+    (scenes / ".thumbnails").mkdir(parents=True)
+    (scenes / "crate.ma").write_text("// crate")
+    (scenes / ".thumbnails" / "crate_screenshot.png").write_bytes(b"PNG")
+    (scenes / "barrel.ma").write_text("// barrel")
+    return library
+
+
+def _make_service():
+    from src.services.offline_cache_impl import OfflineCacheService
+
+    return OfflineCacheService(Path(tempfile.mkdtemp(prefix="assetManager_offline_cache_")))
+
+
+def test_mirror_most_used_assets():
+    """Imported assets are copied with thumbnails and metadata; the rest stay online"""
+    from src.services.metadata_database_impl import get_metadata_database
+
+    library = _make_library()
+    crate = library / "assets" / "scenes" / "crate.ma"
+    database = get_metadata_database(library)
+    database.save_asset_metadata(crate, {"tags": ["prop", "wood"], "category": "props"})
+    database.record_access(crate)
+
+    service = _make_service()
+    assert not service.has_cache(library)
+    assert service.mirror_most_used(library) == [crate]
+    assert service.has_cache(library)
+
+    cache_root = service.get_cache_root(library)
+    assert cache_root == service.get_cache_root(Path(str(library) + os.sep))
+    thumbnail = cache_root / "assets" / "scenes" / ".thumbnails" / "crate_screenshot.png"
+    assert thumbnail.read_bytes() == b"PNG"
+    assert not (cache_root / "assets" / "scenes" / "barrel.ma").exists()
+
+    cached_crate = cache_root / "assets" / "scenes" / "crate.ma"
+    cached = get_metadata_database(cache_root).get_asset_metadata(cached_crate)
+    assert cached["tags"] == ["prop", "wood"] and cached["category"] == "props"
+    assert cached_crate.read_text() == "// crate"
+
+    # Mirrored files are not offline publishes; caching again copies nothing new
+    assert service.get_pending_publishes(library) == []
+    assert service.cache_asset(library, crate)
+    assert service.get_pending_publishes(library) == []
+    assert not service.cache_asset(library, Path(tempfile.gettempdir()) / "elsewhere.ma")
+
+
+def test_sync_offline_publishes():
+    """New publishes sync with their files; assets changed in the library are kept back"""
+    from src.services.metadata_database_impl import get_metadata_database
+
+    library = _make_library()
+    crate = library / "assets" / "scenes" / "crate.ma"
+    service = _make_service()
+    assert service.cache_asset(library, crate)
+
+    # Offline: a new lamp is published and the crate republished in the cache
+    cache_scenes = service.get_cache_root(library) / "assets" / "scenes"
+    (cache_scenes / "lamp.ma").write_text("// lamp")
+    (cache_scenes / ".thumbnails" / "lamp_screenshot.png").write_bytes(b"LAMP")
+    cache_root = service.get_cache_root(library)
+    get_metadata_database(cache_root).save_asset_metadata(
+        cache_scenes / "lamp.ma", {"tags": ["light"]}
+    )
+    (cache_scenes / "crate.ma").write_text("// crate v2 from home")
+    os.utime(cache_scenes / "crate.ma", (crate.stat().st_mtime + 60,) * 2)
+    pending = service.get_pending_publishes(library)
+    assert pending == [cache_scenes / "crate.ma", cache_scenes / "lamp.ma"]
+
+    # Unreachable library: everything stays queued
+    offline = service.sync(library / "unmounted")
+    assert offline.synced == [] and offline.conflicts == []
+
+    # Meanwhile someone else republished the crate in the library
+    crate.write_text("// crate v2 from the studio")
+    os.utime(crate, (crate.stat().st_mtime + 120,) * 2)
+
+    result = service.sync(library)
+    lamp = library / "assets" / "scenes" / "lamp.ma"
+    assert result.synced == [lamp]
+    assert result.conflicts == [crate] and result.failed == []
+    assert lamp.read_text() == "// lamp"
+    assert (library / "assets" / "scenes" / ".thumbnails" / "lamp_screenshot.png").is_file()
+    assert get_metadata_database(library).get_asset_metadata(lamp)["tags"] == ["light"]
+    assert crate.read_text() == "// crate v2 from the studio"
+
+    # The conflict stays queued; the synced lamp does not
+    assert service.get_pending_publishes(library) == [cache_scenes / "crate.ma"]
@@ -0,0 +1,109 @@
+"""
+Test suite for parallel batch publishing
+
+Validates running exports in a pool of worker processes with aggregated progress,
+cancelling the exports not started yet, and a batch publish whose failing export
+does not affect the other assets.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+import time
+from pathlib import Path
+
+
+def test_worker_pool_progress_failures_and_cancel():
+    """Every job reports once; a raising job fails alone; stopping cancels the queue"""
+    from src.services.publish_worker_pool_impl import ExportJob, PublishWorkerPool
+
+    staging = Path(tempfile.mkdtemp(prefix="assetManager_workers_"))
+
+    def export(scene, job):
+        if job.name == "Broken":
+            raise RuntimeError("mayapy crashed")
+        job.output.write_text(f"{scene.name}: {' '.join(job.nodes)}")
+        return True
+
+    names = ["Chair", "Broken", "Table", "Lamp"]
+    jobs = [ExportJob(name, [f"|{name}_grp"], staging / f"{name}.ma") for name in names]
+    finished = []
+    pool = PublishWorkerPool(max_workers=3, runner=export)
+    assert pool.is_available()
+    pool.run(staging / "scene.mb", jobs, lambda count, job: finished.append(count) or True)
+    assert sorted(finished) == [1, 2, 3, 4]
+    assert [job.status for job in jobs] == ["done", "failed", "done", "done"]
+    assert jobs[1].error == "mayapy crashed"
+    assert (staging / "Lamp.ma").read_text() == "scene.mb: |Lamp_grp"
+
+    # One worker runs the jobs in order; stopping after the first cancels those still queued
+    def slow_export(scene, job):
+        time.sleep(0.0 if job.name == "Chair" else 0.2)
+        return export(scene, job)
+
+    jobs = [ExportJob(name, [], staging / f"{name}.ma") for name in ("Chair", "Table", "Lamp")]
+    stops = []
+    PublishWorkerPool(max_workers=1, runner=slow_export).run(
+        staging / "scene.mb", jobs, lambda count, job: stops.append(job.name) and False
+    )
+    assert stops == ["Chair"]
+    assert jobs[0].status == "done" and jobs[2].status == "cancelled"
+
+
+def test_publish_scene_items_in_workers():
+    """Exports run in workers from a snapshot; results keep item order and isolate failures"""
+    from src.services.batch_service_impl import BatchService, SOURCE_SETS, ScenePublishItem
+    from src.services.metadata_database_impl import get_metadata_database
+    from src.services.publish_worker_pool_impl import PublishWorkerPool
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+    from tests.test_batch_scene_publish import FakeCmds
+
+    snapshots = []
+
+    def export(scene, job):
+        snapshots.append(scene.name)
+        if job.name == "Broken":
+            job.error = "Error: Cannot find node |broken"
+            return False
+        job.output.write_text(" ".join(job.nodes))
+        return True
+
+    service = BatchService(
+        thumbnail_queue=ThumbnailQueue(max_workers=1, runner=lambda job: True),
+        worker_pool=PublishWorkerPool(max_workers=2, runner=export),
+    )
+    library = Path(tempfile.mkdtemp(prefix="assetManager_parallel_batch_"))
+    cmds = FakeCmds()
+    chair, table = service.find_scene_items(cmds, SOURCE_SETS)
+    chair.name, chair.tags = "Chair", ["seating"]
+    table.name = "Table"
+    broken = ScenePublishItem("broken_set", "Broken", ["|broken"])
+
+    finished = []
+    results = service.publish_scene_items(
+        cmds,
+        [chair, broken, table],
+        library,
+        tags=["kitchen"],
+        thumbnail=False,
+        progress=lambda count, item: finished.append(item.name) or True,
+        workers=2,
+    )
+    assert sorted(finished) == ["Broken", "Chair", "Table"]
+    assert snapshots == ["scene.mb"] * 3
+    assert [result.success for result in results] == [True, False, True]
+    assert [result.details["source"] for result in results] == [
+        "chair_set",
+        "broken_set",
+        "table_set",
+    ]
+    assert results[1].message == "export failed: Error: Cannot find node |broken"
+    scenes = library / "assets" / "scenes"
+    assert (scenes / "Table.ma").read_text() == "|table_grp |table_cloth"
+    assert not (scenes / "Broken.ma").exists()
+    assert results[0].details["version"] == 1
+
+    database = get_metadata_database(library)
+    assert database.get_asset_metadata(scenes / "Chair.ma")["tags"] == ["kitchen", "seating"]
+    assert cmds.selection == ["|chair_grp"]
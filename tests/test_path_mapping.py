@@ -0,0 +1,147 @@
+"""
+Test suite for cross-platform path mapping
+
+Validates storing library paths under a root token, resolving them and other
+platforms' mounts on this machine, and mapping mounts through Maya's dirmap.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Maya dirmap recorder"""
+
+    def __init__(self):
+        self.enabled = False
+        self.mappings = []
+
+    def dirmap(self, enable=None, mapDirectory=None):
+        if enable is not None:
+            self.enabled = enable
+        if mapDirectory is not None:
+            self.mappings.append(tuple(mapDirectory))
+
+
+def _make_library(roots):
+    from src.core.models.path_mapping import PathMapping
+    from src.services.path_mapping_service_impl import PathMappingService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_pathmap_"))
+    service = PathMappingService()
+    assert service.save_mapping(library, PathMapping(roots=roots))
+    return library, service
+
+
+def test_portable_paths_resolve_on_each_platform():
+    """Paths under any mount store as $ASSET_ROOT and come back under the local one"""
+    from src.core.models.path_mapping import PathMapping, get_platform
+
+    library, service = _make_library({"windows": "P:\\assets\\", "linux": "/mnt/assets"})
+    assert service.get_mapping(library).roots == {"windows": "P:/assets", "linux": "/mnt/assets"}
+    assert (get_platform("win32"), get_platform("darwin"), get_platform("linux")) == (
+        "windows",
+        "macos",
+        "linux",
+    )
+
+    linux = {"environ": {}, "platform": "linux"}
+    windows = {"environ": {}, "platform": "windows"}
+    # Drive letters compare without case; other mounts and unrelated paths are kept
+    stored = service.to_portable("p:\\Assets\\scenes\\crate.ma", library, **linux)
+    assert stored == "$ASSET_ROOT/scenes/crate.ma"
+    assert service.to_portable("/mnt/assets/scenes/crate.ma", library, **windows) == stored
+    assert service.to_portable("/mnt/assets_old/crate.ma", library, **linux) == (
+        "/mnt/assets_old/crate.ma"
+    )
+
+    assert service.resolve(stored, library, **linux) == Path("/mnt/assets/scenes/crate.ma")
+    assert service.resolve("${ASSET_ROOT}/crate.ma", library, **windows) == Path(
+        "P:/assets/crate.ma"
+    )
+    assert service.resolve("P:/assets/scenes/crate.ma", library, **linux) == Path(
+        "/mnt/assets/scenes/crate.ma"
+    )
+    # The environment wins over the table; unmapped platforms use the opened folder
+    assert service.resolve(stored, library, {"ASSET_ROOT": "/net/a"}, "linux") == Path(
+        "/net/a/scenes/crate.ma"
+    )
+    assert service.resolve(stored, library, {}, "macos") == library / "scenes" / "crate.ma"
+
+    for data in ({"token": "ASSET ROOT"}, {"roots": {"amiga": "DH0:"}}):
+        try:
+            PathMapping.from_dict(data)
+        except ValueError:
+            pass
+        else:
+            raise AssertionError(f"{data} should be rejected")
+
+
+def test_install_sets_token_and_dirmap():
+    """Opening a library sets the token for the session and maps other mounts"""
+    from src.core.models.library_entry import LibraryEntry
+
+    library, service = _make_library(
+        {"windows": "P:/assets", "linux": "/mnt/assets", "macos": "/Volumes/assets"}
+    )
+    cmds = FakeCmds()
+    environ = {}
+    pairs = service.install(library, cmds, environ, "linux")
+    assert environ == {"ASSET_ROOT": "/mnt/assets"}
+    assert cmds.enabled and cmds.mappings == pairs == [
+        ("P:/assets", "/mnt/assets"),
+        ("/Volumes/assets", "/mnt/assets"),
+    ]
+    # The installed library is used when none is given
+    assert service.resolve("$ASSET_ROOT/crate.ma", environ=environ) == Path("/mnt/assets/crate.ma")
+
+    # Project configs shared across platforms can name libraries by the token
+    entry = LibraryEntry.from_dict({"path": "$ASSET_ROOT/props"}, None)
+    assert entry.root_path.as_posix().endswith("/props")
+
+
+def test_switching_libraries_keeps_each_root():
+    """A library opened after another neither inherits its root nor stores its token"""
+    from src.services.path_mapping_service_impl import PathMappingService
+
+    library_a, _service = _make_library({"linux": "/mnt/a"})
+    library_b = Path(tempfile.mkdtemp(prefix="assetManager_pathmap_"))  # No mapping file
+    service = PathMappingService()
+    environ = {}
+
+    service.install(library_a, None, environ, "linux")
+    assert environ == {"ASSET_ROOT": "/mnt/a"}
+    assert service.to_portable("/mnt/a/props/crate.ma", library_a, environ, "linux") == (
+        "$ASSET_ROOT/props/crate.ma"
+    )
+
+    # B has no table, so its paths stay absolute and A's root is taken back
+    crate_b = (library_b / "props" / "crate.ma").as_posix()
+    assert service.to_portable(crate_b, library_b, environ, "linux") == crate_b
+    service.install(library_b, None, environ, "linux")
+    assert environ == {} and service.to_portable(crate_b, environ=environ) == crate_b
+    # A's token paths never resolve under B's folder
+    assert service.resolve("$ASSET_ROOT/props/crate.ma", library_b, environ, "linux") == Path(
+        "$ASSET_ROOT/props/crate.ma"
+    )
+
+    # A mapped library's root replaces the one before it and is taken back after it
+    library_c, _service = _make_library({"linux": "/mnt/c"})
+    service.install(library_a, None, environ, "linux")
+    service.install(library_c, None, environ, "linux")
+    assert environ == {"ASSET_ROOT": "/mnt/c"}
+    service.install(library_b, None, environ, "linux")
+    assert environ == {}
+
+    # A root the studio set is never replaced, and B shares it
+    environ = {"ASSET_ROOT": "/net/studio"}
+    service = PathMappingService()
+    service.install(library_c, None, environ, "linux")
+    service.install(library_b, None, environ, "linux")
+    assert environ == {"ASSET_ROOT": "/net/studio"}
+    assert service.to_portable("/net/studio/props/crate.ma", library_b, environ, "linux") == (
+        "$ASSET_ROOT/props/crate.ma"
+    )
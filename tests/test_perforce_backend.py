@@ -0,0 +1,96 @@
+"""
+Test suite for the Perforce library backend
+
+Validates depot revision parsing, the publish changelist sequence, and syncing to head
+or a pinned changelist against a scripted stand-in for the p4 client.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeP4:
+    """Records p4 commands and answers them from canned JSON records"""
+
+    def __init__(self, root, opened=True):
+        self.root = root
+        self.opened = opened
+        self.commands = []
+        self.inputs = []
+
+    def __call__(self, args, input_text):
+        self.commands.append(args)
+        if input_text is not None:
+            self.inputs.append(input_text)
+        command = args[0]
+        if command == "info":
+            return [{"clientName": "mike_ws", "clientRoot": str(self.root)}]
+        if command == "fstat":
+            crate = str(self.root / "props" / "crate.ma")
+            return [
+                {
+                    "depotFile": "//art/props/crate.ma",
+                    "clientFile": crate,
+                    "haveRev": "3",
+                    "headRev": "5",
+                    "headChange": "1234",
+                },
+                {"data": "barrel.ma - no such file(s).", "severity": 2},
+            ]
+        if command == "change" and args[1] == "-o":
+            return [{"Change": "new", "Client": "mike_ws", "User": "mike"}]
+        if command == "change" and args[1] == "-i":
+            return [{"data": "Change 77 created."}]
+        if command == "opened":
+            return [{"depotFile": "//art/props/crate.ma"}] if self.opened else []
+        if command == "submit":
+            return [{"submittedChange": "78"}]
+        return []
+
+
+def test_depot_revisions():
+    """fstat output becomes #have/#head revisions; files outside the workspace are skipped"""
+    from src.services.perforce_service_impl import PerforceServiceImpl
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_p4_"))
+    p4 = FakeP4(root)
+    service = PerforceServiceImpl(runner=p4)
+
+    assert service.is_available()
+    revisions = service.get_revisions([root / "props" / "crate.ma", Path("/elsewhere/a.ma")])
+    revision = revisions[str(root / "props" / "crate.ma")]
+    assert revision.label == "#3/5"
+    assert not revision.is_latest
+    assert revision.head_change == 1234
+    assert p4.commands[-1][-1] == str(root / "props" / "crate.ma")
+
+
+def test_publish_changelist_and_sync():
+    """A publish opens files in a new changelist, reconciles, and submits; sync honours pins"""
+    from src.services.perforce_service_impl import PerforceServiceImpl
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_p4_"))
+    p4 = FakeP4(root)
+    service = PerforceServiceImpl(runner=p4)
+    paths = [root / "props" / "crate.ma", root / "props" / ".versions" / "crate" / "..."]
+
+    change = service.open_for_publish(paths, "Publish crate\n\nNew lid")
+    assert change == 77
+    assert "Description:\n\tPublish crate\n\t\n\tNew lid\n" in p4.inputs[0]
+    assert p4.commands[-2][:3] == ["edit", "-c", "77"]
+
+    assert service.submit_publish(change, paths) == 78
+    assert [args[0] for args in p4.commands[-4:]] == ["reconcile", "revert", "opened", "submit"]
+
+    # Nothing changed: the empty changelist is deleted instead of submitted
+    p4.opened = False
+    assert service.submit_publish(change, paths) is None
+    assert p4.commands[-1] == ["change", "-d", "77"]
+
+    assert service.sync(paths[:1])
+    assert p4.commands[-1] == ["sync", f"{paths[0]}#head"]
+    service.sync(paths[:1], change=1200)
+    assert p4.commands[-1] == ["sync", f"{paths[0]}@1200"]
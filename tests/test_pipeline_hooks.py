@@ -0,0 +1,94 @@
+"""
+Test suite for pipeline hooks
+
+Validates loading hook files from user and library folders, the context passed
+to callbacks, cancelling from pre_ hooks, and isolating broken hooks.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+HOOK_FILE = '''
+from src.services.hook_service_impl import HookCancelled
+
+CALLS = []
+
+
+def post_publish(context):
+    CALLS.append(("post_publish", context["asset_name"], context["version"]))
+
+
+def block_wip(context):
+    if context["asset_name"].endswith("_wip"):
+        raise HookCancelled("WIP assets cannot be published")
+    CALLS.append(("pre_publish", context["asset_name"]))
+
+
+def register(hooks):
+    hooks.register("pre_publish", block_wip)
+'''
+
+
+def test_hooks_from_folders():
+    """Hook files add callbacks by function name or register(); pre_ hooks can cancel"""
+    from src.services.hook_service_impl import HookCancelled, HookService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_hooks_"))
+    user_hooks = root / "user_hooks"
+    user_hooks.mkdir()
+    (user_hooks / "studio_hooks.py").write_text(HOOK_FILE)
+    (user_hooks / "broken.py").write_text(
+        "def post_publish(context):\n    raise OSError('offline')\n"
+    )
+    (user_hooks / "syntax_error.py").write_text("def pre_import(:\n")
+
+    library = root / "library"
+    library_hooks = library / ".assetmanager" / "hooks"
+    library_hooks.mkdir(parents=True)
+    seen = []
+    (library_hooks / "library_hooks.py").write_text(
+        "SEEN = []\n\ndef on_asset_deleted(context):\n    SEEN.append(context)\n"
+    )
+
+    service = HookService(user_hooks_dir=user_hooks)
+    assert service.get_callbacks("on_asset_deleted") == []
+    assert [source for source, _ in service.get_callbacks("pre_publish", library)] == [
+        str(user_hooks / "studio_hooks.py")
+    ]
+    assert len(service.get_callbacks("post_publish", library)) == 2
+
+    # Broken hooks are reported and skipped
+    calls = service.get_callbacks("pre_publish", library)[0][1].__globals__["CALLS"]
+    assert service.run("pre_publish", library, asset_name="crate") == 1
+    assert service.run("post_publish", library, asset_name="crate", version=3) == 1
+    assert calls == [("pre_publish", "crate"), ("post_publish", "crate", 3)]
+
+    try:
+        service.run("pre_publish", library, asset_name="crate_wip")
+    except HookCancelled as e:
+        assert "WIP" in str(e)
+    else:
+        raise AssertionError("pre_publish hook should have cancelled the publish")
+
+    service.register("on_asset_deleted", seen.append)
+    service.run("on_asset_deleted", library, asset_file=library / "crate.ma", mode="delete")
+    library_seen = service.get_callbacks("on_asset_deleted", library)[0][1].__globals__["SEEN"]
+    assert library_seen[0]["asset_file"] == library / "crate.ma"
+    assert library_seen[0]["library_root"] == library
+    assert seen[0]["hook"] == "on_asset_deleted" and seen[0]["user"]
+
+    # Code registrations survive reloading the hook files
+    service.load(library)
+    assert len(service.get_callbacks("on_asset_deleted", library)) == 2
+    service.unregister("on_asset_deleted", seen.append)
+    assert len(service.get_callbacks("on_asset_deleted", library)) == 1
+
+    for hook in ("pre_rename", "post_import"):
+        try:
+            service.register(hook, None if hook == "post_import" else print)
+        except ValueError:
+            continue
+        raise AssertionError(f"register should reject {hook}")
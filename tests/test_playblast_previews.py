@@ -0,0 +1,148 @@
+"""
+Test suite for playblast previews
+
+Validates capturing a viewport playblast through the chosen camera, encoding it as
+the asset's MP4 preview, and finding the movie for the preview panel.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import os
+import stat
+import sys
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Viewport with a model panel, cameras, and a playblast writing PNG frames"""
+
+    def __init__(self):
+        self.panel_camera = "persp"
+        self.time = 12.0
+        self.blasts = []
+
+    def ls(self, type=None, long=False):
+        return ["|persp|perspShape", "|shotCam|shotCamShape", "|front|frontShape"]
+
+    def listRelatives(self, node, parent=False):
+        return [node.split("|")[1]]
+
+    def playbackOptions(self, query=True, minTime=False, maxTime=False):
+        return 1.0 if minTime else 24.0
+
+    def currentUnit(self, query=True, time=True):
+        return "film"
+
+    def currentTime(self, value=None, query=False):
+        if query:
+            return self.time
+        self.time = value
+
+    def objExists(self, node):
+        return node in ("persp", "shotCam", "front")
+
+    def getPanel(self, withFocus=False, visiblePanels=False, typeOf=None):
+        if typeOf:
+            return "modelPanel" if typeOf.startswith("modelPanel") else "scriptedPanel"
+        return ["outlinerPanel1", "modelPanel4"] if visiblePanels else "outlinerPanel1"
+
+    def modelPanel(self, panel, query=False, edit=False, camera=None):
+        if query:
+            return self.panel_camera
+        self.panel_camera = camera
+
+    def playblast(self, startTime, endTime, filename, framePadding, editorPanelName="", **_):
+        self.blasts.append((editorPanelName, self.panel_camera))
+        for frame in range(int(startTime), int(endTime) + 1):
+            Path(f"{filename}.{frame:0{framePadding}d}.png").write_bytes(b"PNG")
+            self.time = frame
+
+
+def _make_fake_ffmpeg(folder: Path) -> Path:
+    """Script standing in for ffmpeg: writes its arguments as the output movie"""
+    script = folder / "ffmpeg"
+    script.write_text(
+        f"#!{sys.executable}\n"
+        "import json, sys\n"
+        "open(sys.argv[-1], 'w').write(json.dumps(sys.argv[1:-1]))\n"
+    )
+    script.chmod(script.stat().st_mode | stat.S_IEXEC)
+    return script
+
+
+def test_playblast_settings_round_trip():
+    """Settings survive library metadata and describe their range"""
+    from src.core.models.playblast_settings import PlayblastSettings
+
+    settings = PlayblastSettings(1001.0, 1048.0, "shotCam", 1280, 720)
+    assert settings.frame_count == 48
+    assert settings.description == "Frames 1001-1048 through shotCam, 1280x720"
+    data = settings.to_dict()
+    assert PlayblastSettings.from_dict(dict(data, unknown=True)) == settings
+    assert PlayblastSettings(10.0, 5.0).frame_count == 0
+
+
+def test_capture_playblast_preview():
+    """Frames are blasted through the chosen camera and encoded next to the thumbnail"""
+    from src.core.models.playblast_settings import PlayblastSettings
+    from src.services.playblast_service_impl import (
+        FFMPEG_ENV_VAR,
+        PlayblastService,
+        find_preview_movie,
+        get_playblast_path,
+    )
+
+    service = PlayblastService()
+    cmds = FakeCmds()
+    assert service.list_cameras(cmds) == ["persp", "front", "shotCam"]
+    assert service.get_default_settings(cmds) == PlayblastSettings(1.0, 24.0)
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_playblast_"))
+    clip = library / "assets" / "animation" / "walk_cycle.animclip"
+    clip.parent.mkdir(parents=True)
+    clip.write_text("{}")
+    assert find_preview_movie(clip) is None
+
+    previous = os.environ.get(FFMPEG_ENV_VAR)
+    os.environ[FFMPEG_ENV_VAR] = str(_make_fake_ffmpeg(library))
+    try:
+        settings = PlayblastSettings(5.0, 8.0, "shotCam", 320, 180)
+        movie = service.capture(cmds, clip, settings)
+    finally:
+        if previous is None:
+            os.environ.pop(FFMPEG_ENV_VAR)
+        else:
+            os.environ[FFMPEG_ENV_VAR] = previous
+
+    assert movie == get_playblast_path(clip) == find_preview_movie(clip)
+    assert movie == library / "assets" / "animation" / ".thumbnails" / "walk_cycle_playblast.mp4"
+    arguments = json.loads(movie.read_text())
+    assert arguments[arguments.index("-framerate") + 1] == "24"
+    assert arguments[arguments.index("-start_number") + 1] == "5"
+    assert arguments[arguments.index("-i") + 1].endswith("frame.%04d.png")
+
+    # Blasted in the visible viewport through shotCam, then the artist's view is restored
+    assert cmds.blasts == [("modelPanel4", "shotCam")]
+    assert cmds.panel_camera == "persp" and cmds.time == 12.0
+
+
+def test_capture_without_ffmpeg_or_frames():
+    """Nothing is written when the range is empty or ffmpeg cannot be found"""
+    from src.core.models.playblast_settings import PlayblastSettings
+    from src.services import playblast_service_impl
+    from src.services.playblast_service_impl import PlayblastService
+
+    service = PlayblastService()
+    clip = Path(tempfile.mkdtemp(prefix="assetManager_playblast_")) / "idle.animclip"
+    assert service.capture(FakeCmds(), clip, PlayblastSettings(10.0, 1.0)) is None
+
+    original = playblast_service_impl.find_ffmpeg
+    playblast_service_impl.find_ffmpeg = lambda: None
+    try:
+        assert service.capture(FakeCmds(), clip, PlayblastSettings(1.0, 10.0)) is None
+    finally:
+        playblast_service_impl.find_ffmpeg = original
+    assert not (clip.parent / ".thumbnails").exists()
@@ -0,0 +1,75 @@
+"""
+Test suite for the pose library
+
+Validates left/right control name mirroring and applying poses with blend and mirror
+options against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+
+class FakeCmds:
+    """Stores attribute values set through the pose service"""
+
+    def __init__(self, values):
+        self.values = dict(values)
+
+    def objExists(self, plug):
+        return plug in self.values
+
+    def getAttr(self, plug):
+        return self.values[plug]
+
+    def setAttr(self, plug, value):
+        self.values[plug] = value
+
+
+POSE = {
+    "type": "pose",
+    "name": "reach",
+    "controls": {
+        "L_arm_ctrl": {"rotateZ": 40.0},
+        "spine_ctrl": {"translateX": 2.0, "rotateX": 10.0},
+    },
+}
+
+
+def test_mirror_control_names():
+    """Side tokens are swapped only when they are whole name parts"""
+    from src.services.pose_service_impl import mirror_control_name
+
+    assert mirror_control_name("L_arm_ctrl") == "R_arm_ctrl"
+    assert mirror_control_name("arm_R_ctrl") == "arm_L_ctrl"
+    assert mirror_control_name("hand_left") == "hand_right"
+    assert mirror_control_name("Lid_ctrl") is None
+    assert mirror_control_name("spine_ctrl") is None
+
+
+def test_apply_pose_with_blend_and_mirror():
+    """Blend moves part way from the current pose; mirror swaps sides and reflects center"""
+    from src.services.pose_service_impl import PoseService
+
+    service = PoseService()
+    cmds = FakeCmds(
+        {
+            "heroB:L_arm_ctrl.rotateZ": 0.0,
+            "heroB:R_arm_ctrl.rotateZ": 0.0,
+            "heroB:spine_ctrl.translateX": 0.0,
+            "heroB:spine_ctrl.rotateX": 0.0,
+        }
+    )
+
+    result = service.apply_pose(cmds, POSE, "heroB", blend=0.5)
+    assert result.applied_attributes == 3
+    assert cmds.values["heroB:L_arm_ctrl.rotateZ"] == 20.0
+    assert cmds.values["heroB:spine_ctrl.translateX"] == 1.0
+
+    result = service.apply_pose(cmds, POSE, "heroB", mirror=True)
+    assert cmds.values["heroB:R_arm_ctrl.rotateZ"] == 40.0
+    assert cmds.values["heroB:spine_ctrl.translateX"] == -2.0
+    assert cmds.values["heroB:spine_ctrl.rotateX"] == 10.0
+
+    result = service.apply_pose(cmds, POSE, "heroC")
+    assert not result.success
+    assert len(result.missing) == 3
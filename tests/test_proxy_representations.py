@@ -0,0 +1,251 @@
+"""
+Test suite for proxy representations
+
+Validates generating GPU cache, bounding box, and Arnold standin proxies at publish,
+importing them, swapping them for full geometry and back, and the render-time swap
+hooks, against a stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Top-level transforms with string attributes, a mesh, and render globals"""
+
+    def __init__(self):
+        self.assemblies = []
+        self.attributes = {
+            "defaultRenderGlobals.preMel": "print 1",
+            "defaultRenderGlobals.postMel": "",
+        }
+        self.matrices = {}
+        self.parents = {}
+        self.imports = []
+        self.undo_chunks = []
+        self.selection = []
+
+    def pluginInfo(self, plugin, query, loaded):
+        return True
+
+    def currentTime(self, query):
+        return 12.0
+
+    def gpuCache(self, *nodes, directory, fileName, **kwargs):
+        Path(directory, f"{fileName}.abc").write_text(" ".join(nodes))
+
+    def arnoldExportAss(self, filename, selected, **kwargs):
+        Path(filename).write_text("### ass " + " ".join(self.selection))
+
+    def mayaUSDExport(self, file, selection, **kwargs):
+        Path(file).write_text("#usda 1.0 " + " ".join(self.selection))
+
+    def listRelatives(self, nodes, parent=False, fullPath=False, **kwargs):
+        if parent:
+            return [self.parents[nodes]] if nodes in self.parents else None
+        return ["|tree|treeShape"]
+
+    def exactWorldBoundingBox(self, node):
+        return [-1.0, 0.0, -1.0, 1.0, 4.0, 1.0]
+
+    def polyCube(self, name, **kwargs):
+        self.assemblies.append(f"|{name}")
+        return [f"|{name}"]
+
+    def group(self, nodes, name, world):
+        for node in nodes:
+            self.assemblies.remove(node)
+        self.assemblies.append(f"|{name}")
+        return f"|{name}"
+
+    def select(self, nodes, replace=False):
+        self.selection = [nodes] if isinstance(nodes, str) else list(nodes)
+
+    def file(self, path=None, **kwargs):
+        if kwargs.get("exportSelected"):
+            Path(path).write_text("//Maya ASCII " + " ".join(self.selection))
+            return path
+        if kwargs.get("i"):
+            root = f"|{Path(path).stem}{len(self.imports)}"
+            self.imports.append(path)
+            self.assemblies.append(root)
+            return [root, f"{root}Shape"]
+        raise AssertionError(kwargs)
+
+    def createNode(self, node_type, name, parent=None):
+        if parent is None:
+            self.assemblies.append(f"|{name}")
+            return f"|{name}"
+        return f"{parent}|{name}"
+
+    def ls(self, pattern=None, assemblies=False, **kwargs):
+        if assemblies:
+            return list(self.assemblies)
+        attribute = pattern.split(".")[-1]
+        return [name.rsplit(".", 1)[0] for name in self.attributes if name.endswith(attribute)]
+
+    def attributeQuery(self, attribute, node, exists):
+        return f"{node}.{attribute}" in self.attributes
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, attribute, value, type=None):
+        self.attributes[attribute] = value
+
+    def getAttr(self, attribute):
+        return self.attributes.get(attribute, False)
+
+    def referenceQuery(self, node, isNodeReferenced):
+        return False
+
+    def xform(self, node, query=False, matrix=None, worldSpace=False, translation=None):
+        if query:
+            return self.matrices.get(node, [1.0] * 16)
+        if matrix is not None:
+            self.matrices[node] = matrix
+
+    def delete(self, node):
+        if node in self.assemblies:
+            self.assemblies.remove(node)
+        for attribute in [a for a in self.attributes if a.startswith(f"{node}.")]:
+            del self.attributes[attribute]
+
+    def parent(self, node, parent):
+        self.assemblies.remove(node)
+        moved = f"{parent}{node}"
+        for attribute in [a for a in self.attributes if a.startswith(f"{node}.")]:
+            self.attributes[moved + attribute[len(node) :]] = self.attributes.pop(attribute)
+        self.parents[moved] = parent
+        return [moved]
+
+    def undoInfo(self, openChunk=False, closeChunk=False, chunkName=""):
+        self.undo_chunks.append("open" if openChunk else "close")
+
+
+def _make_asset() -> Path:
+    library = Path(tempfile.mkdtemp(prefix="assetManager_proxies_"))
+    asset_file = library / "assets" / "scenes" / "forest.ma"
+    asset_file.parent.mkdir(parents=True)
+    asset_file.write_text("//Maya ASCII forest")
+    return asset_file
+
+
+def test_generate_proxies_and_render_hooks():
+    """Both proxy kinds are exported next to the asset; render hooks keep other scripts"""
+    from src.services.proxy_service_impl import POST_RENDER_HOOK, PRE_RENDER_HOOK, ProxyService
+
+    asset_file = _make_asset()
+    cmds = FakeCmds()
+    service = ProxyService()
+    assert not service.has_proxy(asset_file) and service.get_proxy_files(asset_file) == []
+
+    bbox = service.generate(cmds, asset_file, "bbox", ["|tree"], author="kim")
+    gpu = service.generate(cmds, asset_file, "gpu", ["|tree"])
+    assert bbox == asset_file.parent / ".proxies" / "forest" / "forest_bbox.ma"
+    assert "forest_bbox" in bbox.read_text() and cmds.assemblies == []
+    assert gpu.name == "forest_gpu.abc" and gpu.read_text() == "|tree"
+
+    for kind, selection in (("cards", ["|tree"]), ("gpu", [])):
+        try:
+            service.generate(cmds, asset_file, kind, selection)
+        except ValueError:
+            continue
+        raise AssertionError(f"{kind} proxy should be refused")
+
+    proxies = service.get_proxies(asset_file)
+    assert [proxy.label for proxy in proxies] == ["GPU cache", "Bounding box"]
+    assert proxies[1].author == "kim" and proxies[1].published is not None
+    assert [path.name for path in service.get_proxy_files(asset_file)] == [
+        "forest_bbox.ma",
+        "forest_gpu.abc",
+        "proxies.json",
+    ]
+
+    service.install_render_swap(cmds)
+    service.install_render_swap(cmds)
+    assert cmds.attributes["defaultRenderGlobals.preMel"] == f"print 1; {PRE_RENDER_HOOK}"
+    assert cmds.attributes["defaultRenderGlobals.postMel"] == POST_RENDER_HOOK
+    assert service.is_render_swap_installed(cmds)
+    service.remove_render_swap(cmds)
+    assert cmds.attributes["defaultRenderGlobals.preMel"] == "print 1"
+    assert cmds.attributes["defaultRenderGlobals.postMel"] == ""
+    assert not service.is_render_swap_installed(cmds)
+
+
+def test_swap_proxies_to_full_geometry():
+    """Full geometry takes the proxy's place; render restores leave manual swaps alone"""
+    from src.services.proxy_service_impl import STATE_RENDER, ProxyService
+
+    asset_file = _make_asset()
+    cmds = FakeCmds()
+    service = ProxyService()
+    service.generate(cmds, asset_file, "gpu", ["|tree"])
+    service.generate(cmds, asset_file, "bbox", ["|tree"])
+
+    gpu_root = service.import_proxy(cmds, asset_file)
+    bbox_root = service.import_proxy(cmds, asset_file, "bbox")
+    shape = f"{gpu_root}|forest_proxyShape"
+    assert cmds.attributes[f"{shape}.cacheFileName"].endswith("forest_gpu.abc")
+    assert cmds.imports == [str(service.get_proxy_path(asset_file, "bbox"))]
+    cmds.matrices[gpu_root] = [2.0] * 16
+    cmds.parents[gpu_root] = "|set"
+
+    scene_proxies = service.find_scene_proxies(cmds)
+    assert [scene_proxy.label for scene_proxy in scene_proxies] == [
+        "forest - Bounding box, proxy",
+        "forest - GPU cache, proxy",
+    ]
+    full = service.swap_to_full(cmds, scene_proxies[1])
+    assert full.is_full and full.node == "|set|forest_full"
+    assert cmds.imports[-1] == str(asset_file)
+    assert cmds.matrices["|set|forest_full"] == [2.0] * 16
+    assert gpu_root not in cmds.assemblies
+
+    # The render swap brings in the bounding box asset, then only puts that one back
+    assert service.swap_all(cmds, to_full=True, state=STATE_RENDER) == 1
+    assert bbox_root not in cmds.assemblies
+    assert service.swap_all(cmds, to_full=False, state=STATE_RENDER) == 1
+    labels = [scene_proxy.label for scene_proxy in service.find_scene_proxies(cmds)]
+    assert labels == ["forest - Bounding box, proxy", "forest - GPU cache, full geometry"]
+
+    restored = service.swap_to_proxy(cmds, full)
+    assert not restored.is_full and restored.node == "|set|forest_proxy"
+    assert cmds.matrices[restored.node] == [2.0] * 16
+    assert cmds.undo_chunks == ["open", "close"] * 4
+
+
+def test_arnold_standins_render_as_they_are():
+    """Standins publish beside the proxy, load in an aiStandIn, and skip render swaps"""
+    from src.services.proxy_service_impl import STATE_RENDER, ProxyService
+
+    asset_file = _make_asset()
+    cmds = FakeCmds()
+    service = ProxyService()
+    service.generate(cmds, asset_file, "gpu", ["|tree"])
+    ass = service.generate(cmds, asset_file, "standin", ["|tree"])
+    assert ass.name == "forest_standin.ass" and ass.read_text() == "### ass |tree"
+
+    try:
+        service.generate(cmds, asset_file, "standin", ["|tree"], standin_format=".abc")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Standins should only load .ass or USD files")
+
+    usd = service.generate(cmds, asset_file, "standin", ["|tree"], standin_format=".usd")
+    assert usd.name == "forest_standin.usd" and not ass.exists()
+    labels = [proxy.label for proxy in service.get_proxies(asset_file)]
+    assert labels == ["GPU cache", "Arnold standin (.usd)"]
+
+    root = service.import_proxy(cmds, asset_file, "standin")
+    assert root == "|forest_standin"
+    assert cmds.attributes[f"{root}|forest_standinShape.dso"] == usd.as_posix()
+
+    assert service.swap_all(cmds, to_full=True, state=STATE_RENDER) == 0
+    assert service.swap_all(cmds, to_full=True) == 1
+    labels = [scene_proxy.label for scene_proxy in service.find_scene_proxies(cmds)]
+    assert labels == ["forest - Arnold standin, full geometry"]
@@ -0,0 +1,129 @@
+"""
+Test suite for remembered publish settings
+
+Validates keeping a publish's dialog values with the asset and the artist, mapping
+work scenes to the asset they published, and the order the publish dialog's
+defaults are merged in.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+CRATE_PUBLISH = {
+    "name": "crate",
+    "category": "Props",
+    "description": "Wooden crate",
+    "tags": ["props/wood"],
+    "export_selected": True,
+    "generate_thumbnail": True,
+    "include_materials": False,
+    "format": ".mb",
+    "collect_dependencies": True,
+    "create_package": False,
+    "proxy": "gpu_cache",
+    "thumbnail_camera": "scene:|cameras|hero_cam",
+}
+
+
+class FakeDatabase:
+    """Library metadata database keeping asset metadata in memory"""
+
+    def __init__(self):
+        self.metadata = {}
+
+    def get_asset_metadata(self, asset_path):
+        return dict(self.metadata.get(str(asset_path), {})) or None
+
+    def save_asset_metadata(self, asset_path, metadata):
+        self.metadata[str(asset_path)] = dict(metadata)
+
+
+def test_publish_remembered_for_asset_and_artist():
+    """A publish pre-fills its asset's next publish; new assets get only the options"""
+    from src.services.publish_settings_service_impl import (
+        PUBLISH_SETTINGS_METADATA_KEY,
+        PublishSettingsService,
+    )
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_publish_settings_"))
+    asset_file = root / "library" / "Props" / "crate.mb"
+    asset_file.parent.mkdir(parents=True)
+    asset_file.write_bytes(b"FOR4")
+    scene_file = root / "work" / "crate_v012.ma"
+    database = FakeDatabase()
+    database.save_asset_metadata(asset_file, {"tags": ["props/wood"]})
+
+    settings_file = root / "publish_settings.json"
+    service = PublishSettingsService(settings_file=settings_file)
+    assert service.get_user_defaults() == {} and service.get_scene_asset(scene_file) is None
+    assert service.remember_publish(CRATE_PUBLISH, asset_file, scene_file, database)
+
+    stored = database.get_asset_metadata(asset_file)
+    assert stored["tags"] == ["props/wood"]  # Other metadata is kept
+    assert stored[PUBLISH_SETTINGS_METADATA_KEY]["thumbnail_camera"] == "scene:|cameras|hero_cam"
+    assert stored[PUBLISH_SETTINGS_METADATA_KEY]["send_to_unreal"] is False
+
+    # Publishing again from the work scene fills in everything, after a restart too
+    service = PublishSettingsService(settings_file=settings_file)
+    assert service.get_scene_asset(scene_file) == asset_file
+    defaults = service.get_dialog_defaults(database, asset_file)
+    assert {key: defaults[key] for key in CRATE_PUBLISH} == CRATE_PUBLISH
+
+    # A new asset starts with the artist's export options, not the crate's name or tags
+    defaults = service.get_dialog_defaults(database, None)
+    assert defaults["format"] == ".mb" and defaults["proxy"] == "gpu_cache"
+    assert defaults["include_materials"] is False
+    assert "name" not in defaults and "tags" not in defaults
+    assert "thumbnail_camera" not in defaults
+
+    # An asset deleted from the library is no longer offered for the scene
+    asset_file.unlink()
+    assert service.get_scene_asset(scene_file) is None
+    assert service.get_scene_asset(None) is None
+
+
+def test_dialog_defaults_merge_order():
+    """Artist defaults give way to the template, which gives way to the asset's values"""
+    from src.services.publish_settings_service_impl import (
+        PublishSettingsService,
+        extract_settings,
+        merge_settings,
+    )
+
+    options = extract_settings(
+        dict(CRATE_PUBLISH, format=".abc", alembic={"start_frame": 1, "step": 0.5}),
+        with_asset_values=False,
+    )
+    assert options["alembic"] == {"step": 0.5, "world_space": True}  # Not the frame range
+    assert options["standin"] is None and "name" not in options
+    assert merge_settings({"format": ".ma", "proxy": "gpu_cache"}, None, {"proxy": None}) == {
+        "format": ".ma",
+        "proxy": None,
+    }
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_publish_settings_"))
+    service = PublishSettingsService(settings_file=root / "publish_settings.json")
+    asset_file = root / "tree.ma"
+    asset_file.write_text("//Maya ASCII 2024 scene\n")
+    database = FakeDatabase()
+    service.remember_publish(dict(CRATE_PUBLISH, name="tree", format=".ma"), asset_file)
+    assert database.get_asset_metadata(asset_file) is None  # No database, no asset values
+
+    template = {"name": "tree_01", "category": "Environments", "format": ".usd", "tags": []}
+    defaults = service.get_dialog_defaults(database, asset_file, template)
+    assert (defaults["name"], defaults["format"], defaults["proxy"]) == (
+        "tree_01",
+        ".usd",
+        "gpu_cache",
+    )
+
+    service.remember_publish(dict(CRATE_PUBLISH, name="tree"), asset_file, None, database)
+    defaults = service.get_dialog_defaults(database, asset_file, template)
+    assert (defaults["name"], defaults["category"], defaults["format"]) == ("tree", "Props", ".mb")
+
+    # An unreadable settings file starts over instead of failing the publish dialog
+    (root / "broken.json").write_text("{not json")
+    assert PublishSettingsService(settings_file=root / "broken.json").get_user_defaults() == {}
@@ -0,0 +1,149 @@
+"""
+Test suite for crash-safe publishing
+
+Validates that publish outputs are staged out of sight, verified before anything is
+moved into the library, and that publishes a crash interrupted are finished or cleaned
+up when the library is opened again.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from datetime import datetime, timedelta
+from pathlib import Path
+
+
+def test_staged_outputs_are_verified_before_commit():
+    """Nothing reaches the asset folder until every output is written and not empty"""
+    from src.services.publish_transaction_service_impl import (
+        STAGING_DIR_NAME,
+        PublishIncomplete,
+        PublishTransactionService,
+    )
+
+    service = PublishTransactionService()
+    scenes = Path(tempfile.mkdtemp(prefix="assetManager_transactions_")) / "scenes"
+    asset_file = scenes / "car.ma"
+
+    transaction = service.begin(asset_file, notes="first")
+    assert transaction.staging_dir.parent == scenes / STAGING_DIR_NAME
+    texture = transaction.get_staged_path(scenes / ".dependencies" / "car" / "paint.png")
+    texture.parent.mkdir(parents=True)
+    texture.write_bytes(b"PNG")
+    fbx_file = transaction.get_staged_path(scenes / "car.fbx")
+
+    # The export never finished: the asset is missing and the FBX is empty
+    fbx_file.write_bytes(b"")
+    problems = service.verify(transaction, [fbx_file])
+    assert problems == ["car.ma was not written", "car.fbx is empty"]
+    try:
+        service.commit(transaction, [fbx_file])
+    except PublishIncomplete:
+        pass
+    else:
+        raise AssertionError("An incomplete publish should not be committed")
+    assert not asset_file.exists() and not (scenes / "car.fbx").exists()
+
+    transaction.staged_file.write_text("//Maya ASCII car")
+    fbx_file.write_bytes(b"FBX")
+    moved = service.commit(transaction, [fbx_file])
+    assert moved[-1] == asset_file  # The asset file lands last
+    assert set(moved) == {asset_file, scenes / "car.fbx", scenes / ".dependencies/car/paint.png"}
+    assert asset_file.read_text() == "//Maya ASCII car"
+    service.finish(transaction)
+    assert not (scenes / STAGING_DIR_NAME).exists()
+
+    # A publish that fails part way leaves the asset as it was
+    failed = service.begin(asset_file)
+    failed.staged_file.write_text("//Maya ASCII half")
+    service.rollback(failed)
+    assert not failed.staging_dir.exists()
+    assert asset_file.read_text() == "//Maya ASCII car"
+
+
+def test_recover_interrupted_publishes():
+    """Interrupted commits are finished and versioned, abandoned staging is deleted"""
+    from src.services.publish_transaction_service_impl import PublishTransactionService
+    from src.services.version_service_impl import get_version_service
+
+    scenes = Path(tempfile.mkdtemp(prefix="assetManager_transactions_")) / "scenes"
+    crashed_session = PublishTransactionService()
+
+    # Maya dies after the first file of a commit was moved
+    interrupted = crashed_session.begin(scenes / "car.ma", notes="new paint")
+    interrupted.staged_file.write_text("//Maya ASCII car")
+    interrupted.get_staged_path(scenes / "car.fbx").write_bytes(b"FBX")
+
+    def crash(transaction):
+        staged = transaction.get_staged_files()[0]
+        staged.replace(transaction.get_target_path(staged))
+        raise RuntimeError("Maya crashed")
+
+    crashed_session._move_staged_files = crash
+    try:
+        crashed_session.commit(interrupted)
+    except RuntimeError:
+        pass
+    assert (scenes / "car.fbx").exists() and not (scenes / "car.ma").exists()
+
+    abandoned = crashed_session.begin(scenes / "tree.ma")
+    abandoned.staged_file.write_text("//Maya ASCII tree")
+    library = scenes.parent
+
+    # A publish this session is still writing is never touched
+    assert crashed_session.recover(library, datetime.now() + timedelta(days=1)) == []
+
+    # Another session may still be writing recent staging folders
+    session = PublishTransactionService()
+    assert session.recover(library) == []
+    assert session.recover(None) == []
+
+    later = datetime.now() + timedelta(days=1)
+    actions = session.recover(library, later)
+    assert actions == [
+        "Completed the interrupted publish of car.ma as v001",
+        "Removed the unfinished publish of tree.ma",
+    ]
+    assert (scenes / "car.ma").read_text() == "//Maya ASCII car"
+    assert not (scenes / "tree.ma").exists()
+    versions = get_version_service().get_versions(scenes / "car.ma")
+    assert [version.notes for version in versions] == ["new paint"]
+    assert session.find_staging_dirs(library) == []
+
+
+def test_rollback_keeps_a_commit_that_failed_part_way():
+    """Files a failed commit did not move yet stay staged for recovery to finish"""
+    from src.services.publish_transaction_service_impl import PublishTransactionService
+
+    scenes = Path(tempfile.mkdtemp(prefix="assetManager_transactions_")) / "scenes"
+    session = PublishTransactionService()
+    transaction = session.begin(scenes / "car.ma", notes="new paint")
+    transaction.staged_file.write_text("//Maya ASCII car")
+    transaction.get_staged_path(scenes / "car.fbx").write_bytes(b"FBX")
+
+    # The share drops out after the FBX was moved, before the asset file
+    replace = os.replace
+
+    def failing_replace(source, target):
+        if Path(target) == scenes / "car.ma":
+            raise OSError("Network path not found")
+        replace(source, target)
+
+    os.replace = failing_replace
+    try:
+        session.commit(transaction)
+    except OSError:
+        session.rollback(transaction)
+    else:
+        raise AssertionError("The failed move should reach the caller")
+    finally:
+        os.replace = replace
+    assert (scenes / "car.fbx").exists() and not (scenes / "car.ma").exists()
+    assert transaction.staged_file.read_text() == "//Maya ASCII car"
+
+    actions = session.recover(scenes.parent, datetime.now() + timedelta(days=1))
+    assert actions == ["Completed the interrupted publish of car.ma as v001"]
+    assert (scenes / "car.ma").read_text() == "//Maya ASCII car"
+    assert session.find_staging_dirs(scenes.parent) == []
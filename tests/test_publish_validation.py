@@ -0,0 +1,129 @@
+"""
+Test suite for the publish validation framework
+
+Validates check discovery from plugin folders, configuration, and built-in checks
+against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for the validation service and built-in checks"""
+
+    def __init__(self):
+        self.transforms = {
+            "|car": {"translate": (0, 0, 0), "rotate": (0, 0, 0), "scale": (1, 1, 1)},
+            "|car|pCube1": {"translate": (0, 2, 0), "rotate": (0, 0, 0), "scale": (1, 1, 1)},
+        }
+        self.faces = {"|car|pCube1|pCubeShape1": ["FACE 0: 0 1 2 3", "FACE 1: 0 1 2 3 4"]}
+
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            return ["|persp", "|car"]
+        return []
+
+    def listRelatives(self, node, **kwargs):
+        if kwargs.get("allDescendents"):
+            return [name for name in self.transforms if name.startswith(node + "|")]
+        if kwargs.get("shapes") and node == "|car|pCube1":
+            return ["|car|pCube1|pCubeShape1"]
+        return []
+
+    def nodeType(self, node, **kwargs):
+        return "transform"
+
+    def getAttr(self, attribute):
+        node, name = attribute.rsplit(".", 1)
+        return [self.transforms[node][name]]
+
+    def polyInfo(self, component, **kwargs):
+        return self.faces[component.split(".")[0]]
+
+
+def _make_service(root: Path):
+    from src.services.validation_service_impl import ValidationServiceImpl
+
+    return ValidationServiceImpl(
+        config_file=root / "validation.json", user_checks_dir=root / "checks"
+    )
+
+
+def test_builtin_checks_are_discovered():
+    """All six built-in checks load with their default severities"""
+    service = _make_service(Path(tempfile.mkdtemp(prefix="assetManager_validation_")))
+    checks = {check.check_id: check for check in service.discover_checks()}
+
+    assert {
+        "frozen_transforms",
+        "construction_history",
+        "naming_convention",
+        "unknown_nodes",
+        "uv_range",
+        "ngons",
+    } <= set(checks)
+    assert checks["ngons"].severity == "error"
+    assert checks["uv_range"].severity == "warning"
+
+
+def test_plugin_folder_and_configuration():
+    """Studio checks are loaded from the folder; config disables or downgrades checks"""
+    root = Path(tempfile.mkdtemp(prefix="assetManager_validation_"))
+    (root / "checks").mkdir()
+    (root / "checks" / "studio_rules.py").write_text(
+        "from src.core.interfaces.validation_check import IValidationCheck\n"
+        "\n"
+        "class AlwaysFails(IValidationCheck):\n"
+        "    check_id = 'always_fails'\n"
+        "    name = 'Always Fails'\n"
+        "    def run(self, cmds, nodes):\n"
+        "        return [self.issue('studio rule broken', nodes)]\n"
+        "\n"
+        "class Crashes(IValidationCheck):\n"
+        "    check_id = 'crashes'\n"
+        "    def run(self, cmds, nodes):\n"
+        "        raise RuntimeError('boom')\n",
+        encoding="utf-8",
+    )
+    (root / "checks" / "broken.py").write_text("import does_not_exist\n", encoding="utf-8")
+
+    service = _make_service(root)
+    for check in service.discover_checks():
+        if check.check_id not in ("always_fails", "crashes"):
+            service.set_check_config(check.check_id, enabled=False)
+    report = service.validate(FakeCmds())
+
+    assert report.checks_run == ["always_fails", "crashes"]
+    assert report.failed_checks == ["crashes"]
+    assert not report.can_publish
+    assert report.errors[0].nodes == ("|car", "|car|pCube1")
+
+    service.set_check_config("always_fails", severity="warning")
+    assert service.save_config()
+    report = _make_service(root).validate(FakeCmds())
+    assert report.can_publish
+    assert [issue.message for issue in report.warnings] == ["studio rule broken"]
+
+
+def test_frozen_transform_naming_and_ngon_checks():
+    """Built-in checks flag the unfrozen, default-named cube and its n-gon"""
+    from src.services.validation_checks.frozen_transforms import FrozenTransformsCheck
+    from src.services.validation_checks.naming_convention import NamingConventionCheck
+    from src.services.validation_checks.ngons import NgonsCheck
+
+    cmds = FakeCmds()
+    nodes = ["|car", "|car|pCube1"]
+
+    frozen = FrozenTransformsCheck().run(cmds, nodes)
+    assert frozen[0].nodes == ("|car|pCube1",)
+
+    naming = NamingConventionCheck().run(cmds, nodes)
+    assert naming[0].nodes == ("|car|pCube1",)
+    assert not naming[0].is_error
+
+    ngons = NgonsCheck().run(cmds, nodes)
+    assert ngons[0].nodes == ("|car|pCube1|pCubeShape1.f[1]",)
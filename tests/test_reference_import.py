@@ -0,0 +1,141 @@
+"""
+Test suite for referencing assets
+
+Validates namespace cleanup and uniqueness, the namespace modes and grouping options
+passed to Maya when referencing, and replacing a reference's file while it keeps its
+namespace, against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Scene recording file calls and the namespaces of its references"""
+
+    def __init__(self, namespaces=None):
+        self.namespaces = list(namespaces or [])
+        self.plugins = set()
+        self.references = {}  # reference node -> {"path", "namespace"}
+        self.calls = []
+
+    def pluginInfo(self, plugin, query=False, loaded=False):
+        return plugin in self.plugins
+
+    def loadPlugin(self, plugin, quiet=False):
+        self.plugins.add(plugin)
+
+    def namespaceInfo(self, listOnlyNamespaces=False):
+        return list(self.namespaces)
+
+    def file(self, path, **flags):
+        self.calls.append((path, flags))
+        if "loadReference" in flags:
+            self.references[flags["loadReference"]]["path"] = path
+            return path
+        namespace = flags["namespace"]
+        if namespace != ":":
+            self.namespaces.append(namespace)
+        self.references[f"{namespace.strip(':') or Path(path).stem}RN"] = {
+            "path": path,
+            "namespace": namespace,
+        }
+        return path
+
+
+def _make_integration(cmds):
+    """Get a Maya integration talking to the fake scene"""
+    from src.services.maya_integration_impl import MayaIntegrationImpl
+
+    integration = MayaIntegrationImpl()
+    integration._maya_available = True
+    integration._maya_cmds = cmds
+    return integration
+
+
+def _make_asset(path: Path):
+    """Get a library asset for a file on disk"""
+    from src.core.models.asset import Asset
+
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_text("//Maya ASCII", encoding="utf-8")
+    return Asset(
+        id=path.stem,
+        name=path.stem,
+        file_path=path,
+        file_extension=path.suffix,
+        file_size=path.stat().st_size,
+    )
+
+
+def test_namespaces_are_sanitized_and_made_unique():
+    """Asset names become valid namespaces that do not collide with the scene's"""
+    from src.services.maya_integration_impl import make_unique_namespace, sanitize_namespace
+
+    assert sanitize_namespace("red car-01") == "red_car_01"
+    assert sanitize_namespace("01_crate") == "_01_crate"
+    assert sanitize_namespace("  ") == "asset"
+
+    assert make_unique_namespace("crate", ["barrel"]) == "crate"
+    assert make_unique_namespace("crate", ["crate", "crate_1"]) == "crate_2"
+
+
+def test_reference_namespace_modes_and_grouping():
+    """Auto namespaces are made unique, none merges into root, custom ones are used as is"""
+    scenes = Path(tempfile.mkdtemp(prefix="assetManager_references_")) / "scenes"
+    crate = _make_asset(scenes / "crate.ma")
+    cmds = FakeCmds(namespaces=["crate"])
+    integration = _make_integration(cmds)
+
+    # Auto: the asset name, with a suffix when the scene already uses it
+    assert integration.reference_asset(crate)
+    path, flags = cmds.calls[-1]
+    assert path == crate.file_path.as_posix()
+    assert flags["reference"] and flags["type"] == "mayaAscii"
+    assert flags["namespace"] == "crate_1" and not flags["mergeNamespacesOnClash"]
+    assert "groupReference" not in flags and "groupName" not in flags
+
+    # None: the root namespace, merging nodes that clash
+    assert integration.reference_asset(crate, namespace="")
+    flags = cmds.calls[-1][1]
+    assert flags["namespace"] == ":" and flags["mergeNamespacesOnClash"]
+
+    # Custom, grouped under a transform
+    assert integration.reference_asset(crate, namespace="hero_crate", group_name="props_grp")
+    flags = cmds.calls[-1][1]
+    assert flags["namespace"] == "hero_crate" and not flags["mergeNamespacesOnClash"]
+    assert flags["groupReference"] and flags["groupName"] == "props_grp"
+
+    # Alembic needs its plugin; files Maya cannot reference are refused
+    assert integration.reference_asset(_make_asset(scenes / "rock.abc"))
+    assert cmds.calls[-1][1]["type"] == "Alembic" and "AbcImport" in cmds.plugins
+    calls = len(cmds.calls)
+    assert not integration.reference_asset(_make_asset(scenes / "tree.usd"))
+    missing = _make_asset(scenes / "lamp.ma")
+    missing.file_path.unlink()
+    assert not integration.reference_asset(missing)
+    assert len(cmds.calls) == calls
+
+
+def test_replace_reference_keeps_its_namespace():
+    """Replacing swaps the file on the reference node instead of referencing it again"""
+    scenes = Path(tempfile.mkdtemp(prefix="assetManager_references_")) / "scenes"
+    crate = _make_asset(scenes / "crate.ma")
+    barrel = _make_asset(scenes / "barrel.mb")
+    cmds = FakeCmds()
+    integration = _make_integration(cmds)
+    assert integration.reference_asset(crate)
+
+    assert integration.replace_reference("crateRN", barrel.file_path)
+    path, flags = cmds.calls[-1]
+    assert flags == {"loadReference": "crateRN", "type": "mayaBinary"}
+    assert cmds.references == {"crateRN": {"path": path, "namespace": "crate"}}
+    assert cmds.namespaces == ["crate"]
+
+    calls = len(cmds.calls)
+    assert not integration.replace_reference("crateRN", scenes / "missing.ma")
+    assert not integration.replace_reference("crateRN", _make_asset(scenes / "tree.usd").file_path)
+    assert len(cmds.calls) == calls
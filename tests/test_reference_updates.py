@@ -0,0 +1,232 @@
+"""
+Test suite for outdated reference detection
+
+Validates that pinned and live references are compared with the version history
+of their library asset, that updating reloads them at the latest version, and that
+reference edits the new version no longer takes are reported and resolved.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import fnmatch
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """File references keyed by reference node"""
+
+    def __init__(self, references):
+        self.references = dict(references)  # reference node -> file path
+
+    def file(self, path=None, query=False, reference=False, loadReference=None, type=None):
+        if query:
+            return [f"{node}|{file_path}" for node, file_path in self.references.items()]
+        self.references[loadReference] = path
+        return path
+
+    def referenceQuery(self, target, filename=False, referenceNode=False, **kwargs):
+        node, file_path = target.split("|")
+        if filename:
+            return file_path
+        return node if referenceNode else node.replace("RN", "")
+
+
+class EditCmds(FakeCmds):
+    """File references with reference edits applied to the nodes of the loaded file"""
+
+    def __init__(self, references, nodes, edits):
+        super().__init__(references)
+        self.nodes = dict(nodes)  # node -> attributes
+        self.edits = {node: list(strings) for node, strings in edits.items()}
+        self.next_nodes = None  # Nodes of the file the next update loads
+        self.reloads = []
+
+    def file(self, path=None, loadReference=None, **kwargs):
+        if loadReference and path is None:
+            self.reloads.append(loadReference)
+            return None
+        if loadReference and self.next_nodes is not None:
+            self.nodes = dict(self.next_nodes)
+        return super().file(path, loadReference=loadReference, **kwargs)
+
+    def referenceQuery(self, target, editStrings=False, failedEdits=False, **kwargs):
+        if not editStrings:
+            return super().referenceQuery(target, **kwargs)
+        return [edit for edit in self.edits.get(target, []) if self._fails(edit)]
+
+    def referenceEdit(self, target, removeEdits=False, changeEditTarget=None, **flags):
+        for reference_node, strings in self.edits.items():
+            for index, edit in enumerate(list(strings)):
+                if not edit.startswith(flags["editCommand"]) or not self._fails(edit):
+                    continue
+                if changeEditTarget and reference_node == target:
+                    strings[index] = edit.replace(*changeEditTarget)
+                elif removeEdits and f'"{target}"' in edit:
+                    strings.remove(edit)
+
+    def objExists(self, node):
+        return node in self.nodes
+
+    def listAttr(self, node):
+        return list(self.nodes[node])
+
+    def ls(self, pattern):
+        return fnmatch.filter(self.nodes, pattern)
+
+    def _fails(self, edit):
+        from src.services.reference_update_service_impl import parse_reference_edit
+
+        node, _, attribute = parse_reference_edit(edit)[1].partition(".")
+        return node not in self.nodes or bool(attribute and attribute not in self.nodes[node])
+
+
+def _publish(version_service, asset_file: Path, content: str, author: str) -> None:
+    asset_file.write_text(content)
+    version_service.publish_version(asset_file, notes=content, author=author)
+
+
+def test_outdated_references():
+    """Pinned references compare their version folder; live ones the version noted"""
+    from src.services.lod_service_impl import LodService
+    from src.services.reference_update_service_impl import ReferenceUpdateService
+    from src.services.version_service_impl import VersionServiceImpl
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_refupdates_"))
+    asset_file = library / "assets" / "scenes" / "crate.ma"
+    asset_file.parent.mkdir(parents=True)
+    unversioned = asset_file.parent / "tree.ma"
+    unversioned.write_text("tree")
+
+    versions = VersionServiceImpl()
+    _publish(versions, asset_file, "v1", "kim")
+    _publish(versions, asset_file, "v2", "kim")
+    pinned = versions.get_version(asset_file, 1).file_path
+
+    cmds = FakeCmds(
+        {
+            "crateRN": str(asset_file),
+            "cratePinnedRN": str(pinned),
+            "treeRN": str(unversioned),
+        }
+    )
+    service = ReferenceUpdateService(versions, LodService())
+    assert service.resolve(pinned) == (asset_file, 1)
+    assert service.resolve(asset_file) == (asset_file, None)
+
+    updates = {update.reference_node: update for update in service.scan(cmds)}
+    assert set(updates) == {"crateRN", "cratePinnedRN"}
+    assert not updates["crateRN"].is_outdated
+    assert updates["cratePinnedRN"].label == "cratePinned:cratePinnedRN v001 -> v002"
+
+    # Publishing while the scene is open leaves the live reference behind
+    _publish(versions, asset_file, "v3", "lee")
+    outdated = {update.reference_node: update for update in service.get_outdated(cmds)}
+    assert set(outdated) == {"crateRN", "cratePinnedRN"}
+    assert outdated["crateRN"].loaded_version == 2
+    assert outdated["crateRN"].latest_author == "lee"
+
+    # A reloaded reference is noted again at the latest version
+    service.forget("crateRN")
+    assert [update.reference_node for update in service.get_outdated(cmds)] == [
+        "cratePinnedRN"
+    ]
+
+    updated, errors = service.update_all(cmds, list(outdated.values()))
+    assert errors == []
+    assert cmds.references["cratePinnedRN"] == str(asset_file)
+    assert all(update.loaded_version == 3 and not update.pinned for update in updated)
+    assert service.get_outdated(cmds) == []
+
+
+def test_reference_edits_lost_on_update():
+    """Only edits failing since the update are reported, then discarded or retargeted"""
+    from src.core.models.reference_edit import EDIT_DISCARD, EDIT_KEEP, EDIT_RETARGET
+    from src.services.lod_service_impl import LodService
+    from src.services.reference_update_service_impl import (
+        ReferenceUpdateService,
+        parse_reference_edit,
+    )
+    from src.services.version_service_impl import VersionServiceImpl
+
+    assert parse_reference_edit('setAttr "crate:lid.rotateX" 45;') == (
+        "setAttr",
+        "crate:lid.rotateX",
+    )
+    assert parse_reference_edit(
+        'connectAttr "|rig|blend.output" "crate:lid.rotateY"', "crate"
+    ) == ("connectAttr", "crate:lid.rotateY")
+    assert parse_reference_edit('setAttr -type "string" "crate:lid.notes" "a b"') == (
+        "setAttr",
+        "crate:lid.notes",
+    )
+    assert parse_reference_edit("") == ("", "")
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_refedits_"))
+    asset_file = library / "assets" / "scenes" / "crate.ma"
+    asset_file.parent.mkdir(parents=True)
+    versions = VersionServiceImpl()
+    _publish(versions, asset_file, "v1", "kim")
+    _publish(versions, asset_file, "v2", "lee")
+    pinned = versions.get_version(asset_file, 1).file_path
+
+    rotate_x = 'setAttr "crate:lid.rotateX" 45'
+    rotate_y = 'setAttr "crate:lid.rotateY" 10'
+    scale = 'setAttr "crate:box.scaleTarget" 2'
+    ghost = 'setAttr "crate:ghost.visibility" 0'  # Failing before the update
+    cmds = EditCmds(
+        {"crateRN": str(pinned)},
+        {
+            "crate:lid": ["rotateX", "rotateY"],
+            "crate:box": ["scaleTarget", "visibility"],
+        },
+        {"crateRN": [rotate_x, rotate_y, scale, ghost]},
+    )
+    # Version 2 renamed the lid and the box's scaleTarget attribute
+    cmds.next_nodes = {
+        "crate:lid_geo": ["rotateX", "rotateY"],
+        "crate:box": ["scaleTargets", "visibility"],
+        "crate:root": ["visibility"],
+    }
+
+    service = ReferenceUpdateService(versions, LodService())
+    updated, errors = service.update_all(cmds, service.get_outdated(cmds))
+    assert errors == [] and len(updated) == 1
+    lost = {edit.target: edit for edit in updated[0].lost_edits}
+    assert set(lost) == {"crate:lid.rotateX", "crate:lid.rotateY", "crate:box.scaleTarget"}
+    assert lost["crate:lid.rotateX"].edits == (rotate_x,)
+    assert lost["crate:lid.rotateX"].problem == "Node crate:lid was renamed or removed"
+    assert lost["crate:box.scaleTarget"].problem == "Attribute scaleTarget was removed"
+    assert lost["crate:box.scaleTarget"].label == "setAttr box.scaleTarget"
+
+    # Close names are suggested: the renamed node, or the node's renamed attribute
+    assert service.suggest_targets(cmds, lost["crate:lid.rotateX"]) == [
+        "crate:lid_geo.rotateX"
+    ]
+    assert service.suggest_targets(cmds, lost["crate:box.scaleTarget"]) == [
+        "crate:box.scaleTargets"
+    ]
+
+    still_failed, errors = service.resolve_edits(
+        cmds,
+        [
+            (lost["crate:lid.rotateX"], EDIT_RETARGET, "crate:lid_geo.rotateX"),
+            (lost["crate:lid.rotateY"], EDIT_RETARGET, "crate:missing.rotateY"),
+            (lost["crate:box.scaleTarget"], EDIT_DISCARD, ""),
+            (lost["crate:box.scaleTarget"], EDIT_RETARGET, " "),
+        ],
+    )
+    assert len(errors) == 1 and "choose the node" in errors[0]
+    assert [edit.target for edit in still_failed] == ["crate:missing.rotateY"]
+    assert cmds.reloads == ["crateRN"]  # Once per reference to apply retargeted edits
+    assert cmds.edits["crateRN"] == [
+        'setAttr "crate:lid_geo.rotateX" 45',
+        'setAttr "crate:missing.rotateY" 10',
+        ghost,
+    ]
+
+    # Kept edits are left as they are
+    kept = [edit for edit in service.get_failed_edits(cmds, "crateRN") if "ghost" in edit.target]
+    assert service.resolve_edits(cmds, [(kept[0], EDIT_KEEP, "")]) == ([], [])
+    assert ghost in cmds.edits["crateRN"]
@@ -108,7 +108,10 @@ def test_menu_connection():
             return False
 
         # Check for connection
-        if "refresh_action.triggered.connect(self._on_refresh_library)" in content:
+        if (
+            "refresh_action.triggered.connect(lambda: self._on_refresh_library(full_scan=True))"
+            in content
+        ):
             print("✅ Menu action properly connected to _on_refresh_library")
         else:
             print("❌ Menu action connection not found")
@@ -144,7 +147,9 @@ def test_button_connection():
             return False
 
         # Check for button connection
-        if "refresh_btn.clicked.connect(self._on_refresh_library)" in content:
+        if "refresh_btn.clicked.connect(lambda: self._on_refresh_library(full_scan=True))" in (
+            content
+        ):
             print("✅ Button properly connected to _on_refresh_library")
         else:
             print("❌ Button connection not found")
@@ -0,0 +1,124 @@
+"""
+Test suite for render farm submission
+
+Validates the Deadline job files and Tractor Alfred scripts heavy publish steps are
+submitted with, and the job records that keep an asset marked as processing until
+the farm task writes its result back to the library.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def test_deadline_and_tractor_submissions():
+    """Jobs are described the way each farm expects and their job ids are read back"""
+    from src.services.render_farm_service_impl import (
+        DeadlineFarm,
+        RenderFarmError,
+        TractorFarm,
+    )
+
+    submitted = []
+
+    def deadline_runner(command):
+        submitted.append([Path(path).read_text(encoding="utf-8") for path in command[1:]])
+        return "Submitting to Repository...\nResult=Success\nJobID=64f1c2ab9e01\n"
+
+    deadline = DeadlineFarm(deadline_runner, executable="deadlinecommand")
+    command = ["/opt/maya/bin/mayapy", "farm_batch.py", "--input", "/lib/my hero.ma"]
+    assert deadline.submit("hero - Turntable render", command, "maya", 70) == "64f1c2ab9e01"
+    job_info, plugin_info = submitted[0]
+    assert "Plugin=CommandLine\n" in job_info and "Pool=maya\n" in job_info
+    assert "Priority=70\n" in job_info
+    assert "Executable=/opt/maya/bin/mayapy\n" in plugin_info
+    assert "farm_batch.py --input '/lib/my hero.ma'" in plugin_info
+
+    tractor = TractorFarm(lambda command: "OK job script accepted, jid: 1042", "tractor-spool")
+    assert tractor.submit("hero - USD conversion", command) == "1042"
+    script = tractor.build_script("hero {v2}", command, "", 50)
+    assert script.startswith("Job -title {hero \\{v2\\}} -priority 50 -service {PixarRender}")
+    remote_command = (
+        "RemoteCmd {{/opt/maya/bin/mayapy} {farm_batch.py} {--input} {/lib/my hero.ma}}"
+    )
+    assert remote_command in script
+
+    refusing = DeadlineFarm(lambda command: "Error: no pool named maya", "deadlinecommand")
+    try:
+        refusing.submit("hero", command, "maya")
+    except RenderFarmError as e:
+        assert str(e) == "Error: no pool named maya"
+    else:
+        raise AssertionError("A submission without a job id must fail")
+
+
+def test_asset_is_processing_until_the_farm_reports_back():
+    """Records are written at submission and collected once the farm task wrote a result"""
+    from src.core.interfaces.render_farm import IRenderFarm
+    from src.core.models.farm_job import FARM_STATE_COMPLETED, FARM_STATE_FAILED
+    from src.services import farm_batch
+    from src.services.render_farm_service_impl import RenderFarmError, RenderFarmService
+
+    class FakeFarm(IRenderFarm):
+        def __init__(self):
+            self.commands = []
+
+        def is_available(self):
+            return True
+
+        def submit(self, title, command, pool="", priority=50):
+            if "broken" in title:
+                raise RenderFarmError("farm offline")
+            self.commands.append(command)
+            return str(100 + len(self.commands))
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_farm_"))
+    hero = library / "assets" / "scenes" / "hero.ma"
+    farm = FakeFarm()
+    service = RenderFarmService({"deadline": farm})
+    assert service.get_available_farms() == ["deadline"]
+
+    turntable = service.submit(library, hero, "turntable", "deadline", user="kim", frames=24)
+    usd = service.submit(library, hero, "usd", "deadline", user="kim")
+    assert (turntable.job_id, usd.job_id) == ("101", "102")
+    assert [path.name for path in turntable.outputs] == [
+        "hero_turntable.mp4",
+        "hero_screenshot.png",
+    ]
+    assert usd.outputs == (hero.with_suffix(".usd"),)
+    assert sorted(job.description for job in service.get_processing(library)[str(hero)]) == [
+        "Turntable render on deadline, job 101",
+        "USD conversion on deadline, job 102",
+    ]
+
+    # The farm node gets everything it needs to write its result back
+    args = farm_batch._parse_args(farm.commands[0][2:])
+    assert (args.key, args.step, Path(args.input)) == (turntable.key, "turntable", hero)
+    assert Path(args.jobs_dir) == service.get_jobs_dir(library)
+    assert args.step_arguments[-4:] == ["--turntable", str(turntable.outputs[0]), "--frames", "24"]
+    assert farm_batch._parse_args(farm.commands[1][2:]).output == str(usd.outputs[0])
+
+    for bad in ((library / "hero.usd", "usd", "deadline"), (hero, "turntable", "tractor")):
+        try:
+            service.submit(library, *bad)
+        except RenderFarmError:
+            pass
+        else:
+            raise AssertionError(f"Submission must fail: {bad}")
+    try:
+        service.submit(library, library / "broken.ma", "usd", "deadline")
+    except RenderFarmError:
+        assert len(service.list_jobs(library)) == 2  # No record left behind
+    else:
+        raise AssertionError("A refused job must fail")
+
+    service.write_result(service.get_jobs_dir(library), turntable.key, True)
+    assert service.collect_finished(library)[0].state == FARM_STATE_COMPLETED
+    assert [job.key for job in service.list_jobs(library)] == [usd.key]
+
+    service.write_result(service.get_jobs_dir(library), usd.key, False, "mayaUsdPlugin missing")
+    failed = service.collect_finished(library)
+    assert failed[0].state == FARM_STATE_FAILED and failed[0].error == "mayaUsdPlugin missing"
+    assert service.get_processing(library) == {} and service.list_jobs(library) == []
@@ -0,0 +1,171 @@
+"""
+Test suite for rig publishing
+
+Validates the rig top node structure check, collecting controller sets into library
+metadata, and rebuilding selection and character sets on an imported or referenced
+copy of the rig.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """DAG of full paths with node types, object sets, and string attributes"""
+
+    def __init__(self):
+        self.types = {}  # full path (or set name) -> node type
+        self.set_members = {}  # set -> members
+        self.characters = {}  # character set -> members
+        self.attributes = {}
+
+    def add(self, path, node_type="transform", shape=None):
+        self.types[path] = node_type
+        if shape:
+            self.types[f"{path}|{path.rsplit('|', 1)[-1]}Shape"] = shape
+
+    def add_rig(self, namespace=""):
+        """hero_rig with geo, rig, two arm controls and a body control"""
+        ns = f"{namespace}:" if namespace else ""
+        top = f"|{ns}hero_rig"
+        self.add(top)
+        self.add(f"{top}|{ns}geo")
+        self.add(f"{top}|{ns}geo|{ns}body", shape="mesh")
+        self.add(f"{top}|{ns}rig")
+        self.add(f"{top}|{ns}rig|{ns}body_ctrl", shape="nurbsCurve")
+        self.add(f"{top}|{ns}rig|{ns}body_ctrl|{ns}arm_L_ctrl", shape="nurbsCurve")
+        self.add(f"{top}|{ns}rig|{ns}body_ctrl|{ns}arm_R_ctrl", shape="nurbsCurve")
+        return top
+
+    def _children(self, node):
+        depth = node.count("|") + 1
+        return [p for p in self.types if p.startswith(f"{node}|") and p.count("|") == depth]
+
+    # Nodes
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            return [p for p in self.types if p.startswith("|") and p.count("|") == 1]
+        if kwargs.get("type") == "objectSet":
+            sets = ("objectSet", "shadingEngine")
+            return [name for name, kind in self.types.items() if kind in sets]
+        nodes = args[0] if args else []
+        if kwargs.get("transforms"):
+            return [node for node in nodes if self.types.get(node) == "transform"]
+        return list(nodes)
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def listRelatives(self, node, children=False, allDescendents=False, shapes=False, **kwargs):
+        if shapes:
+            return [c for c in self._children(node) if self.types[c] != "transform"] or None
+        if allDescendents:
+            found = [p for p in self.types if p.startswith(f"{node}|")]
+            found = [p for p in found if self.types[p] == "transform"]
+            return list(reversed(found)) or None  # Maya lists children before parents
+        kids = [c for c in self._children(node) if self.types[c] == "transform"]
+        return kids or None
+
+    def objExists(self, node):
+        return node in self.types
+
+    # Sets
+    def sets(self, *args, query=False, name=""):
+        if query:
+            return list(self.set_members.get(args[0], []))
+        self.types[name] = "objectSet"
+        self.set_members[name] = list(args[0])
+        return name
+
+    def character(self, nodes, name):
+        self.characters[name] = list(nodes)
+        return name
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+
+def test_rig_structure_validation():
+    """A rig needs one top node with the required groups; missing controls only warn"""
+    from src.services.rig_service_impl import RIG_CHECK_ID, RigService
+
+    service = RigService()
+    cmds = FakeCmds()
+    top = cmds.add_rig()
+
+    report = service.validate_rig(cmds, [top])
+    assert report.is_clean and report.checks_run == [RIG_CHECK_ID]
+    assert len(service.find_controls(cmds, top)) == 3
+
+    cmds.add("|prop")
+    assert not service.validate_rig(cmds).can_publish  # Two top nodes in the scene
+    assert service.validate_rig(cmds, [top, f"{top}|rig"]).can_publish  # Children are skipped
+
+    # Studios require their own groups in the library settings
+    library = Path(tempfile.mkdtemp(prefix="assetManager_rigs_"))
+    settings_file = service.get_settings_file(library)
+    settings_file.parent.mkdir(parents=True)
+    settings_file.write_text(json.dumps({"required_groups": ["geo", "rig", "skeleton"]}))
+    report = service.validate_rig(cmds, [top], library)
+    assert not report.can_publish and "skeleton" in report.errors[0].message
+
+    bare = FakeCmds()
+    bare.add("|bare_rig")
+    bare.add("|bare_rig|geo")
+    bare.add("|bare_rig|bare_rig_grp")  # Only the "rig" suffix counts, not "_grp"
+    bare.add("|bare_rig|hero_rig")
+    report = service.validate_rig(bare, ["|bare_rig"])
+    assert report.can_publish and [issue.severity for issue in report.issues] == ["warning"]
+
+
+def test_controller_sets_rebuilt_on_referenced_rig():
+    """Controller sets are stored without namespaces and rebuilt on the loaded copy"""
+    from src.services.rig_service_impl import PICKER_ATTRIBUTE, RigService
+
+    service = RigService()
+    cmds = FakeCmds()
+    top = cmds.add_rig()
+    arms = [f"{top}|rig|body_ctrl|arm_L_ctrl", f"{top}|rig|body_ctrl|arm_R_ctrl"]
+    cmds.sets(arms, name="arm_ctrls")
+    cmds.sets([f"{top}|geo|body"], name="skinCluster1Set")  # Deformer set of a mesh
+    cmds.sets(arms, name="ctrlSG")
+    cmds.types["ctrlSG"] = "shadingEngine"
+
+    controller_sets = service.find_controller_sets(cmds, top)
+    assert controller_sets == {"arm_ctrls": arms}
+    metadata = service.build_rig_metadata(top, controller_sets, picker={"buttons": []})
+    assert metadata["top_node"] == "hero_rig"
+    assert metadata["controller_sets"]["arm_ctrls"] == [
+        "rig|body_ctrl|arm_L_ctrl",
+        "rig|body_ctrl|arm_R_ctrl",
+    ]
+
+    # Referenced under a namespace inside a reference group
+    scene = FakeCmds()
+    scene.add("|heroA_grp")
+    referenced = scene.add_rig("heroA")
+    for path in list(scene.types):
+        if path.startswith(referenced):
+            scene.types[f"|heroA_grp{path}"] = scene.types.pop(path)
+    created = service.setup_rig(scene, ["|heroA_grp"], metadata, "hero")
+    assert created == ["heroA:hero_arm_ctrls", "heroA:hero_character"]
+    assert scene.set_members["heroA:hero_arm_ctrls"] == [
+        "|heroA_grp|heroA:hero_rig|heroA:rig|heroA:body_ctrl|heroA:arm_L_ctrl",
+        "|heroA_grp|heroA:hero_rig|heroA:rig|heroA:body_ctrl|heroA:arm_R_ctrl",
+    ]
+    picker = scene.attributes[f"heroA:hero_character.{PICKER_ATTRIBUTE}"]
+    assert json.loads(picker) == {"buttons": []}
+
+    # Rigs published without sets get one set of every control
+    plain = FakeCmds()
+    plain_top = plain.add_rig()
+    metadata = service.build_rig_metadata(top, {}, character_set=False)
+    assert service.setup_rig(plain, [plain_top], metadata, "hero") == ["hero_controls"]
+    assert len(plain.set_members["hero_controls"]) == 3 and not plain.characters
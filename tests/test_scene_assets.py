@@ -0,0 +1,298 @@
+"""
+Test suite for the Scene Assets outliner
+
+Validates finding imported and referenced library assets in the scene, their loaded
+versions and locks, the provenance recorded at import, and updating, replacing, and
+removing them.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Top-level transforms and network nodes with attributes, plus file references"""
+
+    def __init__(self):
+        self.attributes = {}  # node -> {attribute: value}
+        self.connections = {}  # destination plug -> source node
+        self.references = {}  # reference node -> (file, namespace, nodes)
+        self.deleted = []
+        self.loaded = []
+        self.on_import = None  # Adds the nodes of cmds.file(i=True)
+
+    # Nodes
+    def ls(self, *args, **kwargs):
+        if kwargs.get("assemblies"):
+            return [node for node in self.attributes if node.startswith("|")]
+        if kwargs.get("type") == "network":
+            return [node for node in self.attributes if not node.startswith("|")]
+        if args and isinstance(args[0], str) and args[0].startswith("*."):
+            attribute = args[0][2:]
+            return [node for node, values in self.attributes.items() if attribute in values]
+        if kwargs.get("transforms"):
+            return [node for node in args[0] if not node.endswith("Shape")]
+        if args and isinstance(args[0], list):
+            return [node for node in args[0] if node in self.attributes]
+        return []
+
+    def addNode(self, node):
+        self.attributes[node] = {}
+
+    def createNode(self, node_type, name, skipSelect=False):
+        while name in self.attributes:
+            name += "1"
+        self.addNode(name)
+        return name
+
+    def rename(self, node, new_name):
+        self.attributes[new_name] = self.attributes.pop(node)
+        for plug, source in self.connections.items():
+            if source == node:
+                self.connections[plug] = new_name
+        return new_name
+
+    def attributeQuery(self, attribute, node, exists=False):
+        return attribute in self.attributes.get(node, {})
+
+    def addAttr(self, node, longName, dataType=None, attributeType=None, multi=False):
+        self.attributes[node][longName] = ""
+
+    def connectAttr(self, source_plug, destination_plug):
+        self.connections[destination_plug] = source_plug.rsplit(".", 1)[0]
+
+    def listConnections(self, plug, source=True, destination=True):
+        prefix = plug + "["
+        found = [node for dest, node in self.connections.items() if dest.startswith(prefix)]
+        return found or None
+
+    def setAttr(self, plug, value, type=None):
+        node, attribute = plug.rsplit(".", 1)
+        self.attributes[node][attribute] = value
+
+    def getAttr(self, plug):
+        node, attribute = plug.rsplit(".", 1)
+        return self.attributes[node][attribute]
+
+    def objExists(self, node):
+        return node in self.attributes
+
+    def listRelatives(self, *args, **kwargs):
+        return []
+
+    def xform(self, *args, **kwargs):
+        return [1.0] * 16
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def delete(self, nodes):
+        nodes = [nodes] if isinstance(nodes, str) else nodes
+        for node in nodes:
+            self.attributes.pop(node, None)
+            for plug, source in list(self.connections.items()):
+                if node in (source, plug.split(".", 1)[0]):
+                    del self.connections[plug]
+        self.deleted.extend(nodes)
+
+    # References
+    def referenceQuery(self, target, **kwargs):
+        if kwargs.get("isNodeReferenced"):
+            return False
+        if kwargs.get("nodes"):
+            return list(self.references[target][2])
+        for node, (file_path, namespace, _nodes) in self.references.items():
+            if target in (node, file_path):
+                if kwargs.get("filename"):
+                    return file_path
+                if kwargs.get("referenceNode"):
+                    return node
+                if kwargs.get("namespace"):
+                    return namespace
+        raise RuntimeError(f"not a reference: {target}")
+
+    def file(self, path=None, **kwargs):
+        if kwargs.get("i"):
+            self.on_import()
+            return [path]
+        if kwargs.get("query") and kwargs.get("reference"):
+            return [file_path for file_path, _ns, _nodes in self.references.values()]
+        if kwargs.get("removeReference"):
+            del self.references[kwargs["referenceNode"]]
+        elif kwargs.get("loadReference"):
+            self.loaded.append((kwargs["loadReference"], Path(path).name))
+        return None
+
+
+def _make_library():
+    """Library with a versioned crate (v001, v002) and an unversioned barrel"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_scene_assets_"))
+    scenes = library / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    crate, barrel = scenes / "crate.ma", scenes / "barrel.ma"
+    barrel.write_text("//Maya ASCII barrel")
+    versions = VersionServiceImpl()
+    crate.write_text("//Maya ASCII crate v1")
+    versions.publish_version(crate, notes="first")
+    return library, crate, barrel, versions
+
+
+def _make_service(versions, merge_import_service=None):
+    from src.services.lock_service_impl import LockServiceImpl
+    from src.services.reference_update_service_impl import ReferenceUpdateService
+    from src.services.scene_asset_service_impl import SceneAssetService
+
+    return SceneAssetService(
+        version_service=versions,
+        lock_service=LockServiceImpl(),
+        reference_update_service=ReferenceUpdateService(version_service=versions),
+        merge_import_service=merge_import_service,
+    )
+
+
+def test_scan_imported_and_referenced_assets():
+    """Tagged imports and library references are listed; other nodes are not"""
+    library, crate, barrel, versions = _make_library()
+    service = _make_service(versions)
+    cmds = FakeCmds()
+    cmds.addNode("|untracked_grp")
+
+    def importer():
+        cmds.addNode("|crate_grp")
+        cmds.addNode("|crate_lid")
+        return True
+
+    roots = service.import_tracked(cmds, crate, importer)
+    assert roots == ["|crate_grp", "|crate_lid"]
+    assert service.import_tracked(cmds, crate, lambda: False) is None
+    cmds.references["barrelRN"] = (str(barrel), "barrel", ["|barrel:root", "|barrel:rootShape"])
+    cmds.references["otherRN"] = ("/elsewhere/tree.ma", "tree", ["|tree:root"])
+
+    # A publish made after the import makes the imported crate outdated
+    crate.write_text("//Maya ASCII crate v2")
+    versions.publish_version(crate, notes="second")
+    from src.services.lock_service_impl import LockServiceImpl
+
+    LockServiceImpl().check_out(barrel, user="anna")
+
+    scene_assets = service.scan(cmds, library)
+    assert [scene_asset.label for scene_asset in scene_assets] == ["barrel:barrelRN", "crate"]
+    referenced, imported = scene_assets
+    assert imported.nodes == ("|crate_grp", "|crate_lid")
+    assert imported.version_label == "v001" and imported.is_outdated
+    assert imported.update_status == "v002 available"
+    assert referenced.is_referenced and referenced.update_status == "Not versioned"
+    assert referenced.locked_by.startswith("anna")
+    assert service.get_nodes(cmds, referenced) == ["|barrel:root"]
+
+    # Without a library only versioned references count as managed
+    assert [scene_asset.label for scene_asset in service.scan(cmds)] == ["crate"]
+
+
+def test_update_and_remove_scene_assets():
+    """Updating re-imports at latest in place; removing unloads or deletes"""
+    library, crate, barrel, versions = _make_library()
+    service = _make_service(versions)
+    cmds = FakeCmds()
+
+    def importer():
+        cmds.addNode("|crate_grp")
+        return True
+
+    service.import_tracked(cmds, crate, importer)
+    crate.write_text("//Maya ASCII crate v2")
+    versions.publish_version(crate, notes="second")
+    (imported,) = service.scan(cmds, library)
+
+    # Re-import through cmds.file creates the new root
+    cmds.on_import = importer
+    updated = service.update(cmds, imported)
+    assert cmds.deleted == ["|crate_grp", imported.provenance_node]
+    assert updated.loaded_version == 2 and not updated.is_outdated
+    assert service.read_provenance(cmds, updated.provenance_node).version == 2
+    assert service.find_provenance_nodes(cmds) == [updated.provenance_node]
+
+    service.remove(cmds, updated)
+    assert cmds.deleted[-2:] == ["|crate_grp", updated.provenance_node]
+    assert not cmds.attributes
+
+    cmds = FakeCmds()
+    cmds.references["barrelRN"] = (str(barrel), "barrel", ["|barrel:root"])
+    (referenced,) = service.scan(cmds, library)
+    service.remove(cmds, referenced)
+    assert cmds.references == {}
+
+    # Updating in place merges onto the same roots and records the latest version
+    from src.core.models.merge_import import MergeImportReport
+
+    class FakeMergeImport:
+        def merge(self, cmds, roots, asset_file, options=None):
+            return MergeImportReport(asset_file, updated=("crate_grp|body",), roots=tuple(roots))
+
+    service = _make_service(versions, merge_import_service=FakeMergeImport())
+    cmds = FakeCmds()
+    service.import_tracked(cmds, crate, importer)
+    crate.write_text("//Maya ASCII crate v3")
+    versions.publish_version(crate, notes="third")
+    (imported,) = service.scan(cmds, library)
+    merged, report = service.update_in_place(cmds, imported)
+    assert report.updated == ("crate_grp|body",) and "|crate_grp" not in cmds.deleted
+    assert merged.nodes == ("|crate_grp",) and merged.loaded_version == 3
+    assert service.find_provenance_nodes(cmds) == [merged.provenance_node]
+    try:
+        service.update_in_place(cmds, referenced)
+    except RuntimeError:
+        pass
+    else:
+        raise AssertionError("References should not be merged in place")
+
+
+def test_provenance_survives_renames():
+    """Provenance nodes identify imports after renames; replace swaps the asset"""
+    from src.core.models.asset_provenance import AssetProvenance
+    from src.services.asset_repository_impl import generate_asset_id
+
+    library, crate, barrel, versions = _make_library()
+    service = _make_service(versions)
+    cmds = FakeCmds()
+
+    def importer():
+        cmds.addNode("|crate_grp")
+        return True
+
+    service.import_tracked(cmds, crate, importer, library_root=library)
+    (node,) = service.find_provenance_nodes(cmds)
+    assert node == "crate_provenance"
+    provenance = service.read_provenance(cmds, node)
+    assert provenance.asset_id == generate_asset_id(crate)
+    assert provenance.relative_path == "assets/scenes/crate.ma"
+    assert provenance.version == 1 and provenance.import_date is not None
+    assert AssetProvenance.from_dict(provenance.to_dict()) == provenance
+    try:
+        AssetProvenance.from_dict({"asset_id": "abc"})
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Provenance without an asset file should be rejected")
+
+    # Renaming the root (or editing its namespace) keeps the connection
+    cmds.rename("|crate_grp", "|set:hero_crate")
+    (imported,) = service.scan(cmds, library)
+    assert imported.nodes == ("|set:hero_crate",)
+    assert imported.provenance == provenance and imported.label == "crate"
+
+    # Replacing imports the other asset in place with its own provenance
+    cmds.on_import = importer
+    replaced = service.replace(cmds, imported, barrel)
+    assert replaced.asset_file == barrel and replaced.nodes == ("|crate_grp",)
+    assert service.read_provenance(cmds, replaced.provenance_node).asset_file == barrel
+    assert [scene_asset.label for scene_asset in service.scan(cmds, library)] == ["barrel"]
+
+    # Deleting every member leaves nothing listed
+    cmds.delete(["|crate_grp"])
+    assert service.scan(cmds, library) == []
@@ -0,0 +1,146 @@
+"""
+Test suite for the personal scratch library
+
+Validates automatic quick export names, exporting the selection into the scratch
+library, listing it in the library picker, and promoting scratch assets into a
+curated library.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from datetime import datetime
+from pathlib import Path
+
+
+class FakeCmds:
+    """Selection and exportSelected of maya.cmds"""
+
+    def __init__(self, selection):
+        self.selection = list(selection)
+        self.exports = []  # (path, flags)
+
+    def ls(self, selection=False, **kwargs):
+        return list(self.selection) if selection else []
+
+    def file(self, path, **flags):
+        self.exports.append((path, flags))
+        Path(path).write_text("//Maya ASCII scene\n")
+
+
+class FakeDatabase:
+    """Library metadata database keeping asset metadata in memory"""
+
+    def __init__(self):
+        self.metadata = {}
+
+    def get_asset_metadata(self, asset_path):
+        return dict(self.metadata.get(str(asset_path), {})) or None
+
+    def save_asset_metadata(self, asset_path, metadata):
+        self.metadata[str(asset_path)] = dict(metadata)
+
+
+def test_quick_export_to_scratch():
+    """Exports are named after the selection and time, and never overwrite each other"""
+    from src.services.library_registry_impl import LibraryRegistryService
+    from src.services.scratch_service_impl import (
+        ScratchService,
+        make_scratch_name,
+        suggest_library_name,
+    )
+
+    now = datetime(2026, 10, 14, 15, 30, 12)
+    assert make_scratch_name(["|props|set:crate"], now=now) == "crate_20261014_153012"
+    assert make_scratch_name([], "{user}-{object}", "kim", now) == "kim-scene"
+    assert make_scratch_name(["rock 1"], "{object}_{date}", now=now) == "rock_1_20261014"
+    assert suggest_library_name("crate_20261014_153012") == "crate"
+    assert suggest_library_name("crate_20261014_153012_2") == "crate"
+    assert suggest_library_name("crate_v2") == "crate_v2"
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_scratch_"))
+    service = ScratchService(config_file=root / "scratch.json")
+    assert service.get_root() == root / "scratch"
+    try:
+        service.set_config(file_format=".fbx")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Scratch exports should only be Maya files")
+
+    try:
+        service.export_selection(FakeCmds([]))
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Exporting an empty selection should be refused")
+
+    cmds = FakeCmds(["|props|crate"])
+    first = service.export_selection(cmds, "crate_take")
+    second = service.export_selection(cmds, "crate_take")
+    assert first == root / "scratch" / "assets" / "scenes" / "crate_take.ma"
+    assert second.name == "crate_take_2.ma" and first.is_file()
+    assert cmds.exports[0][1]["exportSelected"] and cmds.exports[0][1]["type"] == "mayaAscii"
+    automatic = service.export_selection(cmds)
+    assert automatic.stem.startswith("crate_") and service.is_scratch(automatic)
+    assert set(service.get_assets()) == {first, second, automatic}
+    assert not service.is_scratch(root / "library" / "crate.ma")
+
+    # The scratch library is listed in the picker as a personal library, once
+    registry = LibraryRegistryService(config_file=root / "libraries.json")
+    entry = service.ensure_library(registry)
+    assert entry.name == "Scratch" and entry.scope == "personal"
+    assert service.ensure_library(registry) == entry
+    assert len(LibraryRegistryService(config_file=root / "libraries.json").get_libraries()) == 1
+
+    other = ScratchService(config_file=root / "other" / "scratch.json")
+    other_entry = other.ensure_library(registry)  # "Scratch" is taken by the first one
+    assert other_entry.root_path == root / "other" / "scratch" and other_entry.name != "Scratch"
+
+
+def test_promote_scratch_asset():
+    """Promoted assets get a first version and their origin; the scratch copy goes"""
+    from src.services.scratch_service_impl import PROMOTED_METADATA_KEY, ScratchService
+    from src.services.version_service_impl import get_version_service
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_scratch_promote_"))
+    library = root / "library"
+    service = ScratchService(config_file=root / "scratch.json")
+    cmds = FakeCmds(["crate"])
+    scratch_file = service.export_selection(cmds, "crate_20261014_153012")
+    database = FakeDatabase()
+
+    outside_file = root / "crate.ma"
+    outside_file.write_text("scene")
+    for source, target in ((outside_file, library), (scratch_file, service.get_root())):
+        try:
+            service.promote(source, target, "crate")
+        except ValueError:
+            continue
+        raise AssertionError(f"Promoting {source} to {target} should be refused")
+
+    asset_file, version = service.promote(scratch_file, library, "crate", database=database)
+    assert asset_file == library / "assets" / "scenes" / "crate.ma"
+    assert asset_file.read_text() == "//Maya ASCII scene\n"
+    assert not scratch_file.exists()  # Moved out of scratch
+    assert version.number == 1
+    assert get_version_service().get_versions(asset_file)[0].notes == (
+        "Promoted from scratch crate_20261014_153012.ma"
+    )
+    origin = database.get_asset_metadata(asset_file)[PROMOTED_METADATA_KEY]
+    assert origin["source"] == "crate_20261014_153012.ma" and origin["user"]
+    assert not list(asset_file.parent.glob(".*.promote"))  # No partial copies left
+
+    # Promotion never replaces a curated asset; keep_after_promote leaves the scratch copy
+    again = service.export_selection(cmds, "crate_again")
+    try:
+        service.promote(again, library, "crate")
+    except FileExistsError:
+        pass
+    else:
+        raise AssertionError("Promoting over a library asset should be refused")
+    service.set_config(keep_after_promote=True)
+    kept_file, _version = service.promote(again, library, "crate hero", notes="Hero crate")
+    assert kept_file.name == "crate_hero.ma" and again.exists()
+    assert get_version_service().get_versions(kept_file)[0].notes == "Hero crate"
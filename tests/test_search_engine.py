@@ -0,0 +1,104 @@
+"""
+Test suite for the indexed asset search engine
+
+Validates query parsing, field filters, boolean operators, and prefix matching.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from datetime import datetime
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def _asset(name, extension=".ma", tags=(), modified=None, favorite=False):
+    """Create a lightweight stand-in for a library asset"""
+    return SimpleNamespace(
+        name=name,
+        display_name=name,
+        file_path=Path("/library/assets/scenes") / f"{name}{extension}",
+        file_extension=extension,
+        asset_type="maya_scene" if extension in (".ma", ".mb") else "image",
+        category="general",
+        tags=list(tags),
+        metadata={},
+        modified_date=modified,
+        is_favorite=favorite,
+    )
+
+
+def _build_index():
+    from src.services.search_engine_impl import SearchIndex
+
+    assets = [
+        _asset("sports_car", tags=["vehicle", "hero"], modified=datetime(2024, 3, 5)),
+        _asset("truck_rig", tags=["vehicle", "wip"], modified=datetime(2024, 7, 1)),
+        _asset("brick_diffuse", ".png", tags=["texture"], modified=datetime(2023, 11, 20)),
+        _asset("walk_cycle", ".fbx", tags=["anim"], favorite=True),
+    ]
+    authors = {
+        str(assets[0].file_path): ["mike"],
+        str(assets[1].file_path): ["anna", "mike"],
+    }
+    index = SearchIndex()
+    index.build(assets, authors)
+    return index
+
+
+def _names(results):
+    return [asset.name for asset in results]
+
+
+def test_boolean_query_with_fields():
+    """tag:vehicle AND author:mike -tag:wip keeps only the finished car"""
+    index = _build_index()
+
+    assert _names(index.search("tag:vehicle AND author:mike -tag:wip")) == ["sports_car"]
+    assert _names(index.search("tag:vehicle author:mike")) == ["sports_car", "truck_rig"]
+    assert _names(index.search("tag:texture OR is:favorite")) == ["brick_diffuse", "walk_cycle"]
+    assert _names(index.search("NOT (tag:vehicle OR tag:texture)")) == ["walk_cycle"]
+    assert _names(index.search("tag:veh*")) == ["sports_car", "truck_rig"]
+
+
+def test_type_and_date_filters():
+    """type: understands model/rig/texture/anim; dates accept partial values"""
+    index = _build_index()
+
+    assert _names(index.search("type:rig")) == ["truck_rig"]
+    assert _names(index.search("type:model")) == ["sports_car"]
+    assert _names(index.search("type:texture")) == ["brick_diffuse"]
+    assert _names(index.search("type:anim")) == ["walk_cycle"]
+    assert _names(index.search("date:2024-03")) == ["sports_car"]
+    assert _names(index.search("after:2024 before:2024-06-30")) == ["sports_car"]
+
+
+def test_live_prefix_and_malformed_queries():
+    """Partial words match while typing and broken syntax never raises"""
+    index = _build_index()
+
+    assert _names(index.search("spo")) == ["sports_car"]
+    assert _names(index.search("an")) == ["truck_rig", "walk_cycle"]
+    assert _names(index.search('"sports car"')) == []
+    assert _names(index.search('"sports_car"')) == ["sports_car"]
+    assert len(index.search("")) == 4
+    assert _names(index.search("(tag:vehicle OR")) == ["sports_car", "truck_rig"]
+    assert index.search("after:someday") == []
+
+
+def test_dangling_operators_and_huge_date_ranges():
+    """Operators with nothing after them are ignored and huge ranges reach back to year 1"""
+    from src.services.search_engine_impl import parse_query
+
+    index = _build_index()
+    for query in ("NOT", "AND", "OR", "(", "NOT (", "a OR NOT", "tag:vehicle NOT )"):
+        parse_query(query)  # Never raises
+    assert parse_query("NOT") is None and parse_query("(") is None
+    assert _names(index.search("tag:vehicle AND")) == ["sports_car", "truck_rig"]
+    assert _names(index.search("tag:texture OR NOT")) == ["brick_diffuse"]
+    assert _names(index.search("tag:wip (")) == ["truck_rig"]
+
+    dated = ["sports_car", "truck_rig", "brick_diffuse"]
+    assert _names(index.search("after:999999999d")) == dated
+    assert _names(index.search("date:999999d")) == dated
+    assert _names(index.search("before:999999999d")) == []
@@ -0,0 +1,97 @@
+"""
+Test suite for ShotGrid publish registration
+
+Validates PublishedFile creation with project, task, artist, version, and thumbnail
+through a script key connection, and the behaviour when no project is known.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+class FakeShotGrid:
+    """Records shotgun_api3 calls and answers them from an in-memory entity table"""
+
+    def __init__(self):
+        self.entities = {"HumanUser": [{"type": "HumanUser", "id": 42, "name": "Mike"}]}
+        self.created = []
+        self.thumbnails = []
+
+    def find_one(self, entity_type, filters, fields=None):
+        code = filters[0][2]
+        for entity in self.entities.get(entity_type, []):
+            if entity_type == "HumanUser" or entity.get("code") == code:
+                return entity
+        return None
+
+    def create(self, entity_type, data):
+        entity = dict(data, type=entity_type, id=len(self.created) + 100)
+        self.created.append(entity)
+        self.entities.setdefault(entity_type, []).append(entity)
+        return entity
+
+    def upload_thumbnail(self, entity_type, entity_id, path):
+        self.thumbnails.append((entity_type, entity_id, path))
+
+
+def test_publish_creates_published_file():
+    """A publish creates its PublishedFileType once and links project, task, and artist"""
+    from src.services.shotgrid_service_impl import ShotGridContext, ShotGridService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_sg_"))
+    asset_file = root / "crate.ma"
+    asset_file.write_text("//Maya ASCII")
+    thumbnail = root / "crate.png"
+    thumbnail.write_bytes(b"png")
+
+    connection = FakeShotGrid()
+    service = ShotGridService(
+        config_file=root / "shotgrid.json", connection_factory=lambda config: connection
+    )
+    service.get_toolkit_engine = lambda: None
+    context = ShotGridContext(
+        project={"type": "Project", "id": 1, "name": "Robots"},
+        entity={"type": "Asset", "id": 7, "code": "Crate"},
+        task={"type": "Task", "id": 9, "content": "Model"},
+    )
+
+    published = service.publish(asset_file, 3, "Fixed UVs", thumbnail, context)
+    service.publish(asset_file, 4, "", None, context)
+
+    assert published["type"] == "PublishedFile"
+    assert published["version_number"] == 3
+    assert published["task"]["id"] == 9
+    assert published["entity"]["code"] == "Crate"
+    assert published["created_by"]["id"] == 42
+    assert published["path"] == {"local_path": str(asset_file)}
+    assert published["published_file_type"]["code"] == "Maya Scene"
+    assert context.description == "Robots > Crate > Model"
+
+    file_types = [e for e in connection.created if e["type"] == "PublishedFileType"]
+    assert len(file_types) == 1
+    assert connection.thumbnails == [("PublishedFile", published["id"], str(thumbnail))]
+
+
+def test_publish_without_project_is_skipped():
+    """Outside Toolkit the configured project is used; with none, nothing is created"""
+    from src.services.shotgrid_service_impl import ShotGridService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_sg_"))
+    connection = FakeShotGrid()
+    service = ShotGridService(
+        config_file=root / "shotgrid.json", connection_factory=lambda config: connection
+    )
+    service.get_toolkit_engine = lambda: None
+
+    assert service.publish(root / "crate.ma", 1) is None
+    assert connection.created == []
+
+    service.set_config(enabled=True, project_id=12)
+    assert service.save_config()
+    reloaded = ShotGridService(config_file=root / "shotgrid.json")
+    reloaded.get_toolkit_engine = lambda: None
+    assert reloaded.is_enabled()
+    assert reloaded.get_context().project == {"type": "Project", "id": 12}
@@ -0,0 +1,75 @@
+"""
+Test suite for the show context filter
+
+Validates reading the current show from the environment and the Maya workspace,
+listing only assets tagged for it, and tagging publishes with it.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+
+class FakeCmds:
+    """Maya workspace with variables"""
+
+    def __init__(self, variables=None):
+        self.variables = variables or {}
+
+    def workspace(self, variableEntry=None):
+        return self.variables.get(variableEntry, "")
+
+
+class FakeAsset:
+    def __init__(self, name, tags):
+        self.name = name
+        self.tags = tags
+
+
+def test_current_show_lookup_order():
+    """ASSETMANAGER_SHOW wins over the workspace, which wins over SHOW"""
+    from src.services.show_context_service_impl import ShowContextService
+
+    service = ShowContextService()
+    workspace = FakeCmds({"show": "Gotham"})
+
+    show = service.get_current_show(workspace, {"ASSETMANAGER_SHOW": "metro ", "SHOW": "x"})
+    assert (show.show, show.source) == ("metro", "ASSETMANAGER_SHOW")
+    show = service.get_current_show(workspace, {"SHOW": "x"})
+    assert (show.show, show.source, show.tag) == ("Gotham", "Maya workspace", "show:gotham")
+    assert show.description == "Gotham, from Maya workspace"
+    show = service.get_current_show(None, {"SHOW": "x"})
+    assert (show.show, show.source) == ("x", "SHOW")
+    assert service.get_current_show(FakeCmds(), {}) is None
+
+    # A name that cannot be a tag is skipped in favour of the next source
+    show = service.get_current_show(workspace, {"ASSETMANAGER_SHOW": "bad:name"})
+    assert show.show == "Gotham"
+
+
+def test_filter_assets_and_publish_tags():
+    """Assets need the show's tag or show:all; publishes get the show's tag"""
+    from src.core.models.show_context import ShowContext, get_show_tags
+    from src.services.show_context_service_impl import ShowContextService
+
+    service = ShowContextService()
+    show = ShowContext("Gotham", "ASSETMANAGER_SHOW")
+    assets = [
+        FakeAsset("batmobile", ["vehicle", "Show:Gotham"]),
+        FakeAsset("crate", ["prop", "show:all"]),
+        FakeAsset("spaceship", ["show:metro"]),
+        FakeAsset("untagged", []),
+    ]
+    assert [a.name for a in service.filter_assets(assets, show)] == ["batmobile", "crate"]
+    assert len(service.filter_assets(assets, None)) == 4
+    assert get_show_tags(["prop", " SHOW:Metro "]) == ["show:metro"]
+
+    assert service.get_publish_tags(["prop"], show) == ["prop", "show:gotham"]
+    assert service.get_publish_tags(["show:all"], show) == ["show:all"]
+    assert service.get_publish_tags(["show:metro"], show) == ["show:metro"]
+    assert service.get_publish_tags(["prop"], None) == ["prop"]
+    try:
+        ShowContext("", "SHOW")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("An empty show name should be rejected")
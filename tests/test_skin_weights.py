@@ -0,0 +1,269 @@
+"""
+Test suite for skin weight assets
+
+Validates the nearest point lookup, mapping weights by point order, world position,
+and UV, and publishing a skinned mesh's weights and applying them onto revised
+meshes against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import random
+import tempfile
+from pathlib import Path
+
+# Two quads side by side, six vertices, UVs laid out like the points
+GRID_POINTS = [(0.0, 0, 0), (1.0, 0, 0), (2.0, 0, 0), (0.0, 1.0, 0), (1.0, 1.0, 0), (2.0, 1.0, 0)]
+GRID_FACES = [[0, 1, 4, 3], [1, 2, 5, 4]]
+GRID_UVS = [(x / 2.0, y) for x, y, _z in GRID_POINTS]
+
+
+class FakeCmds:
+    """Meshes with points, faces and UVs, transforms, and the skinClusters made on them"""
+
+    def __init__(self, meshes, joints):
+        self.meshes = {name: dict(mesh) for name, mesh in meshes.items()}
+        self.joints = list(joints)
+        self.skin_clusters = {}  # skinCluster -> {"mesh", "matrix": {index: joint}, ...}
+        self.attributes = {}
+
+    def _mesh(self, component):
+        return self.meshes[component.split(".")[0]]
+
+    def polyInfo(self, mesh, faceToVertex=False):
+        return [
+            f"FACE {index:6d}:" + "".join(f"{v:7d}" for v in face) + " \n"
+            for index, face in enumerate(self.meshes[mesh]["faces"])
+        ]
+
+    def polyEvaluate(self, mesh, vertex=False, edge=False, face=False, uvcoord=False):
+        data = self.meshes[mesh]
+        if vertex:
+            return len(data["points"])
+        if face:
+            return len(data["faces"])
+        if uvcoord:
+            return len([uv for uv in data.get("uvs", []) if uv])
+        faces = data["faces"]
+        return len({tuple(sorted((f[i], f[(i + 1) % len(f)]))) for f in faces for i in range(4)})
+
+    def xform(self, component, query=False, worldSpace=False, translation=False):
+        return [value for point in self._mesh(component)["points"] for value in point]
+
+    def polyListComponentConversion(self, component, fromVertex=False, toUV=False):
+        vertex = int(component.rsplit("[", 1)[1].rstrip("]"))
+        uvs = self._mesh(component).get("uvs", [])
+        if vertex < len(uvs) and uvs[vertex]:
+            return [f"{component.split('.')[0]}.map[{vertex}]"]
+        return []
+
+    def polyEditUV(self, component, query=False):
+        vertex = int(component.rsplit("[", 1)[1].rstrip("]"))
+        return list(self._mesh(component)["uvs"][vertex])
+
+    def ls(self, nodes=None, type=None, long=False, dag=False, noIntermediate=False):
+        if type == "mesh":
+            return [node for node in nodes or self.meshes if node in self.meshes]
+        if type == "skinCluster":
+            return [node for node in nodes if node in self.skin_clusters]
+        if nodes.startswith("*:"):
+            return [joint for joint in self.joints if joint.endswith(nodes[1:])]
+        return [nodes] if nodes in self.joints else []
+
+    def listRelatives(self, shape, parent=False, fullPath=False):
+        return [shape]
+
+    def listHistory(self, mesh):
+        return [name for name, skin in self.skin_clusters.items() if skin["mesh"] == mesh]
+
+    def skinCluster(
+        self,
+        *args,
+        query=False,
+        influence=False,
+        edit=False,
+        addInfluence=None,
+        weight=0.0,
+        name="",
+        **flags,
+    ):
+        if query:
+            return list(self.skin_clusters[args[0]]["matrix"].values())
+        if edit:
+            matrix = self.skin_clusters[args[0]]["matrix"]
+            matrix[max(matrix) + 2] = addInfluence  # Logical indices can have gaps
+            return None
+        *joints, mesh = args
+        self.skin_clusters[name] = {"mesh": mesh, "matrix": dict(enumerate(joints))}
+        self.attributes[f"{name}.maxInfluences"] = flags["maximumInfluences"]
+        self.attributes[f"{name}.skinningMethod"] = flags["skinMethod"]
+        return [name]
+
+    def skinPercent(self, skin_cluster, component, query=False, value=False):
+        vertex = int(component.rsplit("[", 1)[1].rstrip("]"))
+        matrix = self.skin_clusters[skin_cluster]["matrix"]
+        plug = f"{skin_cluster}.weightList[{vertex}].weights"
+        return [self.attributes.get(f"{plug}[{index}]", 0.0) for index in matrix]
+
+    def getAttr(self, plug, multiIndices=False):
+        if plug.endswith(".matrix"):
+            return list(self.skin_clusters[plug.split(".")[0]]["matrix"])
+        if multiIndices:
+            prefix = plug + "["
+            return [int(key[len(prefix):-1]) for key in self.attributes if key.startswith(prefix)]
+        return self.attributes.get(plug)
+
+    def listConnections(self, plug, source=False):
+        skin_cluster, attribute = plug.split(".")
+        index = int(attribute[len("matrix["):-1])
+        return [self.skin_clusters[skin_cluster]["matrix"][index]]
+
+    def setAttr(self, plug, value):
+        self.attributes[plug] = value
+
+    def get_weights(self, skin_cluster, vertex):
+        """Get a vertex's non-zero weights by joint, for the assertions"""
+        matrix = self.skin_clusters[skin_cluster]["matrix"]
+        plug = f"{skin_cluster}.weightList[{vertex}].weights"
+        weights = {matrix[i]: self.attributes.get(f"{plug}[{i}]", 0.0) for i in matrix}
+        return {joint: round(weight, 4) for joint, weight in weights.items() if weight}
+
+
+def test_nearest_points_and_transfer_methods():
+    """The grid lookup finds the true nearest point; each method maps the right vertex"""
+    from src.services.skin_weights_service_impl import (
+        METHOD_POINT_ORDER,
+        METHOD_UV,
+        METHOD_WORLD_POSITION,
+        NearestPoints,
+        normalize_weights,
+        transfer_weights,
+    )
+
+    generator = random.Random(7)
+    for dimensions in (2, 3):
+        points = [[generator.uniform(-5, 5) for _ in range(dimensions)] for _ in range(300)]
+        lookup = NearestPoints(points)
+        for _ in range(100):
+            query = [generator.uniform(-8, 8) for _ in range(dimensions)]
+            distances = [sum((a - b) ** 2 for a, b in zip(p, query)) for p in points]
+            assert distances[lookup.nearest(query)] == min(distances)
+    assert NearestPoints([[1.0, 1.0, 1.0]] * 3).nearest([9.0, 9.0, 9.0]) == 0
+
+    # Three vertices along x, each fully on a different influence
+    stored = {
+        "name": "arm_geo",
+        "points": [[0.0, 0, 0], [1.0, 0, 0], [2.0, 0, 0]],
+        "uvs": [[0.0, 0.0], [0.5, 0.0], None],
+        "weights": [[[0, 1.0]], [[1, 1.0]], [[2, 1.0]]],
+    }
+    # The revision is mirrored in space, the UV layout is kept, and it has one more vertex
+    points = [[2.0, 0, 0], [1.1, 0, 0], [0.1, 0, 0], [1.9, 0, 0]]
+    uvs = [[0.02, 0.0], [0.49, 0.01], None, [0.01, 0.0]]
+
+    weights, fallback = transfer_weights(stored, METHOD_POINT_ORDER, points)
+    assert weights == [[[0, 1.0]], [[1, 1.0]], [[2, 1.0]], [[2, 1.0]]] and fallback == 1
+    weights, fallback = transfer_weights(stored, METHOD_WORLD_POSITION, points)
+    assert weights == [[[2, 1.0]], [[1, 1.0]], [[0, 1.0]], [[2, 1.0]]] and fallback == 0
+    weights, fallback = transfer_weights(stored, METHOD_UV, points, uvs)
+    assert weights == [[[0, 1.0]], [[1, 1.0]], [[0, 1.0]], [[0, 1.0]]] and fallback == 1
+    try:
+        transfer_weights(dict(stored, uvs=[]), METHOD_UV, points, uvs)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("UV transfer needs published UVs")
+
+    # Weights on influences missing from the scene go to the vertex's other influences
+    assert normalize_weights([[0, 0.5], [1, 0.25], [2, 0.25]], {0: 4, 2: 6}) == [
+        (4, 0.666667),
+        (6, 0.333333),
+    ]
+    assert normalize_weights([[1, 1.0]], {0: 4}) == []
+
+
+def test_publish_and_apply_to_revised_meshes():
+    """Weights apply by point order to the same topology and by UV to a re-ordered mesh"""
+    from src.services.skin_weights_service_impl import (
+        METHOD_AUTO,
+        METHOD_POINT_ORDER,
+        METHOD_UV,
+        SkinWeightsService,
+    )
+
+    # The revision lists its vertices in reverse and sits two units up
+    reordered = list(reversed(range(6)))
+    revision = {
+        "points": [(x, y + 2.0, z) for x, y, z in reversed(GRID_POINTS)],
+        "faces": [[reordered.index(v) for v in face] for face in GRID_FACES],
+        "uvs": list(reversed(GRID_UVS)),
+    }
+    cmds = FakeCmds(
+        {
+            "body_geo": {"points": GRID_POINTS, "faces": GRID_FACES, "uvs": GRID_UVS},
+            "hero:body_geo": {"points": GRID_POINTS, "faces": GRID_FACES},
+            "body_geo_v2": revision,
+        },
+        ["root", "arm", "hero:root"],
+    )
+    cmds.skinCluster(
+        "root", "arm", "body_geo", maximumInfluences=2, skinMethod=0, name="skinCluster1"
+    )
+    for vertex in range(6):
+        arm = GRID_POINTS[vertex][0] / 2.0  # Blends from root to arm along x
+        cmds.setAttr(f"skinCluster1.weightList[{vertex}].weights[0]", 1.0 - arm)
+        cmds.setAttr(f"skinCluster1.weightList[{vertex}].weights[1]", arm)
+
+    service = SkinWeightsService()
+    assert service.find_skin_cluster(cmds, "body_geo") == "skinCluster1"
+    assert service.read_mesh_weights(cmds, "hero:body_geo") is None
+    skin_weights = service.create_skin_weights(cmds, ["body_geo", "hero:body_geo"], "body_skin")
+    assert [entry["name"] for entry in skin_weights["meshes"]] == ["body_geo"]
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_skin_weights_"))
+    weights_path = service.save_skin_weights(root / "skinweights" / "body_skin", skin_weights)
+    assert weights_path.name == "body_skin.skinweights"
+    loaded = service.load_skin_weights(weights_path)
+    entry = service.get_mesh_weights(loaded, "body_geo")
+    assert entry["influences"] == ["root", "arm"] and entry["max_influences"] == 2
+    assert entry["weights"][0] == [[0, 1.0]] and entry["weights"][4] == [[0, 0.5], [1, 0.5]]
+    assert service.suggest_targets(cmds, loaded, ["|hero:body_geo", "body_geo_v2"]) == {
+        "body_geo": "|hero:body_geo"
+    }
+
+    # The same topology in a rig without an arm: point order, a new skinCluster on the
+    # joint in the mesh's namespace, and the arm's weights folded into the root
+    cmds.joints.remove("arm")
+    assert service.choose_method(cmds, entry, "hero:body_geo") == METHOD_POINT_ORDER
+    result = service.apply_skin_weights(cmds, entry, "hero:body_geo", METHOD_AUTO)
+    assert result.success and result.created and result.method == METHOD_POINT_ORDER
+    assert result.influences == ["root"] and result.missing_influences == ["arm"]
+    assert cmds.skin_clusters["body_geo_skinCluster"]["matrix"] == {0: "hero:root"}
+    assert cmds.get_weights("body_geo_skinCluster", 1) == {"hero:root": 1.0}
+    assert cmds.get_weights("body_geo_skinCluster", 2) == {}  # Keeps its bind weights
+    cmds.joints.append("arm")
+
+    # The re-ordered revision matches by UV, even though it moved in space
+    assert service.check_topology(cmds, entry, "body_geo_v2")
+    assert service.choose_method(cmds, entry, "body_geo_v2") == METHOD_UV
+    result = service.apply_skin_weights(cmds, entry, "body_geo_v2")
+    assert result.method == METHOD_UV and result.fallback_vertices == 0
+    skin_cluster = result.skin_cluster
+    for vertex, (x, _y, _z) in enumerate(revision["points"]):
+        expected = {"root": round(1.0 - x / 2.0, 4), "arm": round(x / 2.0, 4)}
+        assert cmds.get_weights(skin_cluster, vertex) == {
+            joint: weight for joint, weight in expected.items() if weight
+        }
+
+    # Re-applying onto an existing skinCluster adds missing influences and replaces weights
+    cmds.skin_clusters[skin_cluster]["matrix"] = {0: "arm"}
+    cmds.setAttr(f"{skin_cluster}.weightList[5].weights[0]", 0.9)
+    result = service.apply_skin_weights(cmds, entry, "body_geo_v2", METHOD_UV)
+    assert not result.created and result.skin_cluster == skin_cluster
+    assert cmds.skin_clusters[skin_cluster]["matrix"] == {0: "arm", 2: "root"}
+    assert cmds.get_weights(skin_cluster, 5) == {"root": 1.0}  # Was GRID vertex 0
+    assert cmds.attributes[f"{skin_cluster}.normalizeWeights"] == 1
+
+    (root / "other.skinweights").write_text('{"type": "pose"}')
+    assert service.load_skin_weights(root / "other.skinweights") is None
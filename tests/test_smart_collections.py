@@ -0,0 +1,105 @@
+"""
+Test suite for manual and smart collections
+
+Validates collection membership by asset key, the legacy file name fallback, and smart
+collections that re-run a saved query with relative dates.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from datetime import date, datetime, timedelta
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def _asset(name, tags=(), modified=None):
+    """Create a lightweight stand-in for a library asset"""
+    return SimpleNamespace(
+        name=name,
+        display_name=name,
+        file_path=Path("/library/assets/env") / f"{name}.ma",
+        file_extension=".ma",
+        asset_type="maya_scene",
+        category="general",
+        tags=list(tags),
+        metadata={},
+        modified_date=modified,
+        is_favorite=False,
+    )
+
+
+def _key_of(asset):
+    return asset.file_path.relative_to("/library").as_posix()
+
+
+def test_manual_collection_members():
+    """Members resolve by key in collection order; old collections still match file names"""
+    from src.services.collection_service_impl import CollectionService, make_collection
+    from src.services.search_engine_impl import SearchIndex
+
+    assets = [_asset("rock"), _asset("tree"), _asset("bush")]
+    index = SearchIndex()
+    index.build(assets)
+    service = CollectionService()
+
+    data = make_collection("Forest set")
+    assert service.add_members(data, ["assets/env/tree.ma", "assets/env/rock.ma"]) == 2
+    assert service.add_members(data, ["assets/env/rock.ma"]) == 0
+    members = service.resolve_members(data, assets, index, _key_of)
+    assert [asset.name for asset in members] == ["tree", "rock"]
+
+    legacy = {"description": "", "assets": ["bush.ma", "missing.ma"]}
+    assert [a.name for a in service.resolve_members(legacy, assets, index, _key_of)] == ["bush"]
+
+    assert service.remove_members(data, ["assets/env/tree.ma"]) == 1
+    assert data["assets"] == ["assets/env/rock.ma"]
+
+
+def test_smart_collection_follows_library():
+    """A saved query with a relative date picks up newly tagged and recently changed assets"""
+    from src.services.collection_service_impl import (
+        CollectionService,
+        is_smart_collection,
+        make_collection,
+    )
+    from src.services.search_engine_impl import SearchIndex
+
+    now = datetime.now()
+    assets = [
+        _asset("cliff", ["environment"], now - timedelta(days=2)),
+        _asset("ruins", ["environment"], now - timedelta(days=30)),
+        _asset("crate", ["prop"], now),
+    ]
+    index = SearchIndex()
+    index.build(assets)
+    service = CollectionService()
+
+    data = make_collection("Fresh environments", "tag:environment updated:7d")
+    assert is_smart_collection(data)
+    assert service.add_members(data, ["assets/env/crate.ma"]) == 0
+    assert [a.name for a in service.resolve_members(data, assets, index, _key_of)] == ["cliff"]
+
+    # Tagging another asset and rebuilding the index updates the collection
+    assets[2].tags.append("environment")
+    index.build(assets)
+    members = service.resolve_members(data, assets, index, _key_of)
+    assert sorted(a.name for a in members) == ["cliff", "crate"]
+
+
+def test_relative_date_ranges():
+    """Nd / Nw / Nm count back from today; before: with a relative value is exclusive"""
+    from src.services.search_engine_impl import SearchIndex, _parse_date_range
+
+    today = date(2024, 6, 15)
+    assert _parse_date_range("7d", today) == (date(2024, 6, 8), today)
+    assert _parse_date_range("2w", today) == (date(2024, 6, 1), today)
+    assert _parse_date_range("1m", today) == (date(2024, 5, 16), today)
+    assert _parse_date_range("yesterday", today) == (date(2024, 6, 14), date(2024, 6, 14))
+    assert _parse_date_range("7x", today) is None
+
+    now = datetime.now()
+    assets = [_asset("old", modified=now - timedelta(days=40)), _asset("new", modified=now)]
+    index = SearchIndex()
+    index.build(assets)
+    assert [a.name for a in index.search("before:30d")] == ["old"]
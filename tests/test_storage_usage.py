@@ -0,0 +1,126 @@
+"""
+Test suite for library storage usage and quotas
+
+Validates measuring a library by category and asset with everything stored for each
+asset, keeping one size snapshot per day for growth, and warning when a publish or the
+nightly stats refresh passes a quota.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from datetime import datetime, timedelta
+from pathlib import Path
+
+MEGABYTE = 1024 * 1024
+
+
+def _make_library():
+    """Library with a heavy cached prop, a light one, a character, and a trashed asset"""
+    library = Path(tempfile.mkdtemp(prefix="assetManager_storage_"))
+    props = library / "assets" / "props"
+    (props / ".versions" / "crate").mkdir(parents=True)
+    (props / ".thumbnails").mkdir()
+    (props / "crate.ma").write_bytes(b"m" * MEGABYTE)
+    (props / "crate.fbx").write_bytes(b"f" * MEGABYTE)  # FBX handoff of the scene
+    (props / ".versions" / "crate" / "v001.abc").write_bytes(b"c" * 3 * MEGABYTE)
+    (props / ".thumbnails" / "crate_screenshot.png").write_bytes(b"p" * 1024)
+    (props / "cup.obj").write_bytes(b"o" * 1024)
+    characters = library / "assets" / "characters"
+    characters.mkdir()
+    (characters / "hero.mb").write_bytes(b"h" * 2 * MEGABYTE)
+    (characters / "notes.txt").write_text("not an asset")
+    (library / ".trash").mkdir()
+    (library / ".trash" / "old.ma").write_bytes(b"t" * MEGABYTE)
+    return library, props
+
+
+def test_library_measured_by_category_and_asset_with_daily_history():
+    """Asset sizes include versions and handoffs once; snapshots track growth per day"""
+    from src.core.models.storage_usage import StorageQuota, format_size
+    from src.services.storage_service_impl import StorageService
+
+    service = StorageService()
+    library, props = _make_library()
+    monday = datetime(2026, 3, 2, 9, 0)
+
+    report = service.measure(library, now=monday)
+    assert report.total_bytes == 8 * MEGABYTE + 2 * 1024 + len("not an asset")
+    assert report.category_bytes["props"] == 5 * MEGABYTE + 2 * 1024
+    assert set(report.category_bytes) == {"characters", "props"}  # Trash is no category
+
+    crate, hero, cup = report.get_largest(3)
+    assert (crate.name, crate.size_bytes, crate.file_count) == ("crate", 5 * MEGABYTE + 1024, 4)
+    assert (hero.category, hero.size_bytes) == ("characters", 2 * MEGABYTE)
+    assert cup.name == "cup" and len(report.assets) == 3  # crate.fbx belongs to the scene
+    assert [usage.name for usage in report.get_category_assets("props")] == ["crate", "cup"]
+    assert report.warnings == () and report.summary == "3 asset(s), 8.0 MB"
+
+    # A second measurement the same day replaces the first, later days add snapshots
+    (props / "barrel.ma").write_bytes(b"b" * MEGABYTE)
+    service.measure(library, now=monday + timedelta(hours=3))
+    report = service.measure(library, now=monday + timedelta(days=8))
+    assert [snapshot.day.day for snapshot in report.history] == [2, 10]
+    assert report.get_growth(7) == 0 and report.get_growth(30) is None
+    assert report.history[0].asset_count == 4
+    (props / "lamp.ma").write_bytes(b"l" * 2 * MEGABYTE)
+    preview = service.measure(library, record=False, now=monday + timedelta(days=9))
+    growth = preview.get_growth(7)  # Since 2 March: the lamp and a longer history file
+    assert 2 * MEGABYTE < growth < 2 * MEGABYTE + 1024
+    assert len(service.get_history(library)) == 2
+
+    assert format_size(5 * MEGABYTE + 1024) == "5.0 MB" and format_size(512) == "512 B"
+    try:
+        StorageQuota(warn_percent=0)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("A warning threshold of 0% should be rejected")
+
+
+def test_quotas_warn_on_publish_and_fail_stats_refresh():
+    """Quota settings are saved with the library and checked at publish and by maintenance"""
+    from src.core.models.storage_usage import StorageQuota
+    from src.services.maintenance_service_impl import MaintenanceService
+    from src.services.storage_service_impl import StorageService
+
+    service = StorageService()
+    library, props = _make_library()
+    gigabyte = 1024**3
+    quota = StorageQuota(
+        library_gb=20 * MEGABYTE / gigabyte,
+        asset_gb=4 * MEGABYTE / gigabyte,
+        category_gb={"props": 5.5 * MEGABYTE / gigabyte},
+        warn_percent=80,
+    )
+    assert service.load_quota(library) == StorageQuota() and not StorageQuota().is_set
+    assert service.save_quota(library, quota)
+    assert service.load_quota(library) == quota
+
+    # The crate with its cache is over the asset limit; props is at 91% of its quota
+    warnings = service.check_publish(library, props / "crate.ma")
+    assert [(w.scope, w.name, w.is_exceeded) for w in warnings] == [
+        ("asset", "crate", True),
+        ("category", "props", False),
+    ]
+    assert warnings[0].description == "Asset 'crate' uses 5.0 MB of 4.0 MB (125%)"
+    assert service.check_publish(library, library / "assets" / "characters" / "hero.mb") == []
+
+    report = service.measure(library)
+    assert [(w.scope, w.name) for w in report.warnings] == [
+        ("asset", "crate"),
+        ("category", "props"),
+    ]
+    assert len(report.exceeded) == 1
+
+    # The nightly stats refresh records the snapshot and fails while a quota is passed
+    result = MaintenanceService().run_task(library, "stats")
+    assert not result.success and result.summary.endswith(", 2 quota warning(s)")
+    assert result.details[0] == warnings[0].description
+    stats = json.loads((library / ".assetmanager" / "library_stats.json").read_text())
+    assert stats["disk_bytes"] == service.get_history(library)[-1].total_bytes
+
+    (props / ".versions" / "crate" / "v001.abc").unlink()
+    assert MaintenanceService().run_task(library, "stats").success
@@ -0,0 +1,90 @@
+"""
+Test suite for hierarchical tags
+
+Validates tidying typed tags, building the tag tree, and renaming, merging, and
+deleting tags (and the tags below them) on every asset of a library.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+from types import SimpleNamespace
+
+
+def test_tag_parsing_and_tree():
+    """Typed tags are tidied and nest into a tree with parent tags filled in"""
+    from src.core.models.tag_hierarchy import (
+        build_tag_tree,
+        is_tag_within,
+        normalize_tag,
+        parse_tags,
+        tag_ancestors,
+    )
+
+    assert normalize_tag("  Environment / exterior\\\\forest  ") == "Environment/exterior/forest"
+    assert parse_tags("char, Char , environment//forest,,") == ["char", "environment/forest"]
+    assert tag_ancestors("environment/exterior/forest") == ["environment", "environment/exterior"]
+    assert is_tag_within("Environment/Exterior", "environment")
+    assert not is_tag_within("environmental", "environment")
+
+    tree = build_tag_tree(
+        {"environment/exterior/forest": 3, "environment/interior": 1, "prop": 2}, ["WIP"]
+    )
+    assert [node.path for node in tree] == ["environment", "prop", "WIP"]
+    environment = tree[0]
+    assert environment.uses == 0 and environment.total_uses == 4
+    assert [child.name for child in environment.children] == ["exterior", "interior"]
+    assert environment.children[0].children[0].path == "environment/exterior/forest"
+
+
+def test_rename_merge_and_delete_library_wide():
+    """Changes reach sub-tags, the database, .meta sidecars, and tag: searches"""
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.search_engine_impl import SearchIndex
+    from src.services.tag_service_impl import TagService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_tags_"))
+    scenes = root / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    database = MetadataDatabase(root)
+    tags = {
+        "tree.ma": ["environment/exterior/forest", "chars"],
+        "house.ma": ["environment/exterior", "character"],
+        "kid.ma": ["character", "chars/kids", "wip"],
+    }
+    for name, asset_tags in tags.items():
+        database.save_asset_metadata(scenes / name, {"tags": asset_tags})
+    (scenes / "tree.ma.meta").write_text(json.dumps({"tags": tags["tree.ma"]}))
+
+    service = TagService()
+    changed = service.rename_tag(database, "environment", " env ")
+    assert changed == ["assets/scenes/house.ma", "assets/scenes/tree.ma"]
+    sidecar = json.loads((scenes / "tree.ma.meta").read_text())
+    assert sidecar["tags"] == ["env/exterior/forest", "chars"]
+
+    service.merge_tags(database, ["chars", "character"], "characters")
+    kid_tags = database.get_asset_metadata(scenes / "kid.ma")["tags"]
+    assert sorted(kid_tags) == ["characters", "characters/kids", "wip"]
+    assert "env" in service.get_known_tags(database) and "chars" not in database.get_all_tags()
+
+    assert service.delete_tag(database, "WIP") == ["assets/scenes/kid.ma"]
+    assert database.get_all_tags() == {
+        "characters": 3,
+        "characters/kids": 1,
+        "env/exterior": 1,
+        "env/exterior/forest": 1,
+    }
+
+    index = SearchIndex()
+    index.build(
+        [
+            SimpleNamespace(name=Path(key).stem, file_path=root / key, tags=data["tags"])
+            for key, data in sorted(database.get_all_metadata().items())
+        ]
+    )
+    assert [asset.name for asset in index.search("tag:env")] == ["house", "tree"]
+    assert [asset.name for asset in index.search("tag:env/exterior/forest")] == ["tree"]
+    database.close()
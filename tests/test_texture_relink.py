@@ -0,0 +1,121 @@
+"""
+Test suite for the texture relink subsystem
+
+Validates search-root lookup of missing textures (UDIM tiles, paths from other
+machines, folder-based disambiguation) against a stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Texture nodes with their file path attributes"""
+
+    def __init__(self, paths):
+        self.attributes = {f"{node}.fileTextureName": path for node, path in paths.items()}
+        self.undo_chunks = []
+
+    def ls(self, nodes=None, type=None):
+        if type != "file":
+            return []
+        names = [attribute.split(".")[0] for attribute in self.attributes]
+        return [name for name in names if nodes is None or name in nodes]
+
+    def getAttr(self, attribute):
+        return self.attributes[attribute]
+
+    def setAttr(self, attribute, value, type=None):
+        self.attributes[attribute] = value
+
+    def undoInfo(self, openChunk=False, closeChunk=False, chunkName=""):
+        self.undo_chunks.append("open" if openChunk else "close")
+
+
+def _touch(path: Path) -> Path:
+    path.parent.mkdir(parents=True, exist_ok=True)
+    path.write_bytes(b"texture")
+    return path
+
+
+def test_find_texture():
+    """Plain names, UDIM tokens, and foreign paths resolve; folder names break ties"""
+    from src.services.texture_relink_service_impl import TextureRelinkService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_relink_"))
+    _touch(root / "ship" / "textures" / "hull_1001.png")
+    _touch(root / "ship" / "textures" / "hull_1002.png")
+    _touch(root / "ship" / "old" / "Paint.png")
+    _touch(root / "car" / "textures" / "paint.png")
+    _touch(root / ".backup" / "wheel.png")
+
+    service = TextureRelinkService(config_file=root / "config.json")
+    index = service.build_index([root])
+
+    found = service.find_texture("D:\\jobs\\ship\\textures\\hull_<UDIM>.png", index)
+    assert found == (root / "ship" / "textures" / "hull_<UDIM>.png").as_posix()
+    found = service.find_texture("/mnt/car/textures/paint.png", index)
+    assert found == (root / "car" / "textures" / "paint.png").as_posix()
+    found = service.find_texture("C:/anything/ship/old/paint.png", index)
+    assert found == (root / "ship" / "old" / "Paint.png").as_posix()
+    assert service.find_texture("/mnt/hull.####.png", index) is None
+    assert service.find_texture("/mnt/wheel.png", index) is None
+    assert service.is_tokenized("hull.<UDIM>.exr") and not service.is_tokenized("hull.exr")
+
+
+def test_relink_scene_and_config():
+    """Missing textures are relinked in one undo chunk; the rest stay missing"""
+    from src.services.texture_relink_service_impl import (
+        ROOTS_ENVIRONMENT,
+        STATUS_FOUND,
+        STATUS_MISSING,
+        STATUS_RELINKED,
+        TextureRelinkService,
+    )
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_relink_"))
+    textures = library / "assets" / "textures"
+    present = _touch(library / "assets" / "scenes" / "ship" / "deck.png")
+    _touch(textures / "hull_1001.exr")
+    studio = Path(tempfile.mkdtemp(prefix="assetManager_relink_studio_"))
+    _touch(studio / "rope.tif")
+
+    cmds = FakeCmds(
+        {
+            "deckFile": present.as_posix(),
+            "hullFile": "Z:/ship/hull_<UDIM>.exr",
+            "ropeFile": "/home/bob/rope.tif",
+            "sailFile": "/home/bob/sail.tif",
+        }
+    )
+    service = TextureRelinkService(config_file=library / "relink.json")
+    service.set_configured_roots([studio, studio])
+    assert service.save_config()
+    assert TextureRelinkService(config_file=library / "relink.json").get_configured_roots() == [
+        studio
+    ]
+
+    os.environ[ROOTS_ENVIRONMENT] = str(library / "missing")
+    try:
+        roots = service.get_search_roots(library, present)
+    finally:
+        del os.environ[ROOTS_ENVIRONMENT]
+    assert roots == [present.parent, textures, studio]
+
+    links = {link.node: link for link in service.relink(cmds, roots)}
+    assert links["deckFile"].status == STATUS_FOUND
+    assert links["hullFile"].status == STATUS_RELINKED
+    assert cmds.attributes["hullFile.fileTextureName"] == (textures / "hull_<UDIM>.exr").as_posix()
+    assert links["ropeFile"].path == (studio / "rope.tif").as_posix()
+    assert links["ropeFile"].original_path == "/home/bob/rope.tif"
+    assert links["sailFile"].status == STATUS_MISSING and not links["sailFile"].is_resolved
+    assert cmds.undo_chunks == ["open", "close"]
+
+    # Imports only touch the nodes they created
+    fixed = service.set_texture_path(cmds, links["sailFile"], present.as_posix())
+    assert fixed.status == STATUS_RELINKED
+    assert [link.node for link in service.relink(cmds, roots, ["ropeFile"])] == ["ropeFile"]
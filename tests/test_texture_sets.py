@@ -0,0 +1,141 @@
+"""
+Test suite for texture set assets
+
+Validates grouping texture maps and UDIM tiles into one set, publishing them with
+per-map colorspaces, and wiring them into Arnold and Maya standard surface shaders.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Typed nodes with attribute values and connections"""
+
+    def __init__(self):
+        self.types = {}  # node -> node type
+        self.attributes = {}  # plug -> value
+        self.connections = {}  # destination plug -> source plug
+
+    def nodeType(self, node):
+        return self.types[node]
+
+    def shadingNode(self, node_type, asTexture=False, asUtility=False, asShader=False, name=""):
+        self.types[name] = node_type
+        return name
+
+    def connectAttr(self, source, destination, force=False):
+        self.connections[destination] = source
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+    def listConnections(self, node, type=None, **kwargs):
+        engines = [
+            destination.split(".")[0]
+            for destination, source in self.connections.items()
+            if source.split(".")[0] == node
+        ]
+        return [engine for engine in engines if type is None or self.types[engine] == type]
+
+
+def _make_textures():
+    """hull maps: two base color and roughness UDIM tiles, one normal map, one stray file"""
+    source = Path(tempfile.mkdtemp(prefix="assetManager_textures_"))
+    for tile in (1001, 1002):
+        (source / f"hull_BaseColor.{tile}.png").write_bytes(b"PNG%d" % tile)
+        (source / f"hull_Roughness.{tile}.png").write_bytes(b"PNG")
+    (source / "hull_Normal.exr").write_bytes(b"EXR")
+    (source / "hull_preview.png").write_bytes(b"PNG")
+    return source
+
+
+def test_group_and_publish_texture_set():
+    """Tiles become one map, colorspaces default per map, and files land in the library"""
+    from src.services.texture_set_service_impl import (
+        TextureSetService,
+        detect_map_type,
+        suggest_set_name,
+    )
+
+    assert detect_map_type("hull_Base_Color.1001.png") == "basecolor"
+    assert detect_map_type("hull_rough.tif") == "roughness"
+    assert detect_map_type("hull_preview.png") is None
+    assert suggest_set_name("hull_paint_BaseColor.<UDIM>.png") == "hull_paint"
+
+    service = TextureSetService()
+    source = _make_textures()
+    maps, unmatched = service.group_maps(sorted(source.iterdir()))
+    assert sorted(maps) == ["basecolor", "normal", "roughness"]
+    assert maps["basecolor"]["source"] == source / "hull_BaseColor.<UDIM>.png"
+    assert maps["basecolor"]["tiles"] == [1001, 1002]
+    assert maps["basecolor"]["colorspace"] == "sRGB"
+    assert maps["normal"]["colorspace"] == "Raw" and maps["normal"]["tiles"] == []
+    assert unmatched == [source / "hull_preview.png"]
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_texsets_"))
+    maps["roughness"]["colorspace"] = "Utility - Raw"  # Changed in the publish dialog
+    saved = service.save_texture_set(maps, library / "assets" / "textures" / "hull", "Ship hull")
+    assert saved == library / "assets" / "textures" / "hull.texset"
+
+    descriptor = service.load_texture_set(saved)
+    basecolor = descriptor["maps"]["basecolor"]
+    assert basecolor == {
+        "file": ".texsets/hull/hull_BaseColor.<UDIM>.png",
+        "colorspace": "sRGB",
+        "udim": True,
+        "tiles": [1001, 1002],
+    }
+    assert descriptor["maps"]["roughness"]["colorspace"] == "Utility - Raw"
+    assert (saved.parent / ".texsets" / "hull" / "hull_BaseColor.1002.png").is_file()
+    thumbnail = saved.parent / ".thumbnails" / "hull_screenshot.png"
+    assert thumbnail.read_bytes() == b"PNG1001"
+
+    # Other JSON files are not texture sets
+    other = library / "other.texset"
+    other.write_text(json.dumps({"type": "material"}))
+    assert service.load_texture_set(other) is None
+
+
+def test_apply_texture_set_to_shaders():
+    """Maps connect with their colorspace; normals go through the renderer's node"""
+    from src.services.texture_set_service_impl import UV_TILING_UDIM, TextureSetService
+
+    service = TextureSetService()
+    library = Path(tempfile.mkdtemp(prefix="assetManager_texsets_"))
+    maps, _unmatched = service.group_maps(sorted(_make_textures().iterdir()))
+    saved = service.save_texture_set(maps, library / "hull.texset")
+
+    cmds = FakeCmds()
+    cmds.types.update({"hullShader": "aiStandardSurface", "hullSG": "shadingEngine"})
+    cmds.connectAttr("hullShader.outColor", "hullSG.surfaceShader")
+    assert service.find_target_shaders(cmds, ["hullShader"]) == ["hullShader"]
+
+    file_nodes = service.apply_texture_set(cmds, saved, "hullShader")
+    assert file_nodes == ["hull_basecolor", "hull_normal", "hull_roughness"]
+    assert cmds.connections["hullShader.baseColor"] == "hull_basecolor.outColor"
+    assert cmds.connections["hullShader.specularRoughness"] == "hull_roughness.outAlpha"
+    assert cmds.connections["hullShader.normalCamera"] == "hull_normal_normal.outValue"
+    assert cmds.types["hull_normal_normal"] == "aiNormalMap"
+    assert cmds.attributes["hull_basecolor.colorSpace"] == "sRGB"
+    assert cmds.attributes["hull_roughness.colorSpace"] == "Raw"
+    assert cmds.attributes["hull_basecolor.ignoreColorSpaceFileRules"] is True
+    assert cmds.attributes["hull_basecolor.uvTilingMode"] == UV_TILING_UDIM
+    assert cmds.attributes["hull_normal.uvTilingMode"] == 0
+    assert cmds.attributes["hull_basecolor.fileTextureName"].endswith(
+        ".texsets/hull/hull_BaseColor.<UDIM>.png"
+    )
+
+    # Maya's standard surface uses a tangent space bump2d for the normal map
+    cmds = FakeCmds()
+    cmds.types["paintShader"] = "standardSurface"
+    service.apply_texture_set(cmds, saved, "paintShader")
+    assert cmds.connections["paintShader.normalCamera"] == "hull_normal_bump.outNormal"
+    assert cmds.attributes["hull_normal_bump.bumpInterp"] == 1
+
+    cmds.types["oldShader"] = "lambert"
+    assert service.apply_texture_set(cmds, saved, "oldShader") == []
@@ -0,0 +1,84 @@
+"""
+Test suite for the bounded thumbnail image cache
+
+Validates least recently used eviction, per-variant entries, decoding again after a
+thumbnail file changes, and images that cannot be decoded.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+from pathlib import Path
+
+
+def _thumbnails(count):
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_thumb_cache_"))
+    paths = []
+    for index in range(count):
+        path = folder / f"thumb_{index}.png"
+        path.write_bytes(b"png")
+        paths.append(path)
+    return paths
+
+
+def test_least_recently_used_eviction():
+    """The cap holds; the image shown longest ago is the one dropped"""
+    from src.services.thumbnail_cache_impl import ThumbnailCache
+
+    decoded = []
+
+    def loader(path, variant):
+        decoded.append((path.name, variant))
+        return f"{path.name}:{variant}"
+
+    first, second, third = _thumbnails(3)
+    cache = ThumbnailCache(loader, max_items=2)
+    assert cache.get(first) == "thumb_0.png:"
+    assert cache.get(second, "approved") == "thumb_1.png:approved"
+    assert cache.get(first) == "thumb_0.png:"  # Hit - first is now the most recent
+    assert cache.hits == 1 and cache.misses == 2
+
+    cache.get(third)
+    assert len(cache) == 2
+    decoded.clear()
+    cache.get(first)
+    cache.get(second, "approved")
+    assert decoded == [("thumb_1.png", "approved")]  # Second was evicted, first kept
+
+    cache.get(second)  # Variants are separate entries
+    assert decoded[-1] == ("thumb_1.png", "")
+    assert cache.peek(third) is None  # Peeking never decodes
+    assert cache.peek(second) == "thumb_1.png:"
+    cache.set_max_items(1)
+    assert len(cache) == 1
+
+
+def test_changed_and_missing_files():
+    """A rewritten thumbnail is decoded again; missing or undecodable files are not kept"""
+    from src.services.thumbnail_cache_impl import ThumbnailCache
+
+    decoded = []
+
+    def loader(path, variant):
+        decoded.append(path.name)
+        return None if b"broken" in path.read_bytes() else path.read_bytes()
+
+    (thumbnail,) = _thumbnails(1)
+    cache = ThumbnailCache(loader)
+    assert cache.get(thumbnail) == b"png"
+    assert cache.get(thumbnail) == b"png" and len(decoded) == 1
+
+    thumbnail.write_bytes(b"png2")
+    stat = thumbnail.stat()
+    os.utime(thumbnail, ns=(stat.st_atime_ns, stat.st_mtime_ns + 1_000_000_000))
+    assert cache.get(thumbnail) == b"png2" and len(decoded) == 2
+
+    cache.discard(thumbnail)
+    assert len(cache) == 0
+
+    thumbnail.write_bytes(b"broken")
+    assert cache.get(thumbnail) is None and len(cache) == 0
+    thumbnail.unlink()
+    assert cache.get(thumbnail) is None
@@ -0,0 +1,87 @@
+"""
+Test suite for the background thumbnail decoder
+
+Validates finding the grid rows in view by bisection, prefetching ahead of the scroll
+direction, and decoding on worker threads into the shared thumbnail cache, skipping
+images already decoded and requests that went stale.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+import threading
+from pathlib import Path
+
+
+def test_visible_and_prefetched_rows():
+    """Rows in view are found in a few lookups; prefetch follows the scroll, nearest first"""
+    from src.services.thumbnail_decoder_impl import find_visible_rows, plan_prefetch_rows
+
+    # 10,000 items, 5 per grid row of 100 pixels; the view shows pixels 1000-1299
+    lookups = []
+
+    def row_top(row):
+        lookups.append(row)
+        return (row // 5) * 100
+
+    def row_bottom(row):
+        return row_top(row) + 99
+
+    assert find_visible_rows(10000, row_top, row_bottom, 1000, 1299) == (50, 64)
+    assert len(lookups) < 40
+    assert find_visible_rows(10000, row_top, row_bottom, 0, 99) == (0, 4)
+    assert find_visible_rows(10, row_top, row_bottom, 1000, 1299) is None
+    assert find_visible_rows(0, row_top, row_bottom, 0, 99) is None
+
+    assert plan_prefetch_rows(50, 64, 10000, 1) == list(range(65, 95))
+    assert plan_prefetch_rows(50, 64, 10000, -1) == list(range(49, 19, -1))
+    assert plan_prefetch_rows(10, 14, 10000, -1, screens=4) == list(range(9, -1, -1))
+    assert plan_prefetch_rows(9990, 9999, 10000, 1) == []
+
+
+def test_decodes_on_workers_into_the_cache():
+    """Only uncached, still wanted images are read, off the calling thread"""
+    from src.services.thumbnail_cache_impl import ThumbnailCache
+    from src.services.thumbnail_decoder_impl import ThumbnailDecoder
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_thumb_decoder_"))
+    paths = []
+    for index in range(4):
+        paths.append(folder / f"thumb_{index}.png")
+        paths[-1].write_bytes(b"png")
+
+    gate = threading.Event()
+    decoded = []
+
+    def loader(path, variant):
+        gate.wait(5)
+        decoded.append((path.name, threading.current_thread().name))
+        return f"{path.name}:{variant}"
+
+    notified = []
+    cache = ThumbnailCache(loader)
+    decoder = ThumbnailDecoder(cache, max_workers=1)
+    decoder.add_decoded_callback(lambda path, variant: notified.append(path.name))
+    try:
+        assert decoder.request([(paths[0], "64:"), (paths[1], "64:")]) == 2
+        # Scrolled on before the worker got to thumb_1: it is dropped unread
+        assert decoder.request([(paths[0], "64:"), (paths[2], "64:")]) == 1
+        gate.set()
+        decoder.wait(5)
+        assert [name for name, _thread in decoded] == ["thumb_0.png", "thumb_2.png"]
+        assert all(thread.startswith("thumbdecode") for _name, thread in decoded)
+        assert notified == ["thumb_0.png", "thumb_2.png"]
+        assert cache.peek(paths[2], "64:") == "thumb_2.png:64:"
+        assert decoder.pending_count() == 0
+
+        # Cached images are not read again unless asked to refresh
+        assert decoder.request([(paths[0], "64:"), (paths[2], "64:")]) == 0
+        assert decoder.request([(paths[0], "64:")], refresh=[(paths[0], "64:")]) == 1
+        decoder.wait(5)
+        assert len(decoded) == 2  # Unchanged file, the cache hit is kept
+        assert decoder.request([(paths[3], "128:")]) == 1
+        decoder.wait(5)
+        assert decoded[-1][0] == "thumb_3.png"
+    finally:
+        decoder.shutdown()
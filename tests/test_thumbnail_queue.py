@@ -0,0 +1,71 @@
+"""
+Test suite for the background thumbnail queue
+
+Validates job output paths, de-duplication, callbacks, and batch command lines.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+
+def test_jobs_run_in_background_and_notify():
+    """Finished jobs should report status through callbacks"""
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_thumbs_"))
+    finished = []
+    queue = ThumbnailQueue(runner=lambda job: job.asset_path.stem != "broken")
+    queue.add_finished_callback(finished.append)
+
+    jobs = queue.enqueue_many([root / "hero.ma", root / "broken.ma"])
+    queue.wait(timeout=5)
+
+    assert len(jobs) == 2
+    assert sorted(job.status for job in finished) == ["done", "failed"]
+    assert queue.pending_count() == 0
+    queue.clear_finished()
+    assert queue.get_jobs() == []
+
+
+def test_duplicate_requests_are_ignored_while_pending():
+    """Queuing the same asset twice should not render it twice"""
+    import threading
+
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+
+    release = threading.Event()
+    queue = ThumbnailQueue(runner=lambda job: release.wait(5))
+    asset = Path(tempfile.mkdtemp(prefix="assetManager_thumbs_")) / "hero.ma"
+
+    assert queue.enqueue(asset) is not None
+    assert queue.enqueue(asset) is None
+    release.set()
+    queue.wait(timeout=5)
+    assert queue.enqueue(asset) is not None
+    queue.wait(timeout=5)
+
+
+def test_batch_command_and_output_paths():
+    """Outputs should land in .thumbnails where the library looks for screenshots"""
+    from src.services.thumbnail_queue_impl import ThumbnailJob, ThumbnailQueue, get_turntable_path
+
+    asset = Path("/library/assets/scenes/hero.ma")
+    job = ThumbnailJob(asset, turntable_format=".gif", frames=24, size=256)
+
+    assert job.still_path == Path("/library/assets/scenes/.thumbnails/hero_screenshot.png")
+    assert job.turntable_path == get_turntable_path(asset, ".gif")
+
+    command = ThumbnailQueue(runner=lambda job: True).build_batch_command(job, Path("mayapy"))
+    assert command[0] == "mayapy"
+    assert command[1].endswith("thumbnail_batch.py")
+    assert command[command.index("--frames") + 1] == "24"
+    assert "--turntable" in command
+
+    still_only = ThumbnailJob(asset)
+    assert still_only.turntable_path is None
+    assert "--turntable" not in ThumbnailQueue(runner=lambda j: True).build_batch_command(
+        still_only, Path("mayapy")
+    )
@@ -0,0 +1,102 @@
+"""
+Test suite for thumbnail capture settings
+
+Validates library thumbnail settings per asset type, an asset's own scene camera
+winning over its type's settings, and the thumbnail batch arguments they produce.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+from pathlib import Path
+
+MATRIX = [1.0, 0, 0, 0, 0, 1.0, 0, 0, 0, 0, 1.0, 0, 4.0, 2.5, 12.0, 1.0]
+
+
+class FakeCmds:
+    """A scene with one camera (shotCam) and one mesh"""
+
+    def nodeType(self, node):
+        return "camera" if node.endswith("Shape") else "transform"
+
+    def listRelatives(self, node, parent=False, shapes=False, type=None, fullPath=False):
+        if parent:
+            return ["|shotCam"]
+        return ["|shotCam|shotCamShape"] if node == "|shotCam" else []
+
+    def xform(self, node, query=False, worldSpace=False, matrix=False):
+        return list(MATRIX)
+
+    def getAttr(self, plug):
+        return 50.0
+
+
+def test_settings_per_asset_type_and_asset():
+    """Asset types override the library default; an asset's own camera wins over both"""
+    from src.core.models.thumbnail_settings import ThumbnailSettings
+    from src.services.metadata_database_impl import get_metadata_database
+    from src.services.thumbnail_settings_service_impl import ThumbnailSettingsService
+
+    service = ThumbnailSettingsService()
+    library = Path(tempfile.mkdtemp(prefix="assetManager_thumbnail_settings_"))
+    assert service.load_settings(library) == (ThumbnailSettings(), {})
+
+    default = ThumbnailSettings(background=(0.1, 0.1, 0.1))
+    environments = ThumbnailSettings(camera="top", lighting=False, resolution=1024)
+    assert service.save_settings(library, default, {"Environments": environments})
+    assert service.load_settings(library) == (default, {"Environments": environments})
+    assert service.get_settings_for_asset_type(library, "Props") == default
+    assert environments.description == "Top camera, asset lighting, 1024 x 1024"
+
+    database = get_metadata_database(library)
+    street = library / "assets" / "scenes" / "street.ma"
+    database.save_asset_metadata(street, {"asset_type": "Environments"})
+    assert service.get_asset_settings(library, street, database) == environments
+    assert service.get_asset_settings(library, street) == default
+
+    custom = service.capture_camera(FakeCmds(), "|shotCam|shotCamShape", environments)
+    assert custom.is_custom and custom.focal_length == 50.0
+    assert custom.camera_matrix[12:15] == (4.0, 2.5, 12.0)
+    assert custom.resolution == 1024 and not custom.lighting
+    service.set_asset_settings(database, street, custom)
+    assert service.get_asset_settings(library, street, database) == custom
+    assert database.get_asset_metadata(street)["asset_type"] == "Environments"
+    service.set_asset_settings(database, street, None)
+    assert service.get_asset_settings(library, street, database) == environments
+
+
+def test_queued_jobs_render_with_their_settings():
+    """Jobs take the settings' resolution and pass camera and lighting to mayapy"""
+    from src.core.models.thumbnail_settings import ThumbnailSettings
+    from src.services.thumbnail_queue_impl import ThumbnailQueue
+    from src.services.thumbnail_settings_service_impl import ThumbnailSettingsService
+
+    queue = ThumbnailQueue(max_workers=1, runner=lambda job: True)
+    root = Path(tempfile.mkdtemp(prefix="assetManager_thumbnail_settings_"))
+    top = ThumbnailSettings(camera="top", lighting=False, resolution=1024)
+    custom = ThumbnailSettingsService().capture_camera(FakeCmds(), "|shotCam")
+    chosen = {root / "street.ma": top, root / "hero.ma": custom}
+
+    street, hero = queue.enqueue_many([root / "street.ma", root / "hero.ma"], chosen.get)
+    plain = queue.enqueue(root / "crate.ma", size=256)
+    queue.wait()
+    assert (street.size, hero.size, plain.size) == (1024, 512, 256)
+
+    command = queue.build_batch_command(street, Path("mayapy"))
+    assert command[command.index("--camera") + 1] == "top"
+    assert command[command.index("--background") + 1] == "0.36,0.36,0.36"
+    assert "--no-lighting" in command and "--camera-matrix" not in command
+
+    command = queue.build_batch_command(hero, Path("mayapy"))
+    assert command[command.index("--camera-matrix") + 1] == "1,0,0,0,0,1,0,0,0,0,1,0,4,2.5,12,1"
+    assert command[command.index("--focal-length") + 1] == "50"
+    assert "--no-lighting" not in command
+    assert "--camera" not in queue.build_batch_command(plain, Path("mayapy"))
+
+    # The batch script reads the same arguments back
+    from src.services.thumbnail_batch import _parse_args
+
+    args = _parse_args(["--input", "a.ma", "--still", "a.png"] + command[8:])
+    assert args.camera == "custom" and args.camera_matrix[13] == 2.5
+    assert args.focal_length == 50.0 and not args.no_lighting
@@ -0,0 +1,183 @@
+"""
+Test suite for undo chunks around import and apply operations
+
+Validates that operations are one named undo step, that failures are rolled back by
+undoing the chunk, and that nodes and namespaces are deleted when undo cannot.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+
+class FakeCmds:
+    """Scene whose undo queue records chunks like Maya, dropping empty ones"""
+
+    def __init__(self, undo_state=True):
+        self.nodes = {"|persp": "transform", "lambert1": "lambert"}
+        self.namespaces = []
+        self.locked = set()
+        self.undo_state = undo_state
+        self.queue = []  # (chunk name, scene before it)
+        self.depth = 0
+        self.undone = []
+
+    def _snapshot(self):
+        return dict(self.nodes), list(self.namespaces)
+
+    def create(self, node, node_type="transform", namespace=""):
+        if namespace and namespace not in self.namespaces:
+            self.namespaces.append(namespace)
+        self.nodes[node] = node_type
+
+    def undoInfo(self, query=False, state=None, undoName=False, openChunk=False, **kwargs):
+        if query and undoName:
+            return self.queue[-1][0] if self.queue else ""
+        if query:
+            return self.undo_state
+        if openChunk:
+            if self.depth == 0:
+                self.before = (kwargs["chunkName"], self._snapshot())
+            self.depth += 1
+        elif kwargs.get("closeChunk"):
+            self.depth -= 1
+            name, before = self.before
+            if self.depth == 0 and self.undo_state and before != self._snapshot():
+                self.queue.append((name, before))
+
+    def undo(self):
+        name, (nodes, namespaces) = self.queue.pop()
+        self.nodes, self.namespaces = nodes, namespaces
+        self.undone.append(name)
+
+    def ls(self, long=False):
+        return list(self.nodes)
+
+    def namespaceInfo(self, root, listOnlyNamespaces, recurse):
+        return ["UI", "shared"] + self.namespaces
+
+    def namespace(self, exists=None, removeNamespace=None, deleteNamespaceContent=False):
+        if exists is not None:
+            return exists in self.namespaces
+        self.namespaces.remove(removeNamespace)
+        for node in [n for n in self.nodes if n.lstrip("|").startswith(f"{removeNamespace}:")]:
+            del self.nodes[node]
+
+    def objExists(self, node):
+        return node in self.nodes
+
+    def nodeType(self, node):
+        return self.nodes[node]
+
+    def lockNode(self, node, query=False, lock=True):
+        if query:
+            return [node in self.locked]
+        self.locked.discard(node)
+
+    def delete(self, node):
+        if node in self.locked:
+            raise RuntimeError(f"Cannot delete locked node '{node}'")
+        for child in [n for n in self.nodes if n == node or n.startswith(f"{node}|")]:
+            del self.nodes[child]
+
+    def file(self, referenceNode, removeReference):
+        del self.nodes[referenceNode]
+
+
+def test_operations_are_one_undo_step_rolled_back_on_failure():
+    """Nested work is one chunk; failures raise or abort and are undone once"""
+    from src.services.undo_service_impl import UndoService
+
+    cmds = FakeCmds()
+    service = UndoService()
+    with service.chunk(cmds, "Import crate") as chunk:
+        assert service.is_open
+        cmds.create("|crate")
+        with service.chunk(cmds, "assignMaterial"):
+            cmds.create("crate_mtl", "lambert")
+    assert not service.is_open and not chunk.aborted
+    assert [name for name, _before in cmds.queue] == ["Import crate"]
+    assert "|crate" in cmds.nodes and cmds.undone == []
+
+    try:
+        with service.chunk(cmds, "Import barrel") as chunk:
+            cmds.create("|barrel")
+            cmds.create("|barrel|lid")
+            raise RuntimeError("FBX plugin not available")
+    except RuntimeError:
+        pass
+    else:
+        raise AssertionError("The failure should reach the caller")
+    assert chunk.aborted and chunk.rolled_back and cmds.undone == ["Import barrel"]
+    assert "|barrel" not in cmds.nodes and "|crate" in cmds.nodes
+
+    # An aborted inner chunk rolls back the whole operation, once
+    with service.chunk(cmds, "Import lamp") as outer:
+        cmds.create("|lamp")
+        with service.chunk(cmds, "Import lamp") as inner:
+            cmds.create("|lamp|bulb")
+            inner.abort()
+        assert not inner.rolled_back and "|lamp|bulb" in cmds.nodes
+    assert outer.aborted and outer.rolled_back
+    assert cmds.undone == ["Import barrel", "Import lamp"] and "|lamp" not in cmds.nodes
+
+    # A failure that changed nothing leaves the artist's earlier steps alone
+    with service.chunk(cmds, "applyPose") as chunk:
+        chunk.abort()
+    assert chunk.rolled_back and cmds.undone == ["Import barrel", "Import lamp"]
+    assert [name for name, _before in cmds.queue] == ["Import crate"]
+
+
+def test_empty_failure_keeps_the_previous_step_of_the_same_name():
+    """An empty failed chunk does not undo the artist's earlier step with its name"""
+    from src.services.undo_service_impl import UndoService
+
+    cmds = FakeCmds()
+    service = UndoService()
+    with service.chunk(cmds, "applyPose"):
+        cmds.create("|pose_locator")
+    with service.chunk(cmds, "applyPose") as chunk:
+        chunk.abort()
+    assert chunk.rolled_back and cmds.undone == []
+    assert "|pose_locator" in cmds.nodes
+    assert [name for name, _before in cmds.queue] == ["applyPose"]
+
+    # A failure that did change the scene is still undone as its own step
+    try:
+        with service.chunk(cmds, "applyPose"):
+            cmds.create("|pose_locator1")
+            raise RuntimeError("Pose does not match the rig")
+    except RuntimeError:
+        pass
+    assert cmds.undone == ["applyPose"] and "|pose_locator1" not in cmds.nodes
+    assert "|pose_locator" in cmds.nodes and len(cmds.queue) == 1
+
+
+def test_rollback_deletes_what_undo_cannot_take_back():
+    """Without undo, the nodes, references, and namespaces a failure left are deleted"""
+    from src.services.undo_service_impl import UndoService
+
+    cmds = FakeCmds(undo_state=False)
+    cmds.create("|old:crate", namespace="old")
+    service = UndoService()
+    with service.chunk(cmds, "Import crate") as chunk:
+        cmds.create("|crate1:crate", namespace="crate1")
+        cmds.create("|crate1:crate|crate1:lid")
+        cmds.create("crate1:crate_mtl", "lambert")
+        cmds.create("crateRN", "reference")
+        cmds.create("crate_rig_set", "objectSet")
+        cmds.locked.add("crate_rig_set")
+        chunk.abort()
+    assert chunk.rolled_back and cmds.undone == []
+    assert cmds.nodes == {"|persp": "transform", "lambert1": "lambert", "|old:crate": "transform"}
+    assert cmds.namespaces == ["old"]
+
+    # Nodes that cannot be deleted are reported, not hidden
+    class StubbornCmds(FakeCmds):
+        def delete(self, node):
+            raise RuntimeError("Cannot delete default node")
+
+    cmds = StubbornCmds(undo_state=False)
+    with service.chunk(cmds, "Import crate") as chunk:
+        cmds.create("|crate")
+        chunk.abort()
+    assert chunk.aborted and not chunk.rolled_back and "|crate" in cmds.nodes
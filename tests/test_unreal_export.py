@@ -0,0 +1,118 @@
+"""
+Test suite for the Send to Unreal publish target
+
+Validates the Content folder layout and naming, the editor import script, and
+remote import results against stand-ins for maya.cmds, maya.mel, and the editor.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import re
+import tempfile
+from pathlib import Path
+
+
+class FakeCmds:
+    """Just enough of maya.cmds for an FBX export"""
+
+    def pluginInfo(self, plugin, **kwargs):
+        return True
+
+    def playbackOptions(self, **kwargs):
+        return 1.0 if kwargs.get("minTime") else 24.0
+
+    def select(self, nodes, replace=False):
+        self.selected = list(nodes)
+
+
+def _run_mel(executed):
+    def run(command):
+        executed.append(command)
+        match = re.match(r'FBXExport -f "(.+?)"', command)
+        if match:
+            Path(match.group(1)).write_bytes(b"Kaydara FBX Binary")
+
+    return run
+
+
+def test_send_to_content_folder_and_import():
+    """The FBX lands where it imports to, and the editor runs an unattended import"""
+    from src.services.unreal_service_impl import UnrealService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_unreal_"))
+    asset_file = root / "library" / "assets" / "scenes" / "wooden crate.ma"
+    content_dir = root / "MyGame" / "Content"
+    game_path = "/Game/AssetManager/Props/wooden_crate/SM_wooden_crate"
+    commands = []
+
+    def editor(command):
+        commands.append(command)
+        return {"success": True, "output": [{"type": "Info", "output": game_path}]}
+
+    service = UnrealService(config_file=root / "unreal.json", remote_runner=editor)
+    service.set_config(content_dir=str(content_dir))
+    executed = []
+    result = service.send(FakeCmds(), asset_file, "Props", ["crate_geo"], None, _run_mel(executed))
+
+    expected = content_dir / "AssetManager" / "Props" / "wooden_crate" / "SM_wooden_crate.fbx"
+    assert result.fbx_file == expected and expected.is_file()
+    assert result.imported and result.game_path == game_path
+    assert result.imported_paths == (game_path,)
+    # The built-in Unreal preset: Z up, triangulated
+    assert "FBXExportUpAxis z" in executed and "FBXExportTriangulate -v true" in executed
+
+    script = commands[0]
+    assert f'task.filename = "{expected.as_posix()}"' in script
+    assert 'task.destination_path = "/Game/AssetManager/Props/wooden_crate"' in script
+    assert "task.automated = True" in script and "options.import_as_skeletal = False" in script
+    compile(script, "import_script", "exec")
+
+    rig_script = service.build_import_command(expected, "/Game/Rigs", "SK_Hero", "Rigs")
+    assert "options.import_as_skeletal = True" in rig_script
+    assert service.get_asset_name("walk", "Animations") == "A_walk"
+
+
+def test_unreachable_editor_keeps_fbx_by_asset():
+    """Without a Content folder the FBX sits by the asset; a failed import never raises"""
+    from src.services.unreal_service_impl import (
+        UnrealService,
+        decode_message,
+        encode_message,
+    )
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_unreal_"))
+    asset_file = root / "scenes" / "hero.ma"
+    asset_file.parent.mkdir(parents=True)
+
+    def no_editor(command):
+        raise RuntimeError("No Unreal Editor answered")
+
+    service = UnrealService(config_file=root / "unreal.json", remote_runner=no_editor)
+    result = service.send(FakeCmds(), asset_file, "Characters", [], None, _run_mel([]))
+    assert result.fbx_file == asset_file.parent / "SK_hero.fbx" and result.fbx_file.is_file()
+    assert not result.imported and "No Unreal Editor" in result.message
+
+    failing = UnrealService(
+        config_file=root / "unreal.json",
+        remote_runner=lambda command: {"success": False, "result": "Import failed"},
+    )
+    assert failing.send(FakeCmds(), asset_file, "Props", [], None, _run_mel([])).message == (
+        "Import failed"
+    )
+
+    service.set_config(remote_import=False, game_folder="")
+    assert service.save_config()
+    reloaded = UnrealService(config_file=root / "unreal.json", remote_runner=no_editor)
+    assert reloaded.get_game_folder("hero", "Characters") == "/Game/Characters/hero"
+    assert reloaded.send(FakeCmds(), asset_file, "Props", [], None, _run_mel([])).message == (
+        "remote import is off"
+    )
+
+    message = decode_message(encode_message("ping", "node-a", None, None))
+    assert message == {"version": 1, "magic": "ue_py", "type": "ping", "source": "node-a"}
+    try:
+        decode_message(b'{"magic": "other", "version": 1}')
+        assert False, "foreign messages must be rejected"
+    except ValueError:
+        pass
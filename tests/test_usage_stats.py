@@ -0,0 +1,101 @@
+"""
+Test suite for local usage statistics
+
+Validates counting imports per asset and publishes per artist only in libraries that
+opted in, and ranking assets, tags, artists, and neglected categories from the counts.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from datetime import date
+from pathlib import Path
+
+
+def test_usage_stats_rankings():
+    """Imports rank assets and tags; publishes give frequency; unused categories come first"""
+    from src.core.models.usage_stats import UsageStats
+
+    day = date(2026, 10, 14)
+    stats = UsageStats(enabled=True, since=date(2026, 6, 1))
+    for asset_key in ["props/crate.ma", "props/crate.ma", "props/barrel.ma", "sets/dock.ma"]:
+        stats = stats.with_import(asset_key, day)
+    stats = stats.with_import("props/crate.ma", date(2026, 10, 15))
+    for user, publish_day in [("kim", day), ("kim", day), ("lee", date(2026, 1, 5))]:
+        stats = stats.with_publish(user, publish_day)
+
+    assert stats.import_count == 5 and stats.publish_count == 3
+    assert stats.get_most_imported(2) == [("props/crate.ma", 3), ("props/barrel.ma", 1)]
+    assert stats.last_imports["props/crate.ma"] == date(2026, 10, 15)
+
+    tags = {"props/crate.ma": ["wood", "wood", "hero"], "sets/dock.ma": ["wood"]}
+    assert stats.get_tag_use(tags) == [("wood", 4), ("hero", 3)]
+
+    kim, lee = stats.get_publishers(window_days=28, today=day)
+    assert (kim.user, kim.publish_count, kim.per_week) == ("kim", 2, 0.5)
+    assert (lee.publish_count, lee.last_publish) == (0, date(2026, 1, 5))
+
+    categories = {
+        "props/crate.ma": "props",
+        "props/barrel.ma": "props",
+        "props/rope.ma": "props",
+        "sets/dock.ma": "sets",
+        "fx/smoke.ma": "fx",
+    }
+    fx, props, sets = stats.get_category_use(categories)
+    assert fx.name == "fx" and fx.is_neglected and fx.import_count == 0
+    assert (props.asset_count, props.used_count, props.import_count) == (3, 2, 4)
+    assert sets.used_percent == 100.0 and not sets.is_neglected
+
+    restored = UsageStats.from_dict(json.loads(json.dumps(stats.to_dict())))
+    assert restored == stats
+    assert UsageStats.from_dict({}) == UsageStats()
+
+
+def test_usage_counted_only_when_opted_in():
+    """Nothing is counted until the library opts in; opting out keeps the counts"""
+    from src.services.hook_service_impl import (
+        HOOK_POST_IMPORT,
+        HOOK_POST_PUBLISH,
+        HOOK_PRE_IMPORT,
+    )
+    from src.services.metadata_database_impl import MetadataDatabase
+    from src.services.usage_stats_service_impl import UsageStatsService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_usage_"))
+    crate = root / "assets" / "props" / "crate.ma"
+    crate.parent.mkdir(parents=True)
+    database = MetadataDatabase(root)
+    database.save_asset_metadata(crate, {"tags": ["wood"]})
+    service = UsageStatsService(database_factory=lambda _root: database)
+
+    assert not service.record_hook(HOOK_POST_IMPORT, root, {"asset_file": crate})
+    assert not service.get_stats_file(root).exists()
+    assert not service.record_import(None, crate)
+
+    assert service.set_enabled(root, True)
+    assert service.load(root).since == date.today()
+    assert service.record_hook(HOOK_POST_IMPORT, root, {"asset_file": crate})
+    assert service.record_hook(HOOK_POST_PUBLISH, root, {"asset_file": crate, "user": "kim"})
+    assert not service.record_hook(HOOK_PRE_IMPORT, root, {"asset_file": crate})
+    assert service.record_import(root, crate, day=date(2026, 10, 1))
+
+    stats = service.load(root)
+    assert stats.imports == {"assets/props/crate.ma": 2}
+    assert stats.last_imports["assets/props/crate.ma"] == date.today()
+    assert list(stats.publishes) == ["kim"]
+    assert stats.get_tag_use(service.get_tags(root)) == [("wood", 2)]
+
+    # Opting out stops counting but keeps what was counted
+    assert service.set_enabled(root, False)
+    assert not service.record_import(root, crate)
+    assert service.load(root).import_count == 2 and not service.load(root).enabled
+
+    assert service.set_enabled(root, True) and service.clear(root)
+    cleared = service.load(root)
+    assert cleared.enabled and cleared.import_count == 0 and cleared.publishes == {}
+
+    service.get_stats_file(root).write_text("{not json")
+    assert service.load(root) == service.load(root.parent / "missing_library")
@@ -0,0 +1,295 @@
+"""
+Test suite for studio sign-in identities
+
+Validates sessions and their expiry, the workstation login fallback, studio-managed
+settings, import-only access until sign-in, LDAP binds, and the OAuth device flow.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import os
+import tempfile
+from pathlib import Path
+
+
+class _FakeBackend:
+    """Password backend accepting one password"""
+
+    name = "ldap"
+    uses_password = True
+
+    def authenticate(self, user_name, password):
+        from src.core.models.user_identity import UserIdentity
+        from src.services.identity_service_impl import AuthenticationError
+
+        if password != "secret":
+            raise AuthenticationError("The directory refused the user name or password")
+        return UserIdentity(user_name=user_name, display_name="Kim Lee", backend="ldap")
+
+
+def test_sessions_and_sign_in_enforcement():
+    """Sign-ins last the session length; until then only imports are allowed"""
+    import src.services.identity_service_impl as identity_module
+    from src.core.models.user_identity import UserIdentity
+    from src.services.identity_service_impl import (
+        IDENTITY_CONFIG_ENV,
+        AuthenticationError,
+        IdentityService,
+        get_os_user,
+    )
+    from src.services.permission_service_impl import PermissionDenied, PermissionService
+    from src.services.version_service_impl import get_current_user
+
+    folder = Path(tempfile.mkdtemp(prefix="assetManager_identity_"))
+    session_file = folder / "identity_session.json"
+
+    def create_service():
+        return IdentityService(
+            config_file=folder / "identity.json",
+            session_file=session_file,
+            backend_factory=lambda name, settings: _FakeBackend(),
+        )
+
+    service = create_service()
+    assert not service.requires_sign_in()  # Workstation logins need no sign-in
+    assert service.get_identity() == UserIdentity(user_name=get_os_user(), os_user=get_os_user())
+    try:
+        service.set_config(backend="kerberos")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("set_config should reject unknown backends")
+
+    service.set_config(backend="ldap", session_hours=8, ldap={"server": "ldaps://dc01"})
+    assert service.get_config()["ldap"]["bind_format"] == "{user}"  # Merged, not replaced
+    assert service.save_config()
+    assert service.requires_sign_in()
+    try:
+        service.sign_in("klee", "wrong")
+    except AuthenticationError:
+        pass
+    else:
+        raise AssertionError("sign_in should pass on the backend's refusal")
+
+    identity = service.sign_in("klee", "secret")
+    assert identity.verified and identity.os_user == get_os_user() and identity.expires
+    assert identity.label == "Kim Lee (klee)"
+    assert create_service().get_user_name() == "klee"  # The session survives restarts
+
+    # Switching artist replaces the session; signing out falls back to the workstation login
+    service.sign_in("sam", "secret")
+    assert create_service().get_user_name() == "sam"
+    assert service.sign_out().user_name == "sam" and not session_file.exists()
+    assert service.get_user_name() == get_os_user() and service.requires_sign_in()
+
+    # Expired sessions and sessions from another backend are not honored
+    expired = UserIdentity("klee", backend="ldap", verified=True, expires="2000-01-01T00:00:00")
+    session_file.write_text(json.dumps(expired.to_dict()))
+    assert create_service().requires_sign_in()
+    other = UserIdentity("klee", backend="oauth", verified=True)
+    session_file.write_text(json.dumps(other.to_dict()))
+    assert create_service().requires_sign_in()
+
+    # Shared workstations sign out when Maya closes
+    service.set_config(sign_out_on_exit=True)
+    service.sign_in("klee", "secret")
+    service.on_exit()
+    assert not session_file.exists()
+
+    # A studio settings file decides the backend and is never written
+    studio_file = folder / "studio_identity.json"
+    studio_file.write_text(json.dumps({"backend": "os"}))
+    os.environ[IDENTITY_CONFIG_ENV] = str(studio_file)
+    try:
+        managed = create_service()
+        assert managed.is_managed() and managed.get_backend_name() == "os"
+        managed.set_config(backend="ldap")
+        assert not managed.save_config()
+        assert json.loads(studio_file.read_text()) == {"backend": "os"}
+    finally:
+        del os.environ[IDENTITY_CONFIG_ENV]
+
+    # Until the artist signs in, libraries can only be imported from
+    library = Path(tempfile.mkdtemp(prefix="assetManager_identity_library_"))
+    previous = identity_module._identity_service_instance
+    identity_module._identity_service_instance = create_service()
+    try:
+        permissions = PermissionService()
+        assert permissions.is_allowed(library, "import")
+        assert not permissions.is_allowed(library, "publish")
+        try:
+            permissions.check(library, "publish")
+        except PermissionDenied as e:
+            assert "Sign in" in str(e)
+        else:
+            raise AssertionError("check should ask for a sign-in before publishing")
+
+        identity_module._identity_service_instance.sign_in("klee", "secret")
+        assert permissions.is_allowed(library, "publish")
+        assert get_current_user() == "klee"  # Publish records use the studio identity
+    finally:
+        identity_module._identity_service_instance = previous
+
+
+def test_ldap_and_oauth_backends():
+    """LDAP binds as the artist and reads groups; OAuth polls until the code is entered"""
+    from src.services.identity_service_impl import (
+        DEVICE_CODE_GRANT,
+        AuthenticationError,
+        LdapBackend,
+        OAuthBackend,
+    )
+
+    binds = []
+
+    class FakeConnection:
+        def __init__(self, server, bind_name, password):
+            self.bind_name = bind_name
+            self.password = password
+            self.response = []
+            self.unbound = False
+            binds.append(self)
+
+        def bind(self):
+            return self.password == "secret"
+
+        def search(self, base_dn, search_filter, attributes=None):
+            assert base_dn == "dc=studio,dc=local"
+            assert search_filter == "(sAMAccountName=klee\\2a)"  # Escaped, no wildcards
+            self.response = [
+                {
+                    "type": "searchResEntry",
+                    "attributes": {
+                        "sAMAccountName": "klee",
+                        "displayName": ["Kim Lee"],
+                        "mail": "kim@studio.local",
+                        "memberOf": ["CN=Riggers,OU=Groups,DC=studio", "CN=Leads,DC=studio"],
+                    },
+                }
+            ]
+
+        def unbind(self):
+            self.unbound = True
+
+    ldap = LdapBackend(
+        {
+            "server": "ldaps://dc01",
+            "bind_format": "{user}@studio.local",
+            "base_dn": "dc=studio,dc=local",
+            "user_filter": "(sAMAccountName={user})",
+        },
+        connect=FakeConnection,
+    )
+    for password in ("", "wrong"):
+        try:
+            ldap.authenticate("klee*", password)
+        except AuthenticationError:
+            continue
+        raise AssertionError(f"authenticate should refuse password '{password}'")
+    assert len(binds) == 1  # Empty passwords never reach the directory
+    identity = ldap.authenticate("klee*", "secret")
+    assert binds[-1].bind_name == "klee*@studio.local" and binds[-1].unbound
+    assert identity.user_name == "klee" and identity.display_name == "Kim Lee"
+    assert identity.email == "kim@studio.local" and identity.groups == ("Riggers", "Leads")
+    assert identity.backend == "ldap" and identity.verified
+
+    # User names cannot add components to a distinguished name bind
+    dn_ldap = LdapBackend(
+        {"server": "ldaps://dc01", "bind_format": "uid={user},ou=people,dc=studio"},
+        connect=FakeConnection,
+    )
+    dn_ldap.authenticate("klee,ou=admins", "secret")
+    assert binds[-1].bind_name == "uid=klee\\,ou\\=admins,ou=people,dc=studio"
+
+    token_answers = [
+        (400, {"error": "authorization_pending"}),
+        (400, {"error": "slow_down"}),
+        (200, {"access_token": "token"}),
+    ]
+    requests = []
+
+    def fake_http(url, data, headers):
+        requests.append((url, data, headers))
+        if url.endswith("/.well-known/openid-configuration"):
+            return 200, {
+                "device_authorization_endpoint": "https://idp/device",
+                "token_endpoint": "https://idp/token",
+                "userinfo_endpoint": "https://idp/userinfo",
+            }
+        if url == "https://idp/device":
+            return 200, {
+                "device_code": "device",
+                "user_code": "ABCD-EFGH",
+                "verification_uri": "https://idp/activate",
+                "interval": 5,
+            }
+        if url == "https://idp/token":
+            assert data["grant_type"] == DEVICE_CODE_GRANT and data["device_code"] == "device"
+            return token_answers.pop(0)
+        assert headers == {"Authorization": "Bearer token"}
+        return 200, {"preferred_username": "kim@studio.com", "name": "Kim Lee", "groups": ["fx"]}
+
+    settings = {
+        "issuer": "https://idp/",
+        "client_id": "asset-manager",
+        "scopes": "openid profile email",
+        "username_claim": "preferred_username",
+        "strip_email_domain": True,
+    }
+    oauth = OAuthBackend(settings, http=fake_http)
+    sign_in = oauth.start()
+    assert sign_in.user_code == "ABCD-EFGH" and sign_in.verification_uri == "https://idp/activate"
+    assert requests[0][0] == "https://idp/.well-known/openid-configuration"
+    assert oauth.poll(sign_in) is None
+    assert oauth.poll(sign_in) is None and sign_in.interval == 10  # The provider slowed us
+    identity = oauth.poll(sign_in)
+    assert identity.user_name == "kim" and identity.display_name == "Kim Lee"
+    assert identity.groups == ("fx",) and identity.backend == "oauth" and identity.verified
+
+    token_answers.append((400, {"error": "access_denied", "error_description": "Declined"}))
+    try:
+        oauth.poll(sign_in)
+    except AuthenticationError as e:
+        assert "Declined" in str(e)
+    else:
+        raise AssertionError("poll should fail when the artist declines")
+
+    try:
+        OAuthBackend(dict(settings, client_id=""), http=fake_http).start()
+    except AuthenticationError:
+        pass
+    else:
+        raise AssertionError("start should need a client ID")
+
+    # A provider without a userinfo endpoint is refused before a code is asked for
+    discovery = {
+        "device_authorization_endpoint": "https://idp/device",
+        "token_endpoint": "https://idp/token",
+    }
+
+    def no_userinfo_http(url, data, headers):
+        requests.append((url, data, headers))
+        if url.endswith("/.well-known/openid-configuration"):
+            return 200, discovery
+        return fake_http(url, data, headers)
+
+    del requests[:]
+    try:
+        OAuthBackend(settings, http=no_userinfo_http).start()
+    except AuthenticationError as e:
+        assert "userinfo" in str(e)
+    else:
+        raise AssertionError("start should fail without a userinfo endpoint")
+    assert [url for url, _data, _headers in requests] == [
+        "https://idp/.well-known/openid-configuration"
+    ]  # No code is handed out
+
+    del discovery["token_endpoint"]
+    try:
+        OAuthBackend(settings, http=no_userinfo_http).start()
+    except AuthenticationError:
+        pass
+    else:
+        raise AssertionError("start should need a token endpoint")
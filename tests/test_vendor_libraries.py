@@ -0,0 +1,127 @@
+"""
+Test suite for read-only vendor libraries
+
+Validates that vendor libraries only allow importing whatever the artist's role, that
+license terms are read per asset and folder, and that delivery bundles record the terms
+of the vendor files they carry.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from pathlib import Path
+
+PACK_TERMS = {
+    "vendor": "KitBash3D",
+    "license_type": "Royalty Free",
+    "source_url": "https://example.com/license",
+    "redistributable": True,
+    "assets": {"assets/signs/": {"license_type": "Editorial Only", "redistributable": False}},
+}
+
+
+def _write_vendor_file(library: Path, data) -> None:
+    vendor_file = library / ".assetmanager" / "vendor.json"
+    vendor_file.parent.mkdir(parents=True, exist_ok=True)
+    vendor_file.write_text(data if isinstance(data, str) else json.dumps(data))
+
+
+def test_vendor_library_is_read_only_with_license_terms():
+    """Everyone may import, nobody may publish or delete, and admins still manage"""
+    from src.core.models.library_permissions import (
+        ACTION_DELETE,
+        ACTION_EDIT,
+        ACTION_IMPORT,
+        ACTION_MANAGE,
+        ACTION_PUBLISH,
+    )
+    from src.core.models.vendor_license import VendorLibrary
+    from src.services.permission_service_impl import PermissionDenied, PermissionService
+    from src.services.vendor_library_service_impl import VendorLibraryService
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_vendor_")) / "city_pack"
+    vendor_service = VendorLibraryService()
+    permissions = PermissionService()
+    assert not vendor_service.is_read_only(library)
+    assert permissions.is_allowed(library, ACTION_PUBLISH)
+
+    assert vendor_service.save_vendor_library(library, VendorLibrary.from_dict(PACK_TERMS))
+    assert json.loads(vendor_service.get_vendor_file(library).read_text()) == {
+        "attribution": "",
+        **PACK_TERMS,
+        "assets": {"assets/signs": PACK_TERMS["assets"]["assets/signs/"]},
+    }
+    for action in (ACTION_PUBLISH, ACTION_EDIT, ACTION_DELETE):
+        assert not permissions.is_allowed(library, action)
+    assert permissions.is_allowed(library, ACTION_IMPORT)
+    assert permissions.is_allowed(library, ACTION_MANAGE)
+    try:
+        permissions.check(library, ACTION_DELETE)
+    except PermissionDenied as e:
+        assert "read-only vendor library" in str(e)
+    else:
+        raise AssertionError("Assets of a vendor library should not be deletable")
+
+    # Folders carry their own terms, everything else the pack's
+    tower = vendor_service.get_license(library / "assets" / "buildings" / "tower.ma")
+    assert tower.label == "KitBash3D - Royalty Free" and not tower.is_restrictive
+    neon = vendor_service.get_license(library / "assets" / "signs" / "neon.ma")
+    assert neon.license_type == "Editorial Only" and neon.is_restrictive
+    assert neon.source_url == "https://example.com/license"
+    assert vendor_service.get_license(library.parent / "studio" / "crate.ma") is None
+
+    # A broken vendor file keeps the library read-only
+    _write_vendor_file(library, "{not json")
+    assert not permissions.is_allowed(library, ACTION_PUBLISH)
+    assert vendor_service.get_license(library / "assets" / "a.ma").label == (
+        "Unknown vendor - license not recorded"
+    )
+
+    assert vendor_service.remove_vendor_library(library)
+    assert permissions.is_allowed(library, ACTION_PUBLISH)
+    assert not vendor_service.remove_vendor_library(library)
+
+
+def test_bundles_record_vendor_licenses():
+    """A bundle lists the terms of the vendor files it carries and flags restricted ones"""
+    from src.services.asset_bundle_service_impl import AssetBundleService
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_vendor_"))
+    pack = root / "city_pack"
+    _write_vendor_file(pack, PACK_TERMS)
+    neon_map = pack / "assets" / "signs" / "neon.png"
+    brick_map = pack / "assets" / "textures" / "brick.png"
+    for texture in (neon_map, brick_map):
+        texture.parent.mkdir(parents=True, exist_ok=True)
+        texture.write_bytes(b"png")
+
+    scenes = root / "studio" / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+
+    def make_scene(name, texture):
+        scene = scenes / f"{name}.ma"
+        scene.write_text(
+            "//Maya ASCII scene\n"
+            'createNode file -n "map";\n'
+            f'\tsetAttr ".ftn" -type "string" "{texture.as_posix()}";\n',
+            encoding="utf-8",
+        )
+        return scene
+
+    service = AssetBundleService()
+    wall = make_scene("wall", brick_map)
+    bundle = service.export_bundle(root / "studio", [wall], root / "wall.zip")
+    assert [terms.license_type for terms in bundle.licenses] == ["Royalty Free"]
+    assert bundle.restricted_licenses == ()
+
+    shop = make_scene("shop", neon_map)
+    bundle = service.export_bundle(root / "studio", [shop, wall], root / "shop.zip")
+    assert [terms.license_type for terms in bundle.restricted_licenses] == ["Editorial Only"]
+    assert "1 restricted vendor license(s)" in bundle.summary()
+    assert service.read_bundle(root / "shop.zip").licenses == bundle.licenses
+
+    # Studio-only bundles carry no licenses
+    plain = make_scene("plain", root / "missing.png")
+    assert service.plan_bundle(root / "studio", [plain])[0].licenses == ()
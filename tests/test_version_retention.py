@@ -0,0 +1,130 @@
+"""
+Test suite for version retention policies
+
+Validates planning which old versions a library's policy prunes (keeping the last N,
+approved versions, and young ones), removing them with their checksums while their
+history entries stay, and the dry-run first maintenance job.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import tempfile
+from datetime import datetime, timedelta
+from pathlib import Path
+
+NOW = datetime(2026, 6, 1, 12, 0)
+
+
+def _make_library(version_count=6):
+    """Library with a crate published monthly since January and a trashed history"""
+    from src.services.version_service_impl import VersionServiceImpl
+
+    library = Path(tempfile.mkdtemp(prefix="assetManager_retention_"))
+    props = library / "assets" / "props"
+    props.mkdir(parents=True)
+    crate = props / "crate.ma"
+    service = VersionServiceImpl()
+    for number in range(1, version_count + 1):
+        crate.write_text(f"// crate v{number}\n" + "x" * 100 * number)
+        service.publish_version(crate, notes=f"pass {number}", author="ana")
+
+    # Backdate the versions a month apart, the latest one a week ago
+    manifest = props / ".versions" / "crate" / "versions.json"
+    data = json.loads(manifest.read_text())
+    for index, entry in enumerate(data["versions"]):
+        age = timedelta(days=7 + 30 * (version_count - 1 - index))
+        entry["created_date"] = (NOW - age).isoformat()
+    manifest.write_text(json.dumps(data))
+
+    trashed = library / ".trash" / "old" / ".versions" / "old"
+    trashed.mkdir(parents=True)
+    (trashed / "versions.json").write_text(manifest.read_text())
+    return library, crate, service
+
+
+def test_plan_keeps_last_approved_and_young_versions():
+    """A plan lists only old versions outside the last N that were never approved"""
+    from src.core.models.activity_event import ACTIVITY_STATUS
+    from src.core.models.retention_policy import RetentionPolicy
+    from src.services.metadata_database_impl import get_metadata_database
+    from src.services.retention_service_impl import RetentionService
+
+    library, crate, version_service = _make_library()
+    service = RetentionService(version_service=version_service)
+    assert service.load_policy(library) == RetentionPolicy() and not RetentionPolicy().enabled
+    assert service.find_versioned_assets(library) == [crate]  # The trash is skipped
+
+    # v2 was the latest one when the asset was approved, before the later passes
+    approved = NOW - timedelta(days=7 + 30 * 4 - 1)
+    get_metadata_database(library).log_activity(
+        crate, ACTIVITY_STATUS, "lead", "ws01", approved.isoformat(), {"status": "Approved"}
+    )
+
+    policy = RetentionPolicy(enabled=True, keep_last=2, max_wip_age_days=90)
+    report = service.plan(library, policy, now=NOW)
+    assert [version.number for version in report.versions] == [1, 3]  # v4 is 67 days old
+    assert report.versions[1].label == "crate v003" and report.versions[1].author == "ana"
+    assert (report.assets_checked, report.versions_kept, report.dry_run) == (1, 4, True)
+    assert report.freed_bytes == sum(version.size_bytes for version in report.versions) > 0
+    assert report.summary.startswith("Would prune 2 version(s) of 1 asset(s), ")
+
+    # Without the approval rule or an age limit only the last versions stay
+    policy = RetentionPolicy(keep_last=2, keep_approved=False, max_wip_age_days=0)
+    report = service.plan(library, policy, now=NOW)
+    assert [version.number for version in report.versions] == [1, 2, 3, 4]
+    assert policy.description == "Keep the last 2 versions, prune the others (dry run)"
+    assert list(crate.parent.glob(".versions/crate/v00*")) != []  # Planning removes nothing
+
+    assert RetentionPolicy.from_dict({"keep_last": 0}).keep_last == 1
+    try:
+        RetentionPolicy(keep_last=0)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("A policy keeping no versions should be rejected")
+
+
+def test_prune_removes_files_and_checksums_through_maintenance():
+    """Pruning keeps the history entries; maintenance only reports while a dry run"""
+    from src.core.models.retention_policy import RetentionPolicy
+    from src.services.maintenance_service_impl import MaintenanceService
+    from src.services.metadata_database_impl import get_metadata_database
+    from src.services.retention_service_impl import RetentionService
+
+    library, crate, version_service = _make_library(version_count=4)
+    service = RetentionService(version_service=version_service)
+    history_dir = crate.parent / ".versions" / "crate"
+    database = get_metadata_database(library)
+    database.record_checksums(crate, {path: "0" * 64 for path in history_dir.rglob("*.ma")})
+
+    result = MaintenanceService().run_task(library, "retention")
+    assert result.success and result.summary == "Version retention is off"
+
+    policy = RetentionPolicy(enabled=True, keep_last=2, max_wip_age_days=30)
+    assert service.save_policy(library, policy) and service.load_policy(library) == policy
+    result = MaintenanceService().run_task(library, "retention")
+    assert result.summary.startswith("Would prune 2 version(s)")
+    assert result.details == ("crate v001", "crate v002") and (history_dir / "v001").is_dir()
+
+    service.save_policy(library, RetentionPolicy(enabled=True, keep_last=2, dry_run=False))
+    result = MaintenanceService().run_task(library, "retention")
+    assert result.success and result.summary.startswith("Pruned 2 version(s) of 1 asset(s)")
+    assert not (history_dir / "v001").exists() and (history_dir / "v003").is_dir()
+
+    versions = version_service.get_versions(crate)
+    assert [version.is_pruned for version in versions] == [True, True, False, False]
+    assert versions[0].notes == "pass 1"  # The history still tells what the version was
+    keys = set(database.get_checksums())
+    assert not any("/v001/" in key or "/v002/" in key for key in keys)
+    assert any("/v003/" in key for key in keys)
+
+    # Pruned versions are not pruned twice, and the latest version never goes
+    assert service.plan(library, now=datetime.now()).versions == ()
+    try:
+        version_service.prune_version(crate, 4)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("The latest version should not be prunable")
@@ -0,0 +1,108 @@
+"""
+Test suite for dropping library assets into the viewport
+
+Validates modifier to drop mode mapping, the ground plane fallback, and placing,
+grouping, and instancing dropped assets against a minimal stand-in for maya.cmds.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+from pathlib import Path
+
+
+class FakeCmds:
+    """Tracks top-level nodes, string attributes, and moves made by the drop service"""
+
+    def __init__(self):
+        self.assemblies = ["|persp", "|top"]
+        self.attributes = {}
+        self.moves = []
+        self.instanced = []
+
+    def undoInfo(self, **kwargs):
+        pass
+
+    def ls(self, assemblies=False, long=False):
+        return list(self.assemblies)
+
+    def attributeQuery(self, name, node=None, exists=False):
+        return f"{node}.{name}" in self.attributes
+
+    def addAttr(self, node, longName=None, dataType=None):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+    def getAttr(self, plug):
+        return self.attributes[plug]
+
+    def group(self, nodes, name=None):
+        for node in nodes:
+            self.assemblies.remove(node)
+        self.assemblies.append(f"|{name}")
+        return f"|{name}"
+
+    def instance(self, node):
+        copy = f"{node}_instance{len(self.instanced) + 1}"
+        self.instanced.append(node)
+        self.assemblies.append(copy)
+        return [copy]
+
+    def exactWorldBoundingBox(self, node):
+        return [-1.0, 2.0, -1.0, 1.0, 4.0, 3.0]
+
+    def move(self, x, y, z, node, relative=False, worldSpace=False):
+        self.moves.append((node, (x, y, z)))
+
+    def select(self, node, replace=False):
+        self.selected = node
+
+
+def test_drop_modes_and_ground_plane():
+    """Ctrl references, Shift instances; rays looking up miss the ground"""
+    from src.services.viewport_drop_service_impl import (
+        DROP_MODE_IMPORT,
+        DROP_MODE_INSTANCE,
+        DROP_MODE_REFERENCE,
+        drop_mode_for_modifiers,
+        intersect_ground_plane,
+    )
+
+    assert drop_mode_for_modifiers(False, False) == DROP_MODE_IMPORT
+    assert drop_mode_for_modifiers(False, True) == DROP_MODE_REFERENCE
+    assert drop_mode_for_modifiers(True, False) == DROP_MODE_INSTANCE
+
+    assert intersect_ground_plane((0.0, 10.0, 0.0), (0.5, -1.0, 0.0)) == (5.0, 0.0, 0.0)
+    assert intersect_ground_plane((0.0, 10.0, 0.0), (0.0, 1.0, 0.0)) is None
+    assert intersect_ground_plane((0.0, 10.0, 0.0), (1.0, 0.0, 0.0)) is None
+
+
+def test_place_groups_moves_and_instances():
+    """New roots are grouped and set on the drop point; Shift drops instance that copy"""
+    from src.services.viewport_drop_service_impl import SOURCE_ATTRIBUTE, ViewportDropService
+
+    service = ViewportDropService()
+    cmds = FakeCmds()
+    asset = Path("/library/props/crate.ma")
+    loads = []
+
+    def loader(mode):
+        loads.append(mode)
+        cmds.assemblies.extend(["|crate_geo", "|crate_lid"])
+        return True
+
+    root = service.place_asset(cmds, asset, "instance", (10.0, 0.0, 5.0), loader)
+    assert root == "|crate_grp"
+    assert loads == ["import"]
+    assert cmds.attributes[f"{root}.{SOURCE_ATTRIBUTE}"] == asset.as_posix()
+    # Bottom centre of the bounding box (0, 2, 1) lands on the drop point
+    assert cmds.moves[-1] == (root, (10.0, -2.0, 4.0))
+
+    copy = service.place_asset(cmds, asset, "instance", (0.0, 0.0, 0.0), loader)
+    assert copy == "|crate_grp_instance1"
+    assert loads == ["import"]
+    assert cmds.instanced == ["|crate_grp"]
+
+    assert service.place_asset(cmds, asset, "reference", (0.0, 0.0, 0.0), lambda m: False) is None
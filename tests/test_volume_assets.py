@@ -0,0 +1,167 @@
+"""
+Test suite for OpenVDB volume assets
+
+Validates finding a .vdb sequence and its grids from the file headers, copying it into
+the library with a mid-frame slice thumbnail, and loading it as an Arnold volume or a
+fluid container.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import json
+import struct
+import sys
+import tempfile
+import types
+from pathlib import Path
+
+
+def write_vdb(path, grids):
+    """Write the header and grid descriptors of an OpenVDB file (no voxel data)"""
+
+    def string(text):
+        data = text.encode("utf-8")
+        return struct.pack("<I", len(data)) + data
+
+    header = struct.pack("<qI", 0x56444220, 224) + struct.pack("<II", 11, 0)
+    header += b"\x01" + b"0" * 36  # Grid offsets, UUID
+    header += struct.pack("<I", 1) + string("creator") + string("string") + string("houdini")
+    header += struct.pack("<I", len(grids))
+    for name in grids:
+        descriptor = string(name) + string("Tree_float_5_4_3") + string("")
+        end = len(header) + len(descriptor) + 24
+        header += descriptor + struct.pack("<qqq", end, end, end)
+    Path(path).write_bytes(header)
+
+
+class FakeGrid:
+    """OpenVDB Python grid with a ramp along X on one Z slice"""
+
+    def evalActiveVoxelBoundingBox(self):
+        return (0, 0, 0), (3, 1, 4)
+
+    def getConstAccessor(self):
+        return self
+
+    def getValue(self, ijk):
+        x, _y, z = ijk
+        return float(x) if z == 2 else 99.0
+
+
+class FakeCmds:
+    """Nodes created for volumes, with a plugin that may be missing"""
+
+    def __init__(self, arnold=True):
+        self.arnold = arnold
+        self.nodes = {}  # shape -> node type
+        self.attributes = {}
+        self.connections = []
+
+    def loadPlugin(self, name, quiet=False):
+        if not self.arnold:
+            raise RuntimeError(f"Plug-in {name} not found")
+
+    def createNode(self, node_type, name):
+        self.nodes[name] = node_type
+        return name
+
+    def listRelatives(self, shape, parent=False, fullPath=False):
+        return [f"|{shape.replace('Shape', '')}"]
+
+    def setAttr(self, plug, value, type=None):
+        self.attributes[plug] = value
+
+    def addAttr(self, node, longName, dataType):
+        self.attributes[f"{node}.{longName}"] = ""
+
+    def connectAttr(self, source, target, force=False):
+        self.connections.append((source, target))
+
+
+def test_vdb_sequence_published_with_grids_and_slice():
+    """A sequence is found from any frame, copied with its grids, and sliced for a thumbnail"""
+    from src.services.volume_service_impl import (
+        VolumeService,
+        find_sequence,
+        read_grid_names,
+        render_slice,
+    )
+
+    source = Path(tempfile.mkdtemp(prefix="assetManager_vdb_"))
+    for frame in (1001, 1002, 1003):
+        write_vdb(source / f"smoke.{frame}.vdb", ["density", "temperature", "density\x1e1"])
+    write_vdb(source / "smoke.01.vdb", ["density"])  # Other padding, other sequence
+    write_vdb(source / "fire.1001.vdb", ["flame"])
+    (source / "notes.vdb").write_text("not a volume")
+
+    pattern, frames = find_sequence(source / "smoke.1002.vdb")
+    assert pattern == "smoke.####.vdb"
+    assert [frame for frame, _path in frames] == [1001, 1002, 1003]
+    assert read_grid_names(source / "smoke.1001.vdb") == ["density", "temperature"]
+    assert read_grid_names(source / "notes.vdb") == []
+    assert find_sequence(source / "notes.vdb")[1] == [(0, source / "notes.vdb")]
+
+    # Slices show the middle Z layer, scaled to the brightest voxel
+    width, height, pixels = render_slice(FakeGrid(), size=8)
+    assert (width, height) == (8, 4)
+    assert pixels[0] == 0 and pixels[width - 1] == 255
+
+    fake_openvdb = types.ModuleType("pyopenvdb")
+    fake_openvdb.read = lambda _path, grid_name: FakeGrid()
+    sys.modules["pyopenvdb"] = fake_openvdb
+    try:
+        library = Path(tempfile.mkdtemp(prefix="assetManager_volumes_"))
+        descriptor_path = library / "assets" / "fx" / "smoke_plume.volume"
+        saved = VolumeService().save_volume(source / "smoke.1003.vdb", descriptor_path, "v2")
+    finally:
+        del sys.modules["pyopenvdb"]
+
+    descriptor = json.loads(saved.read_text())
+    assert descriptor["sequence"] == ".volumes/smoke_plume/smoke.####.vdb"
+    assert (descriptor["start_frame"], descriptor["end_frame"]) == (1001, 1003)
+    assert descriptor["grids"] == ["density", "temperature"] and descriptor["frame_count"] == 3
+    assert (saved.parent / ".volumes" / "smoke_plume" / "smoke.1002.vdb").is_file()
+    assert not (saved.parent / ".volumes" / "smoke_plume" / "smoke.01.vdb").exists()
+    assert VolumeService().describe(descriptor) == "Frames 1001-1003, grids density, temperature"
+
+    thumbnail = saved.parent / ".thumbnails" / "smoke_plume_screenshot.png"
+    png = thumbnail.read_bytes()
+    assert png.startswith(b"\x89PNG") and struct.unpack(">II", png[16:24]) == (256, 128)
+
+
+def test_volume_loads_as_arnold_volume_or_fluid_container():
+    """Arnold reads the sequence at the scene frame; without it a fluid container stands in"""
+    from src.services.volume_service_impl import (
+        IMPORT_MODE_ARNOLD,
+        VOLUME_ATTRIBUTE,
+        VolumeService,
+    )
+
+    source = Path(tempfile.mkdtemp(prefix="assetManager_vdb_"))
+    for frame in (1, 2):
+        write_vdb(source / f"cloud_{frame:03d}.vdb", ["density", "vel"])
+    library = Path(tempfile.mkdtemp(prefix="assetManager_volumes_"))
+    descriptor_path = VolumeService().save_volume(
+        source / "cloud_001.vdb", library / "cloud.volume", thumbnail=False
+    )
+    sequence = (library / ".volumes" / "cloud" / "cloud_###.vdb").as_posix()
+
+    cmds = FakeCmds()
+    transform = VolumeService().import_volume(cmds, descriptor_path)
+    assert cmds.nodes == {"cloudVolumeShape": "aiVolume"}
+    assert cmds.attributes["cloudVolumeShape.filename"] == sequence
+    assert cmds.attributes["cloudVolumeShape.grids"] == "density vel"
+    assert ("time1.outTime", "cloudVolumeShape.frame") in cmds.connections
+    assert cmds.attributes[f"{transform}.{VOLUME_ATTRIBUTE}"] == sequence
+
+    # Without Arnold the default load falls back, an Arnold load fails
+    no_arnold = FakeCmds(arnold=False)
+    transform = VolumeService().import_volume(no_arnold, descriptor_path)
+    assert no_arnold.nodes == {"cloud_fluidShape": "fluidShape"}
+    assert no_arnold.attributes[f"{transform}.{VOLUME_ATTRIBUTE}"] == sequence
+    no_arnold = FakeCmds(arnold=False)
+    assert VolumeService().import_volume(no_arnold, descriptor_path, IMPORT_MODE_ARNOLD) is None
+
+    (library / "broken.volume").write_text(json.dumps({"type": "light_rig"}))
+    assert VolumeService().import_volume(cmds, library / "broken.volume") is None
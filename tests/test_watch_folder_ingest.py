@@ -0,0 +1,137 @@
+"""
+Test suite for watch folder ingestion
+
+Validates reading asset names out of dropped files with naming rules, waiting for
+exports to finish, ingesting new files as assets or new versions, and flagging
+them for review.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import os
+import tempfile
+import time
+from pathlib import Path
+
+
+class FakeDatabase:
+    """Library metadata database keeping asset metadata in memory"""
+
+    def __init__(self):
+        self.metadata = {}
+
+    def get_asset_metadata(self, asset_path):
+        return dict(self.metadata.get(str(asset_path), {})) or None
+
+    def save_asset_metadata(self, asset_path, metadata):
+        self.metadata[str(asset_path)] = dict(metadata)
+
+
+def _drop(folder: Path, name: str, content: bytes, age: float = 60.0) -> Path:
+    """Write an export into a watch folder, finished `age` seconds ago"""
+    file_path = folder / name
+    file_path.write_bytes(content)
+    finished = time.time() - age
+    os.utime(file_path, (finished, finished))
+    return file_path
+
+
+def test_names_and_ready_files():
+    """Naming rules read the asset out of file names; unfinished exports wait"""
+    from src.core.models.watch_folder import WatchFolder
+    from src.services.ingest_service_impl import IngestService, parse_ingest_name
+
+    assert parse_ingest_name("ogre_v004.fbx") == ("ogre", "", 4)
+    assert parse_ingest_name("ogre_004.fbx") == ("ogre_004", "", 0)  # Variants are assets
+    assert parse_ingest_name("Props_crate_v12.obj", "{type}_{assetName}_v{version}") == (
+        "crate",
+        "Props",
+        12,
+    )
+    assert parse_ingest_name("zb_ogre_body.obj", "zb_{assetName}") == ("ogre_body", "", 0)
+    assert parse_ingest_name("ogre_final.obj", "*_{assetName}_v{version}") is None
+    try:
+        parse_ingest_name("ogre.obj", "{type}_v{version}")
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("A name pattern without {assetName} should be rejected")
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_ingest_"))
+    drop = root / "zbrush"
+    drop.mkdir()
+    _drop(drop, "old_rock.obj", b"v 0 0 0\n")
+    service = IngestService(config_file=root / "watch_folders.json")
+    service.add_folder(WatchFolder(path=str(drop), category="Characters"))
+    assert service.find_ready_files() == []  # Files there before the folder was added
+
+    _drop(drop, "ogre_v001.fbx", b"fbx")
+    _drop(drop, "ogre_notes.txt", b"notes")
+    _drop(drop, "~ogre_v002.fbx", b"partial")
+    writing = _drop(drop, "troll.obj", b"v 0", age=0.0)
+    assert [path.name for _folder, path in service.find_ready_files()] == ["ogre_v001.fbx"]
+    assert writing.name in [p.name for _f, p in service.find_ready_files(now=time.time() + 30)]
+
+    assert service.save_config()
+    reloaded = IngestService(config_file=root / "watch_folders.json")
+    assert reloaded.get_folders() == service.get_folders()
+    assert reloaded.get_folders()[0].category == "Characters"
+    assert [path.name for _f, path in reloaded.find_ready_files()] == ["ogre_v001.fbx"]
+    assert reloaded.remove_folder(str(drop)) and reloaded.find_ready_files() == []
+    try:
+        reloaded.set_config(poll=True)
+    except ValueError:
+        pass
+    else:
+        raise AssertionError("Unknown watch folder settings should be rejected")
+
+
+def test_ingest_new_assets_and_versions():
+    """New names become assets, re-exports new versions, both pending review"""
+    from src.core.models.watch_folder import WatchFolder
+    from src.services.ingest_service_impl import INGEST_METADATA_KEY, IngestService
+    from src.services.version_service_impl import get_version_service
+
+    root = Path(tempfile.mkdtemp(prefix="assetManager_ingest_"))
+    library = root / "library"
+    drop = root / "marvelous"
+    drop.mkdir()
+    database = FakeDatabase()
+    service = IngestService(config_file=root / "watch_folders.json")
+    service.add_folder(
+        WatchFolder(path=str(drop), category="Props", name_pattern="{assetName}_v{version}")
+    )
+    assert service.scan(library, database) == []  # Watching is off
+    service.set_config(enabled=True)
+
+    _drop(drop, "jacket_v001.obj", b"v 1 1 1\n")
+    _drop(drop, "swatch_v001.png", b"png")
+    _drop(drop, "jacket-final.obj", b"v 2 2 2\n")
+    results = {result.source.name: result for result in service.scan(library, database)}
+    jacket = results["jacket_v001.obj"]
+    assert jacket.succeeded and jacket.is_new_asset and jacket.flagged and jacket.version == 1
+    assert jacket.asset_file == library / "assets" / "models" / "jacket.obj"
+    assert jacket.summary == "jacket_v001.obj -> jacket v001 (new asset, pending review)"
+    assert results["swatch_v001.png"].asset_file == library / "assets" / "textures" / "swatch.png"
+    assert not results["jacket-final.obj"].succeeded
+    assert "pattern" in results["jacket-final.obj"].summary
+
+    metadata = database.get_asset_metadata(jacket.asset_file)
+    assert metadata["asset_type"] == "Props" and metadata["status"]["state"] == "pending_review"
+    assert metadata[INGEST_METADATA_KEY]["source"] == str(drop / "jacket_v001.obj")
+    assert service.scan(library, database) == []  # Nothing new since
+
+    # A re-export of the same file is a new version of the asset
+    _drop(drop, "jacket_v001.obj", b"v 3 3 3\nv 4 4 4\n", age=30.0)
+    _drop(drop, "jacket_v002.obj", b"v 5 5 5\n")
+    results = service.scan(library, database)
+    assert [(r.source.name, r.is_new_asset, r.version) for r in results] == [
+        ("jacket_v001.obj", False, 2),
+        ("jacket_v002.obj", False, 3),
+    ]
+    assert jacket.asset_file.read_bytes() == b"v 5 5 5\n"
+    versions = get_version_service().get_versions(jacket.asset_file)
+    assert [version.number for version in versions] == [1, 2, 3]
+    assert versions[1].notes == "Ingested from marvelous/jacket_v001.obj"
+    assert not list(jacket.asset_file.parent.glob(".*.ingest"))  # No partial copies left
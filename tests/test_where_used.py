@@ -0,0 +1,100 @@
+"""
+Test suite for the where used scene scanner
+
+Validates finding the project scenes that reference an asset, the version and
+namespace each one loads, and the background scan reading binary scenes.
+
+Author: Asset Manager Development Team
+Version: 1.5.0
+"""
+
+import tempfile
+import threading
+from pathlib import Path
+
+SHOT_SCENE = """//Maya ASCII 2024 scene
+file -rdi 1 -ns "crate" -rfn "crateRN" -typ "mayaAscii" "{live}";
+file -rdi 1 -ns "crate1" -rfn "crate1RN" -typ "mayaAscii" "{pinned}{{1}}";
+file -rdi 1 -ns "barrel" -rfn "barrelRN" -typ "mayaAscii" "{other}";
+file -r -ns "crate" -dr 1 -rfn "crateRN" -typ "mayaAscii" "{live}";
+"""
+
+
+def _make_project():
+    """Library with a crate and barrel, and a shot tree referencing them"""
+    root = Path(tempfile.mkdtemp(prefix="assetManager_where_used_"))
+    scenes = root / "library" / "assets" / "scenes"
+    scenes.mkdir(parents=True)
+    crate = scenes / "crate.ma"
+    crate.write_text("//Maya ASCII 2024 scene\n")
+    barrel = scenes / "barrel.ma"
+    barrel.write_text("//Maya ASCII 2024 scene\n")
+
+    shots = root / "shots" / "sh010"
+    shots.mkdir(parents=True)
+    pinned = scenes / ".versions" / "crate" / "v003" / "crate.ma"
+    (shots / "shot010.ma").write_text(
+        SHOT_SCENE.format(live=crate.as_posix(), pinned=pinned.as_posix(), other=barrel)
+    )
+    (shots / "shot020.mb").write_bytes(b"FOR4\x00\x00")
+    (root / "shots" / ".backup").mkdir()
+    (root / "shots" / ".backup" / "shot010.ma").write_text(
+        SHOT_SCENE.format(live=crate.as_posix(), pinned="", other="")
+    )
+    return root, crate.resolve()
+
+
+def test_scan_lists_scenes_versions_and_namespaces():
+    """ASCII scenes are parsed; snapshots count as their asset at that version"""
+    from src.services.where_used_service_impl import WhereUsedService
+
+    root, crate = _make_project()
+    config = root / "where_used.json"
+    service = WhereUsedService(config_file=config, binary_reader=lambda scenes: {})
+
+    usages = service.scan([root / "shots"], crate)
+    assert [(u.scene.name, u.namespace, u.version_label) for u in usages] == [
+        ("shot010.ma", "crate", "current"),
+        ("shot010.ma", "crate1", "v003"),
+    ]
+    assert usages[1].label == "shot010.ma: crate1 v003"
+
+    # The configured shot tree replaces the project folders, and is remembered
+    assert service.get_search_roots([root]) == [root]
+    service.set_shot_roots([root / "shots"])
+    assert service.save_config()
+    reloaded = WhereUsedService(config_file=config)
+    assert reloaded.get_search_roots([root]) == [root / "shots"]
+
+
+def test_background_scan_reads_binary_scenes_with_the_reader():
+    """Binary scenes go to the mayapy reader; results arrive on the worker thread"""
+    from src.services.where_used_service_impl import WhereUsedService
+
+    root, crate = _make_project()
+    binary = root / "shots" / "sh010" / "shot020.mb"
+    read = []
+
+    def reader(scenes):
+        read.extend(scene.name for scene in scenes)
+        return {binary: [(crate.as_posix() + "{2}", "crateB")]}
+
+    found = []
+    finished = threading.Event()
+
+    def on_finished(usages):
+        found.extend(usages)
+        finished.set()
+
+    service = WhereUsedService(config_file=root / "where_used.json", binary_reader=reader)
+    thread = service.scan_in_background([root / "shots"], crate, on_finished)
+    assert finished.wait(10)
+    thread.join(10)
+
+    assert read == ["shot020.mb"]
+    assert [(u.scene.name, u.namespace, u.is_pinned) for u in found] == [
+        ("shot010.ma", "crate", False),
+        ("shot010.ma", "crate1", True),
+        ("shot020.mb", "crateB", False),
+    ]
+    assert found[2].reference_path == crate.as_posix()